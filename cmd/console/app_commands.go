@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/minio/console/pkg/logger"
@@ -35,11 +36,31 @@ var appCmds = []cli.Command{
 
 // StartServer starts the console service
 func StartServer(ctx *cli.Context) error {
-	if err := loadAllCerts(ctx); err != nil {
+	// configure the server from the configuration file, if any, before anything reads the environment
+	if err := restapi.LoadConsoleConfigFile(ctx.String("config")); err != nil {
+		restapi.LogError("%v", err)
+		return err
+	}
+	// apply the changes of the reloadable sections of the configuration file on SIGHUP
+	restapi.ReloadConsoleConfigOnSignal(syscall.SIGHUP)
+
+	var rctx restapi.Context
+	if err := rctx.Load(ctx); err != nil {
+		restapi.LogError("argument validation failed: %v", err)
+		return err
+	}
+
+	if err := loadAllCerts(ctx, rctx); err != nil {
 		// Log this as a warning and continue running console without TLS certificates
 		restapi.LogError("Unable to load certs: %v", err)
 	}
 
+	// open the sockets passed by systemd or the Unix domain socket, before the server is configured to serve them
+	if err := restapi.ListenSockets(rctx.SocketPath); err != nil {
+		restapi.LogError("Unable to listen on the sockets: %v", err)
+		return err
+	}
+
 	xctx := context.Background()
 	transport := restapi.PrepareSTSClientTransport(false)
 	if err := logger.InitializeLogger(xctx, transport); err != nil {
@@ -47,15 +68,29 @@ func StartServer(ctx *cli.Context) error {
 		logger.CriticalIf(xctx, err)
 	}
 	// custom error configuration
+	restapi.LogDebug = logger.Debug
 	restapi.LogInfo = logger.Info
 	restapi.LogError = logger.Error
 	restapi.LogIf = logger.LogIf
+	restapi.LogDebugContext = logger.DebugContext
+	restapi.LogInfoContext = logger.InfoContext
+	restapi.LogErrorContext = logger.ErrorContext
 
-	var rctx restapi.Context
-	if err := rctx.Load(ctx); err != nil {
-		restapi.LogError("argument validation failed: %v", err)
+	// export the spans of the API calls, the websockets and the outgoing calls when a collector is configured
+	shutdownTracing, err := restapi.InitTracing(xctx)
+	if err != nil {
+		restapi.LogError("Unable to initialize tracing: %v", err)
+		return err
+	}
+	defer shutdownTracing(context.Background())
+
+	// serve the metrics of the console process on a listener of their own
+	stopMetrics, err := restapi.StartMetricsServer()
+	if err != nil {
+		restapi.LogError("Unable to serve the console metrics: %v", err)
 		return err
 	}
+	defer stopMetrics()
 
 	server, err := buildServer()
 	if err != nil {
@@ -63,6 +98,12 @@ func StartServer(ctx *cli.Context) error {
 		return err
 	}
 
+	// validate the configured integrations instead of failing at their first use
+	if err := restapi.RunStartupPreflight(xctx); err != nil {
+		restapi.LogError("Unable to start console server: %v", err)
+		return err
+	}
+
 	server.Host = rctx.Host
 	server.Port = rctx.HTTPPort
 	// set conservative timesout for uploads
@@ -83,6 +124,9 @@ func StartServer(ctx *cli.Context) error {
 		restapi.TLSRedirect = rctx.TLSRedirect
 	}
 
+	// serve on the sockets instead of the TCP ports, if any
+	server.UseSockets()
+
 	defer server.Shutdown()
 
 	return server.Serve()
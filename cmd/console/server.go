@@ -17,9 +17,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"path/filepath"
+	"strconv"
 	"syscall"
 
 	"github.com/go-openapi/loads"
@@ -37,6 +40,11 @@ var serverCmd = cli.Command{
 	Usage:   "Start MinIO Console server",
 	Action:  StartServer,
 	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "config",
+			Value: restapi.GetConsoleConfigFile(),
+			Usage: "path to a YAML or JSON configuration file, the environment variables take precedence",
+		},
 		cli.StringFlag{
 			Name:  "host",
 			Value: restapi.GetHostname(),
@@ -56,6 +64,11 @@ var serverCmd = cli.Command{
 			Value:  restapi.GetHostname(),
 			Hidden: true,
 		},
+		cli.StringFlag{
+			Name:  "socket-path",
+			Value: restapi.GetSocketPath(),
+			Usage: "serve on a Unix domain socket instead of the TCP ports",
+		},
 		cli.StringFlag{
 			Name:  "certs-dir",
 			Value: certs.GlobalCertsCADir.Get(),
@@ -125,7 +138,7 @@ func buildServer() (*restapi.Server, error) {
 	return server, nil
 }
 
-func loadAllCerts(ctx *cli.Context) error {
+func loadAllCerts(ctx *cli.Context, rctx restapi.Context) error {
 	var err error
 	// Set all certs and CAs directories path
 	certs.GlobalCertsDir, _, err = certs.NewConfigDirFromCtx(ctx, "certs-dir", certs.DefaultCertsDir.Get)
@@ -139,6 +152,13 @@ func loadAllCerts(ctx *cli.Context) error {
 		return fmt.Errorf("unable to create certs CA directory at %s: failed with %w", certs.GlobalCertsCADir.Get(), err)
 	}
 
+	// obtain the certificate from the ACME CA, if configured, before loading it with the others
+	httpAddr := net.JoinHostPort(rctx.Host, strconv.Itoa(rctx.HTTPPort))
+	if err = restapi.InitAutoTLS(context.Background(), certs.GlobalCertsDir.Get(), httpAddr); err != nil {
+		// keep serving the certificate obtained before, if any
+		restapi.LogError("Unable to provision the ACME certificate: %v", err)
+	}
+
 	// load the certificates and the CAs
 	restapi.GlobalRootCAs, restapi.GlobalPublicCerts, restapi.GlobalTLSCertsManager, err = certs.GetAllCertificatesAndCAs()
 	if err != nil {
@@ -152,7 +172,12 @@ func loadAllCerts(ctx *cli.Context) error {
 		swaggerServerCACertificate := ctx.String("tls-ca")
 		// load tls cert and key from swagger server tls-certificate and tls-key flags
 		if swaggerServerCertificate != "" && swaggerServerCertificateKey != "" {
-			if err = restapi.GlobalTLSCertsManager.AddCertificate(swaggerServerCertificate, swaggerServerCertificateKey); err != nil {
+			if restapi.GlobalTLSCertsManager == nil {
+				restapi.GlobalTLSCertsManager, err = certs.NewManager(swaggerServerCertificate, swaggerServerCertificateKey)
+			} else {
+				err = restapi.GlobalTLSCertsManager.AddCertificate(swaggerServerCertificate, swaggerServerCertificateKey)
+			}
+			if err != nil {
 				return err
 			}
 			x509Certs, err := certs.ParsePublicCertFile(swaggerServerCertificate)
@@ -49,12 +49,24 @@ type AddBucketLifecycle struct {
 	// Non required, can be set in case of expiration is enabled
 	NoncurrentversionExpirationDays int32 `json:"noncurrentversion_expiration_days,omitempty"`
 
+	// Non required, number of newer noncurrent versions to retain before the expiration applies
+	NoncurrentversionExpirationNewerVersions int32 `json:"noncurrentversion_expiration_newer_versions,omitempty"`
+
 	// Non required, can be set in case of transition is enabled
 	NoncurrentversionTransitionDays int32 `json:"noncurrentversion_transition_days,omitempty"`
 
+	// Non required, number of newer noncurrent versions to retain before the transition applies
+	NoncurrentversionTransitionNewerVersions int32 `json:"noncurrentversion_transition_newer_versions,omitempty"`
+
 	// Non required, can be set in case of transition is enabled
 	NoncurrentversionTransitionStorageClass string `json:"noncurrentversion_transition_storage_class,omitempty"`
 
+	// Non required, only objects larger than this size in bytes are matched
+	ObjectSizeGreaterThan int64 `json:"object_size_greater_than,omitempty"`
+
+	// Non required, only objects smaller than this size in bytes are matched
+	ObjectSizeLessThan int64 `json:"object_size_less_than,omitempty"`
+
 	// Non required field, it matches a prefix to perform ILM operations on it
 	Prefix string `json:"prefix,omitempty"`
 
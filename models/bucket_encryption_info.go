@@ -37,6 +37,9 @@ type BucketEncryptionInfo struct {
 	// algorithm
 	Algorithm string `json:"algorithm,omitempty"`
 
+	// kms context
+	KmsContext map[string]string `json:"kmsContext,omitempty"`
+
 	// kms master key ID
 	KmsMasterKeyID string `json:"kmsMasterKeyID,omitempty"`
 }
@@ -35,9 +35,15 @@ import (
 // swagger:model bucketEncryptionRequest
 type BucketEncryptionRequest struct {
 
+	// create key
+	CreateKey bool `json:"createKey,omitempty"`
+
 	// enc type
 	EncType *BucketEncryptionType `json:"encType,omitempty"`
 
+	// kms context
+	KmsContext map[string]string `json:"kmsContext,omitempty"`
+
 	// kms key ID
 	KmsKeyID string `json:"kmsKeyID,omitempty"`
 }
@@ -40,9 +40,18 @@ type BucketQuota struct {
 	// quota
 	Quota int64 `json:"quota,omitempty"`
 
+	// soft limit
+	SoftLimit int64 `json:"soft_limit,omitempty"`
+
+	// soft limit exceeded
+	SoftLimitExceeded bool `json:"soft_limit_exceeded,omitempty"`
+
 	// type
 	// Enum: [hard]
 	Type string `json:"type,omitempty"`
+
+	// usage
+	Usage int64 `json:"usage,omitempty"`
 }
 
 // Validate validates this bucket quota
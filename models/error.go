@@ -46,6 +46,9 @@ type Error struct {
 	// message
 	// Required: true
 	Message *string `json:"message"`
+
+	// missing permission
+	MissingPermission string `json:"missingPermission,omitempty"`
 }
 
 // Validate validates this error
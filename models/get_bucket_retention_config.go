@@ -38,6 +38,9 @@ type GetBucketRetentionConfig struct {
 	// mode
 	Mode ObjectRetentionMode `json:"mode,omitempty"`
 
+	// object locking enabled
+	ObjectLockingEnabled bool `json:"objectLockingEnabled,omitempty"`
+
 	// unit
 	Unit ObjectRetentionUnit `json:"unit,omitempty"`
 
@@ -39,6 +39,9 @@ type ListBucketEventsResponse struct {
 	// events
 	Events []*NotificationConfig `json:"events"`
 
+	// cursor of the next page, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+
 	// total number of bucket events
 	Total int64 `json:"total,omitempty"`
 }
@@ -39,6 +39,9 @@ type ListBucketsResponse struct {
 	// list of resulting buckets
 	Buckets []*Bucket `json:"buckets"`
 
+	// cursor of the next page, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+
 	// number of buckets accessible to the user
 	Total int64 `json:"total,omitempty"`
 }
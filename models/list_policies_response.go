@@ -36,6 +36,9 @@ import (
 // swagger:model listPoliciesResponse
 type ListPoliciesResponse struct {
 
+	// cursor of the next page, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+
 	// list of policies
 	Policies []*Policy `json:"policies"`
 
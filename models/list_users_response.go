@@ -36,6 +36,12 @@ import (
 // swagger:model listUsersResponse
 type ListUsersResponse struct {
 
+	// cursor of the next page, empty on the last page
+	NextCursor string `json:"nextCursor,omitempty"`
+
+	// total number of users
+	Total int64 `json:"total,omitempty"`
+
 	// list of resulting users
 	Users []*User `json:"users"`
 }
@@ -38,9 +38,15 @@ type LoginRequest struct {
 	// access key
 	AccessKey string `json:"accessKey,omitempty"`
 
+	// captcha
+	Captcha string `json:"captcha,omitempty"`
+
 	// features
 	Features *LoginRequestFeatures `json:"features,omitempty"`
 
+	// otp
+	Otp string `json:"otp,omitempty"`
+
 	// secret key
 	SecretKey string `json:"secretKey,omitempty"`
 
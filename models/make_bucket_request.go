@@ -36,6 +36,9 @@ import (
 // swagger:model makeBucketRequest
 type MakeBucketRequest struct {
 
+	// encryption
+	Encryption *BucketEncryptionRequest `json:"encryption,omitempty"`
+
 	// locking
 	Locking bool `json:"locking,omitempty"`
 
@@ -49,6 +52,9 @@ type MakeBucketRequest struct {
 	// retention
 	Retention *PutBucketRetentionRequest `json:"retention,omitempty"`
 
+	// tags
+	Tags map[string]string `json:"tags,omitempty"`
+
 	// versioning
 	Versioning bool `json:"versioning,omitempty"`
 }
@@ -57,6 +63,10 @@ type MakeBucketRequest struct {
 func (m *MakeBucketRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEncryption(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -75,6 +85,25 @@ func (m *MakeBucketRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *MakeBucketRequest) validateEncryption(formats strfmt.Registry) error {
+	if swag.IsZero(m.Encryption) { // not required
+		return nil
+	}
+
+	if m.Encryption != nil {
+		if err := m.Encryption.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryption")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryption")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *MakeBucketRequest) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -126,6 +155,10 @@ func (m *MakeBucketRequest) validateRetention(formats strfmt.Registry) error {
 func (m *MakeBucketRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateEncryption(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateQuota(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -140,6 +173,22 @@ func (m *MakeBucketRequest) ContextValidate(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *MakeBucketRequest) contextValidateEncryption(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Encryption != nil {
+		if err := m.Encryption.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("encryption")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("encryption")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *MakeBucketRequest) contextValidateQuota(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Quota != nil {
@@ -39,6 +39,9 @@ type MultiBucketsRelation struct {
 
 	// origin bucket
 	OriginBucket string `json:"originBucket,omitempty"`
+
+	// priority
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // Validate validates this multi buckets relation
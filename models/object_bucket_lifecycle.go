@@ -42,6 +42,12 @@ type ObjectBucketLifecycle struct {
 	// id
 	ID string `json:"id,omitempty"`
 
+	// object size greater than
+	ObjectSizeGreaterThan int64 `json:"object_size_greater_than,omitempty"`
+
+	// object size less than
+	ObjectSizeLessThan int64 `json:"object_size_less_than,omitempty"`
+
 	// prefix
 	Prefix string `json:"prefix,omitempty"`
 
@@ -46,12 +46,30 @@ type Principal struct {
 	// account access key
 	AccountAccessKey string `json:"accountAccessKey,omitempty"`
 
+	// cluster ID
+	ClusterID string `json:"clusterID,omitempty"`
+
 	// custom style ob
 	CustomStyleOb string `json:"customStyleOb,omitempty"`
 
+	// expiration
+	Expiration int64 `json:"expiration,omitempty"`
+
 	// hm
 	Hm bool `json:"hm,omitempty"`
 
+	// idp name
+	IdpName string `json:"idpName,omitempty"`
+
+	// idp refresh token
+	IdpRefreshToken string `json:"idpRefreshToken,omitempty"`
+
+	// issued at
+	IssuedAt int64 `json:"issuedAt,omitempty"`
+
+	// login cluster
+	LoginCluster string `json:"loginCluster,omitempty"`
+
 	// ob
 	Ob bool `json:"ob,omitempty"`
 }
@@ -36,6 +36,9 @@ import (
 // swagger:model profilingStartRequest
 type ProfilingStartRequest struct {
 
+	// seconds the profiling can run before it's stopped
+	Duration int64 `json:"duration,omitempty"`
+
 	// type
 	// Required: true
 	Type *string `json:"type"`
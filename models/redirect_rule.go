@@ -37,6 +37,9 @@ type RedirectRule struct {
 	// display name
 	DisplayName string `json:"displayName,omitempty"`
 
+	// name
+	Name string `json:"name,omitempty"`
+
 	// redirect
 	Redirect string `json:"redirect,omitempty"`
 
@@ -41,6 +41,9 @@ type SessionResponse struct {
 	// allow resources
 	AllowResources []*PermissionResource `json:"allowResources"`
 
+	// console grants
+	ConsoleGrants []string `json:"consoleGrants"`
+
 	// custom styles
 	CustomStyles string `json:"customStyles,omitempty"`
 
@@ -56,12 +59,18 @@ type SessionResponse struct {
 	// operator
 	Operator bool `json:"operator,omitempty"`
 
+	// password change required
+	PasswordChangeRequired bool `json:"passwordChangeRequired,omitempty"`
+
 	// permissions
 	Permissions map[string][]string `json:"permissions,omitempty"`
 
 	// server end point
 	ServerEndPoint string `json:"serverEndPoint,omitempty"`
 
+	// session renew in
+	SessionRenewIn int64 `json:"sessionRenewIn,omitempty"`
+
 	// status
 	// Enum: [ok]
 	Status string `json:"status,omitempty"`
@@ -47,6 +47,9 @@ type SetBucketQuota struct {
 	// quota type
 	// Enum: [hard]
 	QuotaType string `json:"quota_type,omitempty"`
+
+	// soft limit
+	SoftLimit int64 `json:"soft_limit,omitempty"`
 }
 
 // Validate validates this set bucket quota
@@ -39,6 +39,9 @@ type StartProfilingList struct {
 	// start results
 	StartResults []*StartProfilingItem `json:"startResults"`
 
+	// time the profiling is stopped unless it is stopped before
+	StopsAt string `json:"stopsAt,omitempty"`
+
 	// number of start results
 	Total int64 `json:"total,omitempty"`
 }
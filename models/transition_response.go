@@ -40,6 +40,9 @@ type TransitionResponse struct {
 	// days
 	Days int64 `json:"days,omitempty"`
 
+	// newer noncurrent transition versions
+	NewerNoncurrentTransitionVersions int64 `json:"newer_noncurrent_transition_versions,omitempty"`
+
 	// noncurrent storage class
 	NoncurrentStorageClass string `json:"noncurrent_storage_class,omitempty"`
 
@@ -0,0 +1,232 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package actionaudit records the state changing calls made to the Console REST API: who made them, to what
+// operation, with which parameters once their secrets are redacted, and how they ended. The recent events are kept
+// in memory to be queried and every event is sent to the configured sinks.
+package actionaudit
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Result of an event
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Redacted replaces the values of the secret parameters
+const Redacted = "REDACTED"
+
+// queueSize is the number of events waiting to be sent to the sinks, more are dropped
+const queueSize = 10000
+
+// secretParams are the fragments of the parameter names whose values are redacted
+var secretParams = []string{"secret", "password", "token", "privatekey", "private_key", "otp", "captcha", "credential", "passphrase", "apikey", "api_key", "sessionid"}
+
+// Event is a state changing call
+type Event struct {
+	Time      time.Time              `json:"time"`
+	RequestID string                 `json:"requestID,omitempty"`
+	User      string                 `json:"user,omitempty"`
+	SourceIP  string                 `json:"sourceIP,omitempty"`
+	Operation string                 `json:"operation"`
+	Method    string                 `json:"method"`
+	Path      string                 `json:"path"`
+	Params    map[string]interface{} `json:"params,omitempty"`
+	Status    int                    `json:"status"`
+	Result    string                 `json:"result"`
+	Error     string                 `json:"error,omitempty"`
+}
+
+// Sink receives every event
+type Sink interface {
+	Send(event Event) error
+	String() string
+}
+
+// Filter selects events, the zero values match every event
+type Filter struct {
+	User      string
+	Operation string
+	Result    string
+	Since     time.Time
+	Limit     int
+}
+
+func (f Filter) match(event Event) bool {
+	return (f.User == "" || event.User == f.User) &&
+		(f.Operation == "" || event.Operation == f.Operation) &&
+		(f.Result == "" || event.Result == f.Result) &&
+		(f.Since.IsZero() || !event.Time.Before(f.Since))
+}
+
+// Redact returns a copy of the parameters where the values of the secret ones, at any depth, are replaced
+func Redact(params map[string]interface{}) map[string]interface{} {
+	if params == nil {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(params))
+	for name, value := range params {
+		if IsSecretParam(name) {
+			redacted[name] = Redacted
+			continue
+		}
+		redacted[name] = redactValue(value)
+	}
+	return redacted
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return Redact(v)
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = redactValue(item)
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// IsSecretParam returns whether the values of the parameter, or field, name are secret
+func IsSecretParam(name string) bool {
+	name = strings.ToLower(name)
+	for _, fragment := range secretParams {
+		if strings.Contains(name, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// Log keeps the recent events and sends them to the sinks in the background
+type Log struct {
+	mu      sync.Mutex
+	events  []Event
+	next    int
+	full    bool
+	sinks   []Sink
+	onError func(sink Sink, err error)
+	queue   chan Event
+	done    chan struct{}
+	dropped uint64
+}
+
+// New returns a log keeping the last capacity events and sending them to the sinks, onError is called when a
+// sink fails
+func New(capacity int, sinks []Sink, onError func(sink Sink, err error)) *Log {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	l := &Log{
+		events:  make([]Event, capacity),
+		sinks:   sinks,
+		onError: onError,
+		done:    make(chan struct{}),
+	}
+	if len(sinks) == 0 {
+		close(l.done)
+		return l
+	}
+	l.queue = make(chan Event, queueSize)
+	go l.dispatch()
+	return l
+}
+
+func (l *Log) dispatch() {
+	defer close(l.done)
+	for event := range l.queue {
+		for _, sink := range l.sinks {
+			if err := sink.Send(event); err != nil && l.onError != nil {
+				l.onError(sink, err)
+			}
+		}
+	}
+}
+
+// keep adds the event to the recent ones, replacing the oldest when full
+func (l *Log) keep(event Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events[l.next] = event
+	l.next = (l.next + 1) % len(l.events)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Restore adds events recorded before, oldest first, to the recent ones without sending them again
+func (l *Log) Restore(events []Event) {
+	for _, event := range events {
+		l.keep(event)
+	}
+}
+
+// Record keeps the event and queues it for the sinks, it is dropped for them when they can't keep up
+func (l *Log) Record(event Event) {
+	l.keep(event)
+	if l.queue == nil {
+		return
+	}
+	select {
+	case l.queue <- event:
+	default:
+		atomic.AddUint64(&l.dropped, 1)
+	}
+}
+
+// Dropped returns the number of events the sinks didn't receive
+func (l *Log) Dropped() uint64 {
+	return atomic.LoadUint64(&l.dropped)
+}
+
+// Query returns the recent events matching the filter, newest first
+func (l *Log) Query(filter Filter) []Event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	count := l.next
+	if l.full {
+		count = len(l.events)
+	}
+	events := []Event{}
+	for i := 1; i <= count; i++ {
+		event := l.events[(l.next-i+len(l.events))%len(l.events)]
+		if !filter.match(event) {
+			continue
+		}
+		events = append(events, event)
+		if filter.Limit > 0 && len(events) == filter.Limit {
+			break
+		}
+	}
+	return events
+}
+
+// Close sends the queued events and stops, Record must not be called after
+func (l *Log) Close() {
+	if l.queue != nil {
+		close(l.queue)
+	}
+	<-l.done
+}
@@ -0,0 +1,184 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package actionaudit
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRedact(t *testing.T) {
+	params := map[string]interface{}{
+		"name": "alice",
+		"body": map[string]interface{}{
+			"accessKey": "alice",
+			"secretKey": "hunter22",
+			"tier": map[string]interface{}{
+				"s3": map[string]interface{}{"secretkey": "abc", "bucket": "cold"},
+			},
+			"items": []interface{}{map[string]interface{}{"Password": "x", "user": "bob"}},
+		},
+		"token": "cpat_x",
+	}
+	want := map[string]interface{}{
+		"name": "alice",
+		"body": map[string]interface{}{
+			"accessKey": "alice",
+			"secretKey": Redacted,
+			"tier": map[string]interface{}{
+				"s3": map[string]interface{}{"secretkey": Redacted, "bucket": "cold"},
+			},
+			"items": []interface{}{map[string]interface{}{"Password": Redacted, "user": "bob"}},
+		},
+		"token": Redacted,
+	}
+	if got := Redact(params); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Redact() = %v, want %v", got, want)
+	}
+	// the parameters are not modified
+	if params["token"] != "cpat_x" {
+		t.Fatal("the parameters were modified")
+	}
+}
+
+type sinkMock struct {
+	events []Event
+	err    error
+}
+
+func (s *sinkMock) Send(event Event) error {
+	s.events = append(s.events, event)
+	return s.err
+}
+
+func (s *sinkMock) String() string {
+	return "mock"
+}
+
+func TestLog(t *testing.T) {
+	sink := &sinkMock{err: errors.New("unavailable")}
+	var failures int
+	log := New(3, []Sink{sink}, func(Sink, error) { failures++ })
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	for i, user := range []string{"alice", "bob", "alice", "carol"} {
+		log.Record(Event{Time: now.Add(time.Duration(i) * time.Minute), User: user, Operation: "AddUser", Result: ResultSuccess})
+	}
+	log.Record(Event{Time: now.Add(5 * time.Minute), User: "alice", Operation: "RemoveUser", Result: ResultFailure})
+
+	events := log.Query(Filter{})
+	if len(events) != 3 || events[0].Operation != "RemoveUser" || events[2].User != "alice" {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if events := log.Query(Filter{User: "alice"}); len(events) != 2 {
+		t.Fatalf("unexpected events of alice %v", events)
+	}
+	if events := log.Query(Filter{Result: ResultFailure}); len(events) != 1 {
+		t.Fatalf("unexpected failed events %v", events)
+	}
+	if events := log.Query(Filter{Since: now.Add(4 * time.Minute)}); len(events) != 1 {
+		t.Fatalf("unexpected recent events %v", events)
+	}
+	if events := log.Query(Filter{Limit: 1}); len(events) != 1 || events[0].Operation != "RemoveUser" {
+		t.Fatalf("unexpected limited events %v", events)
+	}
+
+	log.Close()
+	if len(sink.events) != 5 || failures != 5 {
+		t.Fatalf("sink received %d events with %d failures", len(sink.events), failures)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	events, err := ReadFile(path, 10)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("ReadFile() of a missing file = %v, %v", events, err)
+	}
+
+	sink, err := NewFileSink(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, user := range []string{"alice", "bob", "carol"} {
+		if err := sink.Send(Event{User: user, Operation: "AddUser"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sink.Close()
+
+	events, err = ReadFile(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].User != "bob" || events[1].User != "carol" {
+		t.Fatalf("unexpected events %v", events)
+	}
+
+	log := New(10, nil, nil)
+	log.Restore(events)
+	if events := log.Query(Filter{}); len(events) != 2 || events[0].User != "carol" {
+		t.Fatalf("unexpected restored events %v", events)
+	}
+}
+
+func TestRemoteSinks(t *testing.T) {
+	var received []map[string]interface{}
+	var contentTypes, paths, tokens []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		json.Unmarshal(body, &payload)
+		received = append(received, payload)
+		contentTypes = append(contentTypes, r.Header.Get("Content-Type"))
+		paths = append(paths, r.URL.Path)
+		tokens = append(tokens, r.Header.Get("Authorization"))
+	}))
+	defer server.Close()
+
+	event := Event{User: "alice", Operation: "AddUser", Result: ResultSuccess}
+	if err := NewWebhookSink(server.URL+"/audit", "secret", nil).Send(event); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewKafkaSink(server.URL, "console-audit", nil).Send(event); err != nil {
+		t.Fatal(err)
+	}
+
+	if received[0]["operation"] != "AddUser" || tokens[0] != "Bearer secret" || paths[0] != "/audit" {
+		t.Fatalf("unexpected webhook request %v %s %s", received[0], tokens[0], paths[0])
+	}
+	records := received[1]["records"].([]interface{})
+	record := records[0].(map[string]interface{})
+	if record["key"] != "alice" || paths[1] != "/topics/console-audit" || contentTypes[1] != "application/vnd.kafka.json.v2+json" {
+		t.Fatalf("unexpected kafka request %v %s %s", record, paths[1], contentTypes[1])
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	if err := NewWebhookSink(failing.URL, "", nil).Send(event); err == nil {
+		t.Fatal("a failing webhook didn't return an error")
+	}
+}
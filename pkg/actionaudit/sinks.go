@@ -0,0 +1,181 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package actionaudit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// sendTimeout bounds how long a remote sink takes to receive an event
+const sendTimeout = 10 * time.Second
+
+// maxLineSize bounds the events read back from a file
+const maxLineSize = 1 << 20
+
+// FileSink appends the events to a file as JSON lines
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink opens the file the events are appended to
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{path: path, file: file}, nil
+}
+
+// Send implements Sink
+func (s *FileSink) Send(event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(data, '\n'))
+	return err
+}
+
+func (s *FileSink) String() string {
+	return "file " + s.path
+}
+
+// Close closes the file
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// ReadFile returns the last n events of a file written by a FileSink, oldest first, skipping the lines that
+// can't be parsed
+func ReadFile(path string, n int) ([]Event, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var events []Event
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		events = append(events, event)
+		if len(events) > n {
+			events = events[1:]
+		}
+	}
+	return events, scanner.Err()
+}
+
+// WebhookSink posts every event as JSON to an endpoint
+type WebhookSink struct {
+	endpoint  string
+	authToken string
+	client    *http.Client
+}
+
+// NewWebhookSink returns a sink posting to endpoint, the token is sent as a Bearer token when set
+func NewWebhookSink(endpoint, authToken string, client *http.Client) *WebhookSink {
+	return &WebhookSink{endpoint: endpoint, authToken: authToken, client: client}
+}
+
+// Send implements Sink
+func (s *WebhookSink) Send(event Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return post(s.client, s.endpoint, "application/json", s.authToken, data)
+}
+
+func (s *WebhookSink) String() string {
+	return "webhook " + s.endpoint
+}
+
+// KafkaSink produces every event to a Kafka topic through a Kafka REST Proxy, keyed by the user
+type KafkaSink struct {
+	endpoint string
+	topic    string
+	client   *http.Client
+}
+
+// NewKafkaSink returns a sink producing to the topic with the REST Proxy at endpoint
+func NewKafkaSink(endpoint, topic string, client *http.Client) *KafkaSink {
+	return &KafkaSink{endpoint: endpoint, topic: topic, client: client}
+}
+
+// Send implements Sink
+func (s *KafkaSink) Send(event Event) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"records": []map[string]interface{}{{"key": event.User, "value": event}},
+	})
+	if err != nil {
+		return err
+	}
+	endpoint := fmt.Sprintf("%s/topics/%s", s.endpoint, url.PathEscape(s.topic))
+	return post(s.client, endpoint, "application/vnd.kafka.json.v2+json", "", data)
+}
+
+func (s *KafkaSink) String() string {
+	return fmt.Sprintf("kafka topic %s at %s", s.topic, s.endpoint)
+}
+
+func post(client *http.Client, endpoint, contentType, authToken string, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", endpoint, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package alerting evaluates rules against the conditions observed in a cluster, keeps the alerts they raise and
+// notifies the configured sinks when an alert fires or resolves. The rules are saved to a file when one is
+// configured, the alerts only live in memory.
+package alerting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+)
+
+// Kinds of rules
+const (
+	KindLicenseExpiring    = "license_expiring"
+	KindDriveUsage         = "drive_usage"
+	KindNodeOffline        = "node_offline"
+	KindReplicationBacklog = "replication_backlog"
+)
+
+// States of an alert
+const (
+	StateFiring   = "firing"
+	StateResolved = "resolved"
+)
+
+// resolvedHistory is the number of resolved alerts kept
+const resolvedHistory = 100
+
+var (
+	// ErrInvalidRule is returned for a rule that can't be evaluated
+	ErrInvalidRule = errors.New("invalid alert rule")
+	// ErrRuleNotFound is returned when no rule has the requested id
+	ErrRuleNotFound = errors.New("alert rule not found")
+)
+
+var ruleIDRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// kind describes the observations of a kind of rule
+type kind struct {
+	unit string
+	// below rules fire when the value drops to their threshold, the others when it goes above it
+	below bool
+}
+
+var kinds = map[string]kind{
+	KindLicenseExpiring:    {unit: "days left", below: true},
+	KindDriveUsage:         {unit: "% used"},
+	KindNodeOffline:        {unit: "offline"},
+	KindReplicationBacklog: {unit: "objects pending replication"},
+}
+
+// Rule raises an alert for every subject whose observed value crosses its threshold
+type Rule struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	Kind      string  `json:"kind"`
+	Threshold float64 `json:"threshold"`
+	Enabled   bool    `json:"enabled"`
+}
+
+// DefaultRules are evaluated until rules are configured
+var DefaultRules = []Rule{
+	{ID: "license-expiring", Name: "License expiring", Kind: KindLicenseExpiring, Threshold: 30, Enabled: true},
+	{ID: "drive-usage", Name: "Drive usage above 85%", Kind: KindDriveUsage, Threshold: 85, Enabled: true},
+	{ID: "node-offline", Name: "Node offline", Kind: KindNodeOffline, Threshold: 0, Enabled: true},
+	{ID: "replication-backlog", Name: "Replication backlog", Kind: KindReplicationBacklog, Threshold: 10000, Enabled: true},
+}
+
+// Validate checks the rule can be evaluated
+func (r Rule) Validate() error {
+	if !ruleIDRegexp.MatchString(r.ID) {
+		return fmt.Errorf("%w: the id has to be lowercase letters, digits, dashes and underscores", ErrInvalidRule)
+	}
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("%w: the name can't be empty", ErrInvalidRule)
+	}
+	if _, ok := kinds[r.Kind]; !ok {
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidRule, r.Kind)
+	}
+	if r.Threshold < 0 || math.IsNaN(r.Threshold) || math.IsInf(r.Threshold, 0) {
+		return fmt.Errorf("%w: the threshold has to be a positive number", ErrInvalidRule)
+	}
+	return nil
+}
+
+// fires returns whether an observed value crosses the threshold of the rule
+func (r Rule) fires(value float64) bool {
+	if kinds[r.Kind].below {
+		return value <= r.Threshold
+	}
+	return value > r.Threshold
+}
+
+// Observation is a value measured on a subject of the cluster: the license, a drive, a node or a bucket
+type Observation struct {
+	Kind    string
+	Subject string
+	Value   float64
+}
+
+// Alert is raised by a rule on a subject
+type Alert struct {
+	RuleID     string    `json:"ruleID"`
+	RuleName   string    `json:"ruleName"`
+	Kind       string    `json:"kind"`
+	Subject    string    `json:"subject"`
+	Value      float64   `json:"value"`
+	Threshold  float64   `json:"threshold"`
+	State      string    `json:"state"`
+	FiredAt    time.Time `json:"firedAt"`
+	ResolvedAt time.Time `json:"resolvedAt"`
+}
+
+func formatValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// Message describes the alert in a sentence
+func (a Alert) Message() string {
+	if a.State == StateResolved {
+		return fmt.Sprintf("[resolved] %s on %s", a.RuleName, a.Subject)
+	}
+	return fmt.Sprintf("[firing] %s on %s: %s %s, threshold %s", a.RuleName, a.Subject, formatValue(a.Value), kinds[a.Kind].unit, formatValue(a.Threshold))
+}
+
+func alertKey(ruleID, subject string) string {
+	return ruleID + "/" + subject
+}
+
+// State is the outcome of the evaluations
+type State struct {
+	LastEvaluation time.Time
+	// Errors are the kinds that couldn't be observed in the last evaluation, their alerts are kept as they were
+	Errors   map[string]string
+	Firing   []Alert
+	Resolved []Alert
+}
+
+// Engine evaluates the rules and keeps the firing alerts along with the last resolved ones
+type Engine struct {
+	mu             sync.Mutex
+	path           string
+	rules          []Rule
+	firing         map[string]*Alert
+	resolved       []Alert
+	lastEvaluation time.Time
+	errors         map[string]string
+}
+
+// New returns an engine evaluating the rules saved in path, or the default rules when path is empty or
+// doesn't exist yet
+func New(path string) (*Engine, error) {
+	e := &Engine{path: path, firing: map[string]*Alert{}, errors: map[string]string{}}
+	e.rules = append(e.rules, DefaultRules...)
+	if path == "" {
+		return e, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return e, nil
+		}
+		return nil, err
+	}
+	var rules []Rule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("invalid alert rules file %s: %w", path, err)
+	}
+	for _, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid alert rules file %s: %w", path, err)
+		}
+	}
+	e.rules = rules
+	return e, nil
+}
+
+func (e *Engine) save() error {
+	if e.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(e.rules, "", "  ")
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(e.path, data)
+}
+
+// Rules returns the rules sorted by id
+func (e *Engine) Rules() []Rule {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	rules := append([]Rule{}, e.rules...)
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+// dropAlerts forgets the firing alerts of a rule without notifying their resolution, the next evaluation
+// raises them again when the rule still fires
+func (e *Engine) dropAlerts(ruleID string) {
+	for key, alert := range e.firing {
+		if alert.RuleID == ruleID {
+			delete(e.firing, key)
+		}
+	}
+}
+
+// SetRule adds a rule or replaces the one with the same id
+func (e *Engine) SetRule(rule Rule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	replaced := false
+	for i := range e.rules {
+		if e.rules[i].ID == rule.ID {
+			e.rules[i] = rule
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		e.rules = append(e.rules, rule)
+	}
+	e.dropAlerts(rule.ID)
+	return e.save()
+}
+
+// DeleteRule removes a rule along with its alerts
+func (e *Engine) DeleteRule(id string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i := range e.rules {
+		if e.rules[i].ID == id {
+			e.rules = append(e.rules[:i], e.rules[i+1:]...)
+			e.dropAlerts(id)
+			return e.save()
+		}
+	}
+	return ErrRuleNotFound
+}
+
+// Evaluate applies the enabled rules to the observations and returns the alerts that fired or resolved.
+// unobserved holds the kinds that couldn't be observed along with the reason, their alerts are left as
+// they were instead of being resolved.
+func (e *Engine) Evaluate(now time.Time, observations []Observation, unobserved map[string]string) []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var changes []Alert
+	seen := map[string]bool{}
+	for _, rule := range e.rules {
+		if !rule.Enabled {
+			continue
+		}
+		for _, observation := range observations {
+			if observation.Kind != rule.Kind || !rule.fires(observation.Value) {
+				continue
+			}
+			key := alertKey(rule.ID, observation.Subject)
+			seen[key] = true
+			if alert, ok := e.firing[key]; ok {
+				alert.Value = observation.Value
+				continue
+			}
+			alert := &Alert{
+				RuleID:    rule.ID,
+				RuleName:  rule.Name,
+				Kind:      rule.Kind,
+				Subject:   observation.Subject,
+				Value:     observation.Value,
+				Threshold: rule.Threshold,
+				State:     StateFiring,
+				FiredAt:   now,
+			}
+			e.firing[key] = alert
+			changes = append(changes, *alert)
+		}
+	}
+	for key, alert := range e.firing {
+		if seen[key] {
+			continue
+		}
+		if _, ok := unobserved[alert.Kind]; ok {
+			continue
+		}
+		alert.State = StateResolved
+		alert.ResolvedAt = now
+		delete(e.firing, key)
+		e.resolved = append(e.resolved, *alert)
+		changes = append(changes, *alert)
+	}
+	if len(e.resolved) > resolvedHistory {
+		e.resolved = e.resolved[len(e.resolved)-resolvedHistory:]
+	}
+	e.lastEvaluation = now
+	e.errors = map[string]string{}
+	for k, reason := range unobserved {
+		e.errors[k] = reason
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return alertKey(changes[i].RuleID, changes[i].Subject) < alertKey(changes[j].RuleID, changes[j].Subject)
+	})
+	return changes
+}
+
+// State returns the firing alerts, oldest first, and the resolved ones, newest first
+func (e *Engine) State() State {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	state := State{LastEvaluation: e.lastEvaluation, Errors: map[string]string{}, Firing: []Alert{}, Resolved: []Alert{}}
+	for k, reason := range e.errors {
+		state.Errors[k] = reason
+	}
+	for _, alert := range e.firing {
+		state.Firing = append(state.Firing, *alert)
+	}
+	sort.Slice(state.Firing, func(i, j int) bool {
+		if !state.Firing[i].FiredAt.Equal(state.Firing[j].FiredAt) {
+			return state.Firing[i].FiredAt.Before(state.Firing[j].FiredAt)
+		}
+		return alertKey(state.Firing[i].RuleID, state.Firing[i].Subject) < alertKey(state.Firing[j].RuleID, state.Firing[j].Subject)
+	})
+	for i := len(e.resolved) - 1; i >= 0; i-- {
+		state.Resolved = append(state.Resolved, e.resolved[i])
+	}
+	return state
+}
@@ -0,0 +1,193 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package alerting
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	valid := Rule{ID: "drive-usage", Name: "Drive usage", Kind: KindDriveUsage, Threshold: 90}
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	for _, rule := range []Rule{
+		{ID: "Drive Usage", Name: "Drive usage", Kind: KindDriveUsage},
+		{ID: "drive-usage", Kind: KindDriveUsage},
+		{ID: "drive-usage", Name: "Drive usage", Kind: "cpu"},
+		{ID: "drive-usage", Name: "Drive usage", Kind: KindDriveUsage, Threshold: -1},
+	} {
+		if err := rule.Validate(); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("expected %+v to be invalid, got %v", rule, err)
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	engine, err := New("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	observations := []Observation{
+		{Kind: KindDriveUsage, Subject: "node1:9000/data1", Value: 91},
+		{Kind: KindDriveUsage, Subject: "node1:9000/data2", Value: 40},
+		{Kind: KindNodeOffline, Subject: "node2:9000", Value: 1},
+		{Kind: KindLicenseExpiring, Subject: "license", Value: 45},
+	}
+	changes := engine.Evaluate(now, observations, nil)
+	if len(changes) != 2 || changes[0].Subject != "node1:9000/data1" || changes[1].Subject != "node2:9000" {
+		t.Fatalf("unexpected alerts %+v", changes)
+	}
+	if changes[0].State != StateFiring || changes[0].Message() != "[firing] Drive usage above 85% on node1:9000/data1: 91 % used, threshold 85" {
+		t.Fatalf("unexpected alert %+v: %s", changes[0], changes[0].Message())
+	}
+
+	// firing alerts are only notified once
+	observations[0].Value = 95
+	observations[3].Value = 20
+	changes = engine.Evaluate(now.Add(time.Minute), observations, nil)
+	if len(changes) != 1 || changes[0].Kind != KindLicenseExpiring {
+		t.Fatalf("expected only the license to fire, got %+v", changes)
+	}
+	state := engine.State()
+	if len(state.Firing) != 3 || state.Firing[0].Value != 95 || !state.Firing[0].FiredAt.Equal(now) {
+		t.Fatalf("unexpected firing alerts %+v", state.Firing)
+	}
+
+	// the nodes couldn't be listed, the node alert is kept while the drive recovered
+	changes = engine.Evaluate(now.Add(2*time.Minute), []Observation{
+		{Kind: KindDriveUsage, Subject: "node1:9000/data1", Value: 50},
+		{Kind: KindLicenseExpiring, Subject: "license", Value: 20},
+	}, map[string]string{KindNodeOffline: "access denied"})
+	if len(changes) != 1 || changes[0].State != StateResolved || changes[0].Subject != "node1:9000/data1" {
+		t.Fatalf("expected the drive alert to resolve, got %+v", changes)
+	}
+	state = engine.State()
+	if len(state.Firing) != 2 || len(state.Resolved) != 1 || state.Errors[KindNodeOffline] != "access denied" {
+		t.Fatalf("unexpected state %+v", state)
+	}
+	if !state.LastEvaluation.Equal(now.Add(2 * time.Minute)) {
+		t.Fatalf("unexpected last evaluation %v", state.LastEvaluation)
+	}
+}
+
+func TestRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	engine, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := engine.Rules(); len(got) != len(DefaultRules) {
+		t.Fatalf("expected the default rules, got %+v", got)
+	}
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	engine.Evaluate(now, []Observation{{Kind: KindNodeOffline, Subject: "node2:9000", Value: 1}}, nil)
+
+	if err = engine.SetRule(Rule{ID: "node-offline", Name: "Node offline", Kind: KindNodeOffline, Enabled: false}); err != nil {
+		t.Fatal(err)
+	}
+	// the alerts of a changed rule are dropped
+	if state := engine.State(); len(state.Firing) != 0 {
+		t.Fatalf("expected the node alert to be dropped, got %+v", state.Firing)
+	}
+	if err = engine.SetRule(Rule{ID: "backlog-critical", Name: "Backlog", Kind: KindReplicationBacklog, Threshold: 1e6, Enabled: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err = engine.DeleteRule("license-expiring"); err != nil {
+		t.Fatal(err)
+	}
+	if err = engine.DeleteRule("license-expiring"); !errors.Is(err, ErrRuleNotFound) {
+		t.Fatalf("expected ErrRuleNotFound, got %v", err)
+	}
+	if err = engine.SetRule(Rule{ID: "x", Kind: KindNodeOffline}); !errors.Is(err, ErrInvalidRule) {
+		t.Fatalf("expected ErrInvalidRule, got %v", err)
+	}
+
+	// the rules survive a restart
+	reloaded, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := reloaded.Rules()
+	if len(rules) != len(DefaultRules) || rules[0].ID != "backlog-critical" {
+		t.Fatalf("unexpected reloaded rules %+v", rules)
+	}
+	for _, rule := range rules {
+		if rule.ID == "node-offline" && rule.Enabled {
+			t.Fatal("expected the node offline rule to stay disabled")
+		}
+	}
+}
+
+func TestSinks(t *testing.T) {
+	alert := Alert{RuleID: "node-offline", RuleName: "Node offline", Kind: KindNodeOffline, Subject: "node2:9000", Value: 1, State: StateFiring}
+
+	var received map[string]interface{}
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer server.Close()
+
+	if err := NewWebhookSink(server.URL, "secret", nil).Send(alert); err != nil {
+		t.Fatal(err)
+	}
+	if authorization != "Bearer secret" || received["subject"] != "node2:9000" || received["message"] != alert.Message() {
+		t.Fatalf("unexpected webhook request %q %v", authorization, received)
+	}
+	if err := NewSlackSink(server.URL, nil).Send(alert); err != nil {
+		t.Fatal(err)
+	}
+	if received["text"] != "[firing] Node offline on node2:9000: 1 offline, threshold 0" {
+		t.Fatalf("unexpected slack message %v", received)
+	}
+
+	var mail string
+	email := NewEmailSink("smtp.example.com:587", "console", "pass", "console@example.com", []string{"ops@example.com", "oncall@example.com"})
+	email.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		if addr != "smtp.example.com:587" || a == nil || from != "console@example.com" || len(to) != 2 {
+			t.Errorf("unexpected mail delivery to %s from %s to %v", addr, from, to)
+		}
+		mail = string(msg)
+		return nil
+	}
+	if err := email.Send(alert); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(mail, "Subject: [firing] Node offline on node2:9000") || !strings.Contains(mail, "To: ops@example.com, oncall@example.com") {
+		t.Fatalf("unexpected mail %q", mail)
+	}
+
+	failing := NewWebhookSink("http://127.0.0.1:1", "", nil)
+	var failures int
+	Notify([]Sink{failing}, []Alert{alert}, func(sink Sink, alert Alert, err error) { failures++ })
+	if failures != 1 {
+		t.Fatalf("expected the failure to be reported, got %d", failures)
+	}
+}
@@ -0,0 +1,172 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// sendTimeout bounds how long a sink takes to receive an alert
+const sendTimeout = 10 * time.Second
+
+// Sink is notified when an alert fires or resolves
+type Sink interface {
+	Send(alert Alert) error
+	String() string
+}
+
+// Notify sends the alerts to every sink, onError is called for every alert a sink didn't receive
+func Notify(sinks []Sink, alerts []Alert, onError func(sink Sink, alert Alert, err error)) {
+	for _, alert := range alerts {
+		for _, sink := range sinks {
+			if err := sink.Send(alert); err != nil && onError != nil {
+				onError(sink, alert, err)
+			}
+		}
+	}
+}
+
+// webhookPayload is the alert posted to webhooks
+type webhookPayload struct {
+	Alert
+	Message string `json:"message"`
+}
+
+// WebhookSink posts every alert as JSON to an endpoint
+type WebhookSink struct {
+	endpoint  string
+	authToken string
+	client    *http.Client
+}
+
+// NewWebhookSink returns a sink posting to endpoint, the token is sent as a Bearer token when set
+func NewWebhookSink(endpoint, authToken string, client *http.Client) *WebhookSink {
+	return &WebhookSink{endpoint: endpoint, authToken: authToken, client: client}
+}
+
+// Send implements Sink
+func (s *WebhookSink) Send(alert Alert) error {
+	data, err := json.Marshal(webhookPayload{Alert: alert, Message: alert.Message()})
+	if err != nil {
+		return err
+	}
+	return post(s.client, s.endpoint, s.authToken, data)
+}
+
+func (s *WebhookSink) String() string {
+	return "webhook " + s.endpoint
+}
+
+// SlackSink posts every alert to a Slack incoming webhook
+type SlackSink struct {
+	webhookURL string
+	client     *http.Client
+}
+
+// NewSlackSink returns a sink posting to a Slack incoming webhook
+func NewSlackSink(webhookURL string, client *http.Client) *SlackSink {
+	return &SlackSink{webhookURL: webhookURL, client: client}
+}
+
+// Send implements Sink
+func (s *SlackSink) Send(alert Alert) error {
+	data, err := json.Marshal(map[string]string{"text": alert.Message()})
+	if err != nil {
+		return err
+	}
+	return post(s.client, s.webhookURL, "", data)
+}
+
+func (s *SlackSink) String() string {
+	return "slack"
+}
+
+// EmailSink mails every alert through an SMTP server
+type EmailSink struct {
+	addr     string
+	username string
+	password string
+	from     string
+	to       []string
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewEmailSink returns a sink mailing the recipients through the SMTP server at addr, host:port,
+// authenticating with PLAIN when a username is set
+func NewEmailSink(addr, username, password, from string, to []string) *EmailSink {
+	return &EmailSink{addr: addr, username: username, password: password, from: from, to: to, sendMail: smtp.SendMail}
+}
+
+// Send implements Sink
+func (s *EmailSink) Send(alert Alert) error {
+	var auth smtp.Auth
+	if s.username != "" {
+		host, _, err := net.SplitHostPort(s.addr)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", s.username, s.password, host)
+	}
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", alert.Message())
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n\r\nRule: %s\r\nSubject: %s\r\nFired at: %s\r\n", alert.Message(), alert.RuleID, alert.Subject, alert.FiredAt.UTC().Format(time.RFC3339))
+	if alert.State == StateResolved {
+		fmt.Fprintf(&msg, "Resolved at: %s\r\n", alert.ResolvedAt.UTC().Format(time.RFC3339))
+	}
+	return s.sendMail(s.addr, auth, s.from, s.to, msg.Bytes())
+}
+
+func (s *EmailSink) String() string {
+	return "email " + strings.Join(s.to, ",")
+}
+
+func post(client *http.Client, endpoint, authToken string, body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+authToken)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s responded with %s", endpoint, resp.Status)
+	}
+	return nil
+}
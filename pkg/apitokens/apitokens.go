@@ -0,0 +1,375 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package apitokens keeps the personal access tokens users mint to script the Console REST API. A token stands for
+// a MinIO service account of its owner, Console only hands out an opaque bearer value and keeps the service account
+// credentials encrypted.
+package apitokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+)
+
+// Prefix starts every token, it tells them apart from the session tokens
+const Prefix = "cpat_"
+
+// Scopes of the tokens
+const (
+	// ScopeRead only allows the requests that don't change anything
+	ScopeRead = "read"
+	// ScopeWrite allows every request
+	ScopeWrite = "write"
+)
+
+const (
+	// maxUses is the number of recent uses kept per token, the oldest are dropped first
+	maxUses = 100
+	// saveInterval is how often the uses of a token are persisted, saving on every request would rewrite the file
+	// each time
+	saveInterval = time.Minute
+)
+
+var (
+	// ErrInvalidToken is returned for a value that isn't a current token
+	ErrInvalidToken = errors.New("invalid API token")
+	// ErrExpired is returned for a token past its expiration
+	ErrExpired = errors.New("API token has expired")
+	// ErrNotFound is returned when the owner has no token with that name
+	ErrNotFound = errors.New("API token not found")
+	// ErrInvalid is returned when a token can't be created as requested
+	ErrInvalid = errors.New("invalid API token request")
+)
+
+var nameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// Cipher protects the service account secret keys in the persisted state, owner is the user the token belongs to
+type Cipher interface {
+	Encrypt(plaintext []byte, owner string) (string, error)
+	Decrypt(ciphertext, owner string) ([]byte, error)
+}
+
+// plainCipher keeps the secret keys as they are, it is used when the store isn't given a cipher
+type plainCipher struct{}
+
+func (plainCipher) Encrypt(plaintext []byte, _ string) (string, error) {
+	return string(plaintext), nil
+}
+
+func (plainCipher) Decrypt(ciphertext, _ string) ([]byte, error) {
+	return []byte(ciphertext), nil
+}
+
+// Use is a request authenticated with a token
+type Use struct {
+	Time     time.Time `json:"time"`
+	SourceIP string    `json:"sourceIP"`
+	Method   string    `json:"method"`
+	Path     string    `json:"path"`
+}
+
+// Token is a personal access token, the bearer value itself is only known when it is created
+type Token struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Owner     string    `json:"owner"`
+	Scope     string    `json:"scope"`
+	AccessKey string    `json:"accessKey"`
+	Created   time.Time `json:"created"`
+	Expires   time.Time `json:"expires"`
+	// LastUsed and LastUsedFrom are the time and the source IP of the last use
+	LastUsed     time.Time `json:"lastUsed,omitempty"`
+	LastUsedFrom string    `json:"lastUsedFrom,omitempty"`
+	UseCount     int64     `json:"useCount"`
+
+	// SecretKey is the encrypted secret key of the service account and Hash the hash of the bearer value
+	SecretKey string `json:"secretKey"`
+	Hash      string `json:"hash"`
+	Uses      []Use  `json:"uses,omitempty"`
+}
+
+// Expired returns whether the token is past its expiration at now
+func (t Token) Expired(now time.Time) bool {
+	return !now.Before(t.Expires)
+}
+
+// Allows returns whether the scope of the token allows a request with the HTTP method
+func (t Token) Allows(method string) bool {
+	if t.Scope == ScopeWrite {
+		return true
+	}
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// Store holds the tokens, optionally persisted to a file
+type Store struct {
+	path   string
+	cipher Cipher
+
+	mu     sync.Mutex
+	tokens map[string]*Token
+	saved  map[string]time.Time
+}
+
+// New creates a store protecting the secret keys with cipher, they are kept as they are when it is nil. When path
+// isn't empty the tokens are loaded from and saved to that file, a missing file is an empty store.
+func New(path string, cipher Cipher) (*Store, error) {
+	if cipher == nil {
+		cipher = plainCipher{}
+	}
+	s := &Store{path: path, cipher: cipher, tokens: map[string]*Token{}, saved: map[string]time.Time{}}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(data, &s.tokens); err != nil {
+				return nil, fmt.Errorf("invalid API tokens file %s: %w", path, err)
+			}
+		}
+	}
+	return s, nil
+}
+
+func randomBytes(size int) ([]byte, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+func hash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// find returns the token of the owner with the name, the caller holds the lock
+func (s *Store) find(owner, name string) *Token {
+	for _, t := range s.tokens {
+		if t.Owner == owner && t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// Validate checks a token can be created with the name and scope, before its service account is
+func (s *Store) Validate(owner, name, scope string) error {
+	if !nameRegexp.MatchString(name) {
+		return fmt.Errorf("%w: the name must be 1 to 64 letters, digits, dots, dashes or underscores", ErrInvalid)
+	}
+	if scope != ScopeRead && scope != ScopeWrite {
+		return fmt.Errorf("%w: unknown scope %q", ErrInvalid, scope)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.find(owner, name) != nil {
+		return fmt.Errorf("%w: a token named %s already exists", ErrInvalid, name)
+	}
+	return nil
+}
+
+// Create adds a token of the owner standing for the service account credentials and returns its bearer value
+func (s *Store) Create(owner, name, scope, accessKey, secretKey string, expires, now time.Time) (string, *Token, error) {
+	if err := s.Validate(owner, name, scope); err != nil {
+		return "", nil, err
+	}
+	if !expires.After(now) {
+		return "", nil, fmt.Errorf("%w: the expiration must be in the future", ErrInvalid)
+	}
+	encrypted, err := s.cipher.Encrypt([]byte(secretKey), owner)
+	if err != nil {
+		return "", nil, err
+	}
+	idBytes, err := randomBytes(8)
+	if err != nil {
+		return "", nil, err
+	}
+	secretBytes, err := randomBytes(32)
+	if err != nil {
+		return "", nil, err
+	}
+	// the id is hex encoded so the first underscore after it separates the secret
+	id := hex.EncodeToString(idBytes)
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.find(owner, name) != nil {
+		return "", nil, fmt.Errorf("%w: a token named %s already exists", ErrInvalid, name)
+	}
+	t := &Token{
+		ID:        id,
+		Name:      name,
+		Owner:     owner,
+		Scope:     scope,
+		AccessKey: accessKey,
+		Created:   now,
+		Expires:   expires,
+		SecretKey: encrypted,
+		Hash:      hash(secret),
+	}
+	s.tokens[id] = t
+	if err := s.save(); err != nil {
+		delete(s.tokens, id)
+		return "", nil, err
+	}
+	return Prefix + id + "_" + secret, t.public(), nil
+}
+
+// public returns a copy of the token without its secrets and uses
+func (t *Token) public() *Token {
+	c := *t
+	c.SecretKey = ""
+	c.Hash = ""
+	c.Uses = nil
+	return &c
+}
+
+// Authenticate returns the token of a bearer value and the secret key of its service account
+func (s *Store) Authenticate(value string, now time.Time) (*Token, string, error) {
+	id, secret, ok := strings.Cut(strings.TrimPrefix(value, Prefix), "_")
+	if !strings.HasPrefix(value, Prefix) || !ok {
+		return nil, "", ErrInvalidToken
+	}
+	s.mu.Lock()
+	t, found := s.tokens[id]
+	var token Token
+	if found {
+		token = *t
+	}
+	s.mu.Unlock()
+	if !found || subtle.ConstantTimeCompare([]byte(token.Hash), []byte(hash(secret))) != 1 {
+		return nil, "", ErrInvalidToken
+	}
+	if token.Expired(now) {
+		return nil, "", ErrExpired
+	}
+	secretKey, err := s.cipher.Decrypt(token.SecretKey, token.Owner)
+	if err != nil {
+		return nil, "", err
+	}
+	return token.public(), string(secretKey), nil
+}
+
+// Record records a use of the token, the uses are persisted at most once per saveInterval
+func (s *Store) Record(id string, use Use) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tokens[id]
+	if !ok {
+		return nil
+	}
+	t.LastUsed = use.Time
+	t.LastUsedFrom = use.SourceIP
+	t.UseCount++
+	t.Uses = append(t.Uses, use)
+	if len(t.Uses) > maxUses {
+		t.Uses = t.Uses[len(t.Uses)-maxUses:]
+	}
+	if use.Time.Sub(s.saved[id]) < saveInterval {
+		return nil
+	}
+	s.saved[id] = use.Time
+	return s.save()
+}
+
+// List returns the tokens of the owner, the ones created first first
+func (s *Store) List(owner string) []*Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tokens := []*Token{}
+	for _, t := range s.tokens {
+		if t.Owner == owner {
+			tokens = append(tokens, t.public())
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].Created.Equal(tokens[j].Created) {
+			return tokens[i].Name < tokens[j].Name
+		}
+		return tokens[i].Created.Before(tokens[j].Created)
+	})
+	return tokens
+}
+
+// Uses returns the recent uses of the token of the owner with the name, newest first
+func (s *Store) Uses(owner, name string) ([]Use, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.find(owner, name)
+	if t == nil {
+		return nil, ErrNotFound
+	}
+	uses := make([]Use, 0, len(t.Uses))
+	for i := len(t.Uses) - 1; i >= 0; i-- {
+		uses = append(uses, t.Uses[i])
+	}
+	return uses, nil
+}
+
+// Get returns the token of the owner with the name
+func (s *Store) Get(owner, name string) (*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.find(owner, name)
+	if t == nil {
+		return nil, ErrNotFound
+	}
+	return t.public(), nil
+}
+
+// Revoke removes the token of the owner with the name, it can't be used anymore
+func (s *Store) Revoke(owner, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := s.find(owner, name)
+	if t == nil {
+		return ErrNotFound
+	}
+	delete(s.tokens, t.ID)
+	delete(s.saved, t.ID)
+	return s.save()
+}
+
+// save writes the tokens to the file of the store, the caller holds the lock
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s.tokens)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(s.path, data)
+}
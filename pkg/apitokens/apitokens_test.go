@@ -0,0 +1,124 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package apitokens
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-tokens.json")
+	store, err := New(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	expires := now.Add(24 * time.Hour)
+
+	if err := store.Validate("alice", "bad name", ScopeRead); !errors.Is(err, ErrInvalid) {
+		t.Errorf("a name with a space returned %v", err)
+	}
+	if err := store.Validate("alice", "ci", "admin"); !errors.Is(err, ErrInvalid) {
+		t.Errorf("an unknown scope returned %v", err)
+	}
+	if _, _, err := store.Create("alice", "ci", ScopeRead, "SA1", "secret1", now, now); !errors.Is(err, ErrInvalid) {
+		t.Errorf("an expired token returned %v", err)
+	}
+
+	value, token, err := store.Create("alice", "ci", ScopeRead, "SA1", "secret1", expires, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(value, Prefix) || token.Hash != "" || token.SecretKey != "" {
+		t.Errorf("unexpected token %s %+v", value, token)
+	}
+	if _, _, err := store.Create("alice", "ci", ScopeWrite, "SA2", "secret2", expires, now); !errors.Is(err, ErrInvalid) {
+		t.Errorf("a duplicate name returned %v", err)
+	}
+	if _, _, err := store.Create("bob", "ci", ScopeWrite, "SA2", "secret2", expires, now); err != nil {
+		t.Errorf("the names are per owner: %v", err)
+	}
+
+	authenticated, secretKey, err := store.Authenticate(value, now.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authenticated.Owner != "alice" || authenticated.AccessKey != "SA1" || secretKey != "secret1" {
+		t.Errorf("unexpected authentication %+v %s", authenticated, secretKey)
+	}
+	if authenticated.Allows(http.MethodPost) || !authenticated.Allows(http.MethodGet) {
+		t.Errorf("a read token must only allow safe methods")
+	}
+	if _, _, err := store.Authenticate(value+"x", now); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("a wrong secret returned %v", err)
+	}
+	if _, _, err := store.Authenticate("token", now); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("a value without the prefix returned %v", err)
+	}
+	if _, _, err := store.Authenticate(value, expires); !errors.Is(err, ErrExpired) {
+		t.Errorf("an expired token returned %v", err)
+	}
+
+	// uses are kept newest first and persisted
+	for i := 0; i < maxUses+5; i++ {
+		use := Use{Time: now.Add(time.Duration(i) * time.Second), SourceIP: "10.0.0.1", Method: http.MethodGet, Path: "/api/v1/buckets"}
+		if err := store.Record(authenticated.ID, use); err != nil {
+			t.Fatal(err)
+		}
+	}
+	uses, err := store.Uses("alice", "ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uses) != maxUses || !uses[0].Time.Equal(now.Add((maxUses+4)*time.Second)) {
+		t.Errorf("unexpected uses %d %v", len(uses), uses[0].Time)
+	}
+	listed := store.List("alice")
+	if len(listed) != 1 || listed[0].UseCount != maxUses+5 || listed[0].LastUsedFrom != "10.0.0.1" {
+		t.Errorf("unexpected tokens %+v", listed)
+	}
+
+	// the tokens survive a restart, the first use was saved
+	reloaded, err := New(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := reloaded.Authenticate(value, now); err != nil {
+		t.Errorf("the reloaded token was rejected: %v", err)
+	}
+	if tokens := reloaded.List("alice"); len(tokens) != 1 || tokens[0].UseCount == 0 {
+		t.Errorf("unexpected reloaded tokens %+v", tokens)
+	}
+
+	if err := store.Revoke("alice", "ci"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.Authenticate(value, now); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("a revoked token returned %v", err)
+	}
+	if err := store.Revoke("alice", "ci"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("revoking twice returned %v", err)
+	}
+	if _, err := store.Uses("alice", "ci"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("the uses of a revoked token returned %v", err)
+	}
+}
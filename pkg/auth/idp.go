@@ -18,6 +18,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/minio/console/pkg/auth/idp/oauth2"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -31,6 +32,15 @@ type IdentityProviderI interface {
 	VerifyIdentity(ctx context.Context, code, state string) (*credentials.Credentials, error)
 	VerifyIdentityForOperator(ctx context.Context, code, state string) (*xoauth2.Token, error)
 	GenerateLoginURL() string
+	RefreshIdentity(ctx context.Context, refreshToken string) (*RefreshedIdentity, error)
+}
+
+// RefreshedIdentity holds the credentials of a renewed OpenID session, the refresh token to keep
+// for the next renewal and when the credentials expire
+type RefreshedIdentity struct {
+	Credentials  *credentials.Credentials
+	RefreshToken string
+	Expiry       time.Time
 }
 
 // Interface implementation
@@ -53,6 +63,19 @@ func (c IdentityProvider) VerifyIdentityForOperator(ctx context.Context, code, s
 	return c.Client.VerifyIdentityForOperator(ctx, code, state, c.KeyFunc)
 }
 
+// RefreshIdentity will exchange the refresh token of an OpenID session for new credentials
+func (c IdentityProvider) RefreshIdentity(ctx context.Context, refreshToken string) (*RefreshedIdentity, error) {
+	creds, err := c.Client.RefreshIdentity(ctx, refreshToken, c.RoleARN)
+	if err != nil {
+		return nil, err
+	}
+	return &RefreshedIdentity{
+		Credentials:  creds,
+		RefreshToken: c.Client.RefreshToken,
+		Expiry:       c.Client.Expiry,
+	}, nil
+}
+
 // GenerateLoginURL returns a new URL used by the user to login against the idp
 func (c IdentityProvider) GenerateLoginURL() string {
 	return c.Client.GenerateLoginURL(c.KeyFunc, c.Client.IDPName)
@@ -20,6 +20,8 @@ package oauth2
 
 import (
 	"crypto/sha1"
+	"os"
+	"sort"
 	"strings"
 
 	"github.com/minio/console/pkg/auth/token"
@@ -55,6 +57,81 @@ func (pc ProviderConfig) GetARNInf() string {
 
 type OpenIDPCfg map[string]ProviderConfig
 
+// Names returns the names of the providers, the default one first and the others sorted
+func (o OpenIDPCfg) Names() []string {
+	names := make([]string, 0, len(o))
+	for name := range o {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if names[i] == DefaultProviderName || names[j] == DefaultProviderName {
+			return names[i] == DefaultProviderName
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// DefaultProviderName is the name of the provider configured by the variables without a suffix
+const DefaultProviderName = "_"
+
+// GetOpenIDPCfg returns the providers configured through the environment. The variables without a
+// suffix configure the default provider and the ones ending with _<NAME> the provider of that name,
+// for example CONSOLE_IDP_URL_EMPLOYEES. A provider needs both an URL and a client ID.
+func GetOpenIDPCfg() OpenIDPCfg {
+	suffixes := []string{""}
+	for _, kv := range os.Environ() {
+		key := strings.SplitN(kv, "=", 2)[0]
+		if suffix := strings.TrimPrefix(key, ConsoleIDPURL+"_"); suffix != key && suffix != "" {
+			suffixes = append(suffixes, suffix)
+		}
+	}
+	providers := OpenIDPCfg{}
+	for _, suffix := range suffixes {
+		provider := getProviderConfig(suffix)
+		if provider.URL == "" || provider.ClientID == "" {
+			continue
+		}
+		name := DefaultProviderName
+		if suffix != "" {
+			name = strings.ToLower(suffix)
+		}
+		providers[name] = provider
+	}
+	return providers
+}
+
+// getProviderConfig reads the variables of a provider. The scopes, the callback and the state HMAC
+// of the default provider apply to the named ones that don't set their own.
+func getProviderConfig(suffix string) ProviderConfig {
+	own := func(key string) string {
+		if suffix == "" {
+			return env.Get(key, "")
+		}
+		return env.Get(key+"_"+suffix, "")
+	}
+	shared := func(key, def string) string {
+		if suffix == "" {
+			return env.Get(key, def)
+		}
+		return env.Get(key+"_"+suffix, env.Get(key, def))
+	}
+	return ProviderConfig{
+		URL:                     own(ConsoleIDPURL),
+		DisplayName:             own(ConsoleIDPDisplayName),
+		ClientID:                own(ConsoleIDPClientID),
+		ClientSecret:            own(ConsoleIDPSecret),
+		HMACSalt:                shared(ConsoleIDPHmacSalt, getSaltForIDPHmac()),
+		HMACPassphrase:          shared(ConsoleIDPHmacPassphrase, getPassphraseForIDPHmac()),
+		Scopes:                  shared(ConsoleIDPScopes, getIDPScopes()),
+		Userinfo:                own(ConsoleIDPUserInfo) == "on",
+		RedirectCallbackDynamic: shared(ConsoleIDPCallbackURLDynamic, "") == "on",
+		RedirectCallback:        shared(ConsoleIDPCallbackURL, ""),
+		EndSessionEndpoint:      own(ConsoleIDPEndSessionEndpoint),
+		RoleArn:                 own(ConsoleIDPRoleARN),
+	}
+}
+
 func GetSTSEndpoint() string {
 	return strings.TrimSpace(env.Get(ConsoleMinIOServer, "http://localhost:9000"))
 }
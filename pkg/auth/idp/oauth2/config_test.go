@@ -0,0 +1,61 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package oauth2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOpenIDPCfg(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleIDPURL, "https://sso.example.com/.well-known/openid-configuration")
+	t.Setenv(ConsoleIDPClientID, "console")
+	t.Setenv(ConsoleIDPCallbackURL, "https://console.example.com/oauth_callback")
+	t.Setenv(ConsoleIDPScopes, "openid,groups")
+	t.Setenv(ConsoleIDPURL+"_CONTRACTORS", "https://contractors.example.com/.well-known/openid-configuration")
+	t.Setenv(ConsoleIDPClientID+"_CONTRACTORS", "console-contractors")
+	t.Setenv(ConsoleIDPDisplayName+"_CONTRACTORS", "Contractors")
+	t.Setenv(ConsoleIDPCallbackURL+"_CONTRACTORS", "https://contractors.console.example.com/oauth_callback")
+	t.Setenv(ConsoleIDPRoleARN+"_CONTRACTORS", "arn:minio:iam:::role/contractors")
+	// a provider without a client ID isn't configured
+	t.Setenv(ConsoleIDPURL+"_PARTNERS", "https://partners.example.com/.well-known/openid-configuration")
+
+	providers := GetOpenIDPCfg()
+	assert.Equal([]string{DefaultProviderName, "contractors"}, providers.Names())
+
+	employees := providers[DefaultProviderName]
+	assert.Equal("console", employees.ClientID)
+	assert.Equal("https://console.example.com/oauth_callback", employees.RedirectCallback)
+	assert.Empty(employees.DisplayName)
+
+	contractors := providers["contractors"]
+	assert.Equal("console-contractors", contractors.ClientID)
+	assert.Equal("Contractors", contractors.DisplayName)
+	assert.Equal("https://contractors.console.example.com/oauth_callback", contractors.RedirectCallback)
+	assert.Equal("arn:minio:iam:::role/contractors", contractors.RoleArn)
+	// the scopes and the state HMAC of the default provider are shared
+	assert.Equal("openid,groups", contractors.Scopes)
+	assert.Equal(employees.HMACPassphrase, contractors.HMACPassphrase)
+	assert.Equal(employees.HMACSalt, contractors.HMACSalt)
+}
+
+func TestOpenIDPCfgNames(t *testing.T) {
+	providers := OpenIDPCfg{"zeta": {}, "alpha": {}, DefaultProviderName: {}, "1st": {}}
+	assert.Equal(t, []string{DefaultProviderName, "1st", "alpha", "zeta"}, providers.Names())
+}
@@ -29,4 +29,7 @@ const (
 	ConsoleIDPScopes             = "CONSOLE_IDP_SCOPES"
 	ConsoleIDPUserInfo           = "CONSOLE_IDP_USERINFO"
 	ConsoleIDPTokenExpiration    = "CONSOLE_IDP_TOKEN_EXPIRATION"
+	ConsoleIDPDisplayName        = "CONSOLE_IDP_DISPLAY_NAME"
+	ConsoleIDPRoleARN            = "CONSOLE_IDP_ROLE_ARN"
+	ConsoleIDPEndSessionEndpoint = "CONSOLE_IDP_END_SESSION_ENDPOINT"
 )
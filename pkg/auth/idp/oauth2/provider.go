@@ -114,6 +114,8 @@ type Provider struct {
 	oauth2Config   Configuration
 	provHTTPClient *http.Client
 	stsHTTPClient  *http.Client
+	// Expiry is when the STS credentials of the last verified or refreshed identity expire
+	Expiry time.Time
 }
 
 // DefaultDerivedKey is the key used to compute the HMAC for signing the oauth state parameter
@@ -323,39 +325,14 @@ func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN
 	getWebTokenExpiry := func() (*credentials.WebIdentityToken, error) {
 		customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.provHTTPClient)
 		oauth2Token, err := client.oauth2Config.Exchange(customCtx, code)
-		client.RefreshToken = oauth2Token.RefreshToken
 		if err != nil {
 			return nil, err
 		}
+		client.RefreshToken = oauth2Token.RefreshToken
 		if !oauth2Token.Valid() {
 			return nil, errors.New("invalid token")
 		}
-
-		// expiration configured in the token itself
-		expiration := int(oauth2Token.Expiry.Sub(time.Now().UTC()).Seconds())
-
-		// check if user configured a hardcoded expiration for console via env variables
-		// and override the incoming expiration
-		userConfiguredExpiration := getIDPTokenExpiration()
-		if userConfiguredExpiration != "" {
-			expiration, _ = strconv.Atoi(userConfiguredExpiration)
-		}
-		idToken := oauth2Token.Extra("id_token")
-		if idToken == nil {
-			return nil, errors.New("missing id_token")
-		}
-		token := &credentials.WebIdentityToken{
-			Token:  idToken.(string),
-			Expiry: expiration,
-		}
-		if client.UserInfo { // look for access_token only if userinfo is requested.
-			accessToken := oauth2Token.Extra("access_token")
-			if accessToken == nil {
-				return nil, errors.New("missing access_token")
-			}
-			token.AccessToken = accessToken.(string)
-		}
-		return token, nil
+		return client.webIdentityToken(oauth2Token)
 	}
 	stsEndpoint := GetSTSEndpoint()
 
@@ -368,6 +345,65 @@ func (client *Provider) VerifyIdentity(ctx context.Context, code, state, roleARN
 	return sts, nil
 }
 
+// webIdentityToken returns the web identity token to exchange for STS credentials and records in
+// Expiry when they will expire
+func (client *Provider) webIdentityToken(oauth2Token *xoauth2.Token) (*credentials.WebIdentityToken, error) {
+	// expiration configured in the token itself
+	expiration := int(oauth2Token.Expiry.Sub(time.Now().UTC()).Seconds())
+
+	// check if user configured a hardcoded expiration for console via env variables
+	// and override the incoming expiration
+	userConfiguredExpiration := getIDPTokenExpiration()
+	if userConfiguredExpiration != "" {
+		expiration, _ = strconv.Atoi(userConfiguredExpiration)
+	}
+	idToken := oauth2Token.Extra("id_token")
+	if idToken == nil {
+		return nil, errors.New("missing id_token")
+	}
+	token := &credentials.WebIdentityToken{
+		Token:  idToken.(string),
+		Expiry: expiration,
+	}
+	if client.UserInfo { // look for access_token only if userinfo is requested.
+		accessToken := oauth2Token.Extra("access_token")
+		if accessToken == nil {
+			return nil, errors.New("missing access_token")
+		}
+		token.AccessToken = accessToken.(string)
+	}
+	client.Expiry = time.Now().Add(time.Duration(expiration) * time.Second)
+	return token, nil
+}
+
+// RefreshIdentity exchanges a refresh token for a new ID token and returns the STS credentials it
+// grants, RefreshToken and Expiry are updated as providers may rotate the refresh token
+func (client *Provider) RefreshIdentity(ctx context.Context, refreshToken, roleARN string) (*credentials.Credentials, error) {
+	customCtx := context.WithValue(ctx, oauth2.HTTPClient, client.provHTTPClient)
+	// a token without an access token is expired, the token source refreshes it right away
+	oauth2Token, err := client.oauth2Config.TokenSource(customCtx, &xoauth2.Token{RefreshToken: refreshToken}).Token()
+	if err != nil {
+		return nil, err
+	}
+	if !oauth2Token.Valid() {
+		return nil, errors.New("invalid token")
+	}
+	client.RefreshToken = oauth2Token.RefreshToken
+	token, err := client.webIdentityToken(oauth2Token)
+	if err != nil {
+		return nil, err
+	}
+	sts := credentials.New(&credentials.STSWebIdentity{
+		Client:      client.stsHTTPClient,
+		STSEndpoint: GetSTSEndpoint(),
+		GetWebIDTokenExpiry: func() (*credentials.WebIdentityToken, error) {
+			return token, nil
+		},
+		RoleARN: roleARN,
+	})
+	return sts, nil
+}
+
 // VerifyIdentityForOperator will contact the configured IDP and validate the user identity based on the authorization code and state
 func (client *Provider) VerifyIdentityForOperator(ctx context.Context, code, state string, keyFunc StateKeyFunc) (*xoauth2.Token, error) {
 	// verify the provided state is valid (prevents CSRF attacks)
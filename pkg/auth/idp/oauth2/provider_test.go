@@ -20,6 +20,7 @@ import (
 	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/oauth2"
@@ -69,3 +70,27 @@ func TestGenerateLoginURL(t *testing.T) {
 	url := oauth2Provider.GenerateLoginURL(DefaultDerivedKey, "testIDP")
 	funcAssert.NotEqual("", url)
 }
+
+func TestRefreshIdentity(t *testing.T) {
+	funcAssert := assert.New(t)
+	oauth2Provider := Provider{
+		oauth2Config: Oauth2configMock{},
+	}
+	// Test-1 : RefreshIdentity() keeps the rotated refresh token and when the credentials expire
+	oauth2ConfigokenSourceMock = func(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+		funcAssert.Equal("refresh-1", token.RefreshToken)
+		refreshed := &oauth2.Token{AccessToken: "access", RefreshToken: "refresh-2", Expiry: time.Now().Add(time.Hour)}
+		return oauth2.StaticTokenSource(refreshed.WithExtra(map[string]interface{}{"id_token": "id"}))
+	}
+	creds, err := oauth2Provider.RefreshIdentity(context.Background(), "refresh-1", "")
+	funcAssert.Nil(err)
+	funcAssert.NotNil(creds)
+	funcAssert.Equal("refresh-2", oauth2Provider.RefreshToken)
+	funcAssert.WithinDuration(time.Now().Add(time.Hour), oauth2Provider.Expiry, time.Minute)
+	// Test-2 : RefreshIdentity() fails when the provider doesn't return an ID token
+	oauth2ConfigokenSourceMock = func(ctx context.Context, token *oauth2.Token) oauth2.TokenSource {
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)})
+	}
+	_, err = oauth2Provider.RefreshIdentity(context.Background(), "refresh-2", "")
+	funcAssert.NotNil(err)
+}
@@ -0,0 +1,363 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/auth/token"
+	"github.com/minio/console/pkg/utils"
+	"github.com/secure-io/sio-go/sioutil"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// sessionKeysReloadInterval is how often the session keys file is checked for the rotations of other replicas
+const sessionKeysReloadInterval = 10 * time.Second
+
+// maxSessionKeyID is the longest key ID a ciphertext can carry
+const maxSessionKeyID = 255
+
+// ErrUnknownSessionKey is returned for a ciphertext encrypted with a key that is unknown or retired for too long
+var ErrUnknownSessionKey = errors.New("session key is unknown or has been retired")
+
+// SessionKey is a key the session tokens and the secrets of Console are encrypted with, derived with PBKDF2 from
+// its passphrase and salt. The key with an empty ID encrypts the ciphertexts without a key ID, like the ones made
+// before the keys could be rotated.
+type SessionKey struct {
+	ID         string `json:"id"`
+	Passphrase string `json:"passphrase"`
+	Salt       string `json:"salt"`
+	// RetiredAt is when the key stopped encrypting, the session tokens it encrypted are accepted until the end of the
+	// overlap after it. It is zero for the current key.
+	RetiredAt time.Time `json:"retiredAt,omitempty"`
+}
+
+func (k SessionKey) derive() []byte {
+	return pbkdf2.Key([]byte(k.Passphrase), []byte(k.Salt), 4096, 32, sha1.New)
+}
+
+// sessionKeysFile is the content of the session keys file
+type sessionKeysFile struct {
+	Keys []SessionKey `json:"keys"`
+}
+
+// SessionKeysSealer encrypts the session keys file at rest
+type SessionKeysSealer interface {
+	Seal(data []byte) ([]byte, error)
+	// Unseal returns data as it is when it isn't sealed, the files written before the sealer was set stay readable
+	Unseal(data []byte) ([]byte, error)
+	IsSealed(data []byte) bool
+}
+
+// keyring holds the session keys, the current one first. Without keys the sessions are encrypted with the key
+// of CONSOLE_PBKDF_PASSPHRASE and CONSOLE_PBKDF_SALT.
+type keyring struct {
+	mu      sync.Mutex
+	keys    []SessionKey
+	derived map[string][]byte
+	overlap time.Duration
+	path    string
+	modTime time.Time
+	checked time.Time
+	sealer  SessionKeysSealer
+	now     func() time.Time
+}
+
+var sessionKeys = &keyring{now: time.Now}
+
+// SetSessionKeys encrypts with the first of keys and accepts the session tokens of the retired ones for overlap
+// after their retirement
+func SetSessionKeys(keys []SessionKey, overlap time.Duration) error {
+	if err := validateSessionKeys(keys); err != nil {
+		return err
+	}
+	sessionKeys.mu.Lock()
+	defer sessionKeys.mu.Unlock()
+	sessionKeys.set(keys)
+	sessionKeys.overlap = overlap
+	sessionKeys.path = ""
+	return nil
+}
+
+// SetSessionKeysSealer encrypts the session keys file with sealer, it must be set before LoadSessionKeys
+func SetSessionKeysSealer(sealer SessionKeysSealer) {
+	sessionKeys.mu.Lock()
+	defer sessionKeys.mu.Unlock()
+	sessionKeys.sealer = sealer
+}
+
+// LoadSessionKeys reads the session keys from the file at path, which is created with initial when missing. The
+// keys of initial the file doesn't have yet are added to it, retired when they aren't the current one, and the keys
+// it has keep the time they were first retired. The rotations are saved to the file and the file is read again when
+// another replica sharing it rotates the keys. With a sealer set, a file that isn't sealed yet is written again
+// sealed.
+func LoadSessionKeys(path string, initial []SessionKey, overlap time.Duration) error {
+	sessionKeys.mu.Lock()
+	defer sessionKeys.mu.Unlock()
+	sealer := sessionKeys.sealer
+	keys, modTime, sealed, err := readSessionKeys(path, sealer)
+	if errors.Is(err, os.ErrNotExist) {
+		keys, sealed, err = initial, false, nil
+	} else if err == nil {
+		if merged, changed := mergeSessionKeys(keys, initial, sessionKeys.now().UTC()); changed {
+			keys, sealed = merged, false
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if err := validateSessionKeys(keys); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if !sealed {
+		if err := writeSessionKeys(path, keys, sealer); err != nil {
+			return err
+		}
+		if keys, modTime, _, err = readSessionKeys(path, sealer); err != nil {
+			return err
+		}
+	}
+	sessionKeys.set(keys)
+	sessionKeys.overlap = overlap
+	sessionKeys.path = path
+	sessionKeys.modTime = modTime
+	sessionKeys.checked = sessionKeys.now()
+	return nil
+}
+
+// RotateSessionKey encrypts with a new random key from now on and retires the current one, the session tokens it
+// encrypted are accepted until the end of the overlap. It returns the new key and the retired one.
+func RotateSessionKey() (current, retired SessionKey, err error) {
+	sessionKeys.mu.Lock()
+	defer sessionKeys.mu.Unlock()
+	sessionKeys.reload()
+	random, err := sioutil.Random(64)
+	if err != nil {
+		return current, retired, err
+	}
+	now := sessionKeys.now().UTC()
+	current = SessionKey{
+		ID:         now.Format("20060102T150405Z") + "-" + hex.EncodeToString(random[:4]),
+		Passphrase: hex.EncodeToString(random[4:34]),
+		Salt:       hex.EncodeToString(random[34:]),
+	}
+	keys := sessionKeys.keys
+	if len(keys) == 0 {
+		// the key of the environment is the one retired
+		keys = []SessionKey{{Passphrase: token.GetPBKDFPassphrase(), Salt: token.GetPBKDFSalt()}}
+	}
+	retired = keys[0]
+	retired.RetiredAt = now
+	rotated := append([]SessionKey{current, retired}, keys[1:]...)
+	if sessionKeys.path != "" {
+		if err := writeSessionKeys(sessionKeys.path, rotated, sessionKeys.sealer); err != nil {
+			return current, retired, err
+		}
+		if info, err := os.Stat(sessionKeys.path); err == nil {
+			sessionKeys.modTime = info.ModTime()
+		}
+	}
+	sessionKeys.set(rotated)
+	return current, retired, nil
+}
+
+// mergeSessionKeys adds the keys of initial missing from stored. A new current key retires the stored one at now,
+// the other new keys are retired at now unless they already are.
+func mergeSessionKeys(stored, initial []SessionKey, now time.Time) ([]SessionKey, bool) {
+	if len(stored) == 0 || len(initial) == 0 {
+		return stored, false
+	}
+	known := map[string]bool{}
+	for _, key := range stored {
+		known[key.ID] = true
+	}
+	merged := append([]SessionKey{}, stored...)
+	changed := false
+	if !known[initial[0].ID] {
+		retired := merged[0]
+		retired.RetiredAt = now
+		merged = append([]SessionKey{initial[0], retired}, merged[1:]...)
+		known[initial[0].ID] = true
+		changed = true
+	}
+	for _, key := range initial[1:] {
+		if known[key.ID] {
+			continue
+		}
+		if key.RetiredAt.IsZero() {
+			key.RetiredAt = now
+		}
+		merged = append(merged, key)
+		known[key.ID] = true
+		changed = true
+	}
+	return merged, changed
+}
+
+// SessionKeysOverlap returns how long the session tokens of a retired key are accepted
+func SessionKeysOverlap() time.Duration {
+	sessionKeys.mu.Lock()
+	defer sessionKeys.mu.Unlock()
+	return sessionKeys.overlap
+}
+
+func validateSessionKeys(keys []SessionKey) error {
+	if len(keys) == 0 {
+		return errors.New("no session key")
+	}
+	if !keys[0].RetiredAt.IsZero() {
+		return errors.New("the current session key is retired")
+	}
+	ids := map[string]bool{}
+	for _, key := range keys {
+		if len(key.ID) > maxSessionKeyID {
+			return fmt.Errorf("session key %q has an ID longer than %d bytes", key.ID, maxSessionKeyID)
+		}
+		if key.Passphrase == "" || key.Salt == "" {
+			return fmt.Errorf("session key %q has no passphrase or salt", key.ID)
+		}
+		if ids[key.ID] {
+			return fmt.Errorf("duplicate session key %q", key.ID)
+		}
+		ids[key.ID] = true
+	}
+	return nil
+}
+
+// readSessionKeys returns the keys of the file at path, when it was modified and whether it was sealed with sealer.
+// Without a sealer the file is always reported as sealed, so it is never written again.
+func readSessionKeys(path string, sealer SessionKeysSealer) ([]SessionKey, time.Time, bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, time.Time{}, false, err
+	}
+	sealed := true
+	if sealer != nil {
+		sealed = sealer.IsSealed(data)
+		if data, err = sealer.Unseal(data); err != nil {
+			return nil, time.Time{}, false, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	var file sessionKeysFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, time.Time{}, false, fmt.Errorf("%s: %w", path, err)
+	}
+	return file.Keys, info.ModTime(), sealed, nil
+}
+
+// writeSessionKeys replaces the file at path atomically, the other replicas never read a partial file
+func writeSessionKeys(path string, keys []SessionKey, sealer SessionKeysSealer) error {
+	data, err := json.MarshalIndent(sessionKeysFile{Keys: keys}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if sealer != nil {
+		if data, err = sealer.Seal(data); err != nil {
+			return err
+		}
+	}
+	return utils.WriteFileAtomic(path, data)
+}
+
+// set replaces the keys, the derived keys still in use are kept
+func (k *keyring) set(keys []SessionKey) {
+	derived := map[string][]byte{}
+	for _, key := range keys {
+		if existing, ok := k.derived[key.ID]; ok && k.has(key) {
+			derived[key.ID] = existing
+		} else {
+			derived[key.ID] = key.derive()
+		}
+	}
+	k.keys = keys
+	k.derived = derived
+}
+
+// has returns whether the keyring already holds key with the same secret
+func (k *keyring) has(key SessionKey) bool {
+	for _, existing := range k.keys {
+		if existing.ID == key.ID {
+			return existing.Passphrase == key.Passphrase && existing.Salt == key.Salt
+		}
+	}
+	return false
+}
+
+// reload reads the keys file again when it changed, at most every sessionKeysReloadInterval
+func (k *keyring) reload() {
+	now := k.now()
+	if k.path == "" || now.Sub(k.checked) < sessionKeysReloadInterval {
+		return
+	}
+	k.checked = now
+	info, err := os.Stat(k.path)
+	if err != nil || info.ModTime().Equal(k.modTime) {
+		return
+	}
+	keys, modTime, _, err := readSessionKeys(k.path, k.sealer)
+	if err != nil || validateSessionKeys(keys) != nil {
+		// keep the keys in use until the file is fixed
+		return
+	}
+	k.set(keys)
+	k.modTime = modTime
+}
+
+// current returns the ID and the derived key to encrypt with
+func (k *keyring) current() (string, []byte) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.reload()
+	if len(k.keys) == 0 {
+		return "", derivedKey()
+	}
+	return k.keys[0].ID, k.derived[k.keys[0].ID]
+}
+
+// lookup returns the derived key of id, a retired key is only returned within the overlap unless anyRetired
+func (k *keyring) lookup(id string, anyRetired bool) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.reload()
+	if len(k.keys) == 0 {
+		if id != "" {
+			return nil, ErrUnknownSessionKey
+		}
+		return derivedKey(), nil
+	}
+	for _, key := range k.keys {
+		if key.ID != id {
+			continue
+		}
+		if !anyRetired && !key.RetiredAt.IsZero() && k.now().After(key.RetiredAt.Add(k.overlap)) {
+			return nil, ErrUnknownSessionKey
+		}
+		return k.derived[key.ID], nil
+	}
+	return nil, ErrUnknownSessionKey
+}
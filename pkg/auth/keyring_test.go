@@ -0,0 +1,203 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// useSessionKeys replaces the keyring for a test, its clock starts at now
+// reverseSealer seals by reversing the data after a prefix, standing for a KMS
+type reverseSealer struct{}
+
+var reverseSealerPrefix = []byte("sealed:")
+
+func (reverseSealer) Seal(data []byte) ([]byte, error) {
+	return append(append([]byte{}, reverseSealerPrefix...), reverse(data)...), nil
+}
+
+func (s reverseSealer) Unseal(data []byte) ([]byte, error) {
+	if !s.IsSealed(data) {
+		return data, nil
+	}
+	if len(data) == len(reverseSealerPrefix) {
+		return nil, errors.New("empty sealed data")
+	}
+	return reverse(data[len(reverseSealerPrefix):]), nil
+}
+
+func (reverseSealer) IsSealed(data []byte) bool {
+	return bytes.HasPrefix(data, reverseSealerPrefix)
+}
+
+func reverse(data []byte) []byte {
+	reversed := make([]byte, len(data))
+	for i, b := range data {
+		reversed[len(data)-1-i] = b
+	}
+	return reversed
+}
+
+func useSessionKeys(t *testing.T, now *time.Time) {
+	previous := sessionKeys
+	sessionKeys = &keyring{now: func() time.Time { return *now }}
+	t.Cleanup(func() { sessionKeys = previous })
+}
+
+func TestRotateSessionKey(t *testing.T) {
+	funcAssert := assert.New(t)
+	now := time.Unix(1700000000, 0)
+	useSessionKeys(t, &now)
+
+	// Test-1 : a key without ID encrypts like before the keys could be rotated
+	funcAssert.Nil(SetSessionKeys([]SessionKey{{Passphrase: "passphrase", Salt: "salt"}}, time.Hour))
+	legacyToken, err := NewEncryptedTokenForClient(creds, "", nil)
+	funcAssert.Nil(err)
+	decoded, err := base64.StdEncoding.DecodeString(legacyToken)
+	funcAssert.Nil(err)
+	funcAssert.Equal(byte(0), decoded[0]&keyIDFlag)
+	legacySecret, err := EncryptSecret([]byte("secret"), "alice")
+	funcAssert.Nil(err)
+
+	// Test-2 : the new key encrypts the tokens with its ID
+	current, retired, err := RotateSessionKey()
+	funcAssert.Nil(err)
+	funcAssert.NotEqual("", current.ID)
+	funcAssert.Equal("", retired.ID)
+	funcAssert.Equal(now.UTC(), retired.RetiredAt)
+	token, err := NewEncryptedTokenForClient(creds, "", nil)
+	funcAssert.Nil(err)
+	decoded, err = base64.StdEncoding.DecodeString(token)
+	funcAssert.Nil(err)
+	funcAssert.Equal(byte(keyIDFlag), decoded[0]&keyIDFlag)
+	funcAssert.Equal(current.ID, string(decoded[2:2+decoded[1]]))
+	_, err = SessionTokenAuthenticate(token)
+	funcAssert.Nil(err)
+
+	// Test-3 : the retired key still decrypts the tokens it encrypted within the overlap
+	now = now.Add(59 * time.Minute)
+	_, err = SessionTokenAuthenticate(legacyToken)
+	funcAssert.Nil(err)
+
+	// Test-4 : after the overlap only the secrets still decrypt with the retired key
+	now = now.Add(2 * time.Minute)
+	_, err = SessionTokenAuthenticate(legacyToken)
+	funcAssert.Equal(ErrReadingToken, err)
+	secret, err := DecryptSecret(legacySecret, "alice")
+	funcAssert.Nil(err)
+	funcAssert.Equal("secret", string(secret))
+}
+
+func TestLoadSessionKeys(t *testing.T) {
+	funcAssert := assert.New(t)
+	now := time.Unix(1700000000, 0)
+	useSessionKeys(t, &now)
+	path := filepath.Join(t.TempDir(), "session-keys.json")
+
+	// Test-1 : a missing file is created with the initial keys
+	funcAssert.Nil(LoadSessionKeys(path, []SessionKey{{ID: "k1", Passphrase: "passphrase", Salt: "salt"}}, time.Hour))
+	info, err := os.Stat(path)
+	funcAssert.Nil(err)
+	funcAssert.Equal(os.FileMode(0o600), info.Mode().Perm())
+	keyID, _ := sessionKeys.current()
+	funcAssert.Equal("k1", keyID)
+
+	// Test-2 : a rotation is saved to the file
+	current, _, err := RotateSessionKey()
+	funcAssert.Nil(err)
+	keys, _, _, err := readSessionKeys(path, nil)
+	funcAssert.Nil(err)
+	funcAssert.Len(keys, 2)
+	funcAssert.Equal(current.ID, keys[0].ID)
+	funcAssert.Equal("k1", keys[1].ID)
+
+	// Test-3 : the rotation of another replica is read once the file is checked again
+	funcAssert.Nil(writeSessionKeys(path, []SessionKey{{ID: "k3", Passphrase: "other", Salt: "salt"}, keys[0], keys[1]}, nil))
+	funcAssert.Nil(os.Chtimes(path, now.Add(time.Minute), now.Add(time.Minute)))
+	keyID, _ = sessionKeys.current()
+	funcAssert.Equal(current.ID, keyID)
+	now = now.Add(sessionKeysReloadInterval)
+	keyID, _ = sessionKeys.current()
+	funcAssert.Equal("k3", keyID)
+
+	// Test-4 : invalid keys are rejected
+	funcAssert.NotNil(SetSessionKeys(nil, time.Hour))
+	funcAssert.NotNil(SetSessionKeys([]SessionKey{{ID: "k1", Passphrase: "a", Salt: "b", RetiredAt: now}}, time.Hour))
+	funcAssert.NotNil(SetSessionKeys([]SessionKey{{ID: "k1", Passphrase: "a", Salt: "b"}, {ID: "k1", Passphrase: "c", Salt: "d"}}, time.Hour))
+	funcAssert.NotNil(SetSessionKeys([]SessionKey{{ID: "k1"}}, time.Hour))
+
+	// Test-5 : the keys rotated through the environment are added to the file once, retired at the first startup
+	// seeing them
+	path = filepath.Join(t.TempDir(), "session-keys.json")
+	funcAssert.Nil(LoadSessionKeys(path, []SessionKey{{ID: "k1", Passphrase: "passphrase", Salt: "salt"}}, time.Hour))
+	rotatedAt := now.Add(time.Hour)
+	now = rotatedAt
+	environment := []SessionKey{{ID: "k2", Passphrase: "new-passphrase", Salt: "salt"}, {ID: "k1", Passphrase: "passphrase", Salt: "salt", RetiredAt: now}}
+	funcAssert.Nil(LoadSessionKeys(path, environment, time.Hour))
+	keys, _, _, err = readSessionKeys(path, nil)
+	funcAssert.Nil(err)
+	funcAssert.Equal([]string{"k2", "k1"}, []string{keys[0].ID, keys[1].ID})
+	funcAssert.True(keys[1].RetiredAt.Equal(rotatedAt.UTC()))
+	// a restart keeps the time the key was first retired, its tokens aren't accepted past the overlap
+	now = rotatedAt.Add(2 * time.Hour)
+	environment[1].RetiredAt = now
+	funcAssert.Nil(LoadSessionKeys(path, environment, time.Hour))
+	keys, _, _, err = readSessionKeys(path, nil)
+	funcAssert.Nil(err)
+	funcAssert.Len(keys, 2)
+	funcAssert.True(keys[1].RetiredAt.Equal(rotatedAt.UTC()))
+	_, err = sessionKeys.lookup("k1", false)
+	funcAssert.Equal(ErrUnknownSessionKey, err)
+}
+
+func TestLoadSealedSessionKeys(t *testing.T) {
+	funcAssert := assert.New(t)
+	now := time.Unix(1700000000, 0)
+	useSessionKeys(t, &now)
+	path := filepath.Join(t.TempDir(), "session-keys.json")
+
+	// Test-1 : a file written before the sealer was set is sealed when loaded
+	funcAssert.Nil(writeSessionKeys(path, []SessionKey{{ID: "k1", Passphrase: "passphrase", Salt: "salt"}}, nil))
+	SetSessionKeysSealer(reverseSealer{})
+	funcAssert.Nil(LoadSessionKeys(path, nil, time.Hour))
+	data, err := os.ReadFile(path)
+	funcAssert.Nil(err)
+	funcAssert.True(reverseSealer{}.IsSealed(data))
+	funcAssert.NotContains(string(data), "passphrase")
+	keyID, _ := sessionKeys.current()
+	funcAssert.Equal("k1", keyID)
+
+	// Test-2 : the rotations are sealed too
+	current, _, err := RotateSessionKey()
+	funcAssert.Nil(err)
+	keys, _, sealed, err := readSessionKeys(path, reverseSealer{})
+	funcAssert.Nil(err)
+	funcAssert.True(sealed)
+	funcAssert.Equal(current.ID, keys[0].ID)
+
+	// Test-3 : a sealed file can't be read without the sealer
+	_, _, _, err = readSessionKeys(path, nil)
+	funcAssert.NotNil(err)
+}
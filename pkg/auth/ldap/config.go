@@ -25,3 +25,10 @@ import (
 func GetLDAPEnabled() bool {
 	return strings.ToLower(env.Get(ConsoleLDAPEnabled, "off")) == "on"
 }
+
+// GetLDAPAutoDetect returns whether the login form falls back to the LDAP
+// directory of MinIO when the credentials aren't the ones of a MinIO user,
+// this is the default unless CONSOLE_LDAP_ENABLED is set
+func GetLDAPAutoDetect() bool {
+	return strings.ToLower(env.Get(ConsoleLDAPEnabled, LDAPAutoDetect)) == LDAPAutoDetect
+}
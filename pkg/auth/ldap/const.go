@@ -19,4 +19,6 @@ package ldap
 const (
 	// const for ldap configuration
 	ConsoleLDAPEnabled = "CONSOLE_LDAP_ENABLED"
+	// LDAPAutoDetect tries the LDAP directory after the MinIO users
+	LDAPAutoDetect = "auto"
 )
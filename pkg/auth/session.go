@@ -0,0 +1,179 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/console/pkg/sessionstore"
+	"github.com/secure-io/sio-go/sioutil"
+)
+
+// maxActivityResolution is the longest a request can go without recording the activity of its session, renewing the
+// token on every request would replace the cookie each time
+const maxActivityResolution = time.Minute
+
+// SessionLimits bound how long a session token is accepted, a zero limit doesn't apply
+type SessionLimits struct {
+	// IdleTimeout is how long a session is accepted after its last request
+	IdleTimeout time.Duration
+	// MaxLifetime is how long a session is accepted after the login, whatever its activity
+	MaxLifetime time.Duration
+}
+
+// Check returns ErrSessionEnded or ErrSessionIdle when the limits don't accept the session at now. The tokens issued
+// before the claims recorded the login and the activity aren't accepted by the limits that need them.
+func (l SessionLimits) Check(claims *TokenClaims, now time.Time) error {
+	if l.MaxLifetime > 0 && (claims.IssuedAt == 0 || now.Sub(time.Unix(claims.IssuedAt, 0)) > l.MaxLifetime) {
+		return ErrSessionEnded
+	}
+	if l.IdleTimeout > 0 && (claims.LastActivity == 0 || now.Sub(time.Unix(claims.LastActivity, 0)) > l.IdleTimeout) {
+		return ErrSessionIdle
+	}
+	return nil
+}
+
+// activityResolution returns how long the recorded activity of a session can lag behind its requests
+func (l SessionLimits) activityResolution() time.Duration {
+	resolution := l.IdleTimeout / 10
+	if resolution > maxActivityResolution {
+		resolution = maxActivityResolution
+	}
+	return resolution
+}
+
+// RenewActivity returns a new token for the claims of token with their activity at now, the idle timeout then
+// counts from there. It returns an empty token when there is no idle timeout or the recorded activity is recent
+// enough, and when the session is kept in the session store, which is updated instead.
+func (l SessionLimits) RenewActivity(token string, claims *TokenClaims, now time.Time) (string, error) {
+	if l.IdleTimeout <= 0 || now.Sub(time.Unix(claims.LastActivity, 0)) < l.activityResolution() {
+		return "", nil
+	}
+	renewed := *claims
+	renewed.LastActivity = now.Unix()
+	if id, ok := storedSessionID(token); ok {
+		return "", storeClaims(id, &renewed)
+	}
+	return encryptClaims(&renewed)
+}
+
+// storedSessionPrefix starts the tokens referencing a session of the session store, the tokens carrying their
+// claims are base64 encoded and never contain it
+const storedSessionPrefix = "sid:"
+
+// sessionStoreTimeout bounds how long a request waits for the session store
+const sessionStoreTimeout = 5 * time.Second
+
+// ErrNoSessionStore is returned for a token referencing a stored session when there is no session store
+var ErrNoSessionStore = errors.New("no session store is configured")
+
+var (
+	sessionStore    sessionstore.Store
+	sessionStoreTTL time.Duration
+)
+
+// SetSessionStore keeps the claims of the new sessions in store for ttl, encrypted with the session key, and the
+// tokens only reference them. The Console replicas sharing the store and the session key all serve the sessions, and
+// a session ends everywhere on logout. A nil store issues tokens carrying their claims again.
+func SetSessionStore(store sessionstore.Store, ttl time.Duration) {
+	sessionStore = store
+	sessionStoreTTL = ttl
+}
+
+// storedSessionID returns the ID of the stored session token references
+func storedSessionID(token string) (string, bool) {
+	if !strings.HasPrefix(token, storedSessionPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(token, storedSessionPrefix), true
+}
+
+// newStoredSession stores the claims of a new session and returns the token referencing it
+func newStoredSession(claims *TokenClaims) (string, error) {
+	random, err := sioutil.Random(32)
+	if err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(random)
+	if err := storeClaims(id, claims); err != nil {
+		return "", err
+	}
+	return storedSessionPrefix + id, nil
+}
+
+// storeClaims stores the encrypted claims of the session id, the ID is authenticated with them so the claims of a
+// session can't be moved to another one
+func storeClaims(id string, claims *TokenClaims) error {
+	if sessionStore == nil {
+		return ErrNoSessionStore
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := encrypt(payload, []byte(id))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sessionStoreTimeout)
+	defer cancel()
+	return sessionStore.Put(ctx, id, ciphertext, sessionStoreTTL)
+}
+
+// loadClaims returns the decrypted claims of the session id
+func loadClaims(id string) ([]byte, error) {
+	if sessionStore == nil {
+		return nil, ErrNoSessionStore
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sessionStoreTimeout)
+	defer cancel()
+	ciphertext, err := sessionStore.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(ciphertext, []byte(id), false)
+}
+
+// CheckSessionStore makes sure the session store answers, a session that isn't found is an answer. It does nothing
+// without a session store.
+func CheckSessionStore(ctx context.Context) error {
+	if sessionStore == nil {
+		return nil
+	}
+	if _, err := sessionStore.Get(ctx, "healthcheck"); err != nil && !errors.Is(err, sessionstore.ErrNotFound) {
+		return fmt.Errorf("%s: %w", sessionStore, err)
+	}
+	return nil
+}
+
+// EndSession removes the session token references from the session store, the tokens carrying their claims stay
+// valid until they expire
+func EndSession(token string) error {
+	id, ok := storedSessionID(token)
+	if !ok || sessionStore == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), sessionStoreTimeout)
+	defer cancel()
+	return sessionStore.Delete(ctx, id)
+}
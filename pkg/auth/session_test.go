@@ -0,0 +1,110 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/sessionstore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionLimits(t *testing.T) {
+	funcAssert := assert.New(t)
+	login := time.Unix(1700000000, 0)
+	claims := &TokenClaims{STSAccessKeyID: "fakeAccessKeyID", IssuedAt: login.Unix(), LastActivity: login.Unix()}
+
+	// Test-1 : without limits a session is always accepted and never renewed
+	limits := SessionLimits{}
+	funcAssert.Nil(limits.Check(claims, login.Add(24*time.Hour)))
+	token, err := limits.RenewActivity("", claims, login.Add(time.Hour))
+	funcAssert.Nil(err)
+	funcAssert.Equal("", token)
+
+	// Test-2 : an idle session is rejected, its activity slides the timeout
+	limits = SessionLimits{IdleTimeout: 15 * time.Minute, MaxLifetime: time.Hour}
+	funcAssert.Nil(limits.Check(claims, login.Add(10*time.Minute)))
+	funcAssert.Equal(ErrSessionIdle, limits.Check(claims, login.Add(16*time.Minute)))
+	token, err = limits.RenewActivity("", claims, login.Add(30*time.Second))
+	funcAssert.Nil(err)
+	funcAssert.Equal("", token)
+	token, err = limits.RenewActivity("", claims, login.Add(10*time.Minute))
+	funcAssert.Nil(err)
+	decrypted, err := DecryptToken(token)
+	funcAssert.Nil(err)
+	renewed, err := ParseClaimsFromToken(string(decrypted))
+	funcAssert.Nil(err)
+	funcAssert.Equal(login.Add(10*time.Minute).Unix(), renewed.LastActivity)
+	funcAssert.Equal(claims.IssuedAt, renewed.IssuedAt)
+	funcAssert.Equal(claims.STSAccessKeyID, renewed.STSAccessKeyID)
+	funcAssert.Nil(limits.Check(renewed, login.Add(20*time.Minute)))
+
+	// Test-3 : an active session still ends after its maximum lifetime
+	renewed.LastActivity = login.Add(59 * time.Minute).Unix()
+	funcAssert.Equal(ErrSessionEnded, limits.Check(renewed, login.Add(61*time.Minute)))
+
+	// Test-4 : tokens without the login time aren't accepted by a maximum lifetime
+	funcAssert.Equal(ErrSessionEnded, limits.Check(&TokenClaims{}, login))
+	funcAssert.Nil(SessionLimits{IdleTimeout: time.Minute}.Check(&TokenClaims{LastActivity: login.Unix()}, login))
+}
+
+func TestStoredSessions(t *testing.T) {
+	funcAssert := assert.New(t)
+	store := sessionstore.NewMemory()
+	SetSessionStore(store, time.Hour)
+	defer SetSessionStore(nil, 0)
+
+	// Test-1 : the token only references the claims kept in the store
+	token, err := NewEncryptedTokenForClient(creds, "console", nil)
+	funcAssert.Nil(err)
+	funcAssert.True(strings.HasPrefix(token, storedSessionPrefix))
+	funcAssert.NotContains(token, creds.SecretAccessKey)
+	claims, err := SessionTokenAuthenticate(token)
+	funcAssert.Nil(err)
+	funcAssert.Equal(creds.SecretAccessKey, claims.STSSecretAccessKey)
+	funcAssert.Equal("console", claims.AccountAccessKey)
+
+	// Test-2 : the claims are encrypted at rest and bound to their session
+	id, _ := storedSessionID(token)
+	ciphertext, err := store.Get(context.Background(), id)
+	funcAssert.Nil(err)
+	funcAssert.NotContains(string(ciphertext), creds.SecretAccessKey)
+	funcAssert.Nil(store.Put(context.Background(), "other", ciphertext, time.Hour))
+	_, err = SessionTokenAuthenticate(storedSessionPrefix + "other")
+	funcAssert.Equal(ErrReadingToken, err)
+
+	// Test-3 : the activity is renewed in the store, the token doesn't change
+	limits := SessionLimits{IdleTimeout: 15 * time.Minute}
+	now := time.Unix(claims.LastActivity, 0).Add(10 * time.Minute)
+	renewed, err := limits.RenewActivity(token, claims, now)
+	funcAssert.Nil(err)
+	funcAssert.Equal("", renewed)
+	claims, err = SessionTokenAuthenticate(token)
+	funcAssert.Nil(err)
+	funcAssert.Equal(now.Unix(), claims.LastActivity)
+
+	// Test-4 : an ended session isn't accepted anymore
+	funcAssert.Nil(EndSession(token))
+	_, err = SessionTokenAuthenticate(token)
+	funcAssert.Equal(ErrReadingToken, err)
+
+	// Test-5 : the store answers the health checks
+	funcAssert.Nil(CheckSessionStore(context.Background()))
+}
@@ -47,6 +47,8 @@ var (
 	ErrNoAuthToken  = errors.New("session token missing")
 	ErrTokenExpired = errors.New("session token has expired")
 	ErrReadingToken = errors.New("session token internal data is malformed")
+	ErrSessionIdle  = errors.New("session has been idle for too long")
+	ErrSessionEnded = errors.New("session has reached its maximum lifetime")
 )
 
 // derivedKey is the key used to encrypt the session token claims, its derived using pbkdf on CONSOLE_PBKDF_PASSPHRASE with CONSOLE_PBKDF_SALT
@@ -69,6 +71,15 @@ type TokenClaims struct {
 	HideMenu           bool   `json:"hm,omitempty"`
 	ObjectBrowser      bool   `json:"ob,omitempty"`
 	CustomStyleOB      string `json:"customStyleOb,omitempty"`
+	IDPName            string `json:"idpName,omitempty"`
+	IDPRefreshToken    string `json:"idpRefreshToken,omitempty"`
+	Expiration         int64  `json:"exp,omitempty"`
+	IssuedAt           int64  `json:"iat,omitempty"`
+	LastActivity       int64  `json:"act,omitempty"`
+	// ClusterID is the cluster the session works with and LoginCluster the one its credentials belong to,
+	// both empty for the cluster Console is configured with
+	ClusterID    string `json:"cluster,omitempty"`
+	LoginCluster string `json:"loginCluster,omitempty"`
 }
 
 // STSClaims claims struct for STS Token
@@ -81,6 +92,15 @@ type SessionFeatures struct {
 	HideMenu      bool
 	ObjectBrowser bool
 	CustomStyleOB string
+	// IDPName, IDPRefreshToken and Expiration let an OpenID session renew its credentials before they expire
+	IDPName         string
+	IDPRefreshToken string
+	Expiration      time.Time
+	// IssuedAt is the login of a renewed session, its lifetime still counts from there
+	IssuedAt time.Time
+	// ClusterID and LoginCluster are the cluster the session works with and the one its credentials belong to
+	ClusterID    string
+	LoginCluster string
 }
 
 // SessionTokenAuthenticate takes a session token, decode it, extract claims and validate the signature
@@ -116,16 +136,29 @@ func SessionTokenAuthenticate(token string) (*TokenClaims, error) {
 // encrypts the claims and the sign them
 func NewEncryptedTokenForClient(credentials *credentials.Value, accountAccessKey string, features *SessionFeatures) (string, error) {
 	if credentials != nil {
+		now := time.Now()
 		tokenClaims := &TokenClaims{
 			STSAccessKeyID:     credentials.AccessKeyID,
 			STSSecretAccessKey: credentials.SecretAccessKey,
 			STSSessionToken:    credentials.SessionToken,
 			AccountAccessKey:   accountAccessKey,
+			IssuedAt:           now.Unix(),
+			LastActivity:       now.Unix(),
 		}
 		if features != nil {
 			tokenClaims.HideMenu = features.HideMenu
 			tokenClaims.ObjectBrowser = features.ObjectBrowser
 			tokenClaims.CustomStyleOB = features.CustomStyleOB
+			tokenClaims.IDPName = features.IDPName
+			tokenClaims.IDPRefreshToken = features.IDPRefreshToken
+			if !features.Expiration.IsZero() {
+				tokenClaims.Expiration = features.Expiration.Unix()
+			}
+			if !features.IssuedAt.IsZero() {
+				tokenClaims.IssuedAt = features.IssuedAt.Unix()
+			}
+			tokenClaims.ClusterID = features.ClusterID
+			tokenClaims.LoginCluster = features.LoginCluster
 		}
 
 		encryptedClaims, err := encryptClaims(tokenClaims)
@@ -138,8 +171,11 @@ func NewEncryptedTokenForClient(credentials *credentials.Value, accountAccessKey
 }
 
 // encryptClaims() receives the STS claims, concatenate them and encrypt them using AES-GCM
-// returns a base64 encoded ciphertext
+// returns a base64 encoded ciphertext, or a reference to the claims kept in the session store
 func encryptClaims(credentials *TokenClaims) (string, error) {
+	if sessionStore != nil {
+		return newStoredSession(credentials)
+	}
 	payload, err := json.Marshal(credentials)
 	if err != nil {
 		return "", err
@@ -160,22 +196,48 @@ func ParseClaimsFromToken(claims string) (*TokenClaims, error) {
 	return tokenClaims, nil
 }
 
-// DecryptToken receives base64 encoded ciphertext, decode it, decrypt it (AES-GCM) and produces []byte
+// DecryptToken receives base64 encoded ciphertext, decode it, decrypt it (AES-GCM) and produces []byte, the claims
+// of a stored session are read from the session store
 func DecryptToken(ciphertext string) (plaintext []byte, err error) {
+	if id, ok := storedSessionID(ciphertext); ok {
+		return loadClaims(id)
+	}
 	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
 	if err != nil {
 		return nil, err
 	}
-	plaintext, err = decrypt(decoded, []byte{})
+	plaintext, err = decrypt(decoded, []byte{}, false)
 	if err != nil {
 		return nil, err
 	}
 	return plaintext, nil
 }
 
+// EncryptSecret encrypts a secret Console keeps for a user with the key of the sessions, the user
+// is authenticated with it so a secret can't be moved to another user
+func EncryptSecret(secret []byte, owner string) (string, error) {
+	ciphertext, err := encrypt(secret, []byte(owner))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// DecryptSecret decrypts a secret encrypted with EncryptSecret for the same user, the secrets encrypted
+// with a retired session key still decrypt as long as the key is known
+func DecryptSecret(ciphertext, owner string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(decoded, []byte(owner), true)
+}
+
 const (
 	aesGcm   = 0x00
 	c20p1305 = 0x01
+	// keyIDFlag marks the AEAD IDs of the ciphertexts carrying the ID of their session key
+	keyIDFlag = 0x80
 )
 
 // Encrypt a blob of data using AEAD scheme, AES-GCM if the executing CPU
@@ -187,7 +249,13 @@ const (
 //
 //	AEAD ID | iv | nonce | encrypted data
 //	   1      16		 12     ~ len(data)
+//
+// or, when the current session key has an ID, with keyIDFlag set in the AEAD ID:
+//
+//	AEAD ID | key ID length | key ID        | iv | nonce | encrypted data
+//	   1            1         ~ len(key ID)   16     12     ~ len(data)
 func encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	keyID, key := sessionKeys.current()
 	iv, err := sioutil.Random(16) // 16 bytes IV
 	if err != nil {
 		return nil, err
@@ -201,7 +269,7 @@ func encrypt(plaintext, associatedData []byte) ([]byte, error) {
 	var aead cipher.AEAD
 	switch algorithm {
 	case aesGcm:
-		mac := hmac.New(sha256.New, derivedKey())
+		mac := hmac.New(sha256.New, key)
 		mac.Write(iv)
 		sealingKey := mac.Sum(nil)
 
@@ -216,7 +284,7 @@ func encrypt(plaintext, associatedData []byte) ([]byte, error) {
 		}
 	case c20p1305:
 		var sealingKey []byte
-		sealingKey, err = chacha20.HChaCha20(derivedKey(), iv) // HChaCha20 expects nonce of 16 bytes
+		sealingKey, err = chacha20.HChaCha20(key, iv) // HChaCha20 expects nonce of 16 bytes
 		if err != nil {
 			return nil, err
 		}
@@ -235,7 +303,13 @@ func encrypt(plaintext, associatedData []byte) ([]byte, error) {
 	// ciphertext = AEAD ID | iv | nonce | sealed bytes
 
 	var buf bytes.Buffer
-	buf.WriteByte(algorithm)
+	if keyID != "" {
+		buf.WriteByte(algorithm | keyIDFlag)
+		buf.WriteByte(byte(len(keyID)))
+		buf.WriteString(keyID)
+	} else {
+		buf.WriteByte(algorithm)
+	}
 	buf.Write(iv)
 	buf.Write(nonce)
 	buf.Write(sealedBytes)
@@ -245,8 +319,9 @@ func encrypt(plaintext, associatedData []byte) ([]byte, error) {
 
 // Decrypts a blob of data using AEAD scheme AES-GCM if the executing CPU
 // provides AES hardware support, otherwise will use ChaCha20-Poly1305with
-// and a pbkdf2 derived key
-func decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+// and a pbkdf2 derived key. The session keys retired for longer than the
+// overlap are only used when anyRetired.
+func decrypt(ciphertext, associatedData []byte, anyRetired bool) ([]byte, error) {
 	var (
 		algorithm [1]byte
 		iv        [16]byte
@@ -257,6 +332,23 @@ func decrypt(ciphertext, associatedData []byte) ([]byte, error) {
 	if _, err := io.ReadFull(r, algorithm[:]); err != nil {
 		return nil, err
 	}
+	var keyID string
+	if algorithm[0]&keyIDFlag != 0 {
+		algorithm[0] &^= keyIDFlag
+		size, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		id := make([]byte, size)
+		if _, err := io.ReadFull(r, id); err != nil {
+			return nil, err
+		}
+		keyID = string(id)
+	}
+	key, err := sessionKeys.lookup(keyID, anyRetired)
+	if err != nil {
+		return nil, err
+	}
 	if _, err := io.ReadFull(r, iv[:]); err != nil {
 		return nil, err
 	}
@@ -267,7 +359,7 @@ func decrypt(ciphertext, associatedData []byte) ([]byte, error) {
 	var aead cipher.AEAD
 	switch algorithm[0] {
 	case aesGcm:
-		mac := hmac.New(sha256.New, derivedKey())
+		mac := hmac.New(sha256.New, key)
 		mac.Write(iv[:])
 		sealingKey := mac.Sum(nil)
 		block, err := aes.NewCipher(sealingKey)
@@ -279,7 +371,7 @@ func decrypt(ciphertext, associatedData []byte) ([]byte, error) {
 			return nil, err
 		}
 	case c20p1305:
-		sealingKey, err := chacha20.HChaCha20(derivedKey(), iv[:]) // HChaCha20 expects nonce of 16 bytes
+		sealingKey, err := chacha20.HChaCha20(key, iv[:]) // HChaCha20 expects nonce of 16 bytes
 		if err != nil {
 			return nil, err
 		}
@@ -342,5 +434,7 @@ func GetClaimsFromTokenInRequest(req *http.Request) (*models.Principal, error) {
 		STSSecretAccessKey: claims.STSSecretAccessKey,
 		STSSessionToken:    claims.STSSessionToken,
 		AccountAccessKey:   claims.AccountAccessKey,
+		ClusterID:          claims.ClusterID,
+		LoginCluster:       claims.LoginCluster,
 	}, nil
 }
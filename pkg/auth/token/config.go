@@ -45,3 +45,30 @@ var defaultPBKDFSalt = utils.RandomCharString(64)
 func GetPBKDFSalt() string {
 	return env.Get(ConsolePBKDFSalt, defaultPBKDFSalt)
 }
+
+// GetPBKDFKeyID returns the ID of the key of CONSOLE_PBKDF_PASSPHRASE and CONSOLE_PBKDF_SALT, carried by the tokens
+// it encrypts to tell it from the previous key
+func GetPBKDFKeyID() string {
+	return env.Get(ConsolePBKDFKeyID, "")
+}
+
+// GetPreviousPBKDF returns the ID, passphrase and salt of the key used before the current one, its tokens are
+// accepted for the overlap after the startup
+func GetPreviousPBKDF() (id, passphrase, salt string) {
+	return env.Get(ConsolePBKDFPreviousKeyID, ""), env.Get(ConsolePBKDFPreviousPassphrase, ""), env.Get(ConsolePBKDFPreviousSalt, "")
+}
+
+// GetSessionKeysFile returns the file keeping the session keys shared by the console replicas and their rotations
+func GetSessionKeysFile() string {
+	return env.Get(ConsoleSessionKeysFile, "")
+}
+
+// GetSessionKeyOverlap returns how long the tokens of a retired session key are accepted, by default as long as a
+// session lasts
+func GetSessionKeyOverlap() time.Duration {
+	duration, err := time.ParseDuration(env.Get(ConsoleSessionKeyOverlap, ""))
+	if err != nil || duration < 0 {
+		return GetConsoleSTSDuration()
+	}
+	return duration
+}
@@ -20,4 +20,11 @@ const (
 	ConsoleSTSDuration     = "CONSOLE_STS_DURATION" // time.Duration format, ie: 3600s, 2h45m, 1h, etc
 	ConsolePBKDFPassphrase = "CONSOLE_PBKDF_PASSPHRASE"
 	ConsolePBKDFSalt       = "CONSOLE_PBKDF_SALT"
+
+	ConsolePBKDFKeyID              = "CONSOLE_PBKDF_KEY_ID"
+	ConsolePBKDFPreviousPassphrase = "CONSOLE_PBKDF_PREVIOUS_PASSPHRASE"
+	ConsolePBKDFPreviousSalt       = "CONSOLE_PBKDF_PREVIOUS_SALT"
+	ConsolePBKDFPreviousKeyID      = "CONSOLE_PBKDF_PREVIOUS_KEY_ID"
+	ConsoleSessionKeysFile         = "CONSOLE_SESSION_KEYS_FILE"
+	ConsoleSessionKeyOverlap       = "CONSOLE_SESSION_KEY_OVERLAP" // time.Duration format, defaults to CONSOLE_STS_DURATION
 )
@@ -80,3 +80,16 @@ func TestSessionTokenValid(t *testing.T) {
 	// Test-2 : SessionTokenAuthenticate() provided token is invalid
 	funcAssert.Equal(false, IsSessionTokenValid(badToken))
 }
+
+func TestEncryptSecret(t *testing.T) {
+	funcAssert := assert.New(t)
+	ciphertext, err := EncryptSecret([]byte("12345678901234567890"), "alice")
+	funcAssert.Nil(err)
+	// Test-1 : DecryptSecret() returns the secret of the same user
+	secret, err := DecryptSecret(ciphertext, "alice")
+	funcAssert.Nil(err)
+	funcAssert.Equal([]byte("12345678901234567890"), secret)
+	// Test-2 : DecryptSecret() fails for another user
+	_, err = DecryptSecret(ciphertext, "bob")
+	funcAssert.NotNil(err)
+}
@@ -0,0 +1,379 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package autotls provisions and renews the certificate of Console with an ACME CA such as Let's Encrypt,
+// answering the HTTP-01 challenges itself or the DNS-01 ones through a DNS provider.
+package autotls
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// ChallengeHTTP01 proves the control of the domains by serving a token over HTTP on port 80
+	ChallengeHTTP01 = "http-01"
+	// ChallengeDNS01 proves the control of the domains with a TXT record, it is the one wildcard domains take
+	ChallengeDNS01 = "dns-01"
+
+	// LetsEncryptURL is the directory of the production CA of Let's Encrypt
+	LetsEncryptURL = acme.LetsEncryptURL
+
+	// AccountKeyFile keeps the key of the ACME account in Config.Dir
+	AccountKeyFile = "account.key"
+
+	// checkInterval is how often the certificate is checked for renewal, retryInterval how soon a failed
+	// renewal is retried
+	checkInterval = 12 * time.Hour
+	retryInterval = time.Hour
+	// obtainTimeout bounds how long the CA takes to issue a certificate
+	obtainTimeout = 10 * time.Minute
+)
+
+// ErrInvalidConfig is returned for configurations a certificate can't be obtained with
+var ErrInvalidConfig = errors.New("invalid ACME configuration")
+
+// Config is where and how the certificate is obtained
+type Config struct {
+	// DirectoryURL of the CA, Let's Encrypt when empty
+	DirectoryURL string
+	// Email the CA sends the notices about the account to
+	Email     string
+	Domains   []string
+	Challenge string
+	// DNS publishes the records of the DNS-01 challenges
+	DNS DNSProvider
+	// Dir keeps the account key
+	Dir string
+	// CertFile and KeyFile are written with the certificate chain and its private key
+	CertFile string
+	KeyFile  string
+	// RenewBefore is how long before it expires the certificate is renewed
+	RenewBefore time.Duration
+	HTTPClient  *http.Client
+}
+
+// Manager obtains the certificate of the configured domains and renews it before it expires
+type Manager struct {
+	config Config
+	client *acme.Client
+
+	mu sync.Mutex
+	// http01 maps the paths of the pending HTTP-01 challenges to their response
+	http01     map[string]string
+	registered bool
+}
+
+// New returns a manager for the configuration, the account key is created the first time
+func New(config Config) (*Manager, error) {
+	if len(config.Domains) == 0 {
+		return nil, fmt.Errorf("%w: no domains", ErrInvalidConfig)
+	}
+	if config.Challenge == "" {
+		config.Challenge = ChallengeHTTP01
+	}
+	switch config.Challenge {
+	case ChallengeHTTP01:
+		for _, domain := range config.Domains {
+			if strings.HasPrefix(domain, "*.") {
+				return nil, fmt.Errorf("%w: the wildcard domain %s takes the %s challenge", ErrInvalidConfig, domain, ChallengeDNS01)
+			}
+		}
+	case ChallengeDNS01:
+		if config.DNS == nil {
+			return nil, fmt.Errorf("%w: the %s challenge takes a DNS provider", ErrInvalidConfig, ChallengeDNS01)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown challenge %q", ErrInvalidConfig, config.Challenge)
+	}
+	if config.DirectoryURL == "" {
+		config.DirectoryURL = LetsEncryptURL
+	}
+	key, err := loadAccountKey(filepath.Join(config.Dir, AccountKeyFile))
+	if err != nil {
+		return nil, err
+	}
+	return &Manager{
+		config: config,
+		client: &acme.Client{Key: key, DirectoryURL: config.DirectoryURL, HTTPClient: config.HTTPClient, UserAgent: "MinIO Console"},
+		http01: map[string]string{},
+	}, nil
+}
+
+// loadAccountKey reads the account key from path, creating it when it doesn't exist yet
+func loadAccountKey(path string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, fmt.Errorf("no key found in %s", path)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	if err = utils.WriteFileAtomic(path, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Certificate returns the certificate in CertFile, nil when there is none
+func (m *Manager) Certificate() *x509.Certificate {
+	data, err := os.ReadFile(m.config.CertFile)
+	if err != nil {
+		return nil
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return cert
+}
+
+// NeedsCertificate tells whether there is no certificate yet, it expires within RenewBefore or it doesn't
+// cover all the domains
+func (m *Manager) NeedsCertificate(now time.Time) bool {
+	cert := m.Certificate()
+	if cert == nil || now.Add(m.config.RenewBefore).After(cert.NotAfter) {
+		return true
+	}
+	names := map[string]bool{}
+	for _, name := range cert.DNSNames {
+		names[name] = true
+	}
+	for _, domain := range m.config.Domains {
+		if !names[domain] {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) register(ctx context.Context) error {
+	m.mu.Lock()
+	registered := m.registered
+	m.mu.Unlock()
+	if registered {
+		return nil
+	}
+	account := &acme.Account{}
+	if m.config.Email != "" {
+		account.Contact = []string{"mailto:" + m.config.Email}
+	}
+	if _, err := m.client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return fmt.Errorf("unable to register the ACME account: %w", err)
+	}
+	m.mu.Lock()
+	m.registered = true
+	m.mu.Unlock()
+	return nil
+}
+
+// HTTPHandler answers the pending HTTP-01 challenges and passes the other requests to next
+func (m *Manager) HTTPHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/.well-known/acme-challenge/") {
+			next.ServeHTTP(w, r)
+			return
+		}
+		m.mu.Lock()
+		response, ok := m.http01[r.URL.Path]
+		m.mu.Unlock()
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Write([]byte(response))
+	})
+}
+
+// authorize completes the challenge of an authorization of the order
+func (m *Manager) authorize(ctx context.Context, authzURL string) error {
+	authz, err := m.client.GetAuthorization(ctx, authzURL)
+	if err != nil {
+		return err
+	}
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == m.config.Challenge {
+			challenge = c
+			break
+		}
+	}
+	domain := authz.Identifier.Value
+	if challenge == nil {
+		return fmt.Errorf("the CA offers no %s challenge for %s", m.config.Challenge, domain)
+	}
+	switch m.config.Challenge {
+	case ChallengeHTTP01:
+		response, err := m.client.HTTP01ChallengeResponse(challenge.Token)
+		if err != nil {
+			return err
+		}
+		path := m.client.HTTP01ChallengePath(challenge.Token)
+		m.mu.Lock()
+		m.http01[path] = response
+		m.mu.Unlock()
+		defer func() {
+			m.mu.Lock()
+			delete(m.http01, path)
+			m.mu.Unlock()
+		}()
+	case ChallengeDNS01:
+		value, err := m.client.DNS01ChallengeRecord(challenge.Token)
+		if err != nil {
+			return err
+		}
+		// the identifiers of wildcard domains come without the wildcard
+		fqdn := "_acme-challenge." + strings.TrimPrefix(domain, "*.") + "."
+		if err = m.config.DNS.Present(ctx, fqdn, value); err != nil {
+			return fmt.Errorf("unable to publish the DNS record %s: %w", fqdn, err)
+		}
+		defer m.config.DNS.CleanUp(context.Background(), fqdn, value)
+	}
+	if _, err = m.client.Accept(ctx, challenge); err != nil {
+		return err
+	}
+	if _, err = m.client.WaitAuthorization(ctx, authzURL); err != nil {
+		return fmt.Errorf("%s failed the %s challenge: %w", domain, m.config.Challenge, err)
+	}
+	return nil
+}
+
+// Obtain orders a certificate for the domains and writes it along with its new private key
+func (m *Manager) Obtain(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, obtainTimeout)
+	defer cancel()
+	if err := m.register(ctx); err != nil {
+		return err
+	}
+	order, err := m.client.AuthorizeOrder(ctx, acme.DomainIDs(m.config.Domains...))
+	if err != nil {
+		return err
+	}
+	for _, authzURL := range order.AuthzURLs {
+		if err = m.authorize(ctx, authzURL); err != nil {
+			return err
+		}
+	}
+	if order, err = m.client.WaitOrder(ctx, order.URI); err != nil {
+		return err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: m.config.Domains[0]},
+		DNSNames: m.config.Domains,
+	}, key)
+	if err != nil {
+		return err
+	}
+	chain, _, err := m.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	var certPEM []byte
+	for _, der := range chain {
+		certPEM = append(certPEM, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	// the key goes first, a reload in between fails and keeps serving the previous certificate
+	if err = utils.WriteFileAtomic(m.config.KeyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})); err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(m.config.CertFile, certPEM)
+}
+
+// ObtainListening obtains the certificate while answering the HTTP-01 challenges on addr, for when
+// Console doesn't serve yet
+func (m *Manager) ObtainListening(ctx context.Context, addr string) error {
+	if m.config.Challenge != ChallengeHTTP01 {
+		return m.Obtain(ctx)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{Handler: m.HTTPHandler(http.NotFoundHandler()), ReadHeaderTimeout: 10 * time.Second}
+	go server.Serve(listener)
+	defer server.Close()
+	return m.Obtain(ctx)
+}
+
+// Run renews the certificate when it needs it until ctx is done, renewed is called after every attempt
+func (m *Manager) Run(ctx context.Context, renewed func(err error)) {
+	for {
+		wait := checkInterval
+		if m.NeedsCertificate(time.Now()) {
+			err := m.Obtain(ctx)
+			if err != nil {
+				wait = retryInterval
+			}
+			if renewed != nil {
+				renewed(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,338 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package autotls
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCA is a minimal RFC 8555 server issuing one order, it validates the HTTP-01 challenges through the
+// handler of the manager and the DNS-01 ones through the records of the fake DNS provider
+type fakeCA struct {
+	t       *testing.T
+	server  *httptest.Server
+	key     *ecdsa.PrivateKey
+	manager *Manager
+	dns     *fakeDNS
+
+	mu      sync.Mutex
+	domains []string
+	valid   map[string]bool
+	cert    []byte
+}
+
+func newFakeCA(t *testing.T) *fakeCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca := &fakeCA{t: t, key: key, dns: &fakeDNS{records: map[string]string{}}, valid: map[string]bool{}}
+	ca.server = httptest.NewServer(ca)
+	t.Cleanup(ca.server.Close)
+	return ca
+}
+
+func (ca *fakeCA) url(path string) string {
+	return ca.server.URL + path
+}
+
+// payload decodes the payload of the JWS body of the request
+func (ca *fakeCA) payload(r *http.Request, v interface{}) {
+	var jws struct {
+		Payload string `json:"payload"`
+	}
+	body, _ := io.ReadAll(r.Body)
+	if err := json.Unmarshal(body, &jws); err != nil {
+		ca.t.Error(err)
+		return
+	}
+	data, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		ca.t.Error(err)
+		return
+	}
+	if v != nil && len(data) > 0 {
+		if err = json.Unmarshal(data, v); err != nil {
+			ca.t.Error(err)
+		}
+	}
+}
+
+func (ca *fakeCA) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Replay-Nonce", fmt.Sprintf("nonce-%d", time.Now().UnixNano()))
+	w.Header().Set("Content-Type", "application/json")
+	ca.mu.Lock()
+	defer ca.mu.Unlock()
+	reply := func(status int, v interface{}) {
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(v)
+	}
+	order := func() map[string]interface{} {
+		authz := []string{}
+		status := "ready"
+		for _, domain := range ca.domains {
+			authz = append(authz, ca.url("/authz/"+domain))
+			if !ca.valid[domain] {
+				status = "pending"
+			}
+		}
+		if ca.cert != nil {
+			status = "valid"
+		}
+		return map[string]interface{}{
+			"status":         status,
+			"authorizations": authz,
+			"finalize":       ca.url("/finalize"),
+			"certificate":    ca.url("/cert"),
+		}
+	}
+	switch path := r.URL.Path; {
+	case path == "/directory":
+		reply(http.StatusOK, map[string]string{
+			"newNonce":   ca.url("/nonce"),
+			"newAccount": ca.url("/account"),
+			"newOrder":   ca.url("/order"),
+		})
+	case path == "/nonce":
+		w.WriteHeader(http.StatusOK)
+	case path == "/account":
+		ca.payload(r, nil)
+		w.Header().Set("Location", ca.url("/account/1"))
+		reply(http.StatusCreated, map[string]string{"status": "valid"})
+	case path == "/order":
+		var req struct {
+			Identifiers []struct{ Value string } `json:"identifiers"`
+		}
+		ca.payload(r, &req)
+		ca.domains = nil
+		for _, id := range req.Identifiers {
+			ca.domains = append(ca.domains, id.Value)
+		}
+		w.Header().Set("Location", ca.url("/order/1"))
+		reply(http.StatusCreated, order())
+	case path == "/order/1":
+		ca.payload(r, nil)
+		reply(http.StatusOK, order())
+	case strings.HasPrefix(path, "/authz/"):
+		ca.payload(r, nil)
+		domain := strings.TrimPrefix(path, "/authz/")
+		status := "pending"
+		if ca.valid[domain] {
+			status = "valid"
+		}
+		reply(http.StatusOK, map[string]interface{}{
+			"status":     status,
+			"identifier": map[string]string{"type": "dns", "value": domain},
+			"challenges": []map[string]string{
+				{"type": ChallengeHTTP01, "url": ca.url("/challenge/http-01/" + domain), "token": "token-" + domain, "status": "pending"},
+				{"type": ChallengeDNS01, "url": ca.url("/challenge/dns-01/" + domain), "token": "token-" + domain, "status": "pending"},
+			},
+		})
+	case strings.HasPrefix(path, "/challenge/"):
+		ca.payload(r, nil)
+		parts := strings.SplitN(strings.TrimPrefix(path, "/challenge/"), "/", 2)
+		kind, domain := parts[0], parts[1]
+		token := "token-" + domain
+		switch kind {
+		case ChallengeHTTP01:
+			rec := httptest.NewRecorder()
+			ca.manager.HTTPHandler(http.NotFoundHandler()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/.well-known/acme-challenge/"+token, nil))
+			ca.valid[domain] = rec.Code == http.StatusOK && strings.HasPrefix(rec.Body.String(), token+".")
+		case ChallengeDNS01:
+			ca.valid[domain] = ca.dns.get("_acme-challenge."+domain+".") != ""
+		}
+		reply(http.StatusOK, map[string]string{"type": kind, "url": ca.url(path), "token": token, "status": "valid"})
+	case path == "/finalize":
+		var req struct {
+			CSR string `json:"csr"`
+		}
+		ca.payload(r, &req)
+		der, _ := base64.RawURLEncoding.DecodeString(req.CSR)
+		csr, err := x509.ParseCertificateRequest(der)
+		if err != nil {
+			reply(http.StatusBadRequest, map[string]string{"type": "urn:ietf:params:acme:error:badCSR", "detail": err.Error()})
+			return
+		}
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(time.Now().UnixNano()),
+			Subject:      pkix.Name{CommonName: csr.Subject.CommonName},
+			DNSNames:     csr.DNSNames,
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+		}
+		ca.cert, err = x509.CreateCertificate(rand.Reader, template, template, csr.PublicKey, ca.key)
+		if err != nil {
+			ca.t.Error(err)
+		}
+		reply(http.StatusOK, order())
+	case path == "/cert":
+		ca.payload(r, nil)
+		w.Header().Set("Content-Type", "application/pem-certificate-chain")
+		w.WriteHeader(http.StatusOK)
+		w.Write(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert}))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+type fakeDNS struct {
+	mu      sync.Mutex
+	records map[string]string
+	cleaned []string
+}
+
+func (d *fakeDNS) get(fqdn string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.records[fqdn]
+}
+
+func (d *fakeDNS) Present(_ context.Context, fqdn, value string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.records[fqdn] = value
+	return nil
+}
+
+func (d *fakeDNS) CleanUp(_ context.Context, fqdn, _ string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.records, fqdn)
+	d.cleaned = append(d.cleaned, fqdn)
+	return nil
+}
+
+func TestObtain(t *testing.T) {
+	for _, challenge := range []string{ChallengeHTTP01, ChallengeDNS01} {
+		t.Run(challenge, func(t *testing.T) {
+			ca := newFakeCA(t)
+			dir := t.TempDir()
+			config := Config{
+				DirectoryURL: ca.url("/directory"),
+				Email:        "admin@example.com",
+				Domains:      []string{"console.example.com", "minio.example.com"},
+				Challenge:    challenge,
+				Dir:          dir,
+				CertFile:     filepath.Join(dir, "public.crt"),
+				KeyFile:      filepath.Join(dir, "private.key"),
+				RenewBefore:  30 * 24 * time.Hour,
+			}
+			if challenge == ChallengeDNS01 {
+				config.DNS = ca.dns
+			}
+			manager, err := New(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ca.manager = manager
+			if !manager.NeedsCertificate(time.Now()) {
+				t.Fatal("expected a certificate to be needed before the first one is obtained")
+			}
+			if err = manager.Obtain(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			cert := manager.Certificate()
+			if cert == nil || len(cert.DNSNames) != 2 {
+				t.Fatalf("expected a certificate for both domains, got %+v", cert)
+			}
+			if manager.NeedsCertificate(time.Now()) {
+				t.Fatal("expected the new certificate to be enough")
+			}
+			if !manager.NeedsCertificate(time.Now().Add(61 * 24 * time.Hour)) {
+				t.Fatal("expected the certificate to be renewed 30 days before it expires")
+			}
+			if len(manager.http01) != 0 {
+				t.Fatalf("expected the HTTP-01 tokens to be dropped, got %v", manager.http01)
+			}
+			if challenge == ChallengeDNS01 && (len(ca.dns.cleaned) != 2 || len(ca.dns.records) != 0) {
+				t.Fatalf("expected the DNS records to be cleaned up, got %v", ca.dns.records)
+			}
+			if _, err = os.Stat(filepath.Join(dir, AccountKeyFile)); err != nil {
+				t.Fatalf("expected the account key to be saved: %v", err)
+			}
+			// the account key is reused
+			again, err := New(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !again.client.Key.Public().(*ecdsa.PublicKey).Equal(manager.client.Key.Public()) {
+				t.Fatal("expected the account key to be loaded again")
+			}
+		})
+	}
+}
+
+func TestNewRejectsInvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+	for name, config := range map[string]Config{
+		"no domains":         {Dir: dir},
+		"wildcard http-01":   {Dir: dir, Domains: []string{"*.example.com"}},
+		"dns-01 no provider": {Dir: dir, Domains: []string{"example.com"}, Challenge: ChallengeDNS01},
+		"unknown challenge":  {Dir: dir, Domains: []string{"example.com"}, Challenge: "tls-alpn-01"},
+	} {
+		if _, err := New(config); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("%s: expected ErrInvalidConfig, got %v", name, err)
+		}
+	}
+	if _, err := NewDNSProvider("route53", ""); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("expected an unknown DNS provider to be rejected, got %v", err)
+	}
+}
+
+func TestWebhookProvider(t *testing.T) {
+	var got []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]string
+		json.NewDecoder(r.Body).Decode(&req)
+		got = append(got, req)
+	}))
+	defer server.Close()
+	provider, err := NewDNSProvider("webhook", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err = provider.Present(ctx, "_acme-challenge.example.com.", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if err = provider.CleanUp(ctx, "_acme-challenge.example.com.", "abc"); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0]["action"] != "present" || got[1]["action"] != "cleanup" || got[0]["value"] != "abc" {
+		t.Fatalf("unexpected webhook calls %v", got)
+	}
+}
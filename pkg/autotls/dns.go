@@ -0,0 +1,144 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package autotls
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DNSProvider publishes the TXT records of the DNS-01 challenges
+type DNSProvider interface {
+	// Present creates the TXT record fqdn with value and returns once it is resolvable
+	Present(ctx context.Context, fqdn, value string) error
+	// CleanUp removes the TXT record created by Present
+	CleanUp(ctx context.Context, fqdn, value string) error
+}
+
+// DNSProviderFactory creates a provider from its configuration, the format of which is up to the provider
+type DNSProviderFactory func(config string) (DNSProvider, error)
+
+var (
+	dnsProvidersMu sync.RWMutex
+	dnsProviders   = map[string]DNSProviderFactory{
+		"exec":    newExecProvider,
+		"webhook": newWebhookProvider,
+	}
+)
+
+// RegisterDNSProvider makes a provider available under name, it replaces any provider of the same name
+func RegisterDNSProvider(name string, factory DNSProviderFactory) {
+	dnsProvidersMu.Lock()
+	defer dnsProvidersMu.Unlock()
+	dnsProviders[name] = factory
+}
+
+// NewDNSProvider creates the provider registered under name
+func NewDNSProvider(name, config string) (DNSProvider, error) {
+	dnsProvidersMu.RLock()
+	factory, ok := dnsProviders[name]
+	names := make([]string, 0, len(dnsProviders))
+	for n := range dnsProviders {
+		names = append(names, n)
+	}
+	dnsProvidersMu.RUnlock()
+	if !ok {
+		sort.Strings(names)
+		return nil, fmt.Errorf("%w: unknown DNS provider %q, expected one of %s", ErrInvalidConfig, name, strings.Join(names, ", "))
+	}
+	return factory(config)
+}
+
+// execProvider runs a program as `<program> present|cleanup <fqdn> <value>`, the convention of the exec
+// provider of lego, so the scripts written for it are reused as is
+type execProvider struct {
+	program string
+}
+
+func newExecProvider(config string) (DNSProvider, error) {
+	if config == "" {
+		return nil, fmt.Errorf("%w: the exec DNS provider takes the path of the program to run", ErrInvalidConfig)
+	}
+	return &execProvider{program: config}, nil
+}
+
+func (p *execProvider) run(ctx context.Context, action, fqdn, value string) error {
+	out, err := exec.CommandContext(ctx, p.program, action, fqdn, value).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s %s: %w: %s", p.program, action, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (p *execProvider) Present(ctx context.Context, fqdn, value string) error {
+	return p.run(ctx, "present", fqdn, value)
+}
+
+func (p *execProvider) CleanUp(ctx context.Context, fqdn, value string) error {
+	return p.run(ctx, "cleanup", fqdn, value)
+}
+
+// webhookProvider POSTs {"action": "present"|"cleanup", "fqdn": ..., "value": ...} to a URL and expects a
+// 2xx status once the record is done
+type webhookProvider struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookProvider(config string) (DNSProvider, error) {
+	if !strings.HasPrefix(config, "http://") && !strings.HasPrefix(config, "https://") {
+		return nil, fmt.Errorf("%w: the webhook DNS provider takes the URL to POST the records to", ErrInvalidConfig)
+	}
+	return &webhookProvider{url: config, client: &http.Client{Timeout: 2 * time.Minute}}, nil
+}
+
+func (p *webhookProvider) post(ctx context.Context, action, fqdn, value string) error {
+	body, err := json.Marshal(map[string]string{"action": action, "fqdn": fqdn, "value": value})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("the DNS webhook answered %s to %s", resp.Status, action)
+	}
+	return nil
+}
+
+func (p *webhookProvider) Present(ctx context.Context, fqdn, value string) error {
+	return p.post(ctx, "present", fqdn, value)
+}
+
+func (p *webhookProvider) CleanUp(ctx context.Context, fqdn, value string) error {
+	return p.post(ctx, "cleanup", fqdn, value)
+}
@@ -0,0 +1,100 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"sync"
+	"time"
+
+	xcerts "github.com/minio/pkg/certs"
+)
+
+// Certificate is a certificate and private key pair served by Console
+type Certificate struct {
+	CertFile string
+	KeyFile  string
+	// Chain is the certificate chain loaded last from CertFile
+	Chain    []*x509.Certificate
+	LoadedAt time.Time
+	// Err is the error of the last reload, Chain is still the one loaded before it
+	Err error
+}
+
+var loaded = struct {
+	sync.Mutex
+	certificates []*Certificate
+}{}
+
+// NewManager returns a manager serving the certificate in certFile, the manager reloads the
+// certificates it serves whenever their files change for as long as Console runs.
+func NewManager(certFile, keyFile string) (*xcerts.Manager, error) {
+	return xcerts.NewManager(context.Background(), certFile, keyFile, loadAndTrackX509KeyPair)
+}
+
+// loadAndTrackX509KeyPair loads the key pair with LoadX509KeyPair and records the outcome, the
+// manager calls it on every reload
+func loadAndTrackX509KeyPair(certFile, keyFile string) (tls.Certificate, error) {
+	certificate, err := LoadX509KeyPair(certFile, keyFile)
+	var chain []*x509.Certificate
+	if err == nil {
+		for _, der := range certificate.Certificate {
+			var x509Cert *x509.Certificate
+			if x509Cert, err = x509.ParseCertificate(der); err != nil {
+				break
+			}
+			chain = append(chain, x509Cert)
+		}
+	}
+
+	loaded.Lock()
+	defer loaded.Unlock()
+	var tracked *Certificate
+	for _, c := range loaded.certificates {
+		if c.CertFile == certFile && c.KeyFile == keyFile {
+			tracked = c
+			break
+		}
+	}
+	if tracked == nil {
+		if err != nil {
+			// never served
+			return certificate, err
+		}
+		tracked = &Certificate{CertFile: certFile, KeyFile: keyFile}
+		loaded.certificates = append(loaded.certificates, tracked)
+	}
+	if err != nil {
+		tracked.Err = err
+		return certificate, err
+	}
+	tracked.Chain, tracked.LoadedAt, tracked.Err = chain, time.Now(), nil
+	return certificate, nil
+}
+
+// LoadedCertificates returns the certificates Console serves in the order they were first loaded
+func LoadedCertificates() []Certificate {
+	loaded.Lock()
+	defer loaded.Unlock()
+	certificates := make([]Certificate, 0, len(loaded.certificates))
+	for _, c := range loaded.certificates {
+		certificates = append(certificates, *c)
+	}
+	return certificates
+}
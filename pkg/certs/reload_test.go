@@ -0,0 +1,125 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeKeyPair(t *testing.T, certFile, keyFile string, serial int64) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "console.local"},
+		DNSNames:     []string{"console.local"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func loadedCertificate(certFile string) (Certificate, bool) {
+	for _, c := range LoadedCertificates() {
+		if c.CertFile == certFile {
+			return c, true
+		}
+	}
+	return Certificate{}, false
+}
+
+func TestManagerReloadsCertificates(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, PublicCertFile), filepath.Join(dir, PrivateKeyFile)
+	writeKeyPair(t, certFile, keyFile, 1)
+
+	manager, err := NewManager(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loadedCert, ok := loadedCertificate(certFile)
+	if !ok || len(loadedCert.Chain) != 1 || loadedCert.Chain[0].SerialNumber.Int64() != 1 {
+		t.Fatalf("expected the certificate 1 to be loaded, got %+v", loadedCert)
+	}
+
+	// the new certificate is served without restarting
+	writeKeyPair(t, certFile, keyFile, 2)
+	manager.ReloadCerts()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		served, err := manager.GetCertificate(&tls.ClientHelloInfo{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if served.Leaf.SerialNumber.Int64() == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the certificate 2 was not reloaded")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if loadedCert, _ = loadedCertificate(certFile); loadedCert.Chain[0].SerialNumber.Int64() != 2 || loadedCert.Err != nil {
+		t.Fatalf("expected the certificate 2 to be recorded, got %+v", loadedCert)
+	}
+
+	// a broken certificate keeps the one loaded before it and records the error
+	if err = os.WriteFile(certFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	manager.ReloadCerts()
+	deadline = time.Now().Add(5 * time.Second)
+	for {
+		if loadedCert, _ = loadedCertificate(certFile); loadedCert.Err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the failed reload was not recorded")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if loadedCert.Chain[0].SerialNumber.Int64() != 2 {
+		t.Fatalf("expected the certificate 2 to still be served, got %+v", loadedCert)
+	}
+}
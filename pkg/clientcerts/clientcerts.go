@@ -0,0 +1,135 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package clientcerts maps the client certificates verified by the console listener to the MinIO credentials
+// the requests presenting them are authenticated with.
+package clientcerts
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// ModeOff doesn't ask the clients for a certificate
+	ModeOff = "off"
+	// ModeOptional verifies the certificates the clients present, clients without one log in as usual
+	ModeOptional = "optional"
+	// ModeRequire rejects the clients without a valid certificate
+	ModeRequire = "require"
+)
+
+// ErrInvalidConfig is returned for an unknown mode or an invalid mappings file
+var ErrInvalidConfig = errors.New("invalid client certificates configuration")
+
+// Mapping authenticates the certificates matching Subject with AccessKey and SecretKey, the session is
+// restricted further to Policy when set
+type Mapping struct {
+	// Subject is matched against the common name and the DNS, email and URI SANs, a leading "*." matches
+	// any subdomain
+	Subject   string          `json:"subject"`
+	AccessKey string          `json:"accessKey,omitempty"`
+	SecretKey string          `json:"secretKey,omitempty"`
+	Policy    json.RawMessage `json:"policy,omitempty"`
+}
+
+// ClientAuth returns the TLS client authentication of the mode
+func ClientAuth(mode string) (tls.ClientAuthType, error) {
+	switch mode {
+	case ModeOff, "":
+		return tls.NoClientCert, nil
+	case ModeOptional:
+		return tls.VerifyClientCertIfGiven, nil
+	case ModeRequire:
+		return tls.RequireAndVerifyClientCert, nil
+	}
+	return tls.NoClientCert, fmt.Errorf("%w: unknown mode %q, expected one of off, optional or require", ErrInvalidConfig, mode)
+}
+
+// LoadMappings reads the mappings from a JSON array, the mappings without credentials take defaultAccessKey
+// and defaultSecretKey
+func LoadMappings(path, defaultAccessKey, defaultSecretKey string) ([]Mapping, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var mappings []Mapping
+	if err = json.Unmarshal(data, &mappings); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidConfig, path, err)
+	}
+	for i := range mappings {
+		m := &mappings[i]
+		if m.Subject == "" {
+			return nil, fmt.Errorf("%w: %s: mapping %d has no subject", ErrInvalidConfig, path, i)
+		}
+		if m.AccessKey == "" && m.SecretKey == "" {
+			m.AccessKey, m.SecretKey = defaultAccessKey, defaultSecretKey
+		}
+		if m.AccessKey == "" || m.SecretKey == "" {
+			return nil, fmt.Errorf("%w: %s: the mapping of %s has no credentials", ErrInvalidConfig, path, m.Subject)
+		}
+		if len(m.Policy) > 0 && string(m.Policy) != "null" && !json.Valid(m.Policy) {
+			return nil, fmt.Errorf("%w: %s: the policy of %s isn't valid JSON", ErrInvalidConfig, path, m.Subject)
+		}
+	}
+	return mappings, nil
+}
+
+// Names returns the common name and the DNS, email and URI SANs of the certificate
+func Names(cert *x509.Certificate) []string {
+	var names []string
+	if cert.Subject.CommonName != "" {
+		names = append(names, cert.Subject.CommonName)
+	}
+	names = append(names, cert.DNSNames...)
+	names = append(names, cert.EmailAddresses...)
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+	return names
+}
+
+func matches(subject, name string) bool {
+	if strings.EqualFold(subject, name) {
+		return true
+	}
+	if suffix := strings.TrimPrefix(subject, "*"); suffix != subject && strings.HasPrefix(suffix, ".") {
+		return len(name) > len(suffix) && strings.HasSuffix(strings.ToLower(name), strings.ToLower(suffix))
+	}
+	return false
+}
+
+// Match returns the first mapping matching a name of the certificate along with that name, nil when the
+// certificate isn't mapped
+func Match(mappings []Mapping, cert *x509.Certificate) (*Mapping, string) {
+	names := Names(cert)
+	for i := range mappings {
+		for _, name := range names {
+			if matches(mappings[i].Subject, name) {
+				return &mappings[i], name
+			}
+		}
+	}
+	return nil, ""
+}
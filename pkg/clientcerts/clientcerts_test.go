@@ -0,0 +1,109 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package clientcerts
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClientAuth(t *testing.T) {
+	for mode, want := range map[string]tls.ClientAuthType{
+		"":           tls.NoClientCert,
+		ModeOff:      tls.NoClientCert,
+		ModeOptional: tls.VerifyClientCertIfGiven,
+		ModeRequire:  tls.RequireAndVerifyClientCert,
+	} {
+		got, err := ClientAuth(mode)
+		if err != nil || got != want {
+			t.Errorf("%q: expected %v, got %v %v", mode, want, got, err)
+		}
+	}
+	if _, err := ClientAuth("strict"); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("expected an unknown mode to be rejected, got %v", err)
+	}
+}
+
+func TestLoadMappings(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "mappings.json")
+	write := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(`[
+		{"subject": "backup.example.com", "accessKey": "backup", "secretKey": "backup-secret"},
+		{"subject": "*.monitoring.example.com", "policy": {"Version": "2012-10-17", "Statement": []}}
+	]`)
+	mappings, err := LoadMappings(path, "console", "console-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mappings) != 2 || mappings[0].AccessKey != "backup" || mappings[1].AccessKey != "console" || mappings[1].SecretKey != "console-secret" {
+		t.Fatalf("unexpected mappings %+v", mappings)
+	}
+
+	if _, err = LoadMappings(path, "", ""); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("expected a mapping without credentials to be rejected, got %v", err)
+	}
+	write(`[{"accessKey": "backup", "secretKey": "backup-secret"}]`)
+	if _, err = LoadMappings(path, "", ""); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("expected a mapping without subject to be rejected, got %v", err)
+	}
+	if mappings, err = LoadMappings("", "", ""); err != nil || mappings != nil {
+		t.Errorf("expected no mappings without a file, got %v %v", mappings, err)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	mappings := []Mapping{
+		{Subject: "backup.example.com", AccessKey: "backup"},
+		{Subject: "*.monitoring.example.com", AccessKey: "monitoring"},
+		{Subject: "spiffe://example.com/ingest", AccessKey: "ingest"},
+	}
+	spiffe, _ := url.Parse("spiffe://example.com/ingest")
+	for _, test := range []struct {
+		cert *x509.Certificate
+		want string
+		name string
+	}{
+		{cert: &x509.Certificate{Subject: pkix.Name{CommonName: "Backup.example.com"}}, want: "backup", name: "Backup.example.com"},
+		{cert: &x509.Certificate{Subject: pkix.Name{CommonName: "node"}, DNSNames: []string{"node1.monitoring.example.com"}}, want: "monitoring", name: "node1.monitoring.example.com"},
+		{cert: &x509.Certificate{URIs: []*url.URL{spiffe}}, want: "ingest", name: "spiffe://example.com/ingest"},
+		{cert: &x509.Certificate{DNSNames: []string{"monitoring.example.com"}}},
+		{cert: &x509.Certificate{Subject: pkix.Name{CommonName: "laptop"}}},
+	} {
+		mapping, name := Match(mappings, test.cert)
+		if test.want == "" {
+			if mapping != nil {
+				t.Errorf("expected %v not to be mapped, got %+v", Names(test.cert), mapping)
+			}
+			continue
+		}
+		if mapping == nil || mapping.AccessKey != test.want || name != test.name {
+			t.Errorf("expected %v to be mapped to %s by %s, got %+v by %s", Names(test.cert), test.want, test.name, mapping, name)
+		}
+	}
+}
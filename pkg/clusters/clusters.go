@@ -0,0 +1,283 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package clusters keeps the registry of the MinIO deployments a Console instance manages besides the one it is
+// configured with. A cluster is either reached with credentials registered along with it, kept encrypted, or
+// with the STS credentials its own users log in with.
+package clusters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+)
+
+// DefaultID is the cluster Console is configured with, it can't be registered
+const DefaultID = "default"
+
+// Ways to authenticate to a cluster
+const (
+	// AuthCredentials uses the credentials registered with the cluster for the users it allows
+	AuthCredentials = "credentials"
+	// AuthSTS logs the users in to the cluster with their own credentials
+	AuthSTS = "sts"
+)
+
+var (
+	// ErrInvalid is returned for a cluster that can't be registered
+	ErrInvalid = errors.New("invalid cluster")
+	// ErrNotFound is returned when no cluster has the requested id
+	ErrNotFound = errors.New("cluster not found")
+)
+
+var idRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// Cipher protects the secret keys in the persisted registry, id is the cluster the secret key belongs to
+type Cipher interface {
+	Encrypt(plaintext []byte, id string) (string, error)
+	Decrypt(ciphertext, id string) ([]byte, error)
+}
+
+// plainCipher keeps the secret keys as they are, it is used when the registry isn't given a cipher
+type plainCipher struct{}
+
+func (plainCipher) Encrypt(plaintext []byte, _ string) (string, error) {
+	return string(plaintext), nil
+}
+
+func (plainCipher) Decrypt(ciphertext, _ string) ([]byte, error) {
+	return []byte(ciphertext), nil
+}
+
+// Cluster is a registered MinIO deployment
+type Cluster struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Endpoint string `json:"endpoint"`
+	Auth     string `json:"auth"`
+	// AccessKey and SecretKey, encrypted, are the credentials of the clusters using AuthCredentials
+	AccessKey string `json:"accessKey,omitempty"`
+	SecretKey string `json:"secretKey,omitempty"`
+	// AllowedUsers are the access keys of the Console users allowed to use the registered credentials
+	AllowedUsers []string  `json:"allowedUsers,omitempty"`
+	Created      time.Time `json:"created"`
+}
+
+// Allows returns whether the user with the access key can use the cluster with the registered credentials
+func (c Cluster) Allows(accessKey string) bool {
+	if c.Auth != AuthCredentials || accessKey == "" {
+		return false
+	}
+	for _, user := range c.AllowedUsers {
+		if user == accessKey || user == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// public returns a copy of the cluster without its secret key
+func (c *Cluster) public() Cluster {
+	p := *c
+	p.SecretKey = ""
+	p.AllowedUsers = append([]string{}, c.AllowedUsers...)
+	return p
+}
+
+// validate checks the cluster can be registered
+func (c Cluster) validate() error {
+	if !idRegexp.MatchString(c.ID) || c.ID == DefaultID {
+		return fmt.Errorf("%w: the id has to be lowercase letters, digits and dashes, other than %s", ErrInvalid, DefaultID)
+	}
+	if strings.TrimSpace(c.Name) == "" {
+		return fmt.Errorf("%w: the name can't be empty", ErrInvalid)
+	}
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || (u.Path != "" && u.Path != "/") {
+		return fmt.Errorf("%w: the endpoint has to be the http or https URL of the cluster", ErrInvalid)
+	}
+	switch c.Auth {
+	case AuthCredentials:
+		if c.AccessKey == "" {
+			return fmt.Errorf("%w: the access key can't be empty", ErrInvalid)
+		}
+	case AuthSTS:
+	default:
+		return fmt.Errorf("%w: unknown auth %q", ErrInvalid, c.Auth)
+	}
+	return nil
+}
+
+// Registry holds the registered clusters, optionally persisted to a file
+type Registry struct {
+	path   string
+	cipher Cipher
+
+	mu       sync.Mutex
+	clusters map[string]*Cluster
+}
+
+// New creates a registry protecting the secret keys with cipher, they are kept as they are when it is nil. When
+// path isn't empty the clusters are loaded from and saved to that file, a missing file is an empty registry.
+func New(path string, cipher Cipher) (*Registry, error) {
+	if cipher == nil {
+		cipher = plainCipher{}
+	}
+	r := &Registry{path: path, cipher: cipher, clusters: map[string]*Cluster{}}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(data, &r.clusters); err != nil {
+				return nil, fmt.Errorf("invalid clusters file %s: %w", path, err)
+			}
+		}
+	}
+	return r, nil
+}
+
+// List returns the registered clusters sorted by name
+func (r *Registry) List() []Cluster {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clusters := []Cluster{}
+	for _, c := range r.clusters {
+		clusters = append(clusters, c.public())
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Name == clusters[j].Name {
+			return clusters[i].ID < clusters[j].ID
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters
+}
+
+// Get returns the cluster with the id
+func (r *Registry) Get(id string) (Cluster, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.clusters[id]
+	if !ok {
+		return Cluster{}, ErrNotFound
+	}
+	return c.public(), nil
+}
+
+// Credentials returns the cluster with the id along with its decrypted secret key
+func (r *Registry) Credentials(id string) (Cluster, string, error) {
+	r.mu.Lock()
+	c, ok := r.clusters[id]
+	var cluster Cluster
+	if ok {
+		cluster = *c
+	}
+	r.mu.Unlock()
+	if !ok {
+		return Cluster{}, "", ErrNotFound
+	}
+	if cluster.Auth != AuthCredentials {
+		return cluster.public(), "", nil
+	}
+	secretKey, err := r.cipher.Decrypt(cluster.SecretKey, cluster.ID)
+	if err != nil {
+		return Cluster{}, "", err
+	}
+	return cluster.public(), string(secretKey), nil
+}
+
+// Set registers the cluster or updates the registered one. An empty secret key keeps the secret key of the
+// registered cluster.
+func (r *Registry) Set(c Cluster, secretKey string, now time.Time) (Cluster, error) {
+	c.Endpoint = strings.TrimSuffix(c.Endpoint, "/")
+	if err := c.validate(); err != nil {
+		return Cluster{}, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	previous, exists := r.clusters[c.ID]
+	c.Created = now
+	if exists {
+		c.Created = previous.Created
+	}
+	c.SecretKey = ""
+	if c.Auth == AuthCredentials {
+		switch {
+		case secretKey != "":
+			encrypted, err := r.cipher.Encrypt([]byte(secretKey), c.ID)
+			if err != nil {
+				return Cluster{}, err
+			}
+			c.SecretKey = encrypted
+		case exists && previous.Auth == AuthCredentials && previous.AccessKey == c.AccessKey:
+			c.SecretKey = previous.SecretKey
+		default:
+			return Cluster{}, fmt.Errorf("%w: the secret key can't be empty", ErrInvalid)
+		}
+	} else {
+		c.AccessKey = ""
+		c.AllowedUsers = nil
+	}
+	r.clusters[c.ID] = &c
+	if err := r.save(); err != nil {
+		if exists {
+			r.clusters[c.ID] = previous
+		} else {
+			delete(r.clusters, c.ID)
+		}
+		return Cluster{}, err
+	}
+	return c.public(), nil
+}
+
+// Remove unregisters the cluster with the id
+func (r *Registry) Remove(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.clusters[id]
+	if !ok {
+		return ErrNotFound
+	}
+	delete(r.clusters, id)
+	if err := r.save(); err != nil {
+		r.clusters[id] = c
+		return err
+	}
+	return nil
+}
+
+// save writes the clusters to the file of the registry, the caller holds the lock
+func (r *Registry) save() error {
+	if r.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(r.clusters)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(r.path, data)
+}
@@ -0,0 +1,136 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package clusters
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// reverseCipher reverses the secret keys, enough to tell they don't reach the file as they are
+type reverseCipher struct{}
+
+func reverse(s string) string {
+	b := []byte(s)
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return string(b)
+}
+
+func (reverseCipher) Encrypt(plaintext []byte, id string) (string, error) {
+	return id + ":" + reverse(string(plaintext)), nil
+}
+
+func (reverseCipher) Decrypt(ciphertext, id string) ([]byte, error) {
+	value, ok := strings.CutPrefix(ciphertext, id+":")
+	if !ok {
+		return nil, errors.New("secret of another cluster")
+	}
+	return []byte(reverse(value)), nil
+}
+
+func TestRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clusters.json")
+	registry, err := New(path, reverseCipher{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+
+	invalid := []Cluster{
+		{ID: DefaultID, Name: "Default", Endpoint: "https://minio:9000", Auth: AuthSTS},
+		{ID: "Prod", Name: "Prod", Endpoint: "https://minio:9000", Auth: AuthSTS},
+		{ID: "prod", Name: " ", Endpoint: "https://minio:9000", Auth: AuthSTS},
+		{ID: "prod", Name: "Prod", Endpoint: "minio:9000", Auth: AuthSTS},
+		{ID: "prod", Name: "Prod", Endpoint: "https://minio:9000/console", Auth: AuthSTS},
+		{ID: "prod", Name: "Prod", Endpoint: "https://minio:9000", Auth: "ldap"},
+		{ID: "prod", Name: "Prod", Endpoint: "https://minio:9000", Auth: AuthCredentials},
+	}
+	for _, c := range invalid {
+		if _, err := registry.Set(c, "secret", now); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%+v returned %v", c, err)
+		}
+	}
+	if _, err := registry.Set(Cluster{ID: "prod", Name: "Prod", Endpoint: "https://minio:9000", Auth: AuthCredentials, AccessKey: "console"}, "", now); !errors.Is(err, ErrInvalid) {
+		t.Errorf("a new cluster without a secret key returned %v", err)
+	}
+
+	prod, err := registry.Set(Cluster{
+		ID: "prod", Name: "Prod", Endpoint: "https://minio:9000/", Auth: AuthCredentials,
+		AccessKey: "console", AllowedUsers: []string{"alice"},
+	}, "secret", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prod.Endpoint != "https://minio:9000" || prod.SecretKey != "" || !prod.Created.Equal(now) {
+		t.Errorf("unexpected cluster %+v", prod)
+	}
+	if !prod.Allows("alice") || prod.Allows("bob") || prod.Allows("") {
+		t.Errorf("unexpected allowed users %v", prod.AllowedUsers)
+	}
+	if _, err := registry.Set(Cluster{ID: "edge", Name: "Edge", Endpoint: "http://edge:9000", Auth: AuthSTS, AccessKey: "ignored"}, "ignored", now); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), `"secret"`) || strings.Contains(string(data), "ignored") {
+		t.Errorf("the secret keys were saved as they are: %s", data)
+	}
+
+	// updating the cluster without a secret key keeps the registered one
+	if _, err := registry.Set(Cluster{ID: "prod", Name: "Production", Endpoint: "https://minio:9000", Auth: AuthCredentials, AccessKey: "console"}, "", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := New(path, reverseCipher{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusters := reloaded.List()
+	if len(clusters) != 2 || clusters[0].ID != "edge" || clusters[1].Name != "Production" {
+		t.Fatalf("unexpected clusters %+v", clusters)
+	}
+	cluster, secretKey, err := reloaded.Credentials("prod")
+	if err != nil || secretKey != "secret" || cluster.AccessKey != "console" || !cluster.Created.Equal(now) {
+		t.Errorf("unexpected credentials %+v %q %v", cluster, secretKey, err)
+	}
+	if cluster.Allows("alice") {
+		t.Error("the update didn't replace the allowed users")
+	}
+	edge, secretKey, err := reloaded.Credentials("edge")
+	if err != nil || secretKey != "" || edge.AccessKey != "" {
+		t.Errorf("a STS cluster returned %+v %q %v", edge, secretKey, err)
+	}
+
+	if err := reloaded.Remove("prod"); err != nil {
+		t.Fatal(err)
+	}
+	if err := reloaded.Remove("prod"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("removing a missing cluster returned %v", err)
+	}
+	if _, err := reloaded.Get("prod"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("getting a removed cluster returned %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package confighistory keeps the previous configurations of the MinIO subsystems Console changes, so a change can
+// be rolled back. MinIO only keeps the current configuration.
+package confighistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+)
+
+// Actions of a revision
+const (
+	ActionSet   = "set"
+	ActionReset = "reset"
+)
+
+// Revision is a change of the configuration of a subsystem target, such as `notify_webhook:primary`
+type Revision struct {
+	ID     int64     `json:"id"`
+	Target string    `json:"target"`
+	Time   time.Time `json:"time"`
+	User   string    `json:"user,omitempty"`
+	Action string    `json:"action"`
+	// Previous is the configuration of the target before the change, empty when it wasn't configured
+	Previous string `json:"previous"`
+	// Config is the configuration the change applied, empty for a reset
+	Config string `json:"config,omitempty"`
+	// RollbackOf is the revision the change rolled back
+	RollbackOf int64 `json:"rollbackOf,omitempty"`
+}
+
+// historyFile is the content of the history file
+type historyFile struct {
+	Next      int64      `json:"next"`
+	Revisions []Revision `json:"revisions"`
+}
+
+// Store holds the revisions of every target, oldest first, optionally persisted to a file
+type Store struct {
+	path  string
+	limit int
+
+	mu        sync.Mutex
+	next      int64
+	revisions []Revision
+}
+
+// New creates a store keeping the last limit revisions of every target. When path isn't empty the revisions are
+// loaded from and saved to that file, a missing file is an empty history.
+func New(path string, limit int) (*Store, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("invalid config history limit %d", limit)
+	}
+	s := &Store{path: path, limit: limit, next: 1}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	var file historyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid config history file %s: %w", path, err)
+	}
+	s.revisions = file.Revisions
+	s.next = file.Next
+	for _, rev := range s.revisions {
+		if rev.ID >= s.next {
+			s.next = rev.ID + 1
+		}
+	}
+	return s, nil
+}
+
+// Record adds a revision, its ID is assigned by the store. The oldest revisions of the target past the limit are
+// dropped.
+func (s *Store) Record(rev Revision) (Revision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rev.ID = s.next
+	rev.Time = rev.Time.UTC()
+	s.next++
+	s.revisions = append(s.revisions, rev)
+	count := 0
+	for i := len(s.revisions) - 1; i >= 0; i-- {
+		if s.revisions[i].Target != rev.Target {
+			continue
+		}
+		count++
+		if count > s.limit {
+			s.revisions = append(s.revisions[:i], s.revisions[i+1:]...)
+		}
+	}
+	return rev, s.save()
+}
+
+// save writes the revisions to the file of the store, the caller holds the lock
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(historyFile{Next: s.next, Revisions: s.revisions})
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(s.path, data)
+}
+
+// List returns the revisions of a target, or of every target when it is empty, newest first
+func (s *Store) List(target string) []Revision {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	revisions := []Revision{}
+	for i := len(s.revisions) - 1; i >= 0; i-- {
+		if target == "" || s.revisions[i].Target == target {
+			revisions = append(revisions, s.revisions[i])
+		}
+	}
+	return revisions
+}
+
+// Get returns a revision by ID
+func (s *Store) Get(id int64) (Revision, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, rev := range s.revisions {
+		if rev.ID == id {
+			return rev, true
+		}
+	}
+	return Revision{}, false
+}
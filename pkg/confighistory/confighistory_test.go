@@ -0,0 +1,81 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package confighistory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config-history.json")
+	store, err := New(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i, config := range []string{"region name=us-east-1", "region name=us-west-1", "region name=eu-west-1"} {
+		rev, err := store.Record(Revision{Target: "region", Time: now.Add(time.Duration(i) * time.Minute), Action: ActionSet, Config: config})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rev.ID != int64(i+1) {
+			t.Fatalf("expected revision %d, got %d", i+1, rev.ID)
+		}
+	}
+	if _, err = store.Record(Revision{Target: "notify_webhook:primary", Time: now, Action: ActionReset, Previous: "notify_webhook:primary endpoint=https://hooks"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// only the last revisions of a target are kept, newest first
+	revisions := store.List("region")
+	if len(revisions) != 2 || revisions[0].ID != 3 || revisions[1].ID != 2 {
+		t.Fatalf("expected revisions 3 and 2 of region, got %+v", revisions)
+	}
+	if _, ok := store.Get(1); ok {
+		t.Fatal("expected the first revision to be dropped")
+	}
+	if all := store.List(""); len(all) != 3 || all[0].Target != "notify_webhook:primary" {
+		t.Fatalf("expected the revisions of every target, got %+v", all)
+	}
+
+	// the history survives a restart, and the IDs keep growing past the dropped revisions
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected the history file to be private, got %v", info.Mode().Perm())
+	}
+	reloaded, err := New(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rev, ok := reloaded.Get(4)
+	if !ok || rev.Previous != "notify_webhook:primary endpoint=https://hooks" {
+		t.Fatalf("expected revision 4 after reload, got %+v", rev)
+	}
+	if rev, err = reloaded.Record(Revision{Target: "region", Time: now, Action: ActionSet}); err != nil || rev.ID != 5 {
+		t.Fatalf("expected revision 5, got %d: %v", rev.ID, err)
+	}
+
+	if _, err = New(path, 0); err == nil {
+		t.Fatal("expected an invalid limit to fail")
+	}
+}
@@ -0,0 +1,178 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package cspreport parses the Content-Security-Policy violation reports browsers send and keeps the most
+// recent ones.
+package cspreport
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"sync"
+	"time"
+)
+
+// MaxBodySize bounds the body of a report request, browsers send a few kilobytes at most
+const MaxBodySize = 64 << 10
+
+// ErrInvalidReport is returned for a body that is neither a report-uri nor a Reporting API report
+var ErrInvalidReport = errors.New("invalid CSP report")
+
+// Report is a violation of the policy, in the same form whichever way the browser reported it
+type Report struct {
+	Received           time.Time
+	DocumentURI        string
+	Referrer           string
+	BlockedURI         string
+	ViolatedDirective  string
+	EffectiveDirective string
+	OriginalPolicy     string
+	Disposition        string
+	SourceFile         string
+	Sample             string
+	LineNumber         int64
+	ColumnNumber       int64
+	StatusCode         int64
+	SourceIP           string
+	UserAgent          string
+}
+
+// legacyReport is the body browsers POST to the report-uri directive as application/csp-report
+type legacyReport struct {
+	Report struct {
+		DocumentURI        string `json:"document-uri"`
+		Referrer           string `json:"referrer"`
+		BlockedURI         string `json:"blocked-uri"`
+		ViolatedDirective  string `json:"violated-directive"`
+		EffectiveDirective string `json:"effective-directive"`
+		OriginalPolicy     string `json:"original-policy"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"source-file"`
+		ScriptSample       string `json:"script-sample"`
+		LineNumber         int64  `json:"line-number"`
+		ColumnNumber       int64  `json:"column-number"`
+		StatusCode         int64  `json:"status-code"`
+	} `json:"csp-report"`
+}
+
+// reportingAPIReport is an entry of the array browsers POST to the report-to endpoints as
+// application/reports+json
+type reportingAPIReport struct {
+	Type      string `json:"type"`
+	UserAgent string `json:"user_agent"`
+	Body      struct {
+		DocumentURL        string `json:"documentURL"`
+		Referrer           string `json:"referrer"`
+		BlockedURL         string `json:"blockedURL"`
+		EffectiveDirective string `json:"effectiveDirective"`
+		OriginalPolicy     string `json:"originalPolicy"`
+		Disposition        string `json:"disposition"`
+		SourceFile         string `json:"sourceFile"`
+		Sample             string `json:"sample"`
+		LineNumber         int64  `json:"lineNumber"`
+		ColumnNumber       int64  `json:"columnNumber"`
+		StatusCode         int64  `json:"statusCode"`
+	} `json:"body"`
+}
+
+// Parse returns the violations of a report body of the content type, the entries of Reporting API bodies
+// that aren't CSP violations are skipped
+func Parse(contentType string, body []byte) ([]Report, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/reports+json" {
+		var entries []reportingAPIReport
+		if err := json.Unmarshal(body, &entries); err != nil {
+			return nil, ErrInvalidReport
+		}
+		reports := []Report{}
+		for _, entry := range entries {
+			if entry.Type != "csp-violation" {
+				continue
+			}
+			reports = append(reports, Report{
+				DocumentURI:        entry.Body.DocumentURL,
+				Referrer:           entry.Body.Referrer,
+				BlockedURI:         entry.Body.BlockedURL,
+				ViolatedDirective:  entry.Body.EffectiveDirective,
+				EffectiveDirective: entry.Body.EffectiveDirective,
+				OriginalPolicy:     entry.Body.OriginalPolicy,
+				Disposition:        entry.Body.Disposition,
+				SourceFile:         entry.Body.SourceFile,
+				Sample:             entry.Body.Sample,
+				LineNumber:         entry.Body.LineNumber,
+				ColumnNumber:       entry.Body.ColumnNumber,
+				StatusCode:         entry.Body.StatusCode,
+				UserAgent:          entry.UserAgent,
+			})
+		}
+		return reports, nil
+	}
+	var legacy legacyReport
+	if err := json.Unmarshal(body, &legacy); err != nil || legacy.Report.DocumentURI == "" {
+		return nil, ErrInvalidReport
+	}
+	r := legacy.Report
+	return []Report{{
+		DocumentURI:        r.DocumentURI,
+		Referrer:           r.Referrer,
+		BlockedURI:         r.BlockedURI,
+		ViolatedDirective:  r.ViolatedDirective,
+		EffectiveDirective: r.EffectiveDirective,
+		OriginalPolicy:     r.OriginalPolicy,
+		Disposition:        r.Disposition,
+		SourceFile:         r.SourceFile,
+		Sample:             r.ScriptSample,
+		LineNumber:         r.LineNumber,
+		ColumnNumber:       r.ColumnNumber,
+		StatusCode:         r.StatusCode,
+	}}, nil
+}
+
+// Store keeps the most recent reports, the older ones are dropped first
+type Store struct {
+	mu      sync.Mutex
+	max     int
+	reports []Report
+	total   int64
+}
+
+// NewStore returns a store keeping up to max reports
+func NewStore(max int) *Store {
+	return &Store{max: max}
+}
+
+// Add records the reports
+func (s *Store) Add(reports ...Report) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.total += int64(len(reports))
+	s.reports = append(s.reports, reports...)
+	if len(s.reports) > s.max {
+		s.reports = append([]Report(nil), s.reports[len(s.reports)-s.max:]...)
+	}
+}
+
+// List returns the kept reports, the most recent first, along with how many were received in total
+func (s *Store) List() ([]Report, int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reports := make([]Report, 0, len(s.reports))
+	for i := len(s.reports) - 1; i >= 0; i-- {
+		reports = append(reports, s.reports[i])
+	}
+	return reports, s.total
+}
@@ -0,0 +1,74 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cspreport
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	reports, err := Parse("application/csp-report", []byte(`{"csp-report": {
+		"document-uri": "https://console.example.com/browser",
+		"blocked-uri": "https://cdn.example.net/app.js",
+		"violated-directive": "script-src-elem",
+		"effective-directive": "script-src-elem",
+		"original-policy": "default-src 'self'; report-uri /api/v1/csp-report",
+		"disposition": "report",
+		"line-number": 12,
+		"status-code": 200
+	}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || reports[0].BlockedURI != "https://cdn.example.net/app.js" || reports[0].LineNumber != 12 || reports[0].Disposition != "report" {
+		t.Fatalf("unexpected reports %+v", reports)
+	}
+
+	reports, err = Parse("application/reports+json; charset=utf-8", []byte(`[
+		{"type": "csp-violation", "user_agent": "Mozilla/5.0", "body": {
+			"documentURL": "https://console.example.com/",
+			"blockedURL": "inline",
+			"effectiveDirective": "style-src-attr",
+			"disposition": "enforce",
+			"sample": "color: red"
+		}},
+		{"type": "deprecation", "body": {}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 || reports[0].BlockedURI != "inline" || reports[0].ViolatedDirective != "style-src-attr" || reports[0].UserAgent != "Mozilla/5.0" {
+		t.Fatalf("unexpected reports %+v", reports)
+	}
+
+	for _, body := range []string{`{"hello": "world"}`, `not json`} {
+		if _, err = Parse("application/csp-report", []byte(body)); !errors.Is(err, ErrInvalidReport) {
+			t.Errorf("expected %s to be rejected, got %v", body, err)
+		}
+	}
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(2)
+	store.Add(Report{BlockedURI: "a"})
+	store.Add(Report{BlockedURI: "b"}, Report{BlockedURI: "c"})
+	reports, total := store.List()
+	if total != 3 || len(reports) != 2 || reports[0].BlockedURI != "c" || reports[1].BlockedURI != "b" {
+		t.Fatalf("expected the two most recent of 3 reports, got %d %+v", total, reports)
+	}
+}
@@ -0,0 +1,183 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package kms seals the secrets Console keeps, such as its session keys and the client secrets of its OpenID
+// providers, with a key of a KMS Console never sees.
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/minio/kes"
+)
+
+// sealedPrefix starts the sealed values, followed by the base64 ciphertext of the KMS
+const sealedPrefix = "kms:"
+
+// checkContext is the context of the probe encrypted by Check
+var checkContext = []byte("console-kms-check")
+
+// ErrNoKMS is returned when unsealing a value without a KMS
+var ErrNoKMS = errors.New("the value is sealed by a KMS but no KMS is configured")
+
+// KMS encrypts and decrypts with a key it keeps, the context is authenticated along the ciphertext
+type KMS interface {
+	Encrypt(ctx context.Context, plaintext, context []byte) ([]byte, error)
+	Decrypt(ctx context.Context, ciphertext, context []byte) ([]byte, error)
+	String() string
+}
+
+// KESConfig is how to reach a KES server and which of its keys seals the secrets
+type KESConfig struct {
+	Endpoints []string
+	KeyName   string
+	// CertFile and KeyFile are the client certificate Console is identified with
+	CertFile string
+	KeyFile  string
+	RootCAs  *x509.CertPool
+}
+
+// KES is a KMS backed by a KES server
+type KES struct {
+	client  *kes.Client
+	keyName string
+}
+
+// NewKES returns a KMS encrypting with the key of a KES server
+func NewKES(config KESConfig) (*KES, error) {
+	if len(config.Endpoints) == 0 || config.KeyName == "" {
+		return nil, errors.New("the KES endpoint and key name are required")
+	}
+	cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load the KES client certificate: %w", err)
+	}
+	client := kes.NewClientWithConfig("", &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      config.RootCAs,
+	})
+	client.Endpoints = config.Endpoints
+	return &KES{client: client, keyName: config.KeyName}, nil
+}
+
+// Encrypt implements KMS
+func (k *KES) Encrypt(ctx context.Context, plaintext, context []byte) ([]byte, error) {
+	return k.client.Encrypt(ctx, k.keyName, plaintext, context)
+}
+
+// Decrypt implements KMS
+func (k *KES) Decrypt(ctx context.Context, ciphertext, context []byte) ([]byte, error) {
+	return k.client.Decrypt(ctx, k.keyName, ciphertext, context)
+}
+
+// String implements KMS
+func (k *KES) String() string {
+	return fmt.Sprintf("KES %s key %s", strings.Join(k.client.Endpoints, ","), k.keyName)
+}
+
+// Check verifies the KMS is reachable and can encrypt and decrypt with its key
+func Check(ctx context.Context, k KMS) error {
+	probe := make([]byte, 32)
+	if _, err := rand.Read(probe); err != nil {
+		return err
+	}
+	ciphertext, err := k.Encrypt(ctx, probe, checkContext)
+	if err != nil {
+		return fmt.Errorf("%s can't encrypt: %w", k, err)
+	}
+	plaintext, err := k.Decrypt(ctx, ciphertext, checkContext)
+	if err != nil {
+		return fmt.Errorf("%s can't decrypt: %w", k, err)
+	}
+	if !bytes.Equal(plaintext, probe) {
+		return fmt.Errorf("%s decrypted a different value", k)
+	}
+	return nil
+}
+
+// IsSealed returns whether value was sealed by a KMS
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+// Seal returns plaintext encrypted by the KMS as a value Unseal decrypts with the same context
+func Seal(ctx context.Context, k KMS, plaintext, context []byte) (string, error) {
+	ciphertext, err := k.Encrypt(ctx, plaintext, context)
+	if err != nil {
+		return "", err
+	}
+	return sealedPrefix + base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Unseal returns the plaintext of a sealed value, the values that aren't sealed are returned as they are
+func Unseal(ctx context.Context, k KMS, value string, context []byte) ([]byte, error) {
+	if !IsSealed(value) {
+		return []byte(value), nil
+	}
+	if k == nil {
+		return nil, ErrNoKMS
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, sealedPrefix))
+	if err != nil {
+		return nil, fmt.Errorf("malformed sealed value: %w", err)
+	}
+	return k.Decrypt(ctx, ciphertext, context)
+}
+
+// Sealer seals and unseals whole files with a KMS, bounding every call with a timeout
+type Sealer struct {
+	kms     KMS
+	context []byte
+	timeout time.Duration
+}
+
+// NewSealer returns a Sealer encrypting with k and authenticating context
+func NewSealer(k KMS, context string, timeout time.Duration) *Sealer {
+	return &Sealer{kms: k, context: []byte(context), timeout: timeout}
+}
+
+// Seal returns the sealed data
+func (s *Sealer) Seal(data []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	sealed, err := Seal(ctx, s.kms, data, s.context)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(sealed), nil
+}
+
+// Unseal returns the data of sealed, data that isn't sealed yet is returned as it is
+func (s *Sealer) Unseal(sealed []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	return Unseal(ctx, s.kms, string(bytes.TrimSpace(sealed)), s.context)
+}
+
+// IsSealed returns whether data was sealed
+func (s *Sealer) IsSealed(data []byte) bool {
+	return IsSealed(string(bytes.TrimSpace(data)))
+}
@@ -0,0 +1,127 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// localKMS encrypts with AES-GCM and a key in memory, standing for a KES server
+type localKMS struct {
+	aead   cipher.AEAD
+	broken bool
+}
+
+func newLocalKMS(t *testing.T) *localKMS {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	assert.NoError(t, err)
+	block, err := aes.NewCipher(key)
+	assert.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	assert.NoError(t, err)
+	return &localKMS{aead: aead}
+}
+
+func (k *localKMS) Encrypt(_ context.Context, plaintext, context []byte) ([]byte, error) {
+	if k.broken {
+		return nil, errors.New("connection refused")
+	}
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return k.aead.Seal(nonce, nonce, plaintext, context), nil
+}
+
+func (k *localKMS) Decrypt(_ context.Context, ciphertext, context []byte) ([]byte, error) {
+	if k.broken {
+		return nil, errors.New("connection refused")
+	}
+	if len(ciphertext) < k.aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce := ciphertext[:k.aead.NonceSize()]
+	return k.aead.Open(nil, nonce, ciphertext[k.aead.NonceSize():], context)
+}
+
+func (k *localKMS) String() string {
+	return "local"
+}
+
+func TestSealUnseal(t *testing.T) {
+	funcAssert := assert.New(t)
+	ctx := context.Background()
+	k := newLocalKMS(t)
+
+	// Test-1 : a sealed value unseals with the same context only
+	sealed, err := Seal(ctx, k, []byte("client-secret"), []byte("openid"))
+	funcAssert.NoError(err)
+	funcAssert.True(IsSealed(sealed))
+	plaintext, err := Unseal(ctx, k, sealed, []byte("openid"))
+	funcAssert.NoError(err)
+	funcAssert.Equal("client-secret", string(plaintext))
+	_, err = Unseal(ctx, k, sealed, []byte("pbkdf"))
+	funcAssert.Error(err)
+
+	// Test-2 : values that aren't sealed are returned as they are, with or without a KMS
+	plaintext, err = Unseal(ctx, nil, "client-secret", nil)
+	funcAssert.NoError(err)
+	funcAssert.Equal("client-secret", string(plaintext))
+
+	// Test-3 : sealed values need a KMS
+	_, err = Unseal(ctx, nil, sealed, []byte("openid"))
+	funcAssert.Equal(ErrNoKMS, err)
+	_, err = Unseal(ctx, k, "kms:not base64", nil)
+	funcAssert.Error(err)
+}
+
+func TestCheck(t *testing.T) {
+	k := newLocalKMS(t)
+	assert.NoError(t, Check(context.Background(), k))
+	k.broken = true
+	assert.Error(t, Check(context.Background(), k))
+}
+
+func TestSealer(t *testing.T) {
+	funcAssert := assert.New(t)
+	sealer := NewSealer(newLocalKMS(t), "console-session-keys", time.Second)
+	data := []byte(`{"keys": []}`)
+
+	sealed, err := sealer.Seal(data)
+	funcAssert.NoError(err)
+	funcAssert.True(sealer.IsSealed(sealed))
+	funcAssert.False(bytes.Contains(sealed, []byte("keys")))
+	unsealed, err := sealer.Unseal(append(sealed, '\n'))
+	funcAssert.NoError(err)
+	funcAssert.Equal(data, unsealed)
+
+	// data written before the sealer was set stays readable
+	funcAssert.False(sealer.IsSealed(data))
+	unsealed, err = sealer.Unseal(data)
+	funcAssert.NoError(err)
+	funcAssert.Equal(data, unsealed)
+}
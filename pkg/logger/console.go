@@ -17,9 +17,11 @@
 package logger
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,22 +32,104 @@ import (
 
 // Logger interface describes the methods that need to be implemented to satisfy the interface requirements.
 type Logger interface {
-	json(msg string, args ...interface{})
-	quiet(msg string, args ...interface{})
-	pretty(msg string, args ...interface{})
+	json(req *ReqInfo, msg string, args ...interface{})
+	logfmt(req *ReqInfo, msg string, args ...interface{})
+	quiet(req *ReqInfo, msg string, args ...interface{})
+	pretty(req *ReqInfo, msg string, args ...interface{})
 }
 
-func consoleLog(console Logger, msg string, args ...interface{}) {
+func consoleLog(console Logger, req *ReqInfo, msg string, args ...interface{}) {
 	switch {
 	case jsonFlag:
 		// Strip escape control characters from json message
 		msg = ansiRE.ReplaceAllLiteralString(msg, "")
-		console.json(msg, args...)
+		console.json(req, msg, args...)
+	case logfmtFlag:
+		msg = ansiRE.ReplaceAllLiteralString(msg, "")
+		console.logfmt(req, msg, args...)
 	case quietFlag:
-		console.quiet(msg+"\n", args...)
+		console.quiet(req, msg+requestSuffix(req)+"\n", args...)
 	default:
-		console.pretty(msg+"\n", args...)
+		console.pretty(req, msg+requestSuffix(req)+"\n", args...)
+	}
+}
+
+// requestSuffix returns what is appended to the text messages logged for a request to correlate them
+func requestSuffix(req *ReqInfo) string {
+	if req == nil || req.RequestID == "" {
+		return ""
+	}
+	return " (requestID=" + strings.ReplaceAll(req.RequestID, "%", "%%") + ")"
+}
+
+// newEntry returns the entry of a message printed on the console, with the request it is logged for
+func newEntry(level Level, req *ReqInfo, msg string, args ...interface{}) log.Entry {
+	var message string
+	if msg != "" {
+		message = fmt.Sprintf(msg, args...)
+	} else {
+		message = fmt.Sprint(args...)
 	}
+	entry := log.Entry{
+		Level:   level.String(),
+		Message: message,
+		Time:    time.Now().UTC(),
+	}
+	if req != nil {
+		entry.RequestID = req.RequestID
+		entry.SessionID = req.SessionID
+		entry.RemoteHost = req.RemoteHost
+		if anonFlag {
+			if entry.SessionID != "" {
+				entry.SessionID = hashString(entry.SessionID)
+			}
+			if entry.RemoteHost != "" {
+				entry.RemoteHost = hashString(entry.RemoteHost)
+			}
+		}
+	}
+	return entry
+}
+
+func printJSON(entry log.Entry) {
+	logJSON, err := json.Marshal(&entry)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(logJSON))
+}
+
+// formatLogfmt formats the entry as a line of key=value pairs
+func formatLogfmt(entry log.Entry) string {
+	var b strings.Builder
+	pair := func(key, value string) {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(key)
+		b.WriteByte('=')
+		// the values with spaces, quotes, equal signs or control characters are quoted
+		if value == "" || strings.IndexFunc(value, func(r rune) bool { return r <= ' ' || r == '=' || r == '"' || r == 0x7f }) >= 0 {
+			value = strconv.Quote(value)
+		}
+		b.WriteString(value)
+	}
+	pair("time", entry.Time.Format(time.RFC3339Nano))
+	pair("level", entry.Level)
+	pair("msg", entry.Message)
+	for _, field := range [][2]string{
+		{"requestID", entry.RequestID},
+		{"sessionID", entry.SessionID},
+		{"remotehost", entry.RemoteHost},
+	} {
+		if field[1] != "" {
+			pair(field[0], field[1])
+		}
+	}
+	if entry.Trace != nil && len(entry.Trace.Source) > 0 {
+		pair("source", entry.Trace.Source[0])
+	}
+	return b.String()
 }
 
 // Fatal prints only fatal errors message with no stack trace
@@ -61,36 +145,31 @@ func fatal(err error, msg string, data ...interface{}) {
 	} else {
 		errMsg = err.Error()
 	}
-	consoleLog(fatalMessage, errMsg)
+	consoleLog(fatalMessage, nil, errMsg)
 }
 
 var fatalMessage fatalMsg
 
 type fatalMsg struct{}
 
-func (f fatalMsg) json(msg string, args ...interface{}) {
-	var message string
-	if msg != "" {
-		message = fmt.Sprintf(msg, args...)
-	} else {
-		message = fmt.Sprint(args...)
-	}
-	logJSON, err := json.Marshal(&log.Entry{
-		Level:   FatalLvl.String(),
-		Message: message,
-		Time:    time.Now().UTC(),
-		Trace:   &log.Trace{Message: message, Source: []string{getSource(6)}},
-	})
-	if err != nil {
-		panic(err)
-	}
-	fmt.Println(string(logJSON))
+func (f fatalMsg) json(req *ReqInfo, msg string, args ...interface{}) {
+	entry := newEntry(FatalLvl, req, msg, args...)
+	entry.Trace = &log.Trace{Message: entry.Message, Source: []string{getSource(6)}}
+	printJSON(entry)
+
+	os.Exit(1)
+}
+
+func (f fatalMsg) logfmt(req *ReqInfo, msg string, args ...interface{}) {
+	entry := newEntry(FatalLvl, req, msg, args...)
+	entry.Trace = &log.Trace{Message: entry.Message, Source: []string{getSource(6)}}
+	fmt.Println(formatLogfmt(entry))
 
 	os.Exit(1)
 }
 
-func (f fatalMsg) quiet(msg string, args ...interface{}) {
-	f.pretty(msg, args...)
+func (f fatalMsg) quiet(req *ReqInfo, msg string, args ...interface{}) {
+	f.pretty(req, msg, args...)
 }
 
 var (
@@ -100,7 +179,7 @@ var (
 	bannerWidth = len(logTag) + 1
 )
 
-func (f fatalMsg) pretty(msg string, args ...interface{}) {
+func (f fatalMsg) pretty(_ *ReqInfo, msg string, args ...interface{}) {
 	// Build the passed errors message
 	errMsg := fmt.Sprintf(msg, args...)
 
@@ -142,32 +221,28 @@ func (f fatalMsg) pretty(msg string, args ...interface{}) {
 	os.Exit(1)
 }
 
-type infoMsg struct{}
+// infoMsg prints the informational and debug messages, which are hidden in quiet mode
+type infoMsg struct {
+	level Level
+}
 
-var info infoMsg
+var (
+	info  = infoMsg{level: InformationLvl}
+	debug = infoMsg{level: DebugLvl}
+)
 
-func (i infoMsg) json(msg string, args ...interface{}) {
-	var message string
-	if msg != "" {
-		message = fmt.Sprintf(msg, args...)
-	} else {
-		message = fmt.Sprint(args...)
-	}
-	logJSON, err := json.Marshal(&log.Entry{
-		Level:   InformationLvl.String(),
-		Message: message,
-		Time:    time.Now().UTC(),
-	})
-	if err != nil {
-		panic(err)
-	}
-	fmt.Println(string(logJSON))
+func (i infoMsg) json(req *ReqInfo, msg string, args ...interface{}) {
+	printJSON(newEntry(i.level, req, msg, args...))
+}
+
+func (i infoMsg) logfmt(req *ReqInfo, msg string, args ...interface{}) {
+	fmt.Println(formatLogfmt(newEntry(i.level, req, msg, args...)))
 }
 
-func (i infoMsg) quiet(_ string, _ ...interface{}) {
+func (i infoMsg) quiet(_ *ReqInfo, _ string, _ ...interface{}) {
 }
 
-func (i infoMsg) pretty(msg string, args ...interface{}) {
+func (i infoMsg) pretty(_ *ReqInfo, msg string, args ...interface{}) {
 	if msg == "" {
 		c.Println(args...)
 	}
@@ -178,30 +253,23 @@ type errorMsg struct{}
 
 var errorm errorMsg
 
-func (i errorMsg) json(msg string, args ...interface{}) {
-	var message string
-	if msg != "" {
-		message = fmt.Sprintf(msg, args...)
-	} else {
-		message = fmt.Sprint(args...)
-	}
-	logJSON, err := json.Marshal(&log.Entry{
-		Level:   ErrorLvl.String(),
-		Message: message,
-		Time:    time.Now().UTC(),
-		Trace:   &log.Trace{Message: message, Source: []string{getSource(6)}},
-	})
-	if err != nil {
-		panic(err)
-	}
-	fmt.Println(string(logJSON))
+func (i errorMsg) json(req *ReqInfo, msg string, args ...interface{}) {
+	entry := newEntry(ErrorLvl, req, msg, args...)
+	entry.Trace = &log.Trace{Message: entry.Message, Source: []string{getSource(6)}}
+	printJSON(entry)
+}
+
+func (i errorMsg) logfmt(req *ReqInfo, msg string, args ...interface{}) {
+	entry := newEntry(ErrorLvl, req, msg, args...)
+	entry.Trace = &log.Trace{Message: entry.Message, Source: []string{getSource(6)}}
+	fmt.Println(formatLogfmt(entry))
 }
 
-func (i errorMsg) quiet(msg string, args ...interface{}) {
-	i.pretty(msg, args...)
+func (i errorMsg) quiet(req *ReqInfo, msg string, args ...interface{}) {
+	i.pretty(req, msg, args...)
 }
 
-func (i errorMsg) pretty(msg string, args ...interface{}) {
+func (i errorMsg) pretty(_ *ReqInfo, msg string, args ...interface{}) {
 	if msg == "" {
 		c.Println(args...)
 	}
@@ -211,10 +279,42 @@ func (i errorMsg) pretty(msg string, args ...interface{}) {
 
 // Error :
 func Error(msg string, data ...interface{}) {
-	consoleLog(errorm, msg, data...)
+	if Enabled(ErrorLvl) {
+		consoleLog(errorm, nil, msg, data...)
+	}
 }
 
 // Info :
 func Info(msg string, data ...interface{}) {
-	consoleLog(info, msg, data...)
+	if Enabled(InformationLvl) {
+		consoleLog(info, nil, msg, data...)
+	}
+}
+
+// Debug prints a message only shown at the debug level
+func Debug(msg string, data ...interface{}) {
+	if Enabled(DebugLvl) {
+		consoleLog(debug, nil, msg, data...)
+	}
+}
+
+// ErrorContext prints an error with the request of the context it is logged for
+func ErrorContext(ctx context.Context, msg string, data ...interface{}) {
+	if Enabled(ErrorLvl) {
+		consoleLog(errorm, GetReqInfo(ctx), msg, data...)
+	}
+}
+
+// InfoContext prints a message with the request of the context it is logged for
+func InfoContext(ctx context.Context, msg string, data ...interface{}) {
+	if Enabled(InformationLvl) {
+		consoleLog(info, GetReqInfo(ctx), msg, data...)
+	}
+}
+
+// DebugContext prints a message only shown at the debug level with the request of the context it is logged for
+func DebugContext(ctx context.Context, msg string, data ...interface{}) {
+	if Enabled(DebugLvl) {
+		consoleLog(debug, GetReqInfo(ctx), msg, data...)
+	}
 }
@@ -0,0 +1,64 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/logger/message/log"
+	"github.com/minio/console/pkg/utils"
+)
+
+func TestLevels(t *testing.T) {
+	defer SetLevel(InformationLvl)
+	for name, want := range map[string]Level{"debug": DebugLvl, "INFO": InformationLvl, "": InformationLvl, "error": ErrorLvl} {
+		level, err := ParseLevel(name)
+		if err != nil || level != want {
+			t.Errorf("ParseLevel(%q) = %v %v, want %v", name, level, err, want)
+		}
+	}
+	if _, err := ParseLevel("fatal"); err == nil {
+		t.Error("expected the fatal level to be rejected")
+	}
+
+	SetLevel(ErrorLvl)
+	if Enabled(InformationLvl) || !Enabled(ErrorLvl) || !Enabled(FatalLvl) {
+		t.Errorf("unexpected levels enabled at %s", GetLevel())
+	}
+	SetLevel(DebugLvl)
+	if !Enabled(DebugLvl) {
+		t.Error("expected the debug messages to be printed")
+	}
+}
+
+func TestFormatLogfmt(t *testing.T) {
+	ctx := context.WithValue(context.Background(), utils.ContextRequestID, "7f2d6c1e")
+	ctx = context.WithValue(ctx, utils.ContextRequestUserID, "alice")
+	entry := newEntry(ErrorLvl, GetReqInfo(ctx), "unable to list %q", "photos")
+	entry.Time = time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	entry.Trace = &log.Trace{Source: []string{"restapi/user_buckets.go:120:getListBucketsResponse()"}}
+
+	want := `time=2023-05-01T10:00:00Z level=ERROR msg="unable to list \"photos\"" requestID=7f2d6c1e sessionID=alice source=restapi/user_buckets.go:120:getListBucketsResponse()`
+	if got := formatLogfmt(entry); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+	if got := requestSuffix(GetReqInfo(ctx)); got != " (requestID=7f2d6c1e)" {
+		t.Errorf("unexpected suffix %q", got)
+	}
+}
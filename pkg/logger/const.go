@@ -27,6 +27,8 @@ const (
 	EnvLoggerJSONEnable      = "CONSOLE_LOGGER_JSON_ENABLE"
 	EnvLoggerAnonymousEnable = "CONSOLE_LOGGER_ANONYMOUS_ENABLE"
 	EnvLoggerQuietEnable     = "CONSOLE_LOGGER_QUIET_ENABLE"
+	EnvLoggerFormat          = "CONSOLE_LOGGER_FORMAT"
+	EnvLoggerLevel           = "CONSOLE_LOGGER_LEVEL"
 
 	EnvGlobalDeploymentID      = "CONSOLE_GLOBAL_DEPLOYMENT_ID"
 	EnvLoggerWebhookEnable     = "CONSOLE_LOGGER_WEBHOOK_ENABLE"
@@ -28,6 +28,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -51,9 +52,10 @@ var Disable = false
 // Level type
 type Level int8
 
-// Enumerated level types
+// Enumerated level types, from the most verbose
 const (
-	InformationLvl Level = iota + 1
+	DebugLvl Level = iota + 1
+	InformationLvl
 	ErrorLvl
 	FatalLvl
 )
@@ -70,6 +72,8 @@ var matchingFuncNames = [...]string{
 func (level Level) String() string {
 	var lvlStr string
 	switch level {
+	case DebugLvl:
+		lvlStr = "DEBUG"
 	case InformationLvl:
 		lvlStr = "INFO"
 	case ErrorLvl:
@@ -80,10 +84,81 @@ func (level Level) String() string {
 	return lvlStr
 }
 
+// ParseLevel parses the name of the minimum level of the console logs, debug, info or error
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return DebugLvl, nil
+	case "info", "":
+		return InformationLvl, nil
+	case "error":
+		return ErrorLvl, nil
+	}
+	return 0, fmt.Errorf("invalid log level %q, expected debug, info or error", name)
+}
+
+// minLevel is the minimum level of the messages printed on the console, it can be changed at runtime
+var minLevel atomic.Int32
+
+func init() {
+	minLevel.Store(int32(InformationLvl))
+}
+
+// SetLevel sets the minimum level of the messages printed on the console, the fatal errors are always printed
+func SetLevel(level Level) {
+	if level < DebugLvl || level > ErrorLvl {
+		level = InformationLvl
+	}
+	minLevel.Store(int32(level))
+}
+
+// GetLevel returns the minimum level of the messages printed on the console
+func GetLevel() Level {
+	return Level(minLevel.Load())
+}
+
+// Enabled tells whether the messages of the level are printed on the console
+func Enabled(level Level) bool {
+	return level >= GetLevel()
+}
+
+// Format of the messages printed on the console
+type Format string
+
+// Supported formats
+const (
+	FormatText   Format = "text"
+	FormatJSON   Format = "json"
+	FormatLogfmt Format = "logfmt"
+)
+
+// ParseFormat parses the format of the console logs, text, json or logfmt
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(name))); format {
+	case "":
+		return FormatText, nil
+	case FormatText, FormatJSON, FormatLogfmt:
+		return format, nil
+	}
+	return "", fmt.Errorf("invalid log format %q, expected text, json or logfmt", name)
+}
+
+// GetFormat returns the format of the messages printed on the console
+func GetFormat() Format {
+	switch {
+	case jsonFlag:
+		return FormatJSON
+	case logfmtFlag:
+		return FormatLogfmt
+	}
+	return FormatText
+}
+
 // quietFlag: Hide startup messages if enabled
 // jsonFlag: Display in JSON format, if enabled
+// logfmtFlag: Display in logfmt format, if enabled
 var (
-	quietFlag, jsonFlag, anonFlag bool
+	quietFlag, jsonFlag, logfmtFlag, anonFlag bool
 	// Custom function to format errors
 	errorFmtFunc func(string, error, bool) string
 )
@@ -99,6 +174,12 @@ func EnableJSON() {
 	quietFlag = true
 }
 
+// EnableLogfmt - outputs logs in logfmt format.
+func EnableLogfmt() {
+	logfmtFlag = true
+	quietFlag = true
+}
+
 // EnableAnonymous - turns anonymous flag
 // to avoid printing sensitive information.
 func EnableAnonymous() {
@@ -427,6 +508,21 @@ func InitializeLogger(ctx context.Context, transport *http.Transport) error {
 	if enable, _ := config.ParseBool(env.Get(EnvLoggerJSONEnable, "")); enable {
 		EnableJSON()
 	}
+	format, err := ParseFormat(env.Get(EnvLoggerFormat, ""))
+	if err != nil {
+		return err
+	}
+	switch format {
+	case FormatJSON:
+		EnableJSON()
+	case FormatLogfmt:
+		EnableLogfmt()
+	}
+	level, err := ParseLevel(env.Get(EnvLoggerLevel, ""))
+	if err != nil {
+		return err
+	}
+	SetLevel(level)
 	if enable, _ := config.ParseBool(env.Get(EnvLoggerAnonymousEnable, "")); enable {
 		EnableAnonymous()
 	}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 
+	"github.com/minio/console/pkg/realip"
 	"github.com/minio/console/pkg/utils"
 
 	xhttp "github.com/minio/console/pkg/http"
@@ -78,7 +79,7 @@ func NewEntry(deploymentID string) Entry {
 func ToEntry(w http.ResponseWriter, r *http.Request, reqClaims map[string]interface{}, deploymentID string) Entry {
 	entry := NewEntry(deploymentID)
 
-	entry.RemoteHost = r.RemoteAddr
+	entry.RemoteHost = realip.ClientIP(r)
 	entry.UserAgent = r.UserAgent()
 	entry.ReqClaims = reqClaims
 
@@ -0,0 +1,270 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package loginattempts records the failed Console logins and locks out the access keys and
+// source IPs with too many of them in a short time. MinIO doesn't throttle authentication.
+package loginattempts
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+)
+
+// maxFailures is the number of failures kept for the audit, the oldest are dropped first
+const maxFailures = 1000
+
+// What a lockout applies to
+const (
+	AccessKey = "accessKey"
+	SourceIP  = "sourceIP"
+)
+
+// Failure is a failed login
+type Failure struct {
+	AccessKey string    `json:"accessKey"`
+	SourceIP  string    `json:"sourceIP"`
+	Time      time.Time `json:"time"`
+}
+
+// Lockout prevents the logins of an access key or from a source IP until it ends
+type Lockout struct {
+	Type     string    `json:"type"`
+	Value    string    `json:"value"`
+	Failures int       `json:"failures"`
+	Until    time.Time `json:"until"`
+}
+
+// Policy is when logins get locked out, a zero threshold never locks
+type Policy struct {
+	// AccessKeyThreshold is the number of failures of an access key within Window locking it
+	AccessKeyThreshold int
+	// SourceIPThreshold is the number of failures from a source IP within Window locking it
+	SourceIPThreshold int
+	Window            time.Duration
+	Duration          time.Duration
+}
+
+// Enabled returns whether any login can get locked out
+func (p Policy) Enabled() bool {
+	return p.AccessKeyThreshold > 0 || p.SourceIPThreshold > 0
+}
+
+// state is what is persisted, the failures are oldest first
+type state struct {
+	Failures []Failure          `json:"failures,omitempty"`
+	Lockouts map[string]Lockout `json:"lockouts,omitempty"`
+	// Resets is when an administrator lifted a lockout, the failures before don't count anymore
+	Resets map[string]time.Time `json:"resets,omitempty"`
+}
+
+// Store holds the failed logins and the lockouts, optionally persisted to a file
+type Store struct {
+	path   string
+	policy Policy
+
+	mu    sync.Mutex
+	state state
+}
+
+// New creates a store enforcing policy. When path isn't empty the state is loaded from and saved
+// to that file, a missing file is an empty state.
+func New(path string, policy Policy) (*Store, error) {
+	if policy.Enabled() && (policy.Window <= 0 || policy.Duration <= 0) {
+		return nil, fmt.Errorf("invalid login lockout window %s and duration %s", policy.Window, policy.Duration)
+	}
+	s := &Store{path: path, policy: policy}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(data, &s.state); err != nil {
+				return nil, fmt.Errorf("invalid login attempts file %s: %w", path, err)
+			}
+		}
+	}
+	if s.state.Lockouts == nil {
+		s.state.Lockouts = map[string]Lockout{}
+	}
+	if s.state.Resets == nil {
+		s.state.Resets = map[string]time.Time{}
+	}
+	return s, nil
+}
+
+// Policy returns the lockout policy of the store
+func (s *Store) Policy() Policy {
+	return s.policy
+}
+
+func key(kind, value string) string {
+	return kind + "/" + value
+}
+
+// Locked returns the lockout preventing a login with the access key from the source IP, nil when
+// there is none
+func (s *Store) Locked(accessKey, sourceIP string, now time.Time) *Lockout {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, k := range []string{key(AccessKey, accessKey), key(SourceIP, sourceIP)} {
+		if lockout, ok := s.state.Lockouts[k]; ok && now.Before(lockout.Until) {
+			return &lockout
+		}
+	}
+	return nil
+}
+
+// Failed records a failed login and returns the lockout it started, nil when there is none
+func (s *Store) Failed(accessKey, sourceIP string, now time.Time) (*Lockout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state.Failures = append(s.state.Failures, Failure{AccessKey: accessKey, SourceIP: sourceIP, Time: now})
+	if len(s.state.Failures) > maxFailures {
+		s.state.Failures = s.state.Failures[len(s.state.Failures)-maxFailures:]
+	}
+	s.prune(now)
+
+	var started *Lockout
+	checks := []struct {
+		kind      string
+		value     string
+		threshold int
+	}{
+		{AccessKey, accessKey, s.policy.AccessKeyThreshold},
+		{SourceIP, sourceIP, s.policy.SourceIPThreshold},
+	}
+	for _, check := range checks {
+		if check.threshold <= 0 || check.value == "" {
+			continue
+		}
+		count := s.count(check.kind, check.value, now)
+		if count < check.threshold {
+			continue
+		}
+		lockout := Lockout{Type: check.kind, Value: check.value, Failures: count, Until: now.Add(s.policy.Duration)}
+		s.state.Lockouts[key(check.kind, check.value)] = lockout
+		if started == nil {
+			started = &lockout
+		}
+	}
+	return started, s.save()
+}
+
+// count returns the failures of the access key or source IP within the window and after the last
+// reset, the caller holds the lock
+func (s *Store) count(kind, value string, now time.Time) int {
+	since := now.Add(-s.policy.Window)
+	if reset, ok := s.state.Resets[key(kind, value)]; ok && reset.After(since) {
+		since = reset
+	}
+	count := 0
+	for _, failure := range s.state.Failures {
+		matches := failure.AccessKey == value
+		if kind == SourceIP {
+			matches = failure.SourceIP == value
+		}
+		if matches && failure.Time.After(since) {
+			count++
+		}
+	}
+	return count
+}
+
+// prune drops the ended lockouts and the resets older than the window, the caller holds the lock
+func (s *Store) prune(now time.Time) {
+	for k, lockout := range s.state.Lockouts {
+		if !now.Before(lockout.Until) {
+			delete(s.state.Lockouts, k)
+		}
+	}
+	for k, reset := range s.state.Resets {
+		if reset.Before(now.Add(-s.policy.Window)) {
+			delete(s.state.Resets, k)
+		}
+	}
+}
+
+// Failures returns the recorded failures newest first, only the ones of the access key and from
+// the source IP when they aren't empty and at most limit of them when it is positive
+func (s *Store) Failures(accessKey, sourceIP string, limit int) []Failure {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	failures := []Failure{}
+	for i := len(s.state.Failures) - 1; i >= 0; i-- {
+		failure := s.state.Failures[i]
+		if (accessKey != "" && failure.AccessKey != accessKey) || (sourceIP != "" && failure.SourceIP != sourceIP) {
+			continue
+		}
+		if limit > 0 && len(failures) == limit {
+			break
+		}
+		failures = append(failures, failure)
+	}
+	return failures
+}
+
+// Lockouts returns the active lockouts, the ones ending first first
+func (s *Store) Lockouts(now time.Time) []Lockout {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lockouts := []Lockout{}
+	for _, lockout := range s.state.Lockouts {
+		if now.Before(lockout.Until) {
+			lockouts = append(lockouts, lockout)
+		}
+	}
+	sort.Slice(lockouts, func(i, j int) bool {
+		if lockouts[i].Until.Equal(lockouts[j].Until) {
+			return key(lockouts[i].Type, lockouts[i].Value) < key(lockouts[j].Type, lockouts[j].Value)
+		}
+		return lockouts[i].Until.Before(lockouts[j].Until)
+	})
+	return lockouts
+}
+
+// Unlock lifts the lockout of an access key or a source IP, their previous failures don't count
+// towards a new one
+func (s *Store) Unlock(kind, value string, now time.Time) error {
+	if kind != AccessKey && kind != SourceIP {
+		return fmt.Errorf("unknown lockout type %q", kind)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	k := key(kind, value)
+	delete(s.state.Lockouts, k)
+	s.state.Resets[k] = now
+	s.prune(now)
+	return s.save()
+}
+
+// save writes the state to the file of the store, the caller holds the lock
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s.state)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(s.path, data)
+}
@@ -0,0 +1,124 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package loginattempts
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLockout(t *testing.T) {
+	policy := Policy{AccessKeyThreshold: 3, SourceIPThreshold: 5, Window: 10 * time.Minute, Duration: 15 * time.Minute}
+	s, err := New("", policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// failures outside the window don't count
+	if _, err := s.Failed("alice", "10.0.0.1", now.Add(-time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		lockout, err := s.Failed("alice", "10.0.0.1", now)
+		if err != nil || lockout != nil {
+			t.Fatalf("unexpected lockout %v %v", lockout, err)
+		}
+	}
+	lockout, err := s.Failed("alice", "10.0.0.2", now)
+	if err != nil || lockout == nil {
+		t.Fatalf("expected a lockout, got %v %v", lockout, err)
+	}
+	if lockout.Type != AccessKey || lockout.Value != "alice" || lockout.Failures != 3 || !lockout.Until.Equal(now.Add(15*time.Minute)) {
+		t.Errorf("unexpected lockout %+v", lockout)
+	}
+	if s.Locked("alice", "10.0.0.9", now.Add(time.Minute)) == nil {
+		t.Error("alice should be locked out")
+	}
+	if s.Locked("alice", "10.0.0.9", now.Add(16*time.Minute)) != nil {
+		t.Error("the lockout should have ended")
+	}
+	if s.Locked("bob", "10.0.0.1", now) != nil {
+		t.Error("bob shouldn't be locked out")
+	}
+
+	// an unlock lifts the lockout and resets the count
+	if err := s.Unlock(AccessKey, "alice", now); err != nil {
+		t.Fatal(err)
+	}
+	if s.Locked("alice", "", now) != nil {
+		t.Error("alice should have been unlocked")
+	}
+	if lockout, _ := s.Failed("alice", "10.0.0.3", now.Add(time.Second)); lockout != nil {
+		t.Errorf("unexpected lockout after the unlock %+v", lockout)
+	}
+	if err := s.Unlock("user", "alice", now); err == nil {
+		t.Error("expected an error for an unknown lockout type")
+	}
+
+	// failures of any access key count for the source IP
+	for _, user := range []string{"bob", "carol", "dave"} {
+		s.Failed(user, "10.0.0.1", now)
+	}
+	if lockout := s.Locked("erin", "10.0.0.1", now); lockout == nil || lockout.Type != SourceIP {
+		t.Errorf("expected a source IP lockout, got %+v", lockout)
+	}
+	if lockouts := s.Lockouts(now); len(lockouts) != 1 || lockouts[0].Value != "10.0.0.1" {
+		t.Errorf("unexpected lockouts %+v", lockouts)
+	}
+}
+
+func TestFailures(t *testing.T) {
+	s, _ := New("", Policy{})
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	for i, user := range []string{"alice", "bob", "alice", "alice"} {
+		if lockout, _ := s.Failed(user, "10.0.0.1", now.Add(time.Duration(i)*time.Second)); lockout != nil {
+			t.Fatal("a disabled policy must not lock out")
+		}
+	}
+	failures := s.Failures("alice", "", 2)
+	if len(failures) != 2 || !failures[0].Time.Equal(now.Add(3*time.Second)) {
+		t.Errorf("unexpected failures %+v", failures)
+	}
+	if failures := s.Failures("", "10.0.0.2", 0); len(failures) != 0 {
+		t.Errorf("unexpected failures %+v", failures)
+	}
+	if _, err := New("", Policy{AccessKeyThreshold: 3}); err == nil {
+		t.Error("expected an error without a window")
+	}
+}
+
+func TestPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "login-attempts.json")
+	policy := Policy{AccessKeyThreshold: 1, Window: time.Minute, Duration: time.Hour}
+	now := time.Now()
+	s, err := New(path, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Failed("alice", "10.0.0.1", now); err != nil {
+		t.Fatal(err)
+	}
+	s, err = New(path, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Locked("alice", "", now) == nil || len(s.Failures("", "", 0)) != 1 {
+		t.Error("the state wasn't persisted")
+	}
+}
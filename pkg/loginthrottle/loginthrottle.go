@@ -0,0 +1,222 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package loginthrottle slows down the Console logins of source IPs and access keys: it limits how many
+// attempts they make in a window, makes them wait longer after every consecutive failure and tells when a
+// CAPTCHA must be solved. Unlike the lockouts, the state is only kept in memory.
+package loginthrottle
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Why an attempt is rejected
+const (
+	ReasonRate    = "rate"
+	ReasonBackoff = "backoff"
+)
+
+// Policy configures the throttling, the zero values disable each part
+type Policy struct {
+	// IPRate is the number of attempts from a source IP allowed within Window
+	IPRate int
+	// AccessKeyRate is the number of attempts of an access key allowed within Window
+	AccessKeyRate int
+	// Window is the period attempts are counted in, consecutive failures are also forgotten after a Window
+	// without any
+	Window time.Duration
+	// BackoffBase is the wait after the first consecutive failure, it doubles with every other one
+	BackoffBase time.Duration
+	// BackoffMax caps the wait
+	BackoffMax time.Duration
+	// CaptchaThreshold is the number of consecutive failures after which a CAPTCHA must be solved
+	CaptchaThreshold int
+}
+
+// backoff returns the wait after the consecutive failures
+func (p Policy) backoff(failures int) time.Duration {
+	if p.BackoffBase <= 0 || failures <= 0 {
+		return 0
+	}
+	wait := p.BackoffBase
+	for i := 1; i < failures; i++ {
+		wait *= 2
+		if p.BackoffMax > 0 && wait >= p.BackoffMax {
+			return p.BackoffMax
+		}
+	}
+	if p.BackoffMax > 0 && wait > p.BackoffMax {
+		return p.BackoffMax
+	}
+	return wait
+}
+
+// Decision is the outcome of an attempt
+type Decision struct {
+	// Allowed is false when the attempt must be rejected
+	Allowed bool
+	// Reason and Key tell why and for what key the attempt was rejected
+	Reason string
+	Key    string
+	// RetryAfter is how long to wait before the next attempt can be allowed
+	RetryAfter time.Duration
+	// CaptchaRequired is set when the attempt must come with a solved CAPTCHA
+	CaptchaRequired bool
+	// Failures is the highest number of consecutive failures of the keys
+	Failures int
+}
+
+type entry struct {
+	// attempts within the window, oldest first
+	attempts    []time.Time
+	failures    int
+	lastFailure time.Time
+}
+
+// Limiter throttles the logins according to its policy
+type Limiter struct {
+	policy    Policy
+	mu        sync.Mutex
+	entries   map[string]*entry
+	lastPrune time.Time
+}
+
+// New returns a limiter applying policy
+func New(policy Policy) *Limiter {
+	return &Limiter{policy: policy, entries: map[string]*entry{}}
+}
+
+// Policy returns the policy of the limiter
+func (l *Limiter) Policy() Policy {
+	return l.policy
+}
+
+// IPKey returns the key the attempts from a source IP are tracked by
+func IPKey(sourceIP string) string {
+	return "ip:" + sourceIP
+}
+
+// AccessKeyKey returns the key the attempts of an access key are tracked by
+func AccessKeyKey(accessKey string) string {
+	return "accessKey:" + accessKey
+}
+
+func (l *Limiter) keys(sourceIP, accessKey string) []string {
+	var keys []string
+	if sourceIP != "" {
+		keys = append(keys, IPKey(sourceIP))
+	}
+	if accessKey != "" {
+		keys = append(keys, AccessKeyKey(accessKey))
+	}
+	return keys
+}
+
+func (l *Limiter) rate(key string) int {
+	if strings.HasPrefix(key, IPKey("")) {
+		return l.policy.IPRate
+	}
+	return l.policy.AccessKeyRate
+}
+
+// entry returns the state of key, forgetting the attempts out of the window and the stale failures
+func (l *Limiter) entry(key string, now time.Time) *entry {
+	e, ok := l.entries[key]
+	if !ok {
+		e = &entry{}
+		l.entries[key] = e
+	}
+	from := now.Add(-l.policy.Window)
+	i := 0
+	for i < len(e.attempts) && !e.attempts[i].After(from) {
+		i++
+	}
+	e.attempts = e.attempts[i:]
+	if e.failures > 0 && now.Sub(e.lastFailure) > l.policy.Window+l.policy.backoff(e.failures) {
+		e.failures = 0
+	}
+	return e
+}
+
+// prune drops the keys without any state left, at most once per window
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < l.policy.Window {
+		return
+	}
+	l.lastPrune = now
+	for key := range l.entries {
+		if e := l.entry(key, now); len(e.attempts) == 0 && e.failures == 0 {
+			delete(l.entries, key)
+		}
+	}
+}
+
+// Allow decides whether the login attempt of the access key from the source IP can proceed, either can be
+// empty. Allowed attempts are counted towards the rate, rejected ones aren't
+func (l *Limiter) Allow(sourceIP, accessKey string, now time.Time) Decision {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.prune(now)
+
+	decision := Decision{Allowed: true}
+	keys := l.keys(sourceIP, accessKey)
+	for _, key := range keys {
+		e := l.entry(key, now)
+		if e.failures > decision.Failures {
+			decision.Failures = e.failures
+		}
+		if rate := l.rate(key); rate > 0 && len(e.attempts) >= rate {
+			return Decision{Reason: ReasonRate, Key: key, RetryAfter: e.attempts[0].Add(l.policy.Window).Sub(now), Failures: decision.Failures}
+		}
+		if wait := e.lastFailure.Add(l.policy.backoff(e.failures)).Sub(now); e.failures > 0 && wait > 0 {
+			return Decision{Reason: ReasonBackoff, Key: key, RetryAfter: wait, Failures: decision.Failures}
+		}
+	}
+	for _, key := range keys {
+		e := l.entries[key]
+		e.attempts = append(e.attempts, now)
+	}
+	decision.CaptchaRequired = l.policy.CaptchaThreshold > 0 && decision.Failures >= l.policy.CaptchaThreshold
+	return decision
+}
+
+// Failed records a failed attempt and returns the highest number of consecutive failures of the keys
+func (l *Limiter) Failed(sourceIP, accessKey string, now time.Time) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	failures := 0
+	for _, key := range l.keys(sourceIP, accessKey) {
+		e := l.entry(key, now)
+		e.failures++
+		e.lastFailure = now
+		if e.failures > failures {
+			failures = e.failures
+		}
+	}
+	return failures
+}
+
+// Succeeded forgets the consecutive failures of the access key, those of the source IP only fade with time so
+// a valid account doesn't cover the guesses made for others
+func (l *Limiter) Succeeded(accessKey string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if e, ok := l.entries[AccessKeyKey(accessKey)]; ok && accessKey != "" {
+		e.failures = 0
+	}
+}
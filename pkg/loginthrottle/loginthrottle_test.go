@@ -0,0 +1,110 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package loginthrottle
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := Policy{BackoffBase: time.Second, BackoffMax: 10 * time.Second}
+	for failures, want := range []time.Duration{0, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		if got := policy.backoff(failures); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", failures, got, want)
+		}
+	}
+	if got := (Policy{}).backoff(3); got != 0 {
+		t.Errorf("backoff without a base = %s, want 0", got)
+	}
+}
+
+func TestRate(t *testing.T) {
+	limiter := New(Policy{IPRate: 3, AccessKeyRate: 2, Window: time.Minute})
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if d := limiter.Allow("10.0.0.1", "alice", now); !d.Allowed {
+			t.Fatalf("attempt %d rejected: %+v", i, d)
+		}
+	}
+	d := limiter.Allow("10.0.0.1", "alice", now.Add(10*time.Second))
+	if d.Allowed || d.Reason != ReasonRate || d.Key != AccessKeyKey("alice") || d.RetryAfter != 50*time.Second {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	// another access key from the same IP still has one attempt left
+	if d := limiter.Allow("10.0.0.1", "bob", now); !d.Allowed {
+		t.Fatalf("attempt of bob rejected: %+v", d)
+	}
+	if d := limiter.Allow("10.0.0.1", "carol", now); d.Allowed || d.Key != IPKey("10.0.0.1") {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	// the attempts leave the window
+	if d := limiter.Allow("10.0.0.1", "alice", now.Add(time.Minute+time.Second)); !d.Allowed {
+		t.Fatalf("attempt after the window rejected: %+v", d)
+	}
+}
+
+func TestBackoffAndCaptcha(t *testing.T) {
+	limiter := New(Policy{Window: time.Minute, BackoffBase: time.Second, BackoffMax: time.Minute, CaptchaThreshold: 2})
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	if d := limiter.Allow("10.0.0.1", "alice", now); !d.Allowed || d.CaptchaRequired {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	if failures := limiter.Failed("10.0.0.1", "alice", now); failures != 1 {
+		t.Fatalf("failures = %d, want 1", failures)
+	}
+	d := limiter.Allow("10.0.0.1", "alice", now.Add(500*time.Millisecond))
+	if d.Allowed || d.Reason != ReasonBackoff || d.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	now = now.Add(time.Second)
+	if d := limiter.Allow("10.0.0.1", "alice", now); !d.Allowed || d.CaptchaRequired {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	limiter.Failed("10.0.0.1", "alice", now)
+	if d := limiter.Allow("10.0.0.1", "alice", now.Add(time.Second)); d.Allowed {
+		t.Fatalf("attempt within the doubled backoff allowed: %+v", d)
+	}
+	now = now.Add(2 * time.Second)
+	if d := limiter.Allow("10.0.0.1", "alice", now); !d.Allowed || !d.CaptchaRequired || d.Failures != 2 {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+
+	// a success forgets the failures of the access key but not those of the source IP
+	limiter.Succeeded("alice")
+	if d := limiter.Allow("10.0.0.2", "alice", now); !d.Allowed || d.CaptchaRequired {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	if d := limiter.Allow("10.0.0.1", "bob", now); !d.Allowed || !d.CaptchaRequired {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+
+	// the failures are forgotten after a window without any
+	if d := limiter.Allow("10.0.0.1", "bob", now.Add(2*time.Minute)); !d.Allowed || d.CaptchaRequired {
+		t.Fatalf("unexpected decision %+v", d)
+	}
+	// only the keys of the last attempt are left
+	if len(limiter.entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(limiter.entries))
+	}
+	limiter.Allow("", "", now.Add(5*time.Minute))
+	if len(limiter.entries) != 0 {
+		t.Fatalf("stale entries kept: %d", len(limiter.entries))
+	}
+}
@@ -0,0 +1,264 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package passwordpolicy checks the secret keys builtin users choose against a configurable
+// policy and keeps what MinIO doesn't: the users that must change their secret key and the
+// secret keys they used before.
+package passwordpolicy
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/minio/console/pkg/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrViolation is returned when a secret key doesn't meet the policy or was used before
+var ErrViolation = errors.New("the secret key doesn't meet the password policy")
+
+// MinIO rejects secret keys shorter than 8 characters and longer than 40
+const (
+	minSecretKeyLength = 8
+	maxSecretKeyLength = 40
+	// generatedLength is the length of the one-time secret keys, unless the policy asks for more
+	generatedLength = 24
+)
+
+const (
+	upperChars  = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	lowerChars  = "abcdefghijkmnopqrstuvwxyz"
+	digitChars  = "23456789"
+	symbolChars = "!#%+-.=@_~"
+)
+
+// Policy describes the secret keys users are allowed to choose
+type Policy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// History is how many previous secret keys of a user can't be reused, 0 allows reuse
+	History int
+}
+
+// Check returns an error wrapping ErrViolation listing every rule the secret key breaks
+func (p Policy) Check(secret string) error {
+	var problems []string
+	minLength := p.MinLength
+	if minLength < minSecretKeyLength {
+		minLength = minSecretKeyLength
+	}
+	if n := len([]rune(secret)); n < minLength {
+		problems = append(problems, fmt.Sprintf("at least %d characters", minLength))
+	} else if n > maxSecretKeyLength {
+		problems = append(problems, fmt.Sprintf("at most %d characters", maxSecretKeyLength))
+	}
+	var upper, lower, digit, symbol bool
+	for _, r := range secret {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+	if p.RequireUpper && !upper {
+		problems = append(problems, "an uppercase letter")
+	}
+	if p.RequireLower && !lower {
+		problems = append(problems, "a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		problems = append(problems, "a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		problems = append(problems, "a symbol")
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: it needs %s", ErrViolation, strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// Generate returns a random secret key that meets the policy
+func (p Policy) Generate() (string, error) {
+	length := generatedLength
+	if p.MinLength > length {
+		length = p.MinLength
+	}
+	if length > maxSecretKeyLength {
+		length = maxSecretKeyLength
+	}
+	// one character of every class, the policy may require any of them
+	classes := []string{upperChars, lowerChars, digitChars, symbolChars}
+	all := strings.Join(classes, "")
+	secret := make([]byte, 0, length)
+	for i := 0; i < length; i++ {
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+		c, err := randomChar(chars)
+		if err != nil {
+			return "", err
+		}
+		secret = append(secret, c)
+	}
+	// don't leave the classes in a predictable order
+	for i := len(secret) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		secret[i], secret[j.Int64()] = secret[j.Int64()], secret[i]
+	}
+	return string(secret), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+	return chars[n.Int64()], nil
+}
+
+// userState is what is kept about a user, the previous secret keys are bcrypt hashes newest first
+type userState struct {
+	MustChange bool     `json:"mustChange,omitempty"`
+	History    []string `json:"history,omitempty"`
+}
+
+// Store holds the password state of the users, optionally persisted to a file
+type Store struct {
+	path string
+
+	mu    sync.Mutex
+	users map[string]*userState
+}
+
+// New creates a store. When path isn't empty the state is loaded from and saved to that
+// file, a missing file is an empty state.
+func New(path string) (*Store, error) {
+	s := &Store{path: path, users: map[string]*userState{}}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.users); err != nil {
+		return nil, fmt.Errorf("invalid password state file %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// MustChange returns whether the user has to change their secret key before doing anything else
+func (s *Store) MustChange(user string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	return ok && state.MustChange
+}
+
+// Reset flags the user as having to change the secret key an administrator set for them
+func (s *Store) Reset(user string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	if !ok {
+		state = &userState{}
+		s.users[user] = state
+	}
+	state.MustChange = true
+	return s.save()
+}
+
+// Reused returns whether the secret key is one of the last history ones of the user
+func (s *Store) Reused(user, secret string, history int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	if !ok {
+		return false
+	}
+	for i, hash := range state.History {
+		if i >= history {
+			break
+		}
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret)) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Changed records the new secret key of the user, keeping the last history ones, and clears
+// the must change flag
+func (s *Store) Changed(user, secret string, history int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	if !ok {
+		state = &userState{}
+	}
+	state.MustChange = false
+	if history > 0 {
+		hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+		if err != nil {
+			return err
+		}
+		state.History = append([]string{string(hash)}, state.History...)
+	}
+	if len(state.History) > history {
+		state.History = state.History[:history]
+	}
+	if len(state.History) == 0 {
+		delete(s.users, user)
+	} else {
+		s.users[user] = state
+	}
+	return s.save()
+}
+
+// save writes the state to the file of the store, the caller holds the lock
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s.users)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(s.path, data)
+}
@@ -0,0 +1,114 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package passwordpolicy
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	p := Policy{MinLength: 10, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+	tests := []struct {
+		secret string
+		valid  bool
+	}{
+		{"Sh0rt!", false},
+		{"alllowercase12!", false},
+		{"ALLUPPERCASE12!", false},
+		{"NoDigitsHere!!", false},
+		{"NoSymbols1234", false},
+		{"Valid-Secret-1", true},
+		{"Far-Too-Long-Secret-Key-1234567890-abcdefgh", false},
+	}
+	for _, tt := range tests {
+		err := p.Check(tt.secret)
+		if tt.valid && err != nil {
+			t.Errorf("%q: unexpected error %v", tt.secret, err)
+		}
+		if !tt.valid && !errors.Is(err, ErrViolation) {
+			t.Errorf("%q: expected a policy violation, got %v", tt.secret, err)
+		}
+	}
+	// MinIO's own minimum applies even without a policy
+	if err := (Policy{}).Check("short"); !errors.Is(err, ErrViolation) {
+		t.Errorf("expected the MinIO minimum length to apply, got %v", err)
+	}
+}
+
+func TestPolicyGenerate(t *testing.T) {
+	p := Policy{MinLength: 30, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+	for i := 0; i < 50; i++ {
+		secret, err := p.Generate()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(secret) != 30 {
+			t.Fatalf("expected 30 characters, got %q", secret)
+		}
+		if err = p.Check(secret); err != nil {
+			t.Fatalf("generated secret %q doesn't meet the policy: %v", secret, err)
+		}
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "passwords.json")
+	store, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Reset("alice"); err != nil {
+		t.Fatal(err)
+	}
+	if !store.MustChange("alice") || store.MustChange("bob") {
+		t.Fatal("expected only alice to have to change the secret key")
+	}
+	for _, secret := range []string{"first-secret", "second-secret", "third-secret"} {
+		if err = store.Changed("alice", secret, 2); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if store.MustChange("alice") {
+		t.Fatal("expected the change to clear the flag")
+	}
+	if !store.Reused("alice", "third-secret", 2) || !store.Reused("alice", "second-secret", 2) {
+		t.Fatal("expected the last two secret keys to be remembered")
+	}
+	if store.Reused("alice", "first-secret", 2) {
+		t.Fatal("expected the oldest secret key to be forgotten")
+	}
+
+	// the state survives a restart
+	store.Reset("bob")
+	reloaded, err := New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded.MustChange("bob") || !reloaded.Reused("alice", "third-secret", 2) {
+		t.Fatal("expected the state to be loaded from the file")
+	}
+
+	// without history nothing is kept once the flag is cleared
+	if err = reloaded.Changed("bob", "bob-secret-1", 0); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Reused("bob", "bob-secret-1", 0) || len(reloaded.users) != 1 {
+		t.Fatalf("expected bob to be dropped from the state, got %+v", reloaded.users)
+	}
+}
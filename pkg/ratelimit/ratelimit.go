@@ -0,0 +1,180 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package ratelimit limits the rate of the requests of every principal per class of endpoints with token
+// buckets, and how many expensive operations run at once. The state is only kept in memory.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate allows Requests per Period, in bursts of up to Requests
+type Rate struct {
+	Requests int
+	Period   time.Duration
+}
+
+// ParseRate parses a rate written as `<requests>/<period>`, such as `600/1m`, an empty value is no limit
+func ParseRate(value string) (Rate, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Rate{}, nil
+	}
+	requests, period, ok := strings.Cut(value, "/")
+	if !ok {
+		return Rate{}, fmt.Errorf("invalid rate %q, expected <requests>/<period> such as 600/1m", value)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(requests))
+	// a zero rate would lift the limit instead of blocking everything, no limit is written as an empty value
+	if err != nil || n <= 0 {
+		return Rate{}, fmt.Errorf("invalid number of requests in rate %q, expected at least 1", value)
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(period))
+	if err != nil || d <= 0 {
+		return Rate{}, fmt.Errorf("invalid period in rate %q", value)
+	}
+	return Rate{Requests: n, Period: d}, nil
+}
+
+// Unlimited tells whether the rate doesn't limit anything
+func (r Rate) Unlimited() bool {
+	return r.Requests <= 0 || r.Period <= 0
+}
+
+func (r Rate) String() string {
+	if r.Unlimited() {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d/%s", r.Requests, r.Period)
+}
+
+// bucket holds the tokens left for a key, refilled continuously at the rate of its class
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter limits the requests of every key per class
+type Limiter struct {
+	rates map[string]Rate
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastPrune time.Time
+}
+
+// New returns a limiter applying the rate of every class, the classes without a rate are not limited
+func New(rates map[string]Rate) *Limiter {
+	return &Limiter{rates: rates, buckets: map[string]*bucket{}}
+}
+
+// Rate returns the rate of the class
+func (l *Limiter) Rate(class string) Rate {
+	return l.rates[class]
+}
+
+// Allow takes a token of the key for a request of the class, when none is left it returns how long until
+// the next one
+func (l *Limiter) Allow(class, key string, now time.Time) (bool, time.Duration) {
+	rate := l.rates[class]
+	if rate.Unlimited() {
+		return true, 0
+	}
+	perSecond := float64(rate.Requests) / rate.Period.Seconds()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.prune(now)
+	id := class + "\x00" + key
+	b, ok := l.buckets[id]
+	if !ok {
+		b = &bucket{tokens: float64(rate.Requests), last: now}
+		l.buckets[id] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(rate.Requests), b.tokens+elapsed*perSecond)
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - b.tokens) / perSecond * float64(time.Second))
+	return false, wait
+}
+
+// prune drops the buckets that are full again, at most once a minute, the caller holds the lock
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.lastPrune) < time.Minute {
+		return
+	}
+	l.lastPrune = now
+	for id, b := range l.buckets {
+		class, _, _ := strings.Cut(id, "\x00")
+		if now.Sub(b.last) >= l.rates[class].Period {
+			delete(l.buckets, id)
+		}
+	}
+}
+
+// Caps limits how many operations of every kind run at once
+type Caps struct {
+	limits map[string]int
+
+	mu    sync.Mutex
+	inUse map[string]int
+}
+
+// NewCaps returns caps allowing up to limits[kind] concurrent operations of a kind, the kinds without a
+// positive limit are not capped
+func NewCaps(limits map[string]int) *Caps {
+	return &Caps{limits: limits, inUse: map[string]int{}}
+}
+
+// Acquire takes a slot for an operation of the kind, ok is false when they are all taken. release gives the
+// slot back and can be called more than once.
+func (c *Caps) Acquire(kind string) (release func(), ok bool) {
+	limit := c.limits[kind]
+	if limit <= 0 {
+		return func() {}, true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.inUse[kind] >= limit {
+		return nil, false
+	}
+	c.inUse[kind]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			c.inUse[kind]--
+		})
+	}, true
+}
+
+// InUse returns how many operations of the kind are running
+func (c *Caps) InUse(kind string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.inUse[kind]
+}
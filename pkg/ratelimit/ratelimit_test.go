@@ -0,0 +1,88 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	rate, err := ParseRate("600/1m")
+	if err != nil || rate != (Rate{Requests: 600, Period: time.Minute}) {
+		t.Fatalf("unexpected rate %v %v", rate, err)
+	}
+	if rate, err = ParseRate(""); err != nil || !rate.Unlimited() {
+		t.Fatalf("expected no limit, got %v %v", rate, err)
+	}
+	for _, value := range []string{"600", "x/1m", "0/1m", "-1/1m", "10/0s", "10/forever"} {
+		if _, err = ParseRate(value); err == nil {
+			t.Errorf("expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := New(map[string]Rate{"listing": {Requests: 2, Period: time.Minute}})
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := limiter.Allow("listing", "alice", now); !ok {
+			t.Fatalf("request %d rejected", i)
+		}
+	}
+	ok, wait := limiter.Allow("listing", "alice", now)
+	if ok || wait != 30*time.Second {
+		t.Fatalf("expected to wait 30s for the next token, got %v %s", ok, wait)
+	}
+	// the other principals and classes have their own budget
+	if ok, _ = limiter.Allow("listing", "bob", now); !ok {
+		t.Fatal("request of bob rejected")
+	}
+	if ok, _ = limiter.Allow("admin", "alice", now); !ok {
+		t.Fatal("unlimited class rejected")
+	}
+	// a token is back after half the period
+	if ok, _ = limiter.Allow("listing", "alice", now.Add(30*time.Second)); !ok {
+		t.Fatal("request after the refill rejected")
+	}
+	if ok, _ = limiter.Allow("listing", "alice", now.Add(31*time.Second)); ok {
+		t.Fatal("expected the bucket to be empty again")
+	}
+}
+
+func TestCaps(t *testing.T) {
+	caps := NewCaps(map[string]int{"speedtest": 1})
+	release, ok := caps.Acquire("speedtest")
+	if !ok {
+		t.Fatal("first speedtest rejected")
+	}
+	if _, ok = caps.Acquire("speedtest"); ok {
+		t.Fatal("expected the second speedtest to be rejected")
+	}
+	release()
+	release()
+	if caps.InUse("speedtest") != 0 {
+		t.Fatalf("expected the slot to be released once, %d in use", caps.InUse("speedtest"))
+	}
+	if _, ok = caps.Acquire("speedtest"); !ok {
+		t.Fatal("speedtest after the release rejected")
+	}
+	if _, ok = caps.Acquire("zip-download"); !ok {
+		t.Fatal("uncapped kind rejected")
+	}
+}
@@ -0,0 +1,199 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package realip extracts the address of the client that originated a request when Console runs behind
+// load balancers or reverse proxies. Forwarding headers are only honored when the request comes from a
+// trusted proxy, otherwise any client could spoof its address.
+package realip
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Supported forwarding headers
+const (
+	HeaderXForwardedFor = "X-Forwarded-For"
+	HeaderXRealIP       = "X-Real-IP"
+)
+
+// UnixSocketProxy trusts the requests served on a Unix domain socket, which only the local processes its permissions
+// allow can connect to
+const UnixSocketProxy = "unix"
+
+// DefaultHeaders is the header precedence used when none is configured
+var DefaultHeaders = []string{HeaderXForwardedFor, HeaderXRealIP}
+
+// Config holds the trusted proxies and the order in which the forwarding headers are checked
+type Config struct {
+	proxies    []*net.IPNet
+	unixSocket bool
+	headers    []string
+}
+
+// New parses the trusted proxies, given as CIDRs or single IPs, and validates the header precedence
+func New(proxies, headers []string) (*Config, error) {
+	c := &Config{}
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if proxy == UnixSocketProxy {
+			c.unixSocket = true
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+			}
+			bits := 32
+			if ip.To4() == nil {
+				bits = 128
+			}
+			proxy = fmt.Sprintf("%s/%d", ip, bits)
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", proxy)
+		}
+		c.proxies = append(c.proxies, network)
+	}
+	for _, header := range headers {
+		header = http.CanonicalHeaderKey(strings.TrimSpace(header))
+		if header == "" {
+			continue
+		}
+		if header != http.CanonicalHeaderKey(HeaderXForwardedFor) && header != http.CanonicalHeaderKey(HeaderXRealIP) {
+			return nil, fmt.Errorf("unsupported forwarding header %q", header)
+		}
+		c.headers = append(c.headers, header)
+	}
+	if len(c.headers) == 0 {
+		for _, header := range DefaultHeaders {
+			c.headers = append(c.headers, http.CanonicalHeaderKey(header))
+		}
+	}
+	return c, nil
+}
+
+// Proxies returns the trusted proxies as CIDRs
+func (c *Config) Proxies() []string {
+	proxies := make([]string, 0, len(c.proxies))
+	for _, proxy := range c.proxies {
+		proxies = append(proxies, proxy.String())
+	}
+	if c.unixSocket {
+		proxies = append(proxies, UnixSocketProxy)
+	}
+	return proxies
+}
+
+// Headers returns the forwarding headers in the order they are checked
+func (c *Config) Headers() []string {
+	return append([]string{}, c.headers...)
+}
+
+func (c *Config) trusted(ip net.IP) bool {
+	for _, proxy := range c.proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address of the client that originated the request. The forwarding headers are
+// walked from the closest hop backwards, skipping trusted proxies, so a client can't spoof its address by
+// sending the headers itself.
+func (c *Config) ClientIP(r *http.Request) string {
+	remote := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	remoteIP := net.ParseIP(remote)
+	if (remoteIP == nil || !c.trusted(remoteIP)) && !c.fromUnixSocket(r) {
+		return remote
+	}
+	for _, header := range c.headers {
+		switch header {
+		case http.CanonicalHeaderKey(HeaderXForwardedFor):
+			if ip := c.fromForwardedFor(r.Header.Values(header)); ip != "" {
+				return ip
+			}
+		case http.CanonicalHeaderKey(HeaderXRealIP):
+			if ip := net.ParseIP(strings.TrimSpace(r.Header.Get(header))); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+	return remote
+}
+
+// fromUnixSocket tells whether the request was served on a Unix domain socket trusted as a proxy
+func (c *Config) fromUnixSocket(r *http.Request) bool {
+	addr, ok := r.Context().Value(http.LocalAddrContextKey).(net.Addr)
+	return c.unixSocket && ok && addr.Network() == "unix"
+}
+
+func (c *Config) fromForwardedFor(values []string) string {
+	var hops []net.IP
+	for _, value := range values {
+		for _, hop := range strings.Split(value, ",") {
+			ip := net.ParseIP(strings.TrimSpace(hop))
+			if ip == nil {
+				// a malformed hop breaks the chain, nothing before it can be trusted
+				hops = nil
+				continue
+			}
+			hops = append(hops, ip)
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		if !c.trusted(hops[i]) || i == 0 {
+			return hops[i].String()
+		}
+	}
+	return ""
+}
+
+var (
+	globalMu     sync.RWMutex
+	globalConfig = &Config{}
+)
+
+// Set replaces the configuration used by ClientIP, it can be changed while Console is running
+func Set(c *Config) {
+	globalMu.Lock()
+	defer globalMu.Unlock()
+	globalConfig = c
+}
+
+// Get returns the configuration used by ClientIP
+func Get() *Config {
+	globalMu.RLock()
+	defer globalMu.RUnlock()
+	return globalConfig
+}
+
+// ClientIP returns the address of the client that originated the request using the current configuration
+func ClientIP(r *http.Request) string {
+	return Get().ClientIP(r)
+}
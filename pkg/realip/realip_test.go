@@ -0,0 +1,181 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package realip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	config, err := New([]string{"10.0.0.0/8", "192.168.1.1"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	realIPFirst, err := New([]string{"10.0.0.0/8"}, []string{"x-real-ip", "x-forwarded-for"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		config  *Config
+		remote  string
+		local   net.Addr
+		headers map[string]string
+		want    string
+	}{
+		{
+			name:   "direct client",
+			config: config,
+			remote: "203.0.113.7:51234",
+			want:   "203.0.113.7",
+		},
+		{
+			name:    "untrusted client spoofing the header",
+			config:  config,
+			remote:  "203.0.113.7:51234",
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:    "203.0.113.7",
+		},
+		{
+			name:    "single trusted proxy",
+			config:  config,
+			remote:  "10.1.2.3:443",
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "chain of trusted proxies",
+			config:  config,
+			remote:  "10.1.2.3:443",
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.9, 198.51.100.1, 192.168.1.1"},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "only trusted hops",
+			config:  config,
+			remote:  "10.1.2.3:443",
+			headers: map[string]string{"X-Forwarded-For": "10.0.0.5, 10.0.0.6"},
+			want:    "10.0.0.5",
+		},
+		{
+			name:    "falls back to x-real-ip",
+			config:  config,
+			remote:  "10.1.2.3:443",
+			headers: map[string]string{"X-Real-IP": "198.51.100.2"},
+			want:    "198.51.100.2",
+		},
+		{
+			name:    "header precedence",
+			config:  realIPFirst,
+			remote:  "10.1.2.3:443",
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1", "X-Real-IP": "198.51.100.2"},
+			want:    "198.51.100.2",
+		},
+		{
+			name:    "malformed header",
+			config:  config,
+			remote:  "10.1.2.3:443",
+			headers: map[string]string{"X-Forwarded-For": "not-an-ip"},
+			want:    "10.1.2.3",
+		},
+		{
+			name:    "no trusted proxies",
+			config:  &Config{},
+			remote:  "10.1.2.3:443",
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:    "10.1.2.3",
+		},
+		{
+			name:    "ipv6 proxy",
+			config:  mustNew(t, []string{"fd00::/8"}),
+			remote:  "[fd00::1]:443",
+			headers: map[string]string{"X-Forwarded-For": "2001:db8::1"},
+			want:    "2001:db8::1",
+		},
+		{
+			name:    "trusted unix socket",
+			config:  mustNew(t, []string{"unix"}),
+			remote:  "@",
+			local:   &net.UnixAddr{Name: "/run/console/console.sock", Net: "unix"},
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:    "198.51.100.1",
+		},
+		{
+			name:    "untrusted unix socket",
+			config:  config,
+			remote:  "@",
+			local:   &net.UnixAddr{Name: "/run/console/console.sock", Net: "unix"},
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:    "@",
+		},
+		{
+			name:    "unix socket trusted on tcp",
+			config:  mustNew(t, []string{"unix"}),
+			remote:  "203.0.113.7:51234",
+			local:   &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 9090},
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.1"},
+			want:    "203.0.113.7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/session", nil)
+			r.RemoteAddr = tt.remote
+			if tt.local != nil {
+				r = r.WithContext(context.WithValue(r.Context(), http.LocalAddrContextKey, tt.local))
+			}
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+			if got := tt.config.ClientIP(r); got != tt.want {
+				t.Errorf("ClientIP() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New([]string{"10.0.0.0/33"}, nil); err == nil {
+		t.Error("expected an error for an invalid CIDR")
+	}
+	if _, err := New([]string{"proxy.local"}, nil); err == nil {
+		t.Error("expected an error for a hostname")
+	}
+	if _, err := New(nil, []string{"Forwarded"}); err == nil {
+		t.Error("expected an error for an unsupported header")
+	}
+	c := mustNew(t, []string{"192.168.1.1", " 10.0.0.0/8 ", "unix"})
+	if got := c.Proxies(); len(got) != 3 || got[0] != "192.168.1.1/32" || got[1] != "10.0.0.0/8" || got[2] != "unix" {
+		t.Errorf("Proxies() = %v", got)
+	}
+	if got := c.Headers(); len(got) != 2 || got[0] != "X-Forwarded-For" || got[1] != "X-Real-Ip" {
+		t.Errorf("Headers() = %v", got)
+	}
+}
+
+func mustNew(t *testing.T, proxies []string) *Config {
+	c, err := New(proxies, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
@@ -0,0 +1,370 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package replay records the REST interactions served by Console into a golden
+// file and serves them back deterministically, so integrators can exercise the
+// Console API without a live MinIO cluster.
+package replay
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/minio/console/pkg/actionaudit"
+)
+
+// Mode of the replay harness
+type Mode string
+
+const (
+	// ModeOff serves every request with the real handlers
+	ModeOff Mode = "off"
+	// ModeRecord serves every request with the real handlers and stores the interaction
+	ModeRecord Mode = "record"
+	// ModeReplay serves every request from the stored interactions
+	ModeReplay Mode = "replay"
+)
+
+// response headers that depend on the moment, the session or the request of the recording
+var ignoredHeaders = []string{"Date", "Set-Cookie", "Content-Length", "X-Request-ID"}
+
+// LogError logs the interactions that couldn't be recorded, Console replaces it with its own logger
+var LogError = func(ctx context.Context, msg string, data ...interface{}) {
+	log.Printf(msg, data...)
+}
+
+// Request identifies a recorded request. The request body is only kept as a digest,
+// that way credentials sent on login are never written to the fixtures.
+type Request struct {
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Query      string `json:"query,omitempty"`
+	BodySHA256 string `json:"body_sha256,omitempty"`
+}
+
+// Response is the recorded answer to a Request
+type Response struct {
+	Status     int         `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+	BodyBase64 string      `json:"body_base64,omitempty"`
+}
+
+// Interaction is a request with the response that was served for it
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+func (r Request) key() string {
+	return r.Method + " " + r.Path + "?" + r.Query + "#" + r.BodySHA256
+}
+
+func (r Response) body() ([]byte, error) {
+	if r.BodyBase64 != "" {
+		return base64.StdEncoding.DecodeString(r.BodyBase64)
+	}
+	return []byte(r.Body), nil
+}
+
+// newRequest reads the body of the http request, leaving it available for the
+// next handlers, and returns its recorded representation
+func newRequest(r *http.Request) (Request, error) {
+	req := Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		// Encode sorts the parameters by key, so the order used by the client doesn't matter
+		Query: r.URL.Query().Encode(),
+	}
+	if r.Body == nil {
+		return req, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return req, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if len(body) > 0 {
+		sum := sha256.Sum256(body)
+		req.BodySHA256 = hex.EncodeToString(sum[:])
+	}
+	return req, nil
+}
+
+// Cassette is the set of interactions stored in a golden file, one JSON interaction per line
+type Cassette struct {
+	path string
+
+	mu           sync.Mutex
+	interactions []Interaction
+	// number of times every request has been replayed
+	served map[string]int
+}
+
+// Load reads the cassette stored in path, a missing file is an empty cassette
+func Load(path string) (*Cassette, error) {
+	c := &Cassette{path: path, served: map[string]int{}}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	for {
+		var i Interaction
+		if err := dec.Decode(&i); err != nil {
+			if errors.Is(err, io.EOF) {
+				return c, nil
+			}
+			return nil, fmt.Errorf("invalid fixtures file %s: %w", path, err)
+		}
+		c.interactions = append(c.interactions, i)
+	}
+}
+
+// Interactions returns a copy of the recorded interactions
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// add stores a new interaction and appends it to the golden file
+func (c *Cassette) add(i Interaction) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.interactions = append(c.interactions, i)
+	// query strings and bodies are stored as is
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(i); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(c.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// match returns the recorded response for the request. Requests recorded more than
+// once are answered in the order they were recorded, repeating the last one when the
+// recording is exhausted.
+func (c *Cassette) match(req Request) (Response, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := req.key()
+	var found []Response
+	for _, i := range c.interactions {
+		if i.Request.key() == key {
+			found = append(found, i.Response)
+		}
+	}
+	if len(found) == 0 {
+		return Response{}, false
+	}
+	n := c.served[key]
+	c.served[key]++
+	if n >= len(found) {
+		n = len(found) - 1
+	}
+	return found[n], true
+}
+
+// recordWriter copies everything written to the client
+type recordWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recordWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *recordWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *recordWriter) response() Response {
+	resp := Response{Status: w.status, Header: w.Header().Clone()}
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+	for _, h := range ignoredHeaders {
+		resp.Header.Del(h)
+	}
+	if len(resp.Header) == 0 {
+		resp.Header = nil
+	}
+	if body, ok := redactJSON(resp.Header.Get("Content-Type"), w.body.Bytes()); ok {
+		resp.Body = string(body)
+	} else if utf8.Valid(w.body.Bytes()) {
+		resp.Body = w.body.String()
+	} else {
+		resp.BodyBase64 = base64.StdEncoding.EncodeToString(w.body.Bytes())
+	}
+	return resp
+}
+
+// redactJSON returns the JSON body with the values of its secret fields, such as the keys and the session tokens
+// Console returns, replaced. It reports false for the bodies that aren't JSON.
+func redactJSON(contentType string, body []byte) ([]byte, bool) {
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType != "application/json" {
+		return nil, false
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return nil, false
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactValue(value)); err != nil {
+		return nil, false
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), true
+}
+
+// redactValue replaces the strings of the secret fields at any depth, the other values keep their type so the
+// replayed responses still decode
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			if _, ok := field.(string); ok && actionaudit.IsSecretParam(name) {
+				v[name] = actionaudit.Redacted
+			} else {
+				v[name] = redactValue(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// Handler wraps next according to mode, storing or reading the interactions
+// of the requests whose path starts with prefix from the cassette
+func Handler(mode Mode, prefix string, c *Cassette, next http.Handler) http.Handler {
+	switch mode {
+	case ModeRecord:
+		return recordHandler(prefix, c, next)
+	case ModeReplay:
+		return replayHandler(prefix, c, next)
+	default:
+		return next
+	}
+}
+
+func recordHandler(prefix string, c *Cassette, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		req, err := newRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rw := &recordWriter{ResponseWriter: w}
+		next.ServeHTTP(rw, r)
+		if err := c.add(Interaction{Request: req, Response: rw.response()}); err != nil {
+			LogError(r.Context(), "unable to record %s %s: %v", req.Method, req.Path, err)
+		}
+	})
+}
+
+func replayHandler(prefix string, c *Cassette, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		req, err := newRequest(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, ok := c.match(req)
+		if !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotImplemented)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"code":    http.StatusNotImplemented,
+				"message": fmt.Sprintf("no recorded interaction for %s %s", req.Method, req.Path),
+			})
+			return
+		}
+		body, err := resp.body()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		live := w.Header().Clone()
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		// the fixtures recorded before a header was ignored don't replace the one of the live request
+		for _, h := range ignoredHeaders {
+			if v := live.Values(h); len(v) > 0 {
+				w.Header()[http.CanonicalHeaderKey(h)] = v
+			} else {
+				w.Header().Del(h)
+			}
+		}
+		w.WriteHeader(resp.Status)
+		w.Write(body)
+	})
+}
@@ -0,0 +1,197 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package replay
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const goldenFile = "testdata/interactions.jsonl"
+
+// consoleStub answers the requests stored in the golden file the same way Console does
+func consoleStub() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "token", Value: "secret-session"})
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/buckets/test/objects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"objects":[{"name":"folder/file.txt","size":4}],"total":1}`))
+	})
+	mux.HandleFunc("/api/v1/buckets/test/objects/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write([]byte{0xff, 0x00, 0xfe, 0x01})
+	})
+	return mux
+}
+
+type testRequest struct {
+	method string
+	url    string
+	body   string
+}
+
+var goldenRequests = []testRequest{
+	{method: "POST", url: "/api/v1/login", body: `{"accessKey":"minio","secretKey":"minio123"}`},
+	{method: "GET", url: "/api/v1/buckets/test/objects?recursive=true&prefix=Zm9sZGVyLw=="},
+	{method: "GET", url: "/api/v1/buckets/test/objects/download?prefix=Zm9sZGVyL2ZpbGUudHh0"},
+}
+
+func serve(h http.Handler, req testRequest) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(req.method, req.url, strings.NewReader(req.body)))
+	return rec
+}
+
+func TestRecordMatchesGoldenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interactions.jsonl")
+	cassette, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := Handler(ModeRecord, "/api/", cassette, consoleStub())
+	for _, req := range goldenRequests {
+		serve(h, req)
+	}
+	// requests outside of the prefix are not recorded
+	serve(h, testRequest{method: "GET", url: "/index.html"})
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("recorded interactions don't match %s, got:\n%s", goldenFile, got)
+	}
+	if bytes.Contains(got, []byte("minio123")) || bytes.Contains(got, []byte("secret-session")) {
+		t.Errorf("credentials must not be recorded")
+	}
+}
+
+func TestRecordRedactsSecrets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interactions.jsonl")
+	cassette, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"accessKey":"svc","secretKey":"svc-secret","sessionToken":"svc-token","passwordChangeRequired":true,"users":[{"name":"bob","password":"bob-password"}]}`))
+	})
+	serve(Handler(ModeRecord, "/api/", cassette, next), testRequest{method: "POST", url: "/api/v1/service-account-credentials"})
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"accessKey":"svc","passwordChangeRequired":true,"secretKey":"REDACTED","sessionToken":"REDACTED","users":[{"name":"bob","password":"REDACTED"}]}`
+	if len(got.interactions) != 1 || got.interactions[0].Response.Body != want {
+		t.Errorf("recorded interactions = %+v, want body %s", got.interactions, want)
+	}
+}
+
+func TestReplayGoldenFile(t *testing.T) {
+	cassette, err := Load(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := Handler(ModeReplay, "/api/", cassette, http.NotFoundHandler())
+	for _, req := range goldenRequests {
+		want := serve(consoleStub(), req)
+		got := serve(h, req)
+		if got.Code != want.Code {
+			t.Errorf("%s %s: status = %d, want %d", req.method, req.url, got.Code, want.Code)
+		}
+		if got.Header().Get("Content-Type") != want.Header().Get("Content-Type") {
+			t.Errorf("%s %s: content type = %q, want %q", req.method, req.url, got.Header().Get("Content-Type"), want.Header().Get("Content-Type"))
+		}
+		if !bytes.Equal(got.Body.Bytes(), want.Body.Bytes()) {
+			t.Errorf("%s %s: body = %q, want %q", req.method, req.url, got.Body.Bytes(), want.Body.Bytes())
+		}
+	}
+
+	// a login with different credentials was never recorded
+	got := serve(h, testRequest{method: "POST", url: "/api/v1/login", body: `{"accessKey":"minio","secretKey":"wrong"}`})
+	if got.Code != http.StatusNotImplemented {
+		t.Errorf("unrecorded request: status = %d, want %d", got.Code, http.StatusNotImplemented)
+	}
+	// requests outside of the prefix reach the next handler
+	got = serve(h, testRequest{method: "GET", url: "/index.html"})
+	if got.Code != http.StatusNotFound {
+		t.Errorf("request outside of prefix: status = %d, want %d", got.Code, http.StatusNotFound)
+	}
+}
+
+func TestReplayKeepsRequestID(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "interactions.jsonl")
+	cassette, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "recorded")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	serve(Handler(ModeRecord, "/api/", cassette, next), testRequest{method: "GET", url: "/api/v1/session"})
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(got, []byte("recorded")) {
+		t.Errorf("the request ID must not be recorded, got:\n%s", got)
+	}
+
+	// the fixtures recorded with the request ID don't replace the live one either
+	cassette.interactions[0].Response.Header = http.Header{"X-Request-Id": {"recorded"}}
+	h := Handler(ModeReplay, "/api/", cassette, http.NotFoundHandler())
+	withRequestID := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-ID", "live")
+		h.ServeHTTP(w, r)
+	})
+	rec := serve(withRequestID, testRequest{method: "GET", url: "/api/v1/session"})
+	if rec.Code != http.StatusNoContent || rec.Header().Get("X-Request-ID") != "live" {
+		t.Errorf("status = %d, request ID = %q, want %d and %q", rec.Code, rec.Header().Get("X-Request-ID"), http.StatusNoContent, "live")
+	}
+}
+
+func TestReplayRepeatedRequests(t *testing.T) {
+	cassette := &Cassette{
+		served: map[string]int{},
+		interactions: []Interaction{
+			{Request: Request{Method: "GET", Path: "/api/v1/session"}, Response: Response{Status: 200, Body: "first"}},
+			{Request: Request{Method: "GET", Path: "/api/v1/session"}, Response: Response{Status: 200, Body: "second"}},
+		},
+	}
+	h := Handler(ModeReplay, "/api/", cassette, http.NotFoundHandler())
+	for _, want := range []string{"first", "second", "second"} {
+		got := serve(h, testRequest{method: "GET", url: "/api/v1/session"})
+		if got.Body.String() != want {
+			t.Errorf("body = %q, want %q", got.Body.String(), want)
+		}
+	}
+}
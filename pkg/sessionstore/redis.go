@@ -0,0 +1,241 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package sessionstore
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRedisPrefix   = "console:session:"
+	defaultRedisTimeout  = 5 * time.Second
+	defaultRedisPoolSize = 16
+)
+
+// RedisConfig is how to reach the Redis server keeping the sessions
+type RedisConfig struct {
+	Address  string
+	Username string
+	Password string
+	DB       int
+	// Prefix is prepended to the session IDs to make the Redis keys
+	Prefix string
+	// TLS is used to connect when set
+	TLS *tls.Config
+	// Timeout bounds every command without a deadline of its own
+	Timeout time.Duration
+	// PoolSize is the number of idle connections kept
+	PoolSize int
+}
+
+// Redis is a Store in a Redis server. It speaks the few commands it needs of the Redis protocol itself and
+// expires the sessions with the key TTLs.
+type Redis struct {
+	config RedisConfig
+	idle   chan *redisConn
+}
+
+type redisConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+// redisError is an error reply of the server
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+// NewRedis returns a Redis store, the connections are opened when needed
+func NewRedis(config RedisConfig) *Redis {
+	if config.Timeout <= 0 {
+		config.Timeout = defaultRedisTimeout
+	}
+	if config.PoolSize <= 0 {
+		config.PoolSize = defaultRedisPoolSize
+	}
+	return &Redis{
+		config: config,
+		idle:   make(chan *redisConn, config.PoolSize),
+	}
+}
+
+// Put implements Store
+func (r *Redis) Put(ctx context.Context, id string, payload []byte, ttl time.Duration) error {
+	milliseconds := ttl.Milliseconds()
+	if milliseconds <= 0 {
+		milliseconds = 1
+	}
+	_, err := r.do(ctx, "SET", r.config.Prefix+id, string(payload), "PX", strconv.FormatInt(milliseconds, 10))
+	return err
+}
+
+// Get implements Store
+func (r *Redis) Get(ctx context.Context, id string) ([]byte, error) {
+	reply, err := r.do(ctx, "GET", r.config.Prefix+id)
+	if err != nil {
+		return nil, err
+	}
+	payload, ok := reply.([]byte)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return payload, nil
+}
+
+// Delete implements Store
+func (r *Redis) Delete(ctx context.Context, id string) error {
+	_, err := r.do(ctx, "DEL", r.config.Prefix+id)
+	return err
+}
+
+// String implements Store
+func (r *Redis) String() string {
+	return "redis " + r.config.Address
+}
+
+// Close closes the idle connections
+func (r *Redis) Close() {
+	for {
+		select {
+		case conn := <-r.idle:
+			conn.Close()
+		default:
+			return
+		}
+	}
+}
+
+// do runs a command on an idle connection or a new one, the connection is kept when the server answered
+func (r *Redis) do(ctx context.Context, args ...string) (interface{}, error) {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := conn.do(ctx, r.config.Timeout, args...)
+	var replyErr redisError
+	if err != nil && !errors.As(err, &replyErr) {
+		conn.Close()
+		return nil, err
+	}
+	select {
+	case r.idle <- conn:
+	default:
+		conn.Close()
+	}
+	return reply, err
+}
+
+func (r *Redis) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-r.idle:
+		return conn, nil
+	default:
+	}
+	dialer := &net.Dialer{Timeout: r.config.Timeout}
+	var conn net.Conn
+	var err error
+	if r.config.TLS != nil {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: r.config.TLS}).DialContext(ctx, "tcp", r.config.Address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", r.config.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c := &redisConn{Conn: conn, reader: bufio.NewReader(conn)}
+	if r.config.Password != "" {
+		args := []string{"AUTH", r.config.Password}
+		if r.config.Username != "" {
+			args = []string{"AUTH", r.config.Username, r.config.Password}
+		}
+		if _, err := c.do(ctx, r.config.Timeout, args...); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if r.config.DB != 0 {
+		if _, err := c.do(ctx, r.config.Timeout, "SELECT", strconv.Itoa(r.config.DB)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// do sends a command and reads its reply, a bulk string reply is returned as []byte and a null one as nil
+func (c *redisConn) do(ctx context.Context, timeout time.Duration, args ...string) (interface{}, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(timeout)
+	}
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	command := []byte("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		command = append(command, "$"+strconv.Itoa(len(arg))+"\r\n"...)
+		command = append(command, arg...)
+		command = append(command, "\r\n"...)
+	}
+	if _, err := c.Write(command); err != nil {
+		return nil, err
+	}
+	return c.readReply()
+}
+
+func (c *redisConn) readReply() (interface{}, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	kind, value := line[0], line[1:len(line)-2]
+	switch kind {
+	case '+':
+		return value, nil
+	case '-':
+		return nil, redisError(value)
+	case ':':
+		return strconv.ParseInt(value, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("redis: malformed reply %q", line)
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(c.reader, data); err != nil {
+			return nil, err
+		}
+		return data[:size], nil
+	}
+	return nil, fmt.Errorf("redis: unexpected reply %q", line)
+}
@@ -0,0 +1,152 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package sessionstore keeps the Console sessions in a backend shared by all the Console replicas, so any of them
+// can serve a session without sticky routing.
+package sessionstore
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned for a session that doesn't exist or has expired
+var ErrNotFound = errors.New("session not found")
+
+// Store keeps opaque session payloads by ID until they expire
+type Store interface {
+	// Put stores the payload of the session id for ttl, replacing the previous one
+	Put(ctx context.Context, id string, payload []byte, ttl time.Duration) error
+	// Get returns the payload of the session id or ErrNotFound
+	Get(ctx context.Context, id string) ([]byte, error)
+	// Delete removes the session id, removing a missing session isn't an error
+	Delete(ctx context.Context, id string) error
+	// String describes the store in logs
+	String() string
+}
+
+// New returns the store of the URL, either memory:// or redis://[user[:password]@]host[:port][/db] and rediss:// for
+// Redis over TLS. The Redis keys are prefixed with the prefix query parameter, console:session: by default.
+func New(rawURL string, tlsConfig *tls.Config) (Store, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session store URL: %w", err)
+	}
+	switch u.Scheme {
+	case "memory":
+		return NewMemory(), nil
+	case "redis", "rediss":
+		config := RedisConfig{
+			Address: u.Host,
+			Prefix:  defaultRedisPrefix,
+		}
+		if u.Port() == "" {
+			config.Address = u.Hostname() + ":6379"
+		}
+		if u.User != nil {
+			config.Username = u.User.Username()
+			config.Password, _ = u.User.Password()
+			// redis://:password@host authenticates the default user
+			if _, ok := u.User.Password(); !ok {
+				config.Username, config.Password = "", config.Username
+			}
+		}
+		if db := strings.Trim(u.Path, "/"); db != "" {
+			if config.DB, err = strconv.Atoi(db); err != nil {
+				return nil, fmt.Errorf("invalid Redis database %q", db)
+			}
+		}
+		if prefix, ok := u.Query()["prefix"]; ok {
+			config.Prefix = prefix[0]
+		}
+		if u.Scheme == "rediss" {
+			config.TLS = tlsConfig
+			if config.TLS == nil {
+				config.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+		}
+		return NewRedis(config), nil
+	}
+	return nil, fmt.Errorf("unsupported session store %q", u.Scheme)
+}
+
+// Memory is a Store in the memory of the process, it can't be shared between replicas
+type Memory struct {
+	mu       sync.Mutex
+	sessions map[string]memorySession
+	now      func() time.Time
+}
+
+type memorySession struct {
+	payload []byte
+	expires time.Time
+}
+
+// NewMemory returns an empty Memory store
+func NewMemory() *Memory {
+	return &Memory{
+		sessions: map[string]memorySession{},
+		now:      time.Now,
+	}
+}
+
+// Put implements Store
+func (m *Memory) Put(_ context.Context, id string, payload []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := m.now()
+	for other, session := range m.sessions {
+		if !now.Before(session.expires) {
+			delete(m.sessions, other)
+		}
+	}
+	m.sessions[id] = memorySession{
+		payload: append([]byte(nil), payload...),
+		expires: now.Add(ttl),
+	}
+	return nil
+}
+
+// Get implements Store
+func (m *Memory) Get(_ context.Context, id string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session, ok := m.sessions[id]
+	if !ok || !m.now().Before(session.expires) {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), session.payload...), nil
+}
+
+// Delete implements Store
+func (m *Memory) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sessions, id)
+	return nil
+}
+
+// String implements Store
+func (m *Memory) String() string {
+	return "memory"
+}
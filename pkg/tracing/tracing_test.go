@@ -0,0 +1,101 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestInitInvalidEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"", "otel-collector:4318", "grpc://otel-collector:4317"} {
+		if _, err := Init(context.Background(), Config{Endpoint: endpoint}); err == nil {
+			t.Errorf("expected %q to be rejected", endpoint)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		exports []string
+	)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		exports = append(exports, r.URL.Path+" "+r.Header.Get("Authorization")+" "+string(body))
+		mu.Unlock()
+	}))
+	defer collector.Close()
+
+	var traceparent string
+	minio := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer minio.Close()
+
+	shutdown, err := Init(context.Background(), Config{
+		Endpoint:       collector.URL,
+		Headers:        map[string]string{"Authorization": "Bearer token"},
+		SampleRatio:    1,
+		ServiceName:    "console",
+		ServiceVersion: "v0.0.0-test",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		SetName(r.Context(), "AdminInfo")
+		req, _ := http.NewRequestWithContext(r.Context(), http.MethodGet, minio.URL+"/minio/admin/v3/info", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		resp.Body.Close()
+	}), func(r *http.Request) bool { return strings.HasPrefix(r.URL.Path, "/api/") })
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/v1/admin/info", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/static/js/main.js", nil))
+	if err = shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(traceparent, "00-") {
+		t.Errorf("expected the trace to be passed to the server, got %q", traceparent)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(exports) != 1 {
+		t.Fatalf("expected a single export, got %d", len(exports))
+	}
+	for _, want := range []string{"/v1/traces Bearer token", "AdminInfo", "HTTP GET", "v0.0.0-test"} {
+		if !strings.Contains(exports[0], want) {
+			t.Errorf("expected the export to contain %q", want)
+		}
+	}
+	if strings.Contains(exports[0], "main.js") {
+		t.Error("expected the static files not to be traced")
+	}
+}
@@ -0,0 +1,363 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package twofactor implements the time-based one-time passwords (RFC 6238) and the recovery
+// codes asked to the users enrolled in two-factor authentication when they log in to Console.
+package twofactor
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+)
+
+const (
+	// Digits is the length of the one-time passwords
+	Digits = 6
+	// Period is how long a one-time password is valid
+	Period = 30 * time.Second
+	// skew is the number of periods before and after the current one accepted for clock drift
+	skew = 1
+	// secretSize is the size in bytes of the shared secrets, the one of the HMAC-SHA1 output
+	secretSize = 20
+	// RecoveryCodes is the number of recovery codes given when enabling two-factor authentication
+	RecoveryCodes = 10
+	// recoveryCodeSize is the number of characters of a recovery code
+	recoveryCodeSize = 10
+)
+
+var (
+	// ErrInvalidCode is returned when a code is neither the current one-time password nor an unused
+	// recovery code
+	ErrInvalidCode = errors.New("invalid two-factor authentication code")
+	// ErrNotEnrolled is returned when the user didn't start an enrollment
+	ErrNotEnrolled = errors.New("two-factor authentication isn't enrolled")
+	// ErrAlreadyEnabled is returned when enrolling a user with two-factor authentication enabled
+	ErrAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+)
+
+var secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a random shared secret
+func GenerateSecret() ([]byte, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// EncodeSecret returns the base32 form of the secret typed in authenticator apps
+func EncodeSecret(secret []byte) string {
+	return secretEncoding.EncodeToString(secret)
+}
+
+// Code returns the one-time password of the secret at t
+func Code(secret []byte, t time.Time) string {
+	return hotp(secret, counter(t))
+}
+
+func counter(t time.Time) int64 {
+	return t.Unix() / int64(Period/time.Second)
+}
+
+// hotp returns the one-time password of the secret for a counter, RFC 4226
+func hotp(secret []byte, counter int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	// dynamic truncation, RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
+
+// ProvisioningURI returns the otpauth URI, usually shown as a QR code, adding the account of the
+// issuer to an authenticator app
+func ProvisioningURI(issuer, account string, secret []byte) string {
+	params := url.Values{}
+	params.Set("secret", EncodeSecret(secret))
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(Digits))
+	params.Set("period", fmt.Sprint(int(Period/time.Second)))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: params.Encode(),
+	}
+	return u.String()
+}
+
+// generateRecoveryCodes returns random recovery codes formatted in two groups, like abcde-fghij
+func generateRecoveryCodes() ([]string, error) {
+	const chars = "abcdefghijklmnopqrstuvwxyz234567"
+	codes := make([]string, RecoveryCodes)
+	for i := range codes {
+		buf := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		for j := range buf {
+			buf[j] = chars[int(buf[j])%len(chars)]
+		}
+		codes[i] = string(buf[:recoveryCodeSize/2]) + "-" + string(buf[recoveryCodeSize/2:])
+	}
+	return codes, nil
+}
+
+// hashRecoveryCode returns the hash of a recovery code ignoring its case and separators, the codes
+// are random enough not to need a slow hash
+func hashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// Cipher protects the shared secrets in the persisted state, owner is the user the secret belongs to
+type Cipher interface {
+	Encrypt(plaintext []byte, owner string) (string, error)
+	Decrypt(ciphertext, owner string) ([]byte, error)
+}
+
+// plainCipher keeps the secrets base32 encoded, it is used when the store isn't given a cipher
+type plainCipher struct{}
+
+func (plainCipher) Encrypt(plaintext []byte, _ string) (string, error) {
+	return EncodeSecret(plaintext), nil
+}
+
+func (plainCipher) Decrypt(ciphertext, _ string) ([]byte, error) {
+	return secretEncoding.DecodeString(ciphertext)
+}
+
+// Status is the two-factor authentication state of a user
+type Status struct {
+	// Enabled is whether the user is asked a code when logging in
+	Enabled bool
+	// Pending is whether the user started an enrollment that wasn't confirmed with a code yet
+	Pending bool
+	// RecoveryCodesLeft is the number of unused recovery codes
+	RecoveryCodesLeft int
+}
+
+type userState struct {
+	Secret  string `json:"secret"`
+	Enabled bool   `json:"enabled"`
+	// LastCounter is the period of the last accepted one-time password, it can't be used twice
+	LastCounter   int64    `json:"lastCounter"`
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
+}
+
+// Store holds the two-factor authentication state of the users, optionally persisted to a file
+type Store struct {
+	path   string
+	cipher Cipher
+
+	mu    sync.Mutex
+	users map[string]*userState
+}
+
+// New creates a store protecting the secrets with cipher, they are only base32 encoded when it is
+// nil. When path isn't empty the state is loaded from and saved to that file, a missing file is an
+// empty state.
+func New(path string, cipher Cipher) (*Store, error) {
+	if cipher == nil {
+		cipher = plainCipher{}
+	}
+	s := &Store{path: path, cipher: cipher, users: map[string]*userState{}}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(data, &s.users); err != nil {
+				return nil, fmt.Errorf("invalid two-factor authentication file %s: %w", path, err)
+			}
+		}
+	}
+	return s, nil
+}
+
+// Enabled returns whether the user is asked a code when logging in
+func (s *Store) Enabled(user string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	return ok && state.Enabled
+}
+
+// Status returns the two-factor authentication state of the user
+func (s *Store) Status(user string) Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	if !ok {
+		return Status{}
+	}
+	return Status{Enabled: state.Enabled, Pending: !state.Enabled, RecoveryCodesLeft: len(state.RecoveryCodes)}
+}
+
+// Enroll starts the enrollment of the user with a new secret, replacing the one of a previous
+// enrollment that wasn't confirmed
+func (s *Store) Enroll(user string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if state, ok := s.users[user]; ok && state.Enabled {
+		return nil, ErrAlreadyEnabled
+	}
+	secret, err := GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := s.cipher.Encrypt(secret, user)
+	if err != nil {
+		return nil, err
+	}
+	s.users[user] = &userState{Secret: encrypted}
+	return secret, s.save()
+}
+
+// Activate confirms the enrollment of the user with a one-time password from the authenticator
+// app and returns the recovery codes, they are only kept hashed
+func (s *Store) Activate(user, code string, now time.Time) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	if !ok {
+		return nil, ErrNotEnrolled
+	}
+	if state.Enabled {
+		return nil, ErrAlreadyEnabled
+	}
+	if err := s.verifyCode(user, state, code, now); err != nil {
+		return nil, err
+	}
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	state.Enabled = true
+	state.RecoveryCodes = make([]string, len(codes))
+	for i, code := range codes {
+		state.RecoveryCodes[i] = hashRecoveryCode(code)
+	}
+	return codes, s.save()
+}
+
+// Verify checks the code given by the user when logging in, a one-time password or a recovery code
+// that can't be used again. Users without two-factor authentication enabled don't have any valid
+// code.
+func (s *Store) Verify(user, code string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.users[user]
+	if !ok || !state.Enabled {
+		return ErrNotEnrolled
+	}
+	if err := s.verifyCode(user, state, code, now); err == nil {
+		return s.save()
+	}
+	hash := hashRecoveryCode(code)
+	for i, recoveryCode := range state.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(recoveryCode), []byte(hash)) == 1 {
+			state.RecoveryCodes = append(state.RecoveryCodes[:i], state.RecoveryCodes[i+1:]...)
+			return s.save()
+		}
+	}
+	return ErrInvalidCode
+}
+
+// verifyCode checks a one-time password of the user and records its period, the caller holds the
+// lock and saves the state
+func (s *Store) verifyCode(user string, state *userState, code string, now time.Time) error {
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return ErrInvalidCode
+	}
+	secret, err := s.cipher.Decrypt(state.Secret, user)
+	if err != nil {
+		return err
+	}
+	current := counter(now)
+	for c := current - skew; c <= current+skew; c++ {
+		if c <= state.LastCounter {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(code), []byte(hotp(secret, c))) == 1 {
+			state.LastCounter = c
+			return nil
+		}
+	}
+	return ErrInvalidCode
+}
+
+// Disable turns two-factor authentication off for the user once they confirm with a code
+func (s *Store) Disable(user, code string, now time.Time) error {
+	if err := s.Verify(user, code, now); err != nil {
+		return err
+	}
+	return s.Reset(user)
+}
+
+// Reset drops the two-factor authentication state of the user, an administrator uses it when the
+// user lost both their authenticator and their recovery codes
+func (s *Store) Reset(user string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.users, user)
+	return s.save()
+}
+
+// save writes the state to the file of the store, the caller holds the lock
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s.users)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(s.path, data)
+}
@@ -0,0 +1,136 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package twofactor
+
+import (
+	"errors"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCode(t *testing.T) {
+	// RFC 6238 appendix B, truncated to six digits
+	secret := []byte("12345678901234567890")
+	tests := []struct {
+		time int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		if got := Code(secret, time.Unix(tt.time, 0)); got != tt.want {
+			t.Errorf("Code(%d) = %s, want %s", tt.time, got, tt.want)
+		}
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("MinIO Console", "alice", []byte("12345678901234567890"))
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" || u.Path != "/MinIO Console:alice" {
+		t.Errorf("unexpected URI %s", uri)
+	}
+	if got := u.Query().Get("secret"); got != "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" {
+		t.Errorf("unexpected secret %s", got)
+	}
+	if u.Query().Get("issuer") != "MinIO Console" || u.Query().Get("digits") != "6" || u.Query().Get("period") != "30" {
+		t.Errorf("unexpected parameters %s", u.RawQuery)
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "two-factor.json")
+	store, err := New(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	if err := store.Verify("alice", "123456", now); !errors.Is(err, ErrNotEnrolled) {
+		t.Errorf("verifying a user that isn't enrolled returned %v", err)
+	}
+	if _, err := store.Activate("alice", "123456", now); !errors.Is(err, ErrNotEnrolled) {
+		t.Errorf("activating a user that isn't enrolled returned %v", err)
+	}
+
+	secret, err := store.Enroll("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.Enabled("alice") || !store.Status("alice").Pending {
+		t.Errorf("an enrollment isn't enabled until confirmed: %+v", store.Status("alice"))
+	}
+	if _, err := store.Activate("alice", Code(secret, now.Add(10*Period)), now); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("activating with a wrong code returned %v", err)
+	}
+	codes, err := store.Activate("alice", Code(secret, now), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != RecoveryCodes || !store.Enabled("alice") {
+		t.Fatalf("unexpected activation %v %+v", codes, store.Status("alice"))
+	}
+	if _, err := store.Enroll("alice"); !errors.Is(err, ErrAlreadyEnabled) {
+		t.Errorf("enrolling an enabled user returned %v", err)
+	}
+
+	// a one-time password can't be replayed, the next one and the previous one are accepted
+	if err := store.Verify("alice", Code(secret, now), now); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("replaying a code returned %v", err)
+	}
+	if err := store.Verify("alice", Code(secret, now.Add(Period)), now); err != nil {
+		t.Errorf("a code of the next period was rejected: %v", err)
+	}
+	if err := store.Verify("alice", Code(secret, now.Add(-10*Period)), now.Add(2*Period)); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("an old code returned %v", err)
+	}
+
+	// recovery codes are single use, their case and separator don't matter
+	recovery := strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))
+	if err := store.Verify("alice", recovery, now); err != nil {
+		t.Errorf("a recovery code was rejected: %v", err)
+	}
+	if err := store.Verify("alice", codes[0], now); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("reusing a recovery code returned %v", err)
+	}
+	if left := store.Status("alice").RecoveryCodesLeft; left != RecoveryCodes-1 {
+		t.Errorf("%d recovery codes left", left)
+	}
+
+	// the state survives a restart
+	reloaded, err := New(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded.Enabled("alice") || reloaded.Status("alice").RecoveryCodesLeft != RecoveryCodes-1 {
+		t.Errorf("unexpected reloaded state %+v", reloaded.Status("alice"))
+	}
+	if err := reloaded.Disable("alice", codes[1], now); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.Enabled("alice") || reloaded.Status("alice").Pending {
+		t.Errorf("two-factor authentication wasn't disabled: %+v", reloaded.Status("alice"))
+	}
+}
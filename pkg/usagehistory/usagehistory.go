@@ -0,0 +1,173 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package usagehistory keeps periodic samples of the size and object count of the
+// buckets, so Console can chart their growth. MinIO only reports the current usage.
+package usagehistory
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/utils"
+)
+
+// Sample is the usage of a bucket at a point in time
+type Sample struct {
+	Time    time.Time `json:"time"`
+	Size    int64     `json:"size"`
+	Objects int64     `json:"objects"`
+}
+
+// Usage is the current usage of a bucket, as reported by MinIO
+type Usage struct {
+	Size    int64
+	Objects int64
+}
+
+// Store holds the samples of every bucket, optionally persisted to a file
+type Store struct {
+	path      string
+	interval  time.Duration
+	retention time.Duration
+
+	mu      sync.Mutex
+	buckets map[string][]Sample
+}
+
+// New creates a store taking a sample every interval and keeping them for retention.
+// When path isn't empty the samples are loaded from and saved to that file, a missing
+// file is an empty history.
+func New(path string, interval, retention time.Duration) (*Store, error) {
+	if interval <= 0 || retention < interval {
+		return nil, fmt.Errorf("invalid usage history interval %s and retention %s", interval, retention)
+	}
+	s := &Store{path: path, interval: interval, retention: retention, buckets: map[string][]Sample{}}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.buckets); err != nil {
+		return nil, fmt.Errorf("invalid usage history file %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Interval returns the time between two samples of a bucket
+func (s *Store) Interval() time.Duration {
+	return s.interval
+}
+
+// Retention returns for how long samples are kept
+func (s *Store) Retention() time.Duration {
+	return s.retention
+}
+
+// due returns whether a bucket needs a new sample, the caller holds the lock
+func (s *Store) due(bucket string, now time.Time) bool {
+	samples := s.buckets[bucket]
+	return len(samples) == 0 || now.Sub(samples[len(samples)-1].Time) >= s.interval
+}
+
+// Record adds a sample for every bucket whose last one is older than the interval and
+// drops the samples past the retention. Buckets that no longer exist keep their history
+// until it expires.
+func (s *Store) Record(now time.Time, usage map[string]Usage) error {
+	return s.record(now, usage, false)
+}
+
+// RecordNow adds a sample for every bucket regardless of the interval, used when the usage
+// was refreshed on demand
+func (s *Store) RecordNow(now time.Time, usage map[string]Usage) error {
+	return s.record(now, usage, true)
+}
+
+func (s *Store) record(now time.Time, usage map[string]Usage, force bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := false
+	for bucket, u := range usage {
+		if !force && !s.due(bucket, now) {
+			continue
+		}
+		s.buckets[bucket] = append(s.buckets[bucket], Sample{Time: now.UTC(), Size: u.Size, Objects: u.Objects})
+		changed = true
+	}
+	oldest := now.Add(-s.retention)
+	for bucket, samples := range s.buckets {
+		i := sort.Search(len(samples), func(i int) bool { return !samples[i].Time.Before(oldest) })
+		if i == 0 {
+			continue
+		}
+		changed = true
+		if i == len(samples) {
+			delete(s.buckets, bucket)
+			continue
+		}
+		s.buckets[bucket] = append([]Sample(nil), samples[i:]...)
+	}
+	if !changed {
+		return nil
+	}
+	return s.save()
+}
+
+// save writes the samples to the file of the store, the caller holds the lock
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(s.buckets)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFileAtomic(s.path, data)
+}
+
+// Series returns the samples of a bucket taken at or after since, oldest first
+func (s *Store) Series(bucket string, since time.Time) []Sample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	samples := s.buckets[bucket]
+	i := sort.Search(len(samples), func(i int) bool { return !samples[i].Time.Before(since) })
+	return append([]Sample{}, samples[i:]...)
+}
+
+// LastChange returns the time of the oldest sample of a bucket that holds its latest usage,
+// false when the bucket has no sample
+func (s *Store) LastChange(bucket string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	samples := s.buckets[bucket]
+	if len(samples) == 0 {
+		return time.Time{}, false
+	}
+	i := len(samples) - 1
+	for i > 0 && samples[i-1].Size == samples[i].Size && samples[i-1].Objects == samples[i].Objects {
+		i--
+	}
+	return samples[i].Time, true
+}
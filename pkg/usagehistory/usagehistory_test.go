@@ -0,0 +1,105 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package usagehistory
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+	store, err := New(path, time.Hour, 3*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	if err = store.Record(start, map[string]Usage{"photos": {Size: 10, Objects: 1}, "logs": {Size: 5, Objects: 5}}); err != nil {
+		t.Fatal(err)
+	}
+	// too soon, ignored
+	if err = store.Record(start.Add(30*time.Minute), map[string]Usage{"photos": {Size: 20, Objects: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.Series("photos", time.Time{}); len(got) != 1 || got[0].Size != 10 {
+		t.Fatalf("expected a single sample before the interval, got %+v", got)
+	}
+	for i := 1; i <= 4; i++ {
+		if err = store.Record(start.Add(time.Duration(i)*time.Hour), map[string]Usage{"photos": {Size: int64(10 * (i + 1)), Objects: int64(i + 1)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	series := store.Series("photos", time.Time{})
+	// samples older than the retention are dropped
+	if len(series) != 4 || series[0].Size != 20 || series[3].Size != 50 {
+		t.Fatalf("unexpected series %+v", series)
+	}
+	if got := store.Series("photos", start.Add(3*time.Hour)); len(got) != 2 {
+		t.Fatalf("expected 2 samples since the third hour, got %d", len(got))
+	}
+	// logs stopped reporting, its history expired
+	if got := store.Series("logs", time.Time{}); len(got) != 0 {
+		t.Fatalf("expected the logs history to expire, got %+v", got)
+	}
+
+	// the history survives a restart
+	reloaded, err := New(path, time.Hour, 3*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reloaded.Series("photos", time.Time{}); len(got) != 4 || !got[3].Time.Equal(start.Add(4*time.Hour)) {
+		t.Fatalf("unexpected reloaded series %+v", got)
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	if _, err := New("", 0, time.Hour); err == nil {
+		t.Fatal("expected an error for a zero interval")
+	}
+	if _, err := New("", time.Hour, time.Minute); err == nil {
+		t.Fatal("expected an error for a retention shorter than the interval")
+	}
+}
+
+func TestRecordNowAndLastChange(t *testing.T) {
+	store, err := New("", time.Hour, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	if _, ok := store.LastChange("photos"); ok {
+		t.Fatal("expected no last change without samples")
+	}
+	if err = store.Record(start, map[string]Usage{"photos": {Size: 10, Objects: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	// a forced sample is kept even before the interval elapsed
+	if err = store.RecordNow(start.Add(time.Minute), map[string]Usage{"photos": {Size: 20, Objects: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.RecordNow(start.Add(2*time.Minute), map[string]Usage{"photos": {Size: 20, Objects: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.Series("photos", time.Time{}); len(got) != 3 {
+		t.Fatalf("expected 3 samples, got %+v", got)
+	}
+	if got, ok := store.LastChange("photos"); !ok || !got.Equal(start.Add(time.Minute)) {
+		t.Fatalf("unexpected last change %v", got)
+	}
+}
@@ -17,7 +17,10 @@
 import React, { useEffect, useState } from "react";
 import { Navigate, useLocation } from "react-router-dom";
 import api from "./common/api";
-import { ISessionResponse } from "./screens/Console/types";
+import {
+  ISessionRenewResponse,
+  ISessionResponse,
+} from "./screens/Console/types";
 import useApi from "./screens/Console/Common/Hooks/useApi";
 import { ErrorResponseHandler } from "./common/types";
 import { ReplicationSite } from "./screens/Console/Configurations/SiteReplication/SiteReplication";
@@ -43,6 +46,7 @@ const ProtectedRoute = ({ Component }: ProtectedRouteProps) => {
   const dispatch = useAppDispatch();
 
   const [sessionLoading, setSessionLoading] = useState<boolean>(true);
+  const [renewAt, setRenewAt] = useState<number>(0);
   const userLoggedIn = useSelector((state: AppState) => state.system.loggedIn);
   const anonymousMode = useSelector(
     (state: AppState) => state.system.anonymousMode
@@ -64,6 +68,9 @@ const ProtectedRoute = ({ Component }: ProtectedRouteProps) => {
         dispatch(saveSessionResponse(res));
         dispatch(userLogged(true));
         setSessionLoading(false);
+        if (res.sessionRenewIn) {
+          setRenewAt(Date.now() + res.sessionRenewIn * 1000);
+        }
         dispatch(globalSetDistributedSetup(res.distributedMode || false));
 
         if (res.customStyles && res.customStyles !== "") {
@@ -108,6 +115,26 @@ const ProtectedRoute = ({ Component }: ProtectedRouteProps) => {
       });
   }, [dispatch, screen, pathnameParts]);
 
+  // OpenID sessions renew their credentials silently before they expire
+  useEffect(() => {
+    if (renewAt === 0) {
+      return;
+    }
+    const timer = setTimeout(() => {
+      api
+        .invoke("POST", `/api/v1/session/renew`)
+        .then((res: ISessionRenewResponse) => {
+          setRenewAt(
+            res.sessionRenewIn ? Date.now() + res.sessionRenewIn * 1000 : 0
+          );
+        })
+        .catch((err: ErrorResponseHandler) => {
+          console.error("Unable to renew the session", err);
+        });
+    }, Math.max(renewAt - Date.now(), 0));
+    return () => clearTimeout(timer);
+  }, [renewAt]);
+
   const [, invokeSRInfoApi] = useApi(
     (res: any) => {
       const { name: curSiteName, enabled = false } = res || {};
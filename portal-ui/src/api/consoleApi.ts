@@ -69,11 +69,196 @@ export interface Bucket {
 export interface BucketEncryptionRequest {
   encType?: BucketEncryptionType;
   kmsKeyID?: string;
+  createKey?: boolean;
+  kmsContext?: Record<string, string>;
 }
 
 export interface BucketEncryptionInfo {
   kmsMasterKeyID?: string;
   algorithm?: string;
+  kmsContext?: Record<string, string>;
+}
+
+export interface BucketCorsRule {
+  id?: string;
+  allowedOrigins: string[];
+  allowedMethods: string[];
+  allowedHeaders?: string[];
+  exposeHeaders?: string[];
+  /** @format int64 */
+  maxAgeSeconds?: number;
+}
+
+export interface BucketCorsConfiguration {
+  rules?: BucketCorsRule[];
+}
+
+export interface PrefixUsage {
+  prefix?: string;
+  /** @format int64 */
+  size?: number;
+  /** @format int64 */
+  objects?: number;
+}
+
+export interface BucketPrefixUsageResponse {
+  prefix?: string;
+  /** @format int32 */
+  depth?: number;
+  /** @format int64 */
+  totalSize?: number;
+  /** @format int64 */
+  totalObjects?: number;
+  /** @format int64 */
+  directSize?: number;
+  /** @format int64 */
+  directObjects?: number;
+  truncated?: boolean;
+  /** @format int64 */
+  total?: number;
+  prefixes?: PrefixUsage[];
+}
+
+export interface BucketUsageSample {
+  time?: string;
+  /** @format int64 */
+  size?: number;
+  /** @format int64 */
+  objects?: number;
+}
+
+export interface BucketUsageHistory {
+  bucket?: string;
+  /** @format int64 */
+  interval?: number;
+  /** @format int64 */
+  retention?: number;
+  samples?: BucketUsageSample[];
+}
+
+export interface BucketConfigSnapshot {
+  /** @format int32 */
+  version?: number;
+  bucket?: string;
+  exportedAt?: string;
+  policy?: string;
+  lifecycle?: object;
+  replication?: object;
+  encryption?: object;
+  notifications?: object;
+  tags?: Record<string, string>;
+  quota?: BucketQuota;
+  locking?: GetBucketRetentionConfig;
+}
+
+export interface BucketConfigImportRequest {
+  snapshot: BucketConfigSnapshot;
+  dryRun?: boolean;
+}
+
+export interface BucketConfigSectionChange {
+  section?: string;
+  action?: "unchanged" | "create" | "update" | "skip";
+  applied?: boolean;
+  error?: string;
+}
+
+export interface BucketConfigImportResponse {
+  dryRun?: boolean;
+  applied?: boolean;
+  sections?: BucketConfigSectionChange[];
+}
+
+export interface BulkBucketTag {
+  key: string;
+  value?: string;
+}
+
+export interface BulkBucketOperationRequest {
+  buckets: string[];
+  operation: "setTag" | "setQuota" | "enableVersioning" | "applyLifecycle";
+  tag?: BulkBucketTag;
+  quota?: SetBucketQuota;
+  lifecycle?: AddBucketLifecycle;
+}
+
+export interface BulkBucketOperationResult {
+  bucket?: string;
+  error?: string;
+}
+
+export interface BulkBucketOperationResponse {
+  operation?: string;
+  /** @format int64 */
+  succeeded?: number;
+  /** @format int64 */
+  failed?: number;
+  results?: BulkBucketOperationResult[];
+}
+
+export interface BucketRenameRequest {
+  target: string;
+  delete_source?: boolean;
+  update_policies?: boolean;
+}
+
+export interface BucketRenameFailure {
+  object?: string;
+  version_id?: string;
+  error?: string;
+}
+
+export interface BucketRenameJob {
+  id?: string;
+  source?: string;
+  target?: string;
+  delete_source?: boolean;
+  update_policies?: boolean;
+  state?: "running" | "completed" | "failed" | "canceled";
+  phase?: "copying" | "configuring" | "policies" | "deleting" | "done";
+  started?: string;
+  finished?: string;
+  /** @format int64 */
+  objects?: number;
+  /** @format int64 */
+  copied?: number;
+  /** @format int64 */
+  copied_bytes?: number;
+  /** @format int64 */
+  failed_objects_count?: number;
+  failed_objects?: BucketRenameFailure[];
+  updated_policies?: string[];
+  warnings?: string[];
+  source_deleted?: boolean;
+  error?: string;
+}
+
+export interface AccessInsightEntry {
+  name?: string;
+  /** @format int64 */
+  requests?: number;
+  /** @format int64 */
+  errors?: number;
+  /** @format double */
+  error_rate?: number;
+  last_seen?: string;
+}
+
+export interface BucketAccessInsight {
+  bucket?: string;
+  since?: string;
+  /** @format int64 */
+  requests?: number;
+  /** @format int64 */
+  client_errors?: number;
+  /** @format int64 */
+  server_errors?: number;
+  /** @format double */
+  error_rate?: number;
+  truncated?: boolean;
+  top_callers?: AccessInsightEntry[];
+  top_apis?: AccessInsightEntry[];
+  top_remote_hosts?: AccessInsightEntry[];
 }
 
 export interface ListBucketsResponse {
@@ -84,6 +269,8 @@ export interface ListBucketsResponse {
    * @format int64
    */
   total?: number;
+  /** cursor of the next page, empty on the last page */
+  nextCursor?: string;
 }
 
 export interface UserServiceAccountSummary {
@@ -129,6 +316,35 @@ export interface MakeBucketRequest {
   versioning?: boolean;
   quota?: SetBucketQuota;
   retention?: PutBucketRetentionRequest;
+  encryption?: BucketEncryptionRequest;
+  tags?: any;
+}
+
+export interface BatchRequest {
+  requests: BatchSubrequest[];
+}
+
+export interface BatchSubrequest {
+  /** label of the call returned with its response */
+  id?: string;
+  method: "GET" | "POST" | "PUT" | "PATCH" | "DELETE";
+  /** path of the call under /api/v1 with its query, such as /buckets/photos/quota */
+  path: string;
+  /** JSON body of the call */
+  body?: object;
+}
+
+export interface BatchResponse {
+  /** responses of the calls that ran, the last one is the failed call that stopped the batch if any */
+  responses?: BatchSubresponse[];
+}
+
+export interface BatchSubresponse {
+  id?: string;
+  /** @format int32 */
+  status?: number;
+  /** JSON body of the response */
+  body?: object;
 }
 
 export interface Error {
@@ -136,6 +352,7 @@ export interface Error {
   code?: number;
   message: string;
   detailedMessage: string;
+  missingPermission?: string;
 }
 
 export interface User {
@@ -149,6 +366,13 @@ export interface User {
 export interface ListUsersResponse {
   /** list of resulting users */
   users?: User[];
+  /**
+   * total number of users
+   * @format int64
+   */
+  total?: number;
+  /** cursor of the next page, empty on the last page */
+  nextCursor?: string;
 }
 
 export interface AddUserRequest {
@@ -158,6 +382,42 @@ export interface AddUserRequest {
   policies: string[];
 }
 
+export interface AccessKeyInventoryEntry {
+  accessKey?: string;
+  type?: "user" | "serviceAccount";
+  parentUser?: string;
+  status?: string;
+  policies?: string[];
+  inlinePolicy?: boolean;
+  updatedAt?: string;
+  expiration?: string;
+  lastUsed?: string;
+}
+
+export interface AccessKeyInventory {
+  keys?: AccessKeyInventoryEntry[];
+  auditAvailable?: boolean;
+  generatedAt?: string;
+}
+
+export interface UserImportResult {
+  /** @format int32 */
+  row?: number;
+  accessKey?: string;
+  status?: "valid" | "created" | "invalid" | "failed" | "rolledBack";
+  error?: string;
+  secretKey?: string;
+  credentialsDelivered?: boolean;
+}
+
+export interface UserImportResponse {
+  dryRun?: boolean;
+  imported?: boolean;
+  /** @format int64 */
+  created?: number;
+  results?: UserImportResult[];
+}
+
 export interface Group {
   name?: string;
   status?: string;
@@ -216,6 +476,93 @@ export interface AddPolicyRequest {
   policy: string;
 }
 
+export interface PolicySimulationRequest {
+  users?: string[];
+  groups?: string[];
+  policies?: string[];
+  action: string;
+  resource?: string;
+  sourceIP?: string;
+  prefix?: string;
+}
+
+export interface PolicySimulationStatement {
+  policy?: string;
+  sid?: string;
+  effect?: string;
+  statement?: string;
+}
+
+export interface PolicySimulationResult {
+  allowed?: boolean;
+  decision?: "allow" | "explicitDeny" | "implicitDeny";
+  evaluatedPolicies?: string[];
+  matchingStatements?: PolicySimulationStatement[];
+}
+
+export interface PolicyValidationRequest {
+  policy: string;
+}
+
+export interface PolicyValidationIssue {
+  severity?: "error" | "warning";
+  code?: string;
+  message?: string;
+  path?: string;
+  /** @format int64 */
+  line?: number;
+}
+
+export interface PolicyValidationResult {
+  valid?: boolean;
+  issues?: PolicyValidationIssue[];
+}
+
+export interface PolicyTemplateParameter {
+  name?: string;
+  label?: string;
+  required?: boolean;
+  multiple?: boolean;
+  defaultValue?: string;
+}
+
+export interface PolicyTemplate {
+  name?: string;
+  title?: string;
+  description?: string;
+  parameters?: PolicyTemplateParameter[];
+}
+
+export interface PolicyTemplateList {
+  templates?: PolicyTemplate[];
+}
+
+export interface PolicyTemplateRenderRequest {
+  parameters?: Record<string, string>;
+}
+
+export interface PolicyTemplateRender {
+  template?: string;
+  policy?: string;
+}
+
+export interface PolicyOpenIDMapping {
+  configName?: string;
+  enabled?: boolean;
+  claimName?: string;
+  roleArn?: string;
+}
+
+export interface PolicyEntities {
+  policy?: string;
+  users?: string[];
+  groups?: string[];
+  ldapUsers?: string[];
+  ldapGroups?: string[];
+  openID?: PolicyOpenIDMapping[];
+  warnings?: string[];
+}
+
 export interface AddServiceAccountPolicyRequest {
   policy: string;
 }
@@ -228,6 +575,8 @@ export interface ListPoliciesResponse {
    * @format int64
    */
   total?: number;
+  /** cursor of the next page, empty on the last page */
+  nextCursor?: string;
 }
 
 export interface ListAccessRulesResponse {
@@ -344,6 +693,97 @@ export interface BucketReplicationRule {
   destination?: BucketReplicationDestination;
 }
 
+export interface BucketReplicationTarget {
+  arn?: string;
+  endpoint?: string;
+  targetBucket?: string;
+  syncMode?: string;
+  /** @format int64 */
+  bandwidth?: number;
+  /** @format int64 */
+  healthCheckPeriod?: number;
+  online?: boolean;
+  lastOnline?: string;
+  /** @format int64 */
+  totalDowntime?: number;
+  /** @format int64 */
+  latencyCurrent?: number;
+  /** @format int64 */
+  latencyAverage?: number;
+  /** @format int64 */
+  latencyMax?: number;
+  rules?: string[];
+}
+
+export interface ReplicationLatency {
+  /** @format int64 */
+  current?: number;
+  /** @format int64 */
+  average?: number;
+  /** @format int64 */
+  max?: number;
+  /** @format int64 */
+  p50?: number;
+  /** @format int64 */
+  p90?: number;
+  /** @format int64 */
+  p99?: number;
+  /** @format int64 */
+  samples?: number;
+}
+
+export interface ReplicationTargetMetrics {
+  arn?: string;
+  endpoint?: string;
+  targetBucket?: string;
+  online?: boolean;
+  lastOnline?: string;
+  /** @format int64 */
+  totalDowntime?: number;
+  /** @format int64 */
+  pendingCount?: number;
+  /** @format int64 */
+  pendingSize?: number;
+  /** @format int64 */
+  failedCount?: number;
+  /** @format int64 */
+  failedSize?: number;
+  /** @format int64 */
+  replicatedSize?: number;
+  latency?: ReplicationLatency;
+}
+
+export interface BucketReplicationMetrics {
+  bucket?: string;
+  /** @format int64 */
+  pendingCount?: number;
+  /** @format int64 */
+  pendingSize?: number;
+  /** @format int64 */
+  failedCount?: number;
+  /** @format int64 */
+  failedSize?: number;
+  /** @format int64 */
+  replicatedSize?: number;
+  /** @format int64 */
+  replicaSize?: number;
+  targets?: ReplicationTargetMetrics[];
+}
+
+export interface BucketReplicationTargetsResponse {
+  targets?: BucketReplicationTarget[];
+}
+
+export interface BucketReplicationRulePriority {
+  id: string;
+  /** @format int32 */
+  priority: number;
+}
+
+export interface BucketReplicationPriorities {
+  rules: BucketReplicationRulePriority[];
+}
+
 export interface BucketReplicationRuleList {
   rules?: string[];
 }
@@ -380,6 +820,7 @@ export interface MultiBucketReplication {
   replicateDeleteMarkers?: boolean;
   replicateDeletes?: boolean;
   replicateMetadata?: boolean;
+  replicateExistingObjects?: boolean;
   /**
    * @format int32
    * @default 0
@@ -413,6 +854,8 @@ export interface MultiBucketReplicationEdit {
 export interface MultiBucketsRelation {
   originBucket?: string;
   destinationBucket?: string;
+  /** @format int32 */
+  priority?: number;
 }
 
 export interface MultiBucketResponseItem {
@@ -441,6 +884,8 @@ export interface ListBucketEventsResponse {
    * @format int64
    */
   total?: number;
+  /** cursor of the next page, empty on the last page */
+  nextCursor?: string;
 }
 
 export interface SetBucketPolicyRequest {
@@ -451,12 +896,16 @@ export interface SetBucketPolicyRequest {
 export interface BucketQuota {
   quota?: number;
   type?: "hard";
+  soft_limit?: number;
+  usage?: number;
+  soft_limit_exceeded?: boolean;
 }
 
 export interface SetBucketQuota {
   enabled: boolean;
   quota_type?: "hard";
   amount?: number;
+  soft_limit?: number;
 }
 
 export interface LoginDetails {
@@ -480,6 +929,8 @@ export interface LoginRequest {
   accessKey?: string;
   secretKey?: string;
   sts?: string;
+  otp?: string;
+  captcha?: string;
   features?: {
     hide_menu?: boolean;
   };
@@ -502,6 +953,14 @@ export interface Principal {
   hm?: boolean;
   ob?: boolean;
   customStyleOb?: string;
+  idpName?: string;
+  idpRefreshToken?: string;
+  /** @format int64 */
+  expiration?: number;
+  /** @format int64 */
+  issuedAt?: number;
+  clusterID?: string;
+  loginCluster?: string;
 }
 
 export interface StartProfilingItem {
@@ -517,10 +976,40 @@ export interface StartProfilingList {
    */
   total?: number;
   startResults?: StartProfilingItem[];
+  /** time the profiling is stopped unless it is stopped before */
+  stopsAt?: string;
+}
+
+export interface ServerNodeVersion {
+  endpoint?: string;
+  state?: string;
+  version?: string;
+  outdated?: boolean;
+}
+
+export interface ServerUpdateCheck {
+  latestVersion?: string;
+  updateAvailable?: boolean;
+  nodes?: ServerNodeVersion[];
+}
+
+export interface ServiceConfirmationRequest {
+  action: "restart" | "update";
+}
+
+export interface ServiceConfirmation {
+  action?: string;
+  token?: string;
+  expiresAt?: string;
 }
 
 export interface ProfilingStartRequest {
   type: string;
+  /**
+   * seconds the profiling can run before it's stopped
+   * @format int64
+   */
+  duration?: number;
 }
 
 export interface SessionResponse {
@@ -533,6 +1022,15 @@ export interface SessionResponse {
   customStyles?: string;
   allowResources?: PermissionResource[];
   envConstants?: EnvironmentConstants;
+  passwordChangeRequired?: boolean;
+  /** @format int64 */
+  sessionRenewIn?: number;
+  consoleGrants?: string[];
+}
+
+export interface SessionRenew {
+  /** @format int64 */
+  sessionRenewIn?: number;
 }
 
 export interface WidgetResult {
@@ -572,6 +1070,22 @@ export interface WidgetDetails {
   targets?: ResultTarget[];
 }
 
+export interface PreflightCheck {
+  name?: string;
+  critical?: boolean;
+  status?: "ok" | "failed";
+  error?: string;
+  hint?: string;
+  /** @format int64 */
+  durationMs?: number;
+}
+
+export interface PreflightReport {
+  status?: "ok" | "degraded" | "failed";
+  checkedAt?: string;
+  checks?: PreflightCheck[];
+}
+
 export interface AdminInfoResponse {
   buckets?: number;
   objects?: number;
@@ -641,10 +1155,35 @@ export interface NotificationEndpointItem {
   status?: string;
 }
 
-export interface NotificationEndpoint {
-  service: NofiticationService;
-  account_id: string;
-  properties: Record<string, string>;
+export interface NotificationEndpointStatus {
+  service?: string;
+  account_id?: string;
+  address?: string;
+  enabled?: boolean;
+  status?: "online" | "offline" | "disabled" | "unknown";
+  error?: string;
+  queue_dir?: string;
+  buckets?: string[];
+}
+
+export interface NotificationEndpointsStatus {
+  endpoints?: NotificationEndpointStatus[];
+  /** @format int64 */
+  total?: number;
+  /** @format int64 */
+  online?: number;
+  /** @format int64 */
+  offline?: number;
+  /** @format int64 */
+  disabled?: number;
+  /** @format int64 */
+  unknown?: number;
+}
+
+export interface NotificationEndpoint {
+  service: NofiticationService;
+  account_id: string;
+  properties: Record<string, string>;
 }
 
 export interface SetNotificationEndpointResponse {
@@ -654,6 +1193,78 @@ export interface SetNotificationEndpointResponse {
   restart?: boolean;
 }
 
+export interface UpdateNotificationEndpointRequest {
+  properties: Record<string, string>;
+}
+
+export interface BucketPolicyValidateRequest {
+  policy: string;
+}
+
+export interface BucketPolicyIssue {
+  statement?: number;
+  message?: string;
+}
+
+export interface BucketPolicyValidation {
+  valid?: boolean;
+  errors?: BucketPolicyIssue[];
+  warnings?: BucketPolicyIssue[];
+}
+
+export interface BucketPolicyPrefixRule {
+  prefix?: string;
+  access: "readonly" | "writeonly" | "readwrite";
+}
+
+export interface BucketPolicyGenerateRequest {
+  rules: BucketPolicyPrefixRule[];
+}
+
+export interface BucketPolicyGenerateResponse {
+  policy?: string;
+  warnings?: BucketPolicyIssue[];
+}
+
+export interface BucketEventTestResult {
+  arn?: string;
+  eventName?: string;
+  key?: string;
+  targetStatus?: string;
+  delivered?: boolean;
+  responseCode?: number;
+  error?: string;
+}
+
+export interface NotificationEndpointTestResult {
+  status?: string;
+  eventSent?: boolean;
+  responseCode?: number;
+  message?: string;
+}
+
+export interface LogTarget {
+  type: "audit_webhook" | "audit_kafka" | "logger_webhook";
+  name: string;
+  enabled?: boolean;
+  restart?: boolean;
+  properties?: Record<string, string>;
+}
+
+export interface LogTargetList {
+  targets?: LogTarget[];
+}
+
+export interface UpdateLogTargetRequest {
+  properties?: Record<string, string>;
+}
+
+export interface LogTargetTestResult {
+  delivered?: boolean;
+  responseCode?: number;
+  message?: string;
+}
+
 export interface NotifEndpointResponse {
   notification_endpoints?: NotificationEndpointItem[];
 }
@@ -747,6 +1358,137 @@ export interface ServiceAccountCreds {
   url?: string;
 }
 
+export interface TemporaryCredentialsRequest {
+  policy?: string;
+  /** @format int32 */
+  durationSeconds?: number;
+}
+
+export interface TemporaryCredentials {
+  accessKey?: string;
+  secretKey?: string;
+  sessionToken?: string;
+  expiration?: string;
+  url?: string;
+}
+
+export interface UserEffectivePolicySource {
+  type?: "user" | "group" | "claim";
+  name?: string;
+  policies?: string[];
+  disabled?: boolean;
+}
+
+export interface UserEffectivePolicyStatement {
+  policy?: string;
+  sources?: string[];
+  sid?: string;
+  effect?: string;
+  statement?: string;
+}
+
+export interface LoginFailure {
+  accessKey?: string;
+  sourceIP?: string;
+  time?: string;
+}
+
+export interface LoginLockout {
+  type?: "accessKey" | "sourceIP";
+  value?: string;
+  /** @format int32 */
+  failures?: number;
+  until?: string;
+}
+
+export interface LoginAttempts {
+  lockoutEnabled?: boolean;
+  failures?: LoginFailure[];
+  lockouts?: LoginLockout[];
+}
+
+export interface LoginUnlockRequest {
+  accessKey?: string;
+  sourceIP?: string;
+}
+
+export interface UserEffectivePolicy {
+  user?: string;
+  sources?: UserEffectivePolicySource[];
+  policies?: string[];
+  statements?: UserEffectivePolicyStatement[];
+  policy?: string;
+  warnings?: string[];
+}
+
+export interface UserPasswordReset {
+  accessKey?: string;
+  secretKey?: string;
+  mustChange?: boolean;
+}
+
+export interface TwoFactorStatus {
+  enabled?: boolean;
+  pending?: boolean;
+  /** @format int32 */
+  recoveryCodesLeft?: number;
+}
+
+export interface TwoFactorEnrollment {
+  secret?: string;
+  provisioningURI?: string;
+}
+
+export interface TwoFactorCodeRequest {
+  code: string;
+}
+
+export interface TwoFactorRecoveryCodes {
+  recoveryCodes?: string[];
+}
+
+export interface ApiToken {
+  name?: string;
+  scope?: "read" | "write";
+  accessKey?: string;
+  createdAt?: string;
+  expiresAt?: string;
+  expired?: boolean;
+  lastUsed?: string;
+  lastUsedFrom?: string;
+  /** @format int64 */
+  useCount?: number;
+}
+
+export interface ApiTokenList {
+  tokens?: ApiToken[];
+}
+
+export interface CreateAPITokenRequest {
+  name: string;
+  scope?: "read" | "write";
+  /** @format int64 */
+  expiresIn?: number;
+  policy?: string;
+}
+
+export interface ApiTokenCreated {
+  name?: string;
+  token?: string;
+  expiresAt?: string;
+}
+
+export interface ApiTokenUse {
+  time?: string;
+  sourceIP?: string;
+  method?: string;
+  path?: string;
+}
+
+export interface ApiTokenUsage {
+  uses?: ApiTokenUse[];
+}
+
 export interface RemoteBucket {
   /** @minLength 3 */
   accessKey: string;
@@ -813,6 +1555,55 @@ export interface LogSearchResponse {
   results?: object;
 }
 
+export interface LogEntriesResponse {
+  /** log entries of the page matching the filters */
+  results?: object;
+  /** @format int32 */
+  pageNo?: number;
+  /** @format int32 */
+  pageSize?: number;
+  hasMore?: boolean;
+}
+
+export interface ConsoleAuditEvent {
+  time?: string;
+  requestID?: string;
+  user?: string;
+  sourceIP?: string;
+  operation?: string;
+  method?: string;
+  path?: string;
+  params?: object;
+  /** @format int32 */
+  status?: number;
+  result?: string;
+  error?: string;
+}
+
+export interface ConsoleAuditEvents {
+  events?: ConsoleAuditEvent[];
+}
+
+export interface LogLevel {
+  level: "debug" | "info" | "error";
+  format?: string;
+}
+
+export interface ConsoleConfigReload {
+  file?: string;
+  /** sections whose changes were applied */
+  reloaded?: string[];
+  /** sections whose changes are only applied on restart */
+  restartRequired?: string[];
+}
+
+export interface SessionKeyRotation {
+  keyID?: string;
+  retiredKeyID?: string;
+  acceptedUntil?: string;
+  persisted?: boolean;
+}
+
 export enum ObjectLegalHoldStatus {
   Enabled = "enabled",
   Disabled = "disabled",
@@ -853,7 +1644,73 @@ export interface PutBucketRetentionRequest {
   validity: number;
 }
 
+export interface RestoreTieredObjectRequest {
+  /**
+   * number of days the restored copy will be available
+   * @format int32
+   */
+  days: number;
+  tier?: "Standard" | "Bulk" | "Expedited";
+}
+
+export interface ObjectTierRestoreStatus {
+  storage_class?: string;
+  transitioned?: boolean;
+  ongoing_restore?: boolean;
+  restore_expiry_date?: string;
+}
+
+export interface ObjectChecksumManifest {
+  object_name?: string;
+  version_id?: string;
+  etag?: string;
+  /** @format int64 */
+  size?: number;
+  algorithm?: string;
+  /** @format int64 */
+  part_size?: number;
+  parts?: ObjectChecksumManifestPart[];
+}
+
+export interface ObjectChecksumManifestPart {
+  /** @format int32 */
+  part_number?: number;
+  /** @format int64 */
+  offset?: number;
+  /** @format int64 */
+  size?: number;
+  checksum?: string;
+}
+
+export interface ObjectChecksumVerifyResponse {
+  valid?: boolean;
+  message?: string;
+  mismatched_parts?: number[];
+}
+
+export interface ObjectIntegrityRequest {
+  algorithm: "CRC32" | "CRC32C" | "SHA1" | "SHA256" | "MD5" | "ETAG";
+  /** expected digest, hex or base64 encoded */
+  digest: string;
+  /**
+   * part size used to reconstruct multipart ETags
+   * @format int64
+   */
+  part_size?: number;
+}
+
+export interface ObjectIntegrityResponse {
+  algorithm?: string;
+  valid?: boolean;
+  expected?: string;
+  computed?: string;
+  computed_base64?: string;
+  etag?: string;
+  message?: string;
+}
+
 export interface GetBucketRetentionConfig {
+  objectLockingEnabled?: boolean;
   mode?: ObjectRetentionMode;
   unit?: ObjectRetentionUnit;
   /** @format int32 */
@@ -871,6 +1728,8 @@ export interface ExpirationResponse {
   delete_marker?: boolean;
   /** @format int64 */
   noncurrent_expiration_days?: number;
+  /** @format int64 */
+  newer_noncurrent_expiration_versions?: number;
 }
 
 export interface TransitionResponse {
@@ -881,6 +1740,8 @@ export interface TransitionResponse {
   /** @format int64 */
   noncurrent_transition_days?: number;
   noncurrent_storage_class?: string;
+  /** @format int64 */
+  newer_noncurrent_transition_versions?: number;
 }
 
 export interface LifecycleTag {
@@ -895,6 +1756,10 @@ export interface ObjectBucketLifecycle {
   expiration?: ExpirationResponse;
   transition?: TransitionResponse;
   tags?: LifecycleTag[];
+  /** @format int64 */
+  object_size_greater_than?: number;
+  /** @format int64 */
+  object_size_less_than?: number;
 }
 
 export interface AddBucketLifecycle {
@@ -936,6 +1801,30 @@ export interface AddBucketLifecycle {
   noncurrentversion_transition_days?: number;
   /** Non required, can be set in case of transition is enabled */
   noncurrentversion_transition_storage_class?: string;
+  /**
+   * Non required, number of newer noncurrent versions to retain before the expiration applies
+   * @format int32
+   * @default 0
+   */
+  noncurrentversion_expiration_newer_versions?: number;
+  /**
+   * Non required, number of newer noncurrent versions to retain before the transition applies
+   * @format int32
+   * @default 0
+   */
+  noncurrentversion_transition_newer_versions?: number;
+  /**
+   * Non required, only objects larger than this size in bytes are matched
+   * @format int64
+   * @default 0
+   */
+  object_size_greater_than?: number;
+  /**
+   * Non required, only objects smaller than this size in bytes are matched
+   * @format int64
+   * @default 0
+   */
+  object_size_less_than?: number;
 }
 
 export interface UpdateBucketLifecycle {
@@ -977,6 +1866,30 @@ export interface UpdateBucketLifecycle {
   noncurrentversion_transition_days?: number;
   /** Non required, can be set in case of transition is enabled */
   noncurrentversion_transition_storage_class?: string;
+  /**
+   * Non required, number of newer noncurrent versions to retain before the expiration applies
+   * @format int32
+   * @default 0
+   */
+  noncurrentversion_expiration_newer_versions?: number;
+  /**
+   * Non required, number of newer noncurrent versions to retain before the transition applies
+   * @format int32
+   * @default 0
+   */
+  noncurrentversion_transition_newer_versions?: number;
+  /**
+   * Non required, only objects larger than this size in bytes are matched
+   * @format int64
+   * @default 0
+   */
+  object_size_greater_than?: number;
+  /**
+   * Non required, only objects smaller than this size in bytes are matched
+   * @format int64
+   * @default 0
+   */
+  object_size_less_than?: number;
 }
 
 export interface AddMultiBucketLifecycle {
@@ -1017,6 +1930,30 @@ export interface AddMultiBucketLifecycle {
   noncurrentversion_transition_days?: number;
   /** Non required, can be set in case of transition is enabled */
   noncurrentversion_transition_storage_class?: string;
+  /**
+   * Non required, number of newer noncurrent versions to retain before the expiration applies
+   * @format int32
+   * @default 0
+   */
+  noncurrentversion_expiration_newer_versions?: number;
+  /**
+   * Non required, number of newer noncurrent versions to retain before the transition applies
+   * @format int32
+   * @default 0
+   */
+  noncurrentversion_transition_newer_versions?: number;
+  /**
+   * Non required, only objects larger than this size in bytes are matched
+   * @format int64
+   * @default 0
+   */
+  object_size_greater_than?: number;
+  /**
+   * Non required, only objects smaller than this size in bytes are matched
+   * @format int64
+   * @default 0
+   */
+  object_size_less_than?: number;
 }
 
 export interface MulticycleResultItem {
@@ -1042,12 +1979,59 @@ export interface SetConfigResponse {
   restart?: boolean;
 }
 
+export interface IamImportSection {
+  name?: string;
+  added?: string[];
+  updated?: string[];
+  /** @format int64 */
+  unchanged?: number;
+}
+
+export interface IamImportResponse {
+  dryRun?: boolean;
+  imported?: boolean;
+  sections?: IamImportSection[];
+}
+
 export interface ConfigExportResponse {
   /** Returns base64 encoded value */
   value?: string;
   status?: string;
 }
 
+export interface ServerConfigImportResponse {
+  dryRun?: boolean;
+  imported?: boolean;
+  restartRequired?: boolean;
+  added?: string[];
+  updated?: string[];
+  removed?: string[];
+  /** @format int64 */
+  unchanged?: number;
+}
+
+export interface ConfigRevision {
+  /** @format int64 */
+  id?: number;
+  target?: string;
+  time?: string;
+  user?: string;
+  action?: "set" | "reset";
+  previous?: string;
+  config?: string;
+  /** @format int64 */
+  rollbackOf?: number;
+}
+
+export interface ConfigRevisions {
+  revisions?: ConfigRevision[];
+}
+
+export interface TrustedProxiesConfiguration {
+  proxies?: string[];
+  headers?: string[];
+}
+
 export interface License {
   email?: string;
   organization?: string;
@@ -1148,14 +2132,34 @@ export interface TierListResponse {
 export interface TierCredentialsRequest {
   access_key?: string;
   secret_key?: string;
-  /** a base64 encoded value */
   creds?: string;
 }
 
-export interface RewindItem {
-  last_modified?: string;
-  /** @format int64 */
-  size?: number;
+export interface TierVerification {
+  name?: string;
+  type?: string;
+  online?: boolean;
+  error?: string;
+}
+
+export interface TierStats {
+  name?: string;
+  type?: string;
+  /** @format int64 */
+  objects?: number;
+  /** @format int64 */
+  versions?: number;
+  /** @format int64 */
+  size?: number;
+  online?: boolean;
+  /** a base64 encoded value */
+  error?: string;
+}
+
+export interface RewindItem {
+  last_modified?: string;
+  /** @format int64 */
+  size?: number;
   version_id?: string;
   delete_flag?: boolean;
   action?: string;
@@ -1252,6 +2256,25 @@ export interface KmsKeyStatusResponse {
   decryptionErr?: string;
 }
 
+export interface KmsTestKeyRequest {
+  /** bucket the SSE-KMS probe object is written to, the object round trip is skipped without one */
+  bucket?: string;
+}
+
+export interface KmsTestStep {
+  name?: "dataKey" | "encryptObject" | "decryptObject";
+  success?: boolean;
+  error?: string;
+  /** @format int64 */
+  durationMs?: number;
+}
+
+export interface KmsTestKeyResponse {
+  keyID?: string;
+  success?: boolean;
+  steps?: KmsTestStep[];
+}
+
 export interface KmsCreateKeyRequest {
   key: string;
 }
@@ -1378,6 +2401,7 @@ export interface RedirectRule {
   redirect?: string;
   displayName?: string;
   serviceType?: string;
+  name?: string;
 }
 
 export interface IdpServerConfiguration {
@@ -1395,10 +2419,49 @@ export interface IdpServerConfigurationInfo {
   isEnv?: boolean;
 }
 
+export interface IdpConfigurationTestStep {
+  name?: string;
+  status?: "passed" | "failed" | "warning" | "skipped";
+  message?: string;
+}
+
+export interface IdpConfigurationTestResult {
+  success?: boolean;
+  steps?: IdpConfigurationTestStep[];
+}
+
 export interface IdpListConfigurationsResponse {
   results?: IdpServerConfiguration[];
 }
 
+export interface OpenIDClaimMapping {
+  configName?: string;
+  enabled?: boolean;
+  mode?: "claim" | "role";
+  claimName?: string;
+  claimPrefix?: string;
+  rolePolicy?: string;
+  roleArn?: string;
+}
+
+export interface OpenIDClaimMappingRequest {
+  claimName?: string;
+  claimPrefix?: string;
+  rolePolicy?: string;
+}
+
+export interface OpenIDClaimMappingPreviewRequest {
+  token: string;
+}
+
+export interface OpenIDClaimMappingPreview {
+  claim?: string;
+  claimValue?: string;
+  policies?: string[];
+  missingPolicies?: string[];
+  warnings?: string[];
+}
+
 export interface SetIDPResponse {
   restart?: boolean;
 }
@@ -1495,274 +2558,3413 @@ export interface LdapPolicyEntity {
   groups?: string[];
 }
 
-export type QueryParamsType = Record<string | number, any>;
-export type ResponseFormat = keyof Omit<Body, "body" | "bodyUsed">;
+export interface LdapPolicyAssociationRequest {
+  policies: string[];
+  user?: string;
+  group?: string;
+}
 
-export interface FullRequestParams extends Omit<RequestInit, "body"> {
-  /** set parameter to `true` for call `securityWorker` for this request */
-  secure?: boolean;
-  /** request path */
-  path: string;
-  /** content type of request body */
-  type?: ContentType;
-  /** query params */
-  query?: QueryParamsType;
-  /** format of response (i.e. response.json() -> format: "json") */
-  format?: ResponseFormat;
-  /** request body */
-  body?: unknown;
-  /** base url */
-  baseUrl?: string;
-  /** request cancellation token */
-  cancelToken?: CancelToken;
+export interface LdapPolicyAssociationResponse {
+  user?: string;
+  group?: string;
+  policies?: string[];
 }
 
-export type RequestParams = Omit<
-  FullRequestParams,
-  "body" | "method" | "query" | "path"
->;
+export interface LdapEffectivePolicyRequest {
+  user: string;
+  groups?: string[];
+}
 
-export interface ApiConfig<SecurityDataType = unknown> {
-  baseUrl?: string;
-  baseApiParams?: Omit<RequestParams, "baseUrl" | "cancelToken" | "signal">;
-  securityWorker?: (
-    securityData: SecurityDataType | null
-  ) => Promise<RequestParams | void> | RequestParams | void;
-  customFetch?: typeof fetch;
+export interface LdapEffectivePolicy {
+  user?: string;
+  userPolicies?: string[];
+  groups?: LdapGroupPolicyEntity[];
+  policies?: string[];
+  policy?: string;
 }
 
-export interface HttpResponse<D extends unknown, E extends unknown = unknown>
-  extends Response {
-  data: D;
-  error: E;
+export interface StagedOperation {
+  id?: string;
+  action: "delete" | "tag";
+  bucket_name: string;
+  path: string;
+  version_id?: string;
+  recursive?: boolean;
+  tags?: Record<string, string>;
 }
 
-type CancelToken = Symbol | string | number;
+export interface StagingWorkspace {
+  id?: string;
+  name?: string;
+  created?: string;
+  updated?: string;
+  operations?: StagedOperation[];
+}
 
-export enum ContentType {
-  Json = "application/json",
-  FormData = "multipart/form-data",
-  UrlEncoded = "application/x-www-form-urlencoded",
-  Text = "text/plain",
+export interface ListStagingWorkspacesResponse {
+  workspaces?: StagingWorkspace[];
 }
 
-export class HttpClient<SecurityDataType = unknown> {
-  public baseUrl: string = "/api/v1";
-  private securityData: SecurityDataType | null = null;
-  private securityWorker?: ApiConfig<SecurityDataType>["securityWorker"];
-  private abortControllers = new Map<CancelToken, AbortController>();
-  private customFetch = (...fetchParams: Parameters<typeof fetch>) =>
-    fetch(...fetchParams);
+export interface CreateStagingWorkspaceRequest {
+  name?: string;
+}
 
-  private baseApiParams: RequestParams = {
-    credentials: "same-origin",
-    headers: {},
-    redirect: "follow",
-    referrerPolicy: "no-referrer",
-  };
+export interface AddStagedOperationsRequest {
+  operations: StagedOperation[];
+}
 
-  constructor(apiConfig: ApiConfig<SecurityDataType> = {}) {
-    Object.assign(this, apiConfig);
-  }
+export interface StagedOperationFailure {
+  operation_id?: string;
+  error?: string;
+}
 
-  public setSecurityData = (data: SecurityDataType | null) => {
-    this.securityData = data;
-  };
+export interface StagingCommitResponse {
+  /** @format int64 */
+  succeeded?: number;
+  /** @format int64 */
+  failed?: number;
+  failures?: StagedOperationFailure[];
+}
 
-  protected encodeQueryParam(key: string, value: any) {
-    const encodedKey = encodeURIComponent(key);
-    return `${encodedKey}=${encodeURIComponent(
-      typeof value === "number" ? value : `${value}`
-    )}`;
-  }
+export interface ReplicationResyncRequest {
+  arn: string;
+  /** @format int64 */
+  olderThan?: number;
+}
 
-  protected addQueryParam(query: QueryParamsType, key: string) {
-    return this.encodeQueryParam(key, query[key]);
-  }
+export interface ReplicationResyncTarget {
+  arn?: string;
+  resetID?: string;
+  status?: string;
+  startTime?: string;
+  endTime?: string;
+  /** @format int64 */
+  replicatedCount?: number;
+  /** @format int64 */
+  replicatedSize?: number;
+  /** @format int64 */
+  failedCount?: number;
+  /** @format int64 */
+  failedSize?: number;
+  /** @format int64 */
+  pendingCount?: number;
+  /** @format int64 */
+  pendingSize?: number;
+  lastObject?: string;
+}
 
-  protected addArrayQueryParam(query: QueryParamsType, key: string) {
-    const value = query[key];
-    return value.map((v: any) => this.encodeQueryParam(key, v)).join("&");
-  }
+export interface ReplicationResyncStatus {
+  targets?: ReplicationResyncTarget[];
+}
 
-  protected toQueryString(rawQuery?: QueryParamsType): string {
-    const query = rawQuery || {};
-    const keys = Object.keys(query).filter(
-      (key) => "undefined" !== typeof query[key]
-    );
-    return keys
-      .map((key) =>
-        Array.isArray(query[key])
-          ? this.addArrayQueryParam(query, key)
-          : this.addQueryParam(query, key)
-      )
-      .join("&");
-  }
+export interface ReplicationRetryRequest {
+  prefix?: string;
+  /** @format int32 */
+  batch_size?: number;
+}
 
-  protected addQueryParams(rawQuery?: QueryParamsType): string {
-    const queryString = this.toQueryString(rawQuery);
-    return queryString ? `?${queryString}` : "";
-  }
+export interface ReplicationRetryFailure {
+  object?: string;
+  version_id?: string;
+  error?: string;
+}
 
-  private contentFormatters: Record<ContentType, (input: any) => any> = {
-    [ContentType.Json]: (input: any) =>
-      input !== null && (typeof input === "object" || typeof input === "string")
-        ? JSON.stringify(input)
-        : input,
-    [ContentType.Text]: (input: any) =>
-      input !== null && typeof input !== "string"
-        ? JSON.stringify(input)
-        : input,
-    [ContentType.FormData]: (input: any) =>
-      Object.keys(input || {}).reduce((formData, key) => {
-        const property = input[key];
-        formData.append(
-          key,
-          property instanceof Blob
-            ? property
-            : typeof property === "object" && property !== null
-            ? JSON.stringify(property)
-            : `${property}`
-        );
-        return formData;
-      }, new FormData()),
-    [ContentType.UrlEncoded]: (input: any) => this.toQueryString(input),
-  };
+export interface ReplicationRetryJob {
+  id?: string;
+  bucket?: string;
+  prefix?: string;
+  state?: "running" | "completed" | "failed";
+  started?: string;
+  finished?: string;
+  /** @format int64 */
+  scanned?: number;
+  /** @format int64 */
+  failed_objects?: number;
+  /** @format int64 */
+  retriggered?: number;
+  /** @format int64 */
+  replicated?: number;
+  /** @format int64 */
+  pending?: number;
+  /** @format int64 */
+  persistent_failures_count?: number;
+  persistent_failures?: ReplicationRetryFailure[];
+  error?: string;
+}
 
-  protected mergeRequestParams(
-    params1: RequestParams,
-    params2?: RequestParams
-  ): RequestParams {
-    return {
-      ...this.baseApiParams,
-      ...params1,
-      ...(params2 || {}),
-      headers: {
-        ...(this.baseApiParams.headers || {}),
-        ...(params1.headers || {}),
-        ...((params2 && params2.headers) || {}),
-      },
-    };
-  }
+export interface ClusterSnapshot {
+  deploymentID?: string;
+  name?: string;
+  endpoint?: string;
+  versions?: string[];
+  buckets?: number;
+  users?: number;
+  groups?: number;
+  policies?: number;
+  features?: string[];
+}
 
-  protected createAbortSignal = (
-    cancelToken: CancelToken
-  ): AbortSignal | undefined => {
-    if (this.abortControllers.has(cancelToken)) {
-      const abortController = this.abortControllers.get(cancelToken);
-      if (abortController) {
-        return abortController.signal;
-      }
-      return void 0;
-    }
+export interface ClusterDrift {
+  category?: "version" | "config" | "bucket" | "iam" | "feature";
+  item?: string;
+  siteA?: string;
+  siteB?: string;
+}
 
-    const abortController = new AbortController();
-    this.abortControllers.set(cancelToken, abortController);
+export interface ClusterComparisonReport {
+  siteA?: ClusterSnapshot;
+  siteB?: ClusterSnapshot;
+  inSync?: boolean;
+  drift?: ClusterDrift[];
+}
+
+export interface BatchJobFilter {
+  newerThan?: string;
+  olderThan?: string;
+  createdAfter?: string;
+  createdBefore?: string;
+}
+
+export interface BatchJobReplicateTarget {
+  endpoint: string;
+  bucket: string;
+  prefix?: string;
+  path?: "auto" | "on" | "off";
+  accessKey: string;
+  secretKey: string;
+}
+
+export interface BatchJobKeyRotation {
+  type: "sse-s3" | "sse-kms";
+  key?: string;
+  context?: string;
+}
+
+export interface BatchJobExpiration {
+  objectType?: "object" | "deleted";
+  olderThan?: string;
+  createdBefore?: string;
+  retainVersions?: number;
+}
+
+export interface BatchJobRequest {
+  type: "replicate" | "keyrotate" | "expire";
+  bucket: string;
+  prefix?: string;
+  filter?: BatchJobFilter;
+  replicate?: BatchJobReplicateTarget;
+  keyRotation?: BatchJobKeyRotation;
+  expiration?: BatchJobExpiration;
+  notifyEndpoint?: string;
+  notifyToken?: string;
+  retryAttempts?: number;
+  retryDelay?: string;
+}
+
+export interface BatchJobDefinition {
+  type?: string;
+  yaml?: string;
+}
+
+export interface StartBatchJobRequest {
+  yaml?: string;
+  job?: BatchJobRequest;
+}
+
+export interface BatchJob {
+  id?: string;
+  type?: string;
+  user?: string;
+  started?: string;
+  elapsedSeconds?: number;
+}
+
+export interface BatchJobList {
+  jobs?: BatchJob[];
+}
+
+export interface BatchJobStatus {
+  id?: string;
+  type?: string;
+  started?: string;
+  lastUpdate?: string;
+  complete?: boolean;
+  failed?: boolean;
+  retryAttempts?: number;
+  objects?: number;
+  objectsFailed?: number;
+  bytesTransferred?: number;
+  bytesFailed?: number;
+  lastBucket?: string;
+  lastObject?: string;
+}
+
+export interface BatchJobDetails {
+  id?: string;
+  yaml?: string;
+  status?: BatchJobStatus;
+}
+
+export interface RebalancePoolStatus {
+  id: number;
+  status?: string;
+  usedPercent?: number;
+  progressPercent?: number;
+  objects?: number;
+  versions?: number;
+  bytes?: number;
+  bucket?: string;
+  object?: string;
+  elapsedSeconds?: number;
+  etaSeconds?: number;
+}
+
+export interface RebalanceStatus {
+  id?: string;
+  running?: boolean;
+  stoppedAt?: string;
+  progressPercent?: number;
+  etaSeconds?: number;
+  estimatedCompletion?: string;
+  pools?: RebalancePoolStatus[];
+}
+
+export interface PoolDecommission {
+  status?: "active" | "complete" | "failed" | "canceled";
+  startTime?: string;
+  totalSize?: number;
+  startSize?: number;
+  currentSize?: number;
+  bytesMoved?: number;
+  bytesPending?: number;
+  progressPercent?: number;
+}
+
+export interface PoolStatus {
+  id: number;
+  cmdLine?: string;
+  lastUpdate?: string;
+  totalSpace?: number;
+  usedSpace?: number;
+  availableSpace?: number;
+  decommission?: PoolDecommission;
+}
+
+export interface PoolList {
+  pools?: PoolStatus[];
+}
+
+export interface HealRequest {
+  bucket?: string;
+  prefix?: string;
+  object?: string;
+  recursive?: boolean;
+  dryRun?: boolean;
+  remove?: boolean;
+  scanMode?: "normal" | "deep";
+  forceStart?: boolean;
+}
+
+export interface HealStartResponse {
+  clientToken?: string;
+  clientAddress?: string;
+  startTime?: string;
+}
+
+export interface ErasureSetHealStatus {
+  id?: string;
+  pool: number;
+  set: number;
+  status?: string;
+  priority?: string;
+  totalObjects?: number;
+  drives?: number;
+  offlineDrives?: number;
+  healingDrives?: number;
+  itemsHealed?: number;
+  itemsFailed?: number;
+  bytesHealed?: number;
+  bytesFailed?: number;
+}
+
+export interface BackgroundHealStatus {
+  scannedItems?: number;
+  offlineEndpoints?: string[];
+  healingDrives?: string[];
+  sets?: ErasureSetHealStatus[];
+}
+
+export interface TopologyDrive {
+  index: number;
+  uuid?: string;
+  server?: string;
+  endpoint?: string;
+  drivePath?: string;
+  state?: string;
+  model?: string;
+  totalSpace?: number;
+  usedSpace?: number;
+  availableSpace?: number;
+  healing?: boolean;
+  healProgressPercent?: number;
+  itemsHealed?: number;
+  itemsFailed?: number;
+}
+
+export interface TopologySet {
+  set: number;
+  onlineDrives?: number;
+  offlineDrives?: number;
+  healingDrives?: number;
+  drives?: TopologyDrive[];
+}
+
+export interface TopologyPool {
+  id: number;
+  sets?: TopologySet[];
+}
+
+export interface DriveTopology {
+  totalDrives?: number;
+  onlineDrives?: number;
+  offlineDrives?: number;
+  healingDrives?: number;
+  pools?: TopologyPool[];
+}
+
+export interface ApiTraceStats {
+  api?: string;
+  calls?: number;
+  errors?: number;
+  errorRate?: number;
+  rx?: number;
+  tx?: number;
+  avgMs?: number;
+  p50Ms?: number;
+  p90Ms?: number;
+  p99Ms?: number;
+  maxMs?: number;
+}
+
+export interface TraceStats {
+  windowSeconds?: number;
+  calls?: number;
+  errors?: number;
+  apis?: ApiTraceStats[];
+}
+
+export interface LockEntry {
+  resource?: string;
+  type?: string;
+  operation?: string;
+  source?: string;
+  owner?: string;
+  id?: string;
+  servers?: string[];
+  quorum?: number;
+  acquiredAt?: string;
+  heldSeconds?: number;
+}
+
+export interface LongRunningOperation {
+  operation?: string;
+  resources?: string[];
+  locks?: number;
+  owners?: string[];
+  oldestAcquiredAt?: string;
+  heldSeconds?: number;
+}
+
+export interface TopLocks {
+  locks?: LockEntry[];
+  longRunning?: LongRunningOperation[];
+}
+
+export interface MetricsPoint {
+  /** @format int64 */
+  time?: number;
+  /** @format double */
+  value?: number;
+}
+
+export interface MetricsSeries {
+  name?: string;
+  points?: MetricsPoint[];
+}
+
+export interface MetricsNodeHealth {
+  instance?: string;
+  up?: boolean;
+}
+
+export interface MetricsDashboard {
+  /** @format int64 */
+  start?: number;
+  /** @format int64 */
+  end?: number;
+  /** @format int64 */
+  step?: number;
+  capacity?: MetricsSeries[];
+  requestRates?: MetricsSeries[];
+  requestErrors?: MetricsSeries[];
+  replicationLag?: MetricsSeries[];
+  nodes?: MetricsNodeHealth[];
+  /** @format int64 */
+  nodesOnline?: number;
+  /** @format int64 */
+  nodesOffline?: number;
+  /** @format int64 */
+  drivesOnline?: number;
+  /** @format int64 */
+  drivesOffline?: number;
+  unavailable?: string[];
+}
+
+export interface ScannerBucketStatus {
+  bucket?: string;
+  /** @format int64 */
+  size?: number;
+  /** @format int64 */
+  objects?: number;
+  scanning?: boolean;
+  lastChangedAt?: string;
+}
+
+export interface ScannerStatus {
+  /** @format int64 */
+  currentCycle?: number;
+  cycleStartedAt?: string;
+  lastCycleCompletedAt?: string;
+  /** @format int64 */
+  averageCycleSeconds?: number;
+  /** @format int64 */
+  ongoingBuckets?: number;
+  activePaths?: string[];
+  usageUpdatedAt?: string;
+  /** @format int64 */
+  usageAgeSeconds?: number;
+  usageStale?: boolean;
+  buckets?: ScannerBucketStatus[];
+}
+
+export interface DataUsageRefresh {
+  usageUpdatedAt?: string;
+  /** @format int64 */
+  bucketsRecorded?: number;
+  scannerTriggered?: boolean;
+  message?: string;
+}
+
+export interface AlertRule {
+  id?: string;
+  name: string;
+  kind:
+    | "license_expiring"
+    | "drive_usage"
+    | "node_offline"
+    | "replication_backlog";
+  /** @format double */
+  threshold: number;
+  enabled?: boolean;
+}
+
+export interface AlertRules {
+  rules?: AlertRule[];
+}
+
+export interface Alert {
+  ruleId?: string;
+  ruleName?: string;
+  kind?: string;
+  subject?: string;
+  /** @format double */
+  value?: number;
+  /** @format double */
+  threshold?: number;
+  state?: string;
+  message?: string;
+  firedAt?: string;
+  resolvedAt?: string;
+}
+
+export interface AlertsState {
+  lastEvaluation?: string;
+  errors?: string[];
+  firing?: Alert[];
+  resolved?: Alert[];
+  sinks?: string[];
+  backgroundEvaluation?: boolean;
+  /** @format int64 */
+  interval?: number;
+}
+
+export interface DriveHealth {
+  server?: string;
+  drivePath?: string;
+  uuid?: string;
+  state?: string;
+  healing?: boolean;
+  device?: string;
+  model?: string;
+  fsType?: string;
+  /** @format int64 */
+  totalSpace?: number;
+  /** @format int64 */
+  usedSpace?: number;
+  /** @format int64 */
+  usedInodes?: number;
+  /** @format int64 */
+  freeInodes?: number;
+  /** @format double */
+  utilization?: number;
+  /** @format double */
+  readLatency?: number;
+  /** @format double */
+  writeLatency?: number;
+  /** @format int64 */
+  lastMinuteCalls?: number;
+  /** @format double */
+  lastMinuteAvgLatencyMs?: number;
+  hardwareError?: string;
+  warnings?: string[];
+}
+
+export interface DrivesHealth {
+  drives?: DriveHealth[];
+  /** @format int64 */
+  total?: number;
+  /** @format int64 */
+  withWarnings?: number;
+  hardwareInfoError?: string;
+}
+
+export interface SiteReplicationEntitySync {
+  total?: number;
+  replicated?: number;
+  outOfSync?: string[];
+}
+
+export interface SiteReplicationSiteHealth {
+  name?: string;
+  endpoint?: string;
+  deploymentID?: string;
+  healthy?: boolean;
+  buckets?: SiteReplicationEntitySync;
+  policies?: SiteReplicationEntitySync;
+  users?: SiteReplicationEntitySync;
+  groups?: SiteReplicationEntitySync;
+}
+
+export interface SiteReplicationHealthResponse {
+  enabled?: boolean;
+  healthy?: boolean;
+  sites?: SiteReplicationSiteHealth[];
+}
+
+export interface ImportBucketLifecycleRequest {
+  format: "json" | "xml";
+  configuration: string;
+  dry_run?: boolean;
+}
+
+export interface ImportBucketLifecycleResponse {
+  applied?: boolean;
+  rules?: number;
+  added?: string[];
+  removed?: string[];
+  changed?: string[];
+  unchanged?: string[];
+}
+
+export interface Cluster {
+  id?: string;
+  name: string;
+  endpoint: string;
+  auth: "credentials" | "sts";
+  accessKey?: string;
+  secretKey?: string;
+  allowedUsers?: string[];
+  created?: string;
+  available?: boolean;
+}
+
+export interface ClusterList {
+  active?: string;
+  clusters?: Cluster[];
+}
+
+export interface SwitchClusterRequest {
+  clusterId: string;
+  accessKey?: string;
+  secretKey?: string;
+  otp?: string;
+}
+
+export interface TlsCertificate {
+  subject?: string;
+  issuer?: string;
+  serialNumber?: string;
+  dnsNames?: string[];
+  ipAddresses?: string[];
+  isCA?: boolean;
+  notBefore?: string;
+  notAfter?: string;
+  /** seconds until the certificate expires, negative once expired */
+  expiresIn?: number;
+  expired?: boolean;
+  expiring?: boolean;
+}
+
+export interface TlsCertificateChain {
+  /** certificate file of the console or address of the MinIO endpoint */
+  name?: string;
+  cluster?: string;
+  loadedAt?: string;
+  /** error of the last reload of a console certificate or of the verification of a MinIO endpoint */
+  error?: string;
+  certificates?: TlsCertificate[];
+}
+
+export interface TlsCertificatesResponse {
+  console?: TlsCertificateChain[];
+  minio?: TlsCertificateChain[];
+}
+
+export interface CspReport {
+  received?: string;
+  documentURI?: string;
+  referrer?: string;
+  blockedURI?: string;
+  violatedDirective?: string;
+  effectiveDirective?: string;
+  originalPolicy?: string;
+  disposition?: string;
+  sourceFile?: string;
+  sample?: string;
+  /** @format int64 */
+  lineNumber?: number;
+  /** @format int64 */
+  columnNumber?: number;
+  /** @format int64 */
+  statusCode?: number;
+  sourceIP?: string;
+  userAgent?: string;
+}
+
+export interface CspReports {
+  /** @format int64 */
+  total?: number;
+  reports?: CspReport[];
+}
+
+export type QueryParamsType = Record<string | number, any>;
+export type ResponseFormat = keyof Omit<Body, "body" | "bodyUsed">;
+
+export interface FullRequestParams extends Omit<RequestInit, "body"> {
+  /** set parameter to `true` for call `securityWorker` for this request */
+  secure?: boolean;
+  /** request path */
+  path: string;
+  /** content type of request body */
+  type?: ContentType;
+  /** query params */
+  query?: QueryParamsType;
+  /** format of response (i.e. response.json() -> format: "json") */
+  format?: ResponseFormat;
+  /** request body */
+  body?: unknown;
+  /** base url */
+  baseUrl?: string;
+  /** request cancellation token */
+  cancelToken?: CancelToken;
+}
+
+export type RequestParams = Omit<
+  FullRequestParams,
+  "body" | "method" | "query" | "path"
+>;
+
+export interface ApiConfig<SecurityDataType = unknown> {
+  baseUrl?: string;
+  baseApiParams?: Omit<RequestParams, "baseUrl" | "cancelToken" | "signal">;
+  securityWorker?: (
+    securityData: SecurityDataType | null
+  ) => Promise<RequestParams | void> | RequestParams | void;
+  customFetch?: typeof fetch;
+}
+
+export interface HttpResponse<D extends unknown, E extends unknown = unknown>
+  extends Response {
+  data: D;
+  error: E;
+}
+
+type CancelToken = Symbol | string | number;
+
+export enum ContentType {
+  Json = "application/json",
+  FormData = "multipart/form-data",
+  UrlEncoded = "application/x-www-form-urlencoded",
+  Text = "text/plain",
+}
+
+export class HttpClient<SecurityDataType = unknown> {
+  public baseUrl: string = "/api/v1";
+  private securityData: SecurityDataType | null = null;
+  private securityWorker?: ApiConfig<SecurityDataType>["securityWorker"];
+  private abortControllers = new Map<CancelToken, AbortController>();
+  private customFetch = (...fetchParams: Parameters<typeof fetch>) =>
+    fetch(...fetchParams);
+
+  private baseApiParams: RequestParams = {
+    credentials: "same-origin",
+    headers: {},
+    redirect: "follow",
+    referrerPolicy: "no-referrer",
+  };
+
+  constructor(apiConfig: ApiConfig<SecurityDataType> = {}) {
+    Object.assign(this, apiConfig);
+  }
+
+  public setSecurityData = (data: SecurityDataType | null) => {
+    this.securityData = data;
+  };
+
+  protected encodeQueryParam(key: string, value: any) {
+    const encodedKey = encodeURIComponent(key);
+    return `${encodedKey}=${encodeURIComponent(
+      typeof value === "number" ? value : `${value}`
+    )}`;
+  }
+
+  protected addQueryParam(query: QueryParamsType, key: string) {
+    return this.encodeQueryParam(key, query[key]);
+  }
+
+  protected addArrayQueryParam(query: QueryParamsType, key: string) {
+    const value = query[key];
+    return value.map((v: any) => this.encodeQueryParam(key, v)).join("&");
+  }
+
+  protected toQueryString(rawQuery?: QueryParamsType): string {
+    const query = rawQuery || {};
+    const keys = Object.keys(query).filter(
+      (key) => "undefined" !== typeof query[key]
+    );
+    return keys
+      .map((key) =>
+        Array.isArray(query[key])
+          ? this.addArrayQueryParam(query, key)
+          : this.addQueryParam(query, key)
+      )
+      .join("&");
+  }
+
+  protected addQueryParams(rawQuery?: QueryParamsType): string {
+    const queryString = this.toQueryString(rawQuery);
+    return queryString ? `?${queryString}` : "";
+  }
+
+  private contentFormatters: Record<ContentType, (input: any) => any> = {
+    [ContentType.Json]: (input: any) =>
+      input !== null && (typeof input === "object" || typeof input === "string")
+        ? JSON.stringify(input)
+        : input,
+    [ContentType.Text]: (input: any) =>
+      input !== null && typeof input !== "string"
+        ? JSON.stringify(input)
+        : input,
+    [ContentType.FormData]: (input: any) =>
+      Object.keys(input || {}).reduce((formData, key) => {
+        const property = input[key];
+        formData.append(
+          key,
+          property instanceof Blob
+            ? property
+            : typeof property === "object" && property !== null
+            ? JSON.stringify(property)
+            : `${property}`
+        );
+        return formData;
+      }, new FormData()),
+    [ContentType.UrlEncoded]: (input: any) => this.toQueryString(input),
+  };
+
+  protected mergeRequestParams(
+    params1: RequestParams,
+    params2?: RequestParams
+  ): RequestParams {
+    return {
+      ...this.baseApiParams,
+      ...params1,
+      ...(params2 || {}),
+      headers: {
+        ...(this.baseApiParams.headers || {}),
+        ...(params1.headers || {}),
+        ...((params2 && params2.headers) || {}),
+      },
+    };
+  }
+
+  protected createAbortSignal = (
+    cancelToken: CancelToken
+  ): AbortSignal | undefined => {
+    if (this.abortControllers.has(cancelToken)) {
+      const abortController = this.abortControllers.get(cancelToken);
+      if (abortController) {
+        return abortController.signal;
+      }
+      return void 0;
+    }
+
+    const abortController = new AbortController();
+    this.abortControllers.set(cancelToken, abortController);
     return abortController.signal;
   };
-
-  public abortRequest = (cancelToken: CancelToken) => {
-    const abortController = this.abortControllers.get(cancelToken);
-
-    if (abortController) {
-      abortController.abort();
-      this.abortControllers.delete(cancelToken);
-    }
+
+  public abortRequest = (cancelToken: CancelToken) => {
+    const abortController = this.abortControllers.get(cancelToken);
+
+    if (abortController) {
+      abortController.abort();
+      this.abortControllers.delete(cancelToken);
+    }
+  };
+
+  public request = async <T = any, E = any>({
+    body,
+    secure,
+    path,
+    type,
+    query,
+    format,
+    baseUrl,
+    cancelToken,
+    ...params
+  }: FullRequestParams): Promise<HttpResponse<T, E>> => {
+    const secureParams =
+      ((typeof secure === "boolean" ? secure : this.baseApiParams.secure) &&
+        this.securityWorker &&
+        (await this.securityWorker(this.securityData))) ||
+      {};
+    const requestParams = this.mergeRequestParams(params, secureParams);
+    const queryString = query && this.toQueryString(query);
+    const payloadFormatter = this.contentFormatters[type || ContentType.Json];
+    const responseFormat = format || requestParams.format;
+
+    return this.customFetch(
+      `${baseUrl || this.baseUrl || ""}${path}${
+        queryString ? `?${queryString}` : ""
+      }`,
+      {
+        ...requestParams,
+        headers: {
+          ...(requestParams.headers || {}),
+          ...(type && type !== ContentType.FormData
+            ? { "Content-Type": type }
+            : {}),
+        },
+        signal: cancelToken
+          ? this.createAbortSignal(cancelToken)
+          : requestParams.signal,
+        body:
+          typeof body === "undefined" || body === null
+            ? null
+            : payloadFormatter(body),
+      }
+    ).then(async (response) => {
+      const r = response as HttpResponse<T, E>;
+      r.data = null as unknown as T;
+      r.error = null as unknown as E;
+
+      const data = !responseFormat
+        ? r
+        : await response[responseFormat]()
+            .then((data) => {
+              if (r.ok) {
+                r.data = data;
+              } else {
+                r.error = data;
+              }
+              return r;
+            })
+            .catch((e) => {
+              r.error = e;
+              return r;
+            });
+
+      if (cancelToken) {
+        this.abortControllers.delete(cancelToken);
+      }
+
+      if (!response.ok) throw data;
+      return data;
+    });
+  };
+}
+
+/**
+ * @title MinIO Console Server
+ * @version 0.1.0
+ * @baseUrl /api/v1
+ */
+export class Api<
+  SecurityDataType extends unknown
+> extends HttpClient<SecurityDataType> {
+  login = {
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name LoginDetail
+     * @summary Returns login strategy, form or sso.
+     * @request GET:/login
+     */
+    loginDetail: (params: RequestParams = {}) =>
+      this.request<LoginDetails, Error>({
+        path: `/login`,
+        method: "GET",
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name Login
+     * @summary Login to Console
+     * @request POST:/login
+     */
+    login: (body: LoginRequest, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/login`,
+        method: "POST",
+        body: body,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name LoginOauth2Auth
+     * @summary Identity Provider oauth2 callback endpoint.
+     * @request POST:/login/oauth2/auth
+     */
+    loginOauth2Auth: (
+      body: LoginOauth2AuthRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/login/oauth2/auth`,
+        method: "POST",
+        body: body,
+        type: ContentType.Json,
+        ...params,
+      }),
+  };
+  logout = {
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name Logout
+     * @summary Logout from Console.
+     * @request POST:/logout
+     * @secure
+     */
+    logout: (body: LogoutRequest, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/logout`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+  };
+  session = {
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SessionCheck
+     * @summary Endpoint to check if your session is still valid
+     * @request GET:/session
+     * @secure
+     */
+    sessionCheck: (params: RequestParams = {}) =>
+      this.request<SessionResponse, Error>({
+        path: `/session`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SessionRenew
+     * @summary Renew the credentials of an OpenID session with its refresh token
+     * @request POST:/session/renew
+     * @secure
+     */
+    sessionRenew: (params: RequestParams = {}) =>
+      this.request<SessionRenew, Error>({
+        path: `/session/renew`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name SwitchCluster
+     * @summary Switch the cluster the session works with
+     * @request PUT:/session/cluster
+     * @secure
+     */
+    switchCluster: (body: SwitchClusterRequest, params: RequestParams = {}) =>
+      this.request<ClusterList, Error>({
+        path: `/session/cluster`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Auth
+     * @name RotateSessionKey
+     * @summary Rotate the key encrypting the sessions, the sessions of the retired key are accepted for the overlap
+     * @request POST:/session/keys/rotate
+     * @secure
+     */
+    rotateSessionKey: (params: RequestParams = {}) =>
+      this.request<SessionKeyRotation, Error>({
+        path: `/session/keys/rotate`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+  };
+  checkVersion = {
+    /**
+     * No description
+     *
+     * @tags System
+     * @name CheckMinIoVersion
+     * @summary Checks the current MinIO version against the latest
+     * @request GET:/check-version
+     */
+    checkMinIoVersion: (params: RequestParams = {}) =>
+      this.request<CheckVersionResponse, Error>({
+        path: `/check-version`,
+        method: "GET",
+        format: "json",
+        ...params,
+      }),
+  };
+  account = {
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name AccountChangePassword
+     * @summary Change password of currently logged in user.
+     * @request POST:/account/change-password
+     * @secure
+     */
+    accountChangePassword: (
+      body: AccountChangePasswordRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/account/change-password`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name ChangeUserPassword
+     * @summary Change password of currently logged in user.
+     * @request POST:/account/change-user-password
+     * @secure
+     */
+    changeUserPassword: (
+      body: ChangeUserPasswordRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/account/change-user-password`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name CreateTemporaryCredentials
+     * @summary Create temporary credentials for the currently logged in user.
+     * @request POST:/account/temporary-credentials
+     * @secure
+     */
+    createTemporaryCredentials: (
+      body: TemporaryCredentialsRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<TemporaryCredentials, Error>({
+        path: `/account/temporary-credentials`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name TwoFactorStatus
+     * @summary Two-factor authentication status of the currently logged in user
+     * @request GET:/account/two-factor
+     * @secure
+     */
+    twoFactorStatus: (params: RequestParams = {}) =>
+      this.request<TwoFactorStatus, Error>({
+        path: `/account/two-factor`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name EnrollTwoFactor
+     * @summary Start the two-factor authentication enrollment of the currently logged in user
+     * @request POST:/account/two-factor/enroll
+     * @secure
+     */
+    enrollTwoFactor: (params: RequestParams = {}) =>
+      this.request<TwoFactorEnrollment, Error>({
+        path: `/account/two-factor/enroll`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name VerifyTwoFactor
+     * @summary Enable two-factor authentication with a code of the enrolled authenticator
+     * @request POST:/account/two-factor/verify
+     * @secure
+     */
+    verifyTwoFactor: (body: TwoFactorCodeRequest, params: RequestParams = {}) =>
+      this.request<TwoFactorRecoveryCodes, Error>({
+        path: `/account/two-factor/verify`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name DisableTwoFactor
+     * @summary Disable two-factor authentication with a code of the authenticator or a recovery code
+     * @request POST:/account/two-factor/disable
+     * @secure
+     */
+    disableTwoFactor: (
+      body: TwoFactorCodeRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/account/two-factor/disable`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name ListApiTokens
+     * @summary List the API tokens of the currently logged in user
+     * @request GET:/account/api-tokens
+     * @secure
+     */
+    listApiTokens: (params: RequestParams = {}) =>
+      this.request<ApiTokenList, Error>({
+        path: `/account/api-tokens`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name CreateApiToken
+     * @summary Create an API token usable as a Bearer credential against the console REST API
+     * @request POST:/account/api-tokens
+     * @secure
+     */
+    createApiToken: (body: CreateAPITokenRequest, params: RequestParams = {}) =>
+      this.request<ApiTokenCreated, Error>({
+        path: `/account/api-tokens`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name RevokeApiToken
+     * @summary Revoke an API token of the currently logged in user
+     * @request DELETE:/account/api-tokens/{name}
+     * @secure
+     */
+    revokeApiToken: (name: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/account/api-tokens/${name}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Account
+     * @name GetApiTokenUsage
+     * @summary Recent requests authenticated with an API token of the currently logged in user
+     * @request GET:/account/api-tokens/{name}/usage
+     * @secure
+     */
+    getApiTokenUsage: (name: string, params: RequestParams = {}) =>
+      this.request<ApiTokenUsage, Error>({
+        path: `/account/api-tokens/${name}/usage`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+  };
+  buckets = {
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListBuckets
+     * @summary List Buckets
+     * @request GET:/buckets
+     * @secure
+     */
+    listBuckets: (
+      query?: {
+        /** opaque cursor returned by the previous page as nextCursor, the first page is returned without it */
+        cursor?: string;
+        /**
+         * number of items of the page, capped by CONSOLE_LIST_MAX_PAGE_SIZE
+         * @format int32
+         */
+        pageSize?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ListBucketsResponse, Error>({
+        path: `/buckets`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name MakeBucket
+     * @summary Make bucket
+     * @request POST:/buckets
+     * @secure
+     */
+    makeBucket: (body: MakeBucketRequest, params: RequestParams = {}) =>
+      this.request<MakeBucketsResponse, Error>({
+        path: `/buckets`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name BucketInfo
+     * @summary Bucket Info
+     * @request GET:/buckets/{name}
+     * @secure
+     */
+    bucketInfo: (name: string, params: RequestParams = {}) =>
+      this.request<Bucket, Error>({
+        path: `/buckets/${name}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucket
+     * @summary Delete Bucket
+     * @request DELETE:/buckets/{name}
+     * @secure
+     */
+    deleteBucket: (name: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/buckets/${name}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketRetentionConfig
+     * @summary Get Bucket's retention config
+     * @request GET:/buckets/{bucket_name}/retention
+     * @secure
+     */
+    getBucketRetentionConfig: (
+      bucketName: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<GetBucketRetentionConfig, Error>({
+        path: `/buckets/${bucketName}/retention`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name SetBucketRetentionConfig
+     * @summary Set Bucket's retention config
+     * @request PUT:/buckets/{bucket_name}/retention
+     * @secure
+     */
+    setBucketRetentionConfig: (
+      bucketName: string,
+      body: PutBucketRetentionRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/retention`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ClearBucketRetentionConfig
+     * @summary Remove the default retention of a bucket
+     * @request DELETE:/buckets/{bucket_name}/retention
+     * @secure
+     */
+    clearBucketRetentionConfig: (
+      bucketName: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/retention`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name ListObjects
+     * @summary List Objects
+     * @request GET:/buckets/{bucket_name}/objects
+     * @secure
+     */
+    listObjects: (
+      bucketName: string,
+      query?: {
+        prefix?: string;
+        recursive?: boolean;
+        with_versions?: boolean;
+        with_metadata?: boolean;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ListObjectsResponse, Error>({
+        path: `/buckets/${bucketName}/objects`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name DeleteObject
+     * @summary Delete Object
+     * @request DELETE:/buckets/{bucket_name}/objects
+     * @secure
+     */
+    deleteObject: (
+      bucketName: string,
+      query: {
+        path: string;
+        version_id?: string;
+        recursive?: boolean;
+        all_versions?: boolean;
+        non_current_versions?: boolean;
+        bypass?: boolean;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects`,
+        method: "DELETE",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name DeleteMultipleObjects
+     * @summary Delete Multiple Objects
+     * @request POST:/buckets/{bucket_name}/delete-objects
+     * @secure
+     */
+    deleteMultipleObjects: (
+      bucketName: string,
+      files: DeleteFile[],
+      query?: {
+        all_versions?: boolean;
+        bypass?: boolean;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/delete-objects`,
+        method: "POST",
+        query: query,
+        body: files,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name ObjectsUploadCreate
+     * @summary Uploads an Object.
+     * @request POST:/buckets/{bucket_name}/objects/upload
+     * @secure
+     */
+    objectsUploadCreate: (
+      bucketName: string,
+      query?: {
+        prefix?: string;
+      },
+      data?: any,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects/upload`,
+        method: "POST",
+        query: query,
+        body: data,
+        secure: true,
+        type: ContentType.FormData,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name DownloadObject
+     * @summary Download Object
+     * @request GET:/buckets/{bucket_name}/objects/download
+     * @secure
+     */
+    downloadObject: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id?: string;
+        /** @default false */
+        preview?: boolean;
+        /** @default "" */
+        override_file_name?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<File, Error>({
+        path: `/buckets/${bucketName}/objects/download`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name ShareObject
+     * @summary Shares an Object on a url
+     * @request GET:/buckets/{bucket_name}/objects/share
+     * @secure
+     */
+    shareObject: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id: string;
+        expires?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<IamEntity, Error>({
+        path: `/buckets/${bucketName}/objects/share`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name PutObjectLegalHold
+     * @summary Put Object's legalhold status
+     * @request PUT:/buckets/{bucket_name}/objects/legalhold
+     * @secure
+     */
+    putObjectLegalHold: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id: string;
+      },
+      body: PutObjectLegalHoldRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects/legalhold`,
+        method: "PUT",
+        query: query,
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name PutObjectRetention
+     * @summary Put Object's retention status
+     * @request PUT:/buckets/{bucket_name}/objects/retention
+     * @secure
+     */
+    putObjectRetention: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id: string;
+      },
+      body: PutObjectRetentionRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects/retention`,
+        method: "PUT",
+        query: query,
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name DeleteObjectRetention
+     * @summary Delete Object retention from an object
+     * @request DELETE:/buckets/{bucket_name}/objects/retention
+     * @secure
+     */
+    deleteObjectRetention: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects/retention`,
+        method: "DELETE",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name PutObjectTags
+     * @summary Put Object's tags
+     * @request PUT:/buckets/{bucket_name}/objects/tags
+     * @secure
+     */
+    putObjectTags: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id: string;
+      },
+      body: PutObjectTagsRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects/tags`,
+        method: "PUT",
+        query: query,
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name PutObjectRestore
+     * @summary Restore Object to a selected version
+     * @request PUT:/buckets/{bucket_name}/objects/restore
+     * @secure
+     */
+    putObjectRestore: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects/restore`,
+        method: "PUT",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name GetObjectTierRestoreStatus
+     * @summary Gets the restore status of an object transitioned to a remote tier
+     * @request GET:/buckets/{bucket_name}/objects/tier-restore
+     * @secure
+     */
+    getObjectTierRestoreStatus: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ObjectTierRestoreStatus, Error>({
+        path: `/buckets/${bucketName}/objects/tier-restore`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name RestoreTieredObject
+     * @summary Restores an object transitioned to a remote tier
+     * @request POST:/buckets/{bucket_name}/objects/tier-restore
+     * @secure
+     */
+    restoreTieredObject: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id?: string;
+      },
+      body: RestoreTieredObjectRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/objects/tier-restore`,
+        method: "POST",
+        query: query,
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name GetObjectChecksumManifest
+     * @summary Gets a manifest with the checksum of each part of an object
+     * @request GET:/buckets/{bucket_name}/objects/checksum-manifest
+     * @secure
+     */
+    getObjectChecksumManifest: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id?: string;
+        /** @format int64 */
+        part_size?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ObjectChecksumManifest, Error>({
+        path: `/buckets/${bucketName}/objects/checksum-manifest`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name VerifyObjectChecksumManifest
+     * @summary Verifies an object against a checksum manifest
+     * @request POST:/buckets/{bucket_name}/objects/checksum-manifest/verify
+     * @secure
+     */
+    verifyObjectChecksumManifest: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id?: string;
+      },
+      body: ObjectChecksumManifest,
+      params: RequestParams = {}
+    ) =>
+      this.request<ObjectChecksumVerifyResponse, Error>({
+        path: `/buckets/${bucketName}/objects/checksum-manifest/verify`,
+        method: "POST",
+        query: query,
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name VerifyObjectIntegrity
+     * @summary Verifies the integrity of an object against a digest
+     * @request POST:/buckets/{bucket_name}/objects/verify
+     * @secure
+     */
+    verifyObjectIntegrity: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id?: string;
+      },
+      body: ObjectIntegrityRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<ObjectIntegrityResponse, Error>({
+        path: `/buckets/${bucketName}/objects/verify`,
+        method: "POST",
+        query: query,
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Object
+     * @name GetObjectMetadata
+     * @summary Gets the metadata of an object
+     * @request GET:/buckets/{bucket_name}/objects/metadata
+     * @secure
+     */
+    getObjectMetadata: (
+      bucketName: string,
+      query: {
+        prefix: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<Metadata, Error>({
+        path: `/buckets/${bucketName}/objects/metadata`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name PutBucketTags
+     * @summary Put Bucket's tags
+     * @request PUT:/buckets/{bucket_name}/tags
+     * @secure
+     */
+    putBucketTags: (
+      bucketName: string,
+      body: PutBucketTagsRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/tags`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name BucketSetPolicy
+     * @summary Bucket Set Policy
+     * @request PUT:/buckets/{name}/set-policy
+     * @secure
+     */
+    bucketSetPolicy: (
+      name: string,
+      body: SetBucketPolicyRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<Bucket, Error>({
+        path: `/buckets/${name}/set-policy`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ValidateBucketPolicy
+     * @summary Validates a bucket policy
+     * @request POST:/buckets/{bucket_name}/policy/validate
+     * @secure
+     */
+    validateBucketPolicy: (
+      bucketName: string,
+      body: BucketPolicyValidateRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketPolicyValidation, Error>({
+        path: `/buckets/${bucketName}/policy/validate`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GenerateBucketPolicy
+     * @summary Generates a bucket policy from prefix access rules
+     * @request POST:/buckets/{bucket_name}/policy/generate
+     * @secure
+     */
+    generateBucketPolicy: (
+      bucketName: string,
+      body: BucketPolicyGenerateRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketPolicyGenerateResponse, Error>({
+        path: `/buckets/${bucketName}/policy/generate`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketQuota
+     * @summary Get Bucket Quota
+     * @request GET:/buckets/{name}/quota
+     * @secure
+     */
+    getBucketQuota: (name: string, params: RequestParams = {}) =>
+      this.request<BucketQuota, Error>({
+        path: `/buckets/${name}/quota`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name SetBucketQuota
+     * @summary Bucket Quota
+     * @request PUT:/buckets/{name}/quota
+     * @secure
+     */
+    setBucketQuota: (
+      name: string,
+      body: SetBucketQuota,
+      params: RequestParams = {}
+    ) =>
+      this.request<Bucket, Error>({
+        path: `/buckets/${name}/quota`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListBucketEvents
+     * @summary List Bucket Events
+     * @request GET:/buckets/{bucket_name}/events
+     * @secure
+     */
+    listBucketEvents: (
+      bucketName: string,
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+        /** opaque cursor returned by the previous page as nextCursor, the first page is returned without it */
+        cursor?: string;
+        /**
+         * number of items of the page, capped by CONSOLE_LIST_MAX_PAGE_SIZE
+         * @format int32
+         */
+        pageSize?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ListBucketEventsResponse, Error>({
+        path: `/buckets/${bucketName}/events`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name CreateBucketEvent
+     * @summary Create Bucket Event
+     * @request POST:/buckets/{bucket_name}/events
+     * @secure
+     */
+    createBucketEvent: (
+      bucketName: string,
+      body: BucketEventRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/events`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name TestBucketEvent
+     * @summary Sends a test object created event to the target of a bucket event subscription
+     * @request POST:/buckets/{bucket_name}/events/{arn}/test
+     * @secure
+     */
+    testBucketEvent: (
+      bucketName: string,
+      arn: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketEventTestResult, Error>({
+        path: `/buckets/${bucketName}/events/${arn}/test`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketEvent
+     * @summary Delete Bucket Event
+     * @request DELETE:/buckets/{bucket_name}/events/{arn}
+     * @secure
+     */
+    deleteBucketEvent: (
+      bucketName: string,
+      arn: string,
+      body: NotificationDeleteRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/events/${arn}`,
+        method: "DELETE",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketReplication
+     * @summary Bucket Replication
+     * @request GET:/buckets/{bucket_name}/replication
+     * @secure
+     */
+    getBucketReplication: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketReplicationResponse, Error>({
+        path: `/buckets/${bucketName}/replication`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name StartReplicationRetry
+     * @summary Start a job re-triggering the replication of objects that failed to replicate
+     * @request POST:/buckets/{bucket_name}/replication-retry
+     * @secure
+     */
+    startReplicationRetry: (
+      bucketName: string,
+      body: ReplicationRetryRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<ReplicationRetryJob, Error>({
+        path: `/buckets/${bucketName}/replication-retry`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name StartReplicationResync
+     * @summary Start resyncing the objects of a bucket to one of its replication targets
+     * @request POST:/buckets/{bucket_name}/replication-resync
+     * @secure
+     */
+    startReplicationResync: (
+      bucketName: string,
+      body: ReplicationResyncRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<ReplicationResyncStatus, Error>({
+        path: `/buckets/${bucketName}/replication-resync`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetReplicationResyncStatus
+     * @summary Get the progress of the replication resyncs of a bucket
+     * @request GET:/buckets/{bucket_name}/replication-resync
+     * @secure
+     */
+    getReplicationResyncStatus: (
+      bucketName: string,
+      query?: {
+        arn?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ReplicationResyncStatus, Error>({
+        path: `/buckets/${bucketName}/replication-resync`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name CancelReplicationResync
+     * @summary Cancel the replication resync running for a target
+     * @request DELETE:/buckets/{bucket_name}/replication-resync
+     * @secure
+     */
+    cancelReplicationResync: (
+      bucketName: string,
+      query: {
+        arn: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/replication-resync`,
+        method: "DELETE",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketReplicationMetrics
+     * @summary Replication backlog, failures and link status of every target of a bucket
+     * @request GET:/buckets/{bucket_name}/replication-metrics
+     * @secure
+     */
+    getBucketReplicationMetrics: (
+      bucketName: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketReplicationMetrics, Error>({
+        path: `/buckets/${bucketName}/replication-metrics`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListBucketReplicationTargets
+     * @summary List the remote targets a bucket replicates to along with their health
+     * @request GET:/buckets/{bucket_name}/replication-targets
+     * @secure
+     */
+    listBucketReplicationTargets: (
+      bucketName: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketReplicationTargetsResponse, Error>({
+        path: `/buckets/${bucketName}/replication-targets`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name SetBucketReplicationPriorities
+     * @summary Update the priority of several replication rules at once
+     * @request PUT:/buckets/{bucket_name}/replication-priorities
+     * @secure
+     */
+    setBucketReplicationPriorities: (
+      bucketName: string,
+      body: BucketReplicationPriorities,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/replication-priorities`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetReplicationRetryJob
+     * @summary Get the progress and summary of a replication retry job
+     * @request GET:/buckets/{bucket_name}/replication-retry/{job_id}
+     * @secure
+     */
+    getReplicationRetryJob: (
+      bucketName: string,
+      jobId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<ReplicationRetryJob, Error>({
+        path: `/buckets/${bucketName}/replication-retry/${jobId}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketReplicationRule
+     * @summary Bucket Replication
+     * @request GET:/buckets/{bucket_name}/replication/{rule_id}
+     * @secure
+     */
+    getBucketReplicationRule: (
+      bucketName: string,
+      ruleId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketReplicationRule, Error>({
+        path: `/buckets/${bucketName}/replication/${ruleId}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name UpdateMultiBucketReplication
+     * @summary Update Replication rule
+     * @request PUT:/buckets/{bucket_name}/replication/{rule_id}
+     * @secure
+     */
+    updateMultiBucketReplication: (
+      bucketName: string,
+      ruleId: string,
+      body: MultiBucketReplicationEdit,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/replication/${ruleId}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketReplicationRule
+     * @summary Bucket Replication Rule Delete
+     * @request DELETE:/buckets/{bucket_name}/replication/{rule_id}
+     * @secure
+     */
+    deleteBucketReplicationRule: (
+      bucketName: string,
+      ruleId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/replication/${ruleId}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteAllReplicationRules
+     * @summary Deletes all replication rules from a bucket
+     * @request DELETE:/buckets/{bucket_name}/delete-all-replication-rules
+     * @secure
+     */
+    deleteAllReplicationRules: (
+      bucketName: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/delete-all-replication-rules`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteSelectedReplicationRules
+     * @summary Deletes selected replication rules from a bucket
+     * @request DELETE:/buckets/{bucket_name}/delete-selected-replication-rules
+     * @secure
+     */
+    deleteSelectedReplicationRules: (
+      bucketName: string,
+      rules: BucketReplicationRuleList,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/delete-selected-replication-rules`,
+        method: "DELETE",
+        body: rules,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketVersioning
+     * @summary Bucket Versioning
+     * @request GET:/buckets/{bucket_name}/versioning
+     * @secure
+     */
+    getBucketVersioning: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketVersioningResponse, Error>({
+        path: `/buckets/${bucketName}/versioning`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name SetBucketVersioning
+     * @summary Set Bucket Versioning
+     * @request PUT:/buckets/{bucket_name}/versioning
+     * @secure
+     */
+    setBucketVersioning: (
+      bucketName: string,
+      body: SetBucketVersioning,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/versioning`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketObjectLockingStatus
+     * @summary Returns the status of object locking support on the bucket
+     * @request GET:/buckets/{bucket_name}/object-locking
+     * @secure
+     */
+    getBucketObjectLockingStatus: (
+      bucketName: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketObLockingResponse, Error>({
+        path: `/buckets/${bucketName}/object-locking`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name EnableBucketEncryption
+     * @summary Enable bucket encryption.
+     * @request POST:/buckets/{bucket_name}/encryption/enable
+     * @secure
+     */
+    enableBucketEncryption: (
+      bucketName: string,
+      body: BucketEncryptionRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/encryption/enable`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DisableBucketEncryption
+     * @summary Disable bucket encryption.
+     * @request POST:/buckets/{bucket_name}/encryption/disable
+     * @secure
+     */
+    disableBucketEncryption: (bucketName: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/encryption/disable`,
+        method: "POST",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketEncryptionInfo
+     * @summary Get bucket encryption information.
+     * @request GET:/buckets/{bucket_name}/encryption/info
+     * @secure
+     */
+    getBucketEncryptionInfo: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketEncryptionInfo, Error>({
+        path: `/buckets/${bucketName}/encryption/info`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketCors
+     * @summary Get the CORS rules of a bucket
+     * @request GET:/buckets/{bucket_name}/cors
+     * @secure
+     */
+    getBucketCors: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketCorsConfiguration, Error>({
+        path: `/buckets/${bucketName}/cors`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name PutBucketCors
+     * @summary Replace the CORS rules of a bucket
+     * @request PUT:/buckets/{bucket_name}/cors
+     * @secure
+     */
+    putBucketCors: (
+      bucketName: string,
+      body: BucketCorsConfiguration,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketCorsConfiguration, Error>({
+        path: `/buckets/${bucketName}/cors`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketCors
+     * @summary Remove the CORS rules of a bucket
+     * @request DELETE:/buckets/{bucket_name}/cors
+     * @secure
+     */
+    deleteBucketCors: (bucketName: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/cors`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketPrefixUsage
+     * @summary Approximate storage usage of a bucket grouped by prefix
+     * @request GET:/buckets/{bucket_name}/usage/prefixes
+     * @secure
+     */
+    getBucketPrefixUsage: (
+      bucketName: string,
+      query?: {
+        prefix?: string;
+        /** @format int32 */
+        depth?: number;
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketPrefixUsageResponse, Error>({
+        path: `/buckets/${bucketName}/usage/prefixes`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketUsageHistory
+     * @summary Size and object count samples of a bucket over time
+     * @request GET:/buckets/{bucket_name}/usage/history
+     * @secure
+     */
+    getBucketUsageHistory: (
+      bucketName: string,
+      query?: {
+        since?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketUsageHistory, Error>({
+        path: `/buckets/${bucketName}/usage/history`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListBucketEncryptionKeys
+     * @summary List the KMS keys available to encrypt the bucket with.
+     * @request GET:/buckets/{bucket_name}/encryption/keys
+     * @secure
+     */
+    listBucketEncryptionKeys: (
+      bucketName: string,
+      query?: {
+        pattern?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<KmsListKeysResponse, Error>({
+        path: `/buckets/${bucketName}/encryption/keys`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketLifecycle
+     * @summary Bucket Lifecycle
+     * @request GET:/buckets/{bucket_name}/lifecycle
+     * @secure
+     */
+    getBucketLifecycle: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketLifecycleResponse, Error>({
+        path: `/buckets/${bucketName}/lifecycle`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name AddBucketLifecycle
+     * @summary Add Bucket Lifecycle
+     * @request POST:/buckets/{bucket_name}/lifecycle
+     * @secure
+     */
+    addBucketLifecycle: (
+      bucketName: string,
+      body: AddBucketLifecycle,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/lifecycle`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name AddMultiBucketLifecycle
+     * @summary Add Multi Bucket Lifecycle
+     * @request POST:/buckets/multi-lifecycle
+     * @secure
+     */
+    addMultiBucketLifecycle: (
+      body: AddMultiBucketLifecycle,
+      params: RequestParams = {}
+    ) =>
+      this.request<MultiLifecycleResult, Error>({
+        path: `/buckets/multi-lifecycle`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name BulkBucketOperation
+     * @summary Apply an operation to many buckets at once
+     * @request POST:/buckets/bulk
+     * @secure
+     */
+    bulkBucketOperation: (
+      body: BulkBucketOperationRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<BulkBucketOperationResponse, Error>({
+        path: `/buckets/bulk`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name UpdateBucketLifecycle
+     * @summary Update Lifecycle rule
+     * @request PUT:/buckets/{bucket_name}/lifecycle/{lifecycle_id}
+     * @secure
+     */
+    updateBucketLifecycle: (
+      bucketName: string,
+      lifecycleId: string,
+      body: UpdateBucketLifecycle,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/lifecycle/${lifecycleId}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ExportBucketLifecycle
+     * @summary Export Bucket Lifecycle Configuration
+     * @request GET:/buckets/{bucket_name}/lifecycle/export
+     * @secure
+     */
+    exportBucketLifecycle: (
+      bucketName: string,
+      query?: {
+        /** @default "json" */
+        format?: "json" | "xml";
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<File, Error>({
+        path: `/buckets/${bucketName}/lifecycle/export`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ImportBucketLifecycle
+     * @summary Import Bucket Lifecycle Configuration
+     * @request POST:/buckets/{bucket_name}/lifecycle/import
+     * @secure
+     */
+    importBucketLifecycle: (
+      bucketName: string,
+      body: ImportBucketLifecycleRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<ImportBucketLifecycleResponse, Error>({
+        path: `/buckets/${bucketName}/lifecycle/import`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ExportBucketConfig
+     * @summary Export the whole configuration of a bucket as a snapshot
+     * @request GET:/buckets/{bucket_name}/config/export
+     * @secure
+     */
+    exportBucketConfig: (bucketName: string, params: RequestParams = {}) =>
+      this.request<BucketConfigSnapshot, Error>({
+        path: `/buckets/${bucketName}/config/export`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ImportBucketConfig
+     * @summary Apply a configuration snapshot to a bucket
+     * @request POST:/buckets/{bucket_name}/config/import
+     * @secure
+     */
+    importBucketConfig: (
+      bucketName: string,
+      body: BucketConfigImportRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketConfigImportResponse, Error>({
+        path: `/buckets/${bucketName}/config/import`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name StartBucketRename
+     * @summary Start a job copying the bucket, its objects and configuration to a new bucket
+     * @request POST:/buckets/{bucket_name}/rename
+     * @secure
+     */
+    startBucketRename: (
+      bucketName: string,
+      body: BucketRenameRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketRenameJob, Error>({
+        path: `/buckets/${bucketName}/rename`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketRenameJob
+     * @summary Get the progress of a bucket rename job
+     * @request GET:/buckets/{bucket_name}/rename/{job_id}
+     * @secure
+     */
+    getBucketRenameJob: (
+      bucketName: string,
+      jobId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketRenameJob, Error>({
+        path: `/buckets/${bucketName}/rename/${jobId}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name CancelBucketRenameJob
+     * @summary Cancel a running bucket rename job
+     * @request DELETE:/buckets/{bucket_name}/rename/{job_id}
+     * @secure
+     */
+    cancelBucketRenameJob: (
+      bucketName: string,
+      jobId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketRenameJob, Error>({
+        path: `/buckets/${bucketName}/rename/${jobId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketAccessInsight
+     * @summary Summary of the recent audit log entries of a bucket
+     * @request GET:/buckets/{bucket_name}/access-insight
+     * @secure
+     */
+    getBucketAccessInsight: (
+      bucketName: string,
+      query?: {
+        window?: string;
+        /** @format int32 */
+        top?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<BucketAccessInsight, Error>({
+        path: `/buckets/${bucketName}/access-insight`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name DeleteBucketLifecycleRule
+     * @summary Delete Lifecycle rule
+     * @request DELETE:/buckets/{bucket_name}/lifecycle/{lifecycle_id}
+     * @secure
+     */
+    deleteBucketLifecycleRule: (
+      bucketName: string,
+      lifecycleId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/buckets/${bucketName}/lifecycle/${lifecycleId}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name GetBucketRewind
+     * @summary Get objects in a bucket for a rewind date
+     * @request GET:/buckets/{bucket_name}/rewind/{date}
+     * @secure
+     */
+    getBucketRewind: (
+      bucketName: string,
+      date: string,
+      query?: {
+        prefix?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<RewindResponse, Error>({
+        path: `/buckets/${bucketName}/rewind/${date}`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+  };
+  public = {
+    /**
+     * No description
+     *
+     * @tags Public
+     * @name PublicListObjects
+     * @summary List Objects of a Public Bucket
+     * @request GET:/public/buckets/{bucket_name}/objects
+     */
+    publicListObjects: (
+      bucketName: string,
+      query?: {
+        prefix?: string;
+        recursive?: boolean;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ListObjectsResponse, Error>({
+        path: `/public/buckets/${bucketName}/objects`,
+        method: "GET",
+        query: query,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Public
+     * @name PublicDownloadObject
+     * @summary Download Object from a Public Bucket
+     * @request GET:/public/buckets/{bucket_name}/objects/download
+     */
+    publicDownloadObject: (
+      bucketName: string,
+      query: {
+        prefix: string;
+        version_id?: string;
+        /** @default false */
+        preview?: boolean;
+        /** @default "" */
+        override_file_name?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<File, Error>({
+        path: `/public/buckets/${bucketName}/objects/download`,
+        method: "GET",
+        query: query,
+        ...params,
+      }),
+  };
+  listExternalBuckets = {
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name ListExternalBuckets
+     * @summary Lists an External list of buckets using custom credentials
+     * @request POST:/list-external-buckets
+     * @secure
+     */
+    listExternalBuckets: (
+      body: ListExternalBucketsParams,
+      params: RequestParams = {}
+    ) =>
+      this.request<ListBucketsResponse, Error>({
+        path: `/list-external-buckets`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+  };
+  bucketsReplication = {
+    /**
+     * No description
+     *
+     * @tags Bucket
+     * @name SetMultiBucketReplication
+     * @summary Sets Multi Bucket Replication in multiple Buckets
+     * @request POST:/buckets-replication
+     * @secure
+     */
+    setMultiBucketReplication: (
+      body: MultiBucketReplication,
+      params: RequestParams = {}
+    ) =>
+      this.request<MultiBucketResponseState, Error>({
+        path: `/buckets-replication`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
   };
+  serviceAccounts = {
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name ListUserServiceAccounts
+     * @summary List User's Service Accounts
+     * @request GET:/service-accounts
+     * @secure
+     */
+    listUserServiceAccounts: (
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+        /** opaque cursor returned by the previous page as nextCursor, the first page is returned without it */
+        cursor?: string;
+        /**
+         * number of items of the page, capped by CONSOLE_LIST_MAX_PAGE_SIZE
+         * @format int32
+         */
+        pageSize?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ServiceAccounts, Error>({
+        path: `/service-accounts`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
 
-  public request = async <T = any, E = any>({
-    body,
-    secure,
-    path,
-    type,
-    query,
-    format,
-    baseUrl,
-    cancelToken,
-    ...params
-  }: FullRequestParams): Promise<HttpResponse<T, E>> => {
-    const secureParams =
-      ((typeof secure === "boolean" ? secure : this.baseApiParams.secure) &&
-        this.securityWorker &&
-        (await this.securityWorker(this.securityData))) ||
-      {};
-    const requestParams = this.mergeRequestParams(params, secureParams);
-    const queryString = query && this.toQueryString(query);
-    const payloadFormatter = this.contentFormatters[type || ContentType.Json];
-    const responseFormat = format || requestParams.format;
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name CreateServiceAccount
+     * @summary Create Service Account
+     * @request POST:/service-accounts
+     * @secure
+     */
+    createServiceAccount: (
+      body: ServiceAccountRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<ServiceAccountCreds, Error>({
+        path: `/service-accounts`,
+        method: "POST",
+        body: body,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
 
-    return this.customFetch(
-      `${baseUrl || this.baseUrl || ""}${path}${
-        queryString ? `?${queryString}` : ""
-      }`,
-      {
-        ...requestParams,
-        headers: {
-          ...(requestParams.headers || {}),
-          ...(type && type !== ContentType.FormData
-            ? { "Content-Type": type }
-            : {}),
-        },
-        signal: cancelToken
-          ? this.createAbortSignal(cancelToken)
-          : requestParams.signal,
-        body:
-          typeof body === "undefined" || body === null
-            ? null
-            : payloadFormatter(body),
-      }
-    ).then(async (response) => {
-      const r = response as HttpResponse<T, E>;
-      r.data = null as unknown as T;
-      r.error = null as unknown as E;
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name DeleteServiceAccount
+     * @summary Delete Service Account
+     * @request DELETE:/service-accounts/{access_key}
+     * @secure
+     */
+    deleteServiceAccount: (accessKey: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/service-accounts/${accessKey}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
 
-      const data = !responseFormat
-        ? r
-        : await response[responseFormat]()
-            .then((data) => {
-              if (r.ok) {
-                r.data = data;
-              } else {
-                r.error = data;
-              }
-              return r;
-            })
-            .catch((e) => {
-              r.error = e;
-              return r;
-            });
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name DeleteMultipleServiceAccounts
+     * @summary Delete Multiple Service Accounts
+     * @request DELETE:/service-accounts/delete-multi
+     * @secure
+     */
+    deleteMultipleServiceAccounts: (
+      selectedSA: string[],
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/service-accounts/delete-multi`,
+        method: "DELETE",
+        body: selectedSA,
+        secure: true,
+        ...params,
+      }),
 
-      if (cancelToken) {
-        this.abortControllers.delete(cancelToken);
-      }
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name GetServiceAccountPolicy
+     * @summary Get Service Account Policy
+     * @request GET:/service-accounts/{access_key}/policy
+     * @secure
+     */
+    getServiceAccountPolicy: (accessKey: string, params: RequestParams = {}) =>
+      this.request<IamEntity, Error>({
+        path: `/service-accounts/${accessKey}/policy`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
 
-      if (!response.ok) throw data;
-      return data;
-    });
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name SetServiceAccountPolicy
+     * @summary Set Service Account Policy
+     * @request PUT:/service-accounts/{access_key}/policy
+     * @secure
+     */
+    setServiceAccountPolicy: (
+      accessKey: string,
+      policy: AddServiceAccountPolicyRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/service-accounts/${accessKey}/policy`,
+        method: "PUT",
+        body: policy,
+        secure: true,
+        type: ContentType.Json,
+        ...params,
+      }),
+  };
+  serviceAccountCredentials = {
+    /**
+     * No description
+     *
+     * @tags ServiceAccount
+     * @name CreateServiceAccountCreds
+     * @summary Create Service Account With Credentials
+     * @request POST:/service-account-credentials
+     * @secure
+     */
+    createServiceAccountCreds: (
+      body: ServiceAccountRequestCreds,
+      params: RequestParams = {}
+    ) =>
+      this.request<ServiceAccountCreds, Error>({
+        path: `/service-account-credentials`,
+        method: "POST",
+        body: body,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
   };
-}
-
-/**
- * @title MinIO Console Server
- * @version 0.1.0
- * @baseUrl /api/v1
- */
-export class Api<
-  SecurityDataType extends unknown
-> extends HttpClient<SecurityDataType> {
-  login = {
+  users = {
     /**
      * No description
      *
-     * @tags Auth
-     * @name LoginDetail
-     * @summary Returns login strategy, form or sso.
-     * @request GET:/login
+     * @tags User
+     * @name ListUsers
+     * @summary List Users
+     * @request GET:/users
+     * @secure
      */
-    loginDetail: (params: RequestParams = {}) =>
-      this.request<LoginDetails, Error>({
-        path: `/login`,
+    listUsers: (
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+        /** opaque cursor returned by the previous page as nextCursor, the first page is returned without it */
+        cursor?: string;
+        /**
+         * number of items of the page, capped by CONSOLE_LIST_MAX_PAGE_SIZE
+         * @format int32
+         */
+        pageSize?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ListUsersResponse, Error>({
+        path: `/users`,
         method: "GET",
+        query: query,
+        secure: true,
         format: "json",
         ...params,
       }),
@@ -1770,154 +5972,299 @@ export class Api<
     /**
      * No description
      *
-     * @tags Auth
-     * @name Login
-     * @summary Login to Console
-     * @request POST:/login
+     * @tags User
+     * @name ImportUsers
+     * @summary Create users from a CSV or JSON file, all of them or none
+     * @request POST:/users/import
+     * @secure
      */
-    login: (body: LoginRequest, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/login`,
+    importUsers: (
+      data: {
+        file: File;
+      },
+      query?: {
+        format?: string;
+        dryRun?: boolean;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<UserImportResponse, Error>({
+        path: `/users/import`,
         method: "POST",
-        body: body,
-        type: ContentType.Json,
+        query: query,
+        body: data,
+        secure: true,
+        type: ContentType.FormData,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Auth
-     * @name LoginOauth2Auth
-     * @summary Identity Provider oauth2 callback endpoint.
-     * @request POST:/login/oauth2/auth
+     * @tags User
+     * @name ListAccessKeyInventory
+     * @summary List the access keys of users and service accounts with their policies and last use
+     * @request GET:/users/access-keys
+     * @secure
      */
-    loginOauth2Auth: (
-      body: LoginOauth2AuthRequest,
+    listAccessKeyInventory: (params: RequestParams = {}) =>
+      this.request<AccessKeyInventory, Error>({
+        path: `/users/access-keys`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name ListLoginAttempts
+     * @summary List the recent failed Console logins and the active lockouts
+     * @request GET:/users/login-attempts
+     * @secure
+     */
+    listLoginAttempts: (
+      query?: {
+        accessKey?: string;
+        sourceIP?: string;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<LoginAttempts, Error>({
+        path: `/users/login-attempts`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name UnlockLoginAttempts
+     * @summary Lift the Console login lockout of an access key or a source IP
+     * @request POST:/users/login-attempts/unlock
+     * @secure
+     */
+    unlockLoginAttempts: (
+      body: LoginUnlockRequest,
       params: RequestParams = {}
     ) =>
       this.request<void, Error>({
-        path: `/login/oauth2/auth`,
+        path: `/users/login-attempts/unlock`,
         method: "POST",
         body: body,
+        secure: true,
         type: ContentType.Json,
         ...params,
       }),
-  };
-  logout = {
+
     /**
      * No description
      *
-     * @tags Auth
-     * @name Logout
-     * @summary Logout from Console.
-     * @request POST:/logout
+     * @tags User
+     * @name AddUser
+     * @summary Add User
+     * @request POST:/users
      * @secure
      */
-    logout: (body: LogoutRequest, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/logout`,
+    addUser: (body: AddUserRequest, params: RequestParams = {}) =>
+      this.request<User, Error>({
+        path: `/users`,
         method: "POST",
         body: body,
         secure: true,
         type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name CheckUserServiceAccounts
+     * @summary Check number of service accounts for each user specified
+     * @request POST:/users/service-accounts
+     * @secure
+     */
+    checkUserServiceAccounts: (
+      selectedUsers: string[],
+      params: RequestParams = {}
+    ) =>
+      this.request<UserServiceAccountSummary, Error>({
+        path: `/users/service-accounts`,
+        method: "POST",
+        body: selectedUsers,
+        secure: true,
+        format: "json",
         ...params,
       }),
   };
-  session = {
+  user = {
     /**
      * No description
      *
-     * @tags Auth
-     * @name SessionCheck
-     * @summary Endpoint to check if your session is still valid
-     * @request GET:/session
+     * @tags User
+     * @name GetUserInfo
+     * @summary Get User Info
+     * @request GET:/user/{name}
      * @secure
      */
-    sessionCheck: (params: RequestParams = {}) =>
-      this.request<SessionResponse, Error>({
-        path: `/session`,
+    getUserInfo: (name: string, params: RequestParams = {}) =>
+      this.request<User, Error>({
+        path: `/user/${name}`,
         method: "GET",
         secure: true,
         format: "json",
         ...params,
       }),
-  };
-  checkVersion = {
+
     /**
      * No description
      *
-     * @tags System
-     * @name CheckMinIoVersion
-     * @summary Checks the current MinIO version against the latest
-     * @request GET:/check-version
+     * @tags User
+     * @name UpdateUserInfo
+     * @summary Update User Info
+     * @request PUT:/user/{name}
+     * @secure
      */
-    checkMinIoVersion: (params: RequestParams = {}) =>
-      this.request<CheckVersionResponse, Error>({
-        path: `/check-version`,
+    updateUserInfo: (
+      name: string,
+      body: UpdateUser,
+      params: RequestParams = {}
+    ) =>
+      this.request<User, Error>({
+        path: `/user/${name}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name RemoveUser
+     * @summary Remove user
+     * @request DELETE:/user/{name}
+     * @secure
+     */
+    removeUser: (name: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/user/${name}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags User
+     * @name UpdateUserGroups
+     * @summary Update Groups for a user
+     * @request PUT:/user/{name}/groups
+     * @secure
+     */
+    updateUserGroups: (
+      name: string,
+      body: UpdateUserGroups,
+      params: RequestParams = {}
+    ) =>
+      this.request<User, Error>({
+        path: `/user/${name}/groups`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Policy
+     * @name GetUserPolicy
+     * @summary returns policies for logged in user
+     * @request GET:/user/policy
+     * @secure
+     */
+    getUserPolicy: (params: RequestParams = {}) =>
+      this.request<IamEntity, Error>({
+        path: `/user/policy`,
         method: "GET",
+        secure: true,
         format: "json",
         ...params,
       }),
-  };
-  account = {
+
     /**
      * No description
      *
-     * @tags Account
-     * @name AccountChangePassword
-     * @summary Change password of currently logged in user.
-     * @request POST:/account/change-password
+     * @tags User
+     * @name GetUserEffectivePolicy
+     * @summary Returns the merged policy of a user and the user, group or claim each statement comes from
+     * @request GET:/user/{name}/effective-policy
      * @secure
      */
-    accountChangePassword: (
-      body: AccountChangePasswordRequest,
+    getUserEffectivePolicy: (
+      name: string,
+      query?: {
+        claimPolicies?: string;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/account/change-password`,
-        method: "POST",
-        body: body,
+      this.request<UserEffectivePolicy, Error>({
+        path: `/user/${name}/effective-policy`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Account
-     * @name ChangeUserPassword
-     * @summary Change password of currently logged in user.
-     * @request POST:/account/change-user-password
+     * @tags Policy
+     * @name GetSaUserPolicy
+     * @summary returns policies assigned for a specified user
+     * @request GET:/user/{name}/policies
      * @secure
      */
-    changeUserPassword: (
-      body: ChangeUserPasswordRequest,
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/account/change-user-password`,
-        method: "POST",
-        body: body,
+    getSaUserPolicy: (name: string, params: RequestParams = {}) =>
+      this.request<AUserPolicyResponse, Error>({
+        path: `/user/${name}/policies`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-  };
-  buckets = {
+
     /**
      * No description
      *
-     * @tags Bucket
-     * @name ListBuckets
-     * @summary List Buckets
-     * @request GET:/buckets
+     * @tags User
+     * @name ListAUserServiceAccounts
+     * @summary returns a list of service accounts for a user
+     * @request GET:/user/{name}/service-accounts
      * @secure
      */
-    listBuckets: (params: RequestParams = {}) =>
-      this.request<ListBucketsResponse, Error>({
-        path: `/buckets`,
+    listAUserServiceAccounts: (name: string, params: RequestParams = {}) =>
+      this.request<ServiceAccounts, Error>({
+        path: `/user/${name}/service-accounts`,
         method: "GET",
         secure: true,
         format: "json",
@@ -1927,19 +6274,22 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name MakeBucket
-     * @summary Make bucket
-     * @request POST:/buckets
+     * @tags User
+     * @name CreateAUserServiceAccount
+     * @summary Create Service Account for User
+     * @request POST:/user/{name}/service-accounts
      * @secure
      */
-    makeBucket: (body: MakeBucketRequest, params: RequestParams = {}) =>
-      this.request<MakeBucketsResponse, Error>({
-        path: `/buckets`,
+    createAUserServiceAccount: (
+      name: string,
+      body: ServiceAccountRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<ServiceAccountCreds, Error>({
+        path: `/user/${name}/service-accounts`,
         method: "POST",
         body: body,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -1947,16 +6297,21 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name BucketInfo
-     * @summary Bucket Info
-     * @request GET:/buckets/{name}
+     * @tags User
+     * @name CreateServiceAccountCredentials
+     * @summary Create Service Account for User With Credentials
+     * @request POST:/user/{name}/service-account-credentials
      * @secure
      */
-    bucketInfo: (name: string, params: RequestParams = {}) =>
-      this.request<Bucket, Error>({
-        path: `/buckets/${name}`,
-        method: "GET",
+    createServiceAccountCredentials: (
+      name: string,
+      body: ServiceAccountRequestCreds,
+      params: RequestParams = {}
+    ) =>
+      this.request<ServiceAccountCreds, Error>({
+        path: `/user/${name}/service-account-credentials`,
+        method: "POST",
+        body: body,
         secure: true,
         format: "json",
         ...params,
@@ -1965,87 +6320,79 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name DeleteBucket
-     * @summary Delete Bucket
-     * @request DELETE:/buckets/{name}
+     * @tags User
+     * @name ResetUserPassword
+     * @summary Reset the secret key of a user to a one-time value that must be changed on next login
+     * @request POST:/user/{name}/reset-password
      * @secure
      */
-    deleteBucket: (name: string, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/buckets/${name}`,
-        method: "DELETE",
+    resetUserPassword: (name: string, params: RequestParams = {}) =>
+      this.request<UserPasswordReset, Error>({
+        path: `/user/${name}/reset-password`,
+        method: "POST",
         secure: true,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name GetBucketRetentionConfig
-     * @summary Get Bucket's retention config
-     * @request GET:/buckets/{bucket_name}/retention
+     * @tags User
+     * @name ResetUserTwoFactor
+     * @summary Reset the two-factor authentication of a user that lost their authenticator and recovery codes
+     * @request POST:/user/{name}/reset-two-factor
      * @secure
      */
-    getBucketRetentionConfig: (
-      bucketName: string,
-      params: RequestParams = {}
-    ) =>
-      this.request<GetBucketRetentionConfig, Error>({
-        path: `/buckets/${bucketName}/retention`,
-        method: "GET",
+    resetUserTwoFactor: (name: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/user/${name}/reset-two-factor`,
+        method: "POST",
         secure: true,
-        format: "json",
         ...params,
       }),
-
+  };
+  usersGroupsBulk = {
     /**
      * No description
      *
-     * @tags Bucket
-     * @name SetBucketRetentionConfig
-     * @summary Set Bucket's retention config
-     * @request PUT:/buckets/{bucket_name}/retention
+     * @tags User
+     * @name BulkUpdateUsersGroups
+     * @summary Bulk functionality to Add Users to Groups
+     * @request PUT:/users-groups-bulk
      * @secure
      */
-    setBucketRetentionConfig: (
-      bucketName: string,
-      body: PutBucketRetentionRequest,
-      params: RequestParams = {}
-    ) =>
+    bulkUpdateUsersGroups: (body: BulkUserGroups, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/buckets/${bucketName}/retention`,
+        path: `/users-groups-bulk`,
         method: "PUT",
         body: body,
         secure: true,
         type: ContentType.Json,
         ...params,
       }),
-
+  };
+  groups = {
     /**
      * No description
      *
-     * @tags Object
-     * @name ListObjects
-     * @summary List Objects
-     * @request GET:/buckets/{bucket_name}/objects
+     * @tags Group
+     * @name ListGroups
+     * @summary List Groups
+     * @request GET:/groups
      * @secure
      */
-    listObjects: (
-      bucketName: string,
+    listGroups: (
       query?: {
-        prefix?: string;
-        recursive?: boolean;
-        with_versions?: boolean;
-        with_metadata?: boolean;
+        /** @format int32 */
+        offset?: number;
         /** @format int32 */
         limit?: number;
       },
       params: RequestParams = {}
     ) =>
-      this.request<ListObjectsResponse, Error>({
-        path: `/buckets/${bucketName}/objects`,
+      this.request<ListGroupsResponse, Error>({
+        path: `/groups`,
         method: "GET",
         query: query,
         secure: true,
@@ -2056,136 +6403,110 @@ export class Api<
     /**
      * No description
      *
-     * @tags Object
-     * @name DeleteObject
-     * @summary Delete Object
-     * @request DELETE:/buckets/{bucket_name}/objects
+     * @tags Group
+     * @name AddGroup
+     * @summary Add Group
+     * @request POST:/groups
      * @secure
      */
-    deleteObject: (
-      bucketName: string,
-      query: {
-        path: string;
-        version_id?: string;
-        recursive?: boolean;
-        all_versions?: boolean;
-        non_current_versions?: boolean;
-        bypass?: boolean;
-      },
-      params: RequestParams = {}
-    ) =>
+    addGroup: (body: AddGroupRequest, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/buckets/${bucketName}/objects`,
-        method: "DELETE",
-        query: query,
+        path: `/groups`,
+        method: "POST",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         ...params,
       }),
-
+  };
+  group = {
     /**
      * No description
      *
-     * @tags Object
-     * @name DeleteMultipleObjects
-     * @summary Delete Multiple Objects
-     * @request POST:/buckets/{bucket_name}/delete-objects
+     * @tags Group
+     * @name GroupInfo
+     * @summary Group info
+     * @request GET:/group/{name}
      * @secure
      */
-    deleteMultipleObjects: (
-      bucketName: string,
-      files: DeleteFile[],
-      query?: {
-        all_versions?: boolean;
-        bypass?: boolean;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/delete-objects`,
-        method: "POST",
-        query: query,
-        body: files,
+    groupInfo: (name: string, params: RequestParams = {}) =>
+      this.request<Group, Error>({
+        path: `/group/${name}`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Object
-     * @name ObjectsUploadCreate
-     * @summary Uploads an Object.
-     * @request POST:/buckets/{bucket_name}/objects/upload
+     * @tags Group
+     * @name RemoveGroup
+     * @summary Remove group
+     * @request DELETE:/group/{name}
      * @secure
      */
-    objectsUploadCreate: (
-      bucketName: string,
-      query?: {
-        prefix?: string;
-      },
-      data?: any,
-      params: RequestParams = {}
-    ) =>
+    removeGroup: (name: string, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/buckets/${bucketName}/objects/upload`,
-        method: "POST",
-        query: query,
-        body: data,
+        path: `/group/${name}`,
+        method: "DELETE",
         secure: true,
-        type: ContentType.FormData,
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Object
-     * @name DownloadObject
-     * @summary Download Object
-     * @request GET:/buckets/{bucket_name}/objects/download
+     * @tags Group
+     * @name UpdateGroup
+     * @summary Update Group Members or Status
+     * @request PUT:/group/{name}
      * @secure
      */
-    downloadObject: (
-      bucketName: string,
-      query: {
-        prefix: string;
-        version_id?: string;
-        /** @default false */
-        preview?: boolean;
-        /** @default "" */
-        override_file_name?: string;
-      },
+    updateGroup: (
+      name: string,
+      body: UpdateGroupRequest,
       params: RequestParams = {}
     ) =>
-      this.request<File, Error>({
-        path: `/buckets/${bucketName}/objects/download`,
-        method: "GET",
-        query: query,
+      this.request<Group, Error>({
+        path: `/group/${name}`,
+        method: "PUT",
+        body: body,
         secure: true,
+        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-
+  };
+  policies = {
     /**
      * No description
      *
-     * @tags Object
-     * @name ShareObject
-     * @summary Shares an Object on a url
-     * @request GET:/buckets/{bucket_name}/objects/share
+     * @tags Policy
+     * @name ListPolicies
+     * @summary List Policies
+     * @request GET:/policies
      * @secure
      */
-    shareObject: (
-      bucketName: string,
-      query: {
-        prefix: string;
-        version_id: string;
-        expires?: string;
+    listPolicies: (
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+        /** opaque cursor returned by the previous page as nextCursor, the first page is returned without it */
+        cursor?: string;
+        /**
+         * number of items of the page, capped by CONSOLE_LIST_MAX_PAGE_SIZE
+         * @format int32
+         */
+        pageSize?: number;
       },
       params: RequestParams = {}
     ) =>
-      this.request<IamEntity, Error>({
-        path: `/buckets/${bucketName}/objects/share`,
+      this.request<ListPoliciesResponse, Error>({
+        path: `/policies`,
         method: "GET",
         query: query,
         secure: true,
@@ -2196,157 +6517,124 @@ export class Api<
     /**
      * No description
      *
-     * @tags Object
-     * @name PutObjectLegalHold
-     * @summary Put Object's legalhold status
-     * @request PUT:/buckets/{bucket_name}/objects/legalhold
+     * @tags Policy
+     * @name AddPolicy
+     * @summary Add Policy
+     * @request POST:/policies
      * @secure
      */
-    putObjectLegalHold: (
-      bucketName: string,
-      query: {
-        prefix: string;
-        version_id: string;
-      },
-      body: PutObjectLegalHoldRequest,
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/objects/legalhold`,
-        method: "PUT",
-        query: query,
+    addPolicy: (body: AddPolicyRequest, params: RequestParams = {}) =>
+      this.request<Policy, Error>({
+        path: `/policies`,
+        method: "POST",
         body: body,
         secure: true,
         type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Object
-     * @name PutObjectRetention
-     * @summary Put Object's retention status
-     * @request PUT:/buckets/{bucket_name}/objects/retention
+     * @tags Policy
+     * @name ValidatePolicy
+     * @summary Validate a policy and report issues and warnings about its statements
+     * @request POST:/policies/validate
      * @secure
      */
-    putObjectRetention: (
-      bucketName: string,
-      query: {
-        prefix: string;
-        version_id: string;
-      },
-      body: PutObjectRetentionRequest,
+    validatePolicy: (
+      body: PolicyValidationRequest,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/objects/retention`,
-        method: "PUT",
-        query: query,
+      this.request<PolicyValidationResult, Error>({
+        path: `/policies/validate`,
+        method: "POST",
         body: body,
         secure: true,
         type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Object
-     * @name DeleteObjectRetention
-     * @summary Delete Object retention from an object
-     * @request DELETE:/buckets/{bucket_name}/objects/retention
+     * @tags Policy
+     * @name ListPolicyTemplates
+     * @summary List the policy templates
+     * @request GET:/policies/templates
      * @secure
      */
-    deleteObjectRetention: (
-      bucketName: string,
-      query: {
-        prefix: string;
-        version_id: string;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/objects/retention`,
-        method: "DELETE",
-        query: query,
+    listPolicyTemplates: (params: RequestParams = {}) =>
+      this.request<PolicyTemplateList, Error>({
+        path: `/policies/templates`,
+        method: "GET",
         secure: true,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Object
-     * @name PutObjectTags
-     * @summary Put Object's tags
-     * @request PUT:/buckets/{bucket_name}/objects/tags
+     * @tags Policy
+     * @name RenderPolicyTemplate
+     * @summary Render a policy template with the given parameters
+     * @request POST:/policies/templates/{template}/render
      * @secure
      */
-    putObjectTags: (
-      bucketName: string,
-      query: {
-        prefix: string;
-        version_id: string;
-      },
-      body: PutObjectTagsRequest,
+    renderPolicyTemplate: (
+      template: string,
+      body: PolicyTemplateRenderRequest,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/objects/tags`,
-        method: "PUT",
-        query: query,
+      this.request<PolicyTemplateRender, Error>({
+        path: `/policies/templates/${template}/render`,
+        method: "POST",
         body: body,
         secure: true,
         type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Object
-     * @name PutObjectRestore
-     * @summary Restore Object to a selected version
-     * @request PUT:/buckets/{bucket_name}/objects/restore
+     * @tags Policy
+     * @name SimulatePolicy
+     * @summary Simulate whether a set of users, groups and policies allows an action on a resource
+     * @request POST:/policies/simulate
      * @secure
      */
-    putObjectRestore: (
-      bucketName: string,
-      query: {
-        prefix: string;
-        version_id: string;
-      },
+    simulatePolicy: (
+      body: PolicySimulationRequest,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/objects/restore`,
-        method: "PUT",
-        query: query,
+      this.request<PolicySimulationResult, Error>({
+        path: `/policies/simulate`,
+        method: "POST",
+        body: body,
         secure: true,
+        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Object
-     * @name GetObjectMetadata
-     * @summary Gets the metadata of an object
-     * @request GET:/buckets/{bucket_name}/objects/metadata
+     * @tags Policy
+     * @name ListUsersForPolicy
+     * @summary List Users for a Policy
+     * @request GET:/policies/{policy}/users
      * @secure
      */
-    getObjectMetadata: (
-      bucketName: string,
-      query: {
-        prefix: string;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<Metadata, Error>({
-        path: `/buckets/${bucketName}/objects/metadata`,
+    listUsersForPolicy: (policy: string, params: RequestParams = {}) =>
+      this.request<ServiceAccounts, Error>({
+        path: `/policies/${policy}/users`,
         method: "GET",
-        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -2355,86 +6643,87 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name PutBucketTags
-     * @summary Put Bucket's tags
-     * @request PUT:/buckets/{bucket_name}/tags
+     * @tags Policy
+     * @name ListGroupsForPolicy
+     * @summary List Groups for a Policy
+     * @request GET:/policies/{policy}/groups
      * @secure
      */
-    putBucketTags: (
-      bucketName: string,
-      body: PutBucketTagsRequest,
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/tags`,
-        method: "PUT",
-        body: body,
+    listGroupsForPolicy: (policy: string, params: RequestParams = {}) =>
+      this.request<ServiceAccounts, Error>({
+        path: `/policies/${policy}/groups`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name BucketSetPolicy
-     * @summary Bucket Set Policy
-     * @request PUT:/buckets/{name}/set-policy
+     * @tags Policy
+     * @name ListPolicyEntities
+     * @summary List the users, groups and identity provider mappings a policy is attached to
+     * @request GET:/policies/{policy}/entities
      * @secure
      */
-    bucketSetPolicy: (
-      name: string,
-      body: SetBucketPolicyRequest,
-      params: RequestParams = {}
-    ) =>
-      this.request<Bucket, Error>({
-        path: `/buckets/${name}/set-policy`,
-        method: "PUT",
-        body: body,
+    listPolicyEntities: (policy: string, params: RequestParams = {}) =>
+      this.request<PolicyEntities, Error>({
+        path: `/policies/${policy}/entities`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-
+  };
+  bucketPolicy = {
     /**
      * No description
      *
      * @tags Bucket
-     * @name GetBucketQuota
-     * @summary Get Bucket Quota
-     * @request GET:/buckets/{name}/quota
+     * @name ListPoliciesWithBucket
+     * @summary List Policies With Given Bucket
+     * @request GET:/bucket-policy/{bucket}
      * @secure
      */
-    getBucketQuota: (name: string, params: RequestParams = {}) =>
-      this.request<BucketQuota, Error>({
-        path: `/buckets/${name}/quota`,
+    listPoliciesWithBucket: (
+      bucket: string,
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ListPoliciesResponse, Error>({
+        path: `/bucket-policy/${bucket}`,
         method: "GET",
+        query: query,
         secure: true,
         format: "json",
         ...params,
       }),
-
+  };
+  bucket = {
     /**
      * No description
      *
      * @tags Bucket
-     * @name SetBucketQuota
-     * @summary Bucket Quota
-     * @request PUT:/buckets/{name}/quota
+     * @name SetAccessRuleWithBucket
+     * @summary Add Access Rule To Given Bucket
+     * @request PUT:/bucket/{bucket}/access-rules
      * @secure
      */
-    setBucketQuota: (
-      name: string,
-      body: SetBucketQuota,
+    setAccessRuleWithBucket: (
+      bucket: string,
+      prefixaccess: PrefixAccessPair,
       params: RequestParams = {}
     ) =>
-      this.request<Bucket, Error>({
-        path: `/buckets/${name}/quota`,
+      this.request<boolean, Error>({
+        path: `/bucket/${bucket}/access-rules`,
         method: "PUT",
-        body: body,
+        body: prefixaccess,
         secure: true,
         type: ContentType.Json,
         format: "json",
@@ -2445,13 +6734,13 @@ export class Api<
      * No description
      *
      * @tags Bucket
-     * @name ListBucketEvents
-     * @summary List Bucket Events
-     * @request GET:/buckets/{bucket_name}/events
+     * @name ListAccessRulesWithBucket
+     * @summary List Access Rules With Given Bucket
+     * @request GET:/bucket/{bucket}/access-rules
      * @secure
      */
-    listBucketEvents: (
-      bucketName: string,
+    listAccessRulesWithBucket: (
+      bucket: string,
       query?: {
         /** @format int32 */
         offset?: number;
@@ -2460,8 +6749,8 @@ export class Api<
       },
       params: RequestParams = {}
     ) =>
-      this.request<ListBucketEventsResponse, Error>({
-        path: `/buckets/${bucketName}/events`,
+      this.request<ListAccessRulesResponse, Error>({
+        path: `/bucket/${bucket}/access-rules`,
         method: "GET",
         query: query,
         secure: true,
@@ -2473,83 +6762,68 @@ export class Api<
      * No description
      *
      * @tags Bucket
-     * @name CreateBucketEvent
-     * @summary Create Bucket Event
-     * @request POST:/buckets/{bucket_name}/events
+     * @name DeleteAccessRuleWithBucket
+     * @summary Delete Access Rule From Given Bucket
+     * @request DELETE:/bucket/{bucket}/access-rules
      * @secure
      */
-    createBucketEvent: (
-      bucketName: string,
-      body: BucketEventRequest,
+    deleteAccessRuleWithBucket: (
+      bucket: string,
+      prefix: PrefixWrapper,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/events`,
-        method: "POST",
-        body: body,
+      this.request<boolean, Error>({
+        path: `/bucket/${bucket}/access-rules`,
+        method: "DELETE",
+        body: prefix,
         secure: true,
         type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-
+  };
+  bucketUsers = {
     /**
      * No description
      *
      * @tags Bucket
-     * @name DeleteBucketEvent
-     * @summary Delete Bucket Event
-     * @request DELETE:/buckets/{bucket_name}/events/{arn}
+     * @name ListUsersWithAccessToBucket
+     * @summary List Users With Access to a Given Bucket
+     * @request GET:/bucket-users/{bucket}
      * @secure
      */
-    deleteBucketEvent: (
-      bucketName: string,
-      arn: string,
-      body: NotificationDeleteRequest,
+    listUsersWithAccessToBucket: (
+      bucket: string,
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/events/${arn}`,
-        method: "DELETE",
-        body: body,
-        secure: true,
-        type: ContentType.Json,
-        ...params,
-      }),
-
-    /**
-     * No description
-     *
-     * @tags Bucket
-     * @name GetBucketReplication
-     * @summary Bucket Replication
-     * @request GET:/buckets/{bucket_name}/replication
-     * @secure
-     */
-    getBucketReplication: (bucketName: string, params: RequestParams = {}) =>
-      this.request<BucketReplicationResponse, Error>({
-        path: `/buckets/${bucketName}/replication`,
+      this.request<ServiceAccounts, Error>({
+        path: `/bucket-users/${bucket}`,
         method: "GET",
+        query: query,
         secure: true,
         format: "json",
         ...params,
       }),
-
+  };
+  policy = {
     /**
      * No description
      *
-     * @tags Bucket
-     * @name GetBucketReplicationRule
-     * @summary Bucket Replication
-     * @request GET:/buckets/{bucket_name}/replication/{rule_id}
+     * @tags Policy
+     * @name PolicyInfo
+     * @summary Policy info
+     * @request GET:/policy/{name}
      * @secure
      */
-    getBucketReplicationRule: (
-      bucketName: string,
-      ruleId: string,
-      params: RequestParams = {}
-    ) =>
-      this.request<BucketReplicationRule, Error>({
-        path: `/buckets/${bucketName}/replication/${ruleId}`,
+    policyInfo: (name: string, params: RequestParams = {}) =>
+      this.request<Policy, Error>({
+        path: `/policy/${name}`,
         method: "GET",
         secure: true,
         format: "json",
@@ -2559,105 +6833,86 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name UpdateMultiBucketReplication
-     * @summary Update Replication rule
-     * @request PUT:/buckets/{bucket_name}/replication/{rule_id}
+     * @tags Policy
+     * @name RemovePolicy
+     * @summary Remove policy
+     * @request DELETE:/policy/{name}
      * @secure
      */
-    updateMultiBucketReplication: (
-      bucketName: string,
-      ruleId: string,
-      body: MultiBucketReplicationEdit,
-      params: RequestParams = {}
-    ) =>
+    removePolicy: (name: string, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/buckets/${bucketName}/replication/${ruleId}`,
-        method: "PUT",
-        body: body,
+        path: `/policy/${name}`,
+        method: "DELETE",
         secure: true,
-        type: ContentType.Json,
         ...params,
       }),
-
+  };
+  configs = {
     /**
      * No description
      *
-     * @tags Bucket
-     * @name DeleteBucketReplicationRule
-     * @summary Bucket Replication Rule Delete
-     * @request DELETE:/buckets/{bucket_name}/replication/{rule_id}
+     * @tags Configuration
+     * @name ListConfig
+     * @summary List Configurations
+     * @request GET:/configs
      * @secure
      */
-    deleteBucketReplicationRule: (
-      bucketName: string,
-      ruleId: string,
+    listConfig: (
+      query?: {
+        /** @format int32 */
+        offset?: number;
+        /** @format int32 */
+        limit?: number;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/replication/${ruleId}`,
-        method: "DELETE",
+      this.request<ListConfigResponse, Error>({
+        path: `/configs`,
+        method: "GET",
+        query: query,
         secure: true,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name DeleteAllReplicationRules
-     * @summary Deletes all replication rules from a bucket
-     * @request DELETE:/buckets/{bucket_name}/delete-all-replication-rules
+     * @tags Configuration
+     * @name ConfigInfo
+     * @summary Configuration info
+     * @request GET:/configs/{name}
      * @secure
      */
-    deleteAllReplicationRules: (
-      bucketName: string,
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/delete-all-replication-rules`,
-        method: "DELETE",
+    configInfo: (name: string, params: RequestParams = {}) =>
+      this.request<Configuration[], Error>({
+        path: `/configs/${name}`,
+        method: "GET",
         secure: true,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name DeleteSelectedReplicationRules
-     * @summary Deletes selected replication rules from a bucket
-     * @request DELETE:/buckets/{bucket_name}/delete-selected-replication-rules
+     * @tags Configuration
+     * @name SetConfig
+     * @summary Set Configuration
+     * @request PUT:/configs/{name}
      * @secure
      */
-    deleteSelectedReplicationRules: (
-      bucketName: string,
-      rules: BucketReplicationRuleList,
+    setConfig: (
+      name: string,
+      body: SetConfigRequest,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/delete-selected-replication-rules`,
-        method: "DELETE",
-        body: rules,
+      this.request<SetConfigResponse, Error>({
+        path: `/configs/${name}`,
+        method: "PUT",
+        body: body,
         secure: true,
         type: ContentType.Json,
-        ...params,
-      }),
-
-    /**
-     * No description
-     *
-     * @tags Bucket
-     * @name GetBucketVersioning
-     * @summary Bucket Versioning
-     * @request GET:/buckets/{bucket_name}/versioning
-     * @secure
-     */
-    getBucketVersioning: (bucketName: string, params: RequestParams = {}) =>
-      this.request<BucketVersioningResponse, Error>({
-        path: `/buckets/${bucketName}/versioning`,
-        method: "GET",
-        secure: true,
         format: "json",
         ...params,
       }),
@@ -2665,100 +6920,110 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name SetBucketVersioning
-     * @summary Set Bucket Versioning
-     * @request PUT:/buckets/{bucket_name}/versioning
+     * @tags Configuration
+     * @name ResetConfig
+     * @summary Configuration reset
+     * @request POST:/configs/{name}/reset
      * @secure
      */
-    setBucketVersioning: (
-      bucketName: string,
-      body: SetBucketVersioning,
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/versioning`,
-        method: "PUT",
-        body: body,
+    resetConfig: (name: string, params: RequestParams = {}) =>
+      this.request<SetConfigResponse, Error>({
+        path: `/configs/${name}/reset`,
+        method: "POST",
         secure: true,
-        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name GetBucketObjectLockingStatus
-     * @summary Returns the status of object locking support on the bucket
-     * @request GET:/buckets/{bucket_name}/object-locking
+     * @tags Configuration
+     * @name ExportServerConfig
+     * @summary Export the whole MinIO server configuration as a document that can be imported
+     * @request GET:/configs/server/export
      * @secure
      */
-    getBucketObjectLockingStatus: (
-      bucketName: string,
+    exportServerConfig: (
+      query?: {
+        redactSecrets?: boolean;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<BucketObLockingResponse, Error>({
-        path: `/buckets/${bucketName}/object-locking`,
+      this.request<File, Error>({
+        path: `/configs/server/export`,
         method: "GET",
+        query: query,
         secure: true,
-        format: "json",
         ...params,
       }),
 
     /**
      * No description
-     *
-     * @tags Bucket
-     * @name EnableBucketEncryption
-     * @summary Enable bucket encryption.
-     * @request POST:/buckets/{bucket_name}/encryption/enable
+     *
+     * @tags Configuration
+     * @name ImportServerConfig
+     * @summary Validate and import a MinIO server configuration document
+     * @request POST:/configs/server/import
      * @secure
      */
-    enableBucketEncryption: (
-      bucketName: string,
-      body: BucketEncryptionRequest,
+    importServerConfig: (
+      data: {
+        file: File;
+      },
+      query?: {
+        dryRun?: boolean;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/encryption/enable`,
+      this.request<ServerConfigImportResponse, Error>({
+        path: `/configs/server/import`,
         method: "POST",
-        body: body,
+        query: query,
+        body: data,
         secure: true,
-        type: ContentType.Json,
+        type: ContentType.FormData,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name DisableBucketEncryption
-     * @summary Disable bucket encryption.
-     * @request POST:/buckets/{bucket_name}/encryption/disable
+     * @tags Configuration
+     * @name ListConfigRevisions
+     * @summary List the configuration changes made through Console, newest first
+     * @request GET:/configs/history
      * @secure
      */
-    disableBucketEncryption: (bucketName: string, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/encryption/disable`,
-        method: "POST",
+    listConfigRevisions: (
+      query?: {
+        target?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ConfigRevisions, Error>({
+        path: `/configs/history`,
+        method: "GET",
+        query: query,
         secure: true,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name GetBucketEncryptionInfo
-     * @summary Get bucket encryption information.
-     * @request GET:/buckets/{bucket_name}/encryption/info
+     * @tags Configuration
+     * @name RollbackConfigRevision
+     * @summary Restore the configuration a subsystem target had before a revision
+     * @request POST:/configs/history/{id}/rollback
      * @secure
      */
-    getBucketEncryptionInfo: (bucketName: string, params: RequestParams = {}) =>
-      this.request<BucketEncryptionInfo, Error>({
-        path: `/buckets/${bucketName}/encryption/info`,
-        method: "GET",
+    rollbackConfigRevision: (id: string, params: RequestParams = {}) =>
+      this.request<SetConfigResponse, Error>({
+        path: `/configs/history/${id}/rollback`,
+        method: "POST",
         secure: true,
         format: "json",
         ...params,
@@ -2767,15 +7032,15 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name GetBucketLifecycle
-     * @summary Bucket Lifecycle
-     * @request GET:/buckets/{bucket_name}/lifecycle
+     * @tags Configuration
+     * @name ExportConfig
+     * @summary Export the current config from MinIO server
+     * @request GET:/configs/export
      * @secure
      */
-    getBucketLifecycle: (bucketName: string, params: RequestParams = {}) =>
-      this.request<BucketLifecycleResponse, Error>({
-        path: `/buckets/${bucketName}/lifecycle`,
+    exportConfig: (params: RequestParams = {}) =>
+      this.request<ConfigExportResponse, Error>({
+        path: `/configs/export`,
         method: "GET",
         secure: true,
         format: "json",
@@ -2785,45 +7050,42 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name AddBucketLifecycle
-     * @summary Add Bucket Lifecycle
-     * @request POST:/buckets/{bucket_name}/lifecycle
+     * @tags Configuration
+     * @name ImportCreate
+     * @summary Uploads a file to import MinIO server config.
+     * @request POST:/configs/import
      * @secure
      */
-    addBucketLifecycle: (
-      bucketName: string,
-      body: AddBucketLifecycle,
+    importCreate: (
+      data: {
+        /** @format binary */
+        file: File;
+      },
       params: RequestParams = {}
     ) =>
       this.request<void, Error>({
-        path: `/buckets/${bucketName}/lifecycle`,
+        path: `/configs/import`,
         method: "POST",
-        body: body,
+        body: data,
         secure: true,
-        type: ContentType.Json,
+        type: ContentType.FormData,
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Bucket
-     * @name AddMultiBucketLifecycle
-     * @summary Add Multi Bucket Lifecycle
-     * @request POST:/buckets/multi-lifecycle
+     * @tags Configuration
+     * @name GetTrustedProxies
+     * @summary Returns the trusted proxies used to resolve client addresses
+     * @request GET:/configs/trusted-proxies
      * @secure
      */
-    addMultiBucketLifecycle: (
-      body: AddMultiBucketLifecycle,
-      params: RequestParams = {}
-    ) =>
-      this.request<MultiLifecycleResult, Error>({
-        path: `/buckets/multi-lifecycle`,
-        method: "POST",
-        body: body,
+    getTrustedProxies: (params: RequestParams = {}) =>
+      this.request<TrustedProxiesConfiguration, Error>({
+        path: `/configs/trusted-proxies`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -2831,224 +7093,199 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name UpdateBucketLifecycle
-     * @summary Update Lifecycle rule
-     * @request PUT:/buckets/{bucket_name}/lifecycle/{lifecycle_id}
+     * @tags Configuration
+     * @name SetTrustedProxies
+     * @summary Sets the trusted proxies used to resolve client addresses
+     * @request PUT:/configs/trusted-proxies
      * @secure
      */
-    updateBucketLifecycle: (
-      bucketName: string,
-      lifecycleId: string,
-      body: UpdateBucketLifecycle,
+    setTrustedProxies: (
+      body: TrustedProxiesConfiguration,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/buckets/${bucketName}/lifecycle/${lifecycleId}`,
+      this.request<TrustedProxiesConfiguration, Error>({
+        path: `/configs/trusted-proxies`,
         method: "PUT",
         body: body,
         secure: true,
         type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-
+  };
+  setPolicy = {
     /**
      * No description
      *
-     * @tags Bucket
-     * @name DeleteBucketLifecycleRule
-     * @summary Delete Lifecycle rule
-     * @request DELETE:/buckets/{bucket_name}/lifecycle/{lifecycle_id}
+     * @tags Policy
+     * @name SetPolicy
+     * @summary Set policy
+     * @request PUT:/set-policy
      * @secure
      */
-    deleteBucketLifecycleRule: (
-      bucketName: string,
-      lifecycleId: string,
-      params: RequestParams = {}
-    ) =>
+    setPolicy: (body: SetPolicyNameRequest, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/buckets/${bucketName}/lifecycle/${lifecycleId}`,
-        method: "DELETE",
-        secure: true,
-        ...params,
-      }),
-
-    /**
-     * No description
-     *
-     * @tags Bucket
-     * @name GetBucketRewind
-     * @summary Get objects in a bucket for a rewind date
-     * @request GET:/buckets/{bucket_name}/rewind/{date}
-     * @secure
-     */
-    getBucketRewind: (
-      bucketName: string,
-      date: string,
-      query?: {
-        prefix?: string;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<RewindResponse, Error>({
-        path: `/buckets/${bucketName}/rewind/${date}`,
-        method: "GET",
-        query: query,
+        path: `/set-policy`,
+        method: "PUT",
+        body: body,
         secure: true,
-        format: "json",
+        type: ContentType.Json,
         ...params,
       }),
   };
-  listExternalBuckets = {
+  setPolicyMulti = {
     /**
      * No description
      *
-     * @tags Bucket
-     * @name ListExternalBuckets
-     * @summary Lists an External list of buckets using custom credentials
-     * @request POST:/list-external-buckets
+     * @tags Policy
+     * @name SetPolicyMultiple
+     * @summary Set policy to multiple users/groups
+     * @request PUT:/set-policy-multi
      * @secure
      */
-    listExternalBuckets: (
-      body: ListExternalBucketsParams,
+    setPolicyMultiple: (
+      body: SetPolicyMultipleNameRequest,
       params: RequestParams = {}
     ) =>
-      this.request<ListBucketsResponse, Error>({
-        path: `/list-external-buckets`,
-        method: "POST",
+      this.request<void, Error>({
+        path: `/set-policy-multi`,
+        method: "PUT",
         body: body,
         secure: true,
         type: ContentType.Json,
-        format: "json",
         ...params,
       }),
   };
-  bucketsReplication = {
+  iam = {
     /**
      * No description
      *
-     * @tags Bucket
-     * @name SetMultiBucketReplication
-     * @summary Sets Multi Bucket Replication in multiple Buckets
-     * @request POST:/buckets-replication
+     * @tags Configuration
+     * @name ExportIam
+     * @summary Export users, groups, policies, service accounts and policy mappings as a zip file
+     * @request GET:/iam/export
      * @secure
      */
-    setMultiBucketReplication: (
-      body: MultiBucketReplication,
-      params: RequestParams = {}
-    ) =>
-      this.request<MultiBucketResponseState, Error>({
-        path: `/buckets-replication`,
-        method: "POST",
-        body: body,
+    exportIam: (params: RequestParams = {}) =>
+      this.request<File, Error>({
+        path: `/iam/export`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
-        format: "json",
         ...params,
       }),
-  };
-  serviceAccounts = {
+
     /**
      * No description
      *
-     * @tags ServiceAccount
-     * @name ListUserServiceAccounts
-     * @summary List User's Service Accounts
-     * @request GET:/service-accounts
+     * @tags Configuration
+     * @name ImportIam
+     * @summary Import users, groups, policies, service accounts and policy mappings from an exported zip file
+     * @request POST:/iam/import
      * @secure
      */
-    listUserServiceAccounts: (
+    importIam: (
+      data: {
+        file: File;
+      },
       query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
+        dryRun?: boolean;
       },
       params: RequestParams = {}
     ) =>
-      this.request<ServiceAccounts, Error>({
-        path: `/service-accounts`,
-        method: "GET",
+      this.request<IamImportResponse, Error>({
+        path: `/iam/import`,
+        method: "POST",
         query: query,
+        body: data,
         secure: true,
+        type: ContentType.FormData,
         format: "json",
         ...params,
       }),
-
+  };
+  service = {
     /**
      * No description
      *
-     * @tags ServiceAccount
-     * @name CreateServiceAccount
-     * @summary Create Service Account
-     * @request POST:/service-accounts
+     * @tags Service
+     * @name RestartService
+     * @summary Restart Service
+     * @request POST:/service/restart
      * @secure
      */
-    createServiceAccount: (
-      body: ServiceAccountRequest,
-      params: RequestParams = {}
-    ) =>
-      this.request<ServiceAccountCreds, Error>({
-        path: `/service-accounts`,
+    restartService: (params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/service/restart`,
         method: "POST",
-        body: body,
         secure: true,
-        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags ServiceAccount
-     * @name DeleteServiceAccount
-     * @summary Delete Service Account
-     * @request DELETE:/service-accounts/{access_key}
+     * @tags Service
+     * @name CheckServerUpdate
+     * @summary Compares the version of every node with the latest MinIO release
+     * @request GET:/service/update
      * @secure
      */
-    deleteServiceAccount: (accessKey: string, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/service-accounts/${accessKey}`,
-        method: "DELETE",
+    checkServerUpdate: (
+      query?: {
+        /** URL of the sha256sum file of the release, the linux-amd64 MinIO release by default */
+        updateURL?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ServerUpdateCheck, Error>({
+        path: `/service/update`,
+        method: "GET",
+        query: query,
         secure: true,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags ServiceAccount
-     * @name DeleteMultipleServiceAccounts
-     * @summary Delete Multiple Service Accounts
-     * @request DELETE:/service-accounts/delete-multi
+     * @tags Service
+     * @name CreateServiceConfirmation
+     * @summary Returns the short lived token an update or a restart of the cluster has to be confirmed with
+     * @request POST:/service/confirmation
      * @secure
      */
-    deleteMultipleServiceAccounts: (
-      selectedSA: string[],
+    createServiceConfirmation: (
+      body: ServiceConfirmationRequest,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/service-accounts/delete-multi`,
-        method: "DELETE",
-        body: selectedSA,
+      this.request<ServiceConfirmation, Error>({
+        path: `/service/confirmation`,
+        method: "POST",
+        body: body,
         secure: true,
+        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-
+  };
+  profiling = {
     /**
      * No description
      *
-     * @tags ServiceAccount
-     * @name GetServiceAccountPolicy
-     * @summary Get Service Account Policy
-     * @request GET:/service-accounts/{access_key}/policy
+     * @tags Profile
+     * @name ProfilingStart
+     * @summary Start recording profile data
+     * @request POST:/profiling/start
      * @secure
      */
-    getServiceAccountPolicy: (accessKey: string, params: RequestParams = {}) =>
-      this.request<IamEntity, Error>({
-        path: `/service-accounts/${accessKey}/policy`,
-        method: "GET",
+    profilingStart: (body: ProfilingStartRequest, params: RequestParams = {}) =>
+      this.request<StartProfilingList, Error>({
+        path: `/profiling/start`,
+        method: "POST",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -3056,70 +7293,74 @@ export class Api<
     /**
      * No description
      *
-     * @tags ServiceAccount
-     * @name SetServiceAccountPolicy
-     * @summary Set Service Account Policy
-     * @request PUT:/service-accounts/{access_key}/policy
+     * @tags Profile
+     * @name ProfilingStop
+     * @summary Stop and download profile data
+     * @request POST:/profiling/stop
      * @secure
      */
-    setServiceAccountPolicy: (
-      accessKey: string,
-      policy: AddServiceAccountPolicyRequest,
-      params: RequestParams = {}
-    ) =>
-      this.request<void, Error>({
-        path: `/service-accounts/${accessKey}/policy`,
-        method: "PUT",
-        body: policy,
+    profilingStop: (params: RequestParams = {}) =>
+      this.request<File, Error>({
+        path: `/profiling/stop`,
+        method: "POST",
         secure: true,
-        type: ContentType.Json,
         ...params,
       }),
   };
-  serviceAccountCredentials = {
+  subnet = {
     /**
      * No description
      *
-     * @tags ServiceAccount
-     * @name CreateServiceAccountCreds
-     * @summary Create Service Account With Credentials
-     * @request POST:/service-account-credentials
+     * @tags Subnet
+     * @name SubnetRegToken
+     * @summary Subnet registraton token
+     * @request GET:/subnet/registration-token
      * @secure
      */
-    createServiceAccountCreds: (
-      body: ServiceAccountRequestCreds,
-      params: RequestParams = {}
-    ) =>
-      this.request<ServiceAccountCreds, Error>({
-        path: `/service-account-credentials`,
-        method: "POST",
-        body: body,
+    subnetRegToken: (params: RequestParams = {}) =>
+      this.request<SubnetRegTokenResponse, Error>({
+        path: `/subnet/registration-token`,
+        method: "GET",
         secure: true,
         format: "json",
         ...params,
       }),
-  };
-  users = {
+
     /**
      * No description
      *
-     * @tags User
-     * @name ListUsers
-     * @summary List Users
-     * @request GET:/users
+     * @tags Subnet
+     * @name SubnetInfo
+     * @summary Subnet info
+     * @request GET:/subnet/info
      * @secure
      */
-    listUsers: (
-      query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
+    subnetInfo: (params: RequestParams = {}) =>
+      this.request<License, Error>({
+        path: `/subnet/info`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Subnet
+     * @name SubnetApiKey
+     * @summary Subnet api key
+     * @request GET:/subnet/apikey
+     * @secure
+     */
+    subnetApiKey: (
+      query: {
+        token: string;
       },
       params: RequestParams = {}
     ) =>
-      this.request<ListUsersResponse, Error>({
-        path: `/users`,
+      this.request<ApiKey, Error>({
+        path: `/subnet/apikey`,
         method: "GET",
         query: query,
         secure: true,
@@ -3130,84 +7371,83 @@ export class Api<
     /**
      * No description
      *
-     * @tags User
-     * @name AddUser
-     * @summary Add User
-     * @request POST:/users
+     * @tags Subnet
+     * @name SubnetRegister
+     * @summary Register cluster with Subnet
+     * @request POST:/subnet/register
      * @secure
      */
-    addUser: (body: AddUserRequest, params: RequestParams = {}) =>
-      this.request<User, Error>({
-        path: `/users`,
+    subnetRegister: (body: SubnetRegisterRequest, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/subnet/register`,
         method: "POST",
         body: body,
         secure: true,
         type: ContentType.Json,
-        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags User
-     * @name CheckUserServiceAccounts
-     * @summary Check number of service accounts for each user specified
-     * @request POST:/users/service-accounts
+     * @tags Subnet
+     * @name SubnetLogin
+     * @summary Login to subnet
+     * @request POST:/subnet/login
      * @secure
      */
-    checkUserServiceAccounts: (
-      selectedUsers: string[],
-      params: RequestParams = {}
-    ) =>
-      this.request<UserServiceAccountSummary, Error>({
-        path: `/users/service-accounts`,
+    subnetLogin: (body: SubnetLoginRequest, params: RequestParams = {}) =>
+      this.request<SubnetLoginResponse, Error>({
+        path: `/subnet/login`,
         method: "POST",
-        body: selectedUsers,
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-  };
-  user = {
+
     /**
      * No description
      *
-     * @tags User
-     * @name GetUserInfo
-     * @summary Get User Info
-     * @request GET:/user/{name}
+     * @tags Subnet
+     * @name SubnetLoginMfa
+     * @summary Login to subnet using mfa
+     * @request POST:/subnet/login/mfa
      * @secure
      */
-    getUserInfo: (name: string, params: RequestParams = {}) =>
-      this.request<User, Error>({
-        path: `/user/${name}`,
-        method: "GET",
+    subnetLoginMfa: (body: SubnetLoginMFARequest, params: RequestParams = {}) =>
+      this.request<SubnetLoginResponse, Error>({
+        path: `/subnet/login/mfa`,
+        method: "POST",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-
+  };
+  admin = {
     /**
      * No description
      *
-     * @tags User
-     * @name UpdateUserInfo
-     * @summary Update User Info
-     * @request PUT:/user/{name}
+     * @tags System
+     * @name GetPreflightReport
+     * @summary Returns the result of validating the integrations configured in Console
+     * @request GET:/admin/preflight
      * @secure
      */
-    updateUserInfo: (
-      name: string,
-      body: UpdateUser,
+    getPreflightReport: (
+      query?: {
+        refresh?: boolean;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<User, Error>({
-        path: `/user/${name}`,
-        method: "PUT",
-        body: body,
+      this.request<PreflightReport, Error>({
+        path: `/admin/preflight`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -3215,40 +7455,52 @@ export class Api<
     /**
      * No description
      *
-     * @tags User
-     * @name RemoveUser
-     * @summary Remove user
-     * @request DELETE:/user/{name}
+     * @tags System
+     * @name AdminInfo
+     * @summary Returns information about the deployment
+     * @request GET:/admin/info
      * @secure
      */
-    removeUser: (name: string, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/user/${name}`,
-        method: "DELETE",
+    adminInfo: (
+      query?: {
+        /** @default false */
+        defaultOnly?: boolean;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<AdminInfoResponse, Error>({
+        path: `/admin/info`,
+        method: "GET",
+        query: query,
         secure: true,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags User
-     * @name UpdateUserGroups
-     * @summary Update Groups for a user
-     * @request PUT:/user/{name}/groups
+     * @tags System
+     * @name DashboardWidgetDetails
+     * @summary Returns information about the deployment
+     * @request GET:/admin/info/widgets/{widgetId}
      * @secure
      */
-    updateUserGroups: (
-      name: string,
-      body: UpdateUserGroups,
+    dashboardWidgetDetails: (
+      widgetId: number,
+      query?: {
+        start?: number;
+        end?: number;
+        /** @format int32 */
+        step?: number;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<User, Error>({
-        path: `/user/${name}/groups`,
-        method: "PUT",
-        body: body,
+      this.request<WidgetDetails, Error>({
+        path: `/admin/info/widgets/${widgetId}`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -3256,15 +7508,15 @@ export class Api<
     /**
      * No description
      *
-     * @tags Policy
-     * @name GetUserPolicy
-     * @summary returns policies for logged in user
-     * @request GET:/user/policy
+     * @tags System
+     * @name ArnList
+     * @summary Returns a list of active ARNs in the instance
+     * @request GET:/admin/arns
      * @secure
      */
-    getUserPolicy: (params: RequestParams = {}) =>
-      this.request<IamEntity, Error>({
-        path: `/user/policy`,
+    arnList: (params: RequestParams = {}) =>
+      this.request<ArnsResponse, Error>({
+        path: `/admin/arns`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3274,15 +7526,15 @@ export class Api<
     /**
      * No description
      *
-     * @tags Policy
-     * @name GetSaUserPolicy
-     * @summary returns policies assigned for a specified user
-     * @request GET:/user/{name}/policies
+     * @tags Configuration
+     * @name NotificationEndpointsStatus
+     * @summary Status of every configured notification endpoint along with the reason it isn't delivering events
+     * @request GET:/admin/notification_endpoints/status
      * @secure
      */
-    getSaUserPolicy: (name: string, params: RequestParams = {}) =>
-      this.request<AUserPolicyResponse, Error>({
-        path: `/user/${name}/policies`,
+    notificationEndpointsStatus: (params: RequestParams = {}) =>
+      this.request<NotificationEndpointsStatus, Error>({
+        path: `/admin/notification_endpoints/status`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3292,15 +7544,19 @@ export class Api<
     /**
      * No description
      *
-     * @tags User
-     * @name ListAUserServiceAccounts
-     * @summary returns a list of service accounts for a user
-     * @request GET:/user/{name}/service-accounts
+     * @tags Configuration
+     * @name GetNotificationEndpoint
+     * @summary Returns the configuration of a notification endpoint
+     * @request GET:/admin/notification_endpoints/{service}/{account_id}
      * @secure
      */
-    listAUserServiceAccounts: (name: string, params: RequestParams = {}) =>
-      this.request<ServiceAccounts, Error>({
-        path: `/user/${name}/service-accounts`,
+    getNotificationEndpoint: (
+      service: string,
+      accountId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<NotificationEndpoint, Error>({
+        path: `/admin/notification_endpoints/${service}/${accountId}`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3310,22 +7566,24 @@ export class Api<
     /**
      * No description
      *
-     * @tags User
-     * @name CreateAUserServiceAccount
-     * @summary Create Service Account for User
-     * @request POST:/user/{name}/service-accounts
+     * @tags Configuration
+     * @name UpdateNotificationEndpoint
+     * @summary Updates the configuration of a notification endpoint
+     * @request PUT:/admin/notification_endpoints/{service}/{account_id}
      * @secure
      */
-    createAUserServiceAccount: (
-      name: string,
-      body: ServiceAccountRequest,
+    updateNotificationEndpoint: (
+      service: string,
+      accountId: string,
+      body: UpdateNotificationEndpointRequest,
       params: RequestParams = {}
     ) =>
-      this.request<ServiceAccountCreds, Error>({
-        path: `/user/${name}/service-accounts`,
-        method: "POST",
+      this.request<SetNotificationEndpointResponse, Error>({
+        path: `/admin/notification_endpoints/${service}/${accountId}`,
+        method: "PUT",
         body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -3333,69 +7591,63 @@ export class Api<
     /**
      * No description
      *
-     * @tags User
-     * @name CreateServiceAccountCredentials
-     * @summary Create Service Account for User With Credentials
-     * @request POST:/user/{name}/service-account-credentials
+     * @tags Configuration
+     * @name DeleteNotificationEndpoint
+     * @summary Removes a notification endpoint
+     * @request DELETE:/admin/notification_endpoints/{service}/{account_id}
      * @secure
      */
-    createServiceAccountCredentials: (
-      name: string,
-      body: ServiceAccountRequestCreds,
+    deleteNotificationEndpoint: (
+      service: string,
+      accountId: string,
+      query?: {
+        force?: boolean;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<ServiceAccountCreds, Error>({
-        path: `/user/${name}/service-account-credentials`,
-        method: "POST",
-        body: body,
+      this.request<void, Error>({
+        path: `/admin/notification_endpoints/${service}/${accountId}`,
+        method: "DELETE",
+        query: query,
         secure: true,
-        format: "json",
         ...params,
       }),
-  };
-  usersGroupsBulk = {
+
     /**
      * No description
      *
-     * @tags User
-     * @name BulkUpdateUsersGroups
-     * @summary Bulk functionality to Add Users to Groups
-     * @request PUT:/users-groups-bulk
+     * @tags Configuration
+     * @name TestNotificationEndpoint
+     * @summary Checks a notification endpoint and sends it a test event when possible
+     * @request POST:/admin/notification_endpoints/{service}/{account_id}/test
      * @secure
      */
-    bulkUpdateUsersGroups: (body: BulkUserGroups, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/users-groups-bulk`,
-        method: "PUT",
-        body: body,
+    testNotificationEndpoint: (
+      service: string,
+      accountId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<NotificationEndpointTestResult, Error>({
+        path: `/admin/notification_endpoints/${service}/${accountId}/test`,
+        method: "POST",
         secure: true,
-        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-  };
-  groups = {
+
     /**
      * No description
      *
-     * @tags Group
-     * @name ListGroups
-     * @summary List Groups
-     * @request GET:/groups
+     * @tags Configuration
+     * @name ListLogTargets
+     * @summary Lists the audit and logger targets server logs are shipped to
+     * @request GET:/admin/log-targets
      * @secure
      */
-    listGroups: (
-      query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<ListGroupsResponse, Error>({
-        path: `/groups`,
+    listLogTargets: (params: RequestParams = {}) =>
+      this.request<LogTargetList, Error>({
+        path: `/admin/log-targets`,
         method: "GET",
-        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -3404,35 +7656,35 @@ export class Api<
     /**
      * No description
      *
-     * @tags Group
-     * @name AddGroup
-     * @summary Add Group
-     * @request POST:/groups
+     * @tags Configuration
+     * @name AddLogTarget
+     * @summary Adds an audit_webhook, audit_kafka or logger_webhook target
+     * @request POST:/admin/log-targets
      * @secure
      */
-    addGroup: (body: AddGroupRequest, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/groups`,
+    addLogTarget: (body: LogTarget, params: RequestParams = {}) =>
+      this.request<LogTarget, Error>({
+        path: `/admin/log-targets`,
         method: "POST",
         body: body,
         secure: true,
         type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-  };
-  group = {
+
     /**
      * No description
      *
-     * @tags Group
-     * @name GroupInfo
-     * @summary Group info
-     * @request GET:/group/{name}
+     * @tags Configuration
+     * @name GetLogTarget
+     * @summary Returns the configuration of a log target
+     * @request GET:/admin/log-targets/{type}/{name}
      * @secure
      */
-    groupInfo: (name: string, params: RequestParams = {}) =>
-      this.request<Group, Error>({
-        path: `/group/${name}`,
+    getLogTarget: (type: string, name: string, params: RequestParams = {}) =>
+      this.request<LogTarget, Error>({
+        path: `/admin/log-targets/${type}/${name}`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3442,67 +7694,81 @@ export class Api<
     /**
      * No description
      *
-     * @tags Group
-     * @name RemoveGroup
-     * @summary Remove group
-     * @request DELETE:/group/{name}
+     * @tags Configuration
+     * @name UpdateLogTarget
+     * @summary Updates the configuration of a log target
+     * @request PUT:/admin/log-targets/{type}/{name}
      * @secure
      */
-    removeGroup: (name: string, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/group/${name}`,
-        method: "DELETE",
+    updateLogTarget: (
+      type: string,
+      name: string,
+      body: UpdateLogTargetRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<LogTarget, Error>({
+        path: `/admin/log-targets/${type}/${name}`,
+        method: "PUT",
+        body: body,
         secure: true,
+        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Group
-     * @name UpdateGroup
-     * @summary Update Group Members or Status
-     * @request PUT:/group/{name}
+     * @tags Configuration
+     * @name DisableLogTarget
+     * @summary Disables a log target keeping its configuration
+     * @request POST:/admin/log-targets/{type}/{name}/disable
      * @secure
      */
-    updateGroup: (
+    disableLogTarget: (
+      type: string,
       name: string,
-      body: UpdateGroupRequest,
       params: RequestParams = {}
     ) =>
-      this.request<Group, Error>({
-        path: `/group/${name}`,
-        method: "PUT",
-        body: body,
+      this.request<LogTarget, Error>({
+        path: `/admin/log-targets/${type}/${name}/disable`,
+        method: "POST",
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-  };
-  policies = {
+
     /**
      * No description
      *
-     * @tags Policy
-     * @name ListPolicies
-     * @summary List Policies
-     * @request GET:/policies
+     * @tags Configuration
+     * @name TestLogTarget
+     * @summary Sends a test entry to a log target when possible
+     * @request POST:/admin/log-targets/{type}/{name}/test
      * @secure
      */
-    listPolicies: (
-      query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<ListPoliciesResponse, Error>({
-        path: `/policies`,
+    testLogTarget: (type: string, name: string, params: RequestParams = {}) =>
+      this.request<LogTargetTestResult, Error>({
+        path: `/admin/log-targets/${type}/${name}/test`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Configuration
+     * @name NotificationEndpointList
+     * @summary Returns a list of active notification endpoints
+     * @request GET:/admin/notification_endpoints
+     * @secure
+     */
+    notificationEndpointList: (params: RequestParams = {}) =>
+      this.request<NotifEndpointResponse, Error>({
+        path: `/admin/notification_endpoints`,
         method: "GET",
-        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -3511,15 +7777,18 @@ export class Api<
     /**
      * No description
      *
-     * @tags Policy
-     * @name AddPolicy
-     * @summary Add Policy
-     * @request POST:/policies
+     * @tags Configuration
+     * @name AddNotificationEndpoint
+     * @summary Allows to configure a new notification endpoint
+     * @request POST:/admin/notification_endpoints
      * @secure
      */
-    addPolicy: (body: AddPolicyRequest, params: RequestParams = {}) =>
-      this.request<Policy, Error>({
-        path: `/policies`,
+    addNotificationEndpoint: (
+      body: NotificationEndpoint,
+      params: RequestParams = {}
+    ) =>
+      this.request<SetNotificationEndpointResponse, Error>({
+        path: `/admin/notification_endpoints`,
         method: "POST",
         body: body,
         secure: true,
@@ -3531,15 +7800,15 @@ export class Api<
     /**
      * No description
      *
-     * @tags Policy
-     * @name ListUsersForPolicy
-     * @summary List Users for a Policy
-     * @request GET:/policies/{policy}/users
+     * @tags SiteReplication
+     * @name GetSiteReplicationInfo
+     * @summary Get list of Replication Sites
+     * @request GET:/admin/site-replication
      * @secure
      */
-    listUsersForPolicy: (policy: string, params: RequestParams = {}) =>
-      this.request<ServiceAccounts, Error>({
-        path: `/policies/${policy}/users`,
+    getSiteReplicationInfo: (params: RequestParams = {}) =>
+      this.request<SiteReplicationInfoResponse, Error>({
+        path: `/admin/site-replication`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3549,71 +7818,109 @@ export class Api<
     /**
      * No description
      *
-     * @tags Policy
-     * @name ListGroupsForPolicy
-     * @summary List Groups for a Policy
-     * @request GET:/policies/{policy}/groups
+     * @tags SiteReplication
+     * @name SiteReplicationInfoAdd
+     * @summary Add a Replication Site
+     * @request POST:/admin/site-replication
      * @secure
      */
-    listGroupsForPolicy: (policy: string, params: RequestParams = {}) =>
-      this.request<ServiceAccounts, Error>({
-        path: `/policies/${policy}/groups`,
-        method: "GET",
+    siteReplicationInfoAdd: (
+      body: SiteReplicationAddRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<SiteReplicationAddResponse, Error>({
+        path: `/admin/site-replication`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags SiteReplication
+     * @name SiteReplicationEdit
+     * @summary Edit a Replication Site
+     * @request PUT:/admin/site-replication
+     * @secure
+     */
+    siteReplicationEdit: (body: PeerInfo, params: RequestParams = {}) =>
+      this.request<PeerSiteEditResponse, Error>({
+        path: `/admin/site-replication`,
+        method: "PUT",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-  };
-  bucketPolicy = {
+
     /**
      * No description
      *
-     * @tags Bucket
-     * @name ListPoliciesWithBucket
-     * @summary List Policies With Given Bucket
-     * @request GET:/bucket-policy/{bucket}
+     * @tags SiteReplication
+     * @name SiteReplicationRemove
+     * @summary Remove a Replication Site
+     * @request DELETE:/admin/site-replication
      * @secure
      */
-    listPoliciesWithBucket: (
-      bucket: string,
-      query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<ListPoliciesResponse, Error>({
-        path: `/bucket-policy/${bucket}`,
-        method: "GET",
-        query: query,
+    siteReplicationRemove: (body: PeerInfoRemove, params: RequestParams = {}) =>
+      this.request<PeerSiteRemoveResponse, Error>({
+        path: `/admin/site-replication`,
+        method: "DELETE",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-  };
-  bucket = {
+
     /**
      * No description
      *
-     * @tags Bucket
-     * @name SetAccessRuleWithBucket
-     * @summary Add Access Rule To Given Bucket
-     * @request PUT:/bucket/{bucket}/access-rules
+     * @tags SiteReplication
+     * @name GetSiteReplicationStatus
+     * @summary Display overall site replication status
+     * @request GET:/admin/site-replication/status
      * @secure
      */
-    setAccessRuleWithBucket: (
-      bucket: string,
-      prefixaccess: PrefixAccessPair,
+    getSiteReplicationStatus: (
+      query?: {
+        /**
+         * Include Bucket stats
+         * @default true
+         */
+        buckets?: boolean;
+        /**
+         * Include Group stats
+         * @default true
+         */
+        groups?: boolean;
+        /**
+         * Include Policies stats
+         * @default true
+         */
+        policies?: boolean;
+        /**
+         * Include Policies stats
+         * @default true
+         */
+        users?: boolean;
+        /** Entity Type to lookup */
+        entityType?: string;
+        /** Entity Value to lookup */
+        entityValue?: string;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<boolean, Error>({
-        path: `/bucket/${bucket}/access-rules`,
-        method: "PUT",
-        body: prefixaccess,
+      this.request<SiteReplicationStatusResponse, Error>({
+        path: `/admin/site-replication/status`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -3621,24 +7928,21 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name ListAccessRulesWithBucket
-     * @summary List Access Rules With Given Bucket
-     * @request GET:/bucket/{bucket}/access-rules
+     * @tags BatchJobs
+     * @name ListBatchJobs
+     * @summary List the batch jobs
+     * @request GET:/admin/batch-jobs
      * @secure
      */
-    listAccessRulesWithBucket: (
-      bucket: string,
+    listBatchJobs: (
       query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
+        /** Only list the jobs of this type */
+        type?: "replicate" | "keyrotate" | "expire";
       },
       params: RequestParams = {}
     ) =>
-      this.request<ListAccessRulesResponse, Error>({
-        path: `/bucket/${bucket}/access-rules`,
+      this.request<BatchJobList, Error>({
+        path: `/admin/batch-jobs`,
         method: "GET",
         query: query,
         secure: true,
@@ -3649,69 +7953,55 @@ export class Api<
     /**
      * No description
      *
-     * @tags Bucket
-     * @name DeleteAccessRuleWithBucket
-     * @summary Delete Access Rule From Given Bucket
-     * @request DELETE:/bucket/{bucket}/access-rules
+     * @tags BatchJobs
+     * @name StartBatchJob
+     * @summary Start a batch job
+     * @request POST:/admin/batch-jobs
      * @secure
      */
-    deleteAccessRuleWithBucket: (
-      bucket: string,
-      prefix: PrefixWrapper,
-      params: RequestParams = {}
-    ) =>
-      this.request<boolean, Error>({
-        path: `/bucket/${bucket}/access-rules`,
-        method: "DELETE",
-        body: prefix,
+    startBatchJob: (body: StartBatchJobRequest, params: RequestParams = {}) =>
+      this.request<BatchJob, Error>({
+        path: `/admin/batch-jobs`,
+        method: "POST",
+        body: body,
         secure: true,
         type: ContentType.Json,
         format: "json",
         ...params,
       }),
-  };
-  bucketUsers = {
+
     /**
      * No description
      *
-     * @tags Bucket
-     * @name ListUsersWithAccessToBucket
-     * @summary List Users With Access to a Given Bucket
-     * @request GET:/bucket-users/{bucket}
+     * @tags BatchJobs
+     * @name GenerateBatchJob
+     * @summary Generate the YAML definition of a batch job
+     * @request POST:/admin/batch-jobs/generate
      * @secure
      */
-    listUsersWithAccessToBucket: (
-      bucket: string,
-      query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<ServiceAccounts, Error>({
-        path: `/bucket-users/${bucket}`,
-        method: "GET",
-        query: query,
+    generateBatchJob: (body: BatchJobRequest, params: RequestParams = {}) =>
+      this.request<BatchJobDefinition, Error>({
+        path: `/admin/batch-jobs/generate`,
+        method: "POST",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-  };
-  policy = {
+
     /**
      * No description
      *
-     * @tags Policy
-     * @name PolicyInfo
-     * @summary Policy info
-     * @request GET:/policy/{name}
+     * @tags BatchJobs
+     * @name DescribeBatchJob
+     * @summary Describe a batch job and its progress
+     * @request GET:/admin/batch-jobs/{id}
      * @secure
      */
-    policyInfo: (name: string, params: RequestParams = {}) =>
-      this.request<Policy, Error>({
-        path: `/policy/${name}`,
+    describeBatchJob: (id: string, params: RequestParams = {}) =>
+      this.request<BatchJobDetails, Error>({
+        path: `/admin/batch-jobs/${id}`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3721,60 +8011,32 @@ export class Api<
     /**
      * No description
      *
-     * @tags Policy
-     * @name RemovePolicy
-     * @summary Remove policy
-     * @request DELETE:/policy/{name}
+     * @tags BatchJobs
+     * @name CancelBatchJob
+     * @summary Cancel a running batch job
+     * @request DELETE:/admin/batch-jobs/{id}
      * @secure
      */
-    removePolicy: (name: string, params: RequestParams = {}) =>
+    cancelBatchJob: (id: string, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/policy/${name}`,
+        path: `/admin/batch-jobs/${id}`,
         method: "DELETE",
         secure: true,
         ...params,
       }),
-  };
-  configs = {
-    /**
-     * No description
-     *
-     * @tags Configuration
-     * @name ListConfig
-     * @summary List Configurations
-     * @request GET:/configs
-     * @secure
-     */
-    listConfig: (
-      query?: {
-        /** @format int32 */
-        offset?: number;
-        /** @format int32 */
-        limit?: number;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<ListConfigResponse, Error>({
-        path: `/configs`,
-        method: "GET",
-        query: query,
-        secure: true,
-        format: "json",
-        ...params,
-      }),
 
     /**
      * No description
      *
-     * @tags Configuration
-     * @name ConfigInfo
-     * @summary Configuration info
-     * @request GET:/configs/{name}
+     * @tags SiteReplication
+     * @name GetSiteReplicationHealth
+     * @summary Report per site whether buckets and IAM entities are in sync
+     * @request GET:/admin/site-replication/health
      * @secure
      */
-    configInfo: (name: string, params: RequestParams = {}) =>
-      this.request<Configuration[], Error>({
-        path: `/configs/${name}`,
+    getSiteReplicationHealth: (params: RequestParams = {}) =>
+      this.request<SiteReplicationHealthResponse, Error>({
+        path: `/admin/site-replication/health`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3784,23 +8046,26 @@ export class Api<
     /**
      * No description
      *
-     * @tags Configuration
-     * @name SetConfig
-     * @summary Set Configuration
-     * @request PUT:/configs/{name}
+     * @tags SiteReplication
+     * @name SiteReplicationCompare
+     * @summary Compare two registered clusters and report the drift between them
+     * @request GET:/admin/site-replication/compare
      * @secure
      */
-    setConfig: (
-      name: string,
-      body: SetConfigRequest,
+    siteReplicationCompare: (
+      query: {
+        /** ID of the first registered cluster, default for the cluster Console is configured with */
+        siteA: string;
+        /** ID of the second registered cluster, default for the cluster Console is configured with */
+        siteB: string;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<SetConfigResponse, Error>({
-        path: `/configs/${name}`,
-        method: "PUT",
-        body: body,
+      this.request<ClusterComparisonReport, Error>({
+        path: `/admin/site-replication/compare`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -3808,16 +8073,16 @@ export class Api<
     /**
      * No description
      *
-     * @tags Configuration
-     * @name ResetConfig
-     * @summary Configuration reset
-     * @request POST:/configs/{name}/reset
+     * @tags Tiering
+     * @name TiersList
+     * @summary Returns a list of tiers for ilm
+     * @request GET:/admin/tiers
      * @secure
      */
-    resetConfig: (name: string, params: RequestParams = {}) =>
-      this.request<SetConfigResponse, Error>({
-        path: `/configs/${name}/reset`,
-        method: "POST",
+    tiersList: (params: RequestParams = {}) =>
+      this.request<TierListResponse, Error>({
+        path: `/admin/tiers`,
+        method: "GET",
         secure: true,
         format: "json",
         ...params,
@@ -3826,124 +8091,119 @@ export class Api<
     /**
      * No description
      *
-     * @tags Configuration
-     * @name ExportConfig
-     * @summary Export the current config from MinIO server
-     * @request GET:/configs/export
+     * @tags Tiering
+     * @name RemoveTier
+     * @summary Remove a tier that holds no objects
+     * @request DELETE:/admin/tiers/{type}/{name}
      * @secure
      */
-    exportConfig: (params: RequestParams = {}) =>
-      this.request<ConfigExportResponse, Error>({
-        path: `/configs/export`,
-        method: "GET",
+    removeTier: (
+      type: "s3" | "gcs" | "azure" | "minio",
+      name: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<void, Error>({
+        path: `/admin/tiers/${type}/${name}`,
+        method: "DELETE",
         secure: true,
-        format: "json",
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Configuration
-     * @name ImportCreate
-     * @summary Uploads a file to import MinIO server config.
-     * @request POST:/configs/import
+     * @tags Tiering
+     * @name VerifyTier
+     * @summary Verify MinIO can reach the tier with its credentials
+     * @request GET:/admin/tiers/{type}/{name}/verify
      * @secure
      */
-    importCreate: (
-      data: {
-        /** @format binary */
-        file: File;
-      },
+    verifyTier: (
+      type: "s3" | "gcs" | "azure" | "minio",
+      name: string,
       params: RequestParams = {}
     ) =>
-      this.request<void, Error>({
-        path: `/configs/import`,
-        method: "POST",
-        body: data,
+      this.request<TierVerification, Error>({
+        path: `/admin/tiers/${type}/${name}/verify`,
+        method: "GET",
         secure: true,
-        type: ContentType.FormData,
+        format: "json",
         ...params,
       }),
-  };
-  setPolicy = {
+
     /**
      * No description
      *
-     * @tags Policy
-     * @name SetPolicy
-     * @summary Set policy
-     * @request PUT:/set-policy
+     * @tags Tiering
+     * @name GetTierStats
+     * @summary Objects and bytes transitioned to a tier along with its connectivity
+     * @request GET:/admin/tiers/{type}/{name}/stats
      * @secure
      */
-    setPolicy: (body: SetPolicyNameRequest, params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/set-policy`,
-        method: "PUT",
-        body: body,
+    getTierStats: (
+      type: "s3" | "gcs" | "azure" | "minio",
+      name: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<TierStats, Error>({
+        path: `/admin/tiers/${type}/${name}/stats`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
+        format: "json",
         ...params,
       }),
-  };
-  setPolicyMulti = {
+
     /**
      * No description
      *
-     * @tags Policy
-     * @name SetPolicyMultiple
-     * @summary Set policy to multiple users/groups
-     * @request PUT:/set-policy-multi
+     * @tags Tiering
+     * @name AddTier
+     * @summary Allows to configure a new tier
+     * @request POST:/admin/tiers
      * @secure
      */
-    setPolicyMultiple: (
-      body: SetPolicyMultipleNameRequest,
-      params: RequestParams = {}
-    ) =>
+    addTier: (body: Tier, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/set-policy-multi`,
-        method: "PUT",
+        path: `/admin/tiers`,
+        method: "POST",
         body: body,
         secure: true,
         type: ContentType.Json,
         ...params,
       }),
-  };
-  service = {
+
     /**
      * No description
      *
-     * @tags Service
-     * @name RestartService
-     * @summary Restart Service
-     * @request POST:/service/restart
+     * @tags System
+     * @name GetRebalanceStatus
+     * @summary Status of the rebalance of the server pools
+     * @request GET:/admin/rebalance
      * @secure
      */
-    restartService: (params: RequestParams = {}) =>
-      this.request<void, Error>({
-        path: `/service/restart`,
-        method: "POST",
+    getRebalanceStatus: (params: RequestParams = {}) =>
+      this.request<RebalanceStatus, Error>({
+        path: `/admin/rebalance`,
+        method: "GET",
         secure: true,
+        format: "json",
         ...params,
       }),
-  };
-  profiling = {
+
     /**
      * No description
      *
-     * @tags Profile
-     * @name ProfilingStart
-     * @summary Start recording profile data
-     * @request POST:/profiling/start
+     * @tags System
+     * @name StartRebalance
+     * @summary Start rebalancing the server pools
+     * @request POST:/admin/rebalance
      * @secure
      */
-    profilingStart: (body: ProfilingStartRequest, params: RequestParams = {}) =>
-      this.request<StartProfilingList, Error>({
-        path: `/profiling/start`,
+    startRebalance: (params: RequestParams = {}) =>
+      this.request<RebalanceStatus, Error>({
+        path: `/admin/rebalance`,
         method: "POST",
-        body: body,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -3951,33 +8211,32 @@ export class Api<
     /**
      * No description
      *
-     * @tags Profile
-     * @name ProfilingStop
-     * @summary Stop and download profile data
-     * @request POST:/profiling/stop
+     * @tags System
+     * @name StopRebalance
+     * @summary Stop the rebalance of the server pools
+     * @request DELETE:/admin/rebalance
      * @secure
      */
-    profilingStop: (params: RequestParams = {}) =>
-      this.request<File, Error>({
-        path: `/profiling/stop`,
-        method: "POST",
+    stopRebalance: (params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/admin/rebalance`,
+        method: "DELETE",
         secure: true,
         ...params,
       }),
-  };
-  subnet = {
+
     /**
      * No description
      *
-     * @tags Subnet
-     * @name SubnetRegToken
-     * @summary Subnet registraton token
-     * @request GET:/subnet/registration-token
+     * @tags System
+     * @name ListPools
+     * @summary List the server pools with their capacity and decommission status
+     * @request GET:/admin/pools
      * @secure
      */
-    subnetRegToken: (params: RequestParams = {}) =>
-      this.request<SubnetRegTokenResponse, Error>({
-        path: `/subnet/registration-token`,
+    listPools: (params: RequestParams = {}) =>
+      this.request<PoolList, Error>({
+        path: `/admin/pools`,
         method: "GET",
         secure: true,
         format: "json",
@@ -3987,15 +8246,15 @@ export class Api<
     /**
      * No description
      *
-     * @tags Subnet
-     * @name SubnetInfo
-     * @summary Subnet info
-     * @request GET:/subnet/info
+     * @tags System
+     * @name GetPoolStatus
+     * @summary Status of a server pool and of its decommission
+     * @request GET:/admin/pools/{id}
      * @secure
      */
-    subnetInfo: (params: RequestParams = {}) =>
-      this.request<License, Error>({
-        path: `/subnet/info`,
+    getPoolStatus: (id: string, params: RequestParams = {}) =>
+      this.request<PoolStatus, Error>({
+        path: `/admin/pools/${id}`,
         method: "GET",
         secure: true,
         format: "json",
@@ -4005,22 +8264,16 @@ export class Api<
     /**
      * No description
      *
-     * @tags Subnet
-     * @name SubnetApiKey
-     * @summary Subnet api key
-     * @request GET:/subnet/apikey
+     * @tags System
+     * @name StartPoolDecommission
+     * @summary Start decommissioning a server pool
+     * @request POST:/admin/pools/{id}/decommission
      * @secure
      */
-    subnetApiKey: (
-      query: {
-        token: string;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<ApiKey, Error>({
-        path: `/subnet/apikey`,
-        method: "GET",
-        query: query,
+    startPoolDecommission: (id: string, params: RequestParams = {}) =>
+      this.request<PoolStatus, Error>({
+        path: `/admin/pools/${id}/decommission`,
+        method: "POST",
         secure: true,
         format: "json",
         ...params,
@@ -4029,34 +8282,32 @@ export class Api<
     /**
      * No description
      *
-     * @tags Subnet
-     * @name SubnetRegister
-     * @summary Register cluster with Subnet
-     * @request POST:/subnet/register
+     * @tags System
+     * @name CancelPoolDecommission
+     * @summary Cancel the decommission of a server pool
+     * @request DELETE:/admin/pools/{id}/decommission
      * @secure
      */
-    subnetRegister: (body: SubnetRegisterRequest, params: RequestParams = {}) =>
+    cancelPoolDecommission: (id: string, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/subnet/register`,
-        method: "POST",
-        body: body,
+        path: `/admin/pools/${id}/decommission`,
+        method: "DELETE",
         secure: true,
-        type: ContentType.Json,
         ...params,
       }),
 
     /**
      * No description
      *
-     * @tags Subnet
-     * @name SubnetLogin
-     * @summary Login to subnet
-     * @request POST:/subnet/login
+     * @tags System
+     * @name StartHeal
+     * @summary Start healing an object, a prefix, a bucket or the drives
+     * @request POST:/admin/heal
      * @secure
      */
-    subnetLogin: (body: SubnetLoginRequest, params: RequestParams = {}) =>
-      this.request<SubnetLoginResponse, Error>({
-        path: `/subnet/login`,
+    startHeal: (body: HealRequest, params: RequestParams = {}) =>
+      this.request<HealStartResponse, Error>({
+        path: `/admin/heal`,
         method: "POST",
         body: body,
         secure: true,
@@ -4068,44 +8319,34 @@ export class Api<
     /**
      * No description
      *
-     * @tags Subnet
-     * @name SubnetLoginMfa
-     * @summary Login to subnet using mfa
-     * @request POST:/subnet/login/mfa
+     * @tags System
+     * @name GetBackgroundHealStatus
+     * @summary Status of the background heal of every erasure set
+     * @request GET:/admin/heal/background
      * @secure
      */
-    subnetLoginMfa: (body: SubnetLoginMFARequest, params: RequestParams = {}) =>
-      this.request<SubnetLoginResponse, Error>({
-        path: `/subnet/login/mfa`,
-        method: "POST",
-        body: body,
+    getBackgroundHealStatus: (params: RequestParams = {}) =>
+      this.request<BackgroundHealStatus, Error>({
+        path: `/admin/heal/background`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
-  };
-  admin = {
+
     /**
      * No description
      *
      * @tags System
-     * @name AdminInfo
-     * @summary Returns information about the deployment
-     * @request GET:/admin/info
+     * @name GetDriveTopology
+     * @summary Drives of every erasure set grouped by pool with their state, capacity and healing progress
+     * @request GET:/admin/topology
      * @secure
      */
-    adminInfo: (
-      query?: {
-        /** @default false */
-        defaultOnly?: boolean;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<AdminInfoResponse, Error>({
-        path: `/admin/info`,
+    getDriveTopology: (params: RequestParams = {}) =>
+      this.request<DriveTopology, Error>({
+        path: `/admin/topology`,
         method: "GET",
-        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -4115,25 +8356,15 @@ export class Api<
      * No description
      *
      * @tags System
-     * @name DashboardWidgetDetails
-     * @summary Returns information about the deployment
-     * @request GET:/admin/info/widgets/{widgetId}
+     * @name GetDrivesHealth
+     * @summary Hardware details, load and error indicators of every drive to spot failing drives early
+     * @request GET:/admin/drives/health
      * @secure
      */
-    dashboardWidgetDetails: (
-      widgetId: number,
-      query?: {
-        start?: number;
-        end?: number;
-        /** @format int32 */
-        step?: number;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<WidgetDetails, Error>({
-        path: `/admin/info/widgets/${widgetId}`,
+    getDrivesHealth: (params: RequestParams = {}) =>
+      this.request<DrivesHealth, Error>({
+        path: `/admin/drives/health`,
         method: "GET",
-        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -4143,15 +8374,29 @@ export class Api<
      * No description
      *
      * @tags System
-     * @name ArnList
-     * @summary Returns a list of active ARNs in the instance
-     * @request GET:/admin/arns
+     * @name GetTraceStats
+     * @summary Traces the calls for a window of time and returns their counts, error rates and latencies per API
+     * @request GET:/admin/trace/stats
      * @secure
      */
-    arnList: (params: RequestParams = {}) =>
-      this.request<ArnsResponse, Error>({
-        path: `/admin/arns`,
+    getTraceStats: (
+      query?: {
+        /**
+         * seconds the calls are traced for, 10 by default and at most 300
+         * @format int32
+         */
+        window?: number;
+        /** kinds of calls traced, s3 by default, s3, internal, storage, os or all */
+        calls?: string;
+        bucket?: string;
+        node?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<TraceStats, Error>({
+        path: `/admin/trace/stats`,
         method: "GET",
+        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -4160,16 +8405,33 @@ export class Api<
     /**
      * No description
      *
-     * @tags Configuration
-     * @name NotificationEndpointList
-     * @summary Returns a list of active notification endpoints
-     * @request GET:/admin/notification_endpoints
+     * @tags System
+     * @name GetTopLocks
+     * @summary Oldest locks held in the cluster along with the operations holding their locks for long
+     * @request GET:/admin/top/locks
      * @secure
      */
-    notificationEndpointList: (params: RequestParams = {}) =>
-      this.request<NotifEndpointResponse, Error>({
-        path: `/admin/notification_endpoints`,
+    getTopLocks: (
+      query?: {
+        /**
+         * locks returned, 10 by default and at most 1000
+         * @format int32
+         */
+        count?: number;
+        /** return only the locks that lost their quorum */
+        stale?: boolean;
+        /**
+         * seconds an operation holds its lock before it's reported as long running, 60 by default
+         * @format int32
+         */
+        longRunning?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<TopLocks, Error>({
+        path: `/admin/top/locks`,
         method: "GET",
+        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -4178,22 +8440,32 @@ export class Api<
     /**
      * No description
      *
-     * @tags Configuration
-     * @name AddNotificationEndpoint
-     * @summary Allows to configure a new notification endpoint
-     * @request POST:/admin/notification_endpoints
+     * @tags System
+     * @name GetMetricsDashboard
+     * @summary Capacity, request rates, replication lag and node health of the cluster queried from Prometheus
+     * @request GET:/admin/metrics/dashboard
      * @secure
      */
-    addNotificationEndpoint: (
-      body: NotificationEndpoint,
+    getMetricsDashboard: (
+      query?: {
+        /**
+         * seconds covered by the series until now, one hour by default and at most 7 days
+         * @format int32
+         */
+        range?: number;
+        /**
+         * seconds between the points of the series, by default the range holds 120 points
+         * @format int32
+         */
+        step?: number;
+      },
       params: RequestParams = {}
     ) =>
-      this.request<SetNotificationEndpointResponse, Error>({
-        path: `/admin/notification_endpoints`,
-        method: "POST",
-        body: body,
+      this.request<MetricsDashboard, Error>({
+        path: `/admin/metrics/dashboard`,
+        method: "GET",
+        query: query,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -4201,15 +8473,15 @@ export class Api<
     /**
      * No description
      *
-     * @tags SiteReplication
-     * @name GetSiteReplicationInfo
-     * @summary Get list of Replication Sites
-     * @request GET:/admin/site-replication
+     * @tags System
+     * @name GetScannerStatus
+     * @summary Data scanner cycle status and freshness of the data usage reported by MinIO
+     * @request GET:/admin/scanner
      * @secure
      */
-    getSiteReplicationInfo: (params: RequestParams = {}) =>
-      this.request<SiteReplicationInfoResponse, Error>({
-        path: `/admin/site-replication`,
+    getScannerStatus: (params: RequestParams = {}) =>
+      this.request<ScannerStatus, Error>({
+        path: `/admin/scanner`,
         method: "GET",
         secure: true,
         format: "json",
@@ -4219,22 +8491,17 @@ export class Api<
     /**
      * No description
      *
-     * @tags SiteReplication
-     * @name SiteReplicationInfoAdd
-     * @summary Add a Replication Site
-     * @request POST:/admin/site-replication
+     * @tags System
+     * @name RefreshDataUsage
+     * @summary Fetch the latest data usage from MinIO and record it in the usage history
+     * @request POST:/admin/scanner/refresh
      * @secure
      */
-    siteReplicationInfoAdd: (
-      body: SiteReplicationAddRequest,
-      params: RequestParams = {}
-    ) =>
-      this.request<SiteReplicationAddResponse, Error>({
-        path: `/admin/site-replication`,
+    refreshDataUsage: (params: RequestParams = {}) =>
+      this.request<DataUsageRefresh, Error>({
+        path: `/admin/scanner/refresh`,
         method: "POST",
-        body: body,
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -4242,19 +8509,17 @@ export class Api<
     /**
      * No description
      *
-     * @tags SiteReplication
-     * @name SiteReplicationEdit
-     * @summary Edit a Replication Site
-     * @request PUT:/admin/site-replication
+     * @tags System
+     * @name GetAlerts
+     * @summary Firing and recently resolved alerts raised by the alerting rules
+     * @request GET:/admin/alerts
      * @secure
      */
-    siteReplicationEdit: (body: PeerInfo, params: RequestParams = {}) =>
-      this.request<PeerSiteEditResponse, Error>({
-        path: `/admin/site-replication`,
-        method: "PUT",
-        body: body,
+    getAlerts: (params: RequestParams = {}) =>
+      this.request<AlertsState, Error>({
+        path: `/admin/alerts`,
+        method: "GET",
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -4262,19 +8527,17 @@ export class Api<
     /**
      * No description
      *
-     * @tags SiteReplication
-     * @name SiteReplicationRemove
-     * @summary Remove a Replication Site
-     * @request DELETE:/admin/site-replication
+     * @tags System
+     * @name EvaluateAlerts
+     * @summary Evaluate the alerting rules against the cluster now and notify the configured sinks
+     * @request POST:/admin/alerts/evaluate
      * @secure
      */
-    siteReplicationRemove: (body: PeerInfoRemove, params: RequestParams = {}) =>
-      this.request<PeerSiteRemoveResponse, Error>({
-        path: `/admin/site-replication`,
-        method: "DELETE",
-        body: body,
+    evaluateAlerts: (params: RequestParams = {}) =>
+      this.request<AlertsState, Error>({
+        path: `/admin/alerts/evaluate`,
+        method: "POST",
         secure: true,
-        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -4282,45 +8545,16 @@ export class Api<
     /**
      * No description
      *
-     * @tags SiteReplication
-     * @name GetSiteReplicationStatus
-     * @summary Display overall site replication status
-     * @request GET:/admin/site-replication/status
+     * @tags System
+     * @name ListAlertRules
+     * @summary List the alerting rules
+     * @request GET:/admin/alerts/rules
      * @secure
      */
-    getSiteReplicationStatus: (
-      query?: {
-        /**
-         * Include Bucket stats
-         * @default true
-         */
-        buckets?: boolean;
-        /**
-         * Include Group stats
-         * @default true
-         */
-        groups?: boolean;
-        /**
-         * Include Policies stats
-         * @default true
-         */
-        policies?: boolean;
-        /**
-         * Include Policies stats
-         * @default true
-         */
-        users?: boolean;
-        /** Entity Type to lookup */
-        entityType?: string;
-        /** Entity Value to lookup */
-        entityValue?: string;
-      },
-      params: RequestParams = {}
-    ) =>
-      this.request<SiteReplicationStatusResponse, Error>({
-        path: `/admin/site-replication/status`,
+    listAlertRules: (params: RequestParams = {}) =>
+      this.request<AlertRules, Error>({
+        path: `/admin/alerts/rules`,
         method: "GET",
-        query: query,
         secure: true,
         format: "json",
         ...params,
@@ -4329,17 +8563,19 @@ export class Api<
     /**
      * No description
      *
-     * @tags Tiering
-     * @name TiersList
-     * @summary Returns a list of tiers for ilm
-     * @request GET:/admin/tiers
+     * @tags System
+     * @name SetAlertRule
+     * @summary Create or update an alerting rule
+     * @request PUT:/admin/alerts/rules/{id}
      * @secure
      */
-    tiersList: (params: RequestParams = {}) =>
-      this.request<TierListResponse, Error>({
-        path: `/admin/tiers`,
-        method: "GET",
+    setAlertRule: (id: string, body: AlertRule, params: RequestParams = {}) =>
+      this.request<AlertRule, Error>({
+        path: `/admin/alerts/rules/${id}`,
+        method: "PUT",
+        body: body,
         secure: true,
+        type: ContentType.Json,
         format: "json",
         ...params,
       }),
@@ -4347,19 +8583,17 @@ export class Api<
     /**
      * No description
      *
-     * @tags Tiering
-     * @name AddTier
-     * @summary Allows to configure a new tier
-     * @request POST:/admin/tiers
+     * @tags System
+     * @name DeleteAlertRule
+     * @summary Remove an alerting rule along with its alerts
+     * @request DELETE:/admin/alerts/rules/{id}
      * @secure
      */
-    addTier: (body: Tier, params: RequestParams = {}) =>
+    deleteAlertRule: (id: string, params: RequestParams = {}) =>
       this.request<void, Error>({
-        path: `/admin/tiers`,
-        method: "POST",
-        body: body,
+        path: `/admin/alerts/rules/${id}`,
+        method: "DELETE",
         secure: true,
-        type: ContentType.Json,
         ...params,
       }),
 
@@ -4409,6 +8643,89 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags System
+     * @name SetCluster
+     * @summary Register a cluster or update a registered one
+     * @request PUT:/admin/clusters/{id}
+     * @secure
+     */
+    setCluster: (id: string, body: Cluster, params: RequestParams = {}) =>
+      this.request<Cluster, Error>({
+        path: `/admin/clusters/${id}`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name DeleteCluster
+     * @summary Unregister a cluster
+     * @request DELETE:/admin/clusters/{id}
+     * @secure
+     */
+    deleteCluster: (id: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/admin/clusters/${id}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name GetTlsCertificates
+     * @summary Certificate chains and expiry dates of the console and of the MinIO endpoints it talks to
+     * @request GET:/admin/tls/certificates
+     * @secure
+     */
+    getTlsCertificates: (
+      query?: {
+        /**
+         * days before expiry a certificate is reported as expiring, 30 by default
+         * @format int32
+         */
+        expiryWarningDays?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<TlsCertificatesResponse, Error>({
+        path: `/admin/tls/certificates`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ListCspReports
+     * @summary Most recent Content-Security-Policy violations reported by the browsers
+     * @request GET:/admin/csp-reports
+     * @secure
+     */
+    listCspReports: (params: RequestParams = {}) =>
+      this.request<CspReports, Error>({
+        path: `/admin/csp-reports`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -4433,6 +8750,51 @@ export class Api<
         secure: true,
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags Inspect
+     * @name InspectBundle
+     * @summary Collects the backend files of an object into a bundle only MinIO support, or the owner of the public key, can open
+     * @request GET:/admin/inspect/bundle
+     * @secure
+     */
+    inspectBundle: (
+      query: {
+        /** bucket and object, its xl.meta is collected unless the path names backend files, wildcards allowed */
+        path: string;
+        /** RSA public key the bundle is encrypted for, PEM or base64 encoded PKCS1, MinIO support's key by default */
+        publicKey?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<File, Error>({
+        path: `/admin/inspect/bundle`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+  };
+  clusters = {
+    /**
+     * No description
+     *
+     * @tags System
+     * @name ListClusters
+     * @summary List the clusters the console manages and the one active in the session
+     * @request GET:/clusters
+     * @secure
+     */
+    listClusters: (params: RequestParams = {}) =>
+      this.request<ClusterList, Error>({
+        path: `/clusters`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
   };
   nodes = {
     /**
@@ -4509,6 +8871,72 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags Logging
+     * @name SearchLogEntries
+     * @summary Page through the historical log entries of the log search store
+     * @request GET:/logs/entries
+     * @secure
+     */
+    searchLogEntries: (
+      query?: {
+        /** RFC3339 time of the oldest entry */
+        timeStart?: string;
+        /** RFC3339 time of the newest entry */
+        timeEnd?: string;
+        node?: string;
+        level?: string;
+        /** text the entries have to contain */
+        query?: string;
+        /** @format int32 */
+        pageSize?: number;
+        /** @format int32 */
+        pageNo?: number;
+        order?: "timeDesc" | "timeAsc";
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<LogEntriesResponse, Error>({
+        path: `/logs/entries`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Logging
+     * @name ExportLogEntries
+     * @summary Export the historical log entries matching the filters as NDJSON
+     * @request GET:/logs/entries/export
+     * @secure
+     */
+    exportLogEntries: (
+      query?: {
+        /** RFC3339 time of the oldest entry */
+        timeStart?: string;
+        /** RFC3339 time of the newest entry */
+        timeEnd?: string;
+        node?: string;
+        level?: string;
+        /** text the entries have to contain */
+        query?: string;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<File, Error>({
+        path: `/logs/entries/export`,
+        method: "GET",
+        query: query,
+        secure: true,
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -4568,6 +8996,96 @@ export class Api<
         format: "json",
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags Logging
+     * @name ListConsoleAuditEvents
+     * @summary List recent console audit events
+     * @request GET:/logs/console-audit
+     * @secure
+     */
+    listConsoleAuditEvents: (
+      query?: {
+        user?: string;
+        operation?: string;
+        result?: "success" | "failure";
+        /** RFC3339 time of the oldest event to return */
+        since?: string;
+        /**
+         * @format int32
+         * @default 100
+         */
+        limit?: number;
+      },
+      params: RequestParams = {}
+    ) =>
+      this.request<ConsoleAuditEvents, Error>({
+        path: `/logs/console-audit`,
+        method: "GET",
+        query: query,
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Logging
+     * @name GetLogLevel
+     * @summary Level and format of the console server logs
+     * @request GET:/logs/level
+     * @secure
+     */
+    getLogLevel: (params: RequestParams = {}) =>
+      this.request<LogLevel, Error>({
+        path: `/logs/level`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Logging
+     * @name SetLogLevel
+     * @summary Change the level of the console server logs until it restarts
+     * @request PUT:/logs/level
+     * @secure
+     */
+    setLogLevel: (body: LogLevel, params: RequestParams = {}) =>
+      this.request<LogLevel, Error>({
+        path: `/logs/level`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+  };
+  consoleConfig = {
+    /**
+     * No description
+     *
+     * @tags Configuration
+     * @name ReloadConsoleConfig
+     * @summary Reload the console configuration file, the sections that can't be reloaded need a restart
+     * @request POST:/console-config/reload
+     * @secure
+     */
+    reloadConsoleConfig: (params: RequestParams = {}) =>
+      this.request<ConsoleConfigReload, Error>({
+        path: `/console-config/reload`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
   };
   kms = {
     /**
@@ -4704,6 +9222,30 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags KMS
+     * @name KmsTestKey
+     * @summary Test a KMS key with an encryption and decryption round trip, optionally through an SSE-KMS object of a bucket
+     * @request POST:/kms/keys/{name}/test
+     * @secure
+     */
+    kmsTestKey: (
+      name: string,
+      body: KmsTestKeyRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<KmsTestKeyResponse, Error>({
+        path: `/kms/keys/${name}/test`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -4917,6 +9459,96 @@ export class Api<
         ...params,
       }),
 
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name TestIdpConfiguration
+     * @summary Test connectivity with an IDP configuration before saving it
+     * @request POST:/idp/{type}/test-configuration
+     * @secure
+     */
+    testIdpConfiguration: (
+      type: string,
+      body: IdpServerConfiguration,
+      params: RequestParams = {}
+    ) =>
+      this.request<IdpConfigurationTestResult, Error>({
+        path: `/idp/${type}/test-configuration`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name GetOpenIdClaimMapping
+     * @summary Get how an OpenID configuration maps claims or roles to policies
+     * @request GET:/idp/openid/{name}/claim-mapping
+     * @secure
+     */
+    getOpenIdClaimMapping: (name: string, params: RequestParams = {}) =>
+      this.request<OpenIDClaimMapping, Error>({
+        path: `/idp/openid/${name}/claim-mapping`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name UpdateOpenIdClaimMapping
+     * @summary Update how an OpenID configuration maps claims or roles to policies
+     * @request PUT:/idp/openid/{name}/claim-mapping
+     * @secure
+     */
+    updateOpenIdClaimMapping: (
+      name: string,
+      body: OpenIDClaimMappingRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<SetIDPResponse, Error>({
+        path: `/idp/openid/${name}/claim-mapping`,
+        method: "PUT",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name PreviewOpenIdClaimMapping
+     * @summary Show the policies a sample ID token would resolve to with an OpenID configuration
+     * @request POST:/idp/openid/{name}/claim-mapping/preview
+     * @secure
+     */
+    previewOpenIdClaimMapping: (
+      name: string,
+      body: OpenIDClaimMappingPreviewRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<OpenIDClaimMappingPreview, Error>({
+        path: `/idp/openid/${name}/claim-mapping/preview`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -5053,6 +9685,24 @@ export class Api<
       }),
   };
   ldapEntities = {
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name ListLdapEntities
+     * @summary List the LDAP users and groups with policies attached
+     * @request GET:/ldap-entities
+     * @secure
+     */
+    listLdapEntities: (params: RequestParams = {}) =>
+      this.request<LdapEntities, Error>({
+        path: `/ldap-entities`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
     /**
      * No description
      *
@@ -5072,6 +9722,75 @@ export class Api<
         format: "json",
         ...params,
       }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name AttachLdapPolicy
+     * @summary Attach policies to an LDAP user or group
+     * @request POST:/ldap-entities/policy/attach
+     * @secure
+     */
+    attachLdapPolicy: (
+      body: LdapPolicyAssociationRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<LdapPolicyAssociationResponse, Error>({
+        path: `/ldap-entities/policy/attach`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name DetachLdapPolicy
+     * @summary Detach policies from an LDAP user or group
+     * @request POST:/ldap-entities/policy/detach
+     * @secure
+     */
+    detachLdapPolicy: (
+      body: LdapPolicyAssociationRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<LdapPolicyAssociationResponse, Error>({
+        path: `/ldap-entities/policy/detach`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags idp
+     * @name GetLdapEffectivePolicy
+     * @summary Get the effective policy of an LDAP user
+     * @request POST:/ldap-entities/effective-policy
+     * @secure
+     */
+    getLdapEffectivePolicy: (
+      body: LdapEffectivePolicyRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<LdapEffectivePolicy, Error>({
+        path: `/ldap-entities/effective-policy`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
   };
   releases = {
     /**
@@ -5143,4 +9862,166 @@ export class Api<
         ...params,
       }),
   };
+  staging = {
+    /**
+     * No description
+     *
+     * @tags Staging
+     * @name ListStagingWorkspaces
+     * @summary List the staging workspaces of the session
+     * @request GET:/staging/workspaces
+     * @secure
+     */
+    listStagingWorkspaces: (params: RequestParams = {}) =>
+      this.request<ListStagingWorkspacesResponse, Error>({
+        path: `/staging/workspaces`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Staging
+     * @name CreateStagingWorkspace
+     * @summary Create a staging workspace
+     * @request POST:/staging/workspaces
+     * @secure
+     */
+    createStagingWorkspace: (
+      body: CreateStagingWorkspaceRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<StagingWorkspace, Error>({
+        path: `/staging/workspaces`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Staging
+     * @name GetStagingWorkspace
+     * @summary Get a staging workspace
+     * @request GET:/staging/workspaces/{workspace_id}
+     * @secure
+     */
+    getStagingWorkspace: (workspaceId: string, params: RequestParams = {}) =>
+      this.request<StagingWorkspace, Error>({
+        path: `/staging/workspaces/${workspaceId}`,
+        method: "GET",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Staging
+     * @name DeleteStagingWorkspace
+     * @summary Discard a staging workspace
+     * @request DELETE:/staging/workspaces/{workspace_id}
+     * @secure
+     */
+    deleteStagingWorkspace: (workspaceId: string, params: RequestParams = {}) =>
+      this.request<void, Error>({
+        path: `/staging/workspaces/${workspaceId}`,
+        method: "DELETE",
+        secure: true,
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Staging
+     * @name AddStagedOperations
+     * @summary Stage operations in a workspace
+     * @request POST:/staging/workspaces/{workspace_id}/operations
+     * @secure
+     */
+    addStagedOperations: (
+      workspaceId: string,
+      body: AddStagedOperationsRequest,
+      params: RequestParams = {}
+    ) =>
+      this.request<StagingWorkspace, Error>({
+        path: `/staging/workspaces/${workspaceId}/operations`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Staging
+     * @name RemoveStagedOperation
+     * @summary Remove a staged operation from a workspace
+     * @request DELETE:/staging/workspaces/{workspace_id}/operations/{operation_id}
+     * @secure
+     */
+    removeStagedOperation: (
+      workspaceId: string,
+      operationId: string,
+      params: RequestParams = {}
+    ) =>
+      this.request<StagingWorkspace, Error>({
+        path: `/staging/workspaces/${workspaceId}/operations/${operationId}`,
+        method: "DELETE",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+
+    /**
+     * No description
+     *
+     * @tags Staging
+     * @name CommitStagingWorkspace
+     * @summary Apply the operations staged in a workspace as a single job
+     * @request POST:/staging/workspaces/{workspace_id}/commit
+     * @secure
+     */
+    commitStagingWorkspace: (workspaceId: string, params: RequestParams = {}) =>
+      this.request<StagingCommitResponse, Error>({
+        path: `/staging/workspaces/${workspaceId}/commit`,
+        method: "POST",
+        secure: true,
+        format: "json",
+        ...params,
+      }),
+  };
+  batch = {
+    /**
+     * No description
+     *
+     * @tags System
+     * @name Batch
+     * @summary Run several API calls in order with the session of the batch, up to the first one failing
+     * @request POST:/batch
+     * @secure
+     */
+    batch: (body: BatchRequest, params: RequestParams = {}) =>
+      this.request<BatchResponse, Error>({
+        path: `/batch`,
+        method: "POST",
+        body: body,
+        secure: true,
+        type: ContentType.Json,
+        format: "json",
+        ...params,
+      }),
+  };
 }
@@ -16,7 +16,7 @@
 
 import { store } from "../../store";
 import get from "lodash/get";
-import { IAM_SCOPES } from "./permissions";
+import { IAM_PAGES_CONSOLE_GRANTS, IAM_SCOPES } from "./permissions";
 
 const hasPermission = (
   resource: string | string[] | undefined,
@@ -163,4 +163,19 @@ const hasAccessToResource = (
     : permissions.length > 0;
 };
 
+// hasConsoleGrant returns whether the session is granted the console area of the page, sessions from servers
+// not reporting their grants can use every page
+export const hasConsoleGrant = (page: string | undefined) => {
+  const area = page ? IAM_PAGES_CONSOLE_GRANTS[page] : undefined;
+  if (!area) {
+    return true;
+  }
+  const state = store.getState();
+  const grants = state.console.session?.consoleGrants;
+  if (!grants) {
+    return true;
+  }
+  return grants.includes(area);
+};
+
 export default hasPermission;
@@ -14,5 +14,5 @@
 //  You should have received a copy of the GNU Affero General Public License
 //  along with this program.  If not, see <http://www.gnu.org/licenses/>.
 
-export { default as hasPermission } from "./accessControl";
+export { default as hasPermission, hasConsoleGrant } from "./accessControl";
 export { default as SecureComponent } from "./SecureComponent";
@@ -584,3 +584,36 @@ export const browseBucketPermissions = [
   IAM_SCOPES.S3_LIST_BUCKET,
   IAM_SCOPES.S3_ALL_LIST_BUCKET,
 ];
+
+// console areas the server must grant to the session for the page to be available
+export const IAM_PAGES_CONSOLE_GRANTS: Record<string, string> = {
+  [IAM_PAGES.OBJECT_BROWSER_VIEW]: "object-browser",
+  [IAM_PAGES.OBJECT_BROWSER_BUCKET_VIEW]: "object-browser",
+  [IAM_PAGES.OBJECT_BROWSER_BUCKET_DETAILS_VIEW]: "object-browser",
+  [IAM_PAGES.USERS]: "iam",
+  [IAM_PAGES.USERS_VIEW]: "iam",
+  [IAM_PAGES.USER_ADD]: "iam",
+  [IAM_PAGES.GROUPS]: "iam",
+  [IAM_PAGES.GROUPS_ADD]: "iam",
+  [IAM_PAGES.GROUPS_VIEW]: "iam",
+  [IAM_PAGES.USER_SA_ACCOUNT_ADD]: "iam",
+  [IAM_PAGES.IDP_LDAP_CONFIGURATIONS]: "iam",
+  [IAM_PAGES.IDP_OPENID_CONFIGURATIONS]: "iam",
+  [IAM_PAGES.IDP_OPENID_CONFIGURATIONS_VIEW]: "iam",
+  [IAM_PAGES.IDP_OPENID_CONFIGURATIONS_ADD]: "iam",
+  [IAM_PAGES.POLICIES]: "iam",
+  [IAM_PAGES.POLICY_ADD]: "iam",
+  [IAM_PAGES.POLICIES_VIEW]: "iam",
+  [IAM_PAGES.TOOLS_LOGS]: "diagnostics",
+  [IAM_PAGES.TOOLS_AUDITLOGS]: "diagnostics",
+  [IAM_PAGES.TOOLS_TRACE]: "diagnostics",
+  [IAM_PAGES.TOOLS_DIAGNOSTICS]: "diagnostics",
+  [IAM_PAGES.TOOLS_SPEEDTEST]: "diagnostics",
+  [IAM_PAGES.PROFILE]: "diagnostics",
+  [IAM_PAGES.SUPPORT_INSPECT]: "diagnostics",
+  [IAM_PAGES.TIERS]: "tiering",
+  [IAM_PAGES.TIERS_ADD]: "tiering",
+  [IAM_PAGES.TIERS_ADD_SERVICE]: "tiering",
+  [IAM_PAGES.REGISTER_SUPPORT]: "subnet",
+  [IAM_PAGES.CALL_HOME]: "subnet",
+};
@@ -37,6 +37,7 @@ export interface ErrorResponseHandler {
 }
 
 export interface IRetentionConfig {
+  objectLockingEnabled?: boolean;
   mode: string;
   unit: string;
   validity: number;
@@ -41,6 +41,7 @@ import {
 
 import { ErrorResponseHandler } from "../../../common/types";
 import ChangePasswordModal from "./ChangePasswordModal";
+import TwoFactorModal from "./TwoFactorModal";
 import SearchBox from "../Common/SearchBox";
 import withSuspense from "../Common/Components/withSuspense";
 import {
@@ -93,6 +94,7 @@ const Account = () => {
   >(null);
   const [changePasswordModalOpen, setChangePasswordModalOpen] =
     useState<boolean>(false);
+  const [twoFactorModalOpen, setTwoFactorModalOpen] = useState<boolean>(false);
   const [selectedSAs, setSelectedSAs] = useState<string[]>([]);
   const [deleteMultipleOpen, setDeleteMultipleOpen] = useState<boolean>(false);
   const [policyOpen, setPolicyOpen] = useState<boolean>(false);
@@ -203,6 +205,10 @@ const Account = () => {
         open={changePasswordModalOpen}
         closeModal={() => setChangePasswordModalOpen(false)}
       />
+      <TwoFactorModal
+        open={twoFactorModalOpen}
+        closeModal={() => setTwoFactorModalOpen(false)}
+      />
       <PageHeaderWrapper label="Access Keys" />
       <PageLayout>
         <Grid container spacing={1}>
@@ -247,6 +253,14 @@ const Account = () => {
                   disabled={userIDP}
                 />
               </SecureComponent>
+              <Button
+                id={"two-factor"}
+                onClick={() => setTwoFactorModalOpen(true)}
+                label={`Two-Factor Authentication`}
+                icon={<PasswordKeyIcon />}
+                variant={"regular"}
+                disabled={userIDP}
+              />
               <Button
                 id={"create-service-account"}
                 onClick={() => {
@@ -0,0 +1,253 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+import React, { Fragment, useEffect, useState } from "react";
+import { Button, PasswordKeyIcon } from "mds";
+import { Theme } from "@mui/material/styles";
+import createStyles from "@mui/styles/createStyles";
+import withStyles from "@mui/styles/withStyles";
+import ModalWrapper from "../Common/ModalWrapper/ModalWrapper";
+import Grid from "@mui/material/Grid";
+import InputBoxWrapper from "../Common/FormComponents/InputBoxWrapper/InputBoxWrapper";
+import { LinearProgress } from "@mui/material";
+import {
+  formFieldStyles,
+  modalStyleUtils,
+  spacingUtils,
+} from "../Common/FormComponents/common/styleLibrary";
+import {
+  TwoFactorCodeRequest,
+  TwoFactorEnrollment,
+  TwoFactorRecoveryCodes,
+  TwoFactorStatus,
+} from "../../../api/consoleApi";
+import { ErrorResponseHandler } from "../../../common/types";
+import api from "../../../common/api";
+import { setModalErrorSnackMessage } from "../../../systemSlice";
+import { useAppDispatch } from "../../../store";
+
+const styles = (theme: Theme) =>
+  createStyles({
+    ...modalStyleUtils,
+    ...formFieldStyles,
+    ...spacingUtils,
+    codes: {
+      fontFamily: "monospace",
+      fontSize: 14,
+      columnCount: 2,
+      margin: "10px 0",
+    },
+    secret: {
+      fontFamily: "monospace",
+      wordBreak: "break-all",
+    },
+  });
+
+interface ITwoFactorProps {
+  classes: any;
+  open: boolean;
+  closeModal: () => void;
+}
+
+const TwoFactorModal = ({ classes, open, closeModal }: ITwoFactorProps) => {
+  const dispatch = useAppDispatch();
+  const [status, setStatus] = useState<TwoFactorStatus | null>(null);
+  const [enrollment, setEnrollment] = useState<TwoFactorEnrollment | null>(
+    null
+  );
+  const [recoveryCodes, setRecoveryCodes] = useState<string[]>([]);
+  const [code, setCode] = useState<string>("");
+  const [loading, setLoading] = useState<boolean>(false);
+
+  useEffect(() => {
+    if (open) {
+      setLoading(true);
+      api
+        .invoke("GET", "/api/v1/account/two-factor")
+        .then((res: TwoFactorStatus) => {
+          setLoading(false);
+          setStatus(res);
+        })
+        .catch((err: ErrorResponseHandler) => {
+          setLoading(false);
+          dispatch(setModalErrorSnackMessage(err));
+        });
+    }
+  }, [open, dispatch]);
+
+  const onClose = () => {
+    setStatus(null);
+    setEnrollment(null);
+    setRecoveryCodes([]);
+    setCode("");
+    closeModal();
+  };
+
+  const enroll = () => {
+    setLoading(true);
+    api
+      .invoke("POST", "/api/v1/account/two-factor/enroll")
+      .then((res: TwoFactorEnrollment) => {
+        setLoading(false);
+        setEnrollment(res);
+      })
+      .catch((err: ErrorResponseHandler) => {
+        setLoading(false);
+        dispatch(setModalErrorSnackMessage(err));
+      });
+  };
+
+  const submitCode = (event: React.FormEvent) => {
+    event.preventDefault();
+    if (loading) {
+      return;
+    }
+    setLoading(true);
+    const request: TwoFactorCodeRequest = { code };
+    if (status?.enabled) {
+      api
+        .invoke("POST", "/api/v1/account/two-factor/disable", request)
+        .then(() => {
+          setLoading(false);
+          onClose();
+        })
+        .catch((err: ErrorResponseHandler) => {
+          setLoading(false);
+          setCode("");
+          dispatch(setModalErrorSnackMessage(err));
+        });
+      return;
+    }
+    api
+      .invoke("POST", "/api/v1/account/two-factor/verify", request)
+      .then((res: TwoFactorRecoveryCodes) => {
+        setLoading(false);
+        setCode("");
+        setEnrollment(null);
+        setStatus({ enabled: true });
+        setRecoveryCodes(res.recoveryCodes || []);
+      })
+      .catch((err: ErrorResponseHandler) => {
+        setLoading(false);
+        setCode("");
+        dispatch(setModalErrorSnackMessage(err));
+      });
+  };
+
+  let content: React.ReactNode = null;
+  if (recoveryCodes.length > 0) {
+    content = (
+      <Fragment>
+        <div>
+          Two-factor authentication is enabled. Keep these recovery codes in a
+          safe place, each one can be used once to log in without your
+          authenticator. They won't be shown again.
+        </div>
+        <div className={classes.codes}>
+          {recoveryCodes.map((recoveryCode) => (
+            <div key={recoveryCode}>{recoveryCode}</div>
+          ))}
+        </div>
+        <Grid item xs={12} className={classes.modalButtonBar}>
+          <Button
+            id={"close-two-factor-modal"}
+            variant="callAction"
+            onClick={onClose}
+            label="Done"
+          />
+        </Grid>
+      </Fragment>
+    );
+  } else if (status && !status.enabled && !enrollment) {
+    content = (
+      <Fragment>
+        <div>
+          Two-factor authentication asks for a code of your authenticator app
+          after your password when you log in to Console.
+        </div>
+        <Grid item xs={12} className={classes.modalButtonBar}>
+          <Button
+            id={"enroll-two-factor"}
+            variant="callAction"
+            onClick={enroll}
+            disabled={loading}
+            label="Set up"
+          />
+        </Grid>
+      </Fragment>
+    );
+  } else if (status) {
+    content = (
+      <form noValidate autoComplete="off" onSubmit={submitCode}>
+        {enrollment ? (
+          <div>
+            Add this account to your authenticator app by opening{" "}
+            <a href={enrollment.provisioningURI}>this link</a> or by typing the
+            key <span className={classes.secret}>{enrollment.secret}</span>,
+            then enter the code it shows.
+          </div>
+        ) : (
+          <div>
+            Two-factor authentication is enabled, {status.recoveryCodesLeft}{" "}
+            recovery codes are left. Enter a code of your authenticator or a
+            recovery code to disable it.
+          </div>
+        )}
+        <Grid container>
+          <Grid item xs={12} className={classes.formFieldRow}>
+            <InputBoxWrapper
+              id="two-factor-code"
+              name="two-factor-code"
+              onChange={(event: React.ChangeEvent<HTMLInputElement>) => {
+                setCode(event.target.value);
+              }}
+              label="Code"
+              value={code}
+            />
+          </Grid>
+          <Grid item xs={12} className={classes.modalButtonBar}>
+            <Button
+              id={"submit-two-factor-code"}
+              type="submit"
+              variant="callAction"
+              color="primary"
+              disabled={loading || code.trim() === ""}
+              label={status.enabled ? "Disable" : "Enable"}
+            />
+          </Grid>
+        </Grid>
+      </form>
+    );
+  }
+
+  return open ? (
+    <ModalWrapper
+      title="Two-Factor Authentication"
+      modalOpen={open}
+      onClose={onClose}
+      titleIcon={<PasswordKeyIcon />}
+    >
+      {content}
+      {loading && (
+        <Grid item xs={12}>
+          <LinearProgress />
+        </Grid>
+      )}
+    </ModalWrapper>
+  ) : null;
+};
+
+export default withStyles(styles)(TwoFactorModal);
@@ -90,6 +90,7 @@ const AddReplicationModal = ({
   const [repDeleteMarker, setRepDeleteMarker] = useState<boolean>(true);
   const [repDelete, setRepDelete] = useState<boolean>(true);
   const [metadataSync, setMetadataSync] = useState<boolean>(true);
+  const [repExisting, setRepExisting] = useState<boolean>(true);
   const [tags, setTags] = useState<string>("");
   const [replicationMode, setReplicationMode] = useState<string>("async");
   const [bandwidthScalar, setBandwidthScalar] = useState<string>("100");
@@ -144,6 +145,7 @@ const AddReplicationModal = ({
       priority: parseInt(priority),
       storageClass: targetStorageClass,
       replicateMetadata: metadataSync,
+      replicateExistingObjects: repExisting,
     };
 
     api
@@ -410,6 +412,17 @@ const AddReplicationModal = ({
                     value={metadataSync}
                     description={"Metadata Sync"}
                   />
+                  <FormSwitchWrapper
+                    checked={repExisting}
+                    id="repExisting"
+                    name="repExisting"
+                    label="Existing Objects"
+                    onChange={(e) => {
+                      setRepExisting(e.target.checked);
+                    }}
+                    value={repExisting}
+                    description={"Replicate existing objects"}
+                  />
                   <FormSwitchWrapper
                     checked={repDeleteMarker}
                     id="deleteMarker"
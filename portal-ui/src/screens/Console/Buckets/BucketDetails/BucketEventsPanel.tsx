@@ -23,7 +23,7 @@ import createStyles from "@mui/styles/createStyles";
 import withStyles from "@mui/styles/withStyles";
 import get from "lodash/get";
 import Grid from "@mui/material/Grid";
-import { BucketEvent, BucketEventList } from "../types";
+import { BucketEvent, BucketEventList, BucketEventTestResult } from "../types";
 import {
   actionsTray,
   searchField,
@@ -40,7 +40,10 @@ import {
 import { IAM_SCOPES } from "../../../../common/SecureComponent/permissions";
 
 import withSuspense from "../../Common/Components/withSuspense";
-import { setErrorSnackMessage } from "../../../../systemSlice";
+import {
+  setErrorSnackMessage,
+  setSnackBarMessage,
+} from "../../../../systemSlice";
 import { selBucketDetailsLoading } from "./bucketDetailsSlice";
 import { useAppDispatch } from "../../../../store";
 import TooltipWrapper from "../../Common/TooltipWrapper/TooltipWrapper";
@@ -127,7 +130,35 @@ const BucketEventsPanel = ({ classes }: IBucketEventsProps) => {
     }
   };
 
-  const tableActions = [{ type: "delete", onClick: confirmDeleteEvent }];
+  const testEvent = (evnt: BucketEvent) => {
+    api
+      .invoke(
+        "POST",
+        `/api/v1/buckets/${bucketName}/events/${encodeURIComponent(
+          evnt.arn
+        )}/test`
+      )
+      .then((res: BucketEventTestResult) => {
+        if (res.delivered) {
+          dispatch(setSnackBarMessage(`Test event delivered to ${res.arn}`));
+        } else {
+          dispatch(
+            setErrorSnackMessage({
+              errorMessage: "Test event was not delivered",
+              detailedError: res.error || "",
+            })
+          );
+        }
+      })
+      .catch((err: ErrorResponseHandler) => {
+        dispatch(setErrorSnackMessage(err));
+      });
+  };
+
+  const tableActions = [
+    { type: "preview", onClick: testEvent },
+    { type: "delete", onClick: confirmDeleteEvent },
+  ];
 
   return (
     <Fragment>
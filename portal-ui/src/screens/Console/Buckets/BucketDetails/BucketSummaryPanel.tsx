@@ -319,7 +319,7 @@ const BucketSummary = ({ classes }: IBucketSummaryProps) => {
         .invoke("GET", `/api/v1/buckets/${bucketName}/retention`)
         .then((res: IRetentionConfig) => {
           setLoadingRetention(false);
-          setRetentionEnabled(true);
+          setRetentionEnabled(!!res.mode);
           setRetentionConfig(res);
         })
         .catch((err: ErrorResponseHandler) => {
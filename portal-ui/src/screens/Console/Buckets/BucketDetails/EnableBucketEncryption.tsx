@@ -30,6 +30,9 @@ import { ErrorResponseHandler } from "../../../../common/types";
 import api from "../../../../common/api";
 import ModalWrapper from "../../Common/ModalWrapper/ModalWrapper";
 import SelectWrapper from "../../Common/FormComponents/SelectWrapper/SelectWrapper";
+import InputBoxWrapper from "../../Common/FormComponents/InputBoxWrapper/InputBoxWrapper";
+import FormSwitchWrapper from "../../Common/FormComponents/FormSwitchWrapper/FormSwitchWrapper";
+import QueryMultiSelector from "../../Common/FormComponents/QueryMultiSelector/QueryMultiSelector";
 
 import { setModalErrorSnackMessage } from "../../../../systemSlice";
 import { useAppDispatch } from "../../../../store";
@@ -70,6 +73,8 @@ const EnableBucketEncryption = ({
   const [keys, setKeys] = useState<[]>([]);
   const [loadingKeys, setLoadingKeys] = useState<boolean>(false);
   const [addOpen, setAddOpen] = useState<boolean>(false);
+  const [createKey, setCreateKey] = useState<boolean>(false);
+  const [kmsContext, setKmsContext] = useState<string>("");
 
   useEffect(() => {
     if (encryptionCfg) {
@@ -78,6 +83,11 @@ const EnableBucketEncryption = ({
       } else {
         setEncryptionType("sse-kms");
         setKmsKeyID(encryptionCfg.kmsMasterKeyID);
+        setKmsContext(
+          Object.entries(encryptionCfg.kmsContext || {})
+            .map(([key, value]) => `${key}=${value}`)
+            .join("&")
+        );
       }
     }
   }, [encryptionCfg]);
@@ -85,9 +95,9 @@ const EnableBucketEncryption = ({
   useEffect(() => {
     if (encryptionType === "sse-kms") {
       api
-        .invoke("GET", `/api/v1/kms/keys`)
+        .invoke("GET", `/api/v1/buckets/${selectedBucket}/encryption/keys`)
         .then((res: any) => {
-          setKeys(res.results);
+          setKeys(res.results || []);
           setLoadingKeys(false);
         })
         .catch((err: ErrorResponseHandler) => {
@@ -95,7 +105,18 @@ const EnableBucketEncryption = ({
           dispatch(setModalErrorSnackMessage(err));
         });
     }
-  }, [encryptionType, loadingKeys, dispatch]);
+  }, [encryptionType, loadingKeys, selectedBucket, dispatch]);
+
+  const contextMap = () => {
+    const result: Record<string, string> = {};
+    kmsContext.split("&").forEach((pair: string) => {
+      const [key, value] = pair.split("=");
+      if (key && key.trim() !== "" && value !== undefined) {
+        result[key.trim()] = value;
+      }
+    });
+    return result;
+  };
 
   const enableBucketEncryption = (event: React.FormEvent) => {
     event.preventDefault();
@@ -118,6 +139,8 @@ const EnableBucketEncryption = ({
         .invoke("POST", `/api/v1/buckets/${selectedBucket}/encryption/enable`, {
           encType: encryptionType,
           kmsKeyID: kmsKeyID,
+          createKey: encryptionType === "sse-kms" && createKey,
+          kmsContext: encryptionType === "sse-kms" ? contextMap() : undefined,
         })
         .then(() => {
           setLoading(false);
@@ -186,6 +209,39 @@ const EnableBucketEncryption = ({
               </Grid>
 
               {encryptionType === "sse-kms" && (
+                <Grid item xs={12} className={classes.formFieldRow}>
+                  <FormSwitchWrapper
+                    value="createKey"
+                    id="create-kms-key"
+                    name="create-kms-key"
+                    checked={createKey}
+                    onChange={(e) => {
+                      setCreateKey(e.target.checked);
+                      setKmsKeyID("");
+                    }}
+                    label={"Create a new key"}
+                    tooltip={
+                      "The key is created in the KMS before encrypting the bucket"
+                    }
+                  />
+                </Grid>
+              )}
+
+              {encryptionType === "sse-kms" && createKey && (
+                <Grid item xs={12} className={classes.formFieldRow}>
+                  <InputBoxWrapper
+                    id="new-kms-key-id"
+                    name="new-kms-key-id"
+                    label={"New Key Name"}
+                    value={kmsKeyID}
+                    onChange={(e: React.ChangeEvent<HTMLInputElement>) => {
+                      setKmsKeyID(e.target.value);
+                    }}
+                  />
+                </Grid>
+              )}
+
+              {encryptionType === "sse-kms" && !createKey && (
                 <Grid
                   item
                   xs={12}
@@ -228,6 +284,25 @@ const EnableBucketEncryption = ({
                   </Grid>
                 </Grid>
               )}
+
+              {encryptionType === "sse-kms" && (
+                <Grid item xs={12} className={classes.formFieldRow}>
+                  <QueryMultiSelector
+                    name="kms-context"
+                    label="Encryption Context"
+                    tooltip={
+                      "Context applied to the objects uploaded through Console"
+                    }
+                    elements={kmsContext}
+                    onChange={(vl: string) => {
+                      setKmsContext(vl);
+                    }}
+                    keyPlaceholder="Context Key"
+                    valuePlaceholder="Context Value"
+                    withBorder
+                  />
+                </Grid>
+              )}
             </Grid>
             <Grid item xs={12} className={classes.modalButtonBar}>
               <Button
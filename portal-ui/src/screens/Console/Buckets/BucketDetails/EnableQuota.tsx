@@ -70,6 +70,8 @@ const EnableQuota = ({
   const [quotaEnabled, setQuotaEnabled] = useState<boolean>(false);
   const [quotaSize, setQuotaSize] = useState<string>("1");
   const [quotaUnit, setQuotaUnit] = useState<string>("Ti");
+  const [softLimitSize, setSoftLimitSize] = useState<string>("");
+  const [softLimitUnit, setSoftLimitUnit] = useState<string>("Ti");
   const [validInput, setValidInput] = useState<boolean>(false);
 
   useEffect(() => {
@@ -80,6 +82,12 @@ const EnableQuota = ({
 
         setQuotaSize(unitCalc.total.toString());
         setQuotaUnit(unitCalc.unit);
+        if (cfg.soft_limit) {
+          const softCalc = calculateBytes(cfg.soft_limit, true, false, true);
+
+          setSoftLimitSize(softCalc.total.toString());
+          setSoftLimitUnit(softCalc.unit);
+        }
         setValidInput(true);
       }
     }
@@ -93,8 +101,11 @@ const EnableQuota = ({
       return;
     }
 
-    setValidInput(valRegExp.test(quotaSize));
-  }, [quotaEnabled, quotaSize]);
+    setValidInput(
+      valRegExp.test(quotaSize) &&
+        (softLimitSize === "" || valRegExp.test(softLimitSize))
+    );
+  }, [quotaEnabled, quotaSize, softLimitSize]);
 
   const enableBucketEncryption = () => {
     if (loading || !validInput) {
@@ -104,6 +115,10 @@ const EnableQuota = ({
       enabled: quotaEnabled,
       amount: parseInt(getBytes(quotaSize, quotaUnit, true)),
       quota_type: "hard",
+      soft_limit:
+        softLimitSize !== ""
+          ? parseInt(getBytes(softLimitSize, softLimitUnit, true))
+          : 0,
     };
 
     api
@@ -185,6 +200,29 @@ const EnableQuota = ({
                     </Grid>
                   </Grid>
                 </Grid>
+                <Grid item xs={12} className={classes.formFieldRow}>
+                  <InputBoxWrapper
+                    id="soft_limit_size"
+                    name="soft_limit_size"
+                    onChange={(e: React.ChangeEvent<HTMLInputElement>) => {
+                      setSoftLimitSize(e.target.value);
+                    }}
+                    label="Soft Limit"
+                    tooltip="An alert is raised when the bucket usage crosses this limit. Leave it empty to disable it"
+                    value={softLimitSize}
+                    overlayObject={
+                      <InputUnitMenu
+                        id={"soft_limit_unit"}
+                        onUnitChange={(newValue) => {
+                          setSoftLimitUnit(newValue);
+                        }}
+                        unitSelected={softLimitUnit}
+                        unitsList={k8sScalarUnitsExcluding(["Ki"])}
+                        disabled={false}
+                      />
+                    }
+                  />
+                </Grid>
               </React.Fragment>
             )}
           </Grid>
@@ -28,6 +28,7 @@ import {
 } from "../../Common/FormComponents/common/styleLibrary";
 
 import { ErrorResponseHandler } from "../../../../common/types";
+import { BucketPolicyValidation } from "../../../../api/consoleApi";
 import api from "../../../../common/api";
 import ModalWrapper from "../../Common/ModalWrapper/ModalWrapper";
 import SelectWrapper from "../../Common/FormComponents/SelectWrapper/SelectWrapper";
@@ -84,14 +85,21 @@ const SetAccessPolicy = ({
       return;
     }
     setAddLoading(true);
-    api
-      .invoke("PUT", `/api/v1/buckets/${bucketName}/set-policy`, {
-        access: accessPolicy,
-        definition: policyDefinition,
-      })
-      .then((res) => {
-        setAddLoading(false);
-        closeModalAndRefresh();
+    validatePolicy()
+      .then((valid) => {
+        if (!valid) {
+          setAddLoading(false);
+          return;
+        }
+        return api
+          .invoke("PUT", `/api/v1/buckets/${bucketName}/set-policy`, {
+            access: accessPolicy,
+            definition: policyDefinition,
+          })
+          .then((res) => {
+            setAddLoading(false);
+            closeModalAndRefresh();
+          });
       })
       .catch((err: ErrorResponseHandler) => {
         setAddLoading(false);
@@ -99,6 +107,33 @@ const SetAccessPolicy = ({
       });
   };
 
+  const validatePolicy = (): Promise<boolean> => {
+    if (accessPolicy !== "CUSTOM") {
+      return Promise.resolve(true);
+    }
+    return api
+      .invoke("POST", `/api/v1/buckets/${bucketName}/policy/validate`, {
+        policy: policyDefinition,
+      })
+      .then((res: BucketPolicyValidation) => {
+        if (res.valid) {
+          return true;
+        }
+        const issues = (res.errors || []).map((issue) =>
+          issue.statement
+            ? `Statement ${issue.statement}: ${issue.message}`
+            : issue.message
+        );
+        dispatch(
+          setModalErrorSnackMessage({
+            errorMessage: "Invalid bucket policy",
+            detailedError: issues.join("\n"),
+          })
+        );
+        return false;
+      });
+  };
+
   useEffect(() => {
     setAccessPolicy(actualPolicy);
     setPolicyDefinition(
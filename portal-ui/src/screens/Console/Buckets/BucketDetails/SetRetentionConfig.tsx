@@ -67,6 +67,7 @@ const SetRetentionConfig = ({
   const [retentionUnit, setRetentionUnit] = useState<string>("days");
   const [retentionValidity, setRetentionValidity] = useState<number>(1);
   const [valid, setValid] = useState<boolean>(false);
+  const [hasRetention, setHasRetention] = useState<boolean>(false);
 
   const setRetention = (event: React.FormEvent) => {
     event.preventDefault();
@@ -90,6 +91,23 @@ const SetRetentionConfig = ({
       });
   };
 
+  const clearRetention = () => {
+    if (addLoading) {
+      return;
+    }
+    setAddLoading(true);
+    api
+      .invoke("DELETE", `/api/v1/buckets/${bucketName}/retention`)
+      .then(() => {
+        setAddLoading(false);
+        closeModalAndRefresh();
+      })
+      .catch((err: ErrorResponseHandler) => {
+        setAddLoading(false);
+        dispatch(setModalErrorSnackMessage(err));
+      });
+  };
+
   useEffect(() => {
     if (Number.isNaN(retentionValidity) || retentionValidity < 1) {
       setValid(false);
@@ -106,9 +124,12 @@ const SetRetentionConfig = ({
           setLoadingForm(false);
 
           // We set default values
-          setRetentionMode(res.mode);
-          setRetentionValidity(res.validity);
-          setRetentionUnit(res.unit);
+          if (res.mode) {
+            setHasRetention(true);
+            setRetentionMode(res.mode);
+            setRetentionValidity(res.validity);
+            setRetentionUnit(res.unit);
+          }
         })
         .catch((err: ErrorResponseHandler) => {
           setLoadingForm(false);
@@ -192,6 +213,16 @@ const SetRetentionConfig = ({
                 }}
                 label={"Cancel"}
               />
+              {hasRetention && (
+                <Button
+                  id={"clear"}
+                  type="button"
+                  variant="secondary"
+                  disabled={addLoading}
+                  onClick={clearRetention}
+                  label={"Clear"}
+                />
+              )}
               <Button
                 id={"set"}
                 type="submit"
@@ -53,6 +53,19 @@ const BucketQuotaSize = ({ quota }: { quota: any }) => {
           {quota?.type} Quota
         </label>
         <label> {niceBytes(`${quota?.quota}`, true)}</label>
+        {quota?.soft_limit ? (
+          <label
+            style={{
+              fontSize: "14px",
+              color: quota?.soft_limit_exceeded ? "#C51B3F" : "inherit",
+            }}
+          >
+            Soft limit {niceBytes(`${quota.soft_limit}`, true)}
+            {quota?.soft_limit_exceeded
+              ? ` exceeded (${niceBytes(`${quota.usage || 0}`, true)} used)`
+              : ""}
+          </label>
+        ) : null}
       </Box>
     </Box>
   );
@@ -17,6 +17,7 @@
 export interface BucketEncryptionInfo {
   algorithm: string;
   kmsMasterKeyID: string;
+  kmsContext?: Record<string, string>;
 }
 
 export interface Details {
@@ -43,6 +44,16 @@ export interface BucketEvent {
   suffix: string;
 }
 
+export interface BucketEventTestResult {
+  arn: string;
+  eventName: string;
+  key: string;
+  targetStatus: string;
+  delivered: boolean;
+  responseCode?: number;
+  error?: string;
+}
+
 export interface BucketEventList {
   events: BucketEvent[];
   total: number;
@@ -89,6 +100,9 @@ export interface BucketReplication {
 export interface BucketQuota {
   quota: number;
   type: string;
+  soft_limit?: number;
+  usage?: number;
+  soft_limit_exceeded?: boolean;
 }
 
 export interface ChangePasswordRequest {
@@ -43,7 +43,10 @@ import {
   IAM_SCOPES,
   S3_ALL_RESOURCES,
 } from "../../common/SecureComponent/permissions";
-import { hasPermission } from "../../common/SecureComponent";
+import {
+  hasConsoleGrant,
+  hasPermission,
+} from "../../common/SecureComponent";
 import { IRouteRule } from "./Menu/types";
 import LoadingComponent from "../../common/LoadingComponent";
 import ComponentsScreen from "./Common/ComponentsScreen";
@@ -453,7 +456,8 @@ const Console = ({ classes }: IConsoleProps) => {
                 CONSOLE_UI_RESOURCE,
                 IAM_PAGES_PERMISSIONS[route.path]
               ))) &&
-        !route.fsHidden
+        !route.fsHidden &&
+        hasConsoleGrant(route.path)
   );
 
   const closeSnackBar = () => {
@@ -34,9 +34,10 @@ import FiberManualRecordIcon from "@mui/icons-material/FiberManualRecord";
 import {
   NotificationEndpointItem,
   NotificationEndpointsList,
+  NotificationEndpointTestResult,
   TransformedEndpointItem,
 } from "./types";
-import { getNotificationConfigKey, notificationTransform } from "./utils";
+import { notificationTransform } from "./utils";
 import TableWrapper from "../Common/TableWrapper/TableWrapper";
 
 import {
@@ -55,6 +56,7 @@ import { IAM_PAGES } from "../../../common/SecureComponent/permissions";
 import {
   setErrorSnackMessage,
   setServerNeedsRestart,
+  setSnackBarMessage,
 } from "../../../systemSlice";
 import { useAppDispatch } from "../../../store";
 import ConfirmDeleteDestinationModal from "./ConfirmDeleteDestinationModal";
@@ -128,38 +130,60 @@ const ListEventDestinations = ({ classes }: IListNotificationEndpoints) => {
     setIsLoading(true);
   }, []);
 
-  const resetNotificationConfig = (
+  const deleteNotificationEndpoint = (
     ep: TransformedEndpointItem | undefined | null
   ) => {
     if (ep?.name) {
-      const configKey = getNotificationConfigKey(ep.name);
-      let accountId = `:${ep.account_id}`;
-      if (configKey) {
-        api
-          .invoke("POST", `/api/v1/configs/${configKey}${accountId}/reset`)
-          .then((res) => {
-            dispatch(setServerNeedsRestart(true));
-            setSelNotifyEndpoint(null);
-            setIsDelConfirmOpen(false);
-          })
-          .catch((err: ErrorResponseHandler) => {
-            setIsDelConfirmOpen(false);
-            dispatch(setErrorSnackMessage(err));
-          });
-      } else {
-        setSelNotifyEndpoint(null);
-        setIsDelConfirmOpen(false);
-        console.log(`Unable to find Config key for ${ep.name}`);
-      }
+      api
+        .invoke(
+          "DELETE",
+          `/api/v1/admin/notification_endpoints/${ep.name}/${encodeURIComponent(
+            ep.account_id
+          )}`
+        )
+        .then(() => {
+          dispatch(setServerNeedsRestart(true));
+          setSelNotifyEndpoint(null);
+          setIsDelConfirmOpen(false);
+        })
+        .catch((err: ErrorResponseHandler) => {
+          setIsDelConfirmOpen(false);
+          dispatch(setErrorSnackMessage(err));
+        });
     }
   };
 
+  const testNotifyEndpoint = (record: TransformedEndpointItem) => {
+    api
+      .invoke(
+        "POST",
+        `/api/v1/admin/notification_endpoints/${
+          record.name
+        }/${encodeURIComponent(record.account_id)}/test`
+      )
+      .then((res: NotificationEndpointTestResult) => {
+        dispatch(
+          setSnackBarMessage(
+            `${record.service_name} is ${res.status}${
+              res.message ? `: ${res.message}` : ""
+            }`
+          )
+        );
+      })
+      .catch((err: ErrorResponseHandler) => {
+        dispatch(setErrorSnackMessage(err));
+      });
+  };
+
   const confirmDelNotifyEndpoint = (record: TransformedEndpointItem) => {
     setSelNotifyEndpoint(record);
     setIsDelConfirmOpen(true);
   };
 
-  const tableActions = [{ type: "delete", onClick: confirmDelNotifyEndpoint }];
+  const tableActions = [
+    { type: "preview", onClick: testNotifyEndpoint },
+    { type: "delete", onClick: confirmDelNotifyEndpoint },
+  ];
 
   const filteredRecords = records.filter((b: TransformedEndpointItem) => {
     if (filter === "") {
@@ -312,7 +336,7 @@ const ListEventDestinations = ({ classes }: IListNotificationEndpoints) => {
         {isDelConfirmOpen ? (
           <ConfirmDeleteDestinationModal
             onConfirm={() => {
-              resetNotificationConfig(selNotifyEndPoint);
+              deleteNotificationEndpoint(selNotifyEndPoint);
             }}
             status={`${selNotifyEndPoint?.status}`}
             serviceName={`${selNotifyEndPoint?.service_name}`}
@@ -33,6 +33,13 @@ export interface TransformedEndpointItem {
   account_id: string;
 }
 
+export interface NotificationEndpointTestResult {
+  status: string;
+  eventSent: boolean;
+  responseCode?: number;
+  message?: string;
+}
+
 export interface NotificationEndpointsList {
   notification_endpoints: NotificationEndpointItem[];
 }
@@ -31,7 +31,10 @@ import {
 } from "./MenuStyleUtils";
 import List from "@mui/material/List";
 import { MenuCollapsedIcon, MenuExpandedIcon } from "mds";
-import { hasPermission } from "../../../common/SecureComponent";
+import {
+  hasConsoleGrant,
+  hasPermission,
+} from "../../../common/SecureComponent";
 import {
   CONSOLE_UI_RESOURCE,
   IAM_PAGES_PERMISSIONS,
@@ -66,7 +69,8 @@ const MenuItem = ({
         ? item.customPermissionFnc()
         : hasPermission(CONSOLE_UI_RESOURCE, IAM_PAGES_PERMISSIONS[item.to])) ||
         item.forceDisplay) &&
-      !item.fsHidden
+      !item.fsHidden &&
+      hasConsoleGrant(item.to)
   );
 
   let hasChildren = childrenMenuList?.length;
@@ -40,6 +40,12 @@ export interface ISessionResponse {
   customStyles?: string | null;
   envConstants?: IEnvironmentContants | null;
   serverEndPoint?: string | undefined;
+  sessionRenewIn?: number;
+  consoleGrants?: string[];
+}
+
+export interface ISessionRenewResponse {
+  sessionRenewIn?: number;
 }
 
 export interface ButtonProps {
@@ -51,7 +51,10 @@ import {
   UsersMenuIcon,
   WatchIcon,
 } from "mds";
-import { hasPermission } from "../../common/SecureComponent";
+import {
+  hasConsoleGrant,
+  hasPermission,
+} from "../../common/SecureComponent";
 import React from "react";
 import LicenseBadge from "./Menu/LicenseBadge";
 import EncryptionIcon from "../../icons/SidebarMenus/EncryptionIcon";
@@ -326,7 +329,8 @@ export const validRoutes = (features: string[] | null | undefined) => {
                 IAM_PAGES_PERMISSIONS[childItem.to ?? ""]
               )) ||
             childItem.forceDisplay) &&
-          !childItem.fsHidden
+          !childItem.fsHidden &&
+          hasConsoleGrant(childItem.to)
         );
       });
       return c.length > 0;
@@ -340,7 +344,8 @@ export const validRoutes = (features: string[] | null | undefined) => {
             IAM_PAGES_PERMISSIONS[item.to ?? ""]
           )) ||
         item.forceDisplay) &&
-      !item.fsHidden;
+      !item.fsHidden &&
+      hasConsoleGrant(item.to);
     return res;
   });
   return allowedItems;
@@ -234,6 +234,7 @@ export interface LoginStrategyPayload {
   accessKey: string;
   secretKey: string;
   sts?: string;
+  otp?: string;
 }
 
 export const getTargetPath = () => {
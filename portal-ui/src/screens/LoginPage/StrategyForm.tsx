@@ -23,7 +23,13 @@ import {
   PasswordKeyIcon,
   UserFilledIcon,
 } from "mds";
-import { setAccessKey, setSecretKey, setSTS, setUseSTS } from "./loginSlice";
+import {
+  setAccessKey,
+  setOtp,
+  setSecretKey,
+  setSTS,
+  setUseSTS,
+} from "./loginSlice";
 import {
   InputAdornment,
   LinearProgress,
@@ -80,6 +86,10 @@ const StrategyForm = ({ redirectRules }: IStrategyForm) => {
   const secretKey = useSelector((state: AppState) => state.login.secretKey);
   const sts = useSelector((state: AppState) => state.login.sts);
   const useSTS = useSelector((state: AppState) => state.login.useSTS);
+  const otp = useSelector((state: AppState) => state.login.otp);
+  const otpRequired = useSelector(
+    (state: AppState) => state.login.otpRequired
+  );
 
   const loginSending = useSelector(
     (state: AppState) => state.login.loginSending
@@ -156,7 +166,11 @@ const StrategyForm = ({ redirectRules }: IStrategyForm) => {
               }}
             />
           </Grid>
-          <Grid item xs={12} className={useSTS ? classes.spacerBottom : ""}>
+          <Grid
+            item
+            xs={12}
+            className={useSTS || otpRequired ? classes.spacerBottom : ""}
+          >
             <LoginField
               fullWidth
               className={classes.inputField}
@@ -211,6 +225,35 @@ const StrategyForm = ({ redirectRules }: IStrategyForm) => {
               />
             </Grid>
           )}
+          {otpRequired && !useSTS && (
+            <Grid item xs={12} className={classes.spacerBottom}>
+              <LoginField
+                fullWidth
+                id="otp"
+                className={classes.inputField}
+                value={otp}
+                onChange={(e: React.ChangeEvent<HTMLInputElement>) =>
+                  dispatch(setOtp(e.target.value))
+                }
+                placeholder={"Authentication or recovery code"}
+                name="otp"
+                autoComplete="one-time-code"
+                autoFocus
+                disabled={loginSending}
+                variant={"outlined"}
+                InputProps={{
+                  startAdornment: (
+                    <InputAdornment
+                      position="start"
+                      className={classes.iconColor}
+                    >
+                      <PasswordKeyIcon />
+                    </InputAdornment>
+                  ),
+                }}
+              />
+            </Grid>
+          )}
         </Grid>
 
         <Grid item xs={12} className={classes.submitContainer}>
@@ -223,6 +266,7 @@ const StrategyForm = ({ redirectRules }: IStrategyForm) => {
             disabled={
               (!useSTS && (accessKey === "" || secretKey === "")) ||
               (useSTS && sts === "") ||
+              (otpRequired && !useSTS && otp === "") ||
               loginSending
             }
             label={"Login"}
@@ -27,6 +27,8 @@ export interface LoginState {
   secretKey: string;
   sts: string;
   useSTS: boolean;
+  otp: string;
+  otpRequired: boolean;
   backgroundAnimation: boolean;
 
   loginStrategy: ILoginDetails;
@@ -47,6 +49,8 @@ const initialState: LoginState = {
   secretKey: "",
   sts: "",
   useSTS: false,
+  otp: "",
+  otpRequired: false,
   loginStrategy: {
     loginStrategy: loginStrategyType.unknown,
     redirectRules: [],
@@ -78,6 +82,13 @@ export const loginSlice = createSlice({
     setSTS: (state, action: PayloadAction<string>) => {
       state.sts = action.payload;
     },
+    setOtp: (state, action: PayloadAction<string>) => {
+      state.otp = action.payload;
+    },
+    setOtpRequired: (state, action: PayloadAction<boolean>) => {
+      state.otpRequired = action.payload;
+      state.otp = "";
+    },
     setNavigateTo: (state, action: PayloadAction<string>) => {
       state.navigateTo = action.payload;
     },
@@ -130,6 +141,8 @@ export const {
   setSecretKey,
   setUseSTS,
   setSTS,
+  setOtp,
+  setOtpRequired,
   setNavigateTo,
   resetForm,
 } = loginSlice.actions;
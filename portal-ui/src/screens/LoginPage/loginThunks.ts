@@ -20,9 +20,12 @@ import api from "../../common/api";
 import { ErrorResponseHandler } from "../../common/types";
 import { setErrorSnackMessage, userLogged } from "../../systemSlice";
 import { ILoginDetails } from "./types";
-import { setNavigateTo } from "./loginSlice";
+import { setNavigateTo, setOtpRequired } from "./loginSlice";
 import { getTargetPath, LoginStrategyPayload } from "./LoginPage";
 
+const twoFactorRequiredMessage =
+  "a two-factor authentication code is required";
+
 export const doLoginAsync = createAsyncThunk(
   "login/doLoginAsync",
   async (_, { getState, rejectWithValue, dispatch }) => {
@@ -31,11 +34,15 @@ export const doLoginAsync = createAsyncThunk(
     const secretKey = state.login.secretKey;
     const sts = state.login.sts;
     const useSTS = state.login.useSTS;
+    const otp = state.login.otp;
 
     let loginStrategyPayload: LoginStrategyPayload = {
       accessKey,
       secretKey,
     };
+    if (state.login.otpRequired) {
+      loginStrategyPayload.otp = otp;
+    }
     if (useSTS) {
       loginStrategyPayload = {
         accessKey,
@@ -52,7 +59,15 @@ export const doLoginAsync = createAsyncThunk(
         localStorage.setItem("userLoggedIn", accessKey);
         dispatch(setNavigateTo(getTargetPath()));
       })
-      .catch((err) => {
+      .catch((err: ErrorResponseHandler) => {
+        // enrolled users are asked their code once the credentials are valid
+        if (
+          err.statusCode === 401 &&
+          err.errorMessage.toLowerCase() === twoFactorRequiredMessage
+        ) {
+          dispatch(setOtpRequired(true));
+          return;
+        }
         dispatch(setErrorSnackMessage(err));
       });
   }
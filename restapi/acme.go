@@ -0,0 +1,109 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/minio/console/pkg/autotls"
+	"github.com/minio/console/pkg/certs"
+	xcerts "github.com/minio/pkg/certs"
+)
+
+// globalAutoTLS obtains and renews the certificate of Console from an ACME CA, nil unless CONSOLE_ACME_DOMAINS
+// is set
+var globalAutoTLS *autotls.Manager
+
+// newAutoTLS configures the ACME manager from the environment, the certificate is written to certsDir where
+// it is loaded and reloaded like any other certificate
+func newAutoTLS(certsDir string) (*autotls.Manager, error) {
+	// the CAs are not loaded yet, a private ACME CA is trusted with the certs/CAs directory as well
+	rootCAs, err := xcerts.GetRootCAs(filepath.Join(certsDir, certs.CertsCADir))
+	if err != nil {
+		return nil, err
+	}
+	config := autotls.Config{
+		DirectoryURL: getConsoleACMEDirectoryURL(),
+		Email:        getConsoleACMEEmail(),
+		Domains:      getConsoleACMEDomains(),
+		Challenge:    getConsoleACMEChallenge(),
+		Dir:          filepath.Join(certsDir, "acme"),
+		CertFile:     filepath.Join(certsDir, certs.PublicCertFile),
+		KeyFile:      filepath.Join(certsDir, certs.PrivateKeyFile),
+		RenewBefore:  getConsoleACMERenewBefore(),
+		HTTPClient: &http.Client{Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12},
+		}},
+	}
+	if config.Challenge == autotls.ChallengeDNS01 {
+		name, providerConfig := getConsoleACMEDNSProvider()
+		provider, err := autotls.NewDNSProvider(name, providerConfig)
+		if err != nil {
+			return nil, err
+		}
+		config.DNS = provider
+	}
+	return autotls.New(config)
+}
+
+// InitAutoTLS obtains the certificate of Console before it starts serving when it has none yet, answering the
+// HTTP-01 challenges on httpAddr, and keeps renewing it in the background. It does nothing without
+// CONSOLE_ACME_DOMAINS.
+func InitAutoTLS(ctx context.Context, certsDir, httpAddr string) error {
+	if len(getConsoleACMEDomains()) == 0 {
+		return nil
+	}
+	manager, err := newAutoTLS(certsDir)
+	if err != nil {
+		return err
+	}
+	if manager.NeedsCertificate(time.Now()) {
+		if err = manager.ObtainListening(ctx, httpAddr); err != nil {
+			return fmt.Errorf("unable to obtain a certificate for %s: %w", strings.Join(getConsoleACMEDomains(), ", "), err)
+		}
+		LogInfoContext(ctx, "obtained a certificate for %s", strings.Join(getConsoleACMEDomains(), ", "))
+	}
+	globalAutoTLS = manager
+	go manager.Run(ctx, func(err error) {
+		if err != nil {
+			LogErrorContext(ctx, "unable to renew the certificate: %v", err)
+			return
+		}
+		LogInfoContext(ctx, "renewed the certificate for %s", strings.Join(getConsoleACMEDomains(), ", "))
+		// the files are watched too, reloading right away doesn't wait for the watcher
+		if GlobalTLSCertsManager != nil {
+			GlobalTLSCertsManager.ReloadCerts()
+		}
+	})
+	return nil
+}
+
+// ACMEChallengeMiddleware answers the HTTP-01 challenges of the renewals ahead of the redirect to HTTPS and
+// the allowed hosts check
+func ACMEChallengeMiddleware(next http.Handler) http.Handler {
+	if globalAutoTLS == nil {
+		return next
+	}
+	return globalAutoTLS.HTTPHandler(next)
+}
@@ -0,0 +1,222 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	userApi "github.com/minio/console/restapi/operations/user"
+	"github.com/minio/madmin-go/v2"
+)
+
+// accessKeyInventoryConcurrency bounds the admin and Log Search requests made in parallel for the inventory
+const accessKeyInventoryConcurrency = 8
+
+func registerAccessKeyInventoryHandlers(api *operations.ConsoleAPI) {
+	// inventory of users and service accounts
+	api.UserListAccessKeyInventoryHandler = userApi.ListAccessKeyInventoryHandlerFunc(func(params userApi.ListAccessKeyInventoryParams, session *models.Principal) middleware.Responder {
+		resp, err := getListAccessKeyInventoryResponse(session, params)
+		if err != nil {
+			return userApi.NewListAccessKeyInventoryDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewListAccessKeyInventoryOK().WithPayload(resp)
+	})
+}
+
+// accessKeyLastUsed returns when an access key last appeared in the audit log, empty when it never did
+func accessKeyLastUsed(logSearchURL, token, accessKey string) (string, error) {
+	query := url.Values{}
+	query.Set("token", token)
+	query.Set("q", "reqinfo")
+	query.Set("fp", "access_key:"+accessKey)
+	query.Set("timeDesc", "ok")
+	query.Set("pageSize", "1")
+	query.Set("pageNo", "0")
+	resp, err := logSearch(fmt.Sprintf("%s/api/query?%s", strings.TrimSuffix(logSearchURL, "/"), query.Encode()))
+	if err != nil {
+		return "", err
+	}
+	entries, _ := resp.Results.([]map[string]interface{})
+	if len(entries) == 0 {
+		return "", nil
+	}
+	return auditField(entries[0], "time"), nil
+}
+
+func splitPolicies(policies string) []string {
+	names := []string{}
+	for _, name := range strings.Split(policies, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// forEachConcurrently calls fn for every index with at most accessKeyInventoryConcurrency calls in flight
+func forEachConcurrently(n int, fn func(i int)) {
+	sem := make(chan struct{}, accessKeyInventoryConcurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// accessKeyInventory lists the access keys of every user and of their service accounts. MinIO doesn't keep
+// when a key was created, the last time the account was updated is reported instead. Service accounts
+// that inherit their policy get the policies of their parent user
+func accessKeyInventory(ctx context.Context, client MinioAdmin, lastUsed func(accessKey string) (string, error)) (*models.AccessKeyInventory, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	inventory := &models.AccessKeyInventory{
+		Keys:        []*models.AccessKeyInventoryEntry{},
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	userNames := make([]string, 0, len(users))
+	for accessKey, user := range users {
+		userNames = append(userNames, accessKey)
+		entry := &models.AccessKeyInventoryEntry{
+			AccessKey: accessKey,
+			Type:      models.AccessKeyInventoryEntryTypeUser,
+			Status:    string(user.Status),
+			Policies:  splitPolicies(user.PolicyName),
+		}
+		if !user.UpdatedAt.IsZero() {
+			entry.UpdatedAt = user.UpdatedAt.UTC().Format(time.RFC3339)
+		}
+		inventory.Keys = append(inventory.Keys, entry)
+	}
+
+	var mu sync.Mutex
+	var firstErr error
+	forEachConcurrently(len(userNames), func(i int) {
+		parent := userNames[i]
+		accounts, err := client.listServiceAccounts(ctx, parent)
+		var entries []*models.AccessKeyInventoryEntry
+		for j := 0; err == nil && j < len(accounts.Accounts); j++ {
+			var info madmin.InfoServiceAccountResp
+			info, err = client.infoServiceAccount(ctx, accounts.Accounts[j])
+			if err != nil {
+				break
+			}
+			entry := &models.AccessKeyInventoryEntry{
+				AccessKey:    accounts.Accounts[j],
+				Type:         models.AccessKeyInventoryEntryTypeServiceAccount,
+				ParentUser:   info.ParentUser,
+				Status:       info.AccountStatus,
+				Policies:     []string{},
+				InlinePolicy: !info.ImpliedPolicy,
+			}
+			if info.ImpliedPolicy {
+				entry.Policies = splitPolicies(users[parent].PolicyName)
+			}
+			// accounts that never expire carry the zero unix time
+			if info.Expiration != nil && info.Expiration.Unix() > 0 {
+				entry.Expiration = info.Expiration.UTC().Format(time.RFC3339)
+			}
+			entries = append(entries, entry)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		inventory.Keys = append(inventory.Keys, entries...)
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	sort.Slice(inventory.Keys, func(i, j int) bool {
+		return inventory.Keys[i].AccessKey < inventory.Keys[j].AccessKey
+	})
+
+	if lastUsed == nil {
+		return inventory, nil
+	}
+	// the inventory is still useful without the audit log, failures only leave the last use out
+	inventory.AuditAvailable = true
+	forEachConcurrently(len(inventory.Keys), func(i int) {
+		when, err := lastUsed(inventory.Keys[i].AccessKey)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			inventory.AuditAvailable = false
+			return
+		}
+		inventory.Keys[i].LastUsed = when
+	})
+	if !inventory.AuditAvailable {
+		for _, key := range inventory.Keys {
+			key.LastUsed = ""
+		}
+	}
+	return inventory, nil
+}
+
+func getListAccessKeyInventoryResponse(session *models.Principal, params userApi.ListAccessKeyInventoryParams) (*models.AccessKeyInventory, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	var lastUsed func(accessKey string) (string, error)
+	if logSearchURL := getLogSearchURL(); logSearchURL != "" {
+		sessionResp, serr := getSessionResponse(ctx, session)
+		if serr != nil {
+			return nil, serr
+		}
+		if hasLogSearchAccess(sessionResp.Permissions) {
+			token := getLogSearchAPIToken()
+			lastUsed = func(accessKey string) (string, error) {
+				return accessKeyLastUsed(logSearchURL, token, accessKey)
+			}
+		}
+	}
+	inventory, err := accessKeyInventory(ctx, adminClient, lastUsed)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return inventory, nil
+}
@@ -0,0 +1,113 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessKeyInventory(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	updated := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	expiration := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {Status: madmin.AccountEnabled, PolicyName: "readwrite,diagnostics", UpdatedAt: updated},
+			"bob":   {Status: madmin.AccountDisabled},
+		}, nil
+	}
+	minioListServiceAccountsMock = func(ctx context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		if user == "alice" {
+			return madmin.ListServiceAccountsResp{Accounts: []string{"alice-sa1", "alice-sa2"}}, nil
+		}
+		return madmin.ListServiceAccountsResp{}, nil
+	}
+	minioInfoServiceAccountMock = func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error) {
+		if serviceAccount == "alice-sa1" {
+			return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "on", ImpliedPolicy: true}, nil
+		}
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "off", Expiration: &expiration}, nil
+	}
+
+	inventory, err := accessKeyInventory(ctx, adminClient, nil)
+	assert.NoError(err)
+	assert.False(inventory.AuditAvailable)
+	assert.Len(inventory.Keys, 4)
+	assert.Equal(&models.AccessKeyInventoryEntry{
+		AccessKey: "alice",
+		Type:      models.AccessKeyInventoryEntryTypeUser,
+		Status:    "enabled",
+		Policies:  []string{"readwrite", "diagnostics"},
+		UpdatedAt: "2023-04-01T12:00:00Z",
+	}, inventory.Keys[0])
+	assert.Equal(&models.AccessKeyInventoryEntry{
+		AccessKey:  "alice-sa1",
+		Type:       models.AccessKeyInventoryEntryTypeServiceAccount,
+		ParentUser: "alice",
+		Status:     "on",
+		Policies:   []string{"readwrite", "diagnostics"},
+	}, inventory.Keys[1])
+	assert.Equal(&models.AccessKeyInventoryEntry{
+		AccessKey:    "alice-sa2",
+		Type:         models.AccessKeyInventoryEntryTypeServiceAccount,
+		ParentUser:   "alice",
+		Status:       "off",
+		Policies:     []string{},
+		InlinePolicy: true,
+		Expiration:   "2024-01-01T00:00:00Z",
+	}, inventory.Keys[2])
+	assert.Equal("bob", inventory.Keys[3].AccessKey)
+	assert.Empty(inventory.Keys[3].Policies)
+
+	// last use comes from the audit log
+	inventory, err = accessKeyInventory(ctx, adminClient, func(accessKey string) (string, error) {
+		if accessKey == "alice" {
+			return "2023-05-01T08:00:00Z", nil
+		}
+		return "", nil
+	})
+	assert.NoError(err)
+	assert.True(inventory.AuditAvailable)
+	assert.Equal("2023-05-01T08:00:00Z", inventory.Keys[0].LastUsed)
+	assert.Empty(inventory.Keys[1].LastUsed)
+
+	// an unreachable audit log leaves the last use out
+	inventory, err = accessKeyInventory(ctx, adminClient, func(accessKey string) (string, error) {
+		if accessKey == "bob" {
+			return "", errors.New("the Log Search API cannot be reached")
+		}
+		return "2023-05-01T08:00:00Z", nil
+	})
+	assert.NoError(err)
+	assert.False(inventory.AuditAvailable)
+	assert.Empty(inventory.Keys[0].LastUsed)
+
+	minioInfoServiceAccountMock = func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{}, errors.New("service account not found")
+	}
+	_, err = accessKeyInventory(ctx, adminClient, nil)
+	assert.EqualError(err, "service account not found")
+}
@@ -0,0 +1,402 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/alerting"
+	xhttp "github.com/minio/console/pkg/http"
+	"github.com/minio/console/pkg/subnet"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+// alertEvaluationTimeout bounds a background evaluation of the alert rules
+const alertEvaluationTimeout = 2 * time.Minute
+
+var (
+	globalAlertingEngine     *alerting.Engine
+	globalAlertingEngineOnce sync.Once
+
+	globalAlertSinks     []alerting.Sink
+	globalAlertSinksOnce sync.Once
+
+	// alertEvaluationMu keeps the background and the requested evaluations from notifying the same alerts twice
+	alertEvaluationMu sync.Mutex
+)
+
+// alertingEngine returns the engine evaluating the alert rules, the rules are kept in memory when the
+// rules file can't be read
+func alertingEngine() *alerting.Engine {
+	globalAlertingEngineOnce.Do(func() {
+		path := getConsoleAlertingRulesFile()
+		engine, err := alerting.New(path)
+		if err != nil {
+			LogError("unable to read the alert rules of %s, the default rules are kept in memory: %v", path, err)
+			engine, _ = alerting.New("")
+		}
+		globalAlertingEngine = engine
+	})
+	return globalAlertingEngine
+}
+
+// alertSinks returns the sinks notified of the alerts, configured from the environment
+func alertSinks() []alerting.Sink {
+	globalAlertSinksOnce.Do(func() {
+		if endpoint, authToken := getConsoleAlertingWebhook(); endpoint != "" {
+			globalAlertSinks = append(globalAlertSinks, alerting.NewWebhookSink(endpoint, authToken, GetConsoleHTTPClient(endpoint)))
+		}
+		if webhookURL := getConsoleAlertingSlackWebhookURL(); webhookURL != "" {
+			globalAlertSinks = append(globalAlertSinks, alerting.NewSlackSink(webhookURL, GetConsoleHTTPClient(webhookURL)))
+		}
+		server, username, password := getConsoleAlertingSMTP()
+		from, to := getConsoleAlertingEmail()
+		if server != "" && from != "" && len(to) > 0 {
+			globalAlertSinks = append(globalAlertSinks, alerting.NewEmailSink(server, username, password, from, to))
+		}
+	})
+	return globalAlertSinks
+}
+
+func registerAlertingHandlers(api *operations.ConsoleAPI) {
+	// firing and resolved alerts
+	api.SystemGetAlertsHandler = systemApi.GetAlertsHandlerFunc(func(params systemApi.GetAlertsParams, session *models.Principal) middleware.Responder {
+		return systemApi.NewGetAlertsOK().WithPayload(alertsStateResponse(alertingEngine().State(), alertSinks()))
+	})
+	// evaluate the rules now
+	api.SystemEvaluateAlertsHandler = systemApi.EvaluateAlertsHandlerFunc(func(params systemApi.EvaluateAlertsParams, session *models.Principal) middleware.Responder {
+		state, err := getEvaluateAlertsResponse(session, params)
+		if err != nil {
+			return systemApi.NewEvaluateAlertsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewEvaluateAlertsOK().WithPayload(state)
+	})
+	// list the rules
+	api.SystemListAlertRulesHandler = systemApi.ListAlertRulesHandlerFunc(func(params systemApi.ListAlertRulesParams, session *models.Principal) middleware.Responder {
+		return systemApi.NewListAlertRulesOK().WithPayload(listAlertRules(alertingEngine()))
+	})
+	// create or update a rule
+	api.SystemSetAlertRuleHandler = systemApi.SetAlertRuleHandlerFunc(func(params systemApi.SetAlertRuleParams, session *models.Principal) middleware.Responder {
+		rule, err := getSetAlertRuleResponse(params)
+		if err != nil {
+			return systemApi.NewSetAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewSetAlertRuleOK().WithPayload(rule)
+	})
+	// remove a rule
+	api.SystemDeleteAlertRuleHandler = systemApi.DeleteAlertRuleHandlerFunc(func(params systemApi.DeleteAlertRuleParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteAlertRuleResponse(params); err != nil {
+			return systemApi.NewDeleteAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewDeleteAlertRuleNoContent()
+	})
+}
+
+// alertRuleError maps the errors of the alerting engine to the errors of the API
+func alertRuleError(err error) error {
+	switch {
+	case errors.Is(err, alerting.ErrInvalidRule):
+		return fmt.Errorf("%w%s", ErrInvalidAlertRule, strings.TrimPrefix(err.Error(), alerting.ErrInvalidRule.Error()))
+	case errors.Is(err, alerting.ErrRuleNotFound):
+		return ErrAlertRuleNotFound
+	}
+	return err
+}
+
+func alertRuleModel(rule alerting.Rule) *models.AlertRule {
+	return &models.AlertRule{
+		ID:        rule.ID,
+		Name:      swag.String(rule.Name),
+		Kind:      swag.String(rule.Kind),
+		Threshold: swag.Float64(rule.Threshold),
+		Enabled:   rule.Enabled,
+	}
+}
+
+func listAlertRules(engine *alerting.Engine) *models.AlertRules {
+	rules := &models.AlertRules{Rules: []*models.AlertRule{}}
+	for _, rule := range engine.Rules() {
+		rules.Rules = append(rules.Rules, alertRuleModel(rule))
+	}
+	return rules
+}
+
+// setAlertRule creates or updates the rule id, the id of the body has to match it when given
+func setAlertRule(engine *alerting.Engine, id string, body *models.AlertRule) (*models.AlertRule, error) {
+	if body == nil {
+		return nil, fmt.Errorf("%w: missing rule", ErrInvalidAlertRule)
+	}
+	if body.ID != "" && body.ID != id {
+		return nil, fmt.Errorf("%w: the id of the rule doesn't match the path", ErrInvalidAlertRule)
+	}
+	rule := alerting.Rule{
+		ID:        id,
+		Name:      swag.StringValue(body.Name),
+		Kind:      swag.StringValue(body.Kind),
+		Threshold: swag.Float64Value(body.Threshold),
+		Enabled:   body.Enabled,
+	}
+	if err := engine.SetRule(rule); err != nil {
+		return nil, alertRuleError(err)
+	}
+	return alertRuleModel(rule), nil
+}
+
+func formatAlertTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func alertModel(alert alerting.Alert) *models.Alert {
+	return &models.Alert{
+		RuleID:     alert.RuleID,
+		RuleName:   alert.RuleName,
+		Kind:       alert.Kind,
+		Subject:    alert.Subject,
+		Value:      alert.Value,
+		Threshold:  alert.Threshold,
+		State:      alert.State,
+		Message:    alert.Message(),
+		FiredAt:    formatAlertTime(alert.FiredAt),
+		ResolvedAt: formatAlertTime(alert.ResolvedAt),
+	}
+}
+
+func alertsStateResponse(state alerting.State, sinks []alerting.Sink) *models.AlertsState {
+	accessKey, _ := getConsoleAlertingCredentials()
+	response := &models.AlertsState{
+		LastEvaluation:       formatAlertTime(state.LastEvaluation),
+		Errors:               []string{},
+		Firing:               []*models.Alert{},
+		Resolved:             []*models.Alert{},
+		Sinks:                []string{},
+		BackgroundEvaluation: accessKey != "",
+		Interval:             int64(getConsoleAlertingInterval().Seconds()),
+	}
+	for kind, reason := range state.Errors {
+		response.Errors = append(response.Errors, fmt.Sprintf("%s: %s", kind, reason))
+	}
+	sort.Strings(response.Errors)
+	for _, alert := range state.Firing {
+		response.Firing = append(response.Firing, alertModel(alert))
+	}
+	for _, alert := range state.Resolved {
+		response.Resolved = append(response.Resolved, alertModel(alert))
+	}
+	for _, sink := range sinks {
+		response.Sinks = append(response.Sinks, sink.String())
+	}
+	return response
+}
+
+// licenseExpiry returns when the SUBNET license of the cluster expires, zero when the cluster has no license
+var licenseExpiry = func(ctx context.Context, client MinioAdmin) (time.Time, error) {
+	// license gets seeded to us by MinIO, otherwise it is part of the subnet config
+	license := os.Getenv(EnvSubnetLicense)
+	if license == "" {
+		configBytes, err := client.getConfigKV(ctx, "subnet")
+		if err != nil {
+			return time.Time{}, err
+		}
+		subSysConfigs, err := madmin.ParseServerConfigOutput(string(configBytes))
+		if err != nil {
+			return time.Time{}, err
+		}
+		for _, v := range subSysConfigs {
+			for _, sv := range v.KV {
+				if sv.Key == "license" {
+					license = sv.Value
+				}
+			}
+		}
+	}
+	if license == "" {
+		return time.Time{}, nil
+	}
+	licenseInfo, err := subnet.ParseLicense(&xhttp.Client{Client: GetConsoleHTTPClient("")}, license)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return licenseInfo.ExpiresAt, nil
+}
+
+// collectAlertObservations measures the conditions the rules are evaluated against, the kinds that can't be
+// measured are returned along with the reason
+func collectAlertObservations(ctx context.Context, admin MinioAdmin, client MinioClient, now time.Time) ([]alerting.Observation, map[string]string) {
+	var observations []alerting.Observation
+	unobserved := map[string]string{}
+
+	expiry, err := licenseExpiry(ctx, admin)
+	if err != nil {
+		unobserved[alerting.KindLicenseExpiring] = err.Error()
+	} else if !expiry.IsZero() {
+		observations = append(observations, alerting.Observation{
+			Kind:    alerting.KindLicenseExpiring,
+			Subject: "license",
+			Value:   math.Floor(expiry.Sub(now).Hours() / 24),
+		})
+	}
+
+	info, err := admin.serverInfo(ctx)
+	if err != nil {
+		unobserved[alerting.KindDriveUsage] = err.Error()
+		unobserved[alerting.KindNodeOffline] = err.Error()
+	} else {
+		for _, server := range info.Servers {
+			offline := 0.0
+			if server.State != "online" {
+				offline = 1
+			}
+			observations = append(observations, alerting.Observation{Kind: alerting.KindNodeOffline, Subject: server.Endpoint, Value: offline})
+			for _, disk := range server.Disks {
+				// offline drives don't report their capacity
+				if disk.TotalSpace == 0 {
+					continue
+				}
+				observations = append(observations, alerting.Observation{
+					Kind:    alerting.KindDriveUsage,
+					Subject: server.Endpoint + disk.DrivePath,
+					Value:   math.Round(float64(disk.UsedSpace)/float64(disk.TotalSpace)*1000) / 10,
+				})
+			}
+		}
+	}
+
+	account, err := admin.AccountInfo(ctx)
+	if err != nil {
+		unobserved[alerting.KindReplicationBacklog] = err.Error()
+	} else {
+		for _, bucket := range account.Buckets {
+			if bucket.Details == nil || !bucket.Details.Replication {
+				continue
+			}
+			metrics, err := client.getBucketReplicationMetrics(ctx, bucket.Name)
+			if err != nil {
+				unobserved[alerting.KindReplicationBacklog] = fmt.Sprintf("%s: %v", bucket.Name, err)
+				continue
+			}
+			observations = append(observations, alerting.Observation{
+				Kind:    alerting.KindReplicationBacklog,
+				Subject: bucket.Name,
+				Value:   float64(metrics.PendingCount),
+			})
+		}
+	}
+	return observations, unobserved
+}
+
+// evaluateAlerts evaluates the rules of engine against the cluster and notifies the sinks of the alerts
+// that fired or resolved
+func evaluateAlerts(ctx context.Context, engine *alerting.Engine, sinks []alerting.Sink, admin MinioAdmin, client MinioClient, now time.Time) []alerting.Alert {
+	alertEvaluationMu.Lock()
+	defer alertEvaluationMu.Unlock()
+	observations, unobserved := collectAlertObservations(ctx, admin, client, now)
+	changes := engine.Evaluate(now, observations, unobserved)
+	alerting.Notify(sinks, changes, func(sink alerting.Sink, alert alerting.Alert, err error) {
+		LogErrorContext(ctx, "unable to notify %s of the alert %s: %v", sink, alert.Message(), err)
+	})
+	return changes
+}
+
+// startAlertEvaluation evaluates the rules in the background with the alerting credentials, when they are
+// configured, and returns a function stopping it
+func startAlertEvaluation() func() {
+	accessKey, secretKey := getConsoleAlertingCredentials()
+	if accessKey == "" {
+		return func() {}
+	}
+	session := &models.Principal{STSAccessKeyID: accessKey, STSSecretAccessKey: secretKey}
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(getConsoleAlertingInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				mAdmin, err := NewMinioAdminClient(session)
+				if err != nil {
+					LogError("unable to evaluate the alert rules: %v", err)
+					continue
+				}
+				mClient, err := newMinioClient(session)
+				if err != nil {
+					LogError("unable to evaluate the alert rules: %v", err)
+					continue
+				}
+				ctx, cancel := context.WithTimeout(context.Background(), alertEvaluationTimeout)
+				evaluateAlerts(ctx, alertingEngine(), alertSinks(), AdminClient{Client: mAdmin}, minioClient{client: mClient}, time.Now())
+				cancel()
+			}
+		}
+	}()
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+func getEvaluateAlertsResponse(session *models.Principal, params systemApi.EvaluateAlertsParams) (*models.AlertsState, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	engine, sinks := alertingEngine(), alertSinks()
+	evaluateAlerts(ctx, engine, sinks, AdminClient{Client: mAdmin}, minioClient{client: mClient}, time.Now())
+	return alertsStateResponse(engine.State(), sinks), nil
+}
+
+func getSetAlertRuleResponse(params systemApi.SetAlertRuleParams) (*models.AlertRule, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	rule, err := setAlertRule(alertingEngine(), params.ID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getDeleteAlertRuleResponse(params systemApi.DeleteAlertRuleParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := alertingEngine().DeleteRule(params.ID); err != nil {
+		return ErrorWithContext(ctx, alertRuleError(err))
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/alerting"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectAlertObservations(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	minClient := minioClientMock{}
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	defaultLicenseExpiry := licenseExpiry
+	defer func() { licenseExpiry = defaultLicenseExpiry }()
+	licenseExpiry = func(ctx context.Context, client MinioAdmin) (time.Time, error) {
+		return now.Add(20*24*time.Hour + time.Hour), nil
+	}
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+			{
+				Endpoint: "node1:9000",
+				State:    "online",
+				Disks: []madmin.Disk{
+					{DrivePath: "/data1", TotalSpace: 1000, UsedSpace: 900},
+					{DrivePath: "/data2", TotalSpace: 0},
+				},
+			},
+			{Endpoint: "node2:9000", State: "offline"},
+		}}, nil
+	}
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+			{Name: "images", Details: &madmin.BucketDetails{Replication: true}},
+			{Name: "logs"},
+		}}, nil
+	}
+	minioGetReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{PendingCount: 12000}, nil
+	}
+
+	observations, unobserved := collectAlertObservations(ctx, adminClient, minClient, now)
+	assert.Empty(unobserved)
+	assert.Equal([]alerting.Observation{
+		{Kind: alerting.KindLicenseExpiring, Subject: "license", Value: 20},
+		{Kind: alerting.KindNodeOffline, Subject: "node1:9000", Value: 0},
+		{Kind: alerting.KindDriveUsage, Subject: "node1:9000/data1", Value: 90},
+		{Kind: alerting.KindNodeOffline, Subject: "node2:9000", Value: 1},
+		{Kind: alerting.KindReplicationBacklog, Subject: "images", Value: 12000},
+	}, observations)
+
+	// the kinds that can't be observed are reported instead
+	licenseExpiry = func(ctx context.Context, client MinioAdmin) (time.Time, error) {
+		return time.Time{}, nil
+	}
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("server unreachable")
+	}
+	minioGetReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{}, errors.New("access denied")
+	}
+	observations, unobserved = collectAlertObservations(ctx, adminClient, minClient, now)
+	assert.Empty(observations)
+	assert.Equal(map[string]string{
+		alerting.KindDriveUsage:         "server unreachable",
+		alerting.KindNodeOffline:        "server unreachable",
+		alerting.KindReplicationBacklog: "images: access denied",
+	}, unobserved)
+}
+
+func TestEvaluateAlerts(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	minClient := minioClientMock{}
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	var mu sync.Mutex
+	var received []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		assert.NoError(json.NewDecoder(r.Body).Decode(&payload))
+		mu.Lock()
+		received = append(received, payload)
+		mu.Unlock()
+	}))
+	defer server.Close()
+	sinks := []alerting.Sink{alerting.NewWebhookSink(server.URL, "", http.DefaultClient)}
+
+	defaultLicenseExpiry := licenseExpiry
+	defer func() { licenseExpiry = defaultLicenseExpiry }()
+	licenseExpiry = func(ctx context.Context, client MinioAdmin) (time.Time, error) {
+		return time.Time{}, nil
+	}
+	state := "offline"
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{{Endpoint: "node1:9000", State: state}}}, nil
+	}
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{}, nil
+	}
+
+	engine, err := alerting.New("")
+	assert.NoError(err)
+	changes := evaluateAlerts(ctx, engine, sinks, adminClient, minClient, now)
+	if assert.Len(changes, 1) {
+		assert.Equal("node-offline", changes[0].RuleID)
+		assert.Equal(alerting.StateFiring, changes[0].State)
+	}
+	if assert.Len(received, 1) {
+		assert.Equal("node1:9000", received[0]["subject"])
+		assert.Equal("firing", received[0]["state"])
+	}
+
+	response := alertsStateResponse(engine.State(), sinks)
+	assert.Equal("2023-06-01T12:00:00Z", response.LastEvaluation)
+	assert.Equal([]string{"webhook " + server.URL}, response.Sinks)
+	if assert.Len(response.Firing, 1) {
+		assert.Equal("[firing] Node offline on node1:9000: 1 offline, threshold 0", response.Firing[0].Message)
+		assert.Empty(response.Firing[0].ResolvedAt)
+	}
+
+	// a firing alert isn't sent again until it resolves
+	assert.Empty(evaluateAlerts(ctx, engine, sinks, adminClient, minClient, now.Add(time.Minute)))
+	state = "online"
+	changes = evaluateAlerts(ctx, engine, sinks, adminClient, minClient, now.Add(2*time.Minute))
+	if assert.Len(changes, 1) {
+		assert.Equal(alerting.StateResolved, changes[0].State)
+	}
+	assert.Len(received, 2)
+	response = alertsStateResponse(engine.State(), sinks)
+	assert.Empty(response.Firing)
+	if assert.Len(response.Resolved, 1) {
+		assert.Equal("2023-06-01T12:02:00Z", response.Resolved[0].ResolvedAt)
+	}
+}
+
+func TestSetAlertRule(t *testing.T) {
+	assert := assert.New(t)
+	engine, err := alerting.New("")
+	assert.NoError(err)
+
+	rule, err := setAlertRule(engine, "drive-usage-critical", &models.AlertRule{
+		Name:      swag.String("Drive usage above 95%"),
+		Kind:      swag.String(alerting.KindDriveUsage),
+		Threshold: swag.Float64(95),
+		Enabled:   true,
+	})
+	assert.NoError(err)
+	assert.Equal("drive-usage-critical", rule.ID)
+	assert.Len(listAlertRules(engine).Rules, len(alerting.DefaultRules)+1)
+
+	_, err = setAlertRule(engine, "drive-usage", &models.AlertRule{
+		ID:        "other",
+		Name:      swag.String("Drive usage"),
+		Kind:      swag.String(alerting.KindDriveUsage),
+		Threshold: swag.Float64(90),
+	})
+	assert.ErrorIs(err, ErrInvalidAlertRule)
+
+	_, err = setAlertRule(engine, "drive-usage", &models.AlertRule{
+		Name:      swag.String("Drive usage"),
+		Kind:      swag.String(alerting.KindDriveUsage),
+		Threshold: swag.Float64(-1),
+	})
+	assert.ErrorIs(err, ErrInvalidAlertRule)
+	assert.Equal("invalid alert rule: the threshold has to be a positive number", err.Error())
+
+	assert.NoError(engine.DeleteRule("drive-usage-critical"))
+	assert.ErrorIs(alertRuleError(engine.DeleteRule("drive-usage-critical")), ErrAlertRuleNotFound)
+}
@@ -0,0 +1,577 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	batchApi "github.com/minio/console/restapi/operations/batch_jobs"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/websocket"
+	"gopkg.in/yaml.v3"
+)
+
+// types of batch jobs
+const (
+	batchJobTypeReplicate = "replicate"
+	batchJobTypeKeyRotate = "keyrotate"
+	batchJobTypeExpire    = "expire"
+)
+
+// default and minimum interval between the updates of a batch job progress stream
+const (
+	defaultBatchJobStreamInterval = 2 * time.Second
+	minBatchJobStreamInterval     = 500 * time.Millisecond
+)
+
+// batchJobAPIVersion is the version of the batch job definitions generated by console
+const batchJobAPIVersion = "v1"
+
+type batchJobProgressOptions struct {
+	JobID    string
+	Interval time.Duration
+}
+
+// YAML documents of the batch jobs, following the templates of `mc batch generate`
+
+type batchJobYAML struct {
+	Replicate *batchJobReplicateYAML `yaml:"replicate,omitempty"`
+	KeyRotate *batchJobKeyRotateYAML `yaml:"keyrotate,omitempty"`
+	Expire    *batchJobExpireYAML    `yaml:"expire,omitempty"`
+}
+
+type batchJobFilterYAML struct {
+	NewerThan     string `yaml:"newerThan,omitempty"`
+	OlderThan     string `yaml:"olderThan,omitempty"`
+	CreatedAfter  string `yaml:"createdAfter,omitempty"`
+	CreatedBefore string `yaml:"createdBefore,omitempty"`
+}
+
+type batchJobNotifyYAML struct {
+	Endpoint string `yaml:"endpoint,omitempty"`
+	Token    string `yaml:"token,omitempty"`
+}
+
+type batchJobRetryYAML struct {
+	Attempts int64  `yaml:"attempts,omitempty"`
+	Delay    string `yaml:"delay,omitempty"`
+}
+
+type batchJobFlagsYAML struct {
+	Filter *batchJobFilterYAML `yaml:"filter,omitempty"`
+	Notify *batchJobNotifyYAML `yaml:"notify,omitempty"`
+	Retry  *batchJobRetryYAML  `yaml:"retry,omitempty"`
+}
+
+type batchJobSourceYAML struct {
+	Type   string `yaml:"type"`
+	Bucket string `yaml:"bucket"`
+	Prefix string `yaml:"prefix,omitempty"`
+}
+
+type batchJobTargetYAML struct {
+	Type        string                  `yaml:"type"`
+	Bucket      string                  `yaml:"bucket"`
+	Prefix      string                  `yaml:"prefix,omitempty"`
+	Endpoint    string                  `yaml:"endpoint"`
+	Path        string                  `yaml:"path"`
+	Credentials batchJobCredentialsYAML `yaml:"credentials"`
+}
+
+type batchJobCredentialsYAML struct {
+	AccessKey string `yaml:"accessKey"`
+	SecretKey string `yaml:"secretKey"`
+}
+
+type batchJobReplicateYAML struct {
+	APIVersion string             `yaml:"apiVersion"`
+	Source     batchJobSourceYAML `yaml:"source"`
+	Target     batchJobTargetYAML `yaml:"target"`
+	Flags      *batchJobFlagsYAML `yaml:"flags,omitempty"`
+}
+
+type batchJobEncryptionYAML struct {
+	Type    string `yaml:"type"`
+	Key     string `yaml:"key,omitempty"`
+	Context string `yaml:"context,omitempty"`
+}
+
+type batchJobKeyRotateYAML struct {
+	APIVersion string                 `yaml:"apiVersion"`
+	Bucket     string                 `yaml:"bucket"`
+	Prefix     string                 `yaml:"prefix,omitempty"`
+	Encryption batchJobEncryptionYAML `yaml:"encryption"`
+	Flags      *batchJobFlagsYAML     `yaml:"flags,omitempty"`
+}
+
+type batchJobPurgeYAML struct {
+	RetainVersions int64 `yaml:"retainVersions"`
+}
+
+type batchJobExpireRuleYAML struct {
+	Type          string             `yaml:"type"`
+	OlderThan     string             `yaml:"olderThan,omitempty"`
+	CreatedBefore string             `yaml:"createdBefore,omitempty"`
+	Purge         *batchJobPurgeYAML `yaml:"purge,omitempty"`
+}
+
+type batchJobExpireYAML struct {
+	APIVersion string                   `yaml:"apiVersion"`
+	Bucket     string                   `yaml:"bucket"`
+	Prefix     string                   `yaml:"prefix,omitempty"`
+	Rules      []batchJobExpireRuleYAML `yaml:"rules"`
+	Notify     *batchJobNotifyYAML      `yaml:"notify,omitempty"`
+	Retry      *batchJobRetryYAML       `yaml:"retry,omitempty"`
+}
+
+func registerBatchJobHandlers(api *operations.ConsoleAPI) {
+	// generate the definition of a batch job
+	api.BatchJobsGenerateBatchJobHandler = batchApi.GenerateBatchJobHandlerFunc(func(params batchApi.GenerateBatchJobParams, session *models.Principal) middleware.Responder {
+		definition, err := getGenerateBatchJobResponse(params)
+		if err != nil {
+			return batchApi.NewGenerateBatchJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return batchApi.NewGenerateBatchJobOK().WithPayload(definition)
+	})
+	// start a batch job
+	api.BatchJobsStartBatchJobHandler = batchApi.StartBatchJobHandlerFunc(func(params batchApi.StartBatchJobParams, session *models.Principal) middleware.Responder {
+		job, err := getStartBatchJobResponse(session, params)
+		if err != nil {
+			return batchApi.NewStartBatchJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return batchApi.NewStartBatchJobCreated().WithPayload(job)
+	})
+	// list the batch jobs
+	api.BatchJobsListBatchJobsHandler = batchApi.ListBatchJobsHandlerFunc(func(params batchApi.ListBatchJobsParams, session *models.Principal) middleware.Responder {
+		jobs, err := getListBatchJobsResponse(session, params)
+		if err != nil {
+			return batchApi.NewListBatchJobsDefault(int(err.Code)).WithPayload(err)
+		}
+		return batchApi.NewListBatchJobsOK().WithPayload(jobs)
+	})
+	// describe a batch job and its progress
+	api.BatchJobsDescribeBatchJobHandler = batchApi.DescribeBatchJobHandlerFunc(func(params batchApi.DescribeBatchJobParams, session *models.Principal) middleware.Responder {
+		details, err := getDescribeBatchJobResponse(session, params)
+		if err != nil {
+			return batchApi.NewDescribeBatchJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return batchApi.NewDescribeBatchJobOK().WithPayload(details)
+	})
+	// cancel a running batch job
+	api.BatchJobsCancelBatchJobHandler = batchApi.CancelBatchJobHandlerFunc(func(params batchApi.CancelBatchJobParams, session *models.Principal) middleware.Responder {
+		if err := getCancelBatchJobResponse(session, params); err != nil {
+			return batchApi.NewCancelBatchJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return batchApi.NewCancelBatchJobNoContent()
+	})
+}
+
+// batchJobFlags returns the filter, notification and retry flags of a batch job, nil when none is set
+func batchJobFlags(req *models.BatchJobRequest) *batchJobFlagsYAML {
+	flags := &batchJobFlagsYAML{Notify: batchJobNotify(req), Retry: batchJobRetry(req)}
+	if f := req.Filter; f != nil && (f.NewerThan != "" || f.OlderThan != "" || f.CreatedAfter != "" || f.CreatedBefore != "") {
+		flags.Filter = &batchJobFilterYAML{
+			NewerThan:     f.NewerThan,
+			OlderThan:     f.OlderThan,
+			CreatedAfter:  f.CreatedAfter,
+			CreatedBefore: f.CreatedBefore,
+		}
+	}
+	if flags.Filter == nil && flags.Notify == nil && flags.Retry == nil {
+		return nil
+	}
+	return flags
+}
+
+func batchJobNotify(req *models.BatchJobRequest) *batchJobNotifyYAML {
+	if req.NotifyEndpoint == "" {
+		return nil
+	}
+	return &batchJobNotifyYAML{Endpoint: req.NotifyEndpoint, Token: req.NotifyToken}
+}
+
+func batchJobRetry(req *models.BatchJobRequest) *batchJobRetryYAML {
+	if req.RetryAttempts == 0 && req.RetryDelay == "" {
+		return nil
+	}
+	return &batchJobRetryYAML{Attempts: req.RetryAttempts, Delay: req.RetryDelay}
+}
+
+// validateBatchJobRequest checks a batch job has what its type requires
+func validateBatchJobRequest(req *models.BatchJobRequest) error {
+	if req == nil || req.Type == nil || req.Bucket == nil || strings.TrimSpace(*req.Bucket) == "" {
+		return fmt.Errorf("%w: a type and a bucket are required", ErrInvalidBatchJob)
+	}
+	if req.RetryAttempts < 0 {
+		return fmt.Errorf("%w: the retry attempts can't be negative", ErrInvalidBatchJob)
+	}
+	if req.RetryDelay != "" {
+		if _, err := time.ParseDuration(req.RetryDelay); err != nil {
+			return fmt.Errorf("%w: invalid retry delay %q", ErrInvalidBatchJob, req.RetryDelay)
+		}
+	}
+	if req.NotifyEndpoint != "" {
+		if u, err := url.Parse(req.NotifyEndpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("%w: invalid notification endpoint %q", ErrInvalidBatchJob, req.NotifyEndpoint)
+		}
+	}
+	switch *req.Type {
+	case batchJobTypeReplicate:
+		target := req.Replicate
+		if target == nil || target.Endpoint == nil || target.Bucket == nil || target.AccessKey == nil || target.SecretKey == nil {
+			return fmt.Errorf("%w: a replicate job requires a target endpoint, bucket and credentials", ErrInvalidBatchJob)
+		}
+		if u, err := url.Parse(*target.Endpoint); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("%w: invalid target endpoint %q", ErrInvalidBatchJob, *target.Endpoint)
+		}
+	case batchJobTypeKeyRotate:
+		rotation := req.KeyRotation
+		if rotation == nil || rotation.Type == nil {
+			return fmt.Errorf("%w: a keyrotate job requires an encryption type", ErrInvalidBatchJob)
+		}
+		if *rotation.Type == "sse-kms" && rotation.Key == "" {
+			return fmt.Errorf("%w: rotating to SSE-KMS requires a key", ErrInvalidBatchJob)
+		}
+	case batchJobTypeExpire:
+		// an expire job without a condition would delete the whole prefix
+		expiration := req.Expiration
+		if expiration == nil || (expiration.OlderThan == "" && expiration.CreatedBefore == "") {
+			return fmt.Errorf("%w: an expire job requires an age or a creation date", ErrInvalidBatchJob)
+		}
+		if expiration.RetainVersions < 0 {
+			return fmt.Errorf("%w: the versions to retain can't be negative", ErrInvalidBatchJob)
+		}
+	default:
+		return fmt.Errorf("%w: unknown job type %q", ErrInvalidBatchJob, *req.Type)
+	}
+	return nil
+}
+
+// generateBatchJob builds the YAML definition MinIO expects for a batch job
+func generateBatchJob(req *models.BatchJobRequest) (string, error) {
+	if err := validateBatchJobRequest(req); err != nil {
+		return "", err
+	}
+	var job batchJobYAML
+	switch *req.Type {
+	case batchJobTypeReplicate:
+		path := req.Replicate.Path
+		if path == "" {
+			path = "auto"
+		}
+		job.Replicate = &batchJobReplicateYAML{
+			APIVersion: batchJobAPIVersion,
+			Source:     batchJobSourceYAML{Type: "minio", Bucket: *req.Bucket, Prefix: req.Prefix},
+			Target: batchJobTargetYAML{
+				Type:     "minio",
+				Bucket:   *req.Replicate.Bucket,
+				Prefix:   req.Replicate.Prefix,
+				Endpoint: *req.Replicate.Endpoint,
+				Path:     path,
+				Credentials: batchJobCredentialsYAML{
+					AccessKey: *req.Replicate.AccessKey,
+					SecretKey: *req.Replicate.SecretKey,
+				},
+			},
+			Flags: batchJobFlags(req),
+		}
+	case batchJobTypeKeyRotate:
+		job.KeyRotate = &batchJobKeyRotateYAML{
+			APIVersion: batchJobAPIVersion,
+			Bucket:     *req.Bucket,
+			Prefix:     req.Prefix,
+			Encryption: batchJobEncryptionYAML{
+				Type:    *req.KeyRotation.Type,
+				Key:     req.KeyRotation.Key,
+				Context: req.KeyRotation.Context,
+			},
+			Flags: batchJobFlags(req),
+		}
+	case batchJobTypeExpire:
+		rule := batchJobExpireRuleYAML{
+			Type:          req.Expiration.ObjectType,
+			OlderThan:     req.Expiration.OlderThan,
+			CreatedBefore: req.Expiration.CreatedBefore,
+		}
+		if rule.Type == "" {
+			rule.Type = "object"
+		}
+		if req.Expiration.RetainVersions > 0 {
+			rule.Purge = &batchJobPurgeYAML{RetainVersions: req.Expiration.RetainVersions}
+		}
+		job.Expire = &batchJobExpireYAML{
+			APIVersion: batchJobAPIVersion,
+			Bucket:     *req.Bucket,
+			Prefix:     req.Prefix,
+			Rules:      []batchJobExpireRuleYAML{rule},
+			Notify:     batchJobNotify(req),
+			Retry:      batchJobRetry(req),
+		}
+	}
+	var out strings.Builder
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(job); err != nil {
+		return "", err
+	}
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+func getGenerateBatchJobResponse(params batchApi.GenerateBatchJobParams) (*models.BatchJobDefinition, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	definition, err := generateBatchJob(params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.BatchJobDefinition{Type: *params.Body.Type, Yaml: definition}, nil
+}
+
+// batchJobToModel converts a job reported by MinIO
+func batchJobToModel(job madmin.BatchJobResult) *models.BatchJob {
+	started := ""
+	if !job.Started.IsZero() {
+		started = job.Started.Format(time.RFC3339)
+	}
+	return &models.BatchJob{
+		ID:             job.ID,
+		Type:           string(job.Type),
+		User:           job.User,
+		Started:        started,
+		ElapsedSeconds: int64(job.Elapsed.Seconds()),
+	}
+}
+
+// startBatchJob starts a job from its YAML definition or from the structured input it is generated from
+func startBatchJob(ctx context.Context, client MinioAdmin, req *models.StartBatchJobRequest) (*models.BatchJob, error) {
+	if req == nil || (req.Yaml == "") == (req.Job == nil) {
+		return nil, fmt.Errorf("%w: either a YAML definition or a job is required", ErrInvalidBatchJob)
+	}
+	definition := req.Yaml
+	if req.Job != nil {
+		var err error
+		if definition, err = generateBatchJob(req.Job); err != nil {
+			return nil, err
+		}
+	}
+	result, err := client.startBatchJob(ctx, definition)
+	if err != nil {
+		return nil, err
+	}
+	return batchJobToModel(result), nil
+}
+
+func getStartBatchJobResponse(session *models.Principal, params batchApi.StartBatchJobParams) (*models.BatchJob, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	job, err := startBatchJob(ctx, AdminClient{Client: mAdmin}, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return job, nil
+}
+
+func listBatchJobs(ctx context.Context, client MinioAdmin, jobType string) (*models.BatchJobList, error) {
+	result, err := client.listBatchJobs(ctx, jobType)
+	if err != nil {
+		return nil, err
+	}
+	jobs := &models.BatchJobList{Jobs: []*models.BatchJob{}}
+	for _, job := range result.Jobs {
+		jobs.Jobs = append(jobs.Jobs, batchJobToModel(job))
+	}
+	return jobs, nil
+}
+
+func getListBatchJobsResponse(session *models.Principal, params batchApi.ListBatchJobsParams) (*models.BatchJobList, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	jobType := ""
+	if params.Type != nil {
+		jobType = *params.Type
+	}
+	jobs, err := listBatchJobs(ctx, AdminClient{Client: mAdmin}, jobType)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return jobs, nil
+}
+
+// batchJobStatusToModel converts the progress of a job reported by the MinIO metrics
+func batchJobStatusToModel(job *madmin.JobMetric) *models.BatchJobStatus {
+	status := &models.BatchJobStatus{
+		ID:            job.JobID,
+		Type:          job.JobType,
+		Complete:      job.Complete,
+		Failed:        job.Failed,
+		RetryAttempts: int64(job.RetryAttempts),
+	}
+	if !job.StartTime.IsZero() {
+		status.Started = job.StartTime.Format(time.RFC3339)
+	}
+	if !job.LastUpdate.IsZero() {
+		status.LastUpdate = job.LastUpdate.Format(time.RFC3339)
+	}
+	if job.Replicate != nil {
+		status.Objects = job.Replicate.Objects
+		status.ObjectsFailed = job.Replicate.ObjectsFailed
+		status.BytesTransferred = job.Replicate.BytesTransferred
+		status.BytesFailed = job.Replicate.BytesFailed
+		status.LastBucket = job.Replicate.Bucket
+		status.LastObject = job.Replicate.Object
+	}
+	if job.KeyRotate != nil {
+		status.Objects = job.KeyRotate.Objects
+		status.ObjectsFailed = job.KeyRotate.ObjectsFailed
+		status.LastBucket = job.KeyRotate.Bucket
+		status.LastObject = job.KeyRotate.Object
+	}
+	return status
+}
+
+// describeBatchJob returns the definition of a job and, while MinIO reports it, its progress
+func describeBatchJob(ctx context.Context, client MinioAdmin, jobID string) (*models.BatchJobDetails, error) {
+	definition, err := client.describeBatchJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	details := &models.BatchJobDetails{ID: jobID, Yaml: definition}
+	metrics, err := client.batchJobMetrics(ctx, jobID)
+	if err != nil {
+		// the definition is still useful without the progress
+		LogErrorContext(ctx, "unable to get the progress of the batch job %s: %v", jobID, err)
+		return details, nil
+	}
+	if metrics != nil {
+		details.Status = batchJobStatusToModel(metrics)
+	}
+	return details, nil
+}
+
+func getDescribeBatchJobResponse(session *models.Principal, params batchApi.DescribeBatchJobParams) (*models.BatchJobDetails, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	details, err := describeBatchJob(ctx, AdminClient{Client: mAdmin}, params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return details, nil
+}
+
+// cancelBatchJob cancels a running job, madmin doesn't expose the call in this release
+func cancelBatchJob(ctx context.Context, httpClient *http.Client, endpoint string, session *models.Principal, jobID string) error {
+	_, err := signedAdminRequest(ctx, httpClient, endpoint, getConsoleCredentialsFromSession(session), GetMinIORegion(),
+		http.MethodDelete, "/cancel-job", url.Values{"id": {jobID}}, nil)
+	return err
+}
+
+func getCancelBatchJobResponse(session *models.Principal, params batchApi.CancelBatchJobParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if strings.TrimSpace(params.ID) == "" {
+		return ErrorWithContext(ctx, fmt.Errorf("%w: a job id is required", ErrInvalidBatchJob))
+	}
+	minioURL, err := sessionMinIOServer(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err := cancelBatchJob(ctx, GetConsoleHTTPClient(minioURL), minioURL, session, params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// getBatchJobProgressOptionsFromReq parses requests like /batch-jobs/<id>?interval=<seconds>
+func getBatchJobProgressOptionsFromReq(req *http.Request) (*batchJobProgressOptions, error) {
+	re := regexp.MustCompile(`(/batch-jobs/)(.*?$)`)
+	matches := re.FindStringSubmatch(req.URL.Path)
+	if len(matches) < 3 || strings.TrimSpace(matches[2]) == "" {
+		return nil, fmt.Errorf("invalid url: %s", req.URL.Path)
+	}
+	opts := &batchJobProgressOptions{
+		JobID:    strings.TrimSpace(matches[2]),
+		Interval: defaultBatchJobStreamInterval,
+	}
+	if interval := req.FormValue("interval"); interval != "" {
+		seconds, err := strconv.ParseFloat(interval, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval: %s", interval)
+		}
+		opts.Interval = time.Duration(seconds * float64(time.Second))
+		if opts.Interval < minBatchJobStreamInterval {
+			opts.Interval = minBatchJobStreamInterval
+		}
+	}
+	return opts, nil
+}
+
+// startBatchJobProgressStream sends the progress of a batch job until it completes or fails
+func startBatchJobProgressStream(ctx context.Context, conn WSConn, client MinioAdmin, opts *batchJobProgressOptions) error {
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		metrics, err := client.batchJobMetrics(ctx, opts.JobID)
+		if err != nil {
+			return err
+		}
+		if metrics == nil {
+			return fmt.Errorf("%w: %s", ErrBatchJobNotFound, opts.JobID)
+		}
+		status := batchJobStatusToModel(metrics)
+		message, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		if err = conn.writeMessage(websocket.TextMessage, message); err != nil {
+			return err
+		}
+		if status.Complete || status.Failed {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
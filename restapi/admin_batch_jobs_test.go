@@ -0,0 +1,294 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestGenerateBatchJob(t *testing.T) {
+	assert := assert.New(t)
+
+	definition, err := generateBatchJob(&models.BatchJobRequest{
+		Type:   swag.String(batchJobTypeReplicate),
+		Bucket: swag.String("source"),
+		Prefix: "2023/",
+		Replicate: &models.BatchJobReplicateTarget{
+			Endpoint:  swag.String("https://dr.example.com:9000"),
+			Bucket:    swag.String("target"),
+			AccessKey: swag.String("access"),
+			SecretKey: swag.String("secret"),
+		},
+		Filter:        &models.BatchJobFilter{OlderThan: "30d"},
+		RetryAttempts: 5,
+	})
+	assert.NoError(err)
+	var job batchJobYAML
+	assert.NoError(yaml.Unmarshal([]byte(definition), &job))
+	assert.NotNil(job.Replicate)
+	assert.Equal("v1", job.Replicate.APIVersion)
+	assert.Equal("source", job.Replicate.Source.Bucket)
+	assert.Equal("2023/", job.Replicate.Source.Prefix)
+	assert.Equal("https://dr.example.com:9000", job.Replicate.Target.Endpoint)
+	assert.Equal("auto", job.Replicate.Target.Path)
+	assert.Equal("secret", job.Replicate.Target.Credentials.SecretKey)
+	assert.Equal("30d", job.Replicate.Flags.Filter.OlderThan)
+	assert.Equal(int64(5), job.Replicate.Flags.Retry.Attempts)
+	assert.Nil(job.Replicate.Flags.Notify)
+
+	definition, err = generateBatchJob(&models.BatchJobRequest{
+		Type:        swag.String(batchJobTypeKeyRotate),
+		Bucket:      swag.String("data"),
+		KeyRotation: &models.BatchJobKeyRotation{Type: swag.String("sse-kms"), Key: "new-key"},
+	})
+	assert.NoError(err)
+	job = batchJobYAML{}
+	assert.NoError(yaml.Unmarshal([]byte(definition), &job))
+	assert.NotNil(job.KeyRotate)
+	assert.Equal("sse-kms", job.KeyRotate.Encryption.Type)
+	assert.Equal("new-key", job.KeyRotate.Encryption.Key)
+	assert.Nil(job.KeyRotate.Flags)
+
+	definition, err = generateBatchJob(&models.BatchJobRequest{
+		Type:           swag.String(batchJobTypeExpire),
+		Bucket:         swag.String("logs"),
+		Expiration:     &models.BatchJobExpiration{OlderThan: "90d", RetainVersions: 2},
+		NotifyEndpoint: "https://hooks.example.com/batch",
+	})
+	assert.NoError(err)
+	job = batchJobYAML{}
+	assert.NoError(yaml.Unmarshal([]byte(definition), &job))
+	assert.NotNil(job.Expire)
+	assert.Len(job.Expire.Rules, 1)
+	assert.Equal("object", job.Expire.Rules[0].Type)
+	assert.Equal("90d", job.Expire.Rules[0].OlderThan)
+	assert.Equal(int64(2), job.Expire.Rules[0].Purge.RetainVersions)
+	assert.Equal("https://hooks.example.com/batch", job.Expire.Notify.Endpoint)
+}
+
+func TestGenerateBatchJobValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *models.BatchJobRequest
+	}{
+		{
+			name: "no bucket",
+			req:  &models.BatchJobRequest{Type: swag.String(batchJobTypeExpire), Bucket: swag.String(" ")},
+		},
+		{
+			name: "unknown type",
+			req:  &models.BatchJobRequest{Type: swag.String("copy"), Bucket: swag.String("data")},
+		},
+		{
+			name: "replicate without a target",
+			req:  &models.BatchJobRequest{Type: swag.String(batchJobTypeReplicate), Bucket: swag.String("data")},
+		},
+		{
+			name: "replicate to an invalid endpoint",
+			req: &models.BatchJobRequest{
+				Type:   swag.String(batchJobTypeReplicate),
+				Bucket: swag.String("data"),
+				Replicate: &models.BatchJobReplicateTarget{
+					Endpoint:  swag.String("dr.example.com"),
+					Bucket:    swag.String("target"),
+					AccessKey: swag.String("access"),
+					SecretKey: swag.String("secret"),
+				},
+			},
+		},
+		{
+			name: "key rotation to SSE-KMS without a key",
+			req: &models.BatchJobRequest{
+				Type:        swag.String(batchJobTypeKeyRotate),
+				Bucket:      swag.String("data"),
+				KeyRotation: &models.BatchJobKeyRotation{Type: swag.String("sse-kms")},
+			},
+		},
+		{
+			name: "expiration without a condition",
+			req: &models.BatchJobRequest{
+				Type:       swag.String(batchJobTypeExpire),
+				Bucket:     swag.String("data"),
+				Expiration: &models.BatchJobExpiration{},
+			},
+		},
+		{
+			name: "invalid retry delay",
+			req: &models.BatchJobRequest{
+				Type:       swag.String(batchJobTypeExpire),
+				Bucket:     swag.String("data"),
+				Expiration: &models.BatchJobExpiration{OlderThan: "7d"},
+				RetryDelay: "soon",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generateBatchJob(tt.req)
+			assert.ErrorIs(t, err, ErrInvalidBatchJob)
+		})
+	}
+}
+
+func TestStartBatchJob(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	var started string
+	minioStartBatchJobMock = func(ctx context.Context, job string) (madmin.BatchJobResult, error) {
+		started = job
+		return madmin.BatchJobResult{
+			ID:      "job-1",
+			Type:    madmin.BatchJobReplicate,
+			User:    "admin",
+			Started: time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		}, nil
+	}
+
+	job, err := startBatchJob(ctx, adminClient, &models.StartBatchJobRequest{Yaml: "replicate:\n  apiVersion: v1\n"})
+	assert.NoError(err)
+	assert.Equal("replicate:\n  apiVersion: v1\n", started)
+	assert.Equal("job-1", job.ID)
+	assert.Equal("replicate", job.Type)
+	assert.Equal("2023-05-01T10:00:00Z", job.Started)
+
+	_, err = startBatchJob(ctx, adminClient, &models.StartBatchJobRequest{Job: &models.BatchJobRequest{
+		Type:        swag.String(batchJobTypeKeyRotate),
+		Bucket:      swag.String("data"),
+		KeyRotation: &models.BatchJobKeyRotation{Type: swag.String("sse-s3")},
+	}})
+	assert.NoError(err)
+	assert.Contains(started, "keyrotate:")
+
+	// either the YAML or the job is required, not both
+	_, err = startBatchJob(ctx, adminClient, &models.StartBatchJobRequest{})
+	assert.ErrorIs(err, ErrInvalidBatchJob)
+	_, err = startBatchJob(ctx, adminClient, &models.StartBatchJobRequest{Yaml: "expire:", Job: &models.BatchJobRequest{}})
+	assert.ErrorIs(err, ErrInvalidBatchJob)
+
+	minioStartBatchJobMock = func(ctx context.Context, job string) (madmin.BatchJobResult, error) {
+		return madmin.BatchJobResult{}, errors.New("invalid batch job definition")
+	}
+	_, err = startBatchJob(ctx, adminClient, &models.StartBatchJobRequest{Yaml: "replicate:"})
+	assert.EqualError(err, "invalid batch job definition")
+}
+
+func TestListAndDescribeBatchJobs(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	minioListBatchJobsMock = func(ctx context.Context, jobType string) (madmin.ListBatchJobsResult, error) {
+		assert.Equal("expire", jobType)
+		return madmin.ListBatchJobsResult{Jobs: []madmin.BatchJobResult{{ID: "job-1", Elapsed: 90 * time.Second}}}, nil
+	}
+	jobs, err := listBatchJobs(ctx, adminClient, "expire")
+	assert.NoError(err)
+	assert.Len(jobs.Jobs, 1)
+	assert.Equal(int64(90), jobs.Jobs[0].ElapsedSeconds)
+
+	minioDescribeBatchJobMock = func(ctx context.Context, jobID string) (string, error) {
+		return "replicate:\n  apiVersion: v1\n", nil
+	}
+	minioBatchJobMetricsMock = func(ctx context.Context, jobID string) (*madmin.JobMetric, error) {
+		return &madmin.JobMetric{
+			JobID:     jobID,
+			JobType:   "replicate",
+			Replicate: &madmin.ReplicateInfo{Objects: 10, ObjectsFailed: 1, Object: "a.txt"},
+		}, nil
+	}
+	details, err := describeBatchJob(ctx, adminClient, "job-1")
+	assert.NoError(err)
+	assert.Equal("replicate:\n  apiVersion: v1\n", details.Yaml)
+	assert.Equal(int64(10), details.Status.Objects)
+	assert.Equal("a.txt", details.Status.LastObject)
+
+	// finished jobs are no longer reported by the metrics
+	minioBatchJobMetricsMock = func(ctx context.Context, jobID string) (*madmin.JobMetric, error) {
+		return nil, nil
+	}
+	details, err = describeBatchJob(ctx, adminClient, "job-1")
+	assert.NoError(err)
+	assert.Nil(details.Status)
+}
+
+func TestStartBatchJobProgressStream(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	mockWSConn := mockConn{}
+
+	calls := 0
+	minioBatchJobMetricsMock = func(ctx context.Context, jobID string) (*madmin.JobMetric, error) {
+		calls++
+		return &madmin.JobMetric{
+			JobID:     jobID,
+			Complete:  calls == 3,
+			KeyRotate: &madmin.KeyRotationInfo{Objects: int64(calls)},
+		}, nil
+	}
+	var messages []models.BatchJobStatus
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var status models.BatchJobStatus
+		assert.NoError(json.Unmarshal(data, &status))
+		messages = append(messages, status)
+		return nil
+	}
+	err := startBatchJobProgressStream(ctx, mockWSConn, adminClient, &batchJobProgressOptions{JobID: "job-1", Interval: time.Millisecond})
+	assert.NoError(err)
+	assert.Len(messages, 3)
+	assert.True(messages[2].Complete)
+	assert.Equal(int64(3), messages[2].Objects)
+
+	// unknown jobs end the stream with an error
+	minioBatchJobMetricsMock = func(ctx context.Context, jobID string) (*madmin.JobMetric, error) {
+		return nil, nil
+	}
+	err = startBatchJobProgressStream(ctx, mockWSConn, adminClient, &batchJobProgressOptions{JobID: "job-2", Interval: time.Millisecond})
+	assert.ErrorIs(err, ErrBatchJobNotFound)
+}
+
+func TestGetBatchJobProgressOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+	req := httptest.NewRequest(http.MethodGet, "/ws/batch-jobs/job-1?interval=0.1", nil)
+	opts, err := getBatchJobProgressOptionsFromReq(req)
+	assert.NoError(err)
+	assert.Equal("job-1", opts.JobID)
+	assert.Equal(minBatchJobStreamInterval, opts.Interval)
+
+	req = httptest.NewRequest(http.MethodGet, "/ws/batch-jobs/job-1", nil)
+	opts, err = getBatchJobProgressOptionsFromReq(req)
+	assert.NoError(err)
+	assert.Equal(defaultBatchJobStreamInterval, opts.Interval)
+
+	req = httptest.NewRequest(http.MethodGet, "/ws/batch-jobs/", nil)
+	_, err = getBatchJobProgressOptionsFromReq(req)
+	assert.Error(err)
+}
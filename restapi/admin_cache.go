@@ -0,0 +1,127 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The admin calls whose results are cached, they name the caches in the metrics
+const (
+	adminCacheServerInfo = "serverInfo"
+	adminCacheDataUsage  = "dataUsage"
+	adminCacheConfig     = "config"
+)
+
+type adminCacheEntry struct {
+	// done is closed once the call returned, the callers of the same key meanwhile wait for it
+	done    chan struct{}
+	value   interface{}
+	err     error
+	expires time.Time
+}
+
+// expired tells whether the entry has to be fetched again, the entries of calls still running never are
+func (e *adminCacheEntry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+// adminCache keeps the results of the expensive admin calls the dashboard repeats on every refresh
+type adminCache struct {
+	mu      sync.Mutex
+	entries map[string]*adminCacheEntry
+}
+
+var globalAdminCache adminCache
+
+// adminCacheKey returns the key of a call, the results are only shared by the same credentials on the same cluster
+// so the policies of every session keep applying
+func adminCacheKey(kind, cluster, accessKey, arg string) string {
+	return strings.Join([]string{kind, cluster, accessKey, arg}, "|")
+}
+
+// get returns the result of call kept under key for ttl, calling it when it's missing or expired. The callers of a key
+// being fetched wait for the same call, its errors aren't kept.
+func (c *adminCache) get(ctx context.Context, kind, key string, ttl time.Duration, call func() (interface{}, error)) (interface{}, error) {
+	if ttl <= 0 {
+		return call()
+	}
+	now := time.Now()
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[string]*adminCacheEntry{}
+	}
+	if entry, ok := c.entries[key]; ok && !entry.expired(now) {
+		c.mu.Unlock()
+		recordCacheLookup(kind, true)
+		select {
+		case <-entry.done:
+			return entry.value, entry.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	for k, entry := range c.entries {
+		if entry.expired(now) {
+			delete(c.entries, k)
+		}
+	}
+	entry := &adminCacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+	recordCacheLookup(kind, false)
+
+	entry.value, entry.err = call()
+	c.mu.Lock()
+	entry.expires = time.Now().Add(ttl)
+	if entry.err != nil && c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.done)
+	return entry.value, entry.err
+}
+
+// invalidate drops the results of the kinds of calls on the cluster for all the credentials, a result being fetched
+// isn't kept either
+func (c *adminCache) invalidate(cluster string, kinds ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, kind := range kinds {
+		prefix := kind + "|" + cluster + "|"
+		for k := range c.entries {
+			if strings.HasPrefix(k, prefix) {
+				delete(c.entries, k)
+			}
+		}
+	}
+}
+
+// cachedCall returns the result of the admin call for the credentials of the client, kept for CONSOLE_ADMIN_CACHE_TTL
+func (ac AdminClient) cachedCall(ctx context.Context, kind, arg string, call func() (interface{}, error)) (interface{}, error) {
+	accessKey, _ := ac.Client.GetAccessAndSecretKey()
+	key := adminCacheKey(kind, ac.Client.GetEndpointURL().Host, accessKey, arg)
+	return globalAdminCache.get(ctx, kind, key, getConsoleAdminCacheTTL(), call)
+}
+
+// invalidateCache drops the results of the kinds of calls on the cluster of the client after a change
+func (ac AdminClient) invalidateCache(kinds ...string) {
+	globalAdminCache.invalidate(ac.Client.GetEndpointURL().Host, kinds...)
+}
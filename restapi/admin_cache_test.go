@@ -0,0 +1,143 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminCache(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	var cache adminCache
+	var calls int32
+	call := func() (interface{}, error) {
+		return atomic.AddInt32(&calls, 1), nil
+	}
+	key := adminCacheKey(adminCacheServerInfo, "minio:9000", "admin", "")
+	otherUser := adminCacheKey(adminCacheServerInfo, "minio:9000", "bob", "")
+
+	// Test-1 : the result is reused until it expires, and only by the same credentials
+	value, err := cache.get(ctx, adminCacheServerInfo, key, time.Minute, call)
+	assert.NoError(err)
+	assert.Equal(int32(1), value)
+	value, _ = cache.get(ctx, adminCacheServerInfo, key, time.Minute, call)
+	assert.Equal(int32(1), value)
+	value, _ = cache.get(ctx, adminCacheServerInfo, otherUser, time.Minute, call)
+	assert.Equal(int32(2), value)
+	cache.entries[key].expires = time.Now().Add(-time.Second)
+	value, _ = cache.get(ctx, adminCacheServerInfo, key, time.Minute, call)
+	assert.Equal(int32(3), value)
+
+	// Test-2 : a change on the cluster drops the results of all the credentials
+	cache.invalidate("minio:9000", adminCacheConfig)
+	assert.Len(cache.entries, 2)
+	cache.invalidate("minio:9000", adminCacheConfig, adminCacheServerInfo)
+	assert.Len(cache.entries, 0)
+
+	// Test-3 : the errors aren't kept
+	failing := func() (interface{}, error) { return nil, errors.New("connection refused") }
+	_, err = cache.get(ctx, adminCacheServerInfo, key, time.Minute, failing)
+	assert.Error(err)
+	value, err = cache.get(ctx, adminCacheServerInfo, key, time.Minute, call)
+	assert.NoError(err)
+	assert.Equal(int32(4), value)
+
+	// Test-4 : nothing is kept without a TTL
+	value, _ = cache.get(ctx, adminCacheDataUsage, adminCacheKey(adminCacheDataUsage, "minio:9000", "admin", ""), 0, call)
+	assert.Equal(int32(5), value)
+	_, ok := cache.entries[adminCacheKey(adminCacheDataUsage, "minio:9000", "admin", "")]
+	assert.False(ok)
+}
+
+func TestAdminCacheConcurrentCalls(t *testing.T) {
+	var cache adminCache
+	var calls int32
+	release := make(chan struct{})
+	call := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "info", nil
+	}
+	key := adminCacheKey(adminCacheServerInfo, "minio:9000", "admin", "")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := cache.get(context.Background(), adminCacheServerInfo, key, time.Minute, call)
+			assert.NoError(t, err)
+			assert.Equal(t, "info", value)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), calls)
+}
+
+func TestAdminClientServerInfoCache(t *testing.T) {
+	var calls int32
+	minio := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/info") {
+			atomic.AddInt32(&calls, 1)
+			w.Write([]byte(`{"mode":"online","deploymentID":"deployment-1"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer minio.Close()
+	defer func() { globalAdminCache = adminCache{} }()
+	t.Setenv(ConsoleAdminCacheTTL, "1m")
+
+	newClient := func(accessKey string) AdminClient {
+		client, err := madmin.New(strings.TrimPrefix(minio.URL, "http://"), accessKey, "secret", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return AdminClient{Client: client}
+	}
+	admin, bob := newClient("admin"), newClient("bob")
+	ctx := context.Background()
+
+	info, err := admin.serverInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "deployment-1", info.DeploymentID)
+	_, err = admin.serverInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	_, err = bob.serverInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	admin.invalidateCache(adminCacheServerInfo)
+	_, err = bob.serverInfo(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
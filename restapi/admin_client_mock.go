@@ -46,6 +46,7 @@ var (
 
 	minioHealMock func(ctx context.Context, bucket, prefix string, healOpts madmin.HealOpts, clientToken string,
 		forceStart, forceStop bool) (healStart madmin.HealStartSuccess, healTaskStatus madmin.HealTaskStatus, err error)
+	minioBackgroundHealStatusMock func(ctx context.Context) (madmin.BgHealState, error)
 
 	minioServerHealthInfoMock func(ctx context.Context, healthDataTypes []madmin.HealthDataType, deadline time.Duration) (interface{}, string, error)
 
@@ -59,6 +60,7 @@ var (
 	minioStopProfiling  func() (io.ReadCloser, error)
 
 	minioServiceRestartMock func(ctx context.Context) error
+	minioServerUpdateMock   func(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error)
 
 	getSiteReplicationInfo        func(ctx context.Context) (*madmin.SiteReplicationInfo, error)
 	addSiteReplicationInfo        func(ctx context.Context, sites []madmin.PeerSite) (*madmin.ReplicateAddStatus, error)
@@ -71,6 +73,9 @@ var (
 	minioAddTiersMock  func(ctx context.Context, tier *madmin.TierConfig) error
 	minioEditTiersMock func(ctx context.Context, tierName string, creds madmin.TierCreds) error
 
+	minioVerifyTierStatusMock func(ctx context.Context, tierName string) error
+	minioRemoveTierMock       func(ctx context.Context, tierName string) error
+
 	minioServiceTraceMock func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo
 
 	minioListUsersMock     func() (map[string]madmin.UserInfo, error)
@@ -86,6 +91,39 @@ var (
 	minioInfoServiceAccountMock    func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error)
 	minioUpdateServiceAccountMock  func(ctx context.Context, serviceAccount string, opts madmin.UpdateServiceAccountReq) error
 	minioGetLDAPPolicyEntitiesMock func(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error)
+
+	minioSetBucketQuotaMock func(ctx context.Context, bucket string, quota *madmin.BucketQuota) error
+	minioGetBucketQuotaMock func(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+
+	minioExportIAMMock func(ctx context.Context) (io.ReadCloser, error)
+	minioImportIAMMock func(ctx context.Context, contentReader io.ReadCloser) error
+
+	minioGetServerConfigMock func(ctx context.Context) ([]byte, error)
+	minioSetServerConfigMock func(ctx context.Context, config io.Reader) error
+
+	minioStartBatchJobMock    func(ctx context.Context, job string) (madmin.BatchJobResult, error)
+	minioListBatchJobsMock    func(ctx context.Context, jobType string) (madmin.ListBatchJobsResult, error)
+	minioDescribeBatchJobMock func(ctx context.Context, jobID string) (string, error)
+	minioBatchJobMetricsMock  func(ctx context.Context, jobID string) (*madmin.JobMetric, error)
+
+	minioStartRebalanceMock  func(ctx context.Context) (string, error)
+	minioRebalanceStatusMock func(ctx context.Context) (madmin.RebalanceStatus, error)
+	minioStopRebalanceMock   func(ctx context.Context) error
+
+	minioListPoolsStatusMock        func(ctx context.Context) ([]madmin.PoolStatus, error)
+	minioDecommissionPoolMock       func(ctx context.Context, pool string) error
+	minioCancelDecommissionPoolMock func(ctx context.Context, pool string) error
+
+	minioTopLocksMock func(ctx context.Context, count int, stale bool) (madmin.LockEntries, error)
+
+	minioDataUsageInfoMock  func(ctx context.Context) (madmin.DataUsageInfo, error)
+	minioScannerMetricsMock func(ctx context.Context) (*madmin.ScannerMetrics, error)
+
+	minioSpeedtestMock      func(opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error)
+	minioDriveSpeedtestMock func(opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error)
+	minioNetperfMock        func(duration time.Duration) (madmin.NetperfResult, error)
+
+	minioInspectMock func(insOpts madmin.InspectOptions) ([]byte, io.ReadCloser, error)
 )
 
 func (ac AdminClientMock) serverInfo(ctx context.Context) (madmin.InfoMessage, error) {
@@ -112,12 +150,34 @@ func (ac AdminClientMock) changePassword(ctx context.Context, accessKey, secretK
 	return minioChangePasswordMock(ctx, accessKey, secretKey)
 }
 
-func (ac AdminClientMock) speedtest(_ context.Context, _ madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error) {
-	return nil, nil
+func (ac AdminClientMock) speedtest(_ context.Context, opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error) {
+	if minioSpeedtestMock == nil {
+		return nil, nil
+	}
+	return minioSpeedtestMock(opts)
 }
 
-func (ac AdminClientMock) verifyTierStatus(_ context.Context, _ string) error {
-	return nil
+func (ac AdminClientMock) driveSpeedtest(_ context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+	return minioDriveSpeedtestMock(opts)
+}
+
+func (ac AdminClientMock) netperf(_ context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+	return minioNetperfMock(duration)
+}
+
+func (ac AdminClientMock) verifyTierStatus(ctx context.Context, tierName string) error {
+	if minioVerifyTierStatusMock == nil {
+		return nil
+	}
+	return minioVerifyTierStatusMock(ctx, tierName)
+}
+
+func (ac AdminClientMock) removeTier(ctx context.Context, tierName string) error {
+	return minioRemoveTierMock(ctx, tierName)
+}
+
+func (ac AdminClientMock) inspect(_ context.Context, insOpts madmin.InspectOptions) ([]byte, io.ReadCloser, error) {
+	return minioInspectMock(insOpts)
 }
 
 // mock function helpConfigKV()
@@ -170,6 +230,10 @@ func (ac AdminClientMock) heal(ctx context.Context, bucket, prefix string, healO
 	return minioHealMock(ctx, bucket, prefix, healOpts, clientToken, forceStart, forceStop)
 }
 
+func (ac AdminClientMock) backgroundHealStatus(ctx context.Context) (madmin.BgHealState, error) {
+	return minioBackgroundHealStatusMock(ctx)
+}
+
 func (ac AdminClientMock) serverHealthInfo(ctx context.Context, healthDataTypes []madmin.HealthDataType, deadline time.Duration) (interface{}, string, error) {
 	return minioServerHealthInfoMock(ctx, healthDataTypes, deadline)
 }
@@ -309,6 +373,11 @@ func (ac AdminClientMock) serviceRestart(ctx context.Context) error {
 	return minioServiceRestartMock(ctx)
 }
 
+// mock function of serverUpdate()
+func (ac AdminClientMock) serverUpdate(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error) {
+	return minioServerUpdateMock(ctx, updateURL)
+}
+
 func (ac AdminClientMock) getSiteReplicationInfo(ctx context.Context) (*madmin.SiteReplicationInfo, error) {
 	return getSiteReplicationInfo(ctx)
 }
@@ -396,3 +465,79 @@ func (ac AdminClientMock) updateServiceAccount(ctx context.Context, serviceAccou
 func (ac AdminClientMock) getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
 	return minioGetLDAPPolicyEntitiesMock(ctx, query)
 }
+
+func (ac AdminClientMock) setBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error {
+	return minioSetBucketQuotaMock(ctx, bucket, quota)
+}
+
+func (ac AdminClientMock) getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+	return minioGetBucketQuotaMock(ctx, bucket)
+}
+
+func (ac AdminClientMock) exportIAM(ctx context.Context) (io.ReadCloser, error) {
+	return minioExportIAMMock(ctx)
+}
+
+func (ac AdminClientMock) importIAM(ctx context.Context, contentReader io.ReadCloser) error {
+	return minioImportIAMMock(ctx, contentReader)
+}
+
+func (ac AdminClientMock) getServerConfig(ctx context.Context) ([]byte, error) {
+	return minioGetServerConfigMock(ctx)
+}
+
+func (ac AdminClientMock) setServerConfig(ctx context.Context, config io.Reader) error {
+	return minioSetServerConfigMock(ctx, config)
+}
+
+func (ac AdminClientMock) startBatchJob(ctx context.Context, job string) (madmin.BatchJobResult, error) {
+	return minioStartBatchJobMock(ctx, job)
+}
+
+func (ac AdminClientMock) listBatchJobs(ctx context.Context, jobType string) (madmin.ListBatchJobsResult, error) {
+	return minioListBatchJobsMock(ctx, jobType)
+}
+
+func (ac AdminClientMock) describeBatchJob(ctx context.Context, jobID string) (string, error) {
+	return minioDescribeBatchJobMock(ctx, jobID)
+}
+
+func (ac AdminClientMock) batchJobMetrics(ctx context.Context, jobID string) (*madmin.JobMetric, error) {
+	return minioBatchJobMetricsMock(ctx, jobID)
+}
+
+func (ac AdminClientMock) startRebalance(ctx context.Context) (string, error) {
+	return minioStartRebalanceMock(ctx)
+}
+
+func (ac AdminClientMock) rebalanceStatus(ctx context.Context) (madmin.RebalanceStatus, error) {
+	return minioRebalanceStatusMock(ctx)
+}
+
+func (ac AdminClientMock) stopRebalance(ctx context.Context) error {
+	return minioStopRebalanceMock(ctx)
+}
+
+func (ac AdminClientMock) listPoolsStatus(ctx context.Context) ([]madmin.PoolStatus, error) {
+	return minioListPoolsStatusMock(ctx)
+}
+
+func (ac AdminClientMock) decommissionPool(ctx context.Context, pool string) error {
+	return minioDecommissionPoolMock(ctx, pool)
+}
+
+func (ac AdminClientMock) cancelDecommissionPool(ctx context.Context, pool string) error {
+	return minioCancelDecommissionPoolMock(ctx, pool)
+}
+
+func (ac AdminClientMock) topLocks(ctx context.Context, count int, stale bool) (madmin.LockEntries, error) {
+	return minioTopLocksMock(ctx, count, stale)
+}
+
+func (ac AdminClientMock) dataUsageInfo(ctx context.Context) (madmin.DataUsageInfo, error) {
+	return minioDataUsageInfoMock(ctx)
+}
+
+func (ac AdminClientMock) scannerMetrics(ctx context.Context) (*madmin.ScannerMetrics, error) {
+	return minioScannerMetricsMock(ctx)
+}
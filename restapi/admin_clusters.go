@@ -0,0 +1,345 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/clusters"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/pkg/twofactor"
+	"github.com/minio/console/restapi/operations"
+	authApi "github.com/minio/console/restapi/operations/auth"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+var (
+	globalClusters     *clusters.Registry
+	globalClustersOnce sync.Once
+)
+
+func registerClustersHandlers(api *operations.ConsoleAPI) {
+	// list the clusters and the one the session works with
+	api.SystemListClustersHandler = systemApi.ListClustersHandlerFunc(func(params systemApi.ListClustersParams, session *models.Principal) middleware.Responder {
+		return systemApi.NewListClustersOK().WithPayload(listClusters(clusterRegistry(), session))
+	})
+	// register or update a cluster
+	api.SystemSetClusterHandler = systemApi.SetClusterHandlerFunc(func(params systemApi.SetClusterParams, session *models.Principal) middleware.Responder {
+		resp, err := getSetClusterResponse(session, params)
+		if err != nil {
+			return systemApi.NewSetClusterDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewSetClusterOK().WithPayload(resp)
+	})
+	// unregister a cluster, the sessions working with it have to log in again
+	api.SystemDeleteClusterHandler = systemApi.DeleteClusterHandlerFunc(func(params systemApi.DeleteClusterParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteClusterResponse(session, params); err != nil {
+			return systemApi.NewDeleteClusterDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewDeleteClusterNoContent()
+	})
+	// switch the cluster of the session
+	api.AuthSwitchClusterHandler = authApi.SwitchClusterHandlerFunc(func(params authApi.SwitchClusterParams, session *models.Principal) middleware.Responder {
+		sessionID, list, err := getSwitchClusterResponse(session, params)
+		if err != nil {
+			return authApi.NewSwitchClusterDefault(int(err.Code)).WithPayload(err)
+		}
+		// Custom response writer to replace the session cookie
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			cookie := NewSessionCookieForConsole(sessionID)
+			http.SetCookie(w, &cookie)
+			authApi.NewSwitchClusterOK().WithPayload(list).WriteResponse(w, p)
+		})
+	})
+}
+
+// clusterRegistry returns the registry of the clusters, when the configured file can't be loaded they are only kept
+// in memory
+func clusterRegistry() *clusters.Registry {
+	globalClustersOnce.Do(func() {
+		registry, err := clusters.New(getConsoleClustersFile(), sessionKeyCipher{})
+		if err != nil {
+			LogError("unable to load the clusters: %v", err)
+			registry, _ = clusters.New("", sessionKeyCipher{})
+		}
+		globalClusters = registry
+	})
+	return globalClusters
+}
+
+// clusterError reports the errors of the registry with the matching sentinel
+func clusterError(err error) error {
+	switch {
+	case errors.Is(err, clusters.ErrInvalid):
+		return fmt.Errorf("%w: %v", ErrInvalidCluster, err)
+	case errors.Is(err, clusters.ErrNotFound):
+		return ErrClusterNotFound
+	}
+	return err
+}
+
+// sessionCluster returns the endpoint of the cluster the session works with and the credentials to reach it with.
+// Those are the credentials of the session on the cluster it logged in to, the registered ones on the clusters
+// allowing its user.
+func sessionCluster(registry *clusters.Registry, session *models.Principal) (string, *credentials.Credentials, error) {
+	if session == nil {
+		return getMinIOServer(), credentials.NewStaticV4("", "", ""), nil
+	}
+	endpoint := getMinIOServer()
+	if session.ClusterID != "" {
+		cluster, err := registry.Get(session.ClusterID)
+		if err != nil {
+			return "", nil, clusterError(err)
+		}
+		endpoint = cluster.Endpoint
+	}
+	if session.ClusterID == session.LoginCluster {
+		return endpoint, credentials.NewStaticV4(session.STSAccessKeyID, session.STSSecretAccessKey, session.STSSessionToken), nil
+	}
+	cluster, secretKey, err := registry.Credentials(session.ClusterID)
+	if err != nil {
+		return "", nil, clusterError(err)
+	}
+	if !cluster.Allows(session.AccountAccessKey) {
+		return "", nil, fmt.Errorf("%w: cluster %s", ErrAccessDenied, cluster.ID)
+	}
+	return endpoint, credentials.NewStaticV4(cluster.AccessKey, secretKey, ""), nil
+}
+
+// sessionMinIOServer returns the endpoint of the cluster the session works with
+func sessionMinIOServer(session *models.Principal) (string, error) {
+	endpoint, _, err := sessionCluster(clusterRegistry(), session)
+	return endpoint, err
+}
+
+// clusterAvailable returns whether the session can switch to the cluster without credentials
+func clusterAvailable(cluster clusters.Cluster, session *models.Principal) bool {
+	return session.LoginCluster == cluster.ID || cluster.Allows(session.AccountAccessKey)
+}
+
+// clusterModel returns the cluster as every session lists it, the registered access key and allowed users are only
+// returned to the administrators registering it
+func clusterModel(cluster clusters.Cluster, available bool) *models.Cluster {
+	return &models.Cluster{
+		ID:        cluster.ID,
+		Name:      swag.String(cluster.Name),
+		Endpoint:  swag.String(cluster.Endpoint),
+		Auth:      swag.String(cluster.Auth),
+		Created:   cluster.Created.Format(time.RFC3339),
+		Available: available,
+	}
+}
+
+// listClusters returns the cluster Console is configured with followed by the registered ones
+func listClusters(registry *clusters.Registry, session *models.Principal) *models.ClusterList {
+	list := &models.ClusterList{
+		Active: clusters.DefaultID,
+		Clusters: []*models.Cluster{{
+			ID:        clusters.DefaultID,
+			Name:      swag.String(clusters.DefaultID),
+			Endpoint:  swag.String(getMinIOServer()),
+			Auth:      swag.String(clusters.AuthSTS),
+			Available: session.LoginCluster == "",
+		}},
+	}
+	if session.ClusterID != "" {
+		list.Active = session.ClusterID
+	}
+	for _, cluster := range registry.List() {
+		list.Clusters = append(list.Clusters, clusterModel(cluster, clusterAvailable(cluster, session)))
+	}
+	return list
+}
+
+// clusterCredentialsFunc returns the credentials of a user on the cluster at endpoint, an empty endpoint is the
+// cluster Console is configured with
+type clusterCredentialsFunc func(endpoint, accessKey, secretKey string) (*ConsoleCredentials, error)
+
+// clusterCredentials logs the users in the way the login does on the cluster Console is configured with, with STS
+// on the registered clusters
+func clusterCredentials(endpoint, accessKey, secretKey string) (*ConsoleCredentials, error) {
+	if endpoint == "" {
+		return getConsoleCredentials(accessKey, secretKey)
+	}
+	creds, err := stsCredentials(endpoint, accessKey, secretKey, GetMinIORegion())
+	if err != nil {
+		return nil, err
+	}
+	return &ConsoleCredentials{ConsoleCredentials: creds, AccountAccessKey: accessKey}, nil
+}
+
+// switchCluster returns the credentials and features of the session switched to the cluster of the request. The
+// session keeps its credentials for the cluster it logged in to and the clusters allowing its user, the others need
+// the credentials of the request, which become the ones of the session.
+func switchCluster(registry *clusters.Registry, session *models.Principal, req *models.SwitchClusterRequest, newCredentials clusterCredentialsFunc) (ConsoleCredentialsI, *auth.SessionFeatures, error) {
+	id := swag.StringValue(req.ClusterID)
+	if id == clusters.DefaultID {
+		id = ""
+	}
+	endpoint := ""
+	available := session.LoginCluster == id
+	if id != "" {
+		cluster, err := registry.Get(id)
+		if err != nil {
+			return nil, nil, clusterError(err)
+		}
+		endpoint = cluster.Endpoint
+		available = clusterAvailable(cluster, session)
+	}
+	features := &auth.SessionFeatures{
+		HideMenu:        session.Hm,
+		ObjectBrowser:   session.Ob,
+		CustomStyleOB:   session.CustomStyleOb,
+		IDPName:         session.IdpName,
+		IDPRefreshToken: session.IdpRefreshToken,
+		IssuedAt:        sessionIssuedAt(session),
+		ClusterID:       id,
+		LoginCluster:    session.LoginCluster,
+	}
+	if session.Expiration != 0 {
+		features.Expiration = time.Unix(session.Expiration, 0)
+	}
+	if req.AccessKey == "" {
+		if !available {
+			return nil, nil, fmt.Errorf("%w: the credentials of the user on cluster %s are required", ErrInvalidCluster, swag.StringValue(req.ClusterID))
+		}
+		return &ConsoleCredentials{
+			ConsoleCredentials: credentials.NewStaticV4(session.STSAccessKeyID, session.STSSecretAccessKey, session.STSSessionToken),
+			AccountAccessKey:   session.AccountAccessKey,
+		}, features, nil
+	}
+	creds, err := newCredentials(endpoint, req.AccessKey, req.SecretKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the session now belongs to the user of the request, the OpenID login can't renew it anymore
+	features.LoginCluster = id
+	features.IDPName = ""
+	features.IDPRefreshToken = ""
+	features.Expiration = time.Time{}
+	return creds, features, nil
+}
+
+// requireClustersAdmin checks the session can manage the clusters, the administrators allowed to update the
+// configuration of the cluster Console is configured with while working with it
+func requireClustersAdmin(ctx context.Context, session *models.Principal) *models.Error {
+	if session.ClusterID != "" || session.LoginCluster != "" {
+		return ErrorWithContext(ctx, fmt.Errorf("%w: the clusters are managed from the %s cluster", ErrAccessDenied, clusters.DefaultID))
+	}
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ConfigUpdateAdminAction) {
+		return ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	return nil
+}
+
+func getSetClusterResponse(session *models.Principal, params systemApi.SetClusterParams) (*models.Cluster, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := requireClustersAdmin(ctx, session); err != nil {
+		return nil, err
+	}
+	body := params.Body
+	cluster, err := clusterRegistry().Set(clusters.Cluster{
+		ID:           params.ID,
+		Name:         swag.StringValue(body.Name),
+		Endpoint:     swag.StringValue(body.Endpoint),
+		Auth:         swag.StringValue(body.Auth),
+		AccessKey:    body.AccessKey,
+		AllowedUsers: body.AllowedUsers,
+	}, body.SecretKey, time.Now().UTC())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, clusterError(err))
+	}
+	resp := clusterModel(cluster, clusterAvailable(cluster, session))
+	resp.AccessKey = cluster.AccessKey
+	resp.AllowedUsers = cluster.AllowedUsers
+	return resp, nil
+}
+
+func getDeleteClusterResponse(session *models.Principal, params systemApi.DeleteClusterParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := requireClustersAdmin(ctx, session); err != nil {
+		return err
+	}
+	if err := clusterRegistry().Remove(params.ID); err != nil {
+		return ErrorWithContext(ctx, clusterError(err))
+	}
+	return nil
+}
+
+// switchedSession creates the session of the credentials switchCluster returned. The users enrolled in two-factor
+// authentication logging in to the cluster Console is configured with give their code, the session is only created
+// once the credentials and the code are checked.
+func switchedSession(store *twofactor.Store, creds ConsoleCredentialsI, features *auth.SessionFeatures, req *models.SwitchClusterRequest, now time.Time) (*string, error) {
+	if _, err := creds.Get(); err != nil {
+		return nil, err
+	}
+	if req.AccessKey != "" && features.LoginCluster == "" {
+		if err := checkLoginTwoFactor(store, req.AccessKey, req.Otp, now); err != nil {
+			return nil, err
+		}
+	}
+	return login(creds, features)
+}
+
+func getSwitchClusterResponse(session *models.Principal, params authApi.SwitchClusterParams) (string, *models.ClusterList, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	req := params.Body
+	sourceIP := realip.ClientIP(params.HTTPRequest)
+	if req.AccessKey != "" {
+		if err := checkLoginLockout(loginAttempts(), req.AccessKey, sourceIP, time.Now()); err != nil {
+			return "", nil, ErrorWithContext(ctx, err)
+		}
+	}
+	registry := clusterRegistry()
+	creds, features, err := switchCluster(registry, session, req, clusterCredentials)
+	if err != nil {
+		return "", nil, ErrorWithContext(ctx, err)
+	}
+	sessionID, err := switchedSession(twoFactor(), creds, features, req, time.Now())
+	if err != nil {
+		if req.AccessKey != "" && !errors.Is(err, ErrTwoFactorRequired) {
+			recordLoginFailure(loginAttempts(), req.AccessKey, sourceIP, err, time.Now())
+			failedLoginAttempt(ctx, err)
+		}
+		return "", nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+	}
+	switched := &models.Principal{
+		ClusterID:        features.ClusterID,
+		LoginCluster:     features.LoginCluster,
+		AccountAccessKey: creds.GetAccountAccessKey(),
+	}
+	return *sessionID, listClusters(registry, switched), nil
+}
@@ -0,0 +1,174 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/base32"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/clusters"
+	"github.com/minio/console/pkg/sessionstore"
+	"github.com/minio/console/pkg/twofactor"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusters(t *testing.T) {
+	assert := assert.New(t)
+	registry, err := clusters.New("", nil)
+	assert.NoError(err)
+	now := time.Unix(1700000000, 0)
+	_, err = registry.Set(clusters.Cluster{
+		ID: "prod", Name: "Prod", Endpoint: "https://prod:9000", Auth: clusters.AuthCredentials,
+		AccessKey: "console", AllowedUsers: []string{"alice"},
+	}, "console-secret", now)
+	assert.NoError(err)
+	_, err = registry.Set(clusters.Cluster{ID: "edge", Name: "Edge", Endpoint: "http://edge:9000", Auth: clusters.AuthSTS}, "", now)
+	assert.NoError(err)
+
+	alice := &models.Principal{STSAccessKeyID: "STS1", STSSecretAccessKey: "sts-secret", STSSessionToken: "token", AccountAccessKey: "alice"}
+	bob := &models.Principal{STSAccessKeyID: "STS2", STSSecretAccessKey: "sts-secret", AccountAccessKey: "bob"}
+
+	// the sessions work with the cluster Console is configured with until they switch
+	endpoint, creds, err := sessionCluster(registry, alice)
+	assert.NoError(err)
+	assert.Equal(getMinIOServer(), endpoint)
+	value, _ := creds.Get()
+	assert.Equal("STS1", value.AccessKeyID)
+
+	list := listClusters(registry, alice)
+	assert.Equal(clusters.DefaultID, list.Active)
+	assert.Len(list.Clusters, 3)
+	assert.Equal([]bool{true, false, true}, []bool{list.Clusters[0].Available, list.Clusters[1].Available, list.Clusters[2].Available})
+	assert.Empty(list.Clusters[2].SecretKey)
+	// the registered credentials aren't listed to the sessions using them
+	assert.Empty(list.Clusters[1].AccessKey)
+	assert.Empty(list.Clusters[1].AllowedUsers)
+
+	noCredentials := func(endpoint, accessKey, secretKey string) (*ConsoleCredentials, error) {
+		t.Fatalf("unexpected login to %q", endpoint)
+		return nil, nil
+	}
+	_, _, err = switchCluster(registry, alice, &models.SwitchClusterRequest{ClusterID: swag.String("staging")}, noCredentials)
+	assert.True(errors.Is(err, ErrClusterNotFound))
+	// a user allowed by a cluster switches to it without credentials and keeps the ones of the session
+	consoleCreds, features, err := switchCluster(registry, alice, &models.SwitchClusterRequest{ClusterID: swag.String("prod")}, noCredentials)
+	assert.NoError(err)
+	assert.Equal("prod", features.ClusterID)
+	assert.Empty(features.LoginCluster)
+	assert.Equal("alice", consoleCreds.GetAccountAccessKey())
+
+	onProd := &models.Principal{STSAccessKeyID: "STS1", STSSecretAccessKey: "sts-secret", AccountAccessKey: "alice", ClusterID: "prod"}
+	endpoint, creds, err = sessionCluster(registry, onProd)
+	assert.NoError(err)
+	assert.Equal("https://prod:9000", endpoint)
+	value, _ = creds.Get()
+	assert.Equal("console", value.AccessKeyID)
+	assert.Equal("console-secret", value.SecretAccessKey)
+	// the registered credentials are only for the allowed users
+	_, _, err = sessionCluster(registry, &models.Principal{AccountAccessKey: "bob", ClusterID: "prod"})
+	assert.True(errors.Is(err, ErrAccessDenied))
+
+	// the other clusters need the credentials of the user, the session then belongs to that cluster
+	_, _, err = switchCluster(registry, bob, &models.SwitchClusterRequest{ClusterID: swag.String("edge")}, noCredentials)
+	assert.True(errors.Is(err, ErrInvalidCluster))
+	var loginEndpoint string
+	userCredentials := func(endpoint, accessKey, secretKey string) (*ConsoleCredentials, error) {
+		loginEndpoint = endpoint
+		return &ConsoleCredentials{ConsoleCredentials: credentials.NewStaticV4("EDGE1", "edge-secret", "edge-token"), AccountAccessKey: accessKey}, nil
+	}
+	consoleCreds, features, err = switchCluster(registry, bob, &models.SwitchClusterRequest{ClusterID: swag.String("edge"), AccessKey: "bob-edge", SecretKey: "secret"}, userCredentials)
+	assert.NoError(err)
+	assert.Equal("http://edge:9000", loginEndpoint)
+	assert.Equal("edge", features.ClusterID)
+	assert.Equal("edge", features.LoginCluster)
+	assert.Equal("bob-edge", consoleCreds.GetAccountAccessKey())
+
+	onEdge := &models.Principal{STSAccessKeyID: "EDGE1", STSSecretAccessKey: "edge-secret", AccountAccessKey: "bob-edge", ClusterID: "edge", LoginCluster: "edge"}
+	endpoint, creds, err = sessionCluster(registry, onEdge)
+	assert.NoError(err)
+	assert.Equal("http://edge:9000", endpoint)
+	value, _ = creds.Get()
+	assert.Equal("EDGE1", value.AccessKeyID)
+	// going back to the default cluster takes credentials again
+	assert.False(listClusters(registry, onEdge).Clusters[0].Available)
+	_, _, err = switchCluster(registry, onEdge, &models.SwitchClusterRequest{ClusterID: swag.String(clusters.DefaultID)}, noCredentials)
+	assert.True(errors.Is(err, ErrInvalidCluster))
+	_, features, err = switchCluster(registry, onEdge, &models.SwitchClusterRequest{ClusterID: swag.String(clusters.DefaultID), AccessKey: "bob", SecretKey: "secret"}, userCredentials)
+	assert.NoError(err)
+	assert.Empty(loginEndpoint)
+	assert.Empty(features.ClusterID)
+	assert.Empty(features.LoginCluster)
+
+	// the sessions of an unregistered cluster can't reach it anymore
+	assert.NoError(registry.Remove("edge"))
+	_, _, err = sessionCluster(registry, onEdge)
+	assert.True(errors.Is(err, ErrClusterNotFound))
+}
+
+// sessionStoreCounter counts the sessions stored
+type sessionStoreCounter struct {
+	sessionstore.Store
+	sessions int
+}
+
+func (s *sessionStoreCounter) Put(ctx context.Context, id string, payload []byte, ttl time.Duration) error {
+	s.sessions++
+	return s.Store.Put(ctx, id, payload, ttl)
+}
+
+func TestSwitchedSession(t *testing.T) {
+	assert := assert.New(t)
+	sessions := &sessionStoreCounter{Store: sessionstore.NewMemory()}
+	auth.SetSessionStore(sessions, time.Hour)
+	defer auth.SetSessionStore(nil, 0)
+	store, err := twofactor.New("", nil)
+	assert.NoError(err)
+	now := time.Unix(1700000000, 0)
+	enrollment, err := enrollTwoFactor(store, "MinIO Console", "bob")
+	assert.NoError(err)
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	assert.NoError(err)
+	_, err = verifyTwoFactor(store, "bob", twofactor.Code(key, now), now)
+	assert.NoError(err)
+
+	creds := &ConsoleCredentials{ConsoleCredentials: credentials.NewStaticV4("BOB1", "bob-secret", ""), AccountAccessKey: "bob"}
+	features := &auth.SessionFeatures{}
+	// the users enrolled in two-factor authentication switching back to the cluster Console is configured with
+	// give their code, no session is created until it's checked
+	_, err = switchedSession(store, creds, features, &models.SwitchClusterRequest{ClusterID: swag.String(clusters.DefaultID), AccessKey: "bob", SecretKey: "secret"}, now)
+	assert.True(errors.Is(err, ErrTwoFactorRequired))
+	_, err = switchedSession(store, creds, features, &models.SwitchClusterRequest{ClusterID: swag.String(clusters.DefaultID), AccessKey: "bob", SecretKey: "secret", Otp: twofactor.Code(key, now.Add(time.Hour))}, now)
+	assert.True(errors.Is(err, ErrInvalidLogin))
+	assert.Equal(0, sessions.sessions)
+
+	sessionID, err := switchedSession(store, creds, features, &models.SwitchClusterRequest{ClusterID: swag.String(clusters.DefaultID), AccessKey: "bob", SecretKey: "secret", Otp: twofactor.Code(key, now.Add(twofactor.Period))}, now)
+	assert.NoError(err)
+	assert.NotEmpty(*sessionID)
+	assert.Equal(1, sessions.sessions)
+
+	// the code isn't asked on the clusters the user logs in to with their own credentials
+	_, err = switchedSession(store, creds, &auth.SessionFeatures{ClusterID: "edge", LoginCluster: "edge"}, &models.SwitchClusterRequest{ClusterID: swag.String("edge"), AccessKey: "bob", SecretKey: "secret"}, now)
+	assert.NoError(err)
+	assert.Equal(2, sessions.sessions)
+}
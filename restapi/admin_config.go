@@ -81,6 +81,13 @@ func registerConfigHandlers(api *operations.ConsoleAPI) {
 	})
 }
 
+// checkServerConfigAccess makes sure the session is allowed to read the server configuration, it guards
+// Console settings that only configuration admins should see or change
+func checkServerConfigAccess(ctx context.Context, client MinioAdmin) error {
+	_, err := client.getConfigKV(ctx, "api")
+	return err
+}
+
 // listConfig gets all configurations' names and their descriptions
 func listConfig(client MinioAdmin) ([]*models.ConfigDescription, error) {
 	ctx, cancel := context.WithCancel(context.Background())
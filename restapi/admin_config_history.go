@@ -0,0 +1,206 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/confighistory"
+	"github.com/minio/console/restapi/operations"
+	cfgApi "github.com/minio/console/restapi/operations/configuration"
+	madmin "github.com/minio/madmin-go/v2"
+)
+
+var (
+	globalConfigHistory     *confighistory.Store
+	globalConfigHistoryOnce sync.Once
+)
+
+// configRollbackKey is the context key of the revision a configuration change rolls back
+type configRollbackKey struct{}
+
+func registerConfigHistoryHandlers(api *operations.ConsoleAPI) {
+	// list the configuration revisions
+	api.ConfigurationListConfigRevisionsHandler = cfgApi.ListConfigRevisionsHandlerFunc(func(params cfgApi.ListConfigRevisionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListConfigRevisionsResponse(session, params)
+		if err != nil {
+			return cfgApi.NewListConfigRevisionsDefault(int(err.Code)).WithPayload(err)
+		}
+		return cfgApi.NewListConfigRevisionsOK().WithPayload(resp)
+	})
+	// roll back a configuration revision
+	api.ConfigurationRollbackConfigRevisionHandler = cfgApi.RollbackConfigRevisionHandlerFunc(func(params cfgApi.RollbackConfigRevisionParams, session *models.Principal) middleware.Responder {
+		resp, err := getRollbackConfigRevisionResponse(session, params)
+		if err != nil {
+			return cfgApi.NewRollbackConfigRevisionDefault(int(err.Code)).WithPayload(err)
+		}
+		return cfgApi.NewRollbackConfigRevisionOK().WithPayload(resp)
+	})
+}
+
+// configHistory returns the store of the configuration revisions, when the configured file can't be loaded the
+// history is only kept in memory
+func configHistory() *confighistory.Store {
+	globalConfigHistoryOnce.Do(func() {
+		limit := getConsoleConfigHistoryLimit()
+		store, err := confighistory.New(getConsoleConfigHistoryFile(), limit)
+		if err != nil {
+			LogError("unable to load the configuration history: %v", err)
+			store, _ = confighistory.New("", limit)
+		}
+		globalConfigHistory = store
+	})
+	return globalConfigHistory
+}
+
+// configTarget returns the subsystem target a configuration line applies to, its first field
+func configTarget(kv string) string {
+	fields := strings.Fields(kv)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// currentTargetConfig returns the configuration line of a subsystem target, empty when it isn't configured
+func currentTargetConfig(ctx context.Context, client MinioAdmin, target string) (string, error) {
+	out, err := client.getConfigKV(ctx, target)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioConfigError" {
+			return "", nil
+		}
+		return "", err
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		// the environment overrides are listed in comments before the line
+		if line = strings.TrimSpace(line); configTarget(line) == target {
+			return line, nil
+		}
+	}
+	return "", nil
+}
+
+// configChangeUser returns the user performing the request changing the configuration
+func configChangeUser(ctx context.Context) string {
+	if actor, ok := ctx.Value(consoleAuditActorKey{}).(*consoleAuditActor); ok {
+		return actor.user
+	}
+	return ""
+}
+
+// trackConfigChange applies a change of the configuration of the subsystem target of kv and records the
+// configuration the target had before in the history. The history doesn't block the change: when the previous
+// configuration can't be read the change isn't recorded.
+func trackConfigChange(ctx context.Context, client MinioAdmin, store *confighistory.Store, action, kv string, apply func() error) error {
+	target := configTarget(kv)
+	previous, err := currentTargetConfig(ctx, client, target)
+	if err != nil {
+		LogErrorContext(ctx, "unable to read the configuration of %s before changing it: %v", target, err)
+	}
+	if applyErr := apply(); applyErr != nil {
+		return applyErr
+	}
+	if err != nil {
+		return nil
+	}
+	rev := confighistory.Revision{Target: target, Time: time.Now(), User: configChangeUser(ctx), Action: action, Previous: previous}
+	if action == confighistory.ActionSet {
+		rev.Config = kv
+	}
+	if id, ok := ctx.Value(configRollbackKey{}).(int64); ok {
+		rev.RollbackOf = id
+	}
+	if _, err = store.Record(rev); err != nil {
+		LogErrorContext(ctx, "unable to save the configuration history: %v", err)
+	}
+	return nil
+}
+
+// rollbackConfigRevision restores the configuration the target of a revision had before it, the rollback is a
+// revision itself
+func rollbackConfigRevision(ctx context.Context, client MinioAdmin, store *confighistory.Store, id string) (restart bool, err error) {
+	revID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, ErrConfigRevisionNotFound
+	}
+	rev, ok := store.Get(revID)
+	if !ok {
+		return false, ErrConfigRevisionNotFound
+	}
+	ctx = context.WithValue(ctx, configRollbackKey{}, rev.ID)
+	if rev.Previous == "" {
+		// the target wasn't configured before the revision
+		return true, client.delConfigKV(ctx, rev.Target)
+	}
+	return client.setConfigKV(ctx, rev.Previous)
+}
+
+// configRevisionsResponse returns the revisions with the secrets of their configurations redacted
+func configRevisionsResponse(revisions []confighistory.Revision) *models.ConfigRevisions {
+	resp := &models.ConfigRevisions{Revisions: []*models.ConfigRevision{}}
+	for _, rev := range revisions {
+		resp.Revisions = append(resp.Revisions, &models.ConfigRevision{
+			ID:         rev.ID,
+			Target:     rev.Target,
+			Time:       rev.Time.Format(time.RFC3339),
+			User:       rev.User,
+			Action:     rev.Action,
+			Previous:   string(redactServerConfig([]byte(rev.Previous))),
+			Config:     string(redactServerConfig([]byte(rev.Config))),
+			RollbackOf: rev.RollbackOf,
+		})
+	}
+	return resp
+}
+
+func getListConfigRevisionsResponse(session *models.Principal, params cfgApi.ListConfigRevisionsParams) (*models.ConfigRevisions, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// the revisions are as sensitive as the configuration itself
+	if err = checkServerConfigAccess(ctx, AdminClient{Client: mAdmin}); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	target := ""
+	if params.Target != nil {
+		target = *params.Target
+	}
+	return configRevisionsResponse(configHistory().List(target)), nil
+}
+
+func getRollbackConfigRevisionResponse(session *models.Principal, params cfgApi.RollbackConfigRevisionParams) (*models.SetConfigResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	restart, err := rollbackConfigRevision(ctx, AdminClient{Client: mAdmin}, configHistory(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.SetConfigResponse{Restart: restart}, nil
+}
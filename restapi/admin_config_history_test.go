@@ -0,0 +1,102 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/console/pkg/confighistory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrackConfigChange(t *testing.T) {
+	assert := assert.New(t)
+	store, err := confighistory.New("", 10)
+	assert.NoError(err)
+	ctx := context.WithValue(context.Background(), consoleAuditActorKey{}, &consoleAuditActor{user: "alice"})
+	adminClient := AdminClientMock{}
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte("# MINIO_IDENTITY_OPENID_CLIENT_ID=console\nidentity_openid config_url=https://idp client_secret=s3cr3t\n"), nil
+	}
+
+	// the configuration of the target before the change is recorded once MinIO applied it
+	kv := "identity_openid client_secret=n3w"
+	assert.NoError(trackConfigChange(ctx, adminClient, store, confighistory.ActionSet, kv, func() error { return nil }))
+	revisions := store.List("identity_openid")
+	assert.Len(revisions, 1)
+	assert.Equal("alice", revisions[0].User)
+	assert.Equal("identity_openid config_url=https://idp client_secret=s3cr3t", revisions[0].Previous)
+	assert.Equal(kv, revisions[0].Config)
+
+	// the revisions are listed without their secrets
+	resp := configRevisionsResponse(revisions)
+	assert.Equal("identity_openid config_url=https://idp client_secret=REDACTED", resp.Revisions[0].Previous)
+	assert.Equal("identity_openid client_secret=REDACTED", resp.Revisions[0].Config)
+
+	// a change MinIO rejects isn't recorded
+	applyErr := errors.New("invalid value")
+	assert.Equal(applyErr, trackConfigChange(ctx, adminClient, store, confighistory.ActionSet, kv, func() error { return applyErr }))
+	assert.Len(store.List(""), 1)
+
+	// the history doesn't block a change when the previous configuration can't be read
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return nil, errors.New("connection refused")
+	}
+	assert.NoError(trackConfigChange(ctx, adminClient, store, confighistory.ActionReset, "region", func() error { return nil }))
+	assert.Len(store.List(""), 1)
+}
+
+func TestRollbackConfigRevision(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	store, err := confighistory.New("", 10)
+	assert.NoError(err)
+	adminClient := AdminClientMock{}
+	set, err := store.Record(confighistory.Revision{Target: "region", Action: confighistory.ActionSet, Previous: "region name=us-east-1", Config: "region name=us-west-1"})
+	assert.NoError(err)
+	added, err := store.Record(confighistory.Revision{Target: "notify_webhook:ops", Action: confighistory.ActionSet, Config: "notify_webhook:ops endpoint=https://hooks"})
+	assert.NoError(err)
+
+	// the previous configuration of the target is restored
+	var applied string
+	minioSetConfigKVMock = func(kv string) (restart bool, err error) {
+		applied = kv
+		return true, nil
+	}
+	restart, err := rollbackConfigRevision(ctx, adminClient, store, "1")
+	assert.NoError(err)
+	assert.True(restart)
+	assert.Equal("region name=us-east-1", applied)
+	assert.Equal(int64(1), set.ID)
+
+	// a target that wasn't configured before is reset
+	var reset string
+	minioDelConfigKVMock = func(name string) (err error) {
+		reset = name
+		return nil
+	}
+	_, err = rollbackConfigRevision(ctx, adminClient, store, "2")
+	assert.NoError(err)
+	assert.Equal(added.Target, reset)
+
+	for _, id := range []string{"3", "latest"} {
+		_, err = rollbackConfigRevision(ctx, adminClient, store, id)
+		assert.ErrorIs(err, ErrConfigRevisionNotFound)
+	}
+}
@@ -0,0 +1,299 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	cfgApi "github.com/minio/console/restapi/operations/configuration"
+	madmin "github.com/minio/madmin-go/v2"
+)
+
+// largest server configuration document accepted for import
+const maxServerConfigSize = 10 << 20
+
+// serverConfigRedacted replaces the secrets of a redacted export, a document with it can't be imported
+const serverConfigRedacted = "REDACTED"
+
+// serverConfigSecretKeys are the keys of the server configuration holding secrets
+var serverConfigSecretKeys = map[string]bool{
+	"api_key":              true,
+	"auth_token":           true,
+	"client_secret":        true,
+	"connection_string":    true,
+	"dsn_string":           true,
+	"lookup_bind_password": true,
+	"password":             true,
+	"sasl_password":        true,
+	"secret_key":           true,
+}
+
+// serverConfigKV is a key value of a server configuration line, start and end delimit its value in the line,
+// quotes included
+type serverConfigKV struct {
+	key, value string
+	start, end int
+}
+
+// serverConfigEntry is a line of a server configuration document, the key values of a subsystem target
+type serverConfigEntry struct {
+	name string
+	kvs  []serverConfigKV
+}
+
+func registerServerConfigTransferHandlers(api *operations.ConsoleAPI) {
+	// export the server configuration
+	api.ConfigurationExportServerConfigHandler = cfgApi.ExportServerConfigHandlerFunc(func(params cfgApi.ExportServerConfigParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportServerConfigResponse(session, params)
+		if err != nil {
+			return cfgApi.NewExportServerConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		return resp
+	})
+	// import a server configuration
+	api.ConfigurationImportServerConfigHandler = cfgApi.ImportServerConfigHandlerFunc(func(params cfgApi.ImportServerConfigParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportServerConfigResponse(session, params)
+		if err != nil {
+			return cfgApi.NewImportServerConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		return cfgApi.NewImportServerConfigOK().WithPayload(resp)
+	})
+}
+
+// parseServerConfigLine splits a line of the form `subsys[:target] key=value key="quoted value"`
+func parseServerConfigLine(line string) (serverConfigEntry, error) {
+	var entry serverConfigEntry
+	i := strings.IndexByte(line, ' ')
+	if i < 0 {
+		i = len(line)
+	}
+	entry.name = line[:i]
+	for i < len(line) {
+		if line[i] == ' ' {
+			i++
+			continue
+		}
+		eq := strings.IndexByte(line[i:], '=')
+		if eq <= 0 || strings.IndexByte(line[i:i+eq], ' ') >= 0 {
+			return entry, fmt.Errorf("%s: expected key=value at %q", entry.name, line[i:])
+		}
+		kv := serverConfigKV{key: line[i : i+eq], start: i + eq + 1}
+		i = kv.start
+		if i < len(line) && (line[i] == '"' || line[i] == '\'') {
+			end := strings.IndexByte(line[i+1:], line[i])
+			if end < 0 {
+				return entry, fmt.Errorf("%s: unterminated value of %s", entry.name, kv.key)
+			}
+			kv.value = line[i+1 : i+1+end]
+			i += end + 2
+		} else {
+			end := strings.IndexByte(line[i:], ' ')
+			if end < 0 {
+				end = len(line) - i
+			}
+			kv.value = line[i : i+end]
+			i += end
+		}
+		kv.end = i
+		entry.kvs = append(entry.kvs, kv)
+	}
+	return entry, nil
+}
+
+// parseServerConfig validates a server configuration document and returns its entries. The comments, and the
+// subsystems disabled with one, are skipped like MinIO does.
+func parseServerConfig(doc []byte) ([]serverConfigEntry, error) {
+	var entries []serverConfigEntry
+	names := map[string]bool{}
+	for n, line := range strings.Split(string(doc), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entry, err := parseServerConfigLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidServerConfig, n+1, err)
+		}
+		subSys := strings.SplitN(entry.name, ":", 2)[0]
+		if !madmin.SubSystems.Contains(subSys) {
+			return nil, fmt.Errorf("%w: line %d: unknown subsystem %s", ErrInvalidServerConfig, n+1, subSys)
+		}
+		if names[entry.name] {
+			return nil, fmt.Errorf("%w: line %d: %s is configured twice", ErrInvalidServerConfig, n+1, entry.name)
+		}
+		names[entry.name] = true
+		for _, kv := range entry.kvs {
+			if serverConfigSecretKeys[kv.key] && kv.value == serverConfigRedacted {
+				return nil, fmt.Errorf("%w: line %d: the %s of %s was redacted by the export", ErrInvalidServerConfig, n+1, kv.key, entry.name)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: the document doesn't configure any subsystem", ErrInvalidServerConfig)
+	}
+	return entries, nil
+}
+
+// redactServerConfig replaces the secrets of a server configuration document, including the ones of the
+// subsystems disabled with a comment
+func redactServerConfig(doc []byte) []byte {
+	lines := strings.Split(string(doc), "\n")
+	for n, line := range lines {
+		prefix := ""
+		if strings.HasPrefix(line, "# ") {
+			prefix, line = "# ", strings.TrimPrefix(line, "# ")
+		}
+		entry, err := parseServerConfigLine(line)
+		if err != nil {
+			continue
+		}
+		for i := len(entry.kvs) - 1; i >= 0; i-- {
+			kv := entry.kvs[i]
+			if serverConfigSecretKeys[kv.key] && kv.value != "" {
+				line = line[:kv.start] + serverConfigRedacted + line[kv.end:]
+			}
+		}
+		lines[n] = prefix + line
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// serverConfigValues returns the key values of every entry by name
+func serverConfigValues(entries []serverConfigEntry) map[string]map[string]string {
+	values := map[string]map[string]string{}
+	for _, entry := range entries {
+		kvs := map[string]string{}
+		for _, kv := range entry.kvs {
+			kvs[kv.key] = kv.value
+		}
+		values[entry.name] = kvs
+	}
+	return values
+}
+
+// diffServerConfigs reports the subsystem targets the import adds, updates and removes. MinIO replaces the whole
+// configuration, the targets missing from the document are reset to their defaults.
+func diffServerConfigs(current, incoming []serverConfigEntry) *models.ServerConfigImportResponse {
+	resp := &models.ServerConfigImportResponse{Added: []string{}, Updated: []string{}, Removed: []string{}}
+	currentValues := serverConfigValues(current)
+	incomingValues := serverConfigValues(incoming)
+	for _, entry := range incoming {
+		existing, ok := currentValues[entry.name]
+		switch {
+		case !ok:
+			resp.Added = append(resp.Added, entry.name)
+		case fmt.Sprint(existing) != fmt.Sprint(incomingValues[entry.name]):
+			resp.Updated = append(resp.Updated, entry.name)
+		default:
+			resp.Unchanged++
+		}
+	}
+	for _, entry := range current {
+		if _, ok := incomingValues[entry.name]; !ok {
+			resp.Removed = append(resp.Removed, entry.name)
+		}
+	}
+	sort.Strings(resp.Added)
+	sort.Strings(resp.Updated)
+	sort.Strings(resp.Removed)
+	return resp
+}
+
+// importServerConfig validates the document, compares it with the configuration of the cluster and, unless it is
+// a dry run, imports it. MinIO applies an imported configuration on restart.
+func importServerConfig(ctx context.Context, client MinioAdmin, doc []byte, dryRun bool) (*models.ServerConfigImportResponse, error) {
+	incoming, err := parseServerConfig(doc)
+	if err != nil {
+		return nil, err
+	}
+	currentDoc, err := client.getServerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := parseServerConfig(currentDoc)
+	if err != nil {
+		return nil, err
+	}
+	resp := diffServerConfigs(current, incoming)
+	resp.DryRun = dryRun
+	resp.RestartRequired = len(resp.Added)+len(resp.Updated)+len(resp.Removed) > 0
+	if dryRun {
+		return resp, nil
+	}
+	if err = client.setServerConfig(ctx, bytes.NewReader(doc)); err != nil {
+		return nil, err
+	}
+	resp.Imported = true
+	return resp, nil
+}
+
+func getExportServerConfigResponse(session *models.Principal, params cfgApi.ExportServerConfigParams) (middleware.Responder, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	doc, err := AdminClient{Client: mAdmin}.getServerConfig(ctx)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if params.RedactSecrets != nil && *params.RedactSecrets {
+		doc = redactServerConfig(doc)
+	}
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"server-config-%s.txt\"", time.Now().UTC().Format("20060102150405")))
+		if _, err := w.Write(doc); err != nil {
+			LogError("Unable to write the server configuration export: %v", err)
+		}
+	}), nil
+}
+
+func getImportServerConfigResponse(session *models.Principal, params cfgApi.ImportServerConfigParams) (*models.ServerConfigImportResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	defer params.File.Close()
+	doc, err := io.ReadAll(io.LimitReader(params.File, maxServerConfigSize+1))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if len(doc) > maxServerConfigSize {
+		return nil, ErrorWithContext(ctx, fmt.Errorf("%w: the file is larger than %d bytes", ErrInvalidServerConfig, maxServerConfigSize))
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp, err := importServerConfig(ctx, AdminClient{Client: mAdmin}, doc, params.DryRun != nil && *params.DryRun)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
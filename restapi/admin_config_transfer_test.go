@@ -0,0 +1,114 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testServerConfig = `api requests_max=0 requests_deadline=10s
+region name=us-east-1
+identity_openid config_url=https://idp/.well-known/openid-configuration client_id=console client_secret="s3cr3t value"
+# notify_webhook:primary enable=off endpoint=https://hooks/primary auth_token=token
+notify_webhook:audit endpoint=https://hooks/audit auth_token=
+`
+
+func TestRedactServerConfig(t *testing.T) {
+	assert := assert.New(t)
+	redacted := string(redactServerConfig([]byte(testServerConfig)))
+	assert.NotContains(redacted, "s3cr3t")
+	assert.Contains(redacted, "client_id=console client_secret=REDACTED\n")
+	// the secrets of the disabled subsystems are redacted too
+	assert.Contains(redacted, "# notify_webhook:primary enable=off endpoint=https://hooks/primary auth_token=REDACTED\n")
+	// empty secrets are left empty
+	assert.Contains(redacted, "auth_token=\n")
+
+	// a redacted document can't be imported, it would replace the secrets
+	_, err := parseServerConfig([]byte(redacted))
+	assert.ErrorIs(err, ErrInvalidServerConfig)
+}
+
+func TestParseServerConfig(t *testing.T) {
+	assert := assert.New(t)
+	entries, err := parseServerConfig([]byte(testServerConfig))
+	assert.NoError(err)
+	assert.Len(entries, 4)
+	assert.Equal("identity_openid", entries[2].name)
+	assert.Equal("client_secret", entries[2].kvs[2].key)
+	assert.Equal("s3cr3t value", entries[2].kvs[2].value)
+	assert.Equal("notify_webhook:audit", entries[3].name)
+
+	for _, doc := range []string{
+		"",
+		"# only comments",
+		"unknown_subsys key=value",
+		"region name",
+		"region name=\"us-east-1",
+		"region name=us-east-1\nregion name=us-west-1",
+	} {
+		_, err = parseServerConfig([]byte(doc))
+		assert.ErrorIs(err, ErrInvalidServerConfig, doc)
+	}
+}
+
+func TestImportServerConfig(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	minioGetServerConfigMock = func(ctx context.Context) ([]byte, error) {
+		return []byte(testServerConfig), nil
+	}
+	var imported []byte
+	minioSetServerConfigMock = func(ctx context.Context, config io.Reader) error {
+		imported, _ = io.ReadAll(config)
+		return nil
+	}
+
+	incoming := []byte(`api requests_max=0 requests_deadline=10s
+region name=us-west-1
+notify_webhook:audit endpoint=https://hooks/audit auth_token=
+notify_webhook:ops endpoint=https://hooks/ops auth_token=
+`)
+	resp, err := importServerConfig(ctx, adminClient, incoming, true)
+	assert.NoError(err)
+	assert.True(resp.DryRun)
+	assert.False(resp.Imported)
+	assert.Nil(imported)
+	assert.True(resp.RestartRequired)
+	assert.Equal([]string{"notify_webhook:ops"}, resp.Added)
+	assert.Equal([]string{"region"}, resp.Updated)
+	assert.Equal([]string{"identity_openid"}, resp.Removed)
+	assert.Equal(int64(2), resp.Unchanged)
+
+	resp, err = importServerConfig(ctx, adminClient, incoming, false)
+	assert.NoError(err)
+	assert.True(resp.Imported)
+	assert.Equal(incoming, imported)
+
+	// importing the current configuration changes nothing
+	resp, err = importServerConfig(ctx, adminClient, []byte(testServerConfig), true)
+	assert.NoError(err)
+	assert.False(resp.RestartRequired)
+	assert.Equal(int64(4), resp.Unchanged)
+
+	_, err = importServerConfig(ctx, adminClient, []byte("region name"), true)
+	assert.ErrorIs(err, ErrInvalidServerConfig)
+}
@@ -19,6 +19,7 @@ package restapi
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"time"
 
@@ -63,27 +64,97 @@ func startConsoleLog(ctx context.Context, conn WSConn, client MinioAdmin, logReq
 				return nil
 			}
 			if logInfo.Err != nil {
-				LogError("error on console logs: %v", logInfo.Err)
+				LogErrorContext(ctx, "error on console logs: %v", logInfo.Err)
 				return logInfo.Err
 			}
 
+			if !matchConsoleLog(logRequest, logInfo) {
+				continue
+			}
+
 			// Serialize message to be sent
 			bytes, err := json.Marshal(serializeConsoleLogInfo(&logInfo))
 			if err != nil {
-				LogError("error on json.Marshal: %v", err)
+				LogErrorContext(ctx, "error on json.Marshal: %v", err)
 				return err
 			}
 
 			// Send Message through websocket connection
 			err = conn.writeMessage(websocket.TextMessage, bytes)
 			if err != nil {
-				LogError("error writeMessage: %v", err)
+				LogErrorContext(ctx, "error writeMessage: %v", err)
 				return err
 			}
 		}
 	}
 }
 
+// newLogRequest returns the filters of a log request, the time range is given as RFC3339 times
+func newLogRequest(node, level, query, since, until string) (LogRequest, error) {
+	logRequest := LogRequest{node: node, level: level, query: query}
+	var err error
+	if since != "" {
+		if logRequest.since, err = time.Parse(time.RFC3339, since); err != nil {
+			return logRequest, fmt.Errorf("%w: invalid start time %q", ErrInvalidLogQuery, since)
+		}
+	}
+	if until != "" {
+		if logRequest.until, err = time.Parse(time.RFC3339, until); err != nil {
+			return logRequest, fmt.Errorf("%w: invalid end time %q", ErrInvalidLogQuery, until)
+		}
+	}
+	if !logRequest.since.IsZero() && !logRequest.until.IsZero() && logRequest.until.Before(logRequest.since) {
+		return logRequest, fmt.Errorf("%w: the end time is before the start time", ErrInvalidLogQuery)
+	}
+	return logRequest, nil
+}
+
+// matchLogTime returns whether the time of a log entry is within the time range of the request, entries without
+// a time only match requests without a range
+func matchLogTime(logRequest LogRequest, entryTime string) bool {
+	if logRequest.since.IsZero() && logRequest.until.IsZero() {
+		return true
+	}
+	tm, err := time.Parse(time.RFC3339Nano, entryTime)
+	if err != nil {
+		return false
+	}
+	return !tm.Before(logRequest.since) && (logRequest.until.IsZero() || !tm.After(logRequest.until))
+}
+
+// anyContainsFold returns whether any of the values contains the text, ignoring case
+func anyContainsFold(text string, values ...string) bool {
+	text = strings.ToLower(text)
+	for _, value := range values {
+		if strings.Contains(strings.ToLower(value), text) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchConsoleLog filters the server logs by severity, time range and text, the node and the kind of the logs
+// are filtered by MinIO
+func matchConsoleLog(logRequest LogRequest, logInfo madmin.LogInfo) bool {
+	if logRequest.level != "" && !strings.EqualFold(logInfo.Level, logRequest.level) {
+		return false
+	}
+	if !matchLogTime(logRequest, logInfo.Time) {
+		return false
+	}
+	if logRequest.query == "" {
+		return true
+	}
+	values := []string{logInfo.ConsoleMsg, logInfo.Message}
+	if logInfo.API != nil {
+		values = append(values, logInfo.API.Name)
+	}
+	if logInfo.Trace != nil {
+		values = append(values, logInfo.Trace.Message)
+	}
+	return anyContainsFold(logRequest.query, values...)
+}
+
 func serializeConsoleLogInfo(l *madmin.LogInfo) (logInfo madmin.LogInfo) {
 	logInfo = *l
 	if logInfo.ConsoleMsg != "" {
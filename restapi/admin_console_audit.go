@@ -0,0 +1,296 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/actionaudit"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	logApi "github.com/minio/console/restapi/operations/logging"
+)
+
+// consoleAuditMaxBody bounds the request body recorded along a console audit event
+const consoleAuditMaxBody = 64 << 10
+
+var (
+	globalActionAudit     *actionaudit.Log
+	globalActionAuditOnce sync.Once
+)
+
+// actionAudit returns the log of the console actions, configured from the environment
+func actionAudit() *actionaudit.Log {
+	globalActionAuditOnce.Do(func() {
+		globalActionAudit = newActionAudit()
+	})
+	return globalActionAudit
+}
+
+func newActionAudit() *actionaudit.Log {
+	history := getConsoleActionAuditHistory()
+	var sinks []actionaudit.Sink
+	var recent []actionaudit.Event
+	if path := getConsoleActionAuditFile(); path != "" {
+		events, err := actionaudit.ReadFile(path, history)
+		if err != nil {
+			LogError("unable to read the console audit events of %s: %v", path, err)
+		}
+		recent = events
+		sink, err := actionaudit.NewFileSink(path)
+		if err != nil {
+			LogError("unable to open the console audit file %s: %v", path, err)
+		} else {
+			sinks = append(sinks, sink)
+		}
+	}
+	if endpoint := getConsoleActionAuditWebhookEndpoint(); endpoint != "" {
+		sinks = append(sinks, actionaudit.NewWebhookSink(endpoint, getConsoleActionAuditWebhookAuthToken(), GetConsoleHTTPClient(endpoint)))
+	}
+	if endpoint := getConsoleActionAuditKafkaRESTURL(); endpoint != "" {
+		sinks = append(sinks, actionaudit.NewKafkaSink(endpoint, getConsoleActionAuditKafkaTopic(), GetConsoleHTTPClient(endpoint)))
+	}
+	log := actionaudit.New(history, sinks, func(sink actionaudit.Sink, err error) {
+		LogError("unable to send a console audit event to %s: %v", sink, err)
+	})
+	log.Restore(recent)
+	return log
+}
+
+func registerConsoleAuditHandlers(api *operations.ConsoleAPI) {
+	// list the recent console audit events
+	api.LoggingListConsoleAuditEventsHandler = logApi.ListConsoleAuditEventsHandlerFunc(func(params logApi.ListConsoleAuditEventsParams, session *models.Principal) middleware.Responder {
+		eventsResp, err := getListConsoleAuditEventsResponse(session, params)
+		if err != nil {
+			return logApi.NewListConsoleAuditEventsDefault(int(err.Code)).WithPayload(err)
+		}
+		return logApi.NewListConsoleAuditEventsOK().WithPayload(eventsResp)
+	})
+}
+
+// getListConsoleAuditEventsResponse returns the recent console audit events matching the params, newest first
+func getListConsoleAuditEventsResponse(session *models.Principal, params logApi.ListConsoleAuditEventsParams) (*models.ConsoleAuditEvents, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasLogSearchAccess(sessionResp.Permissions) {
+		return nil, ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	filter, errFilter := consoleAuditFilter(params)
+	if errFilter != nil {
+		return nil, ErrorWithContext(ctx, errFilter)
+	}
+	return consoleAuditEventsResponse(actionAudit().Query(filter)), nil
+}
+
+// consoleAuditFilter returns the filter of the console audit events requested by params
+func consoleAuditFilter(params logApi.ListConsoleAuditEventsParams) (actionaudit.Filter, error) {
+	var filter actionaudit.Filter
+	if params.User != nil {
+		filter.User = *params.User
+	}
+	if params.Operation != nil {
+		filter.Operation = *params.Operation
+	}
+	if params.Result != nil {
+		filter.Result = *params.Result
+	}
+	if params.Since != nil && *params.Since != "" {
+		since, err := time.Parse(time.RFC3339, *params.Since)
+		if err != nil {
+			return filter, ErrBadRequest
+		}
+		filter.Since = since
+	}
+	if params.Limit != nil && *params.Limit > 0 {
+		filter.Limit = int(*params.Limit)
+	}
+	return filter, nil
+}
+
+func consoleAuditEventsResponse(events []actionaudit.Event) *models.ConsoleAuditEvents {
+	resp := &models.ConsoleAuditEvents{Events: []*models.ConsoleAuditEvent{}}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &models.ConsoleAuditEvent{
+			Time:      event.Time.UTC().Format(time.RFC3339),
+			RequestID: event.RequestID,
+			User:      event.User,
+			SourceIP:  event.SourceIP,
+			Operation: event.Operation,
+			Method:    event.Method,
+			Path:      event.Path,
+			Params:    event.Params,
+			Status:    int32(event.Status),
+			Result:    event.Result,
+			Error:     event.Error,
+		})
+	}
+	return resp
+}
+
+// consoleAuditActorKey is the context key of the consoleAuditActor of an audited request
+type consoleAuditActorKey struct{}
+
+// consoleAuditActor is filled by actionAuditAuthorizer with the user performing the audited request
+type consoleAuditActor struct {
+	user string
+}
+
+// actionAuditAuthorizer is a runtime.Authorizer that tells ConsoleActionAuditMiddleware who performs the
+// request, the authorization itself is left to next
+type actionAuditAuthorizer struct {
+	next runtime.Authorizer
+}
+
+// Authorize implements runtime.Authorizer
+func (a actionAuditAuthorizer) Authorize(r *http.Request, principal interface{}) error {
+	if actor, ok := r.Context().Value(consoleAuditActorKey{}).(*consoleAuditActor); ok {
+		if session, ok := principal.(*models.Principal); ok && session != nil {
+			actor.user = consoleAuditUser(session)
+		}
+	}
+	if a.next == nil {
+		return nil
+	}
+	return a.next.Authorize(r, principal)
+}
+
+// consoleAuditUser returns the user of the session, the IDP users are named by the claims of their token
+func consoleAuditUser(session *models.Principal) string {
+	if session.AccountAccessKey != "" {
+		return session.AccountAccessKey
+	}
+	claims, _ := getClaimsFromToken(session.STSSessionToken)
+	for _, claim := range []string{"preferred_username", "sub", "parent"} {
+		if user, ok := claims[claim].(string); ok && user != "" {
+			return user
+		}
+	}
+	return session.STSAccessKeyID
+}
+
+// isConsoleAuditedMethod returns whether requests with method change state and are audited
+func isConsoleAuditedMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// consoleAuditParams returns the route, query and JSON body parameters of the request, the body is restored
+// for the handler
+func consoleAuditParams(r *http.Request, route *middleware.MatchedRoute) map[string]interface{} {
+	params := map[string]interface{}{}
+	for _, param := range route.Params {
+		params[param.Name] = param.Value
+	}
+	for name, values := range r.URL.Query() {
+		if len(values) == 1 {
+			params[name] = values[0]
+		} else {
+			params[name] = values
+		}
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if r.Body == nil || mediaType != "application/json" {
+		return params
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, consoleAuditMaxBody+1))
+	r.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), r.Body))
+	if err != nil || len(data) > consoleAuditMaxBody {
+		return params
+	}
+	var body interface{}
+	if err := json.Unmarshal(data, &body); err == nil {
+		params["body"] = body
+	}
+	return params
+}
+
+// consoleAuditError returns the message of the error response body
+func consoleAuditError(body []byte) string {
+	var apiErr models.Error
+	if err := json.Unmarshal(body, &apiErr); err != nil {
+		return ""
+	}
+	if apiErr.DetailedMessage != nil && *apiErr.DetailedMessage != "" {
+		return *apiErr.DetailedMessage
+	}
+	if apiErr.Message != nil {
+		return *apiErr.Message
+	}
+	return ""
+}
+
+// ConsoleActionAuditMiddleware records who performed every console operation that changes state, with which
+// parameters and how it ended
+func ConsoleActionAuditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if !isConsoleAuditedMethod(r.Method) || route == nil || route.Operation == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		params := consoleAuditParams(r, route)
+		actor := &consoleAuditActor{}
+		rw := logger.NewResponseWriter(w)
+		rw.LogErrBody = true
+		next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), consoleAuditActorKey{}, actor)))
+
+		event := actionaudit.Event{
+			Time:      time.Now().UTC(),
+			User:      actor.user,
+			SourceIP:  realip.ClientIP(r),
+			Operation: route.Operation.ID,
+			Method:    r.Method,
+			Path:      r.URL.Path,
+			Params:    actionaudit.Redact(params),
+			Status:    rw.StatusCode,
+			Result:    actionaudit.ResultSuccess,
+		}
+		if requestID, ok := r.Context().Value(utils.ContextRequestID).(string); ok {
+			event.RequestID = requestID
+		}
+		// the logins are anonymous, they are performed by the access key they authenticate
+		if body, ok := params["body"].(map[string]interface{}); ok && route.Operation.ID == "Login" {
+			if accessKey, ok := body["accessKey"].(string); ok {
+				event.User = accessKey
+			}
+		}
+		if rw.StatusCode >= http.StatusBadRequest {
+			event.Result = actionaudit.ResultFailure
+			event.Error = consoleAuditError(rw.Body())
+		}
+		actionAudit().Record(event)
+	})
+}
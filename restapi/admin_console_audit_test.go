@@ -0,0 +1,105 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/actionaudit"
+	logApi "github.com/minio/console/restapi/operations/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_consoleAuditParams(t *testing.T) {
+	assert := assert.New(t)
+
+	body := `{"accessKey": "alice", "secretKey": "hunter22", "groups": ["ops"]}`
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/users?force=true", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	route := &middleware.MatchedRoute{Params: middleware.RouteParams{{Name: "name", Value: "images"}}}
+
+	params := consoleAuditParams(r, route)
+	assert.Equal("images", params["name"])
+	assert.Equal("true", params["force"])
+	assert.Equal("hunter22", params["body"].(map[string]interface{})["secretKey"])
+	assert.Equal(actionaudit.Redacted, actionaudit.Redact(params)["body"].(map[string]interface{})["secretKey"])
+
+	// the handler still reads the whole body
+	data, err := io.ReadAll(r.Body)
+	assert.NoError(err)
+	assert.Equal(body, string(data))
+
+	// uploads aren't recorded
+	r = httptest.NewRequest(http.MethodPost, "/api/v1/buckets/images/objects/upload", strings.NewReader("data"))
+	r.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+	assert.NotContains(consoleAuditParams(r, &middleware.MatchedRoute{}), "body")
+}
+
+func Test_isConsoleAuditedMethod(t *testing.T) {
+	assert.True(t, isConsoleAuditedMethod(http.MethodPost))
+	assert.True(t, isConsoleAuditedMethod(http.MethodPut))
+	assert.True(t, isConsoleAuditedMethod(http.MethodDelete))
+	assert.False(t, isConsoleAuditedMethod(http.MethodGet))
+	assert.False(t, isConsoleAuditedMethod(http.MethodHead))
+}
+
+func Test_consoleAuditError(t *testing.T) {
+	assert.Equal(t, "user already exists", consoleAuditError([]byte(`{"code": 409, "message": "conflict", "detailedMessage": "user already exists"}`)))
+	assert.Equal(t, "unauthenticated for invalid credentials", consoleAuditError([]byte(`{"code": 401, "message": "unauthenticated for invalid credentials"}`)))
+	assert.Equal(t, "", consoleAuditError([]byte("<BODY>")))
+}
+
+func Test_actionAuditAuthorizer(t *testing.T) {
+	actor := &consoleAuditActor{}
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/buckets/images", nil)
+	r = r.WithContext(context.WithValue(r.Context(), consoleAuditActorKey{}, actor))
+
+	assert.NoError(t, actionAuditAuthorizer{}.Authorize(r, &models.Principal{AccountAccessKey: "alice"}))
+	assert.Equal(t, "alice", actor.user)
+
+	// requests that aren't audited are authorized as usual
+	assert.NoError(t, actionAuditAuthorizer{}.Authorize(httptest.NewRequest(http.MethodGet, "/api/v1/buckets", nil), &models.Principal{}))
+}
+
+func Test_consoleAuditFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	limit := float64(10)
+	filter, err := consoleAuditFilter(logApi.ListConsoleAuditEventsParams{
+		User:   swag.String("alice"),
+		Result: swag.String(actionaudit.ResultFailure),
+		Since:  swag.String("2023-05-01T10:00:00Z"),
+		Limit:  &limit,
+	})
+	assert.NoError(err)
+	assert.Equal("alice", filter.User)
+	assert.Equal(actionaudit.ResultFailure, filter.Result)
+	assert.Equal(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC), filter.Since.UTC())
+	assert.Equal(10, filter.Limit)
+
+	_, err = consoleAuditFilter(logApi.ListConsoleAuditEventsParams{Since: swag.String("yesterday")})
+	assert.ErrorIs(err, ErrBadRequest)
+}
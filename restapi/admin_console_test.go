@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/minio/madmin-go/v2"
 	"github.com/stretchr/testify/assert"
@@ -115,3 +116,46 @@ func TestAdminConsoleLog(t *testing.T) {
 		assert.Equal("error on Console", err.Error())
 	}
 }
+
+func Test_newLogRequest(t *testing.T) {
+	assert := assert.New(t)
+
+	logRequest, err := newLogRequest("node1:9000", "ERROR", "disk", "2023-03-01T10:00:00Z", "2023-03-01T11:00:00Z")
+	if assert.NoError(err) {
+		assert.Equal("node1:9000", logRequest.node)
+		assert.Equal("ERROR", logRequest.level)
+		assert.Equal("disk", logRequest.query)
+		assert.Equal(time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC), logRequest.since.UTC())
+		assert.Equal(time.Date(2023, 3, 1, 11, 0, 0, 0, time.UTC), logRequest.until.UTC())
+	}
+
+	_, err = newLogRequest("", "", "", "yesterday", "")
+	assert.ErrorIs(err, ErrInvalidLogQuery)
+	_, err = newLogRequest("", "", "", "2023-03-01T11:00:00Z", "2023-03-01T10:00:00Z")
+	assert.ErrorIs(err, ErrInvalidLogQuery)
+}
+
+func Test_matchConsoleLog(t *testing.T) {
+	assert := assert.New(t)
+
+	var logInfo madmin.LogInfo
+	assert.NoError(json.Unmarshal([]byte(`{
+		"level": "ERROR",
+		"time": "2023-03-01T10:30:00.123Z",
+		"api": {"name": "PutObject"},
+		"error": {"message": "drive /data3 is offline"}
+	}`), &logInfo))
+
+	since := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	assert.True(matchConsoleLog(LogRequest{}, logInfo))
+	assert.True(matchConsoleLog(LogRequest{level: "error", query: "DRIVE /data3"}, logInfo))
+	assert.True(matchConsoleLog(LogRequest{query: "putobject", since: since, until: since.Add(time.Hour)}, logInfo))
+
+	assert.False(matchConsoleLog(LogRequest{level: "WARNING"}, logInfo))
+	assert.False(matchConsoleLog(LogRequest{query: "bucket"}, logInfo))
+	assert.False(matchConsoleLog(LogRequest{since: since.Add(time.Hour)}, logInfo))
+	assert.False(matchConsoleLog(LogRequest{until: since}, logInfo))
+
+	// console messages are matched by their text
+	assert.True(matchConsoleLog(LogRequest{query: "healing"}, madmin.LogInfo{ConsoleMsg: "Healing drive /data3"}))
+}
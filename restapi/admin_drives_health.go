@@ -0,0 +1,197 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+const (
+	// drivesHealthDeadline bounds how long the servers take to collect the hardware details of their drives
+	drivesHealthDeadline = 10 * time.Second
+	// driveSpaceWarning and driveInodesWarning are the percentages of used space and inodes drives are
+	// flagged at
+	driveSpaceWarning  = 90
+	driveInodesWarning = 95
+	// slowDriveLatency is the average latency of the calls of the last minute drives are flagged at
+	slowDriveLatency = 500 * time.Millisecond
+)
+
+func registerDrivesHealthHandlers(api *operations.ConsoleAPI) {
+	// hardware details and health indicators of every drive
+	api.SystemGetDrivesHealthHandler = systemApi.GetDrivesHealthHandlerFunc(func(params systemApi.GetDrivesHealthParams, session *models.Principal) middleware.Responder {
+		health, err := getDrivesHealthResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetDrivesHealthDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetDrivesHealthOK().WithPayload(health)
+	})
+}
+
+// drivePartitions returns the partitions the servers reported in their health info, keyed by server
+// and mount point
+func drivePartitions(info madmin.HealthInfo) map[string]madmin.Partition {
+	partitions := map[string]madmin.Partition{}
+	for _, node := range info.Sys.Partitions {
+		for _, partition := range node.Partitions {
+			partitions[node.Addr+partition.Mountpoint] = partition
+		}
+	}
+	return partitions
+}
+
+// driveLastMinute returns the calls the drive served in the last minute and their average latency
+func driveLastMinute(metrics *madmin.DiskMetrics) (int64, time.Duration) {
+	if metrics == nil {
+		return 0, 0
+	}
+	var count, acc uint64
+	for _, action := range metrics.LastMinute {
+		count += action.Count
+		acc += action.AccTime
+	}
+	if count == 0 {
+		return 0, 0
+	}
+	return int64(count), time.Duration(acc / count)
+}
+
+// driveWarnings lists the signs of a drive failing or running out of room
+func driveWarnings(drive *models.DriveHealth, avgLatency time.Duration) []string {
+	warnings := []string{}
+	if drive.State != madmin.DriveStateOk {
+		warnings = append(warnings, fmt.Sprintf("MinIO reports the drive %s", drive.State))
+	}
+	if drive.HardwareError != "" {
+		warnings = append(warnings, "the hardware details can't be read: "+drive.HardwareError)
+	}
+	if drive.Healing {
+		warnings = append(warnings, "the drive is healing")
+	}
+	if drive.TotalSpace > 0 && drive.UsedSpace*100 >= drive.TotalSpace*driveSpaceWarning {
+		warnings = append(warnings, fmt.Sprintf("%d%% of the space is used", drive.UsedSpace*100/drive.TotalSpace))
+	}
+	if inodes := drive.UsedInodes + drive.FreeInodes; inodes > 0 && drive.UsedInodes*100 >= inodes*driveInodesWarning {
+		warnings = append(warnings, fmt.Sprintf("%d%% of the inodes are used", drive.UsedInodes*100/inodes))
+	}
+	if avgLatency >= slowDriveLatency {
+		warnings = append(warnings, fmt.Sprintf("calls took %s on average in the last minute", avgLatency.Round(time.Millisecond)))
+	}
+	return warnings
+}
+
+// drivesHealth combines the drives MinIO reports with the hardware details of the health info, health info
+// is nil when the servers couldn't collect it
+func drivesHealth(info madmin.InfoMessage, health *madmin.HealthInfo) *models.DrivesHealth {
+	res := &models.DrivesHealth{Drives: []*models.DriveHealth{}}
+	var partitions map[string]madmin.Partition
+	if health != nil {
+		partitions = drivePartitions(*health)
+	}
+	for _, server := range info.Servers {
+		for _, disk := range server.Disks {
+			drive := &models.DriveHealth{
+				Server:       server.Endpoint,
+				DrivePath:    disk.DrivePath,
+				UUID:         disk.UUID,
+				State:        disk.State,
+				Healing:      disk.Healing,
+				Model:        disk.Model,
+				TotalSpace:   int64(disk.TotalSpace),
+				UsedSpace:    int64(disk.UsedSpace),
+				UsedInodes:   int64(disk.UsedInodes),
+				FreeInodes:   int64(disk.FreeInodes),
+				Utilization:  disk.Utilization,
+				ReadLatency:  disk.ReadLatency,
+				WriteLatency: disk.WriteLatency,
+			}
+			if partition, ok := partitions[server.Endpoint+disk.DrivePath]; ok {
+				drive.Device = partition.Device
+				drive.FsType = partition.FSType
+				drive.HardwareError = partition.Error
+			}
+			calls, avgLatency := driveLastMinute(disk.Metrics)
+			drive.LastMinuteCalls = calls
+			drive.LastMinuteAvgLatencyMs = math.Round(float64(avgLatency)/float64(time.Millisecond)*100) / 100
+			drive.Warnings = driveWarnings(drive, avgLatency)
+			if len(drive.Warnings) > 0 {
+				res.WithWarnings++
+			}
+			res.Drives = append(res.Drives, drive)
+		}
+	}
+	// drives with warnings first
+	sort.SliceStable(res.Drives, func(i, j int) bool {
+		if (len(res.Drives[i].Warnings) > 0) != (len(res.Drives[j].Warnings) > 0) {
+			return len(res.Drives[i].Warnings) > 0
+		}
+		if res.Drives[i].Server != res.Drives[j].Server {
+			return res.Drives[i].Server < res.Drives[j].Server
+		}
+		return res.Drives[i].DrivePath < res.Drives[j].DrivePath
+	})
+	res.Total = int64(len(res.Drives))
+	return res
+}
+
+func getDrivesHealth(ctx context.Context, client MinioAdmin) (*models.DrivesHealth, error) {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the hardware details complete the drives MinIO reports, they are left out when they can't be collected
+	var health *madmin.HealthInfo
+	var healthErr string
+	healthInfo, _, err := client.serverHealthInfo(ctx, []madmin.HealthDataType{madmin.HealthDataTypeSysDriveHw}, drivesHealthDeadline)
+	if err != nil {
+		healthErr = err.Error()
+	} else if h, ok := healthInfo.(madmin.HealthInfo); ok {
+		health = &h
+		if h.Error != "" {
+			healthErr = h.Error
+		}
+	} else {
+		healthErr = "the servers don't report the hardware details of their drives"
+	}
+	res := drivesHealth(info, health)
+	res.HardwareInfoError = healthErr
+	return res, nil
+}
+
+func getDrivesHealthResponse(session *models.Principal, params systemApi.GetDrivesHealthParams) (*models.DrivesHealth, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	health, err := getDrivesHealth(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return health, nil
+}
@@ -0,0 +1,112 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getDrivesHealth(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+			{
+				Endpoint: "node1:9000",
+				Disks: []madmin.Disk{
+					{
+						DrivePath: "/data1", State: madmin.DriveStateOk, Model: "WDC WD40EFRX", TotalSpace: 1000, UsedSpace: 200,
+						Metrics: &madmin.DiskMetrics{LastMinute: map[string]madmin.TimedAction{
+							"ReadFile":  {Count: 30, AccTime: uint64(30 * time.Millisecond)},
+							"WriteAll":  {Count: 10, AccTime: uint64(50 * time.Millisecond)},
+							"DeleteVol": {},
+						}},
+					},
+					{
+						DrivePath: "/data2", State: madmin.DriveStateOk, TotalSpace: 1000, UsedSpace: 950,
+						UsedInodes: 10, FreeInodes: 90,
+						Metrics: &madmin.DiskMetrics{LastMinute: map[string]madmin.TimedAction{
+							"ReadFile": {Count: 2, AccTime: uint64(2 * time.Second)},
+						}},
+					},
+				},
+			},
+			{
+				Endpoint: "node2:9000",
+				Disks:    []madmin.Disk{{DrivePath: "/data1", State: madmin.DriveStateOffline}},
+			},
+		}}, nil
+	}
+	minioServerHealthInfoMock = func(ctx context.Context, healthDataTypes []madmin.HealthDataType, deadline time.Duration) (interface{}, string, error) {
+		info := madmin.HealthInfo{}
+		info.Sys.Partitions = []madmin.Partitions{{
+			NodeCommon: madmin.NodeCommon{Addr: "node1:9000"},
+			Partitions: []madmin.Partition{
+				{Mountpoint: "/data1", Device: "/dev/sdb", FSType: "xfs"},
+				{Mountpoint: "/data2", Error: "permission denied"},
+			},
+		}}
+		return info, madmin.HealthInfoVersion, nil
+	}
+
+	health, err := getDrivesHealth(ctx, client)
+	assert.NoError(err)
+	assert.Equal(int64(3), health.Total)
+	assert.Equal(int64(2), health.WithWarnings)
+	assert.Empty(health.HardwareInfoError)
+	if !assert.Len(health.Drives, 3) {
+		return
+	}
+
+	// drives with warnings come first
+	full := health.Drives[0]
+	assert.Equal("/data2", full.DrivePath)
+	assert.Equal([]string{
+		"the hardware details can't be read: permission denied",
+		"95% of the space is used",
+		"calls took 1s on average in the last minute",
+	}, full.Warnings)
+
+	offline := health.Drives[1]
+	assert.Equal("node2:9000", offline.Server)
+	assert.Equal([]string{"MinIO reports the drive offline"}, offline.Warnings)
+
+	healthy := health.Drives[2]
+	assert.Equal("/dev/sdb", healthy.Device)
+	assert.Equal("WDC WD40EFRX", healthy.Model)
+	assert.Equal("xfs", healthy.FsType)
+	assert.Equal(int64(40), healthy.LastMinuteCalls)
+	assert.Equal(2.0, healthy.LastMinuteAvgLatencyMs)
+	assert.Empty(healthy.Warnings)
+
+	// the drives are still reported without their hardware details
+	minioServerHealthInfoMock = func(ctx context.Context, healthDataTypes []madmin.HealthDataType, deadline time.Duration) (interface{}, string, error) {
+		return nil, "", errors.New("deadline exceeded")
+	}
+	health, err = getDrivesHealth(ctx, client)
+	assert.NoError(err)
+	assert.Equal("deadline exceeded", health.HardwareInfoError)
+	assert.Len(health.Drives, 3)
+}
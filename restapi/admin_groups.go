@@ -328,27 +328,27 @@ func groupUpdate(ctx context.Context, client MinioAdmin, groupName string, expec
 	// get current members and status
 	groupDescription, err := groupInfo(ctx, client, groupName)
 	if err != nil {
-		LogInfo("error getting group info: %v", err)
+		LogInfoContext(ctx, "error getting group info: %v", err)
 		return nil, err
 	}
 	// update group members
 	err = addOrDeleteMembers(ctx, client, groupDescription, expectedMembers)
 	if err != nil {
-		LogInfo("error updating group: %v", err)
+		LogInfoContext(ctx, "error updating group: %v", err)
 		return nil, err
 	}
 	// update group status only if different from current status
 	if expectedStatus != groupDescription.Status {
 		err = setGroupStatus(ctx, client, groupDescription.Name, expectedStatus)
 		if err != nil {
-			LogInfo("error updating group's status: %v", err)
+			LogInfoContext(ctx, "error updating group's status: %v", err)
 			return nil, err
 		}
 	}
 	// return latest group info to verify that changes were applied correctly
 	groupDescription, err = groupInfo(ctx, client, groupName)
 	if err != nil {
-		LogInfo("error getting group info: %v", err)
+		LogInfoContext(ctx, "error getting group info: %v", err)
 		return nil, err
 	}
 	return groupDescription, nil
@@ -94,6 +94,10 @@ type healStatus struct {
 	ObjectsHealed int64 `json:"objectsHealed"`
 	ItemsHealed   int64 `json:"itemsHealed"`
 
+	// Counters for objects and all kinds of items found with corrupted parts
+	ObjectsCorrupted int64 `json:"objectsCorrupted"`
+	ItemsCorrupted   int64 `json:"itemsCorrupted"`
+
 	ItemsHealthStatus []healItemStatus `json:"itemsHealthStatus"`
 	// Map of health color code to number of objects with that
 	// health color code.
@@ -106,21 +110,26 @@ type healOptions struct {
 	Prefix     string
 	ForceStart bool
 	ForceStop  bool
+	// ClientToken follows a heal already started instead of starting one
+	ClientToken string
 	madmin.HealOpts
 }
 
 // startHeal starts healing of the servers based on heal options
 func startHeal(ctx context.Context, conn WSConn, client MinioAdmin, hOpts *healOptions) error {
-	// Initialize heal
-	healStart, _, err := client.heal(ctx, hOpts.BucketName, hOpts.Prefix, hOpts.HealOpts, "", hOpts.ForceStart, hOpts.ForceStop)
-	if err != nil {
-		LogError("error initializing healing: %v", err)
-		return err
-	}
-	if hOpts.ForceStop {
-		return nil
+	clientToken := hOpts.ClientToken
+	if clientToken == "" {
+		// Initialize heal
+		healStart, _, err := client.heal(ctx, hOpts.BucketName, hOpts.Prefix, hOpts.HealOpts, "", hOpts.ForceStart, hOpts.ForceStop)
+		if err != nil {
+			LogErrorContext(ctx, "error initializing healing: %v", err)
+			return err
+		}
+		if hOpts.ForceStop {
+			return nil
+		}
+		clientToken = healStart.ClientToken
 	}
-	clientToken := healStart.ClientToken
 	hs := healStatus{
 		HealthBeforeCols: make(map[col]int64),
 		HealthAfterCols:  make(map[col]int64),
@@ -132,7 +141,7 @@ func startHeal(ctx context.Context, conn WSConn, client MinioAdmin, hOpts *healO
 		default:
 			_, res, err := client.heal(ctx, hOpts.BucketName, hOpts.Prefix, hOpts.HealOpts, clientToken, hOpts.ForceStart, hOpts.ForceStop)
 			if err != nil {
-				LogError("error on heal: %v", err)
+				LogErrorContext(ctx, "error on heal: %v", err)
 				return err
 			}
 
@@ -193,6 +202,13 @@ func (h *healStatus) updateStats(i madmin.HealResultItem) error {
 	}
 	h.ItemsScanned++
 
+	if beforeCorrupted, _ := i.GetCorruptedCounts(); beforeCorrupted > 0 {
+		if i.Type == madmin.HealItemObject {
+			h.ObjectsCorrupted++
+		}
+		h.ItemsCorrupted++
+	}
+
 	beforeUp, afterUp := i.GetOnlineCounts()
 	if afterUp > beforeUp {
 		if i.Type == madmin.HealItemObject {
@@ -325,6 +341,7 @@ func getHealOptionsFromReq(req *http.Request) (*healOptions, error) {
 	hOptions.BucketName = strings.TrimSpace(string(matches[0][2]))
 	hOptions.Prefix = req.FormValue("prefix")
 	hOptions.HealOpts.ScanMode = transformScanStr(req.FormValue("scan"))
+	hOptions.ClientToken = req.FormValue("token")
 
 	if req.FormValue("force-start") != "" {
 		boolVal, err := strconv.ParseBool(req.FormValue("force-start"))
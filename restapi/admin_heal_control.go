@@ -0,0 +1,154 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+// healthyDriveState is the state MinIO reports for drives that are online
+const healthyDriveState = "ok"
+
+func registerHealHandlers(api *operations.ConsoleAPI) {
+	// start a heal, its progress is followed over the heal websocket with the returned client token
+	api.SystemStartHealHandler = systemApi.StartHealHandlerFunc(func(params systemApi.StartHealParams, session *models.Principal) middleware.Responder {
+		res, err := getStartHealResponse(session, params)
+		if err != nil {
+			return systemApi.NewStartHealDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewStartHealCreated().WithPayload(res)
+	})
+	// status of the background healing per erasure set
+	api.SystemGetBackgroundHealStatusHandler = systemApi.GetBackgroundHealStatusHandlerFunc(func(params systemApi.GetBackgroundHealStatusParams, session *models.Principal) middleware.Responder {
+		res, err := getBackgroundHealStatusResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetBackgroundHealStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetBackgroundHealStatusOK().WithPayload(res)
+	})
+}
+
+// startHealing starts healing a bucket, a prefix or a single object. Without a bucket the drive formats
+// are healed, drives replaced in a running deployment are picked up by the background healing.
+func startHealing(ctx context.Context, client MinioAdmin, req *models.HealRequest) (*models.HealStartResponse, error) {
+	if req.Bucket == "" && (req.Prefix != "" || req.Object != "") {
+		return nil, fmt.Errorf("%w: a bucket is required to heal a prefix or an object", ErrInvalidHealRequest)
+	}
+	if req.Prefix != "" && req.Object != "" {
+		return nil, fmt.Errorf("%w: heal either a prefix or an object", ErrInvalidHealRequest)
+	}
+	prefix := req.Prefix
+	recursive := req.Recursive
+	if req.Object != "" {
+		prefix = req.Object
+		recursive = false
+	}
+	opts := madmin.HealOpts{
+		Recursive: recursive,
+		DryRun:    req.DryRun,
+		Remove:    req.Remove,
+		ScanMode:  transformScanStr(req.ScanMode),
+	}
+	healStart, _, err := client.heal(ctx, req.Bucket, prefix, opts, "", req.ForceStart, false)
+	if err != nil {
+		return nil, err
+	}
+	return &models.HealStartResponse{
+		ClientToken:   healStart.ClientToken,
+		ClientAddress: healStart.ClientAddress,
+		StartTime:     healStart.StartTime.Format(time.RFC3339),
+	}, nil
+}
+
+func getStartHealResponse(session *models.Principal, params systemApi.StartHealParams) (*models.HealStartResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	res, err := startHealing(ctx, AdminClient{Client: mAdmin}, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return res, nil
+}
+
+// backgroundHealStatusToModel summarizes the drives of every erasure set, drives being healed report the
+// items and bytes they have healed so far
+func backgroundHealStatusToModel(state madmin.BgHealState) *models.BackgroundHealStatus {
+	res := &models.BackgroundHealStatus{
+		ScannedItems:     state.ScannedItemsCount,
+		OfflineEndpoints: []string{},
+		HealingDrives:    []string{},
+		Sets:             []*models.ErasureSetHealStatus{},
+	}
+	res.OfflineEndpoints = append(res.OfflineEndpoints, state.OfflineEndpoints...)
+	res.HealingDrives = append(res.HealingDrives, state.HealDisks...)
+	for _, set := range state.Sets {
+		setStatus := &models.ErasureSetHealStatus{
+			Pool:         swag.Int64(int64(set.PoolIndex)),
+			Set:          swag.Int64(int64(set.SetIndex)),
+			ID:           set.ID,
+			Status:       set.HealStatus,
+			Priority:     set.HealPriority,
+			TotalObjects: int64(set.TotalObjects),
+			Drives:       int64(len(set.Disks)),
+		}
+		for _, disk := range set.Disks {
+			if disk.State != healthyDriveState {
+				setStatus.OfflineDrives++
+			}
+			if !disk.Healing {
+				continue
+			}
+			setStatus.HealingDrives++
+			if disk.HealInfo != nil {
+				setStatus.ItemsHealed += int64(disk.HealInfo.ItemsHealed)
+				setStatus.ItemsFailed += int64(disk.HealInfo.ItemsFailed)
+				setStatus.BytesHealed += int64(disk.HealInfo.BytesDone)
+				setStatus.BytesFailed += int64(disk.HealInfo.BytesFailed)
+			}
+		}
+		res.Sets = append(res.Sets, setStatus)
+	}
+	return res
+}
+
+func getBackgroundHealStatusResponse(session *models.Principal, params systemApi.GetBackgroundHealStatusParams) (*models.BackgroundHealStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	state, err := adminClient.backgroundHealStatus(ctx)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return backgroundHealStatusToModel(state), nil
+}
@@ -0,0 +1,135 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartHealing(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var healedBucket, healedPrefix string
+	var healedOpts madmin.HealOpts
+	startTime := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	minioHealMock = func(ctx context.Context, bucket, prefix string, healOpts madmin.HealOpts, clientToken string,
+		forceStart, forceStop bool,
+	) (healStart madmin.HealStartSuccess, healTaskStatus madmin.HealTaskStatus, err error) {
+		healedBucket, healedPrefix, healedOpts = bucket, prefix, healOpts
+		return madmin.HealStartSuccess{ClientToken: "a1b2c3", ClientAddress: "10.0.0.1", StartTime: startTime}, madmin.HealTaskStatus{}, nil
+	}
+
+	// Test-1: heal a prefix recursively
+	res, err := startHealing(ctx, adminClient, &models.HealRequest{Bucket: "images", Prefix: "2023/", Recursive: true, ScanMode: "deep"})
+	if assert.NoError(err) {
+		assert.Equal("a1b2c3", res.ClientToken)
+		assert.Equal("10.0.0.1", res.ClientAddress)
+		assert.Equal("2023-03-01T10:00:00Z", res.StartTime)
+	}
+	assert.Equal("images", healedBucket)
+	assert.Equal("2023/", healedPrefix)
+	assert.True(healedOpts.Recursive)
+	assert.Equal(madmin.HealDeepScan, healedOpts.ScanMode)
+
+	// Test-2: a single object is never healed recursively
+	_, err = startHealing(ctx, adminClient, &models.HealRequest{Bucket: "images", Object: "2023/cat.png", Recursive: true})
+	assert.NoError(err)
+	assert.Equal("2023/cat.png", healedPrefix)
+	assert.False(healedOpts.Recursive)
+	assert.Equal(madmin.HealNormalScan, healedOpts.ScanMode)
+
+	// Test-3: a prefix or an object needs a bucket
+	_, err = startHealing(ctx, adminClient, &models.HealRequest{Prefix: "2023/"})
+	assert.ErrorIs(err, ErrInvalidHealRequest)
+	_, err = startHealing(ctx, adminClient, &models.HealRequest{Bucket: "images", Prefix: "2023/", Object: "cat.png"})
+	assert.ErrorIs(err, ErrInvalidHealRequest)
+
+	// Test-4: errors starting the heal are returned
+	minioHealMock = func(ctx context.Context, bucket, prefix string, healOpts madmin.HealOpts, clientToken string,
+		forceStart, forceStop bool,
+	) (healStart madmin.HealStartSuccess, healTaskStatus madmin.HealTaskStatus, err error) {
+		return madmin.HealStartSuccess{}, madmin.HealTaskStatus{}, errors.New("heal already running")
+	}
+	_, err = startHealing(ctx, adminClient, &models.HealRequest{Bucket: "images"})
+	if assert.Error(err) {
+		assert.Equal("heal already running", err.Error())
+	}
+}
+
+func TestBackgroundHealStatusToModel(t *testing.T) {
+	assert := assert.New(t)
+
+	state := madmin.BgHealState{
+		ScannedItemsCount: 1200,
+		OfflineEndpoints:  []string{"http://node4:9000"},
+		HealDisks:         []string{"/data2"},
+		Sets: []madmin.SetStatus{
+			{
+				ID:           "0-0",
+				HealStatus:   "healing",
+				HealPriority: "high",
+				TotalObjects: 300,
+				Disks: []madmin.Disk{
+					{State: "ok"},
+					{State: "ok", Healing: true, HealInfo: &madmin.HealingDisk{ItemsHealed: 40, ItemsFailed: 2, BytesDone: 4096, BytesFailed: 128}},
+					{State: "offline"},
+				},
+			},
+			{
+				ID:        "1-0",
+				PoolIndex: 1,
+				Disks:     []madmin.Disk{{State: "ok"}},
+			},
+		},
+	}
+
+	res := backgroundHealStatusToModel(state)
+	assert.Equal(int64(1200), res.ScannedItems)
+	assert.Equal([]string{"http://node4:9000"}, res.OfflineEndpoints)
+	assert.Equal([]string{"/data2"}, res.HealingDrives)
+	if assert.Len(res.Sets, 2) {
+		set := res.Sets[0]
+		assert.Equal(int64(0), *set.Pool)
+		assert.Equal(int64(0), *set.Set)
+		assert.Equal("healing", set.Status)
+		assert.Equal(int64(300), set.TotalObjects)
+		assert.Equal(int64(3), set.Drives)
+		assert.Equal(int64(1), set.OfflineDrives)
+		assert.Equal(int64(1), set.HealingDrives)
+		assert.Equal(int64(40), set.ItemsHealed)
+		assert.Equal(int64(2), set.ItemsFailed)
+		assert.Equal(int64(4096), set.BytesHealed)
+		assert.Equal(int64(128), set.BytesFailed)
+		assert.Equal(int64(1), *res.Sets[1].Pool)
+		assert.Equal(int64(0), res.Sets[1].OfflineDrives)
+	}
+
+	// no sets are reported on single drive deployments
+	res = backgroundHealStatusToModel(madmin.BgHealState{})
+	assert.Empty(res.Sets)
+	assert.NotNil(res.OfflineEndpoints)
+}
@@ -267,4 +267,13 @@ func TestHeal(t *testing.T) {
 	if assert.Error(err) {
 		assert.Equal("strconv.ParseBool: parsing \"nonbool\": invalid syntax", err.Error())
 	}
+	// Test-9: getHealOptionsFromReq returns the token of a heal to follow
+	u, _ = url.Parse("http://localhost/api/v1/heal/bucket1?token=a1b2c3")
+	req = &http.Request{
+		URL: u,
+	}
+	opts, err = getHealOptionsFromReq(req)
+	if assert.NoError(err) {
+		assert.Equal("a1b2c3", opts.ClientToken)
+	}
 }
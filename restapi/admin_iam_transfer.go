@@ -0,0 +1,245 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	cfgApi "github.com/minio/console/restapi/operations/configuration"
+)
+
+// largest IAM export accepted for import
+const maxIAMExportSize = 100 << 20
+
+// iamExportSection is one of the files of the zip MinIO exports IAM to
+type iamExportSection struct {
+	name string
+	file string
+}
+
+// iamExportSections are the files of the export, in the order MinIO imports them
+var iamExportSections = []iamExportSection{
+	{name: "policies", file: "policies.json"},
+	{name: "users", file: "users.json"},
+	{name: "groups", file: "groups.json"},
+	{name: "serviceAccounts", file: "svcaccts.json"},
+	{name: "userPolicyMappings", file: "user_mappings.json"},
+	{name: "groupPolicyMappings", file: "group_mappings.json"},
+	{name: "stsPolicyMappings", file: "stsuser_mappings.json"},
+}
+
+// iamVolatileFields change on every export without the entry changing, they are ignored when comparing
+var iamVolatileFields = []string{"updatedAt"}
+
+func registerIAMTransferHandlers(api *operations.ConsoleAPI) {
+	// export IAM
+	api.ConfigurationExportIAMHandler = cfgApi.ExportIAMHandlerFunc(func(params cfgApi.ExportIAMParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportIAMResponse(session, params)
+		if err != nil {
+			return cfgApi.NewExportIAMDefault(int(err.Code)).WithPayload(err)
+		}
+		return resp
+	})
+	// import IAM
+	api.ConfigurationImportIAMHandler = cfgApi.ImportIAMHandlerFunc(func(params cfgApi.ImportIAMParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportIAMResponse(session, params)
+		if err != nil {
+			return cfgApi.NewImportIAMDefault(int(err.Code)).WithPayload(err)
+		}
+		return cfgApi.NewImportIAMOK().WithPayload(resp)
+	})
+}
+
+// readIAMExport returns the entries of every section of an IAM export, keyed by name
+func readIAMExport(data []byte) (map[string]map[string]json.RawMessage, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIAMExport, err)
+	}
+	sections := map[string]map[string]json.RawMessage{}
+	for _, f := range archive.File {
+		for _, section := range iamExportSections {
+			if path.Base(f.Name) != section.file {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidIAMExport, err)
+			}
+			entries := map[string]json.RawMessage{}
+			err = json.NewDecoder(rc).Decode(&entries)
+			rc.Close()
+			if err != nil && err != io.EOF {
+				return nil, fmt.Errorf("%w: %s: %v", ErrInvalidIAMExport, f.Name, err)
+			}
+			sections[section.name] = entries
+		}
+	}
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("%w: the file doesn't contain any IAM section", ErrInvalidIAMExport)
+	}
+	return sections, nil
+}
+
+// normalizeIAMEntry returns the entry in a form that can be compared, without volatile fields and
+// with its keys sorted
+func normalizeIAMEntry(raw json.RawMessage) ([]byte, error) {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	if object, ok := value.(map[string]interface{}); ok {
+		for _, field := range iamVolatileFields {
+			delete(object, field)
+		}
+	}
+	return json.Marshal(value)
+}
+
+// diffIAMExports reports, for every section of the incoming export, the entries the import would add
+// or update in the cluster. Importing never removes entries of the cluster missing from the export.
+func diffIAMExports(current, incoming map[string]map[string]json.RawMessage) ([]*models.IamImportSection, error) {
+	var sections []*models.IamImportSection
+	for _, section := range iamExportSections {
+		entries, ok := incoming[section.name]
+		if !ok {
+			continue
+		}
+		result := &models.IamImportSection{Name: section.name, Added: []string{}, Updated: []string{}}
+		names := make([]string, 0, len(entries))
+		for name := range entries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			existing, ok := current[section.name][name]
+			if !ok {
+				result.Added = append(result.Added, name)
+				continue
+			}
+			a, err := normalizeIAMEntry(existing)
+			if err != nil {
+				return nil, err
+			}
+			b, err := normalizeIAMEntry(entries[name])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s %s: %v", ErrInvalidIAMExport, section.name, name, err)
+			}
+			if bytes.Equal(a, b) {
+				result.Unchanged++
+			} else {
+				result.Updated = append(result.Updated, name)
+			}
+		}
+		sections = append(sections, result)
+	}
+	return sections, nil
+}
+
+// exportIAMData returns the IAM export of the cluster
+func exportIAMData(ctx context.Context, client MinioAdmin) ([]byte, error) {
+	rc, err := client.exportIAM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// importIAM compares the export with the IAM of the cluster and, unless it is a dry run, imports it
+func importIAM(ctx context.Context, client MinioAdmin, data []byte, dryRun bool) (*models.IamImportResponse, error) {
+	incoming, err := readIAMExport(data)
+	if err != nil {
+		return nil, err
+	}
+	currentData, err := exportIAMData(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	current, err := readIAMExport(currentData)
+	if err != nil {
+		return nil, err
+	}
+	sections, err := diffIAMExports(current, incoming)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.IamImportResponse{DryRun: dryRun, Sections: sections}
+	if dryRun {
+		return resp, nil
+	}
+	if err = client.importIAM(ctx, io.NopCloser(bytes.NewReader(data))); err != nil {
+		return nil, err
+	}
+	resp.Imported = true
+	return resp, nil
+}
+
+func getExportIAMResponse(session *models.Principal, params cfgApi.ExportIAMParams) (middleware.Responder, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	data, err := exportIAMData(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"iam-export-%s.zip\"", time.Now().UTC().Format("20060102150405")))
+		if _, err := w.Write(data); err != nil {
+			LogError("Unable to write the IAM export: %v", err)
+		}
+	}), nil
+}
+
+func getImportIAMResponse(session *models.Principal, params cfgApi.ImportIAMParams) (*models.IamImportResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	defer params.File.Close()
+	data, err := io.ReadAll(io.LimitReader(params.File, maxIAMExportSize+1))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if len(data) > maxIAMExportSize {
+		return nil, ErrorWithContext(ctx, fmt.Errorf("%w: the file is larger than %d bytes", ErrInvalidIAMExport, maxIAMExportSize))
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp, err := importIAM(ctx, AdminClient{Client: mAdmin}, data, params.DryRun != nil && *params.DryRun)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
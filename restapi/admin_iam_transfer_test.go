@@ -0,0 +1,91 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func iamExportZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create("iam-assets/" + name)
+		assert.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestImportIAM(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	current := iamExportZip(t, map[string]string{
+		"users.json":         `{"alice":{"secretKey":"alicesecret","policy":"readwrite","status":"enabled"},"bob":{"secretKey":"bobsecret","status":"enabled"}}`,
+		"policies.json":      `{"readwrite":{"Version":"2012-10-17","Statement":[]}}`,
+		"user_mappings.json": `{"alice":{"version":1,"policy":"readwrite","updatedAt":"2023-01-01T00:00:00Z"}}`,
+	})
+	minioExportIAMMock = func(ctx context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(current)), nil
+	}
+	var imported []byte
+	minioImportIAMMock = func(ctx context.Context, contentReader io.ReadCloser) error {
+		imported, _ = io.ReadAll(contentReader)
+		return nil
+	}
+
+	incoming := iamExportZip(t, map[string]string{
+		"users.json":         `{"alice":{"secretKey":"alicesecret","policy":"readwrite","status":"enabled"},"bob":{"secretKey":"bobsecret","status":"disabled"},"carol":{"secretKey":"carolsecret","status":"enabled"}}`,
+		"user_mappings.json": `{"alice":{"version":1,"policy":"readwrite","updatedAt":"2023-05-01T00:00:00Z"}}`,
+		"groups.json":        `{"devs":{"status":"enabled","members":["carol"]}}`,
+	})
+	resp, err := importIAM(ctx, adminClient, incoming, true)
+	assert.NoError(err)
+	assert.True(resp.DryRun)
+	assert.False(resp.Imported)
+	assert.Nil(imported)
+	// sections follow the import order and only those in the file are reported
+	assert.Len(resp.Sections, 3)
+	assert.Equal("users", resp.Sections[0].Name)
+	assert.Equal([]string{"carol"}, resp.Sections[0].Added)
+	assert.Equal([]string{"bob"}, resp.Sections[0].Updated)
+	assert.Equal(int64(1), resp.Sections[0].Unchanged)
+	assert.Equal("groups", resp.Sections[1].Name)
+	assert.Equal([]string{"devs"}, resp.Sections[1].Added)
+	// the update time of a mapping doesn't make it different
+	assert.Equal("userPolicyMappings", resp.Sections[2].Name)
+	assert.Equal(int64(1), resp.Sections[2].Unchanged)
+
+	resp, err = importIAM(ctx, adminClient, incoming, false)
+	assert.NoError(err)
+	assert.True(resp.Imported)
+	assert.Equal(incoming, imported)
+
+	_, err = importIAM(ctx, adminClient, []byte("not a zip"), true)
+	assert.ErrorIs(err, ErrInvalidIAMExport)
+	_, err = importIAM(ctx, adminClient, iamExportZip(t, map[string]string{"readme.txt": "hello"}), true)
+	assert.ErrorIs(err, ErrInvalidIAMExport)
+}
@@ -74,6 +74,13 @@ func registerIDPHandlers(api *operations.ConsoleAPI) {
 		}
 		return idp.NewGetLDAPEntitiesOK().WithPayload(response)
 	})
+	api.IdpTestIDPConfigurationHandler = idp.TestIDPConfigurationHandlerFunc(func(params idp.TestIDPConfigurationParams, session *models.Principal) middleware.Responder {
+		response, err := testIDPConfigurationResponse(session, params)
+		if err != nil {
+			return idp.NewTestIDPConfigurationDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewTestIDPConfigurationOK().WithPayload(response)
+	})
 }
 
 func createIDPConfigurationResponse(session *models.Principal, params idp.CreateConfigurationParams) (*models.SetIDPResponse, *models.Error) {
@@ -0,0 +1,370 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/restapi/operations/idp"
+	madmin "github.com/minio/madmin-go/v2"
+)
+
+const (
+	// idpTestTimeout bounds every network call made while testing an IDP configuration
+	idpTestTimeout = 10 * time.Second
+	// idpTestMaxResponse bounds the documents read from an OpenID provider
+	idpTestMaxResponse = 1 << 20
+)
+
+// parseIDPConfigInput reads the key=value pairs of an IDP configuration, values with spaces can be quoted
+func parseIDPConfigInput(input string) (map[string]string, error) {
+	config := map[string]string{}
+	for input = strings.TrimSpace(input); input != ""; input = strings.TrimSpace(input) {
+		eq := strings.IndexByte(input, '=')
+		if eq <= 0 || strings.ContainsAny(input[:eq], " \t\n") {
+			return nil, fmt.Errorf("%w: expected key=value at %q", ErrInvalidIDPConfiguration, input)
+		}
+		key := input[:eq]
+		input = input[eq+1:]
+		var value string
+		if input != "" && (input[0] == '"' || input[0] == '\'') {
+			end := strings.IndexByte(input[1:], input[0])
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated quote in the value of %s", ErrInvalidIDPConfiguration, key)
+			}
+			value, input = input[1:end+1], input[end+2:]
+		} else {
+			end := strings.IndexAny(input, " \t\n")
+			if end < 0 {
+				end = len(input)
+			}
+			value, input = input[:end], input[end:]
+		}
+		config[key] = value
+	}
+	return config, nil
+}
+
+// idpConfigTest collects the outcome of each step of a connectivity test
+type idpConfigTest struct {
+	result *models.IdpConfigurationTestResult
+}
+
+func newIDPConfigTest() *idpConfigTest {
+	return &idpConfigTest{result: &models.IdpConfigurationTestResult{Success: true, Steps: []*models.IdpConfigurationTestStep{}}}
+}
+
+func (t *idpConfigTest) step(name, status, format string, args ...interface{}) {
+	if status == models.IdpConfigurationTestStepStatusFailed {
+		t.result.Success = false
+	}
+	t.result.Steps = append(t.result.Steps, &models.IdpConfigurationTestStep{
+		Name:    name,
+		Status:  status,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (t *idpConfigTest) passed(name, format string, args ...interface{}) {
+	t.step(name, models.IdpConfigurationTestStepStatusPassed, format, args...)
+}
+
+func (t *idpConfigTest) failed(name, format string, args ...interface{}) {
+	t.step(name, models.IdpConfigurationTestStepStatusFailed, format, args...)
+}
+
+func (t *idpConfigTest) warning(name, format string, args ...interface{}) {
+	t.step(name, models.IdpConfigurationTestStepStatusWarning, format, args...)
+}
+
+func (t *idpConfigTest) skipped(name, format string, args ...interface{}) {
+	t.step(name, models.IdpConfigurationTestStepStatusSkipped, format, args...)
+}
+
+func splitBaseDNs(value string) []string {
+	var dns []string
+	for _, dn := range strings.Split(value, ";") {
+		if dn = strings.TrimSpace(dn); dn != "" {
+			dns = append(dns, dn)
+		}
+	}
+	return dns
+}
+
+// testLDAPConfiguration connects to the LDAP server the way MinIO would, binds with the lookup account
+// and checks the configured search bases exist, stopping at the first step that fails
+func testLDAPConfiguration(ctx context.Context, config map[string]string) *models.IdpConfigurationTestResult {
+	test := newIDPConfigTest()
+	addr := config["server_addr"]
+	if addr == "" {
+		test.failed("configuration", "server_addr is required")
+		return test.result
+	}
+	insecure := config["server_insecure"] == "on"
+	startTLS := config["server_starttls"] == "on"
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		host, port = addr, "636"
+		if insecure || startTLS {
+			port = "389"
+		}
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		RootCAs:            GlobalRootCAs,
+		InsecureSkipVerify: config["tls_skip_verify"] == "on",
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	dialer := &net.Dialer{Timeout: idpTestTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		test.failed("connect", "could not connect to %s: %v", addr, err)
+		return test.result
+	}
+	_ = conn.SetDeadline(time.Now().Add(idpTestTimeout))
+	if !insecure && !startTLS {
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			test.failed("connect", "TLS handshake with %s failed: %v", addr, err)
+			return test.result
+		}
+		conn = tlsConn
+	}
+	lc := newLDAPConn(conn)
+	defer lc.Close()
+	test.passed("connect", "connected to %s", net.JoinHostPort(host, port))
+	if startTLS {
+		if err := lc.startTLS(tlsConfig); err != nil {
+			test.failed("startTLS", "StartTLS failed: %v", err)
+			return test.result
+		}
+		test.passed("startTLS", "connection upgraded to TLS")
+	}
+
+	bindDN := config["lookup_bind_dn"]
+	if bindDN == "" {
+		test.failed("lookupBind", "lookup_bind_dn is required")
+		return test.result
+	}
+	if err := lc.simpleBind(bindDN, config["lookup_bind_password"]); err != nil {
+		test.failed("lookupBind", "bind as %s failed: %v", bindDN, err)
+		return test.result
+	}
+	test.passed("lookupBind", "bound as %s", bindDN)
+
+	userBases := splitBaseDNs(config["user_dn_search_base_dn"])
+	if len(userBases) == 0 {
+		test.failed("userSearchBase", "user_dn_search_base_dn is required")
+		return test.result
+	}
+	for _, dn := range userBases {
+		if err := lc.searchBase(dn); err != nil {
+			test.failed("userSearchBase", "user search base %s could not be read: %v", dn, err)
+			return test.result
+		}
+	}
+	test.passed("userSearchBase", "found %s", strings.Join(userBases, "; "))
+	if filter := config["user_dn_search_filter"]; !strings.Contains(filter, "%s") {
+		test.warning("userSearchFilter", "user_dn_search_filter should contain %%s to be replaced by the username, got %q", filter)
+	}
+
+	groupBases := splitBaseDNs(config["group_search_base_dn"])
+	if len(groupBases) == 0 {
+		if config["group_search_filter"] != "" {
+			test.warning("groupSearchBase", "group_search_filter is set but group_search_base_dn is empty, groups won't be looked up")
+		} else {
+			test.skipped("groupSearchBase", "group lookups aren't configured")
+		}
+		return test.result
+	}
+	for _, dn := range groupBases {
+		if err := lc.searchBase(dn); err != nil {
+			test.failed("groupSearchBase", "group search base %s could not be read: %v", dn, err)
+			return test.result
+		}
+	}
+	test.passed("groupSearchBase", "found %s", strings.Join(groupBases, "; "))
+	return test.result
+}
+
+func getIDPJSON(ctx context.Context, client *http.Client, endpoint string, v interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, idpTestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", endpoint, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, idpTestMaxResponse)).Decode(v)
+}
+
+// testOpenIDConfiguration fetches the discovery document and the signing keys of the provider and
+// checks the client credentials against its token endpoint
+func testOpenIDConfiguration(ctx context.Context, config map[string]string, client *http.Client) *models.IdpConfigurationTestResult {
+	test := newIDPConfigTest()
+	configURL := config["config_url"]
+	if configURL == "" {
+		test.failed("configuration", "config_url is required")
+		return test.result
+	}
+	clientID := config["client_id"]
+	if clientID == "" {
+		test.failed("configuration", "client_id is required")
+		return test.result
+	}
+
+	var discovery oauth2.DiscoveryDoc
+	if err := getIDPJSON(ctx, client, configURL, &discovery); err != nil {
+		test.failed("discovery", "discovery document could not be read: %v", err)
+		return test.result
+	}
+	var missing []string
+	for field, value := range map[string]string{
+		"issuer":                 discovery.Issuer,
+		"authorization_endpoint": discovery.AuthEndpoint,
+		"token_endpoint":         discovery.TokenEndpoint,
+		"jwks_uri":               discovery.JwksURI,
+	} {
+		if value == "" {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		test.failed("discovery", "discovery document is missing %s", strings.Join(missing, ", "))
+		return test.result
+	}
+	test.passed("discovery", "issuer %s", discovery.Issuer)
+	if scopes := config["scopes"]; scopes != "" && len(discovery.ScopesSupported) > 0 {
+		for _, scope := range strings.Split(scopes, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" && !IsElementInArray(discovery.ScopesSupported, scope) {
+				test.warning("scopes", "scope %s isn't advertised by the provider", scope)
+			}
+		}
+	}
+
+	var keys struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := getIDPJSON(ctx, client, discovery.JwksURI, &keys); err != nil {
+		test.failed("signingKeys", "signing keys could not be read: %v", err)
+		return test.result
+	}
+	if len(keys.Keys) == 0 {
+		test.failed("signingKeys", "%s has no signing keys", discovery.JwksURI)
+		return test.result
+	}
+	test.passed("signingKeys", "%d signing keys found", len(keys.Keys))
+
+	testOpenIDClient(ctx, test, client, discovery.TokenEndpoint, clientID, config["client_secret"])
+	return test.result
+}
+
+// testOpenIDClient requests a token with the client credentials grant, the provider authenticates
+// the client before looking at the grant so an invalid_client error means the credentials are wrong
+func testOpenIDClient(ctx context.Context, test *idpConfigTest, client *http.Client, tokenEndpoint, clientID, clientSecret string) {
+	ctx, cancel := context.WithTimeout(ctx, idpTestTimeout)
+	defer cancel()
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		test.failed("clientAuthentication", "%v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+	resp, err := client.Do(req)
+	if err != nil {
+		test.failed("clientAuthentication", "token endpoint could not be reached: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		test.passed("clientAuthentication", "client %s authenticated", clientID)
+		return
+	}
+	var tokenErr struct {
+		Error       string `json:"error"`
+		Description string `json:"error_description"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, idpTestMaxResponse)).Decode(&tokenErr)
+	if tokenErr.Description != "" {
+		tokenErr.Description = ": " + tokenErr.Description
+	}
+	switch {
+	case tokenErr.Error == "invalid_client" || (tokenErr.Error == "" && resp.StatusCode == http.StatusUnauthorized):
+		test.failed("clientAuthentication", "client %s was rejected by the provider%s", clientID, tokenErr.Description)
+	case tokenErr.Error != "":
+		test.warning("clientAuthentication", "the provider doesn't allow client %s to get tokens by itself (%s%s), its secret will be checked on the first login", clientID, tokenErr.Error, tokenErr.Description)
+	default:
+		test.failed("clientAuthentication", "token endpoint returned %s", resp.Status)
+	}
+}
+
+// testIDPConfiguration runs the connectivity test of an IDP type, only users allowed to read the IDP
+// configurations can run it since it makes the console connect to arbitrary servers
+func testIDPConfiguration(ctx context.Context, client MinioAdmin, idpType, input string) (*models.IdpConfigurationTestResult, error) {
+	if !madmin.ValidIDPConfigTypes.Contains(idpType) {
+		return nil, errInvalidIDPType
+	}
+	config, err := parseIDPConfigInput(input)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.listIDPConfig(ctx, idpType); err != nil {
+		return nil, err
+	}
+	if idpType == madmin.LDAPIDPCfg {
+		return testLDAPConfiguration(ctx, config), nil
+	}
+	return testOpenIDConfiguration(ctx, config, GetConsoleHTTPClient(config["config_url"])), nil
+}
+
+func testIDPConfigurationResponse(session *models.Principal, params idp.TestIDPConfigurationParams) (*models.IdpConfigurationTestResult, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	result, err := testIDPConfiguration(ctx, AdminClient{Client: mAdmin}, params.Type, params.Body.Input)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,218 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIDPConfigInput(t *testing.T) {
+	assert := assert.New(t)
+	config, err := parseIDPConfigInput(`server_addr=ldap.example.org:389  lookup_bind_dn="cn=admin, dc=example,dc=org" lookup_bind_password='p@ss word' server_insecure=on empty=`)
+	assert.NoError(err)
+	assert.Equal(map[string]string{
+		"server_addr":          "ldap.example.org:389",
+		"lookup_bind_dn":       "cn=admin, dc=example,dc=org",
+		"lookup_bind_password": "p@ss word",
+		"server_insecure":      "on",
+		"empty":                "",
+	}, config)
+
+	for _, input := range []string{"server_addr", "=value", `lookup_bind_dn="cn=admin`} {
+		_, err = parseIDPConfigInput(input)
+		assert.ErrorIs(err, ErrInvalidIDPConfiguration, input)
+	}
+}
+
+// fakeLDAPServer answers binds for cn=admin,dc=example,dc=org with the password secret
+// and base searches for the entries given
+func fakeLDAPServer(t *testing.T, entries ...string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	result := func(id int, tag byte, code int) []byte {
+		return berTLV(berSequence, berInt(berInteger, id), berTLV(tag,
+			berInt(berEnumerated, code), berString(berOctetString, ""), berString(berOctetString, ""),
+		))
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				for {
+					message, err := readBER(conn)
+					if err != nil {
+						return
+					}
+					parts, _ := berChildren(message.content)
+					id := int(parts[0].content[0])
+					fields, _ := berChildren(parts[1].content)
+					switch parts[1].tag {
+					case ldapBindRequest:
+						code := 49
+						if string(fields[1].content) == "cn=admin,dc=example,dc=org" && string(fields[2].content) == "secret" {
+							code = 0
+						}
+						conn.Write(result(id, ldapBindResponse, code))
+					case ldapSearchRequest:
+						code := ldapResultNoSuchObject
+						if IsElementInArray(entries, string(fields[0].content)) {
+							conn.Write(berTLV(berSequence, berInt(berInteger, id), berTLV(ldapSearchResultEntry, fields[0].content)))
+							code = 0
+						}
+						conn.Write(result(id, ldapSearchResultDone, code))
+					default:
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func stepStatuses(result *models.IdpConfigurationTestResult) []string {
+	var statuses []string
+	for _, step := range result.Steps {
+		statuses = append(statuses, step.Name+":"+step.Status)
+	}
+	return statuses
+}
+
+func TestTestLDAPConfiguration(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	addr := fakeLDAPServer(t, "ou=people,dc=example,dc=org", "ou=groups,dc=example,dc=org")
+	config := map[string]string{
+		"server_addr":            addr,
+		"server_insecure":        "on",
+		"lookup_bind_dn":         "cn=admin,dc=example,dc=org",
+		"lookup_bind_password":   "secret",
+		"user_dn_search_base_dn": "ou=people,dc=example,dc=org",
+		"user_dn_search_filter":  "(uid=%s)",
+	}
+
+	result := testLDAPConfiguration(ctx, config)
+	assert.True(result.Success)
+	assert.Equal([]string{"connect:passed", "lookupBind:passed", "userSearchBase:passed", "groupSearchBase:skipped"}, stepStatuses(result))
+
+	config["group_search_base_dn"] = "ou=groups,dc=example,dc=org;ou=missing,dc=example,dc=org"
+	result = testLDAPConfiguration(ctx, config)
+	assert.False(result.Success)
+	assert.Equal("groupSearchBase:failed", stepStatuses(result)[3])
+	assert.Equal("group search base ou=missing,dc=example,dc=org could not be read: noSuchObject", result.Steps[3].Message)
+
+	config["lookup_bind_password"] = "wrong"
+	result = testLDAPConfiguration(ctx, config)
+	assert.Equal([]string{"connect:passed", "lookupBind:failed"}, stepStatuses(result))
+	assert.Equal("bind as cn=admin,dc=example,dc=org failed: invalidCredentials", result.Steps[1].Message)
+
+	// a server that isn't listening anymore
+	listener, _ := net.Listen("tcp", "127.0.0.1:0")
+	config["server_addr"] = listener.Addr().String()
+	listener.Close()
+	result = testLDAPConfiguration(ctx, config)
+	assert.Equal([]string{"connect:failed"}, stepStatuses(result))
+
+	result = testLDAPConfiguration(ctx, map[string]string{})
+	assert.Equal([]string{"configuration:failed"}, stepStatuses(result))
+}
+
+func TestTestOpenIDConfiguration(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"issuer":                 server.URL,
+				"authorization_endpoint": server.URL + "/auth",
+				"token_endpoint":         server.URL + "/token",
+				"jwks_uri":               server.URL + "/keys",
+				"scopes_supported":       []string{"openid", "profile"},
+			})
+		case "/keys":
+			fmt.Fprint(w, `{"keys":[{"kty":"RSA","kid":"1"}]}`)
+		case "/token":
+			id, secret, _ := r.BasicAuth()
+			switch {
+			case id == "login-only":
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"unauthorized_client"}`)
+			case id != "console" || secret != "good":
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"error":"invalid_client","error_description":"Invalid client credentials"}`)
+			default:
+				fmt.Fprint(w, `{"access_token":"token","token_type":"Bearer"}`)
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	config := map[string]string{
+		"config_url":    server.URL + "/.well-known/openid-configuration",
+		"client_id":     "console",
+		"client_secret": "good",
+		"scopes":        "openid,groups",
+	}
+
+	result := testOpenIDConfiguration(ctx, config, server.Client())
+	assert.True(result.Success)
+	assert.Equal([]string{"discovery:passed", "scopes:warning", "signingKeys:passed", "clientAuthentication:passed"}, stepStatuses(result))
+
+	config["client_secret"] = "bad"
+	result = testOpenIDConfiguration(ctx, config, server.Client())
+	assert.False(result.Success)
+	assert.Equal("client console was rejected by the provider: Invalid client credentials", result.Steps[3].Message)
+
+	config["client_id"] = "login-only"
+	result = testOpenIDConfiguration(ctx, config, server.Client())
+	assert.True(result.Success)
+	assert.Equal("clientAuthentication:warning", stepStatuses(result)[3])
+
+	config["config_url"] = server.URL + "/missing"
+	result = testOpenIDConfiguration(ctx, config, server.Client())
+	assert.Equal([]string{"discovery:failed"}, stepStatuses(result))
+}
+
+func TestTestIDPConfiguration(t *testing.T) {
+	assert := assert.New(t)
+	_, err := testIDPConfiguration(context.Background(), AdminClientMock{}, "kerberos", "")
+	assert.ErrorIs(err, errInvalidIDPType)
+	_, err = testIDPConfiguration(context.Background(), AdminClientMock{}, "ldap", "server_addr")
+	assert.ErrorIs(err, ErrInvalidIDPConfiguration)
+	result, err := testIDPConfiguration(context.Background(), AdminClientMock{}, "ldap", "")
+	assert.NoError(err)
+	assert.False(result.Success)
+}
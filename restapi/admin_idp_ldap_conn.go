@@ -0,0 +1,323 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+)
+
+// The connectivity test only needs a handful of LDAP operations, they are encoded here
+// following RFC 4511 instead of pulling a full LDAP client
+
+const (
+	berBoolean     = 0x01
+	berInteger     = 0x02
+	berOctetString = 0x04
+	berEnumerated  = 0x0a
+	berSequence    = 0x30
+
+	ldapBindRequest        = 0x60
+	ldapBindResponse       = 0x61
+	ldapUnbindRequest      = 0x42
+	ldapSearchRequest      = 0x63
+	ldapSearchResultEntry  = 0x64
+	ldapSearchResultDone   = 0x65
+	ldapSearchResultRef    = 0x73
+	ldapExtendedRequest    = 0x77
+	ldapExtendedResponse   = 0x78
+	ldapSimpleAuth         = 0x80
+	ldapExtendedName       = 0x80
+	ldapPresentFilter      = 0x87
+	ldapStartTLSOID        = "1.3.6.1.4.1.1466.20037"
+	ldapMaxMessageSize     = 1 << 20
+	ldapResultSuccess      = 0
+	ldapResultNoSuchObject = 32
+)
+
+var ldapResultCodes = map[int]string{
+	1:  "operationsError",
+	2:  "protocolError",
+	3:  "timeLimitExceeded",
+	4:  "sizeLimitExceeded",
+	7:  "authMethodNotSupported",
+	8:  "strongerAuthRequired",
+	13: "confidentialityRequired",
+	32: "noSuchObject",
+	34: "invalidDNSyntax",
+	48: "inappropriateAuthentication",
+	49: "invalidCredentials",
+	50: "insufficientAccessRights",
+	51: "busy",
+	52: "unavailable",
+	53: "unwillingToPerform",
+	80: "other",
+}
+
+// ldapResultError is an LDAP operation that completed with a result code other than success
+type ldapResultError struct {
+	Code    int
+	Message string
+}
+
+func (e *ldapResultError) Error() string {
+	name, ok := ldapResultCodes[e.Code]
+	if !ok {
+		name = fmt.Sprintf("result code %d", e.Code)
+	}
+	if e.Message == "" {
+		return name
+	}
+	return fmt.Sprintf("%s: %s", name, e.Message)
+}
+
+func berLength(n int) []byte {
+	if n < 0x80 {
+		return []byte{byte(n)}
+	}
+	var b []byte
+	for ; n > 0; n >>= 8 {
+		b = append([]byte{byte(n)}, b...)
+	}
+	return append([]byte{0x80 | byte(len(b))}, b...)
+}
+
+func berTLV(tag byte, content ...[]byte) []byte {
+	var body []byte
+	for _, c := range content {
+		body = append(body, c...)
+	}
+	return append(append([]byte{tag}, berLength(len(body))...), body...)
+}
+
+func berInt(tag byte, v int) []byte {
+	b := []byte{byte(v)}
+	for v >>= 8; v > 0; v >>= 8 {
+		b = append([]byte{byte(v)}, b...)
+	}
+	// keep the value positive in two's complement
+	if b[0]&0x80 != 0 {
+		b = append([]byte{0}, b...)
+	}
+	return berTLV(tag, b)
+}
+
+func berString(tag byte, s string) []byte {
+	return berTLV(tag, []byte(s))
+}
+
+type berElement struct {
+	tag     byte
+	content []byte
+}
+
+func readBER(r io.Reader) (berElement, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return berElement{}, err
+	}
+	length := int(header[1])
+	if length&0x80 != 0 {
+		size := length & 0x7f
+		if size == 0 || size > 4 {
+			return berElement{}, errors.New("unsupported BER length")
+		}
+		b := make([]byte, size)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return berElement{}, err
+		}
+		length = 0
+		for _, v := range b {
+			length = length<<8 | int(v)
+		}
+	}
+	if length > ldapMaxMessageSize {
+		return berElement{}, errors.New("LDAP message too large")
+	}
+	content := make([]byte, length)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return berElement{}, err
+	}
+	return berElement{tag: header[0], content: content}, nil
+}
+
+// berChildren splits the content of a constructed element into its elements
+func berChildren(content []byte) ([]berElement, error) {
+	var children []berElement
+	r := bytes.NewReader(content)
+	for {
+		child, err := readBER(r)
+		if errors.Is(err, io.EOF) {
+			return children, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+}
+
+// ldapConn is a minimal LDAP v3 client connection
+type ldapConn struct {
+	conn      net.Conn
+	r         *bufio.Reader
+	messageID int
+}
+
+func newLDAPConn(conn net.Conn) *ldapConn {
+	return &ldapConn{conn: conn, r: bufio.NewReader(conn)}
+}
+
+func (c *ldapConn) send(op []byte) (int, error) {
+	c.messageID++
+	_, err := c.conn.Write(berTLV(berSequence, berInt(berInteger, c.messageID), op))
+	return c.messageID, err
+}
+
+// receive reads the next message answering the given request, returning its operation
+func (c *ldapConn) receive(messageID int) (berElement, error) {
+	for {
+		message, err := readBER(c.r)
+		if err != nil {
+			return berElement{}, err
+		}
+		parts, err := berChildren(message.content)
+		if err != nil {
+			return berElement{}, err
+		}
+		if message.tag != berSequence || len(parts) < 2 || parts[0].tag != berInteger {
+			return berElement{}, errors.New("malformed LDAP message")
+		}
+		id := 0
+		for _, b := range parts[0].content {
+			id = id<<8 | int(b)
+		}
+		// unsolicited notifications use the message id 0, usually right before the server disconnects
+		if id == 0 {
+			if err := parseLDAPResult(parts[1]); err != nil {
+				return berElement{}, err
+			}
+			continue
+		}
+		if id == messageID {
+			return parts[1], nil
+		}
+	}
+}
+
+// parseLDAPResult reads the LDAPResult of a response and turns result codes other than success into errors
+func parseLDAPResult(op berElement) error {
+	fields, err := berChildren(op.content)
+	if err != nil {
+		return err
+	}
+	if len(fields) < 3 || fields[0].tag != berEnumerated {
+		return errors.New("malformed LDAP result")
+	}
+	code := 0
+	for _, b := range fields[0].content {
+		code = code<<8 | int(b)
+	}
+	if code == ldapResultSuccess {
+		return nil
+	}
+	return &ldapResultError{Code: code, Message: string(fields[2].content)}
+}
+
+func (c *ldapConn) expect(messageID int, tag byte) error {
+	op, err := c.receive(messageID)
+	if err != nil {
+		return err
+	}
+	if op.tag != tag {
+		return fmt.Errorf("unexpected LDAP response 0x%02x", op.tag)
+	}
+	return parseLDAPResult(op)
+}
+
+// startTLS upgrades the connection with the StartTLS extended operation
+func (c *ldapConn) startTLS(config *tls.Config) error {
+	id, err := c.send(berTLV(ldapExtendedRequest, berString(ldapExtendedName, ldapStartTLSOID)))
+	if err != nil {
+		return err
+	}
+	if err := c.expect(id, ldapExtendedResponse); err != nil {
+		return err
+	}
+	tlsConn := tls.Client(c.conn, config)
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+	c.conn = tlsConn
+	c.r = bufio.NewReader(tlsConn)
+	return nil
+}
+
+// simpleBind authenticates with a DN and a password
+func (c *ldapConn) simpleBind(dn, password string) error {
+	id, err := c.send(berTLV(ldapBindRequest,
+		berInt(berInteger, 3),
+		berString(berOctetString, dn),
+		berString(ldapSimpleAuth, password),
+	))
+	if err != nil {
+		return err
+	}
+	return c.expect(id, ldapBindResponse)
+}
+
+// searchBase checks that an entry exists with a base scoped search that returns no attributes
+func (c *ldapConn) searchBase(dn string) error {
+	id, err := c.send(berTLV(ldapSearchRequest,
+		berString(berOctetString, dn),
+		berInt(berEnumerated, 0), // baseObject
+		berInt(berEnumerated, 0), // neverDerefAliases
+		berInt(berInteger, 1),
+		berInt(berInteger, 10),
+		berTLV(berBoolean, []byte{0}),
+		berString(ldapPresentFilter, "objectClass"),
+		berTLV(berSequence, berString(berOctetString, "1.1")),
+	))
+	if err != nil {
+		return err
+	}
+	for {
+		op, err := c.receive(id)
+		if err != nil {
+			return err
+		}
+		switch op.tag {
+		case ldapSearchResultEntry, ldapSearchResultRef:
+			continue
+		case ldapSearchResultDone:
+			return parseLDAPResult(op)
+		default:
+			return fmt.Errorf("unexpected LDAP response 0x%02x", op.tag)
+		}
+	}
+}
+
+func (c *ldapConn) Close() error {
+	// the unbind request has no response, the server closes the connection
+	_, _ = c.send([]byte{ldapUnbindRequest, 0})
+	return c.conn.Close()
+}
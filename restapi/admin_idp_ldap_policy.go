@@ -0,0 +1,245 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/idp"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerLDAPPolicyHandlers(api *operations.ConsoleAPI) {
+	api.IdpListLDAPEntitiesHandler = idp.ListLDAPEntitiesHandlerFunc(func(params idp.ListLDAPEntitiesParams, session *models.Principal) middleware.Responder {
+		response, err := getListLDAPEntitiesResponse(session, params)
+		if err != nil {
+			return idp.NewListLDAPEntitiesDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewListLDAPEntitiesOK().WithPayload(response)
+	})
+	api.IdpAttachLDAPPolicyHandler = idp.AttachLDAPPolicyHandlerFunc(func(params idp.AttachLDAPPolicyParams, session *models.Principal) middleware.Responder {
+		response, err := getUpdateLDAPPolicyResponse(params.HTTPRequest.Context(), session, params.Body, true)
+		if err != nil {
+			return idp.NewAttachLDAPPolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewAttachLDAPPolicyOK().WithPayload(response)
+	})
+	api.IdpDetachLDAPPolicyHandler = idp.DetachLDAPPolicyHandlerFunc(func(params idp.DetachLDAPPolicyParams, session *models.Principal) middleware.Responder {
+		response, err := getUpdateLDAPPolicyResponse(params.HTTPRequest.Context(), session, params.Body, false)
+		if err != nil {
+			return idp.NewDetachLDAPPolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewDetachLDAPPolicyOK().WithPayload(response)
+	})
+	api.IdpGetLDAPEffectivePolicyHandler = idp.GetLDAPEffectivePolicyHandlerFunc(func(params idp.GetLDAPEffectivePolicyParams, session *models.Principal) middleware.Responder {
+		response, err := getLDAPEffectivePolicyResponse(session, params)
+		if err != nil {
+			return idp.NewGetLDAPEffectivePolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewGetLDAPEffectivePolicyOK().WithPayload(response)
+	})
+}
+
+func getListLDAPEntitiesResponse(session *models.Principal, params idp.ListLDAPEntitiesParams) (*models.LdapEntities, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// an empty query returns every LDAP user and group with a policy mapping
+	result, err := getEntitiesResult(ctx, AdminClient{Client: mAdmin}, nil, nil, nil)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
+
+// ldapMappedPolicies returns the policies attached to an LDAP user or group DN
+func ldapMappedPolicies(ctx context.Context, client MinioAdmin, dn string, isGroup bool) ([]string, error) {
+	query := madmin.PolicyEntitiesQuery{Users: []string{dn}}
+	if isGroup {
+		query = madmin.PolicyEntitiesQuery{Groups: []string{dn}}
+	}
+	entities, err := client.getLDAPPolicyEntities(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	// DNs are compared ignoring case as LDAP does
+	if isGroup {
+		for _, mapping := range entities.GroupMappings {
+			if strings.EqualFold(mapping.Group, dn) {
+				return mapping.Policies, nil
+			}
+		}
+		return nil, nil
+	}
+	for _, mapping := range entities.UserMappings {
+		if strings.EqualFold(mapping.User, dn) {
+			return mapping.Policies, nil
+		}
+	}
+	return nil, nil
+}
+
+// updateLDAPPolicy attaches or detaches policies to exactly one LDAP user or group DN,
+// the policies left attached are returned
+func updateLDAPPolicy(ctx context.Context, client MinioAdmin, req *models.LdapPolicyAssociationRequest, attach bool) (*models.LdapPolicyAssociationResponse, error) {
+	user, group := strings.TrimSpace(req.User), strings.TrimSpace(req.Group)
+	if (user == "") == (group == "") {
+		return nil, fmt.Errorf("%w: either a user or a group DN is required", ErrInvalidLDAPPolicyAssociation)
+	}
+	if len(req.Policies) == 0 {
+		return nil, fmt.Errorf("%w: at least one policy is required", ErrInvalidLDAPPolicyAssociation)
+	}
+	dn, isGroup := user, false
+	if group != "" {
+		dn, isGroup = group, true
+	}
+	current, err := ldapMappedPolicies(ctx, client, dn, isGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := []string{}
+	if attach {
+		policies = append(policies, current...)
+		for _, policy := range req.Policies {
+			if IsElementInArray(policies, policy) {
+				continue
+			}
+			// attaching a policy that doesn't exist would leave a dangling mapping
+			if _, err := client.getPolicy(ctx, policy); err != nil {
+				return nil, err
+			}
+			policies = append(policies, policy)
+		}
+	} else {
+		for _, policy := range req.Policies {
+			if !IsElementInArray(current, policy) {
+				return nil, fmt.Errorf("%w: policy %s is not attached to %s", ErrInvalidLDAPPolicyAssociation, policy, dn)
+			}
+		}
+		for _, policy := range current {
+			if !IsElementInArray(req.Policies, policy) {
+				policies = append(policies, policy)
+			}
+		}
+	}
+
+	// setting no policy removes the mapping altogether
+	if err := client.setPolicy(ctx, strings.Join(policies, ","), dn, isGroup); err != nil {
+		return nil, err
+	}
+	return &models.LdapPolicyAssociationResponse{
+		User:     user,
+		Group:    group,
+		Policies: policies,
+	}, nil
+}
+
+func getUpdateLDAPPolicyResponse(reqCtx context.Context, session *models.Principal, body *models.LdapPolicyAssociationRequest, attach bool) (*models.LdapPolicyAssociationResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(reqCtx)
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	response, err := updateLDAPPolicy(ctx, AdminClient{Client: mAdmin}, body, attach)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return response, nil
+}
+
+// getLDAPEffectivePolicy combines the policies attached to an LDAP user DN with the ones of the groups it belongs to,
+// MinIO resolves group membership against the directory on login so the groups are given by the caller
+func getLDAPEffectivePolicy(ctx context.Context, client MinioAdmin, user string, groups []string) (*models.LdapEffectivePolicy, error) {
+	user = strings.TrimSpace(user)
+	if user == "" {
+		return nil, fmt.Errorf("%w: a user DN is required", ErrInvalidLDAPPolicyAssociation)
+	}
+	userPolicies, err := ldapMappedPolicies(ctx, client, user, false)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.LdapEffectivePolicy{
+		User:         user,
+		UserPolicies: append([]string{}, userPolicies...),
+		Groups:       []*models.LdapGroupPolicyEntity{},
+		Policies:     []string{},
+	}
+	for _, policy := range userPolicies {
+		if !IsElementInArray(result.Policies, policy) {
+			result.Policies = append(result.Policies, policy)
+		}
+	}
+	for _, group := range groups {
+		groupPolicies, err := ldapMappedPolicies(ctx, client, group, true)
+		if err != nil {
+			return nil, err
+		}
+		result.Groups = append(result.Groups, &models.LdapGroupPolicyEntity{
+			Group:    group,
+			Policies: append([]string{}, groupPolicies...),
+		})
+		for _, policy := range groupPolicies {
+			if !IsElementInArray(result.Policies, policy) {
+				result.Policies = append(result.Policies, policy)
+			}
+		}
+	}
+
+	var statements []iampolicy.Statement
+	for _, policy := range result.Policies {
+		policyStatements, err := getPolicyStatements(ctx, client, policy)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, policyStatements...)
+	}
+	combinedPolicy, err := json.Marshal(iampolicy.Policy{
+		Version:    "2012-10-17",
+		Statements: statements,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Policy = string(combinedPolicy)
+	return result, nil
+}
+
+func getLDAPEffectivePolicyResponse(session *models.Principal, params idp.GetLDAPEffectivePolicyParams) (*models.LdapEffectivePolicy, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	result, err := getLDAPEffectivePolicy(ctx, AdminClient{Client: mAdmin}, *params.Body.User, params.Body.Groups)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
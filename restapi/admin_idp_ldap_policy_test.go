@@ -0,0 +1,121 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testLDAPUserDN  = "uid=alice,ou=people,dc=example,dc=org"
+	testLDAPGroupDN = "cn=devs,ou=groups,dc=example,dc=org"
+)
+
+func mockLDAPPolicyEntities() {
+	minioGetLDAPPolicyEntitiesMock = func(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
+		var result madmin.PolicyEntitiesResult
+		for _, user := range query.Users {
+			if user == testLDAPUserDN {
+				result.UserMappings = append(result.UserMappings, madmin.UserPolicyEntities{User: testLDAPUserDN, Policies: []string{"readonly", "diagnostics"}})
+			}
+		}
+		for _, group := range query.Groups {
+			if group == testLDAPGroupDN {
+				result.GroupMappings = append(result.GroupMappings, madmin.GroupPolicyEntities{Group: testLDAPGroupDN, Policies: []string{"readwrite", "readonly"}})
+			}
+		}
+		return result, nil
+	}
+}
+
+func TestUpdateLDAPPolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	mockLDAPPolicyEntities()
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if name == "missing" {
+			return nil, errors.New("policy not found")
+		}
+		return &iampolicy.Policy{Version: "2012-10-17"}, nil
+	}
+	var setPolicies, setEntity string
+	var setGroup bool
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		setPolicies, setEntity, setGroup = policyName, entityName, isGroup
+		return nil
+	}
+
+	response, err := updateLDAPPolicy(ctx, adminClient, &models.LdapPolicyAssociationRequest{User: testLDAPUserDN, Policies: []string{"readwrite", "readonly"}}, true)
+	assert.NoError(err)
+	assert.Equal([]string{"readonly", "diagnostics", "readwrite"}, response.Policies)
+	assert.Equal("readonly,diagnostics,readwrite", setPolicies)
+	assert.Equal(testLDAPUserDN, setEntity)
+	assert.False(setGroup)
+
+	response, err = updateLDAPPolicy(ctx, adminClient, &models.LdapPolicyAssociationRequest{Group: testLDAPGroupDN, Policies: []string{"readwrite", "readonly"}}, false)
+	assert.NoError(err)
+	assert.Empty(response.Policies)
+	assert.Equal("", setPolicies)
+	assert.True(setGroup)
+
+	// a new DN has no policies yet
+	_, err = updateLDAPPolicy(ctx, adminClient, &models.LdapPolicyAssociationRequest{Group: "cn=ops,ou=groups,dc=example,dc=org", Policies: []string{"diagnostics"}}, true)
+	assert.NoError(err)
+	assert.Equal("diagnostics", setPolicies)
+
+	_, err = updateLDAPPolicy(ctx, adminClient, &models.LdapPolicyAssociationRequest{User: testLDAPUserDN, Policies: []string{"missing"}}, true)
+	assert.EqualError(err, "policy not found")
+	_, err = updateLDAPPolicy(ctx, adminClient, &models.LdapPolicyAssociationRequest{User: testLDAPUserDN, Policies: []string{"readwrite"}}, false)
+	assert.ErrorIs(err, ErrInvalidLDAPPolicyAssociation)
+	_, err = updateLDAPPolicy(ctx, adminClient, &models.LdapPolicyAssociationRequest{User: testLDAPUserDN, Group: testLDAPGroupDN, Policies: []string{"readonly"}}, true)
+	assert.ErrorIs(err, ErrInvalidLDAPPolicyAssociation)
+	_, err = updateLDAPPolicy(ctx, adminClient, &models.LdapPolicyAssociationRequest{User: testLDAPUserDN}, true)
+	assert.ErrorIs(err, ErrInvalidLDAPPolicyAssociation)
+}
+
+func TestGetLDAPEffectivePolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	mockLDAPPolicyEntities()
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		raw := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::` + name + `/*"]}]}`
+		return iampolicy.ParseConfig(bytes.NewReader([]byte(raw)))
+	}
+
+	result, err := getLDAPEffectivePolicy(ctx, adminClient, testLDAPUserDN, []string{testLDAPGroupDN, "cn=empty,dc=example,dc=org"})
+	assert.NoError(err)
+	assert.Equal([]string{"readonly", "diagnostics"}, result.UserPolicies)
+	assert.Equal([]string{"readonly", "diagnostics", "readwrite"}, result.Policies)
+	assert.Len(result.Groups, 2)
+	assert.Empty(result.Groups[1].Policies)
+	policy, err := iampolicy.ParseConfig(bytes.NewReader([]byte(result.Policy)))
+	assert.NoError(err)
+	assert.Len(policy.Statements, 3)
+
+	_, err = getLDAPEffectivePolicy(ctx, adminClient, " ", nil)
+	assert.ErrorIs(err, ErrInvalidLDAPPolicyAssociation)
+}
@@ -0,0 +1,272 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/idp"
+	"github.com/minio/madmin-go/v2"
+)
+
+func registerOpenIDClaimMappingHandlers(api *operations.ConsoleAPI) {
+	api.IdpGetOpenIDClaimMappingHandler = idp.GetOpenIDClaimMappingHandlerFunc(func(params idp.GetOpenIDClaimMappingParams, session *models.Principal) middleware.Responder {
+		response, err := getOpenIDClaimMappingResponse(session, params)
+		if err != nil {
+			return idp.NewGetOpenIDClaimMappingDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewGetOpenIDClaimMappingOK().WithPayload(response)
+	})
+	api.IdpUpdateOpenIDClaimMappingHandler = idp.UpdateOpenIDClaimMappingHandlerFunc(func(params idp.UpdateOpenIDClaimMappingParams, session *models.Principal) middleware.Responder {
+		response, err := updateOpenIDClaimMappingResponse(session, params)
+		if err != nil {
+			return idp.NewUpdateOpenIDClaimMappingDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewUpdateOpenIDClaimMappingOK().WithPayload(response)
+	})
+	api.IdpPreviewOpenIDClaimMappingHandler = idp.PreviewOpenIDClaimMappingHandlerFunc(func(params idp.PreviewOpenIDClaimMappingParams, session *models.Principal) middleware.Responder {
+		response, err := previewOpenIDClaimMappingResponse(session, params)
+		if err != nil {
+			return idp.NewPreviewOpenIDClaimMappingDefault(int(err.Code)).WithPayload(err)
+		}
+		return idp.NewPreviewOpenIDClaimMappingOK().WithPayload(response)
+	})
+}
+
+// openIDClaimMapping reads the policy mapping out of an OpenID configuration, a role policy takes
+// precedence over the claim as MinIO doesn't allow both
+func openIDClaimMapping(name string, config madmin.IDPConfig) *models.OpenIDClaimMapping {
+	mapping := &models.OpenIDClaimMapping{
+		ConfigName: name,
+		Mode:       models.OpenIDClaimMappingModeClaim,
+		ClaimName:  defaultOpenIDClaimName,
+	}
+	for _, info := range config.Info {
+		switch info.Key {
+		case "claim_name":
+			if info.Value != "" {
+				mapping.ClaimName = info.Value
+			}
+		case "claim_prefix":
+			mapping.ClaimPrefix = info.Value
+		case "role_policy":
+			mapping.RolePolicy = info.Value
+		}
+	}
+	if mapping.RolePolicy != "" {
+		mapping.Mode = models.OpenIDClaimMappingModeRole
+	}
+	return mapping
+}
+
+// openIDClaimMappingInput returns the configuration input setting the mapping, exactly one of the
+// claim name or the role policy must be set and the other one is cleared
+func openIDClaimMappingInput(req *models.OpenIDClaimMappingRequest) (string, error) {
+	values := map[string]string{
+		"claim_name":   strings.TrimSpace(req.ClaimName),
+		"claim_prefix": strings.TrimSpace(req.ClaimPrefix),
+		"role_policy":  strings.Join(splitPolicies(req.RolePolicy), ","),
+	}
+	if (values["claim_name"] == "") == (values["role_policy"] == "") {
+		return "", fmt.Errorf("%w: either a claim name or a role policy is required", ErrInvalidOpenIDClaimMapping)
+	}
+	if values["role_policy"] != "" && values["claim_prefix"] != "" {
+		return "", fmt.Errorf("%w: a claim prefix can't be used with a role policy", ErrInvalidOpenIDClaimMapping)
+	}
+	var input []string
+	for _, key := range []string{"claim_name", "claim_prefix", "role_policy"} {
+		if strings.ContainsAny(values[key], " \t\n\"'=") {
+			return "", fmt.Errorf("%w: %s can't contain spaces, quotes or equal signs", ErrInvalidOpenIDClaimMapping, key)
+		}
+		input = append(input, fmt.Sprintf("%s=%q", key, values[key]))
+	}
+	return strings.Join(input, " "), nil
+}
+
+// decodeIDTokenClaims returns the claims of a JWT without verifying its signature, the preview
+// only needs to know what a token carries
+func decodeIDTokenClaims(token string) (map[string]interface{}, error) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: the token isn't a JWT", ErrInvalidOpenIDClaimMapping)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("%w: the token payload can't be decoded: %v", ErrInvalidOpenIDClaimMapping, err)
+	}
+	var claims map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("%w: the token payload isn't a JSON object: %v", ErrInvalidOpenIDClaimMapping, err)
+	}
+	return claims, nil
+}
+
+// previewOpenIDClaimMapping resolves the policies a token maps to, policies that don't exist are
+// reported apart as MinIO ignores them
+func previewOpenIDClaimMapping(mapping *models.OpenIDClaimMapping, token string, existing map[string]bool, now time.Time) (*models.OpenIDClaimMappingPreview, error) {
+	claims, err := decodeIDTokenClaims(token)
+	if err != nil {
+		return nil, err
+	}
+	preview := &models.OpenIDClaimMappingPreview{
+		Policies:        []string{},
+		MissingPolicies: []string{},
+		Warnings:        []string{},
+	}
+	if exp, ok := claims["exp"].(json.Number); ok {
+		if seconds, err := exp.Int64(); err == nil && time.Unix(seconds, 0).Before(now) {
+			preview.Warnings = append(preview.Warnings, "the token is expired, MinIO would reject it")
+		}
+	}
+
+	var policies []string
+	if mapping.Mode == models.OpenIDClaimMappingModeRole {
+		preview.Warnings = append(preview.Warnings, "the configuration uses a role policy, every token gets the same policies regardless of its claims")
+		policies = splitPolicies(mapping.RolePolicy)
+	} else {
+		preview.Claim = mapping.ClaimPrefix + mapping.ClaimName
+		switch value := claims[preview.Claim].(type) {
+		case nil:
+			preview.Warnings = append(preview.Warnings, fmt.Sprintf("the token has no %s claim, MinIO would reject it", preview.Claim))
+		case string:
+			preview.ClaimValue = value
+			policies = splitPolicies(value)
+		case []interface{}:
+			raw, _ := json.Marshal(value)
+			preview.ClaimValue = string(raw)
+			for _, item := range value {
+				if name, ok := item.(string); ok {
+					policies = append(policies, splitPolicies(name)...)
+				} else {
+					preview.Warnings = append(preview.Warnings, fmt.Sprintf("%v in the %s claim isn't a policy name", item, preview.Claim))
+				}
+			}
+		default:
+			raw, _ := json.Marshal(value)
+			preview.ClaimValue = string(raw)
+			preview.Warnings = append(preview.Warnings, fmt.Sprintf("the %s claim must be a string or a list of strings", preview.Claim))
+		}
+	}
+	for _, policy := range policies {
+		if IsElementInArray(preview.Policies, policy) || IsElementInArray(preview.MissingPolicies, policy) {
+			continue
+		}
+		if existing[policy] {
+			preview.Policies = append(preview.Policies, policy)
+		} else {
+			preview.MissingPolicies = append(preview.MissingPolicies, policy)
+		}
+	}
+	if len(preview.Policies) == 0 {
+		preview.Warnings = append(preview.Warnings, "no existing policy applies, every request would be denied")
+	}
+	return preview, nil
+}
+
+// getOpenIDClaimMapping returns the mapping of a configuration along with its role ARN and status
+func getOpenIDClaimMapping(ctx context.Context, client MinioAdmin, name string) (*models.OpenIDClaimMapping, error) {
+	config, err := client.getIDPConfig(ctx, madmin.OpenidIDPCfg, name)
+	if err != nil {
+		return nil, err
+	}
+	mapping := openIDClaimMapping(name, config)
+	items, err := client.listIDPConfig(ctx, madmin.OpenidIDPCfg)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Name == name {
+			mapping.Enabled = item.Enabled
+			mapping.RoleArn = item.RoleARN
+		}
+	}
+	return mapping, nil
+}
+
+// resolveOpenIDClaimMappingPreview previews a token against the current mapping and policies
+func resolveOpenIDClaimMappingPreview(ctx context.Context, client MinioAdmin, name, token string) (*models.OpenIDClaimMappingPreview, error) {
+	mapping, err := getOpenIDClaimMapping(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for policy := range policies {
+		existing[policy] = true
+	}
+	return previewOpenIDClaimMapping(mapping, token, existing, time.Now())
+}
+
+func getOpenIDClaimMappingResponse(session *models.Principal, params idp.GetOpenIDClaimMappingParams) (*models.OpenIDClaimMapping, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mapping, err := getOpenIDClaimMapping(ctx, AdminClient{Client: mAdmin}, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return mapping, nil
+}
+
+func updateOpenIDClaimMappingResponse(session *models.Principal, params idp.UpdateOpenIDClaimMappingParams) (*models.SetIDPResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	input, err := openIDClaimMappingInput(params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	restart, err := createOrUpdateIDPConfig(ctx, madmin.OpenidIDPCfg, params.Name, input, true, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.SetIDPResponse{Restart: restart}, nil
+}
+
+func previewOpenIDClaimMappingResponse(session *models.Principal, params idp.PreviewOpenIDClaimMappingParams) (*models.OpenIDClaimMappingPreview, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	preview, err := resolveOpenIDClaimMappingPreview(ctx, AdminClient{Client: mAdmin}, params.Name, *params.Body.Token)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return preview, nil
+}
@@ -0,0 +1,111 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func testIDToken(payload string) string {
+	return "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestOpenIDClaimMapping(t *testing.T) {
+	assert := assert.New(t)
+	mapping := openIDClaimMapping("okta", madmin.IDPConfig{Info: []madmin.IDPCfgInfo{
+		{Key: "config_url", Value: "https://okta.example.com/.well-known/openid-configuration"},
+		{Key: "claim_prefix", Value: "minio-"},
+	}})
+	assert.Equal("okta", mapping.ConfigName)
+	assert.Equal(models.OpenIDClaimMappingModeClaim, mapping.Mode)
+	assert.Equal(defaultOpenIDClaimName, mapping.ClaimName)
+	assert.Equal("minio-", mapping.ClaimPrefix)
+
+	mapping = openIDClaimMapping("okta", madmin.IDPConfig{Info: []madmin.IDPCfgInfo{
+		{Key: "claim_name", Value: "groups"},
+		{Key: "role_policy", Value: "readonly"},
+	}})
+	assert.Equal(models.OpenIDClaimMappingModeRole, mapping.Mode)
+	assert.Equal("readonly", mapping.RolePolicy)
+}
+
+func TestOpenIDClaimMappingInput(t *testing.T) {
+	assert := assert.New(t)
+	input, err := openIDClaimMappingInput(&models.OpenIDClaimMappingRequest{ClaimName: "groups", ClaimPrefix: "minio-"})
+	assert.NoError(err)
+	assert.Equal(`claim_name="groups" claim_prefix="minio-" role_policy=""`, input)
+
+	input, err = openIDClaimMappingInput(&models.OpenIDClaimMappingRequest{RolePolicy: "readonly, diagnostics"})
+	assert.NoError(err)
+	assert.Equal(`claim_name="" claim_prefix="" role_policy="readonly,diagnostics"`, input)
+
+	tests := []*models.OpenIDClaimMappingRequest{
+		{},
+		{ClaimName: "groups", RolePolicy: "readonly"},
+		{RolePolicy: "readonly", ClaimPrefix: "minio-"},
+		{ClaimName: `groups" role_policy="consoleAdmin`},
+	}
+	for _, req := range tests {
+		_, err := openIDClaimMappingInput(req)
+		assert.True(errors.Is(err, ErrInvalidOpenIDClaimMapping), req)
+	}
+}
+
+func TestPreviewOpenIDClaimMapping(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Unix(1700000000, 0)
+	existing := map[string]bool{"readonly": true, "diagnostics": true}
+	mapping := &models.OpenIDClaimMapping{Mode: models.OpenIDClaimMappingModeClaim, ClaimName: "policy", ClaimPrefix: "minio-"}
+
+	preview, err := previewOpenIDClaimMapping(mapping, testIDToken(`{"exp":1800000000,"minio-policy":"readonly,missing"}`), existing, now)
+	assert.NoError(err)
+	assert.Equal("minio-policy", preview.Claim)
+	assert.Equal("readonly,missing", preview.ClaimValue)
+	assert.Equal([]string{"readonly"}, preview.Policies)
+	assert.Equal([]string{"missing"}, preview.MissingPolicies)
+	assert.Empty(preview.Warnings)
+
+	// lists are accepted and an expired token is reported
+	preview, err = previewOpenIDClaimMapping(mapping, testIDToken(`{"exp":1600000000,"minio-policy":["readonly","diagnostics","readonly",3]}`), existing, now)
+	assert.NoError(err)
+	assert.Equal([]string{"readonly", "diagnostics"}, preview.Policies)
+	assert.Len(preview.Warnings, 2)
+
+	preview, err = previewOpenIDClaimMapping(mapping, testIDToken(`{"policy":"readonly"}`), existing, now)
+	assert.NoError(err)
+	assert.Empty(preview.Policies)
+	assert.Len(preview.Warnings, 2)
+
+	// a role policy ignores the token claims
+	role := &models.OpenIDClaimMapping{Mode: models.OpenIDClaimMappingModeRole, RolePolicy: "diagnostics"}
+	preview, err = previewOpenIDClaimMapping(role, testIDToken(`{"policy":"readonly"}`), existing, now)
+	assert.NoError(err)
+	assert.Equal([]string{"diagnostics"}, preview.Policies)
+	assert.Len(preview.Warnings, 1)
+
+	for _, token := range []string{"", "not-a-token", "a.!!!.c", testIDToken(`[1,2]`)} {
+		_, err := previewOpenIDClaimMapping(mapping, token, existing, now)
+		assert.True(errors.Is(err, ErrInvalidOpenIDClaimMapping), token)
+	}
+}
@@ -977,33 +977,90 @@ func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, adm
 	return sessionResp, nil
 }
 
-func unmarshalPrometheus(ctx context.Context, endpoint string, data interface{}) bool {
-	httpClnt := GetConsoleHTTPClient(endpoint)
+// prometheusAuthTransport adds the configured credentials to the requests sent to Prometheus, they
+// never reach the browser
+type prometheusAuthTransport struct {
+	base     http.RoundTripper
+	token    string
+	username string
+	password string
+}
+
+func (t prometheusAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	} else {
+		req.SetBasicAuth(t.username, t.password)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// prometheusHTTPClient returns the client to query Prometheus, authenticated with a bearer token or
+// basic auth when configured
+func prometheusHTTPClient(prometheusURL string) *http.Client {
+	client := GetConsoleHTTPClient(prometheusURL)
+	token, username := getPrometheusAuthToken(), getPrometheusUsername()
+	if token == "" && username == "" {
+		return client
+	}
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	authClient := *client
+	authClient.Transport = prometheusAuthTransport{
+		base:     base,
+		token:    token,
+		username: username,
+		password: getPrometheusPassword(),
+	}
+	return &authClient
+}
+
+// prometheusSelector returns the labels selecting the MinIO metrics in Prometheus
+func prometheusSelector() string {
+	prometheusJobID := getPrometheusJobID()
+	prometheusExtraLabels := getPrometheusExtraLabels()
+
+	selector := fmt.Sprintf(`job="%s"`, prometheusJobID)
+	if strings.TrimSpace(prometheusExtraLabels) != "" {
+		selector = fmt.Sprintf(`job="%s",%s`, prometheusJobID, prometheusExtraLabels)
+	}
+	return selector
+}
+
+// fetchPrometheus decodes the response of a Prometheus API endpoint into data
+func fetchPrometheus(ctx context.Context, endpoint string, data interface{}) error {
+	httpClnt := prometheusHTTPClient(endpoint)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
-		ErrorWithContext(ctx, fmt.Errorf("Unable to create the request to fetch labels from prometheus: %w", err))
-		return true
+		return fmt.Errorf("Unable to create the request to fetch labels from prometheus: %w", err)
 	}
 
 	resp, err := httpClnt.Do(req)
 	if err != nil {
-		ErrorWithContext(ctx, fmt.Errorf("Unable to fetch labels from prometheus: %w", err))
-		return true
+		return fmt.Errorf("Unable to fetch labels from prometheus: %w", err)
 	}
 
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		ErrorWithContext(ctx, fmt.Errorf("Unexpected status code from prometheus (%s)", resp.Status))
-		return true
+		return fmt.Errorf("Unexpected status code from prometheus (%s)", resp.Status)
 	}
 
 	if err = json.NewDecoder(resp.Body).Decode(data); err != nil {
-		ErrorWithContext(ctx, fmt.Errorf("Unexpected error from prometheus: %w", err))
-		return true
+		return fmt.Errorf("Unexpected error from prometheus: %w", err)
 	}
+	return nil
+}
 
+func unmarshalPrometheus(ctx context.Context, endpoint string, data interface{}) bool {
+	if err := fetchPrometheus(ctx, endpoint, data); err != nil {
+		ErrorWithContext(ctx, err)
+		return true
+	}
 	return false
 }
 
@@ -1013,7 +1070,7 @@ func testPrometheusURL(ctx context.Context, url string) bool {
 		ErrorWithContext(ctx, fmt.Errorf("error Building Request: (%v)", err))
 		return false
 	}
-	response, err := GetConsoleHTTPClient(url).Do(req)
+	response, err := prometheusHTTPClient(url).Do(req)
 	if err != nil {
 		ErrorWithContext(ctx, fmt.Errorf("default Prometheus URL not reachable, trying root testing: (%v)", err))
 		newTestURL := req.URL.Scheme + "://" + req.URL.Host + "/-/healthy"
@@ -1022,7 +1079,7 @@ func testPrometheusURL(ctx context.Context, url string) bool {
 			ErrorWithContext(ctx, fmt.Errorf("error Building Root Request: (%v)", err))
 			return false
 		}
-		rootResponse, err := GetConsoleHTTPClient(newTestURL).Do(req2)
+		rootResponse, err := prometheusHTTPClient(newTestURL).Do(req2)
 		if err != nil {
 			// URL & Root tests didn't work. Prometheus not reachable
 			ErrorWithContext(ctx, fmt.Errorf("root Prometheus URL not reachable: (%v)", err))
@@ -1036,15 +1093,7 @@ func testPrometheusURL(ctx context.Context, url string) bool {
 func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams) (*models.WidgetDetails, *models.Error) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
-	prometheusURL := getPrometheusURL()
-	prometheusJobID := getPrometheusJobID()
-	prometheusExtraLabels := getPrometheusExtraLabels()
-
-	selector := fmt.Sprintf(`job="%s"`, prometheusJobID)
-	if strings.TrimSpace(prometheusExtraLabels) != "" {
-		selector = fmt.Sprintf(`job="%s",%s`, prometheusJobID, prometheusExtraLabels)
-	}
-	return getWidgetDetails(ctx, prometheusURL, selector, params.WidgetID, params.Step, params.Start, params.End)
+	return getWidgetDetails(ctx, getPrometheusURL(), prometheusSelector(), params.WidgetID, params.Step, params.Start, params.End)
 }
 
 func getWidgetDetails(ctx context.Context, prometheusURL string, selector string, widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *models.Error) {
@@ -17,15 +17,20 @@
 package restapi
 
 import (
+	"context"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strings"
 	"unicode/utf8"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations"
 	inspectApi "github.com/minio/console/restapi/operations/inspect"
@@ -33,6 +38,9 @@ import (
 	"github.com/secure-io/sio-go"
 )
 
+// minioSupportPublicKey is the key inspect data is encrypted for so only MinIO support can open it
+const minioSupportPublicKey = "MIIBCgKCAQEAs/128UFS9A8YSJY1XqYKt06dLVQQCGDee69T+0Tip/1jGAB4z0/3QMpH0MiS8Wjs4BRWV51qvkfAHzwwdU7y6jxU05ctb/H/WzRj3FYdhhHKdzear9TLJftlTs+xwj2XaADjbLXCV1jGLS889A7f7z5DgABlVZMQd9BjVAR8ED3xRJ2/ZCNuQVJ+A8r7TYPGMY3wWvhhPgPk3Lx4WDZxDiDNlFs4GQSaESSsiVTb9vyGe/94CsCTM6Cw9QG6ifHKCa/rFszPYdKCabAfHcS3eTr0GM+TThSsxO7KfuscbmLJkfQev1srfL2Ii2RbnysqIJVWKEwdW05ID8ryPkuTuwIDAQAB"
+
 func registerInspectHandler(api *operations.ConsoleAPI) {
 	api.InspectInspectHandler = inspectApi.InspectHandlerFunc(func(params inspectApi.InspectParams, principal *models.Principal) middleware.Responder {
 		if v, err := base64.URLEncoding.DecodeString(params.File); err == nil && utf8.Valid(v) {
@@ -50,6 +58,13 @@ func registerInspectHandler(api *operations.ConsoleAPI) {
 
 		return middleware.ResponderFunc(processInspectResponse(&params, k, r))
 	})
+	api.InspectInspectBundleHandler = inspectApi.InspectBundleHandlerFunc(func(params inspectApi.InspectBundleParams, principal *models.Principal) middleware.Responder {
+		resp, err := getInspectBundleResponse(principal, params)
+		if err != nil {
+			return inspectApi.NewInspectBundleDefault(int(err.Code)).WithPayload(err)
+		}
+		return resp
+	})
 }
 
 func getInspectResult(session *models.Principal, params *inspectApi.InspectParams) ([]byte, io.ReadCloser, *models.Error) {
@@ -67,7 +82,7 @@ func getInspectResult(session *models.Principal, params *inspectApi.InspectParam
 	// TODO: Remove encryption option and always encrypt.
 	// Maybe also add public key field.
 	if params.Encrypt != nil && *params.Encrypt {
-		cfg.PublicKey, _ = base64.StdEncoding.DecodeString("MIIBCgKCAQEAs/128UFS9A8YSJY1XqYKt06dLVQQCGDee69T+0Tip/1jGAB4z0/3QMpH0MiS8Wjs4BRWV51qvkfAHzwwdU7y6jxU05ctb/H/WzRj3FYdhhHKdzear9TLJftlTs+xwj2XaADjbLXCV1jGLS889A7f7z5DgABlVZMQd9BjVAR8ED3xRJ2/ZCNuQVJ+A8r7TYPGMY3wWvhhPgPk3Lx4WDZxDiDNlFs4GQSaESSsiVTb9vyGe/94CsCTM6Cw9QG6ifHKCa/rFszPYdKCabAfHcS3eTr0GM+TThSsxO7KfuscbmLJkfQev1srfL2Ii2RbnysqIJVWKEwdW05ID8ryPkuTuwIDAQAB")
+		cfg.PublicKey, _ = base64.StdEncoding.DecodeString(minioSupportPublicKey)
 	}
 
 	// create a MinIO Admin Client interface implementation
@@ -99,22 +114,7 @@ func processInspectResponse(params *inspectApi.InspectParams, k []byte, r io.Rea
 			ext = "zip"
 			r = decryptInspectV1(*(*[32]byte)(k), r)
 		}
-		fileName := fmt.Sprintf("inspect-%s-%s.%s", params.Volume, params.File, ext)
-		fileName = strings.Map(func(r rune) rune {
-			switch {
-			case r >= 'A' && r <= 'Z':
-				return r
-			case r >= 'a' && r <= 'z':
-				return r
-			case r >= '0' && r <= '9':
-				return r
-			default:
-				if strings.ContainsAny(string(r), "-+._") {
-					return r
-				}
-				return '_'
-			}
-		}, fileName)
+		fileName := inspectFileName(params.Volume, params.File, ext)
 		w.Header().Set("Content-Type", "application/octet-stream")
 		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
 
@@ -124,3 +124,109 @@ func processInspectResponse(params *inspectApi.InspectParams, k []byte, r io.Rea
 		}
 	}
 }
+
+// inspectFileName names the downloaded inspect data after the files it holds
+func inspectFileName(volume, file, ext string) string {
+	fileName := fmt.Sprintf("inspect-%s-%s.%s", volume, file, ext)
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			return r
+		case r >= 'a' && r <= 'z':
+			return r
+		case r >= '0' && r <= '9':
+			return r
+		default:
+			if strings.ContainsAny(string(r), "-+._") {
+				return r
+			}
+			return '_'
+		}
+	}, fileName)
+}
+
+// inspectBackendFile matches the last element of paths naming backend files rather than objects
+var inspectBackendFile = regexp.MustCompile(`^(xl\.meta|part\.\d+)$`)
+
+// inspectPath splits the path of an object like `mc admin inspect` does, collecting the xl.meta of the
+// object unless the path already names backend files or uses wildcards
+func inspectPath(path string) (volume, file string, err error) {
+	path = strings.TrimPrefix(path, "/")
+	volume, file, _ = strings.Cut(path, "/")
+	file = strings.TrimSuffix(file, "/")
+	if volume == "" || file == "" {
+		return "", "", fmt.Errorf("%w: the path has to name a bucket and an object", ErrInvalidInspectRequest)
+	}
+	if !strings.Contains(file, "*") && !inspectBackendFile.MatchString(file[strings.LastIndex(file, "/")+1:]) {
+		file += "/xl.meta"
+	}
+	return volume, file, nil
+}
+
+// inspectPublicKey returns the PKCS1 encoded key the bundle is encrypted for, MinIO support's when none is given
+func inspectPublicKey(key string) ([]byte, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		key = minioSupportPublicKey
+	}
+	var der []byte
+	if block, _ := pem.Decode([]byte(key)); block != nil {
+		der = block.Bytes
+	} else {
+		var err error
+		if der, err = base64.StdEncoding.DecodeString(key); err != nil {
+			return nil, fmt.Errorf("%w: the public key has to be PEM or base64 encoded", ErrInvalidInspectRequest)
+		}
+	}
+	if _, err := x509.ParsePKCS1PublicKey(der); err != nil {
+		return nil, fmt.Errorf("%w: the public key isn't a PKCS1 RSA key", ErrInvalidInspectRequest)
+	}
+	return der, nil
+}
+
+// inspectBundle collects the backend files of the path encrypted with the public key. Servers that can't
+// encrypt with a public key return the key of the data instead, the bundle is refused as it wouldn't be
+// protected.
+func inspectBundle(ctx context.Context, client MinioAdmin, path, publicKey string) (string, io.ReadCloser, error) {
+	volume, file, err := inspectPath(path)
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := inspectPublicKey(publicKey)
+	if err != nil {
+		return "", nil, err
+	}
+	dataKey, r, err := client.inspect(ctx, madmin.InspectOptions{Volume: volume, File: file, PublicKey: key})
+	if err != nil {
+		return "", nil, err
+	}
+	if len(dataKey) == 32 {
+		r.Close()
+		return "", nil, ErrInspectEncryptionNotSupported
+	}
+	return inspectFileName(volume, file, "enc"), r, nil
+}
+
+func getInspectBundleResponse(session *models.Principal, params inspectApi.InspectBundleParams) (middleware.Responder, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	path := params.Path
+	if v, err := base64.URLEncoding.DecodeString(path); err == nil && utf8.Valid(v) {
+		path = string(v)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	fileName, r, err := inspectBundle(ctx, AdminClient{Client: mAdmin}, path, swag.StringValue(params.PublicKey))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		defer r.Close()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+		if _, err := io.Copy(w, r); err != nil {
+			LogError("Unable to write the inspect bundle: %v", err)
+		}
+	}), nil
+}
@@ -0,0 +1,112 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_inspectPath(t *testing.T) {
+	assert := assert.New(t)
+
+	volume, file, err := inspectPath("images/2023/cat.png")
+	assert.NoError(err)
+	assert.Equal("images", volume)
+	assert.Equal("2023/cat.png/xl.meta", file)
+
+	// paths naming backend files or using wildcards are collected as they are
+	_, file, err = inspectPath("/images/cat.png/*/part.1")
+	assert.NoError(err)
+	assert.Equal("cat.png/*/part.1", file)
+	_, file, err = inspectPath("images/cat*")
+	assert.NoError(err)
+	assert.Equal("cat*", file)
+
+	_, _, err = inspectPath("images")
+	assert.ErrorIs(err, ErrInvalidInspectRequest)
+	_, _, err = inspectPath("images/")
+	assert.ErrorIs(err, ErrInvalidInspectRequest)
+}
+
+func Test_inspectPublicKey(t *testing.T) {
+	assert := assert.New(t)
+
+	supportKey, err := inspectPublicKey("")
+	assert.NoError(err)
+	expected, _ := base64.StdEncoding.DecodeString(minioSupportPublicKey)
+	assert.Equal(expected, supportKey)
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if !assert.NoError(err) {
+		return
+	}
+	der := x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)
+	key, err := inspectPublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: der})))
+	assert.NoError(err)
+	assert.Equal(der, key)
+	key, err = inspectPublicKey(base64.StdEncoding.EncodeToString(der))
+	assert.NoError(err)
+	assert.Equal(der, key)
+
+	_, err = inspectPublicKey("not a key")
+	assert.ErrorIs(err, ErrInvalidInspectRequest)
+	_, err = inspectPublicKey(base64.StdEncoding.EncodeToString([]byte("not a key")))
+	assert.ErrorIs(err, ErrInvalidInspectRequest)
+}
+
+func Test_inspectBundle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	var options madmin.InspectOptions
+	minioInspectMock = func(insOpts madmin.InspectOptions) ([]byte, io.ReadCloser, error) {
+		options = insOpts
+		return []byte{1}, io.NopCloser(strings.NewReader("encrypted")), nil
+	}
+	fileName, r, err := inspectBundle(ctx, client, "images/cat.png", "")
+	if !assert.NoError(err) {
+		return
+	}
+	data, _ := io.ReadAll(r)
+	assert.Equal("encrypted", string(data))
+	assert.Equal("inspect-images-cat.png_xl.meta.enc", fileName)
+	assert.Equal("images", options.Volume)
+	assert.Equal("cat.png/xl.meta", options.File)
+	assert.NotEmpty(options.PublicKey)
+
+	// servers returning the key of the data can't protect the bundle
+	minioInspectMock = func(insOpts madmin.InspectOptions) ([]byte, io.ReadCloser, error) {
+		return make([]byte, 32), io.NopCloser(strings.NewReader("data")), nil
+	}
+	_, _, err = inspectBundle(ctx, client, "images/cat.png", "")
+	assert.ErrorIs(err, ErrInspectEncryptionNotSupported)
+
+	_, _, err = inspectBundle(ctx, client, "images", "")
+	assert.ErrorIs(err, ErrInvalidInspectRequest)
+}
@@ -32,6 +32,7 @@ import (
 func registerKMSHandlers(api *operations.ConsoleAPI) {
 	registerKMSStatusHandlers(api)
 	registerKMSKeyHandlers(api)
+	registerKMSTestKeyHandlers(api)
 	registerKMSPolicyHandlers(api)
 	registerKMSIdentityHandlers(api)
 }
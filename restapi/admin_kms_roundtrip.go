@@ -0,0 +1,189 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	kmsAPI "github.com/minio/console/restapi/operations/k_m_s"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// kmsTestObjectPrefix is where the probe objects of the KMS key tests are written, they are removed right after
+const kmsTestObjectPrefix = ".console-kms-test/"
+
+// Steps of a KMS key test
+const (
+	kmsTestStepDataKey       = "dataKey"
+	kmsTestStepEncryptObject = "encryptObject"
+	kmsTestStepDecryptObject = "decryptObject"
+)
+
+func registerKMSTestKeyHandlers(api *operations.ConsoleAPI) {
+	// test a KMS key with a round trip
+	api.KmsKMSTestKeyHandler = kmsAPI.KMSTestKeyHandlerFunc(func(params kmsAPI.KMSTestKeyParams, session *models.Principal) middleware.Responder {
+		resp, err := getKMSTestKeyResponse(session, params)
+		if err != nil {
+			return kmsAPI.NewKMSTestKeyDefault(int(err.Code)).WithPayload(err)
+		}
+		return kmsAPI.NewKMSTestKeyOK().WithPayload(resp)
+	})
+}
+
+// runKMSTestStep runs a step of a KMS key test and reports its outcome and duration
+func runKMSTestStep(name string, step func() error) *models.KmsTestStep {
+	start := time.Now()
+	err := step()
+	result := &models.KmsTestStep{Name: name, Success: err == nil, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// testKMSKeyDataKey has MinIO generate a data key with the key and decrypt it back
+func testKMSKeyDataKey(ctx context.Context, adminClient MinioAdmin, key string) error {
+	status, err := adminClient.keyStatus(ctx, key)
+	if err != nil {
+		return err
+	}
+	if status.EncryptionErr != "" {
+		return fmt.Errorf("encryption failed: %s", status.EncryptionErr)
+	}
+	if status.DecryptionErr != "" {
+		return fmt.Errorf("decryption failed: %s", status.DecryptionErr)
+	}
+	return nil
+}
+
+// testKMSKeyObject writes a probe object encrypted with SSE-KMS and the key to the bucket, reads it back and
+// removes it. It returns the outcome of the encryption and of the decryption.
+func testKMSKeyObject(ctx context.Context, client MinioClient, bucket, key string) (encryptStep, decryptStep *models.KmsTestStep) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return &models.KmsTestStep{Name: kmsTestStepEncryptObject, Error: err.Error()}, nil
+	}
+	probe := []byte(hex.EncodeToString(random))
+	object := kmsTestObjectPrefix + string(probe)
+	var versionID string
+	encryptStep = runKMSTestStep(kmsTestStepEncryptObject, func() error {
+		sse, err := encrypt.NewSSEKMS(key, nil)
+		if err != nil {
+			return err
+		}
+		info, err := client.putObject(ctx, bucket, object, bytes.NewReader(probe), int64(len(probe)), minio.PutObjectOptions{
+			ServerSideEncryption: sse,
+			ContentType:          "text/plain",
+		})
+		if err != nil {
+			return err
+		}
+		versionID = info.VersionID
+		stat, err := client.statObject(ctx, bucket, object, minio.GetObjectOptions{VersionID: versionID})
+		if err != nil {
+			return err
+		}
+		if stat.Metadata.Get("X-Amz-Server-Side-Encryption") != "aws:kms" {
+			return fmt.Errorf("the object wasn't encrypted with SSE-KMS")
+		}
+		if keyID := strings.TrimPrefix(stat.Metadata.Get("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id"), "arn:aws:kms:"); keyID != key {
+			return fmt.Errorf("the object was encrypted with the key %q", keyID)
+		}
+		return nil
+	})
+	if !encryptStep.Success && versionID == "" {
+		return encryptStep, nil
+	}
+	// the probe is removed even when it couldn't be verified, versioned buckets don't keep it either
+	defer func() {
+		if err := client.removeObject(ctx, bucket, object, minio.RemoveObjectOptions{VersionID: versionID}); err != nil {
+			LogErrorContext(ctx, "unable to remove the KMS test object %s of %s: %v", object, bucket, err)
+		}
+	}()
+	if !encryptStep.Success {
+		return encryptStep, nil
+	}
+	decryptStep = runKMSTestStep(kmsTestStepDecryptObject, func() error {
+		rc, err := client.getObject(ctx, bucket, object, minio.GetObjectOptions{VersionID: versionID})
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		data, err := io.ReadAll(io.LimitReader(rc, int64(len(probe))+1))
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(data, probe) {
+			return fmt.Errorf("the object read back differs from the one written")
+		}
+		return nil
+	})
+	return encryptStep, decryptStep
+}
+
+// testKMSKey tests a key with a data key round trip through MinIO and, with a bucket, an SSE-KMS object round trip
+func testKMSKey(ctx context.Context, adminClient MinioAdmin, client MinioClient, key, bucket string) *models.KmsTestKeyResponse {
+	resp := &models.KmsTestKeyResponse{KeyID: key}
+	resp.Steps = append(resp.Steps, runKMSTestStep(kmsTestStepDataKey, func() error {
+		return testKMSKeyDataKey(ctx, adminClient, key)
+	}))
+	if bucket != "" && resp.Steps[0].Success {
+		encryptStep, decryptStep := testKMSKeyObject(ctx, client, bucket, key)
+		resp.Steps = append(resp.Steps, encryptStep)
+		if decryptStep != nil {
+			resp.Steps = append(resp.Steps, decryptStep)
+		}
+	}
+	resp.Success = true
+	for _, step := range resp.Steps {
+		resp.Success = resp.Success && step.Success
+	}
+	return resp
+}
+
+func getKMSTestKeyResponse(session *models.Principal, params kmsAPI.KMSTestKeyParams) (*models.KmsTestKeyResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	var client MinioClient
+	bucket := ""
+	if params.Body != nil {
+		bucket = params.Body.Bucket
+	}
+	if bucket != "" {
+		mClient, err := newMinioClient(session)
+		if err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		client = minioClient{client: mClient}
+	}
+	return testKMSKey(ctx, AdminClient{Client: mAdmin}, client, params.Name, bucket), nil
+}
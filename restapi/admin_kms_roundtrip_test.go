@@ -0,0 +1,101 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockKMSTestObjects keeps the probe objects written by the KMS key tests in memory
+func mockKMSTestObjects(objects map[string][]byte, sseHeader string) {
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return minio.UploadInfo{}, err
+		}
+		objects[objectName] = data
+		return minio.UploadInfo{Bucket: bucketName, Key: objectName, VersionID: "v1"}, nil
+	}
+	minioStatObjectMock = func(ctx context.Context, bucketName, prefix string, opts minio.GetObjectOptions) (minio.ObjectInfo, error) {
+		metadata := http.Header{}
+		metadata.Set("X-Amz-Server-Side-Encryption", sseHeader)
+		metadata.Set("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id", "arn:aws:kms:my-key")
+		return minio.ObjectInfo{Key: prefix, Metadata: metadata}, nil
+	}
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+		data, ok := objects[objectName]
+		if !ok {
+			return nil, errors.New("object not found")
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	minioRemoveObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+		delete(objects, objectName)
+		return nil
+	}
+}
+
+func Test_testKMSKey(t *testing.T) {
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	adminClient := AdminClientMock{}
+	client := minioClientMock{}
+
+	// without a bucket only the data key is tested
+	resp := testKMSKey(ctx, adminClient, nil, "my-key", "")
+	assert.True(resp.Success)
+	assert.Len(resp.Steps, 1)
+	assert.Equal(kmsTestStepDataKey, resp.Steps[0].Name)
+
+	objects := map[string][]byte{}
+	mockKMSTestObjects(objects, "aws:kms")
+	resp = testKMSKey(ctx, adminClient, client, "my-key", "bucket")
+	assert.True(resp.Success)
+	assert.Len(resp.Steps, 3)
+	assert.Equal(kmsTestStepEncryptObject, resp.Steps[1].Name)
+	assert.Equal(kmsTestStepDecryptObject, resp.Steps[2].Name)
+	assert.Empty(objects, "the probe object must be removed")
+
+	// the object was stored without SSE-KMS
+	mockKMSTestObjects(objects, "AES256")
+	resp = testKMSKey(ctx, adminClient, client, "my-key", "bucket")
+	assert.False(resp.Success)
+	assert.Len(resp.Steps, 2)
+	assert.False(resp.Steps[1].Success)
+	assert.Empty(objects, "the probe object must be removed")
+
+	// the object can't be read back
+	mockKMSTestObjects(objects, "aws:kms")
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+		return nil, errors.New("decryption failed")
+	}
+	resp = testKMSKey(ctx, adminClient, client, "my-key", "bucket")
+	assert.False(resp.Success)
+	assert.Len(resp.Steps, 3)
+	assert.True(resp.Steps[1].Success)
+	assert.Contains(resp.Steps[2].Error, "decryption failed")
+	assert.Empty(objects, "the probe object must be removed")
+}
@@ -0,0 +1,100 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/restapi/operations"
+	logApi "github.com/minio/console/restapi/operations/logging"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerLogLevelHandlers(api *operations.ConsoleAPI) {
+	// level and format of the console server logs
+	api.LoggingGetLogLevelHandler = logApi.GetLogLevelHandlerFunc(func(params logApi.GetLogLevelParams, session *models.Principal) middleware.Responder {
+		resp, err := getLogLevelResponse(session, params)
+		if err != nil {
+			return logApi.NewGetLogLevelDefault(int(err.Code)).WithPayload(err)
+		}
+		return logApi.NewGetLogLevelOK().WithPayload(resp)
+	})
+	// change the level of the console server logs at runtime
+	api.LoggingSetLogLevelHandler = logApi.SetLogLevelHandlerFunc(func(params logApi.SetLogLevelParams, session *models.Principal) middleware.Responder {
+		resp, err := getSetLogLevelResponse(session, params)
+		if err != nil {
+			return logApi.NewSetLogLevelDefault(int(err.Code)).WithPayload(err)
+		}
+		return logApi.NewSetLogLevelOK().WithPayload(resp)
+	})
+}
+
+// currentLogLevel returns the level and format the console server logs are printed with
+func currentLogLevel() *models.LogLevel {
+	return &models.LogLevel{
+		Level:  swag.String(strings.ToLower(logger.GetLevel().String())),
+		Format: string(logger.GetFormat()),
+	}
+}
+
+// setLogLevel changes the minimum level of the console server logs, it is reset when the server restarts
+func setLogLevel(ctx context.Context, name string) (*models.LogLevel, error) {
+	level, err := logger.ParseLevel(name)
+	if err != nil {
+		return nil, err
+	}
+	if previous := logger.GetLevel(); previous != level {
+		logger.SetLevel(level)
+		LogInfoContext(ctx, "log level changed from %s to %s", previous, level)
+	}
+	return currentLogLevel(), nil
+}
+
+func getLogLevelResponse(session *models.Principal, params logApi.GetLogLevelParams) (*models.LogLevel, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ServerInfoAdminAction) {
+		return nil, ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	return currentLogLevel(), nil
+}
+
+func getSetLogLevelResponse(session *models.Principal, params logApi.SetLogLevelParams) (*models.LogLevel, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ConfigUpdateAdminAction) {
+		return nil, ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	resp, setErr := setLogLevel(ctx, swag.StringValue(params.Body.Level))
+	if setErr != nil {
+		return nil, ErrorWithContext(ctx, ErrInvalidLogLevel, setErr)
+	}
+	return resp, nil
+}
@@ -0,0 +1,71 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLogLevel(t *testing.T) {
+	assert := assert.New(t)
+	defer logger.SetLevel(logger.InformationLvl)
+
+	resp, err := setLogLevel(context.Background(), "debug")
+	assert.NoError(err)
+	assert.Equal("debug", swag.StringValue(resp.Level))
+	assert.Equal("text", resp.Format)
+	assert.True(logger.Enabled(logger.DebugLvl))
+
+	_, err = setLogLevel(context.Background(), "verbose")
+	assert.Error(err)
+	assert.Equal("debug", swag.StringValue(currentLogLevel().Level))
+}
+
+func TestContextMiddlewareRequestID(t *testing.T) {
+	assert := assert.New(t)
+	var requestID string
+	handler := ContextMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID, _ = r.Context().Value(utils.ContextRequestID).(string)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/session", nil))
+	assert.NotEmpty(requestID)
+	assert.Equal(requestID, w.Header().Get("X-Request-ID"))
+
+	// the ID of the proxy in front of Console is kept when it is sane
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+	r.Header.Set("X-Request-ID", "lb-6f1c2a")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal("lb-6f1c2a", requestID)
+	assert.Equal("lb-6f1c2a", w.Header().Get("X-Request-ID"))
+
+	r.Header.Set("X-Request-ID", "<script>")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.NotEqual("<script>", requestID)
+	assert.Equal(requestID, w.Header().Get("X-Request-ID"))
+}
@@ -0,0 +1,369 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	configurationApi "github.com/minio/console/restapi/operations/configuration"
+	"github.com/minio/madmin-go/v2"
+)
+
+// logTargetTypes are the config sub-systems of the targets server logs are shipped to
+var logTargetTypes = []string{
+	models.LogTargetTypeAuditWebhook,
+	models.LogTargetTypeAuditKafka,
+	models.LogTargetTypeLoggerWebhook,
+}
+
+// logTargetNameRegexp matches the names MinIO accepts for config targets
+var logTargetNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func registerLogTargetsHandlers(api *operations.ConsoleAPI) {
+	// list the audit and logger targets
+	api.ConfigurationListLogTargetsHandler = configurationApi.ListLogTargetsHandlerFunc(func(params configurationApi.ListLogTargetsParams, session *models.Principal) middleware.Responder {
+		targets, err := getListLogTargetsResponse(session, params)
+		if err != nil {
+			return configurationApi.NewListLogTargetsDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewListLogTargetsOK().WithPayload(targets)
+	})
+	// add a log target
+	api.ConfigurationAddLogTargetHandler = configurationApi.AddLogTargetHandlerFunc(func(params configurationApi.AddLogTargetParams, session *models.Principal) middleware.Responder {
+		target, err := getAddLogTargetResponse(session, params)
+		if err != nil {
+			return configurationApi.NewAddLogTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewAddLogTargetCreated().WithPayload(target)
+	})
+	// get the configuration of a log target
+	api.ConfigurationGetLogTargetHandler = configurationApi.GetLogTargetHandlerFunc(func(params configurationApi.GetLogTargetParams, session *models.Principal) middleware.Responder {
+		target, err := getLogTargetResponse(session, params)
+		if err != nil {
+			return configurationApi.NewGetLogTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewGetLogTargetOK().WithPayload(target)
+	})
+	// update the configuration of a log target
+	api.ConfigurationUpdateLogTargetHandler = configurationApi.UpdateLogTargetHandlerFunc(func(params configurationApi.UpdateLogTargetParams, session *models.Principal) middleware.Responder {
+		target, err := getUpdateLogTargetResponse(session, params)
+		if err != nil {
+			return configurationApi.NewUpdateLogTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewUpdateLogTargetOK().WithPayload(target)
+	})
+	// disable a log target
+	api.ConfigurationDisableLogTargetHandler = configurationApi.DisableLogTargetHandlerFunc(func(params configurationApi.DisableLogTargetParams, session *models.Principal) middleware.Responder {
+		target, err := getDisableLogTargetResponse(session, params)
+		if err != nil {
+			return configurationApi.NewDisableLogTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewDisableLogTargetOK().WithPayload(target)
+	})
+	// send a test entry to a log target
+	api.ConfigurationTestLogTargetHandler = configurationApi.TestLogTargetHandlerFunc(func(params configurationApi.TestLogTargetParams, session *models.Principal) middleware.Responder {
+		result, err := getTestLogTargetResponse(session, params)
+		if err != nil {
+			return configurationApi.NewTestLogTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewTestLogTargetOK().WithPayload(result)
+	})
+}
+
+// validateLogTarget checks the type and the name of a log target
+func validateLogTarget(targetType, name string) error {
+	valid := false
+	for _, t := range logTargetTypes {
+		valid = valid || t == targetType
+	}
+	if !valid {
+		return fmt.Errorf("%w: unsupported type %q", ErrInvalidLogTarget, targetType)
+	}
+	if !logTargetNameRegexp.MatchString(name) {
+		return fmt.Errorf("%w: the name can only have letters, digits, '-' and '_'", ErrInvalidLogTarget)
+	}
+	return nil
+}
+
+// logTargetToModel returns a log target with its secrets masked
+func logTargetToModel(targetType, name string, properties map[string]string) *models.LogTarget {
+	return &models.LogTarget{
+		Type:       swag.String(targetType),
+		Name:       swag.String(name),
+		Enabled:    properties["enable"] == "on",
+		Properties: maskNotificationSecrets(properties),
+	}
+}
+
+// listLogTargets returns the named targets of every log target type
+func listLogTargets(ctx context.Context, client MinioAdmin) (*models.LogTargetList, error) {
+	res := &models.LogTargetList{Targets: []*models.LogTarget{}}
+	for _, targetType := range logTargetTypes {
+		configs, err := getConfig(ctx, client, targetType)
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range configs {
+			// the default target only holds the defaults of the sub-system
+			name := strings.TrimPrefix(config.Name, targetType+":")
+			if name == config.Name || name == "" {
+				continue
+			}
+			properties := make(map[string]string, len(config.KeyValues))
+			for _, kv := range config.KeyValues {
+				properties[kv.Key] = kv.Value
+			}
+			res.Targets = append(res.Targets, logTargetToModel(targetType, name, properties))
+		}
+	}
+	sort.SliceStable(res.Targets, func(i, j int) bool {
+		if *res.Targets[i].Type != *res.Targets[j].Type {
+			return *res.Targets[i].Type < *res.Targets[j].Type
+		}
+		return *res.Targets[i].Name < *res.Targets[j].Name
+	})
+	return res, nil
+}
+
+func getListLogTargetsResponse(session *models.Principal, params configurationApi.ListLogTargetsParams) (*models.LogTargetList, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	targets, err := listLogTargets(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return targets, nil
+}
+
+// logTargetConfig returns the stored properties of a log target
+func logTargetConfig(ctx context.Context, client MinioAdmin, targetType, name string) (map[string]string, error) {
+	if err := validateLogTarget(targetType, name); err != nil {
+		return nil, err
+	}
+	configName := fmt.Sprintf("%s:%s", targetType, name)
+	configs, err := getConfig(ctx, client, configName)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioConfigError" {
+			return nil, ErrLogTargetNotFound
+		}
+		return nil, err
+	}
+	for _, config := range configs {
+		if config.Name != configName {
+			continue
+		}
+		properties := make(map[string]string, len(config.KeyValues))
+		for _, kv := range config.KeyValues {
+			properties[kv.Key] = kv.Value
+		}
+		return properties, nil
+	}
+	return nil, ErrLogTargetNotFound
+}
+
+// setLogTarget stores the properties of a log target, sorted so the config line is easy to follow in the
+// server logs
+func setLogTarget(ctx context.Context, client MinioAdmin, targetType, name string, properties map[string]string) (bool, error) {
+	configs := make([]*models.ConfigurationKV, 0, len(properties))
+	for k, v := range properties {
+		configs = append(configs, &models.ConfigurationKV{Key: k, Value: v})
+	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Key < configs[j].Key })
+	return setConfigWithARNAccountID(ctx, client, swag.String(targetType), configs, name)
+}
+
+// addLogTarget configures a new log target, enabled unless its properties say otherwise
+func addLogTarget(ctx context.Context, client MinioAdmin, target *models.LogTarget) (*models.LogTarget, error) {
+	targetType, name := swag.StringValue(target.Type), swag.StringValue(target.Name)
+	if err := validateLogTarget(targetType, name); err != nil {
+		return nil, err
+	}
+	properties := map[string]string{"enable": "on"}
+	for k, v := range target.Properties {
+		properties[k] = v
+	}
+	restart, err := setLogTarget(ctx, client, targetType, name, properties)
+	if err != nil {
+		return nil, err
+	}
+	res := logTargetToModel(targetType, name, properties)
+	res.Restart = restart
+	return res, nil
+}
+
+func getAddLogTargetResponse(session *models.Principal, params configurationApi.AddLogTargetParams) (*models.LogTarget, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	target, err := addLogTarget(ctx, AdminClient{Client: mAdmin}, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return target, nil
+}
+
+func getLogTargetResponse(session *models.Principal, params configurationApi.GetLogTargetParams) (*models.LogTarget, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	properties, err := logTargetConfig(ctx, AdminClient{Client: mAdmin}, params.Type, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return logTargetToModel(params.Type, params.Name, properties), nil
+}
+
+// updateLogTarget changes the properties of an existing log target, masked secrets are left untouched
+func updateLogTarget(ctx context.Context, client MinioAdmin, targetType, name string, properties map[string]string) (*models.LogTarget, error) {
+	current, err := logTargetConfig(ctx, client, targetType, name)
+	if err != nil {
+		return nil, err
+	}
+	changed := map[string]string{}
+	for k, v := range properties {
+		if v == notificationSecretMask {
+			continue
+		}
+		current[k] = v
+		changed[k] = v
+	}
+	restart := false
+	if len(changed) > 0 {
+		if restart, err = setLogTarget(ctx, client, targetType, name, changed); err != nil {
+			return nil, err
+		}
+	}
+	res := logTargetToModel(targetType, name, current)
+	res.Restart = restart
+	return res, nil
+}
+
+func getUpdateLogTargetResponse(session *models.Principal, params configurationApi.UpdateLogTargetParams) (*models.LogTarget, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	target, err := updateLogTarget(ctx, AdminClient{Client: mAdmin}, params.Type, params.Name, params.Body.Properties)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return target, nil
+}
+
+func getDisableLogTargetResponse(session *models.Principal, params configurationApi.DisableLogTargetParams) (*models.LogTarget, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	target, err := updateLogTarget(ctx, AdminClient{Client: mAdmin}, params.Type, params.Name, map[string]string{"enable": "off"})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return target, nil
+}
+
+// logTargetTestEntry mimics the entries MinIO ships to audit and logger webhooks
+type logTargetTestEntry struct {
+	Version string `json:"version,omitempty"`
+	Time    string `json:"time"`
+	Level   string `json:"level,omitempty"`
+	Trigger string `json:"trigger,omitempty"`
+	Message string `json:"message,omitempty"`
+	API     struct {
+		Name string `json:"name"`
+	} `json:"api"`
+}
+
+func newLogTargetTestEntry(targetType string, now time.Time) logTargetTestEntry {
+	entry := logTargetTestEntry{Time: now.UTC().Format(time.RFC3339Nano)}
+	entry.API.Name = "ConsoleTest"
+	if targetType == models.LogTargetTypeAuditWebhook {
+		entry.Version = "1"
+		entry.Trigger = "console"
+	} else {
+		entry.Level = "INFO"
+		entry.Message = "test entry sent by Console"
+	}
+	return entry
+}
+
+// testLogTarget sends a test entry to webhook targets, Kafka targets need their own client so they can't
+// be tested by Console
+func testLogTarget(ctx context.Context, client MinioAdmin, targetType, name string, httpClient func(endpoint string) *http.Client) (*models.LogTargetTestResult, error) {
+	properties, err := logTargetConfig(ctx, client, targetType, name)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.LogTargetTestResult{}
+	if targetType == models.LogTargetTypeAuditKafka {
+		result.Message = "test entries can't be sent to Kafka targets"
+		return result, nil
+	}
+	endpoint := properties["endpoint"]
+	if endpoint == "" {
+		return nil, fmt.Errorf("%w: the webhook has no endpoint", ErrInvalidLogTarget)
+	}
+	testCtx, cancel := context.WithTimeout(ctx, notificationTestTimeout)
+	defer cancel()
+	code, err := sendWebhookTestEvent(testCtx, httpClient(endpoint), endpoint, properties["auth_token"], newLogTargetTestEntry(targetType, time.Now()))
+	result.ResponseCode = int64(code)
+	if err != nil {
+		result.Message = err.Error()
+		return result, nil
+	}
+	result.Delivered = true
+	result.Message = "test entry delivered"
+	return result, nil
+}
+
+func getTestLogTargetResponse(session *models.Principal, params configurationApi.TestLogTargetParams) (*models.LogTargetTestResult, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	result, err := testLogTarget(ctx, AdminClient{Client: mAdmin}, params.Type, params.Name, GetConsoleHTTPClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,175 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minio/console/models"
+)
+
+func Test_listLogTargets(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		switch key {
+		case models.LogTargetTypeAuditWebhook:
+			return []byte(`audit_webhook enable=off endpoint=""
+audit_webhook:siem enable=on endpoint="http://siem:8080" auth_token="secret"
+audit_webhook:archive enable=off endpoint="http://archive:8080"`), nil
+		case models.LogTargetTypeLoggerWebhook:
+			return []byte(`logger_webhook:ops enable=on endpoint="http://ops:8080"`), nil
+		}
+		return []byte(`audit_kafka enable=off brokers=""`), nil
+	}
+
+	got, err := listLogTargets(ctx, client)
+	if !assert.NoError(err) || !assert.Len(got.Targets, 3) {
+		return
+	}
+	// the unnamed default targets are not listed
+	assert.Equal("archive", *got.Targets[0].Name)
+	assert.False(got.Targets[0].Enabled)
+	assert.Equal("siem", *got.Targets[1].Name)
+	assert.True(got.Targets[1].Enabled)
+	assert.Equal(notificationSecretMask, got.Targets[1].Properties["auth_token"])
+	assert.Equal(models.LogTargetTypeLoggerWebhook, *got.Targets[2].Type)
+}
+
+func Test_addLogTarget(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	var stored string
+	minioSetConfigKVMock = func(kv string) (bool, error) {
+		stored = kv
+		return true, nil
+	}
+	got, err := addLogTarget(ctx, client, &models.LogTarget{
+		Type:       swag.String(models.LogTargetTypeAuditWebhook),
+		Name:       swag.String("siem"),
+		Properties: map[string]string{"endpoint": "http://siem:8080", "auth_token": "secret"},
+	})
+	assert.NoError(err)
+	assert.Equal(`audit_webhook:siem auth_token="secret" enable="on" endpoint="http://siem:8080"`, stored)
+	assert.True(got.Enabled)
+	assert.True(got.Restart)
+	assert.Equal(notificationSecretMask, got.Properties["auth_token"])
+
+	_, err = addLogTarget(ctx, client, &models.LogTarget{
+		Type: swag.String("notify_webhook"),
+		Name: swag.String("siem"),
+	})
+	assert.ErrorIs(err, ErrInvalidLogTarget)
+
+	_, err = addLogTarget(ctx, client, &models.LogTarget{
+		Type: swag.String(models.LogTargetTypeAuditWebhook),
+		Name: swag.String("siem target"),
+	})
+	assert.ErrorIs(err, ErrInvalidLogTarget)
+}
+
+func Test_updateLogTarget(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`audit_webhook:siem enable=on endpoint="http://siem:8080" auth_token="secret"`), nil
+	}
+	var stored string
+	minioSetConfigKVMock = func(kv string) (bool, error) {
+		stored = kv
+		return false, nil
+	}
+	got, err := updateLogTarget(ctx, client, models.LogTargetTypeAuditWebhook, "siem", map[string]string{
+		"endpoint":   "http://siem:9090",
+		"auth_token": notificationSecretMask,
+	})
+	assert.NoError(err)
+	// the masked token is not sent back to the server
+	assert.Equal(`audit_webhook:siem endpoint="http://siem:9090"`, stored)
+	assert.Equal("http://siem:9090", got.Properties["endpoint"])
+	assert.True(got.Enabled)
+
+	got, err = updateLogTarget(ctx, client, models.LogTargetTypeAuditWebhook, "siem", map[string]string{"enable": "off"})
+	assert.NoError(err)
+	assert.Equal(`audit_webhook:siem enable="off"`, stored)
+	assert.False(got.Enabled)
+
+	_, err = updateLogTarget(ctx, client, models.LogTargetTypeAuditWebhook, "other", map[string]string{"enable": "off"})
+	assert.ErrorIs(err, ErrLogTargetNotFound)
+}
+
+func Test_testLogTarget(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	var received logTargetTestEntry
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer server.Close()
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(key + ` enable=on endpoint="` + server.URL + `" auth_token="secret"`), nil
+	}
+	httpClient := func(string) *http.Client { return server.Client() }
+
+	got, err := testLogTarget(ctx, client, models.LogTargetTypeAuditWebhook, "siem", httpClient)
+	assert.NoError(err)
+	assert.True(got.Delivered)
+	assert.Equal(int64(http.StatusOK), got.ResponseCode)
+	assert.Equal("Bearer secret", authorization)
+	assert.Equal("1", received.Version)
+	assert.Equal("ConsoleTest", received.API.Name)
+
+	got, err = testLogTarget(ctx, client, models.LogTargetTypeLoggerWebhook, "ops", httpClient)
+	assert.NoError(err)
+	assert.True(got.Delivered)
+	assert.Equal("INFO", received.Level)
+
+	// receivers answering with an error are reported without failing the request
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	got, err = testLogTarget(ctx, client, models.LogTargetTypeAuditWebhook, "siem", httpClient)
+	assert.NoError(err)
+	assert.False(got.Delivered)
+	assert.Equal(int64(http.StatusForbidden), got.ResponseCode)
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`audit_kafka:events enable=on brokers="localhost:9092" topic="audit"`), nil
+	}
+	got, err = testLogTarget(ctx, client, models.LogTargetTypeAuditKafka, "events", httpClient)
+	assert.NoError(err)
+	assert.False(got.Delivered)
+	assert.NotEmpty(got.Message)
+}
@@ -0,0 +1,332 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+)
+
+const (
+	defaultMetricsDashboardRange = time.Hour
+	maxMetricsDashboardRange     = 7 * 24 * time.Hour
+	// metricsDashboardPoints is the number of points of a series when no step is given
+	metricsDashboardPoints  = 120
+	minMetricsDashboardStep = 15 * time.Second
+	// maxMetricsDashboardPoints is the most points Prometheus returns for a series
+	maxMetricsDashboardPoints = 11000
+)
+
+// metricsDashboardQuery is a PromQL query feeding a panel of the dashboard, $__query is replaced by
+// the selector of the MinIO metrics and $__rate_interval by the window of the rates
+type metricsDashboardQuery struct {
+	panel string
+	// name of the series, when empty the series are named after the value of label
+	name  string
+	label string
+	expr  string
+	// instant queries only return the current value
+	instant bool
+}
+
+var metricsDashboardQueries = []metricsDashboardQuery{
+	{
+		panel: "capacity",
+		name:  "total",
+		expr:  `topk(1, sum(minio_cluster_capacity_usable_total_bytes{$__query}) by (instance))`,
+	},
+	{
+		panel: "capacity",
+		name:  "used",
+		expr:  `topk(1, sum(minio_cluster_capacity_usable_total_bytes{$__query}) by (instance)) - topk(1, sum(minio_cluster_capacity_usable_free_bytes{$__query}) by (instance))`,
+	},
+	{
+		panel: "requestRates",
+		label: "api",
+		expr:  `sum by (api) (rate(minio_s3_requests_total{$__query}[$__rate_interval]))`,
+	},
+	{
+		panel: "requestErrors",
+		label: "api",
+		expr:  `sum by (api) (rate(minio_s3_requests_errors_total{$__query}[$__rate_interval]))`,
+	},
+	{
+		panel: "replicationLag",
+		label: "bucket",
+		expr:  `max by (bucket) (minio_bucket_replication_latency_ms{$__query})`,
+	},
+	{
+		panel:   "nodes",
+		label:   "instance",
+		expr:    `up{$__query}`,
+		instant: true,
+	},
+	{
+		panel:   "nodesOnline",
+		name:    "nodesOnline",
+		expr:    `max(minio_cluster_nodes_online_total{$__query})`,
+		instant: true,
+	},
+	{
+		panel:   "nodesOffline",
+		name:    "nodesOffline",
+		expr:    `max(minio_cluster_nodes_offline_total{$__query})`,
+		instant: true,
+	},
+	{
+		panel:   "drivesOnline",
+		name:    "drivesOnline",
+		expr:    `max(minio_cluster_disk_online_total{$__query})`,
+		instant: true,
+	},
+	{
+		panel:   "drivesOffline",
+		name:    "drivesOffline",
+		expr:    `max(minio_cluster_disk_offline_total{$__query})`,
+		instant: true,
+	},
+}
+
+// prometheusQueryResult is a series of an instant query, with value, or of a range query, with values
+type prometheusQueryResult struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+	Values [][]interface{}   `json:"values"`
+}
+
+type prometheusQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string                  `json:"resultType"`
+		Result     []prometheusQueryResult `json:"result"`
+	} `json:"data"`
+}
+
+func registerMetricsDashboardHandlers(api *operations.ConsoleAPI) {
+	// pre-shaped series of the dashboards
+	api.SystemGetMetricsDashboardHandler = systemApi.GetMetricsDashboardHandlerFunc(func(params systemApi.GetMetricsDashboardParams, session *models.Principal) middleware.Responder {
+		dashboard, err := getMetricsDashboardResponse(params)
+		if err != nil {
+			return systemApi.NewGetMetricsDashboardDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetMetricsDashboardOK().WithPayload(dashboard)
+	})
+}
+
+// metricsDashboardStep returns the step between the points of the series, step is zero when not
+// given by the user
+func metricsDashboardStep(timeRange, step time.Duration) (time.Duration, error) {
+	if timeRange <= 0 || timeRange > maxMetricsDashboardRange {
+		return 0, fmt.Errorf("%w: range has to be between 1 second and %s", ErrInvalidMetricsRange, maxMetricsDashboardRange)
+	}
+	if step == 0 {
+		step = timeRange / metricsDashboardPoints
+		if step < minMetricsDashboardStep {
+			step = minMetricsDashboardStep
+		}
+		return step.Truncate(time.Second), nil
+	}
+	if step < time.Second || timeRange/step > maxMetricsDashboardPoints {
+		return 0, fmt.Errorf("%w: step has to be at least 1 second and the range can hold at most %d steps", ErrInvalidMetricsRange, maxMetricsDashboardPoints)
+	}
+	return step, nil
+}
+
+// prometheusPoint converts a sample of Prometheus, a timestamp and a value as a string, into a point,
+// samples that aren't numbers can't be represented in JSON and are dropped
+func prometheusPoint(sample []interface{}) (*models.MetricsPoint, bool) {
+	if len(sample) != 2 {
+		return nil, false
+	}
+	ts, ok := sample[0].(float64)
+	if !ok {
+		return nil, false
+	}
+	raw, ok := sample[1].(string)
+	if !ok {
+		return nil, false
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, false
+	}
+	return &models.MetricsPoint{Time: int64(ts), Value: value}, true
+}
+
+// queryMetricsDashboard runs a query of the dashboard and returns its series sorted by name
+func queryMetricsDashboard(ctx context.Context, prometheusURL string, query metricsDashboardQuery, selector string, start, end time.Time, step time.Duration) ([]*models.MetricsSeries, error) {
+	rateInterval := 4 * step
+	if rateInterval < time.Minute {
+		rateInterval = time.Minute
+	}
+	expr := strings.ReplaceAll(query.expr, "$__query", selector)
+	expr = strings.ReplaceAll(expr, "$__rate_interval", fmt.Sprintf("%ds", int64(rateInterval.Seconds())))
+
+	values := url.Values{}
+	values.Set("query", expr)
+	apiType := "query"
+	if query.instant {
+		values.Set("time", strconv.FormatInt(end.Unix(), 10))
+	} else {
+		apiType = "query_range"
+		values.Set("start", strconv.FormatInt(start.Unix(), 10))
+		values.Set("end", strconv.FormatInt(end.Unix(), 10))
+		values.Set("step", strconv.FormatInt(int64(step.Seconds()), 10))
+	}
+	var response prometheusQueryResponse
+	if err := fetchPrometheus(ctx, fmt.Sprintf("%s/api/v1/%s?%s", strings.TrimSuffix(prometheusURL, "/"), apiType, values.Encode()), &response); err != nil {
+		return nil, err
+	}
+	if response.Status != "success" {
+		return nil, fmt.Errorf("prometheus query %s failed", query.panel)
+	}
+
+	series := []*models.MetricsSeries{}
+	for _, result := range response.Data.Result {
+		item := &models.MetricsSeries{Name: query.name, Points: []*models.MetricsPoint{}}
+		if query.label != "" {
+			item.Name = result.Metric[query.label]
+		}
+		if query.instant {
+			if point, ok := prometheusPoint(result.Value); ok {
+				item.Points = append(item.Points, point)
+			}
+		}
+		for _, sample := range result.Values {
+			if point, ok := prometheusPoint(sample); ok {
+				item.Points = append(item.Points, point)
+			}
+		}
+		series = append(series, item)
+	}
+	sort.SliceStable(series, func(i, j int) bool { return series[i].Name < series[j].Name })
+	return series, nil
+}
+
+// lastMetricsValue returns the latest value of the first series
+func lastMetricsValue(series []*models.MetricsSeries) int64 {
+	if len(series) == 0 || len(series[0].Points) == 0 {
+		return 0
+	}
+	return int64(series[0].Points[len(series[0].Points)-1].Value)
+}
+
+// getMetricsDashboard queries Prometheus for every panel of the dashboard at once, panels whose
+// query failed are listed as unavailable so the others can still be displayed
+func getMetricsDashboard(ctx context.Context, prometheusURL, selector string, timeRange, step time.Duration, now time.Time) (*models.MetricsDashboard, error) {
+	if prometheusURL == "" {
+		return nil, ErrPrometheusNotConfigured
+	}
+	step, err := metricsDashboardStep(timeRange, step)
+	if err != nil {
+		return nil, err
+	}
+	end := now.Truncate(time.Second)
+	start := end.Add(-timeRange)
+
+	var (
+		wg      sync.WaitGroup
+		results = make([][]*models.MetricsSeries, len(metricsDashboardQueries))
+		errs    = make([]error, len(metricsDashboardQueries))
+	)
+	for i, query := range metricsDashboardQueries {
+		wg.Add(1)
+		go func(i int, query metricsDashboardQuery) {
+			defer wg.Done()
+			results[i], errs[i] = queryMetricsDashboard(ctx, prometheusURL, query, selector, start, end, step)
+		}(i, query)
+	}
+	wg.Wait()
+
+	dashboard := &models.MetricsDashboard{
+		Start:          start.Unix(),
+		End:            end.Unix(),
+		Step:           int64(step.Seconds()),
+		Capacity:       []*models.MetricsSeries{},
+		RequestRates:   []*models.MetricsSeries{},
+		RequestErrors:  []*models.MetricsSeries{},
+		ReplicationLag: []*models.MetricsSeries{},
+		Nodes:          []*models.MetricsNodeHealth{},
+		Unavailable:    []string{},
+	}
+	for i, query := range metricsDashboardQueries {
+		if errs[i] != nil {
+			LogErrorContext(ctx, "unable to query %s from prometheus: %v", query.panel, errs[i])
+			if len(dashboard.Unavailable) == 0 || dashboard.Unavailable[len(dashboard.Unavailable)-1] != query.panel {
+				dashboard.Unavailable = append(dashboard.Unavailable, query.panel)
+			}
+			continue
+		}
+		series := results[i]
+		switch query.panel {
+		case "capacity":
+			dashboard.Capacity = append(dashboard.Capacity, series...)
+		case "requestRates":
+			dashboard.RequestRates = series
+		case "requestErrors":
+			dashboard.RequestErrors = series
+		case "replicationLag":
+			dashboard.ReplicationLag = series
+		case "nodes":
+			for _, s := range series {
+				dashboard.Nodes = append(dashboard.Nodes, &models.MetricsNodeHealth{Instance: s.Name, Up: lastMetricsValue([]*models.MetricsSeries{s}) == 1})
+			}
+		case "nodesOnline":
+			dashboard.NodesOnline = lastMetricsValue(series)
+		case "nodesOffline":
+			dashboard.NodesOffline = lastMetricsValue(series)
+		case "drivesOnline":
+			dashboard.DrivesOnline = lastMetricsValue(series)
+		case "drivesOffline":
+			dashboard.DrivesOffline = lastMetricsValue(series)
+		}
+	}
+	return dashboard, nil
+}
+
+func getMetricsDashboardResponse(params systemApi.GetMetricsDashboardParams) (*models.MetricsDashboard, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	timeRange := defaultMetricsDashboardRange
+	if params.Range != nil {
+		timeRange = time.Duration(*params.Range) * time.Second
+	}
+	var step time.Duration
+	if params.Step != nil {
+		step = time.Duration(*params.Step) * time.Second
+		if step == 0 {
+			return nil, ErrorWithContext(ctx, fmt.Errorf("%w: step has to be at least 1 second", ErrInvalidMetricsRange))
+		}
+	}
+	dashboard, err := getMetricsDashboard(ctx, getPrometheusURL(), prometheusSelector(), timeRange, step, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return dashboard, nil
+}
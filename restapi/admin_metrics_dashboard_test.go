@@ -0,0 +1,158 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_metricsDashboardStep(t *testing.T) {
+	assert := assert.New(t)
+
+	step, err := metricsDashboardStep(time.Hour, 0)
+	assert.NoError(err)
+	assert.Equal(30*time.Second, step)
+	// short ranges keep a minimum step
+	step, err = metricsDashboardStep(10*time.Minute, 0)
+	assert.NoError(err)
+	assert.Equal(15*time.Second, step)
+	step, err = metricsDashboardStep(time.Hour, time.Minute)
+	assert.NoError(err)
+	assert.Equal(time.Minute, step)
+
+	_, err = metricsDashboardStep(0, 0)
+	assert.ErrorIs(err, ErrInvalidMetricsRange)
+	_, err = metricsDashboardStep(8*24*time.Hour, 0)
+	assert.ErrorIs(err, ErrInvalidMetricsRange)
+	_, err = metricsDashboardStep(7*24*time.Hour, time.Second)
+	assert.ErrorIs(err, ErrInvalidMetricsRange)
+}
+
+func Test_prometheusPoint(t *testing.T) {
+	assert := assert.New(t)
+
+	point, ok := prometheusPoint([]interface{}{float64(1682899200), "12.5"})
+	assert.True(ok)
+	assert.Equal(int64(1682899200), point.Time)
+	assert.Equal(12.5, point.Value)
+
+	_, ok = prometheusPoint([]interface{}{float64(1682899200), "NaN"})
+	assert.False(ok)
+	_, ok = prometheusPoint([]interface{}{"1682899200", "1"})
+	assert.False(ok)
+	_, ok = prometheusPoint(nil)
+	assert.False(ok)
+}
+
+func Test_getMetricsDashboard(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(PrometheusAuthToken, "prom-token")
+
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Bearer prom-token", r.Header.Get("Authorization"))
+		query := r.URL.Query().Get("query")
+		mu.Lock()
+		queries = append(queries, query)
+		mu.Unlock()
+		var result string
+		switch {
+		case r.URL.Path == "/api/v1/query_range" && strings.Contains(query, "minio_s3_requests_total"):
+			assert.Contains(query, `job="minio-job"`)
+			assert.Contains(query, "[120s]")
+			assert.Equal("30", r.URL.Query().Get("step"))
+			result = `{"resultType":"matrix","result":[
+				{"metric":{"api":"PutObject"},"values":[[1682899170,"2"],[1682899200,"NaN"]]},
+				{"metric":{"api":"GetObject"},"values":[[1682899200,"5.5"]]}]}`
+		case strings.Contains(query, "minio_s3_requests_errors_total"):
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		case strings.Contains(query, "usable_free_bytes"):
+			result = `{"resultType":"matrix","result":[{"metric":{},"values":[[1682899200,"400"]]}]}`
+		case strings.Contains(query, "usable_total_bytes"):
+			result = `{"resultType":"matrix","result":[{"metric":{},"values":[[1682899200,"1000"]]}]}`
+		case r.URL.Path == "/api/v1/query" && strings.HasPrefix(query, "up"):
+			result = `{"resultType":"vector","result":[
+				{"metric":{"instance":"node2:9000"},"value":[1682899200,"0"]},
+				{"metric":{"instance":"node1:9000"},"value":[1682899200,"1"]}]}`
+		case strings.Contains(query, "minio_cluster_nodes_online_total"):
+			result = `{"resultType":"vector","result":[{"metric":{},"value":[1682899200,"1"]}]}`
+		case strings.Contains(query, "minio_cluster_nodes_offline_total"):
+			result = `{"resultType":"vector","result":[{"metric":{},"value":[1682899200,"1"]}]}`
+		default:
+			result = `{"resultType":"vector","result":[]}`
+		}
+		fmt.Fprintf(w, `{"status":"success","data":%s}`, result)
+	}))
+	defer server.Close()
+
+	now := time.Unix(1682899200, 0)
+	dashboard, err := getMetricsDashboard(context.Background(), server.URL, `job="minio-job"`, time.Hour, 0, now)
+	assert.NoError(err)
+	assert.Equal(now.Add(-time.Hour).Unix(), dashboard.Start)
+	assert.Equal(int64(30), dashboard.Step)
+	if assert.Len(dashboard.Capacity, 2) {
+		assert.Equal("total", dashboard.Capacity[0].Name)
+		assert.Equal("used", dashboard.Capacity[1].Name)
+	}
+	if assert.Len(dashboard.RequestRates, 2) {
+		assert.Equal("GetObject", dashboard.RequestRates[0].Name)
+		// samples that aren't numbers are dropped
+		assert.Len(dashboard.RequestRates[1].Points, 1)
+	}
+	assert.Equal([]string{"requestErrors"}, dashboard.Unavailable)
+	assert.Empty(dashboard.RequestErrors)
+	assert.NotNil(dashboard.ReplicationLag)
+	if assert.Len(dashboard.Nodes, 2) {
+		assert.Equal("node1:9000", dashboard.Nodes[0].Instance)
+		assert.True(dashboard.Nodes[0].Up)
+		assert.False(dashboard.Nodes[1].Up)
+	}
+	assert.Equal(int64(1), dashboard.NodesOnline)
+	assert.Equal(int64(1), dashboard.NodesOffline)
+	assert.Equal(len(metricsDashboardQueries), len(queries))
+
+	_, err = getMetricsDashboard(context.Background(), "", `job="minio-job"`, time.Hour, 0, now)
+	assert.ErrorIs(err, ErrPrometheusNotConfigured)
+}
+
+func Test_prometheusHTTPClientBasicAuth(t *testing.T) {
+	t.Setenv(PrometheusUsername, "console")
+	t.Setenv(PrometheusPassword, "secret")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "console", user)
+		assert.Equal(t, "secret", password)
+	}))
+	defer server.Close()
+
+	assert.True(t, testPrometheusURL(context.Background(), server.URL))
+}
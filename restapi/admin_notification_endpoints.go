@@ -17,15 +17,41 @@
 package restapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations"
 	configurationApi "github.com/minio/console/restapi/operations/configuration"
+	"github.com/minio/madmin-go/v2"
 )
 
+// notificationSecretMask replaces the secrets of notification endpoints in the API responses, sending
+// it back on an update keeps the stored value
+const notificationSecretMask = "*redacted*"
+
+// notificationTestTimeout bounds the delivery of test events
+const notificationTestTimeout = 10 * time.Second
+
+// notificationSecretKeys are the properties of notification endpoints holding credentials
+var notificationSecretKeys = map[string]bool{
+	"password":          true,
+	"auth_token":        true,
+	"sasl_password":     true,
+	"token":             true,
+	"connection_string": true,
+	"dsn_string":        true,
+}
+
 func registerAdminNotificationEndpointsHandlers(api *operations.ConsoleAPI) {
 	// return a list of notification endpoints
 	api.ConfigurationNotificationEndpointListHandler = configurationApi.NotificationEndpointListHandlerFunc(func(params configurationApi.NotificationEndpointListParams, session *models.Principal) middleware.Responder {
@@ -43,6 +69,37 @@ func registerAdminNotificationEndpointsHandlers(api *operations.ConsoleAPI) {
 		}
 		return configurationApi.NewAddNotificationEndpointCreated().WithPayload(notifEndpoints)
 	})
+	// get the configuration of a notification endpoint
+	api.ConfigurationGetNotificationEndpointHandler = configurationApi.GetNotificationEndpointHandlerFunc(func(params configurationApi.GetNotificationEndpointParams, session *models.Principal) middleware.Responder {
+		notifEndpoint, err := getNotificationEndpointResponse(session, params)
+		if err != nil {
+			return configurationApi.NewGetNotificationEndpointDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewGetNotificationEndpointOK().WithPayload(notifEndpoint)
+	})
+	// update the configuration of a notification endpoint
+	api.ConfigurationUpdateNotificationEndpointHandler = configurationApi.UpdateNotificationEndpointHandlerFunc(func(params configurationApi.UpdateNotificationEndpointParams, session *models.Principal) middleware.Responder {
+		notifEndpoint, err := getUpdateNotificationEndpointResponse(session, params)
+		if err != nil {
+			return configurationApi.NewUpdateNotificationEndpointDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewUpdateNotificationEndpointOK().WithPayload(notifEndpoint)
+	})
+	// remove a notification endpoint
+	api.ConfigurationDeleteNotificationEndpointHandler = configurationApi.DeleteNotificationEndpointHandlerFunc(func(params configurationApi.DeleteNotificationEndpointParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteNotificationEndpointResponse(session, params); err != nil {
+			return configurationApi.NewDeleteNotificationEndpointDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewDeleteNotificationEndpointNoContent()
+	})
+	// check a notification endpoint and send it a test event
+	api.ConfigurationTestNotificationEndpointHandler = configurationApi.TestNotificationEndpointHandlerFunc(func(params configurationApi.TestNotificationEndpointParams, session *models.Principal) middleware.Responder {
+		result, err := getTestNotificationEndpointResponse(session, params)
+		if err != nil {
+			return configurationApi.NewTestNotificationEndpointDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewTestNotificationEndpointOK().WithPayload(result)
+	})
 }
 
 // getNotificationEndpoints invokes admin info and returns a list of notification endpoints
@@ -93,32 +150,9 @@ func getNotificationEndpointsResponse(session *models.Principal, params configur
 
 func addNotificationEndpoint(ctx context.Context, client MinioAdmin, params *configurationApi.AddNotificationEndpointParams) (*models.SetNotificationEndpointResponse, error) {
 	configs := []*models.ConfigurationKV{}
-	var configName string
-
-	// we have different add validations for each service
-	switch *params.Body.Service {
-	case models.NofiticationServiceAmqp:
-		configName = "notify_amqp"
-	case models.NofiticationServiceMqtt:
-		configName = "notify_mqtt"
-	case models.NofiticationServiceElasticsearch:
-		configName = "notify_elasticsearch"
-	case models.NofiticationServiceRedis:
-		configName = "notify_redis"
-	case models.NofiticationServiceNats:
-		configName = "notify_nats"
-	case models.NofiticationServicePostgres:
-		configName = "notify_postgres"
-	case models.NofiticationServiceMysql:
-		configName = "notify_mysql"
-	case models.NofiticationServiceKafka:
-		configName = "notify_kafka"
-	case models.NofiticationServiceWebhook:
-		configName = "notify_webhook"
-	case models.NofiticationServiceNsq:
-		configName = "notify_nsq"
-	default:
-		return nil, errors.New("provided service is not supported")
+	configName, err := notificationConfigName(*params.Body.Service)
+	if err != nil {
+		return nil, err
 	}
 
 	// set all the config values if found on the param.Body.Properties
@@ -142,6 +176,34 @@ func addNotificationEndpoint(ctx context.Context, client MinioAdmin, params *con
 	}, nil
 }
 
+// notificationConfigName returns the config sub-system the targets of the service are stored in
+func notificationConfigName(service models.NofiticationService) (string, error) {
+	switch service {
+	case models.NofiticationServiceAmqp:
+		return "notify_amqp", nil
+	case models.NofiticationServiceMqtt:
+		return "notify_mqtt", nil
+	case models.NofiticationServiceElasticsearch:
+		return "notify_elasticsearch", nil
+	case models.NofiticationServiceRedis:
+		return "notify_redis", nil
+	case models.NofiticationServiceNats:
+		return "notify_nats", nil
+	case models.NofiticationServicePostgres:
+		return "notify_postgres", nil
+	case models.NofiticationServiceMysql:
+		return "notify_mysql", nil
+	case models.NofiticationServiceKafka:
+		return "notify_kafka", nil
+	case models.NofiticationServiceWebhook:
+		return "notify_webhook", nil
+	case models.NofiticationServiceNsq:
+		return "notify_nsq", nil
+	default:
+		return "", errors.New("provided service is not supported")
+	}
+}
+
 // getNotificationEndpointsResponse returns a list of notification endpoints in the instance
 func getAddNotificationEndpointResponse(session *models.Principal, params configurationApi.AddNotificationEndpointParams) (*models.SetNotificationEndpointResponse, *models.Error) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
@@ -160,3 +222,366 @@ func getAddNotificationEndpointResponse(session *models.Principal, params config
 	}
 	return notfEndpointResp, nil
 }
+
+// notificationEndpointConfig returns the stored properties of a notification endpoint
+func notificationEndpointConfig(ctx context.Context, client MinioAdmin, service models.NofiticationService, accountID string) (map[string]string, error) {
+	configName, err := notificationConfigName(service)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidNotificationEndpoint, err)
+	}
+	configName = fmt.Sprintf("%s:%s", configName, accountID)
+	configs, err := getConfig(ctx, client, configName)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioConfigError" {
+			return nil, ErrNotificationEndpointNotFound
+		}
+		return nil, err
+	}
+	for _, config := range configs {
+		if config.Name != configName {
+			continue
+		}
+		properties := make(map[string]string, len(config.KeyValues))
+		for _, kv := range config.KeyValues {
+			properties[kv.Key] = kv.Value
+		}
+		return properties, nil
+	}
+	return nil, ErrNotificationEndpointNotFound
+}
+
+// maskNotificationSecrets hides the credentials of a notification endpoint
+func maskNotificationSecrets(properties map[string]string) map[string]string {
+	masked := make(map[string]string, len(properties))
+	for k, v := range properties {
+		if notificationSecretKeys[k] && v != "" {
+			v = notificationSecretMask
+		}
+		masked[k] = v
+	}
+	return masked
+}
+
+func getNotificationEndpoint(ctx context.Context, client MinioAdmin, service models.NofiticationService, accountID string) (*models.NotificationEndpoint, error) {
+	properties, err := notificationEndpointConfig(ctx, client, service, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.NotificationEndpoint{
+		Service:    &service,
+		AccountID:  &accountID,
+		Properties: maskNotificationSecrets(properties),
+	}, nil
+}
+
+func getNotificationEndpointResponse(session *models.Principal, params configurationApi.GetNotificationEndpointParams) (*models.NotificationEndpoint, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	notifEndpoint, err := getNotificationEndpoint(ctx, adminClient, models.NofiticationService(params.Service), params.AccountID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return notifEndpoint, nil
+}
+
+// updateNotificationEndpoint changes the properties of an existing notification endpoint, masked
+// secrets are left untouched
+func updateNotificationEndpoint(ctx context.Context, client MinioAdmin, service models.NofiticationService, accountID string, properties map[string]string) (*models.SetNotificationEndpointResponse, error) {
+	current, err := notificationEndpointConfig(ctx, client, service, accountID)
+	if err != nil {
+		return nil, err
+	}
+	configName, err := notificationConfigName(service)
+	if err != nil {
+		return nil, err
+	}
+	var configs []*models.ConfigurationKV
+	for k, v := range properties {
+		if v == notificationSecretMask {
+			continue
+		}
+		current[k] = v
+		configs = append(configs, &models.ConfigurationKV{Key: k, Value: v})
+	}
+	needsRestart := false
+	if len(configs) > 0 {
+		// keep the order stable, the config line is easier to follow in the server logs
+		sort.Slice(configs, func(i, j int) bool { return configs[i].Key < configs[j].Key })
+		if needsRestart, err = setConfigWithARNAccountID(ctx, client, &configName, configs, accountID); err != nil {
+			return nil, err
+		}
+	}
+	return &models.SetNotificationEndpointResponse{
+		AccountID:  &accountID,
+		Properties: maskNotificationSecrets(current),
+		Service:    &service,
+		Restart:    needsRestart,
+	}, nil
+}
+
+func getUpdateNotificationEndpointResponse(session *models.Principal, params configurationApi.UpdateNotificationEndpointParams) (*models.SetNotificationEndpointResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	notifEndpoint, err := updateNotificationEndpoint(ctx, adminClient, models.NofiticationService(params.Service), params.AccountID, params.Body.Properties)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return notifEndpoint, nil
+}
+
+// notificationTargetBuckets returns the buckets with event rules delivering to each endpoint, keyed by
+// service and account id
+func notificationTargetBuckets(ctx context.Context, client MinioClient) (map[string][]string, error) {
+	buckets, err := client.listBucketsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := map[string][]string{}
+	for _, bucket := range buckets {
+		config, err := client.getBucketNotification(ctx, bucket.Name)
+		if err != nil {
+			return nil, err
+		}
+		var arns []string
+		for _, c := range config.QueueConfigs {
+			arns = append(arns, c.Queue)
+		}
+		for _, c := range config.TopicConfigs {
+			arns = append(arns, c.Topic)
+		}
+		for _, c := range config.LambdaConfigs {
+			arns = append(arns, c.Lambda)
+		}
+		seen := map[string]bool{}
+		for _, arn := range arns {
+			// ARNs look like arn:minio:sqs:<region>:<account_id>:<service>
+			parts := strings.Split(arn, ":")
+			if len(parts) != 6 {
+				continue
+			}
+			key := notificationTargetKey(models.NofiticationService(parts[5]), parts[4])
+			if !seen[key] {
+				seen[key] = true
+				targets[key] = append(targets[key], bucket.Name)
+			}
+		}
+	}
+	return targets, nil
+}
+
+func notificationTargetKey(service models.NofiticationService, accountID string) string {
+	return string(service) + ":" + accountID
+}
+
+// notificationEndpointBuckets returns the buckets with event rules delivering to the endpoint
+func notificationEndpointBuckets(ctx context.Context, client MinioClient, service models.NofiticationService, accountID string) ([]string, error) {
+	targets, err := notificationTargetBuckets(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return targets[notificationTargetKey(service, accountID)], nil
+}
+
+// deleteNotificationEndpoint removes the notification endpoint, unless forced it refuses to remove
+// endpoints bucket events are still delivered to
+func deleteNotificationEndpoint(ctx context.Context, client MinioClient, adminClient MinioAdmin, service models.NofiticationService, accountID string, force bool) error {
+	if _, err := notificationEndpointConfig(ctx, adminClient, service, accountID); err != nil {
+		return err
+	}
+	if !force {
+		buckets, err := notificationEndpointBuckets(ctx, client, service, accountID)
+		if err != nil {
+			return err
+		}
+		if len(buckets) > 0 {
+			return fmt.Errorf("%w: %s", ErrNotificationEndpointInUse, strings.Join(buckets, ", "))
+		}
+	}
+	configName, err := notificationConfigName(service)
+	if err != nil {
+		return err
+	}
+	configName = fmt.Sprintf("%s:%s", configName, accountID)
+	return resetConfig(ctx, adminClient, &configName)
+}
+
+func getDeleteNotificationEndpointResponse(session *models.Principal, params configurationApi.DeleteNotificationEndpointParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	minioClient := minioClient{client: mClient}
+	force := params.Force != nil && *params.Force
+	if err := deleteNotificationEndpoint(ctx, minioClient, adminClient, models.NofiticationService(params.Service), params.AccountID, force); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// notificationTestEvent mimics the events MinIO delivers, so receivers can parse it with their usual code
+type notificationTestEvent struct {
+	EventName string                        `json:"EventName"`
+	Key       string                        `json:"Key"`
+	Records   []notificationTestEventRecord `json:"Records"`
+}
+
+type notificationTestEventRecord struct {
+	EventVersion string `json:"eventVersion"`
+	EventSource  string `json:"eventSource"`
+	EventTime    string `json:"eventTime"`
+	EventName    string `json:"eventName"`
+	S3           struct {
+		SchemaVersion   string `json:"s3SchemaVersion"`
+		ConfigurationID string `json:"configurationId"`
+		Bucket          struct {
+			Name string `json:"name"`
+			ARN  string `json:"arn"`
+		} `json:"bucket"`
+		Object struct {
+			Key  string `json:"key"`
+			Size int64  `json:"size"`
+		} `json:"object"`
+	} `json:"s3"`
+}
+
+func newNotificationTestEvent(now time.Time, eventName, bucket, key string) notificationTestEvent {
+	record := notificationTestEventRecord{
+		EventVersion: "2.0",
+		EventSource:  "minio:s3",
+		EventTime:    now.UTC().Format(time.RFC3339Nano),
+		EventName:    eventName,
+	}
+	record.S3.SchemaVersion = "1.0"
+	record.S3.ConfigurationID = "Config"
+	record.S3.Bucket.Name = bucket
+	record.S3.Bucket.ARN = "arn:aws:s3:::" + bucket
+	record.S3.Object.Key = key
+	return notificationTestEvent{
+		EventName: eventName,
+		Key:       bucket + "/" + key,
+		Records:   []notificationTestEventRecord{record},
+	}
+}
+
+// sendWebhookTestEvent posts the event to the webhook the same way MinIO does, including the auth token.
+// Rejected events return the status code along with the beginning of the response body.
+func sendWebhookTestEvent(ctx context.Context, client *http.Client, endpoint, authToken string, event interface{}) (int, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authToken != "" && authToken != notificationSecretMask {
+		// a token with a scheme is sent as is, otherwise it's a bearer token
+		if len(strings.Fields(authToken)) == 2 {
+			req.Header.Set("Authorization", authToken)
+		} else {
+			req.Header.Set("Authorization", "Bearer "+authToken)
+		}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		if msg := strings.TrimSpace(string(detail)); msg != "" {
+			return resp.StatusCode, fmt.Errorf("webhook answered %s: %s", resp.Status, msg)
+		}
+		return resp.StatusCode, fmt.Errorf("webhook answered %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// notificationEndpointStatus returns the status MinIO reports for the endpoint, unknown if it isn't listed
+func notificationEndpointStatus(ctx context.Context, client MinioAdmin, service models.NofiticationService, accountID string) (string, error) {
+	endpoints, err := getNotificationEndpoints(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	for _, endpoint := range endpoints.NotificationEndpoints {
+		if endpoint.Service == service && endpoint.AccountID == accountID {
+			return endpoint.Status, nil
+		}
+	}
+	return "unknown", nil
+}
+
+// testNotificationEndpoint reports the status MinIO has for the endpoint. Webhooks also receive a test
+// event sent by Console, other services need their own clients so only their status is reported.
+func testNotificationEndpoint(ctx context.Context, client MinioAdmin, service models.NofiticationService, accountID string, httpClient func(endpoint string) *http.Client) (*models.NotificationEndpointTestResult, error) {
+	properties, err := notificationEndpointConfig(ctx, client, service, accountID)
+	if err != nil {
+		return nil, err
+	}
+	status, err := notificationEndpointStatus(ctx, client, service, accountID)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.NotificationEndpointTestResult{Status: status}
+	if service != models.NofiticationServiceWebhook {
+		result.Message = fmt.Sprintf("test events can't be sent to %s endpoints, the status reported by MinIO is shown instead", service)
+		return result, nil
+	}
+	endpoint := properties["endpoint"]
+	if endpoint == "" {
+		return nil, fmt.Errorf("%w: the webhook has no endpoint", ErrInvalidNotificationEndpoint)
+	}
+	testCtx, cancel := context.WithTimeout(ctx, notificationTestTimeout)
+	defer cancel()
+	event := newNotificationTestEvent(time.Now(), "s3:TestEvent", "console-test", "console-test-event")
+	code, err := sendWebhookTestEvent(testCtx, httpClient(endpoint), endpoint, properties["auth_token"], event)
+	result.ResponseCode = int64(code)
+	if err != nil {
+		result.Message = err.Error()
+		return result, nil
+	}
+	result.EventSent = true
+	result.Message = "test event delivered"
+	return result, nil
+}
+
+func getTestNotificationEndpointResponse(session *models.Principal, params configurationApi.TestNotificationEndpointParams) (*models.NotificationEndpointTestResult, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := testNotificationEndpoint(ctx, adminClient, models.NofiticationService(params.Service), params.AccountID, GetConsoleHTTPClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,201 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	configurationApi "github.com/minio/console/restapi/operations/configuration"
+)
+
+// defaultNotificationAccountID is the account id MinIO gives the target configured without a name
+const defaultNotificationAccountID = "_"
+
+// notificationServices are the services events can be delivered to
+var notificationServices = []models.NofiticationService{
+	models.NofiticationServiceWebhook,
+	models.NofiticationServiceAmqp,
+	models.NofiticationServiceKafka,
+	models.NofiticationServiceMqtt,
+	models.NofiticationServiceNats,
+	models.NofiticationServiceNsq,
+	models.NofiticationServiceMysql,
+	models.NofiticationServicePostgres,
+	models.NofiticationServiceElasticsearch,
+	models.NofiticationServiceRedis,
+}
+
+// notificationAddressKeys are the properties holding the address of the endpoint of each service
+var notificationAddressKeys = map[models.NofiticationService]string{
+	models.NofiticationServiceWebhook:       "endpoint",
+	models.NofiticationServiceAmqp:          "url",
+	models.NofiticationServiceKafka:         "brokers",
+	models.NofiticationServiceMqtt:          "broker",
+	models.NofiticationServiceNats:          "address",
+	models.NofiticationServiceNsq:           "nsqd_address",
+	models.NofiticationServiceMysql:         "host",
+	models.NofiticationServicePostgres:      "host",
+	models.NofiticationServiceElasticsearch: "url",
+	models.NofiticationServiceRedis:         "address",
+}
+
+func registerNotificationEndpointsStatusHandlers(api *operations.ConsoleAPI) {
+	// status of every notification endpoint
+	api.ConfigurationNotificationEndpointsStatusHandler = configurationApi.NotificationEndpointsStatusHandlerFunc(func(params configurationApi.NotificationEndpointsStatusParams, session *models.Principal) middleware.Responder {
+		status, err := getNotificationEndpointsStatusResponse(session, params)
+		if err != nil {
+			return configurationApi.NewNotificationEndpointsStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewNotificationEndpointsStatusOK().WithPayload(status)
+	})
+}
+
+// configuredNotificationEndpoints returns the endpoints stored in the config of MinIO, including the ones
+// set through environment variables, keyed by service and account id
+func configuredNotificationEndpoints(ctx context.Context, client MinioAdmin) (map[string]*models.NotificationEndpointStatus, error) {
+	endpoints := map[string]*models.NotificationEndpointStatus{}
+	for _, service := range notificationServices {
+		configName, err := notificationConfigName(service)
+		if err != nil {
+			return nil, err
+		}
+		configs, err := getConfig(ctx, client, configName)
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range configs {
+			properties := map[string]string{}
+			for _, kv := range config.KeyValues {
+				properties[kv.Key] = kv.Value
+				if kv.EnvOverride != nil {
+					properties[kv.Key] = kv.EnvOverride.Value
+				}
+			}
+			enabled := properties["enable"] == "on"
+			accountID := strings.TrimPrefix(config.Name, configName+":")
+			if config.Name == configName {
+				// the target without a name is always listed by MinIO, it is only an endpoint once enabled
+				if !enabled {
+					continue
+				}
+				accountID = defaultNotificationAccountID
+			}
+			endpoint := &models.NotificationEndpointStatus{
+				Service:   string(service),
+				AccountID: accountID,
+				Enabled:   enabled,
+				QueueDir:  properties["queue_dir"],
+			}
+			if key := notificationAddressKeys[service]; !notificationSecretKeys[key] {
+				endpoint.Address = properties[key]
+			}
+			endpoints[notificationTargetKey(service, accountID)] = endpoint
+		}
+	}
+	return endpoints, nil
+}
+
+// notificationEndpointsStatus combines the configured endpoints with the status MinIO reports for the
+// ones it loaded and the buckets delivering events to them
+func notificationEndpointsStatus(ctx context.Context, client MinioClient, adminClient MinioAdmin) (*models.NotificationEndpointsStatus, error) {
+	endpoints, err := configuredNotificationEndpoints(ctx, adminClient)
+	if err != nil {
+		return nil, err
+	}
+	loaded, err := getNotificationEndpoints(ctx, adminClient)
+	if err != nil {
+		return nil, err
+	}
+	reported := map[string]string{}
+	for _, item := range loaded.NotificationEndpoints {
+		key := notificationTargetKey(item.Service, item.AccountID)
+		reported[key] = strings.ToLower(item.Status)
+		if _, ok := endpoints[key]; !ok {
+			// endpoints MinIO loaded without a stored config, e.g. set on a single node
+			endpoints[key] = &models.NotificationEndpointStatus{Service: string(item.Service), AccountID: item.AccountID, Enabled: true}
+		}
+	}
+	buckets, err := notificationTargetBuckets(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.NotificationEndpointsStatus{Endpoints: []*models.NotificationEndpointStatus{}}
+	for key, endpoint := range endpoints {
+		endpoint.Buckets = buckets[key]
+		if endpoint.Buckets == nil {
+			endpoint.Buckets = []string{}
+		}
+		status, ok := reported[key]
+		switch {
+		case !endpoint.Enabled:
+			endpoint.Status = models.NotificationEndpointStatusStatusDisabled
+			if len(endpoint.Buckets) > 0 {
+				endpoint.Error = "the endpoint is disabled, the events of its buckets aren't delivered"
+			}
+			response.Disabled++
+		case !ok:
+			endpoint.Status = models.NotificationEndpointStatusStatusUnknown
+			endpoint.Error = "MinIO didn't load the endpoint, a restart may be pending or it failed to connect at startup"
+			response.Unknown++
+		case status == models.NotificationEndpointStatusStatusOnline:
+			endpoint.Status = models.NotificationEndpointStatusStatusOnline
+			response.Online++
+		default:
+			endpoint.Status = models.NotificationEndpointStatusStatusOffline
+			if endpoint.QueueDir != "" {
+				endpoint.Error = fmt.Sprintf("MinIO can't reach the endpoint, events are queued in %s until it is back", endpoint.QueueDir)
+			} else {
+				endpoint.Error = "MinIO can't reach the endpoint, events are dropped until it is back"
+			}
+			response.Offline++
+		}
+		response.Endpoints = append(response.Endpoints, endpoint)
+	}
+	sort.Slice(response.Endpoints, func(i, j int) bool {
+		if response.Endpoints[i].Service != response.Endpoints[j].Service {
+			return response.Endpoints[i].Service < response.Endpoints[j].Service
+		}
+		return response.Endpoints[i].AccountID < response.Endpoints[j].AccountID
+	})
+	response.Total = int64(len(response.Endpoints))
+	return response, nil
+}
+
+func getNotificationEndpointsStatusResponse(session *models.Principal, params configurationApi.NotificationEndpointsStatusParams) (*models.NotificationEndpointsStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	status, err := notificationEndpointsStatus(ctx, minioClient{client: mClient}, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return status, nil
+}
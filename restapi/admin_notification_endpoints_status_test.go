@@ -0,0 +1,120 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_notificationEndpointsStatus(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	adminClient := AdminClientMock{}
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		switch key {
+		case "notify_webhook":
+			return []byte(strings.Join([]string{
+				`notify_webhook enable=off endpoint="" queue_dir=""`,
+				`notify_webhook:hook enable=on endpoint="http://localhost:8080/events" auth_token="secret" queue_dir=""`,
+				`notify_webhook:queued enable=on endpoint="http://localhost:8081/events" queue_dir="/events"`,
+				`notify_webhook:paused enable=off endpoint="http://localhost:8082/events" queue_dir=""`,
+			}, "\n")), nil
+		case "notify_kafka":
+			return []byte(`notify_kafka enable=on brokers="kafka:9092" queue_dir=""`), nil
+		case "notify_postgres":
+			return []byte(`notify_postgres:pg enable=on connection_string="secret" host="db" queue_dir=""`), nil
+		}
+		return []byte(key + ` enable=off`), nil
+	}
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Services: madmin.Services{
+			Notifications: []map[string][]madmin.TargetIDStatus{
+				{"webhook": {
+					{"hook": madmin.Status{Status: "Online"}},
+					{"queued": madmin.Status{Status: "Offline"}},
+				}},
+				{"kafka": {{"_": madmin.Status{Status: "offline"}}}},
+			},
+		}}, nil
+	}
+	minioListBucketsWithContextMock = func(ctx context.Context) ([]minio.BucketInfo, error) {
+		return []minio.BucketInfo{{Name: "images"}, {Name: "logs"}}, nil
+	}
+	minioGetBucketNotificationMock = func(ctx context.Context, bucketName string) (notification.Configuration, error) {
+		if bucketName == "images" {
+			return notification.Configuration{
+				QueueConfigs: []notification.QueueConfig{
+					{Queue: "arn:minio:sqs::hook:webhook"},
+					{Queue: "arn:minio:sqs::hook:webhook"},
+				},
+			}, nil
+		}
+		return notification.Configuration{
+			QueueConfigs: []notification.QueueConfig{{Queue: "arn:minio:sqs::paused:webhook"}},
+		}, nil
+	}
+
+	status, err := notificationEndpointsStatus(ctx, client, adminClient)
+	assert.NoError(err)
+	assert.Equal(int64(5), status.Total)
+	assert.Equal(int64(1), status.Online)
+	assert.Equal(int64(2), status.Offline)
+	assert.Equal(int64(1), status.Disabled)
+	assert.Equal(int64(1), status.Unknown)
+	if !assert.Len(status.Endpoints, 5) {
+		return
+	}
+
+	kafka := status.Endpoints[0]
+	assert.Equal("kafka", kafka.Service)
+	assert.Equal("_", kafka.AccountID)
+	assert.Equal("kafka:9092", kafka.Address)
+	assert.Equal(models.NotificationEndpointStatusStatusOffline, kafka.Status)
+	assert.Contains(kafka.Error, "dropped")
+
+	postgres := status.Endpoints[1]
+	assert.Equal("pg", postgres.AccountID)
+	assert.Equal("db", postgres.Address)
+	assert.Equal(models.NotificationEndpointStatusStatusUnknown, postgres.Status)
+
+	hook := status.Endpoints[2]
+	assert.Equal("hook", hook.AccountID)
+	assert.Equal(models.NotificationEndpointStatusStatusOnline, hook.Status)
+	assert.Empty(hook.Error)
+	assert.Equal([]string{"images"}, hook.Buckets)
+
+	paused := status.Endpoints[3]
+	assert.Equal("paused", paused.AccountID)
+	assert.Equal(models.NotificationEndpointStatusStatusDisabled, paused.Status)
+	assert.Equal([]string{"logs"}, paused.Buckets)
+	assert.NotEmpty(paused.Error)
+
+	queued := status.Endpoints[4]
+	assert.Equal(models.NotificationEndpointStatusStatusOffline, queued.Status)
+	assert.Contains(queued.Error, "/events")
+	assert.Equal([]string{}, queued.Buckets)
+}
@@ -18,11 +18,18 @@ package restapi
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
 	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/minio/console/models"
 	cfgApi "github.com/minio/console/restapi/operations/configuration"
@@ -452,3 +459,144 @@ func Test_addNotificationEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func Test_getNotificationEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`notify_webhook:hook endpoint="http://localhost:8080/events" auth_token="secret" queue_limit="0"`), nil
+	}
+	got, err := getNotificationEndpoint(ctx, client, models.NofiticationServiceWebhook, "hook")
+	assert.NoError(err)
+	assert.Equal("hook", *got.AccountID)
+	assert.Equal(map[string]string{
+		"endpoint":    "http://localhost:8080/events",
+		"auth_token":  notificationSecretMask,
+		"queue_limit": "0",
+	}, got.Properties)
+
+	// another target of the same service
+	_, err = getNotificationEndpoint(ctx, client, models.NofiticationServiceWebhook, "other")
+	assert.ErrorIs(err, ErrNotificationEndpointNotFound)
+
+	_, err = getNotificationEndpoint(ctx, client, models.NofiticationService("smtp"), "hook")
+	assert.ErrorIs(err, ErrInvalidNotificationEndpoint)
+}
+
+func Test_updateNotificationEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`notify_webhook:hook endpoint="http://localhost:8080/events" auth_token="secret"`), nil
+	}
+	var stored string
+	minioSetConfigKVMock = func(kv string) (bool, error) {
+		stored = kv
+		return true, nil
+	}
+	got, err := updateNotificationEndpoint(ctx, client, models.NofiticationServiceWebhook, "hook", map[string]string{
+		"endpoint":   "http://localhost:9090/events",
+		"auth_token": notificationSecretMask,
+	})
+	assert.NoError(err)
+	// the masked token is not sent back to the server
+	assert.Equal(`notify_webhook:hook endpoint="http://localhost:9090/events"`, stored)
+	assert.True(got.Restart)
+	assert.Equal(map[string]string{
+		"endpoint":   "http://localhost:9090/events",
+		"auth_token": notificationSecretMask,
+	}, got.Properties)
+}
+
+func Test_deleteNotificationEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	adminClient := AdminClientMock{}
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`notify_webhook:hook endpoint="http://localhost:8080/events"`), nil
+	}
+	minioListBucketsWithContextMock = func(ctx context.Context) ([]minio.BucketInfo, error) {
+		return []minio.BucketInfo{{Name: "images"}, {Name: "logs"}}, nil
+	}
+	minioGetBucketNotificationMock = func(ctx context.Context, bucketName string) (notification.Configuration, error) {
+		if bucketName != "images" {
+			return notification.Configuration{}, nil
+		}
+		return notification.Configuration{
+			QueueConfigs: []notification.QueueConfig{{Queue: "arn:minio:sqs::hook:webhook"}},
+		}, nil
+	}
+	var removed string
+	minioDelConfigKVMock = func(name string) error {
+		removed = name
+		return nil
+	}
+
+	err := deleteNotificationEndpoint(ctx, client, adminClient, models.NofiticationServiceWebhook, "hook", false)
+	assert.ErrorIs(err, ErrNotificationEndpointInUse)
+	assert.Contains(err.Error(), "images")
+	assert.Equal("", removed)
+
+	assert.NoError(deleteNotificationEndpoint(ctx, client, adminClient, models.NofiticationServiceWebhook, "hook", true))
+	assert.Equal("notify_webhook:hook", removed)
+}
+
+func Test_testNotificationEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	var received notificationTestEvent
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer server.Close()
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`notify_webhook:hook endpoint="` + server.URL + `" auth_token="secret"`), nil
+	}
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Services: madmin.Services{
+			Notifications: []map[string][]madmin.TargetIDStatus{{
+				"webhook": {{"hook": madmin.Status{Status: "online"}}},
+			}},
+		}}, nil
+	}
+	httpClient := func(string) *http.Client { return server.Client() }
+
+	got, err := testNotificationEndpoint(ctx, client, models.NofiticationServiceWebhook, "hook", httpClient)
+	assert.NoError(err)
+	assert.Equal("online", got.Status)
+	assert.True(got.EventSent)
+	assert.Equal(int64(http.StatusOK), got.ResponseCode)
+	assert.Equal("Bearer secret", authorization)
+	if assert.Len(received.Records, 1) {
+		assert.Equal("s3:TestEvent", received.Records[0].EventName)
+	}
+
+	// receivers answering with an error are reported without failing the request
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	got, err = testNotificationEndpoint(ctx, client, models.NofiticationServiceWebhook, "hook", httpClient)
+	assert.NoError(err)
+	assert.False(got.EventSent)
+	assert.Equal(int64(http.StatusUnauthorized), got.ResponseCode)
+
+	// other services only get their status
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`notify_kafka:hook brokers="localhost:9092" topic="events"`), nil
+	}
+	got, err = testNotificationEndpoint(ctx, client, models.NofiticationServiceKafka, "hook", httpClient)
+	assert.NoError(err)
+	assert.False(got.EventSent)
+	assert.Equal("unknown", got.Status)
+}
@@ -44,7 +44,7 @@ func registersPoliciesHandler(api *operations.ConsoleAPI) {
 		if err != nil {
 			return policyApi.NewListPoliciesDefault(int(err.Code)).WithPayload(err)
 		}
-		return policyApi.NewListPoliciesOK().WithPayload(listPoliciesResponse)
+		return withPageHeaders(policyApi.NewListPoliciesOK().WithPayload(listPoliciesResponse), listPoliciesResponse.Total, listPoliciesResponse.NextCursor)
 	})
 	// Policy Info
 	api.PolicyPolicyInfoHandler = policyApi.PolicyInfoHandlerFunc(func(params policyApi.PolicyInfoParams, session *models.Principal) middleware.Responder {
@@ -296,10 +296,16 @@ func getListPoliciesResponse(session *models.Principal, params policyApi.ListPol
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+	page, err := paginate(len(policies), func(i int) string { return policies[i].Name }, params.Cursor, params.PageSize)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	// serialize output
 	listPoliciesResponse := &models.ListPoliciesResponse{
-		Policies: policies,
-		Total:    int64(len(policies)),
+		Policies:   policies[page.start:page.end],
+		Total:      int64(page.total),
+		NextCursor: page.nextCursor,
 	}
 	return listPoliciesResponse, nil
 }
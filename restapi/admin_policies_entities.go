@@ -0,0 +1,191 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	policyApi "github.com/minio/console/restapi/operations/policy"
+	"github.com/minio/madmin-go/v2"
+)
+
+// defaultOpenIDClaimName is the claim MinIO reads policies from when an OpenID configuration doesn't set one
+const defaultOpenIDClaimName = "policy"
+
+func registerPolicyEntitiesHandlers(api *operations.ConsoleAPI) {
+	// List Policy Entities
+	api.PolicyListPolicyEntitiesHandler = policyApi.ListPolicyEntitiesHandlerFunc(func(params policyApi.ListPolicyEntitiesParams, session *models.Principal) middleware.Responder {
+		entitiesResponse, err := getListPolicyEntitiesResponse(session, params)
+		if err != nil {
+			return policyApi.NewListPolicyEntitiesDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewListPolicyEntitiesOK().WithPayload(entitiesResponse)
+	})
+}
+
+func hasPolicy(policies, policy string) bool {
+	for _, name := range strings.Split(policies, ",") {
+		if strings.TrimSpace(name) == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// openIDPolicyMapping tells how an OpenID configuration can grant a policy: configurations with a role policy
+// grant it to everyone assuming the role, the rest grant it to tokens listing it in their policy claim
+func openIDPolicyMapping(item madmin.IDPListItem, config madmin.IDPConfig, policy string) *models.PolicyOpenIDMapping {
+	claimName := defaultOpenIDClaimName
+	rolePolicy := ""
+	for _, info := range config.Info {
+		switch info.Key {
+		case "claim_name":
+			if info.Value != "" {
+				claimName = info.Value
+			}
+		case "role_policy":
+			rolePolicy = info.Value
+		}
+	}
+	mapping := &models.PolicyOpenIDMapping{
+		ConfigName: item.Name,
+		Enabled:    item.Enabled,
+	}
+	if rolePolicy != "" {
+		if !hasPolicy(rolePolicy, policy) {
+			return nil
+		}
+		mapping.RoleArn = item.RoleARN
+		return mapping
+	}
+	mapping.ClaimName = claimName
+	return mapping
+}
+
+// listPolicyEntities finds every user, group and identity provider mapping a policy is attached to,
+// identity providers that can't be queried are reported as warnings so the rest is still returned
+func listPolicyEntities(ctx context.Context, client MinioAdmin, policy string) (*models.PolicyEntities, error) {
+	if _, err := client.getPolicy(ctx, policy); err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchPolicy" {
+			return nil, ErrPolicyNotFound
+		}
+		return nil, err
+	}
+	entities := &models.PolicyEntities{
+		Policy:     policy,
+		Users:      []string{},
+		Groups:     []string{},
+		LdapUsers:  []string{},
+		LdapGroups: []string{},
+		OpenID:     []*models.PolicyOpenIDMapping{},
+		Warnings:   []string{},
+	}
+
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for accessKey, user := range users {
+		if hasPolicy(user.PolicyName, policy) {
+			entities.Users = append(entities.Users, accessKey)
+		}
+	}
+	sort.Strings(entities.Users)
+
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		if hasPolicy(desc.Policy, policy) {
+			entities.Groups = append(entities.Groups, group)
+		}
+	}
+	sort.Strings(entities.Groups)
+
+	ldapConfigs, err := client.listIDPConfig(ctx, madmin.LDAPIDPCfg)
+	if err != nil {
+		entities.Warnings = append(entities.Warnings, fmt.Sprintf("LDAP configuration could not be read: %v", err))
+	}
+	ldapEnabled := false
+	for _, config := range ldapConfigs {
+		ldapEnabled = ldapEnabled || config.Enabled
+	}
+	if ldapEnabled {
+		result, err := client.getLDAPPolicyEntities(ctx, madmin.PolicyEntitiesQuery{Policy: []string{policy}})
+		if err != nil {
+			entities.Warnings = append(entities.Warnings, fmt.Sprintf("LDAP entities could not be listed: %v", err))
+		}
+		for _, mapping := range result.PolicyMappings {
+			if mapping.Policy == policy {
+				entities.LdapUsers = append(entities.LdapUsers, mapping.Users...)
+				entities.LdapGroups = append(entities.LdapGroups, mapping.Groups...)
+			}
+		}
+	}
+	sort.Strings(entities.LdapUsers)
+	sort.Strings(entities.LdapGroups)
+
+	openIDConfigs, err := client.listIDPConfig(ctx, madmin.OpenidIDPCfg)
+	if err != nil {
+		entities.Warnings = append(entities.Warnings, fmt.Sprintf("OpenID configurations could not be read: %v", err))
+	}
+	for _, item := range openIDConfigs {
+		config, err := client.getIDPConfig(ctx, madmin.OpenidIDPCfg, item.Name)
+		if err != nil {
+			entities.Warnings = append(entities.Warnings, fmt.Sprintf("OpenID configuration %s could not be read: %v", item.Name, err))
+			continue
+		}
+		if mapping := openIDPolicyMapping(item, config, policy); mapping != nil {
+			entities.OpenID = append(entities.OpenID, mapping)
+		}
+	}
+	return entities, nil
+}
+
+// getListPolicyEntitiesResponse performs listPolicyEntities() and serializes it to the handler's output
+func getListPolicyEntitiesResponse(session *models.Principal, params policyApi.ListPolicyEntitiesParams) (*models.PolicyEntities, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	policy, err := utils.DecodeBase64(params.Policy)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	entities, err := listPolicyEntities(ctx, adminClient, policy)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return entities, nil
+}
@@ -0,0 +1,92 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPolicyEntities(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		return &iampolicy.Policy{Version: "2012-10-17"}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"zoe":   {PolicyName: "readonly,diagnostics"},
+			"alice": {PolicyName: "readonly"},
+			"bob":   {PolicyName: "readwrite"},
+		}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"auditors", "developers"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "auditors" {
+			return &madmin.GroupDesc{Name: group, Policy: "readonly"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Policy: "readwrite"}, nil
+	}
+
+	entities, err := listPolicyEntities(ctx, adminClient, "readonly")
+	assert.NoError(err)
+	assert.Equal("readonly", entities.Policy)
+	assert.Equal([]string{"alice", "zoe"}, entities.Users)
+	assert.Equal([]string{"auditors"}, entities.Groups)
+	assert.Empty(entities.LdapUsers)
+	assert.Empty(entities.Warnings)
+	// the mocked OpenID configuration has no role policy, so the policy can be granted through its claim
+	assert.Equal([]*models.PolicyOpenIDMapping{{ConfigName: "mock", ClaimName: "policy"}}, entities.OpenID)
+
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+	}
+	_, err = listPolicyEntities(ctx, adminClient, "missing")
+	assert.ErrorIs(err, ErrPolicyNotFound)
+
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		return &iampolicy.Policy{Version: "2012-10-17"}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return nil, errors.New("groups unavailable")
+	}
+	_, err = listPolicyEntities(ctx, adminClient, "readonly")
+	assert.EqualError(err, "groups unavailable")
+}
+
+func TestOpenIDPolicyMapping(t *testing.T) {
+	assert := assert.New(t)
+	item := madmin.IDPListItem{Name: "keycloak", Enabled: true, RoleARN: "arn:minio:iam:::role/keycloak"}
+
+	mapping := openIDPolicyMapping(item, madmin.IDPConfig{Info: []madmin.IDPCfgInfo{{Key: "role_policy", Value: "readonly,diagnostics"}}}, "diagnostics")
+	assert.Equal(&models.PolicyOpenIDMapping{ConfigName: "keycloak", Enabled: true, RoleArn: "arn:minio:iam:::role/keycloak"}, mapping)
+	mapping = openIDPolicyMapping(item, madmin.IDPConfig{Info: []madmin.IDPCfgInfo{{Key: "role_policy", Value: "readonly"}}}, "diagnostics")
+	assert.Nil(mapping)
+
+	mapping = openIDPolicyMapping(item, madmin.IDPConfig{Info: []madmin.IDPCfgInfo{{Key: "claim_name", Value: "groups"}}}, "diagnostics")
+	assert.Equal("groups", mapping.ClaimName)
+	assert.Empty(mapping.RoleArn)
+}
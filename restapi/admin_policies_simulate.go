@@ -0,0 +1,211 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	policyApi "github.com/minio/console/restapi/operations/policy"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerPolicySimulationHandlers(api *operations.ConsoleAPI) {
+	// Simulate Policy
+	api.PolicySimulatePolicyHandler = policyApi.SimulatePolicyHandlerFunc(func(params policyApi.SimulatePolicyParams, session *models.Principal) middleware.Responder {
+		simulationResponse, err := getSimulatePolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewSimulatePolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewSimulatePolicyOK().WithPayload(simulationResponse)
+	})
+}
+
+// policySimulationArgs builds the arguments MinIO evaluates a request with out of a simulation request
+func policySimulationArgs(req *models.PolicySimulationRequest) (*iampolicy.Args, error) {
+	if req.Action == nil || strings.TrimSpace(*req.Action) == "" {
+		return nil, fmt.Errorf("%w: an action is required", ErrInvalidPolicySimulation)
+	}
+	action := iampolicy.Action(strings.TrimSpace(*req.Action))
+	// admin actions are listed apart from the S3 ones
+	if !action.IsValid() && !iampolicy.AdminAction(action).IsValid() {
+		return nil, fmt.Errorf("%w: unknown action %s", ErrInvalidPolicySimulation, action)
+	}
+	resource := strings.TrimPrefix(strings.TrimSpace(req.Resource), s3ResourcePrefix)
+	resource = strings.TrimPrefix(resource, "/")
+	if resource == "" && !strings.HasPrefix(string(action), "admin:") {
+		return nil, fmt.Errorf("%w: a resource is required for %s", ErrInvalidPolicySimulation, action)
+	}
+	bucket, object, _ := strings.Cut(resource, "/")
+	if len(req.Users)+len(req.Groups)+len(req.Policies) == 0 {
+		return nil, fmt.Errorf("%w: at least one user, group or policy is required", ErrInvalidPolicySimulation)
+	}
+
+	conditions := map[string][]string{}
+	if req.SourceIP != "" {
+		conditions["SourceIp"] = []string{req.SourceIP}
+	}
+	if req.Prefix != "" {
+		conditions["prefix"] = []string{req.Prefix}
+	}
+	args := &iampolicy.Args{
+		Action:          action,
+		BucketName:      bucket,
+		ObjectName:      object,
+		ConditionValues: conditions,
+	}
+	// policy variables such as ${aws:username} can only be resolved for a single user
+	if len(req.Users) == 1 {
+		args.AccountName = req.Users[0]
+		conditions["username"] = []string{req.Users[0]}
+		conditions["userid"] = []string{req.Users[0]}
+	}
+	return args, nil
+}
+
+// simulationPolicyNames returns the policies and groups that apply to the users, groups and policies of the request,
+// users bring the policies of the groups they belong to as MinIO does when evaluating their requests
+func simulationPolicyNames(ctx context.Context, client MinioAdmin, req *models.PolicySimulationRequest) ([]string, []string, error) {
+	var names []string
+	addPolicies := func(policies string) {
+		for _, name := range strings.Split(policies, ",") {
+			name = strings.TrimSpace(name)
+			if name != "" && !IsElementInArray(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	var groups []string
+	for _, group := range req.Groups {
+		if !IsElementInArray(groups, group) {
+			groups = append(groups, group)
+		}
+	}
+	for _, user := range req.Users {
+		info, err := client.getUserInfo(ctx, user)
+		if err != nil {
+			return nil, nil, err
+		}
+		addPolicies(info.PolicyName)
+		for _, group := range info.MemberOf {
+			if !IsElementInArray(groups, group) {
+				groups = append(groups, group)
+			}
+		}
+	}
+	for _, group := range groups {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return nil, nil, err
+		}
+		if desc.Status == "disabled" {
+			continue
+		}
+		addPolicies(desc.Policy)
+	}
+	addPolicies(strings.Join(req.Policies, ","))
+	return names, groups, nil
+}
+
+// statementMatches reports whether a statement applies to the request, regardless of its effect
+func statementMatches(statement iampolicy.Statement, args iampolicy.Args) bool {
+	allowed := statement.IsAllowed(args)
+	if statement.Effect == "Deny" {
+		return !allowed
+	}
+	return allowed
+}
+
+// simulatePolicy evaluates the request against every applicable policy: a matching Deny statement
+// always wins, otherwise the request is allowed only when an Allow statement matches it
+func simulatePolicy(ctx context.Context, client MinioAdmin, req *models.PolicySimulationRequest) (*models.PolicySimulationResult, error) {
+	args, err := policySimulationArgs(req)
+	if err != nil {
+		return nil, err
+	}
+	names, groups, err := simulationPolicyNames(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+	args.Groups = groups
+
+	result := &models.PolicySimulationResult{
+		EvaluatedPolicies:  names,
+		MatchingStatements: []*models.PolicySimulationStatement{},
+	}
+	denied, allowed := false, false
+	for _, name := range names {
+		policy, err := client.getPolicy(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		for _, statement := range policy.Statements {
+			if !statementMatches(statement, *args) {
+				continue
+			}
+			rawStatement, err := json.Marshal(statement)
+			if err != nil {
+				return nil, err
+			}
+			result.MatchingStatements = append(result.MatchingStatements, &models.PolicySimulationStatement{
+				Policy:    name,
+				Sid:       string(statement.SID),
+				Effect:    string(statement.Effect),
+				Statement: string(rawStatement),
+			})
+			if statement.Effect == "Deny" {
+				denied = true
+			} else {
+				allowed = true
+			}
+		}
+	}
+
+	switch {
+	case denied:
+		result.Decision = models.PolicySimulationResultDecisionExplicitDeny
+	case allowed:
+		result.Allowed = true
+		result.Decision = models.PolicySimulationResultDecisionAllow
+	default:
+		result.Decision = models.PolicySimulationResultDecisionImplicitDeny
+	}
+	return result, nil
+}
+
+// getSimulatePolicyResponse performs simulatePolicy() and serializes it to the handler's output
+func getSimulatePolicyResponse(session *models.Principal, params policyApi.SimulatePolicyParams) (*models.PolicySimulationResult, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := simulatePolicy(ctx, adminClient, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
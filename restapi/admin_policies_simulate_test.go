@@ -0,0 +1,149 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatePolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	policies := map[string]string{
+		"readwrite":     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`,
+		"deny-private":  `{"Version":"2012-10-17","Statement":[{"Sid":"NoPrivate","Effect":"Deny","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/private/*"]}]}`,
+		"office-only":   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::reports/*"],"Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8"]}}}]}`,
+		"home-listing":  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::home"],"Condition":{"StringLike":{"s3:prefix":["${aws:username}/*"]}}}]}`,
+		"disabled-team": `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`,
+	}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		raw, ok := policies[name]
+		if !ok {
+			return nil, errors.New("policy not found")
+		}
+		return iampolicy.ParseConfig(bytes.NewReader([]byte(raw)))
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "home-listing", MemberOf: []string{"analysts", "old-team"}, Status: madmin.AccountEnabled}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "old-team" {
+			return &madmin.GroupDesc{Name: group, Policy: "disabled-team", Status: "disabled"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Policy: "readwrite,deny-private", Status: "enabled"}, nil
+	}
+
+	// users are evaluated with their own policies and the ones of their enabled groups
+	result, err := simulatePolicy(ctx, adminClient, &models.PolicySimulationRequest{
+		Users:    []string{"alice"},
+		Action:   swag.String("s3:GetObject"),
+		Resource: "arn:aws:s3:::data/private/salaries.csv",
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"home-listing", "readwrite", "deny-private"}, result.EvaluatedPolicies)
+	assert.False(result.Allowed)
+	assert.Equal(models.PolicySimulationResultDecisionExplicitDeny, result.Decision)
+	assert.Len(result.MatchingStatements, 2)
+	assert.Equal("deny-private", result.MatchingStatements[1].Policy)
+	assert.Equal("NoPrivate", result.MatchingStatements[1].Sid)
+
+	result, err = simulatePolicy(ctx, adminClient, &models.PolicySimulationRequest{
+		Groups:   []string{"analysts"},
+		Action:   swag.String("s3:PutObject"),
+		Resource: "data/public/report.csv",
+	})
+	assert.NoError(err)
+	assert.True(result.Allowed)
+	assert.Equal(models.PolicySimulationResultDecisionAllow, result.Decision)
+
+	// conditions are evaluated with the context of the request
+	request := &models.PolicySimulationRequest{
+		Policies: []string{"office-only"},
+		Action:   swag.String("s3:GetObject"),
+		Resource: "reports/q1.pdf",
+		SourceIP: "192.168.1.20",
+	}
+	result, err = simulatePolicy(ctx, adminClient, request)
+	assert.NoError(err)
+	assert.Equal(models.PolicySimulationResultDecisionImplicitDeny, result.Decision)
+	assert.Empty(result.MatchingStatements)
+	request.SourceIP = "10.1.2.3"
+	result, err = simulatePolicy(ctx, adminClient, request)
+	assert.NoError(err)
+	assert.True(result.Allowed)
+
+	// policy variables are resolved for a single user
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "home-listing", Status: madmin.AccountEnabled}, nil
+	}
+	request = &models.PolicySimulationRequest{
+		Users:    []string{"bob"},
+		Action:   swag.String("s3:ListBucket"),
+		Resource: "home",
+		Prefix:   "bob/documents/",
+	}
+	result, err = simulatePolicy(ctx, adminClient, request)
+	assert.NoError(err)
+	assert.True(result.Allowed)
+	request.Prefix = "alice/"
+	result, err = simulatePolicy(ctx, adminClient, request)
+	assert.NoError(err)
+	assert.False(result.Allowed)
+
+	_, err = simulatePolicy(ctx, adminClient, &models.PolicySimulationRequest{Policies: []string{"unknown"}, Action: swag.String("s3:GetObject"), Resource: "data/a"})
+	assert.EqualError(err, "policy not found")
+}
+
+func TestPolicySimulationArgs(t *testing.T) {
+	assert := assert.New(t)
+	args, err := policySimulationArgs(&models.PolicySimulationRequest{
+		Policies: []string{"readwrite"},
+		Action:   swag.String(" s3:GetObject "),
+		Resource: "arn:aws:s3:::data/nested/file.txt",
+		SourceIP: "10.0.0.1",
+	})
+	assert.NoError(err)
+	assert.Equal(iampolicy.Action(iampolicy.GetObjectAction), args.Action)
+	assert.Equal("data", args.BucketName)
+	assert.Equal("nested/file.txt", args.ObjectName)
+	assert.Equal([]string{"10.0.0.1"}, args.ConditionValues["SourceIp"])
+	assert.Empty(args.AccountName)
+
+	// admin actions don't need a resource
+	_, err = policySimulationArgs(&models.PolicySimulationRequest{Policies: []string{"consoleAdmin"}, Action: swag.String("admin:ServerInfo")})
+	assert.NoError(err)
+
+	for _, req := range []*models.PolicySimulationRequest{
+		{Policies: []string{"readwrite"}, Resource: "data"},
+		{Policies: []string{"readwrite"}, Action: swag.String("s3:Fly"), Resource: "data"},
+		{Policies: []string{"readwrite"}, Action: swag.String("s3:GetObject")},
+		{Action: swag.String("s3:GetObject"), Resource: "data"},
+	} {
+		_, err = policySimulationArgs(req)
+		assert.ErrorIs(err, ErrInvalidPolicySimulation)
+	}
+}
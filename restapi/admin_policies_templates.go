@@ -0,0 +1,332 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	policyApi "github.com/minio/console/restapi/operations/policy"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// policyTemplateParam is a value the policy of a template is rendered with
+type policyTemplateParam struct {
+	name         string
+	label        string
+	required     bool
+	multiple     bool
+	defaultValue string
+	// bucket values must be valid bucket names, the others are object prefixes
+	bucket bool
+}
+
+// templateStatement is a statement of a rendered policy, fields are kept in the order admins are used to
+type templateStatement struct {
+	Effect    string                         `json:"Effect"`
+	Action    []string                       `json:"Action"`
+	Resource  []string                       `json:"Resource"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
+}
+
+type templatePolicy struct {
+	Version   string              `json:"Version"`
+	Statement []templateStatement `json:"Statement"`
+}
+
+// policyTemplate renders a policy for a common use case from a few parameters, values of multiple
+// parameters are given comma separated
+type policyTemplate struct {
+	name        string
+	title       string
+	description string
+	params      []policyTemplateParam
+	statements  func(values map[string][]string) []templateStatement
+}
+
+var policyTemplates = []policyTemplate{
+	{
+		name:        "read-only-bucket",
+		title:       "Read-only buckets",
+		description: "List and download the objects of the buckets, nothing can be changed.",
+		params: []policyTemplateParam{
+			{name: "buckets", label: "Buckets", required: true, multiple: true, bucket: true},
+		},
+		statements: func(values map[string][]string) []templateStatement {
+			return []templateStatement{
+				{Effect: "Allow", Action: []string{"s3:GetBucketLocation", "s3:ListBucket"}, Resource: bucketResources(values["buckets"], "")},
+				{Effect: "Allow", Action: []string{"s3:GetObject"}, Resource: bucketResources(values["buckets"], "*")},
+			}
+		},
+	},
+	{
+		name:        "home-directories",
+		title:       "Per-user home directories",
+		description: "Every user gets full access to a prefix named after their access key, and can only see the names of the others.",
+		params: []policyTemplateParam{
+			{name: "bucket", label: "Bucket", required: true, bucket: true},
+			{name: "prefix", label: "Parent prefix of the home directories", defaultValue: "home"},
+		},
+		statements: func(values map[string][]string) []templateStatement {
+			buckets, parent := values["bucket"], templatePrefix(values["prefix"])
+			home := parent + "${aws:username}/"
+			return []templateStatement{
+				{Effect: "Allow", Action: []string{"s3:GetBucketLocation"}, Resource: bucketResources(buckets, "")},
+				{
+					Effect:   "Allow",
+					Action:   []string{"s3:ListBucket"},
+					Resource: bucketResources(buckets, ""),
+					Condition: map[string]map[string][]string{
+						"StringEquals": {"s3:prefix": uniqueStrings([]string{"", parent}), "s3:delimiter": {"/"}},
+					},
+				},
+				{
+					Effect:   "Allow",
+					Action:   []string{"s3:ListBucket"},
+					Resource: bucketResources(buckets, ""),
+					Condition: map[string]map[string][]string{
+						"StringLike": {"s3:prefix": {home + "*"}},
+					},
+				},
+				{
+					Effect: "Allow",
+					Action: []string{
+						"s3:AbortMultipartUpload", "s3:DeleteObject", "s3:GetObject",
+						"s3:ListMultipartUploadParts", "s3:PutObject",
+					},
+					Resource: bucketResources(buckets, home+"*"),
+				},
+			}
+		},
+	},
+	{
+		name:  "write-once-ingest",
+		title: "Write-once ingest",
+		description: "Upload objects under a prefix without being able to read or delete them. Enable versioning or " +
+			"object locking on the bucket so uploads can't replace existing objects either.",
+		params: []policyTemplateParam{
+			{name: "bucket", label: "Bucket", required: true, bucket: true},
+			{name: "prefix", label: "Prefix"},
+		},
+		statements: func(values map[string][]string) []templateStatement {
+			buckets, objects := values["bucket"], templatePrefix(values["prefix"])+"*"
+			return []templateStatement{
+				{Effect: "Allow", Action: []string{"s3:GetBucketLocation", "s3:ListBucketMultipartUploads"}, Resource: bucketResources(buckets, "")},
+				{
+					Effect:   "Allow",
+					Action:   []string{"s3:AbortMultipartUpload", "s3:ListMultipartUploadParts", "s3:PutObject"},
+					Resource: bucketResources(buckets, objects),
+				},
+				{
+					Effect: "Deny",
+					Action: []string{
+						"s3:BypassGovernanceRetention", "s3:DeleteObject", "s3:DeleteObjectVersion",
+						"s3:PutObjectLegalHold", "s3:PutObjectRetention",
+					},
+					Resource: bucketResources(buckets, objects),
+				},
+			}
+		},
+	},
+	{
+		name:        "replication-operator",
+		title:       "Replication operator",
+		description: "Configure the replication of the buckets to remote targets and replicate their objects.",
+		params: []policyTemplateParam{
+			{name: "buckets", label: "Buckets", required: true, multiple: true, bucket: true},
+		},
+		statements: func(values map[string][]string) []templateStatement {
+			return []templateStatement{
+				{Effect: "Allow", Action: []string{"admin:GetBucketTarget", "admin:SetBucketTarget"}, Resource: []string{s3ResourcePrefix + "*"}},
+				{
+					Effect: "Allow",
+					Action: []string{
+						"s3:GetBucketLocation", "s3:GetBucketObjectLockConfiguration", "s3:GetBucketVersioning",
+						"s3:GetEncryptionConfiguration", "s3:GetReplicationConfiguration", "s3:ListBucket",
+						"s3:ListBucketMultipartUploads", "s3:PutBucketVersioning", "s3:PutReplicationConfiguration",
+						"s3:ResetBucketReplicationState",
+					},
+					Resource: bucketResources(values["buckets"], ""),
+				},
+				{
+					Effect: "Allow",
+					Action: []string{
+						"s3:GetObjectLegalHold", "s3:GetObjectRetention", "s3:GetObjectVersion",
+						"s3:GetObjectVersionForReplication", "s3:GetObjectVersionTagging", "s3:ReplicateDelete",
+						"s3:ReplicateObject", "s3:ReplicateTags",
+					},
+					Resource: bucketResources(values["buckets"], "*"),
+				},
+			}
+		},
+	},
+}
+
+func registerPolicyTemplateHandlers(api *operations.ConsoleAPI) {
+	// List Policy Templates
+	api.PolicyListPolicyTemplatesHandler = policyApi.ListPolicyTemplatesHandlerFunc(func(params policyApi.ListPolicyTemplatesParams, session *models.Principal) middleware.Responder {
+		return policyApi.NewListPolicyTemplatesOK().WithPayload(listPolicyTemplates())
+	})
+	// Render Policy Template
+	api.PolicyRenderPolicyTemplateHandler = policyApi.RenderPolicyTemplateHandlerFunc(func(params policyApi.RenderPolicyTemplateParams, session *models.Principal) middleware.Responder {
+		rendered, err := getRenderPolicyTemplateResponse(params)
+		if err != nil {
+			return policyApi.NewRenderPolicyTemplateDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewRenderPolicyTemplateOK().WithPayload(rendered)
+	})
+}
+
+// bucketResources returns the resources of the objects matching pattern in the buckets, the buckets
+// themselves when pattern is empty
+func bucketResources(buckets []string, pattern string) []string {
+	resources := make([]string, 0, len(buckets))
+	for _, bucket := range buckets {
+		if pattern == "" {
+			resources = append(resources, s3ResourcePrefix+bucket)
+		} else {
+			resources = append(resources, s3ResourcePrefix+bucket+"/"+pattern)
+		}
+	}
+	return resources
+}
+
+// templatePrefix returns the prefix parameter with a trailing slash, empty for the whole bucket
+func templatePrefix(values []string) string {
+	if len(values) == 0 || values[0] == "" {
+		return ""
+	}
+	return values[0] + "/"
+}
+
+func uniqueStrings(list []string) []string {
+	var unique []string
+	for _, s := range list {
+		if !IsElementInArray(unique, s) {
+			unique = append(unique, s)
+		}
+	}
+	return unique
+}
+
+func listPolicyTemplates() *models.PolicyTemplateList {
+	list := &models.PolicyTemplateList{Templates: []*models.PolicyTemplate{}}
+	for _, template := range policyTemplates {
+		item := &models.PolicyTemplate{
+			Name:        template.name,
+			Title:       template.title,
+			Description: template.description,
+			Parameters:  []*models.PolicyTemplateParameter{},
+		}
+		for _, param := range template.params {
+			item.Parameters = append(item.Parameters, &models.PolicyTemplateParameter{
+				Name:         param.name,
+				Label:        param.label,
+				Required:     param.required,
+				Multiple:     param.multiple,
+				DefaultValue: param.defaultValue,
+			})
+		}
+		list.Templates = append(list.Templates, item)
+	}
+	return list
+}
+
+// templateValues validates the parameters given for a template and fills in the defaults
+func templateValues(template policyTemplate, parameters map[string]string) (map[string][]string, error) {
+	for name := range parameters {
+		known := false
+		for _, param := range template.params {
+			known = known || param.name == name
+		}
+		if !known {
+			return nil, fmt.Errorf("%w: unknown parameter %q", ErrInvalidPolicyTemplateParameters, name)
+		}
+	}
+	values := map[string][]string{}
+	for _, param := range template.params {
+		value, ok := parameters[param.name]
+		if !ok {
+			value = param.defaultValue
+		}
+		var items []string
+		if param.multiple {
+			items = splitEnvList(value)
+		} else if value = strings.TrimSpace(value); value != "" {
+			items = []string{value}
+		}
+		if param.required && len(items) == 0 {
+			return nil, fmt.Errorf("%w: %s is required", ErrInvalidPolicyTemplateParameters, param.name)
+		}
+		for i, item := range items {
+			if param.bucket {
+				if err := s3utils.CheckValidBucketNameStrict(item); err != nil {
+					return nil, fmt.Errorf("%w: %s: %v", ErrInvalidPolicyTemplateParameters, param.name, err)
+				}
+				continue
+			}
+			item = strings.Trim(item, "/")
+			// the prefix ends up in resources and conditions, it can't widen them
+			if strings.ContainsAny(item, "*?") || strings.Contains(item, "${") {
+				return nil, fmt.Errorf("%w: %s can't contain wildcards or policy variables", ErrInvalidPolicyTemplateParameters, param.name)
+			}
+			items[i] = item
+		}
+		values[param.name] = items
+	}
+	return values, nil
+}
+
+// renderPolicyTemplate returns the policy of a template rendered with the given parameters
+func renderPolicyTemplate(name string, parameters map[string]string) (*models.PolicyTemplateRender, error) {
+	for _, template := range policyTemplates {
+		if template.name != name {
+			continue
+		}
+		values, err := templateValues(template, parameters)
+		if err != nil {
+			return nil, err
+		}
+		policy, err := json.MarshalIndent(templatePolicy{Version: iampolicy.DefaultVersion, Statement: template.statements(values)}, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		// templates are meant to be correct, never hand out a policy MinIO would refuse
+		if _, err := iampolicy.ParseConfig(bytes.NewReader(policy)); err != nil {
+			return nil, err
+		}
+		return &models.PolicyTemplateRender{Template: name, Policy: string(policy)}, nil
+	}
+	return nil, ErrPolicyTemplateNotFound
+}
+
+func getRenderPolicyTemplateResponse(params policyApi.RenderPolicyTemplateParams) (*models.PolicyTemplateRender, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	rendered, err := renderPolicyTemplate(params.Template, params.Body.Parameters)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rendered, nil
+}
@@ -0,0 +1,87 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPolicyTemplates(t *testing.T) {
+	assert := assert.New(t)
+	list := listPolicyTemplates()
+	assert.Len(list.Templates, len(policyTemplates))
+	for _, template := range list.Templates {
+		assert.NotEmpty(template.Title)
+		assert.NotEmpty(template.Parameters)
+	}
+}
+
+func TestRenderPolicyTemplate(t *testing.T) {
+	assert := assert.New(t)
+
+	// every template renders a policy MinIO accepts
+	for _, template := range policyTemplates {
+		parameters := map[string]string{}
+		for _, param := range template.params {
+			if param.required {
+				parameters[param.name] = "photos"
+			}
+		}
+		_, err := renderPolicyTemplate(template.name, parameters)
+		assert.NoError(err, template.name)
+	}
+
+	rendered, err := renderPolicyTemplate("read-only-bucket", map[string]string{"buckets": "photos, videos"})
+	assert.NoError(err)
+	assert.Equal("read-only-bucket", rendered.Template)
+	var policy templatePolicy
+	assert.NoError(json.Unmarshal([]byte(rendered.Policy), &policy))
+	assert.Equal([]string{"arn:aws:s3:::photos", "arn:aws:s3:::videos"}, policy.Statement[0].Resource)
+	assert.Equal([]string{"arn:aws:s3:::photos/*", "arn:aws:s3:::videos/*"}, policy.Statement[1].Resource)
+
+	rendered, err = renderPolicyTemplate("home-directories", map[string]string{"bucket": "shared", "prefix": "/users/"})
+	assert.NoError(err)
+	assert.NoError(json.Unmarshal([]byte(rendered.Policy), &policy))
+	assert.Equal([]string{"", "users/"}, policy.Statement[1].Condition["StringEquals"]["s3:prefix"])
+	assert.Equal([]string{"users/${aws:username}/*"}, policy.Statement[2].Condition["StringLike"]["s3:prefix"])
+	assert.Equal([]string{"arn:aws:s3:::shared/users/${aws:username}/*"}, policy.Statement[3].Resource)
+
+	// the default parent prefix is used when none is given
+	rendered, err = renderPolicyTemplate("home-directories", map[string]string{"bucket": "shared"})
+	assert.NoError(err)
+	assert.Contains(rendered.Policy, "arn:aws:s3:::shared/home/${aws:username}/*")
+
+	rendered, err = renderPolicyTemplate("write-once-ingest", map[string]string{"bucket": "ingest"})
+	assert.NoError(err)
+	assert.NoError(json.Unmarshal([]byte(rendered.Policy), &policy))
+	assert.Equal("Deny", policy.Statement[2].Effect)
+	assert.Equal([]string{"arn:aws:s3:::ingest/*"}, policy.Statement[2].Resource)
+
+	_, err = renderPolicyTemplate("unknown", nil)
+	assert.ErrorIs(err, ErrPolicyTemplateNotFound)
+	_, err = renderPolicyTemplate("read-only-bucket", nil)
+	assert.ErrorIs(err, ErrInvalidPolicyTemplateParameters)
+	_, err = renderPolicyTemplate("read-only-bucket", map[string]string{"buckets": "Not_A_Bucket"})
+	assert.ErrorIs(err, ErrInvalidPolicyTemplateParameters)
+	_, err = renderPolicyTemplate("read-only-bucket", map[string]string{"buckets": "photos", "prefix": "a"})
+	assert.ErrorIs(err, ErrInvalidPolicyTemplateParameters)
+	_, err = renderPolicyTemplate("write-once-ingest", map[string]string{"bucket": "ingest", "prefix": "uploads/*"})
+	assert.ErrorIs(err, ErrInvalidPolicyTemplateParameters)
+}
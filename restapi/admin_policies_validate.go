@@ -0,0 +1,530 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	policyApi "github.com/minio/console/restapi/operations/policy"
+	"github.com/minio/pkg/bucket/policy/condition"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/minio/pkg/wildcard"
+)
+
+const kmsResourcePrefix = "arn:minio:kms:::"
+
+var (
+	policyFields    = []string{"Version", "ID", "Statement"}
+	statementFields = []string{"Sid", "Effect", "Action", "NotAction", "Resource", "NotResource", "Condition"}
+	// conditionOperators are the condition operators supported by MinIO, without qualifiers nor the IfExists suffix
+	conditionOperators = []string{
+		"StringEquals", "StringNotEquals", "StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase",
+		"StringLike", "StringNotLike", "BinaryEquals", "IpAddress", "NotIpAddress", "Null", "Bool",
+		"NumericEquals", "NumericNotEquals", "NumericLessThan", "NumericLessThanEquals",
+		"NumericGreaterThan", "NumericGreaterThanEquals", "DateEquals", "DateNotEquals",
+		"DateLessThan", "DateLessThanEquals", "DateGreaterThan", "DateGreaterThanEquals",
+	}
+)
+
+func registerPolicyValidationHandlers(api *operations.ConsoleAPI) {
+	// Validate Policy
+	api.PolicyValidatePolicyHandler = policyApi.ValidatePolicyHandlerFunc(func(params policyApi.ValidatePolicyParams, session *models.Principal) middleware.Responder {
+		return policyApi.NewValidatePolicyOK().WithPayload(validatePolicyDocument(*params.Body.Policy))
+	})
+}
+
+// lintedStatement keeps what's needed from a statement to compare it with the rest of the policy
+type lintedStatement struct {
+	path          string
+	effect        string
+	actions       []string
+	resources     []string
+	notAction     bool
+	notResource   bool
+	hasConditions bool
+	raw           map[string]interface{}
+}
+
+type policyLinter struct {
+	lines  map[string]int64
+	issues []*models.PolicyValidationIssue
+}
+
+func (l *policyLinter) add(severity, code, path, format string, args ...interface{}) {
+	l.issues = append(l.issues, &models.PolicyValidationIssue{
+		Severity: severity,
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+		Path:     path,
+		Line:     l.line(path),
+	})
+}
+
+func (l *policyLinter) errorf(code, path, format string, args ...interface{}) {
+	l.add(models.PolicyValidationIssueSeverityError, code, path, format, args...)
+}
+
+func (l *policyLinter) warnf(code, path, format string, args ...interface{}) {
+	l.add(models.PolicyValidationIssueSeverityWarning, code, path, format, args...)
+}
+
+// line returns the line of the closest element to path that was found in the document
+func (l *policyLinter) line(path string) int64 {
+	for {
+		if line, ok := l.lines[path]; ok {
+			return line
+		}
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			return l.lines[""]
+		}
+		path = path[:i]
+	}
+}
+
+func joinPolicyPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// lineAt returns the line of the first value after offset, skipping the separators before it
+func lineAt(data []byte, offset int64) int64 {
+	for offset < int64(len(data)) && strings.ContainsRune(" \t\r\n:,", rune(data[offset])) {
+		offset++
+	}
+	return int64(bytes.Count(data[:offset], []byte("\n"))) + 1
+}
+
+// policyLines maps the path of every element of a JSON document to the line it starts at
+func policyLines(data []byte) map[string]int64 {
+	lines := map[string]int64{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var walk func(path string) error
+	walk = func(path string) error {
+		start := dec.InputOffset()
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		lines[path] = lineAt(data, start)
+		switch tok {
+		case json.Delim('{'):
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				if err := walk(joinPolicyPath(path, fmt.Sprint(key))); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				if err := walk(fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+		}
+		return err
+	}
+	// a document that can't be tokenized is reported by the parser, the lines found so far are still useful
+	_ = walk("")
+	return lines
+}
+
+// policyField looks up a field the way the policy parser does, ignoring the case of its name
+func policyField(object map[string]interface{}, name string) (interface{}, string, bool) {
+	if value, ok := object[name]; ok {
+		return value, name, true
+	}
+	for key, value := range object {
+		if strings.EqualFold(key, name) {
+			return value, key, true
+		}
+	}
+	return nil, "", false
+}
+
+// stringOrList reads the values of a field that may be a single string or a list of strings
+func stringOrList(value interface{}) ([]string, bool) {
+	switch v := value.(type) {
+	case string:
+		return []string{v}, true
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+	return nil, false
+}
+
+func isAdminOrKMSAction(action string) bool {
+	return strings.HasPrefix(action, "admin:") || strings.HasPrefix(action, "kms:")
+}
+
+func isKnownAction(action string) bool {
+	// the admin and KMS actions are listed apart from the S3 ones
+	if iampolicy.Action(action).IsValid() || iampolicy.AdminAction(action).IsValid() || iampolicy.KMSAction(action).IsValid() {
+		return true
+	}
+	if action == "*" {
+		return true
+	}
+	// wildcards can't be checked against the list of actions, only their service can
+	if strings.Contains(action, "*") {
+		for _, service := range []string{"s3:", "admin:", "kms:", "sts:"} {
+			if strings.HasPrefix(action, service) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isKnownConditionOperator(operator string) bool {
+	operator = strings.TrimPrefix(operator, "ForAnyValue:")
+	operator = strings.TrimPrefix(operator, "ForAllValues:")
+	operator = strings.TrimSuffix(operator, "IfExists")
+	return IsElementInArray(conditionOperators, operator)
+}
+
+func (l *policyLinter) lintActions(path string, statement map[string]interface{}, linted *lintedStatement) {
+	value, key, ok := policyField(statement, "Action")
+	if !ok {
+		value, key, ok = policyField(statement, "NotAction")
+		linted.notAction = ok
+	}
+	if !ok {
+		l.errorf("missingAction", path, "statement has neither Action nor NotAction")
+		return
+	}
+	fieldPath := joinPolicyPath(path, key)
+	actions, ok := stringOrList(value)
+	if !ok || len(actions) == 0 {
+		l.errorf("invalidAction", fieldPath, "%s must be a string or a non empty list of strings", key)
+		return
+	}
+	for i, action := range actions {
+		if !isKnownAction(action) {
+			l.errorf("unknownAction", elementPath(fieldPath, value, i), "unknown action %s", action)
+		}
+	}
+	linted.actions = actions
+}
+
+func (l *policyLinter) lintResources(path string, statement map[string]interface{}, linted *lintedStatement) {
+	value, key, ok := policyField(statement, "Resource")
+	if !ok {
+		value, key, ok = policyField(statement, "NotResource")
+		linted.notResource = ok
+	}
+	if !ok {
+		for _, action := range linted.actions {
+			if !isAdminOrKMSAction(action) {
+				l.errorf("missingResource", path, "statement has neither Resource nor NotResource, which %s requires", action)
+				return
+			}
+		}
+		return
+	}
+	fieldPath := joinPolicyPath(path, key)
+	resources, ok := stringOrList(value)
+	if !ok || len(resources) == 0 {
+		l.errorf("invalidResource", fieldPath, "%s must be a string or a non empty list of strings", key)
+		return
+	}
+	for i, resource := range resources {
+		name := strings.TrimPrefix(resource, s3ResourcePrefix)
+		if name == resource {
+			name = strings.TrimPrefix(resource, kmsResourcePrefix)
+		}
+		if name == resource || name == "" || strings.HasPrefix(name, "/") {
+			l.errorf("malformedResource", elementPath(fieldPath, value, i), "malformed resource %q, expected %s<bucket>[/<object>]", resource, s3ResourcePrefix)
+		}
+	}
+	linted.resources = resources
+}
+
+func (l *policyLinter) lintConditions(path string, statement map[string]interface{}, linted *lintedStatement) {
+	value, key, ok := policyField(statement, "Condition")
+	if !ok {
+		return
+	}
+	fieldPath := joinPolicyPath(path, key)
+	operators, ok := value.(map[string]interface{})
+	if !ok {
+		l.errorf("invalidCondition", fieldPath, "Condition must be an object")
+		return
+	}
+	linted.hasConditions = len(operators) > 0
+	for _, operator := range sortedPolicyKeys(operators) {
+		keys := operators[operator]
+		operatorPath := joinPolicyPath(fieldPath, operator)
+		if !isKnownConditionOperator(operator) {
+			l.errorf("unknownConditionOperator", operatorPath, "unknown condition operator %s", operator)
+			continue
+		}
+		keyValues, ok := keys.(map[string]interface{})
+		if !ok {
+			l.errorf("invalidCondition", operatorPath, "condition %s must be an object of keys and values", operator)
+			continue
+		}
+		for _, conditionKey := range sortedPolicyKeys(keyValues) {
+			// the keys taking a variable, like s3:ExistingObjectTag/<tag>, are checked by their name
+			name, _, _ := strings.Cut(conditionKey, "/")
+			if !condition.KeyName(name).ToKey().IsValid() {
+				l.errorf("unsupportedConditionKey", joinPolicyPath(operatorPath, conditionKey), "unsupported condition key %s", conditionKey)
+			}
+		}
+	}
+}
+
+// elementPath is the path of the i-th value of a field that may hold a single value
+func elementPath(fieldPath string, value interface{}, i int) string {
+	if _, isList := value.([]interface{}); isList {
+		return fmt.Sprintf("%s[%d]", fieldPath, i)
+	}
+	return fieldPath
+}
+
+func (l *policyLinter) lintStatement(path string, value interface{}) *lintedStatement {
+	statement, ok := value.(map[string]interface{})
+	if !ok {
+		l.errorf("invalidStatement", path, "statement must be an object")
+		return nil
+	}
+	linted := &lintedStatement{path: path, raw: statement}
+	for _, key := range sortedPolicyKeys(statement) {
+		if !containsFold(statementFields, key) {
+			l.warnf("unknownField", joinPolicyPath(path, key), "%s is not supported in IAM policies and is ignored", key)
+		}
+	}
+	effect, key, ok := policyField(statement, "Effect")
+	if !ok {
+		l.errorf("invalidEffect", path, "statement has no Effect")
+	} else if effect != "Allow" && effect != "Deny" {
+		l.errorf("invalidEffect", joinPolicyPath(path, key), "Effect must be Allow or Deny, got %v", effect)
+	} else {
+		linted.effect = effect.(string)
+	}
+	l.lintActions(path, statement, linted)
+	l.lintResources(path, statement, linted)
+	l.lintConditions(path, statement, linted)
+	return linted
+}
+
+// sortedPolicyKeys returns the keys of an object in a stable order so issues are always reported the same way
+func sortedPolicyKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isOverlyPermissive reports statements that allow every S3 action on every bucket, or every admin action
+func isOverlyPermissive(statement *lintedStatement) bool {
+	if statement.effect != "Allow" || statement.notAction || statement.notResource || statement.hasConditions {
+		return false
+	}
+	for _, action := range statement.actions {
+		switch action {
+		case "admin:*":
+			return true
+		case "*", "s3:*":
+			for _, resource := range statement.resources {
+				if resource == s3ResourcePrefix+"*" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// coversAll reports whether every value is matched by at least one of the patterns
+func coversAll(patterns, values []string) bool {
+	for _, value := range values {
+		covered := false
+		for _, pattern := range patterns {
+			if wildcard.Match(pattern, value) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// deniedBy reports whether an Allow statement never applies because an unconditional Deny covers all of it
+func deniedBy(allow, deny *lintedStatement) bool {
+	if allow.effect != "Allow" || deny.effect != "Deny" || deny.hasConditions {
+		return false
+	}
+	if allow.notAction || deny.notAction || allow.notResource || deny.notResource {
+		return false
+	}
+	if !coversAll(deny.actions, allow.actions) {
+		return false
+	}
+	// statements with only admin actions have no resources to compare
+	if len(allow.resources) == 0 {
+		return true
+	}
+	return coversAll(deny.resources, allow.resources)
+}
+
+func (l *policyLinter) lintStatements(statements []*lintedStatement) {
+	for i, statement := range statements {
+		if isOverlyPermissive(statement) {
+			l.warnf("overlyPermissive", statement.path, "statement grants unrestricted access, consider limiting its actions and resources")
+		}
+		for j, other := range statements {
+			if i == j {
+				continue
+			}
+			if deniedBy(statement, other) {
+				l.warnf("unreachableStatement", statement.path, "statement never applies, %s denies everything it allows", other.path)
+				break
+			}
+			if j < i && statement.effect != "" && reflect.DeepEqual(statement.raw, other.raw) {
+				l.warnf("duplicateStatement", statement.path, "statement is a duplicate of %s", other.path)
+				break
+			}
+		}
+	}
+}
+
+// validatePolicyDocument lints an IAM policy document, errors make MinIO reject the policy
+// and warnings point at statements that probably don't do what was intended
+func validatePolicyDocument(document string) *models.PolicyValidationResult {
+	data := []byte(document)
+	l := &policyLinter{lines: policyLines(data)}
+	result := &models.PolicyValidationResult{}
+
+	var policy interface{}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		issue := &models.PolicyValidationIssue{
+			Severity: models.PolicyValidationIssueSeverityError,
+			Code:     "invalidJSON",
+			Message:  err.Error(),
+			Line:     1,
+		}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) && syntaxErr.Offset > 0 {
+			// the offset is right after the character that couldn't be parsed
+			issue.Line = int64(bytes.Count(data[:syntaxErr.Offset-1], []byte("\n"))) + 1
+		}
+		result.Issues = []*models.PolicyValidationIssue{issue}
+		return result
+	}
+	root, ok := policy.(map[string]interface{})
+	if !ok {
+		l.errorf("invalidPolicy", "", "policy must be a JSON object")
+	} else {
+		l.lintPolicy(root)
+	}
+	// anything the checks above miss is still reported as MinIO would
+	if !hasPolicyErrors(l.issues) {
+		if _, err := iampolicy.ParseConfig(bytes.NewReader(data)); err != nil {
+			l.errorf("invalidPolicy", "", "%s", err.Error())
+		}
+	}
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		return l.issues[i].Line < l.issues[j].Line
+	})
+	result.Issues = l.issues
+	result.Valid = !hasPolicyErrors(l.issues)
+	return result
+}
+
+func (l *policyLinter) lintPolicy(root map[string]interface{}) {
+	for _, key := range sortedPolicyKeys(root) {
+		if !containsFold(policyFields, key) {
+			l.warnf("unknownField", key, "%s is not supported in IAM policies and is ignored", key)
+		}
+	}
+	if version, key, ok := policyField(root, "Version"); ok && version != iampolicy.DefaultVersion {
+		l.errorf("invalidVersion", key, "unsupported policy version %v, use %s", version, iampolicy.DefaultVersion)
+	}
+	value, key, ok := policyField(root, "Statement")
+	if !ok {
+		l.errorf("missingStatement", "", "policy has no Statement")
+		return
+	}
+	var statements []*lintedStatement
+	switch v := value.(type) {
+	case []interface{}:
+		if len(v) == 0 {
+			l.errorf("missingStatement", key, "policy has no statements")
+		}
+		for i, statement := range v {
+			if linted := l.lintStatement(fmt.Sprintf("%s[%d]", key, i), statement); linted != nil {
+				statements = append(statements, linted)
+			}
+		}
+	default:
+		// MinIO only reads lists of statements, the statement is still checked to report everything at once
+		l.errorf("invalidStatement", key, "Statement must be a list of statements")
+		if linted := l.lintStatement(key, v); linted != nil {
+			statements = append(statements, linted)
+		}
+	}
+	l.lintStatements(statements)
+}
+
+func hasPolicyErrors(issues []*models.PolicyValidationIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == models.PolicyValidationIssueSeverityError {
+			return true
+		}
+	}
+	return false
+}
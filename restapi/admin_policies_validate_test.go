@@ -0,0 +1,107 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePolicyDocument(t *testing.T) {
+	assert := assert.New(t)
+	policy := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:*"],
+      "Resource": ["arn:aws:s3:::*"]
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:Fly"],
+      "Resource": ["arn:aws:s3:::data/private/*", "data/*"],
+      "Condition": {"IpAddres": {"aws:SourceIp": "10.0.0.0/8"}, "StringLike": {"s3:foo": "x"}}
+    },
+    {
+      "Effect": "Deny",
+      "Action": ["s3:Get*"],
+      "Resource": ["arn:aws:s3:::data/*"]
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::data/reports/*",
+      "Principal": "*"
+    },
+    {"Effect": "Allow", "Action": "admin:ServerInfo"},
+    {"Effect": "Maybe", "Action": "s3:GetObject"}
+  ]
+}`
+	result := validatePolicyDocument(policy)
+	assert.False(result.Valid)
+	type issue struct {
+		severity, code, path string
+		line                 int64
+	}
+	var issues []issue
+	for _, i := range result.Issues {
+		issues = append(issues, issue{i.Severity, i.Code, i.Path, i.Line})
+	}
+	warning, err := models.PolicyValidationIssueSeverityWarning, models.PolicyValidationIssueSeverityError
+	assert.Equal([]issue{
+		{warning, "overlyPermissive", "Statement[0]", 4},
+		{err, "unknownAction", "Statement[1].Action[1]", 11},
+		{err, "malformedResource", "Statement[1].Resource[1]", 12},
+		{err, "unknownConditionOperator", "Statement[1].Condition.IpAddres", 13},
+		{err, "unsupportedConditionKey", "Statement[1].Condition.StringLike.s3:foo", 13},
+		{warning, "unreachableStatement", "Statement[3]", 20},
+		{warning, "unknownField", "Statement[3].Principal", 24},
+		{err, "invalidEffect", "Statement[5].Effect", 27},
+		{err, "missingResource", "Statement[5]", 27},
+	}, issues)
+
+	result = validatePolicyDocument(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]},{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::data/*"]}]}`)
+	assert.True(result.Valid)
+	assert.Len(result.Issues, 1)
+	assert.Equal("duplicateStatement", result.Issues[0].Code)
+
+	result = validatePolicyDocument(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::data/*"}]}`)
+	assert.True(result.Valid)
+	assert.Empty(result.Issues)
+
+	result = validatePolicyDocument(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::data/*","Condition":{"StringEquals":{"aws:username":"alice","s3:ExistingObjectTag/team":"ops"}}}]}`)
+	assert.True(result.Valid)
+	assert.Empty(result.Issues)
+
+	result = validatePolicyDocument(`{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:Fly","Resource":"arn:aws:s3:::data/*"}}`)
+	assert.False(result.Valid)
+	assert.Equal("invalidStatement", result.Issues[0].Code)
+	assert.Equal("unknownAction", result.Issues[1].Code)
+	assert.Equal("Statement.Action", result.Issues[1].Path)
+
+	result = validatePolicyDocument("{\n \"Statement\": [\n  {\"Effect\": \"Allow\",}\n ]\n}")
+	assert.False(result.Valid)
+	assert.Equal("invalidJSON", result.Issues[0].Code)
+	assert.Equal(int64(3), result.Issues[0].Line)
+
+	for _, policy := range []string{`[]`, `{"Version":"2012-10-17"}`, `{"Version":"2023-01-01","Statement":[]}`} {
+		assert.False(validatePolicyDocument(policy).Valid, policy)
+	}
+}
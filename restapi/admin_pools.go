@@ -0,0 +1,303 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+// poolCapacity is the raw space of the drives of a pool
+type poolCapacity struct {
+	total     int64
+	used      int64
+	available int64
+}
+
+func registerPoolHandlers(api *operations.ConsoleAPI) {
+	// list the pools
+	api.SystemListPoolsHandler = systemApi.ListPoolsHandlerFunc(func(params systemApi.ListPoolsParams, session *models.Principal) middleware.Responder {
+		pools, err := getListPoolsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListPoolsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewListPoolsOK().WithPayload(pools)
+	})
+	// status of a pool
+	api.SystemGetPoolStatusHandler = systemApi.GetPoolStatusHandlerFunc(func(params systemApi.GetPoolStatusParams, session *models.Principal) middleware.Responder {
+		pool, err := getPoolStatusResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetPoolStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetPoolStatusOK().WithPayload(pool)
+	})
+	// start decommissioning a pool
+	api.SystemStartPoolDecommissionHandler = systemApi.StartPoolDecommissionHandlerFunc(func(params systemApi.StartPoolDecommissionParams, session *models.Principal) middleware.Responder {
+		pool, err := getStartPoolDecommissionResponse(session, params)
+		if err != nil {
+			return systemApi.NewStartPoolDecommissionDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewStartPoolDecommissionCreated().WithPayload(pool)
+	})
+	// cancel the decommission of a pool
+	api.SystemCancelPoolDecommissionHandler = systemApi.CancelPoolDecommissionHandlerFunc(func(params systemApi.CancelPoolDecommissionParams, session *models.Principal) middleware.Responder {
+		if err := getCancelPoolDecommissionResponse(session, params); err != nil {
+			return systemApi.NewCancelPoolDecommissionDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewCancelPoolDecommissionNoContent()
+	})
+}
+
+// poolCapacities sums the space of the drives of every pool
+func poolCapacities(info madmin.InfoMessage) map[int]poolCapacity {
+	capacities := map[int]poolCapacity{}
+	for _, server := range info.Servers {
+		for _, drive := range server.Disks {
+			capacity := capacities[drive.PoolIndex]
+			capacity.total += int64(drive.TotalSpace)
+			capacity.used += int64(drive.UsedSpace)
+			capacity.available += int64(drive.AvailableSpace)
+			capacities[drive.PoolIndex] = capacity
+		}
+	}
+	return capacities
+}
+
+// poolDecommissionActive tells whether a pool is being drained, or was drained, so it no longer takes new data
+func poolDecommissionActive(pool madmin.PoolStatus) bool {
+	return pool.Decommission != nil && !pool.Decommission.Canceled && !pool.Decommission.Failed
+}
+
+// poolDecommissionToModel converts the decommission progress reported by MinIO, the sizes are the free space
+// of the pool so the data still to move is what isn't free yet
+func poolDecommissionToModel(info *madmin.PoolDecommissionInfo) *models.PoolDecommission {
+	decommission := &models.PoolDecommission{
+		Status:      "active",
+		TotalSize:   info.TotalSize,
+		StartSize:   info.StartSize,
+		CurrentSize: info.CurrentSize,
+	}
+	// the data moved out of the pool is the space freed since the decommission started
+	if moved := info.CurrentSize - info.StartSize; moved > 0 {
+		decommission.BytesMoved = moved
+	}
+	if !info.StartTime.IsZero() {
+		decommission.StartTime = info.StartTime.Format(time.RFC3339)
+	}
+	switch {
+	case info.Complete:
+		decommission.Status = "complete"
+		decommission.ProgressPercent = 100
+		return decommission
+	case info.Failed:
+		decommission.Status = "failed"
+	case info.Canceled:
+		decommission.Status = "canceled"
+	}
+	if pending := info.TotalSize - info.CurrentSize; pending > 0 {
+		decommission.BytesPending = pending
+	}
+	if usedAtStart := info.TotalSize - info.StartSize; usedAtStart > 0 {
+		progress := float64(info.CurrentSize-info.StartSize) / float64(usedAtStart) * 100
+		decommission.ProgressPercent = math.Round(math.Max(0, math.Min(100, progress))*10) / 10
+	}
+	return decommission
+}
+
+func poolStatusToModel(pool madmin.PoolStatus, capacity poolCapacity) *models.PoolStatus {
+	status := &models.PoolStatus{
+		ID:             swag.Int64(int64(pool.ID)),
+		CmdLine:        pool.CmdLine,
+		TotalSpace:     capacity.total,
+		UsedSpace:      capacity.used,
+		AvailableSpace: capacity.available,
+	}
+	if !pool.LastUpdate.IsZero() {
+		status.LastUpdate = pool.LastUpdate.Format(time.RFC3339)
+	}
+	if pool.Decommission != nil {
+		status.Decommission = poolDecommissionToModel(pool.Decommission)
+	}
+	return status
+}
+
+// getPools returns the status of the pools along with their capacity
+func getPools(ctx context.Context, client MinioAdmin) ([]madmin.PoolStatus, map[int]poolCapacity, error) {
+	pools, err := client.listPoolsStatus(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pools, poolCapacities(info), nil
+}
+
+// findPool returns the pool with the id of the request
+func findPool(pools []madmin.PoolStatus, id string) (madmin.PoolStatus, error) {
+	poolID, err := strconv.Atoi(id)
+	if err == nil {
+		for _, pool := range pools {
+			if pool.ID == poolID {
+				return pool, nil
+			}
+		}
+	}
+	return madmin.PoolStatus{}, fmt.Errorf("%w: %s", ErrPoolNotFound, id)
+}
+
+// checkDecommissionCapacity refuses the decommission of a pool when the pools still taking data don't have
+// enough free space for what it holds
+func checkDecommissionCapacity(pools []madmin.PoolStatus, capacities map[int]poolCapacity, target madmin.PoolStatus) error {
+	var available int64
+	remaining := 0
+	for _, pool := range pools {
+		if pool.ID == target.ID || poolDecommissionActive(pool) {
+			continue
+		}
+		remaining++
+		available += capacities[pool.ID].available
+	}
+	if remaining == 0 {
+		return fmt.Errorf("%w: no other pool would be left to hold the data", ErrInsufficientPoolCapacity)
+	}
+	if required := capacities[target.ID].used; required > available {
+		return fmt.Errorf("%w: %d bytes to move, %d bytes free", ErrInsufficientPoolCapacity, required, available)
+	}
+	return nil
+}
+
+func listPools(ctx context.Context, client MinioAdmin) (*models.PoolList, error) {
+	pools, capacities, err := getPools(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	list := &models.PoolList{Pools: []*models.PoolStatus{}}
+	for _, pool := range pools {
+		list.Pools = append(list.Pools, poolStatusToModel(pool, capacities[pool.ID]))
+	}
+	return list, nil
+}
+
+func getPoolStatus(ctx context.Context, client MinioAdmin, id string) (*models.PoolStatus, error) {
+	pools, capacities, err := getPools(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := findPool(pools, id)
+	if err != nil {
+		return nil, err
+	}
+	return poolStatusToModel(pool, capacities[pool.ID]), nil
+}
+
+// startPoolDecommission starts draining a pool once the other pools are known to have room for its data
+func startPoolDecommission(ctx context.Context, client MinioAdmin, id string) (*models.PoolStatus, error) {
+	pools, capacities, err := getPools(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := findPool(pools, id)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkDecommissionCapacity(pools, capacities, pool); err != nil {
+		return nil, err
+	}
+	if err = client.decommissionPool(ctx, pool.CmdLine); err != nil {
+		return nil, err
+	}
+	return getPoolStatus(ctx, client, id)
+}
+
+func cancelPoolDecommission(ctx context.Context, client MinioAdmin, id string) error {
+	pools, err := client.listPoolsStatus(ctx)
+	if err != nil {
+		return err
+	}
+	pool, err := findPool(pools, id)
+	if err != nil {
+		return err
+	}
+	return client.cancelDecommissionPool(ctx, pool.CmdLine)
+}
+
+func getListPoolsResponse(session *models.Principal, params systemApi.ListPoolsParams) (*models.PoolList, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	pools, err := listPools(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return pools, nil
+}
+
+func getPoolStatusResponse(session *models.Principal, params systemApi.GetPoolStatusParams) (*models.PoolStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	pool, err := getPoolStatus(ctx, AdminClient{Client: mAdmin}, params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return pool, nil
+}
+
+func getStartPoolDecommissionResponse(session *models.Principal, params systemApi.StartPoolDecommissionParams) (*models.PoolStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	pool, err := startPoolDecommission(ctx, AdminClient{Client: mAdmin}, params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return pool, nil
+}
+
+func getCancelPoolDecommissionResponse(session *models.Principal, params systemApi.CancelPoolDecommissionParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err := cancelPoolDecommission(ctx, AdminClient{Client: mAdmin}, params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
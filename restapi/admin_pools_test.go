@@ -0,0 +1,148 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockPools mocks a deployment with pools of a single server and drive, used and free space in bytes
+func mockPools(pools []madmin.PoolStatus, used, available map[int]uint64) {
+	minioListPoolsStatusMock = func(ctx context.Context) ([]madmin.PoolStatus, error) {
+		return pools, nil
+	}
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		info := madmin.InfoMessage{}
+		for _, pool := range pools {
+			info.Servers = append(info.Servers, madmin.ServerProperties{Disks: []madmin.Disk{{
+				PoolIndex:      pool.ID,
+				TotalSpace:     used[pool.ID] + available[pool.ID],
+				UsedSpace:      used[pool.ID],
+				AvailableSpace: available[pool.ID],
+			}}})
+		}
+		return info, nil
+	}
+}
+
+func Test_poolDecommissionToModel(t *testing.T) {
+	assert := assert.New(t)
+
+	decommission := poolDecommissionToModel(&madmin.PoolDecommissionInfo{TotalSize: 1000, StartSize: 200, CurrentSize: 600})
+	assert.Equal("active", decommission.Status)
+	assert.Equal(int64(400), decommission.BytesPending)
+	assert.Equal(int64(400), decommission.BytesMoved)
+	assert.Equal(50.0, decommission.ProgressPercent)
+
+	decommission = poolDecommissionToModel(&madmin.PoolDecommissionInfo{TotalSize: 1000, StartSize: 200, CurrentSize: 300, Canceled: true})
+	assert.Equal("canceled", decommission.Status)
+	assert.Equal(12.5, decommission.ProgressPercent)
+
+	decommission = poolDecommissionToModel(&madmin.PoolDecommissionInfo{TotalSize: 1000, StartSize: 200, CurrentSize: 1000, Complete: true})
+	assert.Equal("complete", decommission.Status)
+	assert.Equal(100.0, decommission.ProgressPercent)
+	assert.Zero(decommission.BytesPending)
+}
+
+func Test_listPools(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	mockPools([]madmin.PoolStatus{
+		{ID: 0, CmdLine: "http://server{1...4}/disk{1...4}"},
+		{ID: 1, CmdLine: "http://server{5...8}/disk{1...4}", Decommission: &madmin.PoolDecommissionInfo{TotalSize: 100, StartSize: 50, CurrentSize: 75}},
+	}, map[int]uint64{0: 300, 1: 25}, map[int]uint64{0: 700, 1: 75})
+
+	pools, err := listPools(ctx, adminClient)
+	assert.NoError(err)
+	assert.Len(pools.Pools, 2)
+	assert.Equal(int64(0), *pools.Pools[0].ID)
+	assert.Equal(int64(1000), pools.Pools[0].TotalSpace)
+	assert.Nil(pools.Pools[0].Decommission)
+	assert.Equal(50.0, pools.Pools[1].Decommission.ProgressPercent)
+
+	pool, err := getPoolStatus(ctx, adminClient, "1")
+	assert.NoError(err)
+	assert.Equal("http://server{5...8}/disk{1...4}", pool.CmdLine)
+
+	_, err = getPoolStatus(ctx, adminClient, "2")
+	assert.ErrorIs(err, ErrPoolNotFound)
+	_, err = getPoolStatus(ctx, adminClient, "first")
+	assert.ErrorIs(err, ErrPoolNotFound)
+}
+
+func Test_startPoolDecommission(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	var decommissioned string
+	minioDecommissionPoolMock = func(ctx context.Context, pool string) error {
+		decommissioned = pool
+		return nil
+	}
+	pools := []madmin.PoolStatus{
+		{ID: 0, CmdLine: "http://server{1...4}/disk{1...4}"},
+		{ID: 1, CmdLine: "http://server{5...8}/disk{1...4}"},
+		{ID: 2, CmdLine: "http://server{9...12}/disk{1...4}"},
+	}
+
+	// the other pools have room for the data of the first one
+	mockPools(pools, map[int]uint64{0: 500, 1: 100, 2: 100}, map[int]uint64{0: 500, 1: 300, 2: 300})
+	_, err := startPoolDecommission(ctx, adminClient, "0")
+	assert.NoError(err)
+	assert.Equal("http://server{1...4}/disk{1...4}", decommissioned)
+
+	// they don't
+	decommissioned = ""
+	mockPools(pools, map[int]uint64{0: 700, 1: 100, 2: 100}, map[int]uint64{0: 300, 1: 300, 2: 300})
+	_, err = startPoolDecommission(ctx, adminClient, "0")
+	assert.ErrorIs(err, ErrInsufficientPoolCapacity)
+	assert.Empty(decommissioned)
+
+	// a pool being decommissioned doesn't take data
+	pools[2].Decommission = &madmin.PoolDecommissionInfo{TotalSize: 400, StartSize: 300, CurrentSize: 300}
+	mockPools(pools, map[int]uint64{0: 500, 1: 100, 2: 100}, map[int]uint64{0: 500, 1: 300, 2: 300})
+	_, err = startPoolDecommission(ctx, adminClient, "0")
+	assert.ErrorIs(err, ErrInsufficientPoolCapacity)
+
+	// the last pool taking data can't be decommissioned
+	mockPools(pools[:1], map[int]uint64{0: 0}, map[int]uint64{0: 1000})
+	_, err = startPoolDecommission(ctx, adminClient, "0")
+	assert.ErrorIs(err, ErrInsufficientPoolCapacity)
+}
+
+func Test_cancelPoolDecommission(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	mockPools([]madmin.PoolStatus{{ID: 0, CmdLine: "http://server{1...4}/disk{1...4}"}}, nil, nil)
+	var canceled string
+	minioCancelDecommissionPoolMock = func(ctx context.Context, pool string) error {
+		canceled = pool
+		return nil
+	}
+	assert.NoError(cancelPoolDecommission(ctx, adminClient, "0"))
+	assert.Equal("http://server{1...4}/disk{1...4}", canceled)
+	assert.ErrorIs(cancelPoolDecommission(ctx, adminClient, "3"), ErrPoolNotFound)
+}
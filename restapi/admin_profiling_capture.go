@@ -0,0 +1,233 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	profileApi "github.com/minio/console/restapi/operations/profile"
+	"github.com/minio/madmin-go/v2"
+)
+
+// profilerTypes are the profilers MinIO nodes can run
+var profilerTypes = map[madmin.ProfilerType]bool{
+	madmin.ProfilerCPU:        true,
+	madmin.ProfilerMEM:        true,
+	madmin.ProfilerBlock:      true,
+	madmin.ProfilerMutex:      true,
+	madmin.ProfilerGoroutines: true,
+	madmin.ProfilerThreads:    true,
+	madmin.ProfilerTrace:      true,
+}
+
+// profilingDownloadTimeout bounds the download of the profiles when the profiling runs out of time
+const profilingDownloadTimeout = 5 * time.Minute
+
+func registerProfilingHandlers(api *operations.ConsoleAPI) {
+	// start profiling the cluster nodes
+	api.ProfileProfilingStartHandler = profileApi.ProfilingStartHandlerFunc(func(params profileApi.ProfilingStartParams, session *models.Principal) middleware.Responder {
+		results, err := getProfilingStartResponse(session, params)
+		if err != nil {
+			return profileApi.NewProfilingStartDefault(int(err.Code)).WithPayload(err)
+		}
+		return profileApi.NewProfilingStartCreated().WithPayload(results)
+	})
+	// stop profiling and download the profiles
+	api.ProfileProfilingStopHandler = profileApi.ProfilingStopHandlerFunc(func(params profileApi.ProfilingStopParams, session *models.Principal) middleware.Responder {
+		resp, err := getProfilingStopResponse(session, params)
+		if err != nil {
+			return profileApi.NewProfilingStopDefault(int(err.Code)).WithPayload(err)
+		}
+		return resp
+	})
+}
+
+// parseProfilerTypes validates a comma separated list of profilers
+func parseProfilerTypes(types string) (madmin.ProfilerType, error) {
+	var profilers []string
+	for _, t := range strings.Split(types, ",") {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+		if !profilerTypes[madmin.ProfilerType(t)] {
+			return "", fmt.Errorf("%w: unknown profiler %q", ErrInvalidProfilingRequest, t)
+		}
+		profilers = append(profilers, t)
+	}
+	if len(profilers) == 0 {
+		return "", fmt.Errorf("%w: no profiler selected", ErrInvalidProfilingRequest)
+	}
+	return madmin.ProfilerType(strings.Join(profilers, ",")), nil
+}
+
+// profilingCapture keeps track of the profiling started through the API. MinIO runs a single profiling
+// for the whole cluster, so does Console: the profiles are downloaded when the profiling is stopped, or
+// when it runs for longer than allowed and kept until they are requested.
+type profilingCapture struct {
+	mu      sync.Mutex
+	client  MinioAdmin
+	running bool
+	// generation identifies the running profiling so the timer of a previous one doesn't stop it
+	generation int
+	timer      *time.Timer
+	profiles   []byte
+	err        error
+}
+
+var consoleProfiling = &profilingCapture{}
+
+func (p *profilingCapture) start(ctx context.Context, client MinioAdmin, types madmin.ProfilerType, duration time.Duration) (*models.StartProfilingList, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	results, err := client.startProfiling(ctx, types)
+	if err != nil {
+		return nil, err
+	}
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	p.generation++
+	generation := p.generation
+	p.client = client
+	p.running = true
+	p.profiles, p.err = nil, nil
+	p.timer = time.AfterFunc(duration, func() { p.expire(generation) })
+
+	list := &models.StartProfilingList{
+		StartResults: []*models.StartProfilingItem{},
+		StopsAt:      time.Now().Add(duration).UTC().Format(time.RFC3339),
+	}
+	for _, result := range results {
+		list.StartResults = append(list.StartResults, &models.StartProfilingItem{
+			NodeName: result.NodeName,
+			Success:  result.Success,
+			Error:    result.Error,
+		})
+	}
+	list.Total = int64(len(list.StartResults))
+	return list, nil
+}
+
+// expire stops a profiling that ran for the maximum duration, keeping its profiles for the next stop
+func (p *profilingCapture) expire(generation int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.running || p.generation != generation {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), profilingDownloadTimeout)
+	defer cancel()
+	p.running = false
+	p.profiles, p.err = downloadProfiles(ctx, p.client)
+	if p.err != nil {
+		LogError("Unable to stop the profiling after %s: %v", getConsoleProfilingMaxDuration(), p.err)
+	}
+}
+
+// stop returns the zipped profiles of the running profiling or of the one that ran out of time
+func (p *profilingCapture) stop(ctx context.Context, client MinioAdmin) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.running {
+		p.timer.Stop()
+		p.running = false
+		return downloadProfiles(ctx, client)
+	}
+	if p.profiles == nil && p.err == nil {
+		return nil, ErrProfilingNotStarted
+	}
+	profiles, err := p.profiles, p.err
+	p.profiles, p.err = nil, nil
+	return profiles, err
+}
+
+func downloadProfiles(ctx context.Context, client MinioAdmin) ([]byte, error) {
+	zippedData, err := client.stopProfiling(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer zippedData.Close()
+	return io.ReadAll(zippedData)
+}
+
+// profilingDuration returns for how long the profiling runs, the maximum duration when none is requested
+func profilingDuration(seconds int64) (time.Duration, error) {
+	maxDuration := getConsoleProfilingMaxDuration()
+	if seconds == 0 {
+		return maxDuration, nil
+	}
+	duration := time.Duration(seconds) * time.Second
+	if seconds < 0 || duration > maxDuration {
+		return 0, fmt.Errorf("%w: the duration has to be between 1 second and %s", ErrInvalidProfilingRequest, maxDuration)
+	}
+	return duration, nil
+}
+
+func getProfilingStartResponse(session *models.Principal, params profileApi.ProfilingStartParams) (*models.StartProfilingList, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	types, err := parseProfilerTypes(swag.StringValue(params.Body.Type))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	duration, err := profilingDuration(params.Body.Duration)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	results, err := consoleProfiling.start(ctx, AdminClient{Client: mAdmin}, types, duration)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return results, nil
+}
+
+func getProfilingStopResponse(session *models.Principal, params profileApi.ProfilingStopParams) (middleware.Responder, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	profiles, err := consoleProfiling.stop(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"profile-%s.zip\"", time.Now().UTC().Format("20060102150405")))
+		w.WriteHeader(http.StatusCreated)
+		if _, err := w.Write(profiles); err != nil {
+			LogError("Unable to write the profiling data: %v", err)
+		}
+	}), nil
+}
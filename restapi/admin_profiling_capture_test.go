@@ -0,0 +1,113 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseProfilerTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	types, err := parseProfilerTypes("cpu, MEM,goroutines")
+	assert.NoError(err)
+	assert.Equal(madmin.ProfilerType("cpu,mem,goroutines"), types)
+
+	_, err = parseProfilerTypes("cpu,heap")
+	assert.ErrorIs(err, ErrInvalidProfilingRequest)
+	_, err = parseProfilerTypes(" , ")
+	assert.ErrorIs(err, ErrInvalidProfilingRequest)
+}
+
+func Test_profilingDuration(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleProfilingMaxDuration, "2m")
+
+	duration, err := profilingDuration(0)
+	assert.NoError(err)
+	assert.Equal(2*time.Minute, duration)
+
+	duration, err = profilingDuration(30)
+	assert.NoError(err)
+	assert.Equal(30*time.Second, duration)
+
+	_, err = profilingDuration(121)
+	assert.ErrorIs(err, ErrInvalidProfilingRequest)
+	_, err = profilingDuration(-1)
+	assert.ErrorIs(err, ErrInvalidProfilingRequest)
+}
+
+func Test_profilingCapture(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+	capture := &profilingCapture{}
+
+	var profilers madmin.ProfilerType
+	minioStartProfiling = func(profiler madmin.ProfilerType) ([]madmin.StartProfilingResult, error) {
+		profilers = profiler
+		return []madmin.StartProfilingResult{
+			{NodeName: "http://node1:9000/", Success: true},
+			{NodeName: "http://node2:9000/", Error: "profiler already running"},
+		}, nil
+	}
+	stopped := 0
+	minioStopProfiling = func() (io.ReadCloser, error) {
+		stopped++
+		return &ClosingBuffer{bytes.NewBufferString("zipped profiles")}, nil
+	}
+
+	_, err := capture.stop(ctx, client)
+	assert.ErrorIs(err, ErrProfilingNotStarted)
+
+	list, err := capture.start(ctx, client, "cpu,mutex", time.Hour)
+	assert.NoError(err)
+	assert.Equal(madmin.ProfilerType("cpu,mutex"), profilers)
+	assert.Equal(int64(2), list.Total)
+	assert.Equal("profiler already running", list.StartResults[1].Error)
+	assert.NotEmpty(list.StopsAt)
+
+	profiles, err := capture.stop(ctx, client)
+	assert.NoError(err)
+	assert.Equal("zipped profiles", string(profiles))
+	assert.Equal(1, stopped)
+
+	// a profiling running for longer than allowed is stopped and its profiles kept for the next stop
+	_, err = capture.start(ctx, client, "mem", 10*time.Millisecond)
+	assert.NoError(err)
+	time.Sleep(100 * time.Millisecond)
+	capture.mu.Lock()
+	running := capture.running
+	capture.mu.Unlock()
+	assert.False(running)
+	assert.Equal(2, stopped)
+
+	profiles, err = capture.stop(ctx, client)
+	assert.NoError(err)
+	assert.Equal("zipped profiles", string(profiles))
+	assert.Equal(2, stopped)
+
+	_, err = capture.stop(ctx, client)
+	assert.ErrorIs(err, ErrProfilingNotStarted)
+}
@@ -0,0 +1,196 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+// rebalanceNotStartedCode is the error MinIO returns for the status of a rebalance that never started
+const rebalanceNotStartedCode = "XMinioAdminRebalanceNotStarted"
+
+// statuses of a pool in a rebalance
+const (
+	rebalancePoolStarted   = "Started"
+	rebalancePoolCompleted = "Completed"
+)
+
+func registerRebalanceHandlers(api *operations.ConsoleAPI) {
+	// status of the rebalance
+	api.SystemGetRebalanceStatusHandler = systemApi.GetRebalanceStatusHandlerFunc(func(params systemApi.GetRebalanceStatusParams, session *models.Principal) middleware.Responder {
+		status, err := getRebalanceStatusResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetRebalanceStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetRebalanceStatusOK().WithPayload(status)
+	})
+	// start rebalancing the pools
+	api.SystemStartRebalanceHandler = systemApi.StartRebalanceHandlerFunc(func(params systemApi.StartRebalanceParams, session *models.Principal) middleware.Responder {
+		status, err := getStartRebalanceResponse(session, params)
+		if err != nil {
+			return systemApi.NewStartRebalanceDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewStartRebalanceCreated().WithPayload(status)
+	})
+	// stop the running rebalance
+	api.SystemStopRebalanceHandler = systemApi.StopRebalanceHandlerFunc(func(params systemApi.StopRebalanceParams, session *models.Principal) middleware.Responder {
+		if err := getStopRebalanceResponse(session, params); err != nil {
+			return systemApi.NewStopRebalanceDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewStopRebalanceNoContent()
+	})
+}
+
+// rebalancePoolProgress estimates the progress of a pool from the time it has been rebalancing and the time
+// MinIO expects it still needs
+func rebalancePoolProgress(pool madmin.RebalancePoolStatus) float64 {
+	if pool.Status == rebalancePoolCompleted {
+		return 100
+	}
+	total := pool.Progress.Elapsed + pool.Progress.ETA
+	if total <= 0 {
+		return 0
+	}
+	return math.Round(float64(pool.Progress.Elapsed)/float64(total)*1000) / 10
+}
+
+// rebalanceStatusToModel converts the status reported by MinIO, the overall progress is the average of the
+// pools taking part in the rebalance and the estimated completion the one of the slowest pool
+func rebalanceStatusToModel(status madmin.RebalanceStatus, now time.Time) *models.RebalanceStatus {
+	res := &models.RebalanceStatus{ID: status.ID, Pools: []*models.RebalancePoolStatus{}}
+	if !status.StoppedAt.IsZero() {
+		res.StoppedAt = status.StoppedAt.Format(time.RFC3339)
+	}
+	var participating int
+	var progress float64
+	var eta time.Duration
+	for _, pool := range status.Pools {
+		poolStatus := &models.RebalancePoolStatus{
+			ID:             swag.Int64(int64(pool.ID)),
+			Status:         pool.Status,
+			UsedPercent:    math.Round(pool.Used*10) / 10,
+			Objects:        int64(pool.Progress.NumObjects),
+			Versions:       int64(pool.Progress.NumVersions),
+			Bytes:          int64(pool.Progress.Bytes),
+			Bucket:         pool.Progress.Bucket,
+			Object:         pool.Progress.Object,
+			ElapsedSeconds: int64(pool.Progress.Elapsed.Seconds()),
+		}
+		res.Pools = append(res.Pools, poolStatus)
+		// pools that are already balanced don't move any data
+		if pool.Status == "" {
+			continue
+		}
+		poolStatus.ProgressPercent = rebalancePoolProgress(pool)
+		participating++
+		progress += poolStatus.ProgressPercent
+		if pool.Status == rebalancePoolStarted {
+			res.Running = true
+			poolStatus.EtaSeconds = int64(pool.Progress.ETA.Seconds())
+			if pool.Progress.ETA > eta {
+				eta = pool.Progress.ETA
+			}
+		}
+	}
+	if participating > 0 {
+		res.ProgressPercent = math.Round(progress/float64(participating)*10) / 10
+	}
+	if res.Running {
+		res.EtaSeconds = int64(eta.Seconds())
+		res.EstimatedCompletion = now.Add(eta).UTC().Format(time.RFC3339)
+	}
+	return res
+}
+
+// getRebalanceStatus returns the status of the last rebalance, an empty status when there was none
+func getRebalanceStatus(ctx context.Context, client MinioAdmin) (*models.RebalanceStatus, error) {
+	status, err := client.rebalanceStatus(ctx)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code == rebalanceNotStartedCode {
+			return &models.RebalanceStatus{Pools: []*models.RebalancePoolStatus{}}, nil
+		}
+		return nil, err
+	}
+	return rebalanceStatusToModel(status, time.Now()), nil
+}
+
+func getRebalanceStatusResponse(session *models.Principal, params systemApi.GetRebalanceStatusParams) (*models.RebalanceStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	status, err := getRebalanceStatus(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return status, nil
+}
+
+// startRebalance starts rebalancing the pools and returns the initial status of the rebalance
+func startRebalance(ctx context.Context, client MinioAdmin) (*models.RebalanceStatus, error) {
+	id, err := client.startRebalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err := getRebalanceStatus(ctx, client)
+	if err != nil {
+		// the rebalance started, only its first status is missing
+		LogErrorContext(ctx, "unable to get the status of the rebalance %s: %v", id, err)
+		return &models.RebalanceStatus{ID: id, Running: true, Pools: []*models.RebalancePoolStatus{}}, nil
+	}
+	status.ID = id
+	return status, nil
+}
+
+func getStartRebalanceResponse(session *models.Principal, params systemApi.StartRebalanceParams) (*models.RebalanceStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	status, err := startRebalance(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return status, nil
+}
+
+func getStopRebalanceResponse(session *models.Principal, params systemApi.StopRebalanceParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	if err := adminClient.stopRebalance(ctx); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_rebalanceStatusToModel(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	status := rebalanceStatusToModel(madmin.RebalanceStatus{
+		ID: "rebalance-1",
+		Pools: []madmin.RebalancePoolStatus{
+			{
+				ID:     0,
+				Status: "Started",
+				Used:   81.26,
+				Progress: madmin.RebalPoolProgress{
+					NumObjects: 1000,
+					Bytes:      1 << 30,
+					Bucket:     "images",
+					Object:     "a.png",
+					Elapsed:    time.Hour,
+					ETA:        3 * time.Hour,
+				},
+			},
+			{
+				ID:       1,
+				Status:   "Completed",
+				Used:     60,
+				Progress: madmin.RebalPoolProgress{Elapsed: 2 * time.Hour},
+			},
+			// an empty pool, the target of the rebalance
+			{ID: 2, Used: 10},
+		},
+	}, now)
+	assert.Equal("rebalance-1", status.ID)
+	assert.True(status.Running)
+	assert.Len(status.Pools, 3)
+	assert.Equal(int64(0), *status.Pools[0].ID)
+	assert.Equal(25.0, status.Pools[0].ProgressPercent)
+	assert.Equal(81.3, status.Pools[0].UsedPercent)
+	assert.Equal(int64(3*3600), status.Pools[0].EtaSeconds)
+	assert.Equal(100.0, status.Pools[1].ProgressPercent)
+	assert.Equal(0.0, status.Pools[2].ProgressPercent)
+	assert.Equal(62.5, status.ProgressPercent)
+	assert.Equal(int64(3*3600), status.EtaSeconds)
+	assert.Equal("2023-06-01T15:00:00Z", status.EstimatedCompletion)
+
+	// a stopped rebalance has no estimated completion
+	stoppedAt := now.Add(-time.Minute)
+	status = rebalanceStatusToModel(madmin.RebalanceStatus{
+		ID:        "rebalance-1",
+		StoppedAt: stoppedAt,
+		Pools:     []madmin.RebalancePoolStatus{{ID: 0, Status: "Stopped", Progress: madmin.RebalPoolProgress{Elapsed: time.Hour, ETA: time.Hour}}},
+	}, now)
+	assert.False(status.Running)
+	assert.Equal("2023-06-01T11:59:00Z", status.StoppedAt)
+	assert.Equal(50.0, status.ProgressPercent)
+	assert.Empty(status.EstimatedCompletion)
+}
+
+func Test_getRebalanceStatus(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	minioRebalanceStatusMock = func(ctx context.Context) (madmin.RebalanceStatus, error) {
+		return madmin.RebalanceStatus{}, madmin.ErrorResponse{Code: rebalanceNotStartedCode, Message: "Pool rebalance is not started"}
+	}
+	status, err := getRebalanceStatus(ctx, adminClient)
+	assert.NoError(err)
+	assert.False(status.Running)
+	assert.Empty(status.Pools)
+
+	minioRebalanceStatusMock = func(ctx context.Context) (madmin.RebalanceStatus, error) {
+		return madmin.RebalanceStatus{}, errors.New("server unavailable")
+	}
+	_, err = getRebalanceStatus(ctx, adminClient)
+	assert.Error(err)
+}
+
+func Test_startRebalance(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+
+	minioStartRebalanceMock = func(ctx context.Context) (string, error) {
+		return "rebalance-2", nil
+	}
+	minioRebalanceStatusMock = func(ctx context.Context) (madmin.RebalanceStatus, error) {
+		return madmin.RebalanceStatus{Pools: []madmin.RebalancePoolStatus{{ID: 0, Status: "Started"}}}, nil
+	}
+	status, err := startRebalance(ctx, adminClient)
+	assert.NoError(err)
+	assert.Equal("rebalance-2", status.ID)
+	assert.True(status.Running)
+
+	// the rebalance is reported as started even when its status can't be read yet
+	minioRebalanceStatusMock = func(ctx context.Context) (madmin.RebalanceStatus, error) {
+		return madmin.RebalanceStatus{}, errors.New("server unavailable")
+	}
+	status, err = startRebalance(ctx, adminClient)
+	assert.NoError(err)
+	assert.True(status.Running)
+
+	minioStartRebalanceMock = func(ctx context.Context) (string, error) {
+		return "", errors.New("rebalance already in progress")
+	}
+	_, err = startRebalance(ctx, adminClient)
+	assert.EqualError(err, "rebalance already in progress")
+}
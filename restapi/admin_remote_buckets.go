@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/minio/madmin-go/v2"
@@ -297,7 +298,7 @@ func addRemoteBucket(ctx context.Context, client MinioAdmin, params models.Creat
 	return bucketARN, err
 }
 
-func addBucketReplicationItem(ctx context.Context, session *models.Principal, minClient minioClient, bucketName, prefix, destinationARN string, repDelMark, repDels, repMeta bool, tags string, priority int32, storageClass string) error {
+func addBucketReplicationItem(ctx context.Context, session *models.Principal, minClient minioClient, bucketName, prefix, destinationARN string, repDelMark, repDels, repMeta, existingObjectRep bool, tags string, priority int32, storageClass string) error {
 	// we will tolerate this call failing
 	cfg, err := minClient.getBucketReplication(ctx, bucketName)
 	if err != nil {
@@ -342,13 +343,18 @@ func addBucketReplicationItem(ctx context.Context, session *models.Principal, mi
 		repMetaStatus = "enable"
 	}
 
+	existingRepStatus := "disable"
+	if existingObjectRep {
+		existingRepStatus = "enable"
+	}
+
 	opts := replication.Options{
 		Priority:                fmt.Sprintf("%d", maxPrio),
 		RuleStatus:              "enable",
 		DestBucket:              destinationARN,
 		Op:                      replication.AddOption,
 		TagString:               tags,
-		ExistingObjectReplicate: "enable", // enabled by default
+		ExistingObjectReplicate: existingRepStatus,
 		ReplicateDeleteMarkers:  repDelMarkStatus,
 		ReplicateDeletes:        repDelsStatus,
 		ReplicaSync:             repMetaStatus,
@@ -431,77 +437,85 @@ func editBucketReplicationItem(ctx context.Context, session *models.Principal, m
 func setMultiBucketReplication(ctx context.Context, session *models.Principal, client MinioAdmin, minClient minioClient, params bucketApi.SetMultiBucketReplicationParams) []RemoteBucketResult {
 	bucketsRelation := params.Body.BucketsRelation
 
-	// Parallel remote bucket adding
-	parallelRemoteBucket := func(bucketRelationData *models.MultiBucketsRelation) chan RemoteBucketResult {
-		remoteProc := make(chan RemoteBucketResult)
+	addReplicationTarget := func(bucketRelationData *models.MultiBucketsRelation) RemoteBucketResult {
 		sourceBucket := bucketRelationData.OriginBucket
 		targetBucket := bucketRelationData.DestinationBucket
 
-		go func() {
-			defer close(remoteProc)
-
-			createRemoteBucketParams := models.CreateRemoteBucket{
-				AccessKey:         params.Body.AccessKey,
-				SecretKey:         params.Body.SecretKey,
-				SourceBucket:      &sourceBucket,
-				TargetBucket:      &targetBucket,
-				Region:            params.Body.Region,
-				TargetURL:         params.Body.TargetURL,
-				SyncMode:          params.Body.SyncMode,
-				Bandwidth:         params.Body.Bandwidth,
-				HealthCheckPeriod: params.Body.HealthCheckPeriod,
-			}
+		createRemoteBucketParams := models.CreateRemoteBucket{
+			AccessKey:         params.Body.AccessKey,
+			SecretKey:         params.Body.SecretKey,
+			SourceBucket:      &sourceBucket,
+			TargetBucket:      &targetBucket,
+			Region:            params.Body.Region,
+			TargetURL:         params.Body.TargetURL,
+			SyncMode:          params.Body.SyncMode,
+			Bandwidth:         params.Body.Bandwidth,
+			HealthCheckPeriod: params.Body.HealthCheckPeriod,
+		}
 
-			// We add the remote bucket reference & store the arn or errors returned
-			arn, err := addRemoteBucket(ctx, client, createRemoteBucketParams)
-
-			if err == nil {
-				err = addBucketReplicationItem(
-					ctx,
-					session,
-					minClient,
-					sourceBucket,
-					params.Body.Prefix,
-					arn,
-					params.Body.ReplicateDeleteMarkers,
-					params.Body.ReplicateDeletes,
-					params.Body.ReplicateMetadata,
-					params.Body.Tags,
-					params.Body.Priority,
-					params.Body.StorageClass)
-			}
+		// a priority set for this destination takes precedence over the shared one
+		priority := params.Body.Priority
+		if bucketRelationData.Priority > 0 {
+			priority = bucketRelationData.Priority
+		}
 
-			errorReturn := ""
+		// We add the remote bucket reference & store the arn or errors returned
+		arn, err := addRemoteBucket(ctx, client, createRemoteBucketParams)
+
+		if err == nil {
+			err = addBucketReplicationItem(
+				ctx,
+				session,
+				minClient,
+				sourceBucket,
+				params.Body.Prefix,
+				arn,
+				params.Body.ReplicateDeleteMarkers,
+				params.Body.ReplicateDeletes,
+				params.Body.ReplicateMetadata,
+				params.Body.ReplicateExistingObjects,
+				params.Body.Tags,
+				priority,
+				params.Body.StorageClass)
+		}
 
-			if err != nil {
-				deleteRemoteBucket(ctx, client, sourceBucket, arn)
-				errorReturn = err.Error()
-			}
+		errorReturn := ""
 
-			retParams := RemoteBucketResult{
-				OriginBucket: sourceBucket,
-				TargetBucket: targetBucket,
-				Error:        errorReturn,
-			}
+		if err != nil {
+			deleteRemoteBucket(ctx, client, sourceBucket, arn)
+			errorReturn = err.Error()
+		}
 
-			remoteProc <- retParams
-		}()
-		return remoteProc
+		return RemoteBucketResult{
+			OriginBucket: sourceBucket,
+			TargetBucket: targetBucket,
+			Error:        errorReturn,
+		}
 	}
 
-	var bucketsManagement []chan RemoteBucketResult
-
-	for _, bucketName := range bucketsRelation {
-		// We generate the ARNs for each bucket
-		rBucket := parallelRemoteBucket(bucketName)
-		bucketsManagement = append(bucketsManagement, rBucket)
+	// Every rule of a bucket is stored in the same replication configuration, so the destinations of the same
+	// origin bucket are added one after the other while different origin buckets are handled in parallel
+	var origins []string
+	relationsByOrigin := map[string][]int{}
+	for i, relation := range bucketsRelation {
+		if _, ok := relationsByOrigin[relation.OriginBucket]; !ok {
+			origins = append(origins, relation.OriginBucket)
+		}
+		relationsByOrigin[relation.OriginBucket] = append(relationsByOrigin[relation.OriginBucket], i)
 	}
 
-	resultsList := []RemoteBucketResult{}
-	for _, result := range bucketsManagement {
-		res := <-result
-		resultsList = append(resultsList, res)
+	resultsList := make([]RemoteBucketResult, len(bucketsRelation))
+	var wg sync.WaitGroup
+	for _, origin := range origins {
+		wg.Add(1)
+		go func(indexes []int) {
+			defer wg.Done()
+			for _, i := range indexes {
+				resultsList[i] = addReplicationTarget(bucketsRelation[i])
+			}
+		}(relationsByOrigin[origin])
 	}
+	wg.Wait()
 
 	return resultsList
 }
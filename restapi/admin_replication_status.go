@@ -18,6 +18,7 @@ package restapi
 
 import (
 	"context"
+	"sort"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/models"
@@ -34,6 +35,13 @@ func registerSiteReplicationStatusHandler(api *operations.ConsoleAPI) {
 		}
 		return siteRepApi.NewGetSiteReplicationStatusOK().WithPayload(rInfo)
 	})
+	api.SiteReplicationGetSiteReplicationHealthHandler = siteRepApi.GetSiteReplicationHealthHandlerFunc(func(params siteRepApi.GetSiteReplicationHealthParams, session *models.Principal) middleware.Responder {
+		health, err := getSRHealthResponse(session, params)
+		if err != nil {
+			return siteRepApi.NewGetSiteReplicationHealthDefault(int(err.Code)).WithPayload(err)
+		}
+		return siteRepApi.NewGetSiteReplicationHealthOK().WithPayload(health)
+	})
 }
 
 func getSRStatusResponse(session *models.Principal, params siteRepApi.GetSiteReplicationStatusParams) (*models.SiteReplicationStatusResponse, *models.Error) {
@@ -64,6 +72,9 @@ func getSRStats(ctx context.Context, client MinioAdmin, params siteRepApi.GetSit
 	}
 
 	srInfo, err := client.getSiteReplicationStatus(ctx, srParams)
+	if err != nil {
+		return nil, err
+	}
 
 	retInfo := models.SiteReplicationStatusResponse{
 		BucketStats:  &srInfo.BucketStats,
@@ -78,9 +89,106 @@ func getSRStats(ctx context.Context, client MinioAdmin, params siteRepApi.GetSit
 		StatsSummary: srInfo.StatsSummary,
 		UserStats:    &srInfo.UserStats,
 	}
+	return &retInfo, nil
+}
 
+func getSRHealthResponse(session *models.Principal, params siteRepApi.GetSiteReplicationHealthParams) (*models.SiteReplicationHealthResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	res, err := getSRHealth(ctx, AdminClient{Client: mAdmin})
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return res, nil
+}
+
+// getSRHealth summarizes, for every site, how many buckets, policies, users and groups are replicated and which
+// of them are missing or differ from the other sites
+func getSRHealth(ctx context.Context, client MinioAdmin) (*models.SiteReplicationHealthResponse, error) {
+	srInfo, err := client.getSiteReplicationStatus(ctx, madmin.SRStatusOptions{
+		Buckets:  true,
+		Policies: true,
+		Users:    true,
+		Groups:   true,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &retInfo, nil
+	health := &models.SiteReplicationHealthResponse{Enabled: srInfo.Enabled, Healthy: true, Sites: []*models.SiteReplicationSiteHealth{}}
+	if !srInfo.Enabled {
+		return health, nil
+	}
+	for deploymentID, peer := range srInfo.Sites {
+		summary := srInfo.StatsSummary[deploymentID]
+		site := &models.SiteReplicationSiteHealth{
+			Name:         peer.Name,
+			Endpoint:     peer.Endpoint,
+			DeploymentID: deploymentID,
+			Buckets: &models.SiteReplicationEntitySync{
+				Total:      int64(summary.TotalBucketsCount),
+				Replicated: int64(summary.ReplicatedBuckets),
+				OutOfSync:  []string{},
+			},
+			Policies: &models.SiteReplicationEntitySync{
+				Total:      int64(summary.TotalIAMPoliciesCount),
+				Replicated: int64(summary.ReplicatedIAMPolicies),
+				OutOfSync:  []string{},
+			},
+			Users: &models.SiteReplicationEntitySync{
+				Total:      int64(summary.TotalUsersCount),
+				Replicated: int64(summary.ReplicatedUsers),
+				OutOfSync:  []string{},
+			},
+			Groups: &models.SiteReplicationEntitySync{
+				Total:      int64(summary.TotalGroupsCount),
+				Replicated: int64(summary.ReplicatedGroups),
+				OutOfSync:  []string{},
+			},
+		}
+		for bucket, stats := range srInfo.BucketStats {
+			st, ok := stats[deploymentID]
+			if !ok || bucketOutOfSync(st) {
+				site.Buckets.OutOfSync = append(site.Buckets.OutOfSync, bucket)
+			}
+		}
+		for policy, stats := range srInfo.PolicyStats {
+			st, ok := stats[deploymentID]
+			if !ok || !st.HasPolicy || st.PolicyMismatch {
+				site.Policies.OutOfSync = append(site.Policies.OutOfSync, policy)
+			}
+		}
+		for user, stats := range srInfo.UserStats {
+			st, ok := stats[deploymentID]
+			if !ok || !st.HasUser || st.UserInfoMismatch || st.PolicyMismatch {
+				site.Users.OutOfSync = append(site.Users.OutOfSync, user)
+			}
+		}
+		for group, stats := range srInfo.GroupStats {
+			st, ok := stats[deploymentID]
+			if !ok || !st.HasGroup || st.GroupDescMismatch || st.PolicyMismatch {
+				site.Groups.OutOfSync = append(site.Groups.OutOfSync, group)
+			}
+		}
+		site.Healthy = true
+		for _, entities := range []*models.SiteReplicationEntitySync{site.Buckets, site.Policies, site.Users, site.Groups} {
+			sort.Strings(entities.OutOfSync)
+			site.Healthy = site.Healthy && len(entities.OutOfSync) == 0
+		}
+		health.Healthy = health.Healthy && site.Healthy
+		health.Sites = append(health.Sites, site)
+	}
+	sort.Slice(health.Sites, func(i, j int) bool {
+		return health.Sites[i].Name < health.Sites[j].Name
+	})
+	return health, nil
+}
+
+// bucketOutOfSync tells whether a bucket is missing on a site or has some metadata that differs from the other sites
+func bucketOutOfSync(st madmin.SRBucketStatsSummary) bool {
+	return !st.HasBucket || st.TagMismatch || st.VersioningConfigMismatch || st.OLockConfigMismatch ||
+		st.PolicyMismatch || st.SSEConfigMismatch || st.ReplicationCfgMismatch || st.QuotaCfgMismatch
 }
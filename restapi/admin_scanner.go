@@ -0,0 +1,215 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/usagehistory"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+const (
+	// defaultUsageStaleAfter is how old the data usage gets before it's reported as stale, when the
+	// scanner didn't complete enough cycles to know how long one takes
+	defaultUsageStaleAfter = time.Hour
+	// the data usage is stale once it's older than this many scanner cycles
+	usageStaleCycles = 2
+
+	dataUsageRefreshMessage = "MinIO doesn't allow starting a scanner cycle on demand, the latest data usage was recorded and the next cycle will update it"
+)
+
+func registerScannerHandlers(api *operations.ConsoleAPI) {
+	// data scanner cycle and data usage freshness
+	api.SystemGetScannerStatusHandler = systemApi.GetScannerStatusHandlerFunc(func(params systemApi.GetScannerStatusParams, session *models.Principal) middleware.Responder {
+		status, err := getScannerStatusResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetScannerStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetScannerStatusOK().WithPayload(status)
+	})
+	// records the latest data usage in the usage history
+	api.SystemRefreshDataUsageHandler = systemApi.RefreshDataUsageHandlerFunc(func(params systemApi.RefreshDataUsageParams, session *models.Principal) middleware.Responder {
+		refresh, err := refreshDataUsageResponse(session, params)
+		if err != nil {
+			return systemApi.NewRefreshDataUsageDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewRefreshDataUsageOK().WithPayload(refresh)
+	})
+}
+
+// averageScannerCycle returns how long the last completed cycles took, zero when less than two
+// cycles completed
+func averageScannerCycle(completed []time.Time) time.Duration {
+	if len(completed) < 2 {
+		return 0
+	}
+	times := append([]time.Time{}, completed...)
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times[len(times)-1].Sub(times[0]) / time.Duration(len(times)-1)
+}
+
+// scanningBuckets returns the buckets named in the paths the scanner is working on, MinIO
+// reports them prefixed by the drive being scanned so every path element is checked
+func scanningBuckets(activePaths []string, buckets map[string]madmin.BucketUsageInfo) map[string]bool {
+	scanning := map[string]bool{}
+	for _, p := range activePaths {
+		for _, elem := range strings.Split(p, "/") {
+			if _, ok := buckets[elem]; ok {
+				scanning[elem] = true
+			}
+		}
+	}
+	return scanning
+}
+
+// scannerStatus combines the scanner metrics, nil when MinIO doesn't report them, with the data usage
+// it last computed and the changes recorded in the usage history
+func scannerStatus(metrics *madmin.ScannerMetrics, usage madmin.DataUsageInfo, store *usagehistory.Store, now time.Time) *models.ScannerStatus {
+	status := &models.ScannerStatus{
+		ActivePaths: []string{},
+		Buckets:     []*models.ScannerBucketStatus{},
+	}
+	staleAfter := defaultUsageStaleAfter
+	if metrics != nil {
+		status.CurrentCycle = int64(metrics.CurrentCycle)
+		if !metrics.CurrentStarted.IsZero() {
+			status.CycleStartedAt = metrics.CurrentStarted.UTC().Format(time.RFC3339)
+		}
+		var last time.Time
+		for _, completed := range metrics.CyclesCompletedAt {
+			if completed.After(last) {
+				last = completed
+			}
+		}
+		if !last.IsZero() {
+			status.LastCycleCompletedAt = last.UTC().Format(time.RFC3339)
+		}
+		if avg := averageScannerCycle(metrics.CyclesCompletedAt); avg > 0 {
+			status.AverageCycleSeconds = int64(avg.Seconds())
+			staleAfter = usageStaleCycles * avg
+		}
+		if metrics.ActivePaths != nil {
+			status.ActivePaths = append(status.ActivePaths, metrics.ActivePaths...)
+			sort.Strings(status.ActivePaths)
+		}
+	}
+
+	// the usage is stale until the scanner completes its first cycle
+	status.UsageStale = true
+	if !usage.LastUpdate.IsZero() {
+		age := now.Sub(usage.LastUpdate)
+		status.UsageUpdatedAt = usage.LastUpdate.UTC().Format(time.RFC3339)
+		status.UsageAgeSeconds = int64(age.Seconds())
+		status.UsageStale = age > staleAfter
+	}
+
+	scanning := scanningBuckets(status.ActivePaths, usage.BucketsUsage)
+	// MinIO doesn't count the buckets in progress, they are the ones named in the active paths
+	status.OngoingBuckets = int64(len(scanning))
+	for name, bucket := range usage.BucketsUsage {
+		item := &models.ScannerBucketStatus{
+			Bucket:   name,
+			Size:     int64(bucket.Size),
+			Objects:  int64(bucket.ObjectsCount),
+			Scanning: scanning[name],
+		}
+		if changed, ok := store.LastChange(name); ok {
+			item.LastChangedAt = changed.UTC().Format(time.RFC3339)
+		}
+		status.Buckets = append(status.Buckets, item)
+	}
+	sort.Slice(status.Buckets, func(i, j int) bool { return status.Buckets[i].Bucket < status.Buckets[j].Bucket })
+	return status
+}
+
+// getScannerStatus returns the status of the data scanner, servers not reporting its metrics only
+// get the freshness of the data usage
+func getScannerStatus(ctx context.Context, client MinioAdmin, store *usagehistory.Store, now time.Time) (*models.ScannerStatus, error) {
+	usage, err := client.dataUsageInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := client.scannerMetrics(ctx)
+	if err != nil {
+		LogErrorContext(ctx, "unable to get the data scanner metrics: %v", err)
+		metrics = nil
+	}
+	return scannerStatus(metrics, usage, store, now), nil
+}
+
+// refreshDataUsage fetches the data usage MinIO last computed and records it in the usage history
+// right away, MinIO has no API to start a scanner cycle so the usage itself is only as fresh as its
+// last cycle
+func refreshDataUsage(ctx context.Context, client MinioAdmin, store *usagehistory.Store, now time.Time) (*models.DataUsageRefresh, error) {
+	info, err := client.dataUsageInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usage := make(map[string]usagehistory.Usage, len(info.BucketsUsage))
+	for name, bucket := range info.BucketsUsage {
+		usage[name] = usagehistory.Usage{Size: int64(bucket.Size), Objects: int64(bucket.ObjectsCount)}
+	}
+	if err = store.RecordNow(now, usage); err != nil {
+		return nil, fmt.Errorf("unable to save the bucket usage history: %w", err)
+	}
+	refresh := &models.DataUsageRefresh{
+		BucketsRecorded:  int64(len(usage)),
+		ScannerTriggered: false,
+		Message:          dataUsageRefreshMessage,
+	}
+	if !info.LastUpdate.IsZero() {
+		refresh.UsageUpdatedAt = info.LastUpdate.UTC().Format(time.RFC3339)
+	}
+	return refresh, nil
+}
+
+func getScannerStatusResponse(session *models.Principal, params systemApi.GetScannerStatusParams) (*models.ScannerStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	status, err := getScannerStatus(ctx, AdminClient{Client: mAdmin}, usageHistory(), time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return status, nil
+}
+
+func refreshDataUsageResponse(session *models.Principal, params systemApi.RefreshDataUsageParams) (*models.DataUsageRefresh, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	refresh, err := refreshDataUsage(ctx, AdminClient{Client: mAdmin}, usageHistory(), time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return refresh, nil
+}
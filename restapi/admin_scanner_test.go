@@ -0,0 +1,134 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/usagehistory"
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_averageScannerCycle(t *testing.T) {
+	assert := assert.New(t)
+	start := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(time.Duration(0), averageScannerCycle(nil))
+	assert.Equal(time.Duration(0), averageScannerCycle([]time.Time{start}))
+	assert.Equal(15*time.Minute, averageScannerCycle([]time.Time{start.Add(30 * time.Minute), start, start.Add(10 * time.Minute)}))
+}
+
+func Test_getScannerStatus(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	store, err := usagehistory.New("", time.Hour, 24*time.Hour)
+	assert.NoError(err)
+	assert.NoError(store.Record(now.Add(-3*time.Hour), map[string]usagehistory.Usage{"photos": {Size: 10, Objects: 1}}))
+
+	usage := madmin.DataUsageInfo{
+		LastUpdate: now.Add(-50 * time.Minute),
+		BucketsUsage: map[string]madmin.BucketUsageInfo{
+			"photos": {Size: 10, ObjectsCount: 1},
+			"logs":   {Size: 500, ObjectsCount: 50},
+		},
+	}
+	minioDataUsageInfoMock = func(ctx context.Context) (madmin.DataUsageInfo, error) {
+		return usage, nil
+	}
+	minioScannerMetricsMock = func(ctx context.Context) (*madmin.ScannerMetrics, error) {
+		return &madmin.ScannerMetrics{
+			CurrentCycle:      4,
+			CurrentStarted:    now.Add(-5 * time.Minute),
+			CyclesCompletedAt: []time.Time{now.Add(-50 * time.Minute), now.Add(-30 * time.Minute), now.Add(-10 * time.Minute)},
+			ActivePaths:       []string{"/data1/logs/2023/app.log"},
+		}, nil
+	}
+
+	status, err := getScannerStatus(ctx, client, store, now)
+	assert.NoError(err)
+	assert.Equal(int64(4), status.CurrentCycle)
+	assert.Equal("2023-05-01T11:55:00Z", status.CycleStartedAt)
+	assert.Equal("2023-05-01T11:50:00Z", status.LastCycleCompletedAt)
+	assert.Equal(int64(20*60), status.AverageCycleSeconds)
+	assert.Equal(int64(1), status.OngoingBuckets)
+	assert.Equal(int64(50*60), status.UsageAgeSeconds)
+	// older than two cycles
+	assert.True(status.UsageStale)
+	if assert.Len(status.Buckets, 2) {
+		assert.Equal("logs", status.Buckets[0].Bucket)
+		assert.True(status.Buckets[0].Scanning)
+		assert.Empty(status.Buckets[0].LastChangedAt)
+		assert.Equal("photos", status.Buckets[1].Bucket)
+		assert.False(status.Buckets[1].Scanning)
+		assert.Equal("2023-05-01T09:00:00Z", status.Buckets[1].LastChangedAt)
+	}
+
+	// servers without scanner metrics only report the usage freshness
+	minioScannerMetricsMock = func(ctx context.Context) (*madmin.ScannerMetrics, error) {
+		return nil, errors.New("not implemented")
+	}
+	status, err = getScannerStatus(ctx, client, store, now)
+	assert.NoError(err)
+	assert.Equal(int64(0), status.CurrentCycle)
+	assert.NotNil(status.ActivePaths)
+	assert.False(status.UsageStale)
+
+	// the scanner didn't complete a cycle yet
+	usage = madmin.DataUsageInfo{}
+	status, err = getScannerStatus(ctx, client, store, now)
+	assert.NoError(err)
+	assert.True(status.UsageStale)
+	assert.Empty(status.UsageUpdatedAt)
+
+	minioDataUsageInfoMock = func(ctx context.Context) (madmin.DataUsageInfo, error) {
+		return madmin.DataUsageInfo{}, errors.New("access denied")
+	}
+	_, err = getScannerStatus(ctx, client, store, now)
+	assert.Error(err)
+}
+
+func Test_refreshDataUsage(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	store, err := usagehistory.New("", time.Hour, 24*time.Hour)
+	assert.NoError(err)
+	assert.NoError(store.Record(now.Add(-time.Minute), map[string]usagehistory.Usage{"photos": {Size: 10, Objects: 1}}))
+
+	minioDataUsageInfoMock = func(ctx context.Context) (madmin.DataUsageInfo, error) {
+		return madmin.DataUsageInfo{
+			LastUpdate:   now.Add(-10 * time.Second),
+			BucketsUsage: map[string]madmin.BucketUsageInfo{"photos": {Size: 20, ObjectsCount: 2}},
+		}, nil
+	}
+	refresh, err := refreshDataUsage(ctx, client, store, now)
+	assert.NoError(err)
+	assert.Equal(int64(1), refresh.BucketsRecorded)
+	assert.False(refresh.ScannerTriggered)
+	assert.Equal("2023-05-01T11:59:50Z", refresh.UsageUpdatedAt)
+	// recorded even though the interval didn't elapse since the last sample
+	series := store.Series("photos", time.Time{})
+	if assert.Len(series, 2) {
+		assert.Equal(int64(20), series[1].Size)
+	}
+}
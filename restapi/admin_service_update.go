@@ -0,0 +1,318 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	svcApi "github.com/minio/console/restapi/operations/service"
+	"github.com/minio/websocket"
+)
+
+// defaultServerUpdateURL is where MinIO publishes the checksum, and the name, of its latest release
+const defaultServerUpdateURL = "https://dl.min.io/server/minio/release/linux-amd64/minio.sha256sum"
+
+// serviceConfirmationTTL is how long a confirmation token can be used for
+const serviceConfirmationTTL = 2 * time.Minute
+
+var (
+	// serviceRestartGracePeriod is the time the nodes take to shut down, copied from mc
+	serviceRestartGracePeriod = 6 * time.Second
+	// serviceStatusInterval is the time between two checks of the nodes while they restart
+	serviceStatusInterval = 2 * time.Second
+	// serviceActionTimeout bounds the wait for the nodes to come back online
+	serviceActionTimeout = 5 * time.Minute
+)
+
+func registerServiceUpdateHandlers(api *operations.ConsoleAPI) {
+	// compare the version of the nodes with the latest release
+	api.ServiceCheckServerUpdateHandler = svcApi.CheckServerUpdateHandlerFunc(func(params svcApi.CheckServerUpdateParams, session *models.Principal) middleware.Responder {
+		check, err := getCheckServerUpdateResponse(session, params)
+		if err != nil {
+			return svcApi.NewCheckServerUpdateDefault(int(err.Code)).WithPayload(err)
+		}
+		return svcApi.NewCheckServerUpdateOK().WithPayload(check)
+	})
+	// token confirming an update or a restart
+	api.ServiceCreateServiceConfirmationHandler = svcApi.CreateServiceConfirmationHandlerFunc(func(params svcApi.CreateServiceConfirmationParams, session *models.Principal) middleware.Responder {
+		owner := sessionOwner(session)
+		if owner == "" {
+			err := ErrorWithContext(params.HTTPRequest.Context(), ErrInvalidSession)
+			return svcApi.NewCreateServiceConfirmationDefault(int(err.Code)).WithPayload(err)
+		}
+		confirmation := globalServiceConfirmations.issue(owner, swag.StringValue(params.Body.Action), time.Now())
+		return svcApi.NewCreateServiceConfirmationCreated().WithPayload(confirmation)
+	})
+}
+
+// serviceConfirmation is a token that can be used once, by the same user, for the action it was issued for
+type serviceConfirmation struct {
+	owner   string
+	action  string
+	expires time.Time
+}
+
+type serviceConfirmations struct {
+	mu     sync.Mutex
+	tokens map[string]serviceConfirmation
+}
+
+var globalServiceConfirmations = &serviceConfirmations{tokens: make(map[string]serviceConfirmation)}
+
+func (c *serviceConfirmations) issue(owner, action string, now time.Time) *models.ServiceConfirmation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for token, confirmation := range c.tokens {
+		if now.After(confirmation.expires) {
+			delete(c.tokens, token)
+		}
+	}
+	token := RandomCharString(32)
+	expires := now.Add(serviceConfirmationTTL)
+	c.tokens[token] = serviceConfirmation{owner: owner, action: action, expires: expires}
+	return &models.ServiceConfirmation{Action: action, Token: token, ExpiresAt: expires.UTC().Format(time.RFC3339)}
+}
+
+// consume checks the token confirms the action of the user, it can't be used again afterwards
+func (c *serviceConfirmations) consume(token, owner, action string, now time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	confirmation, ok := c.tokens[token]
+	if !ok || now.After(confirmation.expires) || owner == "" || confirmation.owner != owner || confirmation.action != action {
+		return ErrInvalidServiceConfirmation
+	}
+	delete(c.tokens, token)
+	return nil
+}
+
+// releaseVersion returns the time of a MinIO release, the checksum file names the release like
+// `minio.RELEASE.2023-05-04T21-44-30Z` while the servers report `2023-05-04T21:44:30Z`
+func releaseVersion(release string) (time.Time, error) {
+	if i := strings.LastIndex(release, "RELEASE."); i >= 0 {
+		return time.Parse("2006-01-02T15-04-05Z", release[i+len("RELEASE."):])
+	}
+	return time.Parse(time.RFC3339, release)
+}
+
+// latestRelease reads the name of the latest release out of its checksum file, `<sha256> minio.RELEASE.<time>`
+func latestRelease(ctx context.Context, client *http.Client, updateURL string) (time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, updateURL, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return time.Time{}, fmt.Errorf("unable to get the latest release from %s: %s", updateURL, resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	if err != nil {
+		return time.Time{}, err
+	}
+	fields := strings.Fields(string(body))
+	if len(fields) != 2 {
+		return time.Time{}, fmt.Errorf("unexpected release information from %s", updateURL)
+	}
+	return releaseVersion(fields[1])
+}
+
+// checkServerUpdate compares the version of every node with the latest release, nodes running development
+// builds are never reported as outdated
+func checkServerUpdate(ctx context.Context, client MinioAdmin, httpClient *http.Client, updateURL string) (*models.ServerUpdateCheck, error) {
+	latest, err := latestRelease(ctx, httpClient, updateURL)
+	if err != nil {
+		return nil, err
+	}
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &models.ServerUpdateCheck{
+		LatestVersion: latest.UTC().Format(time.RFC3339),
+		Nodes:         []*models.ServerNodeVersion{},
+	}
+	for _, server := range info.Servers {
+		node := &models.ServerNodeVersion{Endpoint: server.Endpoint, State: server.State, Version: server.Version}
+		if version, err := releaseVersion(server.Version); err == nil && version.Before(latest) {
+			node.Outdated = true
+			res.UpdateAvailable = true
+		}
+		res.Nodes = append(res.Nodes, node)
+	}
+	return res, nil
+}
+
+func getCheckServerUpdateResponse(session *models.Principal, params svcApi.CheckServerUpdateParams) (*models.ServerUpdateCheck, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	updateURL := defaultServerUpdateURL
+	if params.UpdateURL != nil && *params.UpdateURL != "" {
+		updateURL = *params.UpdateURL
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	check, err := checkServerUpdate(ctx, AdminClient{Client: mAdmin}, GetConsoleHTTPClient(updateURL), updateURL)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return check, nil
+}
+
+// serviceActionOptions are the update or restart of the cluster asked through the `/service` websocket
+type serviceActionOptions struct {
+	Action    string
+	Token     string
+	UpdateURL string
+}
+
+func getServiceActionOptionsFromReq(req *http.Request) (*serviceActionOptions, error) {
+	query := req.URL.Query()
+	opts := &serviceActionOptions{
+		Action:    query.Get("action"),
+		Token:     query.Get("token"),
+		UpdateURL: query.Get("updateURL"),
+	}
+	if opts.Action != models.ServiceConfirmationRequestActionRestart && opts.Action != models.ServiceConfirmationRequestActionUpdate {
+		return nil, fmt.Errorf("unknown service action: %s", opts.Action)
+	}
+	return opts, nil
+}
+
+// serviceNodeStatus is the state of a node while the cluster restarts
+type serviceNodeStatus struct {
+	Endpoint string `json:"endpoint"`
+	State    string `json:"state"`
+	Version  string `json:"version,omitempty"`
+}
+
+// serviceActionStatus is sent when the update or restart progresses and whenever the state of a node changes
+type serviceActionStatus struct {
+	Action         string              `json:"action"`
+	Phase          string              `json:"phase"`
+	CurrentVersion string              `json:"currentVersion,omitempty"`
+	UpdatedVersion string              `json:"updatedVersion,omitempty"`
+	Nodes          []serviceNodeStatus `json:"nodes,omitempty"`
+	Error          string              `json:"error,omitempty"`
+}
+
+func writeServiceActionStatus(conn WSConn, status serviceActionStatus) error {
+	bytes, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return conn.writeMessage(websocket.TextMessage, bytes)
+}
+
+// serviceNodes returns the state of the nodes, all of them are unreachable while the server answering
+// restarts
+func serviceNodes(ctx context.Context, client MinioAdmin, previous []serviceNodeStatus) ([]serviceNodeStatus, bool) {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		nodes := make([]serviceNodeStatus, len(previous))
+		for i, node := range previous {
+			nodes[i] = serviceNodeStatus{Endpoint: node.Endpoint, State: "unreachable", Version: node.Version}
+		}
+		return nodes, false
+	}
+	nodes := make([]serviceNodeStatus, 0, len(info.Servers))
+	for _, server := range info.Servers {
+		nodes = append(nodes, serviceNodeStatus{Endpoint: server.Endpoint, State: server.State, Version: server.Version})
+	}
+	return nodes, true
+}
+
+// runServiceAction updates or restarts the cluster once the user confirmed it, then follows the nodes
+// until they are all back online, running the updated version after an update
+func runServiceAction(ctx context.Context, conn WSConn, client MinioAdmin, owner string, opts *serviceActionOptions) error {
+	if err := globalServiceConfirmations.consume(opts.Token, owner, opts.Action, time.Now()); err != nil {
+		return err
+	}
+	status := serviceActionStatus{Action: opts.Action, Phase: "started"}
+	status.Nodes, _ = serviceNodes(ctx, client, nil)
+	if opts.Action == models.ServiceConfirmationRequestActionUpdate {
+		// MinIO restarts the nodes itself once they are updated
+		update, err := client.serverUpdate(ctx, opts.UpdateURL)
+		if err != nil {
+			return err
+		}
+		status.CurrentVersion, status.UpdatedVersion = update.CurrentVersion, update.UpdatedVersion
+		if update.CurrentVersion == update.UpdatedVersion {
+			status.Phase = "done"
+			return writeServiceActionStatus(conn, status)
+		}
+	} else if err := client.serviceRestart(ctx); err != nil {
+		return err
+	}
+	if err := writeServiceActionStatus(conn, status); err != nil {
+		return err
+	}
+
+	timeout := time.NewTimer(serviceActionTimeout)
+	defer timeout.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(serviceRestartGracePeriod):
+	}
+	status.Phase = "restarting"
+	ticker := time.NewTicker(serviceStatusInterval)
+	defer ticker.Stop()
+	for {
+		nodes, reachable := serviceNodes(ctx, client, status.Nodes)
+		done := reachable && len(nodes) > 0
+		for _, node := range nodes {
+			done = done && node.State == "online" && (status.UpdatedVersion == "" || node.Version == status.UpdatedVersion)
+		}
+		changed := !reflect.DeepEqual(nodes, status.Nodes)
+		status.Nodes = nodes
+		if done {
+			status.Phase = "done"
+			return writeServiceActionStatus(conn, status)
+		}
+		if changed {
+			if err := writeServiceActionStatus(conn, status); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout.C:
+			status.Phase = "failed"
+			status.Error = fmt.Sprintf("the nodes aren't all back online after %s", serviceActionTimeout)
+			return writeServiceActionStatus(conn, status)
+		case <-ticker.C:
+		}
+	}
+}
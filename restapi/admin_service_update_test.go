@@ -0,0 +1,171 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minio/console/models"
+)
+
+func Test_serviceConfirmations(t *testing.T) {
+	assert := assert.New(t)
+	confirmations := &serviceConfirmations{tokens: make(map[string]serviceConfirmation)}
+	now := time.Now()
+
+	confirmation := confirmations.issue("admin", models.ServiceConfirmationRequestActionRestart, now)
+	assert.Len(confirmation.Token, 32)
+	assert.Equal(now.Add(serviceConfirmationTTL).UTC().Format(time.RFC3339), confirmation.ExpiresAt)
+
+	// tokens are bound to the user and the action they were issued for
+	assert.ErrorIs(confirmations.consume(confirmation.Token, "other", models.ServiceConfirmationRequestActionRestart, now), ErrInvalidServiceConfirmation)
+	assert.ErrorIs(confirmations.consume(confirmation.Token, "admin", models.ServiceConfirmationRequestActionUpdate, now), ErrInvalidServiceConfirmation)
+	assert.NoError(confirmations.consume(confirmation.Token, "admin", models.ServiceConfirmationRequestActionRestart, now))
+	// and can only be used once
+	assert.ErrorIs(confirmations.consume(confirmation.Token, "admin", models.ServiceConfirmationRequestActionRestart, now), ErrInvalidServiceConfirmation)
+	// the sessions without an owner don't share the tokens issued for them
+	confirmation = confirmations.issue("", models.ServiceConfirmationRequestActionRestart, now)
+	assert.ErrorIs(confirmations.consume(confirmation.Token, "", models.ServiceConfirmationRequestActionRestart, now), ErrInvalidServiceConfirmation)
+
+	confirmation = confirmations.issue("admin", models.ServiceConfirmationRequestActionUpdate, now)
+	later := now.Add(serviceConfirmationTTL + time.Second)
+	assert.ErrorIs(confirmations.consume(confirmation.Token, "admin", models.ServiceConfirmationRequestActionUpdate, later), ErrInvalidServiceConfirmation)
+	// expired tokens are dropped when new ones are issued
+	confirmations.issue("admin", models.ServiceConfirmationRequestActionUpdate, later)
+	assert.Len(confirmations.tokens, 1)
+}
+
+func Test_checkServerUpdate(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("4e2a1ae7f0e0e6cd9b6b8ee4d0d3cc0b1ffd3b4e07d87a4fc5e0e5e1b4c2d8a1 minio.RELEASE.2023-05-04T21-44-30Z\n"))
+	}))
+	defer server.Close()
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+			{Endpoint: "node1:9000", State: "online", Version: "2023-05-04T21:44:30Z"},
+			{Endpoint: "node2:9000", State: "online", Version: "2023-04-28T18:11:17Z"},
+			{Endpoint: "node3:9000", State: "offline", Version: "DEVELOPMENT.GOGET"},
+		}}, nil
+	}
+
+	check, err := checkServerUpdate(ctx, client, server.Client(), server.URL)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal("2023-05-04T21:44:30Z", check.LatestVersion)
+	assert.True(check.UpdateAvailable)
+	if assert.Len(check.Nodes, 3) {
+		assert.False(check.Nodes[0].Outdated)
+		assert.True(check.Nodes[1].Outdated)
+		assert.False(check.Nodes[2].Outdated)
+	}
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	_, err = checkServerUpdate(ctx, client, server.Client(), server.URL)
+	assert.Error(err)
+}
+
+func Test_runServiceAction(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	defer func(grace, interval, timeout time.Duration) {
+		serviceRestartGracePeriod, serviceStatusInterval, serviceActionTimeout = grace, interval, timeout
+	}(serviceRestartGracePeriod, serviceStatusInterval, serviceActionTimeout)
+	serviceRestartGracePeriod, serviceStatusInterval, serviceActionTimeout = time.Millisecond, time.Millisecond, time.Second
+
+	var messages []serviceActionStatus
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var status serviceActionStatus
+		assert.NoError(json.Unmarshal(data, &status))
+		messages = append(messages, status)
+		return nil
+	}
+	// the nodes come back one after the other, running the updated version
+	infoCalls := 0
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		infoCalls++
+		switch infoCalls {
+		case 1:
+			return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+				{Endpoint: "node1:9000", State: "online", Version: "2023-04-28T18:11:17Z"},
+				{Endpoint: "node2:9000", State: "online", Version: "2023-04-28T18:11:17Z"},
+			}}, nil
+		case 2:
+			return madmin.InfoMessage{}, errors.New("connection refused")
+		case 3:
+			return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+				{Endpoint: "node1:9000", State: "online", Version: "2023-05-04T21:44:30Z"},
+				{Endpoint: "node2:9000", State: "offline", Version: "2023-04-28T18:11:17Z"},
+			}}, nil
+		}
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+			{Endpoint: "node1:9000", State: "online", Version: "2023-05-04T21:44:30Z"},
+			{Endpoint: "node2:9000", State: "online", Version: "2023-05-04T21:44:30Z"},
+		}}, nil
+	}
+	minioServerUpdateMock = func(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error) {
+		return madmin.ServerUpdateStatus{CurrentVersion: "2023-04-28T18:11:17Z", UpdatedVersion: "2023-05-04T21:44:30Z"}, nil
+	}
+
+	opts := &serviceActionOptions{Action: models.ServiceConfirmationRequestActionUpdate, Token: "invalid"}
+	assert.ErrorIs(runServiceAction(ctx, mockConn{}, client, "admin", opts), ErrInvalidServiceConfirmation)
+
+	opts.Token = globalServiceConfirmations.issue("admin", opts.Action, time.Now()).Token
+	assert.NoError(runServiceAction(ctx, mockConn{}, client, "admin", opts))
+	if assert.Len(messages, 4) {
+		assert.Equal("started", messages[0].Phase)
+		assert.Equal("2023-05-04T21:44:30Z", messages[0].UpdatedVersion)
+		assert.Equal("unreachable", messages[1].Nodes[0].State)
+		assert.Equal("offline", messages[2].Nodes[1].State)
+		assert.Equal("done", messages[3].Phase)
+	}
+
+	// a restart that doesn't complete in time
+	messages = nil
+	serviceActionTimeout = 20 * time.Millisecond
+	minioServiceRestartMock = func(ctx context.Context) error {
+		return nil
+	}
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{{Endpoint: "node1:9000", State: "offline"}}}, nil
+	}
+	opts = &serviceActionOptions{Action: models.ServiceConfirmationRequestActionRestart}
+	opts.Token = globalServiceConfirmations.issue("admin", opts.Action, time.Now()).Token
+	assert.NoError(runServiceAction(ctx, mockConn{}, client, "admin", opts))
+	if assert.NotEmpty(messages) {
+		last := messages[len(messages)-1]
+		assert.Equal("failed", last.Phase)
+		assert.NotEmpty(last.Error)
+	}
+}
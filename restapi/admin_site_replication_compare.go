@@ -0,0 +1,294 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/clusters"
+	"github.com/minio/console/restapi/operations"
+	siteRepApi "github.com/minio/console/restapi/operations/site_replication"
+)
+
+// comparedConfigSubsystems are the configuration subsystems expected to match between clusters, site
+// specific or credential bearing subsystems are left out on purpose
+var comparedConfigSubsystems = []string{"api", "compression", "heal", "scanner", "storage_class"}
+
+// siteState is everything collected from a cluster to build its side of the comparison
+type siteState struct {
+	snapshot *models.ClusterSnapshot
+	buckets  map[string]bool
+	config   map[string]string
+}
+
+func registerSiteReplicationCompareHandler(api *operations.ConsoleAPI) {
+	api.SiteReplicationSiteReplicationCompareHandler = siteRepApi.SiteReplicationCompareHandlerFunc(func(params siteRepApi.SiteReplicationCompareParams, session *models.Principal) middleware.Responder {
+		report, err := getSRCompareResponse(session, params)
+		if err != nil {
+			return siteRepApi.NewSiteReplicationCompareDefault(int(err.Code)).WithPayload(err)
+		}
+		return siteRepApi.NewSiteReplicationCompareOK().WithPayload(report)
+	})
+}
+
+func getSRCompareResponse(session *models.Principal, params siteRepApi.SiteReplicationCompareParams) (*models.ClusterComparisonReport, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	// the clusters are reached the way the session reaches them once switched to them
+	connect := func(id string) (MinioAdmin, error) {
+		clusterSession := *session
+		clusterSession.ClusterID = id
+		mAdmin, err := NewMinioAdminClient(&clusterSession)
+		if err != nil {
+			return nil, err
+		}
+		return AdminClient{Client: mAdmin}, nil
+	}
+	report, err := compareClusters(ctx, clusterRegistry(), params.SiteA, params.SiteB, connect)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return report, nil
+}
+
+// compareClusters compares versions, configuration, bucket inventory, IAM counts and enabled features of two
+// registered clusters, clusters.DefaultID being the cluster Console is configured with. The clusters are reached
+// through connect with their registry ID, empty for the default one.
+func compareClusters(ctx context.Context, registry *clusters.Registry, clusterA, clusterB string, connect func(id string) (MinioAdmin, error)) (*models.ClusterComparisonReport, error) {
+	var compared []clusters.Cluster
+	for _, id := range []string{clusterA, clusterB} {
+		if id == clusters.DefaultID || id == "" {
+			compared = append(compared, clusters.Cluster{Name: clusters.DefaultID, Endpoint: getMinIOServer()})
+			continue
+		}
+		cluster, err := registry.Get(id)
+		if err != nil {
+			return nil, clusterError(err)
+		}
+		compared = append(compared, cluster)
+	}
+	if compared[0].ID == compared[1].ID {
+		return nil, ErrInvalidSiteComparison
+	}
+
+	var states []*siteState
+	for _, cluster := range compared {
+		client, err := connect(cluster.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error connecting to cluster %s: %w", cluster.Name, err)
+		}
+		state, err := collectSiteState(ctx, client, cluster)
+		if err != nil {
+			return nil, fmt.Errorf("error collecting the state of cluster %s: %w", cluster.Name, err)
+		}
+		states = append(states, state)
+	}
+
+	drift := diffSiteStates(states[0], states[1])
+	return &models.ClusterComparisonReport{
+		SiteA:  states[0].snapshot,
+		SiteB:  states[1].snapshot,
+		Drift:  drift,
+		InSync: len(drift) == 0,
+	}, nil
+}
+
+// collectSiteState gathers the comparable state of a single cluster
+func collectSiteState(ctx context.Context, client MinioAdmin, cluster clusters.Cluster) (*siteState, error) {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountInfo, err := client.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := client.listTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &siteState{
+		snapshot: &models.ClusterSnapshot{
+			DeploymentID: info.DeploymentID,
+			Name:         cluster.Name,
+			Endpoint:     cluster.Endpoint,
+			Buckets:      int64(len(accountInfo.Buckets)),
+			Users:        int64(len(users)),
+			Groups:       int64(len(groups)),
+			Policies:     int64(len(policies)),
+			Versions:     []string{},
+			Features:     []string{},
+		},
+		buckets: map[string]bool{},
+		config:  map[string]string{},
+	}
+
+	versions := map[string]bool{}
+	for _, server := range info.Servers {
+		if server.Version != "" && !versions[server.Version] {
+			versions[server.Version] = true
+			state.snapshot.Versions = append(state.snapshot.Versions, server.Version)
+		}
+	}
+	sort.Strings(state.snapshot.Versions)
+
+	if info.Services.KMS.Status != "" {
+		state.snapshot.Features = append(state.snapshot.Features, "kms")
+	}
+	if info.Services.LDAP.Status != "" {
+		state.snapshot.Features = append(state.snapshot.Features, "ldap")
+	}
+	if len(info.Services.Notifications) > 0 {
+		state.snapshot.Features = append(state.snapshot.Features, "notifications")
+	}
+	if len(tiers) > 0 {
+		state.snapshot.Features = append(state.snapshot.Features, "tiering")
+	}
+
+	for _, bucket := range accountInfo.Buckets {
+		state.buckets[bucket.Name] = true
+	}
+
+	for _, subSys := range comparedConfigSubsystems {
+		value, err := client.getConfigKV(ctx, subSys)
+		if err != nil {
+			// a subsystem unknown to one of the versions shows up as drift instead of failing the comparison
+			state.config[subSys] = "unavailable"
+			continue
+		}
+		state.config[subSys] = strings.TrimSpace(string(value))
+	}
+	return state, nil
+}
+
+// diffSiteStates lists every difference found between two sites
+func diffSiteStates(a, b *siteState) []*models.ClusterDrift {
+	drift := []*models.ClusterDrift{}
+
+	versionsA := strings.Join(a.snapshot.Versions, ", ")
+	versionsB := strings.Join(b.snapshot.Versions, ", ")
+	if versionsA != versionsB {
+		drift = append(drift, &models.ClusterDrift{
+			Category: models.ClusterDriftCategoryVersion,
+			Item:     "minio",
+			SiteA:    versionsA,
+			SiteB:    versionsB,
+		})
+	}
+
+	for _, subSys := range comparedConfigSubsystems {
+		if a.config[subSys] != b.config[subSys] {
+			drift = append(drift, &models.ClusterDrift{
+				Category: models.ClusterDriftCategoryConfig,
+				Item:     subSys,
+				SiteA:    a.config[subSys],
+				SiteB:    b.config[subSys],
+			})
+		}
+	}
+
+	for _, bucket := range unionKeys(a.buckets, b.buckets) {
+		if a.buckets[bucket] != b.buckets[bucket] {
+			drift = append(drift, &models.ClusterDrift{
+				Category: models.ClusterDriftCategoryBucket,
+				Item:     bucket,
+				SiteA:    presence(a.buckets[bucket], "present", "missing"),
+				SiteB:    presence(b.buckets[bucket], "present", "missing"),
+			})
+		}
+	}
+
+	iamCounts := []struct {
+		item string
+		a, b int64
+	}{
+		{"users", a.snapshot.Users, b.snapshot.Users},
+		{"groups", a.snapshot.Groups, b.snapshot.Groups},
+		{"policies", a.snapshot.Policies, b.snapshot.Policies},
+	}
+	for _, count := range iamCounts {
+		if count.a != count.b {
+			drift = append(drift, &models.ClusterDrift{
+				Category: models.ClusterDriftCategoryIam,
+				Item:     count.item,
+				SiteA:    fmt.Sprint(count.a),
+				SiteB:    fmt.Sprint(count.b),
+			})
+		}
+	}
+
+	featuresA := toSet(a.snapshot.Features)
+	featuresB := toSet(b.snapshot.Features)
+	for _, feature := range unionKeys(featuresA, featuresB) {
+		if featuresA[feature] != featuresB[feature] {
+			drift = append(drift, &models.ClusterDrift{
+				Category: models.ClusterDriftCategoryFeature,
+				Item:     feature,
+				SiteA:    presence(featuresA[feature], "enabled", "disabled"),
+				SiteB:    presence(featuresB[feature], "enabled", "disabled"),
+			})
+		}
+	}
+	return drift
+}
+
+func unionKeys(a, b map[string]bool) []string {
+	var keys []string
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if !a[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+func presence(ok bool, yes, no string) string {
+	if ok {
+		return yes
+	}
+	return no
+}
@@ -0,0 +1,168 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/clusters"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+// siteAdminMock answers with the state of a single site so two of them can be compared
+type siteAdminMock struct {
+	AdminClientMock
+	info     madmin.InfoMessage
+	buckets  []string
+	users    int
+	config   map[string]string
+	tierless bool
+}
+
+func (s siteAdminMock) serverInfo(_ context.Context) (madmin.InfoMessage, error) {
+	return s.info, nil
+}
+
+func (s siteAdminMock) AccountInfo(_ context.Context) (madmin.AccountInfo, error) {
+	info := madmin.AccountInfo{}
+	for _, b := range s.buckets {
+		info.Buckets = append(info.Buckets, madmin.BucketAccessInfo{Name: b})
+	}
+	return info, nil
+}
+
+func (s siteAdminMock) listUsers(_ context.Context) (map[string]madmin.UserInfo, error) {
+	users := map[string]madmin.UserInfo{}
+	for i := 0; i < s.users; i++ {
+		users[string(rune('a'+i))] = madmin.UserInfo{}
+	}
+	return users, nil
+}
+
+func (s siteAdminMock) listGroups(_ context.Context) ([]string, error) {
+	return []string{"admins"}, nil
+}
+
+func (s siteAdminMock) listPolicies(_ context.Context) (map[string]*iampolicy.Policy, error) {
+	return map[string]*iampolicy.Policy{"readwrite": {}}, nil
+}
+
+func (s siteAdminMock) listTiers(_ context.Context) ([]*madmin.TierConfig, error) {
+	if s.tierless {
+		return nil, nil
+	}
+	return []*madmin.TierConfig{{Name: "WARM"}}, nil
+}
+
+func (s siteAdminMock) getConfigKV(_ context.Context, key string) ([]byte, error) {
+	if v, ok := s.config[key]; ok {
+		return []byte(v), nil
+	}
+	return nil, errors.New("unknown sub-system")
+}
+
+func Test_compareClusters(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	registry, err := clusters.New("", nil)
+	assert.NoError(err)
+	_, err = registry.Set(clusters.Cluster{ID: "dr", Name: "DR", Endpoint: "https://dr:9000", Auth: clusters.AuthSTS}, "", time.Unix(1700000000, 0))
+	assert.NoError(err)
+
+	config := map[string]string{}
+	for _, subSys := range comparedConfigSubsystems {
+		config[subSys] = subSys + " enable=on"
+	}
+	siteA := siteAdminMock{
+		info: madmin.InfoMessage{
+			DeploymentID: "dep-a",
+			Servers:      []madmin.ServerProperties{{Version: "2023-03-20T20-16-18Z"}, {Version: "2023-03-20T20-16-18Z"}},
+		},
+		buckets: []string{"images", "logs"},
+		users:   2,
+		config:  config,
+	}
+	siteB := siteAdminMock{
+		info: madmin.InfoMessage{
+			DeploymentID: "dep-b",
+			Servers:      []madmin.ServerProperties{{Version: "2023-03-20T20-16-18Z"}},
+		},
+		buckets: []string{"images", "logs"},
+		users:   2,
+		config:  config,
+	}
+	// the cluster Console is configured with is reached without a registry ID
+	connect := func(id string) (MinioAdmin, error) {
+		if id == "" {
+			return siteA, nil
+		}
+		assert.Equal("dr", id)
+		return siteB, nil
+	}
+
+	// identical clusters
+	report, err := compareClusters(ctx, registry, clusters.DefaultID, "dr", connect)
+	assert.NoError(err)
+	assert.True(report.InSync)
+	assert.Empty(report.Drift)
+	assert.Equal([]string{"2023-03-20T20-16-18Z"}, report.SiteA.Versions)
+	assert.Equal([]string{"tiering"}, report.SiteA.Features)
+	assert.Equal("dep-a", report.SiteA.DeploymentID)
+	assert.Equal(getMinIOServer(), report.SiteA.Endpoint)
+	assert.Equal("DR", report.SiteB.Name)
+	assert.Equal("https://dr:9000", report.SiteB.Endpoint)
+
+	// drifted clusters
+	driftedConfig := map[string]string{}
+	for k, v := range config {
+		driftedConfig[k] = v
+	}
+	driftedConfig["scanner"] = "scanner speed=slow"
+	siteB.info.Servers = []madmin.ServerProperties{{Version: "2023-01-02T09-40-09Z"}}
+	siteB.buckets = []string{"images", "backups"}
+	siteB.users = 3
+	siteB.config = driftedConfig
+	siteB.tierless = true
+	report, err = compareClusters(ctx, registry, clusters.DefaultID, "dr", connect)
+	assert.NoError(err)
+	assert.False(report.InSync)
+	assert.Equal([]*models.ClusterDrift{
+		{Category: models.ClusterDriftCategoryVersion, Item: "minio", SiteA: "2023-03-20T20-16-18Z", SiteB: "2023-01-02T09-40-09Z"},
+		{Category: models.ClusterDriftCategoryConfig, Item: "scanner", SiteA: "scanner enable=on", SiteB: "scanner speed=slow"},
+		{Category: models.ClusterDriftCategoryBucket, Item: "backups", SiteA: "missing", SiteB: "present"},
+		{Category: models.ClusterDriftCategoryBucket, Item: "logs", SiteA: "present", SiteB: "missing"},
+		{Category: models.ClusterDriftCategoryIam, Item: "users", SiteA: "2", SiteB: "3"},
+		{Category: models.ClusterDriftCategoryFeature, Item: "tiering", SiteA: "enabled", SiteB: "disabled"},
+	}, report.Drift)
+
+	// a cluster compared against itself
+	_, err = compareClusters(ctx, registry, "dr", "dr", connect)
+	assert.ErrorIs(err, ErrInvalidSiteComparison)
+	_, err = compareClusters(ctx, registry, clusters.DefaultID, "", connect)
+	assert.ErrorIs(err, ErrInvalidSiteComparison)
+
+	// unregistered clusters
+	_, err = compareClusters(ctx, registry, clusters.DefaultID, "staging", connect)
+	assert.ErrorIs(err, ErrClusterNotFound)
+}
@@ -20,6 +20,7 @@ package restapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -253,3 +254,54 @@ func TestSiteReplicationStatus(t *testing.T) {
 
 	assert.Equal(expValueMock, srInfo, fmt.Sprintf("Failed on %s: expected result is not same", function))
 }
+
+func TestSiteReplicationHealth(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	getSiteReplicationStatus = func(ctx context.Context, params madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
+		return &madmin.SRStatusInfo{
+			Enabled: true,
+			Sites: map[string]madmin.PeerInfo{
+				"dep-1": {Name: "site-1", Endpoint: "https://site-1:9000", DeploymentID: "dep-1"},
+				"dep-2": {Name: "site-2", Endpoint: "https://site-2:9000", DeploymentID: "dep-2"},
+			},
+			StatsSummary: map[string]madmin.SRSiteSummary{
+				"dep-1": {TotalBucketsCount: 2, ReplicatedBuckets: 2, TotalUsersCount: 1, ReplicatedUsers: 1},
+				"dep-2": {TotalBucketsCount: 2, ReplicatedBuckets: 1, TotalUsersCount: 1, ReplicatedUsers: 1},
+			},
+			BucketStats: map[string]map[string]madmin.SRBucketStatsSummary{
+				"images": {
+					"dep-1": {HasBucket: true, PolicyMismatch: false},
+					"dep-2": {HasBucket: true, PolicyMismatch: true},
+				},
+			},
+			UserStats: map[string]map[string]madmin.SRUserStatsSummary{
+				"alice": {
+					"dep-1": {HasUser: true},
+				},
+			},
+		}, nil
+	}
+
+	health, err := getSRHealth(ctx, adminClient)
+	assert.Nil(err)
+	assert.True(health.Enabled)
+	assert.False(health.Healthy)
+	assert.Len(health.Sites, 2)
+	assert.Equal("site-1", health.Sites[0].Name)
+	assert.True(health.Sites[0].Healthy)
+	assert.Equal("site-2", health.Sites[1].Name)
+	assert.False(health.Sites[1].Healthy)
+	assert.Equal([]string{"images"}, health.Sites[1].Buckets.OutOfSync)
+	assert.Equal([]string{"alice"}, health.Sites[1].Users.OutOfSync)
+	assert.Equal(int64(1), health.Sites[1].Buckets.Replicated)
+
+	getSiteReplicationStatus = func(ctx context.Context, params madmin.SRStatusOptions) (*madmin.SRStatusInfo, error) {
+		return nil, errors.New("site replication is not reachable")
+	}
+	_, err = getSRHealth(ctx, adminClient)
+	assert.NotNil(err)
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
@@ -99,7 +100,7 @@ func getSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error
 func startSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, speedtestOpts *madmin.SpeedtestOpts) error {
 	speedtestRes, err := client.speedtest(ctx, *speedtestOpts)
 	if err != nil {
-		LogError("error initializing speedtest: %v", err)
+		LogErrorContext(ctx, "error initializing speedtest: %v", err)
 		return err
 	}
 
@@ -107,16 +108,280 @@ func startSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, speedte
 		// Serializing message
 		bytes, err := json.Marshal(result)
 		if err != nil {
-			LogError("error serializing json: %v", err)
+			LogErrorContext(ctx, "error serializing json: %v", err)
 			return err
 		}
 		// Send Message through websocket connection
 		err = conn.writeMessage(websocket.TextMessage, bytes)
 		if err != nil {
-			LogError("error writing speedtest response: %v", err)
+			LogErrorContext(ctx, "error writing speedtest response: %v", err)
 			return err
 		}
 	}
 
 	return nil
 }
+
+// Kinds of speedtests streamed with a final report
+const (
+	speedtestObject = "object"
+	speedtestDrive  = "drive"
+	speedtestNet    = "net"
+)
+
+// speedtestRequest holds the options of the speedtest selected by the websocket path,
+// `/speedtest/object`, `/speedtest/drive` or `/speedtest/net`
+type speedtestRequest struct {
+	Test   string
+	Object *madmin.SpeedtestOpts
+	Drive  madmin.DriveSpeedTestOpts
+	// Duration of the network test
+	Duration time.Duration
+}
+
+// speedtestMessage is sent for every result of a speedtest, then once with the report of the whole test
+type speedtestMessage struct {
+	Type   string           `json:"type"`
+	Test   string           `json:"test"`
+	Result interface{}      `json:"result,omitempty"`
+	Report *speedtestReport `json:"report,omitempty"`
+}
+
+// speedtestReport summarizes a speedtest per node, throughputs are in bytes per second
+type speedtestReport struct {
+	Test        string `json:"test"`
+	Servers     int    `json:"servers,omitempty"`
+	Drives      int    `json:"drives,omitempty"`
+	Size        int    `json:"size,omitempty"`
+	Concurrency int    `json:"concurrency,omitempty"`
+
+	PutThroughput    uint64 `json:"putThroughput,omitempty"`
+	PutObjectsPerSec uint64 `json:"putObjectsPerSec,omitempty"`
+	GetThroughput    uint64 `json:"getThroughput,omitempty"`
+	GetObjectsPerSec uint64 `json:"getObjectsPerSec,omitempty"`
+	ReadThroughput   uint64 `json:"readThroughput,omitempty"`
+	WriteThroughput  uint64 `json:"writeThroughput,omitempty"`
+	TxThroughput     uint64 `json:"txThroughput,omitempty"`
+	RxThroughput     uint64 `json:"rxThroughput,omitempty"`
+
+	Nodes []speedtestNodeReport `json:"nodes"`
+}
+
+// speedtestNodeReport is the result of a node, or of one of its drives for drive speedtests
+type speedtestNodeReport struct {
+	Endpoint        string `json:"endpoint"`
+	Path            string `json:"path,omitempty"`
+	PutThroughput   uint64 `json:"putThroughput,omitempty"`
+	GetThroughput   uint64 `json:"getThroughput,omitempty"`
+	ReadThroughput  uint64 `json:"readThroughput,omitempty"`
+	WriteThroughput uint64 `json:"writeThroughput,omitempty"`
+	TxThroughput    uint64 `json:"txThroughput,omitempty"`
+	RxThroughput    uint64 `json:"rxThroughput,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// netperfProgress is sent every second while the network is tested since MinIO reports it all at once
+type netperfProgress struct {
+	ElapsedSeconds  int `json:"elapsedSeconds"`
+	DurationSeconds int `json:"durationSeconds"`
+}
+
+// getSpeedtestRequestFromReq reads the options of a speedtest, path come as :
+// `/speedtest/drive?blockSize=4MiB&fileSize=1GiB&serial=true` or `/speedtest/net?duration=10s`
+func getSpeedtestRequestFromReq(test string, req *http.Request) (*speedtestRequest, error) {
+	queryPairs := req.URL.Query()
+	request := &speedtestRequest{Test: test}
+	switch test {
+	case speedtestObject:
+		opts, err := getSpeedtestOptionsFromReq(req)
+		if err != nil {
+			return nil, err
+		}
+		request.Object = opts
+	case speedtestDrive:
+		blockSize, err := humanize.ParseBytes(getQueryValue(queryPairs, "blockSize", "4MiB"))
+		if err != nil || blockSize == 0 {
+			return nil, fmt.Errorf("invalid block size")
+		}
+		fileSize, err := humanize.ParseBytes(getQueryValue(queryPairs, "fileSize", "1GiB"))
+		if err != nil || fileSize < blockSize {
+			return nil, fmt.Errorf("the file size has to be at least the block size")
+		}
+		request.Drive = madmin.DriveSpeedTestOpts{
+			Serial:    queryPairs.Get("serial") == "true",
+			BlockSize: blockSize,
+			FileSize:  fileSize,
+		}
+	case speedtestNet:
+		duration, err := time.ParseDuration(getQueryValue(queryPairs, "duration", "10s"))
+		if err != nil || duration < time.Second {
+			return nil, fmt.Errorf("the duration has to be at least 1s")
+		}
+		request.Duration = duration
+	default:
+		return nil, fmt.Errorf("unknown speedtest: %s", test)
+	}
+	return request, nil
+}
+
+// getQueryValue returns the value of a query parameter or def when it's missing
+func getQueryValue(values url.Values, key, def string) string {
+	if value := values.Get(key); value != "" {
+		return value
+	}
+	return def
+}
+
+func writeSpeedtestMessage(conn WSConn, message speedtestMessage) error {
+	bytes, err := json.Marshal(message)
+	if err != nil {
+		LogError("error serializing json: %v", err)
+		return err
+	}
+	if err = conn.writeMessage(websocket.TextMessage, bytes); err != nil {
+		LogError("error writing speedtest response: %v", err)
+		return err
+	}
+	return nil
+}
+
+// objectSpeedtestReport reports the last result of an object speedtest, with autotune it's the one that
+// reached the highest throughput
+func objectSpeedtestReport(result madmin.SpeedTestResult) *speedtestReport {
+	report := &speedtestReport{
+		Test:             speedtestObject,
+		Servers:          result.Servers,
+		Drives:           result.Disks,
+		Size:             result.Size,
+		Concurrency:      result.Concurrent,
+		PutThroughput:    result.PUTStats.ThroughputPerSec,
+		PutObjectsPerSec: result.PUTStats.ObjectsPerSec,
+		GetThroughput:    result.GETStats.ThroughputPerSec,
+		GetObjectsPerSec: result.GETStats.ObjectsPerSec,
+		Nodes:            []speedtestNodeReport{},
+	}
+	nodes := map[string]int{}
+	node := func(stat madmin.SpeedTestStatServer) *speedtestNodeReport {
+		i, ok := nodes[stat.Endpoint]
+		if !ok {
+			i = len(report.Nodes)
+			nodes[stat.Endpoint] = i
+			report.Nodes = append(report.Nodes, speedtestNodeReport{Endpoint: stat.Endpoint})
+		}
+		if stat.Err != "" {
+			report.Nodes[i].Error = stat.Err
+		}
+		return &report.Nodes[i]
+	}
+	for _, stat := range result.PUTStats.Servers {
+		node(stat).PutThroughput = stat.ThroughputPerSec
+	}
+	for _, stat := range result.GETStats.Servers {
+		node(stat).GetThroughput = stat.ThroughputPerSec
+	}
+	return report
+}
+
+// driveSpeedtestReport reports every drive of the servers tested
+func driveSpeedtestReport(results []madmin.DriveSpeedTestResult) *speedtestReport {
+	report := &speedtestReport{Test: speedtestDrive, Servers: len(results), Nodes: []speedtestNodeReport{}}
+	for _, result := range results {
+		if result.Error != "" {
+			report.Nodes = append(report.Nodes, speedtestNodeReport{Endpoint: result.Endpoint, Error: result.Error})
+			continue
+		}
+		for _, drive := range result.DrivePerf {
+			report.Drives++
+			report.ReadThroughput += drive.ReadThroughput
+			report.WriteThroughput += drive.WriteThroughput
+			report.Nodes = append(report.Nodes, speedtestNodeReport{
+				Endpoint:        result.Endpoint,
+				Path:            drive.Path,
+				ReadThroughput:  drive.ReadThroughput,
+				WriteThroughput: drive.WriteThroughput,
+				Error:           drive.Error,
+			})
+		}
+	}
+	return report
+}
+
+// netperfReport reports the throughput every node sent and received
+func netperfReport(result madmin.NetperfResult) *speedtestReport {
+	report := &speedtestReport{Test: speedtestNet, Servers: len(result.NodeResults), Nodes: []speedtestNodeReport{}}
+	for _, node := range result.NodeResults {
+		report.TxThroughput += node.TX
+		report.RxThroughput += node.RX
+		report.Nodes = append(report.Nodes, speedtestNodeReport{
+			Endpoint:     node.Endpoint,
+			TxThroughput: node.TX,
+			RxThroughput: node.RX,
+			Error:        node.Error,
+		})
+	}
+	return report
+}
+
+// runSpeedtest streams the results of a speedtest as they come then sends its report
+func runSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, request *speedtestRequest) error {
+	var report *speedtestReport
+	switch request.Test {
+	case speedtestObject:
+		results, err := client.speedtest(ctx, *request.Object)
+		if err != nil {
+			LogErrorContext(ctx, "error initializing speedtest: %v", err)
+			return err
+		}
+		var last madmin.SpeedTestResult
+		for result := range results {
+			last = result
+			if err = writeSpeedtestMessage(conn, speedtestMessage{Type: "progress", Test: request.Test, Result: result}); err != nil {
+				return err
+			}
+		}
+		report = objectSpeedtestReport(last)
+	case speedtestDrive:
+		results, err := client.driveSpeedtest(ctx, request.Drive)
+		if err != nil {
+			LogErrorContext(ctx, "error initializing drive speedtest: %v", err)
+			return err
+		}
+		var all []madmin.DriveSpeedTestResult
+		for result := range results {
+			all = append(all, result)
+			if err = writeSpeedtestMessage(conn, speedtestMessage{Type: "progress", Test: request.Test, Result: result}); err != nil {
+				return err
+			}
+		}
+		report = driveSpeedtestReport(all)
+	case speedtestNet:
+		type netperfOutcome struct {
+			result madmin.NetperfResult
+			err    error
+		}
+		done := make(chan netperfOutcome, 1)
+		go func() {
+			result, err := client.netperf(ctx, request.Duration)
+			done <- netperfOutcome{result, err}
+		}()
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		progress := netperfProgress{DurationSeconds: int(request.Duration.Seconds())}
+		for report == nil {
+			select {
+			case outcome := <-done:
+				if outcome.err != nil {
+					LogErrorContext(ctx, "error running network speedtest: %v", outcome.err)
+					return outcome.err
+				}
+				report = netperfReport(outcome.result)
+			case <-ticker.C:
+				progress.ElapsedSeconds++
+				if err := writeSpeedtestMessage(conn, speedtestMessage{Type: "progress", Test: request.Test, Result: progress}); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return writeSpeedtestMessage(conn, speedtestMessage{Type: "report", Test: request.Test, Report: report})
+}
@@ -0,0 +1,171 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_getSpeedtestRequestFromReq(t *testing.T) {
+	assert := assert.New(t)
+
+	req := httptest.NewRequest("GET", "/ws/speedtest/object?size=8MiB&concurrent=4&autotune=true", nil)
+	request, err := getSpeedtestRequestFromReq(speedtestObject, req)
+	if assert.NoError(err) {
+		assert.Equal(8<<20, request.Object.Size)
+		assert.Equal(4, request.Object.Concurrency)
+		assert.True(request.Object.Autotune)
+	}
+
+	req = httptest.NewRequest("GET", "/ws/speedtest/drive", nil)
+	request, err = getSpeedtestRequestFromReq(speedtestDrive, req)
+	if assert.NoError(err) {
+		assert.Equal(madmin.DriveSpeedTestOpts{BlockSize: 4 << 20, FileSize: 1 << 30}, request.Drive)
+	}
+	req = httptest.NewRequest("GET", "/ws/speedtest/drive?blockSize=8MiB&fileSize=4MiB", nil)
+	_, err = getSpeedtestRequestFromReq(speedtestDrive, req)
+	assert.Error(err)
+
+	req = httptest.NewRequest("GET", "/ws/speedtest/net?duration=30s", nil)
+	request, err = getSpeedtestRequestFromReq(speedtestNet, req)
+	if assert.NoError(err) {
+		assert.Equal(30*time.Second, request.Duration)
+	}
+	req = httptest.NewRequest("GET", "/ws/speedtest/net?duration=10ms", nil)
+	_, err = getSpeedtestRequestFromReq(speedtestNet, req)
+	assert.Error(err)
+
+	_, err = getSpeedtestRequestFromReq("site", req)
+	assert.Error(err)
+}
+
+// speedtestMessages runs a speedtest returning the messages written to the websocket
+func speedtestMessages(t *testing.T, request *speedtestRequest) ([]speedtestMessage, error) {
+	var messages []speedtestMessage
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var message speedtestMessage
+		assert.NoError(t, json.Unmarshal(data, &message))
+		messages = append(messages, message)
+		return nil
+	}
+	err := runSpeedtest(context.Background(), mockConn{}, AdminClientMock{}, request)
+	return messages, err
+}
+
+func Test_runObjectSpeedtest(t *testing.T) {
+	assert := assert.New(t)
+
+	minioSpeedtestMock = func(opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error) {
+		results := make(chan madmin.SpeedTestResult, 2)
+		results <- madmin.SpeedTestResult{Servers: 2, Concurrent: 16}
+		results <- madmin.SpeedTestResult{
+			Servers: 2, Disks: 8, Size: opts.Size, Concurrent: 32,
+			PUTStats: madmin.SpeedTestStats{ThroughputPerSec: 300, ObjectsPerSec: 3, Servers: []madmin.SpeedTestStatServer{
+				{Endpoint: "node1:9000", ThroughputPerSec: 100},
+				{Endpoint: "node2:9000", ThroughputPerSec: 200},
+			}},
+			GETStats: madmin.SpeedTestStats{ThroughputPerSec: 500, ObjectsPerSec: 5, Servers: []madmin.SpeedTestStatServer{
+				{Endpoint: "node2:9000", ThroughputPerSec: 400},
+				{Endpoint: "node1:9000", Err: "timeout"},
+			}},
+		}
+		close(results)
+		return results, nil
+	}
+	messages, err := speedtestMessages(t, &speedtestRequest{Test: speedtestObject, Object: &madmin.SpeedtestOpts{Size: 64}})
+	assert.NoError(err)
+	if !assert.Len(messages, 3) {
+		return
+	}
+	assert.Equal("progress", messages[0].Type)
+	report := messages[2].Report
+	assert.Equal("report", messages[2].Type)
+	assert.Equal(32, report.Concurrency)
+	assert.Equal(64, report.Size)
+	assert.Equal(uint64(300), report.PutThroughput)
+	assert.Equal(uint64(5), report.GetObjectsPerSec)
+	assert.Equal([]speedtestNodeReport{
+		{Endpoint: "node1:9000", PutThroughput: 100, Error: "timeout"},
+		{Endpoint: "node2:9000", PutThroughput: 200, GetThroughput: 400},
+	}, report.Nodes)
+
+	minioSpeedtestMock = func(opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error) {
+		return nil, errors.New("error")
+	}
+	_, err = speedtestMessages(t, &speedtestRequest{Test: speedtestObject, Object: &madmin.SpeedtestOpts{}})
+	assert.Error(err)
+}
+
+func Test_runDriveSpeedtest(t *testing.T) {
+	assert := assert.New(t)
+
+	minioDriveSpeedtestMock = func(opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+		results := make(chan madmin.DriveSpeedTestResult, 2)
+		results <- madmin.DriveSpeedTestResult{Endpoint: "node1:9000", DrivePerf: []madmin.DrivePerf{
+			{Path: "/data1", ReadThroughput: 10, WriteThroughput: 5},
+			{Path: "/data2", ReadThroughput: 20, WriteThroughput: 7},
+		}}
+		results <- madmin.DriveSpeedTestResult{Endpoint: "node2:9000", Error: "drive offline"}
+		close(results)
+		return results, nil
+	}
+	messages, err := speedtestMessages(t, &speedtestRequest{Test: speedtestDrive})
+	assert.NoError(err)
+	if !assert.Len(messages, 3) {
+		return
+	}
+	report := messages[2].Report
+	assert.Equal(2, report.Servers)
+	assert.Equal(2, report.Drives)
+	assert.Equal(uint64(30), report.ReadThroughput)
+	assert.Equal(uint64(12), report.WriteThroughput)
+	if assert.Len(report.Nodes, 3) {
+		assert.Equal("/data2", report.Nodes[1].Path)
+		assert.Equal("drive offline", report.Nodes[2].Error)
+	}
+}
+
+func Test_runNetperf(t *testing.T) {
+	assert := assert.New(t)
+
+	minioNetperfMock = func(duration time.Duration) (madmin.NetperfResult, error) {
+		time.Sleep(1500 * time.Millisecond)
+		return madmin.NetperfResult{NodeResults: []madmin.NetperfNodeResult{
+			{Endpoint: "node1:9000", TX: 100, RX: 90},
+			{Endpoint: "node2:9000", TX: 80, RX: 110},
+		}}, nil
+	}
+	messages, err := speedtestMessages(t, &speedtestRequest{Test: speedtestNet, Duration: time.Second})
+	assert.NoError(err)
+	if !assert.Len(messages, 2) {
+		return
+	}
+	// the progress of the test is reported while it runs
+	assert.Equal("progress", messages[0].Type)
+	report := messages[1].Report
+	assert.Equal(uint64(180), report.TxThroughput)
+	assert.Equal(uint64(200), report.RxThroughput)
+	assert.Equal(2, report.Servers)
+}
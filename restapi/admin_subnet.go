@@ -210,7 +210,10 @@ func GetSubnetHTTPClient(ctx context.Context, minioClient MinioAdmin) (*xhttp.Cl
 		if err != nil {
 			return nil, err
 		}
-		subnetHTTPClient.Transport.(*http.Transport).Proxy = http.ProxyURL(subnetProxyURL)
+		// a client of its own, the one of GetConsoleHTTPClient is shared
+		transport := PrepareSTSClientTransport(false)
+		transport.Proxy = http.ProxyURL(subnetProxyURL)
+		subnetHTTPClient = &http.Client{Transport: instrumentTransport(transport)}
 	} else {
 		subnetHTTPClient = GetConsoleHTTPClient("")
 	}
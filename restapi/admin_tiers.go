@@ -19,6 +19,7 @@ package restapi
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
 	"strconv"
 
 	"github.com/dustin/go-humanize"
@@ -62,6 +63,38 @@ func registerAdminTiersHandlers(api *operations.ConsoleAPI) {
 		}
 		return tieringApi.NewEditTierCredentialsOK()
 	})
+	// remove a tier
+	api.TieringRemoveTierHandler = tieringApi.RemoveTierHandlerFunc(func(params tieringApi.RemoveTierParams, session *models.Principal) middleware.Responder {
+		err := getRemoveTierResponse(session, params)
+		if err != nil {
+			return tieringApi.NewRemoveTierDefault(int(err.Code)).WithPayload(err)
+		}
+		return tieringApi.NewRemoveTierNoContent()
+	})
+	// verify the connectivity of a tier
+	api.TieringVerifyTierHandler = tieringApi.VerifyTierHandlerFunc(func(params tieringApi.VerifyTierParams, session *models.Principal) middleware.Responder {
+		verification, err := getVerifyTierResponse(session, params)
+		if err != nil {
+			return tieringApi.NewVerifyTierDefault(int(err.Code)).WithPayload(err)
+		}
+		return tieringApi.NewVerifyTierOK().WithPayload(verification)
+	})
+	// statistics of a tier
+	api.TieringGetTierStatsHandler = tieringApi.GetTierStatsHandlerFunc(func(params tieringApi.GetTierStatsParams, session *models.Principal) middleware.Responder {
+		stats, err := getTierStatsResponse(session, params)
+		if err != nil {
+			return tieringApi.NewGetTierStatsDefault(int(err.Code)).WithPayload(err)
+		}
+		return tieringApi.NewGetTierStatsOK().WithPayload(stats)
+	})
+}
+
+// tierConfigTypes maps the tier types of the API to the ones of MinIO
+var tierConfigTypes = map[string]madmin.TierType{
+	models.TierTypeS3:    madmin.S3,
+	models.TierTypeGcs:   madmin.GCS,
+	models.TierTypeAzure: madmin.Azure,
+	models.TierTypeMinio: madmin.MinIO,
 }
 
 // getNotificationEndpoints invokes admin info and returns a list of notification endpoints
@@ -350,6 +383,22 @@ func getTier(ctx context.Context, client MinioAdmin, params *tieringApi.GetTierP
 					Region:      tiers[i].Azure.Region,
 				},
 			}, nil
+		case madmin.MinIO:
+			if params.Type != models.TierTypeMinio || tiers[i].Name != params.Name {
+				continue
+			}
+			return &models.Tier{
+				Type: models.TierTypeMinio,
+				Minio: &models.TierMinio{
+					Accesskey: tiers[i].MinIO.AccessKey,
+					Bucket:    tiers[i].MinIO.Bucket,
+					Endpoint:  tiers[i].MinIO.Endpoint,
+					Name:      tiers[i].Name,
+					Prefix:    tiers[i].MinIO.Prefix,
+					Region:    tiers[i].MinIO.Region,
+					Secretkey: tiers[i].MinIO.SecretKey,
+				},
+			}, nil
 		}
 	}
 
@@ -409,3 +458,130 @@ func getEditTierCredentialsResponse(session *models.Principal, params tieringApi
 	}
 	return nil
 }
+
+// findTier returns the configuration of the tier with the given type and name
+func findTier(ctx context.Context, client MinioAdmin, tierType, name string) (*madmin.TierConfig, error) {
+	configType, ok := tierConfigTypes[tierType]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	tiers, err := client.listTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tier := range tiers {
+		if tier.Name == name && tier.Type == configType {
+			return tier, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// statsOfTier returns the objects transitioned to a tier, a tier nothing was transitioned to
+// yet has no statistics
+func statsOfTier(ctx context.Context, client MinioAdmin, name string) (madmin.TierStats, error) {
+	tiersInfo, err := client.tierStats(ctx)
+	if err != nil {
+		return madmin.TierStats{}, err
+	}
+	for _, info := range tiersInfo {
+		if info.Name == name {
+			return info.Stats, nil
+		}
+	}
+	return madmin.TierStats{}, nil
+}
+
+// removeTier removes a tier once no object or version is stored in it, removing a tier in use
+// would leave the transitioned objects unreachable
+func removeTier(ctx context.Context, client MinioAdmin, tierType, name string) error {
+	if _, err := findTier(ctx, client, tierType, name); err != nil {
+		return err
+	}
+	stats, err := statsOfTier(ctx, client, name)
+	if err != nil {
+		return err
+	}
+	if stats.NumObjects > 0 || stats.NumVersions > 0 {
+		return fmt.Errorf("%w: %s holds %d objects and %d versions", ErrTierNotEmpty, name, stats.NumObjects, stats.NumVersions)
+	}
+	return client.removeTier(ctx, name)
+}
+
+// verifyTier checks MinIO can reach the tier, an unreachable tier isn't an error of the request
+func verifyTier(ctx context.Context, client MinioAdmin, tierType, name string) (*models.TierVerification, error) {
+	if _, err := findTier(ctx, client, tierType, name); err != nil {
+		return nil, err
+	}
+	verification := &models.TierVerification{Name: name, Type: tierType, Online: true}
+	if err := client.verifyTierStatus(ctx, name); err != nil {
+		verification.Online = false
+		verification.Error = err.Error()
+	}
+	return verification, nil
+}
+
+// getTierStats returns what was transitioned to a tier along with its connectivity
+func getTierStats(ctx context.Context, client MinioAdmin, tierType, name string) (*models.TierStats, error) {
+	verification, err := verifyTier(ctx, client, tierType, name)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := statsOfTier(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	return &models.TierStats{
+		Name:     name,
+		Type:     tierType,
+		Objects:  int64(stats.NumObjects),
+		Versions: int64(stats.NumVersions),
+		Size:     int64(stats.TotalSize),
+		Online:   verification.Online,
+		Error:    verification.Error,
+	}, nil
+}
+
+// getRemoveTierResponse returns the result of removing a tier
+func getRemoveTierResponse(session *models.Principal, params tieringApi.RemoveTierParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err = removeTier(ctx, AdminClient{Client: mAdmin}, params.Type, params.Name); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// getVerifyTierResponse returns whether a tier is reachable
+func getVerifyTierResponse(session *models.Principal, params tieringApi.VerifyTierParams) (*models.TierVerification, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	verification, err := verifyTier(ctx, AdminClient{Client: mAdmin}, params.Type, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return verification, nil
+}
+
+// getTierStatsResponse returns the statistics of a tier
+func getTierStatsResponse(session *models.Principal, params tieringApi.GetTierStatsParams) (*models.TierStats, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	stats, err := getTierStats(ctx, AdminClient{Client: mAdmin}, params.Type, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return stats, nil
+}
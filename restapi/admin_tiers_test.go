@@ -228,3 +228,76 @@ func TestUpdateTierCreds(t *testing.T) {
 
 	assert.Equal(errors.New("error message"), errT2, fmt.Sprintf("Failed on %s: Error returned", function))
 }
+
+func TestRemoveTier(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	minioListTiersMock = func(ctx context.Context) ([]*madmin.TierConfig, error) {
+		return []*madmin.TierConfig{
+			{Type: madmin.MinIO, Name: "WARM", MinIO: &madmin.TierMinIO{Bucket: "warm"}},
+			{Type: madmin.S3, Name: "COLD", S3: &madmin.TierS3{Bucket: "cold"}},
+		}, nil
+	}
+	minioTierStatsMock = func(ctx context.Context) ([]madmin.TierInfo, error) {
+		return []madmin.TierInfo{
+			{Name: "WARM", Type: "minio", Stats: madmin.TierStats{NumObjects: 3, NumVersions: 4, TotalSize: 1024}},
+		}, nil
+	}
+	var removed []string
+	minioRemoveTierMock = func(ctx context.Context, tierName string) error {
+		removed = append(removed, tierName)
+		return nil
+	}
+
+	// objects were transitioned to the tier
+	err := removeTier(ctx, adminClient, models.TierTypeMinio, "WARM")
+	assert.ErrorIs(err, ErrTierNotEmpty)
+	// the type has to match the tier
+	err = removeTier(ctx, adminClient, models.TierTypeMinio, "COLD")
+	assert.ErrorIs(err, ErrNotFound)
+
+	assert.NoError(removeTier(ctx, adminClient, models.TierTypeS3, "COLD"))
+	assert.Equal([]string{"COLD"}, removed)
+}
+
+func TestGetTierStats(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func() { minioVerifyTierStatusMock = nil }()
+
+	minioListTiersMock = func(ctx context.Context) ([]*madmin.TierConfig, error) {
+		return []*madmin.TierConfig{
+			{Type: madmin.MinIO, Name: "WARM", MinIO: &madmin.TierMinIO{Bucket: "warm"}},
+		}, nil
+	}
+	minioTierStatsMock = func(ctx context.Context) ([]madmin.TierInfo, error) {
+		return []madmin.TierInfo{
+			{Name: "WARM", Type: "minio", Stats: madmin.TierStats{NumObjects: 3, NumVersions: 4, TotalSize: 1024}},
+		}, nil
+	}
+	minioVerifyTierStatusMock = func(ctx context.Context, tierName string) error {
+		return errors.New("remote tier bucket not found")
+	}
+
+	stats, err := getTierStats(ctx, adminClient, models.TierTypeMinio, "WARM")
+	assert.NoError(err)
+	assert.Equal(int64(3), stats.Objects)
+	assert.Equal(int64(4), stats.Versions)
+	assert.Equal(int64(1024), stats.Size)
+	assert.False(stats.Online)
+	assert.Equal("remote tier bucket not found", stats.Error)
+
+	minioVerifyTierStatusMock = nil
+	verification, err := verifyTier(ctx, adminClient, models.TierTypeMinio, "WARM")
+	assert.NoError(err)
+	assert.True(verification.Online)
+	assert.Empty(verification.Error)
+
+	_, err = verifyTier(ctx, adminClient, "nas", "WARM")
+	assert.ErrorIs(err, ErrNotFound)
+}
@@ -0,0 +1,190 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/certs"
+	"github.com/minio/console/pkg/clusters"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	// tlsExpiryWarningDays is how many days before they expire certificates are reported as expiring
+	tlsExpiryWarningDays = 30
+	// tlsHandshakeTimeout bounds how long the MinIO endpoints take to present their certificates
+	tlsHandshakeTimeout = 5 * time.Second
+)
+
+func registerTLSCertificatesHandlers(api *operations.ConsoleAPI) {
+	// certificate chains of the console and of the MinIO endpoints
+	api.SystemGetTLSCertificatesHandler = systemApi.GetTLSCertificatesHandlerFunc(func(params systemApi.GetTLSCertificatesParams, session *models.Principal) middleware.Responder {
+		resp, err := getTLSCertificatesResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetTLSCertificatesDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetTLSCertificatesOK().WithPayload(resp)
+	})
+}
+
+// tlsCertificate describes a certificate, certificates expiring within warning are flagged
+func tlsCertificate(cert *x509.Certificate, now time.Time, warning time.Duration) *models.TLSCertificate {
+	res := &models.TLSCertificate{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: fmt.Sprintf("%X", cert.SerialNumber),
+		DNSNames:     cert.DNSNames,
+		IPAddresses:  []string{},
+		IsCA:         cert.IsCA,
+		NotBefore:    cert.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:     cert.NotAfter.UTC().Format(time.RFC3339),
+		ExpiresIn:    int64(cert.NotAfter.Sub(now) / time.Second),
+	}
+	if res.DNSNames == nil {
+		res.DNSNames = []string{}
+	}
+	for _, ip := range cert.IPAddresses {
+		res.IPAddresses = append(res.IPAddresses, ip.String())
+	}
+	res.Expired = !now.Before(cert.NotAfter)
+	res.Expiring = !res.Expired && cert.NotAfter.Sub(now) <= warning
+	return res
+}
+
+func tlsCertificateChain(chain []*x509.Certificate, now time.Time, warning time.Duration) []*models.TLSCertificate {
+	res := []*models.TLSCertificate{}
+	for _, cert := range chain {
+		res = append(res, tlsCertificate(cert, now, warning))
+	}
+	return res
+}
+
+// consoleCertificateChains describes the certificates Console serves as they were last (re)loaded
+func consoleCertificateChains(loaded []certs.Certificate, now time.Time, warning time.Duration) []*models.TLSCertificateChain {
+	res := []*models.TLSCertificateChain{}
+	for _, c := range loaded {
+		chain := &models.TLSCertificateChain{
+			Name:         c.CertFile,
+			LoadedAt:     c.LoadedAt.UTC().Format(time.RFC3339),
+			Certificates: tlsCertificateChain(c.Chain, now, warning),
+		}
+		if c.Err != nil {
+			chain.Error = fmt.Sprintf("the last reload failed: %v", c.Err)
+		}
+		res = append(res, chain)
+	}
+	return res
+}
+
+// minioCertificateChain fetches the certificate chain the MinIO endpoint presents and verifies it against
+// the CAs Console trusts, roots is nil for the ones of the system
+func minioCertificateChain(ctx context.Context, clusterID, endpoint string, roots *x509.CertPool, now time.Time, warning time.Duration) *models.TLSCertificateChain {
+	res := &models.TLSCertificateChain{Name: endpoint, Cluster: clusterID, Certificates: []*models.TLSCertificate{}}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	if u.Scheme != "https" {
+		res.Error = "the endpoint is not served over TLS"
+		return res
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+	}
+	ctx, cancel := context.WithTimeout(ctx, tlsHandshakeTimeout)
+	defer cancel()
+	// the chain is verified below so that endpoints with invalid certificates are still described
+	dialer := &tls.Dialer{Config: &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS12,
+	}}
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	defer conn.Close()
+	peers := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(peers) == 0 {
+		res.Error = "the endpoint presented no certificate"
+		return res
+	}
+	res.Certificates = tlsCertificateChain(peers, now, warning)
+	intermediates := x509.NewCertPool()
+	for _, cert := range peers[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err = peers[0].Verify(x509.VerifyOptions{
+		DNSName:       u.Hostname(),
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	}); err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// minioCertificateChains describes the certificates of the cluster Console is configured with and of the
+// registered ones
+func minioCertificateChains(ctx context.Context, registry *clusters.Registry, roots *x509.CertPool, now time.Time, warning time.Duration) []*models.TLSCertificateChain {
+	res := []*models.TLSCertificateChain{minioCertificateChain(ctx, clusters.DefaultID, getMinIOServer(), roots, now, warning)}
+	for _, cluster := range registry.List() {
+		res = append(res, minioCertificateChain(ctx, cluster.ID, cluster.Endpoint, roots, now, warning))
+	}
+	return res
+}
+
+func getTLSCertificatesResponse(session *models.Principal, params systemApi.GetTLSCertificatesParams) (*models.TLSCertificatesResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ServerInfoAdminAction) {
+		return nil, ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	days := int32(tlsExpiryWarningDays)
+	if params.ExpiryWarningDays != nil {
+		days = *params.ExpiryWarningDays
+	}
+	if days < 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("expiryWarningDays can't be negative"))
+	}
+	warning := time.Duration(days) * 24 * time.Hour
+	now := time.Now()
+	return &models.TLSCertificatesResponse{
+		Console: consoleCertificateChains(certs.LoadedCertificates(), now, warning),
+		Minio:   minioCertificateChains(ctx, clusterRegistry(), GlobalRootCAs, now, warning),
+	}, nil
+}
@@ -0,0 +1,89 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/certs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSCertificate(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Unix(1700000000, 0)
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(255),
+		Subject:      pkix.Name{CommonName: "console.local"},
+		Issuer:       pkix.Name{CommonName: "Console CA"},
+		NotBefore:    now.Add(-24 * time.Hour),
+		NotAfter:     now.Add(10 * 24 * time.Hour),
+	}
+	res := tlsCertificate(cert, now, 30*24*time.Hour)
+	assert.Equal("CN=console.local", res.Subject)
+	assert.Equal("FF", res.SerialNumber)
+	assert.Equal(int64(10*24*60*60), res.ExpiresIn)
+	assert.True(res.Expiring)
+	assert.False(res.Expired)
+	assert.False(tlsCertificate(cert, now, 7*24*time.Hour).Expiring)
+
+	res = tlsCertificate(cert, now.Add(11*24*time.Hour), 30*24*time.Hour)
+	assert.True(res.Expired)
+	assert.False(res.Expiring)
+	assert.Negative(res.ExpiresIn)
+
+	chains := consoleCertificateChains([]certs.Certificate{
+		{CertFile: "/certs/public.crt", Chain: []*x509.Certificate{cert}, LoadedAt: now, Err: errors.New("tls: private key does not match public key")},
+	}, now, 0)
+	assert.Len(chains, 1)
+	assert.Equal("/certs/public.crt", chains[0].Name)
+	assert.Len(chains[0].Certificates, 1)
+	assert.Contains(chains[0].Error, "private key does not match")
+}
+
+func TestMinIOCertificateChain(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+	ctx := context.Background()
+	now := time.Now()
+
+	// the chain is described even when Console doesn't trust it
+	chain := minioCertificateChain(ctx, "prod", server.URL, x509.NewCertPool(), now, 0)
+	assert.Equal("prod", chain.Cluster)
+	assert.Len(chain.Certificates, 1)
+	assert.Contains(chain.Certificates[0].DNSNames, "example.com")
+	assert.Contains(chain.Error, "unknown authority")
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	chain = minioCertificateChain(ctx, "prod", server.URL, roots, now, 0)
+	assert.Empty(chain.Error)
+	assert.Len(chain.Certificates, 1)
+
+	chain = minioCertificateChain(ctx, "edge", "http://edge:9000", roots, now, 0)
+	assert.Empty(chain.Certificates)
+	assert.Equal("the endpoint is not served over TLS", chain.Error)
+}
@@ -0,0 +1,170 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+const (
+	defaultTopLocksCount = 10
+	maxTopLocksCount     = 1000
+	// defaultLongRunningLock is how long an operation holds its lock before it's reported as long running
+	defaultLongRunningLock = time.Minute
+)
+
+func registerTopLocksHandlers(api *operations.ConsoleAPI) {
+	// oldest locks held in the cluster
+	api.SystemGetTopLocksHandler = systemApi.GetTopLocksHandlerFunc(func(params systemApi.GetTopLocksParams, session *models.Principal) middleware.Responder {
+		locks, err := getTopLocksResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetTopLocksDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetTopLocksOK().WithPayload(locks)
+	})
+}
+
+// lockOperation returns the function that took a lock out of its source, MinIO reports it as
+// `[file.go:line:package.receiver.Function()]`
+func lockOperation(source string) string {
+	source = strings.Trim(source, "[]")
+	operation := source[strings.LastIndex(source, ":")+1:]
+	operation = strings.TrimSuffix(operation, "()")
+	operation = operation[strings.LastIndex(operation, ".")+1:]
+	if operation == "" {
+		return source
+	}
+	return operation
+}
+
+// heldSeconds rounds the time a lock was held for to the millisecond
+func heldSeconds(held time.Duration) float64 {
+	return math.Round(held.Seconds()*1000) / 1000
+}
+
+// topLocks converts the locks, oldest first, and groups the ones held for longer than longRunning by the
+// operation holding them, the operations stuck for the longest time first
+func topLocks(entries madmin.LockEntries, now time.Time, longRunning time.Duration) *models.TopLocks {
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Timestamp.Before(entries[j].Timestamp) })
+
+	res := &models.TopLocks{Locks: []*models.LockEntry{}, LongRunning: []*models.LongRunningOperation{}}
+	byOperation := map[string]*models.LongRunningOperation{}
+	resources := map[string]map[string]bool{}
+	owners := map[string]map[string]bool{}
+	for _, entry := range entries {
+		held := now.Sub(entry.Timestamp)
+		operation := lockOperation(entry.Source)
+		servers := entry.ServerList
+		if servers == nil {
+			servers = []string{}
+		}
+		res.Locks = append(res.Locks, &models.LockEntry{
+			Resource:    entry.Resource,
+			Type:        entry.Type,
+			Operation:   operation,
+			Source:      entry.Source,
+			Owner:       entry.Owner,
+			ID:          entry.ID,
+			Servers:     servers,
+			Quorum:      int64(entry.Quorum),
+			AcquiredAt:  entry.Timestamp.UTC().Format(time.RFC3339),
+			HeldSeconds: heldSeconds(held),
+		})
+		if held < longRunning {
+			continue
+		}
+		op, ok := byOperation[operation]
+		if !ok {
+			// the locks are sorted so the first one of an operation is its oldest
+			op = &models.LongRunningOperation{
+				Operation:        operation,
+				OldestAcquiredAt: entry.Timestamp.UTC().Format(time.RFC3339),
+				HeldSeconds:      heldSeconds(held),
+			}
+			byOperation[operation] = op
+			resources[operation] = map[string]bool{}
+			owners[operation] = map[string]bool{}
+			res.LongRunning = append(res.LongRunning, op)
+		}
+		op.Locks++
+		if !resources[operation][entry.Resource] {
+			resources[operation][entry.Resource] = true
+			op.Resources = append(op.Resources, entry.Resource)
+		}
+		if !owners[operation][entry.Owner] {
+			owners[operation][entry.Owner] = true
+			op.Owners = append(op.Owners, entry.Owner)
+		}
+	}
+	for _, op := range res.LongRunning {
+		sort.Strings(op.Resources)
+		sort.Strings(op.Owners)
+	}
+	sort.SliceStable(res.LongRunning, func(i, j int) bool {
+		return res.LongRunning[i].HeldSeconds > res.LongRunning[j].HeldSeconds
+	})
+	return res
+}
+
+// getTopLocks returns the oldest locks of the cluster, only the stale ones when asked to
+func getTopLocks(ctx context.Context, client MinioAdmin, count int, stale bool, longRunning time.Duration) (*models.TopLocks, error) {
+	if count < 1 || count > maxTopLocksCount {
+		return nil, fmt.Errorf("%w: count has to be between 1 and %d", ErrInvalidLocksQuery, maxTopLocksCount)
+	}
+	if longRunning <= 0 {
+		return nil, fmt.Errorf("%w: longRunning has to be a positive number of seconds", ErrInvalidLocksQuery)
+	}
+	entries, err := client.topLocks(ctx, count, stale)
+	if err != nil {
+		return nil, err
+	}
+	return topLocks(entries, time.Now(), longRunning), nil
+}
+
+func getTopLocksResponse(session *models.Principal, params systemApi.GetTopLocksParams) (*models.TopLocks, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	count := defaultTopLocksCount
+	if params.Count != nil {
+		count = int(*params.Count)
+	}
+	longRunning := defaultLongRunningLock
+	if params.LongRunning != nil {
+		longRunning = time.Duration(*params.LongRunning) * time.Second
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	locks, err := getTopLocks(ctx, AdminClient{Client: mAdmin}, count, swag.BoolValue(params.Stale), longRunning)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return locks, nil
+}
@@ -0,0 +1,115 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_lockOperation(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("DeleteObject", lockOperation("[erasure-object.go:1234:erasureObjects.DeleteObject()]"))
+	assert.Equal("healObject", lockOperation("[C:/minio/cmd/erasure-healing.go:271:cmd.erasureObjects.healObject()]"))
+	assert.Equal("unknown", lockOperation("unknown"))
+}
+
+func Test_topLocks(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	entries := madmin.LockEntries{
+		{
+			Timestamp: now.Add(-10 * time.Second), Resource: "images/cat.png", Type: "WRITE",
+			Source: "[erasure-object.go:10:erasureObjects.PutObject()]", Owner: "node2", ServerList: []string{"node1", "node2"}, Quorum: 2,
+		},
+		{
+			Timestamp: now.Add(-5 * time.Minute), Resource: "logs/2023/04.log", Type: "WRITE",
+			Source: "[erasure-object.go:20:erasureObjects.DeleteObject()]", Owner: "node1",
+		},
+		{
+			Timestamp: now.Add(-2 * time.Minute), Resource: "logs/2023/03.log", Type: "WRITE",
+			Source: "[erasure-object.go:20:erasureObjects.DeleteObject()]", Owner: "node3",
+		},
+		{
+			Timestamp: now.Add(-90 * time.Second), Resource: "images/dog.png", Type: "READ",
+			Source: "[erasure-object.go:30:erasureObjects.GetObjectNInfo()]", Owner: "node1",
+		},
+	}
+
+	res := topLocks(entries, now, time.Minute)
+	if assert.Len(res.Locks, 4) {
+		// oldest locks first
+		assert.Equal("logs/2023/04.log", res.Locks[0].Resource)
+		assert.Equal(300.0, res.Locks[0].HeldSeconds)
+		assert.Equal("2023-05-01T11:55:00Z", res.Locks[0].AcquiredAt)
+		assert.Equal("PutObject", res.Locks[3].Operation)
+		assert.Equal([]string{"node1", "node2"}, res.Locks[3].Servers)
+		assert.Equal(int64(2), res.Locks[3].Quorum)
+		assert.NotNil(res.Locks[0].Servers)
+	}
+	if assert.Len(res.LongRunning, 2) {
+		deletes := res.LongRunning[0]
+		assert.Equal("DeleteObject", deletes.Operation)
+		assert.Equal(int64(2), deletes.Locks)
+		assert.Equal([]string{"logs/2023/03.log", "logs/2023/04.log"}, deletes.Resources)
+		assert.Equal([]string{"node1", "node3"}, deletes.Owners)
+		assert.Equal(300.0, deletes.HeldSeconds)
+		assert.Equal("GetObjectNInfo", res.LongRunning[1].Operation)
+	}
+
+	res = topLocks(nil, now, time.Minute)
+	assert.NotNil(res.Locks)
+	assert.Empty(res.LongRunning)
+}
+
+func Test_getTopLocks(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := AdminClientMock{}
+
+	var requestedCount int
+	var requestedStale bool
+	minioTopLocksMock = func(ctx context.Context, count int, stale bool) (madmin.LockEntries, error) {
+		requestedCount, requestedStale = count, stale
+		return madmin.LockEntries{{Timestamp: time.Now(), Resource: "images/cat.png"}}, nil
+	}
+	res, err := getTopLocks(ctx, client, 25, true, time.Minute)
+	assert.NoError(err)
+	assert.Equal(25, requestedCount)
+	assert.True(requestedStale)
+	assert.Len(res.Locks, 1)
+	assert.Empty(res.LongRunning)
+
+	_, err = getTopLocks(ctx, client, 0, false, time.Minute)
+	assert.ErrorIs(err, ErrInvalidLocksQuery)
+	_, err = getTopLocks(ctx, client, 1001, false, time.Minute)
+	assert.ErrorIs(err, ErrInvalidLocksQuery)
+	_, err = getTopLocks(ctx, client, 10, false, 0)
+	assert.ErrorIs(err, ErrInvalidLocksQuery)
+
+	minioTopLocksMock = func(ctx context.Context, count int, stale bool) (madmin.LockEntries, error) {
+		return nil, errors.New("error")
+	}
+	_, err = getTopLocks(ctx, client, 10, false, time.Minute)
+	assert.Error(err)
+}
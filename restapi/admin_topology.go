@@ -0,0 +1,141 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+func registerTopologyHandlers(api *operations.ConsoleAPI) {
+	// drives of every erasure set grouped by pool
+	api.SystemGetDriveTopologyHandler = systemApi.GetDriveTopologyHandlerFunc(func(params systemApi.GetDriveTopologyParams, session *models.Principal) middleware.Responder {
+		topology, err := getDriveTopologyResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetDriveTopologyDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetDriveTopologyOK().WithPayload(topology)
+	})
+}
+
+// driveHealProgress estimates how much of the data a healing drive has to receive was already written
+func driveHealProgress(heal *madmin.HealingDisk) float64 {
+	if heal == nil || heal.ObjectsTotalSize == 0 {
+		return 0
+	}
+	progress := float64(heal.BytesDone+heal.BytesFailed) / float64(heal.ObjectsTotalSize) * 100
+	return math.Min(100, math.Round(progress*10)/10)
+}
+
+// topologyDriveToModel converts a drive reported by a server
+func topologyDriveToModel(server string, drive madmin.Disk) *models.TopologyDrive {
+	res := &models.TopologyDrive{
+		Index:          swag.Int64(int64(drive.DiskIndex)),
+		UUID:           drive.UUID,
+		Server:         server,
+		Endpoint:       drive.Endpoint,
+		DrivePath:      drive.DrivePath,
+		State:          drive.State,
+		Model:          drive.Model,
+		TotalSpace:     int64(drive.TotalSpace),
+		UsedSpace:      int64(drive.UsedSpace),
+		AvailableSpace: int64(drive.AvailableSpace),
+		Healing:        drive.Healing,
+	}
+	if drive.Healing && drive.HealInfo != nil {
+		res.HealProgressPercent = driveHealProgress(drive.HealInfo)
+		res.ItemsHealed = int64(drive.HealInfo.ItemsHealed)
+		res.ItemsFailed = int64(drive.HealInfo.ItemsFailed)
+	}
+	return res
+}
+
+// driveTopology groups the drives of every server by pool and erasure set, sorted the way MinIO numbers
+// them so a drive can be found in the physical layout before it is replaced
+func driveTopology(info madmin.InfoMessage) *models.DriveTopology {
+	res := &models.DriveTopology{Pools: []*models.TopologyPool{}}
+	pools := map[int]*models.TopologyPool{}
+	sets := map[[2]int]*models.TopologySet{}
+	for _, server := range info.Servers {
+		for _, drive := range server.Disks {
+			pool, ok := pools[drive.PoolIndex]
+			if !ok {
+				pool = &models.TopologyPool{ID: swag.Int64(int64(drive.PoolIndex)), Sets: []*models.TopologySet{}}
+				pools[drive.PoolIndex] = pool
+				res.Pools = append(res.Pools, pool)
+			}
+			key := [2]int{drive.PoolIndex, drive.SetIndex}
+			set, ok := sets[key]
+			if !ok {
+				set = &models.TopologySet{Set: swag.Int64(int64(drive.SetIndex)), Drives: []*models.TopologyDrive{}}
+				sets[key] = set
+				pool.Sets = append(pool.Sets, set)
+			}
+			set.Drives = append(set.Drives, topologyDriveToModel(server.Endpoint, drive))
+
+			res.TotalDrives++
+			if drive.State == healthyDriveState {
+				set.OnlineDrives++
+				res.OnlineDrives++
+			} else {
+				set.OfflineDrives++
+				res.OfflineDrives++
+			}
+			if drive.Healing {
+				set.HealingDrives++
+				res.HealingDrives++
+			}
+		}
+	}
+	sort.Slice(res.Pools, func(i, j int) bool {
+		return *res.Pools[i].ID < *res.Pools[j].ID
+	})
+	for _, pool := range res.Pools {
+		sort.Slice(pool.Sets, func(i, j int) bool {
+			return *pool.Sets[i].Set < *pool.Sets[j].Set
+		})
+		for _, set := range pool.Sets {
+			sort.SliceStable(set.Drives, func(i, j int) bool {
+				return *set.Drives[i].Index < *set.Drives[j].Index
+			})
+		}
+	}
+	return res
+}
+
+func getDriveTopologyResponse(session *models.Principal, params systemApi.GetDriveTopologyParams) (*models.DriveTopology, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	info, err := adminClient.serverInfo(ctx)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return driveTopology(info), nil
+}
@@ -0,0 +1,90 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"testing"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_driveTopology(t *testing.T) {
+	assert := assert.New(t)
+
+	info := madmin.InfoMessage{
+		Servers: []madmin.ServerProperties{
+			{
+				Endpoint: "node2:9000",
+				Disks: []madmin.Disk{
+					{PoolIndex: 1, SetIndex: 0, DiskIndex: 1, State: "ok", DrivePath: "/data1"},
+					{PoolIndex: 0, SetIndex: 1, DiskIndex: 1, State: "offline", DrivePath: "/data2"},
+				},
+			},
+			{
+				Endpoint: "node1:9000",
+				Disks: []madmin.Disk{
+					{PoolIndex: 0, SetIndex: 1, DiskIndex: 0, State: "ok", DrivePath: "/data2", TotalSpace: 1000, UsedSpace: 400, AvailableSpace: 600},
+					{
+						PoolIndex: 0, SetIndex: 0, DiskIndex: 0, State: "ok", DrivePath: "/data1", Healing: true,
+						HealInfo: &madmin.HealingDisk{ObjectsTotalSize: 2000, BytesDone: 900, BytesFailed: 100, ItemsHealed: 30, ItemsFailed: 1},
+					},
+				},
+			},
+		},
+	}
+
+	topology := driveTopology(info)
+	assert.Equal(int64(4), topology.TotalDrives)
+	assert.Equal(int64(3), topology.OnlineDrives)
+	assert.Equal(int64(1), topology.OfflineDrives)
+	assert.Equal(int64(1), topology.HealingDrives)
+	if !assert.Len(topology.Pools, 2) {
+		return
+	}
+	assert.Equal(int64(0), *topology.Pools[0].ID)
+	assert.Equal(int64(1), *topology.Pools[1].ID)
+
+	sets := topology.Pools[0].Sets
+	if assert.Len(sets, 2) {
+		assert.Equal(int64(0), *sets[0].Set)
+		assert.Equal(int64(1), sets[0].HealingDrives)
+		healing := sets[0].Drives[0]
+		assert.True(healing.Healing)
+		assert.Equal(50.0, healing.HealProgressPercent)
+		assert.Equal(int64(30), healing.ItemsHealed)
+		assert.Equal(int64(1), healing.ItemsFailed)
+
+		// drives are ordered by their index in the set, regardless of the server reporting them
+		assert.Equal(int64(1), *sets[1].Set)
+		if assert.Len(sets[1].Drives, 2) {
+			assert.Equal("node1:9000", sets[1].Drives[0].Server)
+			assert.Equal(int64(1000), sets[1].Drives[0].TotalSpace)
+			assert.Equal("node2:9000", sets[1].Drives[1].Server)
+			assert.Equal("offline", sets[1].Drives[1].State)
+		}
+		assert.Equal(int64(1), sets[1].OnlineDrives)
+		assert.Equal(int64(1), sets[1].OfflineDrives)
+	}
+
+	// a healing drive that didn't report its progress yet
+	assert.Equal(0.0, driveHealProgress(&madmin.HealingDisk{}))
+
+	topology = driveTopology(madmin.InfoMessage{})
+	assert.NotNil(topology.Pools)
+	assert.Empty(topology.Pools)
+}
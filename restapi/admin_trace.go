@@ -19,11 +19,15 @@ package restapi
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/websocket"
 )
 
@@ -47,47 +51,61 @@ type callStats struct {
 	Ttfb     string `json:"timeToFirstByte"`
 }
 
-// trace filters
+// trace filters, a trace has to match all the filters passed by the user
 func matchTrace(opts TraceRequest, traceInfo madmin.ServiceTraceInfo) bool {
-	statusCode := int(opts.statusCode)
-	method := opts.method
-	funcName := opts.funcName
-	apiPath := opts.path
-
-	if statusCode == 0 && method == "" && funcName == "" && apiPath == "" {
-		// no specific filtering found trace all the requests
-		return true
-	}
+	trace := traceInfo.Trace
 
 	// Filter request path if passed by the user
-	if apiPath != "" {
-		pathToLookup := strings.ToLower(apiPath)
-		pathFromTrace := strings.ToLower(traceInfo.Trace.Path)
+	if opts.path != "" && !strings.Contains(strings.ToLower(trace.Path), strings.ToLower(opts.path)) {
+		return false
+	}
 
-		return strings.Contains(pathFromTrace, pathToLookup)
+	// Filter the bucket of the request, the first element of its path
+	if opts.bucket != "" {
+		bucket, _, _ := strings.Cut(strings.TrimPrefix(trace.Path, SlashSeparator), SlashSeparator)
+		if bucket != opts.bucket {
+			return false
+		}
 	}
 
 	// Filter response status codes if passed by the user
-	if statusCode > 0 && traceInfo.Trace.HTTP != nil {
-		statusCodeFromTrace := traceInfo.Trace.HTTP.RespInfo.StatusCode
-
-		return statusCodeFromTrace == statusCode
+	if opts.statusCode > 0 && (trace.HTTP == nil || trace.HTTP.RespInfo.StatusCode != int(opts.statusCode)) {
+		return false
 	}
 
 	// Filter request method if passed by the user
-	if method != "" && traceInfo.Trace.HTTP != nil {
-		methodFromTrace := traceInfo.Trace.HTTP.ReqInfo.Method
-
-		return methodFromTrace == method
+	if opts.method != "" && (trace.HTTP == nil || trace.HTTP.ReqInfo.Method != opts.method) {
+		return false
 	}
 
-	if funcName != "" {
-		funcToLookup := strings.ToLower(funcName)
-		funcFromTrace := strings.ToLower(traceInfo.Trace.FuncName)
+	if opts.funcName != "" && !strings.Contains(strings.ToLower(trace.FuncName), strings.ToLower(opts.funcName)) {
+		return false
+	}
 
-		return strings.Contains(funcFromTrace, funcToLookup)
+	// Filter the node that served the request
+	if opts.node != "" && !strings.EqualFold(trace.NodeName, opts.node) {
+		return false
 	}
 
+	return trace.Duration >= opts.minDuration
+}
+
+// traceSampler keeps a share of the matching traces, spread evenly over the session
+type traceSampler struct {
+	rate   float64
+	credit float64
+}
+
+func (s *traceSampler) keep() bool {
+	if s.rate <= 0 || s.rate >= 1 {
+		return true
+	}
+	s.credit += s.rate
+	// tolerate the rounding of the accumulated rates, ten traces at 0.1 keep one
+	if s.credit < 1-1e-9 {
+		return false
+	}
+	s.credit--
 	return true
 }
 
@@ -95,6 +113,7 @@ func matchTrace(opts TraceRequest, traceInfo madmin.ServiceTraceInfo) bool {
 func startTraceInfo(ctx context.Context, conn WSConn, client MinioAdmin, opts TraceRequest) error {
 	// Start listening on all trace activity.
 	traceCh := client.serviceTrace(ctx, opts.threshold, opts.s3, opts.internal, opts.storage, opts.os, opts.onlyErrors)
+	sampler := traceSampler{rate: opts.sampleRate}
 	for {
 		select {
 		case <-ctx.Done():
@@ -105,20 +124,20 @@ func startTraceInfo(ctx context.Context, conn WSConn, client MinioAdmin, opts Tr
 				return nil
 			}
 			if traceInfo.Err != nil {
-				LogError("error on serviceTrace: %v", traceInfo.Err)
+				LogErrorContext(ctx, "error on serviceTrace: %v", traceInfo.Err)
 				return traceInfo.Err
 			}
-			if matchTrace(opts, traceInfo) {
+			if matchTrace(opts, traceInfo) && sampler.keep() {
 				// Serialize message to be sent
 				traceInfoBytes, err := json.Marshal(shortTrace(&traceInfo))
 				if err != nil {
-					LogError("error on json.Marshal: %v", err)
+					LogErrorContext(ctx, "error on json.Marshal: %v", err)
 					return err
 				}
 				// Send Message through websocket connection
 				err = conn.writeMessage(websocket.TextMessage, traceInfoBytes)
 				if err != nil {
-					LogError("error writeMessage: %v", err)
+					LogErrorContext(ctx, "error writeMessage: %v", err)
 					return err
 				}
 			}
@@ -152,3 +171,62 @@ func shortTrace(info *madmin.ServiceTraceInfo) shortTraceMsg {
 
 	return s
 }
+
+// traceRecorder records a trace session to an object of the diagnostics bucket
+type traceRecorder struct {
+	client MinioClient
+	bucket string
+	object string
+}
+
+// traceRecordingName returns the object a trace session of the owner is recorded to, the owner is escaped so it
+// stays a single segment of the object name
+func traceRecordingName(owner string, now time.Time) string {
+	return fmt.Sprintf("traces/%s/%s.jsonl", url.PathEscape(owner), now.UTC().Format("20060102T150405.000Z"))
+}
+
+// traceRecording forwards the messages of a trace session to the websocket while uploading them, one per line,
+// to the recording object
+type traceRecording struct {
+	WSConn
+	pw   *io.PipeWriter
+	done chan error
+}
+
+// startTraceRecording starts uploading the recording and tells the client the object it is recorded to
+func startTraceRecording(conn WSConn, recorder *traceRecorder) (*traceRecording, error) {
+	msg, err := json.Marshal(map[string]interface{}{
+		"recording": map[string]string{"bucket": recorder.bucket, "object": recorder.object},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.writeMessage(websocket.TextMessage, msg); err != nil {
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	recording := &traceRecording{WSConn: conn, pw: pw, done: make(chan error, 1)}
+	go func() {
+		// the upload outlives the websocket, it ends once the session is over and the recording closed
+		_, err := recorder.client.putObject(context.Background(), recorder.bucket, recorder.object, pr, -1,
+			minio.PutObjectOptions{ContentType: "application/x-ndjson"})
+		pr.CloseWithError(err)
+		recording.done <- err
+	}()
+	return recording, nil
+}
+
+func (r *traceRecording) writeMessage(messageType int, data []byte) error {
+	if messageType == websocket.TextMessage {
+		if _, err := r.pw.Write(append(data, '\n')); err != nil {
+			return err
+		}
+	}
+	return r.WSConn.writeMessage(messageType, data)
+}
+
+// finish completes the upload of the recording
+func (r *traceRecording) finish() error {
+	r.pw.Close()
+	return <-r.done
+}
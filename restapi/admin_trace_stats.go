@@ -0,0 +1,217 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+)
+
+const (
+	defaultTraceStatsWindow = 10 * time.Second
+	maxTraceStatsWindow     = 300 * time.Second
+)
+
+func registerTraceStatsHandlers(api *operations.ConsoleAPI) {
+	// calls traced for a window of time aggregated per API
+	api.SystemGetTraceStatsHandler = systemApi.GetTraceStatsHandlerFunc(func(params systemApi.GetTraceStatsParams, session *models.Principal) middleware.Responder {
+		stats, err := getTraceStatsResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetTraceStatsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetTraceStatsOK().WithPayload(stats)
+	})
+}
+
+// apiTraceCalls accumulates the calls traced for an API
+type apiTraceCalls struct {
+	durations []time.Duration
+	errors    int64
+	rx        int64
+	tx        int64
+}
+
+// traceStatsAggregator accumulates the traced calls per API
+type traceStatsAggregator struct {
+	apis   map[string]*apiTraceCalls
+	calls  int64
+	errors int64
+}
+
+func newTraceStatsAggregator() *traceStatsAggregator {
+	return &traceStatsAggregator{apis: map[string]*apiTraceCalls{}}
+}
+
+// add accumulates a traced call, calls answered with an error status or failing internally count as errors
+func (a *traceStatsAggregator) add(trace madmin.TraceInfo) {
+	calls, ok := a.apis[trace.FuncName]
+	if !ok {
+		calls = &apiTraceCalls{}
+		a.apis[trace.FuncName] = calls
+	}
+	calls.durations = append(calls.durations, trace.Duration)
+	a.calls++
+
+	failed := trace.Error != ""
+	if trace.HTTP != nil {
+		calls.rx += int64(trace.HTTP.CallStats.InputBytes)
+		calls.tx += int64(trace.HTTP.CallStats.OutputBytes)
+		failed = failed || trace.HTTP.RespInfo.StatusCode >= http.StatusBadRequest
+	}
+	if failed {
+		calls.errors++
+		a.errors++
+	}
+}
+
+// durationPercentile returns the nearest-rank percentile of sorted durations
+func durationPercentile(sorted []time.Duration, percentile float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(percentile/100*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// durationMs returns a duration in milliseconds rounded to hundredths
+func durationMs(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Millisecond)*100) / 100
+}
+
+// result returns the stats of every API, the most called first
+func (a *traceStatsAggregator) result(window time.Duration) *models.TraceStats {
+	res := &models.TraceStats{
+		WindowSeconds: int64(window.Seconds()),
+		Calls:         a.calls,
+		Errors:        a.errors,
+		Apis:          []*models.APITraceStats{},
+	}
+	for api, calls := range a.apis {
+		sort.Slice(calls.durations, func(i, j int) bool {
+			return calls.durations[i] < calls.durations[j]
+		})
+		var total time.Duration
+		for _, d := range calls.durations {
+			total += d
+		}
+		count := int64(len(calls.durations))
+		res.Apis = append(res.Apis, &models.APITraceStats{
+			API:       api,
+			Calls:     count,
+			Errors:    calls.errors,
+			ErrorRate: math.Round(float64(calls.errors)/float64(count)*1000) / 10,
+			Rx:        calls.rx,
+			Tx:        calls.tx,
+			AvgMs:     durationMs(total / time.Duration(count)),
+			P50Ms:     durationMs(durationPercentile(calls.durations, 50)),
+			P90Ms:     durationMs(durationPercentile(calls.durations, 90)),
+			P99Ms:     durationMs(durationPercentile(calls.durations, 99)),
+			MaxMs:     durationMs(calls.durations[count-1]),
+		})
+	}
+	sort.Slice(res.Apis, func(i, j int) bool {
+		if res.Apis[i].Calls != res.Apis[j].Calls {
+			return res.Apis[i].Calls > res.Apis[j].Calls
+		}
+		return res.Apis[i].API < res.Apis[j].API
+	})
+	return res
+}
+
+// getTraceStats traces the calls matching the filters for the window and aggregates them per API
+func getTraceStats(ctx context.Context, client MinioAdmin, opts TraceRequest, window time.Duration) (*models.TraceStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, window)
+	defer cancel()
+
+	aggregator := newTraceStatsAggregator()
+	traceCh := client.serviceTrace(ctx, opts.threshold, opts.s3, opts.internal, opts.storage, opts.os, opts.onlyErrors)
+	for {
+		select {
+		case <-ctx.Done():
+			return aggregator.result(window), nil
+		case traceInfo, ok := <-traceCh:
+			if !ok {
+				return aggregator.result(window), nil
+			}
+			if traceInfo.Err != nil {
+				return nil, traceInfo.Err
+			}
+			if matchTrace(opts, traceInfo) {
+				aggregator.add(traceInfo.Trace)
+			}
+		}
+	}
+}
+
+// getTraceStatsOptions returns the trace filters and window of the request
+func getTraceStatsOptions(params systemApi.GetTraceStatsParams) (TraceRequest, time.Duration, error) {
+	window := defaultTraceStatsWindow
+	if params.Window != nil {
+		window = time.Duration(*params.Window) * time.Second
+		if window <= 0 || window > maxTraceStatsWindow {
+			return TraceRequest{}, 0, ErrInvalidTraceWindow
+		}
+	}
+	calls := "s3"
+	if params.Calls != nil && *params.Calls != "" {
+		calls = *params.Calls
+	}
+	opts := TraceRequest{
+		s3:       strings.Contains(calls, "s3") || strings.Contains(calls, "all"),
+		internal: strings.Contains(calls, "internal") || strings.Contains(calls, "all"),
+		storage:  strings.Contains(calls, "storage") || strings.Contains(calls, "all"),
+		os:       strings.Contains(calls, "os") || strings.Contains(calls, "all"),
+	}
+	if params.Bucket != nil {
+		opts.bucket = *params.Bucket
+	}
+	if params.Node != nil {
+		opts.node = *params.Node
+	}
+	return opts, window, nil
+}
+
+func getTraceStatsResponse(session *models.Principal, params systemApi.GetTraceStatsParams) (*models.TraceStats, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	opts, window, err := getTraceStatsOptions(params)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	stats, err := getTraceStats(ctx, AdminClient{Client: mAdmin}, opts, window)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return stats, nil
+}
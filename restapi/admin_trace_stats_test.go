@@ -0,0 +1,140 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	systemApi "github.com/minio/console/restapi/operations/system"
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTraceStats(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	call := func(funcName, bucket string, status int, duration time.Duration) madmin.ServiceTraceInfo {
+		return madmin.ServiceTraceInfo{Trace: madmin.TraceInfo{
+			FuncName: funcName,
+			Path:     "/" + bucket + "/object",
+			Duration: duration,
+			HTTP: &madmin.TraceHTTPStats{
+				RespInfo:  madmin.TraceResponseInfo{StatusCode: status},
+				CallStats: madmin.TraceCallStats{InputBytes: 10, OutputBytes: 100},
+			},
+		}}
+	}
+	traces := []madmin.ServiceTraceInfo{call("s3.PutObject", "images", 200, 40*time.Millisecond)}
+	for i := 1; i <= 10; i++ {
+		status := 200
+		if i > 8 {
+			status = 404
+		}
+		traces = append(traces, call("s3.GetObject", "images", status, time.Duration(i)*time.Millisecond))
+	}
+	traces = append(traces, call("s3.GetObject", "logs", 200, time.Second))
+	minioServiceTraceMock = func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
+		ch := make(chan madmin.ServiceTraceInfo, len(traces))
+		for _, trace := range traces {
+			ch <- trace
+		}
+		close(ch)
+		return ch
+	}
+
+	stats, err := getTraceStats(ctx, adminClient, TraceRequest{s3: true, bucket: "images"}, 10*time.Second)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(int64(10), stats.WindowSeconds)
+	assert.Equal(int64(11), stats.Calls)
+	assert.Equal(int64(2), stats.Errors)
+	if assert.Len(stats.Apis, 2) {
+		// the most called API comes first
+		get := stats.Apis[0]
+		assert.Equal("s3.GetObject", get.API)
+		assert.Equal(int64(10), get.Calls)
+		assert.Equal(int64(2), get.Errors)
+		assert.Equal(20.0, get.ErrorRate)
+		assert.Equal(int64(100), get.Rx)
+		assert.Equal(int64(1000), get.Tx)
+		assert.Equal(5.5, get.AvgMs)
+		assert.Equal(5.0, get.P50Ms)
+		assert.Equal(9.0, get.P90Ms)
+		assert.Equal(10.0, get.P99Ms)
+		assert.Equal(10.0, get.MaxMs)
+
+		put := stats.Apis[1]
+		assert.Equal("s3.PutObject", put.API)
+		assert.Equal(int64(1), put.Calls)
+		assert.Equal(40.0, put.P99Ms)
+		assert.Equal(0.0, put.ErrorRate)
+	}
+
+	// the stats are returned once the window is over even if calls keep coming
+	minioServiceTraceMock = func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
+		return make(chan madmin.ServiceTraceInfo)
+	}
+	stats, err = getTraceStats(ctx, adminClient, TraceRequest{}, 10*time.Millisecond)
+	if assert.NoError(err) {
+		assert.Equal(int64(0), stats.Calls)
+		assert.Empty(stats.Apis)
+	}
+
+	// errors tracing stop the aggregation
+	minioServiceTraceMock = func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
+		ch := make(chan madmin.ServiceTraceInfo, 1)
+		ch <- madmin.ServiceTraceInfo{Err: errors.New("error on trace")}
+		return ch
+	}
+	_, err = getTraceStats(ctx, adminClient, TraceRequest{}, time.Second)
+	if assert.Error(err) {
+		assert.Equal("error on trace", err.Error())
+	}
+}
+
+func Test_getTraceStatsOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	opts, window, err := getTraceStatsOptions(systemApi.GetTraceStatsParams{})
+	if assert.NoError(err) {
+		assert.Equal(defaultTraceStatsWindow, window)
+		assert.True(opts.s3)
+		assert.False(opts.internal)
+	}
+
+	seconds := int32(60)
+	calls := "all"
+	bucket := "images"
+	opts, window, err = getTraceStatsOptions(systemApi.GetTraceStatsParams{Window: &seconds, Calls: &calls, Bucket: &bucket})
+	if assert.NoError(err) {
+		assert.Equal(time.Minute, window)
+		assert.True(opts.internal)
+		assert.True(opts.storage)
+		assert.Equal("images", opts.bucket)
+	}
+
+	seconds = 301
+	_, _, err = getTraceStatsOptions(systemApi.GetTraceStatsParams{Window: &seconds})
+	assert.ErrorIs(err, ErrInvalidTraceWindow)
+}
@@ -20,9 +20,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -117,3 +121,117 @@ func TestAdminTrace(t *testing.T) {
 		assert.Equal("error on trace", err.Error())
 	}
 }
+
+func Test_matchTrace(t *testing.T) {
+	assert := assert.New(t)
+
+	trace := madmin.ServiceTraceInfo{Trace: madmin.TraceInfo{
+		NodeName: "node1:9000",
+		FuncName: "s3.PutObject",
+		Path:     "/images/2023/cat.png",
+		Duration: 300 * time.Millisecond,
+		HTTP: &madmin.TraceHTTPStats{
+			ReqInfo:  madmin.TraceRequestInfo{Method: "PUT"},
+			RespInfo: madmin.TraceResponseInfo{StatusCode: 200},
+		},
+	}}
+
+	assert.True(matchTrace(TraceRequest{}, trace))
+	assert.True(matchTrace(TraceRequest{bucket: "images", funcName: "putobject", statusCode: 200, node: "node1:9000"}, trace))
+	assert.True(matchTrace(TraceRequest{path: "2023/", minDuration: 250 * time.Millisecond}, trace))
+
+	// every filter has to match
+	assert.False(matchTrace(TraceRequest{bucket: "images", statusCode: 404}, trace))
+	assert.False(matchTrace(TraceRequest{bucket: "imag"}, trace))
+	assert.False(matchTrace(TraceRequest{node: "node2:9000"}, trace))
+	assert.False(matchTrace(TraceRequest{method: "GET"}, trace))
+	assert.False(matchTrace(TraceRequest{minDuration: time.Second}, trace))
+
+	// calls without an HTTP request don't have a status code
+	assert.False(matchTrace(TraceRequest{statusCode: 200}, madmin.ServiceTraceInfo{Trace: madmin.TraceInfo{FuncName: "storage.ReadAll"}}))
+}
+
+func Test_traceSampler(t *testing.T) {
+	assert := assert.New(t)
+
+	kept := func(rate float64) int {
+		sampler := traceSampler{rate: rate}
+		var kept int
+		for i := 0; i < 100; i++ {
+			if sampler.keep() {
+				kept++
+			}
+		}
+		return kept
+	}
+	assert.Equal(100, kept(0))
+	assert.Equal(100, kept(1))
+	assert.Equal(10, kept(0.1))
+	assert.Equal(25, kept(0.25))
+}
+
+func TestTraceRecording(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := AdminClientMock{}
+	mockWSConn := mockConn{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	minioServiceTraceMock = func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
+		ch := make(chan madmin.ServiceTraceInfo, 3)
+		for _, funcName := range []string{"s3.GetObject", "s3.PutObject", "s3.GetObject"} {
+			ch <- madmin.ServiceTraceInfo{Trace: madmin.TraceInfo{FuncName: funcName}}
+		}
+		close(ch)
+		return ch
+	}
+	var sent []string
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		sent = append(sent, string(data))
+		return nil
+	}
+	var recorded, recordedBucket, recordedObject string
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		data, err := io.ReadAll(reader)
+		recorded, recordedBucket, recordedObject = string(data), bucketName, objectName
+		return minio.UploadInfo{}, err
+	}
+
+	// the IDP users are recorded under their parent user
+	assert.Equal("traces/parent:openid%2Falice/20230301T100000.000Z.jsonl", traceRecordingName("parent:openid/alice", time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)))
+	object := traceRecordingName("console", time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC))
+	assert.Equal("traces/console/20230301T100000.000Z.jsonl", object)
+	recorder := &traceRecorder{client: minioClientMock{}, bucket: "diagnostics", object: object}
+
+	recording, err := startTraceRecording(mockWSConn, recorder)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.NoError(startTraceInfo(ctx, recording, adminClient, TraceRequest{funcName: "GetObject"}))
+	assert.NoError(recording.finish())
+
+	// the client is told where the session is recorded, the traces themselves are recorded one per line
+	if assert.Len(sent, 3) {
+		assert.Equal(`{"recording":{"bucket":"diagnostics","object":"traces/console/20230301T100000.000Z.jsonl"}}`, sent[0])
+	}
+	assert.Equal("diagnostics", recordedBucket)
+	assert.Equal(object, recordedObject)
+	lines := strings.Split(strings.TrimSuffix(recorded, "\n"), "\n")
+	if assert.Len(lines, 2) {
+		assert.Equal(sent[1], lines[0])
+		assert.Equal(sent[2], lines[1])
+	}
+
+	// a failed upload stops the session
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		return minio.UploadInfo{}, fmt.Errorf("bucket not found")
+	}
+	recording, err = startTraceRecording(mockWSConn, recorder)
+	if assert.NoError(err) {
+		err = startTraceInfo(ctx, recording, adminClient, TraceRequest{})
+		if assert.Error(err) {
+			assert.Equal("bucket not found", err.Error())
+		}
+		assert.Error(recording.finish())
+	}
+}
@@ -0,0 +1,98 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/restapi/operations"
+	cfgApi "github.com/minio/console/restapi/operations/configuration"
+)
+
+func registerTrustedProxiesHandlers(api *operations.ConsoleAPI) {
+	// Get the trusted proxies in use
+	api.ConfigurationGetTrustedProxiesHandler = cfgApi.GetTrustedProxiesHandlerFunc(func(params cfgApi.GetTrustedProxiesParams, session *models.Principal) middleware.Responder {
+		resp, err := getTrustedProxiesResponse(session, params)
+		if err != nil {
+			return cfgApi.NewGetTrustedProxiesDefault(int(err.Code)).WithPayload(err)
+		}
+		return cfgApi.NewGetTrustedProxiesOK().WithPayload(resp)
+	})
+	// Replace the trusted proxies without restarting Console
+	api.ConfigurationSetTrustedProxiesHandler = cfgApi.SetTrustedProxiesHandlerFunc(func(params cfgApi.SetTrustedProxiesParams, session *models.Principal) middleware.Responder {
+		resp, err := setTrustedProxiesResponse(session, params)
+		if err != nil {
+			return cfgApi.NewSetTrustedProxiesDefault(int(err.Code)).WithPayload(err)
+		}
+		return cfgApi.NewSetTrustedProxiesOK().WithPayload(resp)
+	})
+}
+
+// trustedProxiesConfiguration returns the model for the given real IP configuration
+func trustedProxiesConfiguration(config *realip.Config) *models.TrustedProxiesConfiguration {
+	return &models.TrustedProxiesConfiguration{
+		Proxies: config.Proxies(),
+		Headers: config.Headers(),
+	}
+}
+
+// setTrustedProxies validates the configuration and applies it to every following request, client
+// addresses feed the audit log so only configuration admins may change how they are resolved
+func setTrustedProxies(ctx context.Context, client MinioAdmin, body *models.TrustedProxiesConfiguration) (*realip.Config, error) {
+	if err := checkServerConfigAccess(ctx, client); err != nil {
+		return nil, err
+	}
+	config, err := realip.New(body.Proxies, body.Headers)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTrustedProxies, err)
+	}
+	realip.Set(config)
+	return config, nil
+}
+
+func getTrustedProxiesResponse(session *models.Principal, params cfgApi.GetTrustedProxiesParams) (*models.TrustedProxiesConfiguration, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	if err := checkServerConfigAccess(ctx, adminClient); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return trustedProxiesConfiguration(realip.Get()), nil
+}
+
+func setTrustedProxiesResponse(session *models.Principal, params cfgApi.SetTrustedProxiesParams) (*models.TrustedProxiesConfiguration, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	config, err := setTrustedProxies(ctx, adminClient, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return trustedProxiesConfiguration(config), nil
+}
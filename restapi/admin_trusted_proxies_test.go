@@ -0,0 +1,63 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/realip"
+)
+
+func TestSetTrustedProxies(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	previous := realip.Get()
+	defer realip.Set(previous)
+
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte("api requests_max=0"), nil
+	}
+
+	config, err := setTrustedProxies(ctx, adminClient, &models.TrustedProxiesConfiguration{
+		Proxies: []string{"10.0.0.0/8"},
+		Headers: []string{"X-Real-IP"},
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"10.0.0.0/8"}, config.Proxies())
+	assert.Equal([]string{"X-Real-Ip"}, realip.Get().Headers())
+
+	// invalid CIDRs are rejected and the current configuration is kept
+	_, err = setTrustedProxies(ctx, adminClient, &models.TrustedProxiesConfiguration{
+		Proxies: []string{"10.0.0.0/40"},
+	})
+	assert.ErrorIs(err, ErrInvalidTrustedProxies)
+	assert.Equal([]string{"10.0.0.0/8"}, realip.Get().Proxies())
+
+	// sessions without access to the server configuration can't change it
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return nil, errors.New("access denied")
+	}
+	_, err = setTrustedProxies(ctx, adminClient, &models.TrustedProxiesConfiguration{})
+	assert.EqualError(err, "access denied")
+	assert.Equal([]string{"10.0.0.0/8"}, realip.Get().Proxies())
+}
@@ -51,7 +51,7 @@ func registerUsersHandlers(api *operations.ConsoleAPI) {
 		if err != nil {
 			return userApi.NewListUsersDefault(int(err.Code)).WithPayload(err)
 		}
-		return userApi.NewListUsersOK().WithPayload(listUsersResponse)
+		return withPageHeaders(userApi.NewListUsersOK().WithPayload(listUsersResponse), listUsersResponse.Total, listUsersResponse.NextCursor)
 	})
 	// Add User
 	api.UserAddUserHandler = userApi.AddUserHandlerFunc(func(params userApi.AddUserParams, session *models.Principal) middleware.Responder {
@@ -168,9 +168,16 @@ func getListUsersResponse(session *models.Principal, params userApi.ListUsersPar
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	sort.Slice(users, func(i, j int) bool { return users[i].AccessKey < users[j].AccessKey })
+	page, err := paginate(len(users), func(i int) string { return users[i].AccessKey }, params.Cursor, params.PageSize)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	// serialize output
 	listUsersResponse := &models.ListUsersResponse{
-		Users: users,
+		Users:      users[page.start:page.end],
+		Total:      int64(page.total),
+		NextCursor: page.nextCursor,
 	}
 	return listUsersResponse, nil
 }
@@ -0,0 +1,178 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	userApi "github.com/minio/console/restapi/operations/user"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerUserEffectivePolicyHandlers(api *operations.ConsoleAPI) {
+	// merged policy of a user with the source of every statement
+	api.UserGetUserEffectivePolicyHandler = userApi.GetUserEffectivePolicyHandlerFunc(func(params userApi.GetUserEffectivePolicyParams, session *models.Principal) middleware.Responder {
+		resp, err := getUserEffectivePolicyResponse(session, params)
+		if err != nil {
+			return userApi.NewGetUserEffectivePolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewGetUserEffectivePolicyOK().WithPayload(resp)
+	})
+}
+
+// effectivePolicySourceName identifies a source in the statements it contributed, e.g. group:developers
+func effectivePolicySourceName(source *models.UserEffectivePolicySource) string {
+	if source.Type == models.UserEffectivePolicySourceTypeClaim {
+		return source.Type
+	}
+	return source.Type + ":" + source.Name
+}
+
+// userEffectivePolicy merges the policies attached to a user, to the groups the user belongs to and, as
+// MinIO doesn't store them, the ones an identity provider claim maps the user to. Disabled groups and
+// policies that no longer exist are left out like MinIO does when evaluating requests
+func userEffectivePolicy(ctx context.Context, client MinioAdmin, user string, claimPolicies []string) (*models.UserEffectivePolicy, error) {
+	info, err := client.getUserInfo(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.UserEffectivePolicy{
+		User:       user,
+		Sources:    []*models.UserEffectivePolicySource{},
+		Policies:   []string{},
+		Statements: []*models.UserEffectivePolicyStatement{},
+		Warnings:   []string{},
+	}
+	if info.Status == madmin.AccountDisabled {
+		result.Warnings = append(result.Warnings, "the user is disabled, every request is denied regardless of the policies")
+	}
+	result.Sources = append(result.Sources, &models.UserEffectivePolicySource{
+		Type:     models.UserEffectivePolicySourceTypeUser,
+		Name:     user,
+		Policies: splitPolicies(info.PolicyName),
+	})
+	for _, group := range info.MemberOf {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		result.Sources = append(result.Sources, &models.UserEffectivePolicySource{
+			Type:     models.UserEffectivePolicySourceTypeGroup,
+			Name:     group,
+			Policies: splitPolicies(desc.Policy),
+			Disabled: desc.Status == "disabled",
+		})
+	}
+	if len(claimPolicies) > 0 {
+		result.Sources = append(result.Sources, &models.UserEffectivePolicySource{
+			Type:     models.UserEffectivePolicySourceTypeClaim,
+			Name:     "claim",
+			Policies: claimPolicies,
+		})
+	}
+
+	// policies in order of first appearance with every source that attaches them
+	var names []string
+	sources := map[string][]string{}
+	for _, source := range result.Sources {
+		if source.Disabled {
+			continue
+		}
+		for _, policy := range source.Policies {
+			if _, ok := sources[policy]; !ok {
+				names = append(names, policy)
+			}
+			sources[policy] = append(sources[policy], effectivePolicySourceName(source))
+		}
+	}
+
+	var statements []iampolicy.Statement
+	for _, name := range names {
+		policy, err := client.getPolicy(ctx, name)
+		if err != nil {
+			if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchPolicy" {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("policy %s is attached but doesn't exist", name))
+				continue
+			}
+			return nil, err
+		}
+		result.Policies = append(result.Policies, name)
+		for _, statement := range policy.Statements {
+			rawStatement, err := json.Marshal(statement)
+			if err != nil {
+				return nil, err
+			}
+			result.Statements = append(result.Statements, &models.UserEffectivePolicyStatement{
+				Policy:    name,
+				Sources:   sources[name],
+				Sid:       string(statement.SID),
+				Effect:    string(statement.Effect),
+				Statement: string(rawStatement),
+			})
+			statements = append(statements, statement)
+		}
+	}
+	if len(result.Policies) == 0 {
+		result.Warnings = append(result.Warnings, "no policy applies to the user, every request is denied")
+	}
+	merged, err := json.Marshal(iampolicy.Policy{
+		Version:    iampolicy.DefaultVersion,
+		Statements: statements,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Policy = string(merged)
+	return result, nil
+}
+
+func getUserEffectivePolicyResponse(session *models.Principal, params userApi.GetUserEffectivePolicyParams) (*models.UserEffectivePolicy, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	userName, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	var claimPolicies []string
+	if params.ClaimPolicies != nil {
+		claimPolicies = splitPolicies(*params.ClaimPolicies)
+	}
+	result, err := userEffectivePolicy(ctx, adminClient, userName, claimPolicies)
+	if err != nil {
+		// User doesn't exist, return 404
+		if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchUser" {
+			return nil, &models.Error{Code: 404, Message: swag.String("User doesn't exist"), DetailedMessage: swag.String(err.Error())}
+		}
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,81 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserEffectivePolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{PolicyName: "readonly", MemberOf: []string{"developers", "contractors"}, Status: madmin.AccountEnabled}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "contractors" {
+			return &madmin.GroupDesc{Name: group, Policy: "writeonly", Status: "disabled"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Policy: "readonly,diagnostics", Status: "enabled"}, nil
+	}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if name == "missing" {
+			return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+		}
+		return iampolicy.ParseConfig(strings.NewReader(`{"Version":"2012-10-17","Statement":[{"Sid":"` + name + `","Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::` + name + `/*"]}]}`))
+	}
+
+	result, err := userEffectivePolicy(ctx, adminClient, "alice", []string{"consoleAdmin", "missing"})
+	assert.NoError(err)
+	assert.Equal("alice", result.User)
+	assert.Len(result.Sources, 4)
+	assert.True(result.Sources[2].Disabled)
+	assert.Equal(models.UserEffectivePolicySourceTypeClaim, result.Sources[3].Type)
+	// the policies of the disabled group don't apply and missing policies are ignored
+	assert.Equal([]string{"readonly", "diagnostics", "consoleAdmin"}, result.Policies)
+	assert.Len(result.Statements, 3)
+	assert.Equal([]string{"user:alice", "group:developers"}, result.Statements[0].Sources)
+	assert.Equal("readonly", result.Statements[0].Sid)
+	assert.Equal([]string{"claim"}, result.Statements[2].Sources)
+	assert.Equal([]string{"policy missing is attached but doesn't exist"}, result.Warnings)
+	merged, err := iampolicy.ParseConfig(strings.NewReader(result.Policy))
+	assert.NoError(err)
+	assert.Len(merged.Statements, 3)
+
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{Status: madmin.AccountDisabled}, nil
+	}
+	result, err = userEffectivePolicy(ctx, adminClient, "bob", nil)
+	assert.NoError(err)
+	assert.Empty(result.Policies)
+	assert.Len(result.Warnings, 2)
+
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{}, errors.New("The specified user does not exist")
+	}
+	_, err = userEffectivePolicy(ctx, adminClient, "carol", nil)
+	assert.Error(err)
+}
@@ -0,0 +1,320 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	userApi "github.com/minio/console/restapi/operations/user"
+)
+
+const (
+	maxImportUsers = 1000
+	// largest users file accepted
+	maxImportUsersFileSize = 10 << 20
+	// secret keys generated for imported users
+	generatedSecretKeyLength   = 40
+	generatedSecretKeyAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	// secret key value asking for a generated one
+	generateSecretKey = "generate"
+
+	userCredentialsEvent = "user_credentials_created"
+)
+
+func registerUsersImportHandlers(api *operations.ConsoleAPI) {
+	// import users from a file
+	api.UserImportUsersHandler = userApi.ImportUsersHandlerFunc(func(params userApi.ImportUsersParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportUsersResponse(session, params)
+		if err != nil {
+			return userApi.NewImportUsersDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewImportUsersOK().WithPayload(resp)
+	})
+}
+
+// userImportRow is a user of the imported file. An empty secret key, or "generate", gets a random one.
+type userImportRow struct {
+	AccessKey string   `json:"accessKey"`
+	SecretKey string   `json:"secretKey"`
+	Groups    []string `json:"groups"`
+	Policies  []string `json:"policies"`
+	// where the generated credentials are sent, passed along to the credentials webhook
+	Email string `json:"email"`
+
+	generated bool
+}
+
+// userCredentialsNotification is the payload posted to the credentials webhook for every user
+// created with a generated secret key
+type userCredentialsNotification struct {
+	Event     string    `json:"event"`
+	AccessKey string    `json:"accessKey"`
+	SecretKey string    `json:"secretKey"`
+	Email     string    `json:"email,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+// splitImportList splits a list of groups or policies of a CSV cell, separated by semicolons
+func splitImportList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// parseUsersCSV reads users from a CSV file whose first row names the columns: accessKey, secretKey,
+// groups, policies and email. Groups and policies are separated by semicolons.
+func parseUsersCSV(r io.Reader) ([]*userImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidUsersImport, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: the file is empty", ErrInvalidUsersImport)
+	}
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), "_", ""))
+		columns[name] = i
+	}
+	if _, ok := columns["accesskey"]; !ok {
+		return nil, fmt.Errorf("%w: the accessKey column is required", ErrInvalidUsersImport)
+	}
+	cell := func(record []string, column string) string {
+		if i, ok := columns[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	var rows []*userImportRow
+	for _, record := range records[1:] {
+		rows = append(rows, &userImportRow{
+			AccessKey: cell(record, "accesskey"),
+			SecretKey: cell(record, "secretkey"),
+			Groups:    splitImportList(cell(record, "groups")),
+			Policies:  splitImportList(cell(record, "policies")),
+			Email:     cell(record, "email"),
+		})
+	}
+	return rows, nil
+}
+
+// parseUsersFile reads the users of a CSV or JSON file. Without an explicit format it is taken from
+// the file extension or, failing that, from its content.
+func parseUsersFile(r io.Reader, format, fileName string) ([]*userImportRow, error) {
+	reader := bufio.NewReader(io.LimitReader(r, maxImportUsersFileSize))
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".")
+	}
+	if format != "csv" && format != "json" {
+		format = "csv"
+		if start, err := reader.Peek(1); err == nil && (start[0] == '[' || start[0] == '{') {
+			format = "json"
+		}
+	}
+	var rows []*userImportRow
+	if format == "json" {
+		if err := json.NewDecoder(reader).Decode(&rows); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidUsersImport, err)
+		}
+	} else {
+		var err error
+		if rows, err = parseUsersCSV(reader); err != nil {
+			return nil, err
+		}
+	}
+	for _, row := range rows {
+		if row == nil {
+			return nil, fmt.Errorf("%w: empty user", ErrInvalidUsersImport)
+		}
+	}
+	if len(rows) == 0 || len(rows) > maxImportUsers {
+		return nil, fmt.Errorf("%w: between 1 and %d users are required", ErrInvalidUsersImport, maxImportUsers)
+	}
+	return rows, nil
+}
+
+// validateImportRow checks a user can be created, generating its secret key when asked to
+func validateImportRow(row *userImportRow, existing map[string]bool, policies map[string]bool) error {
+	row.AccessKey = strings.TrimSpace(row.AccessKey)
+	if len(row.AccessKey) < 3 {
+		return fmt.Errorf("access key must be at least 3 characters long")
+	}
+	if existing[row.AccessKey] {
+		return ErrNonUniqueAccessKey
+	}
+	if row.SecretKey == "" || row.SecretKey == generateSecretKey {
+		row.SecretKey = RandomCharStringWithAlphabet(generatedSecretKeyLength, generatedSecretKeyAlphabet)
+		row.generated = true
+	}
+	if len(row.SecretKey) < 8 || len(row.SecretKey) > 40 {
+		return fmt.Errorf("secret key must be between 8 and 40 characters long")
+	}
+	for _, policy := range row.Policies {
+		if !policies[policy] {
+			return fmt.Errorf("policy %s doesn't exist", policy)
+		}
+	}
+	return nil
+}
+
+// importUsers creates the users of the file. Nothing is created unless every row is valid and, when
+// creating one of them fails, the users already created are removed again.
+func importUsers(ctx context.Context, client MinioAdmin, rows []*userImportRow, dryRun bool) (*models.UserImportResponse, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policyMap, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for accessKey := range users {
+		existing[accessKey] = true
+	}
+	policies := map[string]bool{}
+	for name := range policyMap {
+		policies[name] = true
+	}
+
+	resp := &models.UserImportResponse{DryRun: dryRun}
+	valid := true
+	for i, row := range rows {
+		result := &models.UserImportResult{Row: int32(i + 1), AccessKey: row.AccessKey, Status: models.UserImportResultStatusValid}
+		if err := validateImportRow(row, existing, policies); err != nil {
+			result.Status = models.UserImportResultStatusInvalid
+			result.Error = err.Error()
+			valid = false
+		}
+		result.AccessKey = row.AccessKey
+		// repeated access keys in the file are rejected
+		existing[row.AccessKey] = true
+		resp.Results = append(resp.Results, result)
+	}
+	if !valid || dryRun {
+		return resp, nil
+	}
+
+	for i, row := range rows {
+		result := resp.Results[i]
+		if _, err := addUser(ctx, client, &row.AccessKey, &row.SecretKey, row.Groups, row.Policies); err != nil {
+			result.Status = models.UserImportResultStatusFailed
+			result.Error = err.Error()
+			// the user may exist without all its groups or policies, it's fine if it doesn't
+			_ = client.removeUser(ctx, row.AccessKey)
+			rollbackImportedUsers(ctx, client, rows[:i], resp.Results[:i])
+			resp.Created = 0
+			return resp, nil
+		}
+		result.Status = models.UserImportResultStatusCreated
+		resp.Created++
+	}
+	resp.Imported = true
+	return resp, nil
+}
+
+// rollbackImportedUsers removes the users created by an import that couldn't complete
+func rollbackImportedUsers(ctx context.Context, client MinioAdmin, rows []*userImportRow, results []*models.UserImportResult) {
+	for i, row := range rows {
+		if err := client.removeUser(ctx, row.AccessKey); err != nil {
+			results[i].Error = fmt.Sprintf("unable to roll back: %v", err)
+			continue
+		}
+		results[i].Status = models.UserImportResultStatusRolledBack
+	}
+}
+
+// deliverGeneratedCredentials posts the generated secret keys to the credentials webhook. Without a
+// webhook, or when it can't be reached, the secret key is returned in the response instead so it
+// isn't lost.
+func deliverGeneratedCredentials(resp *models.UserImportResponse, rows []*userImportRow, endpoint string, post func(n userCredentialsNotification) error) {
+	for i, row := range rows {
+		result := resp.Results[i]
+		if !row.generated || result.Status != models.UserImportResultStatusCreated {
+			continue
+		}
+		if endpoint == "" {
+			result.SecretKey = row.SecretKey
+			continue
+		}
+		err := post(userCredentialsNotification{
+			Event:     userCredentialsEvent,
+			AccessKey: row.AccessKey,
+			SecretKey: row.SecretKey,
+			Email:     row.Email,
+			Time:      time.Now().UTC(),
+		})
+		if err != nil {
+			result.SecretKey = row.SecretKey
+			result.Error = fmt.Sprintf("unable to deliver the credentials: %v", err)
+			continue
+		}
+		result.CredentialsDelivered = true
+	}
+}
+
+func getImportUsersResponse(session *models.Principal, params userApi.ImportUsersParams) (*models.UserImportResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	defer params.File.Close()
+	var format, fileName string
+	if params.Format != nil {
+		format = strings.ToLower(*params.Format)
+	}
+	if file, ok := params.File.(*runtime.File); ok && file.Header != nil {
+		fileName = file.Header.Filename
+	}
+	rows, err := parseUsersFile(params.File, format, fileName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	dryRun := params.DryRun != nil && *params.DryRun
+	resp, err := importUsers(ctx, AdminClient{Client: mAdmin}, rows, dryRun)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if resp.Imported {
+		endpoint := getConsoleCredentialsWebhookEndpoint()
+		deliverGeneratedCredentials(resp, rows, endpoint, func(n userCredentialsNotification) error {
+			return postWebhookNotification(endpoint, getConsoleCredentialsWebhookAuthToken(), n)
+		})
+	}
+	return resp, nil
+}
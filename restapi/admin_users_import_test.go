@@ -0,0 +1,166 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUsersFile(t *testing.T) {
+	assert := assert.New(t)
+	csvFile := "access_key,secret_key,groups,policies,email\n" +
+		"alice,alicesecret,devs;ops,readwrite,alice@example.net\n" +
+		"bob,,,readonly,\n"
+	rows, err := parseUsersFile(strings.NewReader(csvFile), "", "users.csv")
+	assert.NoError(err)
+	assert.Len(rows, 2)
+	assert.Equal(&userImportRow{AccessKey: "alice", SecretKey: "alicesecret", Groups: []string{"devs", "ops"}, Policies: []string{"readwrite"}, Email: "alice@example.net"}, rows[0])
+	assert.Equal(&userImportRow{AccessKey: "bob", Policies: []string{"readonly"}}, rows[1])
+
+	// the format is detected from the content
+	rows, err = parseUsersFile(strings.NewReader(`[{"accessKey":"carol","secretKey":"generate","groups":["devs"]}]`), "", "users")
+	assert.NoError(err)
+	assert.Equal("carol", rows[0].AccessKey)
+	assert.Equal([]string{"devs"}, rows[0].Groups)
+
+	_, err = parseUsersFile(strings.NewReader("name,secret\nalice,secret\n"), "csv", "")
+	assert.ErrorIs(err, ErrInvalidUsersImport)
+	_, err = parseUsersFile(strings.NewReader("[]"), "json", "")
+	assert.ErrorIs(err, ErrInvalidUsersImport)
+	_, err = parseUsersFile(strings.NewReader("[null]"), "json", "")
+	assert.ErrorIs(err, ErrInvalidUsersImport)
+}
+
+func TestImportUsers(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"admin": {}}, nil
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readwrite": {}, "readonly": {}}, nil
+	}
+	var created, removed []string
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		if accessKey == "broken" {
+			return errors.New("server unavailable")
+		}
+		created = append(created, accessKey)
+		return nil
+	}
+	minioRemoveUserMock = func(accessKey string) error {
+		removed = append(removed, accessKey)
+		return nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		return nil
+	}
+
+	// invalid rows reject the whole file
+	resp, err := importUsers(ctx, adminClient, []*userImportRow{
+		{AccessKey: "alice", SecretKey: "alicesecret"},
+		{AccessKey: "admin", SecretKey: "adminsecret"},
+		{AccessKey: "alice", SecretKey: "alicesecret"},
+		{AccessKey: "bob", SecretKey: "short"},
+		{AccessKey: "carol", Policies: []string{"unknown"}},
+	}, false)
+	assert.NoError(err)
+	assert.False(resp.Imported)
+	assert.Empty(created)
+	statuses := []string{}
+	for _, result := range resp.Results {
+		statuses = append(statuses, result.Status)
+	}
+	assert.Equal([]string{"valid", "invalid", "invalid", "invalid", "invalid"}, statuses)
+
+	// failures creating a user roll back the ones already created
+	resp, err = importUsers(ctx, adminClient, []*userImportRow{
+		{AccessKey: "alice", SecretKey: "alicesecret", Policies: []string{"readwrite"}},
+		{AccessKey: "broken", SecretKey: "brokensecret"},
+		{AccessKey: "carol", SecretKey: "carolsecret"},
+	}, false)
+	assert.NoError(err)
+	assert.False(resp.Imported)
+	assert.Equal("rolledBack", resp.Results[0].Status)
+	assert.Equal("failed", resp.Results[1].Status)
+	assert.Equal("server unavailable", resp.Results[1].Error)
+	assert.Equal([]string{"alice"}, created)
+	assert.Contains(removed, "alice")
+
+	// dry runs only validate
+	created = nil
+	resp, err = importUsers(ctx, adminClient, []*userImportRow{{AccessKey: "alice", SecretKey: "alicesecret"}}, true)
+	assert.NoError(err)
+	assert.True(resp.DryRun)
+	assert.Empty(created)
+
+	rows := []*userImportRow{{AccessKey: "alice"}, {AccessKey: "bob", SecretKey: "bobsecret1"}}
+	resp, err = importUsers(ctx, adminClient, rows, false)
+	assert.NoError(err)
+	assert.True(resp.Imported)
+	assert.Equal(int64(2), resp.Created)
+	assert.True(rows[0].generated)
+	assert.Len(rows[0].SecretKey, generatedSecretKeyLength)
+}
+
+func TestDeliverGeneratedCredentials(t *testing.T) {
+	assert := assert.New(t)
+	rows := []*userImportRow{
+		{AccessKey: "alice", SecretKey: "generated1", Email: "alice@example.net", generated: true},
+		{AccessKey: "bob", SecretKey: "bobsecret1"},
+		{AccessKey: "carol", SecretKey: "generated2", generated: true},
+	}
+	newResponse := func() *models.UserImportResponse {
+		resp := &models.UserImportResponse{Imported: true}
+		for _, row := range rows {
+			resp.Results = append(resp.Results, &models.UserImportResult{AccessKey: row.AccessKey, Status: models.UserImportResultStatusCreated})
+		}
+		return resp
+	}
+
+	// without a webhook the generated secrets are returned
+	resp := newResponse()
+	deliverGeneratedCredentials(resp, rows, "", nil)
+	assert.Equal("generated1", resp.Results[0].SecretKey)
+	assert.Empty(resp.Results[1].SecretKey)
+
+	var sent []userCredentialsNotification
+	resp = newResponse()
+	deliverGeneratedCredentials(resp, rows, "http://webhook", func(n userCredentialsNotification) error {
+		if n.AccessKey == "carol" {
+			return errors.New("connection refused")
+		}
+		sent = append(sent, n)
+		return nil
+	})
+	assert.Len(sent, 1)
+	assert.Equal("alice@example.net", sent[0].Email)
+	assert.True(resp.Results[0].CredentialsDelivered)
+	assert.Empty(resp.Results[0].SecretKey)
+	// secrets that couldn't be delivered aren't lost
+	assert.False(resp.Results[2].CredentialsDelivered)
+	assert.Equal("generated2", resp.Results[2].SecretKey)
+}
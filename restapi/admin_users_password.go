@@ -0,0 +1,153 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	errorsApi "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/passwordpolicy"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	userApi "github.com/minio/console/restapi/operations/user"
+	"github.com/minio/madmin-go/v2"
+)
+
+var (
+	globalPasswordState     *passwordpolicy.Store
+	globalPasswordStateOnce sync.Once
+)
+
+// passwordChangeOperations are the operations left to the sessions of users that must change their secret key
+var passwordChangeOperations = map[string]bool{
+	"SessionCheck":          true,
+	"AccountChangePassword": true,
+	"Logout":                true,
+}
+
+func registerUserPasswordHandlers(api *operations.ConsoleAPI) {
+	// reset the secret key of a user to a one-time value
+	api.UserResetUserPasswordHandler = userApi.ResetUserPasswordHandlerFunc(func(params userApi.ResetUserPasswordParams, session *models.Principal) middleware.Responder {
+		resp, err := getResetUserPasswordResponse(session, params)
+		if err != nil {
+			return userApi.NewResetUserPasswordDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewResetUserPasswordCreated().WithPayload(resp)
+	})
+}
+
+// passwordState returns the store of the must change flags and secret key history of the users, when
+// the configured file can't be loaded the state is only kept in memory
+func passwordState() *passwordpolicy.Store {
+	globalPasswordStateOnce.Do(func() {
+		store, err := passwordpolicy.New(getConsolePasswordStateFile())
+		if err != nil {
+			LogError("unable to load the password state: %v", err)
+			store, _ = passwordpolicy.New("")
+		}
+		globalPasswordState = store
+	})
+	return globalPasswordState
+}
+
+// passwordChangeAuthorizer is a runtime.Authorizer that only lets the sessions of users that must change
+// their secret key check their session, change it or log out, every other operation goes to next
+type passwordChangeAuthorizer struct {
+	store *passwordpolicy.Store
+	next  runtime.Authorizer
+}
+
+// Authorize implements runtime.Authorizer
+func (a passwordChangeAuthorizer) Authorize(r *http.Request, principal interface{}) error {
+	if session, ok := principal.(*models.Principal); ok && session != nil && a.store.MustChange(session.AccountAccessKey) {
+		route := middleware.MatchedRouteFrom(r)
+		if route == nil || route.Operation == nil || !passwordChangeOperations[route.Operation.ID] {
+			return errorsApi.New(http.StatusForbidden, "the secret key must be changed before continuing")
+		}
+	}
+	if a.next == nil {
+		return nil
+	}
+	return a.next.Authorize(r, principal)
+}
+
+// checkNewPassword validates the secret key a user chose to replace current with
+func checkNewPassword(store *passwordpolicy.Store, policy passwordpolicy.Policy, user, current, secret string) error {
+	if err := policy.Check(secret); err != nil {
+		return err
+	}
+	if store.MustChange(user) && secret == current {
+		return fmt.Errorf("%w: it must differ from the one-time secret key", passwordpolicy.ErrViolation)
+	}
+	if policy.History > 0 && (secret == current || store.Reused(user, secret, policy.History)) {
+		return fmt.Errorf("%w: it can't be one of the last %d secret keys", passwordpolicy.ErrViolation, policy.History)
+	}
+	return nil
+}
+
+// resetUserPassword sets a random secret key meeting the policy for the user and flags it to be changed
+// on the next login
+func resetUserPassword(ctx context.Context, client MinioAdmin, store *passwordpolicy.Store, policy passwordpolicy.Policy, user string) (*models.UserPasswordReset, error) {
+	info, err := client.getUserInfo(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := policy.Generate()
+	if err != nil {
+		return nil, err
+	}
+	if err := client.changePassword(ctx, user, secret); err != nil {
+		return nil, err
+	}
+	// setting the secret key enables the account, disabled users stay disabled
+	if info.Status == madmin.AccountDisabled {
+		if err := client.setUserStatus(ctx, user, madmin.AccountDisabled); err != nil {
+			return nil, err
+		}
+	}
+	if err := store.Reset(user); err != nil {
+		return nil, err
+	}
+	return &models.UserPasswordReset{AccessKey: user, SecretKey: secret, MustChange: true}, nil
+}
+
+func getResetUserPasswordResponse(session *models.Principal, params userApi.ResetUserPasswordParams) (*models.UserPasswordReset, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	userName, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	reset, err := resetUserPassword(ctx, adminClient, passwordState(), getConsolePasswordPolicy(), userName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return reset, nil
+}
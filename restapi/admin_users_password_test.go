@@ -0,0 +1,97 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/passwordpolicy"
+	"github.com/minio/madmin-go/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResetUserPassword(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	store, _ := passwordpolicy.New("")
+	policy := passwordpolicy.Policy{MinLength: 12, RequireUpper: true, RequireDigit: true, RequireSymbol: true}
+	var gotSecret string
+	var gotStatus madmin.AccountStatus
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{Status: madmin.AccountDisabled}, nil
+	}
+	minioChangePasswordMock = func(ctx context.Context, accessKey, secretKey string) error {
+		gotSecret = secretKey
+		return nil
+	}
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		gotStatus = status
+		return nil
+	}
+
+	reset, err := resetUserPassword(ctx, adminClient, store, policy, "alice")
+	assert.NoError(err)
+	assert.Equal("alice", reset.AccessKey)
+	assert.Equal(gotSecret, reset.SecretKey)
+	assert.True(reset.MustChange)
+	assert.NoError(policy.Check(reset.SecretKey))
+	assert.True(store.MustChange("alice"))
+	// the account was disabled before the reset
+	assert.Equal(madmin.AccountDisabled, gotStatus)
+
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		return madmin.UserInfo{}, errors.New("The specified user does not exist")
+	}
+	_, err = resetUserPassword(ctx, adminClient, store, policy, "bob")
+	assert.Error(err)
+	assert.False(store.MustChange("bob"))
+}
+
+func TestCheckNewPassword(t *testing.T) {
+	assert := assert.New(t)
+	store, _ := passwordpolicy.New("")
+	policy := passwordpolicy.Policy{MinLength: 10, History: 2}
+
+	assert.ErrorIs(checkNewPassword(store, policy, "alice", "current-secret", "short"), passwordpolicy.ErrViolation)
+	assert.ErrorIs(checkNewPassword(store, policy, "alice", "current-secret", "current-secret"), passwordpolicy.ErrViolation)
+	assert.NoError(checkNewPassword(store, policy, "alice", "current-secret", "brand-new-secret"))
+
+	assert.NoError(store.Changed("alice", "brand-new-secret", policy.History))
+	assert.ErrorIs(checkNewPassword(store, policy, "alice", "another-secret", "brand-new-secret"), passwordpolicy.ErrViolation)
+
+	// the one-time secret key can't be kept even without history
+	assert.NoError(store.Reset("bob"))
+	assert.ErrorIs(checkNewPassword(store, passwordpolicy.Policy{}, "bob", "one-time-secret", "one-time-secret"), passwordpolicy.ErrViolation)
+}
+
+func TestPasswordChangeAuthorizer(t *testing.T) {
+	assert := assert.New(t)
+	store, _ := passwordpolicy.New("")
+	assert.NoError(store.Reset("alice"))
+	authorizer := passwordChangeAuthorizer{store: store}
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/buckets", nil)
+
+	assert.Error(authorizer.Authorize(req, &models.Principal{AccountAccessKey: "alice"}))
+	assert.NoError(authorizer.Authorize(req, &models.Principal{AccountAccessKey: "bob"}))
+	assert.NoError(authorizer.Authorize(req, &models.Principal{}))
+}
@@ -0,0 +1,173 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	errorsApi "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+)
+
+// authzWebhookTimeout bounds how long an operation waits for the authorization decision
+const authzWebhookTimeout = 5 * time.Second
+
+// authzWebhookInput describes the operation being authorized. It is sent wrapped in an "input" field so
+// an OPA data API endpoint can be used directly as the webhook
+type authzWebhookInput struct {
+	Operation string            `json:"operation"`
+	Method    string            `json:"method"`
+	Path      string            `json:"path"`
+	Params    map[string]string `json:"params,omitempty"`
+	AccessKey string            `json:"accessKey,omitempty"`
+	Time      time.Time         `json:"time"`
+}
+
+// authzWebhookResult accepts both a plain boolean result and an object with the decision and its reason
+type authzWebhookResult struct {
+	Allow  bool   `json:"allow"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r *authzWebhookResult) UnmarshalJSON(data []byte) error {
+	var allow bool
+	if err := json.Unmarshal(data, &allow); err == nil {
+		r.Allow = allow
+		return nil
+	}
+	type result authzWebhookResult
+	return json.Unmarshal(data, (*result)(r))
+}
+
+// authzWebhook is a runtime.Authorizer that asks an external endpoint whether an authenticated principal can
+// execute an operation, letting organizations enforce central policy on top of MinIO IAM
+type authzWebhook struct {
+	endpoint   string
+	token      string
+	operations map[string]bool
+	failOpen   bool
+	client     *http.Client
+}
+
+func newAuthzWebhook(endpoint, token string, operations []string, failOpen bool) *authzWebhook {
+	a := &authzWebhook{
+		endpoint: endpoint,
+		token:    token,
+		failOpen: failOpen,
+		client:   GetConsoleHTTPClient(endpoint),
+	}
+	if len(operations) > 0 {
+		a.operations = map[string]bool{}
+		for _, op := range operations {
+			a.operations[op] = true
+		}
+	}
+	return a
+}
+
+// applies returns whether the operation needs to be authorized by the webhook
+func (a *authzWebhook) applies(operation, method string) bool {
+	if a.operations != nil {
+		return a.operations[operation] || a.operations["*"]
+	}
+	return method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions
+}
+
+// Authorize implements runtime.Authorizer
+func (a *authzWebhook) Authorize(r *http.Request, principal interface{}) error {
+	route := middleware.MatchedRouteFrom(r)
+	if route == nil || route.Operation == nil {
+		return nil
+	}
+	operation := route.Operation.ID
+	if !a.applies(operation, r.Method) {
+		return nil
+	}
+
+	input := authzWebhookInput{
+		Operation: operation,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Params:    map[string]string{},
+		Time:      time.Now().UTC(),
+	}
+	for _, param := range route.Params {
+		input.Params[param.Name] = param.Value
+	}
+	if session, ok := principal.(*models.Principal); ok && session != nil {
+		input.AccessKey = session.AccountAccessKey
+	}
+
+	result, err := a.decide(r.Context(), input)
+	if err != nil {
+		LogErrorContext(r.Context(), "authorization webhook failed for operation %s: %v", operation, err)
+		if a.failOpen {
+			return nil
+		}
+		return errorsApi.New(http.StatusForbidden, "unable to authorize the operation")
+	}
+	if !result.Allow {
+		reason := result.Reason
+		if reason == "" {
+			reason = fmt.Sprintf("operation %s denied by policy", operation)
+		}
+		return errorsApi.New(http.StatusForbidden, reason)
+	}
+	return nil
+}
+
+func (a *authzWebhook) decide(ctx context.Context, input authzWebhookInput) (*authzWebhookResult, error) {
+	body, err := json.Marshal(map[string]interface{}{"input": input})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, authzWebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if a.token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.token)
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	var decision struct {
+		Result *authzWebhookResult `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&decision); err != nil {
+		return nil, err
+	}
+	if decision.Result == nil {
+		// OPA answers without a result when the policy is not defined
+		return &authzWebhookResult{}, nil
+	}
+	return decision.Result, nil
+}
@@ -0,0 +1,91 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_authzWebhookApplies(t *testing.T) {
+	assert := assert.New(t)
+
+	// by default only operations that change state are authorized
+	webhook := newAuthzWebhook("http://localhost:8181", "", nil, false)
+	assert.True(webhook.applies("DeleteBucket", http.MethodDelete))
+	assert.True(webhook.applies("MakeBucket", http.MethodPost))
+	assert.False(webhook.applies("ListBuckets", http.MethodGet))
+
+	webhook = newAuthzWebhook("http://localhost:8181", "", []string{"ListBuckets"}, false)
+	assert.True(webhook.applies("ListBuckets", http.MethodGet))
+	assert.False(webhook.applies("DeleteBucket", http.MethodDelete))
+
+	webhook = newAuthzWebhook("http://localhost:8181", "", []string{"*"}, false)
+	assert.True(webhook.applies("ListBuckets", http.MethodGet))
+}
+
+func Test_authzWebhookDecide(t *testing.T) {
+	assert := assert.New(t)
+
+	var received map[string]authzWebhookInput
+	response := `{"result": true}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Bearer secret", r.Header.Get("Authorization"))
+		assert.NoError(json.NewDecoder(r.Body).Decode(&received))
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	webhook := newAuthzWebhook(server.URL, "secret", nil, false)
+	input := authzWebhookInput{
+		Operation: "DeleteBucket",
+		Method:    http.MethodDelete,
+		Path:      "/api/v1/buckets/images",
+		Params:    map[string]string{"name": "images"},
+		AccessKey: "console",
+	}
+
+	result, err := webhook.decide(context.Background(), input)
+	assert.NoError(err)
+	assert.True(result.Allow)
+	assert.Equal("DeleteBucket", received["input"].Operation)
+	assert.Equal("images", received["input"].Params["name"])
+	assert.Equal("console", received["input"].AccessKey)
+
+	response = `{"result": {"allow": false, "reason": "buckets can only be deleted by the storage team"}}`
+	result, err = webhook.decide(context.Background(), input)
+	assert.NoError(err)
+	assert.False(result.Allow)
+	assert.Equal("buckets can only be deleted by the storage team", result.Reason)
+
+	// an undefined policy denies the operation
+	response = `{}`
+	result, err = webhook.decide(context.Background(), input)
+	assert.NoError(err)
+	assert.False(result.Allow)
+}
+
+func Test_authzWebhookAuthorizeWithoutRoute(t *testing.T) {
+	webhook := newAuthzWebhook("http://localhost:8181", "", []string{"*"}, false)
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/buckets/images", nil)
+	assert.NoError(t, webhook.Authorize(r, nil))
+}
@@ -0,0 +1,182 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+)
+
+const (
+	// calls of a batch, the flows of the UI chain less than ten
+	maxBatchRequests = 20
+	// size of the response of a call above which the batch stops, the downloads aren't meant to be batched
+	maxBatchResponseSize = 8 << 20
+)
+
+// batchExcludedPaths change the session cookie, which the responses of the calls don't return
+var batchExcludedPaths = []string{"/batch", "/login", "/logout", "/session/renew"}
+
+// errBatchResponseTooLarge stops a call whose response is larger than maxBatchResponseSize
+var errBatchResponseTooLarge = errors.New("the response is too large for a batch")
+
+// globalBatchHandler serves the calls of the batches. It's the API behind the authentication, so the calls share the
+// session of their batch and are still rate limited, traced and audited one by one.
+var globalBatchHandler http.Handler
+
+func registerBatchHandlers(api *operations.ConsoleAPI) {
+	// run several API calls in order
+	api.SystemBatchHandler = systemApi.BatchHandlerFunc(func(params systemApi.BatchParams, session *models.Principal) middleware.Responder {
+		resp, err := getBatchResponse(params)
+		if err != nil {
+			return systemApi.NewBatchDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewBatchOK().WithPayload(resp)
+	})
+}
+
+// getBatchResponse runs the calls of the batch in order with the session of the batch, up to the first one failing
+func getBatchResponse(params systemApi.BatchParams) (*models.BatchResponse, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if globalBatchHandler == nil {
+		return nil, ErrorWithContext(ctx, errors.New("batches aren't available"))
+	}
+	calls, err := newBatchCalls(ctx, params.HTTPRequest, params.Body.Requests)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.BatchResponse{Responses: runBatch(globalBatchHandler, params.Body.Requests, calls)}, nil
+}
+
+// newBatchCalls validates the calls of a batch and returns their requests, carrying the headers of the batch and so
+// its session and request ID
+func newBatchCalls(ctx context.Context, batch *http.Request, requests []*models.BatchSubrequest) ([]*http.Request, error) {
+	if len(requests) == 0 || len(requests) > maxBatchRequests {
+		return nil, fmt.Errorf("%w: between 1 and %d calls are required", ErrInvalidBatchRequest, maxBatchRequests)
+	}
+	var calls []*http.Request
+	for i, call := range requests {
+		target, err := url.ParseRequestURI(*call.Path)
+		if err != nil || target.IsAbs() || path.Clean(target.Path) != target.Path {
+			return nil, fmt.Errorf("%w: call %d has an invalid path %q", ErrInvalidBatchRequest, i, *call.Path)
+		}
+		for _, excluded := range batchExcludedPaths {
+			if target.Path == excluded || strings.HasPrefix(target.Path, excluded+"/") {
+				return nil, fmt.Errorf("%w: %s can't be called in a batch", ErrInvalidBatchRequest, excluded)
+			}
+		}
+		var body []byte
+		if call.Body != nil {
+			if body, err = json.Marshal(call.Body); err != nil {
+				return nil, fmt.Errorf("%w: call %d has an invalid body", ErrInvalidBatchRequest, i)
+			}
+		}
+		req, err := http.NewRequestWithContext(ctx, *call.Method, "/api/v1"+target.RequestURI(), bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBatchRequest, err)
+		}
+		req.Header = batch.Header.Clone()
+		req.Header.Del("Content-Length")
+		req.Header.Del("Accept-Encoding")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		} else {
+			req.Header.Del("Content-Type")
+		}
+		req.Host = batch.Host
+		req.RemoteAddr = batch.RemoteAddr
+		req.TLS = batch.TLS
+		calls = append(calls, req)
+	}
+	return calls, nil
+}
+
+// runBatch serves the calls in order and returns their responses, it stops after the first call failing
+func runBatch(handler http.Handler, requests []*models.BatchSubrequest, calls []*http.Request) []*models.BatchSubresponse {
+	var responses []*models.BatchSubresponse
+	for i, call := range calls {
+		w := &batchResponseWriter{header: http.Header{}}
+		handler.ServeHTTP(w, call)
+		status := w.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		resp := &models.BatchSubresponse{ID: requests[i].ID, Status: int32(status)}
+		if w.err != nil {
+			resp.Status = http.StatusInternalServerError
+			resp.Body = ErrorWithContext(call.Context(), w.err)
+		} else if w.body.Len() > 0 {
+			resp.Body = batchResponseBody(w.header.Get("Content-Type"), w.body.Bytes())
+		}
+		responses = append(responses, resp)
+		if resp.Status >= http.StatusBadRequest {
+			break
+		}
+	}
+	return responses
+}
+
+// batchResponseBody returns the JSON body of a call as it is, the other bodies are returned as text
+func batchResponseBody(contentType string, body []byte) interface{} {
+	if mediaType, _, _ := mime.ParseMediaType(contentType); strings.HasSuffix(mediaType, "json") && json.Valid(body) {
+		return json.RawMessage(body)
+	}
+	return string(body)
+}
+
+// batchResponseWriter keeps the response of a call of a batch
+type batchResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+	err    error
+}
+
+// Header implements http.ResponseWriter
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// WriteHeader implements http.ResponseWriter
+func (w *batchResponseWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+}
+
+// Write implements http.ResponseWriter
+func (w *batchResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	if w.body.Len()+len(b) > maxBatchResponseSize {
+		w.err = errBatchResponseTooLarge
+		return 0, w.err
+	}
+	return w.body.Write(b)
+}
@@ -0,0 +1,150 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func batchCall(id, method, path string, body interface{}) *models.BatchSubrequest {
+	return &models.BatchSubrequest{ID: id, Method: swag.String(method), Path: swag.String(path), Body: body}
+}
+
+func TestNewBatchCalls(t *testing.T) {
+	assert := assert.New(t)
+	batch := httptest.NewRequest(http.MethodPost, "/api/v1/batch", nil)
+	batch.Header.Set("Authorization", "Bearer  session-token")
+	batch.Header.Set("X-Request-ID", "batch-1")
+	batch.Header.Set("Accept-Encoding", "br")
+	batch.Header.Set("Content-Type", "application/json")
+	batch.RemoteAddr = "10.0.0.7:52341"
+
+	// Test-1 : the calls carry the session of the batch and their own body
+	calls, err := newBatchCalls(batch.Context(), batch, []*models.BatchSubrequest{
+		batchCall("info", http.MethodGet, "/buckets/photos", nil),
+		batchCall("quota", http.MethodPut, "/buckets/photos/quota?force=true", map[string]interface{}{"enabled": true}),
+	})
+	assert.NoError(err)
+	assert.Len(calls, 2)
+	assert.Equal("/api/v1/buckets/photos", calls[0].URL.Path)
+	assert.Equal("Bearer  session-token", calls[0].Header.Get("Authorization"))
+	assert.Equal("batch-1", calls[0].Header.Get("X-Request-ID"))
+	assert.Empty(calls[0].Header.Get("Accept-Encoding"))
+	assert.Empty(calls[0].Header.Get("Content-Type"))
+	assert.Equal("10.0.0.7:52341", calls[0].RemoteAddr)
+	assert.Equal(http.MethodPut, calls[1].Method)
+	assert.Equal("force=true", calls[1].URL.RawQuery)
+	assert.Equal("application/json", calls[1].Header.Get("Content-Type"))
+	body, _ := io.ReadAll(calls[1].Body)
+	assert.JSONEq(`{"enabled":true}`, string(body))
+
+	// Test-2 : the batches without calls, with too many of them or with calls that can't be batched are rejected
+	tooMany := make([]*models.BatchSubrequest, maxBatchRequests+1)
+	for i := range tooMany {
+		tooMany[i] = batchCall("", http.MethodGet, "/buckets", nil)
+	}
+	for _, requests := range [][]*models.BatchSubrequest{
+		nil,
+		tooMany,
+		{batchCall("", http.MethodGet, "buckets", nil)},
+		{batchCall("", http.MethodGet, "/buckets/../admin/info", nil)},
+		{batchCall("", http.MethodGet, "http://minio:9000/buckets", nil)},
+		{batchCall("", http.MethodPost, "/batch", nil)},
+		{batchCall("", http.MethodPost, "/login/oauth2/auth", nil)},
+		{batchCall("", http.MethodPost, "/logout", nil)},
+	} {
+		_, err = newBatchCalls(batch.Context(), batch, requests)
+		assert.ErrorIs(err, ErrInvalidBatchRequest)
+	}
+}
+
+func TestRunBatch(t *testing.T) {
+	assert := assert.New(t)
+	var served []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = append(served, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/v1/buckets/photos":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"name":"photos"}`))
+		case "/api/v1/buckets/photos/quota":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v1/buckets/photos/objects/download":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write([]byte(strings.Repeat("x", maxBatchResponseSize+1)))
+		case "/api/v1/buckets/missing":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"bucket not found"}`))
+		default:
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+		}
+	})
+	batch := httptest.NewRequest(http.MethodPost, "/api/v1/batch", nil)
+	run := func(requests ...*models.BatchSubrequest) []*models.BatchSubresponse {
+		served = nil
+		calls, err := newBatchCalls(batch.Context(), batch, requests)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return runBatch(handler, requests, calls)
+	}
+
+	// Test-1 : all the calls run in order, the JSON bodies are returned as they are
+	responses := run(
+		batchCall("info", http.MethodGet, "/buckets/photos", nil),
+		batchCall("quota", http.MethodPut, "/buckets/photos/quota", nil),
+	)
+	assert.Len(responses, 2)
+	assert.Equal("info", responses[0].ID)
+	assert.Equal(int32(http.StatusOK), responses[0].Status)
+	encoded, _ := json.Marshal(responses[0])
+	assert.JSONEq(`{"id":"info","status":200,"body":{"name":"photos"}}`, string(encoded))
+	assert.Equal(int32(http.StatusNoContent), responses[1].Status)
+	assert.Nil(responses[1].Body)
+
+	// Test-2 : the batch stops at the first call failing
+	responses = run(
+		batchCall("missing", http.MethodGet, "/buckets/missing", nil),
+		batchCall("info", http.MethodGet, "/buckets/photos", nil),
+	)
+	assert.Len(responses, 1)
+	assert.Equal(int32(http.StatusNotFound), responses[0].Status)
+	assert.Equal([]string{"/api/v1/buckets/missing"}, served)
+
+	// Test-3 : the other bodies are returned as text
+	responses = run(batchCall("", http.MethodGet, "/buckets/photos/events", nil))
+	assert.Equal(int32(http.StatusTooManyRequests), responses[0].Status)
+	assert.Equal("rate limit exceeded\n", responses[0].Body)
+
+	// Test-4 : a response too large fails its call
+	responses = run(
+		batchCall("", http.MethodGet, "/buckets/photos/objects/download", nil),
+		batchCall("", http.MethodGet, "/buckets/photos", nil),
+	)
+	assert.Len(responses, 1)
+	assert.Equal(int32(http.StatusInternalServerError), responses[0].Status)
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/minio/console/models"
 	"github.com/minio/console/pkg"
+	"github.com/minio/console/pkg/confighistory"
 	"github.com/minio/madmin-go/v2"
 	mcCmd "github.com/minio/mc/cmd"
 	"github.com/minio/mc/pkg/probe"
@@ -88,6 +89,7 @@ type MinioAdmin interface {
 	delConfigKV(ctx context.Context, kv string) (err error)
 
 	serviceRestart(ctx context.Context) error
+	serverUpdate(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error)
 	serverInfo(ctx context.Context) (madmin.InfoMessage, error)
 	startProfiling(ctx context.Context, profiler madmin.ProfilerType) ([]madmin.StartProfilingResult, error)
 	stopProfiling(ctx context.Context) (io.ReadCloser, error)
@@ -96,6 +98,7 @@ type MinioAdmin interface {
 	AccountInfo(ctx context.Context) (madmin.AccountInfo, error)
 	heal(ctx context.Context, bucket, prefix string, healOpts madmin.HealOpts, clientToken string,
 		forceStart, forceStop bool) (healStart madmin.HealStartSuccess, healTaskStatus madmin.HealTaskStatus, err error)
+	backgroundHealStatus(ctx context.Context) (madmin.BgHealState, error)
 	// Service Accounts
 	addServiceAccount(ctx context.Context, policy *iampolicy.Policy, user string, accessKey string, secretKey string) (madmin.Credentials, error)
 	listServiceAccounts(ctx context.Context, user string) (madmin.ListServiceAccountsResp, error)
@@ -120,8 +123,14 @@ type MinioAdmin interface {
 	editTierCreds(ctx context.Context, tierName string, creds madmin.TierCreds) error
 	// verify Tier status
 	verifyTierStatus(ctx context.Context, tierName string) error
+	// Remove Tier
+	removeTier(ctx context.Context, tierName string) error
+	// Inspect
+	inspect(ctx context.Context, insOpts madmin.InspectOptions) ([]byte, io.ReadCloser, error)
 	// Speedtest
 	speedtest(ctx context.Context, opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error)
+	driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error)
+	netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error)
 	// Site Relication
 	getSiteReplicationInfo(ctx context.Context) (*madmin.SiteReplicationInfo, error)
 	addSiteReplicationInfo(ctx context.Context, sites []madmin.PeerSite) (*madmin.ReplicateAddStatus, error)
@@ -160,6 +169,41 @@ type MinioAdmin interface {
 
 	// LDAP
 	getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error)
+
+	// Bucket Quota
+	setBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error
+	getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+
+	// IAM
+	exportIAM(ctx context.Context) (io.ReadCloser, error)
+	importIAM(ctx context.Context, contentReader io.ReadCloser) error
+
+	// Server configuration
+	getServerConfig(ctx context.Context) ([]byte, error)
+	setServerConfig(ctx context.Context, config io.Reader) error
+
+	// Batch jobs
+	startBatchJob(ctx context.Context, job string) (madmin.BatchJobResult, error)
+	listBatchJobs(ctx context.Context, jobType string) (madmin.ListBatchJobsResult, error)
+	describeBatchJob(ctx context.Context, jobID string) (string, error)
+	batchJobMetrics(ctx context.Context, jobID string) (*madmin.JobMetric, error)
+
+	// Pool rebalance
+	startRebalance(ctx context.Context) (string, error)
+	rebalanceStatus(ctx context.Context) (madmin.RebalanceStatus, error)
+	stopRebalance(ctx context.Context) error
+
+	// Pool decommission
+	listPoolsStatus(ctx context.Context) ([]madmin.PoolStatus, error)
+	decommissionPool(ctx context.Context, pool string) error
+	cancelDecommissionPool(ctx context.Context, pool string) error
+
+	// Locks
+	topLocks(ctx context.Context, count int, stale bool) (madmin.LockEntries, error)
+
+	// Data scanner
+	dataUsageInfo(ctx context.Context) (madmin.DataUsageInfo, error)
+	scannerMetrics(ctx context.Context) (*madmin.ScannerMetrics, error)
 }
 
 // Interface implementation
@@ -264,9 +308,15 @@ func (ac AdminClient) setPolicy(ctx context.Context, policyName, entityName stri
 	return ac.Client.SetPolicy(ctx, policyName, entityName, isGroup)
 }
 
-// implements madmin.GetConfigKV()
+// implements madmin.GetConfigKV(), the configuration is cached until it changes
 func (ac AdminClient) getConfigKV(ctx context.Context, key string) ([]byte, error) {
-	return ac.Client.GetConfigKV(ctx, key)
+	config, err := ac.cachedCall(ctx, adminCacheConfig, key, func() (interface{}, error) {
+		return ac.Client.GetConfigKV(ctx, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return config.([]byte), nil
 }
 
 // implements madmin.HelpConfigKV()
@@ -279,25 +329,50 @@ func (ac AdminClient) helpConfigKVGlobal(ctx context.Context, envOnly bool) (mad
 	return ac.Client.HelpConfigKV(ctx, "", "", envOnly)
 }
 
-// implements madmin.SetConfigKV()
+// implements madmin.SetConfigKV(), the change is recorded in the configuration history
 func (ac AdminClient) setConfigKV(ctx context.Context, kv string) (restart bool, err error) {
-	return ac.Client.SetConfigKV(ctx, kv)
+	// the history records the configuration read right before the change
+	ac.invalidateCache(adminCacheConfig)
+	defer ac.invalidateCache(adminCacheConfig, adminCacheServerInfo)
+	err = trackConfigChange(ctx, ac, configHistory(), confighistory.ActionSet, kv, func() (err error) {
+		restart, err = ac.Client.SetConfigKV(ctx, kv)
+		return err
+	})
+	return restart, err
 }
 
-// implements madmin.DelConfigKV()
+// implements madmin.DelConfigKV(), the change is recorded in the configuration history
 func (ac AdminClient) delConfigKV(ctx context.Context, kv string) (err error) {
-	_, err = ac.Client.DelConfigKV(ctx, kv)
-	return err
+	// the history records the configuration read right before the change
+	ac.invalidateCache(adminCacheConfig)
+	defer ac.invalidateCache(adminCacheConfig, adminCacheServerInfo)
+	return trackConfigChange(ctx, ac, configHistory(), confighistory.ActionReset, kv, func() (err error) {
+		_, err = ac.Client.DelConfigKV(ctx, kv)
+		return err
+	})
 }
 
 // implements madmin.ServiceRestart()
 func (ac AdminClient) serviceRestart(ctx context.Context) (err error) {
+	defer ac.invalidateCache(adminCacheServerInfo)
 	return ac.Client.ServiceRestart(ctx)
 }
 
-// implements madmin.ServerInfo()
+// implements madmin.ServerUpdate()
+func (ac AdminClient) serverUpdate(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error) {
+	defer ac.invalidateCache(adminCacheServerInfo)
+	return ac.Client.ServerUpdate(ctx, updateURL)
+}
+
+// implements madmin.ServerInfo(), the dashboard refreshes it so it's cached for a short while
 func (ac AdminClient) serverInfo(ctx context.Context) (madmin.InfoMessage, error) {
-	return ac.Client.ServerInfo(ctx)
+	info, err := ac.cachedCall(ctx, adminCacheServerInfo, "", func() (interface{}, error) {
+		return ac.Client.ServerInfo(ctx)
+	})
+	if err != nil {
+		return madmin.InfoMessage{}, err
+	}
+	return info.(madmin.InfoMessage), nil
 }
 
 // implements madmin.StartProfiling()
@@ -377,6 +452,11 @@ func (ac AdminClient) heal(ctx context.Context, bucket, prefix string, healOpts
 	return ac.Client.Heal(ctx, bucket, prefix, healOpts, clientToken, forceStart, forceStop)
 }
 
+// implements madmin.BackgroundHealStatus()
+func (ac AdminClient) backgroundHealStatus(ctx context.Context) (madmin.BgHealState, error) {
+	return ac.Client.BackgroundHealStatus(ctx)
+}
+
 // listRemoteBuckets - return a list of remote buckets
 func (ac AdminClient) listRemoteBuckets(ctx context.Context, bucket, arnType string) (targets []madmin.BucketTarget, err error) {
 	return ac.Client.ListRemoteTargets(ctx, bucket, arnType)
@@ -485,6 +565,11 @@ func (ac AdminClient) verifyTierStatus(ctx context.Context, tierName string) err
 	return ac.Client.VerifyTier(ctx, tierName)
 }
 
+// implements madmin.RemoveTier()
+func (ac AdminClient) removeTier(ctx context.Context, tierName string) error {
+	return ac.Client.RemoveTier(ctx, tierName)
+}
+
 func NewMinioAdminClient(sessionClaims *models.Principal) (*madmin.AdminClient, error) {
 	adminClient, err := newAdminFromClaims(sessionClaims)
 	if err != nil {
@@ -493,19 +578,25 @@ func NewMinioAdminClient(sessionClaims *models.Principal) (*madmin.AdminClient,
 	return adminClient, nil
 }
 
-// newAdminFromClaims creates a minio admin from Decrypted claims using Assume role credentials
+// newAdminFromClaims creates a minio admin from Decrypted claims using Assume role credentials, for the cluster the
+// session works with
 func newAdminFromClaims(claims *models.Principal) (*madmin.AdminClient, error) {
-	tlsEnabled := getMinIOEndpointIsSecure()
-	endpoint := getMinIOEndpoint()
-
-	adminClient, err := madmin.NewWithOptions(endpoint, &madmin.Options{
-		Creds:  credentials.NewStaticV4(claims.STSAccessKeyID, claims.STSSecretAccessKey, claims.STSSessionToken),
-		Secure: tlsEnabled,
+	server, creds, err := sessionCluster(clusterRegistry(), claims)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+	adminClient, err := madmin.NewWithOptions(u.Host, &madmin.Options{
+		Creds:  creds,
+		Secure: u.Scheme == "https",
 	})
 	if err != nil {
 		return nil, err
 	}
-	adminClient.SetCustomTransport(GetConsoleHTTPClient(getMinIOServer()).Transport)
+	adminClient.SetCustomTransport(GetConsoleHTTPClient(server).Transport)
 	return adminClient, nil
 }
 
@@ -578,6 +669,16 @@ func (ac AdminClient) speedtest(ctx context.Context, opts madmin.SpeedtestOpts)
 	return ac.Client.Speedtest(ctx, opts)
 }
 
+// implements madmin.DriveSpeedtest()
+func (ac AdminClient) driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+	return ac.Client.DriveSpeedtest(ctx, opts)
+}
+
+// implements madmin.Netperf()
+func (ac AdminClient) netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+	return ac.Client.Netperf(ctx, duration)
+}
+
 // Site Replication
 func (ac AdminClient) getSiteReplicationInfo(ctx context.Context) (*madmin.SiteReplicationInfo, error) {
 	res, err := ac.Client.SiteReplicationInfo(ctx)
@@ -732,3 +833,123 @@ func (ac AdminClient) getIDPConfig(ctx context.Context, idpType, cfgName string)
 func (ac AdminClient) getLDAPPolicyEntities(ctx context.Context, query madmin.PolicyEntitiesQuery) (madmin.PolicyEntitiesResult, error) {
 	return ac.Client.GetLDAPPolicyEntities(ctx, query)
 }
+
+// implements madmin.ExportIAM()
+func (ac AdminClient) exportIAM(ctx context.Context) (io.ReadCloser, error) {
+	return ac.Client.ExportIAM(ctx)
+}
+
+// implements madmin.ImportIAM()
+func (ac AdminClient) importIAM(ctx context.Context, contentReader io.ReadCloser) error {
+	return ac.Client.ImportIAM(ctx, contentReader)
+}
+
+// implements madmin.GetConfig(), the configuration is cached until it changes
+func (ac AdminClient) getServerConfig(ctx context.Context) ([]byte, error) {
+	config, err := ac.cachedCall(ctx, adminCacheConfig, "", func() (interface{}, error) {
+		return ac.Client.GetConfig(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return config.([]byte), nil
+}
+
+// implements madmin.SetConfig()
+func (ac AdminClient) setServerConfig(ctx context.Context, config io.Reader) error {
+	defer ac.invalidateCache(adminCacheConfig, adminCacheServerInfo)
+	return ac.Client.SetConfig(ctx, config)
+}
+
+// implements madmin.StartBatchJob()
+func (ac AdminClient) startBatchJob(ctx context.Context, job string) (madmin.BatchJobResult, error) {
+	return ac.Client.StartBatchJob(ctx, job)
+}
+
+// implements madmin.ListBatchJobs()
+func (ac AdminClient) listBatchJobs(ctx context.Context, jobType string) (madmin.ListBatchJobsResult, error) {
+	return ac.Client.ListBatchJobs(ctx, &madmin.ListBatchJobsFilter{ByJobType: jobType})
+}
+
+// implements madmin.DescribeBatchJob()
+func (ac AdminClient) describeBatchJob(ctx context.Context, jobID string) (string, error) {
+	return ac.Client.DescribeBatchJob(ctx, jobID)
+}
+
+// batchJobMetrics returns the progress of a batch job reported by the MinIO metrics, nil when
+// the job isn't known to them
+func (ac AdminClient) batchJobMetrics(ctx context.Context, jobID string) (*madmin.JobMetric, error) {
+	var job *madmin.JobMetric
+	err := ac.Client.Metrics(ctx, madmin.MetricsOptions{
+		Type:    madmin.MetricsBatchJobs,
+		N:       1,
+		ByJobID: jobID,
+	}, func(metrics madmin.RealtimeMetrics) {
+		if metrics.Aggregated.BatchJobs == nil {
+			return
+		}
+		if m, ok := metrics.Aggregated.BatchJobs.Jobs[jobID]; ok {
+			job = &m
+		}
+	})
+	return job, err
+}
+
+// implements madmin.RebalanceStart()
+func (ac AdminClient) startRebalance(ctx context.Context) (string, error) {
+	return ac.Client.RebalanceStart(ctx)
+}
+
+// implements madmin.RebalanceStatus()
+func (ac AdminClient) rebalanceStatus(ctx context.Context) (madmin.RebalanceStatus, error) {
+	return ac.Client.RebalanceStatus(ctx)
+}
+
+// implements madmin.RebalanceStop()
+func (ac AdminClient) stopRebalance(ctx context.Context) error {
+	return ac.Client.RebalanceStop(ctx)
+}
+
+// implements madmin.ListPoolsStatus()
+func (ac AdminClient) listPoolsStatus(ctx context.Context) ([]madmin.PoolStatus, error) {
+	return ac.Client.ListPoolsStatus(ctx)
+}
+
+// implements madmin.DecommissionPool()
+func (ac AdminClient) decommissionPool(ctx context.Context, pool string) error {
+	return ac.Client.DecommissionPool(ctx, pool)
+}
+
+// implements madmin.CancelDecommissionPool()
+func (ac AdminClient) cancelDecommissionPool(ctx context.Context, pool string) error {
+	return ac.Client.CancelDecommissionPool(ctx, pool)
+}
+
+// implements madmin.TopLocksWithOpts()
+func (ac AdminClient) topLocks(ctx context.Context, count int, stale bool) (madmin.LockEntries, error) {
+	return ac.Client.TopLocksWithOpts(ctx, madmin.TopLockOpts{Count: count, Stale: stale})
+}
+
+// implements madmin.DataUsageInfo(), the scanner updates it every few minutes so it's cached for a short while
+func (ac AdminClient) dataUsageInfo(ctx context.Context) (madmin.DataUsageInfo, error) {
+	usage, err := ac.cachedCall(ctx, adminCacheDataUsage, "", func() (interface{}, error) {
+		return ac.Client.DataUsageInfo(ctx)
+	})
+	if err != nil {
+		return madmin.DataUsageInfo{}, err
+	}
+	return usage.(madmin.DataUsageInfo), nil
+}
+
+// scannerMetrics returns the data scanner metrics aggregated over the cluster, nil when the
+// servers don't report them
+func (ac AdminClient) scannerMetrics(ctx context.Context) (*madmin.ScannerMetrics, error) {
+	var scanner *madmin.ScannerMetrics
+	err := ac.Client.Metrics(ctx, madmin.MetricsOptions{
+		Type: madmin.MetricsScanner,
+		N:    1,
+	}, func(metrics madmin.RealtimeMetrics) {
+		scanner = metrics.Aggregated.Scanner
+	})
+	return scanner, err
+}
@@ -17,10 +17,20 @@
 package restapi
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
+	"net/url"
 	"path"
 	"strings"
 	"time"
@@ -34,12 +44,14 @@ import (
 	"github.com/minio/console/pkg/auth"
 	"github.com/minio/console/pkg/auth/ldap"
 	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/madmin-go/v2"
 	mc "github.com/minio/mc/cmd"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/signer"
 	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
@@ -58,6 +70,7 @@ type MinioClient interface {
 	setBucketPolicyWithContext(ctx context.Context, bucketName, policy string) error
 	removeBucket(ctx context.Context, bucketName string) error
 	getBucketNotification(ctx context.Context, bucketName string) (config notification.Configuration, err error)
+	setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error
 	getBucketPolicy(ctx context.Context, bucketName string) (string, error)
 	listObjects(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
 	getObjectRetention(ctx context.Context, bucketName, objectName, versionID string) (mode *minio.RetentionMode, retainUntilDate *time.Time, err error)
@@ -66,6 +79,8 @@ type MinioClient interface {
 	putObjectLegalHold(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error
 	putObjectRetention(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error
 	statObject(ctx context.Context, bucketName, prefix string, opts minio.GetObjectOptions) (objectInfo minio.ObjectInfo, err error)
+	restoreObject(ctx context.Context, bucketName, objectName, versionID string, opts minio.RestoreRequest) error
+	getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error)
 	setBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error
 	removeBucketEncryption(ctx context.Context, bucketName string) error
 	getBucketEncryption(ctx context.Context, bucketName string) (*sse.Configuration, error)
@@ -76,7 +91,15 @@ type MinioClient interface {
 	getObjectLockConfig(ctx context.Context, bucketName string) (lock string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
 	getLifecycleRules(ctx context.Context, bucketName string) (lifecycle *lifecycle.Configuration, err error)
 	setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error)
+	setBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error
+	resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error)
+	getBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error)
+	getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error)
 	copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error)
+	removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+	getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
 	GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error)
 	SetBucketTagging(ctx context.Context, bucketName string, tags *tags.Tags) error
 	RemoveBucketTagging(ctx context.Context, bucketName string) error
@@ -130,6 +153,11 @@ func (c minioClient) getBucketNotification(ctx context.Context, bucketName strin
 	return c.client.GetBucketNotification(ctx, bucketName)
 }
 
+// implements minio.SetBucketNotification(bucketName, config)
+func (c minioClient) setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error {
+	return c.client.SetBucketNotification(ctx, bucketName, config)
+}
+
 // implements minio.GetBucketPolicy(bucketName)
 func (c minioClient) getBucketPolicy(ctx context.Context, bucketName string) (string, error) {
 	return c.client.GetBucketPolicy(ctx, bucketName)
@@ -145,6 +173,26 @@ func (c minioClient) getBucketReplication(ctx context.Context, bucketName string
 	return c.client.GetBucketReplication(ctx, bucketName)
 }
 
+// implements minio.SetBucketReplication(ctx, bucketName, cfg)
+func (c minioClient) setBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
+	return c.client.SetBucketReplication(ctx, bucketName, cfg)
+}
+
+// implements minio.ResetBucketReplicationOnTarget(ctx, bucketName, olderThan, arn)
+func (c minioClient) resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error) {
+	return c.client.ResetBucketReplicationOnTarget(ctx, bucketName, olderThan, arn)
+}
+
+// implements minio.GetBucketReplicationResyncStatus(ctx, bucketName, arn)
+func (c minioClient) getBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+	return c.client.GetBucketReplicationResyncStatus(ctx, bucketName, arn)
+}
+
+// implements minio.GetBucketReplicationMetrics(ctx, bucketName)
+func (c minioClient) getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error) {
+	return c.client.GetBucketReplicationMetrics(ctx, bucketName)
+}
+
 // implements minio.listObjects(ctx)
 func (c minioClient) listObjects(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
 	return c.client.ListObjects(ctx, bucket, opts)
@@ -174,6 +222,16 @@ func (c minioClient) statObject(ctx context.Context, bucketName, prefix string,
 	return c.client.StatObject(ctx, bucketName, prefix, opts)
 }
 
+// implements minio.RestoreObject(ctx, bucketName, objectName, versionID, opts)
+func (c minioClient) restoreObject(ctx context.Context, bucketName, objectName, versionID string, opts minio.RestoreRequest) error {
+	return c.client.RestoreObject(ctx, bucketName, objectName, versionID, opts)
+}
+
+// implements minio.GetObject(ctx, bucketName, objectName, opts)
+func (c minioClient) getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	return c.client.GetObject(ctx, bucketName, objectName, opts)
+}
+
 // implements minio.SetBucketEncryption(ctx, bucketName, config)
 func (c minioClient) setBucketEncryption(ctx context.Context, bucketName string, config *sse.Configuration) error {
 	return c.client.SetBucketEncryption(ctx, bucketName, config)
@@ -221,6 +279,16 @@ func (c minioClient) copyObject(ctx context.Context, dst minio.CopyDestOptions,
 	return c.client.CopyObject(ctx, dst, src)
 }
 
+// implements minio.ComposeObject(ctx, dst, srcs...)
+func (c minioClient) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return c.client.ComposeObject(ctx, dst, srcs...)
+}
+
+// implements minio.RemoveObject(ctx, bucketName, objectName, opts)
+func (c minioClient) removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+	return c.client.RemoveObject(ctx, bucketName, objectName, opts)
+}
+
 // MCClient interface with all functions to be implemented
 // by mock when testing, it should include all mc/S3Client respective api calls
 // that are used within this project.
@@ -330,6 +398,12 @@ func (s consoleSTSAssumeRole) IsExpired() bool {
 }
 
 func stsCredentials(minioURL, accessKey, secretKey, location string) (*credentials.Credentials, error) {
+	return stsCredentialsWithPolicy(minioURL, accessKey, secretKey, location, "")
+}
+
+// stsCredentialsWithPolicy assumes the role of accessKey restricted to the session policy, none leaves the
+// policies of accessKey as they are
+func stsCredentialsWithPolicy(minioURL, accessKey, secretKey, location, policy string) (*credentials.Credentials, error) {
 	if accessKey == "" || secretKey == "" {
 		return nil, errors.New("credentials endpoint, access and secret key are mandatory for AssumeRoleSTS")
 	}
@@ -338,6 +412,7 @@ func stsCredentials(minioURL, accessKey, secretKey, location string) (*credentia
 		SecretKey:       secretKey,
 		Location:        location,
 		DurationSeconds: int(xjwt.GetConsoleSTSDuration().Seconds()),
+		Policy:          policy,
 	}
 	stsAssumeRole := &credentials.STSAssumeRole{
 		Client:      GetConsoleHTTPClient(minioURL),
@@ -387,6 +462,17 @@ func NewConsoleCredentials(accessKey, secretKey, location string) (*credentials.
 
 			return creds, nil
 		}
+	// Users of the LDAP directory of MinIO log in with their username and password
+	case ldap.GetLDAPAutoDetect():
+		{
+			stsCreds, err := stsCredentials(minioURL, accessKey, secretKey, location)
+			if err != nil {
+				return nil, err
+			}
+			return firstValidCredentials(stsCreds, func() (*credentials.Credentials, error) {
+				return auth.GetCredentialsFromLDAP(GetConsoleHTTPClient(minioURL), minioURL, accessKey, secretKey)
+			}), nil
+		}
 	// default authentication for Console is via STS (Security Token Service) against MinIO
 	default:
 		{
@@ -395,25 +481,62 @@ func NewConsoleCredentials(accessKey, secretKey, location string) (*credentials.
 	}
 }
 
+// firstValidCredentials returns the first credentials MinIO accepts, the
+// fallbacks are only built when the previous credentials are rejected. The
+// first credentials are returned when none is accepted so the login reports
+// their error rather than the one of a missing LDAP configuration.
+func firstValidCredentials(creds *credentials.Credentials, fallbacks ...func() (*credentials.Credentials, error)) *credentials.Credentials {
+	_, err := creds.Get()
+	if err == nil {
+		return creds
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		// MinIO can't be reached, the fallbacks would fail the same way
+		return creds
+	}
+	for _, fallback := range fallbacks {
+		fallbackCreds, err := fallback()
+		if err != nil {
+			LogError("error building fallback credentials: %v", err)
+			continue
+		}
+		if _, err = fallbackCreds.Get(); err == nil {
+			return fallbackCreds
+		}
+	}
+	return creds
+}
+
 // getConsoleCredentialsFromSession returns the *consoleCredentials.Login associated to the
 // provided session token, this is useful for running the Expire() or IsExpired() operations
 func getConsoleCredentialsFromSession(claims *models.Principal) *credentials.Credentials {
 	if claims == nil {
 		return credentials.NewStaticV4("", "", "")
 	}
-	return credentials.NewStaticV4(claims.STSAccessKeyID, claims.STSSecretAccessKey, claims.STSSessionToken)
+	_, creds, err := sessionCluster(clusterRegistry(), claims)
+	if err != nil {
+		LogError("unable to get the credentials of cluster %s: %v", claims.ClusterID, err)
+		return credentials.NewStaticV4("", "", "")
+	}
+	return creds
 }
 
 // newMinioClient creates a new MinIO client based on the ConsoleCredentials extracted
-// from the provided session token
+// from the provided session token, for the cluster the session works with
 func newMinioClient(claims *models.Principal) (*minio.Client, error) {
-	creds := getConsoleCredentialsFromSession(claims)
-	endpoint := getMinIOEndpoint()
-	secure := getMinIOEndpointIsSecure()
-	minioClient, err := minio.New(endpoint, &minio.Options{
+	server, creds, err := sessionCluster(clusterRegistry(), claims)
+	if err != nil {
+		return nil, err
+	}
+	u, err := xnet.ParseHTTPURL(server)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := minio.New(u.Host, &minio.Options{
 		Creds:     creds,
-		Secure:    secure,
-		Transport: GetConsoleHTTPClient(getMinIOServer()).Transport,
+		Secure:    u.Scheme == "https",
+		Transport: GetConsoleHTTPClient(server).Transport,
 	})
 	if err != nil {
 		return nil, err
@@ -423,9 +546,80 @@ func newMinioClient(claims *models.Principal) (*minio.Client, error) {
 	return minioClient, nil
 }
 
+// signedBucketRequest sends a request to a sub-resource of a bucket signed with the session credentials,
+// used for the calls this release of minio-go doesn't expose. The body of a successful response is returned.
+func signedBucketRequest(ctx context.Context, httpClient *http.Client, endpoint string, creds *credentials.Credentials, region, method, bucketName string, query url.Values, body []byte) ([]byte, error) {
+	resp, respBody, err := sendSignedRequest(ctx, httpClient, endpoint, creds, region, method, "/"+bucketName, query, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return respBody, nil
+	}
+	errResp := minio.ErrorResponse{StatusCode: resp.StatusCode}
+	if xml.Unmarshal(respBody, &errResp) != nil || errResp.Code == "" {
+		return nil, fmt.Errorf("unexpected response from MinIO: %s", resp.Status)
+	}
+	return nil, errResp
+}
+
+// signedAdminRequest sends a request to the admin API signed with the session credentials, used for the
+// calls this release of madmin doesn't expose. The body of a successful response is returned.
+func signedAdminRequest(ctx context.Context, httpClient *http.Client, endpoint string, creds *credentials.Credentials, region, method, apiPath string, query url.Values, body []byte) ([]byte, error) {
+	resp, respBody, err := sendSignedRequest(ctx, httpClient, endpoint, creds, region, method, "/minio/admin/v3"+apiPath, query, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return respBody, nil
+	}
+	var errResp madmin.ErrorResponse
+	if json.Unmarshal(respBody, &errResp) != nil || errResp.Code == "" {
+		return nil, fmt.Errorf("unexpected response from MinIO: %s", resp.Status)
+	}
+	return nil, errResp
+}
+
+// sendSignedRequest signs a request with the session credentials and returns the response with its body
+func sendSignedRequest(ctx context.Context, httpClient *http.Client, endpoint string, creds *credentials.Credentials, region, method, urlPath string, query url.Values, body []byte) (*http.Response, []byte, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	u.Path = urlPath
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	value, err := creds.Get()
+	if err != nil {
+		return nil, nil, err
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+	payloadSum := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadSum[:]))
+	if len(body) > 0 {
+		md5Sum := md5.Sum(body)
+		req.Header.Set("Content-Md5", base64.StdEncoding.EncodeToString(md5Sum[:]))
+	}
+	req = signer.SignV4(*req, value.AccessKeyID, value.SecretAccessKey, value.SessionToken, region)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, respBody, nil
+}
+
 // computeObjectURLWithoutEncode returns a MinIO url containing the object filename without encoding
-func computeObjectURLWithoutEncode(bucketName, prefix string) (string, error) {
-	endpoint := getMinIOServer()
+func computeObjectURLWithoutEncode(endpoint, bucketName, prefix string) (string, error) {
 	u, err := xnet.ParseHTTPURL(endpoint)
 	if err != nil {
 		return "", fmt.Errorf("the provided endpoint is invalid")
@@ -445,12 +639,20 @@ func newS3BucketClient(claims *models.Principal, bucketName string, prefix strin
 	if claims == nil {
 		return nil, fmt.Errorf("the provided credentials are invalid")
 	}
+	server, creds, err := sessionCluster(clusterRegistry(), claims)
+	if err != nil {
+		return nil, err
+	}
+	value, err := creds.Get()
+	if err != nil {
+		return nil, err
+	}
 	// It's very important to avoid encoding the prefix since the minio client will encode the path itself
-	objectURL, err := computeObjectURLWithoutEncode(bucketName, prefix)
+	objectURL, err := computeObjectURLWithoutEncode(server, bucketName, prefix)
 	if err != nil {
 		return nil, fmt.Errorf("the provided endpoint is invalid")
 	}
-	s3Config := newS3Config(objectURL, claims.STSAccessKeyID, claims.STSSecretAccessKey, claims.STSSessionToken)
+	s3Config := newS3Config(objectURL, value.AccessKeyID, value.SecretAccessKey, value.SessionToken)
 	client, pErr := mc.S3New(s3Config)
 	if pErr != nil {
 		return nil, pErr.Cause
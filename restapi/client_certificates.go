@@ -0,0 +1,166 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/certs"
+	"github.com/minio/console/pkg/clientcerts"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// clientCertificateSessions authenticates the requests presenting a mapped client certificate, the
+// credentials of every mapping are assumed once and renewed when they expire
+type clientCertificateSessions struct {
+	mu       sync.Mutex
+	mappings []clientcerts.Mapping
+	creds    map[*clientcerts.Mapping]*credentials.Credentials
+}
+
+// globalClientCertificates holds the mappings loaded along with the TLS configuration
+var globalClientCertificates = &clientCertificateSessions{}
+
+func (s *clientCertificateSessions) set(mappings []clientcerts.Mapping) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mappings = mappings
+	s.creds = map[*clientcerts.Mapping]*credentials.Credentials{}
+}
+
+// credentials returns the credentials of the mapping matching the certificate, nil when it isn't mapped
+func (s *clientCertificateSessions) credentials(cert *x509.Certificate) (*clientcerts.Mapping, *credentials.Credentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	mapping, _ := clientcerts.Match(s.mappings, cert)
+	if mapping == nil {
+		return nil, nil, nil
+	}
+	if creds, ok := s.creds[mapping]; ok {
+		return mapping, creds, nil
+	}
+	var policy string
+	if len(mapping.Policy) > 0 && string(mapping.Policy) != "null" {
+		policy = string(mapping.Policy)
+	}
+	creds, err := stsCredentialsWithPolicy(getMinIOServer(), mapping.AccessKey, mapping.SecretKey, GetMinIORegion(), policy)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.creds[mapping] = creds
+	return mapping, creds, nil
+}
+
+// clientCertificateCAs returns the CAs the client certificates are verified with, the ones of caFile or else
+// the ones of the CAs directory. The system roots are never trusted, any public certificate matching a mapping
+// would log in otherwise.
+func clientCertificateCAs(caFile, casDir string) (*x509.CertPool, error) {
+	files := []string{caFile}
+	if caFile == "" {
+		entries, err := os.ReadDir(casDir)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		files = nil
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				files = append(files, filepath.Join(casDir, entry.Name()))
+			}
+		}
+	}
+	pool := x509.NewCertPool()
+	found := false
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if pool.AppendCertsFromPEM(data) {
+			found = true
+		}
+	}
+	if !found {
+		if caFile != "" {
+			return nil, fmt.Errorf("no CA certificate found in %s", caFile)
+		}
+		return nil, fmt.Errorf("no CA certificate found in %s, set %s to verify the client certificates", casDir, ConsoleMTLSCAFile)
+	}
+	return pool, nil
+}
+
+// configureClientCertificates asks the clients of the TLS listener for a certificate as configured and loads
+// the mappings of the certificates
+func configureClientCertificates(tlsConfig *tls.Config) error {
+	mode := getConsoleMTLSMode()
+	clientAuth, err := clientcerts.ClientAuth(mode)
+	if err != nil || clientAuth == tls.NoClientCert {
+		return err
+	}
+	clientCAs, err := clientCertificateCAs(getConsoleMTLSCAFile(), certs.GlobalCertsCADir.Get())
+	if err != nil {
+		return err
+	}
+	accessKey, secretKey := getConsoleMTLSCredentials()
+	mappings, err := clientcerts.LoadMappings(getConsoleMTLSMappingsFile(), accessKey, secretKey)
+	if err != nil {
+		return err
+	}
+	globalClientCertificates.set(mappings)
+	tlsConfig.ClientAuth = clientAuth
+	tlsConfig.ClientCAs = clientCAs
+	return nil
+}
+
+// authenticateClientCertificate returns the session claims of the client certificate the request was verified
+// with, nil when there is none or it isn't mapped so the client logs in as usual
+func authenticateClientCertificate(sessions *clientCertificateSessions, r *http.Request, now time.Time) (*auth.TokenClaims, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	mapping, creds, err := sessions.credentials(r.TLS.VerifiedChains[0][0])
+	if err != nil || mapping == nil {
+		return nil, err
+	}
+	value, err := creds.Get()
+	if err != nil {
+		return nil, fmt.Errorf("unable to authenticate the client certificate: %w", err)
+	}
+	return &auth.TokenClaims{
+		STSAccessKeyID:     value.AccessKeyID,
+		STSSecretAccessKey: value.SecretAccessKey,
+		STSSessionToken:    value.SessionToken,
+		AccountAccessKey:   mapping.AccessKey,
+		// the certificate is verified on every connection, the session limits see a request as a fresh login
+		IssuedAt:     now.Unix(),
+		LastActivity: now.Unix(),
+	}, nil
+}
+
+// requiresClientCertificate tells whether the request is refused for coming over plain HTTP while the clients
+// must present a certificate
+func requiresClientCertificate(r *http.Request) bool {
+	return r.TLS == nil && getConsoleMTLSMode() == clientcerts.ModeRequire
+}
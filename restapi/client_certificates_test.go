@@ -0,0 +1,142 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/clientcerts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthenticateClientCertificate(t *testing.T) {
+	assert := assert.New(t)
+	var assumed []string
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		assumed = append(assumed, r.Form.Get("Policy"))
+		fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials>
+<AccessKeyId>STS%d</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken>
+<Expiration>%s</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`, len(assumed), time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer sts.Close()
+	t.Setenv(ConsoleMinIOServer, sts.URL)
+
+	sessions := &clientCertificateSessions{}
+	sessions.set([]clientcerts.Mapping{
+		{Subject: "backup.example.com", AccessKey: "backup", SecretKey: "backup-secret"},
+		{Subject: "*.monitoring.example.com", AccessKey: "console", SecretKey: "console-secret", Policy: json.RawMessage(`{"Version":"2012-10-17"}`)},
+	})
+	request := func(cert *x509.Certificate) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+		if cert != nil {
+			r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		}
+		return r
+	}
+	now := time.Now()
+
+	claims, err := authenticateClientCertificate(sessions, request(&x509.Certificate{Subject: pkix.Name{CommonName: "backup.example.com"}}), now)
+	assert.NoError(err)
+	assert.Equal("STS1", claims.STSAccessKeyID)
+	assert.Equal("backup", claims.AccountAccessKey)
+	assert.Equal(now.Unix(), claims.IssuedAt)
+
+	// the credentials are assumed once per mapping
+	claims, err = authenticateClientCertificate(sessions, request(&x509.Certificate{Subject: pkix.Name{CommonName: "backup.example.com"}}), now)
+	assert.NoError(err)
+	assert.Equal("STS1", claims.STSAccessKeyID)
+
+	claims, err = authenticateClientCertificate(sessions, request(&x509.Certificate{DNSNames: []string{"node1.monitoring.example.com"}}), now)
+	assert.NoError(err)
+	assert.Equal("STS2", claims.STSAccessKeyID)
+	assert.Equal("console", claims.AccountAccessKey)
+	assert.Equal([]string{"", `{"Version":"2012-10-17"}`}, assumed)
+
+	// unmapped certificates and requests without one log in as usual
+	claims, err = authenticateClientCertificate(sessions, request(&x509.Certificate{Subject: pkix.Name{CommonName: "laptop"}}), now)
+	assert.NoError(err)
+	assert.Nil(claims)
+	claims, err = authenticateClientCertificate(sessions, request(nil), now)
+	assert.NoError(err)
+	assert.Nil(claims)
+
+	t.Setenv(ConsoleMTLS, clientcerts.ModeRequire)
+	assert.True(requiresClientCertificate(request(nil)))
+	assert.False(requiresClientCertificate(request(&x509.Certificate{})))
+	t.Setenv(ConsoleMTLS, clientcerts.ModeOptional)
+	assert.False(requiresClientCertificate(request(nil)))
+}
+
+func TestClientCertificateCAs(t *testing.T) {
+	assert := assert.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Machines CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(err)
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	dir := t.TempDir()
+	casDir := filepath.Join(dir, "CAs")
+	caFile := filepath.Join(dir, "machines.crt")
+	assert.NoError(os.WriteFile(caFile, caPEM, 0o600))
+
+	// Test-1 : without CAs configured the client certificates can't be verified, the system roots aren't used
+	_, err = clientCertificateCAs("", casDir)
+	assert.Error(err)
+	assert.NoError(os.Mkdir(casDir, 0o700))
+	_, err = clientCertificateCAs("", casDir)
+	assert.Error(err)
+
+	// Test-2 : the CAs directory only holds the CAs trusted
+	assert.NoError(os.WriteFile(filepath.Join(casDir, "machines.crt"), caPEM, 0o600))
+	pool, err := clientCertificateCAs("", casDir)
+	assert.NoError(err)
+	assert.True(pool.Equal(func() *x509.CertPool {
+		p := x509.NewCertPool()
+		p.AppendCertsFromPEM(caPEM)
+		return p
+	}()))
+
+	// Test-3 : the CA file takes precedence and must hold a certificate
+	_, err = clientCertificateCAs(caFile, t.TempDir())
+	assert.NoError(err)
+	_, err = clientCertificateCAs(filepath.Join(dir, "missing.crt"), casDir)
+	assert.Error(err)
+}
@@ -16,7 +16,14 @@
 
 package restapi
 
-import "testing"
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
 
 func Test_computeObjectURLWithoutEncode(t *testing.T) {
 	type args struct {
@@ -77,7 +84,7 @@ func Test_computeObjectURLWithoutEncode(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := computeObjectURLWithoutEncode(tt.args.bucketName, tt.args.prefix)
+			got, err := computeObjectURLWithoutEncode("http://localhost:9000", tt.args.bucketName, tt.args.prefix)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("computeObjectURLWithoutEncode() errors = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -88,3 +95,58 @@ func Test_computeObjectURLWithoutEncode(t *testing.T) {
 		})
 	}
 }
+
+type credentialsProviderMock struct {
+	value credentials.Value
+	err   error
+	calls int
+}
+
+func (p *credentialsProviderMock) Retrieve() (credentials.Value, error) {
+	p.calls++
+	return p.value, p.err
+}
+
+func (p *credentialsProviderMock) IsExpired() bool {
+	return true
+}
+
+func Test_firstValidCredentials(t *testing.T) {
+	assert := assert.New(t)
+	rejected := errors.New("The Access Key Id you provided does not exist in our records.")
+	fallbackOf := func(p *credentialsProviderMock) func() (*credentials.Credentials, error) {
+		return func() (*credentials.Credentials, error) {
+			return credentials.New(p), nil
+		}
+	}
+
+	// credentials of a MinIO user don't reach the LDAP directory
+	builtin := &credentialsProviderMock{value: credentials.Value{AccessKeyID: "builtin"}}
+	ldapUser := &credentialsProviderMock{value: credentials.Value{AccessKeyID: "ldap"}}
+	creds := firstValidCredentials(credentials.New(builtin), fallbackOf(ldapUser))
+	value, err := creds.Get()
+	assert.NoError(err)
+	assert.Equal("builtin", value.AccessKeyID)
+	assert.Equal(0, ldapUser.calls)
+
+	// credentials of an LDAP user are rejected by MinIO and accepted by the directory
+	builtin = &credentialsProviderMock{err: rejected}
+	creds = firstValidCredentials(credentials.New(builtin), fallbackOf(ldapUser))
+	value, err = creds.Get()
+	assert.NoError(err)
+	assert.Equal("ldap", value.AccessKeyID)
+
+	// the error of the MinIO credentials is kept when nothing accepts them
+	ldapUser = &credentialsProviderMock{err: errors.New("LDAP not configured")}
+	creds = firstValidCredentials(credentials.New(builtin), fallbackOf(ldapUser),
+		func() (*credentials.Credentials, error) { return nil, errors.New("invalid endpoint") })
+	_, err = creds.Get()
+	assert.ErrorIs(err, rejected)
+	assert.Equal(1, ldapUser.calls)
+
+	// MinIO being unreachable doesn't try the directory
+	ldapUser = &credentialsProviderMock{value: credentials.Value{AccessKeyID: "ldap"}}
+	builtin = &credentialsProviderMock{err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	firstValidCredentials(credentials.New(builtin), fallbackOf(ldapUser))
+	assert.Equal(0, ldapUser.calls)
+}
@@ -0,0 +1,292 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"io"
+	"io/fs"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/gzhttp"
+	"github.com/klauspost/compress/gzip"
+	"github.com/minio/pkg/mimedb"
+)
+
+// compressionMinSize is the size under which the responses aren't worth compressing, the same as gzip
+const compressionMinSize = gzhttp.DefaultMinSize
+
+// brotliLevel compresses the API responses about as fast as gzip does, with a better ratio
+const brotliLevel = 4
+
+// brotliAssetsLevel compresses the static assets of the UI once, below brotli.BestCompression which takes seconds on
+// the largest bundles
+const brotliAssetsLevel = 9
+
+// staticAssetsPrefix holds the hashed bundles of the UI, which never change while Console runs
+const staticAssetsPrefix = "/static/"
+
+// compressedAsset is a static asset of the UI compressed once for one encoding
+type compressedAsset struct {
+	once sync.Once
+	body []byte
+	err  error
+}
+
+// globalCompressedAssets keeps the compressed static assets by encoding and path
+var globalCompressedAssets sync.Map
+
+// preferredEncoding returns the encoding of the response among the ones the client accepts, br over gzip when it
+// accepts both as much, empty when it accepts neither
+func preferredEncoding(acceptEncoding string) string {
+	var (
+		best  string
+		bestQ float64
+	)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		coding, params, _ := strings.Cut(part, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding != "br" && coding != "gzip" {
+			continue
+		}
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			q, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = q
+		}
+		if quality > 0 && (quality > bestQ || quality == bestQ && coding == "br") {
+			best, bestQ = coding, quality
+		}
+	}
+	return best
+}
+
+// isCompressible tells whether a response of the content type gets smaller when compressed, the archives, images
+// other than SVG, web fonts, audio and video already are compressed
+func isCompressible(contentType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(contentType))
+	if strings.HasPrefix(mediaType, "image/") && !strings.HasPrefix(mediaType, "image/svg") ||
+		strings.HasPrefix(mediaType, "font/woff") {
+		return false
+	}
+	return gzhttp.DefaultContentTypeFilter(contentType)
+}
+
+// CompressionMiddleware compresses the responses with brotli or gzip, whichever the client prefers. Websockets, TUS
+// uploads and range requests are served as they are, and so are the responses that are small, already encoded or
+// of a compressed content type, such as most object downloads.
+func CompressionMiddleware(next http.Handler) http.Handler {
+	gzipWrapper, err := gzhttp.NewWrapper(gzhttp.MinSize(compressionMinSize), gzhttp.ContentTypeFilter(isCompressible))
+	if err != nil {
+		panic(err)
+	}
+	gzipped := gzipWrapper(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/ws") || isTUSRequest(r) || r.Header.Get("Range") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		switch preferredEncoding(r.Header.Get("Accept-Encoding")) {
+		case "br":
+			w.Header().Add("Vary", "Accept-Encoding")
+			bw := &brotliResponseWriter{ResponseWriter: w}
+			next.ServeHTTP(bw, r)
+			if err := bw.Close(); err != nil {
+				LogErrorContext(r.Context(), "unable to compress the response: %v", err)
+			}
+		case "gzip":
+			gzipped.ServeHTTP(w, r)
+		default:
+			w.Header().Add("Vary", "Accept-Encoding")
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// brotliResponseWriter holds back the first compressionMinSize bytes of the response to decide whether it's worth
+// compressing
+type brotliResponseWriter struct {
+	http.ResponseWriter
+	code  int
+	buf   []byte
+	bw    *brotli.Writer
+	plain bool
+}
+
+// WriteHeader implements http.ResponseWriter
+func (w *brotliResponseWriter) WriteHeader(code int) {
+	if w.bw != nil || w.plain {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if w.code == 0 {
+		w.code = code
+	}
+}
+
+// Write implements http.ResponseWriter
+func (w *brotliResponseWriter) Write(b []byte) (int, error) {
+	switch {
+	case w.bw != nil:
+		return w.bw.Write(b)
+	case w.plain:
+		return w.ResponseWriter.Write(b)
+	}
+	w.buf = append(w.buf, b...)
+	if len(w.buf) >= compressionMinSize {
+		if err := w.start(); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+// start sends the headers and the bytes held back, compressed when the response is worth it
+func (w *brotliResponseWriter) start() error {
+	header := w.Header()
+	contentType := header.Get("Content-Type")
+	if contentType == "" && len(w.buf) > 0 {
+		contentType = http.DetectContentType(w.buf)
+		header.Set("Content-Type", contentType)
+	}
+	compress := len(w.buf) >= compressionMinSize && header.Get("Content-Encoding") == "" &&
+		header.Get("Content-Range") == "" && isCompressible(contentType)
+	if compress {
+		header.Set("Content-Encoding", "br")
+		header.Del("Content-Length")
+		header.Del("Accept-Ranges")
+	}
+	if w.code != 0 {
+		w.ResponseWriter.WriteHeader(w.code)
+	}
+	buf := w.buf
+	w.buf = nil
+	if !compress {
+		w.plain = true
+		if len(buf) == 0 {
+			return nil
+		}
+		_, err := w.ResponseWriter.Write(buf)
+		return err
+	}
+	w.bw = brotli.NewWriterLevel(w.ResponseWriter, brotliLevel)
+	_, err := w.bw.Write(buf)
+	return err
+}
+
+// Flush implements http.Flusher, a response flushed before compressionMinSize bytes were written isn't compressed
+func (w *brotliResponseWriter) Flush() {
+	if w.bw != nil {
+		w.bw.Flush()
+	} else if !w.plain {
+		w.start()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Close ends the compressed stream, or sends the response as it is when it was too small to be compressed
+func (w *brotliResponseWriter) Close() error {
+	if w.bw != nil {
+		return w.bw.Close()
+	}
+	if !w.plain {
+		return w.start()
+	}
+	return nil
+}
+
+// Unwrap returns the underlying writer for http.ResponseController
+func (w *brotliResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// serveCompressedAsset serves a static asset of the UI compressed once at the best ratio rather than on every
+// request, it returns false for the requests it doesn't serve
+func serveCompressedAsset(w http.ResponseWriter, r *http.Request, assets fs.FS) bool {
+	if !strings.HasPrefix(r.URL.Path, staticAssetsPrefix) || r.Method != http.MethodGet && r.Method != http.MethodHead ||
+		r.Header.Get("Range") != "" {
+		return false
+	}
+	encoding := preferredEncoding(r.Header.Get("Accept-Encoding"))
+	contentType := mimedb.TypeByExtension(filepath.Ext(r.URL.Path))
+	if encoding == "" || !isCompressible(contentType) {
+		return false
+	}
+	name := strings.TrimPrefix(path.Clean(r.URL.Path), "/")
+	if !strings.HasPrefix("/"+name, staticAssetsPrefix) {
+		return false
+	}
+	info, err := fs.Stat(assets, name)
+	if err != nil || info.IsDir() || info.Size() < compressionMinSize {
+		return false
+	}
+	value, loaded := globalCompressedAssets.LoadOrStore(encoding+":"+name, &compressedAsset{})
+	recordCacheLookup("assets", loaded)
+	asset := value.(*compressedAsset)
+	asset.once.Do(func() {
+		asset.body, asset.err = compressAsset(assets, name, encoding)
+	})
+	if asset.err != nil {
+		LogErrorContext(r.Context(), "unable to compress %s: %v", name, asset.err)
+		return false
+	}
+	header := w.Header()
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Encoding", encoding)
+	header.Set("Content-Length", strconv.Itoa(len(asset.body)))
+	if r.Method == http.MethodHead {
+		w.WriteHeader(http.StatusOK)
+		return true
+	}
+	w.Write(asset.body)
+	return true
+}
+
+// compressAsset returns the static asset compressed with the encoding
+func compressAsset(assets fs.FS, name, encoding string) ([]byte, error) {
+	content, err := fs.ReadFile(assets, name)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		buf bytes.Buffer
+		cw  io.WriteCloser
+	)
+	if encoding == "br" {
+		cw = brotli.NewWriterLevel(&buf, brotliAssetsLevel)
+	} else if cw, err = gzip.NewWriterLevel(&buf, gzip.BestCompression); err != nil {
+		return nil, err
+	}
+	if _, err = cw.Write(content); err != nil {
+		return nil, err
+	}
+	if err = cw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
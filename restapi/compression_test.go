@@ -0,0 +1,185 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferredEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{acceptEncoding: "", want: ""},
+		{acceptEncoding: "identity", want: ""},
+		{acceptEncoding: "gzip, deflate", want: "gzip"},
+		{acceptEncoding: "gzip, deflate, br", want: "br"},
+		{acceptEncoding: "br;q=0.5, gzip;q=0.8", want: "gzip"},
+		{acceptEncoding: "BR, gzip;q=1.0", want: "br"},
+		{acceptEncoding: "br;q=0, gzip", want: "gzip"},
+		{acceptEncoding: "br;q=invalid, gzip;q=0.1", want: "gzip"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			assert.Equal(t, tt.want, preferredEncoding(tt.acceptEncoding))
+		})
+	}
+}
+
+func decompress(t *testing.T, encoding string, body []byte) string {
+	var (
+		r   io.Reader
+		err error
+	)
+	switch encoding {
+	case "br":
+		r = brotli.NewReader(bytes.NewReader(body))
+	case "gzip":
+		if r, err = gzip.NewReader(bytes.NewReader(body)); err != nil {
+			t.Fatal(err)
+		}
+	default:
+		return string(body)
+	}
+	plain, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(plain)
+}
+
+func TestCompressionMiddleware(t *testing.T) {
+	largeJSON := `{"objects":[` + strings.Repeat(`{"name":"photos/2023/01/IMG_0001.jpg","size":1048576},`, 100) + `{}]}`
+	var gzipped bytes.Buffer
+	gw := gzip.NewWriter(&gzipped)
+	gw.Write([]byte(largeJSON))
+	gw.Close()
+	handler := CompressionMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/buckets/photos/objects":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(largeJSON))
+		case "/api/v1/session":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"ok"}`))
+		case "/api/v1/buckets/photos/objects/download":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte(largeJSON))
+		case "/api/v1/buckets/logs/objects/download":
+			w.Header().Set("Content-Type", "text/plain")
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write(gzipped.Bytes())
+		case "/api/v1/buckets":
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		rangeHeader    string
+		wantEncoding   string
+		wantStatus     int
+		wantBody       string
+	}{
+		{name: "brotli", path: "/api/v1/buckets/photos/objects", acceptEncoding: "gzip, deflate, br", wantEncoding: "br", wantBody: largeJSON},
+		{name: "gzip", path: "/api/v1/buckets/photos/objects", acceptEncoding: "gzip", wantEncoding: "gzip", wantBody: largeJSON},
+		{name: "not accepted", path: "/api/v1/buckets/photos/objects", wantBody: largeJSON},
+		{name: "small", path: "/api/v1/session", acceptEncoding: "br", wantBody: `{"status":"ok"}`},
+		{name: "compressed content type", path: "/api/v1/buckets/photos/objects/download", acceptEncoding: "br", wantBody: largeJSON},
+		{name: "compressed content type with gzip", path: "/api/v1/buckets/photos/objects/download", acceptEncoding: "gzip", wantBody: largeJSON},
+		{name: "already encoded", path: "/api/v1/buckets/logs/objects/download", acceptEncoding: "br", wantEncoding: "gzip", wantBody: largeJSON},
+		{name: "range", path: "/api/v1/buckets/photos/objects", acceptEncoding: "br", rangeHeader: "bytes=0-99", wantBody: largeJSON},
+		{name: "no body", path: "/api/v1/buckets", acceptEncoding: "br", wantStatus: http.StatusCreated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			if tt.rangeHeader != "" {
+				r.Header.Set("Range", tt.rangeHeader)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			wantStatus := tt.wantStatus
+			if wantStatus == 0 {
+				wantStatus = http.StatusOK
+			}
+			assert.Equal(t, wantStatus, w.Code)
+			assert.Equal(t, tt.wantEncoding, w.Header().Get("Content-Encoding"))
+			assert.Equal(t, tt.wantBody, decompress(t, tt.wantEncoding, w.Body.Bytes()))
+			if tt.rangeHeader == "" {
+				assert.Contains(t, w.Header().Values("Vary"), "Accept-Encoding")
+			}
+		})
+	}
+}
+
+func TestServeCompressedAsset(t *testing.T) {
+	mainJS := strings.Repeat("function render(){return document.getElementById('root')}\n", 100)
+	assets := fstest.MapFS{
+		"static/js/main.4f2a1c.js":   {Data: []byte(mainJS)},
+		"static/js/small.9b1e.js":    {Data: []byte("render()")},
+		"static/media/logo.8c3d.png": {Data: bytes.Repeat([]byte{0x89}, 4096)},
+		"manifest.json":              {Data: []byte(mainJS)},
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		acceptEncoding string
+		wantServed     bool
+	}{
+		{name: "brotli", path: "/static/js/main.4f2a1c.js", acceptEncoding: "gzip, br", wantServed: true},
+		{name: "gzip", path: "/static/js/main.4f2a1c.js", acceptEncoding: "gzip", wantServed: true},
+		{name: "brotli again", path: "/static/js/main.4f2a1c.js", acceptEncoding: "br", wantServed: true},
+		{name: "not accepted", path: "/static/js/main.4f2a1c.js"},
+		{name: "small", path: "/static/js/small.9b1e.js", acceptEncoding: "br"},
+		{name: "image", path: "/static/media/logo.8c3d.png", acceptEncoding: "br"},
+		{name: "outside static", path: "/manifest.json", acceptEncoding: "br"},
+		{name: "escaping static", path: "/static/../manifest.json", acceptEncoding: "br"},
+		{name: "missing", path: "/static/js/missing.js", acceptEncoding: "br"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.URL.Path = tt.path
+			r.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			w := httptest.NewRecorder()
+			assert.Equal(t, tt.wantServed, serveCompressedAsset(w, r, assets))
+			if !tt.wantServed {
+				return
+			}
+			encoding := w.Header().Get("Content-Encoding")
+			assert.Equal(t, preferredEncoding(tt.acceptEncoding), encoding)
+			assert.Contains(t, w.Header().Get("Content-Type"), "javascript")
+			assert.Equal(t, mainJS, decompress(t, encoding, w.Body.Bytes()))
+		})
+	}
+}
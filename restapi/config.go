@@ -19,10 +19,20 @@ package restapi
 import (
 	"crypto/x509"
 	"net"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/minio/console/pkg/auth"
 	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/autotls"
+	"github.com/minio/console/pkg/clientcerts"
+	"github.com/minio/console/pkg/loginattempts"
+	"github.com/minio/console/pkg/loginthrottle"
+	"github.com/minio/console/pkg/passwordpolicy"
+	"github.com/minio/console/pkg/ratelimit"
+	"github.com/minio/console/pkg/replay"
 	xcerts "github.com/minio/pkg/certs"
 	"github.com/minio/pkg/env"
 	xnet "github.com/minio/pkg/net"
@@ -144,6 +154,11 @@ func GetSecureFrameDeny() bool {
 	return strings.ToLower(env.Get(ConsoleSecureFrameDeny, "on")) == "on"
 }
 
+// FrameOptions sets the X-Frame-Options header to a custom value such as `SAMEORIGIN`, overriding FrameDeny. Default is "".
+func GetSecureFrameOptions() string {
+	return env.Get(ConsoleSecureFrameOptions, "")
+}
+
 // If ContentTypeNosniff is true, adds the X-Content-Type-Options header with the value `nosniff`. Default is true.
 func GetSecureContentTypeNonSniff() bool {
 	return strings.ToLower(env.Get(ConsoleSecureContentTypeNoSniff, "on")) == "on"
@@ -166,6 +181,17 @@ func GetSecureContentSecurityPolicyReportOnly() string {
 	return env.Get(ConsoleSecureContentSecurityPolicyReportOnly, "")
 }
 
+// ContentSecurityPolicyMode is `enforce` or `report-only`, the latter sends the ContentSecurityPolicy as
+// Content-Security-Policy-Report-Only to try it out without breaking anything. Default is `enforce`.
+func GetSecureContentSecurityPolicyMode() string {
+	return strings.ToLower(env.Get(ConsoleSecureContentSecurityPolicyMode, cspModeEnforce))
+}
+
+// If ContentSecurityPolicyReports is true, the browsers report the violations of the policies to Console. Default is false.
+func GetSecureContentSecurityPolicyReports() bool {
+	return strings.ToLower(env.Get(ConsoleSecureContentSecurityPolicyReports, "off")) == "on"
+}
+
 // HostsProxyHeaders is a set of header keys that may hold a proxied hostname value for the request.
 func GetSecureHostsProxyHeaders() []string {
 	allowedHosts := env.Get(ConsoleSecureHostsProxyHeaders, "")
@@ -255,6 +281,20 @@ func getPrometheusExtraLabels() string {
 	return env.Get(PrometheusExtraLabels, "")
 }
 
+// getPrometheusAuthToken returns the bearer token Console sends to Prometheus, it takes precedence
+// over the basic auth credentials
+func getPrometheusAuthToken() string {
+	return env.Get(PrometheusAuthToken, "")
+}
+
+func getPrometheusUsername() string {
+	return env.Get(PrometheusUsername, "")
+}
+
+func getPrometheusPassword() string {
+	return env.Get(PrometheusPassword, "")
+}
+
 func getMaxConcurrentUploadsLimit() int64 {
 	cu, err := strconv.ParseInt(env.Get(ConsoleMaxConcurrentUploads, "10"), 10, 64)
 	if err != nil {
@@ -280,3 +320,492 @@ func getConsoleDevMode() bool {
 func getConsoleAnimatedLogin() bool {
 	return strings.ToLower(env.Get(ConsoleAnimatedLogin, "on")) == "on"
 }
+
+// getConsoleAnonymousBrowsing returns whether unauthenticated, read-only browsing of
+// buckets that allow anonymous access is enabled
+func getConsoleAnonymousBrowsing() bool {
+	return strings.ToLower(env.Get(ConsoleAnonymousBrowsing, "off")) == "on"
+}
+
+// getConsoleReplayMode returns the mode of the REST record/replay harness used
+// for integration testing: off, record or replay
+func getConsoleReplayMode() replay.Mode {
+	return replay.Mode(strings.ToLower(env.Get(ConsoleReplayMode, string(replay.ModeOff))))
+}
+
+// getConsoleReplayFile returns the golden file the recorded interactions are stored in
+func getConsoleReplayFile() string {
+	return env.Get(ConsoleReplayFile, "console-replay.json")
+}
+
+// getConsoleQuotaWebhookEndpoint returns the endpoint notified when a bucket crosses its soft quota
+func getConsoleQuotaWebhookEndpoint() string {
+	return env.Get(ConsoleQuotaWebhookEndpoint, "")
+}
+
+// getConsoleQuotaWebhookAuthToken returns the token sent as Bearer authorization to the quota webhook
+func getConsoleQuotaWebhookAuthToken() string {
+	return env.Get(ConsoleQuotaWebhookAuthToken, "")
+}
+
+// getConsoleCredentialsWebhookEndpoint returns the endpoint generated user credentials are delivered to,
+// empty returns them in the API response instead
+func getConsoleCredentialsWebhookEndpoint() string {
+	return env.Get(ConsoleCredentialsWebhookEndpoint, "")
+}
+
+// getConsoleCredentialsWebhookAuthToken returns the token sent as Bearer authorization to the credentials webhook
+func getConsoleCredentialsWebhookAuthToken() string {
+	return env.Get(ConsoleCredentialsWebhookAuthToken, "")
+}
+
+// getConsoleAuthzWebhookEndpoint returns the endpoint asked to authorize Console operations, empty disables it
+func getConsoleAuthzWebhookEndpoint() string {
+	return env.Get(ConsoleAuthzWebhookEndpoint, "")
+}
+
+// getConsoleAuthzWebhookAuthToken returns the token sent as Bearer authorization to the authorization webhook
+func getConsoleAuthzWebhookAuthToken() string {
+	return env.Get(ConsoleAuthzWebhookAuthToken, "")
+}
+
+// getConsoleAuthzWebhookOperations returns the operation IDs that need to be authorized by the webhook,
+// when none are configured every operation that changes state is authorized
+func getConsoleAuthzWebhookOperations() []string {
+	return splitEnvList(env.Get(ConsoleAuthzWebhookOperations, ""))
+}
+
+// getConsoleAuthzWebhookFailOpen returns whether operations are allowed when the webhook can't be reached
+func getConsoleAuthzWebhookFailOpen() bool {
+	return strings.ToLower(env.Get(ConsoleAuthzWebhookFailOpen, "off")) == "on"
+}
+
+// getConsoleTrustedProxies returns the CIDRs of the proxies allowed to report the client address
+func getConsoleTrustedProxies() []string {
+	return splitEnvList(env.Get(ConsoleTrustedProxies, ""))
+}
+
+// getConsoleRealIPHeaders returns the headers used to find the client address, in order of precedence
+func getConsoleRealIPHeaders() []string {
+	return splitEnvList(env.Get(ConsoleRealIPHeaders, ""))
+}
+
+// getConsolePreflightStrict returns whether Console refuses to start when a critical integration fails its preflight check
+func getConsolePreflightStrict() bool {
+	return strings.ToLower(env.Get(ConsolePreflightStrict, "off")) == "on"
+}
+
+// getConsoleUsageHistoryFile returns the file the bucket usage history is kept in, empty keeps it in memory
+func getConsoleUsageHistoryFile() string {
+	return env.Get(ConsoleUsageHistoryFile, "")
+}
+
+// getConsoleUsageHistoryInterval returns the minimum time between two usage samples of a bucket
+func getConsoleUsageHistoryInterval() time.Duration {
+	return getEnvDuration(ConsoleUsageHistoryInterval, time.Hour)
+}
+
+// getConsoleUsageHistoryRetention returns for how long the bucket usage samples are kept
+func getConsoleUsageHistoryRetention() time.Duration {
+	return getEnvDuration(ConsoleUsageHistoryRetention, 30*24*time.Hour)
+}
+
+// getConsolePasswordPolicy returns the rules the secret keys chosen by builtin users must follow
+func getConsolePasswordPolicy() passwordpolicy.Policy {
+	return passwordpolicy.Policy{
+		MinLength:     getEnvInt(ConsolePasswordMinLength, 8),
+		RequireUpper:  strings.ToLower(env.Get(ConsolePasswordRequireUppercase, "off")) == "on",
+		RequireLower:  strings.ToLower(env.Get(ConsolePasswordRequireLowercase, "off")) == "on",
+		RequireDigit:  strings.ToLower(env.Get(ConsolePasswordRequireDigit, "off")) == "on",
+		RequireSymbol: strings.ToLower(env.Get(ConsolePasswordRequireSymbol, "off")) == "on",
+		History:       getEnvInt(ConsolePasswordHistory, 0),
+	}
+}
+
+// getConsolePasswordStateFile returns the file the password state of the users is kept in, empty keeps it in memory
+func getConsolePasswordStateFile() string {
+	return env.Get(ConsolePasswordStateFile, "")
+}
+
+// getConsoleLoginLockoutPolicy returns when failed logins lock out an access key or a source IP
+func getConsoleLoginLockoutPolicy() loginattempts.Policy {
+	return loginattempts.Policy{
+		AccessKeyThreshold: getEnvInt(ConsoleLoginLockoutThreshold, 0),
+		SourceIPThreshold:  getEnvInt(ConsoleLoginLockoutIPThreshold, 0),
+		Window:             getEnvDuration(ConsoleLoginLockoutWindow, 15*time.Minute),
+		Duration:           getEnvDuration(ConsoleLoginLockoutDuration, 15*time.Minute),
+	}
+}
+
+// getConsoleLoginAttemptsFile returns the file the failed logins and lockouts are kept in, empty keeps them in memory
+func getConsoleLoginAttemptsFile() string {
+	return env.Get(ConsoleLoginAttemptsFile, "")
+}
+
+// getConsoleTwoFactorFile returns the file the two-factor authentication enrollments are kept in, empty keeps them
+// in memory
+func getConsoleTwoFactorFile() string {
+	return env.Get(ConsoleTwoFactorFile, "")
+}
+
+// getConsoleTwoFactorIssuer returns the name authenticator apps show for the Console accounts
+func getConsoleTwoFactorIssuer() string {
+	return env.Get(ConsoleTwoFactorIssuer, "MinIO Console")
+}
+
+// getConsoleSessionLimits returns how long sessions are accepted without activity and after the login, both off by
+// default so the sessions last as long as their credentials
+func getConsoleSessionLimits() auth.SessionLimits {
+	return auth.SessionLimits{
+		IdleTimeout: getEnvDuration(ConsoleSessionIdleTimeout, 0),
+		MaxLifetime: getEnvDuration(ConsoleSessionMaxLifetime, 0),
+	}
+}
+
+// getConsoleAPITokensFile returns the file the API tokens are kept in, empty keeps them in memory
+func getConsoleAPITokensFile() string {
+	return env.Get(ConsoleAPITokensFile, "")
+}
+
+// getConsoleAPITokenMaxExpiry returns the longest an API token can be valid, 90 days by default
+func getConsoleAPITokenMaxExpiry() time.Duration {
+	return getEnvDuration(ConsoleAPITokenMaxExpiry, 90*24*time.Hour)
+}
+
+// getConsoleRolesFile returns the file assigning console roles to users, empty derives the console grants
+// from the MinIO policy of every session
+func getConsoleRolesFile() string {
+	return env.Get(ConsoleRolesFile, "")
+}
+
+// getConsoleLoginThrottlePolicy returns how the logins are rate limited and slowed down after failures, only the
+// window and the longest wait have defaults
+func getConsoleLoginThrottlePolicy() loginthrottle.Policy {
+	return loginthrottle.Policy{
+		IPRate:           getEnvInt(ConsoleLoginRateLimitIP, 0),
+		AccessKeyRate:    getEnvInt(ConsoleLoginRateLimitAccessKey, 0),
+		Window:           getEnvDuration(ConsoleLoginRateLimitWindow, time.Minute),
+		BackoffBase:      getEnvDuration(ConsoleLoginBackoffBase, 0),
+		BackoffMax:       getEnvDuration(ConsoleLoginBackoffMax, 5*time.Minute),
+		CaptchaThreshold: getEnvInt(ConsoleLoginCaptchaThreshold, 0),
+	}
+}
+
+// getConsoleLoginCaptchaVerifyURL returns the endpoint verifying the CAPTCHA responses, such as the siteverify
+// API of reCAPTCHA, hCaptcha or Turnstile
+func getConsoleLoginCaptchaVerifyURL() string {
+	return env.Get(ConsoleLoginCaptchaVerifyURL, "")
+}
+
+// getConsoleLoginCaptchaSecret returns the secret sent along the CAPTCHA responses to verify them
+func getConsoleLoginCaptchaSecret() string {
+	return env.Get(ConsoleLoginCaptchaSecret, "")
+}
+
+// getConsoleActionAuditHistory returns how many console audit events are kept to be queried
+func getConsoleActionAuditHistory() int {
+	return getEnvInt(ConsoleActionAuditHistory, 1000)
+}
+
+// getConsoleActionAuditFile returns the file the console audit events are appended to, empty when disabled
+func getConsoleActionAuditFile() string {
+	return env.Get(ConsoleActionAuditFile, "")
+}
+
+// getConsoleActionAuditWebhookEndpoint returns the endpoint the console audit events are posted to
+func getConsoleActionAuditWebhookEndpoint() string {
+	return env.Get(ConsoleActionAuditWebhookEndpoint, "")
+}
+
+// getConsoleActionAuditWebhookAuthToken returns the bearer token sent to the console audit webhook
+func getConsoleActionAuditWebhookAuthToken() string {
+	return env.Get(ConsoleActionAuditWebhookAuthToken, "")
+}
+
+// getConsoleActionAuditKafkaRESTURL returns the Kafka REST Proxy the console audit events are produced through
+func getConsoleActionAuditKafkaRESTURL() string {
+	return env.Get(ConsoleActionAuditKafkaRESTURL, "")
+}
+
+// getConsoleActionAuditKafkaTopic returns the Kafka topic of the console audit events
+func getConsoleActionAuditKafkaTopic() string {
+	return env.Get(ConsoleActionAuditKafkaTopic, "console-audit")
+}
+
+// getConsoleSessionStoreURL returns the store shared by the console replicas to keep the sessions, empty when the
+// sessions are carried by their tokens
+func getConsoleSessionStoreURL() string {
+	return env.Get(ConsoleSessionStoreURL, "")
+}
+
+// getConsoleKMSKESEndpoints returns the endpoints of the KES server sealing the console secrets
+func getConsoleKMSKESEndpoints() []string {
+	var endpoints []string
+	for _, endpoint := range strings.Split(env.Get(ConsoleKMSKESEndpoint, ""), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint != "" {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+	return endpoints
+}
+
+func getConsoleKMSKESKeyName() string {
+	return env.Get(ConsoleKMSKESKeyName, "")
+}
+
+func getConsoleKMSKESCertFile() string {
+	return env.Get(ConsoleKMSKESCertFile, "")
+}
+
+func getConsoleKMSKESKeyFile() string {
+	return env.Get(ConsoleKMSKESKeyFile, "")
+}
+
+func getConsoleKMSKESCAPath() string {
+	return env.Get(ConsoleKMSKESCAPath, "")
+}
+
+// getConsoleConfigHistoryFile returns the file the configuration history is kept in, empty keeps it in memory
+func getConsoleConfigHistoryFile() string {
+	return env.Get(ConsoleConfigHistoryFile, "")
+}
+
+// getConsoleConfigHistoryLimit returns how many revisions of every subsystem target are kept
+func getConsoleConfigHistoryLimit() int {
+	if limit := getEnvInt(ConsoleConfigHistoryLimit, 50); limit > 0 {
+		return limit
+	}
+	return 50
+}
+
+// getConsoleDiagnosticsBucket returns the bucket trace sessions are recorded to
+func getConsoleDiagnosticsBucket() string {
+	return strings.TrimSpace(env.Get(ConsoleDiagnosticsBucket, ""))
+}
+
+// getConsoleProfilingMaxDuration returns for how long profiling started by Console can run before it's stopped
+func getConsoleProfilingMaxDuration() time.Duration {
+	return getEnvDuration(ConsoleProfilingMaxDuration, 10*time.Minute)
+}
+
+// getConsoleAlertingCredentials returns the credentials the alert rules are evaluated with in the background,
+// empty when alerts are only evaluated on demand
+func getConsoleAlertingCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleAlertingAccessKey, ""), env.Get(ConsoleAlertingSecretKey, "")
+}
+
+// getConsoleAlertingInterval returns how often the alert rules are evaluated in the background
+func getConsoleAlertingInterval() time.Duration {
+	return getEnvDuration(ConsoleAlertingInterval, 5*time.Minute)
+}
+
+// getConsoleAlertingRulesFile returns the file the alert rules are saved to, empty to keep them in memory
+func getConsoleAlertingRulesFile() string {
+	return env.Get(ConsoleAlertingRulesFile, "")
+}
+
+// getConsoleAlertingWebhook returns the endpoint the alerts are posted to along with its bearer token
+func getConsoleAlertingWebhook() (endpoint, authToken string) {
+	return env.Get(ConsoleAlertingWebhookEndpoint, ""), env.Get(ConsoleAlertingWebhookAuthToken, "")
+}
+
+// getConsoleAlertingSlackWebhookURL returns the Slack incoming webhook the alerts are posted to
+func getConsoleAlertingSlackWebhookURL() string {
+	return env.Get(ConsoleAlertingSlackWebhookURL, "")
+}
+
+// getConsoleAlertingSMTP returns the SMTP server, as host:port, the alerts are mailed through along with
+// its credentials
+func getConsoleAlertingSMTP() (server, username, password string) {
+	return env.Get(ConsoleAlertingSMTPServer, ""), env.Get(ConsoleAlertingSMTPUsername, ""), env.Get(ConsoleAlertingSMTPPassword, "")
+}
+
+// getConsoleAlertingEmail returns the sender and the comma separated recipients of the alert emails
+func getConsoleAlertingEmail() (from string, to []string) {
+	return env.Get(ConsoleAlertingEmailFrom, ""), splitEnvList(env.Get(ConsoleAlertingEmailTo, ""))
+}
+
+// getConsoleClustersFile returns the file the registered clusters are kept in, empty keeps them in memory
+func getConsoleClustersFile() string {
+	return env.Get(ConsoleClustersFile, "")
+}
+
+// getConsoleACMEDomains returns the comma separated domains Console obtains its certificate for from an ACME
+// CA, none leaves the certificates to the certs directory
+func getConsoleACMEDomains() []string {
+	return splitEnvList(env.Get(ConsoleACMEDomains, ""))
+}
+
+// getConsoleACMEEmail returns the contact of the ACME account
+func getConsoleACMEEmail() string {
+	return env.Get(ConsoleACMEEmail, "")
+}
+
+// getConsoleACMEDirectoryURL returns the directory of the ACME CA, Let's Encrypt by default
+func getConsoleACMEDirectoryURL() string {
+	return env.Get(ConsoleACMEDirectoryURL, autotls.LetsEncryptURL)
+}
+
+// getConsoleACMEChallenge returns the challenge the domains are validated with, http-01 or dns-01
+func getConsoleACMEChallenge() string {
+	return env.Get(ConsoleACMEChallenge, autotls.ChallengeHTTP01)
+}
+
+// getConsoleACMEDNSProvider returns the provider publishing the dns-01 records along with its configuration
+func getConsoleACMEDNSProvider() (name, config string) {
+	return env.Get(ConsoleACMEDNSProvider, ""), env.Get(ConsoleACMEDNSProviderConfig, "")
+}
+
+// getConsoleACMERenewBefore returns how long before it expires the certificate is renewed
+func getConsoleACMERenewBefore() time.Duration {
+	return getEnvDuration(ConsoleACMERenewBefore, 30*24*time.Hour)
+}
+
+// getConsoleMTLSMode returns whether the console listener asks the clients for a certificate: off, optional
+// or require
+func getConsoleMTLSMode() string {
+	return strings.ToLower(env.Get(ConsoleMTLS, clientcerts.ModeOff))
+}
+
+// getConsoleMTLSCAFile returns the CAs the client certificates are verified with, empty for the ones of the
+// certs/CAs directory
+func getConsoleMTLSCAFile() string {
+	return env.Get(ConsoleMTLSCAFile, "")
+}
+
+// getConsoleMTLSMappingsFile returns the file mapping the client certificates to MinIO credentials
+func getConsoleMTLSMappingsFile() string {
+	return env.Get(ConsoleMTLSMappingsFile, "")
+}
+
+// getConsoleMTLSCredentials returns the credentials of the mappings that don't have their own
+func getConsoleMTLSCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleMTLSAccessKey, ""), env.Get(ConsoleMTLSSecretKey, "")
+}
+
+// getConsoleRateLimit returns the rate, as `<requests>/<period>`, the requests of a principal to a class of
+// endpoints are limited to
+func getConsoleRateLimit(key string) (ratelimit.Rate, error) {
+	return ratelimit.ParseRate(env.Get(key, ""))
+}
+
+// getConsoleConcurrencyCaps returns how many zip downloads and speedtests can run at once, 0 for no cap
+func getConsoleConcurrencyCaps() (zipDownloads, speedtests int) {
+	return getEnvInt(ConsoleConcurrencyZipDownload, 0), getEnvInt(ConsoleConcurrencySpeedtest, 1)
+}
+
+// getConsoleOTelEndpoint returns the URL of the OTLP/HTTP collector the spans are exported to, empty to not
+// export them
+func getConsoleOTelEndpoint() string {
+	return env.Get(ConsoleOTelEndpoint, "")
+}
+
+// getConsoleOTelHeaders returns the headers sent with the exports, written as `key=value` pairs separated
+// by commas
+func getConsoleOTelHeaders() map[string]string {
+	headers := map[string]string{}
+	for _, item := range splitEnvList(env.Get(ConsoleOTelHeaders, "")) {
+		if key, value, ok := strings.Cut(item, "="); ok && strings.TrimSpace(key) != "" {
+			headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	return headers
+}
+
+// getConsoleOTelSampleRatio returns the share of the traces started by Console that are recorded, all by default
+func getConsoleOTelSampleRatio() float64 {
+	ratio, err := strconv.ParseFloat(env.Get(ConsoleOTelSampleRatio, "1"), 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return 1
+	}
+	return ratio
+}
+
+// getConsoleMetricsAddress returns the address the metrics of the console process are served on, empty to not
+// serve them
+func getConsoleMetricsAddress() string {
+	return env.Get(ConsoleMetricsAddress, "")
+}
+
+// getConsoleMetricsAuthToken returns the bearer token the metrics are scraped with, empty to serve them to anyone
+func getConsoleMetricsAuthToken() string {
+	return env.Get(ConsoleMetricsAuthToken, "")
+}
+
+// getConsoleAdminCacheTTL returns how long the server info, data usage and configuration reads are reused, 0 turns
+// the cache off
+func getConsoleAdminCacheTTL() time.Duration {
+	if env.Get(ConsoleAdminCacheTTL, "") == "0" {
+		return 0
+	}
+	return getEnvDuration(ConsoleAdminCacheTTL, 5*time.Second)
+}
+
+// GetConsoleConfigFile returns the YAML or JSON file the server is configured from, besides the environment
+func GetConsoleConfigFile() string {
+	return env.Get(ConsoleConfigFile, "")
+}
+
+// GetSocketPath returns the Unix domain socket console is served on instead of the TCP ports, empty to listen on the
+// ports
+func GetSocketPath() string {
+	return env.Get(ConsoleSocketPath, "")
+}
+
+// getConsoleSocketMode returns the permissions of the Unix domain socket, an octal mode only the owner and the group
+// can connect with by default
+func getConsoleSocketMode() os.FileMode {
+	mode, err := strconv.ParseUint(env.Get(ConsoleSocketMode, "0660"), 8, 32)
+	if err != nil || mode > 0o777 {
+		return 0o660
+	}
+	return os.FileMode(mode)
+}
+
+// getConsoleSocketGroup returns the group, by name or ID, owning the Unix domain socket, empty to keep the group of the
+// process
+func getConsoleSocketGroup() string {
+	return env.Get(ConsoleSocketGroup, "")
+}
+
+// getConsoleListPageSize returns the page size of the listings requested without one, 0 lists everything at once
+func getConsoleListPageSize() int {
+	return getEnvInt(ConsoleListPageSize, 0)
+}
+
+// getConsoleListMaxPageSize returns the largest page the listings return, whatever page size is requested
+func getConsoleListMaxPageSize() int {
+	if size := getEnvInt(ConsoleListMaxPageSize, 1000); size > 0 {
+		return size
+	}
+	return 1000
+}
+
+// getEnvInt parses a non negative integer environment value, falling back to def when missing or invalid
+func getEnvInt(key string, def int) int {
+	value, err := strconv.Atoi(env.Get(key, strconv.Itoa(def)))
+	if err != nil || value < 0 {
+		return def
+	}
+	return value
+}
+
+// getEnvDuration parses a duration environment value, falling back to def when missing or invalid
+func getEnvDuration(key string, def time.Duration) time.Duration {
+	value, err := time.ParseDuration(env.Get(key, def.String()))
+	if err != nil || value <= 0 {
+		return def
+	}
+	return value
+}
+
+// splitEnvList splits a comma separated environment value ignoring empty items
+func splitEnvList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
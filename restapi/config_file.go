@@ -0,0 +1,360 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/restapi/operations"
+	configurationApi "github.com/minio/console/restapi/operations/configuration"
+	"github.com/minio/pkg/env"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"gopkg.in/yaml.v3"
+)
+
+// consoleConfigSection is a section of the configuration file, its settings set the environment variables Console is
+// configured with
+type consoleConfigSection struct {
+	// reloadable sections are read on every use, the others are only applied on restart
+	reloadable bool
+	// settings maps the settings to their environment variable, a section without takes any environment variable
+	settings map[string]string
+	// onReload applies the reloaded settings the server doesn't read on every use
+	onReload func()
+}
+
+// consoleConfigSections are the sections of the configuration file
+var consoleConfigSections = map[string]consoleConfigSection{
+	"listeners": {settings: map[string]string{
+		"host":           ConsoleHostname,
+		"port":           ConsolePort,
+		"tlsPort":        ConsoleTLSPort,
+		"metricsAddress": ConsoleMetricsAddress,
+		"socketPath":     ConsoleSocketPath,
+		"socketMode":     ConsoleSocketMode,
+		"socketGroup":    ConsoleSocketGroup,
+	}},
+	"tls": {settings: map[string]string{
+		"redirect":    ConsoleSecureTLSRedirect,
+		"host":        ConsoleSecureTLSHost,
+		"acmeDomains": ConsoleACMEDomains,
+		"acmeEmail":   ConsoleACMEEmail,
+		"mtls":        ConsoleMTLS,
+		"mtlsCAFile":  ConsoleMTLSCAFile,
+	}},
+	"idp": {settings: map[string]string{
+		"url":                oauth2.ConsoleIDPURL,
+		"clientID":           oauth2.ConsoleIDPClientID,
+		"secret":             oauth2.ConsoleIDPSecret,
+		"callback":           oauth2.ConsoleIDPCallbackURL,
+		"scopes":             oauth2.ConsoleIDPScopes,
+		"displayName":        oauth2.ConsoleIDPDisplayName,
+		"roleARN":            oauth2.ConsoleIDPRoleARN,
+		"endSessionEndpoint": oauth2.ConsoleIDPEndSessionEndpoint,
+	}},
+	"session": {reloadable: true, settings: map[string]string{
+		"duration":    xjwt.ConsoleSTSDuration,
+		"idleTimeout": ConsoleSessionIdleTimeout,
+		"maxLifetime": ConsoleSessionMaxLifetime,
+	}},
+	"logging": {reloadable: true, settings: map[string]string{
+		"level": logger.EnvLoggerLevel,
+	}, onReload: func() {
+		if level, err := logger.ParseLevel(env.Get(logger.EnvLoggerLevel, "")); err == nil {
+			logger.SetLevel(level)
+		}
+	}},
+	"features": {reloadable: true, settings: map[string]string{
+		"animatedLogin":     ConsoleAnimatedLogin,
+		"anonymousBrowsing": ConsoleAnonymousBrowsing,
+	}},
+	"env": {},
+}
+
+// validEnvName matches the environment variables the env section can set
+var validEnvName = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// consoleConfig holds the environment variables set by a configuration file by section
+type consoleConfig map[string]map[string]string
+
+// parseConsoleConfig parses a YAML or JSON configuration file
+func parseConsoleConfig(data []byte) (consoleConfig, error) {
+	var file map[string]map[string]interface{}
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidConsoleConfig, err)
+	}
+	config := consoleConfig{}
+	for name, settings := range file {
+		section, ok := consoleConfigSections[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown section %s", ErrInvalidConsoleConfig, name)
+		}
+		vars := map[string]string{}
+		for setting, value := range settings {
+			envName := setting
+			if section.settings != nil {
+				if envName, ok = section.settings[setting]; !ok {
+					return nil, fmt.Errorf("%w: unknown setting %s.%s", ErrInvalidConsoleConfig, name, setting)
+				}
+			} else if !validEnvName.MatchString(setting) {
+				return nil, fmt.Errorf("%w: %s isn't an environment variable", ErrInvalidConsoleConfig, setting)
+			}
+			if value == nil {
+				continue
+			}
+			envValue, err := consoleConfigValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s.%s %v", ErrInvalidConsoleConfig, name, setting, err)
+			}
+			vars[envName] = envValue
+		}
+		config[name] = vars
+	}
+	return config, nil
+}
+
+// consoleConfigValue returns the environment value of a setting, the booleans are on or off and the lists are comma
+// separated
+func consoleConfigValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "on", nil
+		}
+		return "off", nil
+	case string, int, float64:
+		return fmt.Sprint(v), nil
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			if _, ok := item.([]interface{}); ok {
+				return "", fmt.Errorf("can't be a list of lists")
+			}
+			itemValue, err := consoleConfigValue(item)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, itemValue)
+		}
+		return strings.Join(values, ","), nil
+	}
+	return "", fmt.Errorf("has to be a value or a list of values")
+}
+
+// readConsoleConfig reads and parses the configuration file at path
+func readConsoleConfig(path string) (consoleConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseConsoleConfig(data)
+}
+
+// consoleConfigFile is the configuration file the server was started with
+type consoleConfigFile struct {
+	mu   sync.Mutex
+	path string
+	// applied holds the variables set from the file by section, the ones set in the environment take precedence and
+	// are never changed
+	applied consoleConfig
+}
+
+var globalConsoleConfigFile consoleConfigFile
+
+// fromFile tells whether the environment variable was set from the file
+func (c *consoleConfigFile) fromFile(name string) bool {
+	for _, vars := range c.applied {
+		if _, ok := vars[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sets tells whether the environment variable is set from the file
+func (c *consoleConfigFile) sets(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.fromFile(name)
+}
+
+// settable returns the variables of the section the environment doesn't set
+func (c *consoleConfigFile) settable(vars map[string]string) map[string]string {
+	settable := map[string]string{}
+	for name, value := range vars {
+		if _, ok := os.LookupEnv(name); ok && !c.fromFile(name) {
+			continue
+		}
+		settable[name] = value
+	}
+	return settable
+}
+
+// load sets the environment variables of the file at path
+func (c *consoleConfigFile) load(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	config, err := readConsoleConfig(path)
+	if err != nil {
+		return err
+	}
+	c.path = path
+	c.applied = consoleConfig{}
+	for section, vars := range config {
+		c.applied[section] = c.settable(vars)
+		for name, value := range c.applied[section] {
+			os.Setenv(name, value)
+		}
+	}
+	return nil
+}
+
+// reload applies the changes of the reloadable sections of the file and returns the sections that changed
+func (c *consoleConfigFile) reload() (*models.ConsoleConfigReload, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.path == "" {
+		return nil, ErrConsoleConfigNotConfigured
+	}
+	config, err := readConsoleConfig(c.path)
+	if err != nil {
+		return nil, err
+	}
+	var sections []string
+	for section := range consoleConfigSections {
+		sections = append(sections, section)
+	}
+	sort.Strings(sections)
+	result := &models.ConsoleConfigReload{File: c.path, Reloaded: []string{}, RestartRequired: []string{}}
+	for _, section := range sections {
+		applied, vars := c.applied[section], c.settable(config[section])
+		if equalStringMaps(applied, vars) {
+			continue
+		}
+		if !consoleConfigSections[section].reloadable {
+			result.RestartRequired = append(result.RestartRequired, section)
+			continue
+		}
+		for name := range applied {
+			if _, ok := vars[name]; !ok {
+				os.Unsetenv(name)
+			}
+		}
+		for name, value := range vars {
+			os.Setenv(name, value)
+		}
+		c.applied[section] = vars
+		if onReload := consoleConfigSections[section].onReload; onReload != nil {
+			onReload()
+		}
+		result.Reloaded = append(result.Reloaded, section)
+	}
+	return result, nil
+}
+
+// equalStringMaps tells whether both maps hold the same values, a nil map is equal to an empty one
+func equalStringMaps(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if value, ok := b[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadConsoleConfigFile configures the server from the YAML or JSON file at path, the environment variables already
+// set take precedence over it. Nothing is loaded without a path.
+func LoadConsoleConfigFile(path string) error {
+	if path == "" {
+		return nil
+	}
+	if err := globalConsoleConfigFile.load(path); err != nil {
+		return fmt.Errorf("unable to load the configuration file %s: %w", path, err)
+	}
+	return nil
+}
+
+// reloadConsoleConfigFile reloads the configuration file and logs the sections that changed
+func reloadConsoleConfigFile(ctx context.Context) (*models.ConsoleConfigReload, error) {
+	result, err := globalConsoleConfigFile.reload()
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Reloaded) > 0 {
+		LogInfoContext(ctx, "reloaded the %s sections of %s", strings.Join(result.Reloaded, ", "), result.File)
+	}
+	if len(result.RestartRequired) > 0 {
+		LogInfoContext(ctx, "the changes of the %s sections of %s are applied on restart", strings.Join(result.RestartRequired, ", "), result.File)
+	}
+	return result, nil
+}
+
+// ReloadConsoleConfigOnSignal reloads the configuration file every time the process receives one of the signals
+func ReloadConsoleConfigOnSignal(sig ...os.Signal) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, sig...)
+	go func() {
+		for range signals {
+			if _, err := reloadConsoleConfigFile(context.Background()); err != nil && err != ErrConsoleConfigNotConfigured {
+				LogError("unable to reload the configuration file: %v", err)
+			}
+		}
+	}()
+}
+
+func registerConsoleConfigHandlers(api *operations.ConsoleAPI) {
+	// reload the configuration file
+	api.ConfigurationReloadConsoleConfigHandler = configurationApi.ReloadConsoleConfigHandlerFunc(func(params configurationApi.ReloadConsoleConfigParams, session *models.Principal) middleware.Responder {
+		resp, err := getReloadConsoleConfigResponse(session, params)
+		if err != nil {
+			return configurationApi.NewReloadConsoleConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		return configurationApi.NewReloadConsoleConfigOK().WithPayload(resp)
+	})
+}
+
+func getReloadConsoleConfigResponse(session *models.Principal, params configurationApi.ReloadConsoleConfigParams) (*models.ConsoleConfigReload, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ConfigUpdateAdminAction) {
+		return nil, ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	resp, reloadErr := reloadConsoleConfigFile(ctx)
+	if reloadErr != nil {
+		return nil, ErrorWithContext(ctx, reloadErr)
+	}
+	return resp, nil
+}
@@ -0,0 +1,151 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConsoleConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    consoleConfig
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			data: `
+listeners:
+  port: 9091
+tls:
+  redirect: false
+  acmeDomains: [console.example.com, minio.example.com]
+idp:
+  url: https://sso.example.com/.well-known/openid-configuration
+  displayName:
+features:
+  anonymousBrowsing: true
+env:
+  CONSOLE_ADMIN_CACHE_TTL: 10s
+`,
+			want: consoleConfig{
+				"listeners": {ConsolePort: "9091"},
+				"tls":       {ConsoleSecureTLSRedirect: "off", ConsoleACMEDomains: "console.example.com,minio.example.com"},
+				"idp":       {oauth2.ConsoleIDPURL: "https://sso.example.com/.well-known/openid-configuration"},
+				"features":  {ConsoleAnonymousBrowsing: "on"},
+				"env":       {ConsoleAdminCacheTTL: "10s"},
+			},
+		},
+		{
+			name: "json",
+			data: `{"session": {"idleTimeout": "30m"}, "logging": {"level": "debug"}}`,
+			want: consoleConfig{
+				"session": {ConsoleSessionIdleTimeout: "30m"},
+				"logging": {logger.EnvLoggerLevel: "debug"},
+			},
+		},
+		{name: "unknown section", data: "server:\n  port: 9090\n", wantErr: true},
+		{name: "unknown setting", data: "listeners:\n  address: :9090\n", wantErr: true},
+		{name: "invalid environment variable", data: "env:\n  console_port: 9090\n", wantErr: true},
+		{name: "nested value", data: "listeners:\n  port:\n    http: 9090\n", wantErr: true},
+		{name: "invalid syntax", data: "listeners: [", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseConsoleConfig([]byte(tt.data))
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidConsoleConfig)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConsoleConfigFileReload(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "console.yaml")
+	write := func(data string) {
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the variables set by the file are restored by t.Setenv
+	for _, name := range []string{ConsolePort, ConsoleSessionIdleTimeout, ConsoleSessionMaxLifetime, ConsoleAnimatedLogin} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+	t.Setenv(ConsoleAnonymousBrowsing, "off")
+	var file consoleConfigFile
+
+	// Test-1 : the file sets the variables the environment doesn't
+	write(`
+listeners:
+  port: 9091
+session:
+  idleTimeout: 30m
+  maxLifetime: 12h
+features:
+  anonymousBrowsing: true
+`)
+	assert.NoError(file.load(path))
+	assert.Equal("9091", os.Getenv(ConsolePort))
+	assert.Equal("30m", os.Getenv(ConsoleSessionIdleTimeout))
+	assert.Equal("off", os.Getenv(ConsoleAnonymousBrowsing))
+	assert.True(file.sets(ConsolePort))
+	assert.False(file.sets(ConsoleAnonymousBrowsing))
+
+	// Test-2 : the changes of the reloadable sections are applied, the others wait for a restart
+	write(`
+listeners:
+  port: 9092
+session:
+  idleTimeout: 1h
+features:
+  anonymousBrowsing: true
+  animatedLogin: false
+`)
+	result, err := file.reload()
+	assert.NoError(err)
+	assert.Equal([]string{"features", "session"}, result.Reloaded)
+	assert.Equal([]string{"listeners"}, result.RestartRequired)
+	assert.Equal("9091", os.Getenv(ConsolePort))
+	assert.Equal("1h", os.Getenv(ConsoleSessionIdleTimeout))
+	_, ok := os.LookupEnv(ConsoleSessionMaxLifetime)
+	assert.False(ok)
+	assert.Equal("off", os.Getenv(ConsoleAnimatedLogin))
+	assert.Equal("off", os.Getenv(ConsoleAnonymousBrowsing))
+
+	// Test-3 : an invalid file keeps the configuration
+	write("session:\n  timeout: 1h\n")
+	_, err = file.reload()
+	assert.ErrorIs(err, ErrInvalidConsoleConfig)
+	assert.Equal("1h", os.Getenv(ConsoleSessionIdleTimeout))
+
+	// Test-4 : nothing is reloaded without a file
+	var noFile consoleConfigFile
+	_, err = noFile.reload()
+	assert.ErrorIs(err, ErrConsoleConfigNotConfigured)
+}
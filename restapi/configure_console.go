@@ -22,6 +22,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/fs"
@@ -35,12 +36,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	xjwt "github.com/minio/console/pkg/auth/token"
 	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/pkg/replay"
+	"github.com/minio/console/pkg/sessionstore"
 	"github.com/minio/console/pkg/utils"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 
-	"github.com/klauspost/compress/gzhttp"
-
 	portal_ui "github.com/minio/console/portal-ui"
 	"github.com/minio/pkg/env"
 	"github.com/minio/pkg/mimedb"
@@ -91,6 +95,15 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 			api.Logger("Unable to validate the session token %s: %v", token, err)
 			return nil, errors.New(401, "incorrect api key auth")
 		}
+		if err := getConsoleSessionLimits().Check(claims, time.Now()); err != nil {
+			return nil, errors.New(401, err.Error())
+		}
+		// the sessions of an unregistered cluster have to log in again
+		if claims.ClusterID != "" {
+			if _, err := clusterRegistry().Get(claims.ClusterID); err != nil {
+				return nil, errors.New(401, "the cluster of the session is no longer registered")
+			}
+		}
 		return &models.Principal{
 			STSAccessKeyID:     claims.STSAccessKeyID,
 			STSSecretAccessKey: claims.STSSecretAccessKey,
@@ -99,12 +112,70 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 			Hm:                 claims.HideMenu,
 			Ob:                 claims.ObjectBrowser,
 			CustomStyleOb:      claims.CustomStyleOB,
+			IdpName:            claims.IDPName,
+			IdpRefreshToken:    claims.IDPRefreshToken,
+			Expiration:         claims.Expiration,
+			IssuedAt:           claims.IssuedAt,
+			ClusterID:          claims.ClusterID,
+			LoginCluster:       claims.LoginCluster,
 		}, nil
 	}
 	api.AnonymousAuth = func(s string) (*models.Principal, error) {
 		return &models.Principal{}, nil
 	}
 
+	// Seal the console secrets with a KMS
+	if err := configureConsoleKMS(); err != nil {
+		log.Fatalf("unable to use the console KMS: %v", err)
+	}
+	// Encrypt the sessions with rotating keys
+	if err := configureSessionKeys(time.Now()); err != nil {
+		log.Fatalf("invalid session keys configuration: %v", err)
+	}
+	// Keep the sessions in a store shared by the console replicas
+	if storeURL := getConsoleSessionStoreURL(); storeURL != "" {
+		store, err := sessionstore.New(storeURL, &tls.Config{RootCAs: GlobalRootCAs, MinVersion: tls.VersionTLS12})
+		if err != nil {
+			log.Fatalf("invalid session store configuration: %v", err)
+		}
+		auth.SetSessionStore(store, xjwt.GetConsoleSTSDuration())
+	}
+
+	// Delegate the authorization of selected operations to an external policy endpoint
+	if endpoint := getConsoleAuthzWebhookEndpoint(); endpoint != "" {
+		api.APIAuthorizer = newAuthzWebhook(endpoint, getConsoleAuthzWebhookAuthToken(), getConsoleAuthzWebhookOperations(), getConsoleAuthzWebhookFailOpen())
+	}
+	// Sessions can only use the operations of the console areas they are granted
+	if rolesFile := getConsoleRolesFile(); rolesFile != "" {
+		roles, err := loadConsoleRoles(rolesFile)
+		if err != nil {
+			log.Fatalf("invalid console roles configuration: %v", err)
+		}
+		globalConsoleRoles = roles
+	}
+	api.APIAuthorizer = consolePermissionsAuthorizer{next: api.APIAuthorizer}
+	api.ServeError = serveConsoleError
+	// Sessions of users whose secret key was reset can only be used to change it
+	api.APIAuthorizer = passwordChangeAuthorizer{store: passwordState(), next: api.APIAuthorizer}
+	// Tell the console action audit who performs every request, whether it is authorized or not
+	api.APIAuthorizer = actionAuditAuthorizer{next: api.APIAuthorizer}
+
+	// Resolve client addresses behind the configured trusted proxies
+	realIPConfig, err := realip.New(getConsoleTrustedProxies(), getConsoleRealIPHeaders())
+	if err != nil {
+		log.Fatalf("invalid trusted proxies configuration: %v", err)
+	}
+	realip.Set(realIPConfig)
+
+	// MinIO passes its OpenID providers when embedding Console, a standalone Console reads them from the environment
+	if len(GlobalMinIOConfig.OpenIDProviders) == 0 {
+		providers, err := unsealOpenIDProviders(oauth2.GetOpenIDPCfg())
+		if err != nil {
+			log.Fatalf("invalid OpenID configuration: %v", err)
+		}
+		GlobalMinIOConfig.OpenIDProviders = providers
+	}
+
 	// Register login handlers
 	registerLoginHandlers(api)
 	// Register logout handlers
@@ -113,18 +184,63 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerBucketsHandlers(api)
 	// Register all users handlers
 	registerUsersHandlers(api)
+	// Register users import handlers
+	registerUsersImportHandlers(api)
 	// Register groups handlers
 	registerGroupsHandlers(api)
 	// Register policies handlers
 	registersPoliciesHandler(api)
 	// Register configurations handlers
 	registerConfigHandlers(api)
+	// Register trusted proxies handlers
+	registerTrustedProxiesHandlers(api)
+	// Register IAM export and import handlers
+	registerIAMTransferHandlers(api)
+	// Register server configuration export and import handlers
+	registerServerConfigTransferHandlers(api)
+	// Register configuration history handlers
+	registerConfigHistoryHandlers(api)
+	// Register policy simulator handlers
+	registerPolicySimulationHandlers(api)
+	// Register policy validation handlers
+	registerPolicyValidationHandlers(api)
+	// Register policy entities handlers
+	registerPolicyEntitiesHandlers(api)
+	// Register LDAP policy mapping handlers
+	registerLDAPPolicyHandlers(api)
+	// Register policy template handlers
+	registerPolicyTemplateHandlers(api)
+	// Register access key inventory handlers
+	registerAccessKeyInventoryHandlers(api)
+	// Register temporary credentials handlers
+	registerTemporaryCredentialsHandlers(api)
+	// Register user password reset handlers
+	registerUserPasswordHandlers(api)
+	// Register user effective policy handlers
+	registerUserEffectivePolicyHandlers(api)
+	// Register OpenID claim mapping handlers
+	registerOpenIDClaimMappingHandlers(api)
+	// Register login attempts handlers
+	registerLoginAttemptsHandlers(api)
+	// Register session renew handlers
+	registerSessionRenewHandlers(api)
+	// Register session keys handlers
+	registerSessionKeysHandlers(api)
+	// Register preflight report handlers
+	registerPreflightHandlers(api)
+	// Register two-factor authentication handlers
+	registerTwoFactorHandlers(api)
+	// Register API token handlers
+	registerAPITokenHandlers(api)
 	// Register bucket events handlers
 	registerBucketEventsHandlers(api)
 	// Register bucket lifecycle handlers
 	registerBucketsLifecycleHandlers(api)
+	registerBucketLifecycleTransferHandlers(api)
 	// Register service handlers
 	registerServiceHandlers(api)
+	// Register Server Update Handlers
+	registerServiceUpdateHandlers(api)
 	// Register session handlers
 	registerSessionHandlers(api)
 	// Register version handlers
@@ -135,12 +251,20 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerAdminArnsHandlers(api)
 	// Register admin notification endpoints handlers
 	registerAdminNotificationEndpointsHandlers(api)
+	// Register notification endpoints status handlers
+	registerNotificationEndpointsStatusHandlers(api)
+	// Register audit and logger targets handlers
+	registerLogTargetsHandlers(api)
 	// Register admin Service Account Handlers
 	registerServiceAccountsHandlers(api)
 	// Register admin remote buckets
 	registerAdminBucketRemoteHandlers(api)
 	// Register admin log search
 	registerLogSearchHandlers(api)
+	// Register historical log entries handlers
+	registerLogEntriesHandlers(api)
+	// Register console audit handlers
+	registerConsoleAuditHandlers(api)
 	// Register admin subnet handlers
 	registerSubnetHandlers(api)
 	// Register admin KMS handlers
@@ -156,6 +280,37 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 
 	registerSiteReplicationHandler(api)
 	registerSiteReplicationStatusHandler(api)
+	registerSiteReplicationCompareHandler(api)
+	// Register Batch Job Handlers
+	registerBatchJobHandlers(api)
+	// Register Pool Rebalance Handlers
+	registerRebalanceHandlers(api)
+	// Register Pool Decommission Handlers
+	registerPoolHandlers(api)
+	// Register Heal Handlers
+	registerHealHandlers(api)
+	// Register Drive Topology Handlers
+	registerTopologyHandlers(api)
+	// Register Drives Health Handlers
+	registerDrivesHealthHandlers(api)
+	// Register Clusters Handlers
+	registerClustersHandlers(api)
+	// Register TLS Certificates Handlers
+	registerTLSCertificatesHandlers(api)
+	registerCSPReportsHandlers(api)
+	registerLogLevelHandlers(api)
+	// Register Trace Statistics Handlers
+	registerTraceStatsHandlers(api)
+	// Register Profiling Handlers
+	registerProfilingHandlers(api)
+	// Register Top Locks Handlers
+	registerTopLocksHandlers(api)
+	// Register Scanner Handlers
+	registerScannerHandlers(api)
+	// Register Metrics Dashboard Handlers
+	registerMetricsDashboardHandlers(api)
+	// Register Alerting Handlers
+	registerAlertingHandlers(api)
 	// Register Support Handler
 	registerSupportHandlers(api)
 
@@ -163,46 +318,116 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 
 	// Register Object's Handlers
 	registerObjectsHandlers(api)
+	// Register Object's checksum Handlers
+	registerObjectChecksumHandlers(api)
+	// Register anonymous read-only objects handlers
+	registerPublicObjectsHandlers(api)
+	// Register staging workspaces handlers
+	registerStagingHandlers(api)
 	// Register Bucket Quota's Handlers
 	registerBucketQuotaHandlers(api)
+	// Register Bucket replication targets Handlers
+	registerBucketReplicationTargetsHandlers(api)
+	// Register Bucket replication metrics Handlers
+	registerBucketReplicationMetricsHandlers(api)
+	// Register Bucket replication resync Handlers
+	registerReplicationResyncHandlers(api)
+	// Register Bucket replication retry Handlers
+	registerReplicationRetryHandlers(api)
+	// Register Bucket encryption Handlers
+	registerBucketEncryptionHandlers(api)
+	// Register Bucket policy builder and validator Handlers
+	registerBucketPolicyHandlers(api)
+	// Register Bucket CORS Handlers
+	registerBucketCorsHandlers(api)
+	// Register Bucket usage by prefix Handlers
+	registerBucketUsageHandlers(api)
+	// Register Bucket configuration snapshot Handlers
+	registerBucketConfigSnapshotHandlers(api)
+	// Register Bulk Bucket operations Handlers
+	registerBucketBulkHandlers(api)
+	// Register Bucket rename Handlers
+	registerBucketRenameHandlers(api)
+	// Register Bucket access insight Handlers
+	registerBucketAccessInsightHandlers(api)
 	// Register Account handlers
 	registerAccountHandlers(api)
+	// Register batch requests handlers
+	registerBatchHandlers(api)
+	// Register configuration file reload handlers
+	registerConsoleConfigHandlers(api)
 
 	registerReleasesHandlers(api)
 
 	api.PreServerShutdown = func() {}
 
-	api.ServerShutdown = func() {}
+	// evaluate the alert rules in the background when credentials are configured for it
+	stopAlertEvaluation := startAlertEvaluation()
+
+	api.ServerShutdown = func() {
+		stopAlertEvaluation()
+		// deliver the queued console audit events
+		actionAudit().Close()
+	}
 
 	// do an initial subnet plan caching
 	fetchLicensePlan()
 
-	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
+	handler := api.Serve(setupMiddlewares)
+	// the TUS uploads, which go-swagger doesn't route, are authorized by the routes it built
+	globalTUSHandler = newTUSHandler(api)
+	return setupGlobalMiddleware(handler)
 }
 
 // The TLS configuration before HTTPS server starts.
 func configureTLS(tlsConfig *tls.Config) {
 	tlsConfig.RootCAs = GlobalRootCAs
 	tlsConfig.GetCertificate = GlobalTLSCertsManager.GetCertificate
+	// the manager serves the certificate of the tls-certificate flag as well and reloads it when the
+	// file changes, a static copy would take precedence for the clients not sending SNI
+	tlsConfig.Certificates = nil
+	if err := configureClientCertificates(tlsConfig); err != nil {
+		log.Fatalf("unable to configure the client certificates: %v", err)
+	}
 }
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	// name the spans after the operations
+	handler = traceOperationMiddleware(handler)
+	// count the API calls and their duration per operation
+	handler = consoleMetricsMiddleware(handler)
+	// record the console operations that change state
+	return ConsoleActionAuditMiddleware(handler)
+}
+
+// requestIDHeader carries the ID of a request, which is attached to its logs and returned to correlate them
+const requestIDHeader = "X-Request-ID"
+
+// validRequestID matches the request IDs set by the proxies in front of Console that are kept
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// newRequestID returns the ID the proxy in front of Console assigned to the request, or a new one
+func newRequestID(r *http.Request) (string, error) {
+	if requestID := r.Header.Get(requestIDHeader); validRequestID.MatchString(requestID) {
+		return requestID, nil
+	}
+	return utils.NewUUID()
 }
 
 func ContextMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		requestID, err := utils.NewUUID()
+		requestID, err := newRequestID(r)
 		if err != nil && err != auth.ErrNoAuthToken {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
+		w.Header().Set(requestIDHeader, requestID)
 		ctx := context.WithValue(r.Context(), utils.ContextRequestID, requestID)
 		ctx = context.WithValue(ctx, utils.ContextRequestUserAgent, r.UserAgent())
 		ctx = context.WithValue(ctx, utils.ContextRequestHost, r.Host)
-		ctx = context.WithValue(ctx, utils.ContextRequestRemoteAddr, r.RemoteAddr)
+		ctx = context.WithValue(ctx, utils.ContextRequestRemoteAddr, realip.ClientIP(r))
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -213,6 +438,7 @@ func AuditLogMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(rw, r)
 		if strings.HasPrefix(r.URL.Path, "/ws") || strings.HasPrefix(r.URL.Path, "/api") {
 			logger.AuditLog(r.Context(), rw, r, map[string]interface{}{}, "Authorization", "Cookie", "Set-Cookie")
+			LogDebugContext(r.Context(), "%s %s %d %s", r.Method, r.URL.Path, rw.StatusCode, time.Since(rw.StartTime))
 		}
 	})
 }
@@ -220,13 +446,24 @@ func AuditLogMiddleware(next http.Handler) http.Handler {
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logger and metrics
 func setupGlobalMiddleware(handler http.Handler) http.Handler {
-	gnext := gzhttp.GzipHandler(handler)
+	// record or replay the REST interactions when running in test mode
+	handler = ReplayMiddleware(handler)
+	// throttle the logins, the rejected ones are still audited
+	next := LoginThrottleMiddleware(handler)
 	// if audit-log is enabled console will log all incoming request
-	next := AuditLogMiddleware(gnext)
+	next = AuditLogMiddleware(next)
 	// serve static files
 	next = FileServerMiddleware(next)
+	// compress the API responses and the static files
+	next = CompressionMiddleware(next)
 	// add information to request context
+	// trace the API calls and the websockets, with the ID of the request
+	next = TracingMiddleware(next)
 	next = ContextMiddleware(next)
+	// limit the rate of the requests of every principal, it runs once the session is known
+	next = RateLimitMiddleware(next)
+	// the calls of the batches are served from here, with the session of their batch
+	globalBatchHandler = next
 	// handle cookie or authorization header for session
 	next = AuthenticationMiddleware(next)
 
@@ -240,11 +477,12 @@ func setupGlobalMiddleware(handler http.Handler) http.Handler {
 
 	// Secure middleware, this middleware wrap all the previous handlers and add
 	// HTTP security headers
+	csp, cspReportOnly := contentSecurityPolicies()
 	secureOptions := secure.Options{
 		AllowedHosts:                    GetSecureAllowedHosts(),
 		AllowedHostsAreRegex:            GetSecureAllowedHostsAreRegex(),
 		HostsProxyHeaders:               GetSecureHostsProxyHeaders(),
-		SSLRedirect:                     GetTLSRedirect() == "on" && len(GlobalPublicCerts) > 0,
+		SSLRedirect:                     GetTLSRedirect() == "on" && len(GlobalPublicCerts) > 0 && globalSockets.servesTLS(),
 		SSLHostFunc:                     &sslHostFn,
 		SSLHost:                         GetSecureTLSHost(),
 		STSSeconds:                      GetSecureSTSSeconds(),
@@ -253,10 +491,11 @@ func setupGlobalMiddleware(handler http.Handler) http.Handler {
 		SSLTemporaryRedirect:            false,
 		ForceSTSHeader:                  GetSecureForceSTSHeader(),
 		FrameDeny:                       GetSecureFrameDeny(),
+		CustomFrameOptionsValue:         GetSecureFrameOptions(),
 		ContentTypeNosniff:              GetSecureContentTypeNonSniff(),
 		BrowserXssFilter:                GetSecureBrowserXSSFilter(),
-		ContentSecurityPolicy:           GetSecureContentSecurityPolicy(),
-		ContentSecurityPolicyReportOnly: GetSecureContentSecurityPolicyReportOnly(),
+		ContentSecurityPolicy:           csp,
+		ContentSecurityPolicyReportOnly: cspReportOnly,
 		PublicKey:                       GetSecurePublicKey(),
 		ReferrerPolicy:                  GetSecureReferrerPolicy(),
 		FeaturePolicy:                   GetSecureFeaturePolicy(),
@@ -265,7 +504,30 @@ func setupGlobalMiddleware(handler http.Handler) http.Handler {
 	}
 	secureMiddleware := secure.New(secureOptions)
 	next = secureMiddleware.Handler(next)
-	return RejectS3Middleware(next)
+	// the ACME challenges are answered over plain HTTP for any host
+	return ACMEChallengeMiddleware(RejectS3Middleware(next))
+}
+
+// ReplayMiddleware records the REST API interactions into a golden file or serves
+// them back from it, depending on CONSOLE_REPLAY_MODE, so Console can be exercised
+// without a live MinIO cluster.
+func ReplayMiddleware(next http.Handler) http.Handler {
+	mode := getConsoleReplayMode()
+	if mode == replay.ModeOff {
+		return next
+	}
+	if mode != replay.ModeRecord && mode != replay.ModeReplay {
+		log.Fatalf("invalid %s value %q, expected one of off, record or replay", ConsoleReplayMode, mode)
+	}
+	cassette, err := replay.Load(getConsoleReplayFile())
+	if err != nil {
+		log.Fatalf("unable to load the replay fixtures: %v", err)
+	}
+	LogInfo("REST interactions %s mode enabled using %s", mode, getConsoleReplayFile())
+	replay.LogError = func(ctx context.Context, msg string, data ...interface{}) {
+		LogErrorContext(ctx, msg, data...)
+	}
+	return replay.Handler(mode, "/api/", cassette, next)
 }
 
 const apiRequestErr = `<?xml version="1.0" encoding="UTF-8"?><Error><Code>InvalidArgument</Code><Message>S3 API Requests must be made to API port.</Message><RequestId>0</RequestId></Error>`
@@ -289,23 +551,86 @@ func RejectS3Middleware(next http.Handler) http.Handler {
 
 func AuthenticationMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requiresClientCertificate(r) {
+			http.Error(w, "a client certificate is required, connect over HTTPS", http.StatusForbidden)
+			return
+		}
+		// the claims of API tokens and client certificates are passed as they are, without a session. Only sessions
+		// switch clusters, the registered credentials of a cluster are never handed out to those claims.
+		serveClaims := func(claims *auth.TokenClaims) {
+			claims.ClusterID, claims.LoginCluster = "", ""
+			sessionClaims, err := json.Marshal(claims)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", string(sessionClaims)))
+			ctx := context.WithValue(r.Context(), utils.ContextRequestUserID, claims.STSAccessKeyID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		}
+		// scripts authenticate with an API token instead of a session cookie
+		if value, ok := bearerAPIToken(r); ok {
+			claims, status, err := authenticateAPIToken(apiTokens(), value, r, time.Now())
+			if err != nil {
+				http.Error(w, err.Error(), status)
+				return
+			}
+			serveClaims(claims)
+			return
+		}
 		token, err := auth.GetTokenFromRequest(r)
 		if err != nil && err != auth.ErrNoAuthToken {
 			http.Error(w, err.Error(), http.StatusUnauthorized)
 			return
 		}
+		// machines with a mapped client certificate need no login
+		if err == auth.ErrNoAuthToken {
+			claims, err := authenticateClientCertificate(globalClientCertificates, r, time.Now())
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			if claims != nil {
+				serveClaims(claims)
+				return
+			}
+		}
 		sessionToken, _ := auth.DecryptToken(token)
+		claims, _ := auth.ParseClaimsFromToken(string(sessionToken))
+		if claims != nil {
+			limits := getConsoleSessionLimits()
+			now := time.Now()
+			if err := limits.Check(claims, now); err != nil {
+				// the handlers see an anonymous request, the ones needing a session answer 401
+				sessionToken, claims = nil, nil
+				if err := auth.EndSession(token); err != nil {
+					LogError("unable to end the session: %v", err)
+				}
+				dropSessionCookie(r)
+				cookie := ExpireSessionCookie()
+				http.SetCookie(w, &cookie)
+			} else if renewed, err := limits.RenewActivity(token, claims, now); err != nil {
+				LogError("unable to renew the session activity: %v", err)
+			} else if renewed != "" {
+				cookie := NewSessionCookieForConsole(renewed)
+				http.SetCookie(w, &cookie)
+			}
+			if claims != nil {
+				globalActiveSessions.touch(claims.STSAccessKeyID, now)
+			}
+		}
 		// All handlers handle appropriately to return errors
 		// based on their swagger rules, we do not need to
 		// additionally return error here, let the next ServeHTTPs
-		// handle it appropriately.
+		// handle it appropriately. The Authorization header sent by the client is replaced, the claims it carries
+		// would be taken for the ones of a session otherwise.
+		r.Header.Del("Authorization")
 		if len(sessionToken) > 0 {
-			r.Header.Add("Authorization", fmt.Sprintf("Bearer  %s", string(sessionToken)))
+			r.Header.Set("Authorization", fmt.Sprintf("Bearer  %s", string(sessionToken)))
 		} else {
-			r.Header.Add("Authorization", fmt.Sprintf("Bearer %s", "Anonymous"))
+			r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", "Anonymous"))
 		}
 		ctx := r.Context()
-		claims, _ := auth.ParseClaimsFromToken(string(sessionToken))
 		if claims != nil {
 			// save user session id context
 			ctx = context.WithValue(r.Context(), utils.ContextRequestUserID, claims.STSSessionToken)
@@ -314,6 +639,18 @@ func AuthenticationMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// dropSessionCookie removes the session cookie from the request, the handlers reading it directly then find no
+// session
+func dropSessionCookie(r *http.Request) {
+	cookies := r.Cookies()
+	r.Header.Del("Cookie")
+	for _, cookie := range cookies {
+		if cookie.Name != "token" {
+			r.AddCookie(cookie)
+		}
+	}
+}
+
 // FileServerMiddleware serves files from the static folder
 func FileServerMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -321,6 +658,12 @@ func FileServerMiddleware(next http.Handler) http.Handler {
 		switch {
 		case strings.HasPrefix(r.URL.Path, "/ws"):
 			serveWS(w, r)
+		case isTUSRequest(r) && globalTUSHandler != nil:
+			globalTUSHandler.ServeHTTP(w, r)
+		case r.URL.Path == cspReportPath:
+			serveCSPReport(w, r)
+		case r.URL.Path == healthzPath || r.URL.Path == readyzPath:
+			serveHealth(w, r)
 		case strings.HasPrefix(r.URL.Path, "/api"):
 			next.ServeHTTP(w, r)
 		default:
@@ -328,6 +671,9 @@ func FileServerMiddleware(next http.Handler) http.Handler {
 			if err != nil {
 				panic(err)
 			}
+			if serveCompressedAsset(w, r, buildFs) {
+				return
+			}
 			wrapHandlerSinglePageApplication(requestBounce(http.FileServer(http.FS(buildFs)))).ServeHTTP(w, r)
 		}
 	})
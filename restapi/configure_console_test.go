@@ -17,10 +17,17 @@
 package restapi
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/sessionstore"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -123,3 +130,106 @@ func Test_getSubPath(t *testing.T) {
 		})
 	}
 }
+
+func Test_dropSessionCookie(t *testing.T) {
+	assert := assert.New(t)
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+	r.AddCookie(&http.Cookie{Name: "token", Value: "session"})
+	r.AddCookie(&http.Cookie{Name: "idp-refresh-token", Value: "refresh"})
+	dropSessionCookie(r)
+	_, err := r.Cookie("token")
+	assert.Equal(http.ErrNoCookie, err)
+	cookie, err := r.Cookie("idp-refresh-token")
+	assert.NoError(err)
+	assert.Equal("refresh", cookie.Value)
+}
+
+func TestAuthenticationMiddlewareSessionLimits(t *testing.T) {
+	assert := assert.New(t)
+	os.Setenv(ConsoleSessionIdleTimeout, "15m")
+	os.Setenv(ConsoleSessionMaxLifetime, "8h")
+	defer os.Unsetenv(ConsoleSessionIdleTimeout)
+	defer os.Unsetenv(ConsoleSessionMaxLifetime)
+
+	token, err := auth.NewEncryptedTokenForClient(&credentials.Value{AccessKeyID: "fakeAccessKeyID"}, "alice", nil)
+	assert.NoError(err)
+	var authorization string
+	handler := AuthenticationMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+
+	// a session within its limits reaches the handlers, its recent activity isn't renewed
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+	r.AddCookie(&http.Cookie{Name: "token", Value: token})
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.True(strings.Contains(authorization, "fakeAccessKeyID"))
+	assert.Empty(w.Result().Cookies())
+
+	// a token that doesn't carry its login time can't be bound by the maximum lifetime, without an owner
+	// EncryptSecret encrypts like the session tokens
+	token, err = auth.EncryptSecret([]byte(`{"stsAccessKeyID":"fakeAccessKeyID"}`), "")
+	assert.NoError(err)
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+	r.AddCookie(&http.Cookie{Name: "token", Value: token})
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal("Bearer Anonymous", authorization)
+	cookies := w.Result().Cookies()
+	assert.Len(cookies, 1)
+	assert.Equal("token", cookies[0].Name)
+	assert.Equal("", cookies[0].Value)
+}
+
+func TestAuthenticationMiddlewareStoredSessions(t *testing.T) {
+	assert := assert.New(t)
+	auth.SetSessionStore(sessionstore.NewMemory(), time.Hour)
+	defer auth.SetSessionStore(nil, 0)
+
+	token, err := auth.NewEncryptedTokenForClient(&credentials.Value{AccessKeyID: "fakeAccessKeyID"}, "alice", nil)
+	assert.NoError(err)
+	var authorization string
+	handler := AuthenticationMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+
+	// the claims of the session are read from the store
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+	r.AddCookie(&http.Cookie{Name: "token", Value: token})
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.True(strings.Contains(authorization, "fakeAccessKeyID"))
+
+	// an ended session is anonymous
+	assert.NoError(auth.EndSession(token))
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/session", nil)
+	r.AddCookie(&http.Cookie{Name: "token", Value: token})
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal("Bearer Anonymous", authorization)
+}
+
+func TestAuthenticationMiddlewareForgedClaims(t *testing.T) {
+	assert := assert.New(t)
+	var authorization []string
+	handler := AuthenticationMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Values("Authorization")
+	}))
+	forged := `Bearer {"stsAccessKeyID":"","accountAccessKey":"admin","cluster":"backup","loginCluster":"primary"}`
+
+	// the claims sent by the client never reach the handlers, without a session the request is anonymous
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/admin/info", nil)
+	r.Header.Set("Authorization", forged)
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.Equal([]string{"Bearer Anonymous"}, authorization)
+
+	// nor do they with a session
+	token, err := auth.NewEncryptedTokenForClient(&credentials.Value{AccessKeyID: "fakeAccessKeyID"}, "alice", nil)
+	assert.NoError(err)
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/admin/info", nil)
+	r.Header.Set("Authorization", forged)
+	r.AddCookie(&http.Cookie{Name: "token", Value: token})
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	if assert.Len(authorization, 1) {
+		assert.Contains(authorization[0], "fakeAccessKeyID")
+		assert.NotContains(authorization[0], "backup")
+	}
+}
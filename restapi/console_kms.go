@@ -0,0 +1,120 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/kms"
+)
+
+// consoleKMSTimeout bounds every call to the KMS sealing the console secrets
+const consoleKMSTimeout = 10 * time.Second
+
+// The contexts the console secrets are sealed with, a sealed secret only unseals for its own use
+const (
+	consoleKMSSessionKeysContext = "console-session-keys"
+	consoleKMSPBKDFContext       = "console-pbkdf"
+	consoleKMSOpenIDContext      = "console-openid-client-secret"
+)
+
+// globalConsoleKMS seals the console secrets, it is nil when no KMS is configured
+var globalConsoleKMS kms.KMS
+
+// newConsoleKMS returns the KES server of the environment, nil when no endpoint is configured
+func newConsoleKMS() (kms.KMS, error) {
+	endpoints := getConsoleKMSKESEndpoints()
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+	rootCAs := GlobalRootCAs
+	if caPath := getConsoleKMSKESCAPath(); caPath != "" {
+		pem, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, err
+		}
+		if rootCAs != nil {
+			rootCAs = rootCAs.Clone()
+		} else if rootCAs, err = x509.SystemCertPool(); err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", caPath)
+		}
+	}
+	kes, err := kms.NewKES(kms.KESConfig{
+		Endpoints: endpoints,
+		KeyName:   getConsoleKMSKESKeyName(),
+		CertFile:  getConsoleKMSKESCertFile(),
+		KeyFile:   getConsoleKMSKESKeyFile(),
+		RootCAs:   rootCAs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return kes, nil
+}
+
+// configureConsoleKMS verifies the KMS of the environment is reachable and seals the session keys file with it.
+// Console doesn't start with a KMS it can't use, it couldn't unseal its secrets.
+func configureConsoleKMS() error {
+	k, err := newConsoleKMS()
+	if err != nil || k == nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), consoleKMSTimeout)
+	defer cancel()
+	if err := kms.Check(ctx, k); err != nil {
+		return err
+	}
+	globalConsoleKMS = k
+	auth.SetSessionKeysSealer(kms.NewSealer(k, consoleKMSSessionKeysContext, consoleKMSTimeout))
+	LogInfo("console secrets are sealed by %s", k)
+	return nil
+}
+
+// unsealConsoleSecret returns the plaintext of a secret sealed by the console KMS, secrets that aren't sealed are
+// returned as they are
+func unsealConsoleSecret(value, secretContext string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), consoleKMSTimeout)
+	defer cancel()
+	plaintext, err := kms.Unseal(ctx, globalConsoleKMS, value, []byte(secretContext))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// unsealOpenIDProviders returns the providers with their client secrets unsealed
+func unsealOpenIDProviders(providers oauth2.OpenIDPCfg) (oauth2.OpenIDPCfg, error) {
+	unsealed := oauth2.OpenIDPCfg{}
+	for name, provider := range providers {
+		secret, err := unsealConsoleSecret(provider.ClientSecret, consoleKMSOpenIDContext)
+		if err != nil {
+			return nil, fmt.Errorf("client secret of the OpenID provider %q: %w", name, err)
+		}
+		provider.ClientSecret = secret
+		unsealed[name] = provider
+	}
+	return unsealed, nil
+}
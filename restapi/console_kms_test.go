@@ -0,0 +1,151 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/console/pkg/kms"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+// testKMS encrypts with AES-GCM and a key in memory
+type testKMS struct {
+	aead cipher.AEAD
+}
+
+func newTestKMS(t *testing.T) *testKMS {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	assert.NoError(t, err)
+	block, err := aes.NewCipher(key)
+	assert.NoError(t, err)
+	aead, err := cipher.NewGCM(block)
+	assert.NoError(t, err)
+	return &testKMS{aead: aead}
+}
+
+func (k *testKMS) Encrypt(_ context.Context, plaintext, context []byte) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return k.aead.Seal(nonce, nonce, plaintext, context), nil
+}
+
+func (k *testKMS) Decrypt(_ context.Context, ciphertext, context []byte) ([]byte, error) {
+	if len(ciphertext) < k.aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	return k.aead.Open(nil, ciphertext[:k.aead.NonceSize()], ciphertext[k.aead.NonceSize():], context)
+}
+
+func (k *testKMS) String() string {
+	return "test"
+}
+
+// useTestKMS seals the console secrets with a test KMS until the end of the test
+func useTestKMS(t *testing.T) kms.KMS {
+	k := newTestKMS(t)
+	globalConsoleKMS = k
+	t.Cleanup(func() { globalConsoleKMS = nil })
+	return k
+}
+
+func sealConsoleSecret(t *testing.T, k kms.KMS, secret, secretContext string) string {
+	sealed, err := kms.Seal(context.Background(), k, []byte(secret), []byte(secretContext))
+	assert.NoError(t, err)
+	return sealed
+}
+
+func Test_configureConsoleKMS(t *testing.T) {
+	// without a KES endpoint the secrets aren't sealed
+	assert.NoError(t, configureConsoleKMS())
+	assert.Nil(t, globalConsoleKMS)
+
+	// the client certificate is required
+	os.Setenv(ConsoleKMSKESEndpoint, "https://kes:7373")
+	os.Setenv(ConsoleKMSKESKeyName, "console")
+	defer os.Unsetenv(ConsoleKMSKESEndpoint)
+	defer os.Unsetenv(ConsoleKMSKESKeyName)
+	assert.Error(t, configureConsoleKMS())
+	assert.Nil(t, globalConsoleKMS)
+}
+
+func Test_unsealOpenIDProviders(t *testing.T) {
+	assert := assert.New(t)
+	providers := oauth2.OpenIDPCfg{
+		"plain": {ClientID: "console", ClientSecret: "plain-secret"},
+	}
+
+	// sealed secrets can't be used without the KMS
+	k := newTestKMS(t)
+	providers["sealed"] = oauth2.ProviderConfig{ClientID: "console", ClientSecret: sealConsoleSecret(t, k, "sealed-secret", consoleKMSOpenIDContext)}
+	_, err := unsealOpenIDProviders(providers)
+	assert.ErrorIs(err, kms.ErrNoKMS)
+
+	globalConsoleKMS = k
+	defer func() { globalConsoleKMS = nil }()
+	unsealed, err := unsealOpenIDProviders(providers)
+	assert.NoError(err)
+	assert.Equal("plain-secret", unsealed["plain"].ClientSecret)
+	assert.Equal("sealed-secret", unsealed["sealed"].ClientSecret)
+	assert.Equal("console", unsealed["sealed"].ClientID)
+
+	// a secret sealed for another use doesn't unseal
+	providers["sealed"] = oauth2.ProviderConfig{ClientSecret: sealConsoleSecret(t, k, "sealed-secret", consoleKMSPBKDFContext)}
+	_, err = unsealOpenIDProviders(providers)
+	assert.Error(err)
+}
+
+func Test_configureSessionKeysSealed(t *testing.T) {
+	assert := assert.New(t)
+	// restore the keys of the environment
+	defer configureSessionKeys(time.Now())
+	defer func() {
+		os.Unsetenv(xjwt.ConsolePBKDFPassphrase)
+		os.Unsetenv(xjwt.ConsolePBKDFSalt)
+	}()
+	k := useTestKMS(t)
+
+	assert.NoError(auth.SetSessionKeys([]auth.SessionKey{{Passphrase: "passphrase", Salt: "salt"}}, time.Hour))
+	token, err := auth.NewEncryptedTokenForClient(&credentials.Value{AccessKeyID: "fakeAccessKeyID"}, "alice", nil)
+	assert.NoError(err)
+
+	// the sealed passphrase and salt derive the same key
+	os.Setenv(xjwt.ConsolePBKDFPassphrase, sealConsoleSecret(t, k, "passphrase", consoleKMSPBKDFContext))
+	os.Setenv(xjwt.ConsolePBKDFSalt, sealConsoleSecret(t, k, "salt", consoleKMSPBKDFContext))
+	assert.NoError(configureSessionKeys(time.Now()))
+	claims, err := auth.SessionTokenAuthenticate(token)
+	assert.NoError(err)
+	assert.Equal("alice", claims.AccountAccessKey)
+
+	// a salt sealed for another use is rejected
+	os.Setenv(xjwt.ConsolePBKDFSalt, sealConsoleSecret(t, k, "salt", consoleKMSOpenIDContext))
+	assert.Error(configureSessionKeys(time.Now()))
+}
@@ -0,0 +1,206 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// consoleMetricsPath serves the metrics on the metrics listener
+const consoleMetricsPath = "/metrics"
+
+// activeSessionsWindow is how recently a session made a request to be counted as active
+const activeSessionsWindow = 5 * time.Minute
+
+var (
+	consoleRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console",
+		Name:      "http_requests_total",
+		Help:      "API calls served, per operation and status code",
+	}, []string{"operation", "method", "code"})
+	consoleRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "console",
+		Name:      "http_request_duration_seconds",
+		Help:      "Time taken to serve the API calls, per operation",
+		Buckets:   []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"operation"})
+	consoleWebsockets = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "console",
+		Name:      "websockets_active",
+		Help:      "Websocket streams open, per stream",
+	}, []string{"stream"})
+	consoleUpstreamRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console",
+		Name:      "upstream_requests_total",
+		Help:      "Calls made to MinIO and the other services, per host and status code, error when no response was received",
+	}, []string{"host", "code"})
+	consoleCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console",
+		Name:      "cache_requests_total",
+		Help:      "Lookups in the caches of Console, per cache and result, hit or miss",
+	}, []string{"cache", "result"})
+
+	globalActiveSessions = &activeSessions{seen: map[string]time.Time{}}
+)
+
+// newConsoleMetricsRegistry returns the registry of the metrics of the console process
+func newConsoleMetricsRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		consoleRequests,
+		consoleRequestDuration,
+		consoleWebsockets,
+		consoleUpstreamRequests,
+		consoleCacheRequests,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: "console",
+			Name:      "sessions_active",
+			Help:      "Sessions that made a request in the last 5 minutes",
+		}, func() float64 {
+			return float64(globalActiveSessions.count(time.Now()))
+		}),
+	)
+	return registry
+}
+
+// activeSessions tracks when the sessions made their last request
+type activeSessions struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// touch records a request of the session
+func (s *activeSessions) touch(session string, now time.Time) {
+	if session == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen[session] = now
+}
+
+// count returns how many sessions made a request within activeSessionsWindow, forgetting the others
+func (s *activeSessions) count(now time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for session, last := range s.seen {
+		if now.Sub(last) > activeSessionsWindow {
+			delete(s.seen, session)
+		}
+	}
+	return len(s.seen)
+}
+
+// recordCacheLookup counts a lookup in one of the caches of Console
+func recordCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	consoleCacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+// trackWebsocket counts a websocket stream as open until the returned function is called
+func trackWebsocket(stream string) func() {
+	gauge := consoleWebsockets.WithLabelValues(stream)
+	gauge.Inc()
+	return gauge.Dec
+}
+
+// consoleMetricsMiddleware counts the API calls and their duration per operation
+func consoleMetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := middleware.MatchedRouteFrom(r)
+		if route == nil || route.Operation == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		m := httpsnoop.CaptureMetrics(next, w, r)
+		consoleRequests.WithLabelValues(route.Operation.ID, r.Method, strconv.Itoa(m.Code)).Inc()
+		consoleRequestDuration.WithLabelValues(route.Operation.ID).Observe(m.Duration.Seconds())
+	})
+}
+
+// upstreamMetricsTransport counts the calls sent through it per host and status code
+type upstreamMetricsTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t upstreamMetricsTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(r)
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	consoleUpstreamRequests.WithLabelValues(r.URL.Host, code).Inc()
+	return resp, err
+}
+
+// consoleMetricsHandler serves the metrics, to the requests bearing the token when one is configured
+func consoleMetricsHandler(registry *prometheus.Registry, token string) http.Handler {
+	metrics := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != consoleMetricsPath {
+			http.NotFound(w, r)
+			return
+		}
+		if token != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		metrics.ServeHTTP(w, r)
+	})
+}
+
+// StartMetricsServer serves the metrics of the console process on the listener of CONSOLE_METRICS_ADDRESS,
+// apart from the API. It does nothing without CONSOLE_METRICS_ADDRESS, the returned function stops it.
+func StartMetricsServer() (stop func() error, err error) {
+	address := getConsoleMetricsAddress()
+	if address == "" {
+		return func() error { return nil }, nil
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	server := &http.Server{
+		Handler:           consoleMetricsHandler(newConsoleMetricsRegistry(), getConsoleMetricsAuthToken()),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			LogError("metrics listener stopped: %v", err)
+		}
+	}()
+	LogInfo("serving the console metrics on %s%s", listener.Addr(), consoleMetricsPath)
+	return server.Close, nil
+}
@@ -0,0 +1,94 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActiveSessions(t *testing.T) {
+	sessions := &activeSessions{seen: map[string]time.Time{}}
+	now := time.Now()
+	sessions.touch("ACCESSKEY1", now.Add(-10*time.Minute))
+	sessions.touch("ACCESSKEY2", now.Add(-time.Minute))
+	sessions.touch("ACCESSKEY3", now)
+	sessions.touch("", now)
+	assert.Equal(t, 2, sessions.count(now))
+	assert.Len(t, sessions.seen, 2, "expected the stale sessions to be forgotten")
+	assert.Equal(t, 1, sessions.count(now.Add(4*time.Minute+30*time.Second)))
+}
+
+func TestConsoleMetricsHandler(t *testing.T) {
+	recordCacheLookup("grants", true)
+	recordCacheLookup("grants", false)
+	handler := consoleMetricsHandler(newConsoleMetricsRegistry(), "secret")
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "no token", path: "/metrics", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", path: "/metrics", authorization: "Bearer wrong", wantStatus: http.StatusUnauthorized},
+		{name: "other path", path: "/api/v1/session", authorization: "Bearer secret", wantStatus: http.StatusNotFound},
+		{name: "valid token", path: "/metrics", authorization: "Bearer secret", wantStatus: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				body := w.Body.String()
+				for _, want := range []string{`console_cache_requests_total{cache="grants",result="hit"}`, "console_sessions_active", "go_goroutines"} {
+					assert.True(t, strings.Contains(body, want), "expected the metrics to contain %s", want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpstreamMetricsTransport(t *testing.T) {
+	minio := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer minio.Close()
+	host := strings.TrimPrefix(minio.URL, "http://")
+
+	client := &http.Client{Transport: upstreamMetricsTransport{base: http.DefaultTransport}}
+	resp, err := client.Get(minio.URL + "/minio/admin/v3/info")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	minio.Close()
+	_, err = client.Get(minio.URL + "/minio/admin/v3/info")
+	assert.Error(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(consoleUpstreamRequests.WithLabelValues(host, "403")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(consoleUpstreamRequests.WithLabelValues(host, "error")))
+}
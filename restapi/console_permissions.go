@@ -0,0 +1,406 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	errorsApi "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// console feature areas a session can be granted
+const (
+	consoleAreaDiagnostics   = "diagnostics"
+	consoleAreaIAM           = "iam"
+	consoleAreaTiering       = "tiering"
+	consoleAreaSubnet        = "subnet"
+	consoleAreaObjectBrowser = "object-browser"
+)
+
+// consoleGrantsCacheTTL is how long the grants derived from the policy of a session are reused
+const consoleGrantsCacheTTL = time.Minute
+
+// consoleArea describes the operations a feature area covers and the MinIO actions granting it
+type consoleArea struct {
+	name string
+	// tags of the operations in the area
+	tags []string
+	// operations in the area among the ones of a tag shared with other operations, such as System
+	operations []string
+	// admin actions on the console resource, any of them grants the area
+	adminActions []string
+	// actions on any resource, any of them grants the area
+	resourceActions []string
+}
+
+var consoleAreas = []consoleArea{
+	{
+		name: consoleAreaDiagnostics,
+		tags: []string{"Profile", "Inspect", "Logging"},
+		operations: []string{
+			"GetTraceStats",
+			"GetTopLocks",
+			"StartHeal",
+			"GetBackgroundHealStatus",
+			"GetDriveTopology",
+			"GetDrivesHealth",
+		},
+		adminActions: []string{
+			iampolicy.HealthInfoAdminAction,
+			iampolicy.ProfilingAdminAction,
+			iampolicy.TraceAdminAction,
+			iampolicy.ConsoleLogAdminAction,
+			iampolicy.InspectDataAction,
+			iampolicy.HealAdminAction,
+			iampolicy.TopLocksAdminAction,
+		},
+	},
+	{
+		name: consoleAreaIAM,
+		tags: []string{"User", "Group", "Policy", "idp"},
+		adminActions: []string{
+			iampolicy.ListUsersAdminAction,
+			iampolicy.CreateUserAdminAction,
+			iampolicy.ListGroupsAdminAction,
+			iampolicy.GetPolicyAdminAction,
+			iampolicy.CreatePolicyAdminAction,
+		},
+	},
+	{
+		name:         consoleAreaTiering,
+		tags:         []string{"Tiering"},
+		adminActions: []string{iampolicy.ListTierAction, iampolicy.SetTierAction},
+	},
+	{
+		name:         consoleAreaSubnet,
+		tags:         []string{"Subnet", "Support"},
+		adminActions: []string{iampolicy.ConfigUpdateAdminAction},
+	},
+	{
+		name: consoleAreaObjectBrowser,
+		tags: []string{"Object"},
+		resourceActions: []string{
+			string(iampolicy.ListBucketAction),
+			string(iampolicy.GetObjectAction),
+			string(iampolicy.PutObjectAction),
+		},
+	},
+}
+
+// consoleAreaExemptOperations are the operations in an area every session can use on itself
+var consoleAreaExemptOperations = map[string]bool{
+	"GetUserPolicy": true,
+}
+
+// consoleAreaWebsockets maps the websocket paths to the area they belong to
+var consoleAreaWebsockets = map[string]string{
+	"/trace":         consoleAreaDiagnostics,
+	"/console":       consoleAreaDiagnostics,
+	"/health-info":   consoleAreaDiagnostics,
+	"/speedtest":     consoleAreaDiagnostics,
+	"/profile":       consoleAreaDiagnostics,
+	"/objectManager": consoleAreaObjectBrowser,
+}
+
+// operationConsoleArea returns the area of the operation, empty when it doesn't belong to any
+func operationConsoleArea(operation string, tags []string) string {
+	if consoleAreaExemptOperations[operation] {
+		return ""
+	}
+	for _, area := range consoleAreas {
+		for _, areaOperation := range area.operations {
+			if operation == areaOperation {
+				return area.name
+			}
+		}
+		for _, tag := range tags {
+			for _, areaTag := range area.tags {
+				if tag == areaTag {
+					return area.name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// websocketConsoleArea returns the area of the websocket path, empty when it doesn't belong to any
+func websocketConsoleArea(wsPath string) string {
+	for prefix, area := range consoleAreaWebsockets {
+		if strings.HasPrefix(wsPath, prefix) {
+			return area
+		}
+	}
+	return ""
+}
+
+// actionAllowed returns whether any of the granted actions, which can end with a wildcard, matches action
+func actionAllowed(granted []string, action string) bool {
+	for _, grant := range granted {
+		if grant == action || grant == "*" {
+			return true
+		}
+		if strings.HasSuffix(grant, "*") && strings.HasPrefix(action, strings.TrimSuffix(grant, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// consoleGrantsFromPermissions derives the areas granted by the session permissions
+func consoleGrantsFromPermissions(permissions map[string][]string) []string {
+	grants := []string{}
+	for _, area := range consoleAreas {
+		granted := false
+		for _, action := range area.adminActions {
+			if actionAllowed(permissions[ConsoleResourceName], action) {
+				granted = true
+				break
+			}
+		}
+		for _, action := range area.resourceActions {
+			if granted {
+				break
+			}
+			for _, actions := range permissions {
+				if actionAllowed(actions, action) {
+					granted = true
+					break
+				}
+			}
+		}
+		if granted {
+			grants = append(grants, area.name)
+		}
+	}
+	return grants
+}
+
+// consoleRoles assigns the areas of named roles to users, the "*" user applies to everyone not listed
+type consoleRoles struct {
+	Roles map[string][]string `json:"roles"`
+	Users map[string][]string `json:"users"`
+}
+
+// loadConsoleRoles reads the roles file, every role must only list known areas and every user known roles
+func loadConsoleRoles(path string) (*consoleRoles, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var roles consoleRoles
+	if err := json.Unmarshal(data, &roles); err != nil {
+		return nil, fmt.Errorf("invalid console roles file %s: %v", path, err)
+	}
+	for role, areas := range roles.Roles {
+		for _, name := range areas {
+			if !isConsoleArea(name) {
+				return nil, fmt.Errorf("role %s grants the unknown area %s", role, name)
+			}
+		}
+	}
+	for user, userRoles := range roles.Users {
+		for _, role := range userRoles {
+			if _, ok := roles.Roles[role]; !ok {
+				return nil, fmt.Errorf("user %s is assigned the unknown role %s", user, role)
+			}
+		}
+	}
+	return &roles, nil
+}
+
+func isConsoleArea(name string) bool {
+	for _, area := range consoleAreas {
+		if area.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// grants returns the areas the roles of user grant, false when the user is not assigned any role
+func (c *consoleRoles) grants(user string) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	userRoles, ok := c.Users[user]
+	if !ok || user == "" {
+		userRoles, ok = c.Users["*"]
+	}
+	if !ok {
+		return nil, false
+	}
+	set := map[string]bool{}
+	for _, role := range userRoles {
+		for _, area := range c.Roles[role] {
+			set[area] = true
+		}
+	}
+	grants := []string{}
+	for area := range set {
+		grants = append(grants, area)
+	}
+	sort.Strings(grants)
+	return grants, true
+}
+
+// globalConsoleRoles are the roles loaded from the configured file, nil derives the grants from the policies
+var globalConsoleRoles *consoleRoles
+
+// sessionConsoleGrants returns the areas granted to the session, from its roles when it is assigned any or
+// from its permissions otherwise
+func sessionConsoleGrants(session *models.Principal, permissions map[string][]string) []string {
+	if grants, ok := globalConsoleRoles.grants(session.AccountAccessKey); ok {
+		return grants
+	}
+	return consoleGrantsFromPermissions(permissions)
+}
+
+type consoleGrantsEntry struct {
+	grants  []string
+	expires time.Time
+}
+
+// consoleGrantsCache keeps the grants of the recent sessions so the policy is not evaluated on every request
+type consoleGrantsCache struct {
+	mu      sync.Mutex
+	entries map[string]consoleGrantsEntry
+}
+
+func (c *consoleGrantsCache) get(key string, now time.Time) ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || now.After(entry.expires) {
+		return nil, false
+	}
+	return entry.grants, true
+}
+
+func (c *consoleGrantsCache) put(key string, grants []string, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[string]consoleGrantsEntry{}
+	}
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = consoleGrantsEntry{grants: grants, expires: now.Add(consoleGrantsCacheTTL)}
+}
+
+var globalConsoleGrants consoleGrantsCache
+
+// consolePermissionError is returned when a session lacks the area of an operation, Permission names it so
+// the UI can tell what to hide
+type consolePermissionError struct {
+	Permission string
+}
+
+func (e *consolePermissionError) Error() string {
+	return fmt.Sprintf("the %s console permission is required", e.Permission)
+}
+
+// Code implements errors.Error
+func (e *consolePermissionError) Code() int32 {
+	return http.StatusForbidden
+}
+
+// checkConsoleArea returns a consolePermissionError when the session is not granted the area
+func checkConsoleArea(ctx context.Context, session *models.Principal, area string) error {
+	grants, ok := globalConsoleRoles.grants(session.AccountAccessKey)
+	if !ok {
+		now := time.Now()
+		// the same credentials have other policies on the clusters the session switches to
+		key := session.ClusterID + "/" + session.STSAccessKeyID
+		grants, ok = globalConsoleGrants.get(key, now)
+		recordCacheLookup("grants", ok)
+		if !ok {
+			sessionResp, err := getSessionResponse(ctx, session)
+			if err != nil {
+				return errorsApi.New(err.Code, swag.StringValue(err.Message))
+			}
+			grants = sessionResp.ConsoleGrants
+			globalConsoleGrants.put(key, grants, now)
+		}
+	}
+	for _, grant := range grants {
+		if grant == area {
+			return nil
+		}
+	}
+	return &consolePermissionError{Permission: area}
+}
+
+// consolePermissionsAuthorizer is a runtime.Authorizer that only lets sessions use the operations of the
+// areas they are granted, every other operation goes to next
+type consolePermissionsAuthorizer struct {
+	next runtime.Authorizer
+}
+
+// Authorize implements runtime.Authorizer
+func (a consolePermissionsAuthorizer) Authorize(r *http.Request, principal interface{}) error {
+	if session, ok := principal.(*models.Principal); ok && session != nil && session.STSAccessKeyID != "" {
+		route := middleware.MatchedRouteFrom(r)
+		if route != nil && route.Operation != nil {
+			if area := operationConsoleArea(route.Operation.ID, route.Operation.Tags); area != "" {
+				if err := checkConsoleArea(r.Context(), session, area); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if a.next == nil {
+		return nil
+	}
+	return a.next.Authorize(r, principal)
+}
+
+// serveConsoleError writes the missing console permission along the error, other errors are served as usual
+func serveConsoleError(w http.ResponseWriter, r *http.Request, err error) {
+	permissionErr, ok := err.(*consolePermissionError)
+	if !ok {
+		errorsApi.ServeError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	if r != nil && r.Method == http.MethodHead {
+		return
+	}
+	json.NewEncoder(w).Encode(&models.Error{
+		Code:              http.StatusForbidden,
+		Message:           swag.String(permissionErr.Error()),
+		DetailedMessage:   swag.String(permissionErr.Error()),
+		MissingPermission: permissionErr.Permission,
+	})
+}
@@ -0,0 +1,230 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_operationConsoleArea(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(consoleAreaIAM, operationConsoleArea("ListUsers", []string{"User"}))
+	assert.Equal(consoleAreaTiering, operationConsoleArea("AddTier", []string{"Tiering"}))
+	assert.Equal(consoleAreaObjectBrowser, operationConsoleArea("ListObjects", []string{"Object"}))
+	assert.Equal(consoleAreaSubnet, operationConsoleArea("SubnetInfo", []string{"Subnet"}))
+	assert.Equal(consoleAreaDiagnostics, operationConsoleArea("Inspect", []string{"Inspect"}))
+	assert.Equal("", operationConsoleArea("ListBuckets", []string{"Bucket"}))
+	// every session reads its own policy
+	assert.Equal("", operationConsoleArea("GetUserPolicy", []string{"Policy"}))
+	assert.Equal(consoleAreaDiagnostics, operationConsoleArea("GetTopLocks", []string{"System"}))
+	assert.Equal("", operationConsoleArea("AdminInfo", []string{"System"}))
+
+	assert.Equal(consoleAreaDiagnostics, websocketConsoleArea("/trace"))
+	assert.Equal(consoleAreaObjectBrowser, websocketConsoleArea("/objectManager"))
+	assert.Equal("", websocketConsoleArea("/watch/photos"))
+}
+
+func Test_operationConsoleAreaSystemOperations(t *testing.T) {
+	assert := assert.New(t)
+	swaggerSpec, err := loads.Analyzed(SwaggerJSON, "")
+	assert.NoError(err)
+
+	// the System operations every session can use, a new System operation belongs to an area or is listed here
+	systemOperations := map[string]bool{
+		"CheckMinIOVersion":      true,
+		"GetPreflightReport":     true,
+		"AdminInfo":              true,
+		"DashboardWidgetDetails": true,
+		"ArnList":                true,
+		"GetRebalanceStatus":     true,
+		"StartRebalance":         true,
+		"StopRebalance":          true,
+		"ListPools":              true,
+		"GetPoolStatus":          true,
+		"StartPoolDecommission":  true,
+		"CancelPoolDecommission": true,
+		"GetMetricsDashboard":    true,
+		"GetScannerStatus":       true,
+		"RefreshDataUsage":       true,
+		"GetAlerts":              true,
+		"EvaluateAlerts":         true,
+		"ListAlertRules":         true,
+		"SetAlertRule":           true,
+		"DeleteAlertRule":        true,
+		"ListClusters":           true,
+		"SetCluster":             true,
+		"DeleteCluster":          true,
+		"GetTLSCertificates":     true,
+		"ListCSPReports":         true,
+		"ListNodes":              true,
+		"Batch":                  true,
+	}
+	diagnostics := map[string]bool{}
+	for _, operations := range swaggerSpec.Analyzer.Operations() {
+		for _, operation := range operations {
+			if !swag.ContainsStrings(operation.Tags, "System") {
+				continue
+			}
+			area := operationConsoleArea(operation.ID, operation.Tags)
+			if area == "" {
+				assert.True(systemOperations[operation.ID], "System operation %s isn't in an area", operation.ID)
+				continue
+			}
+			assert.Equal(consoleAreaDiagnostics, area, operation.ID)
+			assert.False(systemOperations[operation.ID], operation.ID)
+			diagnostics[operation.ID] = true
+		}
+	}
+	assert.Equal(map[string]bool{
+		"GetTraceStats":           true,
+		"GetTopLocks":             true,
+		"StartHeal":               true,
+		"GetBackgroundHealStatus": true,
+		"GetDriveTopology":        true,
+		"GetDrivesHealth":         true,
+	}, diagnostics)
+}
+
+func Test_consoleGrantsFromPermissions(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{}, consoleGrantsFromPermissions(map[string][]string{}))
+	assert.Equal([]string{consoleAreaDiagnostics, consoleAreaIAM, consoleAreaTiering, consoleAreaSubnet, consoleAreaObjectBrowser},
+		consoleGrantsFromPermissions(map[string][]string{
+			ConsoleResourceName: {"admin:*"},
+			"arn:aws:s3:::*":    {"s3:*"},
+		}))
+	assert.Equal([]string{consoleAreaDiagnostics, consoleAreaObjectBrowser},
+		consoleGrantsFromPermissions(map[string][]string{
+			ConsoleResourceName:        {"admin:ServerTrace"},
+			"arn:aws:s3:::photos/*":    {"s3:Get*"},
+			"arn:aws:s3:::documents/*": {"s3:DeleteObject"},
+		}))
+}
+
+func Test_loadConsoleRoles(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	write := func(content string) string {
+		path := filepath.Join(dir, "roles.json")
+		assert.NoError(os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	_, err := loadConsoleRoles(write(`{"roles": {"auditor": ["billing"]}}`))
+	assert.Error(err)
+	_, err = loadConsoleRoles(write(`{"roles": {"auditor": ["diagnostics"]}, "users": {"alice": ["admin"]}}`))
+	assert.Error(err)
+	_, err = loadConsoleRoles(filepath.Join(dir, "missing.json"))
+	assert.Error(err)
+
+	roles, err := loadConsoleRoles(write(`{
+		"roles": {"auditor": ["diagnostics"], "storage": ["tiering", "object-browser"]},
+		"users": {"alice": ["auditor", "storage"], "*": ["storage"]}
+	}`))
+	assert.NoError(err)
+	grants, ok := roles.grants("alice")
+	assert.True(ok)
+	assert.Equal([]string{consoleAreaDiagnostics, consoleAreaObjectBrowser, consoleAreaTiering}, grants)
+	grants, ok = roles.grants("bob")
+	assert.True(ok)
+	assert.Equal([]string{consoleAreaObjectBrowser, consoleAreaTiering}, grants)
+
+	delete(roles.Users, "*")
+	_, ok = roles.grants("bob")
+	assert.False(ok)
+
+	var none *consoleRoles
+	_, ok = none.grants("alice")
+	assert.False(ok)
+}
+
+func Test_checkConsoleArea(t *testing.T) {
+	assert := assert.New(t)
+	defer func() { globalConsoleRoles = nil }()
+
+	globalConsoleRoles = &consoleRoles{
+		Roles: map[string][]string{"auditor": {consoleAreaDiagnostics}},
+		Users: map[string][]string{"alice": {"auditor"}},
+	}
+	session := &models.Principal{AccountAccessKey: "alice", STSAccessKeyID: "ALICE"}
+	assert.NoError(checkConsoleArea(context.Background(), session, consoleAreaDiagnostics))
+
+	err := checkConsoleArea(context.Background(), session, consoleAreaIAM)
+	var permissionErr *consolePermissionError
+	assert.True(errors.As(err, &permissionErr))
+	assert.Equal(consoleAreaIAM, permissionErr.Permission)
+	assert.Equal(int32(http.StatusForbidden), permissionErr.Code())
+
+	// sessions without roles use the grants derived from their policy
+	now := time.Now()
+	// the grants are cached for the cluster of the session, the login cluster has no ID
+	globalConsoleGrants.put("/BOB", []string{consoleAreaIAM}, now)
+	session = &models.Principal{AccountAccessKey: "bob", STSAccessKeyID: "BOB"}
+	assert.NoError(checkConsoleArea(context.Background(), session, consoleAreaIAM))
+	assert.Error(checkConsoleArea(context.Background(), session, consoleAreaTiering))
+}
+
+func Test_consoleGrantsCache(t *testing.T) {
+	assert := assert.New(t)
+	var cache consoleGrantsCache
+	now := time.Now()
+
+	_, ok := cache.get("KEY", now)
+	assert.False(ok)
+	cache.put("KEY", []string{consoleAreaIAM}, now)
+	grants, ok := cache.get("KEY", now.Add(consoleGrantsCacheTTL/2))
+	assert.True(ok)
+	assert.Equal([]string{consoleAreaIAM}, grants)
+	_, ok = cache.get("KEY", now.Add(2*consoleGrantsCacheTTL))
+	assert.False(ok)
+
+	cache.put("OTHER", nil, now.Add(2*consoleGrantsCacheTTL))
+	assert.Len(cache.entries, 1)
+}
+
+func Test_serveConsoleError(t *testing.T) {
+	assert := assert.New(t)
+
+	w := httptest.NewRecorder()
+	serveConsoleError(w, httptest.NewRequest(http.MethodGet, "/api/v1/users", nil), &consolePermissionError{Permission: consoleAreaIAM})
+	assert.Equal(http.StatusForbidden, w.Code)
+	var apiErr models.Error
+	assert.NoError(json.NewDecoder(w.Body).Decode(&apiErr))
+	assert.Equal(consoleAreaIAM, apiErr.MissingPermission)
+	assert.Equal(int32(http.StatusForbidden), apiErr.Code)
+
+	// other errors are served without a missing permission
+	w = httptest.NewRecorder()
+	serveConsoleError(w, httptest.NewRequest(http.MethodGet, "/api/v1/users", nil), errors.New("boom"))
+	assert.Equal(http.StatusInternalServerError, w.Code)
+	assert.NotContains(w.Body.String(), "missingPermission")
+}
@@ -30,10 +30,13 @@ const (
 	ConsoleSecureAllowedHosts                    = "CONSOLE_SECURE_ALLOWED_HOSTS"
 	ConsoleSecureAllowedHostsAreRegex            = "CONSOLE_SECURE_ALLOWED_HOSTS_ARE_REGEX"
 	ConsoleSecureFrameDeny                       = "CONSOLE_SECURE_FRAME_DENY"
+	ConsoleSecureFrameOptions                    = "CONSOLE_SECURE_FRAME_OPTIONS"
 	ConsoleSecureContentTypeNoSniff              = "CONSOLE_SECURE_CONTENT_TYPE_NO_SNIFF"
 	ConsoleSecureBrowserXSSFilter                = "CONSOLE_SECURE_BROWSER_XSS_FILTER"
 	ConsoleSecureContentSecurityPolicy           = "CONSOLE_SECURE_CONTENT_SECURITY_POLICY"
 	ConsoleSecureContentSecurityPolicyReportOnly = "CONSOLE_SECURE_CONTENT_SECURITY_POLICY_REPORT_ONLY"
+	ConsoleSecureContentSecurityPolicyMode       = "CONSOLE_SECURE_CONTENT_SECURITY_POLICY_MODE"
+	ConsoleSecureContentSecurityPolicyReports    = "CONSOLE_SECURE_CONTENT_SECURITY_POLICY_REPORTS"
 	ConsoleSecureHostsProxyHeaders               = "CONSOLE_SECURE_HOSTS_PROXY_HEADERS"
 	ConsoleSecureSTSSeconds                      = "CONSOLE_SECURE_STS_SECONDS"
 	ConsoleSecureSTSIncludeSubdomains            = "CONSOLE_SECURE_STS_INCLUDE_SUB_DOMAINS"
@@ -49,12 +52,118 @@ const (
 	PrometheusURL                                = "CONSOLE_PROMETHEUS_URL"
 	PrometheusJobID                              = "CONSOLE_PROMETHEUS_JOB_ID"
 	PrometheusExtraLabels                        = "CONSOLE_PROMETHEUS_EXTRA_LABELS"
+	PrometheusAuthToken                          = "CONSOLE_PROMETHEUS_AUTH_TOKEN"
+	PrometheusUsername                           = "CONSOLE_PROMETHEUS_USERNAME"
+	PrometheusPassword                           = "CONSOLE_PROMETHEUS_PASSWORD"
 	ConsoleLogQueryURL                           = "CONSOLE_LOG_QUERY_URL"
 	ConsoleLogQueryAuthToken                     = "CONSOLE_LOG_QUERY_AUTH_TOKEN"
 	ConsoleMaxConcurrentUploads                  = "CONSOLE_MAX_CONCURRENT_UPLOADS"
 	ConsoleMaxConcurrentDownloads                = "CONSOLE_MAX_CONCURRENT_DOWNLOADS"
 	ConsoleDevMode                               = "CONSOLE_DEV_MODE"
 	ConsoleAnimatedLogin                         = "CONSOLE_ANIMATED_LOGIN"
+	ConsoleAnonymousBrowsing                     = "CONSOLE_ANONYMOUS_BROWSING"
+	ConsoleReplayMode                            = "CONSOLE_REPLAY_MODE"
+	ConsoleReplayFile                            = "CONSOLE_REPLAY_FILE"
+	ConsoleQuotaWebhookEndpoint                  = "CONSOLE_QUOTA_WEBHOOK_ENDPOINT"
+	ConsoleQuotaWebhookAuthToken                 = "CONSOLE_QUOTA_WEBHOOK_AUTH_TOKEN"
+	ConsoleAuthzWebhookEndpoint                  = "CONSOLE_AUTHZ_WEBHOOK_ENDPOINT"
+	ConsoleAuthzWebhookAuthToken                 = "CONSOLE_AUTHZ_WEBHOOK_AUTH_TOKEN"
+	ConsoleAuthzWebhookOperations                = "CONSOLE_AUTHZ_WEBHOOK_OPERATIONS"
+	ConsoleAuthzWebhookFailOpen                  = "CONSOLE_AUTHZ_WEBHOOK_FAIL_OPEN"
+	ConsoleTrustedProxies                        = "CONSOLE_TRUSTED_PROXIES"
+	ConsoleRealIPHeaders                         = "CONSOLE_REAL_IP_HEADERS"
+	ConsolePreflightStrict                       = "CONSOLE_PREFLIGHT_STRICT"
+	ConsoleUsageHistoryFile                      = "CONSOLE_USAGE_HISTORY_FILE"
+	ConsoleUsageHistoryInterval                  = "CONSOLE_USAGE_HISTORY_INTERVAL"
+	ConsoleUsageHistoryRetention                 = "CONSOLE_USAGE_HISTORY_RETENTION"
+	ConsoleCredentialsWebhookEndpoint            = "CONSOLE_CREDENTIALS_WEBHOOK_ENDPOINT"
+	ConsoleCredentialsWebhookAuthToken           = "CONSOLE_CREDENTIALS_WEBHOOK_AUTH_TOKEN"
+	ConsolePasswordMinLength                     = "CONSOLE_PASSWORD_MIN_LENGTH"
+	ConsolePasswordRequireUppercase              = "CONSOLE_PASSWORD_REQUIRE_UPPERCASE"
+	ConsolePasswordRequireLowercase              = "CONSOLE_PASSWORD_REQUIRE_LOWERCASE"
+	ConsolePasswordRequireDigit                  = "CONSOLE_PASSWORD_REQUIRE_DIGIT"
+	ConsolePasswordRequireSymbol                 = "CONSOLE_PASSWORD_REQUIRE_SYMBOL"
+	ConsolePasswordHistory                       = "CONSOLE_PASSWORD_HISTORY"
+	ConsolePasswordStateFile                     = "CONSOLE_PASSWORD_STATE_FILE"
+	ConsoleLoginLockoutThreshold                 = "CONSOLE_LOGIN_LOCKOUT_THRESHOLD"
+	ConsoleLoginLockoutIPThreshold               = "CONSOLE_LOGIN_LOCKOUT_IP_THRESHOLD"
+	ConsoleLoginLockoutWindow                    = "CONSOLE_LOGIN_LOCKOUT_WINDOW"
+	ConsoleLoginLockoutDuration                  = "CONSOLE_LOGIN_LOCKOUT_DURATION"
+	ConsoleLoginAttemptsFile                     = "CONSOLE_LOGIN_ATTEMPTS_FILE"
+	ConsoleTwoFactorFile                         = "CONSOLE_TWO_FACTOR_FILE"
+	ConsoleTwoFactorIssuer                       = "CONSOLE_TWO_FACTOR_ISSUER"
+	ConsoleSessionIdleTimeout                    = "CONSOLE_SESSION_IDLE_TIMEOUT"
+	ConsoleSessionMaxLifetime                    = "CONSOLE_SESSION_MAX_LIFETIME"
+	ConsoleAPITokensFile                         = "CONSOLE_API_TOKENS_FILE"
+	ConsoleAPITokenMaxExpiry                     = "CONSOLE_API_TOKEN_MAX_EXPIRY"
+	ConsoleRolesFile                             = "CONSOLE_ROLES_FILE"
+	ConsoleLoginRateLimitIP                      = "CONSOLE_LOGIN_RATE_LIMIT_IP"
+	ConsoleLoginRateLimitAccessKey               = "CONSOLE_LOGIN_RATE_LIMIT_ACCESS_KEY"
+	ConsoleLoginRateLimitWindow                  = "CONSOLE_LOGIN_RATE_LIMIT_WINDOW"
+	ConsoleLoginBackoffBase                      = "CONSOLE_LOGIN_BACKOFF_BASE"
+	ConsoleLoginBackoffMax                       = "CONSOLE_LOGIN_BACKOFF_MAX"
+	ConsoleLoginCaptchaThreshold                 = "CONSOLE_LOGIN_CAPTCHA_THRESHOLD"
+	ConsoleLoginCaptchaVerifyURL                 = "CONSOLE_LOGIN_CAPTCHA_VERIFY_URL"
+	ConsoleLoginCaptchaSecret                    = "CONSOLE_LOGIN_CAPTCHA_SECRET"
+	ConsoleActionAuditHistory                    = "CONSOLE_ACTION_AUDIT_HISTORY"
+	ConsoleActionAuditFile                       = "CONSOLE_ACTION_AUDIT_FILE"
+	ConsoleActionAuditWebhookEndpoint            = "CONSOLE_ACTION_AUDIT_WEBHOOK_ENDPOINT"
+	ConsoleActionAuditWebhookAuthToken           = "CONSOLE_ACTION_AUDIT_WEBHOOK_AUTH_TOKEN"
+	ConsoleActionAuditKafkaRESTURL               = "CONSOLE_ACTION_AUDIT_KAFKA_REST_URL"
+	ConsoleActionAuditKafkaTopic                 = "CONSOLE_ACTION_AUDIT_KAFKA_TOPIC"
+	ConsoleSessionStoreURL                       = "CONSOLE_SESSION_STORE_URL"
+	ConsoleKMSKESEndpoint                        = "CONSOLE_KMS_KES_ENDPOINT"
+	ConsoleKMSKESKeyName                         = "CONSOLE_KMS_KES_KEY_NAME"
+	ConsoleKMSKESCertFile                        = "CONSOLE_KMS_KES_CERT_FILE"
+	ConsoleKMSKESKeyFile                         = "CONSOLE_KMS_KES_KEY_FILE"
+	ConsoleKMSKESCAPath                          = "CONSOLE_KMS_KES_CAPATH"
+	ConsoleConfigHistoryFile                     = "CONSOLE_CONFIG_HISTORY_FILE"
+	ConsoleConfigHistoryLimit                    = "CONSOLE_CONFIG_HISTORY_LIMIT"
+	ConsoleDiagnosticsBucket                     = "CONSOLE_DIAGNOSTICS_BUCKET"
+	ConsoleProfilingMaxDuration                  = "CONSOLE_PROFILING_MAX_DURATION"
+	ConsoleAlertingAccessKey                     = "CONSOLE_ALERTING_ACCESS_KEY"
+	ConsoleAlertingSecretKey                     = "CONSOLE_ALERTING_SECRET_KEY"
+	ConsoleAlertingInterval                      = "CONSOLE_ALERTING_INTERVAL"
+	ConsoleAlertingRulesFile                     = "CONSOLE_ALERTING_RULES_FILE"
+	ConsoleAlertingWebhookEndpoint               = "CONSOLE_ALERTING_WEBHOOK_ENDPOINT"
+	ConsoleAlertingWebhookAuthToken              = "CONSOLE_ALERTING_WEBHOOK_AUTH_TOKEN"
+	ConsoleAlertingSlackWebhookURL               = "CONSOLE_ALERTING_SLACK_WEBHOOK_URL"
+	ConsoleAlertingSMTPServer                    = "CONSOLE_ALERTING_SMTP_SERVER"
+	ConsoleAlertingSMTPUsername                  = "CONSOLE_ALERTING_SMTP_USERNAME"
+	ConsoleAlertingSMTPPassword                  = "CONSOLE_ALERTING_SMTP_PASSWORD"
+	ConsoleAlertingEmailFrom                     = "CONSOLE_ALERTING_EMAIL_FROM"
+	ConsoleAlertingEmailTo                       = "CONSOLE_ALERTING_EMAIL_TO"
+	ConsoleClustersFile                          = "CONSOLE_CLUSTERS_FILE"
+	ConsoleACMEDomains                           = "CONSOLE_ACME_DOMAINS"
+	ConsoleACMEEmail                             = "CONSOLE_ACME_EMAIL"
+	ConsoleACMEDirectoryURL                      = "CONSOLE_ACME_DIRECTORY_URL"
+	ConsoleACMEChallenge                         = "CONSOLE_ACME_CHALLENGE"
+	ConsoleACMEDNSProvider                       = "CONSOLE_ACME_DNS_PROVIDER"
+	ConsoleACMEDNSProviderConfig                 = "CONSOLE_ACME_DNS_PROVIDER_CONFIG"
+	ConsoleACMERenewBefore                       = "CONSOLE_ACME_RENEW_BEFORE"
+	ConsoleMTLS                                  = "CONSOLE_MTLS"
+	ConsoleMTLSCAFile                            = "CONSOLE_MTLS_CA_FILE"
+	ConsoleMTLSMappingsFile                      = "CONSOLE_MTLS_MAPPINGS_FILE"
+	ConsoleMTLSAccessKey                         = "CONSOLE_MTLS_ACCESS_KEY"
+	ConsoleMTLSSecretKey                         = "CONSOLE_MTLS_SECRET_KEY"
+	ConsoleRateLimit                             = "CONSOLE_RATE_LIMIT"
+	ConsoleRateLimitListing                      = "CONSOLE_RATE_LIMIT_LISTING"
+	ConsoleRateLimitDownload                     = "CONSOLE_RATE_LIMIT_DOWNLOAD"
+	ConsoleRateLimitAdmin                        = "CONSOLE_RATE_LIMIT_ADMIN"
+	ConsoleConcurrencyZipDownload                = "CONSOLE_CONCURRENCY_ZIP_DOWNLOAD"
+	ConsoleConcurrencySpeedtest                  = "CONSOLE_CONCURRENCY_SPEEDTEST"
+	ConsoleOTelEndpoint                          = "CONSOLE_OTEL_ENDPOINT"
+	ConsoleOTelHeaders                           = "CONSOLE_OTEL_HEADERS"
+	ConsoleOTelSampleRatio                       = "CONSOLE_OTEL_SAMPLE_RATIO"
+	ConsoleMetricsAddress                        = "CONSOLE_METRICS_ADDRESS"
+	ConsoleMetricsAuthToken                      = "CONSOLE_METRICS_AUTH_TOKEN"
+	ConsoleAdminCacheTTL                         = "CONSOLE_ADMIN_CACHE_TTL"
+	ConsoleListPageSize                          = "CONSOLE_LIST_PAGE_SIZE"
+	ConsoleListMaxPageSize                       = "CONSOLE_LIST_MAX_PAGE_SIZE"
+	ConsoleConfigFile                            = "CONSOLE_CONFIG_FILE"
+	ConsoleSocketPath                            = "CONSOLE_SOCKET_PATH"
+	ConsoleSocketMode                            = "CONSOLE_SOCKET_MODE"
+	ConsoleSocketGroup                           = "CONSOLE_SOCKET_GROUP"
 	LogSearchQueryAuthToken                      = "LOGSEARCH_QUERY_AUTH_TOKEN"
 	SlashSeparator                               = "/"
 )
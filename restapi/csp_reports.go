@@ -0,0 +1,150 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/cspreport"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	cspModeEnforce    = "enforce"
+	cspModeReportOnly = "report-only"
+
+	// cspReportPath receives the violation reports, it takes no session since browsers send no cookie
+	cspReportPath = "/api/v1/csp-report"
+	// cspReportsKept is how many of the most recent reports are kept
+	cspReportsKept = 200
+)
+
+var globalCSPReports = cspreport.NewStore(cspReportsKept)
+
+func registerCSPReportsHandlers(api *operations.ConsoleAPI) {
+	// most recent violations of the Content-Security-Policy
+	api.SystemListCSPReportsHandler = systemApi.ListCSPReportsHandlerFunc(func(params systemApi.ListCSPReportsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListCSPReportsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListCSPReportsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewListCSPReportsOK().WithPayload(resp)
+	})
+}
+
+// withReportURI makes the browsers report the violations of the policy to Console, unless it already
+// reports them somewhere
+func withReportURI(policy string) string {
+	if policy == "" || strings.Contains(policy, "report-uri") || strings.Contains(policy, "report-to") {
+		return policy
+	}
+	return strings.TrimRight(strings.TrimSpace(policy), ";") + "; report-uri " + cspReportPath
+}
+
+// contentSecurityPolicies returns the values of the Content-Security-Policy and
+// Content-Security-Policy-Report-Only headers
+func contentSecurityPolicies() (enforced, reportOnly string) {
+	enforced, reportOnly = GetSecureContentSecurityPolicy(), GetSecureContentSecurityPolicyReportOnly()
+	if GetSecureContentSecurityPolicyMode() == cspModeReportOnly && enforced != "" {
+		enforced, reportOnly = "", enforced
+	}
+	if GetSecureContentSecurityPolicyReports() {
+		enforced, reportOnly = withReportURI(enforced), withReportURI(reportOnly)
+	}
+	return enforced, reportOnly
+}
+
+// serveCSPReport records the violations a browser reports, either to the report-uri or to the report-to
+// directive
+func serveCSPReport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, cspreport.MaxBodySize+1))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(body) > cspreport.MaxBodySize {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+	reports, err := cspreport.Parse(r.Header.Get("Content-Type"), body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	now := time.Now()
+	for i := range reports {
+		reports[i].Received = now
+		reports[i].SourceIP = realip.ClientIP(r)
+		if reports[i].UserAgent == "" {
+			reports[i].UserAgent = r.UserAgent()
+		}
+	}
+	globalCSPReports.Add(reports...)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func listCSPReports(store *cspreport.Store) *models.CspReports {
+	reports, total := store.List()
+	res := &models.CspReports{Total: total, Reports: []*models.CspReport{}}
+	for _, r := range reports {
+		res.Reports = append(res.Reports, &models.CspReport{
+			Received:           r.Received.UTC().Format(time.RFC3339),
+			DocumentURI:        r.DocumentURI,
+			Referrer:           r.Referrer,
+			BlockedURI:         r.BlockedURI,
+			ViolatedDirective:  r.ViolatedDirective,
+			EffectiveDirective: r.EffectiveDirective,
+			OriginalPolicy:     r.OriginalPolicy,
+			Disposition:        r.Disposition,
+			SourceFile:         r.SourceFile,
+			Sample:             r.Sample,
+			LineNumber:         r.LineNumber,
+			ColumnNumber:       r.ColumnNumber,
+			StatusCode:         r.StatusCode,
+			SourceIP:           r.SourceIP,
+			UserAgent:          r.UserAgent,
+		})
+	}
+	return res
+}
+
+func getListCSPReportsResponse(session *models.Principal, params systemApi.ListCSPReportsParams) (*models.CspReports, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ServerInfoAdminAction) {
+		return nil, ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	return listCSPReports(globalCSPReports), nil
+}
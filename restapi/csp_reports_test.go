@@ -0,0 +1,77 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/pkg/cspreport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentSecurityPolicies(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleSecureContentSecurityPolicy, "default-src 'self';")
+	enforced, reportOnly := contentSecurityPolicies()
+	assert.Equal("default-src 'self';", enforced)
+	assert.Empty(reportOnly)
+
+	t.Setenv(ConsoleSecureContentSecurityPolicyReports, "on")
+	enforced, _ = contentSecurityPolicies()
+	assert.Equal("default-src 'self'; report-uri /api/v1/csp-report", enforced)
+
+	// the policy is only reported while it is tried out
+	t.Setenv(ConsoleSecureContentSecurityPolicyMode, "report-only")
+	enforced, reportOnly = contentSecurityPolicies()
+	assert.Empty(enforced)
+	assert.Equal("default-src 'self'; report-uri /api/v1/csp-report", reportOnly)
+
+	// policies reporting elsewhere are kept as they are
+	t.Setenv(ConsoleSecureContentSecurityPolicy, "default-src 'self'; report-uri https://csp.example.com")
+	_, reportOnly = contentSecurityPolicies()
+	assert.Equal("default-src 'self'; report-uri https://csp.example.com", reportOnly)
+}
+
+func TestServeCSPReport(t *testing.T) {
+	assert := assert.New(t)
+	globalCSPReports = cspreport.NewStore(cspReportsKept)
+	report := func(method, contentType, body string) int {
+		r := httptest.NewRequest(method, cspReportPath, strings.NewReader(body))
+		r.Header.Set("Content-Type", contentType)
+		r.Header.Set("User-Agent", "Mozilla/5.0")
+		w := httptest.NewRecorder()
+		serveCSPReport(w, r)
+		return w.Code
+	}
+
+	assert.Equal(http.StatusNoContent, report(http.MethodPost, "application/csp-report",
+		`{"csp-report": {"document-uri": "https://console.example.com/", "blocked-uri": "inline", "violated-directive": "script-src-elem"}}`))
+	assert.Equal(http.StatusBadRequest, report(http.MethodPost, "application/csp-report", `{}`))
+	assert.Equal(http.StatusRequestEntityTooLarge, report(http.MethodPost, "application/csp-report", strings.Repeat(" ", cspreport.MaxBodySize+1)))
+	assert.Equal(http.StatusMethodNotAllowed, report(http.MethodGet, "", ""))
+
+	res := listCSPReports(globalCSPReports)
+	assert.Equal(int64(1), res.Total)
+	assert.Len(res.Reports, 1)
+	assert.Equal("inline", res.Reports[0].BlockedURI)
+	assert.Equal("Mozilla/5.0", res.Reports[0].UserAgent)
+	assert.NotEmpty(res.Reports[0].SourceIP)
+	assert.NotEmpty(res.Reports[0].Received)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-openapi/swag"
 	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/passwordpolicy"
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/minio-go/v7"
 )
@@ -72,6 +73,85 @@ var (
 	ErrPolicyNotFound                   = errors.New("policy does not exist")
 	ErrLoginNotAllowed                  = errors.New("login not allowed")
 	ErrSubnetUploadFail                 = errors.New("Subnet upload failed")
+	ErrObjectNotRestored                = errors.New("object is stored in a remote tier, restore it before accessing its content")
+	ErrStagingWorkspaceBusy             = errors.New("the staging workspace is being committed")
+	ErrTooManyStagingWorkspaces         = errors.New("too many staging workspaces, commit or discard some of them")
+	ErrTooManyStagedOperations          = errors.New("too many operations staged in the workspace")
+	ErrInvalidStagedOperation           = errors.New("invalid staged operation")
+	ErrInvalidSoftQuota                 = errors.New("the soft quota limit must be lower than the hard quota")
+	ErrInvalidSiteComparison            = errors.New("two different sites are needed for a comparison")
+	ErrInvalidLifecycleConfiguration    = errors.New("invalid lifecycle configuration")
+	ErrInvalidTrustedProxies            = errors.New("invalid trusted proxies configuration")
+	ErrInvalidReplicationPriority       = errors.New("replication rules need distinct priorities")
+	ErrInvalidReplicationResync         = errors.New("invalid replication resync request")
+	ErrInvalidEncryptionContext         = errors.New("invalid bucket encryption settings")
+	ErrInvalidNotificationEndpoint      = errors.New("invalid notification endpoint")
+	ErrNotificationEndpointNotFound     = errors.New("notification endpoint not found")
+	ErrNotificationEndpointInUse        = errors.New("notification endpoint is used by the events of these buckets")
+	ErrBucketEventNotFound              = errors.New("the bucket has no event subscription for this ARN")
+	ErrInvalidBucketEventTest           = errors.New("the bucket event can't be tested")
+	ErrInvalidBucketPolicyRules         = errors.New("invalid bucket policy rules")
+	ErrInvalidBucketCors                = errors.New("invalid bucket CORS configuration")
+	ErrInvalidBucketRetention           = errors.New("invalid bucket retention")
+	ErrBucketObjectLockingDisabled      = errors.New("object locking isn't enabled on this bucket, it can only be enabled when the bucket is created")
+	ErrInvalidPrefixUsageQuery          = errors.New("invalid prefix usage query")
+	ErrInvalidUsageHistoryRange         = errors.New("invalid usage history range")
+	ErrInvalidBucketConfigSnapshot      = errors.New("invalid bucket configuration snapshot")
+	ErrInvalidBulkBucketOperation       = errors.New("invalid bulk bucket operation")
+	ErrInvalidBucketRename              = errors.New("invalid bucket rename")
+	ErrInvalidAccessInsightQuery        = errors.New("invalid access insight query")
+	ErrAuditLogNotConfigured            = errors.New("audit log search is not configured")
+	ErrInvalidUsersImport               = errors.New("invalid users file")
+	ErrInvalidIAMExport                 = errors.New("invalid IAM export")
+	ErrInvalidServerConfig              = errors.New("invalid server configuration")
+	ErrConfigRevisionNotFound           = errors.New("configuration revision not found")
+	ErrInvalidBatchJob                  = errors.New("invalid batch job")
+	ErrBatchJobNotFound                 = errors.New("batch job not found")
+	ErrPoolNotFound                     = errors.New("server pool not found")
+	ErrInsufficientPoolCapacity         = errors.New("not enough free capacity on the remaining pools")
+	ErrInvalidHealRequest               = errors.New("invalid heal request")
+	ErrInvalidTraceWindow               = errors.New("the trace window has to be between 1 and 300 seconds")
+	ErrInvalidLogQuery                  = errors.New("invalid log query")
+	ErrLogSearchNotConfigured           = errors.New("log search is not configured")
+	ErrInvalidLogTarget                 = errors.New("invalid log target")
+	ErrLogTargetNotFound                = errors.New("log target not found")
+	ErrInvalidProfilingRequest          = errors.New("invalid profiling request")
+	ErrProfilingNotStarted              = errors.New("no profiling was started")
+	ErrInvalidLocksQuery                = errors.New("invalid locks query")
+	ErrInvalidInspectRequest            = errors.New("invalid inspect request")
+	ErrInspectEncryptionNotSupported    = errors.New("the server can't encrypt inspect data with a public key")
+	ErrInvalidServiceConfirmation       = errors.New("the confirmation token is invalid or expired")
+	ErrTierNotEmpty                     = errors.New("the tier still holds transitioned objects")
+	ErrPrometheusNotConfigured          = errors.New("prometheus is not configured")
+	ErrInvalidMetricsRange              = errors.New("invalid metrics range")
+	ErrInvalidAlertRule                 = errors.New("invalid alert rule")
+	ErrAlertRuleNotFound                = errors.New("alert rule not found")
+	ErrInvalidPolicySimulation          = errors.New("invalid policy simulation")
+	ErrInvalidLDAPPolicyAssociation     = errors.New("invalid LDAP policy association")
+	ErrInvalidIDPConfiguration          = errors.New("invalid IDP configuration")
+	ErrInvalidTemporaryCredentials      = errors.New("invalid temporary credentials request")
+	ErrPolicyTemplateNotFound           = errors.New("policy template not found")
+	ErrInvalidPolicyTemplateParameters  = errors.New("invalid policy template parameters")
+	ErrInvalidOpenIDClaimMapping        = errors.New("invalid OpenID claim mapping")
+	ErrLoginLockedOut                   = errors.New("too many failed logins, try again later")
+	ErrInvalidLoginUnlock               = errors.New("invalid login unlock request")
+	ErrUnknownIdentityProvider          = errors.New("unknown identity provider")
+	ErrSessionNotRenewable              = errors.New("the session can't be renewed")
+	ErrTwoFactorRequired                = errors.New("a two-factor authentication code is required")
+	ErrInvalidTwoFactor                 = errors.New("invalid two-factor authentication request")
+	ErrInvalidAPIToken                  = errors.New("invalid API token request")
+	ErrAPITokenNotFound                 = errors.New("API token not found")
+	ErrLoginThrottled                   = errors.New("too many login attempts, try again later")
+	ErrCaptchaRequired                  = errors.New("a CAPTCHA must be solved to log in")
+	ErrInvalidCluster                   = errors.New("invalid cluster request")
+	ErrClusterNotFound                  = errors.New("cluster not found")
+	ErrTooManyRequests                  = errors.New("too many requests, try again later")
+	ErrInvalidLogLevel                  = errors.New("invalid log level")
+	ErrInvalidCursor                    = errors.New("invalid pagination cursor")
+	ErrInvalidPageSize                  = errors.New("the page size has to be a positive number")
+	ErrInvalidBatchRequest              = errors.New("invalid batch request")
+	ErrInvalidConsoleConfig             = errors.New("invalid configuration file")
+	ErrConsoleConfigNotConfigured       = errors.New("the server wasn't started with a configuration file")
 )
 
 // ErrorWithContext :
@@ -235,12 +315,383 @@ func ErrorWithContext(ctx context.Context, err ...interface{}) *models.Error {
 				errorCode = 413
 				errorMessage = err1.Error()
 			}
+			// object transitioned to a remote tier and not restored
+			if minio.ToErrorResponse(err1).Code == "InvalidObjectState" {
+				errorCode = 400
+				errorMessage = ErrObjectNotRestored.Error()
+			}
+			// staging workspaces
+			if errors.Is(err1, ErrStagingWorkspaceBusy) || errors.Is(err1, ErrTooManyStagingWorkspaces) ||
+				errors.Is(err1, ErrTooManyStagedOperations) || errors.Is(err1, ErrInvalidStagedOperation) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// soft quota above the hard quota
+			if errors.Is(err1, ErrInvalidSoftQuota) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// site comparison against itself
+			if errors.Is(err1, ErrInvalidSiteComparison) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// lifecycle configuration that can't be imported
+			if errors.Is(err1, ErrInvalidLifecycleConfiguration) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// trusted proxies or real IP headers that can't be parsed
+			if errors.Is(err1, ErrInvalidTrustedProxies) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// replication priorities that collide or point to unknown rules
+			if errors.Is(err1, ErrInvalidReplicationPriority) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// replication resync without a target or with a negative age
+			if errors.Is(err1, ErrInvalidReplicationResync) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// KMS keys or contexts requested without sse-kms, or contexts too large to store
+			if errors.Is(err1, ErrInvalidEncryptionContext) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// unsupported notification services or endpoints missing their required properties
+			if errors.Is(err1, ErrInvalidNotificationEndpoint) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrNotificationEndpointNotFound) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// the endpoint can't be removed while bucket events are delivered to it
+			if errors.Is(err1, ErrNotificationEndpointInUse) {
+				errorCode = 409
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrBucketEventNotFound) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// subscriptions without object created events can't be test-fired
+			if errors.Is(err1, ErrInvalidBucketEventTest) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// policy generation rules without access or with repeated prefixes
+			if errors.Is(err1, ErrInvalidBucketPolicyRules) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// CORS rules without origins, with unsupported methods or too many wildcards
+			if errors.Is(err1, ErrInvalidBucketCors) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// default retention out of the accepted range
+			if errors.Is(err1, ErrInvalidBucketRetention) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// default retention on a bucket created without object locking
+			if errors.Is(err1, ErrBucketObjectLockingDisabled) {
+				errorCode = 400
+				errorMessage = ErrBucketObjectLockingDisabled.Error()
+			}
+			// prefix usage with an unsupported depth or page size
+			if errors.Is(err1, ErrInvalidPrefixUsageQuery) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// usage history requested from an unparseable date
+			if errors.Is(err1, ErrInvalidUsageHistoryRange) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// configuration snapshot with a newer version or sections that can't be decoded
+			if errors.Is(err1, ErrInvalidBucketConfigSnapshot) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// bulk operation without buckets or the parameters of its operation
+			if errors.Is(err1, ErrInvalidBulkBucketOperation) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// rename without a valid target or of a bucket already being renamed
+			if errors.Is(err1, ErrInvalidBucketRename) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// access insight with an invalid window or number of top entries
+			if errors.Is(err1, ErrInvalidAccessInsightQuery) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// no Log Search to read the audit log from
+			if errors.Is(err1, ErrAuditLogNotConfigured) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// users file that can't be read
+			if errors.Is(err1, ErrInvalidUsersImport) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// IAM import of a file that isn't an IAM export
+			if errors.Is(err1, ErrInvalidIAMExport) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// server configuration import of a document MinIO can't read
+			if errors.Is(err1, ErrInvalidServerConfig) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// rollback of a configuration revision that doesn't exist or is no longer kept
+			if errors.Is(err1, ErrConfigRevisionNotFound) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// batch job definition missing what its type requires
+			if errors.Is(err1, ErrInvalidBatchJob) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// batch job that isn't running nor known to MinIO
+			if errors.Is(err1, ErrBatchJobNotFound) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// decommission or status of a pool the deployment doesn't have
+			if errors.Is(err1, ErrPoolNotFound) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// decommission the remaining pools couldn't absorb
+			if errors.Is(err1, ErrInsufficientPoolCapacity) {
+				errorCode = 409
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrInvalidHealRequest) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrInvalidTraceWindow) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrInvalidLogQuery) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrLogSearchNotConfigured) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrInvalidLogTarget) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrLogTargetNotFound) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// profiling of unknown types or running for longer than allowed
+			if errors.Is(err1, ErrInvalidProfilingRequest) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// profiling stopped without starting it first
+			if errors.Is(err1, ErrProfilingNotStarted) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// top locks asking for too many locks or a negative long running time
+			if errors.Is(err1, ErrInvalidLocksQuery) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// inspect of a path without an object or with a key that isn't an RSA public key
+			if errors.Is(err1, ErrInvalidInspectRequest) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// inspect bundle asked to servers that only encrypt the data with a key they return
+			if errors.Is(err1, ErrInspectEncryptionNotSupported) {
+				errorCode = 501
+				errorMessage = err1.Error()
+			}
+			// update or restart of the cluster without a valid confirmation
+			if errors.Is(err1, ErrInvalidServiceConfirmation) {
+				errorCode = 403
+				errorMessage = err1.Error()
+			}
+			// removal of a tier objects were transitioned to
+			if errors.Is(err1, ErrTierNotEmpty) {
+				errorCode = 409
+				errorMessage = err1.Error()
+			}
+			// dashboard metrics without a Prometheus to query
+			if errors.Is(err1, ErrPrometheusNotConfigured) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// dashboard metrics over a range or with a step Prometheus can't answer
+			if errors.Is(err1, ErrInvalidMetricsRange) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// alert rule with an invalid id, kind or threshold
+			if errors.Is(err1, ErrInvalidAlertRule) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// update or removal of an alert rule that doesn't exist
+			if errors.Is(err1, ErrAlertRuleNotFound) {
+				errorCode = 404
+				errorMessage = ErrAlertRuleNotFound.Error()
+			}
+			// policy simulation without an action, resource or principal
+			if errors.Is(err1, ErrInvalidPolicySimulation) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// LDAP policy attach or detach for a wrong entity or policy
+			if errors.Is(err1, ErrInvalidLDAPPolicyAssociation) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// IDP configuration test with input that can't be parsed
+			if errors.Is(err1, ErrInvalidIDPConfiguration) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// temporary credentials with an out of range duration or a malformed session policy
+			if errors.Is(err1, ErrInvalidTemporaryCredentials) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// secret key that doesn't meet the password policy or was used before
+			if errors.Is(err1, passwordpolicy.ErrViolation) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrPolicyTemplateNotFound) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// policy template rendered without its required parameters or with invalid ones
+			if errors.Is(err1, ErrInvalidPolicyTemplateParameters) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// OpenID claim mapping with conflicting settings or a token that can't be decoded
+			if errors.Is(err1, ErrInvalidOpenIDClaimMapping) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// login of a locked out access key or source IP
+			if errors.Is(err1, ErrLoginLockedOut) {
+				errorCode = 429
+				errorMessage = ErrLoginLockedOut.Error()
+			}
+			// login unlock without an access key nor a source IP
+			if errors.Is(err1, ErrInvalidLoginUnlock) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// OpenID callback with the state of a provider that isn't configured
+			if errors.Is(err1, ErrUnknownIdentityProvider) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// session renewal of a session that wasn't opened with an OpenID provider
+			if errors.Is(err1, ErrSessionNotRenewable) {
+				errorCode = 400
+				errorMessage = ErrSessionNotRenewable.Error()
+			}
+			// login of a user enrolled in two-factor authentication without a code
+			if errors.Is(err1, ErrTwoFactorRequired) {
+				errorCode = 401
+				errorMessage = ErrTwoFactorRequired.Error()
+			}
+			// two-factor authentication enrollment with a wrong code or for a session without an access key
+			if errors.Is(err1, ErrInvalidTwoFactor) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// API token with an invalid name, scope or expiry, or managed by a session without an access key
+			if errors.Is(err1, ErrInvalidAPIToken) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// API token the session user doesn't have
+			if errors.Is(err1, ErrAPITokenNotFound) {
+				errorCode = 404
+				errorMessage = ErrAPITokenNotFound.Error()
+			}
+			// login attempt rejected by the rate limit or the backoff after failures
+			if errors.Is(err1, ErrLoginThrottled) {
+				errorCode = 429
+				errorMessage = ErrLoginThrottled.Error()
+			}
+			// request over the rate limit of its principal or operation at its concurrency cap
+			if errors.Is(err1, ErrTooManyRequests) {
+				errorCode = 429
+				errorMessage = err1.Error()
+			}
+			// log level other than debug, info or error
+			if errors.Is(err1, ErrInvalidLogLevel) {
+				errorCode = 400
+				errorMessage = ErrInvalidLogLevel.Error()
+			}
+			// list page requested with a cursor it didn't return or a page size that isn't positive
+			if errors.Is(err1, ErrInvalidCursor) || errors.Is(err1, ErrInvalidPageSize) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// batch without calls, with too many of them or with a call that can't be batched
+			if errors.Is(err1, ErrInvalidBatchRequest) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// configuration file reloaded with an unknown section or setting
+			if errors.Is(err1, ErrInvalidConsoleConfig) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrConsoleConfigNotConfigured) {
+				errorCode = 404
+				errorMessage = err1.Error()
+			}
+			// login after too many failures without a solved CAPTCHA
+			if errors.Is(err1, ErrCaptchaRequired) {
+				errorCode = 401
+				errorMessage = ErrCaptchaRequired.Error()
+			}
+			// cluster with an invalid id, endpoint or auth, or switched to without the credentials it needs
+			if errors.Is(err1, ErrInvalidCluster) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			// cluster that isn't registered
+			if errors.Is(err1, ErrClusterNotFound) {
+				errorCode = 404
+				errorMessage = ErrClusterNotFound.Error()
+			}
 			// bucket already exists
 			if minio.ToErrorResponse(err1).Code == "BucketAlreadyOwnedByYou" {
 				errorCode = 400
 				errorMessage = "Bucket already exists"
 			}
-			LogError("ErrorWithContext:%v", err...)
+			LogErrorContext(ctx, "ErrorWithContext:%v", err...)
 			LogIf(ctx, err1, err...)
 		}
 
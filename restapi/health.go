@@ -0,0 +1,101 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/minio/console/models"
+)
+
+// The probes of the orchestrators, served without authentication
+const (
+	healthzPath = "/healthz"
+	readyzPath  = "/readyz"
+)
+
+// healthCheckInterval is how long the probes reuse the results of the last checks, so the probes of several
+// orchestrators don't load the dependencies
+const healthCheckInterval = 5 * time.Second
+
+// healthChecking lets a single probe run the checks while the others wait for its results
+var healthChecking sync.Mutex
+
+// healthReport returns the results of the checks of the dependencies, run again when they are older than
+// healthCheckInterval
+func healthReport() *models.PreflightReport {
+	healthChecking.Lock()
+	defer healthChecking.Unlock()
+	globalPreflight.Lock()
+	report, checkedAt := globalPreflight.report, globalPreflight.checkedAt
+	globalPreflight.Unlock()
+	fresh := report != nil && time.Since(checkedAt) < healthCheckInterval
+	recordCacheLookup("health", fresh)
+	if !fresh {
+		// the checks aren't bound to the probe, their results are reused by the next ones
+		report = refreshPreflight(context.Background())
+	}
+	return report
+}
+
+// publicHealthReport leaves out the errors and hints of the checks, they expose the configuration of the
+// integrations and are only returned by GET /api/v1/admin/preflight
+func publicHealthReport(report *models.PreflightReport) *models.PreflightReport {
+	public := &models.PreflightReport{
+		Status:    report.Status,
+		CheckedAt: report.CheckedAt,
+		Checks:    make([]*models.PreflightCheck, len(report.Checks)),
+	}
+	for i, check := range report.Checks {
+		public.Checks[i] = &models.PreflightCheck{
+			Name:       check.Name,
+			Critical:   check.Critical,
+			Status:     check.Status,
+			DurationMs: check.DurationMs,
+		}
+	}
+	return public
+}
+
+// serveHealth answers the probes with the status of every dependency. /healthz succeeds while the process serves
+// requests, Console recovers by itself once its dependencies are back, and /readyz fails while a critical dependency
+// is unavailable.
+func serveHealth(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodHead)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	report := publicHealthReport(healthReport())
+	status := http.StatusOK
+	if r.URL.Path == readyzPath && report.Status == models.PreflightReportStatusFailed {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if r.Method == http.MethodHead {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		LogErrorContext(r.Context(), "unable to write the health report: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeHealth(t *testing.T) {
+	defer func() {
+		globalPreflight.report, globalPreflight.checkedAt = nil, time.Time{}
+	}()
+	setReport := func(status string) {
+		globalPreflight.report = &models.PreflightReport{
+			Status:    status,
+			CheckedAt: "2023-05-01T10:00:00Z",
+			Checks: []*models.PreflightCheck{
+				{Name: "minio", Critical: true, Status: models.PreflightCheckStatusOk, DurationMs: 3},
+				{
+					Name:       "session-store",
+					Critical:   true,
+					Status:     models.PreflightCheckStatusFailed,
+					DurationMs: 5000,
+					Error:      "dial tcp 10.0.0.12:6379: i/o timeout",
+					Hint:       "check CONSOLE_SESSION_STORE_URL",
+				},
+			},
+		}
+		globalPreflight.checkedAt = time.Now()
+	}
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		status     string
+		wantStatus int
+	}{
+		{name: "alive with a failed dependency", method: http.MethodGet, path: healthzPath, status: models.PreflightReportStatusFailed, wantStatus: http.StatusOK},
+		{name: "not ready with a failed dependency", method: http.MethodGet, path: readyzPath, status: models.PreflightReportStatusFailed, wantStatus: http.StatusServiceUnavailable},
+		{name: "ready when degraded", method: http.MethodGet, path: readyzPath, status: models.PreflightReportStatusDegraded, wantStatus: http.StatusOK},
+		{name: "head", method: http.MethodHead, path: readyzPath, status: models.PreflightReportStatusFailed, wantStatus: http.StatusServiceUnavailable},
+		{name: "post", method: http.MethodPost, path: healthzPath, status: models.PreflightReportStatusOk, wantStatus: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setReport(tt.status)
+			w := httptest.NewRecorder()
+			serveHealth(w, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.method == http.MethodHead {
+				assert.Zero(t, w.Body.Len())
+			}
+			if tt.method != http.MethodGet {
+				return
+			}
+			var report models.PreflightReport
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+			assert.Equal(t, tt.status, report.Status)
+			assert.Len(t, report.Checks, 2)
+			assert.Equal(t, models.PreflightCheckStatusFailed, report.Checks[1].Status)
+			// the probes don't expose the configuration of the integrations
+			assert.Empty(t, report.Checks[1].Error)
+			assert.Empty(t, report.Checks[1].Hint)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// systemdListenFDsStart is the first file descriptor systemd passes the activated sockets from
+const systemdListenFDsStart = 3
+
+// consoleSockets are the sockets console is served on instead of the TCP ports
+type consoleSockets struct {
+	unix, http, https net.Listener
+}
+
+// globalSockets are the sockets opened by ListenSockets, nil to listen on the TCP ports
+var globalSockets *consoleSockets
+
+// servesTLS tells whether HTTPS is served and so whether the plain HTTP requests can be redirected to it. The sockets
+// only serve it when systemd passes one for it.
+func (s *consoleSockets) servesTLS() bool {
+	return s == nil || s.https != nil
+}
+
+// add assigns a socket to a scheme by its name, the FileDescriptorName of its systemd unit, or else by its kind: the
+// Unix domain socket serves the API and the first TCP socket HTTP, the second one HTTPS
+func (s *consoleSockets) add(name string, l net.Listener) error {
+	isUnix := l.Addr().Network() == "unix"
+	scheme := name
+	switch {
+	case scheme == schemeUnix || scheme == schemeHTTP || scheme == schemeHTTPS:
+		if (scheme == schemeUnix) != isUnix {
+			return fmt.Errorf("the %s socket %s has the wrong kind", scheme, l.Addr())
+		}
+	case isUnix:
+		scheme = schemeUnix
+	case s.http == nil:
+		scheme = schemeHTTP
+	default:
+		scheme = schemeHTTPS
+	}
+	target := map[string]*net.Listener{schemeUnix: &s.unix, schemeHTTP: &s.http, schemeHTTPS: &s.https}[scheme]
+	if *target != nil {
+		return fmt.Errorf("more than one %s socket was passed", scheme)
+	}
+	*target = l
+	return nil
+}
+
+// close closes the sockets, removing the Unix domain socket console created
+func (s *consoleSockets) close() {
+	for _, l := range []net.Listener{s.unix, s.http, s.https} {
+		if l != nil {
+			l.Close()
+		}
+	}
+}
+
+// newConsoleSockets returns the sockets of the files passed by systemd, named after LISTEN_FDNAMES
+func newConsoleSockets(files []*os.File, names []string) (*consoleSockets, error) {
+	sockets := &consoleSockets{}
+	for i, file := range files {
+		// the listener holds a duplicate of the file descriptor, which isn't inherited by the child processes
+		l, err := net.FileListener(file)
+		file.Close()
+		if err != nil {
+			sockets.close()
+			return nil, fmt.Errorf("the socket %d passed by systemd isn't a listening socket: %w", systemdListenFDsStart+i, err)
+		}
+		var name string
+		if i < len(names) {
+			name = names[i]
+		}
+		if err = sockets.add(name, l); err != nil {
+			l.Close()
+			sockets.close()
+			return nil, err
+		}
+	}
+	return sockets, nil
+}
+
+// systemdSockets returns the sockets passed by systemd socket activation, nil when the process wasn't activated by a
+// socket. The activation variables are cleared so the child processes don't take the sockets for theirs.
+func systemdSockets() (*consoleSockets, error) {
+	pid, fds, names := os.Getenv("LISTEN_PID"), os.Getenv("LISTEN_FDS"), os.Getenv("LISTEN_FDNAMES")
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+	if pid == "" || pid != strconv.Itoa(os.Getpid()) {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(fds)
+	if err != nil || n <= 0 {
+		return nil, fmt.Errorf("invalid LISTEN_FDS %q", fds)
+	}
+	files := make([]*os.File, n)
+	for i := range files {
+		files[i] = os.NewFile(uintptr(systemdListenFDsStart+i), fmt.Sprintf("LISTEN_FD_%d", systemdListenFDsStart+i))
+	}
+	var fdNames []string
+	if names != "" {
+		fdNames = strings.Split(names, ":")
+	}
+	return newConsoleSockets(files, fdNames)
+}
+
+// lookupGroupID returns the ID of a group given by name or ID
+func lookupGroupID(group string) (int, error) {
+	if gid, err := strconv.Atoi(group); err == nil {
+		return gid, nil
+	}
+	g, err := user.LookupGroup(group)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(g.Gid)
+}
+
+// removeStaleSocket removes the socket a previous run left behind, anything else than a socket no process serves
+// anymore is kept
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s already exists and isn't a socket", path)
+	}
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		conn.Close()
+		return fmt.Errorf("%s is already served by another process", path)
+	}
+	return os.Remove(path)
+}
+
+// listenUnixSocket listens on the Unix domain socket at path with the permissions given. The socket is only accessible
+// by its owner until its group and mode are set, so no connection is accepted with other permissions. It's removed
+// when closed.
+func listenUnixSocket(path string, mode os.FileMode, group string) (net.Listener, error) {
+	gid := -1
+	if group != "" {
+		var err error
+		if gid, err = lookupGroupID(group); err != nil {
+			return nil, fmt.Errorf("unable to find the group %s of the socket: %w", group, err)
+		}
+	}
+	if err := removeStaleSocket(path); err != nil {
+		return nil, err
+	}
+	var l net.Listener
+	err := withUmask(0o177, func() (err error) {
+		l, err = net.Listen("unix", path)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if gid != -1 {
+		if err = os.Chown(path, -1, gid); err != nil {
+			l.Close()
+			return nil, fmt.Errorf("unable to set the group of the socket: %w", err)
+		}
+	}
+	if err = os.Chmod(path, mode); err != nil {
+		l.Close()
+		return nil, fmt.Errorf("unable to set the permissions of the socket: %w", err)
+	}
+	return l, nil
+}
+
+// ListenSockets opens the sockets passed by systemd socket activation or, without them, the Unix domain socket at
+// socketPath. Console listens on the TCP ports when neither is given.
+func ListenSockets(socketPath string) error {
+	sockets, err := systemdSockets()
+	if err != nil {
+		return err
+	}
+	if sockets == nil && socketPath != "" {
+		l, err := listenUnixSocket(socketPath, getConsoleSocketMode(), getConsoleSocketGroup())
+		if err != nil {
+			return err
+		}
+		sockets = &consoleSockets{unix: l}
+	}
+	if sockets != nil && sockets.https != nil && len(GlobalPublicCerts) == 0 {
+		sockets.close()
+		return errors.New("the https socket passed by systemd requires TLS certificates")
+	}
+	globalSockets = sockets
+	return nil
+}
+
+// UseSockets serves console on the sockets opened by ListenSockets, if any, instead of listening on the TCP ports
+func (s *Server) UseSockets() {
+	sockets := globalSockets
+	if sockets == nil {
+		return
+	}
+	s.EnabledListeners = nil
+	if sockets.unix != nil {
+		s.EnabledListeners = append(s.EnabledListeners, schemeUnix)
+		s.SocketPath = flags.Filename(sockets.unix.Addr().String())
+		s.domainSocketL = sockets.unix
+	}
+	if sockets.http != nil {
+		s.EnabledListeners = append(s.EnabledListeners, schemeHTTP)
+		s.Host, s.Port, _ = swag.SplitHostPort(sockets.http.Addr().String())
+		s.httpServerL = sockets.http
+		Port = strconv.Itoa(s.Port)
+	}
+	if sockets.https != nil {
+		s.EnabledListeners = append(s.EnabledListeners, schemeHTTPS)
+		s.TLSHost, s.TLSPort, _ = swag.SplitHostPort(sockets.https.Addr().String())
+		// Listen, which is skipped, defaults the TLS timeouts to the HTTP ones
+		if int64(s.TLSReadTimeout) == 0 {
+			s.TLSReadTimeout = s.ReadTimeout
+		}
+		if int64(s.TLSWriteTimeout) == 0 {
+			s.TLSWriteTimeout = s.WriteTimeout
+		}
+		s.httpsServerL = sockets.https
+		TLSPort = strconv.Itoa(s.TLSPort)
+	}
+	s.hasListeners = true
+}
@@ -0,0 +1,144 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// listenerFile returns a duplicate of the file descriptor of a new listener, as systemd passes it
+func listenerFile(t *testing.T, network, address string) *os.File {
+	l, err := net.Listen(network, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	file, err := l.(interface{ File() (*os.File, error) }).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestNewConsoleSockets(t *testing.T) {
+	assert := assert.New(t)
+	socketPath := filepath.Join(t.TempDir(), "console.sock")
+
+	// Test-1 : the sockets without a known name are assigned by kind
+	sockets, err := newConsoleSockets([]*os.File{
+		listenerFile(t, "tcp", "127.0.0.1:0"),
+		listenerFile(t, "unix", socketPath),
+		listenerFile(t, "tcp", "127.0.0.1:0"),
+	}, []string{"console.socket", "console.socket"})
+	assert.NoError(err)
+	assert.Equal("unix", sockets.unix.Addr().Network())
+	assert.Equal(socketPath, sockets.unix.Addr().String())
+	assert.NotNil(sockets.http)
+	assert.NotNil(sockets.https)
+	assert.True(sockets.servesTLS())
+	sockets.close()
+
+	// Test-2 : the named sockets are assigned by name
+	sockets, err = newConsoleSockets([]*os.File{
+		listenerFile(t, "tcp", "127.0.0.1:0"),
+		listenerFile(t, "tcp", "127.0.0.1:0"),
+	}, []string{"https", "http"})
+	assert.NoError(err)
+	assert.Nil(sockets.unix)
+	assert.NotEqual(sockets.http.Addr().String(), sockets.https.Addr().String())
+	sockets.close()
+
+	// Test-3 : a named socket of the wrong kind or a scheme with two sockets are rejected
+	_, err = newConsoleSockets([]*os.File{listenerFile(t, "tcp", "127.0.0.1:0")}, []string{"unix"})
+	assert.Error(err)
+	_, err = newConsoleSockets([]*os.File{
+		listenerFile(t, "tcp", "127.0.0.1:0"),
+		listenerFile(t, "tcp", "127.0.0.1:0"),
+		listenerFile(t, "tcp", "127.0.0.1:0"),
+	}, nil)
+	assert.Error(err)
+
+	// Test-4 : without an https socket only plain HTTP is served
+	sockets, err = newConsoleSockets([]*os.File{listenerFile(t, "tcp", "127.0.0.1:0")}, nil)
+	assert.NoError(err)
+	assert.False(sockets.servesTLS())
+	sockets.close()
+	var noSockets *consoleSockets
+	assert.True(noSockets.servesTLS())
+}
+
+func TestListenUnixSocket(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, "console.sock")
+
+	// Test-1 : the socket gets the mode and group given and is removed when closed
+	l, err := listenUnixSocket(path, 0o660, strconv.Itoa(os.Getgid()))
+	assert.NoError(err)
+	info, err := os.Lstat(path)
+	assert.NoError(err)
+	assert.Equal(os.FileMode(0o660), info.Mode().Perm())
+	assert.NotZero(info.Mode() & os.ModeSocket)
+
+	// Test-2 : a socket still served isn't replaced
+	_, err = listenUnixSocket(path, 0o660, "")
+	assert.Error(err)
+	l.Close()
+	_, err = os.Lstat(path)
+	assert.ErrorIs(err, os.ErrNotExist)
+
+	// Test-3 : the socket left behind by a previous run is replaced
+	stale, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	assert.NoError(err)
+	stale.SetUnlinkOnClose(false)
+	stale.Close()
+	l, err = listenUnixSocket(path, 0o600, "")
+	assert.NoError(err)
+	info, _ = os.Lstat(path)
+	assert.Equal(os.FileMode(0o600), info.Mode().Perm())
+	l.Close()
+
+	// Test-4 : anything else than a socket is kept
+	file := filepath.Join(dir, "console.yaml")
+	assert.NoError(os.WriteFile(file, []byte("listeners: {}\n"), 0o600))
+	_, err = listenUnixSocket(file, 0o660, "")
+	assert.Error(err)
+	_, err = os.Stat(file)
+	assert.NoError(err)
+}
+
+func TestGetConsoleSocketMode(t *testing.T) {
+	for value, want := range map[string]os.FileMode{
+		"":     0o660,
+		"0600": 0o600,
+		"666":  0o666,
+		"0999": 0o660,
+		"1777": 0o660,
+	} {
+		t.Setenv(ConsoleSocketMode, value)
+		if value == "" {
+			os.Unsetenv(ConsoleSocketMode)
+		}
+		assert.Equal(t, want, getConsoleSocketMode(), value)
+	}
+}
@@ -22,17 +22,29 @@ import (
 	"errors"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/minio/cli"
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/utils"
 )
 
 var (
+	debugLog = log.New(os.Stdout, "D: ", log.LstdFlags)
 	infoLog  = log.New(os.Stdout, "I: ", log.LstdFlags)
 	errorLog = log.New(os.Stdout, "E: ", log.LstdFlags)
 )
 
+func logDebug(msg string, data ...interface{}) {
+	if logger.Enabled(logger.DebugLvl) {
+		debugLog.Printf(msg+"\n", data...)
+	}
+}
+
 func logInfo(msg string, data ...interface{}) {
-	infoLog.Printf(msg+"\n", data...)
+	if logger.Enabled(logger.InformationLvl) {
+		infoLog.Printf(msg+"\n", data...)
+	}
 }
 
 func logError(msg string, data ...interface{}) {
@@ -42,11 +54,35 @@ func logError(msg string, data ...interface{}) {
 func logIf(_ context.Context, _ error, _ ...interface{}) {
 }
 
-// globally changeable logger styles
+// withRequestID appends the request ID of the context to the message, to correlate the logs of a request
+func withRequestID(ctx context.Context, msg string) string {
+	if requestID, ok := ctx.Value(utils.ContextRequestID).(string); ok && requestID != "" {
+		return msg + " (requestID=" + strings.ReplaceAll(requestID, "%", "%%") + ")"
+	}
+	return msg
+}
+
+func logDebugContext(ctx context.Context, msg string, data ...interface{}) {
+	logDebug(withRequestID(ctx, msg), data...)
+}
+
+func logInfoContext(ctx context.Context, msg string, data ...interface{}) {
+	logInfo(withRequestID(ctx, msg), data...)
+}
+
+func logErrorContext(ctx context.Context, msg string, data ...interface{}) {
+	logError(withRequestID(ctx, msg), data...)
+}
+
+// globally changeable logger styles, the Context ones attach the request of the context to the messages
 var (
-	LogInfo  = logInfo
-	LogError = logError
-	LogIf    = logIf
+	LogDebug        = logDebug
+	LogInfo         = logInfo
+	LogError        = logError
+	LogIf           = logIf
+	LogDebugContext = logDebugContext
+	LogInfoContext  = logInfoContext
+	LogErrorContext = logErrorContext
 )
 
 // Context captures all command line flags values
@@ -54,6 +90,7 @@ type Context struct {
 	Host                string
 	HTTPPort, HTTPSPort int
 	TLSRedirect         string
+	SocketPath          string
 	// Legacy options, TODO: remove in future
 	TLSCertificate, TLSKey, TLSca string
 }
@@ -65,11 +102,29 @@ func (c *Context) Load(ctx *cli.Context) error {
 		HTTPPort:    ctx.Int("port"),
 		HTTPSPort:   ctx.Int("tls-port"),
 		TLSRedirect: ctx.String("tls-redirect"),
+		SocketPath:  ctx.String("socket-path"),
 		// Legacy options to be removed.
 		TLSCertificate: ctx.String("tls-certificate"),
 		TLSKey:         ctx.String("tls-key"),
 		TLSca:          ctx.String("tls-ca"),
 	}
+	// the configuration file is loaded after the defaults of the flags are set, its settings apply to the flags
+	// not given
+	if !ctx.IsSet("host") && globalConsoleConfigFile.sets(ConsoleHostname) {
+		c.Host = GetHostname()
+	}
+	if !ctx.IsSet("port") && globalConsoleConfigFile.sets(ConsolePort) {
+		c.HTTPPort = GetPort()
+	}
+	if !ctx.IsSet("tls-port") && globalConsoleConfigFile.sets(ConsoleTLSPort) {
+		c.HTTPSPort = GetTLSPort()
+	}
+	if !ctx.IsSet("tls-redirect") && globalConsoleConfigFile.sets(ConsoleSecureTLSRedirect) {
+		c.TLSRedirect = GetTLSRedirect()
+	}
+	if !ctx.IsSet("socket-path") && globalConsoleConfigFile.sets(ConsoleSocketPath) {
+		c.SocketPath = GetSocketPath()
+	}
 	if c.HTTPPort > 65535 {
 		return errors.New("invalid argument --port out of range - ports can range from 1-65535")
 	}
@@ -54,6 +54,10 @@ type ListBucketEventsParams struct {
 	  In: path
 	*/
 	BucketName string
+	/*opaque cursor returned by the previous page as nextCursor, the first page is returned without it
+	  In: query
+	*/
+	Cursor *string
 	/*
 	  In: query
 	*/
@@ -62,6 +66,10 @@ type ListBucketEventsParams struct {
 	  In: query
 	*/
 	Offset *int32
+	/*number of items of the page, capped by CONSOLE_LIST_MAX_PAGE_SIZE
+	  In: query
+	*/
+	PageSize *int32
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -80,6 +88,11 @@ func (o *ListBucketEventsParams) BindRequest(r *http.Request, route *middleware.
 		res = append(res, err)
 	}
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
@@ -89,6 +102,11 @@ func (o *ListBucketEventsParams) BindRequest(r *http.Request, route *middleware.
 	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qPageSize, qhkPageSize, _ := qs.GetOK("pageSize")
+	if err := o.bindPageSize(qPageSize, qhkPageSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -109,6 +127,24 @@ func (o *ListBucketEventsParams) bindBucketName(rawData []string, hasKey bool, f
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListBucketEventsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *ListBucketEventsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -154,3 +190,26 @@ func (o *ListBucketEventsParams) bindOffset(rawData []string, hasKey bool, forma
 
 	return nil
 }
+
+// bindPageSize binds and validates parameter PageSize from query.
+func (o *ListBucketEventsParams) bindPageSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("pageSize", "query", "int32", raw)
+	}
+	o.PageSize = &value
+
+	return nil
+}
@@ -35,8 +35,10 @@ import (
 type ListBucketEventsURL struct {
 	BucketName string
 
-	Limit  *int32
-	Offset *int32
+	Cursor   *string
+	Limit    *int32
+	Offset   *int32
+	PageSize *int32
 
 	_basePath string
 	// avoid unkeyed usage
@@ -68,7 +70,7 @@ func (o *ListBucketEventsURL) Build() (*url.URL, error) {
 	if bucketName != "" {
 		_path = strings.Replace(_path, "{bucket_name}", bucketName, -1)
 	} else {
-		return nil, errors.New("bucketName is required on ListBucketEventsURL")
+		return nil, errors.New("bucket_name is required on ListBucketEventsURL")
 	}
 
 	_basePath := o._basePath
@@ -79,6 +81,14 @@ func (o *ListBucketEventsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatInt32(*o.Limit)
@@ -95,6 +105,14 @@ func (o *ListBucketEventsURL) Build() (*url.URL, error) {
 		qs.Set("offset", offsetQ)
 	}
 
+	var pageSizeQ string
+	if o.PageSize != nil {
+		pageSizeQ = swag.FormatInt32(*o.PageSize)
+	}
+	if pageSizeQ != "" {
+		qs.Set("pageSize", pageSizeQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
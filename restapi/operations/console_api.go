@@ -40,6 +40,7 @@ import (
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations/account"
 	"github.com/minio/console/restapi/operations/auth"
+	"github.com/minio/console/restapi/operations/batch_jobs"
 	"github.com/minio/console/restapi/operations/bucket"
 	"github.com/minio/console/restapi/operations/configuration"
 	"github.com/minio/console/restapi/operations/group"
@@ -50,10 +51,12 @@ import (
 	"github.com/minio/console/restapi/operations/object"
 	"github.com/minio/console/restapi/operations/policy"
 	"github.com/minio/console/restapi/operations/profile"
+	"github.com/minio/console/restapi/operations/public"
 	"github.com/minio/console/restapi/operations/release"
 	"github.com/minio/console/restapi/operations/service"
 	"github.com/minio/console/restapi/operations/service_account"
 	"github.com/minio/console/restapi/operations/site_replication"
+	"github.com/minio/console/restapi/operations/staging"
 	"github.com/minio/console/restapi/operations/subnet"
 	"github.com/minio/console/restapi/operations/support"
 	"github.com/minio/console/restapi/operations/system"
@@ -97,6 +100,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		GroupAddGroupHandler: group.AddGroupHandlerFunc(func(params group.AddGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.AddGroup has not yet been implemented")
 		}),
+		ConfigurationAddLogTargetHandler: configuration.AddLogTargetHandlerFunc(func(params configuration.AddLogTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.AddLogTarget has not yet been implemented")
+		}),
 		BucketAddMultiBucketLifecycleHandler: bucket.AddMultiBucketLifecycleHandlerFunc(func(params bucket.AddMultiBucketLifecycleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.AddMultiBucketLifecycle has not yet been implemented")
 		}),
@@ -109,6 +115,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketAddRemoteBucketHandler: bucket.AddRemoteBucketHandlerFunc(func(params bucket.AddRemoteBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.AddRemoteBucket has not yet been implemented")
 		}),
+		StagingAddStagedOperationsHandler: staging.AddStagedOperationsHandlerFunc(func(params staging.AddStagedOperationsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation staging.AddStagedOperations has not yet been implemented")
+		}),
 		TieringAddTierHandler: tiering.AddTierHandlerFunc(func(params tiering.AddTierParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.AddTier has not yet been implemented")
 		}),
@@ -121,27 +130,60 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SystemArnListHandler: system.ArnListHandlerFunc(func(params system.ArnListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.ArnList has not yet been implemented")
 		}),
+		IdpAttachLDAPPolicyHandler: idp.AttachLDAPPolicyHandlerFunc(func(params idp.AttachLDAPPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.AttachLDAPPolicy has not yet been implemented")
+		}),
+		SystemBatchHandler: system.BatchHandlerFunc(func(params system.BatchParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.Batch has not yet been implemented")
+		}),
 		BucketBucketInfoHandler: bucket.BucketInfoHandlerFunc(func(params bucket.BucketInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.BucketInfo has not yet been implemented")
 		}),
 		BucketBucketSetPolicyHandler: bucket.BucketSetPolicyHandlerFunc(func(params bucket.BucketSetPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.BucketSetPolicy has not yet been implemented")
 		}),
+		BucketBulkBucketOperationHandler: bucket.BulkBucketOperationHandlerFunc(func(params bucket.BulkBucketOperationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.BulkBucketOperation has not yet been implemented")
+		}),
 		UserBulkUpdateUsersGroupsHandler: user.BulkUpdateUsersGroupsHandlerFunc(func(params user.BulkUpdateUsersGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.BulkUpdateUsersGroups has not yet been implemented")
 		}),
+		BatchJobsCancelBatchJobHandler: batch_jobs.CancelBatchJobHandlerFunc(func(params batch_jobs.CancelBatchJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch_jobs.CancelBatchJob has not yet been implemented")
+		}),
+		BucketCancelBucketRenameJobHandler: bucket.CancelBucketRenameJobHandlerFunc(func(params bucket.CancelBucketRenameJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.CancelBucketRenameJob has not yet been implemented")
+		}),
+		SystemCancelPoolDecommissionHandler: system.CancelPoolDecommissionHandlerFunc(func(params system.CancelPoolDecommissionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CancelPoolDecommission has not yet been implemented")
+		}),
+		BucketCancelReplicationResyncHandler: bucket.CancelReplicationResyncHandlerFunc(func(params bucket.CancelReplicationResyncParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.CancelReplicationResync has not yet been implemented")
+		}),
 		AccountChangeUserPasswordHandler: account.ChangeUserPasswordHandlerFunc(func(params account.ChangeUserPasswordParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation account.ChangeUserPassword has not yet been implemented")
 		}),
 		SystemCheckMinIOVersionHandler: system.CheckMinIOVersionHandlerFunc(func(params system.CheckMinIOVersionParams) middleware.Responder {
 			return middleware.NotImplemented("operation system.CheckMinIOVersion has not yet been implemented")
 		}),
+		ServiceCheckServerUpdateHandler: service.CheckServerUpdateHandlerFunc(func(params service.CheckServerUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.CheckServerUpdate has not yet been implemented")
+		}),
 		UserCheckUserServiceAccountsHandler: user.CheckUserServiceAccountsHandlerFunc(func(params user.CheckUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CheckUserServiceAccounts has not yet been implemented")
 		}),
+		BucketClearBucketRetentionConfigHandler: bucket.ClearBucketRetentionConfigHandlerFunc(func(params bucket.ClearBucketRetentionConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ClearBucketRetentionConfig has not yet been implemented")
+		}),
+		StagingCommitStagingWorkspaceHandler: staging.CommitStagingWorkspaceHandlerFunc(func(params staging.CommitStagingWorkspaceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation staging.CommitStagingWorkspace has not yet been implemented")
+		}),
 		ConfigurationConfigInfoHandler: configuration.ConfigInfoHandlerFunc(func(params configuration.ConfigInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ConfigInfo has not yet been implemented")
 		}),
+		AccountCreateAPITokenHandler: account.CreateAPITokenHandlerFunc(func(params account.CreateAPITokenParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.CreateAPIToken has not yet been implemented")
+		}),
 		UserCreateAUserServiceAccountHandler: user.CreateAUserServiceAccountHandlerFunc(func(params user.CreateAUserServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CreateAUserServiceAccount has not yet been implemented")
 		}),
@@ -160,18 +202,33 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountCreateServiceAccountCredsHandler: service_account.CreateServiceAccountCredsHandlerFunc(func(params service_account.CreateServiceAccountCredsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.CreateServiceAccountCreds has not yet been implemented")
 		}),
+		ServiceCreateServiceConfirmationHandler: service.CreateServiceConfirmationHandlerFunc(func(params service.CreateServiceConfirmationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service.CreateServiceConfirmation has not yet been implemented")
+		}),
+		StagingCreateStagingWorkspaceHandler: staging.CreateStagingWorkspaceHandlerFunc(func(params staging.CreateStagingWorkspaceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation staging.CreateStagingWorkspace has not yet been implemented")
+		}),
+		AccountCreateTemporaryCredentialsHandler: account.CreateTemporaryCredentialsHandlerFunc(func(params account.CreateTemporaryCredentialsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.CreateTemporaryCredentials has not yet been implemented")
+		}),
 		SystemDashboardWidgetDetailsHandler: system.DashboardWidgetDetailsHandlerFunc(func(params system.DashboardWidgetDetailsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.DashboardWidgetDetails has not yet been implemented")
 		}),
 		BucketDeleteAccessRuleWithBucketHandler: bucket.DeleteAccessRuleWithBucketHandlerFunc(func(params bucket.DeleteAccessRuleWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAccessRuleWithBucket has not yet been implemented")
 		}),
+		SystemDeleteAlertRuleHandler: system.DeleteAlertRuleHandlerFunc(func(params system.DeleteAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DeleteAlertRule has not yet been implemented")
+		}),
 		BucketDeleteAllReplicationRulesHandler: bucket.DeleteAllReplicationRulesHandlerFunc(func(params bucket.DeleteAllReplicationRulesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAllReplicationRules has not yet been implemented")
 		}),
 		BucketDeleteBucketHandler: bucket.DeleteBucketHandlerFunc(func(params bucket.DeleteBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteBucket has not yet been implemented")
 		}),
+		BucketDeleteBucketCorsHandler: bucket.DeleteBucketCorsHandlerFunc(func(params bucket.DeleteBucketCorsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.DeleteBucketCors has not yet been implemented")
+		}),
 		BucketDeleteBucketEventHandler: bucket.DeleteBucketEventHandlerFunc(func(params bucket.DeleteBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteBucketEvent has not yet been implemented")
 		}),
@@ -181,6 +238,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketDeleteBucketReplicationRuleHandler: bucket.DeleteBucketReplicationRuleHandlerFunc(func(params bucket.DeleteBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteBucketReplicationRule has not yet been implemented")
 		}),
+		SystemDeleteClusterHandler: system.DeleteClusterHandlerFunc(func(params system.DeleteClusterParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DeleteCluster has not yet been implemented")
+		}),
 		IdpDeleteConfigurationHandler: idp.DeleteConfigurationHandlerFunc(func(params idp.DeleteConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.DeleteConfiguration has not yet been implemented")
 		}),
@@ -190,6 +250,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountDeleteMultipleServiceAccountsHandler: service_account.DeleteMultipleServiceAccountsHandlerFunc(func(params service_account.DeleteMultipleServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.DeleteMultipleServiceAccounts has not yet been implemented")
 		}),
+		ConfigurationDeleteNotificationEndpointHandler: configuration.DeleteNotificationEndpointHandlerFunc(func(params configuration.DeleteNotificationEndpointParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.DeleteNotificationEndpoint has not yet been implemented")
+		}),
 		ObjectDeleteObjectHandler: object.DeleteObjectHandlerFunc(func(params object.DeleteObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DeleteObject has not yet been implemented")
 		}),
@@ -205,9 +268,24 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountDeleteServiceAccountHandler: service_account.DeleteServiceAccountHandlerFunc(func(params service_account.DeleteServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.DeleteServiceAccount has not yet been implemented")
 		}),
+		StagingDeleteStagingWorkspaceHandler: staging.DeleteStagingWorkspaceHandlerFunc(func(params staging.DeleteStagingWorkspaceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation staging.DeleteStagingWorkspace has not yet been implemented")
+		}),
+		BatchJobsDescribeBatchJobHandler: batch_jobs.DescribeBatchJobHandlerFunc(func(params batch_jobs.DescribeBatchJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch_jobs.DescribeBatchJob has not yet been implemented")
+		}),
+		IdpDetachLDAPPolicyHandler: idp.DetachLDAPPolicyHandlerFunc(func(params idp.DetachLDAPPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.DetachLDAPPolicy has not yet been implemented")
+		}),
 		BucketDisableBucketEncryptionHandler: bucket.DisableBucketEncryptionHandlerFunc(func(params bucket.DisableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DisableBucketEncryption has not yet been implemented")
 		}),
+		ConfigurationDisableLogTargetHandler: configuration.DisableLogTargetHandlerFunc(func(params configuration.DisableLogTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.DisableLogTarget has not yet been implemented")
+		}),
+		AccountDisableTwoFactorHandler: account.DisableTwoFactorHandlerFunc(func(params account.DisableTwoFactorParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.DisableTwoFactor has not yet been implemented")
+		}),
 		ObjectDownloadObjectHandler: object.DownloadObjectHandlerFunc(func(params object.DownloadObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DownloadObject has not yet been implemented")
 		}),
@@ -217,9 +295,51 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketEnableBucketEncryptionHandler: bucket.EnableBucketEncryptionHandlerFunc(func(params bucket.EnableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.EnableBucketEncryption has not yet been implemented")
 		}),
+		AccountEnrollTwoFactorHandler: account.EnrollTwoFactorHandlerFunc(func(params account.EnrollTwoFactorParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.EnrollTwoFactor has not yet been implemented")
+		}),
+		SystemEvaluateAlertsHandler: system.EvaluateAlertsHandlerFunc(func(params system.EvaluateAlertsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.EvaluateAlerts has not yet been implemented")
+		}),
+		BucketExportBucketConfigHandler: bucket.ExportBucketConfigHandlerFunc(func(params bucket.ExportBucketConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ExportBucketConfig has not yet been implemented")
+		}),
+		BucketExportBucketLifecycleHandler: bucket.ExportBucketLifecycleHandlerFunc(func(params bucket.ExportBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ExportBucketLifecycle has not yet been implemented")
+		}),
 		ConfigurationExportConfigHandler: configuration.ExportConfigHandlerFunc(func(params configuration.ExportConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ExportConfig has not yet been implemented")
 		}),
+		ConfigurationExportIAMHandler: configuration.ExportIAMHandlerFunc(func(params configuration.ExportIAMParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ExportIAM has not yet been implemented")
+		}),
+		LoggingExportLogEntriesHandler: logging.ExportLogEntriesHandlerFunc(func(params logging.ExportLogEntriesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation logging.ExportLogEntries has not yet been implemented")
+		}),
+		ConfigurationExportServerConfigHandler: configuration.ExportServerConfigHandlerFunc(func(params configuration.ExportServerConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ExportServerConfig has not yet been implemented")
+		}),
+		BatchJobsGenerateBatchJobHandler: batch_jobs.GenerateBatchJobHandlerFunc(func(params batch_jobs.GenerateBatchJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch_jobs.GenerateBatchJob has not yet been implemented")
+		}),
+		BucketGenerateBucketPolicyHandler: bucket.GenerateBucketPolicyHandlerFunc(func(params bucket.GenerateBucketPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GenerateBucketPolicy has not yet been implemented")
+		}),
+		AccountGetAPITokenUsageHandler: account.GetAPITokenUsageHandlerFunc(func(params account.GetAPITokenUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.GetAPITokenUsage has not yet been implemented")
+		}),
+		SystemGetAlertsHandler: system.GetAlertsHandlerFunc(func(params system.GetAlertsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetAlerts has not yet been implemented")
+		}),
+		SystemGetBackgroundHealStatusHandler: system.GetBackgroundHealStatusHandlerFunc(func(params system.GetBackgroundHealStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetBackgroundHealStatus has not yet been implemented")
+		}),
+		BucketGetBucketAccessInsightHandler: bucket.GetBucketAccessInsightHandlerFunc(func(params bucket.GetBucketAccessInsightParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketAccessInsight has not yet been implemented")
+		}),
+		BucketGetBucketCorsHandler: bucket.GetBucketCorsHandlerFunc(func(params bucket.GetBucketCorsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketCors has not yet been implemented")
+		}),
 		BucketGetBucketEncryptionInfoHandler: bucket.GetBucketEncryptionInfoHandlerFunc(func(params bucket.GetBucketEncryptionInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketEncryptionInfo has not yet been implemented")
 		}),
@@ -229,12 +349,21 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketGetBucketObjectLockingStatusHandler: bucket.GetBucketObjectLockingStatusHandlerFunc(func(params bucket.GetBucketObjectLockingStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketObjectLockingStatus has not yet been implemented")
 		}),
+		BucketGetBucketPrefixUsageHandler: bucket.GetBucketPrefixUsageHandlerFunc(func(params bucket.GetBucketPrefixUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketPrefixUsage has not yet been implemented")
+		}),
 		BucketGetBucketQuotaHandler: bucket.GetBucketQuotaHandlerFunc(func(params bucket.GetBucketQuotaParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketQuota has not yet been implemented")
 		}),
+		BucketGetBucketRenameJobHandler: bucket.GetBucketRenameJobHandlerFunc(func(params bucket.GetBucketRenameJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketRenameJob has not yet been implemented")
+		}),
 		BucketGetBucketReplicationHandler: bucket.GetBucketReplicationHandlerFunc(func(params bucket.GetBucketReplicationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketReplication has not yet been implemented")
 		}),
+		BucketGetBucketReplicationMetricsHandler: bucket.GetBucketReplicationMetricsHandlerFunc(func(params bucket.GetBucketReplicationMetricsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketReplicationMetrics has not yet been implemented")
+		}),
 		BucketGetBucketReplicationRuleHandler: bucket.GetBucketReplicationRuleHandlerFunc(func(params bucket.GetBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketReplicationRule has not yet been implemented")
 		}),
@@ -244,6 +373,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketGetBucketRewindHandler: bucket.GetBucketRewindHandlerFunc(func(params bucket.GetBucketRewindParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketRewind has not yet been implemented")
 		}),
+		BucketGetBucketUsageHistoryHandler: bucket.GetBucketUsageHistoryHandlerFunc(func(params bucket.GetBucketUsageHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetBucketUsageHistory has not yet been implemented")
+		}),
 		BucketGetBucketVersioningHandler: bucket.GetBucketVersioningHandlerFunc(func(params bucket.GetBucketVersioningParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketVersioning has not yet been implemented")
 		}),
@@ -253,27 +385,99 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		IdpGetConfigurationHandler: idp.GetConfigurationHandlerFunc(func(params idp.GetConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.GetConfiguration has not yet been implemented")
 		}),
+		SystemGetDriveTopologyHandler: system.GetDriveTopologyHandlerFunc(func(params system.GetDriveTopologyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetDriveTopology has not yet been implemented")
+		}),
+		SystemGetDrivesHealthHandler: system.GetDrivesHealthHandlerFunc(func(params system.GetDrivesHealthParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetDrivesHealth has not yet been implemented")
+		}),
+		IdpGetLDAPEffectivePolicyHandler: idp.GetLDAPEffectivePolicyHandlerFunc(func(params idp.GetLDAPEffectivePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.GetLDAPEffectivePolicy has not yet been implemented")
+		}),
 		IdpGetLDAPEntitiesHandler: idp.GetLDAPEntitiesHandlerFunc(func(params idp.GetLDAPEntitiesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.GetLDAPEntities has not yet been implemented")
 		}),
+		LoggingGetLogLevelHandler: logging.GetLogLevelHandlerFunc(func(params logging.GetLogLevelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation logging.GetLogLevel has not yet been implemented")
+		}),
+		ConfigurationGetLogTargetHandler: configuration.GetLogTargetHandlerFunc(func(params configuration.GetLogTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.GetLogTarget has not yet been implemented")
+		}),
+		SystemGetMetricsDashboardHandler: system.GetMetricsDashboardHandlerFunc(func(params system.GetMetricsDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetMetricsDashboard has not yet been implemented")
+		}),
+		ConfigurationGetNotificationEndpointHandler: configuration.GetNotificationEndpointHandlerFunc(func(params configuration.GetNotificationEndpointParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.GetNotificationEndpoint has not yet been implemented")
+		}),
+		ObjectGetObjectChecksumManifestHandler: object.GetObjectChecksumManifestHandlerFunc(func(params object.GetObjectChecksumManifestParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.GetObjectChecksumManifest has not yet been implemented")
+		}),
 		ObjectGetObjectMetadataHandler: object.GetObjectMetadataHandlerFunc(func(params object.GetObjectMetadataParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.GetObjectMetadata has not yet been implemented")
 		}),
+		ObjectGetObjectTierRestoreStatusHandler: object.GetObjectTierRestoreStatusHandlerFunc(func(params object.GetObjectTierRestoreStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.GetObjectTierRestoreStatus has not yet been implemented")
+		}),
+		IdpGetOpenIDClaimMappingHandler: idp.GetOpenIDClaimMappingHandlerFunc(func(params idp.GetOpenIDClaimMappingParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.GetOpenIDClaimMapping has not yet been implemented")
+		}),
+		SystemGetPoolStatusHandler: system.GetPoolStatusHandlerFunc(func(params system.GetPoolStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetPoolStatus has not yet been implemented")
+		}),
+		SystemGetPreflightReportHandler: system.GetPreflightReportHandlerFunc(func(params system.GetPreflightReportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetPreflightReport has not yet been implemented")
+		}),
+		SystemGetRebalanceStatusHandler: system.GetRebalanceStatusHandlerFunc(func(params system.GetRebalanceStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetRebalanceStatus has not yet been implemented")
+		}),
+		BucketGetReplicationResyncStatusHandler: bucket.GetReplicationResyncStatusHandlerFunc(func(params bucket.GetReplicationResyncStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetReplicationResyncStatus has not yet been implemented")
+		}),
+		BucketGetReplicationRetryJobHandler: bucket.GetReplicationRetryJobHandlerFunc(func(params bucket.GetReplicationRetryJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.GetReplicationRetryJob has not yet been implemented")
+		}),
 		PolicyGetSAUserPolicyHandler: policy.GetSAUserPolicyHandlerFunc(func(params policy.GetSAUserPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.GetSAUserPolicy has not yet been implemented")
 		}),
+		SystemGetScannerStatusHandler: system.GetScannerStatusHandlerFunc(func(params system.GetScannerStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetScannerStatus has not yet been implemented")
+		}),
 		ServiceAccountGetServiceAccountPolicyHandler: service_account.GetServiceAccountPolicyHandlerFunc(func(params service_account.GetServiceAccountPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.GetServiceAccountPolicy has not yet been implemented")
 		}),
+		SiteReplicationGetSiteReplicationHealthHandler: site_replication.GetSiteReplicationHealthHandlerFunc(func(params site_replication.GetSiteReplicationHealthParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation site_replication.GetSiteReplicationHealth has not yet been implemented")
+		}),
 		SiteReplicationGetSiteReplicationInfoHandler: site_replication.GetSiteReplicationInfoHandlerFunc(func(params site_replication.GetSiteReplicationInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.GetSiteReplicationInfo has not yet been implemented")
 		}),
 		SiteReplicationGetSiteReplicationStatusHandler: site_replication.GetSiteReplicationStatusHandlerFunc(func(params site_replication.GetSiteReplicationStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.GetSiteReplicationStatus has not yet been implemented")
 		}),
+		StagingGetStagingWorkspaceHandler: staging.GetStagingWorkspaceHandlerFunc(func(params staging.GetStagingWorkspaceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation staging.GetStagingWorkspace has not yet been implemented")
+		}),
+		SystemGetTLSCertificatesHandler: system.GetTLSCertificatesHandlerFunc(func(params system.GetTLSCertificatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetTLSCertificates has not yet been implemented")
+		}),
 		TieringGetTierHandler: tiering.GetTierHandlerFunc(func(params tiering.GetTierParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.GetTier has not yet been implemented")
 		}),
+		TieringGetTierStatsHandler: tiering.GetTierStatsHandlerFunc(func(params tiering.GetTierStatsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tiering.GetTierStats has not yet been implemented")
+		}),
+		SystemGetTopLocksHandler: system.GetTopLocksHandlerFunc(func(params system.GetTopLocksParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetTopLocks has not yet been implemented")
+		}),
+		SystemGetTraceStatsHandler: system.GetTraceStatsHandlerFunc(func(params system.GetTraceStatsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.GetTraceStats has not yet been implemented")
+		}),
+		ConfigurationGetTrustedProxiesHandler: configuration.GetTrustedProxiesHandlerFunc(func(params configuration.GetTrustedProxiesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.GetTrustedProxies has not yet been implemented")
+		}),
+		UserGetUserEffectivePolicyHandler: user.GetUserEffectivePolicyHandlerFunc(func(params user.GetUserEffectivePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.GetUserEffectivePolicy has not yet been implemented")
+		}),
 		UserGetUserInfoHandler: user.GetUserInfoHandlerFunc(func(params user.GetUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.GetUserInfo has not yet been implemented")
 		}),
@@ -283,9 +487,27 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		GroupGroupInfoHandler: group.GroupInfoHandlerFunc(func(params group.GroupInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.GroupInfo has not yet been implemented")
 		}),
+		BucketImportBucketConfigHandler: bucket.ImportBucketConfigHandlerFunc(func(params bucket.ImportBucketConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ImportBucketConfig has not yet been implemented")
+		}),
+		BucketImportBucketLifecycleHandler: bucket.ImportBucketLifecycleHandlerFunc(func(params bucket.ImportBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ImportBucketLifecycle has not yet been implemented")
+		}),
+		ConfigurationImportIAMHandler: configuration.ImportIAMHandlerFunc(func(params configuration.ImportIAMParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ImportIAM has not yet been implemented")
+		}),
+		ConfigurationImportServerConfigHandler: configuration.ImportServerConfigHandlerFunc(func(params configuration.ImportServerConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ImportServerConfig has not yet been implemented")
+		}),
+		UserImportUsersHandler: user.ImportUsersHandlerFunc(func(params user.ImportUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ImportUsers has not yet been implemented")
+		}),
 		InspectInspectHandler: inspect.InspectHandlerFunc(func(params inspect.InspectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		}),
+		InspectInspectBundleHandler: inspect.InspectBundleHandlerFunc(func(params inspect.InspectBundleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation inspect.InspectBundle has not yet been implemented")
+		}),
 		KmsKMSAPIsHandler: k_m_s.KMSAPIsHandlerFunc(func(params k_m_s.KMSAPIsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation k_m_s.KMSAPIs has not yet been implemented")
 		}),
@@ -340,27 +562,60 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		KmsKMSStatusHandler: k_m_s.KMSStatusHandlerFunc(func(params k_m_s.KMSStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation k_m_s.KMSStatus has not yet been implemented")
 		}),
+		KmsKMSTestKeyHandler: k_m_s.KMSTestKeyHandlerFunc(func(params k_m_s.KMSTestKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation k_m_s.KMSTestKey has not yet been implemented")
+		}),
 		KmsKMSVersionHandler: k_m_s.KMSVersionHandlerFunc(func(params k_m_s.KMSVersionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation k_m_s.KMSVersion has not yet been implemented")
 		}),
+		AccountListAPITokensHandler: account.ListAPITokensHandlerFunc(func(params account.ListAPITokensParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.ListAPITokens has not yet been implemented")
+		}),
 		UserListAUserServiceAccountsHandler: user.ListAUserServiceAccountsHandlerFunc(func(params user.ListAUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.ListAUserServiceAccounts has not yet been implemented")
 		}),
+		UserListAccessKeyInventoryHandler: user.ListAccessKeyInventoryHandlerFunc(func(params user.ListAccessKeyInventoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ListAccessKeyInventory has not yet been implemented")
+		}),
 		BucketListAccessRulesWithBucketHandler: bucket.ListAccessRulesWithBucketHandlerFunc(func(params bucket.ListAccessRulesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListAccessRulesWithBucket has not yet been implemented")
 		}),
+		SystemListAlertRulesHandler: system.ListAlertRulesHandlerFunc(func(params system.ListAlertRulesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListAlertRules has not yet been implemented")
+		}),
+		BatchJobsListBatchJobsHandler: batch_jobs.ListBatchJobsHandlerFunc(func(params batch_jobs.ListBatchJobsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch_jobs.ListBatchJobs has not yet been implemented")
+		}),
+		BucketListBucketEncryptionKeysHandler: bucket.ListBucketEncryptionKeysHandlerFunc(func(params bucket.ListBucketEncryptionKeysParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ListBucketEncryptionKeys has not yet been implemented")
+		}),
 		BucketListBucketEventsHandler: bucket.ListBucketEventsHandlerFunc(func(params bucket.ListBucketEventsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListBucketEvents has not yet been implemented")
 		}),
+		BucketListBucketReplicationTargetsHandler: bucket.ListBucketReplicationTargetsHandlerFunc(func(params bucket.ListBucketReplicationTargetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ListBucketReplicationTargets has not yet been implemented")
+		}),
 		BucketListBucketsHandler: bucket.ListBucketsHandlerFunc(func(params bucket.ListBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListBuckets has not yet been implemented")
 		}),
+		SystemListCSPReportsHandler: system.ListCSPReportsHandlerFunc(func(params system.ListCSPReportsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListCSPReports has not yet been implemented")
+		}),
+		SystemListClustersHandler: system.ListClustersHandlerFunc(func(params system.ListClustersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListClusters has not yet been implemented")
+		}),
 		ConfigurationListConfigHandler: configuration.ListConfigHandlerFunc(func(params configuration.ListConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ListConfig has not yet been implemented")
 		}),
+		ConfigurationListConfigRevisionsHandler: configuration.ListConfigRevisionsHandlerFunc(func(params configuration.ListConfigRevisionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ListConfigRevisions has not yet been implemented")
+		}),
 		IdpListConfigurationsHandler: idp.ListConfigurationsHandlerFunc(func(params idp.ListConfigurationsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation idp.ListConfigurations has not yet been implemented")
 		}),
+		LoggingListConsoleAuditEventsHandler: logging.ListConsoleAuditEventsHandlerFunc(func(params logging.ListConsoleAuditEventsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation logging.ListConsoleAuditEvents has not yet been implemented")
+		}),
 		BucketListExternalBucketsHandler: bucket.ListExternalBucketsHandlerFunc(func(params bucket.ListExternalBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListExternalBuckets has not yet been implemented")
 		}),
@@ -370,6 +625,15 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		PolicyListGroupsForPolicyHandler: policy.ListGroupsForPolicyHandlerFunc(func(params policy.ListGroupsForPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.ListGroupsForPolicy has not yet been implemented")
 		}),
+		IdpListLDAPEntitiesHandler: idp.ListLDAPEntitiesHandlerFunc(func(params idp.ListLDAPEntitiesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.ListLDAPEntities has not yet been implemented")
+		}),
+		ConfigurationListLogTargetsHandler: configuration.ListLogTargetsHandlerFunc(func(params configuration.ListLogTargetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ListLogTargets has not yet been implemented")
+		}),
+		UserListLoginAttemptsHandler: user.ListLoginAttemptsHandlerFunc(func(params user.ListLoginAttemptsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ListLoginAttempts has not yet been implemented")
+		}),
 		SystemListNodesHandler: system.ListNodesHandlerFunc(func(params system.ListNodesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.ListNodes has not yet been implemented")
 		}),
@@ -382,12 +646,24 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketListPoliciesWithBucketHandler: bucket.ListPoliciesWithBucketHandlerFunc(func(params bucket.ListPoliciesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListPoliciesWithBucket has not yet been implemented")
 		}),
+		PolicyListPolicyEntitiesHandler: policy.ListPolicyEntitiesHandlerFunc(func(params policy.ListPolicyEntitiesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ListPolicyEntities has not yet been implemented")
+		}),
+		PolicyListPolicyTemplatesHandler: policy.ListPolicyTemplatesHandlerFunc(func(params policy.ListPolicyTemplatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ListPolicyTemplates has not yet been implemented")
+		}),
+		SystemListPoolsHandler: system.ListPoolsHandlerFunc(func(params system.ListPoolsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListPools has not yet been implemented")
+		}),
 		ReleaseListReleasesHandler: release.ListReleasesHandlerFunc(func(params release.ListReleasesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation release.ListReleases has not yet been implemented")
 		}),
 		BucketListRemoteBucketsHandler: bucket.ListRemoteBucketsHandlerFunc(func(params bucket.ListRemoteBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListRemoteBuckets has not yet been implemented")
 		}),
+		StagingListStagingWorkspacesHandler: staging.ListStagingWorkspacesHandlerFunc(func(params staging.ListStagingWorkspacesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation staging.ListStagingWorkspaces has not yet been implemented")
+		}),
 		ServiceAccountListUserServiceAccountsHandler: service_account.ListUserServiceAccountsHandlerFunc(func(params service_account.ListUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.ListUserServiceAccounts has not yet been implemented")
 		}),
@@ -421,6 +697,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ConfigurationNotificationEndpointListHandler: configuration.NotificationEndpointListHandlerFunc(func(params configuration.NotificationEndpointListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.NotificationEndpointList has not yet been implemented")
 		}),
+		ConfigurationNotificationEndpointsStatusHandler: configuration.NotificationEndpointsStatusHandlerFunc(func(params configuration.NotificationEndpointsStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.NotificationEndpointsStatus has not yet been implemented")
+		}),
 		PolicyPolicyInfoHandler: policy.PolicyInfoHandlerFunc(func(params policy.PolicyInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.PolicyInfo has not yet been implemented")
 		}),
@@ -430,12 +709,24 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ConfigurationPostConfigsImportHandler: configuration.PostConfigsImportHandlerFunc(func(params configuration.PostConfigsImportParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.PostConfigsImport has not yet been implemented")
 		}),
+		IdpPreviewOpenIDClaimMappingHandler: idp.PreviewOpenIDClaimMappingHandlerFunc(func(params idp.PreviewOpenIDClaimMappingParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.PreviewOpenIDClaimMapping has not yet been implemented")
+		}),
 		ProfileProfilingStartHandler: profile.ProfilingStartHandlerFunc(func(params profile.ProfilingStartParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation profile.ProfilingStart has not yet been implemented")
 		}),
 		ProfileProfilingStopHandler: profile.ProfilingStopHandlerFunc(func(params profile.ProfilingStopParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation profile.ProfilingStop has not yet been implemented")
 		}),
+		PublicPublicDownloadObjectHandler: public.PublicDownloadObjectHandlerFunc(func(params public.PublicDownloadObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation public.PublicDownloadObject has not yet been implemented")
+		}),
+		PublicPublicListObjectsHandler: public.PublicListObjectsHandlerFunc(func(params public.PublicListObjectsParams) middleware.Responder {
+			return middleware.NotImplemented("operation public.PublicListObjects has not yet been implemented")
+		}),
+		BucketPutBucketCorsHandler: bucket.PutBucketCorsHandlerFunc(func(params bucket.PutBucketCorsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.PutBucketCors has not yet been implemented")
+		}),
 		BucketPutBucketTagsHandler: bucket.PutBucketTagsHandlerFunc(func(params bucket.PutBucketTagsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.PutBucketTags has not yet been implemented")
 		}),
@@ -451,6 +742,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ObjectPutObjectTagsHandler: object.PutObjectTagsHandlerFunc(func(params object.PutObjectTagsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.PutObjectTags has not yet been implemented")
 		}),
+		SystemRefreshDataUsageHandler: system.RefreshDataUsageHandlerFunc(func(params system.RefreshDataUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.RefreshDataUsage has not yet been implemented")
+		}),
+		ConfigurationReloadConsoleConfigHandler: configuration.ReloadConsoleConfigHandlerFunc(func(params configuration.ReloadConsoleConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.ReloadConsoleConfig has not yet been implemented")
+		}),
 		BucketRemoteBucketDetailsHandler: bucket.RemoteBucketDetailsHandlerFunc(func(params bucket.RemoteBucketDetailsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.RemoteBucketDetails has not yet been implemented")
 		}),
@@ -460,24 +757,63 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		PolicyRemovePolicyHandler: policy.RemovePolicyHandlerFunc(func(params policy.RemovePolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.RemovePolicy has not yet been implemented")
 		}),
+		StagingRemoveStagedOperationHandler: staging.RemoveStagedOperationHandlerFunc(func(params staging.RemoveStagedOperationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation staging.RemoveStagedOperation has not yet been implemented")
+		}),
+		TieringRemoveTierHandler: tiering.RemoveTierHandlerFunc(func(params tiering.RemoveTierParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tiering.RemoveTier has not yet been implemented")
+		}),
 		UserRemoveUserHandler: user.RemoveUserHandlerFunc(func(params user.RemoveUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.RemoveUser has not yet been implemented")
 		}),
+		PolicyRenderPolicyTemplateHandler: policy.RenderPolicyTemplateHandlerFunc(func(params policy.RenderPolicyTemplateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.RenderPolicyTemplate has not yet been implemented")
+		}),
 		ConfigurationResetConfigHandler: configuration.ResetConfigHandlerFunc(func(params configuration.ResetConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ResetConfig has not yet been implemented")
 		}),
+		UserResetUserPasswordHandler: user.ResetUserPasswordHandlerFunc(func(params user.ResetUserPasswordParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ResetUserPassword has not yet been implemented")
+		}),
+		UserResetUserTwoFactorHandler: user.ResetUserTwoFactorHandlerFunc(func(params user.ResetUserTwoFactorParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ResetUserTwoFactor has not yet been implemented")
+		}),
 		ServiceRestartServiceHandler: service.RestartServiceHandlerFunc(func(params service.RestartServiceParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.RestartService has not yet been implemented")
 		}),
+		ObjectRestoreTieredObjectHandler: object.RestoreTieredObjectHandlerFunc(func(params object.RestoreTieredObjectParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.RestoreTieredObject has not yet been implemented")
+		}),
+		AccountRevokeAPITokenHandler: account.RevokeAPITokenHandlerFunc(func(params account.RevokeAPITokenParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.RevokeAPIToken has not yet been implemented")
+		}),
+		ConfigurationRollbackConfigRevisionHandler: configuration.RollbackConfigRevisionHandlerFunc(func(params configuration.RollbackConfigRevisionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.RollbackConfigRevision has not yet been implemented")
+		}),
+		AuthRotateSessionKeyHandler: auth.RotateSessionKeyHandlerFunc(func(params auth.RotateSessionKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.RotateSessionKey has not yet been implemented")
+		}),
+		LoggingSearchLogEntriesHandler: logging.SearchLogEntriesHandlerFunc(func(params logging.SearchLogEntriesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation logging.SearchLogEntries has not yet been implemented")
+		}),
 		AuthSessionCheckHandler: auth.SessionCheckHandlerFunc(func(params auth.SessionCheckParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation auth.SessionCheck has not yet been implemented")
 		}),
+		AuthSessionRenewHandler: auth.SessionRenewHandlerFunc(func(params auth.SessionRenewParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.SessionRenew has not yet been implemented")
+		}),
 		BucketSetAccessRuleWithBucketHandler: bucket.SetAccessRuleWithBucketHandlerFunc(func(params bucket.SetAccessRuleWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.SetAccessRuleWithBucket has not yet been implemented")
 		}),
+		SystemSetAlertRuleHandler: system.SetAlertRuleHandlerFunc(func(params system.SetAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.SetAlertRule has not yet been implemented")
+		}),
 		BucketSetBucketQuotaHandler: bucket.SetBucketQuotaHandlerFunc(func(params bucket.SetBucketQuotaParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.SetBucketQuota has not yet been implemented")
 		}),
+		BucketSetBucketReplicationPrioritiesHandler: bucket.SetBucketReplicationPrioritiesHandlerFunc(func(params bucket.SetBucketReplicationPrioritiesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.SetBucketReplicationPriorities has not yet been implemented")
+		}),
 		BucketSetBucketRetentionConfigHandler: bucket.SetBucketRetentionConfigHandlerFunc(func(params bucket.SetBucketRetentionConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.SetBucketRetentionConfig has not yet been implemented")
 		}),
@@ -487,9 +823,15 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SupportSetCallHomeStatusHandler: support.SetCallHomeStatusHandlerFunc(func(params support.SetCallHomeStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation support.SetCallHomeStatus has not yet been implemented")
 		}),
+		SystemSetClusterHandler: system.SetClusterHandlerFunc(func(params system.SetClusterParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.SetCluster has not yet been implemented")
+		}),
 		ConfigurationSetConfigHandler: configuration.SetConfigHandlerFunc(func(params configuration.SetConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.SetConfig has not yet been implemented")
 		}),
+		LoggingSetLogLevelHandler: logging.SetLogLevelHandlerFunc(func(params logging.SetLogLevelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation logging.SetLogLevel has not yet been implemented")
+		}),
 		BucketSetMultiBucketReplicationHandler: bucket.SetMultiBucketReplicationHandlerFunc(func(params bucket.SetMultiBucketReplicationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.SetMultiBucketReplication has not yet been implemented")
 		}),
@@ -502,9 +844,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountSetServiceAccountPolicyHandler: service_account.SetServiceAccountPolicyHandlerFunc(func(params service_account.SetServiceAccountPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.SetServiceAccountPolicy has not yet been implemented")
 		}),
+		ConfigurationSetTrustedProxiesHandler: configuration.SetTrustedProxiesHandlerFunc(func(params configuration.SetTrustedProxiesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.SetTrustedProxies has not yet been implemented")
+		}),
 		ObjectShareObjectHandler: object.ShareObjectHandlerFunc(func(params object.ShareObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.ShareObject has not yet been implemented")
 		}),
+		PolicySimulatePolicyHandler: policy.SimulatePolicyHandlerFunc(func(params policy.SimulatePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.SimulatePolicy has not yet been implemented")
+		}),
+		SiteReplicationSiteReplicationCompareHandler: site_replication.SiteReplicationCompareHandlerFunc(func(params site_replication.SiteReplicationCompareParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation site_replication.SiteReplicationCompare has not yet been implemented")
+		}),
 		SiteReplicationSiteReplicationEditHandler: site_replication.SiteReplicationEditHandlerFunc(func(params site_replication.SiteReplicationEditParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.SiteReplicationEdit has not yet been implemented")
 		}),
@@ -514,6 +865,30 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SiteReplicationSiteReplicationRemoveHandler: site_replication.SiteReplicationRemoveHandlerFunc(func(params site_replication.SiteReplicationRemoveParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.SiteReplicationRemove has not yet been implemented")
 		}),
+		BatchJobsStartBatchJobHandler: batch_jobs.StartBatchJobHandlerFunc(func(params batch_jobs.StartBatchJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation batch_jobs.StartBatchJob has not yet been implemented")
+		}),
+		BucketStartBucketRenameHandler: bucket.StartBucketRenameHandlerFunc(func(params bucket.StartBucketRenameParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.StartBucketRename has not yet been implemented")
+		}),
+		SystemStartHealHandler: system.StartHealHandlerFunc(func(params system.StartHealParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.StartHeal has not yet been implemented")
+		}),
+		SystemStartPoolDecommissionHandler: system.StartPoolDecommissionHandlerFunc(func(params system.StartPoolDecommissionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.StartPoolDecommission has not yet been implemented")
+		}),
+		SystemStartRebalanceHandler: system.StartRebalanceHandlerFunc(func(params system.StartRebalanceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.StartRebalance has not yet been implemented")
+		}),
+		BucketStartReplicationResyncHandler: bucket.StartReplicationResyncHandlerFunc(func(params bucket.StartReplicationResyncParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.StartReplicationResync has not yet been implemented")
+		}),
+		BucketStartReplicationRetryHandler: bucket.StartReplicationRetryHandlerFunc(func(params bucket.StartReplicationRetryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.StartReplicationRetry has not yet been implemented")
+		}),
+		SystemStopRebalanceHandler: system.StopRebalanceHandlerFunc(func(params system.StopRebalanceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.StopRebalance has not yet been implemented")
+		}),
 		SubnetSubnetAPIKeyHandler: subnet.SubnetAPIKeyHandlerFunc(func(params subnet.SubnetAPIKeyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation subnet.SubnetAPIKey has not yet been implemented")
 		}),
@@ -532,9 +907,30 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SubnetSubnetRegisterHandler: subnet.SubnetRegisterHandlerFunc(func(params subnet.SubnetRegisterParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation subnet.SubnetRegister has not yet been implemented")
 		}),
+		AuthSwitchClusterHandler: auth.SwitchClusterHandlerFunc(func(params auth.SwitchClusterParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation auth.SwitchCluster has not yet been implemented")
+		}),
+		BucketTestBucketEventHandler: bucket.TestBucketEventHandlerFunc(func(params bucket.TestBucketEventParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.TestBucketEvent has not yet been implemented")
+		}),
+		IdpTestIDPConfigurationHandler: idp.TestIDPConfigurationHandlerFunc(func(params idp.TestIDPConfigurationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.TestIDPConfiguration has not yet been implemented")
+		}),
+		ConfigurationTestLogTargetHandler: configuration.TestLogTargetHandlerFunc(func(params configuration.TestLogTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.TestLogTarget has not yet been implemented")
+		}),
+		ConfigurationTestNotificationEndpointHandler: configuration.TestNotificationEndpointHandlerFunc(func(params configuration.TestNotificationEndpointParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.TestNotificationEndpoint has not yet been implemented")
+		}),
 		TieringTiersListHandler: tiering.TiersListHandlerFunc(func(params tiering.TiersListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.TiersList has not yet been implemented")
 		}),
+		AccountTwoFactorStatusHandler: account.TwoFactorStatusHandlerFunc(func(params account.TwoFactorStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.TwoFactorStatus has not yet been implemented")
+		}),
+		UserUnlockLoginAttemptsHandler: user.UnlockLoginAttemptsHandlerFunc(func(params user.UnlockLoginAttemptsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.UnlockLoginAttempts has not yet been implemented")
+		}),
 		BucketUpdateBucketLifecycleHandler: bucket.UpdateBucketLifecycleHandlerFunc(func(params bucket.UpdateBucketLifecycleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.UpdateBucketLifecycle has not yet been implemented")
 		}),
@@ -544,15 +940,42 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		GroupUpdateGroupHandler: group.UpdateGroupHandlerFunc(func(params group.UpdateGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.UpdateGroup has not yet been implemented")
 		}),
+		ConfigurationUpdateLogTargetHandler: configuration.UpdateLogTargetHandlerFunc(func(params configuration.UpdateLogTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.UpdateLogTarget has not yet been implemented")
+		}),
 		BucketUpdateMultiBucketReplicationHandler: bucket.UpdateMultiBucketReplicationHandlerFunc(func(params bucket.UpdateMultiBucketReplicationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.UpdateMultiBucketReplication has not yet been implemented")
 		}),
+		ConfigurationUpdateNotificationEndpointHandler: configuration.UpdateNotificationEndpointHandlerFunc(func(params configuration.UpdateNotificationEndpointParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation configuration.UpdateNotificationEndpoint has not yet been implemented")
+		}),
+		IdpUpdateOpenIDClaimMappingHandler: idp.UpdateOpenIDClaimMappingHandlerFunc(func(params idp.UpdateOpenIDClaimMappingParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation idp.UpdateOpenIDClaimMapping has not yet been implemented")
+		}),
 		UserUpdateUserGroupsHandler: user.UpdateUserGroupsHandlerFunc(func(params user.UpdateUserGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.UpdateUserGroups has not yet been implemented")
 		}),
 		UserUpdateUserInfoHandler: user.UpdateUserInfoHandlerFunc(func(params user.UpdateUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.UpdateUserInfo has not yet been implemented")
 		}),
+		BucketValidateBucketPolicyHandler: bucket.ValidateBucketPolicyHandlerFunc(func(params bucket.ValidateBucketPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation bucket.ValidateBucketPolicy has not yet been implemented")
+		}),
+		PolicyValidatePolicyHandler: policy.ValidatePolicyHandlerFunc(func(params policy.ValidatePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ValidatePolicy has not yet been implemented")
+		}),
+		ObjectVerifyObjectChecksumManifestHandler: object.VerifyObjectChecksumManifestHandlerFunc(func(params object.VerifyObjectChecksumManifestParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.VerifyObjectChecksumManifest has not yet been implemented")
+		}),
+		ObjectVerifyObjectIntegrityHandler: object.VerifyObjectIntegrityHandlerFunc(func(params object.VerifyObjectIntegrityParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation object.VerifyObjectIntegrity has not yet been implemented")
+		}),
+		TieringVerifyTierHandler: tiering.VerifyTierHandlerFunc(func(params tiering.VerifyTierParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation tiering.VerifyTier has not yet been implemented")
+		}),
+		AccountVerifyTwoFactorHandler: account.VerifyTwoFactorHandlerFunc(func(params account.VerifyTwoFactorParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation account.VerifyTwoFactor has not yet been implemented")
+		}),
 
 		// Applies when the "X-Anonymous" header is set
 		AnonymousAuth: func(token string) (*models.Principal, error) {
@@ -625,6 +1048,8 @@ type ConsoleAPI struct {
 	BucketAddBucketLifecycleHandler bucket.AddBucketLifecycleHandler
 	// GroupAddGroupHandler sets the operation handler for the add group operation
 	GroupAddGroupHandler group.AddGroupHandler
+	// ConfigurationAddLogTargetHandler sets the operation handler for the add log target operation
+	ConfigurationAddLogTargetHandler configuration.AddLogTargetHandler
 	// BucketAddMultiBucketLifecycleHandler sets the operation handler for the add multi bucket lifecycle operation
 	BucketAddMultiBucketLifecycleHandler bucket.AddMultiBucketLifecycleHandler
 	// ConfigurationAddNotificationEndpointHandler sets the operation handler for the add notification endpoint operation
@@ -633,6 +1058,8 @@ type ConsoleAPI struct {
 	PolicyAddPolicyHandler policy.AddPolicyHandler
 	// BucketAddRemoteBucketHandler sets the operation handler for the add remote bucket operation
 	BucketAddRemoteBucketHandler bucket.AddRemoteBucketHandler
+	// StagingAddStagedOperationsHandler sets the operation handler for the add staged operations operation
+	StagingAddStagedOperationsHandler staging.AddStagedOperationsHandler
 	// TieringAddTierHandler sets the operation handler for the add tier operation
 	TieringAddTierHandler tiering.AddTierHandler
 	// UserAddUserHandler sets the operation handler for the add user operation
@@ -641,20 +1068,42 @@ type ConsoleAPI struct {
 	SystemAdminInfoHandler system.AdminInfoHandler
 	// SystemArnListHandler sets the operation handler for the arn list operation
 	SystemArnListHandler system.ArnListHandler
+	// IdpAttachLDAPPolicyHandler sets the operation handler for the attach l d a p policy operation
+	IdpAttachLDAPPolicyHandler idp.AttachLDAPPolicyHandler
+	// SystemBatchHandler sets the operation handler for the batch operation
+	SystemBatchHandler system.BatchHandler
 	// BucketBucketInfoHandler sets the operation handler for the bucket info operation
 	BucketBucketInfoHandler bucket.BucketInfoHandler
 	// BucketBucketSetPolicyHandler sets the operation handler for the bucket set policy operation
 	BucketBucketSetPolicyHandler bucket.BucketSetPolicyHandler
+	// BucketBulkBucketOperationHandler sets the operation handler for the bulk bucket operation operation
+	BucketBulkBucketOperationHandler bucket.BulkBucketOperationHandler
 	// UserBulkUpdateUsersGroupsHandler sets the operation handler for the bulk update users groups operation
 	UserBulkUpdateUsersGroupsHandler user.BulkUpdateUsersGroupsHandler
+	// BatchJobsCancelBatchJobHandler sets the operation handler for the cancel batch job operation
+	BatchJobsCancelBatchJobHandler batch_jobs.CancelBatchJobHandler
+	// BucketCancelBucketRenameJobHandler sets the operation handler for the cancel bucket rename job operation
+	BucketCancelBucketRenameJobHandler bucket.CancelBucketRenameJobHandler
+	// SystemCancelPoolDecommissionHandler sets the operation handler for the cancel pool decommission operation
+	SystemCancelPoolDecommissionHandler system.CancelPoolDecommissionHandler
+	// BucketCancelReplicationResyncHandler sets the operation handler for the cancel replication resync operation
+	BucketCancelReplicationResyncHandler bucket.CancelReplicationResyncHandler
 	// AccountChangeUserPasswordHandler sets the operation handler for the change user password operation
 	AccountChangeUserPasswordHandler account.ChangeUserPasswordHandler
 	// SystemCheckMinIOVersionHandler sets the operation handler for the check min i o version operation
 	SystemCheckMinIOVersionHandler system.CheckMinIOVersionHandler
+	// ServiceCheckServerUpdateHandler sets the operation handler for the check server update operation
+	ServiceCheckServerUpdateHandler service.CheckServerUpdateHandler
 	// UserCheckUserServiceAccountsHandler sets the operation handler for the check user service accounts operation
 	UserCheckUserServiceAccountsHandler user.CheckUserServiceAccountsHandler
+	// BucketClearBucketRetentionConfigHandler sets the operation handler for the clear bucket retention config operation
+	BucketClearBucketRetentionConfigHandler bucket.ClearBucketRetentionConfigHandler
+	// StagingCommitStagingWorkspaceHandler sets the operation handler for the commit staging workspace operation
+	StagingCommitStagingWorkspaceHandler staging.CommitStagingWorkspaceHandler
 	// ConfigurationConfigInfoHandler sets the operation handler for the config info operation
 	ConfigurationConfigInfoHandler configuration.ConfigInfoHandler
+	// AccountCreateAPITokenHandler sets the operation handler for the create API token operation
+	AccountCreateAPITokenHandler account.CreateAPITokenHandler
 	// UserCreateAUserServiceAccountHandler sets the operation handler for the create a user service account operation
 	UserCreateAUserServiceAccountHandler user.CreateAUserServiceAccountHandler
 	// BucketCreateBucketEventHandler sets the operation handler for the create bucket event operation
@@ -667,26 +1116,40 @@ type ConsoleAPI struct {
 	UserCreateServiceAccountCredentialsHandler user.CreateServiceAccountCredentialsHandler
 	// ServiceAccountCreateServiceAccountCredsHandler sets the operation handler for the create service account creds operation
 	ServiceAccountCreateServiceAccountCredsHandler service_account.CreateServiceAccountCredsHandler
+	// ServiceCreateServiceConfirmationHandler sets the operation handler for the create service confirmation operation
+	ServiceCreateServiceConfirmationHandler service.CreateServiceConfirmationHandler
+	// StagingCreateStagingWorkspaceHandler sets the operation handler for the create staging workspace operation
+	StagingCreateStagingWorkspaceHandler staging.CreateStagingWorkspaceHandler
+	// AccountCreateTemporaryCredentialsHandler sets the operation handler for the create temporary credentials operation
+	AccountCreateTemporaryCredentialsHandler account.CreateTemporaryCredentialsHandler
 	// SystemDashboardWidgetDetailsHandler sets the operation handler for the dashboard widget details operation
 	SystemDashboardWidgetDetailsHandler system.DashboardWidgetDetailsHandler
 	// BucketDeleteAccessRuleWithBucketHandler sets the operation handler for the delete access rule with bucket operation
 	BucketDeleteAccessRuleWithBucketHandler bucket.DeleteAccessRuleWithBucketHandler
+	// SystemDeleteAlertRuleHandler sets the operation handler for the delete alert rule operation
+	SystemDeleteAlertRuleHandler system.DeleteAlertRuleHandler
 	// BucketDeleteAllReplicationRulesHandler sets the operation handler for the delete all replication rules operation
 	BucketDeleteAllReplicationRulesHandler bucket.DeleteAllReplicationRulesHandler
 	// BucketDeleteBucketHandler sets the operation handler for the delete bucket operation
 	BucketDeleteBucketHandler bucket.DeleteBucketHandler
+	// BucketDeleteBucketCorsHandler sets the operation handler for the delete bucket cors operation
+	BucketDeleteBucketCorsHandler bucket.DeleteBucketCorsHandler
 	// BucketDeleteBucketEventHandler sets the operation handler for the delete bucket event operation
 	BucketDeleteBucketEventHandler bucket.DeleteBucketEventHandler
 	// BucketDeleteBucketLifecycleRuleHandler sets the operation handler for the delete bucket lifecycle rule operation
 	BucketDeleteBucketLifecycleRuleHandler bucket.DeleteBucketLifecycleRuleHandler
 	// BucketDeleteBucketReplicationRuleHandler sets the operation handler for the delete bucket replication rule operation
 	BucketDeleteBucketReplicationRuleHandler bucket.DeleteBucketReplicationRuleHandler
+	// SystemDeleteClusterHandler sets the operation handler for the delete cluster operation
+	SystemDeleteClusterHandler system.DeleteClusterHandler
 	// IdpDeleteConfigurationHandler sets the operation handler for the delete configuration operation
 	IdpDeleteConfigurationHandler idp.DeleteConfigurationHandler
 	// ObjectDeleteMultipleObjectsHandler sets the operation handler for the delete multiple objects operation
 	ObjectDeleteMultipleObjectsHandler object.DeleteMultipleObjectsHandler
 	// ServiceAccountDeleteMultipleServiceAccountsHandler sets the operation handler for the delete multiple service accounts operation
 	ServiceAccountDeleteMultipleServiceAccountsHandler service_account.DeleteMultipleServiceAccountsHandler
+	// ConfigurationDeleteNotificationEndpointHandler sets the operation handler for the delete notification endpoint operation
+	ConfigurationDeleteNotificationEndpointHandler configuration.DeleteNotificationEndpointHandler
 	// ObjectDeleteObjectHandler sets the operation handler for the delete object operation
 	ObjectDeleteObjectHandler object.DeleteObjectHandler
 	// ObjectDeleteObjectRetentionHandler sets the operation handler for the delete object retention operation
@@ -697,60 +1160,166 @@ type ConsoleAPI struct {
 	BucketDeleteSelectedReplicationRulesHandler bucket.DeleteSelectedReplicationRulesHandler
 	// ServiceAccountDeleteServiceAccountHandler sets the operation handler for the delete service account operation
 	ServiceAccountDeleteServiceAccountHandler service_account.DeleteServiceAccountHandler
+	// StagingDeleteStagingWorkspaceHandler sets the operation handler for the delete staging workspace operation
+	StagingDeleteStagingWorkspaceHandler staging.DeleteStagingWorkspaceHandler
+	// BatchJobsDescribeBatchJobHandler sets the operation handler for the describe batch job operation
+	BatchJobsDescribeBatchJobHandler batch_jobs.DescribeBatchJobHandler
+	// IdpDetachLDAPPolicyHandler sets the operation handler for the detach l d a p policy operation
+	IdpDetachLDAPPolicyHandler idp.DetachLDAPPolicyHandler
 	// BucketDisableBucketEncryptionHandler sets the operation handler for the disable bucket encryption operation
 	BucketDisableBucketEncryptionHandler bucket.DisableBucketEncryptionHandler
+	// ConfigurationDisableLogTargetHandler sets the operation handler for the disable log target operation
+	ConfigurationDisableLogTargetHandler configuration.DisableLogTargetHandler
+	// AccountDisableTwoFactorHandler sets the operation handler for the disable two factor operation
+	AccountDisableTwoFactorHandler account.DisableTwoFactorHandler
 	// ObjectDownloadObjectHandler sets the operation handler for the download object operation
 	ObjectDownloadObjectHandler object.DownloadObjectHandler
 	// TieringEditTierCredentialsHandler sets the operation handler for the edit tier credentials operation
 	TieringEditTierCredentialsHandler tiering.EditTierCredentialsHandler
 	// BucketEnableBucketEncryptionHandler sets the operation handler for the enable bucket encryption operation
 	BucketEnableBucketEncryptionHandler bucket.EnableBucketEncryptionHandler
+	// AccountEnrollTwoFactorHandler sets the operation handler for the enroll two factor operation
+	AccountEnrollTwoFactorHandler account.EnrollTwoFactorHandler
+	// SystemEvaluateAlertsHandler sets the operation handler for the evaluate alerts operation
+	SystemEvaluateAlertsHandler system.EvaluateAlertsHandler
+	// BucketExportBucketConfigHandler sets the operation handler for the export bucket config operation
+	BucketExportBucketConfigHandler bucket.ExportBucketConfigHandler
+	// BucketExportBucketLifecycleHandler sets the operation handler for the export bucket lifecycle operation
+	BucketExportBucketLifecycleHandler bucket.ExportBucketLifecycleHandler
 	// ConfigurationExportConfigHandler sets the operation handler for the export config operation
 	ConfigurationExportConfigHandler configuration.ExportConfigHandler
+	// ConfigurationExportIAMHandler sets the operation handler for the export i a m operation
+	ConfigurationExportIAMHandler configuration.ExportIAMHandler
+	// LoggingExportLogEntriesHandler sets the operation handler for the export log entries operation
+	LoggingExportLogEntriesHandler logging.ExportLogEntriesHandler
+	// ConfigurationExportServerConfigHandler sets the operation handler for the export server config operation
+	ConfigurationExportServerConfigHandler configuration.ExportServerConfigHandler
+	// BatchJobsGenerateBatchJobHandler sets the operation handler for the generate batch job operation
+	BatchJobsGenerateBatchJobHandler batch_jobs.GenerateBatchJobHandler
+	// BucketGenerateBucketPolicyHandler sets the operation handler for the generate bucket policy operation
+	BucketGenerateBucketPolicyHandler bucket.GenerateBucketPolicyHandler
+	// AccountGetAPITokenUsageHandler sets the operation handler for the get API token usage operation
+	AccountGetAPITokenUsageHandler account.GetAPITokenUsageHandler
+	// SystemGetAlertsHandler sets the operation handler for the get alerts operation
+	SystemGetAlertsHandler system.GetAlertsHandler
+	// SystemGetBackgroundHealStatusHandler sets the operation handler for the get background heal status operation
+	SystemGetBackgroundHealStatusHandler system.GetBackgroundHealStatusHandler
+	// BucketGetBucketAccessInsightHandler sets the operation handler for the get bucket access insight operation
+	BucketGetBucketAccessInsightHandler bucket.GetBucketAccessInsightHandler
+	// BucketGetBucketCorsHandler sets the operation handler for the get bucket cors operation
+	BucketGetBucketCorsHandler bucket.GetBucketCorsHandler
 	// BucketGetBucketEncryptionInfoHandler sets the operation handler for the get bucket encryption info operation
 	BucketGetBucketEncryptionInfoHandler bucket.GetBucketEncryptionInfoHandler
 	// BucketGetBucketLifecycleHandler sets the operation handler for the get bucket lifecycle operation
 	BucketGetBucketLifecycleHandler bucket.GetBucketLifecycleHandler
 	// BucketGetBucketObjectLockingStatusHandler sets the operation handler for the get bucket object locking status operation
 	BucketGetBucketObjectLockingStatusHandler bucket.GetBucketObjectLockingStatusHandler
+	// BucketGetBucketPrefixUsageHandler sets the operation handler for the get bucket prefix usage operation
+	BucketGetBucketPrefixUsageHandler bucket.GetBucketPrefixUsageHandler
 	// BucketGetBucketQuotaHandler sets the operation handler for the get bucket quota operation
 	BucketGetBucketQuotaHandler bucket.GetBucketQuotaHandler
+	// BucketGetBucketRenameJobHandler sets the operation handler for the get bucket rename job operation
+	BucketGetBucketRenameJobHandler bucket.GetBucketRenameJobHandler
 	// BucketGetBucketReplicationHandler sets the operation handler for the get bucket replication operation
 	BucketGetBucketReplicationHandler bucket.GetBucketReplicationHandler
+	// BucketGetBucketReplicationMetricsHandler sets the operation handler for the get bucket replication metrics operation
+	BucketGetBucketReplicationMetricsHandler bucket.GetBucketReplicationMetricsHandler
 	// BucketGetBucketReplicationRuleHandler sets the operation handler for the get bucket replication rule operation
 	BucketGetBucketReplicationRuleHandler bucket.GetBucketReplicationRuleHandler
 	// BucketGetBucketRetentionConfigHandler sets the operation handler for the get bucket retention config operation
 	BucketGetBucketRetentionConfigHandler bucket.GetBucketRetentionConfigHandler
 	// BucketGetBucketRewindHandler sets the operation handler for the get bucket rewind operation
 	BucketGetBucketRewindHandler bucket.GetBucketRewindHandler
+	// BucketGetBucketUsageHistoryHandler sets the operation handler for the get bucket usage history operation
+	BucketGetBucketUsageHistoryHandler bucket.GetBucketUsageHistoryHandler
 	// BucketGetBucketVersioningHandler sets the operation handler for the get bucket versioning operation
 	BucketGetBucketVersioningHandler bucket.GetBucketVersioningHandler
 	// SupportGetCallHomeOptionValueHandler sets the operation handler for the get call home option value operation
 	SupportGetCallHomeOptionValueHandler support.GetCallHomeOptionValueHandler
 	// IdpGetConfigurationHandler sets the operation handler for the get configuration operation
 	IdpGetConfigurationHandler idp.GetConfigurationHandler
+	// SystemGetDriveTopologyHandler sets the operation handler for the get drive topology operation
+	SystemGetDriveTopologyHandler system.GetDriveTopologyHandler
+	// SystemGetDrivesHealthHandler sets the operation handler for the get drives health operation
+	SystemGetDrivesHealthHandler system.GetDrivesHealthHandler
+	// IdpGetLDAPEffectivePolicyHandler sets the operation handler for the get l d a p effective policy operation
+	IdpGetLDAPEffectivePolicyHandler idp.GetLDAPEffectivePolicyHandler
 	// IdpGetLDAPEntitiesHandler sets the operation handler for the get l d a p entities operation
 	IdpGetLDAPEntitiesHandler idp.GetLDAPEntitiesHandler
+	// LoggingGetLogLevelHandler sets the operation handler for the get log level operation
+	LoggingGetLogLevelHandler logging.GetLogLevelHandler
+	// ConfigurationGetLogTargetHandler sets the operation handler for the get log target operation
+	ConfigurationGetLogTargetHandler configuration.GetLogTargetHandler
+	// SystemGetMetricsDashboardHandler sets the operation handler for the get metrics dashboard operation
+	SystemGetMetricsDashboardHandler system.GetMetricsDashboardHandler
+	// ConfigurationGetNotificationEndpointHandler sets the operation handler for the get notification endpoint operation
+	ConfigurationGetNotificationEndpointHandler configuration.GetNotificationEndpointHandler
+	// ObjectGetObjectChecksumManifestHandler sets the operation handler for the get object checksum manifest operation
+	ObjectGetObjectChecksumManifestHandler object.GetObjectChecksumManifestHandler
 	// ObjectGetObjectMetadataHandler sets the operation handler for the get object metadata operation
 	ObjectGetObjectMetadataHandler object.GetObjectMetadataHandler
+	// ObjectGetObjectTierRestoreStatusHandler sets the operation handler for the get object tier restore status operation
+	ObjectGetObjectTierRestoreStatusHandler object.GetObjectTierRestoreStatusHandler
+	// IdpGetOpenIDClaimMappingHandler sets the operation handler for the get open ID claim mapping operation
+	IdpGetOpenIDClaimMappingHandler idp.GetOpenIDClaimMappingHandler
+	// SystemGetPoolStatusHandler sets the operation handler for the get pool status operation
+	SystemGetPoolStatusHandler system.GetPoolStatusHandler
+	// SystemGetPreflightReportHandler sets the operation handler for the get preflight report operation
+	SystemGetPreflightReportHandler system.GetPreflightReportHandler
+	// SystemGetRebalanceStatusHandler sets the operation handler for the get rebalance status operation
+	SystemGetRebalanceStatusHandler system.GetRebalanceStatusHandler
+	// BucketGetReplicationResyncStatusHandler sets the operation handler for the get replication resync status operation
+	BucketGetReplicationResyncStatusHandler bucket.GetReplicationResyncStatusHandler
+	// BucketGetReplicationRetryJobHandler sets the operation handler for the get replication retry job operation
+	BucketGetReplicationRetryJobHandler bucket.GetReplicationRetryJobHandler
 	// PolicyGetSAUserPolicyHandler sets the operation handler for the get s a user policy operation
 	PolicyGetSAUserPolicyHandler policy.GetSAUserPolicyHandler
+	// SystemGetScannerStatusHandler sets the operation handler for the get scanner status operation
+	SystemGetScannerStatusHandler system.GetScannerStatusHandler
 	// ServiceAccountGetServiceAccountPolicyHandler sets the operation handler for the get service account policy operation
 	ServiceAccountGetServiceAccountPolicyHandler service_account.GetServiceAccountPolicyHandler
+	// SiteReplicationGetSiteReplicationHealthHandler sets the operation handler for the get site replication health operation
+	SiteReplicationGetSiteReplicationHealthHandler site_replication.GetSiteReplicationHealthHandler
 	// SiteReplicationGetSiteReplicationInfoHandler sets the operation handler for the get site replication info operation
 	SiteReplicationGetSiteReplicationInfoHandler site_replication.GetSiteReplicationInfoHandler
 	// SiteReplicationGetSiteReplicationStatusHandler sets the operation handler for the get site replication status operation
 	SiteReplicationGetSiteReplicationStatusHandler site_replication.GetSiteReplicationStatusHandler
+	// StagingGetStagingWorkspaceHandler sets the operation handler for the get staging workspace operation
+	StagingGetStagingWorkspaceHandler staging.GetStagingWorkspaceHandler
+	// SystemGetTLSCertificatesHandler sets the operation handler for the get TLS certificates operation
+	SystemGetTLSCertificatesHandler system.GetTLSCertificatesHandler
 	// TieringGetTierHandler sets the operation handler for the get tier operation
 	TieringGetTierHandler tiering.GetTierHandler
+	// TieringGetTierStatsHandler sets the operation handler for the get tier stats operation
+	TieringGetTierStatsHandler tiering.GetTierStatsHandler
+	// SystemGetTopLocksHandler sets the operation handler for the get top locks operation
+	SystemGetTopLocksHandler system.GetTopLocksHandler
+	// SystemGetTraceStatsHandler sets the operation handler for the get trace stats operation
+	SystemGetTraceStatsHandler system.GetTraceStatsHandler
+	// ConfigurationGetTrustedProxiesHandler sets the operation handler for the get trusted proxies operation
+	ConfigurationGetTrustedProxiesHandler configuration.GetTrustedProxiesHandler
+	// UserGetUserEffectivePolicyHandler sets the operation handler for the get user effective policy operation
+	UserGetUserEffectivePolicyHandler user.GetUserEffectivePolicyHandler
 	// UserGetUserInfoHandler sets the operation handler for the get user info operation
 	UserGetUserInfoHandler user.GetUserInfoHandler
 	// PolicyGetUserPolicyHandler sets the operation handler for the get user policy operation
 	PolicyGetUserPolicyHandler policy.GetUserPolicyHandler
 	// GroupGroupInfoHandler sets the operation handler for the group info operation
 	GroupGroupInfoHandler group.GroupInfoHandler
+	// BucketImportBucketConfigHandler sets the operation handler for the import bucket config operation
+	BucketImportBucketConfigHandler bucket.ImportBucketConfigHandler
+	// BucketImportBucketLifecycleHandler sets the operation handler for the import bucket lifecycle operation
+	BucketImportBucketLifecycleHandler bucket.ImportBucketLifecycleHandler
+	// ConfigurationImportIAMHandler sets the operation handler for the import i a m operation
+	ConfigurationImportIAMHandler configuration.ImportIAMHandler
+	// ConfigurationImportServerConfigHandler sets the operation handler for the import server config operation
+	ConfigurationImportServerConfigHandler configuration.ImportServerConfigHandler
+	// UserImportUsersHandler sets the operation handler for the import users operation
+	UserImportUsersHandler user.ImportUsersHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
+	// InspectInspectBundleHandler sets the operation handler for the inspect bundle operation
+	InspectInspectBundleHandler inspect.InspectBundleHandler
 	// KmsKMSAPIsHandler sets the operation handler for the k m s a p is operation
 	KmsKMSAPIsHandler k_m_s.KMSAPIsHandler
 	// KmsKMSAssignPolicyHandler sets the operation handler for the k m s assign policy operation
@@ -787,26 +1356,54 @@ type ConsoleAPI struct {
 	KmsKMSSetPolicyHandler k_m_s.KMSSetPolicyHandler
 	// KmsKMSStatusHandler sets the operation handler for the k m s status operation
 	KmsKMSStatusHandler k_m_s.KMSStatusHandler
+	// KmsKMSTestKeyHandler sets the operation handler for the k m s test key operation
+	KmsKMSTestKeyHandler k_m_s.KMSTestKeyHandler
 	// KmsKMSVersionHandler sets the operation handler for the k m s version operation
 	KmsKMSVersionHandler k_m_s.KMSVersionHandler
+	// AccountListAPITokensHandler sets the operation handler for the list API tokens operation
+	AccountListAPITokensHandler account.ListAPITokensHandler
 	// UserListAUserServiceAccountsHandler sets the operation handler for the list a user service accounts operation
 	UserListAUserServiceAccountsHandler user.ListAUserServiceAccountsHandler
+	// UserListAccessKeyInventoryHandler sets the operation handler for the list access key inventory operation
+	UserListAccessKeyInventoryHandler user.ListAccessKeyInventoryHandler
 	// BucketListAccessRulesWithBucketHandler sets the operation handler for the list access rules with bucket operation
 	BucketListAccessRulesWithBucketHandler bucket.ListAccessRulesWithBucketHandler
+	// SystemListAlertRulesHandler sets the operation handler for the list alert rules operation
+	SystemListAlertRulesHandler system.ListAlertRulesHandler
+	// BatchJobsListBatchJobsHandler sets the operation handler for the list batch jobs operation
+	BatchJobsListBatchJobsHandler batch_jobs.ListBatchJobsHandler
+	// BucketListBucketEncryptionKeysHandler sets the operation handler for the list bucket encryption keys operation
+	BucketListBucketEncryptionKeysHandler bucket.ListBucketEncryptionKeysHandler
 	// BucketListBucketEventsHandler sets the operation handler for the list bucket events operation
 	BucketListBucketEventsHandler bucket.ListBucketEventsHandler
+	// BucketListBucketReplicationTargetsHandler sets the operation handler for the list bucket replication targets operation
+	BucketListBucketReplicationTargetsHandler bucket.ListBucketReplicationTargetsHandler
 	// BucketListBucketsHandler sets the operation handler for the list buckets operation
 	BucketListBucketsHandler bucket.ListBucketsHandler
+	// SystemListCSPReportsHandler sets the operation handler for the list c s p reports operation
+	SystemListCSPReportsHandler system.ListCSPReportsHandler
+	// SystemListClustersHandler sets the operation handler for the list clusters operation
+	SystemListClustersHandler system.ListClustersHandler
 	// ConfigurationListConfigHandler sets the operation handler for the list config operation
 	ConfigurationListConfigHandler configuration.ListConfigHandler
+	// ConfigurationListConfigRevisionsHandler sets the operation handler for the list config revisions operation
+	ConfigurationListConfigRevisionsHandler configuration.ListConfigRevisionsHandler
 	// IdpListConfigurationsHandler sets the operation handler for the list configurations operation
 	IdpListConfigurationsHandler idp.ListConfigurationsHandler
+	// LoggingListConsoleAuditEventsHandler sets the operation handler for the list console audit events operation
+	LoggingListConsoleAuditEventsHandler logging.ListConsoleAuditEventsHandler
 	// BucketListExternalBucketsHandler sets the operation handler for the list external buckets operation
 	BucketListExternalBucketsHandler bucket.ListExternalBucketsHandler
 	// GroupListGroupsHandler sets the operation handler for the list groups operation
 	GroupListGroupsHandler group.ListGroupsHandler
 	// PolicyListGroupsForPolicyHandler sets the operation handler for the list groups for policy operation
 	PolicyListGroupsForPolicyHandler policy.ListGroupsForPolicyHandler
+	// IdpListLDAPEntitiesHandler sets the operation handler for the list l d a p entities operation
+	IdpListLDAPEntitiesHandler idp.ListLDAPEntitiesHandler
+	// ConfigurationListLogTargetsHandler sets the operation handler for the list log targets operation
+	ConfigurationListLogTargetsHandler configuration.ListLogTargetsHandler
+	// UserListLoginAttemptsHandler sets the operation handler for the list login attempts operation
+	UserListLoginAttemptsHandler user.ListLoginAttemptsHandler
 	// SystemListNodesHandler sets the operation handler for the list nodes operation
 	SystemListNodesHandler system.ListNodesHandler
 	// ObjectListObjectsHandler sets the operation handler for the list objects operation
@@ -815,10 +1412,18 @@ type ConsoleAPI struct {
 	PolicyListPoliciesHandler policy.ListPoliciesHandler
 	// BucketListPoliciesWithBucketHandler sets the operation handler for the list policies with bucket operation
 	BucketListPoliciesWithBucketHandler bucket.ListPoliciesWithBucketHandler
+	// PolicyListPolicyEntitiesHandler sets the operation handler for the list policy entities operation
+	PolicyListPolicyEntitiesHandler policy.ListPolicyEntitiesHandler
+	// PolicyListPolicyTemplatesHandler sets the operation handler for the list policy templates operation
+	PolicyListPolicyTemplatesHandler policy.ListPolicyTemplatesHandler
+	// SystemListPoolsHandler sets the operation handler for the list pools operation
+	SystemListPoolsHandler system.ListPoolsHandler
 	// ReleaseListReleasesHandler sets the operation handler for the list releases operation
 	ReleaseListReleasesHandler release.ListReleasesHandler
 	// BucketListRemoteBucketsHandler sets the operation handler for the list remote buckets operation
 	BucketListRemoteBucketsHandler bucket.ListRemoteBucketsHandler
+	// StagingListStagingWorkspacesHandler sets the operation handler for the list staging workspaces operation
+	StagingListStagingWorkspacesHandler staging.ListStagingWorkspacesHandler
 	// ServiceAccountListUserServiceAccountsHandler sets the operation handler for the list user service accounts operation
 	ServiceAccountListUserServiceAccountsHandler service_account.ListUserServiceAccountsHandler
 	// UserListUsersHandler sets the operation handler for the list users operation
@@ -841,16 +1446,26 @@ type ConsoleAPI struct {
 	BucketMakeBucketHandler bucket.MakeBucketHandler
 	// ConfigurationNotificationEndpointListHandler sets the operation handler for the notification endpoint list operation
 	ConfigurationNotificationEndpointListHandler configuration.NotificationEndpointListHandler
+	// ConfigurationNotificationEndpointsStatusHandler sets the operation handler for the notification endpoints status operation
+	ConfigurationNotificationEndpointsStatusHandler configuration.NotificationEndpointsStatusHandler
 	// PolicyPolicyInfoHandler sets the operation handler for the policy info operation
 	PolicyPolicyInfoHandler policy.PolicyInfoHandler
 	// ObjectPostBucketsBucketNameObjectsUploadHandler sets the operation handler for the post buckets bucket name objects upload operation
 	ObjectPostBucketsBucketNameObjectsUploadHandler object.PostBucketsBucketNameObjectsUploadHandler
 	// ConfigurationPostConfigsImportHandler sets the operation handler for the post configs import operation
 	ConfigurationPostConfigsImportHandler configuration.PostConfigsImportHandler
+	// IdpPreviewOpenIDClaimMappingHandler sets the operation handler for the preview open ID claim mapping operation
+	IdpPreviewOpenIDClaimMappingHandler idp.PreviewOpenIDClaimMappingHandler
 	// ProfileProfilingStartHandler sets the operation handler for the profiling start operation
 	ProfileProfilingStartHandler profile.ProfilingStartHandler
 	// ProfileProfilingStopHandler sets the operation handler for the profiling stop operation
 	ProfileProfilingStopHandler profile.ProfilingStopHandler
+	// PublicPublicDownloadObjectHandler sets the operation handler for the public download object operation
+	PublicPublicDownloadObjectHandler public.PublicDownloadObjectHandler
+	// PublicPublicListObjectsHandler sets the operation handler for the public list objects operation
+	PublicPublicListObjectsHandler public.PublicListObjectsHandler
+	// BucketPutBucketCorsHandler sets the operation handler for the put bucket cors operation
+	BucketPutBucketCorsHandler bucket.PutBucketCorsHandler
 	// BucketPutBucketTagsHandler sets the operation handler for the put bucket tags operation
 	BucketPutBucketTagsHandler bucket.PutBucketTagsHandler
 	// ObjectPutObjectLegalHoldHandler sets the operation handler for the put object legal hold operation
@@ -861,32 +1476,66 @@ type ConsoleAPI struct {
 	ObjectPutObjectRetentionHandler object.PutObjectRetentionHandler
 	// ObjectPutObjectTagsHandler sets the operation handler for the put object tags operation
 	ObjectPutObjectTagsHandler object.PutObjectTagsHandler
+	// SystemRefreshDataUsageHandler sets the operation handler for the refresh data usage operation
+	SystemRefreshDataUsageHandler system.RefreshDataUsageHandler
+	// ConfigurationReloadConsoleConfigHandler sets the operation handler for the reload console config operation
+	ConfigurationReloadConsoleConfigHandler configuration.ReloadConsoleConfigHandler
 	// BucketRemoteBucketDetailsHandler sets the operation handler for the remote bucket details operation
 	BucketRemoteBucketDetailsHandler bucket.RemoteBucketDetailsHandler
 	// GroupRemoveGroupHandler sets the operation handler for the remove group operation
 	GroupRemoveGroupHandler group.RemoveGroupHandler
 	// PolicyRemovePolicyHandler sets the operation handler for the remove policy operation
 	PolicyRemovePolicyHandler policy.RemovePolicyHandler
+	// StagingRemoveStagedOperationHandler sets the operation handler for the remove staged operation operation
+	StagingRemoveStagedOperationHandler staging.RemoveStagedOperationHandler
+	// TieringRemoveTierHandler sets the operation handler for the remove tier operation
+	TieringRemoveTierHandler tiering.RemoveTierHandler
 	// UserRemoveUserHandler sets the operation handler for the remove user operation
 	UserRemoveUserHandler user.RemoveUserHandler
+	// PolicyRenderPolicyTemplateHandler sets the operation handler for the render policy template operation
+	PolicyRenderPolicyTemplateHandler policy.RenderPolicyTemplateHandler
 	// ConfigurationResetConfigHandler sets the operation handler for the reset config operation
 	ConfigurationResetConfigHandler configuration.ResetConfigHandler
+	// UserResetUserPasswordHandler sets the operation handler for the reset user password operation
+	UserResetUserPasswordHandler user.ResetUserPasswordHandler
+	// UserResetUserTwoFactorHandler sets the operation handler for the reset user two factor operation
+	UserResetUserTwoFactorHandler user.ResetUserTwoFactorHandler
 	// ServiceRestartServiceHandler sets the operation handler for the restart service operation
 	ServiceRestartServiceHandler service.RestartServiceHandler
+	// ObjectRestoreTieredObjectHandler sets the operation handler for the restore tiered object operation
+	ObjectRestoreTieredObjectHandler object.RestoreTieredObjectHandler
+	// AccountRevokeAPITokenHandler sets the operation handler for the revoke API token operation
+	AccountRevokeAPITokenHandler account.RevokeAPITokenHandler
+	// ConfigurationRollbackConfigRevisionHandler sets the operation handler for the rollback config revision operation
+	ConfigurationRollbackConfigRevisionHandler configuration.RollbackConfigRevisionHandler
+	// AuthRotateSessionKeyHandler sets the operation handler for the rotate session key operation
+	AuthRotateSessionKeyHandler auth.RotateSessionKeyHandler
+	// LoggingSearchLogEntriesHandler sets the operation handler for the search log entries operation
+	LoggingSearchLogEntriesHandler logging.SearchLogEntriesHandler
 	// AuthSessionCheckHandler sets the operation handler for the session check operation
 	AuthSessionCheckHandler auth.SessionCheckHandler
+	// AuthSessionRenewHandler sets the operation handler for the session renew operation
+	AuthSessionRenewHandler auth.SessionRenewHandler
 	// BucketSetAccessRuleWithBucketHandler sets the operation handler for the set access rule with bucket operation
 	BucketSetAccessRuleWithBucketHandler bucket.SetAccessRuleWithBucketHandler
+	// SystemSetAlertRuleHandler sets the operation handler for the set alert rule operation
+	SystemSetAlertRuleHandler system.SetAlertRuleHandler
 	// BucketSetBucketQuotaHandler sets the operation handler for the set bucket quota operation
 	BucketSetBucketQuotaHandler bucket.SetBucketQuotaHandler
+	// BucketSetBucketReplicationPrioritiesHandler sets the operation handler for the set bucket replication priorities operation
+	BucketSetBucketReplicationPrioritiesHandler bucket.SetBucketReplicationPrioritiesHandler
 	// BucketSetBucketRetentionConfigHandler sets the operation handler for the set bucket retention config operation
 	BucketSetBucketRetentionConfigHandler bucket.SetBucketRetentionConfigHandler
 	// BucketSetBucketVersioningHandler sets the operation handler for the set bucket versioning operation
 	BucketSetBucketVersioningHandler bucket.SetBucketVersioningHandler
 	// SupportSetCallHomeStatusHandler sets the operation handler for the set call home status operation
 	SupportSetCallHomeStatusHandler support.SetCallHomeStatusHandler
+	// SystemSetClusterHandler sets the operation handler for the set cluster operation
+	SystemSetClusterHandler system.SetClusterHandler
 	// ConfigurationSetConfigHandler sets the operation handler for the set config operation
 	ConfigurationSetConfigHandler configuration.SetConfigHandler
+	// LoggingSetLogLevelHandler sets the operation handler for the set log level operation
+	LoggingSetLogLevelHandler logging.SetLogLevelHandler
 	// BucketSetMultiBucketReplicationHandler sets the operation handler for the set multi bucket replication operation
 	BucketSetMultiBucketReplicationHandler bucket.SetMultiBucketReplicationHandler
 	// PolicySetPolicyHandler sets the operation handler for the set policy operation
@@ -895,14 +1544,36 @@ type ConsoleAPI struct {
 	PolicySetPolicyMultipleHandler policy.SetPolicyMultipleHandler
 	// ServiceAccountSetServiceAccountPolicyHandler sets the operation handler for the set service account policy operation
 	ServiceAccountSetServiceAccountPolicyHandler service_account.SetServiceAccountPolicyHandler
+	// ConfigurationSetTrustedProxiesHandler sets the operation handler for the set trusted proxies operation
+	ConfigurationSetTrustedProxiesHandler configuration.SetTrustedProxiesHandler
 	// ObjectShareObjectHandler sets the operation handler for the share object operation
 	ObjectShareObjectHandler object.ShareObjectHandler
+	// PolicySimulatePolicyHandler sets the operation handler for the simulate policy operation
+	PolicySimulatePolicyHandler policy.SimulatePolicyHandler
+	// SiteReplicationSiteReplicationCompareHandler sets the operation handler for the site replication compare operation
+	SiteReplicationSiteReplicationCompareHandler site_replication.SiteReplicationCompareHandler
 	// SiteReplicationSiteReplicationEditHandler sets the operation handler for the site replication edit operation
 	SiteReplicationSiteReplicationEditHandler site_replication.SiteReplicationEditHandler
 	// SiteReplicationSiteReplicationInfoAddHandler sets the operation handler for the site replication info add operation
 	SiteReplicationSiteReplicationInfoAddHandler site_replication.SiteReplicationInfoAddHandler
 	// SiteReplicationSiteReplicationRemoveHandler sets the operation handler for the site replication remove operation
 	SiteReplicationSiteReplicationRemoveHandler site_replication.SiteReplicationRemoveHandler
+	// BatchJobsStartBatchJobHandler sets the operation handler for the start batch job operation
+	BatchJobsStartBatchJobHandler batch_jobs.StartBatchJobHandler
+	// BucketStartBucketRenameHandler sets the operation handler for the start bucket rename operation
+	BucketStartBucketRenameHandler bucket.StartBucketRenameHandler
+	// SystemStartHealHandler sets the operation handler for the start heal operation
+	SystemStartHealHandler system.StartHealHandler
+	// SystemStartPoolDecommissionHandler sets the operation handler for the start pool decommission operation
+	SystemStartPoolDecommissionHandler system.StartPoolDecommissionHandler
+	// SystemStartRebalanceHandler sets the operation handler for the start rebalance operation
+	SystemStartRebalanceHandler system.StartRebalanceHandler
+	// BucketStartReplicationResyncHandler sets the operation handler for the start replication resync operation
+	BucketStartReplicationResyncHandler bucket.StartReplicationResyncHandler
+	// BucketStartReplicationRetryHandler sets the operation handler for the start replication retry operation
+	BucketStartReplicationRetryHandler bucket.StartReplicationRetryHandler
+	// SystemStopRebalanceHandler sets the operation handler for the stop rebalance operation
+	SystemStopRebalanceHandler system.StopRebalanceHandler
 	// SubnetSubnetAPIKeyHandler sets the operation handler for the subnet Api key operation
 	SubnetSubnetAPIKeyHandler subnet.SubnetAPIKeyHandler
 	// SubnetSubnetInfoHandler sets the operation handler for the subnet info operation
@@ -915,20 +1586,52 @@ type ConsoleAPI struct {
 	SubnetSubnetRegTokenHandler subnet.SubnetRegTokenHandler
 	// SubnetSubnetRegisterHandler sets the operation handler for the subnet register operation
 	SubnetSubnetRegisterHandler subnet.SubnetRegisterHandler
+	// AuthSwitchClusterHandler sets the operation handler for the switch cluster operation
+	AuthSwitchClusterHandler auth.SwitchClusterHandler
+	// BucketTestBucketEventHandler sets the operation handler for the test bucket event operation
+	BucketTestBucketEventHandler bucket.TestBucketEventHandler
+	// IdpTestIDPConfigurationHandler sets the operation handler for the test i d p configuration operation
+	IdpTestIDPConfigurationHandler idp.TestIDPConfigurationHandler
+	// ConfigurationTestLogTargetHandler sets the operation handler for the test log target operation
+	ConfigurationTestLogTargetHandler configuration.TestLogTargetHandler
+	// ConfigurationTestNotificationEndpointHandler sets the operation handler for the test notification endpoint operation
+	ConfigurationTestNotificationEndpointHandler configuration.TestNotificationEndpointHandler
 	// TieringTiersListHandler sets the operation handler for the tiers list operation
 	TieringTiersListHandler tiering.TiersListHandler
+	// AccountTwoFactorStatusHandler sets the operation handler for the two factor status operation
+	AccountTwoFactorStatusHandler account.TwoFactorStatusHandler
+	// UserUnlockLoginAttemptsHandler sets the operation handler for the unlock login attempts operation
+	UserUnlockLoginAttemptsHandler user.UnlockLoginAttemptsHandler
 	// BucketUpdateBucketLifecycleHandler sets the operation handler for the update bucket lifecycle operation
 	BucketUpdateBucketLifecycleHandler bucket.UpdateBucketLifecycleHandler
 	// IdpUpdateConfigurationHandler sets the operation handler for the update configuration operation
 	IdpUpdateConfigurationHandler idp.UpdateConfigurationHandler
 	// GroupUpdateGroupHandler sets the operation handler for the update group operation
 	GroupUpdateGroupHandler group.UpdateGroupHandler
+	// ConfigurationUpdateLogTargetHandler sets the operation handler for the update log target operation
+	ConfigurationUpdateLogTargetHandler configuration.UpdateLogTargetHandler
 	// BucketUpdateMultiBucketReplicationHandler sets the operation handler for the update multi bucket replication operation
 	BucketUpdateMultiBucketReplicationHandler bucket.UpdateMultiBucketReplicationHandler
+	// ConfigurationUpdateNotificationEndpointHandler sets the operation handler for the update notification endpoint operation
+	ConfigurationUpdateNotificationEndpointHandler configuration.UpdateNotificationEndpointHandler
+	// IdpUpdateOpenIDClaimMappingHandler sets the operation handler for the update open ID claim mapping operation
+	IdpUpdateOpenIDClaimMappingHandler idp.UpdateOpenIDClaimMappingHandler
 	// UserUpdateUserGroupsHandler sets the operation handler for the update user groups operation
 	UserUpdateUserGroupsHandler user.UpdateUserGroupsHandler
 	// UserUpdateUserInfoHandler sets the operation handler for the update user info operation
 	UserUpdateUserInfoHandler user.UpdateUserInfoHandler
+	// BucketValidateBucketPolicyHandler sets the operation handler for the validate bucket policy operation
+	BucketValidateBucketPolicyHandler bucket.ValidateBucketPolicyHandler
+	// PolicyValidatePolicyHandler sets the operation handler for the validate policy operation
+	PolicyValidatePolicyHandler policy.ValidatePolicyHandler
+	// ObjectVerifyObjectChecksumManifestHandler sets the operation handler for the verify object checksum manifest operation
+	ObjectVerifyObjectChecksumManifestHandler object.VerifyObjectChecksumManifestHandler
+	// ObjectVerifyObjectIntegrityHandler sets the operation handler for the verify object integrity operation
+	ObjectVerifyObjectIntegrityHandler object.VerifyObjectIntegrityHandler
+	// TieringVerifyTierHandler sets the operation handler for the verify tier operation
+	TieringVerifyTierHandler tiering.VerifyTierHandler
+	// AccountVerifyTwoFactorHandler sets the operation handler for the verify two factor operation
+	AccountVerifyTwoFactorHandler account.VerifyTwoFactorHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -1031,6 +1734,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.GroupAddGroupHandler == nil {
 		unregistered = append(unregistered, "group.AddGroupHandler")
 	}
+	if o.ConfigurationAddLogTargetHandler == nil {
+		unregistered = append(unregistered, "configuration.AddLogTargetHandler")
+	}
 	if o.BucketAddMultiBucketLifecycleHandler == nil {
 		unregistered = append(unregistered, "bucket.AddMultiBucketLifecycleHandler")
 	}
@@ -1043,6 +1749,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketAddRemoteBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.AddRemoteBucketHandler")
 	}
+	if o.StagingAddStagedOperationsHandler == nil {
+		unregistered = append(unregistered, "staging.AddStagedOperationsHandler")
+	}
 	if o.TieringAddTierHandler == nil {
 		unregistered = append(unregistered, "tiering.AddTierHandler")
 	}
@@ -1055,27 +1764,60 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SystemArnListHandler == nil {
 		unregistered = append(unregistered, "system.ArnListHandler")
 	}
+	if o.IdpAttachLDAPPolicyHandler == nil {
+		unregistered = append(unregistered, "idp.AttachLDAPPolicyHandler")
+	}
+	if o.SystemBatchHandler == nil {
+		unregistered = append(unregistered, "system.BatchHandler")
+	}
 	if o.BucketBucketInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.BucketInfoHandler")
 	}
 	if o.BucketBucketSetPolicyHandler == nil {
 		unregistered = append(unregistered, "bucket.BucketSetPolicyHandler")
 	}
+	if o.BucketBulkBucketOperationHandler == nil {
+		unregistered = append(unregistered, "bucket.BulkBucketOperationHandler")
+	}
 	if o.UserBulkUpdateUsersGroupsHandler == nil {
 		unregistered = append(unregistered, "user.BulkUpdateUsersGroupsHandler")
 	}
+	if o.BatchJobsCancelBatchJobHandler == nil {
+		unregistered = append(unregistered, "batch_jobs.CancelBatchJobHandler")
+	}
+	if o.BucketCancelBucketRenameJobHandler == nil {
+		unregistered = append(unregistered, "bucket.CancelBucketRenameJobHandler")
+	}
+	if o.SystemCancelPoolDecommissionHandler == nil {
+		unregistered = append(unregistered, "system.CancelPoolDecommissionHandler")
+	}
+	if o.BucketCancelReplicationResyncHandler == nil {
+		unregistered = append(unregistered, "bucket.CancelReplicationResyncHandler")
+	}
 	if o.AccountChangeUserPasswordHandler == nil {
 		unregistered = append(unregistered, "account.ChangeUserPasswordHandler")
 	}
 	if o.SystemCheckMinIOVersionHandler == nil {
 		unregistered = append(unregistered, "system.CheckMinIOVersionHandler")
 	}
+	if o.ServiceCheckServerUpdateHandler == nil {
+		unregistered = append(unregistered, "service.CheckServerUpdateHandler")
+	}
 	if o.UserCheckUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.CheckUserServiceAccountsHandler")
 	}
+	if o.BucketClearBucketRetentionConfigHandler == nil {
+		unregistered = append(unregistered, "bucket.ClearBucketRetentionConfigHandler")
+	}
+	if o.StagingCommitStagingWorkspaceHandler == nil {
+		unregistered = append(unregistered, "staging.CommitStagingWorkspaceHandler")
+	}
 	if o.ConfigurationConfigInfoHandler == nil {
 		unregistered = append(unregistered, "configuration.ConfigInfoHandler")
 	}
+	if o.AccountCreateAPITokenHandler == nil {
+		unregistered = append(unregistered, "account.CreateAPITokenHandler")
+	}
 	if o.UserCreateAUserServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user.CreateAUserServiceAccountHandler")
 	}
@@ -1094,18 +1836,33 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountCreateServiceAccountCredsHandler == nil {
 		unregistered = append(unregistered, "service_account.CreateServiceAccountCredsHandler")
 	}
+	if o.ServiceCreateServiceConfirmationHandler == nil {
+		unregistered = append(unregistered, "service.CreateServiceConfirmationHandler")
+	}
+	if o.StagingCreateStagingWorkspaceHandler == nil {
+		unregistered = append(unregistered, "staging.CreateStagingWorkspaceHandler")
+	}
+	if o.AccountCreateTemporaryCredentialsHandler == nil {
+		unregistered = append(unregistered, "account.CreateTemporaryCredentialsHandler")
+	}
 	if o.SystemDashboardWidgetDetailsHandler == nil {
 		unregistered = append(unregistered, "system.DashboardWidgetDetailsHandler")
 	}
 	if o.BucketDeleteAccessRuleWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAccessRuleWithBucketHandler")
 	}
+	if o.SystemDeleteAlertRuleHandler == nil {
+		unregistered = append(unregistered, "system.DeleteAlertRuleHandler")
+	}
 	if o.BucketDeleteAllReplicationRulesHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAllReplicationRulesHandler")
 	}
 	if o.BucketDeleteBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteBucketHandler")
 	}
+	if o.BucketDeleteBucketCorsHandler == nil {
+		unregistered = append(unregistered, "bucket.DeleteBucketCorsHandler")
+	}
 	if o.BucketDeleteBucketEventHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteBucketEventHandler")
 	}
@@ -1115,6 +1872,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketDeleteBucketReplicationRuleHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteBucketReplicationRuleHandler")
 	}
+	if o.SystemDeleteClusterHandler == nil {
+		unregistered = append(unregistered, "system.DeleteClusterHandler")
+	}
 	if o.IdpDeleteConfigurationHandler == nil {
 		unregistered = append(unregistered, "idp.DeleteConfigurationHandler")
 	}
@@ -1124,6 +1884,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountDeleteMultipleServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "service_account.DeleteMultipleServiceAccountsHandler")
 	}
+	if o.ConfigurationDeleteNotificationEndpointHandler == nil {
+		unregistered = append(unregistered, "configuration.DeleteNotificationEndpointHandler")
+	}
 	if o.ObjectDeleteObjectHandler == nil {
 		unregistered = append(unregistered, "object.DeleteObjectHandler")
 	}
@@ -1139,9 +1902,24 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountDeleteServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.DeleteServiceAccountHandler")
 	}
+	if o.StagingDeleteStagingWorkspaceHandler == nil {
+		unregistered = append(unregistered, "staging.DeleteStagingWorkspaceHandler")
+	}
+	if o.BatchJobsDescribeBatchJobHandler == nil {
+		unregistered = append(unregistered, "batch_jobs.DescribeBatchJobHandler")
+	}
+	if o.IdpDetachLDAPPolicyHandler == nil {
+		unregistered = append(unregistered, "idp.DetachLDAPPolicyHandler")
+	}
 	if o.BucketDisableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "bucket.DisableBucketEncryptionHandler")
 	}
+	if o.ConfigurationDisableLogTargetHandler == nil {
+		unregistered = append(unregistered, "configuration.DisableLogTargetHandler")
+	}
+	if o.AccountDisableTwoFactorHandler == nil {
+		unregistered = append(unregistered, "account.DisableTwoFactorHandler")
+	}
 	if o.ObjectDownloadObjectHandler == nil {
 		unregistered = append(unregistered, "object.DownloadObjectHandler")
 	}
@@ -1151,9 +1929,51 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketEnableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "bucket.EnableBucketEncryptionHandler")
 	}
+	if o.AccountEnrollTwoFactorHandler == nil {
+		unregistered = append(unregistered, "account.EnrollTwoFactorHandler")
+	}
+	if o.SystemEvaluateAlertsHandler == nil {
+		unregistered = append(unregistered, "system.EvaluateAlertsHandler")
+	}
+	if o.BucketExportBucketConfigHandler == nil {
+		unregistered = append(unregistered, "bucket.ExportBucketConfigHandler")
+	}
+	if o.BucketExportBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "bucket.ExportBucketLifecycleHandler")
+	}
 	if o.ConfigurationExportConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ExportConfigHandler")
 	}
+	if o.ConfigurationExportIAMHandler == nil {
+		unregistered = append(unregistered, "configuration.ExportIAMHandler")
+	}
+	if o.LoggingExportLogEntriesHandler == nil {
+		unregistered = append(unregistered, "logging.ExportLogEntriesHandler")
+	}
+	if o.ConfigurationExportServerConfigHandler == nil {
+		unregistered = append(unregistered, "configuration.ExportServerConfigHandler")
+	}
+	if o.BatchJobsGenerateBatchJobHandler == nil {
+		unregistered = append(unregistered, "batch_jobs.GenerateBatchJobHandler")
+	}
+	if o.BucketGenerateBucketPolicyHandler == nil {
+		unregistered = append(unregistered, "bucket.GenerateBucketPolicyHandler")
+	}
+	if o.AccountGetAPITokenUsageHandler == nil {
+		unregistered = append(unregistered, "account.GetAPITokenUsageHandler")
+	}
+	if o.SystemGetAlertsHandler == nil {
+		unregistered = append(unregistered, "system.GetAlertsHandler")
+	}
+	if o.SystemGetBackgroundHealStatusHandler == nil {
+		unregistered = append(unregistered, "system.GetBackgroundHealStatusHandler")
+	}
+	if o.BucketGetBucketAccessInsightHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketAccessInsightHandler")
+	}
+	if o.BucketGetBucketCorsHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketCorsHandler")
+	}
 	if o.BucketGetBucketEncryptionInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketEncryptionInfoHandler")
 	}
@@ -1163,12 +1983,21 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketGetBucketObjectLockingStatusHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketObjectLockingStatusHandler")
 	}
+	if o.BucketGetBucketPrefixUsageHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketPrefixUsageHandler")
+	}
 	if o.BucketGetBucketQuotaHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketQuotaHandler")
 	}
+	if o.BucketGetBucketRenameJobHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketRenameJobHandler")
+	}
 	if o.BucketGetBucketReplicationHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketReplicationHandler")
 	}
+	if o.BucketGetBucketReplicationMetricsHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketReplicationMetricsHandler")
+	}
 	if o.BucketGetBucketReplicationRuleHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketReplicationRuleHandler")
 	}
@@ -1178,6 +2007,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketGetBucketRewindHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketRewindHandler")
 	}
+	if o.BucketGetBucketUsageHistoryHandler == nil {
+		unregistered = append(unregistered, "bucket.GetBucketUsageHistoryHandler")
+	}
 	if o.BucketGetBucketVersioningHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketVersioningHandler")
 	}
@@ -1187,27 +2019,99 @@ func (o *ConsoleAPI) Validate() error {
 	if o.IdpGetConfigurationHandler == nil {
 		unregistered = append(unregistered, "idp.GetConfigurationHandler")
 	}
+	if o.SystemGetDriveTopologyHandler == nil {
+		unregistered = append(unregistered, "system.GetDriveTopologyHandler")
+	}
+	if o.SystemGetDrivesHealthHandler == nil {
+		unregistered = append(unregistered, "system.GetDrivesHealthHandler")
+	}
+	if o.IdpGetLDAPEffectivePolicyHandler == nil {
+		unregistered = append(unregistered, "idp.GetLDAPEffectivePolicyHandler")
+	}
 	if o.IdpGetLDAPEntitiesHandler == nil {
 		unregistered = append(unregistered, "idp.GetLDAPEntitiesHandler")
 	}
+	if o.LoggingGetLogLevelHandler == nil {
+		unregistered = append(unregistered, "logging.GetLogLevelHandler")
+	}
+	if o.ConfigurationGetLogTargetHandler == nil {
+		unregistered = append(unregistered, "configuration.GetLogTargetHandler")
+	}
+	if o.SystemGetMetricsDashboardHandler == nil {
+		unregistered = append(unregistered, "system.GetMetricsDashboardHandler")
+	}
+	if o.ConfigurationGetNotificationEndpointHandler == nil {
+		unregistered = append(unregistered, "configuration.GetNotificationEndpointHandler")
+	}
+	if o.ObjectGetObjectChecksumManifestHandler == nil {
+		unregistered = append(unregistered, "object.GetObjectChecksumManifestHandler")
+	}
 	if o.ObjectGetObjectMetadataHandler == nil {
 		unregistered = append(unregistered, "object.GetObjectMetadataHandler")
 	}
+	if o.ObjectGetObjectTierRestoreStatusHandler == nil {
+		unregistered = append(unregistered, "object.GetObjectTierRestoreStatusHandler")
+	}
+	if o.IdpGetOpenIDClaimMappingHandler == nil {
+		unregistered = append(unregistered, "idp.GetOpenIDClaimMappingHandler")
+	}
+	if o.SystemGetPoolStatusHandler == nil {
+		unregistered = append(unregistered, "system.GetPoolStatusHandler")
+	}
+	if o.SystemGetPreflightReportHandler == nil {
+		unregistered = append(unregistered, "system.GetPreflightReportHandler")
+	}
+	if o.SystemGetRebalanceStatusHandler == nil {
+		unregistered = append(unregistered, "system.GetRebalanceStatusHandler")
+	}
+	if o.BucketGetReplicationResyncStatusHandler == nil {
+		unregistered = append(unregistered, "bucket.GetReplicationResyncStatusHandler")
+	}
+	if o.BucketGetReplicationRetryJobHandler == nil {
+		unregistered = append(unregistered, "bucket.GetReplicationRetryJobHandler")
+	}
 	if o.PolicyGetSAUserPolicyHandler == nil {
 		unregistered = append(unregistered, "policy.GetSAUserPolicyHandler")
 	}
+	if o.SystemGetScannerStatusHandler == nil {
+		unregistered = append(unregistered, "system.GetScannerStatusHandler")
+	}
 	if o.ServiceAccountGetServiceAccountPolicyHandler == nil {
 		unregistered = append(unregistered, "service_account.GetServiceAccountPolicyHandler")
 	}
+	if o.SiteReplicationGetSiteReplicationHealthHandler == nil {
+		unregistered = append(unregistered, "site_replication.GetSiteReplicationHealthHandler")
+	}
 	if o.SiteReplicationGetSiteReplicationInfoHandler == nil {
 		unregistered = append(unregistered, "site_replication.GetSiteReplicationInfoHandler")
 	}
 	if o.SiteReplicationGetSiteReplicationStatusHandler == nil {
 		unregistered = append(unregistered, "site_replication.GetSiteReplicationStatusHandler")
 	}
+	if o.StagingGetStagingWorkspaceHandler == nil {
+		unregistered = append(unregistered, "staging.GetStagingWorkspaceHandler")
+	}
+	if o.SystemGetTLSCertificatesHandler == nil {
+		unregistered = append(unregistered, "system.GetTLSCertificatesHandler")
+	}
 	if o.TieringGetTierHandler == nil {
 		unregistered = append(unregistered, "tiering.GetTierHandler")
 	}
+	if o.TieringGetTierStatsHandler == nil {
+		unregistered = append(unregistered, "tiering.GetTierStatsHandler")
+	}
+	if o.SystemGetTopLocksHandler == nil {
+		unregistered = append(unregistered, "system.GetTopLocksHandler")
+	}
+	if o.SystemGetTraceStatsHandler == nil {
+		unregistered = append(unregistered, "system.GetTraceStatsHandler")
+	}
+	if o.ConfigurationGetTrustedProxiesHandler == nil {
+		unregistered = append(unregistered, "configuration.GetTrustedProxiesHandler")
+	}
+	if o.UserGetUserEffectivePolicyHandler == nil {
+		unregistered = append(unregistered, "user.GetUserEffectivePolicyHandler")
+	}
 	if o.UserGetUserInfoHandler == nil {
 		unregistered = append(unregistered, "user.GetUserInfoHandler")
 	}
@@ -1217,9 +2121,27 @@ func (o *ConsoleAPI) Validate() error {
 	if o.GroupGroupInfoHandler == nil {
 		unregistered = append(unregistered, "group.GroupInfoHandler")
 	}
+	if o.BucketImportBucketConfigHandler == nil {
+		unregistered = append(unregistered, "bucket.ImportBucketConfigHandler")
+	}
+	if o.BucketImportBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "bucket.ImportBucketLifecycleHandler")
+	}
+	if o.ConfigurationImportIAMHandler == nil {
+		unregistered = append(unregistered, "configuration.ImportIAMHandler")
+	}
+	if o.ConfigurationImportServerConfigHandler == nil {
+		unregistered = append(unregistered, "configuration.ImportServerConfigHandler")
+	}
+	if o.UserImportUsersHandler == nil {
+		unregistered = append(unregistered, "user.ImportUsersHandler")
+	}
 	if o.InspectInspectHandler == nil {
 		unregistered = append(unregistered, "inspect.InspectHandler")
 	}
+	if o.InspectInspectBundleHandler == nil {
+		unregistered = append(unregistered, "inspect.InspectBundleHandler")
+	}
 	if o.KmsKMSAPIsHandler == nil {
 		unregistered = append(unregistered, "k_m_s.KMSAPIsHandler")
 	}
@@ -1274,27 +2196,60 @@ func (o *ConsoleAPI) Validate() error {
 	if o.KmsKMSStatusHandler == nil {
 		unregistered = append(unregistered, "k_m_s.KMSStatusHandler")
 	}
+	if o.KmsKMSTestKeyHandler == nil {
+		unregistered = append(unregistered, "k_m_s.KMSTestKeyHandler")
+	}
 	if o.KmsKMSVersionHandler == nil {
 		unregistered = append(unregistered, "k_m_s.KMSVersionHandler")
 	}
+	if o.AccountListAPITokensHandler == nil {
+		unregistered = append(unregistered, "account.ListAPITokensHandler")
+	}
 	if o.UserListAUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.ListAUserServiceAccountsHandler")
 	}
+	if o.UserListAccessKeyInventoryHandler == nil {
+		unregistered = append(unregistered, "user.ListAccessKeyInventoryHandler")
+	}
 	if o.BucketListAccessRulesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListAccessRulesWithBucketHandler")
 	}
+	if o.SystemListAlertRulesHandler == nil {
+		unregistered = append(unregistered, "system.ListAlertRulesHandler")
+	}
+	if o.BatchJobsListBatchJobsHandler == nil {
+		unregistered = append(unregistered, "batch_jobs.ListBatchJobsHandler")
+	}
+	if o.BucketListBucketEncryptionKeysHandler == nil {
+		unregistered = append(unregistered, "bucket.ListBucketEncryptionKeysHandler")
+	}
 	if o.BucketListBucketEventsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListBucketEventsHandler")
 	}
+	if o.BucketListBucketReplicationTargetsHandler == nil {
+		unregistered = append(unregistered, "bucket.ListBucketReplicationTargetsHandler")
+	}
 	if o.BucketListBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListBucketsHandler")
 	}
+	if o.SystemListCSPReportsHandler == nil {
+		unregistered = append(unregistered, "system.ListCSPReportsHandler")
+	}
+	if o.SystemListClustersHandler == nil {
+		unregistered = append(unregistered, "system.ListClustersHandler")
+	}
 	if o.ConfigurationListConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ListConfigHandler")
 	}
+	if o.ConfigurationListConfigRevisionsHandler == nil {
+		unregistered = append(unregistered, "configuration.ListConfigRevisionsHandler")
+	}
 	if o.IdpListConfigurationsHandler == nil {
 		unregistered = append(unregistered, "idp.ListConfigurationsHandler")
 	}
+	if o.LoggingListConsoleAuditEventsHandler == nil {
+		unregistered = append(unregistered, "logging.ListConsoleAuditEventsHandler")
+	}
 	if o.BucketListExternalBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListExternalBucketsHandler")
 	}
@@ -1304,6 +2259,15 @@ func (o *ConsoleAPI) Validate() error {
 	if o.PolicyListGroupsForPolicyHandler == nil {
 		unregistered = append(unregistered, "policy.ListGroupsForPolicyHandler")
 	}
+	if o.IdpListLDAPEntitiesHandler == nil {
+		unregistered = append(unregistered, "idp.ListLDAPEntitiesHandler")
+	}
+	if o.ConfigurationListLogTargetsHandler == nil {
+		unregistered = append(unregistered, "configuration.ListLogTargetsHandler")
+	}
+	if o.UserListLoginAttemptsHandler == nil {
+		unregistered = append(unregistered, "user.ListLoginAttemptsHandler")
+	}
 	if o.SystemListNodesHandler == nil {
 		unregistered = append(unregistered, "system.ListNodesHandler")
 	}
@@ -1316,12 +2280,24 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketListPoliciesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListPoliciesWithBucketHandler")
 	}
+	if o.PolicyListPolicyEntitiesHandler == nil {
+		unregistered = append(unregistered, "policy.ListPolicyEntitiesHandler")
+	}
+	if o.PolicyListPolicyTemplatesHandler == nil {
+		unregistered = append(unregistered, "policy.ListPolicyTemplatesHandler")
+	}
+	if o.SystemListPoolsHandler == nil {
+		unregistered = append(unregistered, "system.ListPoolsHandler")
+	}
 	if o.ReleaseListReleasesHandler == nil {
 		unregistered = append(unregistered, "release.ListReleasesHandler")
 	}
 	if o.BucketListRemoteBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListRemoteBucketsHandler")
 	}
+	if o.StagingListStagingWorkspacesHandler == nil {
+		unregistered = append(unregistered, "staging.ListStagingWorkspacesHandler")
+	}
 	if o.ServiceAccountListUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "service_account.ListUserServiceAccountsHandler")
 	}
@@ -1355,6 +2331,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ConfigurationNotificationEndpointListHandler == nil {
 		unregistered = append(unregistered, "configuration.NotificationEndpointListHandler")
 	}
+	if o.ConfigurationNotificationEndpointsStatusHandler == nil {
+		unregistered = append(unregistered, "configuration.NotificationEndpointsStatusHandler")
+	}
 	if o.PolicyPolicyInfoHandler == nil {
 		unregistered = append(unregistered, "policy.PolicyInfoHandler")
 	}
@@ -1364,12 +2343,24 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ConfigurationPostConfigsImportHandler == nil {
 		unregistered = append(unregistered, "configuration.PostConfigsImportHandler")
 	}
+	if o.IdpPreviewOpenIDClaimMappingHandler == nil {
+		unregistered = append(unregistered, "idp.PreviewOpenIDClaimMappingHandler")
+	}
 	if o.ProfileProfilingStartHandler == nil {
 		unregistered = append(unregistered, "profile.ProfilingStartHandler")
 	}
 	if o.ProfileProfilingStopHandler == nil {
 		unregistered = append(unregistered, "profile.ProfilingStopHandler")
 	}
+	if o.PublicPublicDownloadObjectHandler == nil {
+		unregistered = append(unregistered, "public.PublicDownloadObjectHandler")
+	}
+	if o.PublicPublicListObjectsHandler == nil {
+		unregistered = append(unregistered, "public.PublicListObjectsHandler")
+	}
+	if o.BucketPutBucketCorsHandler == nil {
+		unregistered = append(unregistered, "bucket.PutBucketCorsHandler")
+	}
 	if o.BucketPutBucketTagsHandler == nil {
 		unregistered = append(unregistered, "bucket.PutBucketTagsHandler")
 	}
@@ -1385,6 +2376,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ObjectPutObjectTagsHandler == nil {
 		unregistered = append(unregistered, "object.PutObjectTagsHandler")
 	}
+	if o.SystemRefreshDataUsageHandler == nil {
+		unregistered = append(unregistered, "system.RefreshDataUsageHandler")
+	}
+	if o.ConfigurationReloadConsoleConfigHandler == nil {
+		unregistered = append(unregistered, "configuration.ReloadConsoleConfigHandler")
+	}
 	if o.BucketRemoteBucketDetailsHandler == nil {
 		unregistered = append(unregistered, "bucket.RemoteBucketDetailsHandler")
 	}
@@ -1394,24 +2391,63 @@ func (o *ConsoleAPI) Validate() error {
 	if o.PolicyRemovePolicyHandler == nil {
 		unregistered = append(unregistered, "policy.RemovePolicyHandler")
 	}
+	if o.StagingRemoveStagedOperationHandler == nil {
+		unregistered = append(unregistered, "staging.RemoveStagedOperationHandler")
+	}
+	if o.TieringRemoveTierHandler == nil {
+		unregistered = append(unregistered, "tiering.RemoveTierHandler")
+	}
 	if o.UserRemoveUserHandler == nil {
 		unregistered = append(unregistered, "user.RemoveUserHandler")
 	}
+	if o.PolicyRenderPolicyTemplateHandler == nil {
+		unregistered = append(unregistered, "policy.RenderPolicyTemplateHandler")
+	}
 	if o.ConfigurationResetConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ResetConfigHandler")
 	}
+	if o.UserResetUserPasswordHandler == nil {
+		unregistered = append(unregistered, "user.ResetUserPasswordHandler")
+	}
+	if o.UserResetUserTwoFactorHandler == nil {
+		unregistered = append(unregistered, "user.ResetUserTwoFactorHandler")
+	}
 	if o.ServiceRestartServiceHandler == nil {
 		unregistered = append(unregistered, "service.RestartServiceHandler")
 	}
+	if o.ObjectRestoreTieredObjectHandler == nil {
+		unregistered = append(unregistered, "object.RestoreTieredObjectHandler")
+	}
+	if o.AccountRevokeAPITokenHandler == nil {
+		unregistered = append(unregistered, "account.RevokeAPITokenHandler")
+	}
+	if o.ConfigurationRollbackConfigRevisionHandler == nil {
+		unregistered = append(unregistered, "configuration.RollbackConfigRevisionHandler")
+	}
+	if o.AuthRotateSessionKeyHandler == nil {
+		unregistered = append(unregistered, "auth.RotateSessionKeyHandler")
+	}
+	if o.LoggingSearchLogEntriesHandler == nil {
+		unregistered = append(unregistered, "logging.SearchLogEntriesHandler")
+	}
 	if o.AuthSessionCheckHandler == nil {
 		unregistered = append(unregistered, "auth.SessionCheckHandler")
 	}
+	if o.AuthSessionRenewHandler == nil {
+		unregistered = append(unregistered, "auth.SessionRenewHandler")
+	}
 	if o.BucketSetAccessRuleWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.SetAccessRuleWithBucketHandler")
 	}
+	if o.SystemSetAlertRuleHandler == nil {
+		unregistered = append(unregistered, "system.SetAlertRuleHandler")
+	}
 	if o.BucketSetBucketQuotaHandler == nil {
 		unregistered = append(unregistered, "bucket.SetBucketQuotaHandler")
 	}
+	if o.BucketSetBucketReplicationPrioritiesHandler == nil {
+		unregistered = append(unregistered, "bucket.SetBucketReplicationPrioritiesHandler")
+	}
 	if o.BucketSetBucketRetentionConfigHandler == nil {
 		unregistered = append(unregistered, "bucket.SetBucketRetentionConfigHandler")
 	}
@@ -1421,9 +2457,15 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SupportSetCallHomeStatusHandler == nil {
 		unregistered = append(unregistered, "support.SetCallHomeStatusHandler")
 	}
+	if o.SystemSetClusterHandler == nil {
+		unregistered = append(unregistered, "system.SetClusterHandler")
+	}
 	if o.ConfigurationSetConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.SetConfigHandler")
 	}
+	if o.LoggingSetLogLevelHandler == nil {
+		unregistered = append(unregistered, "logging.SetLogLevelHandler")
+	}
 	if o.BucketSetMultiBucketReplicationHandler == nil {
 		unregistered = append(unregistered, "bucket.SetMultiBucketReplicationHandler")
 	}
@@ -1436,9 +2478,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountSetServiceAccountPolicyHandler == nil {
 		unregistered = append(unregistered, "service_account.SetServiceAccountPolicyHandler")
 	}
+	if o.ConfigurationSetTrustedProxiesHandler == nil {
+		unregistered = append(unregistered, "configuration.SetTrustedProxiesHandler")
+	}
 	if o.ObjectShareObjectHandler == nil {
 		unregistered = append(unregistered, "object.ShareObjectHandler")
 	}
+	if o.PolicySimulatePolicyHandler == nil {
+		unregistered = append(unregistered, "policy.SimulatePolicyHandler")
+	}
+	if o.SiteReplicationSiteReplicationCompareHandler == nil {
+		unregistered = append(unregistered, "site_replication.SiteReplicationCompareHandler")
+	}
 	if o.SiteReplicationSiteReplicationEditHandler == nil {
 		unregistered = append(unregistered, "site_replication.SiteReplicationEditHandler")
 	}
@@ -1448,6 +2499,30 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SiteReplicationSiteReplicationRemoveHandler == nil {
 		unregistered = append(unregistered, "site_replication.SiteReplicationRemoveHandler")
 	}
+	if o.BatchJobsStartBatchJobHandler == nil {
+		unregistered = append(unregistered, "batch_jobs.StartBatchJobHandler")
+	}
+	if o.BucketStartBucketRenameHandler == nil {
+		unregistered = append(unregistered, "bucket.StartBucketRenameHandler")
+	}
+	if o.SystemStartHealHandler == nil {
+		unregistered = append(unregistered, "system.StartHealHandler")
+	}
+	if o.SystemStartPoolDecommissionHandler == nil {
+		unregistered = append(unregistered, "system.StartPoolDecommissionHandler")
+	}
+	if o.SystemStartRebalanceHandler == nil {
+		unregistered = append(unregistered, "system.StartRebalanceHandler")
+	}
+	if o.BucketStartReplicationResyncHandler == nil {
+		unregistered = append(unregistered, "bucket.StartReplicationResyncHandler")
+	}
+	if o.BucketStartReplicationRetryHandler == nil {
+		unregistered = append(unregistered, "bucket.StartReplicationRetryHandler")
+	}
+	if o.SystemStopRebalanceHandler == nil {
+		unregistered = append(unregistered, "system.StopRebalanceHandler")
+	}
 	if o.SubnetSubnetAPIKeyHandler == nil {
 		unregistered = append(unregistered, "subnet.SubnetAPIKeyHandler")
 	}
@@ -1466,9 +2541,30 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SubnetSubnetRegisterHandler == nil {
 		unregistered = append(unregistered, "subnet.SubnetRegisterHandler")
 	}
+	if o.AuthSwitchClusterHandler == nil {
+		unregistered = append(unregistered, "auth.SwitchClusterHandler")
+	}
+	if o.BucketTestBucketEventHandler == nil {
+		unregistered = append(unregistered, "bucket.TestBucketEventHandler")
+	}
+	if o.IdpTestIDPConfigurationHandler == nil {
+		unregistered = append(unregistered, "idp.TestIDPConfigurationHandler")
+	}
+	if o.ConfigurationTestLogTargetHandler == nil {
+		unregistered = append(unregistered, "configuration.TestLogTargetHandler")
+	}
+	if o.ConfigurationTestNotificationEndpointHandler == nil {
+		unregistered = append(unregistered, "configuration.TestNotificationEndpointHandler")
+	}
 	if o.TieringTiersListHandler == nil {
 		unregistered = append(unregistered, "tiering.TiersListHandler")
 	}
+	if o.AccountTwoFactorStatusHandler == nil {
+		unregistered = append(unregistered, "account.TwoFactorStatusHandler")
+	}
+	if o.UserUnlockLoginAttemptsHandler == nil {
+		unregistered = append(unregistered, "user.UnlockLoginAttemptsHandler")
+	}
 	if o.BucketUpdateBucketLifecycleHandler == nil {
 		unregistered = append(unregistered, "bucket.UpdateBucketLifecycleHandler")
 	}
@@ -1478,15 +2574,42 @@ func (o *ConsoleAPI) Validate() error {
 	if o.GroupUpdateGroupHandler == nil {
 		unregistered = append(unregistered, "group.UpdateGroupHandler")
 	}
+	if o.ConfigurationUpdateLogTargetHandler == nil {
+		unregistered = append(unregistered, "configuration.UpdateLogTargetHandler")
+	}
 	if o.BucketUpdateMultiBucketReplicationHandler == nil {
 		unregistered = append(unregistered, "bucket.UpdateMultiBucketReplicationHandler")
 	}
+	if o.ConfigurationUpdateNotificationEndpointHandler == nil {
+		unregistered = append(unregistered, "configuration.UpdateNotificationEndpointHandler")
+	}
+	if o.IdpUpdateOpenIDClaimMappingHandler == nil {
+		unregistered = append(unregistered, "idp.UpdateOpenIDClaimMappingHandler")
+	}
 	if o.UserUpdateUserGroupsHandler == nil {
 		unregistered = append(unregistered, "user.UpdateUserGroupsHandler")
 	}
 	if o.UserUpdateUserInfoHandler == nil {
 		unregistered = append(unregistered, "user.UpdateUserInfoHandler")
 	}
+	if o.BucketValidateBucketPolicyHandler == nil {
+		unregistered = append(unregistered, "bucket.ValidateBucketPolicyHandler")
+	}
+	if o.PolicyValidatePolicyHandler == nil {
+		unregistered = append(unregistered, "policy.ValidatePolicyHandler")
+	}
+	if o.ObjectVerifyObjectChecksumManifestHandler == nil {
+		unregistered = append(unregistered, "object.VerifyObjectChecksumManifestHandler")
+	}
+	if o.ObjectVerifyObjectIntegrityHandler == nil {
+		unregistered = append(unregistered, "object.VerifyObjectIntegrityHandler")
+	}
+	if o.TieringVerifyTierHandler == nil {
+		unregistered = append(unregistered, "tiering.VerifyTierHandler")
+	}
+	if o.AccountVerifyTwoFactorHandler == nil {
+		unregistered = append(unregistered, "account.VerifyTwoFactorHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1612,6 +2735,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/log-targets"] = configuration.NewAddLogTarget(o.context, o.ConfigurationAddLogTargetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/multi-lifecycle"] = bucket.NewAddMultiBucketLifecycle(o.context, o.BucketAddMultiBucketLifecycleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1628,6 +2755,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/staging/workspaces/{workspace_id}/operations"] = staging.NewAddStagedOperations(o.context, o.StagingAddStagedOperationsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/admin/tiers"] = tiering.NewAddTier(o.context, o.TieringAddTierHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1641,6 +2772,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/arns"] = system.NewArnList(o.context, o.SystemArnListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/ldap-entities/policy/attach"] = idp.NewAttachLDAPPolicy(o.context, o.IdpAttachLDAPPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/batch"] = system.NewBatch(o.context, o.SystemBatchHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1649,10 +2788,30 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/buckets/{name}/set-policy"] = bucket.NewBucketSetPolicy(o.context, o.BucketBucketSetPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/bulk"] = bucket.NewBulkBucketOperation(o.context, o.BucketBulkBucketOperationHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/users-groups-bulk"] = user.NewBulkUpdateUsersGroups(o.context, o.UserBulkUpdateUsersGroupsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/batch-jobs/{id}"] = batch_jobs.NewCancelBatchJob(o.context, o.BatchJobsCancelBatchJobHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/rename/{job_id}"] = bucket.NewCancelBucketRenameJob(o.context, o.BucketCancelBucketRenameJobHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/pools/{id}/decommission"] = system.NewCancelPoolDecommission(o.context, o.SystemCancelPoolDecommissionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/replication-resync"] = bucket.NewCancelReplicationResync(o.context, o.BucketCancelReplicationResyncHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1661,10 +2820,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/check-version"] = system.NewCheckMinIOVersion(o.context, o.SystemCheckMinIOVersionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/service/update"] = service.NewCheckServerUpdate(o.context, o.ServiceCheckServerUpdateHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/users/service-accounts"] = user.NewCheckUserServiceAccounts(o.context, o.UserCheckUserServiceAccountsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/retention"] = bucket.NewClearBucketRetentionConfig(o.context, o.BucketClearBucketRetentionConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/staging/workspaces/{workspace_id}/commit"] = staging.NewCommitStagingWorkspace(o.context, o.StagingCommitStagingWorkspaceHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1672,6 +2843,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/account/api-tokens"] = account.NewCreateAPIToken(o.context, o.AccountCreateAPITokenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/user/{name}/service-accounts"] = user.NewCreateAUserServiceAccount(o.context, o.UserCreateAUserServiceAccountHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1693,6 +2868,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/service-account-credentials"] = service_account.NewCreateServiceAccountCreds(o.context, o.ServiceAccountCreateServiceAccountCredsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/service/confirmation"] = service.NewCreateServiceConfirmation(o.context, o.ServiceCreateServiceConfirmationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/staging/workspaces"] = staging.NewCreateStagingWorkspace(o.context, o.StagingCreateStagingWorkspaceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/account/temporary-credentials"] = account.NewCreateTemporaryCredentials(o.context, o.AccountCreateTemporaryCredentialsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1704,6 +2891,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/alerts/rules/{id}"] = system.NewDeleteAlertRule(o.context, o.SystemDeleteAlertRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/delete-all-replication-rules"] = bucket.NewDeleteAllReplicationRules(o.context, o.BucketDeleteAllReplicationRulesHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1712,6 +2903,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/cors"] = bucket.NewDeleteBucketCors(o.context, o.BucketDeleteBucketCorsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/events/{arn}"] = bucket.NewDeleteBucketEvent(o.context, o.BucketDeleteBucketEventHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1724,6 +2919,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/clusters/{id}"] = system.NewDeleteCluster(o.context, o.SystemDeleteClusterHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/idp/{type}/{name}"] = idp.NewDeleteConfiguration(o.context, o.IdpDeleteConfigurationHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1736,6 +2935,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/notification_endpoints/{service}/{account_id}"] = configuration.NewDeleteNotificationEndpoint(o.context, o.ConfigurationDeleteNotificationEndpointHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/objects"] = object.NewDeleteObject(o.context, o.ObjectDeleteObjectHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1753,10 +2956,30 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/service-accounts/{access_key}"] = service_account.NewDeleteServiceAccount(o.context, o.ServiceAccountDeleteServiceAccountHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/staging/workspaces/{workspace_id}"] = staging.NewDeleteStagingWorkspace(o.context, o.StagingDeleteStagingWorkspaceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/batch-jobs/{id}"] = batch_jobs.NewDescribeBatchJob(o.context, o.BatchJobsDescribeBatchJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/ldap-entities/policy/detach"] = idp.NewDetachLDAPPolicy(o.context, o.IdpDetachLDAPPolicyHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/buckets/{bucket_name}/encryption/disable"] = bucket.NewDisableBucketEncryption(o.context, o.BucketDisableBucketEncryptionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/log-targets/{type}/{name}/disable"] = configuration.NewDisableLogTarget(o.context, o.ConfigurationDisableLogTargetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/account/two-factor/disable"] = account.NewDisableTwoFactor(o.context, o.AccountDisableTwoFactorHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1769,6 +2992,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/buckets/{bucket_name}/encryption/enable"] = bucket.NewEnableBucketEncryption(o.context, o.BucketEnableBucketEncryptionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/account/two-factor/enroll"] = account.NewEnrollTwoFactor(o.context, o.AccountEnrollTwoFactorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/evaluate"] = system.NewEvaluateAlerts(o.context, o.SystemEvaluateAlertsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/config/export"] = bucket.NewExportBucketConfig(o.context, o.BucketExportBucketConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/lifecycle/export"] = bucket.NewExportBucketLifecycle(o.context, o.BucketExportBucketLifecycleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1776,6 +3015,46 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/iam/export"] = configuration.NewExportIAM(o.context, o.ConfigurationExportIAMHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/logs/entries/export"] = logging.NewExportLogEntries(o.context, o.LoggingExportLogEntriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/configs/server/export"] = configuration.NewExportServerConfig(o.context, o.ConfigurationExportServerConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/batch-jobs/generate"] = batch_jobs.NewGenerateBatchJob(o.context, o.BatchJobsGenerateBatchJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/policy/generate"] = bucket.NewGenerateBucketPolicy(o.context, o.BucketGenerateBucketPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/account/api-tokens/{name}/usage"] = account.NewGetAPITokenUsage(o.context, o.AccountGetAPITokenUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts"] = system.NewGetAlerts(o.context, o.SystemGetAlertsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/heal/background"] = system.NewGetBackgroundHealStatus(o.context, o.SystemGetBackgroundHealStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/access-insight"] = bucket.NewGetBucketAccessInsight(o.context, o.BucketGetBucketAccessInsightHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/cors"] = bucket.NewGetBucketCors(o.context, o.BucketGetBucketCorsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/encryption/info"] = bucket.NewGetBucketEncryptionInfo(o.context, o.BucketGetBucketEncryptionInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1788,14 +3067,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/usage/prefixes"] = bucket.NewGetBucketPrefixUsage(o.context, o.BucketGetBucketPrefixUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{name}/quota"] = bucket.NewGetBucketQuota(o.context, o.BucketGetBucketQuotaHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/rename/{job_id}"] = bucket.NewGetBucketRenameJob(o.context, o.BucketGetBucketRenameJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/replication"] = bucket.NewGetBucketReplication(o.context, o.BucketGetBucketReplicationHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-metrics"] = bucket.NewGetBucketReplicationMetrics(o.context, o.BucketGetBucketReplicationMetricsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/replication/{rule_id}"] = bucket.NewGetBucketReplicationRule(o.context, o.BucketGetBucketReplicationRuleHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1808,6 +3099,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/usage/history"] = bucket.NewGetBucketUsageHistory(o.context, o.BucketGetBucketUsageHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/versioning"] = bucket.NewGetBucketVersioning(o.context, o.BucketGetBucketVersioningHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1817,6 +3112,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/idp/{type}/{name}"] = idp.NewGetConfiguration(o.context, o.IdpGetConfigurationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/topology"] = system.NewGetDriveTopology(o.context, o.SystemGetDriveTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/drives/health"] = system.NewGetDrivesHealth(o.context, o.SystemGetDrivesHealthHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/ldap-entities/effective-policy"] = idp.NewGetLDAPEffectivePolicy(o.context, o.IdpGetLDAPEffectivePolicyHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1824,18 +3131,74 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/logs/level"] = logging.NewGetLogLevel(o.context, o.LoggingGetLogLevelHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/log-targets/{type}/{name}"] = configuration.NewGetLogTarget(o.context, o.ConfigurationGetLogTargetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/metrics/dashboard"] = system.NewGetMetricsDashboard(o.context, o.SystemGetMetricsDashboardHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/notification_endpoints/{service}/{account_id}"] = configuration.NewGetNotificationEndpoint(o.context, o.ConfigurationGetNotificationEndpointHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/checksum-manifest"] = object.NewGetObjectChecksumManifest(o.context, o.ObjectGetObjectChecksumManifestHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/metadata"] = object.NewGetObjectMetadata(o.context, o.ObjectGetObjectMetadataHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/objects/tier-restore"] = object.NewGetObjectTierRestoreStatus(o.context, o.ObjectGetObjectTierRestoreStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/idp/openid/{name}/claim-mapping"] = idp.NewGetOpenIDClaimMapping(o.context, o.IdpGetOpenIDClaimMappingHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/pools/{id}"] = system.NewGetPoolStatus(o.context, o.SystemGetPoolStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/preflight"] = system.NewGetPreflightReport(o.context, o.SystemGetPreflightReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/rebalance"] = system.NewGetRebalanceStatus(o.context, o.SystemGetRebalanceStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-resync"] = bucket.NewGetReplicationResyncStatus(o.context, o.BucketGetReplicationResyncStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-retry/{job_id}"] = bucket.NewGetReplicationRetryJob(o.context, o.BucketGetReplicationRetryJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user/{name}/policies"] = policy.NewGetSAUserPolicy(o.context, o.PolicyGetSAUserPolicyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/scanner"] = system.NewGetScannerStatus(o.context, o.SystemGetScannerStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/service-accounts/{access_key}/policy"] = service_account.NewGetServiceAccountPolicy(o.context, o.ServiceAccountGetServiceAccountPolicyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/site-replication/health"] = site_replication.NewGetSiteReplicationHealth(o.context, o.SiteReplicationGetSiteReplicationHealthHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/site-replication"] = site_replication.NewGetSiteReplicationInfo(o.context, o.SiteReplicationGetSiteReplicationInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1844,10 +3207,38 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/staging/workspaces/{workspace_id}"] = staging.NewGetStagingWorkspace(o.context, o.StagingGetStagingWorkspaceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/tls/certificates"] = system.NewGetTLSCertificates(o.context, o.SystemGetTLSCertificatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/tiers/{type}/{name}"] = tiering.NewGetTier(o.context, o.TieringGetTierHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/tiers/{type}/{name}/stats"] = tiering.NewGetTierStats(o.context, o.TieringGetTierStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/top/locks"] = system.NewGetTopLocks(o.context, o.SystemGetTopLocksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/trace/stats"] = system.NewGetTraceStats(o.context, o.SystemGetTraceStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/configs/trusted-proxies"] = configuration.NewGetTrustedProxies(o.context, o.ConfigurationGetTrustedProxiesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{name}/effective-policy"] = user.NewGetUserEffectivePolicy(o.context, o.UserGetUserEffectivePolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user/{name}"] = user.NewGetUserInfo(o.context, o.UserGetUserInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1857,6 +3248,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/group/{name}"] = group.NewGroupInfo(o.context, o.GroupGroupInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/config/import"] = bucket.NewImportBucketConfig(o.context, o.BucketImportBucketConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/lifecycle/import"] = bucket.NewImportBucketLifecycle(o.context, o.BucketImportBucketLifecycleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/iam/import"] = configuration.NewImportIAM(o.context, o.ConfigurationImportIAMHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/configs/server/import"] = configuration.NewImportServerConfig(o.context, o.ConfigurationImportServerConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/import"] = user.NewImportUsers(o.context, o.UserImportUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1864,6 +3275,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/inspect/bundle"] = inspect.NewInspectBundle(o.context, o.InspectInspectBundleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/kms/apis"] = k_m_s.NewKMSAPIs(o.context, o.KmsKMSAPIsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1933,6 +3348,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/kms/status"] = k_m_s.NewKMSStatus(o.context, o.KmsKMSStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/kms/keys/{name}/test"] = k_m_s.NewKMSTestKey(o.context, o.KmsKMSTestKeyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1940,27 +3359,67 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/account/api-tokens"] = account.NewListAPITokens(o.context, o.AccountListAPITokensHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/user/{name}/service-accounts"] = user.NewListAUserServiceAccounts(o.context, o.UserListAUserServiceAccountsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/users/access-keys"] = user.NewListAccessKeyInventory(o.context, o.UserListAccessKeyInventoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/bucket/{bucket}/access-rules"] = bucket.NewListAccessRulesWithBucket(o.context, o.BucketListAccessRulesWithBucketHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/alerts/rules"] = system.NewListAlertRules(o.context, o.SystemListAlertRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/batch-jobs"] = batch_jobs.NewListBatchJobs(o.context, o.BatchJobsListBatchJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/encryption/keys"] = bucket.NewListBucketEncryptionKeys(o.context, o.BucketListBucketEncryptionKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/events"] = bucket.NewListBucketEvents(o.context, o.BucketListBucketEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-targets"] = bucket.NewListBucketReplicationTargets(o.context, o.BucketListBucketReplicationTargetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets"] = bucket.NewListBuckets(o.context, o.BucketListBucketsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/csp-reports"] = system.NewListCSPReports(o.context, o.SystemListCSPReportsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters"] = system.NewListClusters(o.context, o.SystemListClustersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/configs"] = configuration.NewListConfig(o.context, o.ConfigurationListConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/configs/history"] = configuration.NewListConfigRevisions(o.context, o.ConfigurationListConfigRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/idp/{type}"] = idp.NewListConfigurations(o.context, o.IdpListConfigurationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/logs/console-audit"] = logging.NewListConsoleAuditEvents(o.context, o.LoggingListConsoleAuditEventsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1976,6 +3435,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/ldap-entities"] = idp.NewListLDAPEntities(o.context, o.IdpListLDAPEntitiesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/log-targets"] = configuration.NewListLogTargets(o.context, o.ConfigurationListLogTargetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/login-attempts"] = user.NewListLoginAttempts(o.context, o.UserListLoginAttemptsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/nodes"] = system.NewListNodes(o.context, o.SystemListNodesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1992,6 +3463,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/policies/{policy}/entities"] = policy.NewListPolicyEntities(o.context, o.PolicyListPolicyEntitiesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/policies/templates"] = policy.NewListPolicyTemplates(o.context, o.PolicyListPolicyTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/pools"] = system.NewListPools(o.context, o.SystemListPoolsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/releases"] = release.NewListReleases(o.context, o.ReleaseListReleasesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -2000,6 +3483,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/staging/workspaces"] = staging.NewListStagingWorkspaces(o.context, o.StagingListStagingWorkspacesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/service-accounts"] = service_account.NewListUserServiceAccounts(o.context, o.ServiceAccountListUserServiceAccountsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -2044,6 +3531,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/notification_endpoints/status"] = configuration.NewNotificationEndpointsStatus(o.context, o.ConfigurationNotificationEndpointsStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/policy/{name}"] = policy.NewPolicyInfo(o.context, o.PolicyPolicyInfoHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -2056,11 +3547,27 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/idp/openid/{name}/claim-mapping/preview"] = idp.NewPreviewOpenIDClaimMapping(o.context, o.IdpPreviewOpenIDClaimMappingHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/profiling/start"] = profile.NewProfilingStart(o.context, o.ProfileProfilingStartHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/profiling/stop"] = profile.NewProfilingStop(o.context, o.ProfileProfilingStopHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/public/buckets/{bucket_name}/objects/download"] = public.NewPublicDownloadObject(o.context, o.PublicPublicDownloadObjectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/public/buckets/{bucket_name}/objects"] = public.NewPublicListObjects(o.context, o.PublicPublicListObjectsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/cors"] = bucket.NewPutBucketCors(o.context, o.BucketPutBucketCorsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -2081,6 +3588,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/buckets/{bucket_name}/objects/tags"] = object.NewPutObjectTags(o.context, o.ObjectPutObjectTagsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/scanner/refresh"] = system.NewRefreshDataUsage(o.context, o.SystemRefreshDataUsageHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/console-config/reload"] = configuration.NewReloadConsoleConfig(o.context, o.ConfigurationReloadConsoleConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -2096,19 +3611,63 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/staging/workspaces/{workspace_id}/operations/{operation_id}"] = staging.NewRemoveStagedOperation(o.context, o.StagingRemoveStagedOperationHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/tiers/{type}/{name}"] = tiering.NewRemoveTier(o.context, o.TieringRemoveTierHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/user/{name}"] = user.NewRemoveUser(o.context, o.UserRemoveUserHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/policies/templates/{template}/render"] = policy.NewRenderPolicyTemplate(o.context, o.PolicyRenderPolicyTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/configs/{name}/reset"] = configuration.NewResetConfig(o.context, o.ConfigurationResetConfigHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/user/{name}/reset-password"] = user.NewResetUserPassword(o.context, o.UserResetUserPasswordHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/{name}/reset-two-factor"] = user.NewResetUserTwoFactor(o.context, o.UserResetUserTwoFactorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/service/restart"] = service.NewRestartService(o.context, o.ServiceRestartServiceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/tier-restore"] = object.NewRestoreTieredObject(o.context, o.ObjectRestoreTieredObjectHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/account/api-tokens/{name}"] = account.NewRevokeAPIToken(o.context, o.AccountRevokeAPITokenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/configs/history/{id}/rollback"] = configuration.NewRollbackConfigRevision(o.context, o.ConfigurationRollbackConfigRevisionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/session/keys/rotate"] = auth.NewRotateSessionKey(o.context, o.AuthRotateSessionKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/logs/entries"] = logging.NewSearchLogEntries(o.context, o.LoggingSearchLogEntriesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/session"] = auth.NewSessionCheck(o.context, o.AuthSessionCheckHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/session/renew"] = auth.NewSessionRenew(o.context, o.AuthSessionRenewHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -2116,10 +3675,18 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/alerts/rules/{id}"] = system.NewSetAlertRule(o.context, o.SystemSetAlertRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/buckets/{name}/quota"] = bucket.NewSetBucketQuota(o.context, o.BucketSetBucketQuotaHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/replication-priorities"] = bucket.NewSetBucketReplicationPriorities(o.context, o.BucketSetBucketReplicationPrioritiesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/buckets/{bucket_name}/retention"] = bucket.NewSetBucketRetentionConfig(o.context, o.BucketSetBucketRetentionConfigHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -2132,7 +3699,15 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/clusters/{id}"] = system.NewSetCluster(o.context, o.SystemSetClusterHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/configs/{name}"] = configuration.NewSetConfig(o.context, o.ConfigurationSetConfigHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/logs/level"] = logging.NewSetLogLevel(o.context, o.LoggingSetLogLevelHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -2149,10 +3724,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/service-accounts/{access_key}/policy"] = service_account.NewSetServiceAccountPolicy(o.context, o.ServiceAccountSetServiceAccountPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/configs/trusted-proxies"] = configuration.NewSetTrustedProxies(o.context, o.ConfigurationSetTrustedProxiesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/share"] = object.NewShareObject(o.context, o.ObjectShareObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policies/simulate"] = policy.NewSimulatePolicy(o.context, o.PolicySimulatePolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/site-replication/compare"] = site_replication.NewSiteReplicationCompare(o.context, o.SiteReplicationSiteReplicationCompareHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -2165,6 +3752,38 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/admin/site-replication"] = site_replication.NewSiteReplicationRemove(o.context, o.SiteReplicationSiteReplicationRemoveHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/batch-jobs"] = batch_jobs.NewStartBatchJob(o.context, o.BatchJobsStartBatchJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/rename"] = bucket.NewStartBucketRename(o.context, o.BucketStartBucketRenameHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/heal"] = system.NewStartHeal(o.context, o.SystemStartHealHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/pools/{id}/decommission"] = system.NewStartPoolDecommission(o.context, o.SystemStartPoolDecommissionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/rebalance"] = system.NewStartRebalance(o.context, o.SystemStartRebalanceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/replication-resync"] = bucket.NewStartReplicationResync(o.context, o.BucketStartReplicationResyncHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/replication-retry"] = bucket.NewStartReplicationRetry(o.context, o.BucketStartReplicationRetryHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/rebalance"] = system.NewStopRebalance(o.context, o.SystemStopRebalanceHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -2189,10 +3808,38 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/subnet/register"] = subnet.NewSubnetRegister(o.context, o.SubnetSubnetRegisterHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/session/cluster"] = auth.NewSwitchCluster(o.context, o.AuthSwitchClusterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/events/{arn}/test"] = bucket.NewTestBucketEvent(o.context, o.BucketTestBucketEventHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/idp/{type}/test-configuration"] = idp.NewTestIDPConfiguration(o.context, o.IdpTestIDPConfigurationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/log-targets/{type}/{name}/test"] = configuration.NewTestLogTarget(o.context, o.ConfigurationTestLogTargetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/notification_endpoints/{service}/{account_id}/test"] = configuration.NewTestNotificationEndpoint(o.context, o.ConfigurationTestNotificationEndpointHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/tiers"] = tiering.NewTiersList(o.context, o.TieringTiersListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/account/two-factor"] = account.NewTwoFactorStatus(o.context, o.AccountTwoFactorStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/login-attempts/unlock"] = user.NewUnlockLoginAttempts(o.context, o.UserUnlockLoginAttemptsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -2208,15 +3855,51 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/log-targets/{type}/{name}"] = configuration.NewUpdateLogTarget(o.context, o.ConfigurationUpdateLogTargetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/buckets/{bucket_name}/replication/{rule_id}"] = bucket.NewUpdateMultiBucketReplication(o.context, o.BucketUpdateMultiBucketReplicationHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/notification_endpoints/{service}/{account_id}"] = configuration.NewUpdateNotificationEndpoint(o.context, o.ConfigurationUpdateNotificationEndpointHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/idp/openid/{name}/claim-mapping"] = idp.NewUpdateOpenIDClaimMapping(o.context, o.IdpUpdateOpenIDClaimMappingHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/user/{name}/groups"] = user.NewUpdateUserGroups(o.context, o.UserUpdateUserGroupsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/user/{name}"] = user.NewUpdateUserInfo(o.context, o.UserUpdateUserInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/policy/validate"] = bucket.NewValidateBucketPolicy(o.context, o.BucketValidateBucketPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policies/validate"] = policy.NewValidatePolicy(o.context, o.PolicyValidatePolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/checksum-manifest/verify"] = object.NewVerifyObjectChecksumManifest(o.context, o.ObjectVerifyObjectChecksumManifestHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/objects/verify"] = object.NewVerifyObjectIntegrity(o.context, o.ObjectVerifyObjectIntegrityHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/tiers/{type}/{name}/verify"] = tiering.NewVerifyTier(o.context, o.TieringVerifyTierHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/account/two-factor/verify"] = account.NewVerifyTwoFactor(o.context, o.AccountVerifyTwoFactorHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -49,6 +49,10 @@ type ListPoliciesParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*opaque cursor returned by the previous page as nextCursor, the first page is returned without it
+	  In: query
+	*/
+	Cursor *string
 	/*
 	  In: query
 	*/
@@ -57,6 +61,10 @@ type ListPoliciesParams struct {
 	  In: query
 	*/
 	Offset *int32
+	/*number of items of the page, capped by CONSOLE_LIST_MAX_PAGE_SIZE
+	  In: query
+	*/
+	PageSize *int32
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -70,6 +78,11 @@ func (o *ListPoliciesParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	qs := runtime.Values(r.URL.Query())
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qLimit, qhkLimit, _ := qs.GetOK("limit")
 	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
 		res = append(res, err)
@@ -79,12 +92,35 @@ func (o *ListPoliciesParams) BindRequest(r *http.Request, route *middleware.Matc
 	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qPageSize, qhkPageSize, _ := qs.GetOK("pageSize")
+	if err := o.bindPageSize(qPageSize, qhkPageSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListPoliciesParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
 // bindLimit binds and validates parameter Limit from query.
 func (o *ListPoliciesParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -130,3 +166,26 @@ func (o *ListPoliciesParams) bindOffset(rawData []string, hasKey bool, formats s
 
 	return nil
 }
+
+// bindPageSize binds and validates parameter PageSize from query.
+func (o *ListPoliciesParams) bindPageSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("pageSize", "query", "int32", raw)
+	}
+	o.PageSize = &value
+
+	return nil
+}
@@ -32,8 +32,10 @@ import (
 
 // ListPoliciesURL generates an URL for the list policies operation
 type ListPoliciesURL struct {
-	Limit  *int32
-	Offset *int32
+	Cursor   *string
+	Limit    *int32
+	Offset   *int32
+	PageSize *int32
 
 	_basePath string
 	// avoid unkeyed usage
@@ -69,6 +71,14 @@ func (o *ListPoliciesURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
 	var limitQ string
 	if o.Limit != nil {
 		limitQ = swag.FormatInt32(*o.Limit)
@@ -85,6 +95,14 @@ func (o *ListPoliciesURL) Build() (*url.URL, error) {
 		qs.Set("offset", offsetQ)
 	}
 
+	var pageSizeQ string
+	if o.PageSize != nil {
+		pageSizeQ = swag.FormatInt32(*o.PageSize)
+	}
+	if pageSizeQ != "" {
+		qs.Set("pageSize", pageSizeQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
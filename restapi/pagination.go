@@ -0,0 +1,84 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// The headers carrying the pagination of the listings, they are the only hint for the listings returning an array
+const (
+	totalCountHeader = "X-Total-Count"
+	nextCursorHeader = "X-Next-Cursor"
+)
+
+// listPage is the range of a sorted listing returned for a cursor
+type listPage struct {
+	start      int
+	end        int
+	total      int
+	nextCursor string
+}
+
+// paginate returns the page of the n items sorted by their unique key that follows the cursor. The cursor is the last
+// key of the previous page, so the items added or removed in between neither shift nor repeat the next pages. The
+// whole listing is returned when neither the request nor CONSOLE_LIST_PAGE_SIZE set a page size.
+func paginate(n int, key func(i int) string, cursor *string, pageSize *int32) (*listPage, error) {
+	page := &listPage{end: n, total: n}
+	if cursor != nil && *cursor != "" {
+		after, err := base64.RawURLEncoding.DecodeString(*cursor)
+		if err != nil || len(after) == 0 {
+			return nil, ErrInvalidCursor
+		}
+		page.start = sort.Search(n, func(i int) bool { return key(i) > string(after) })
+	}
+	size := getConsoleListPageSize()
+	if pageSize != nil {
+		if *pageSize <= 0 {
+			return nil, ErrInvalidPageSize
+		}
+		size = int(*pageSize)
+	}
+	if size == 0 {
+		return page, nil
+	}
+	if maxSize := getConsoleListMaxPageSize(); size > maxSize {
+		size = maxSize
+	}
+	if page.start+size < n {
+		page.end = page.start + size
+		page.nextCursor = base64.RawURLEncoding.EncodeToString([]byte(key(page.end - 1)))
+	}
+	return page, nil
+}
+
+// withPageHeaders sets the total count and the cursor of the next page on the response
+func withPageHeaders(responder middleware.Responder, total int64, nextCursor string) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		w.Header().Set(totalCountHeader, strconv.FormatInt(total, 10))
+		if nextCursor != "" {
+			w.Header().Set(nextCursorHeader, nextCursor)
+		}
+		responder.WriteResponse(w, p)
+	})
+}
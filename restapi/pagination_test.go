@@ -0,0 +1,97 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	userApi "github.com/minio/console/restapi/operations/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	assert := assert.New(t)
+	users := []string{"alice", "bob", "carol", "dave", "erin"}
+	key := func(i int) string { return users[i] }
+
+	// Test-1 : the whole listing is returned without a page size
+	page, err := paginate(len(users), key, nil, nil)
+	assert.NoError(err)
+	assert.Equal(&listPage{start: 0, end: 5, total: 5}, page)
+
+	// Test-2 : the pages follow each other until the last one, which has no cursor
+	page, err = paginate(len(users), key, nil, swag.Int32(2))
+	assert.NoError(err)
+	assert.Equal([]string{"alice", "bob"}, users[page.start:page.end])
+	assert.NotEmpty(page.nextCursor)
+	page, err = paginate(len(users), key, swag.String(page.nextCursor), swag.Int32(2))
+	assert.NoError(err)
+	assert.Equal([]string{"carol", "dave"}, users[page.start:page.end])
+	page, err = paginate(len(users), key, swag.String(page.nextCursor), swag.Int32(2))
+	assert.NoError(err)
+	assert.Equal([]string{"erin"}, users[page.start:page.end])
+	assert.Empty(page.nextCursor)
+	assert.Equal(5, page.total)
+
+	// Test-3 : an item removed before the cursor doesn't shift the next page
+	page, _ = paginate(len(users), key, nil, swag.Int32(2))
+	users = []string{"alice", "carol", "dave", "erin"}
+	page, err = paginate(len(users), key, swag.String(page.nextCursor), swag.Int32(2))
+	assert.NoError(err)
+	assert.Equal([]string{"carol", "dave"}, users[page.start:page.end])
+
+	// Test-4 : the page size is capped and defaults to CONSOLE_LIST_PAGE_SIZE
+	t.Setenv(ConsoleListMaxPageSize, "3")
+	page, err = paginate(len(users), key, nil, swag.Int32(100))
+	assert.NoError(err)
+	assert.Equal(3, page.end)
+	t.Setenv(ConsoleListPageSize, "1")
+	page, err = paginate(len(users), key, nil, nil)
+	assert.NoError(err)
+	assert.Equal(1, page.end)
+
+	// Test-5 : invalid cursors and page sizes are rejected
+	_, err = paginate(len(users), key, swag.String("not a cursor!"), nil)
+	assert.ErrorIs(err, ErrInvalidCursor)
+	_, err = paginate(len(users), key, nil, swag.Int32(0))
+	assert.ErrorIs(err, ErrInvalidPageSize)
+
+	// Test-6 : an empty listing has a single empty page
+	page, err = paginate(0, key, nil, swag.Int32(2))
+	assert.NoError(err)
+	assert.Equal(&listPage{}, page)
+}
+
+func TestWithPageHeaders(t *testing.T) {
+	w := httptest.NewRecorder()
+	responder := withPageHeaders(userApi.NewListUsersOK().WithPayload(&models.ListUsersResponse{Total: 5, NextCursor: "Ym9i"}), 5, "Ym9i")
+	responder.WriteResponse(w, runtime.JSONProducer())
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "5", w.Header().Get(totalCountHeader))
+	assert.Equal(t, "Ym9i", w.Header().Get(nextCursorHeader))
+	assert.Contains(t, w.Body.String(), `"nextCursor":"Ym9i"`)
+
+	w = httptest.NewRecorder()
+	withPageHeaders(userApi.NewListUsersOK(), 0, "").WriteResponse(w, runtime.JSONProducer())
+	assert.Equal(t, "0", w.Header().Get(totalCountHeader))
+	assert.Empty(t, w.Header().Values(nextCursorHeader))
+}
@@ -0,0 +1,624 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	bucketPolicyVersion = "2012-10-17"
+	s3ResourcePrefix    = "arn:aws:s3:::"
+)
+
+// Issue is a problem found in a bucket policy, Statement is the 1-based index of the statement
+// it was found in or zero when it concerns the whole document
+type Issue struct {
+	Statement int
+	Message   string
+}
+
+// BucketPolicyReport lists the errors that make MinIO reject a bucket policy and the warnings
+// about grants that are probably broader than intended
+type BucketPolicyReport struct {
+	Errors   []Issue
+	Warnings []Issue
+}
+
+// Valid returns true when the policy has no errors
+func (r *BucketPolicyReport) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+func (r *BucketPolicyReport) errorf(statement int, format string, args ...interface{}) {
+	r.Errors = append(r.Errors, Issue{Statement: statement, Message: fmt.Sprintf(format, args...)})
+}
+
+func (r *BucketPolicyReport) warnf(statement int, format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, Issue{Statement: statement, Message: fmt.Sprintf(format, args...)})
+}
+
+// s3Actions are the actions MinIO accepts in bucket policies
+var s3Actions = []string{
+	"s3:AbortMultipartUpload",
+	"s3:BypassGovernanceRetention",
+	"s3:CreateBucket",
+	"s3:DeleteBucket",
+	"s3:DeleteBucketPolicy",
+	"s3:DeleteObject",
+	"s3:DeleteObjectTagging",
+	"s3:DeleteObjectVersion",
+	"s3:DeleteObjectVersionTagging",
+	"s3:ForceDeleteBucket",
+	"s3:GetBucketLocation",
+	"s3:GetBucketNotification",
+	"s3:GetBucketObjectLockConfiguration",
+	"s3:GetBucketPolicy",
+	"s3:GetBucketPolicyStatus",
+	"s3:GetBucketTagging",
+	"s3:GetBucketVersioning",
+	"s3:GetEncryptionConfiguration",
+	"s3:GetLifecycleConfiguration",
+	"s3:GetObject",
+	"s3:GetObjectLegalHold",
+	"s3:GetObjectRetention",
+	"s3:GetObjectTagging",
+	"s3:GetObjectVersion",
+	"s3:GetObjectVersionForReplication",
+	"s3:GetObjectVersionTagging",
+	"s3:GetReplicationConfiguration",
+	"s3:HeadBucket",
+	"s3:ListAllMyBuckets",
+	"s3:ListBucket",
+	"s3:ListBucketMultipartUploads",
+	"s3:ListBucketVersions",
+	"s3:ListMultipartUploadParts",
+	"s3:ListenBucketNotification",
+	"s3:ListenNotification",
+	"s3:PutBucketNotification",
+	"s3:PutBucketObjectLockConfiguration",
+	"s3:PutBucketPolicy",
+	"s3:PutBucketTagging",
+	"s3:PutBucketVersioning",
+	"s3:PutEncryptionConfiguration",
+	"s3:PutLifecycleConfiguration",
+	"s3:PutObject",
+	"s3:PutObjectFanOut",
+	"s3:PutObjectLegalHold",
+	"s3:PutObjectRetention",
+	"s3:PutObjectTagging",
+	"s3:PutObjectVersionTagging",
+	"s3:PutReplicationConfiguration",
+	"s3:ReplicateDelete",
+	"s3:ReplicateObject",
+	"s3:ReplicateTags",
+	"s3:ResetBucketReplicationState",
+	"s3:RestoreObject",
+}
+
+// objectActions apply to object resources, every other action applies to the bucket itself
+var objectActions = map[string]bool{
+	"s3:AbortMultipartUpload":           true,
+	"s3:BypassGovernanceRetention":      true,
+	"s3:DeleteObject":                   true,
+	"s3:DeleteObjectTagging":            true,
+	"s3:DeleteObjectVersion":            true,
+	"s3:DeleteObjectVersionTagging":     true,
+	"s3:GetObject":                      true,
+	"s3:GetObjectLegalHold":             true,
+	"s3:GetObjectRetention":             true,
+	"s3:GetObjectTagging":               true,
+	"s3:GetObjectVersion":               true,
+	"s3:GetObjectVersionForReplication": true,
+	"s3:GetObjectVersionTagging":        true,
+	"s3:ListMultipartUploadParts":       true,
+	"s3:PutObject":                      true,
+	"s3:PutObjectFanOut":                true,
+	"s3:PutObjectLegalHold":             true,
+	"s3:PutObjectRetention":             true,
+	"s3:PutObjectTagging":               true,
+	"s3:PutObjectVersionTagging":        true,
+	"s3:ReplicateDelete":                true,
+	"s3:ReplicateObject":                true,
+	"s3:ReplicateTags":                  true,
+	"s3:RestoreObject":                  true,
+}
+
+// conditionOperators are the condition operators MinIO supports, without the set and IfExists modifiers
+var conditionOperators = map[string]bool{
+	"StringEquals":              true,
+	"StringNotEquals":           true,
+	"StringEqualsIgnoreCase":    true,
+	"StringNotEqualsIgnoreCase": true,
+	"StringLike":                true,
+	"StringNotLike":             true,
+	"BinaryEquals":              true,
+	"IpAddress":                 true,
+	"NotIpAddress":              true,
+	"Null":                      true,
+	"Bool":                      true,
+	"NumericEquals":             true,
+	"NumericNotEquals":          true,
+	"NumericLessThan":           true,
+	"NumericLessThanEquals":     true,
+	"NumericGreaterThan":        true,
+	"NumericGreaterThanEquals":  true,
+	"DateEquals":                true,
+	"DateNotEquals":             true,
+	"DateLessThan":              true,
+	"DateLessThanEquals":        true,
+	"DateGreaterThan":           true,
+	"DateGreaterThanEquals":     true,
+}
+
+// conditionKeys are the condition keys MinIO supports in bucket policies, in lower case
+var conditionKeys = map[string]bool{
+	"aws:currenttime":           true,
+	"aws:epochtime":             true,
+	"aws:principaltype":         true,
+	"aws:referer":               true,
+	"aws:securetransport":       true,
+	"aws:sourceip":              true,
+	"aws:useragent":             true,
+	"aws:userid":                true,
+	"aws:username":              true,
+	"aws:groups":                true,
+	"s3:authtype":               true,
+	"s3:delimiter":              true,
+	"s3:locationconstraint":     true,
+	"s3:max-keys":               true,
+	"s3:object-lock-legal-hold": true,
+	"s3:object-lock-mode":       true,
+	"s3:object-lock-remaining-retention-days":            true,
+	"s3:object-lock-retain-until-date":                   true,
+	"s3:prefix":                                          true,
+	"s3:requestobjecttagkeys":                            true,
+	"s3:signatureage":                                    true,
+	"s3:signatureversion":                                true,
+	"s3:versionid":                                       true,
+	"s3:x-amz-content-sha256":                            true,
+	"s3:x-amz-copy-source":                               true,
+	"s3:x-amz-metadata-directive":                        true,
+	"s3:x-amz-server-side-encryption":                    true,
+	"s3:x-amz-server-side-encryption-aws-kms-key-id":     true,
+	"s3:x-amz-server-side-encryption-customer-algorithm": true,
+	"s3:x-amz-storage-class":                             true,
+}
+
+// conditionKeyPrefixes are condition keys carrying a name after the prefix
+var conditionKeyPrefixes = []string{"s3:existingobjecttag/", "s3:requestobjecttag/"}
+
+type bucketPolicyDocument struct {
+	Version   string          `json:"Version"`
+	ID        string          `json:"Id"`
+	Statement json.RawMessage `json:"Statement"`
+}
+
+type bucketPolicyStatement struct {
+	Sid          string                                `json:"Sid"`
+	Effect       string                                `json:"Effect"`
+	Principal    json.RawMessage                       `json:"Principal"`
+	NotPrincipal json.RawMessage                       `json:"NotPrincipal"`
+	Action       stringList                            `json:"Action"`
+	NotAction    stringList                            `json:"NotAction"`
+	Resource     stringList                            `json:"Resource"`
+	NotResource  stringList                            `json:"NotResource"`
+	Condition    map[string]map[string]json.RawMessage `json:"Condition"`
+}
+
+// stringList accepts either a single string or a list of strings, like policy documents do
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*l = stringList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return errors.New("expected a string or a list of strings")
+	}
+	*l = list
+	return nil
+}
+
+// LintBucketPolicy checks a bucket policy for the given bucket, reporting syntax errors, unknown
+// actions, malformed principals, unsupported condition keys and over-broad grants
+func LintBucketPolicy(raw []byte, bucket string) *BucketPolicyReport {
+	report := &BucketPolicyReport{}
+	var doc bucketPolicyDocument
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		report.errorf(0, "%s", describeJSONError(raw, err))
+		return report
+	}
+	switch doc.Version {
+	case bucketPolicyVersion:
+	case "":
+		report.warnf(0, "Version is missing, %s is assumed", bucketPolicyVersion)
+	default:
+		report.errorf(0, "unsupported Version %q, only %s is supported", doc.Version, bucketPolicyVersion)
+	}
+
+	statements, err := parseStatements(doc.Statement)
+	if err != nil {
+		report.errorf(0, "%s", describeJSONError(doc.Statement, err))
+		return report
+	}
+	if len(statements) == 0 {
+		report.errorf(0, "the policy has no statements")
+		return report
+	}
+	sids := map[string]bool{}
+	for i, st := range statements {
+		n := i + 1
+		if st.Sid != "" {
+			if sids[st.Sid] {
+				report.warnf(n, "Sid %q is used by more than one statement", st.Sid)
+			}
+			sids[st.Sid] = true
+		}
+		lintStatement(report, n, st, bucket)
+	}
+	return report
+}
+
+func parseStatements(raw json.RawMessage) ([]bucketPolicyStatement, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+	// a single statement doesn't need to be wrapped in a list
+	if raw[0] == '{' {
+		var st bucketPolicyStatement
+		if err := json.Unmarshal(raw, &st); err != nil {
+			return nil, err
+		}
+		return []bucketPolicyStatement{st}, nil
+	}
+	var statements []bucketPolicyStatement
+	if err := json.Unmarshal(raw, &statements); err != nil {
+		return nil, err
+	}
+	return statements, nil
+}
+
+func describeJSONError(raw []byte, err error) string {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		line, column := position(raw, syntaxErr.Offset)
+		return fmt.Sprintf("invalid JSON at line %d, column %d: %v", line, column, syntaxErr)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Sprintf("invalid value for %s: expected %s", typeErr.Field, typeErr.Type)
+	}
+	return fmt.Sprintf("invalid policy: %v", err)
+}
+
+func position(raw []byte, offset int64) (line, column int) {
+	if offset > int64(len(raw)) {
+		offset = int64(len(raw))
+	}
+	line = 1 + bytes.Count(raw[:offset], []byte("\n"))
+	column = int(offset) - bytes.LastIndexByte(raw[:offset], '\n')
+	return line, column
+}
+
+func lintStatement(report *BucketPolicyReport, n int, st bucketPolicyStatement, bucket string) {
+	allow := false
+	switch st.Effect {
+	case "Allow":
+		allow = true
+	case "Deny":
+	case "":
+		report.errorf(n, "Effect is required")
+	default:
+		report.errorf(n, "invalid Effect %q, it must be Allow or Deny", st.Effect)
+	}
+
+	anonymous := false
+	switch {
+	case len(st.NotPrincipal) > 0:
+		report.errorf(n, "NotPrincipal is not supported")
+	case len(st.Principal) == 0:
+		report.errorf(n, "Principal is required in bucket policies")
+	default:
+		anonymous = lintPrincipal(report, n, st.Principal)
+	}
+
+	actions := lintActions(report, n, st, allow)
+	lintResources(report, n, st, bucket, actions)
+	conditionKeys := lintConditions(report, n, st.Condition)
+
+	if !allow || !anonymous {
+		return
+	}
+	// over-broad grants to everyone
+	for _, action := range st.Action {
+		if action == "*" || action == "s3:*" {
+			report.warnf(n, "grants every action to everyone")
+			return
+		}
+	}
+	if len(st.NotAction) > 0 {
+		report.warnf(n, "grants everyone every action not listed in NotAction")
+		return
+	}
+	writes := false
+	for _, action := range actions {
+		if isWriteAction(action) {
+			writes = true
+			break
+		}
+	}
+	if writes {
+		report.warnf(n, "lets anyone modify or delete data in the bucket")
+	}
+	if containsString(actions, "s3:ListBucket") && !conditionKeys["s3:prefix"] {
+		report.warnf(n, "lets anyone list every object in the bucket, restrict it with a s3:prefix condition")
+	}
+	if containsString(actions, "s3:GetObject") && len(st.Condition) == 0 {
+		for _, resource := range st.Resource {
+			if resource == s3ResourcePrefix+bucket+"/*" || resource == s3ResourcePrefix+"*" {
+				report.warnf(n, "makes every object in the bucket public")
+				break
+			}
+		}
+	}
+}
+
+// lintPrincipal validates the principal and returns true when it includes everyone
+func lintPrincipal(report *BucketPolicyReport, n int, raw json.RawMessage) bool {
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		if single != "*" {
+			report.errorf(n, "Principal %q must be \"*\" or an object with an AWS key", single)
+			return false
+		}
+		return true
+	}
+	var principal map[string]stringList
+	if err := json.Unmarshal(raw, &principal); err != nil {
+		report.errorf(n, "Principal must be \"*\" or an object with an AWS key")
+		return false
+	}
+	anonymous := false
+	for key, values := range principal {
+		if key != "AWS" {
+			report.errorf(n, "%s principals are not supported, only AWS principals are", key)
+			continue
+		}
+		if len(values) == 0 {
+			report.errorf(n, "the AWS principal has no values")
+		}
+		for _, value := range values {
+			switch {
+			case value == "*":
+				anonymous = true
+			case strings.TrimSpace(value) == "":
+				report.errorf(n, "the AWS principal has an empty value")
+			case strings.HasPrefix(value, "arn:aws:iam::"):
+				report.warnf(n, "principal %q is an AWS account ARN, MinIO matches principals against its own access keys", value)
+			}
+		}
+	}
+	return anonymous
+}
+
+// lintActions validates the actions of the statement and returns the known actions it grants,
+// wildcards are expanded
+func lintActions(report *BucketPolicyReport, n int, st bucketPolicyStatement, allow bool) []string {
+	if len(st.Action) == 0 && len(st.NotAction) == 0 {
+		report.errorf(n, "Action is required")
+		return nil
+	}
+	if len(st.Action) > 0 && len(st.NotAction) > 0 {
+		report.errorf(n, "Action and NotAction can't be used together")
+	}
+	var granted []string
+	for _, actions := range []stringList{st.Action, st.NotAction} {
+		for _, action := range actions {
+			matches := matchActions(action)
+			if len(matches) == 0 {
+				if suggestion := suggestAction(action); suggestion != "" {
+					report.errorf(n, "unknown action %q, did you mean %q?", action, suggestion)
+				} else {
+					report.errorf(n, "unknown action %q", action)
+				}
+				continue
+			}
+			granted = append(granted, matches...)
+		}
+	}
+	if len(st.NotAction) > 0 {
+		granted = nil
+		for _, action := range s3Actions {
+			if !containsString(expandActions(st.NotAction), action) {
+				granted = append(granted, action)
+			}
+		}
+	}
+	return granted
+}
+
+func expandActions(actions []string) []string {
+	var expanded []string
+	for _, action := range actions {
+		expanded = append(expanded, matchActions(action)...)
+	}
+	return expanded
+}
+
+func matchActions(pattern string) []string {
+	if pattern == "*" {
+		pattern = "s3:*"
+	}
+	var matches []string
+	for _, action := range s3Actions {
+		if wildcardMatch(pattern, action) {
+			matches = append(matches, action)
+		}
+	}
+	return matches
+}
+
+func suggestAction(action string) string {
+	for _, known := range s3Actions {
+		if strings.EqualFold(known, action) {
+			return known
+		}
+	}
+	if !strings.Contains(action, ":") {
+		for _, known := range s3Actions {
+			if strings.EqualFold(known, "s3:"+action) {
+				return known
+			}
+		}
+	}
+	return ""
+}
+
+func lintResources(report *BucketPolicyReport, n int, st bucketPolicyStatement, bucket string, actions []string) {
+	if len(st.NotResource) > 0 {
+		report.errorf(n, "NotResource is not supported")
+	}
+	if len(st.Resource) == 0 {
+		if len(st.NotResource) == 0 {
+			report.errorf(n, "Resource is required")
+		}
+		return
+	}
+	objectResources, bucketResources := false, false
+	for _, resource := range st.Resource {
+		if !strings.HasPrefix(resource, s3ResourcePrefix) {
+			report.errorf(n, "resource %q must start with %s", resource, s3ResourcePrefix)
+			continue
+		}
+		name := strings.TrimPrefix(resource, s3ResourcePrefix)
+		resourceBucket, _, isObject := strings.Cut(name, "/")
+		switch {
+		case resourceBucket == "":
+			report.errorf(n, "resource %q has no bucket", resource)
+			continue
+		case !wildcardMatch(resourceBucket, bucket):
+			report.errorf(n, "resource %q doesn't belong to bucket %s", resource, bucket)
+			continue
+		case strings.ContainsAny(resourceBucket, "*?"):
+			report.warnf(n, "resource %q uses a wildcard bucket name, a bucket policy only applies to %s", resource, bucket)
+		}
+		if isObject || strings.HasSuffix(resourceBucket, "*") {
+			objectResources = true
+		}
+		if !isObject {
+			bucketResources = true
+		}
+	}
+	// nothing to compare with when no resource is valid
+	if len(actions) == 0 || (!objectResources && !bucketResources) {
+		return
+	}
+	hasObjectActions, hasBucketActions := false, false
+	for _, action := range actions {
+		if objectActions[action] {
+			hasObjectActions = true
+		} else {
+			hasBucketActions = true
+		}
+	}
+	if hasObjectActions && !objectResources {
+		report.warnf(n, "object actions have no effect, the statement has no object resources like %s%s/*", s3ResourcePrefix, bucket)
+	}
+	if hasBucketActions && !bucketResources {
+		report.warnf(n, "bucket actions have no effect, the statement has no bucket resource like %s%s", s3ResourcePrefix, bucket)
+	}
+}
+
+// lintConditions validates the conditions and returns the condition keys in use, in lower case
+func lintConditions(report *BucketPolicyReport, n int, conditions map[string]map[string]json.RawMessage) map[string]bool {
+	used := map[string]bool{}
+	for operator, values := range conditions {
+		name := strings.TrimPrefix(strings.TrimPrefix(operator, "ForAnyValue:"), "ForAllValues:")
+		name = strings.TrimSuffix(name, "IfExists")
+		if !conditionOperators[name] {
+			report.errorf(n, "unknown condition operator %q", operator)
+		}
+		for key := range values {
+			lower := strings.ToLower(key)
+			used[lower] = true
+			if !isConditionKey(lower) {
+				report.errorf(n, "unsupported condition key %q", key)
+			}
+		}
+	}
+	return used
+}
+
+func isConditionKey(key string) bool {
+	if conditionKeys[key] {
+		return true
+	}
+	for _, prefix := range conditionKeyPrefixes {
+		if strings.HasPrefix(key, prefix) && len(key) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWriteAction(action string) bool {
+	name := strings.TrimPrefix(action, "s3:")
+	for _, prefix := range []string{"Put", "Delete", "ForceDelete", "Abort", "Replicate", "Restore", "Bypass", "Reset", "Create"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcardMatch matches a name against a pattern where * matches any sequence and ? a single character
+func wildcardMatch(pattern, name string) bool {
+	if pattern == "" {
+		return name == ""
+	}
+	switch pattern[0] {
+	case '*':
+		for i := 0; i <= len(name); i++ {
+			if wildcardMatch(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	case '?':
+		return name != "" && wildcardMatch(pattern[1:], name[1:])
+	default:
+		return name != "" && name[0] == pattern[0] && wildcardMatch(pattern[1:], name[1:])
+	}
+}
@@ -0,0 +1,126 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package policy
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintBucketPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       string
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{
+			name: "read only prefix",
+			policy: `{"Version":"2012-10-17","Statement":[
+				{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::photos"],"Condition":{"StringEquals":{"s3:prefix":["public"]}}},
+				{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/public*"]}
+			]}`,
+		},
+		{
+			name:       "syntax error",
+			policy:     "{\n\"Version\": \"2012-10-17\",\n\"Statement\": [}",
+			wantErrors: []string{"line 3"},
+		},
+		{
+			name:       "unknown action",
+			policy:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Principal":"*","Action":"s3:getobject","Resource":"arn:aws:s3:::photos/*"}}`,
+			wantErrors: []string{`did you mean "s3:GetObject"`},
+		},
+		{
+			name:       "unsupported principal",
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*"}]}`,
+			wantErrors: []string{"Service principals are not supported"},
+		},
+		{
+			name:       "unknown condition key",
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/*","Condition":{"StringEquals":{"aws:SourceVpce":"vpce-1"}}}]}`,
+			wantErrors: []string{`unsupported condition key "aws:SourceVpce"`},
+		},
+		{
+			name:       "other bucket",
+			policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::videos/*"}]}`,
+			wantErrors: []string{"doesn't belong to bucket photos"},
+		},
+		{
+			name:         "public write",
+			policy:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":["s3:GetObject","s3:PutObject"],"Resource":"arn:aws:s3:::photos/*"}]}`,
+			wantWarnings: []string{"modify or delete", "every object in the bucket public"},
+		},
+		{
+			name:         "everything to everyone",
+			policy:       `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:*","Resource":["arn:aws:s3:::photos","arn:aws:s3:::photos/*"]}]}`,
+			wantWarnings: []string{"every action to everyone"},
+		},
+		{
+			name:         "listing without prefix and wrong resource",
+			policy:       `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:ListBucket","Resource":"arn:aws:s3:::photos/*"}]}`,
+			wantWarnings: []string{"Version is missing", "list every object", "bucket actions have no effect"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := LintBucketPolicy([]byte(tt.policy), "photos")
+			if len(tt.wantErrors) == 0 && !report.Valid() {
+				t.Fatalf("unexpected errors: %v", report.Errors)
+			}
+			checkIssues(t, "error", report.Errors, tt.wantErrors)
+			checkIssues(t, "warning", report.Warnings, tt.wantWarnings)
+		})
+	}
+}
+
+func checkIssues(t *testing.T, kind string, issues []Issue, want []string) {
+	t.Helper()
+	if len(want) == 0 && len(issues) > 0 {
+		t.Errorf("unexpected %ss: %v", kind, issues)
+	}
+	for _, w := range want {
+		found := false
+		for _, issue := range issues {
+			if strings.Contains(issue.Message, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected a %s containing %q, got %v", kind, w, issues)
+		}
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"s3:*", "s3:GetObject", true},
+		{"s3:Get*", "s3:GetObject", true},
+		{"s3:Get*", "s3:PutObject", false},
+		{"photo?", "photos", true},
+		{"photos", "photos2", false},
+	}
+	for _, tt := range tests {
+		if got := wildcardMatch(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("wildcardMatch(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
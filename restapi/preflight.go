@@ -0,0 +1,308 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/kms"
+	"github.com/minio/console/restapi/operations"
+	systemApi "github.com/minio/console/restapi/operations/system"
+)
+
+// time given to every preflight check before it's reported as failed
+const preflightCheckTimeout = 5 * time.Second
+
+// preflightCheck validates one of the integrations Console depends on
+type preflightCheck struct {
+	name string
+	// critical checks make the related features unusable when they fail
+	critical bool
+	// hint tells the administrator what to look at when the check fails
+	hint string
+	run  func(ctx context.Context) error
+}
+
+var globalPreflight = struct {
+	sync.Mutex
+	report    *models.PreflightReport
+	checkedAt time.Time
+}{}
+
+func registerPreflightHandlers(api *operations.ConsoleAPI) {
+	api.SystemGetPreflightReportHandler = systemApi.GetPreflightReportHandlerFunc(func(params systemApi.GetPreflightReportParams, session *models.Principal) middleware.Responder {
+		resp, err := getPreflightReportResponse(session, params)
+		if err != nil {
+			return systemApi.NewGetPreflightReportDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewGetPreflightReportOK().WithPayload(resp)
+	})
+}
+
+// preflightHTTPGet fails unless the endpoint answers the GET request with a 2xx status
+func preflightHTTPGet(ctx context.Context, client *http.Client, endpoint string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status %s from %s", resp.Status, endpoint)
+	}
+	return resp, nil
+}
+
+// preflightDiscoveryDocument makes sure the OpenID discovery document can be fetched and lists the endpoints used to log in
+func preflightDiscoveryDocument(ctx context.Context, client *http.Client, endpoint string) error {
+	resp, err := preflightHTTPGet(ctx, client, endpoint)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return fmt.Errorf("invalid discovery document: %v", err)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" {
+		return errors.New("discovery document doesn't advertise the authorization and token endpoints")
+	}
+	return nil
+}
+
+// preflightDial makes sure a TCP connection can be opened to the host of the endpoint, it's used for
+// webhooks that can't be called without side effects
+func preflightDial(ctx context.Context, endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%s is not a valid URL", endpoint)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		port := "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+		host = net.JoinHostPort(u.Hostname(), port)
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// preflightChecks returns the checks for every integration configured in Console
+func preflightChecks() []preflightCheck {
+	minioServer := getMinIOServer()
+	checks := []preflightCheck{
+		{
+			name:     "minio",
+			critical: true,
+			hint:     fmt.Sprintf("make sure MinIO is running and %s points to it", ConsoleMinIOServer),
+			run: func(ctx context.Context) error {
+				resp, err := preflightHTTPGet(ctx, GetConsoleHTTPClient(minioServer), strings.TrimSuffix(minioServer, "/")+"/minio/health/live")
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			},
+		},
+	}
+	var providers []string
+	for name := range GlobalMinIOConfig.OpenIDProviders {
+		providers = append(providers, name)
+	}
+	sort.Strings(providers)
+	for _, name := range providers {
+		discoveryURL := GlobalMinIOConfig.OpenIDProviders[name].URL
+		checks = append(checks, preflightCheck{
+			name:     "idp:" + name,
+			critical: true,
+			hint:     "verify the configuration URL of the identity provider returns its OpenID discovery document",
+			run: func(ctx context.Context) error {
+				return preflightDiscoveryDocument(ctx, GetConsoleHTTPClient(""), discoveryURL)
+			},
+		})
+	}
+	if k := globalConsoleKMS; k != nil {
+		checks = append(checks, preflightCheck{
+			name:     "kms",
+			critical: true,
+			hint:     fmt.Sprintf("check %s, the console secrets can't be unsealed while the KMS is unavailable", ConsoleKMSKESEndpoint),
+			run: func(ctx context.Context) error {
+				return kms.Check(ctx, k)
+			},
+		})
+	}
+	if getConsoleSessionStoreURL() != "" {
+		checks = append(checks, preflightCheck{
+			name:     "session-store",
+			critical: true,
+			hint:     fmt.Sprintf("check %s, logins fail and the sessions can't be served while it can't be reached", ConsoleSessionStoreURL),
+			run:      auth.CheckSessionStore,
+		})
+	}
+	if prometheusURL := getPrometheusURL(); prometheusURL != "" {
+		checks = append(checks, preflightCheck{
+			name: "prometheus",
+			hint: fmt.Sprintf("check %s, dashboards will be empty until Prometheus can be queried", PrometheusURL),
+			run: func(ctx context.Context) error {
+				resp, err := preflightHTTPGet(ctx, prometheusHTTPClient(prometheusURL), strings.TrimSuffix(prometheusURL, "/")+"/api/v1/query?query=up")
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			},
+		})
+	}
+	if endpoint := getConsoleAuthzWebhookEndpoint(); endpoint != "" {
+		checks = append(checks, preflightCheck{
+			name: "authz-webhook",
+			// operations are denied while the webhook is unreachable unless Console fails open
+			critical: !getConsoleAuthzWebhookFailOpen(),
+			hint:     fmt.Sprintf("check %s, operations that need authorization are denied while it can't be reached", ConsoleAuthzWebhookEndpoint),
+			run: func(ctx context.Context) error {
+				return preflightDial(ctx, endpoint)
+			},
+		})
+	}
+	if endpoint := getConsoleQuotaWebhookEndpoint(); endpoint != "" {
+		checks = append(checks, preflightCheck{
+			name: "quota-webhook",
+			hint: fmt.Sprintf("check %s, soft quota notifications will be lost", ConsoleQuotaWebhookEndpoint),
+			run: func(ctx context.Context) error {
+				return preflightDial(ctx, endpoint)
+			},
+		})
+	}
+	return checks
+}
+
+// runPreflight runs the checks in parallel and summarizes their results
+func runPreflight(ctx context.Context, checks []preflightCheck) *models.PreflightReport {
+	report := &models.PreflightReport{
+		Status:    models.PreflightReportStatusOk,
+		CheckedAt: time.Now().UTC().Format(time.RFC3339),
+		Checks:    make([]*models.PreflightCheck, len(checks)),
+	}
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check preflightCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, preflightCheckTimeout)
+			defer cancel()
+			start := time.Now()
+			err := check.run(checkCtx)
+			result := &models.PreflightCheck{
+				Name:       check.name,
+				Critical:   check.critical,
+				Status:     models.PreflightCheckStatusOk,
+				DurationMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				result.Status = models.PreflightCheckStatusFailed
+				result.Error = err.Error()
+				result.Hint = check.hint
+			}
+			report.Checks[i] = result
+		}(i, check)
+	}
+	wg.Wait()
+	for _, check := range report.Checks {
+		if check.Status != models.PreflightCheckStatusFailed {
+			continue
+		}
+		if check.Critical {
+			report.Status = models.PreflightReportStatusFailed
+			break
+		}
+		report.Status = models.PreflightReportStatusDegraded
+	}
+	return report
+}
+
+// refreshPreflight validates the configured integrations and keeps the report for the admin API
+func refreshPreflight(ctx context.Context) *models.PreflightReport {
+	report := runPreflight(ctx, preflightChecks())
+	globalPreflight.Lock()
+	globalPreflight.report = report
+	globalPreflight.checkedAt = time.Now()
+	globalPreflight.Unlock()
+	return report
+}
+
+// RunStartupPreflight validates the configured integrations before Console starts serving requests,
+// failures are logged with a hint and prevent the start only when CONSOLE_PREFLIGHT_STRICT is on
+func RunStartupPreflight(ctx context.Context) error {
+	report := refreshPreflight(ctx)
+	for _, check := range report.Checks {
+		if check.Status == models.PreflightCheckStatusFailed {
+			LogErrorContext(ctx, "preflight check %s failed: %s, %s", check.Name, check.Error, check.Hint)
+		}
+	}
+	if report.Status == models.PreflightReportStatusFailed && getConsolePreflightStrict() {
+		return errors.New("critical preflight checks failed, see GET /api/v1/admin/preflight or the logs for details")
+	}
+	return nil
+}
+
+func getPreflightReportResponse(session *models.Principal, params systemApi.GetPreflightReportParams) (*models.PreflightReport, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	// the report exposes the integration endpoints
+	if err := checkServerConfigAccess(ctx, adminClient); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	globalPreflight.Lock()
+	report := globalPreflight.report
+	globalPreflight.Unlock()
+	if report == nil || (params.Refresh != nil && *params.Refresh) {
+		report = refreshPreflight(ctx)
+	}
+	return report, nil
+}
@@ -0,0 +1,109 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunPreflight(t *testing.T) {
+	assert := assert.New(t)
+	ok := func(ctx context.Context) error { return nil }
+	fail := func(ctx context.Context) error { return errors.New("connection refused") }
+
+	report := runPreflight(context.Background(), []preflightCheck{
+		{name: "minio", critical: true, run: ok},
+		{name: "prometheus", run: ok},
+	})
+	assert.Equal(models.PreflightReportStatusOk, report.Status)
+	assert.Len(report.Checks, 2)
+	assert.Equal("minio", report.Checks[0].Name)
+	assert.Empty(report.Checks[0].Hint)
+
+	report = runPreflight(context.Background(), []preflightCheck{
+		{name: "minio", critical: true, run: ok},
+		{name: "prometheus", hint: "check CONSOLE_PROMETHEUS_URL", run: fail},
+	})
+	assert.Equal(models.PreflightReportStatusDegraded, report.Status)
+	assert.Equal(models.PreflightCheckStatusFailed, report.Checks[1].Status)
+	assert.Equal("connection refused", report.Checks[1].Error)
+	assert.Equal("check CONSOLE_PROMETHEUS_URL", report.Checks[1].Hint)
+
+	report = runPreflight(context.Background(), []preflightCheck{
+		{name: "prometheus", run: fail},
+		{name: "minio", critical: true, run: fail},
+	})
+	assert.Equal(models.PreflightReportStatusFailed, report.Status)
+}
+
+func TestPreflightDiscoveryDocument(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/valid/.well-known/openid-configuration":
+			w.Write([]byte(`{"issuer":"https://idp","authorization_endpoint":"https://idp/auth","token_endpoint":"https://idp/token"}`))
+		case "/partial/.well-known/openid-configuration":
+			w.Write([]byte(`{"issuer":"https://idp"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	assert.NoError(preflightDiscoveryDocument(ctx, server.Client(), server.URL+"/valid/.well-known/openid-configuration"))
+	assert.Error(preflightDiscoveryDocument(ctx, server.Client(), server.URL+"/partial/.well-known/openid-configuration"))
+	assert.Error(preflightDiscoveryDocument(ctx, server.Client(), server.URL+"/missing/.well-known/openid-configuration"))
+}
+
+func TestPreflightDial(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	ctx := context.Background()
+
+	assert.NoError(preflightDial(ctx, server.URL+"/hook"))
+	server.Close()
+	assert.Error(preflightDial(ctx, server.URL+"/hook"))
+	assert.Error(preflightDial(ctx, "not a url"))
+}
+
+func TestPreflightChecks(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(PrometheusURL, "")
+	t.Setenv(ConsoleAuthzWebhookEndpoint, "http://authz.local/v1/data/console/allow")
+	t.Setenv(ConsoleAuthzWebhookFailOpen, "on")
+	t.Setenv(ConsoleQuotaWebhookEndpoint, "")
+	t.Setenv(ConsoleSessionStoreURL, "redis://redis.local:6379")
+
+	checks := preflightChecks()
+	var names []string
+	for _, check := range checks {
+		names = append(names, check.name)
+	}
+	assert.Equal([]string{"minio", "session-store", "authz-webhook"}, names)
+	assert.True(checks[0].critical)
+	assert.True(checks[1].critical)
+	// failing open keeps Console usable when the webhook is down
+	assert.False(checks[2].critical)
+}
@@ -0,0 +1,86 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	objectApi "github.com/minio/console/restapi/operations/object"
+	publicApi "github.com/minio/console/restapi/operations/public"
+)
+
+func registerPublicObjectsHandlers(api *operations.ConsoleAPI) {
+	// list objects of a bucket that allows anonymous access
+	api.PublicPublicListObjectsHandler = publicApi.PublicListObjectsHandlerFunc(func(params publicApi.PublicListObjectsParams) middleware.Responder {
+		resp, err := getPublicListObjectsResponse(params)
+		if err != nil {
+			return publicApi.NewPublicListObjectsDefault(int(err.Code)).WithPayload(err)
+		}
+		return publicApi.NewPublicListObjectsOK().WithPayload(resp)
+	})
+	// download an object of a bucket that allows anonymous access
+	api.PublicPublicDownloadObjectHandler = publicApi.PublicDownloadObjectHandlerFunc(func(params publicApi.PublicDownloadObjectParams) middleware.Responder {
+		resp, err := getPublicDownloadObjectResponse(params)
+		if err != nil {
+			return publicApi.NewPublicDownloadObjectDefault(int(err.Code)).WithPayload(err)
+		}
+		return resp
+	})
+}
+
+// getPublicListObjectsResponse lists the objects of a bucket without a session, the request
+// is signed anonymously so MinIO only allows it if the bucket policy grants anonymous access
+func getPublicListObjectsResponse(params publicApi.PublicListObjectsParams) (*models.ListObjectsResponse, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if !getConsoleAnonymousBrowsing() {
+		return nil, ErrorWithContext(ctx, ErrNotFound)
+	}
+	return getListObjectsResponse(nil, objectApi.ListObjectsParams{
+		HTTPRequest: params.HTTPRequest,
+		BucketName:  params.BucketName,
+		Prefix:      params.Prefix,
+		Recursive:   params.Recursive,
+		Limit:       params.Limit,
+	})
+}
+
+// getPublicDownloadObjectResponse downloads a single object without a session, folder
+// downloads are not available anonymously
+func getPublicDownloadObjectResponse(params publicApi.PublicDownloadObjectParams) (middleware.Responder, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if !getConsoleAnonymousBrowsing() {
+		return nil, ErrorWithContext(ctx, ErrNotFound)
+	}
+	prefix, err := decodeObjectPrefix(params.Prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if prefix == "" || strings.HasSuffix(prefix, "/") {
+		return nil, ErrorWithContext(ctx, ErrBadRequest)
+	}
+	return getDownloadObjectResponse(nil, objectApi.DownloadObjectParams{
+		HTTPRequest:      params.HTTPRequest,
+		BucketName:       params.BucketName,
+		Prefix:           params.Prefix,
+		VersionID:        params.VersionID,
+		Preview:          params.Preview,
+		OverrideFileName: params.OverrideFileName,
+	})
+}
@@ -0,0 +1,83 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/base64"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	publicApi "github.com/minio/console/restapi/operations/public"
+)
+
+func Test_getPublicListObjectsResponse(t *testing.T) {
+	t.Setenv(ConsoleAnonymousBrowsing, "off")
+	params := publicApi.PublicListObjectsParams{
+		HTTPRequest: httptest.NewRequest("GET", "/api/v1/public/buckets/bucket/objects", nil),
+		BucketName:  "bucket",
+	}
+	_, err := getPublicListObjectsResponse(params)
+	if err == nil || err.Code != 404 {
+		t.Errorf("expected a 404 error while anonymous browsing is disabled, got %v", err)
+	}
+}
+
+func Test_getPublicDownloadObjectResponse(t *testing.T) {
+	newParams := func(prefix string) publicApi.PublicDownloadObjectParams {
+		return publicApi.PublicDownloadObjectParams{
+			HTTPRequest:      httptest.NewRequest("GET", "/api/v1/public/buckets/bucket/objects/download", nil),
+			BucketName:       "bucket",
+			Prefix:           base64.StdEncoding.EncodeToString([]byte(prefix)),
+			OverrideFileName: swag.String(""),
+		}
+	}
+	tests := []struct {
+		name     string
+		enabled  string
+		prefix   string
+		wantCode int32
+	}{
+		{
+			name:     "anonymous browsing disabled",
+			enabled:  "off",
+			prefix:   "file.txt",
+			wantCode: 404,
+		},
+		{
+			name:     "folder downloads are not allowed",
+			enabled:  "on",
+			prefix:   "folder/",
+			wantCode: 400,
+		},
+		{
+			name:     "empty prefix is not allowed",
+			enabled:  "on",
+			prefix:   "",
+			wantCode: 400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ConsoleAnonymousBrowsing, tt.enabled)
+			_, err := getPublicDownloadObjectResponse(newParams(tt.prefix))
+			if err == nil || err.Code != tt.wantCode {
+				t.Errorf("getPublicDownloadObjectResponse() error = %v, want code %d", err, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/ratelimit"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/pkg/utils"
+)
+
+// Classes of endpoints with their own rate limit
+const (
+	rateLimitClassDefault  = "default"
+	rateLimitClassListing  = "listing"
+	rateLimitClassDownload = "download"
+	rateLimitClassAdmin    = "admin"
+)
+
+// Operations capped in how many run at once
+const (
+	concurrencyZipDownload = "zip-download"
+	concurrencySpeedtest   = "speedtest"
+	// concurrencyRetryAfter is when the clients are told to try again when the operations are all taken, they
+	// take too long for the actual wait to be known
+	concurrencyRetryAfter = 5 * time.Second
+)
+
+// listingPaths are the endpoints listing buckets, objects and IAM entities
+var listingPaths = regexp.MustCompile(`^/api/v1/(buckets|buckets/[^/]+/objects|buckets/[^/]+/versions|users|groups|policies|service-accounts|remote-buckets)$`)
+
+var (
+	globalRateLimiter     *ratelimit.Limiter
+	globalRateLimiterOnce sync.Once
+
+	globalConcurrencyCaps     *ratelimit.Caps
+	globalConcurrencyCapsOnce sync.Once
+)
+
+// rateLimiter returns the limiter of the API requests, the invalid rates are ignored
+func rateLimiter() *ratelimit.Limiter {
+	globalRateLimiterOnce.Do(func() {
+		rates := map[string]ratelimit.Rate{}
+		for class, key := range map[string]string{
+			rateLimitClassDefault:  ConsoleRateLimit,
+			rateLimitClassListing:  ConsoleRateLimitListing,
+			rateLimitClassDownload: ConsoleRateLimitDownload,
+			rateLimitClassAdmin:    ConsoleRateLimitAdmin,
+		} {
+			rate, err := getConsoleRateLimit(key)
+			if err != nil {
+				LogError("ignoring %s: %v", key, err)
+			}
+			rates[class] = rate
+		}
+		globalRateLimiter = ratelimit.New(rates)
+	})
+	return globalRateLimiter
+}
+
+// concurrencyCaps returns the caps of the expensive operations
+func concurrencyCaps() *ratelimit.Caps {
+	globalConcurrencyCapsOnce.Do(func() {
+		zipDownloads, speedtests := getConsoleConcurrencyCaps()
+		globalConcurrencyCaps = ratelimit.NewCaps(map[string]int{
+			concurrencyZipDownload: zipDownloads,
+			concurrencySpeedtest:   speedtests,
+		})
+	})
+	return globalConcurrencyCaps
+}
+
+// rateLimitClass returns the class of endpoints of the request, empty for the ones that aren't limited such
+// as the static files of the UI
+func rateLimitClass(r *http.Request) string {
+	path := r.URL.Path
+	switch {
+	case !strings.HasPrefix(path, "/api/") && !strings.HasPrefix(path, "/ws/"):
+		return ""
+	case strings.HasSuffix(path, "/download"):
+		return rateLimitClassDownload
+	case strings.HasPrefix(path, "/ws/objectManager"):
+		return rateLimitClassDefault
+	case strings.HasPrefix(path, "/api/v1/admin/") || strings.HasPrefix(path, "/ws/"):
+		return rateLimitClassAdmin
+	case r.Method == http.MethodGet && listingPaths.MatchString(path):
+		return rateLimitClassListing
+	}
+	return rateLimitClassDefault
+}
+
+// rateLimitKey returns the principal the request is counted for, its source IP when it has no session
+func rateLimitKey(r *http.Request) string {
+	if userID, ok := r.Context().Value(utils.ContextRequestUserID).(string); ok && userID != "" {
+		return "user:" + userID
+	}
+	return "ip:" + realip.ClientIP(r)
+}
+
+// isZipDownload tells whether the request downloads a folder, which is zipped on the fly
+func isZipDownload(r *http.Request) bool {
+	if r.Method != http.MethodGet || !strings.HasSuffix(r.URL.Path, "/objects/download") {
+		return false
+	}
+	prefix, err := base64.StdEncoding.DecodeString(SanitizeEncodedPrefix(r.URL.Query().Get("prefix")))
+	return err == nil && strings.HasSuffix(string(prefix), "/")
+}
+
+// writeTooManyRequests answers 429 with the seconds to wait before trying again
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	apiErr := ErrorWithContext(r.Context(), ErrTooManyRequests)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.WriteHeader(int(apiErr.Code))
+	json.NewEncoder(w).Encode(apiErr)
+}
+
+// acquireConcurrencySlot takes a slot for an expensive operation, ok is false when they are all taken
+func acquireConcurrencySlot(kind string) (release func(), ok bool) {
+	return concurrencyCaps().Acquire(kind)
+}
+
+// RateLimitMiddleware limits the rate of the API requests of every principal per class of endpoints, and
+// how many folders are zipped at once
+func RateLimitMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		class := rateLimitClass(r)
+		if class == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if ok, retryAfter := rateLimiter().Allow(class, rateLimitKey(r), time.Now()); !ok {
+			writeTooManyRequests(w, r, retryAfter)
+			return
+		}
+		if isZipDownload(r) {
+			release, ok := acquireConcurrencySlot(concurrencyZipDownload)
+			if !ok {
+				writeTooManyRequests(w, r, concurrencyRetryAfter)
+				return
+			}
+			// the zip is streamed before ServeHTTP returns
+			defer release()
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,99 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/ratelimit"
+	"github.com/minio/console/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitClass(t *testing.T) {
+	assert := assert.New(t)
+	folder := base64.StdEncoding.EncodeToString([]byte("photos/2023/"))
+	object := base64.StdEncoding.EncodeToString([]byte("photos/2023/cat.png"))
+	for _, test := range []struct {
+		method, target, class string
+		zip                   bool
+	}{
+		{http.MethodGet, "/static/js/main.js", "", false},
+		{http.MethodGet, "/api/v1/buckets", rateLimitClassListing, false},
+		{http.MethodGet, "/api/v1/buckets/photos/objects?prefix=" + folder, rateLimitClassListing, false},
+		{http.MethodPost, "/api/v1/buckets", rateLimitClassDefault, false},
+		{http.MethodGet, "/api/v1/buckets/photos/objects/download?prefix=" + folder, rateLimitClassDownload, true},
+		{http.MethodGet, "/api/v1/buckets/photos/objects/download?prefix=" + object, rateLimitClassDownload, false},
+		{http.MethodGet, "/api/v1/admin/info", rateLimitClassAdmin, false},
+		{http.MethodGet, "/ws/speedtest", rateLimitClassAdmin, false},
+		{http.MethodGet, "/ws/objectManager", rateLimitClassDefault, false},
+		{http.MethodGet, "/api/v1/session", rateLimitClassDefault, false},
+	} {
+		r := httptest.NewRequest(test.method, test.target, nil)
+		assert.Equal(test.class, rateLimitClass(r), test.target)
+		assert.Equal(test.zip, isZipDownload(r), test.target)
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	assert := assert.New(t)
+	globalRateLimiter = ratelimit.New(map[string]ratelimit.Rate{rateLimitClassListing: {Requests: 1, Period: time.Minute}})
+	globalRateLimiterOnce = sync.Once{}
+	globalRateLimiterOnce.Do(func() {})
+	globalConcurrencyCaps = ratelimit.NewCaps(map[string]int{concurrencyZipDownload: 1})
+	globalConcurrencyCapsOnce = sync.Once{}
+	globalConcurrencyCapsOnce.Do(func() {})
+
+	zipping := make(chan struct{})
+	done := make(chan struct{})
+	handler := RateLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isZipDownload(r) {
+			close(zipping)
+			<-done
+		}
+	}))
+	serve := func(target, userID string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		r = r.WithContext(context.WithValue(r.Context(), utils.ContextRequestUserID, userID))
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(http.StatusOK, serve("/api/v1/buckets", "alice").Code)
+	w := serve("/api/v1/buckets", "alice")
+	assert.Equal(http.StatusTooManyRequests, w.Code)
+	assert.Equal("60", w.Header().Get("Retry-After"))
+	assert.Equal(http.StatusOK, serve("/api/v1/buckets", "bob").Code)
+	assert.Equal(http.StatusOK, serve("/api/v1/admin/info", "alice").Code)
+
+	// a single folder is zipped at once
+	folder := "/api/v1/buckets/photos/objects/download?prefix=" + base64.StdEncoding.EncodeToString([]byte("photos/"))
+	go serve(folder, "alice")
+	<-zipping
+	w = serve(folder, "bob")
+	assert.Equal(http.StatusTooManyRequests, w.Code)
+	assert.Equal("5", w.Header().Get("Retry-After"))
+	close(done)
+	assert.Eventually(func() bool { return globalConcurrencyCaps.InUse(concurrencyZipDownload) == 0 }, time.Second, 10*time.Millisecond)
+}
@@ -21,6 +21,8 @@ import (
 	"net"
 	"net/http"
 	"time"
+
+	"github.com/minio/console/pkg/tracing"
 )
 
 // PrepareSTSClientTransport :
@@ -60,7 +62,12 @@ func PrepareConsoleHTTPClient(insecure bool) *http.Client {
 	transport := PrepareSTSClientTransport(insecure)
 	// Return http client with default configuration
 	c := &http.Client{
-		Transport: transport,
+		Transport: instrumentTransport(transport),
 	}
 	return c
 }
+
+// instrumentTransport traces and counts the calls to MinIO, SUBNET and the other services sent through transport
+func instrumentTransport(transport *http.Transport) http.RoundTripper {
+	return tracing.Transport(upstreamMetricsTransport{base: transport})
+}
@@ -0,0 +1,78 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/pkg"
+	"github.com/minio/console/pkg/tracing"
+	"github.com/minio/console/pkg/utils"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InitTracing exports the spans of Console to the OTLP collector of CONSOLE_OTEL_ENDPOINT, the returned
+// function flushes the spans left when the server stops. It does nothing without CONSOLE_OTEL_ENDPOINT.
+func InitTracing(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	endpoint := getConsoleOTelEndpoint()
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	return tracing.Init(ctx, tracing.Config{
+		Endpoint:       endpoint,
+		Headers:        getConsoleOTelHeaders(),
+		SampleRatio:    getConsoleOTelSampleRatio(),
+		TLSConfig:      &tls.Config{RootCAs: GlobalRootCAs, MinVersion: tls.VersionTLS12},
+		ServiceName:    "console",
+		ServiceVersion: pkg.Version,
+	})
+}
+
+// isTraced tells whether a span is started for the request, the static files of the UI are not traced
+func isTraced(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/api/") || strings.HasPrefix(r.URL.Path, "/ws/")
+}
+
+// TracingMiddleware starts a span for every API call and websocket, tagged with the ID of the request
+func TracingMiddleware(next http.Handler) http.Handler {
+	return tracing.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requestID, ok := r.Context().Value(utils.ContextRequestID).(string); ok {
+			trace.SpanFromContext(r.Context()).SetAttributes(attribute.String("console.request_id", requestID))
+		}
+		next.ServeHTTP(w, r)
+	}), isTraced)
+}
+
+// traceOperationMiddleware names the span of the API calls after the operation they are routed to
+func traceOperationMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			tracing.SetName(r.Context(), route.Operation.ID, attribute.String("http.route", route.PathPattern))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// startStreamSpan starts the span of a websocket stream
+func startStreamSpan(ctx context.Context, stream string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "ws "+stream, attribute.String("console.stream", stream))
+}
@@ -0,0 +1,53 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTraced(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(isTraced(httptest.NewRequest(http.MethodGet, "/api/v1/admin/info", nil)))
+	assert.True(isTraced(httptest.NewRequest(http.MethodGet, "/ws/trace", nil)))
+	assert.False(isTraced(httptest.NewRequest(http.MethodGet, "/static/js/main.js", nil)))
+	assert.False(isTraced(httptest.NewRequest(http.MethodGet, "/", nil)))
+}
+
+func TestTracingConfig(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleOTelHeaders, "Authorization=Bearer token, x-scope-orgid = console ,invalid")
+	assert.Equal(map[string]string{"Authorization": "Bearer token", "x-scope-orgid": "console"}, getConsoleOTelHeaders())
+
+	t.Setenv(ConsoleOTelSampleRatio, "0.25")
+	assert.Equal(0.25, getConsoleOTelSampleRatio())
+	t.Setenv(ConsoleOTelSampleRatio, "2")
+	assert.Equal(1.0, getConsoleOTelSampleRatio())
+
+	// nothing is exported without a collector
+	shutdown, err := InitTracing(context.Background())
+	assert.NoError(err)
+	assert.NoError(shutdown(context.Background()))
+	t.Setenv(ConsoleOTelEndpoint, "otel-collector:4318")
+	_, err = InitTracing(context.Background())
+	assert.Error(err)
+}
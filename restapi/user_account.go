@@ -70,11 +70,18 @@ func getChangePasswordResponse(session *models.Principal, params accountApi.Acco
 	userClient := AdminClient{Client: parentAccountClient}
 	accessKey := session.AccountAccessKey
 	newSecretKey := *params.Body.NewSecretKey
+	policy := getConsolePasswordPolicy()
+	if err := checkNewPassword(passwordState(), policy, accessKey, *params.Body.CurrentSecretKey, newSecretKey); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 
 	// currentSecretKey will compare currentSecretKey against the stored secret key inside the encrypted session
 	if err := changePassword(ctx, userClient, session, newSecretKey); err != nil {
 		return nil, ErrorWithContext(ctx, ErrChangePassword, nil, err)
 	}
+	if err := passwordState().Changed(accessKey, newSecretKey, policy.History); err != nil {
+		LogError("unable to record the password change of %s: %v", accessKey, err)
+	}
 	// user credentials are updated at this point, we need to generate a new admin client and authenticate using
 	// the new credentials
 	credentials, err := getConsoleCredentials(accessKey, newSecretKey)
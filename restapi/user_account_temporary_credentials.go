@@ -0,0 +1,158 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	accountApi "github.com/minio/console/restapi/operations/account"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/signer"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	defaultTemporaryCredentialsDuration = time.Hour
+	// minio-go requests an hour for any shorter duration
+	minTemporaryCredentialsDuration = time.Hour
+	maxTemporaryCredentialsDuration = 12 * time.Hour
+)
+
+func registerTemporaryCredentialsHandlers(api *operations.ConsoleAPI) {
+	// short-lived credentials for the logged in user
+	api.AccountCreateTemporaryCredentialsHandler = accountApi.CreateTemporaryCredentialsHandlerFunc(func(params accountApi.CreateTemporaryCredentialsParams, session *models.Principal) middleware.Responder {
+		resp, err := getCreateTemporaryCredentialsResponse(session, params)
+		if err != nil {
+			return accountApi.NewCreateTemporaryCredentialsDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewCreateTemporaryCredentialsCreated().WithPayload(resp)
+	})
+}
+
+// temporaryCredentialsDuration returns the validity requested, the default one when none was given
+func temporaryCredentialsDuration(seconds int32) (time.Duration, error) {
+	if seconds == 0 {
+		return defaultTemporaryCredentialsDuration, nil
+	}
+	d := time.Duration(seconds) * time.Second
+	if d < minTemporaryCredentialsDuration || d > maxTemporaryCredentialsDuration {
+		return 0, fmt.Errorf("%w: duration must be between %s and %s", ErrInvalidTemporaryCredentials, minTemporaryCredentialsDuration, maxTemporaryCredentialsDuration)
+	}
+	return d, nil
+}
+
+// stsSessionTransport signs the STS calls with the session token of temporary credentials, minio-go only signs
+// AssumeRole with long-lived ones
+type stsSessionTransport struct {
+	base                                         http.RoundTripper
+	accessKey, secretKey, sessionToken, location string
+}
+
+// RoundTrip implements http.RoundTripper
+func (t stsSessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	signed := req.Clone(req.Context())
+	signed.Header.Del("Authorization")
+	signed.Header.Set("X-Amz-Security-Token", t.sessionToken)
+	return t.base.RoundTrip(signer.SignV4STS(*signed, t.accessKey, t.secretKey, t.location))
+}
+
+// createTemporaryCredentials calls AssumeRole with the credentials of the session, the optional session policy
+// can only narrow down what the policies of the user already allow
+func createTemporaryCredentials(client *http.Client, endpoint, location string, session *models.Principal, req *models.TemporaryCredentialsRequest) (*models.TemporaryCredentials, error) {
+	duration, err := temporaryCredentialsDuration(req.DurationSeconds)
+	if err != nil {
+		return nil, err
+	}
+	if req.Policy != "" {
+		if _, err := iampolicy.ParseConfig(bytes.NewReader([]byte(req.Policy))); err != nil {
+			return nil, fmt.Errorf("%w: session policy: %v", ErrInvalidTemporaryCredentials, err)
+		}
+	}
+	if session.STSSessionToken != "" {
+		base := client.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		client = &http.Client{Transport: stsSessionTransport{
+			base:         base,
+			accessKey:    session.STSAccessKeyID,
+			secretKey:    session.STSSecretAccessKey,
+			sessionToken: session.STSSessionToken,
+			location:     location,
+		}}
+	}
+	assumeRole := &credentials.STSAssumeRole{
+		Client:      client,
+		STSEndpoint: endpoint,
+		Options: credentials.STSAssumeRoleOptions{
+			AccessKey:       session.STSAccessKeyID,
+			SecretKey:       session.STSSecretAccessKey,
+			Policy:          req.Policy,
+			Location:        location,
+			DurationSeconds: int(duration.Seconds()),
+		},
+	}
+	// the expiration isn't exposed by the credentials provider, MinIO grants the duration requested
+	issued := time.Now().UTC()
+	value, err := assumeRole.Retrieve()
+	if err != nil {
+		var stsErr credentials.ErrorResponse
+		if errors.As(err, &stsErr) && stsErr.STSError.Code == "AccessDenied" {
+			return nil, fmt.Errorf("%w: %s", ErrAccessDenied, stsErr.STSError.Message)
+		}
+		return nil, err
+	}
+	return &models.TemporaryCredentials{
+		AccessKey:    value.AccessKeyID,
+		SecretKey:    value.SecretAccessKey,
+		SessionToken: value.SessionToken,
+		Expiration:   issued.Add(duration).Format(time.RFC3339),
+		URL:          endpoint,
+	}, nil
+}
+
+func getCreateTemporaryCredentialsResponse(session *models.Principal, params accountApi.CreateTemporaryCredentialsParams) (*models.TemporaryCredentials, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	minioURL, sessionCreds, err := sessionCluster(clusterRegistry(), session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// the clusters allowing the user of the session are reached with their registered credentials
+	value, err := sessionCreds.Get()
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	clusterSession := &models.Principal{
+		STSAccessKeyID:     value.AccessKeyID,
+		STSSecretAccessKey: value.SecretAccessKey,
+		STSSessionToken:    value.SessionToken,
+	}
+	creds, err := createTemporaryCredentials(GetConsoleHTTPClient(minioURL), minioURL, GetMinIORegion(), clusterSession, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return creds, nil
+}
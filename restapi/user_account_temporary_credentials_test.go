@@ -0,0 +1,76 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTemporaryCredentials(t *testing.T) {
+	assert := assert.New(t)
+	var gotRequest *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequest = r
+		r.ParseForm()
+		if r.Form.Get("Policy") != "" && r.Header.Get("X-Amz-Security-Token") == "denied" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><Error><Type></Type><Code>AccessDenied</Code><Message>Access denied: temporary credentials cannot assume a role</Message></Error><RequestId>1</RequestId></ErrorResponse>`))
+			return
+		}
+		w.Write([]byte(`<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials><AccessKeyId>TEMPKEY</AccessKeyId><SecretAccessKey>TEMPSECRET</SecretAccessKey><SessionToken>TEMPTOKEN</SessionToken><Expiration>2030-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`))
+	}))
+	defer server.Close()
+	session := &models.Principal{STSAccessKeyID: "access", STSSecretAccessKey: "secret12345", STSSessionToken: "token"}
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`
+
+	before := time.Now().UTC()
+	creds, err := createTemporaryCredentials(server.Client(), server.URL, "", session, &models.TemporaryCredentialsRequest{Policy: policy, DurationSeconds: 7200})
+	assert.NoError(err)
+	assert.Equal("TEMPKEY", creds.AccessKey)
+	assert.Equal("TEMPSECRET", creds.SecretKey)
+	assert.Equal("TEMPTOKEN", creds.SessionToken)
+	assert.Equal(server.URL, creds.URL)
+	expiration, err := time.Parse(time.RFC3339, creds.Expiration)
+	assert.NoError(err)
+	assert.WithinDuration(before.Add(2*time.Hour), expiration, time.Minute)
+	assert.Equal("AssumeRole", gotRequest.Form.Get("Action"))
+	assert.Equal("7200", gotRequest.Form.Get("DurationSeconds"))
+	assert.Equal(policy, gotRequest.Form.Get("Policy"))
+	assert.Equal("token", gotRequest.Header.Get("X-Amz-Security-Token"))
+	assert.Contains(gotRequest.Header.Get("Authorization"), "x-amz-security-token")
+
+	// the default duration is used when none is requested
+	_, err = createTemporaryCredentials(server.Client(), server.URL, "", session, &models.TemporaryCredentialsRequest{})
+	assert.NoError(err)
+	assert.Equal("3600", gotRequest.Form.Get("DurationSeconds"))
+	assert.Empty(gotRequest.Form.Get("Policy"))
+
+	_, err = createTemporaryCredentials(server.Client(), server.URL, "", session, &models.TemporaryCredentialsRequest{DurationSeconds: 1800})
+	assert.ErrorIs(err, ErrInvalidTemporaryCredentials)
+	_, err = createTemporaryCredentials(server.Client(), server.URL, "", session, &models.TemporaryCredentialsRequest{Policy: "{"})
+	assert.ErrorIs(err, ErrInvalidTemporaryCredentials)
+
+	denied := &models.Principal{STSAccessKeyID: "access", STSSecretAccessKey: "secret12345", STSSessionToken: "denied"}
+	_, err = createTemporaryCredentials(server.Client(), server.URL, "", denied, &models.TemporaryCredentialsRequest{Policy: policy})
+	assert.ErrorIs(err, ErrAccessDenied)
+}
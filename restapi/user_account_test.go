@@ -33,7 +33,7 @@ func Test_getChangePasswordResponse(t *testing.T) {
 		AccountAccessKey: "TESTTEST",
 	}
 	CurrentSecretKey := "string"
-	NewSecretKey := "string"
+	NewSecretKey := "newstring"
 	changePasswordParameters := accountApi.AccountChangePasswordParams{
 		HTTPRequest: &http.Request{},
 		Body: &models.AccountChangePasswordRequest{
@@ -46,6 +46,11 @@ func Test_getChangePasswordResponse(t *testing.T) {
 	assert.Equal(expected, loginResponse)
 	expectedError := "error please check your current password" // errChangePassword
 	assert.Equal(expectedError, *actualError.DetailedMessage)
+
+	// secret keys not meeting the password policy are rejected before trying the current one
+	NewSecretKey = "short"
+	_, actualError = getChangePasswordResponse(session, changePasswordParameters)
+	assert.Equal(int32(400), actualError.Code)
 }
 
 func Test_changePassword(t *testing.T) {
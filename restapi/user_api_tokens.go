@@ -0,0 +1,311 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/apitokens"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/restapi/operations"
+	accountApi "github.com/minio/console/restapi/operations/account"
+	"github.com/minio/madmin-go/v2"
+)
+
+// defaultAPITokenExpiry is how long a token is valid when the request doesn't say
+const defaultAPITokenExpiry = 30 * 24 * time.Hour
+
+var (
+	globalAPITokens     *apitokens.Store
+	globalAPITokensOnce sync.Once
+)
+
+func registerAPITokenHandlers(api *operations.ConsoleAPI) {
+	// list the API tokens of the session user
+	api.AccountListAPITokensHandler = accountApi.ListAPITokensHandlerFunc(func(params accountApi.ListAPITokensParams, session *models.Principal) middleware.Responder {
+		resp, err := getListAPITokensResponse(session, params)
+		if err != nil {
+			return accountApi.NewListAPITokensDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewListAPITokensOK().WithPayload(resp)
+	})
+	// mint an API token, its value is only returned this once
+	api.AccountCreateAPITokenHandler = accountApi.CreateAPITokenHandlerFunc(func(params accountApi.CreateAPITokenParams, session *models.Principal) middleware.Responder {
+		resp, err := getCreateAPITokenResponse(session, params)
+		if err != nil {
+			return accountApi.NewCreateAPITokenDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewCreateAPITokenCreated().WithPayload(resp)
+	})
+	// revoke an API token and remove its service account
+	api.AccountRevokeAPITokenHandler = accountApi.RevokeAPITokenHandlerFunc(func(params accountApi.RevokeAPITokenParams, session *models.Principal) middleware.Responder {
+		if err := getRevokeAPITokenResponse(session, params); err != nil {
+			return accountApi.NewRevokeAPITokenDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewRevokeAPITokenNoContent()
+	})
+	// recent requests authenticated with an API token
+	api.AccountGetAPITokenUsageHandler = accountApi.GetAPITokenUsageHandlerFunc(func(params accountApi.GetAPITokenUsageParams, session *models.Principal) middleware.Responder {
+		resp, err := getAPITokenUsageResponse(session, params)
+		if err != nil {
+			return accountApi.NewGetAPITokenUsageDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewGetAPITokenUsageOK().WithPayload(resp)
+	})
+}
+
+// apiTokens returns the store of the API tokens, when the configured file can't be loaded they are only kept in
+// memory
+func apiTokens() *apitokens.Store {
+	globalAPITokensOnce.Do(func() {
+		store, err := apitokens.New(getConsoleAPITokensFile(), sessionKeyCipher{})
+		if err != nil {
+			LogError("unable to load the API tokens: %v", err)
+			store, _ = apitokens.New("", sessionKeyCipher{})
+		}
+		globalAPITokens = store
+	})
+	return globalAPITokens
+}
+
+// apiTokenOwner returns the user minting or managing tokens. The sessions of the tokens themselves and of OpenID
+// providers have no account access key, a token can't mint another one.
+func apiTokenOwner(session *models.Principal) (string, error) {
+	if session == nil || session.AccountAccessKey == "" {
+		return "", fmt.Errorf("%w: only the users logging in with their credentials can manage API tokens", ErrInvalidAPIToken)
+	}
+	return session.AccountAccessKey, nil
+}
+
+// apiTokenError reports the errors of the store with the matching sentinel
+func apiTokenError(err error) error {
+	switch {
+	case errors.Is(err, apitokens.ErrInvalid):
+		return fmt.Errorf("%w: %v", ErrInvalidAPIToken, err)
+	case errors.Is(err, apitokens.ErrNotFound):
+		return ErrAPITokenNotFound
+	}
+	return err
+}
+
+// apiTokenExpiry returns the expiration of a token valid for expiresIn seconds, the default expiry when it is zero
+func apiTokenExpiry(expiresIn int64, maxExpiry time.Duration, now time.Time) (time.Time, error) {
+	expiry := time.Duration(expiresIn) * time.Second
+	switch {
+	case expiresIn < 0:
+		return time.Time{}, fmt.Errorf("%w: the expiry can't be negative", ErrInvalidAPIToken)
+	case expiresIn == 0:
+		expiry = defaultAPITokenExpiry
+		if expiry > maxExpiry {
+			expiry = maxExpiry
+		}
+	case expiry > maxExpiry:
+		return time.Time{}, fmt.Errorf("%w: the expiry can't exceed %s", ErrInvalidAPIToken, maxExpiry)
+	}
+	return now.Add(expiry), nil
+}
+
+// createAPIToken mints a token of the owner standing for a new service account, restricted to the policy of the
+// request when there is one
+func createAPIToken(ctx context.Context, client MinioAdmin, store *apitokens.Store, owner string, req *models.CreateAPITokenRequest, maxExpiry time.Duration, now time.Time) (*models.APITokenCreated, error) {
+	name := swag.StringValue(req.Name)
+	scope := req.Scope
+	if scope == "" {
+		scope = apitokens.ScopeRead
+	}
+	if err := store.Validate(owner, name, scope); err != nil {
+		return nil, apiTokenError(err)
+	}
+	expires, err := apiTokenExpiry(req.ExpiresIn, maxExpiry, now)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := createServiceAccount(ctx, client, req.Policy)
+	if err != nil {
+		return nil, err
+	}
+	value, token, err := store.Create(owner, name, scope, creds.AccessKey, creds.SecretKey, expires, now)
+	if err != nil {
+		// a service account without its token would never be used nor removed
+		if errDelete := client.deleteServiceAccount(ctx, creds.AccessKey); errDelete != nil {
+			LogErrorContext(ctx, "unable to remove the service account of API token %s: %v", name, errDelete)
+		}
+		return nil, apiTokenError(err)
+	}
+	return &models.APITokenCreated{
+		Name:      token.Name,
+		Token:     value,
+		ExpiresAt: token.Expires.Format(time.RFC3339),
+	}, nil
+}
+
+func apiTokenModel(token *apitokens.Token, now time.Time) *models.APIToken {
+	t := &models.APIToken{
+		Name:         token.Name,
+		Scope:        token.Scope,
+		AccessKey:    token.AccessKey,
+		CreatedAt:    token.Created.Format(time.RFC3339),
+		ExpiresAt:    token.Expires.Format(time.RFC3339),
+		Expired:      token.Expired(now),
+		LastUsedFrom: token.LastUsedFrom,
+		UseCount:     token.UseCount,
+	}
+	if !token.LastUsed.IsZero() {
+		t.LastUsed = token.LastUsed.Format(time.RFC3339)
+	}
+	return t
+}
+
+// listAPITokens returns the tokens of the owner, the expired ones until they are revoked
+func listAPITokens(store *apitokens.Store, owner string, now time.Time) *models.APITokenList {
+	list := &models.APITokenList{Tokens: []*models.APIToken{}}
+	for _, token := range store.List(owner) {
+		list.Tokens = append(list.Tokens, apiTokenModel(token, now))
+	}
+	return list
+}
+
+// revokeAPIToken removes the token of the owner and its service account
+func revokeAPIToken(ctx context.Context, client MinioAdmin, store *apitokens.Store, owner, name string) error {
+	token, err := store.Get(owner, name)
+	if err != nil {
+		return apiTokenError(err)
+	}
+	if err := client.deleteServiceAccount(ctx, token.AccessKey); err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminServiceAccountNotFound" {
+		return err
+	}
+	return apiTokenError(store.Revoke(owner, name))
+}
+
+func apiTokenUsage(store *apitokens.Store, owner, name string) (*models.APITokenUsage, error) {
+	uses, err := store.Uses(owner, name)
+	if err != nil {
+		return nil, apiTokenError(err)
+	}
+	usage := &models.APITokenUsage{Uses: []*models.APITokenUse{}}
+	for _, use := range uses {
+		usage.Uses = append(usage.Uses, &models.APITokenUse{
+			Time:     use.Time.Format(time.RFC3339),
+			SourceIP: use.SourceIP,
+			Method:   use.Method,
+			Path:     use.Path,
+		})
+	}
+	return usage, nil
+}
+
+// bearerAPIToken returns the API token of the Authorization header of the request
+func bearerAPIToken(r *http.Request) (string, bool) {
+	value := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	return value, strings.HasPrefix(value, apitokens.Prefix)
+}
+
+// authenticateAPIToken returns the session claims of a request with an API token and records its use, the claims
+// hold the credentials of the service account of the token
+func authenticateAPIToken(store *apitokens.Store, value string, r *http.Request, now time.Time) (*auth.TokenClaims, int, error) {
+	token, secretKey, err := store.Authenticate(value, now)
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
+	}
+	if !token.Allows(r.Method) {
+		return nil, http.StatusForbidden, fmt.Errorf("the %s scope of API token %s doesn't allow %s requests", token.Scope, token.Name, r.Method)
+	}
+	use := apitokens.Use{Time: now, SourceIP: realip.ClientIP(r), Method: r.Method, Path: r.URL.Path}
+	if err := store.Record(token.ID, use); err != nil {
+		LogErrorContext(r.Context(), "unable to record the use of API token %s: %v", token.Name, err)
+	}
+	return &auth.TokenClaims{
+		STSAccessKeyID:     token.AccessKey,
+		STSSecretAccessKey: secretKey,
+		// the token expires on its own, the session limits see a request as a fresh login
+		IssuedAt:     now.Unix(),
+		LastActivity: now.Unix(),
+	}, http.StatusOK, nil
+}
+
+func getListAPITokensResponse(session *models.Principal, params accountApi.ListAPITokensParams) (*models.APITokenList, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := apiTokenOwner(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return listAPITokens(apiTokens(), owner, time.Now()), nil
+}
+
+func getCreateAPITokenResponse(session *models.Principal, params accountApi.CreateAPITokenParams) (*models.APITokenCreated, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := apiTokenOwner(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO user Admin Client interface implementation
+	// defining the client to be used
+	userAdminClient := AdminClient{Client: mAdmin}
+	created, err := createAPIToken(ctx, userAdminClient, apiTokens(), owner, params.Body, getConsoleAPITokenMaxExpiry(), time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return created, nil
+}
+
+func getRevokeAPITokenResponse(session *models.Principal, params accountApi.RevokeAPITokenParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := apiTokenOwner(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	userAdminClient := AdminClient{Client: mAdmin}
+	if err := revokeAPIToken(ctx, userAdminClient, apiTokens(), owner, params.Name); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getAPITokenUsageResponse(session *models.Principal, params accountApi.GetAPITokenUsageParams) (*models.APITokenUsage, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	owner, err := apiTokenOwner(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	usage, err := apiTokenUsage(apiTokens(), owner, params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return usage, nil
+}
@@ -0,0 +1,113 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/apitokens"
+	"github.com/minio/madmin-go/v2"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPITokens(t *testing.T) {
+	assert := assert.New(t)
+	client := AdminClientMock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store, err := apitokens.New("", nil)
+	assert.NoError(err)
+	now := time.Unix(1700000000, 0)
+	maxExpiry := 90 * 24 * time.Hour
+
+	var deleted []string
+	minioAddServiceAccountMock = func(ctx context.Context, policy *iampolicy.Policy, user string, accessKey string, secretKey string) (madmin.Credentials, error) {
+		return madmin.Credentials{AccessKey: "SA1", SecretKey: "secret1"}, nil
+	}
+	minioDeleteServiceAccountMock = func(ctx context.Context, serviceAccount string) error {
+		deleted = append(deleted, serviceAccount)
+		return nil
+	}
+
+	// OpenID sessions and the sessions of the tokens themselves can't mint tokens
+	_, err = apiTokenOwner(&models.Principal{STSAccessKeyID: "SA1"})
+	assert.True(errors.Is(err, ErrInvalidAPIToken))
+	owner, err := apiTokenOwner(&models.Principal{AccountAccessKey: "alice"})
+	assert.NoError(err)
+
+	// the expiry defaults to 30 days and can't exceed the maximum
+	_, err = createAPIToken(ctx, client, store, owner, &models.CreateAPITokenRequest{Name: swag.String("ci"), ExpiresIn: int64((100 * 24 * time.Hour).Seconds())}, maxExpiry, now)
+	assert.True(errors.Is(err, ErrInvalidAPIToken))
+	_, err = createAPIToken(ctx, client, store, owner, &models.CreateAPITokenRequest{Name: swag.String("ci"), Scope: "admin"}, maxExpiry, now)
+	assert.True(errors.Is(err, ErrInvalidAPIToken))
+	created, err := createAPIToken(ctx, client, store, owner, &models.CreateAPITokenRequest{Name: swag.String("ci")}, maxExpiry, now)
+	assert.NoError(err)
+	assert.Equal(now.Add(defaultAPITokenExpiry).Format(time.RFC3339), created.ExpiresAt)
+	assert.Empty(deleted)
+
+	list := listAPITokens(store, owner, now)
+	assert.Len(list.Tokens, 1)
+	assert.Equal(apitokens.ScopeRead, list.Tokens[0].Scope)
+	assert.Equal("SA1", list.Tokens[0].AccessKey)
+	assert.False(list.Tokens[0].Expired)
+	assert.Empty(listAPITokens(store, "bob", now).Tokens)
+
+	// a read token authenticates safe requests with the credentials of its service account
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/buckets", nil)
+	r.Header.Set("Authorization", "Bearer "+created.Token)
+	value, ok := bearerAPIToken(r)
+	assert.True(ok)
+	claims, status, err := authenticateAPIToken(store, value, r, now)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal("SA1", claims.STSAccessKeyID)
+	assert.Equal("secret1", claims.STSSecretAccessKey)
+	assert.Empty(claims.AccountAccessKey)
+	r = httptest.NewRequest(http.MethodDelete, "/api/v1/buckets/photos", nil)
+	_, status, err = authenticateAPIToken(store, value, r, now)
+	assert.Error(err)
+	assert.Equal(http.StatusForbidden, status)
+	_, status, _ = authenticateAPIToken(store, value, r, now.Add(defaultAPITokenExpiry))
+	assert.Equal(http.StatusUnauthorized, status)
+
+	usage, err := apiTokenUsage(store, owner, "ci")
+	assert.NoError(err)
+	assert.Len(usage.Uses, 1)
+	assert.Equal("/api/v1/buckets", usage.Uses[0].Path)
+	_, err = apiTokenUsage(store, owner, "missing")
+	assert.True(errors.Is(err, ErrAPITokenNotFound))
+
+	// a session cookie isn't an API token
+	r = httptest.NewRequest(http.MethodGet, "/api/v1/buckets", nil)
+	_, ok = bearerAPIToken(r)
+	assert.False(ok)
+
+	// revoking removes the service account, the token can't be used anymore
+	assert.NoError(revokeAPIToken(ctx, client, store, owner, "ci"))
+	assert.Equal([]string{"SA1"}, deleted)
+	_, status, _ = authenticateAPIToken(store, value, httptest.NewRequest(http.MethodGet, "/api/v1/buckets", nil), now)
+	assert.Equal(http.StatusUnauthorized, status)
+	assert.True(errors.Is(revokeAPIToken(ctx, client, store, owner, "ci"), ErrAPITokenNotFound))
+}
@@ -20,12 +20,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/restapi/operations"
 	bucektApi "github.com/minio/console/restapi/operations/bucket"
 
 	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
 
 	"github.com/minio/console/models"
 )
@@ -60,17 +63,32 @@ func setBucketQuotaResponse(session *models.Principal, params bucektApi.SetBucke
 	// create a minioClient interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
 	if err := setBucketQuota(ctx, &adminClient, &params.Name, params.Body); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
+	var softLimit int64
+	if *params.Body.Enabled {
+		softLimit = params.Body.SoftLimit
+	}
+	if err := setBucketSoftQuota(ctx, minioClient, params.Name, softLimit); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
 	return nil
 }
 
-func setBucketQuota(ctx context.Context, ac *AdminClient, bucket *string, bucketQuota *models.SetBucketQuota) error {
+func setBucketQuota(ctx context.Context, ac MinioAdmin, bucket *string, bucketQuota *models.SetBucketQuota) error {
 	if bucketQuota == nil {
 		return errors.New("nil bucket quota was provided")
 	}
 	if *bucketQuota.Enabled {
+		if err := validateSoftQuota(bucketQuota); err != nil {
+			return err
+		}
 		var quotaType madmin.QuotaType
 		switch bucketQuota.QuotaType {
 		case models.SetBucketQuotaQuotaTypeHard:
@@ -85,13 +103,93 @@ func setBucketQuota(ctx context.Context, ac *AdminClient, bucket *string, bucket
 			return err
 		}
 	} else {
-		if err := ac.Client.SetBucketQuota(ctx, *bucket, &madmin.BucketQuota{}); err != nil {
+		if err := ac.setBucketQuota(ctx, *bucket, &madmin.BucketQuota{}); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// validateSoftQuota makes sure the soft limit, when set, is a warning threshold below the hard quota
+func validateSoftQuota(bucketQuota *models.SetBucketQuota) error {
+	if bucketQuota.SoftLimit < 0 {
+		return ErrInvalidSoftQuota
+	}
+	if bucketQuota.SoftLimit > 0 && bucketQuota.Amount > 0 && bucketQuota.SoftLimit >= bucketQuota.Amount {
+		return ErrInvalidSoftQuota
+	}
+	return nil
+}
+
+// getBucketTagMap returns the tags of the bucket, a bucket without tags returns an empty map
+func getBucketTagMap(ctx context.Context, client MinioClient, bucket string) (map[string]string, error) {
+	bucketTags, err := client.GetBucketTagging(ctx, bucket)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchTagSet" {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	if bucketTags == nil {
+		return map[string]string{}, nil
+	}
+	return bucketTags.ToMap(), nil
+}
+
+// setBucketSoftQuota persists the soft limit of the bucket in its tags, a zero limit removes it
+func setBucketSoftQuota(ctx context.Context, client MinioClient, bucket string, softLimit int64) error {
+	value := ""
+	if softLimit > 0 {
+		value = strconv.FormatInt(softLimit, 10)
+	}
+	return setBucketManagedTag(ctx, client, bucket, softQuotaTagKey, value)
+}
+
+// setBucketManagedTag sets one of the tags Console keeps on the bucket, an empty value removes it
+func setBucketManagedTag(ctx context.Context, client MinioClient, bucket, key, value string) error {
+	tagMap, err := getBucketTagMap(ctx, client, bucket)
+	if err != nil {
+		return err
+	}
+	current, hasValue := tagMap[key]
+	if value != "" {
+		if current == value {
+			return nil
+		}
+		tagMap[key] = value
+	} else {
+		if !hasValue {
+			return nil
+		}
+		delete(tagMap, key)
+	}
+	if len(tagMap) == 0 {
+		return client.RemoveBucketTagging(ctx, bucket)
+	}
+	tagSet, err := tags.NewTags(tagMap, true)
+	if err != nil {
+		return err
+	}
+	return client.SetBucketTagging(ctx, bucket, tagSet)
+}
+
+// getBucketSoftQuota returns the soft limit stored for the bucket, zero if there is none
+func getBucketSoftQuota(ctx context.Context, client MinioClient, bucket string) (int64, error) {
+	tagMap, err := getBucketTagMap(ctx, client, bucket)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := tagMap[softQuotaTagKey]
+	if !ok {
+		return 0, nil
+	}
+	softLimit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid soft quota %q stored for bucket %s", value, bucket)
+	}
+	return softLimit, nil
+}
+
 func getBucketQuotaResponse(session *models.Principal, params bucektApi.GetBucketQuotaParams) (*models.BucketQuota, *models.Error) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
@@ -103,20 +201,50 @@ func getBucketQuotaResponse(session *models.Principal, params bucektApi.GetBucke
 	// create a minioClient interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
-	quota, err := getBucketQuota(ctx, &adminClient, &params.Name)
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	quota, err := getBucketQuota(ctx, adminClient, minioClient, &params.Name)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
 	return quota, nil
 }
 
-func getBucketQuota(ctx context.Context, ac *AdminClient, bucket *string) (*models.BucketQuota, error) {
+// getBucketQuota returns the hard quota of the bucket along with its soft limit, when a soft limit is
+// set the current usage of the bucket is checked against it and crossings are notified
+func getBucketQuota(ctx context.Context, ac MinioAdmin, client MinioClient, bucket *string) (*models.BucketQuota, error) {
 	quota, err := ac.getBucketQuota(ctx, *bucket)
 	if err != nil {
 		return nil, err
 	}
-	return &models.BucketQuota{
+	bucketQuota := &models.BucketQuota{
 		Quota: int64(quota.Quota),
 		Type:  string(quota.Type),
-	}, nil
+	}
+	softLimit, err := getBucketSoftQuota(ctx, client, *bucket)
+	if err != nil {
+		// the hard quota is still meaningful without the soft limit
+		ErrorWithContext(ctx, fmt.Errorf("error getting soft quota: %v", err))
+		return bucketQuota, nil
+	}
+	if softLimit == 0 {
+		globalSoftQuotaMonitor.forget(*bucket)
+		return bucketQuota, nil
+	}
+	info, err := ac.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range info.Buckets {
+		if b.Name == *bucket {
+			bucketQuota.Usage = int64(b.Size)
+			break
+		}
+	}
+	bucketQuota.SoftLimit = softLimit
+	bucketQuota.SoftLimitExceeded = globalSoftQuotaMonitor.observe(*bucket, softLimit, bucketQuota.Quota, bucketQuota.Usage)
+	return bucketQuota, nil
 }
@@ -0,0 +1,136 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// softQuotaTagKey is the bucket tag the soft quota is persisted in, MinIO only knows about hard
+// quotas so the soft limit travels along with the bucket instead of living in Console
+const softQuotaTagKey = "console-soft-quota"
+
+const (
+	softQuotaExceededEvent = "bucket_soft_quota_exceeded"
+	softQuotaClearedEvent  = "bucket_soft_quota_cleared"
+)
+
+// softQuotaNotification is the payload posted to the quota webhook when a bucket crosses its soft limit
+type softQuotaNotification struct {
+	Event     string    `json:"event"`
+	Bucket    string    `json:"bucket"`
+	Usage     int64     `json:"usage"`
+	SoftLimit int64     `json:"soft_limit"`
+	HardLimit int64     `json:"hard_limit,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+// softQuotaMonitor keeps track of the buckets above their soft limit so a notification is emitted
+// only when the threshold is crossed, not every time the quota is checked
+type softQuotaMonitor struct {
+	sync.Mutex
+	exceeded map[string]bool
+	notify   func(n softQuotaNotification)
+}
+
+var globalSoftQuotaMonitor = &softQuotaMonitor{
+	exceeded: map[string]bool{},
+	notify:   notifySoftQuotaWebhook,
+}
+
+// observe records the usage of the bucket and returns whether it is above its soft limit
+func (m *softQuotaMonitor) observe(bucket string, softLimit, hardLimit, usage int64) bool {
+	exceeded := softLimit > 0 && usage >= softLimit
+
+	m.Lock()
+	previous := m.exceeded[bucket]
+	if exceeded {
+		m.exceeded[bucket] = true
+	} else {
+		delete(m.exceeded, bucket)
+	}
+	m.Unlock()
+
+	if exceeded == previous {
+		return exceeded
+	}
+	n := softQuotaNotification{
+		Event:     softQuotaClearedEvent,
+		Bucket:    bucket,
+		Usage:     usage,
+		SoftLimit: softLimit,
+		HardLimit: hardLimit,
+		Time:      time.Now().UTC(),
+	}
+	if exceeded {
+		n.Event = softQuotaExceededEvent
+		LogInfo("bucket %s is above its soft quota: %d of %d bytes used", bucket, usage, softLimit)
+	}
+	go m.notify(n)
+	return exceeded
+}
+
+// forget drops the state of a bucket whose soft limit was removed
+func (m *softQuotaMonitor) forget(bucket string) {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.exceeded, bucket)
+}
+
+// notifySoftQuotaWebhook posts the notification to the configured quota webhook, if any
+func notifySoftQuotaWebhook(n softQuotaNotification) {
+	endpoint := getConsoleQuotaWebhookEndpoint()
+	if endpoint == "" {
+		return
+	}
+	if err := postWebhookNotification(endpoint, getConsoleQuotaWebhookAuthToken(), n); err != nil {
+		LogError("error notifying soft quota of bucket %s: %v", n.Bucket, err)
+	}
+}
+
+// postWebhookNotification posts a JSON payload to a webhook, sending the token as Bearer authorization
+func postWebhookNotification(endpoint, token string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := GetConsoleHTTPClient(endpoint).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateSoftQuota(t *testing.T) {
+	tests := []struct {
+		name    string
+		quota   *models.SetBucketQuota
+		wantErr bool
+	}{
+		{name: "no soft limit", quota: &models.SetBucketQuota{Amount: 100}},
+		{name: "soft below hard", quota: &models.SetBucketQuota{Amount: 100, SoftLimit: 80}},
+		{name: "soft without hard", quota: &models.SetBucketQuota{SoftLimit: 80}},
+		{name: "soft equal to hard", quota: &models.SetBucketQuota{Amount: 100, SoftLimit: 100}, wantErr: true},
+		{name: "negative soft", quota: &models.SetBucketQuota{Amount: 100, SoftLimit: -1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSoftQuota(tt.quota)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSoftQuota)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_setBucketSoftQuota(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	getBucketTagging := minioGetBucketTaggingMock
+	defer func() { minioGetBucketTaggingMock = getBucketTagging }()
+
+	var stored map[string]string
+	removed := false
+	minioSetBucketTaggingMock = func(ctx context.Context, bucketName string, t *tags.Tags) error {
+		stored = t.ToMap()
+		return nil
+	}
+	minioRemoveBucketTaggingMock = func(ctx context.Context, bucketName string) error {
+		removed = true
+		return nil
+	}
+
+	// user tags are preserved when the soft limit is added
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return tags.NewTags(map[string]string{"team": "storage"}, true)
+	}
+	assert.NoError(setBucketSoftQuota(ctx, client, "bucket", 1024))
+	assert.Equal(map[string]string{"team": "storage", softQuotaTagKey: "1024"}, stored)
+
+	// a bucket without tags
+	stored = nil
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchTagSet", StatusCode: http.StatusNotFound}
+	}
+	assert.NoError(setBucketSoftQuota(ctx, client, "bucket", 2048))
+	assert.Equal(map[string]string{softQuotaTagKey: "2048"}, stored)
+
+	// removing the only tag removes the tagging
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return tags.NewTags(map[string]string{softQuotaTagKey: "2048"}, true)
+	}
+	assert.NoError(setBucketSoftQuota(ctx, client, "bucket", 0))
+	assert.True(removed)
+
+	// errors reading the tags are returned
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return nil, errors.New("access denied")
+	}
+	assert.Error(setBucketSoftQuota(ctx, client, "bucket", 10))
+}
+
+func Test_getBucketQuota(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	client := minioClientMock{}
+	getBucketTagging := minioGetBucketTaggingMock
+	defer func() { minioGetBucketTaggingMock = getBucketTagging }()
+
+	var mu sync.Mutex
+	var events []string
+	globalSoftQuotaMonitor = &softQuotaMonitor{
+		exceeded: map[string]bool{},
+		notify: func(n softQuotaNotification) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, n.Event)
+		},
+	}
+	defer func() {
+		globalSoftQuotaMonitor = &softQuotaMonitor{exceeded: map[string]bool{}, notify: notifySoftQuotaWebhook}
+	}()
+
+	minioGetBucketQuotaMock = func(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{Quota: 1000, Type: madmin.HardQuota}, nil
+	}
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return tags.NewTags(map[string]string{softQuotaTagKey: "800"}, true)
+	}
+	var usage uint64
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "bucket", Size: usage}}}, nil
+	}
+	bucket := "bucket"
+
+	usage = 500
+	quota, err := getBucketQuota(ctx, adminClient, client, &bucket)
+	assert.NoError(err)
+	assert.Equal(int64(1000), quota.Quota)
+	assert.Equal(int64(800), quota.SoftLimit)
+	assert.Equal(int64(500), quota.Usage)
+	assert.False(quota.SoftLimitExceeded)
+
+	usage = 900
+	quota, err = getBucketQuota(ctx, adminClient, client, &bucket)
+	assert.NoError(err)
+	assert.True(quota.SoftLimitExceeded)
+	// checking again while above the threshold does not notify twice
+	_, err = getBucketQuota(ctx, adminClient, client, &bucket)
+	assert.NoError(err)
+
+	usage = 100
+	quota, err = getBucketQuota(ctx, adminClient, client, &bucket)
+	assert.NoError(err)
+	assert.False(quota.SoftLimitExceeded)
+
+	// notifications are delivered asynchronously
+	assert.Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(events) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.ElementsMatch([]string{softQuotaExceededEvent, softQuotaClearedEvent}, events)
+}
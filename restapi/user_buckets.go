@@ -22,6 +22,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -50,7 +52,7 @@ func registerBucketsHandlers(api *operations.ConsoleAPI) {
 		if err != nil {
 			return bucketApi.NewListBucketsDefault(int(err.Code)).WithPayload(err)
 		}
-		return bucketApi.NewListBucketsOK().WithPayload(listBucketsResponse)
+		return withPageHeaders(bucketApi.NewListBucketsOK().WithPayload(listBucketsResponse), listBucketsResponse.Total, listBucketsResponse.NextCursor)
 	})
 	// make bucket
 	api.BucketMakeBucketHandler = bucketApi.MakeBucketHandlerFunc(func(params bucketApi.MakeBucketParams, session *models.Principal) middleware.Responder {
@@ -162,6 +164,13 @@ func registerBucketsHandlers(api *operations.ConsoleAPI) {
 		}
 		return bucketApi.NewGetBucketRetentionConfigOK().WithPayload(response)
 	})
+	// remove the default retention of a bucket
+	api.BucketClearBucketRetentionConfigHandler = bucketApi.ClearBucketRetentionConfigHandlerFunc(func(params bucketApi.ClearBucketRetentionConfigParams, session *models.Principal) middleware.Responder {
+		if err := getClearBucketRetentionConfigResponse(session, params); err != nil {
+			return bucketApi.NewClearBucketRetentionConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewClearBucketRetentionConfigNoContent()
+	})
 	// get bucket object locking status
 	api.BucketGetBucketObjectLockingStatusHandler = bucketApi.GetBucketObjectLockingStatusHandlerFunc(func(params bucketApi.GetBucketObjectLockingStatusParams, session *models.Principal) middleware.Responder {
 		getBucketObjectLockingStatus, err := getBucketObjectLockingResponse(session, params)
@@ -243,26 +252,7 @@ func getBucketReplicationResponse(session *models.Principal, params bucketApi.Ge
 	var rules []*models.BucketReplicationRule
 
 	for _, rule := range res.Rules {
-		repDelMarkerStatus := false
-		if rule.DeleteMarkerReplication.Status == "enable" {
-			repDelMarkerStatus = true
-		}
-		repDelStatus := false
-		if rule.DeleteReplication.Status == "enable" {
-			repDelMarkerStatus = true
-		}
-
-		rules = append(rules, &models.BucketReplicationRule{
-			DeleteMarkerReplication: repDelMarkerStatus,
-			DeletesReplication:      repDelStatus,
-			Destination:             &models.BucketReplicationDestination{Bucket: rule.Destination.Bucket},
-			Tags:                    rule.Tags(),
-			Prefix:                  rule.Prefix(),
-			ID:                      rule.ID,
-			Priority:                int32(rule.Priority),
-			Status:                  string(rule.Status),
-			StorageClass:            rule.Destination.StorageClass,
-		})
+		rules = append(rules, bucketReplicationRuleFromConfig(rule))
 	}
 
 	// serialize output
@@ -305,38 +295,24 @@ func getBucketReplicationRuleResponse(session *models.Principal, params bucketAp
 		return nil, ErrorWithContext(ctx, errors.New("no rule is set with this ID"))
 	}
 
-	repDelMarkerStatus := false
-	if foundRule.DeleteMarkerReplication.Status == "Enabled" {
-		repDelMarkerStatus = true
-	}
-	repDelStatus := false
-	if foundRule.DeleteReplication.Status == "Enabled" {
-		repDelStatus = true
-	}
-	existingObjects := false
-	if foundRule.ExistingObjectReplication.Status == "Enabled" {
-		existingObjects = true
-	}
-	metadataModifications := false
-	if foundRule.SourceSelectionCriteria.ReplicaModifications.Status == "Enabled" {
-		metadataModifications = true
-	}
+	return bucketReplicationRuleFromConfig(foundRule), nil
+}
 
-	returnRule := &models.BucketReplicationRule{
-		DeleteMarkerReplication: repDelMarkerStatus,
-		DeletesReplication:      repDelStatus,
-		Destination:             &models.BucketReplicationDestination{Bucket: foundRule.Destination.Bucket},
-		Tags:                    foundRule.Tags(),
-		Prefix:                  foundRule.Prefix(),
-		ID:                      foundRule.ID,
-		Priority:                int32(foundRule.Priority),
-		Status:                  string(foundRule.Status),
-		StorageClass:            foundRule.Destination.StorageClass,
-		ExistingObjects:         existingObjects,
-		MetadataReplication:     metadataModifications,
+// bucketReplicationRuleFromConfig converts a rule of the bucket replication configuration to its API model
+func bucketReplicationRuleFromConfig(rule replication.Rule) *models.BucketReplicationRule {
+	return &models.BucketReplicationRule{
+		DeleteMarkerReplication: rule.DeleteMarkerReplication.Status == replication.Enabled,
+		DeletesReplication:      rule.DeleteReplication.Status == replication.Enabled,
+		Destination:             &models.BucketReplicationDestination{Bucket: rule.Destination.Bucket},
+		Tags:                    rule.Tags(),
+		Prefix:                  rule.Prefix(),
+		ID:                      rule.ID,
+		Priority:                int32(rule.Priority),
+		Status:                  string(rule.Status),
+		StorageClass:            rule.Destination.StorageClass,
+		ExistingObjects:         rule.ExistingObjectReplication.Status == replication.Enabled,
+		MetadataReplication:     rule.SourceSelectionCriteria.ReplicaModifications.Status == replication.Enabled,
 	}
-
-	return returnRule, nil
 }
 
 func getBucketVersionedResponse(session *models.Principal, params bucketApi.GetBucketVersioningParams) (*models.BucketVersioningResponse, *models.Error) {
@@ -381,6 +357,7 @@ func getAccountBuckets(ctx context.Context, client MinioAdmin) ([]*models.Bucket
 	if err != nil {
 		return []*models.Bucket{}, err
 	}
+	recordBucketUsage(ctx, usageHistory(), info, time.Now())
 	var bucketInfos []*models.Bucket
 	for _, bucket := range info.Buckets {
 		bucketElem := &models.Bucket{
@@ -435,22 +412,122 @@ func getListBucketsResponse(session *models.Principal, params bucketApi.ListBuck
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	sort.Slice(buckets, func(i, j int) bool { return *buckets[i].Name < *buckets[j].Name })
+	page, err := paginate(len(buckets), func(i int) string { return *buckets[i].Name }, params.Cursor, params.PageSize)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 
 	// serialize output
 	listBucketsResponse := &models.ListBucketsResponse{
-		Buckets: buckets,
-		Total:   int64(len(buckets)),
+		Buckets:    buckets[page.start:page.end],
+		Total:      int64(page.total),
+		NextCursor: page.nextCursor,
 	}
 	return listBucketsResponse, nil
 }
 
+// consoleManagedBucketTags are the bucket tags Console persists settings in, they are hidden from the
+// bucket tags and can only be changed through the API managing them
+var consoleManagedBucketTags = []string{softQuotaTagKey, sseKMSContextTagKey}
+
 // makeBucket creates a bucket for an specific minio client
 func makeBucket(ctx context.Context, client MinioClient, bucketName string, objectLocking bool) error {
 	// creates a new bucket with bucketName with a context to control cancellations and timeouts.
 	return client.makeBucketWithContext(ctx, bucketName, "", objectLocking)
 }
 
-// getMakeBucketResponse performs makeBucket() to create a bucket with its access policy
+// makeBucketWithConfig creates the bucket and applies the requested configuration in order: versioning,
+// retention, quota, encryption and tags. The bucket is removed if any of the steps fails, that way a
+// partial failure never leaves a misconfigured bucket behind.
+func makeBucketWithConfig(ctx context.Context, client MinioClient, versioningClient MCClient, adminClient MinioAdmin, br *models.MakeBucketRequest) (err error) {
+	// validate the tags before creating anything, the soft quota is persisted along with them
+	tagMap := make(map[string]string, len(br.Tags)+1)
+	for k, v := range br.Tags {
+		tagMap[k] = v
+	}
+	if br.Quota != nil && br.Quota.Enabled != nil && *br.Quota.Enabled {
+		if err = validateSoftQuota(br.Quota); err != nil {
+			return err
+		}
+		if br.Quota.SoftLimit > 0 {
+			tagMap[softQuotaTagKey] = strconv.FormatInt(br.Quota.SoftLimit, 10)
+		}
+	}
+	if br.Encryption != nil && br.Encryption.EncType != nil {
+		if err = validateBucketEncryptionRequest(br.Encryption); err != nil {
+			return err
+		}
+		var kmsContext string
+		if kmsContext, err = encodeKMSContext(br.Encryption.KmsContext); err != nil {
+			return err
+		}
+		if kmsContext != "" {
+			tagMap[sseKMSContextTagKey] = kmsContext
+		}
+	}
+	var tagSet *tags.Tags
+	if len(tagMap) > 0 {
+		if tagSet, err = tags.NewTags(tagMap, true); err != nil {
+			return err
+		}
+	}
+	// if we need retention, then object locking needs to be enabled
+	locking := br.Locking || br.Retention != nil
+	if err = makeBucket(ctx, client, *br.Name, locking); err != nil {
+		return err
+	}
+
+	// make sure to delete bucket if an errors occurs after bucket was created
+	defer func() {
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error creating bucket: %v", err))
+			if err := removeBucket(client, *br.Name); err != nil {
+				ErrorWithContext(ctx, fmt.Errorf("error removing bucket: %v", err))
+			}
+		}
+	}()
+
+	// enable versioning if indicated or retention enabled
+	if br.Versioning || br.Retention != nil {
+		if err = doSetVersioning(versioningClient, VersionEnable); err != nil {
+			return fmt.Errorf("error setting versioning for bucket: %w", err)
+		}
+	}
+
+	// Set Bucket Retention Configuration if defined
+	if br.Retention != nil {
+		if err = setBucketRetentionConfig(ctx, client, *br.Name, *br.Retention.Mode, *br.Retention.Unit, br.Retention.Validity); err != nil {
+			return err
+		}
+	}
+
+	if br.Quota != nil && br.Quota.Enabled != nil && *br.Quota.Enabled {
+		if err = setBucketQuota(ctx, adminClient, br.Name, br.Quota); err != nil {
+			return fmt.Errorf("error setting quota for bucket: %w", err)
+		}
+	}
+
+	if br.Encryption != nil && br.Encryption.EncType != nil {
+		if br.Encryption.CreateKey {
+			if err = ensureKMSKey(ctx, adminClient, br.Encryption.KmsKeyID); err != nil {
+				return fmt.Errorf("error creating KMS key %s: %w", br.Encryption.KmsKeyID, err)
+			}
+		}
+		if err = enableBucketEncryption(ctx, client, *br.Name, *br.Encryption.EncType, br.Encryption.KmsKeyID); err != nil {
+			return err
+		}
+	}
+
+	if tagSet != nil {
+		if err = client.SetBucketTagging(ctx, *br.Name, tagSet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getMakeBucketResponse performs makeBucketWithConfig() to create a bucket with all its configuration
 func getMakeBucketResponse(session *models.Principal, params bucketApi.MakeBucketParams) (*models.MakeBucketsResponse, *models.Error) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
@@ -467,26 +544,7 @@ func getMakeBucketResponse(session *models.Principal, params bucketApi.MakeBucke
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
 
-	// if we need retention, then object locking needs to be enabled
-	if br.Retention != nil {
-		br.Locking = true
-	}
-
-	if err := makeBucket(ctx, minioClient, *br.Name, br.Locking); err != nil {
-		return nil, ErrorWithContext(ctx, err)
-	}
-
-	// make sure to delete bucket if an errors occurs after bucket was created
-	defer func() {
-		if err != nil {
-			ErrorWithContext(ctx, fmt.Errorf("error creating bucket: %v", err))
-			if err := removeBucket(minioClient, *br.Name); err != nil {
-				ErrorWithContext(ctx, fmt.Errorf("error removing bucket: %v", err))
-			}
-		}
-	}()
-
-	// enable versioning if indicated or retention enabled
+	var versioningClient MCClient
 	if br.Versioning || br.Retention != nil {
 		s3Client, err := newS3BucketClient(session, *br.Name, "")
 		if err != nil {
@@ -494,34 +552,22 @@ func getMakeBucketResponse(session *models.Principal, params bucketApi.MakeBucke
 		}
 		// create a mc S3Client interface implementation
 		// defining the client to be used
-		amcClient := mcClient{client: s3Client}
-
-		if err = doSetVersioning(amcClient, VersionEnable); err != nil {
-			return nil, ErrorWithContext(ctx, fmt.Errorf("error setting versioning for bucket: %s", err))
-		}
+		versioningClient = mcClient{client: s3Client}
 	}
 
-	// if it has support for
-	if br.Quota != nil && br.Quota.Enabled != nil && *br.Quota.Enabled {
+	var adminClient MinioAdmin
+	if (br.Quota != nil && br.Quota.Enabled != nil && *br.Quota.Enabled) || (br.Encryption != nil && br.Encryption.CreateKey) {
 		mAdmin, err := NewMinioAdminClient(session)
 		if err != nil {
 			return nil, ErrorWithContext(ctx, err)
 		}
 		// create a minioClient interface implementation
 		// defining the client to be used
-		adminClient := AdminClient{Client: mAdmin}
-		// we will tolerate this call failing
-		if err := setBucketQuota(ctx, &adminClient, br.Name, br.Quota); err != nil {
-			ErrorWithContext(ctx, fmt.Errorf("error versioning bucket: %v", err))
-		}
+		adminClient = AdminClient{Client: mAdmin}
 	}
 
-	// Set Bucket Retention Configuration if defined
-	if br.Retention != nil {
-		err = setBucketRetentionConfig(ctx, minioClient, *br.Name, *br.Retention.Mode, *br.Retention.Unit, br.Retention.Validity)
-		if err != nil {
-			return nil, ErrorWithContext(ctx, err)
-		}
+	if err := makeBucketWithConfig(ctx, minioClient, versioningClient, adminClient, br); err != nil {
+		return nil, ErrorWithContext(ctx, err)
 	}
 	return &models.MakeBucketsResponse{BucketName: *br.Name}, nil
 }
@@ -608,7 +654,24 @@ func getPutBucketTagsResponse(session *models.Principal, params bucketApi.PutBuc
 	req := params.Body
 	bucketName := params.BucketName
 
-	newTagSet, err := tags.NewTags(req.Tags, true)
+	tagMap := make(map[string]string, len(req.Tags)+1)
+	for k, v := range req.Tags {
+		tagMap[k] = v
+	}
+	// the soft quota and encryption context are kept in the bucket tags but managed through their own
+	// APIs, preserve them
+	currentTags, err := getBucketTagMap(ctx, minioClient, bucketName)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	for _, key := range consoleManagedBucketTags {
+		delete(tagMap, key)
+		if value, ok := currentTags[key]; ok {
+			tagMap[key] = value
+		}
+	}
+
+	newTagSet, err := tags.NewTags(tagMap, true)
 	if err != nil {
 		return ErrorWithContext(ctx, err)
 	}
@@ -684,6 +747,9 @@ func getBucketInfo(ctx context.Context, client MinioClient, adminClient MinioAdm
 	bucketDetails := &models.BucketDetails{}
 	if bucketTags != nil {
 		bucketDetails.Tags = bucketTags.ToMap()
+		for _, key := range consoleManagedBucketTags {
+			delete(bucketDetails.Tags, key)
+		}
 	}
 
 	info, err := adminClient.AccountInfo(ctx)
@@ -775,7 +841,7 @@ func enableBucketEncryption(ctx context.Context, client MinioClient, bucketName
 	return client.setBucketEncryption(ctx, bucketName, config)
 }
 
-// enableBucketEncryptionResponse calls enableBucketEncryption() to create new encryption configuration for provided bucket name
+// enableBucketEncryptionResponse calls configureBucketEncryption() to create new encryption configuration for provided bucket name
 func enableBucketEncryptionResponse(session *models.Principal, params bucketApi.EnableBucketEncryptionParams) *models.Error {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
@@ -786,7 +852,15 @@ func enableBucketEncryptionResponse(session *models.Principal, params bucketApi.
 	// create a minioClient interface implementation
 	// defining the client to be used
 	minioClient := minioClient{client: mClient}
-	if err := enableBucketEncryption(ctx, minioClient, params.BucketName, *params.Body.EncType, params.Body.KmsKeyID); err != nil {
+	var adminClient MinioAdmin
+	if params.Body.CreateKey {
+		mAdmin, err := NewMinioAdminClient(session)
+		if err != nil {
+			return ErrorWithContext(ctx, err)
+		}
+		adminClient = AdminClient{Client: mAdmin}
+	}
+	if err := configureBucketEncryption(ctx, minioClient, adminClient, params.BucketName, params.Body); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
 	return nil
@@ -794,7 +868,15 @@ func enableBucketEncryptionResponse(session *models.Principal, params bucketApi.
 
 // disableBucketEncryption will disable bucket for the provided bucket name
 func disableBucketEncryption(ctx context.Context, client MinioClient, bucketName string) error {
-	return client.removeBucketEncryption(ctx, bucketName)
+	if err := client.removeBucketEncryption(ctx, bucketName); err != nil {
+		return err
+	}
+	// the encryption context is meaningless without the key, uploads ignore it once the bucket isn't
+	// encrypted anymore so failing to remove it can be tolerated
+	if err := setBucketKMSContext(ctx, client, bucketName, nil); err != nil {
+		ErrorWithContext(ctx, fmt.Errorf("error removing bucket encryption context: %v", err))
+	}
+	return nil
 }
 
 // disableBucketEncryptionResponse calls disableBucketEncryption()
@@ -822,7 +904,16 @@ func getBucketEncryptionInfo(ctx context.Context, client MinioClient, bucketName
 	if len(bucketInfo.Rules) == 0 {
 		return nil, ErrDefault
 	}
-	return &models.BucketEncryptionInfo{Algorithm: bucketInfo.Rules[0].Apply.SSEAlgorithm, KmsMasterKeyID: bucketInfo.Rules[0].Apply.KmsMasterKeyID}, nil
+	kmsContext, err := getBucketKMSContext(ctx, client, bucketName)
+	if err != nil {
+		// we can tolerate this errors
+		ErrorWithContext(ctx, fmt.Errorf("error getting bucket encryption context: %v", err))
+	}
+	return &models.BucketEncryptionInfo{
+		Algorithm:      bucketInfo.Rules[0].Apply.SSEAlgorithm,
+		KmsMasterKeyID: bucketInfo.Rules[0].Apply.KmsMasterKeyID,
+		KmsContext:     kmsContext,
+	}, nil
 }
 
 func getBucketEncryptionInfoResponse(session *models.Principal, params bucketApi.GetBucketEncryptionInfoParams) (*models.BucketEncryptionInfo, *models.Error) {
@@ -842,6 +933,12 @@ func getBucketEncryptionInfoResponse(session *models.Principal, params bucketApi
 	return bucketInfo, nil
 }
 
+// longest default retention accepted by S3
+const (
+	maxRetentionDays  int32 = 36500
+	maxRetentionYears int32 = 100
+)
+
 // setBucketRetentionConfig sets object lock configuration on a bucket
 func setBucketRetentionConfig(ctx context.Context, client MinioClient, bucketName string, mode models.ObjectRetentionMode, unit models.ObjectRetentionUnit, validity *int32) error {
 	if validity == nil {
@@ -868,8 +965,44 @@ func setBucketRetentionConfig(ctx context.Context, client MinioClient, bucketNam
 		return errors.New("invalid retention unit")
 	}
 
+	maxValidity := maxRetentionDays
+	if retentionUnit == minio.Years {
+		maxValidity = maxRetentionYears
+	}
+	if *validity < 1 || *validity > maxValidity {
+		return fmt.Errorf("%w: validity must be between 1 and %d %s", ErrInvalidBucketRetention, maxValidity, unit)
+	}
+
 	retentionValidity := uint(*validity)
-	return client.setObjectLockConfig(ctx, bucketName, &retentionMode, &retentionValidity, &retentionUnit)
+	err := client.setObjectLockConfig(ctx, bucketName, &retentionMode, &retentionValidity, &retentionUnit)
+	return objectLockConfigError(err)
+}
+
+// objectLockConfigError explains the error MinIO returns when the bucket was created without object locking
+func objectLockConfigError(err error) error {
+	if err != nil && minio.ToErrorResponse(err).Code == "InvalidBucketState" {
+		return fmt.Errorf("%w: %v", ErrBucketObjectLockingDisabled, err)
+	}
+	return err
+}
+
+// clearBucketRetentionConfig removes the default retention, object locking stays enabled on the bucket
+func clearBucketRetentionConfig(ctx context.Context, client MinioClient, bucketName string) error {
+	return objectLockConfigError(client.setObjectLockConfig(ctx, bucketName, nil, nil, nil))
+}
+
+func getClearBucketRetentionConfigResponse(session *models.Principal, params bucketApi.ClearBucketRetentionConfigParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	if err = clearBucketRetentionConfig(ctx, minioClient, params.BucketName); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
 }
 
 func getSetBucketRetentionConfigResponse(session *models.Principal, params bucketApi.SetBucketRetentionConfigParams) *models.Error {
@@ -903,7 +1036,7 @@ func getBucketRetentionConfig(ctx context.Context, client MinioClient, bucketNam
 	// object was created with object locking enabled but
 	// does not have any default object locking configuration.
 	if m == nil && v == nil && u == nil {
-		return &models.GetBucketRetentionConfig{}, nil
+		return &models.GetBucketRetentionConfig{ObjectLockingEnabled: true}, nil
 	}
 
 	var mode models.ObjectRetentionMode
@@ -937,9 +1070,10 @@ func getBucketRetentionConfig(ctx context.Context, client MinioClient, bucketNam
 	}
 
 	config := &models.GetBucketRetentionConfig{
-		Mode:     mode,
-		Unit:     unit,
-		Validity: validity,
+		ObjectLockingEnabled: true,
+		Mode:                 mode,
+		Unit:                 unit,
+		Validity:             validity,
 	}
 	return config, nil
 }
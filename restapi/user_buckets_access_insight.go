@@ -0,0 +1,214 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	defaultAccessInsightWindow = 24 * time.Hour
+	maxAccessInsightWindow     = 30 * 24 * time.Hour
+	defaultAccessInsightTop    = 10
+	maxAccessInsightTop        = 100
+	accessInsightPageSize      = 1000
+	// audit entries aggregated at most, the insight is flagged as truncated past it
+	maxAccessInsightEntries = 50000
+)
+
+func registerBucketAccessInsightHandlers(api *operations.ConsoleAPI) {
+	// summary of the audit log of a bucket
+	api.BucketGetBucketAccessInsightHandler = bucketApi.GetBucketAccessInsightHandlerFunc(func(params bucketApi.GetBucketAccessInsightParams, session *models.Principal) middleware.Responder {
+		resp, err := getBucketAccessInsightResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketAccessInsightDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetBucketAccessInsightOK().WithPayload(resp)
+	})
+}
+
+// accessInsightCounter counts requests and errors grouped by a field of the audit entries
+type accessInsightCounter map[string]*models.AccessInsightEntry
+
+// add counts an entry, entries are expected from the newest to the oldest
+func (c accessInsightCounter) add(name string, failed bool, when string) {
+	entry, ok := c[name]
+	if !ok {
+		entry = &models.AccessInsightEntry{Name: name, LastSeen: when}
+		c[name] = entry
+	}
+	entry.Requests++
+	if failed {
+		entry.Errors++
+	}
+}
+
+// top returns the n entries with most requests
+func (c accessInsightCounter) top(n int) []*models.AccessInsightEntry {
+	entries := make([]*models.AccessInsightEntry, 0, len(c))
+	for _, entry := range c {
+		entry.ErrorRate = errorRate(entry.Errors, entry.Requests)
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Requests != entries[j].Requests {
+			return entries[i].Requests > entries[j].Requests
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	if len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+func errorRate(failed, requests int64) float64 {
+	if requests == 0 {
+		return 0
+	}
+	return float64(failed) / float64(requests)
+}
+
+// auditField returns a text field of an audit entry from Log Search
+func auditField(entry map[string]interface{}, field string) string {
+	if value, ok := entry[field].(string); ok {
+		return value
+	}
+	return ""
+}
+
+// parseAccessInsightQuery returns the time window and number of top entries requested
+func parseAccessInsightQuery(window *string, top *int32) (time.Duration, int, error) {
+	d := defaultAccessInsightWindow
+	if window != nil && *window != "" {
+		var err error
+		if d, err = time.ParseDuration(*window); err != nil || d <= 0 || d > maxAccessInsightWindow {
+			return 0, 0, fmt.Errorf("%w: window must be a duration up to %s", ErrInvalidAccessInsightQuery, maxAccessInsightWindow)
+		}
+	}
+	n := defaultAccessInsightTop
+	if top != nil {
+		if *top < 1 || *top > maxAccessInsightTop {
+			return 0, 0, fmt.Errorf("%w: top must be between 1 and %d", ErrInvalidAccessInsightQuery, maxAccessInsightTop)
+		}
+		n = int(*top)
+	}
+	return d, n, nil
+}
+
+// getBucketAccessInsight pages through the audit entries of the bucket stored by Log Search since a
+// given time and aggregates them by caller, API and remote host
+func getBucketAccessInsight(logSearchURL, token, bucketName string, since time.Time, top int) (*models.BucketAccessInsight, error) {
+	insight := &models.BucketAccessInsight{Bucket: bucketName, Since: since.Format(time.RFC3339)}
+	callers, apis, hosts := accessInsightCounter{}, accessInsightCounter{}, accessInsightCounter{}
+	for page := 0; ; page++ {
+		query := url.Values{}
+		query.Set("token", token)
+		query.Set("q", "reqinfo")
+		query.Set("fp", "bucket:"+bucketName)
+		query.Set("timeDesc", "ok")
+		query.Set("timeStart", since.Format(time.RFC3339))
+		query.Set("pageSize", fmt.Sprint(accessInsightPageSize))
+		query.Set("pageNo", fmt.Sprint(page))
+		resp, err := logSearch(fmt.Sprintf("%s/api/query?%s", strings.TrimSuffix(logSearchURL, "/"), query.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		entries, _ := resp.Results.([]map[string]interface{})
+		for _, entry := range entries {
+			if insight.Requests >= maxAccessInsightEntries {
+				insight.Truncated = true
+				break
+			}
+			insight.Requests++
+			status, _ := entry["response_status_code"].(float64)
+			switch {
+			case status >= 500:
+				insight.ServerErrors++
+			case status >= 400:
+				insight.ClientErrors++
+			}
+			isError := status >= 400
+			when := auditField(entry, "time")
+			caller := auditField(entry, "access_key")
+			if caller == "" {
+				caller = "anonymous"
+			}
+			callers.add(caller, isError, when)
+			apis.add(auditField(entry, "api_name"), isError, when)
+			hosts.add(auditField(entry, "remote_host"), isError, when)
+		}
+		if insight.Truncated || len(entries) < accessInsightPageSize {
+			break
+		}
+	}
+	insight.ErrorRate = errorRate(insight.ClientErrors+insight.ServerErrors, insight.Requests)
+	insight.TopCallers = callers.top(top)
+	insight.TopApis = apis.top(top)
+	insight.TopRemoteHosts = hosts.top(top)
+	return insight, nil
+}
+
+// canViewBucketAccessInsight returns whether the user may see who accessed the bucket: accounts with
+// access to Log Search and accounts allowed to read the policy of the bucket
+func canViewBucketAccessInsight(ctx context.Context, client MinioClient, permissions map[string][]string, bucketName string) bool {
+	if hasLogSearchAccess(permissions) {
+		return true
+	}
+	_, err := client.getBucketPolicy(ctx, bucketName)
+	return err == nil || minio.ToErrorResponse(err).Code == "NoSuchBucketPolicy"
+}
+
+func getBucketAccessInsightResponse(session *models.Principal, params bucketApi.GetBucketAccessInsightParams) (*models.BucketAccessInsight, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	window, top, err := parseAccessInsightQuery(params.Window, params.Top)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	logSearchURL := getLogSearchURL()
+	if logSearchURL == "" {
+		return nil, ErrorWithContext(ctx, ErrAuditLogNotConfigured)
+	}
+	sessionResp, serr := getSessionResponse(ctx, session)
+	if serr != nil {
+		return nil, serr
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if !canViewBucketAccessInsight(ctx, minioClient{client: mClient}, sessionResp.Permissions, params.BucketName) {
+		return nil, ErrorWithContext(ctx, ErrForbidden)
+	}
+	insight, err := getBucketAccessInsight(logSearchURL, getLogSearchAPIToken(), params.BucketName, time.Now().UTC().Add(-window), top)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return insight, nil
+}
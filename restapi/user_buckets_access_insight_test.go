@@ -0,0 +1,116 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBucketAccessInsight(t *testing.T) {
+	assert := assert.New(t)
+	entries := []map[string]interface{}{
+		{"time": "2023-05-02T10:00:03Z", "api_name": "GetObject", "access_key": "alice", "remote_host": "10.0.0.1", "response_status_code": float64(200)},
+		{"time": "2023-05-02T10:00:02Z", "api_name": "PutObject", "access_key": "bob", "remote_host": "10.0.0.2", "response_status_code": float64(403)},
+		{"time": "2023-05-02T10:00:01Z", "api_name": "GetObject", "access_key": "alice", "remote_host": "10.0.0.1", "response_status_code": float64(404)},
+		{"time": "2023-05-02T10:00:00Z", "api_name": "GetObject", "access_key": "", "remote_host": "10.0.0.3", "response_status_code": float64(503)},
+	}
+	var query map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/api/query", r.URL.Path)
+		query = r.URL.Query()
+		json.NewEncoder(w).Encode(entries)
+	}))
+	defer server.Close()
+
+	since := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	insight, err := getBucketAccessInsight(server.URL, "secret", "photos", since, 2)
+	assert.NoError(err)
+	assert.Equal([]string{"bucket:photos"}, query["fp"])
+	assert.Equal([]string{"2023-05-01T10:00:00Z"}, query["timeStart"])
+	assert.Equal([]string{"secret"}, query["token"])
+
+	assert.Equal(int64(4), insight.Requests)
+	assert.Equal(int64(2), insight.ClientErrors)
+	assert.Equal(int64(1), insight.ServerErrors)
+	assert.Equal(0.75, insight.ErrorRate)
+	assert.False(insight.Truncated)
+	assert.Equal(&models.AccessInsightEntry{Name: "alice", Requests: 2, Errors: 1, ErrorRate: 0.5, LastSeen: "2023-05-02T10:00:03Z"}, insight.TopCallers[0])
+	// callers with the same requests are sorted by name, anonymous requests have no access key
+	assert.Len(insight.TopCallers, 2)
+	assert.Equal("anonymous", insight.TopCallers[1].Name)
+	assert.Equal("GetObject", insight.TopApis[0].Name)
+	assert.Equal(int64(3), insight.TopApis[0].Requests)
+	assert.Equal("10.0.0.1", insight.TopRemoteHosts[0].Name)
+
+	server.Close()
+	_, err = getBucketAccessInsight(server.URL, "secret", "photos", since, 2)
+	assert.Error(err)
+}
+
+func TestParseAccessInsightQuery(t *testing.T) {
+	assert := assert.New(t)
+	window, top, err := parseAccessInsightQuery(nil, nil)
+	assert.NoError(err)
+	assert.Equal(defaultAccessInsightWindow, window)
+	assert.Equal(defaultAccessInsightTop, top)
+
+	window, top, err = parseAccessInsightQuery(swag.String("1h"), swag.Int32(5))
+	assert.NoError(err)
+	assert.Equal(time.Hour, window)
+	assert.Equal(5, top)
+
+	_, _, err = parseAccessInsightQuery(swag.String("yesterday"), nil)
+	assert.ErrorIs(err, ErrInvalidAccessInsightQuery)
+	_, _, err = parseAccessInsightQuery(swag.String("1000h"), nil)
+	assert.ErrorIs(err, ErrInvalidAccessInsightQuery)
+	_, _, err = parseAccessInsightQuery(nil, swag.Int32(0))
+	assert.ErrorIs(err, ErrInvalidAccessInsightQuery)
+}
+
+func TestCanViewBucketAccessInsight(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return "", minio.ErrorResponse{Code: "AccessDenied"}
+	}
+	assert.False(canViewBucketAccessInsight(ctx, client, map[string][]string{}, "photos"))
+	assert.True(canViewBucketAccessInsight(ctx, client, map[string][]string{ConsoleResourceName: {string(iampolicy.HealthInfoAdminAction)}}, "photos"))
+
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return "", minio.ErrorResponse{Code: "NoSuchBucketPolicy"}
+	}
+	assert.True(canViewBucketAccessInsight(ctx, client, map[string][]string{}, "photos"))
+
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return "", errors.New("connection refused")
+	}
+	assert.False(canViewBucketAccessInsight(ctx, client, map[string][]string{}, "photos"))
+}
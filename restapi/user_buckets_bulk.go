@@ -0,0 +1,201 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+const (
+	// buckets processed at the same time by a bulk operation
+	bulkBucketConcurrency = 8
+	maxBulkBuckets        = 1000
+)
+
+// bulkBucketClients are the clients a bulk operation may need
+type bulkBucketClients struct {
+	client      MinioClient
+	adminClient MinioAdmin
+	// s3Client returns the mc client of a bucket, used to change its versioning
+	s3Client func(bucketName string) (MCClient, error)
+}
+
+func registerBucketBulkHandlers(api *operations.ConsoleAPI) {
+	// apply an operation to many buckets
+	api.BucketBulkBucketOperationHandler = bucketApi.BulkBucketOperationHandlerFunc(func(params bucketApi.BulkBucketOperationParams, session *models.Principal) middleware.Responder {
+		resp, err := getBulkBucketOperationResponse(session, params)
+		if err != nil {
+			return bucketApi.NewBulkBucketOperationDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewBulkBucketOperationOK().WithPayload(resp)
+	})
+}
+
+// validateBulkBucketOperation checks the request has what its operation needs and returns the buckets without repetitions
+func validateBulkBucketOperation(req *models.BulkBucketOperationRequest) ([]string, error) {
+	var buckets []string
+	for _, bucket := range req.Buckets {
+		if bucket = strings.TrimSpace(bucket); bucket != "" && !IsElementInArray(buckets, bucket) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	if len(buckets) == 0 || len(buckets) > maxBulkBuckets {
+		return nil, fmt.Errorf("%w: between 1 and %d buckets are required", ErrInvalidBulkBucketOperation, maxBulkBuckets)
+	}
+	switch *req.Operation {
+	case models.BulkBucketOperationRequestOperationSetTag:
+		if req.Tag == nil || req.Tag.Key == nil || strings.TrimSpace(*req.Tag.Key) == "" {
+			return nil, fmt.Errorf("%w: a tag key is required", ErrInvalidBulkBucketOperation)
+		}
+		if IsElementInArray(consoleManagedBucketTags, *req.Tag.Key) {
+			return nil, fmt.Errorf("%w: tag %s is managed by Console", ErrInvalidBulkBucketOperation, *req.Tag.Key)
+		}
+	case models.BulkBucketOperationRequestOperationSetQuota:
+		if req.Quota == nil || req.Quota.Enabled == nil {
+			return nil, fmt.Errorf("%w: a quota is required", ErrInvalidBulkBucketOperation)
+		}
+		if *req.Quota.Enabled {
+			if err := validateSoftQuota(req.Quota); err != nil {
+				return nil, err
+			}
+		}
+	case models.BulkBucketOperationRequestOperationApplyLifecycle:
+		if req.Lifecycle == nil {
+			return nil, fmt.Errorf("%w: a lifecycle rule is required", ErrInvalidBulkBucketOperation)
+		}
+	case models.BulkBucketOperationRequestOperationEnableVersioning:
+	default:
+		return nil, fmt.Errorf("%w: unsupported operation %s", ErrInvalidBulkBucketOperation, *req.Operation)
+	}
+	return buckets, nil
+}
+
+// setBucketTag adds or replaces a single tag, keeping the rest of the tags of the bucket
+func setBucketTag(ctx context.Context, client MinioClient, bucketName, key, value string) error {
+	tagMap, err := getBucketTagMap(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	tagMap[key] = value
+	bucketTags, err := tags.NewTags(tagMap, false)
+	if err != nil {
+		return err
+	}
+	return client.SetBucketTagging(ctx, bucketName, bucketTags)
+}
+
+// applyBulkBucketOperation runs the operation of the request on a single bucket
+func applyBulkBucketOperation(ctx context.Context, clients bulkBucketClients, bucketName string, req *models.BulkBucketOperationRequest) error {
+	switch *req.Operation {
+	case models.BulkBucketOperationRequestOperationSetTag:
+		return setBucketTag(ctx, clients.client, bucketName, *req.Tag.Key, req.Tag.Value)
+	case models.BulkBucketOperationRequestOperationSetQuota:
+		if err := setBucketQuota(ctx, clients.adminClient, &bucketName, req.Quota); err != nil {
+			return err
+		}
+		var softLimit int64
+		if *req.Quota.Enabled {
+			softLimit = req.Quota.SoftLimit
+		}
+		return setBucketSoftQuota(ctx, clients.client, bucketName, softLimit)
+	case models.BulkBucketOperationRequestOperationEnableVersioning:
+		s3Client, err := clients.s3Client(bucketName)
+		if err != nil {
+			return err
+		}
+		return doSetVersioning(s3Client, VersionEnable)
+	case models.BulkBucketOperationRequestOperationApplyLifecycle:
+		return addBucketLifecycle(ctx, clients.client, bucketApi.AddBucketLifecycleParams{BucketName: bucketName, Body: req.Lifecycle})
+	}
+	return fmt.Errorf("%w: unsupported operation %s", ErrInvalidBulkBucketOperation, *req.Operation)
+}
+
+// bulkBucketOperation applies the operation to every bucket of the request, a failure on a bucket
+// doesn't stop the others. Results are returned in the order of the request.
+func bulkBucketOperation(ctx context.Context, clients bulkBucketClients, req *models.BulkBucketOperationRequest) (*models.BulkBucketOperationResponse, error) {
+	buckets, err := validateBulkBucketOperation(req)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*models.BulkBucketOperationResult, len(buckets))
+	sem := make(chan struct{}, bulkBucketConcurrency)
+	var wg sync.WaitGroup
+	for i, bucket := range buckets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, bucket string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			result := &models.BulkBucketOperationResult{Bucket: bucket}
+			if err := applyBulkBucketOperation(ctx, clients, bucket, req); err != nil {
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}(i, bucket)
+	}
+	wg.Wait()
+
+	resp := &models.BulkBucketOperationResponse{Operation: *req.Operation, Results: results}
+	for _, result := range results {
+		if result.Error != "" {
+			resp.Failed++
+		} else {
+			resp.Succeeded++
+		}
+	}
+	return resp, nil
+}
+
+func getBulkBucketOperationResponse(session *models.Principal, params bucketApi.BulkBucketOperationParams) (*models.BulkBucketOperationResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	clients := bulkBucketClients{
+		client:      minioClient{client: mClient},
+		adminClient: AdminClient{Client: mAdmin},
+		s3Client: func(bucketName string) (MCClient, error) {
+			s3Client, err := newS3BucketClient(session, bucketName, "")
+			if err != nil {
+				return nil, err
+			}
+			return mcClient{client: s3Client}, nil
+		},
+	}
+	resp, err := bulkBucketOperation(ctx, clients, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,159 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/mc/pkg/probe"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkBucketOperationSetTag(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	clients := bulkBucketClients{client: minioClientMock{}}
+	getBucketTagging := minioGetBucketTaggingMock
+	defer func() { minioGetBucketTaggingMock = getBucketTagging }()
+
+	var mu sync.Mutex
+	stored := map[string]map[string]string{}
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		if bucketName == "broken" {
+			return nil, errors.New("access denied")
+		}
+		return tags.NewTags(map[string]string{"owner": bucketName}, false)
+	}
+	minioSetBucketTaggingMock = func(ctx context.Context, bucketName string, t *tags.Tags) error {
+		mu.Lock()
+		defer mu.Unlock()
+		stored[bucketName] = t.ToMap()
+		return nil
+	}
+
+	resp, err := bulkBucketOperation(ctx, clients, &models.BulkBucketOperationRequest{
+		Buckets:   []string{"a", "broken", "b", "a"},
+		Operation: swag.String(models.BulkBucketOperationRequestOperationSetTag),
+		Tag:       &models.BulkBucketTag{Key: swag.String("team"), Value: "storage"},
+	})
+	assert.NoError(err)
+	assert.Equal(int64(2), resp.Succeeded)
+	assert.Equal(int64(1), resp.Failed)
+	// results follow the order of the request, without repeated buckets
+	assert.Equal([]*models.BulkBucketOperationResult{
+		{Bucket: "a"},
+		{Bucket: "broken", Error: "access denied"},
+		{Bucket: "b"},
+	}, resp.Results)
+	// existing tags are kept
+	assert.Equal(map[string]string{"owner": "a", "team": "storage"}, stored["a"])
+	assert.Equal(map[string]string{"owner": "b", "team": "storage"}, stored["b"])
+}
+
+func TestBulkBucketOperationEnableVersioning(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	var mu sync.Mutex
+	var enabled []string
+	clients := bulkBucketClients{
+		s3Client: func(bucketName string) (MCClient, error) {
+			mu.Lock()
+			enabled = append(enabled, bucketName)
+			mu.Unlock()
+			return s3ClientMock{}, nil
+		},
+	}
+	minioSetVersioningMock = func(ctx context.Context, state string) *probe.Error {
+		return nil
+	}
+
+	resp, err := bulkBucketOperation(ctx, clients, &models.BulkBucketOperationRequest{
+		Buckets:   []string{"a", "b", "c"},
+		Operation: swag.String(models.BulkBucketOperationRequestOperationEnableVersioning),
+	})
+	assert.NoError(err)
+	assert.Equal(int64(3), resp.Succeeded)
+	assert.ElementsMatch([]string{"a", "b", "c"}, enabled)
+}
+
+func TestValidateBulkBucketOperation(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name string
+		req  *models.BulkBucketOperationRequest
+		err  error
+	}{
+		{
+			name: "no buckets",
+			req:  &models.BulkBucketOperationRequest{Buckets: []string{" "}, Operation: swag.String(models.BulkBucketOperationRequestOperationEnableVersioning)},
+			err:  ErrInvalidBulkBucketOperation,
+		},
+		{
+			name: "tag without key",
+			req:  &models.BulkBucketOperationRequest{Buckets: []string{"a"}, Operation: swag.String(models.BulkBucketOperationRequestOperationSetTag)},
+			err:  ErrInvalidBulkBucketOperation,
+		},
+		{
+			name: "console managed tag",
+			req: &models.BulkBucketOperationRequest{
+				Buckets:   []string{"a"},
+				Operation: swag.String(models.BulkBucketOperationRequestOperationSetTag),
+				Tag:       &models.BulkBucketTag{Key: swag.String(softQuotaTagKey), Value: "1"},
+			},
+			err: ErrInvalidBulkBucketOperation,
+		},
+		{
+			name: "quota without body",
+			req:  &models.BulkBucketOperationRequest{Buckets: []string{"a"}, Operation: swag.String(models.BulkBucketOperationRequestOperationSetQuota)},
+			err:  ErrInvalidBulkBucketOperation,
+		},
+		{
+			name: "soft limit above the quota",
+			req: &models.BulkBucketOperationRequest{
+				Buckets:   []string{"a"},
+				Operation: swag.String(models.BulkBucketOperationRequestOperationSetQuota),
+				Quota:     &models.SetBucketQuota{Enabled: swag.Bool(true), Amount: 10, SoftLimit: 20},
+			},
+			err: ErrInvalidSoftQuota,
+		},
+		{
+			name: "lifecycle without rule",
+			req:  &models.BulkBucketOperationRequest{Buckets: []string{"a"}, Operation: swag.String(models.BulkBucketOperationRequestOperationApplyLifecycle)},
+			err:  ErrInvalidBulkBucketOperation,
+		},
+		{
+			name: "valid",
+			req:  &models.BulkBucketOperationRequest{Buckets: []string{"a", "b"}, Operation: swag.String(models.BulkBucketOperationRequestOperationEnableVersioning)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateBulkBucketOperation(tt.req)
+			if tt.err != nil {
+				assert.ErrorIs(err, tt.err)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,474 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+)
+
+// bucketConfigSnapshotVersion is the version of the snapshot document produced by the export
+const bucketConfigSnapshotVersion = 1
+
+// error codes MinIO answers with when a bucket has no configuration of a kind
+var bucketConfigNotFoundCodes = []string{
+	"NoSuchBucketPolicy",
+	"NoSuchLifecycleConfiguration",
+	"ReplicationConfigurationNotFoundError",
+	"ServerSideEncryptionConfigurationNotFoundError",
+	"NoSuchTagSet",
+	"XMinioAdminNoSuchQuotaConfiguration",
+}
+
+func registerBucketConfigSnapshotHandlers(api *operations.ConsoleAPI) {
+	// export the configuration of a bucket
+	api.BucketExportBucketConfigHandler = bucketApi.ExportBucketConfigHandlerFunc(func(params bucketApi.ExportBucketConfigParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportBucketConfigResponse(session, params)
+		if err != nil {
+			return bucketApi.NewExportBucketConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewExportBucketConfigOK().WithPayload(resp)
+	})
+	// apply a configuration snapshot to a bucket
+	api.BucketImportBucketConfigHandler = bucketApi.ImportBucketConfigHandlerFunc(func(params bucketApi.ImportBucketConfigParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportBucketConfigResponse(session, params)
+		if err != nil {
+			return bucketApi.NewImportBucketConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewImportBucketConfigOK().WithPayload(resp)
+	})
+}
+
+// bucketConfigSection reads and applies one kind of configuration of a bucket
+type bucketConfigSection struct {
+	name string
+	// current returns the configuration of the bucket, nil when it has none
+	current func(ctx context.Context) (interface{}, error)
+	// export stores the configuration in the snapshot
+	export func(snapshot *models.BucketConfigSnapshot, value interface{})
+	// decode returns the configuration held by the snapshot, nil when it has none
+	decode func(snapshot *models.BucketConfigSnapshot) (interface{}, error)
+	apply  func(ctx context.Context, value interface{}) error
+}
+
+// isBucketConfigNotFound returns whether the error means the bucket has no configuration of a kind
+func isBucketConfigNotFound(err error) bool {
+	code := minio.ToErrorResponse(err).Code
+	if code == "" {
+		code = madmin.ToErrorResponse(err).Code
+	}
+	return IsElementInArray(bucketConfigNotFoundCodes, code)
+}
+
+// decodeSnapshotSection converts a free form section of the snapshot into the type minio-go uses for it
+func decodeSnapshotSection(section string, raw, target interface{}) error {
+	data, err := json.Marshal(raw)
+	if err == nil {
+		err = json.Unmarshal(data, target)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: invalid %s section: %v", ErrInvalidBucketConfigSnapshot, section, err)
+	}
+	return nil
+}
+
+// bucketConfigSections lists the configuration kinds in the order they are applied, object locking
+// goes first as it can't be changed once objects are retained
+func bucketConfigSections(client MinioClient, adminClient MinioAdmin, bucketName string) []bucketConfigSection {
+	return []bucketConfigSection{
+		{
+			name: "locking",
+			current: func(ctx context.Context) (interface{}, error) {
+				config, err := getBucketRetentionConfig(ctx, client, bucketName)
+				if err != nil || !config.ObjectLockingEnabled {
+					return nil, err
+				}
+				return config, nil
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Locking = value.(*models.GetBucketRetentionConfig)
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if snapshot.Locking == nil || !snapshot.Locking.ObjectLockingEnabled {
+					return nil, nil
+				}
+				return snapshot.Locking, nil
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				config := value.(*models.GetBucketRetentionConfig)
+				if config.Mode == "" {
+					return clearBucketRetentionConfig(ctx, client, bucketName)
+				}
+				return setBucketRetentionConfig(ctx, client, bucketName, config.Mode, config.Unit, &config.Validity)
+			},
+		},
+		{
+			name: "encryption",
+			current: func(ctx context.Context) (interface{}, error) {
+				config, err := client.getBucketEncryption(ctx, bucketName)
+				if err != nil || config == nil || len(config.Rules) == 0 {
+					return nil, err
+				}
+				return config, nil
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Encryption = value
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if snapshot.Encryption == nil {
+					return nil, nil
+				}
+				config := &sse.Configuration{}
+				if err := decodeSnapshotSection("encryption", snapshot.Encryption, config); err != nil {
+					return nil, err
+				}
+				return config, nil
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				return client.setBucketEncryption(ctx, bucketName, value.(*sse.Configuration))
+			},
+		},
+		{
+			name: "policy",
+			current: func(ctx context.Context) (interface{}, error) {
+				policy, err := client.getBucketPolicy(ctx, bucketName)
+				if err != nil || policy == "" {
+					return nil, err
+				}
+				return compactPolicy(policy)
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Policy = value.(string)
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if snapshot.Policy == "" {
+					return nil, nil
+				}
+				policy, err := compactPolicy(snapshot.Policy)
+				if err != nil {
+					return nil, fmt.Errorf("%w: the policy section isn't valid JSON", ErrInvalidBucketConfigSnapshot)
+				}
+				return policy, nil
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				return client.setBucketPolicyWithContext(ctx, bucketName, value.(string))
+			},
+		},
+		{
+			name: "lifecycle",
+			current: func(ctx context.Context) (interface{}, error) {
+				config, err := getCurrentLifecycle(ctx, client, bucketName)
+				if err != nil || len(config.Rules) == 0 {
+					return nil, err
+				}
+				return config, nil
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Lifecycle = value
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if snapshot.Lifecycle == nil {
+					return nil, nil
+				}
+				data, err := json.Marshal(snapshot.Lifecycle)
+				if err != nil {
+					return nil, fmt.Errorf("%w: invalid lifecycle section: %v", ErrInvalidBucketConfigSnapshot, err)
+				}
+				return parseLifecycleConfiguration(models.ImportBucketLifecycleRequestFormatJSON, string(data))
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				return client.setBucketLifecycle(ctx, bucketName, value.(*lifecycle.Configuration))
+			},
+		},
+		{
+			name: "tags",
+			current: func(ctx context.Context) (interface{}, error) {
+				bucketTags, err := client.GetBucketTagging(ctx, bucketName)
+				if err != nil || bucketTags == nil || len(bucketTags.ToMap()) == 0 {
+					return nil, err
+				}
+				return bucketTags.ToMap(), nil
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Tags = value.(map[string]string)
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if len(snapshot.Tags) == 0 {
+					return nil, nil
+				}
+				if _, err := tags.NewTags(snapshot.Tags, false); err != nil {
+					return nil, fmt.Errorf("%w: invalid tags section: %v", ErrInvalidBucketConfigSnapshot, err)
+				}
+				return snapshot.Tags, nil
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				bucketTags, err := tags.NewTags(value.(map[string]string), false)
+				if err != nil {
+					return err
+				}
+				return client.SetBucketTagging(ctx, bucketName, bucketTags)
+			},
+		},
+		{
+			name: "quota",
+			current: func(ctx context.Context) (interface{}, error) {
+				quota, err := adminClient.getBucketQuota(ctx, bucketName)
+				if err != nil || quota.Quota == 0 {
+					return nil, err
+				}
+				return &models.BucketQuota{Quota: int64(quota.Quota), Type: string(quota.Type)}, nil
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Quota = value.(*models.BucketQuota)
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if snapshot.Quota == nil || snapshot.Quota.Quota == 0 {
+					return nil, nil
+				}
+				if snapshot.Quota.Quota < 0 {
+					return nil, fmt.Errorf("%w: the quota can't be negative", ErrInvalidBucketConfigSnapshot)
+				}
+				quotaType := snapshot.Quota.Type
+				if quotaType == "" {
+					quotaType = string(madmin.HardQuota)
+				}
+				// only the hard limit is part of the quota, the soft limit is kept in the tags
+				return &models.BucketQuota{Quota: snapshot.Quota.Quota, Type: quotaType}, nil
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				quota := value.(*models.BucketQuota)
+				return adminClient.setBucketQuota(ctx, bucketName, &madmin.BucketQuota{Quota: uint64(quota.Quota), Type: madmin.QuotaType(quota.Type)})
+			},
+		},
+		{
+			name: "notifications",
+			current: func(ctx context.Context) (interface{}, error) {
+				config, err := client.getBucketNotification(ctx, bucketName)
+				if err != nil || len(config.QueueConfigs)+len(config.TopicConfigs)+len(config.LambdaConfigs) == 0 {
+					return nil, err
+				}
+				return &config, nil
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Notifications = value
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if snapshot.Notifications == nil {
+					return nil, nil
+				}
+				config := &notification.Configuration{}
+				if err := decodeSnapshotSection("notifications", snapshot.Notifications, config); err != nil {
+					return nil, err
+				}
+				return config, nil
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				return client.setBucketNotification(ctx, bucketName, *value.(*notification.Configuration))
+			},
+		},
+		{
+			name: "replication",
+			current: func(ctx context.Context) (interface{}, error) {
+				config, err := client.getBucketReplication(ctx, bucketName)
+				if err != nil || len(config.Rules) == 0 {
+					return nil, err
+				}
+				return &config, nil
+			},
+			export: func(snapshot *models.BucketConfigSnapshot, value interface{}) {
+				snapshot.Replication = value
+			},
+			decode: func(snapshot *models.BucketConfigSnapshot) (interface{}, error) {
+				if snapshot.Replication == nil {
+					return nil, nil
+				}
+				config := &replication.Config{}
+				if err := decodeSnapshotSection("replication", snapshot.Replication, config); err != nil {
+					return nil, err
+				}
+				return config, nil
+			},
+			apply: func(ctx context.Context, value interface{}) error {
+				return client.setBucketReplication(ctx, bucketName, *value.(*replication.Config))
+			},
+		},
+	}
+}
+
+// currentSection reads a section of the bucket, a missing configuration isn't an error
+func currentSection(ctx context.Context, section bucketConfigSection) (interface{}, error) {
+	value, err := section.current(ctx)
+	if err != nil {
+		if isBucketConfigNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read the %s configuration: %w", section.name, err)
+	}
+	return value, nil
+}
+
+// exportBucketConfig gathers every configuration of a bucket into a snapshot
+func exportBucketConfig(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string, now time.Time) (*models.BucketConfigSnapshot, error) {
+	snapshot := &models.BucketConfigSnapshot{
+		Version:    bucketConfigSnapshotVersion,
+		Bucket:     bucketName,
+		ExportedAt: now.UTC().Format(time.RFC3339),
+	}
+	for _, section := range bucketConfigSections(client, adminClient, bucketName) {
+		value, err := currentSection(ctx, section)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			section.export(snapshot, value)
+		}
+	}
+	return snapshot, nil
+}
+
+// compactPolicy drops the formatting of a policy so policies can be compared
+func compactPolicy(policy string) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(policy)); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// sameBucketConfig compares two configurations through their JSON representation
+func sameBucketConfig(a, b interface{}) (bool, error) {
+	dataA, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	dataB, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(dataA, dataB), nil
+}
+
+// importBucketConfig compares every section of the snapshot with the configuration of the bucket and,
+// unless it is a dry run, applies the ones that differ. Sections missing from the snapshot are left untouched.
+func importBucketConfig(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName string, req *models.BucketConfigImportRequest) (*models.BucketConfigImportResponse, error) {
+	snapshot := req.Snapshot
+	if snapshot == nil {
+		return nil, fmt.Errorf("%w: the snapshot is required", ErrInvalidBucketConfigSnapshot)
+	}
+	if snapshot.Version > bucketConfigSnapshotVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidBucketConfigSnapshot, snapshot.Version)
+	}
+	sections := bucketConfigSections(client, adminClient, bucketName)
+	// the whole snapshot is validated before anything is applied
+	incoming := make([]interface{}, len(sections))
+	for i, section := range sections {
+		value, err := section.decode(snapshot)
+		if err != nil {
+			return nil, err
+		}
+		incoming[i] = value
+	}
+
+	resp := &models.BucketConfigImportResponse{DryRun: req.DryRun, Sections: []*models.BucketConfigSectionChange{}}
+	failed := false
+	for i, section := range sections {
+		change := &models.BucketConfigSectionChange{Section: section.name, Action: models.BucketConfigSectionChangeActionSkip}
+		resp.Sections = append(resp.Sections, change)
+		if incoming[i] == nil {
+			continue
+		}
+		current, err := currentSection(ctx, section)
+		if err != nil {
+			change.Error = err.Error()
+			failed = true
+			continue
+		}
+		switch same, err := sameBucketConfig(current, incoming[i]); {
+		case err != nil:
+			return nil, err
+		case current == nil:
+			change.Action = models.BucketConfigSectionChangeActionCreate
+		case same:
+			change.Action = models.BucketConfigSectionChangeActionUnchanged
+			continue
+		default:
+			change.Action = models.BucketConfigSectionChangeActionUpdate
+		}
+		if req.DryRun {
+			continue
+		}
+		if err = section.apply(ctx, incoming[i]); err != nil {
+			change.Error = err.Error()
+			failed = true
+			continue
+		}
+		change.Applied = true
+	}
+	resp.Applied = !req.DryRun && !failed
+	return resp, nil
+}
+
+func getExportBucketConfigResponse(session *models.Principal, params bucketApi.ExportBucketConfigParams) (*models.BucketConfigSnapshot, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	snapshot, err := exportBucketConfig(ctx, minioClient{client: mClient}, AdminClient{Client: mAdmin}, params.BucketName, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return snapshot, nil
+}
+
+func getImportBucketConfigResponse(session *models.Principal, params bucketApi.ImportBucketConfigParams) (*models.BucketConfigImportResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp, err := importBucketConfig(ctx, minioClient{client: mClient}, AdminClient{Client: mAdmin}, params.BucketName, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
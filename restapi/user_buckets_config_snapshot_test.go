@@ -0,0 +1,177 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockBucketConfig makes the mocks answer with the configuration of a single bucket
+func mockBucketConfig(policy string, lfcCfg *lifecycle.Configuration, tagMap map[string]string, quota uint64, encryption *sse.Configuration) {
+	minioGetBucketObjectLockConfigMock = func(ctx context.Context, bucketName string) (*minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+		return nil, nil, nil, minio.ErrorResponse{Code: "ObjectLockConfigurationNotFoundError"}
+	}
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		if encryption == nil {
+			return nil, minio.ErrorResponse{Code: "ServerSideEncryptionConfigurationNotFoundError"}
+		}
+		return encryption, nil
+	}
+	minioGetBucketPolicyMock = func(bucketName string) (string, error) {
+		return policy, nil
+	}
+	minioGetLifecycleRulesMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		if lfcCfg == nil {
+			return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration"}
+		}
+		return lfcCfg, nil
+	}
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		if len(tagMap) == 0 {
+			return nil, minio.ErrorResponse{Code: "NoSuchTagSet"}
+		}
+		return tags.NewTags(tagMap, false)
+	}
+	minioGetBucketQuotaMock = func(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{Quota: quota, Type: madmin.HardQuota}, nil
+	}
+	minioGetBucketNotificationMock = func(ctx context.Context, bucketName string) (notification.Configuration, error) {
+		return notification.Configuration{}, nil
+	}
+	minioGetBucketReplicationMock = func(ctx context.Context, bucketName string) (replication.Config, error) {
+		return replication.Config{}, minio.ErrorResponse{Code: "ReplicationConfigurationNotFoundError"}
+	}
+}
+
+func Test_bucketConfigSnapshot(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	adminClient := AdminClientMock{}
+
+	sourceLifecycle := lifecycle.NewConfiguration()
+	sourceLifecycle.Rules = []lifecycle.Rule{{
+		ID:         "expire-logs",
+		Status:     "Enabled",
+		RuleFilter: lifecycle.Filter{Prefix: "logs/"},
+		Expiration: lifecycle.Expiration{Days: 30},
+	}}
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::source/*"]}]}`
+	mockBucketConfig(policy, sourceLifecycle, map[string]string{"team": "web"}, 1<<30, sse.NewConfigurationSSES3())
+
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	snapshot, err := exportBucketConfig(ctx, client, adminClient, "source", now)
+	assert.NoError(err)
+	assert.Equal(int32(bucketConfigSnapshotVersion), snapshot.Version)
+	assert.Equal("2023-06-01T12:00:00Z", snapshot.ExportedAt)
+	assert.Nil(snapshot.Locking)
+	assert.Nil(snapshot.Replication)
+	assert.Nil(snapshot.Notifications)
+	assert.Equal(int64(1<<30), snapshot.Quota.Quota)
+
+	// the snapshot travels as JSON
+	data, err := json.Marshal(snapshot)
+	assert.NoError(err)
+	received := &models.BucketConfigSnapshot{}
+	assert.NoError(json.Unmarshal(data, received))
+
+	// the target bucket only shares the tags
+	mockBucketConfig("", nil, map[string]string{"team": "web"}, 0, nil)
+	applied := map[string]bool{}
+	minioSetBucketPolicyWithContextMock = func(ctx context.Context, bucketName, policy string) error {
+		applied["policy"] = true
+		return nil
+	}
+	minioSetBucketLifecycleMock = func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+		applied["lifecycle"] = true
+		assert.Equal("expire-logs", config.Rules[0].ID)
+		return nil
+	}
+	minioSetBucketEncryptionMock = func(ctx context.Context, bucketName string, config *sse.Configuration) error {
+		applied["encryption"] = true
+		assert.Equal("AES256", config.Rules[0].Apply.SSEAlgorithm)
+		return nil
+	}
+	minioSetBucketQuotaMock = func(ctx context.Context, bucket string, quota *madmin.BucketQuota) error {
+		applied["quota"] = true
+		return errors.New("access denied")
+	}
+	minioSetBucketTaggingMock = func(ctx context.Context, bucketName string, tags *tags.Tags) error {
+		applied["tags"] = true
+		return nil
+	}
+
+	actions := func(resp *models.BucketConfigImportResponse) map[string]string {
+		result := map[string]string{}
+		for _, section := range resp.Sections {
+			result[section.Section] = section.Action
+		}
+		return result
+	}
+	expected := map[string]string{
+		"locking":       models.BucketConfigSectionChangeActionSkip,
+		"encryption":    models.BucketConfigSectionChangeActionCreate,
+		"policy":        models.BucketConfigSectionChangeActionCreate,
+		"lifecycle":     models.BucketConfigSectionChangeActionCreate,
+		"tags":          models.BucketConfigSectionChangeActionUnchanged,
+		"quota":         models.BucketConfigSectionChangeActionCreate,
+		"notifications": models.BucketConfigSectionChangeActionSkip,
+		"replication":   models.BucketConfigSectionChangeActionSkip,
+	}
+
+	resp, err := importBucketConfig(ctx, client, adminClient, "target", &models.BucketConfigImportRequest{Snapshot: received, DryRun: true})
+	assert.NoError(err)
+	assert.False(resp.Applied)
+	assert.Equal(expected, actions(resp))
+	assert.Empty(applied)
+
+	resp, err = importBucketConfig(ctx, client, adminClient, "target", &models.BucketConfigImportRequest{Snapshot: received})
+	assert.NoError(err)
+	assert.Equal(expected, actions(resp))
+	assert.Equal(map[string]bool{"policy": true, "lifecycle": true, "encryption": true, "quota": true}, applied)
+	// the failing section is reported, the rest are still applied
+	assert.False(resp.Applied)
+	for _, section := range resp.Sections {
+		if section.Section == "quota" {
+			assert.Equal("access denied", section.Error)
+			assert.False(section.Applied)
+		}
+		if section.Section == "policy" {
+			assert.True(section.Applied)
+		}
+	}
+
+	// invalid snapshots are rejected before anything is applied
+	_, err = importBucketConfig(ctx, client, adminClient, "target", &models.BucketConfigImportRequest{Snapshot: &models.BucketConfigSnapshot{Version: 2}})
+	assert.True(errors.Is(err, ErrInvalidBucketConfigSnapshot))
+	_, err = importBucketConfig(ctx, client, adminClient, "target", &models.BucketConfigImportRequest{Snapshot: &models.BucketConfigSnapshot{Policy: "{"}})
+	assert.True(errors.Is(err, ErrInvalidBucketConfigSnapshot))
+}
@@ -0,0 +1,250 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7"
+)
+
+// limits enforced by S3 on a CORS configuration
+const (
+	maxBucketCorsRules    = 100
+	maxBucketCorsIDLength = 255
+)
+
+var bucketCorsMethods = []string{http.MethodGet, http.MethodPut, http.MethodHead, http.MethodPost, http.MethodDelete}
+
+// bucketCorsConfiguration is the XML document of the ?cors sub-resource, this release of
+// minio-go doesn't include it
+type bucketCorsConfiguration struct {
+	XMLName xml.Name         `xml:"CORSConfiguration"`
+	Rules   []bucketCorsRule `xml:"CORSRule"`
+}
+
+type bucketCorsRule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+	ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+	MaxAgeSeconds  int64    `xml:"MaxAgeSeconds,omitempty"`
+}
+
+// bucketRequestFunc sends a signed request to a sub-resource of a bucket
+type bucketRequestFunc func(ctx context.Context, method, bucketName string, query url.Values, body []byte) ([]byte, error)
+
+// sessionBucketRequest signs the bucket requests with the credentials of the session
+func sessionBucketRequest(session *models.Principal) bucketRequestFunc {
+	return func(ctx context.Context, method, bucketName string, query url.Values, body []byte) ([]byte, error) {
+		minioURL, creds, err := sessionCluster(clusterRegistry(), session)
+		if err != nil {
+			return nil, err
+		}
+		return signedBucketRequest(ctx, GetConsoleHTTPClient(minioURL), minioURL,
+			creds, GetMinIORegion(), method, bucketName, query, body)
+	}
+}
+
+func registerBucketCorsHandlers(api *operations.ConsoleAPI) {
+	// get bucket CORS rules
+	api.BucketGetBucketCorsHandler = bucketApi.GetBucketCorsHandlerFunc(func(params bucketApi.GetBucketCorsParams, session *models.Principal) middleware.Responder {
+		resp, err := getBucketCorsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketCorsDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetBucketCorsOK().WithPayload(resp)
+	})
+	// replace bucket CORS rules
+	api.BucketPutBucketCorsHandler = bucketApi.PutBucketCorsHandlerFunc(func(params bucketApi.PutBucketCorsParams, session *models.Principal) middleware.Responder {
+		resp, err := getPutBucketCorsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewPutBucketCorsDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewPutBucketCorsOK().WithPayload(resp)
+	})
+	// remove bucket CORS rules
+	api.BucketDeleteBucketCorsHandler = bucketApi.DeleteBucketCorsHandlerFunc(func(params bucketApi.DeleteBucketCorsParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteBucketCorsResponse(session, params); err != nil {
+			return bucketApi.NewDeleteBucketCorsDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewDeleteBucketCorsNoContent()
+	})
+}
+
+func bucketCorsToModel(config bucketCorsConfiguration) *models.BucketCorsConfiguration {
+	result := &models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{}}
+	for _, rule := range config.Rules {
+		result.Rules = append(result.Rules, &models.BucketCorsRule{
+			ID:             rule.ID,
+			AllowedOrigins: rule.AllowedOrigins,
+			AllowedMethods: rule.AllowedMethods,
+			AllowedHeaders: rule.AllowedHeaders,
+			ExposeHeaders:  rule.ExposeHeaders,
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		})
+	}
+	return result
+}
+
+// trimCorsValues drops the blank entries of a list and trims the rest
+func trimCorsValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// validateCorsWildcards checks every value contains at most one wildcard, as S3 does
+func validateCorsWildcards(field string, values []string) error {
+	for _, value := range values {
+		if strings.Count(value, "*") > 1 {
+			return fmt.Errorf("%w: %s %q can contain at most one wildcard", ErrInvalidBucketCors, field, value)
+		}
+	}
+	return nil
+}
+
+// bucketCorsFromModel validates the requested rules and builds the configuration sent to MinIO
+func bucketCorsFromModel(body *models.BucketCorsConfiguration) (*bucketCorsConfiguration, error) {
+	if body == nil || len(body.Rules) == 0 {
+		return nil, fmt.Errorf("%w: at least one rule is required, remove the configuration instead", ErrInvalidBucketCors)
+	}
+	if len(body.Rules) > maxBucketCorsRules {
+		return nil, fmt.Errorf("%w: at most %d rules are allowed", ErrInvalidBucketCors, maxBucketCorsRules)
+	}
+	config := &bucketCorsConfiguration{}
+	for i, rule := range body.Rules {
+		if rule == nil {
+			return nil, fmt.Errorf("%w: rule %d is empty", ErrInvalidBucketCors, i+1)
+		}
+		item := bucketCorsRule{
+			ID:             strings.TrimSpace(rule.ID),
+			AllowedOrigins: trimCorsValues(rule.AllowedOrigins),
+			AllowedHeaders: trimCorsValues(rule.AllowedHeaders),
+			ExposeHeaders:  trimCorsValues(rule.ExposeHeaders),
+			MaxAgeSeconds:  rule.MaxAgeSeconds,
+		}
+		if len(item.ID) > maxBucketCorsIDLength {
+			return nil, fmt.Errorf("%w: rule %d has an ID longer than %d characters", ErrInvalidBucketCors, i+1, maxBucketCorsIDLength)
+		}
+		if len(item.AllowedOrigins) == 0 {
+			return nil, fmt.Errorf("%w: rule %d has no allowed origins", ErrInvalidBucketCors, i+1)
+		}
+		if err := validateCorsWildcards("origin", item.AllowedOrigins); err != nil {
+			return nil, err
+		}
+		if err := validateCorsWildcards("header", item.AllowedHeaders); err != nil {
+			return nil, err
+		}
+		for _, method := range trimCorsValues(rule.AllowedMethods) {
+			method = strings.ToUpper(method)
+			if !IsElementInArray(bucketCorsMethods, method) {
+				return nil, fmt.Errorf("%w: method %s is not supported, use one of %s", ErrInvalidBucketCors, method, strings.Join(bucketCorsMethods, ", "))
+			}
+			if !IsElementInArray(item.AllowedMethods, method) {
+				item.AllowedMethods = append(item.AllowedMethods, method)
+			}
+		}
+		if len(item.AllowedMethods) == 0 {
+			return nil, fmt.Errorf("%w: rule %d has no allowed methods", ErrInvalidBucketCors, i+1)
+		}
+		if item.MaxAgeSeconds < 0 {
+			return nil, fmt.Errorf("%w: rule %d has a negative max age", ErrInvalidBucketCors, i+1)
+		}
+		config.Rules = append(config.Rules, item)
+	}
+	return config, nil
+}
+
+func getBucketCors(ctx context.Context, request bucketRequestFunc, bucketName string) (*models.BucketCorsConfiguration, error) {
+	body, err := request(ctx, http.MethodGet, bucketName, url.Values{"cors": {""}}, nil)
+	if err != nil {
+		// a bucket without CORS rules isn't an error
+		if minio.ToErrorResponse(err).Code == "NoSuchCORSConfiguration" {
+			return bucketCorsToModel(bucketCorsConfiguration{}), nil
+		}
+		return nil, err
+	}
+	var config bucketCorsConfiguration
+	if err = xml.Unmarshal(body, &config); err != nil {
+		return nil, err
+	}
+	return bucketCorsToModel(config), nil
+}
+
+func putBucketCors(ctx context.Context, request bucketRequestFunc, bucketName string, body *models.BucketCorsConfiguration) (*models.BucketCorsConfiguration, error) {
+	config, err := bucketCorsFromModel(body)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := xml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = request(ctx, http.MethodPut, bucketName, url.Values{"cors": {""}}, payload); err != nil {
+		return nil, err
+	}
+	return bucketCorsToModel(*config), nil
+}
+
+func deleteBucketCors(ctx context.Context, request bucketRequestFunc, bucketName string) error {
+	_, err := request(ctx, http.MethodDelete, bucketName, url.Values{"cors": {""}}, nil)
+	return err
+}
+
+func getBucketCorsResponse(session *models.Principal, params bucketApi.GetBucketCorsParams) (*models.BucketCorsConfiguration, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	config, err := getBucketCors(ctx, sessionBucketRequest(session), params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return config, nil
+}
+
+func getPutBucketCorsResponse(session *models.Principal, params bucketApi.PutBucketCorsParams) (*models.BucketCorsConfiguration, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	config, err := putBucketCors(ctx, sessionBucketRequest(session), params.BucketName, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return config, nil
+}
+
+func getDeleteBucketCorsResponse(session *models.Principal, params bucketApi.DeleteBucketCorsParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := deleteBucketCors(ctx, sessionBucketRequest(session), params.BucketName); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
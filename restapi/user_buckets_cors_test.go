@@ -0,0 +1,119 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_bucketCorsFromModel(t *testing.T) {
+	assert := assert.New(t)
+
+	config, err := bucketCorsFromModel(&models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{
+		{
+			ID:             " assets ",
+			AllowedOrigins: []string{"https://*.example.com", " "},
+			AllowedMethods: []string{"get", "HEAD", "GET"},
+			AllowedHeaders: []string{"*"},
+			MaxAgeSeconds:  3600,
+		},
+	}})
+	assert.NoError(err)
+	assert.Equal("assets", config.Rules[0].ID)
+	assert.Equal([]string{"https://*.example.com"}, config.Rules[0].AllowedOrigins)
+	assert.Equal([]string{"GET", "HEAD"}, config.Rules[0].AllowedMethods)
+
+	tests := []struct {
+		name string
+		body *models.BucketCorsConfiguration
+	}{
+		{name: "no rules", body: &models.BucketCorsConfiguration{}},
+		{name: "no origins", body: &models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{{AllowedMethods: []string{"GET"}}}}},
+		{name: "no methods", body: &models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{{AllowedOrigins: []string{"*"}}}}},
+		{name: "unsupported method", body: &models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"PATCH"}}}}},
+		{name: "two wildcards", body: &models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{{AllowedOrigins: []string{"https://*.*.com"}, AllowedMethods: []string{"GET"}}}}},
+		{name: "negative max age", body: &models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{{AllowedOrigins: []string{"*"}, AllowedMethods: []string{"GET"}, MaxAgeSeconds: -1}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := bucketCorsFromModel(tt.body)
+			assert.True(errors.Is(err, ErrInvalidBucketCors), "%v", err)
+		})
+	}
+}
+
+func Test_bucketCorsRequests(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	var stored []byte
+	request := func(ctx context.Context, method, bucketName string, query url.Values, body []byte) ([]byte, error) {
+		assert.Equal("assets", bucketName)
+		_, ok := query["cors"]
+		assert.True(ok)
+		switch method {
+		case http.MethodPut:
+			stored = body
+		case http.MethodDelete:
+			stored = nil
+		case http.MethodGet:
+			if stored == nil {
+				return nil, minio.ErrorResponse{Code: "NoSuchCORSConfiguration", StatusCode: http.StatusNotFound}
+			}
+			return stored, nil
+		}
+		return nil, nil
+	}
+
+	// no configuration yet
+	config, err := getBucketCors(ctx, request, "assets")
+	assert.NoError(err)
+	assert.Empty(config.Rules)
+
+	_, err = putBucketCors(ctx, request, "assets", &models.BucketCorsConfiguration{Rules: []*models.BucketCorsRule{
+		{AllowedOrigins: []string{"https://app.example.com"}, AllowedMethods: []string{"GET", "PUT"}, ExposeHeaders: []string{"ETag"}},
+	}})
+	assert.NoError(err)
+	var sent bucketCorsConfiguration
+	assert.NoError(xml.Unmarshal(stored, &sent))
+	assert.Equal([]string{"ETag"}, sent.Rules[0].ExposeHeaders)
+
+	config, err = getBucketCors(ctx, request, "assets")
+	assert.NoError(err)
+	assert.Len(config.Rules, 1)
+	assert.Equal([]string{"https://app.example.com"}, config.Rules[0].AllowedOrigins)
+
+	assert.NoError(deleteBucketCors(ctx, request, "assets"))
+	config, err = getBucketCors(ctx, request, "assets")
+	assert.NoError(err)
+	assert.Empty(config.Rules)
+
+	// other errors are reported
+	failing := func(ctx context.Context, method, bucketName string, query url.Values, body []byte) ([]byte, error) {
+		return nil, minio.ErrorResponse{Code: "AccessDenied", StatusCode: http.StatusForbidden}
+	}
+	_, err = getBucketCors(ctx, failing, "assets")
+	assert.Equal("AccessDenied", minio.ToErrorResponse(err).Code)
+}
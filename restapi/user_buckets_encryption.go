@@ -0,0 +1,197 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+)
+
+// sseKMSContextTagKey is the bucket tag the SSE-KMS context is persisted in, bucket encryption
+// configurations only carry the key ID so the context travels along with the bucket tags
+const sseKMSContextTagKey = "console-sse-kms-context"
+
+// maxTagValueLength is the maximum length S3 accepts for a tag value
+const maxTagValueLength = 256
+
+func registerBucketEncryptionHandlers(api *operations.ConsoleAPI) {
+	// list the KMS keys a bucket can be encrypted with
+	api.BucketListBucketEncryptionKeysHandler = bucketApi.ListBucketEncryptionKeysHandlerFunc(func(params bucketApi.ListBucketEncryptionKeysParams, session *models.Principal) middleware.Responder {
+		response, err := getListBucketEncryptionKeysResponse(session, params)
+		if err != nil {
+			return bucketApi.NewListBucketEncryptionKeysDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewListBucketEncryptionKeysOK().WithPayload(response)
+	})
+}
+
+func getListBucketEncryptionKeysResponse(session *models.Principal, params bucketApi.ListBucketEncryptionKeysParams) (*models.KmsListKeysResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	pattern := "*"
+	if params.Pattern != nil && *params.Pattern != "" {
+		pattern = *params.Pattern
+	}
+	return listKeys(ctx, pattern, AdminClient{Client: mAdmin})
+}
+
+// validateBucketEncryptionRequest checks the key and context make sense for the requested encryption type
+func validateBucketEncryptionRequest(req *models.BucketEncryptionRequest) error {
+	if req == nil || req.EncType == nil {
+		return ErrInvalidEncryptionAlgorithm
+	}
+	if *req.EncType != models.BucketEncryptionTypeSseDashKms {
+		if req.CreateKey || len(req.KmsContext) > 0 {
+			return fmt.Errorf("%w: keys and contexts are only supported with sse-kms", ErrInvalidEncryptionContext)
+		}
+		return nil
+	}
+	if req.KmsKeyID == "" && req.CreateKey {
+		return fmt.Errorf("%w: a key name is needed to create a key", ErrInvalidEncryptionContext)
+	}
+	for k := range req.KmsContext {
+		if strings.TrimSpace(k) == "" {
+			return fmt.Errorf("%w: context keys can't be empty", ErrInvalidEncryptionContext)
+		}
+	}
+	_, err := encodeKMSContext(req.KmsContext)
+	return err
+}
+
+// encodeKMSContext serializes the context so it can be stored as a tag value, tag values don't
+// allow the characters JSON needs so it is base64 encoded, without the padding and the characters minio-go rejects
+func encodeKMSContext(kmsContext map[string]string) (string, error) {
+	if len(kmsContext) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(kmsContext)
+	if err != nil {
+		return "", err
+	}
+	value := base64.RawURLEncoding.EncodeToString(b)
+	if len(value) > maxTagValueLength {
+		return "", fmt.Errorf("%w: the context is too large", ErrInvalidEncryptionContext)
+	}
+	return value, nil
+}
+
+func decodeKMSContext(value string) (map[string]string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	var kmsContext map[string]string
+	if err = json.Unmarshal(b, &kmsContext); err != nil {
+		return nil, err
+	}
+	return kmsContext, nil
+}
+
+// getBucketKMSContext returns the SSE-KMS context stored for the bucket, nil if there is none
+func getBucketKMSContext(ctx context.Context, client MinioClient, bucket string) (map[string]string, error) {
+	tagMap, err := getBucketTagMap(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := tagMap[sseKMSContextTagKey]
+	if !ok {
+		return nil, nil
+	}
+	kmsContext, err := decodeKMSContext(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption context stored for bucket %s", bucket)
+	}
+	return kmsContext, nil
+}
+
+// setBucketKMSContext persists the SSE-KMS context of the bucket in its tags, an empty context removes it
+func setBucketKMSContext(ctx context.Context, client MinioClient, bucket string, kmsContext map[string]string) error {
+	value, err := encodeKMSContext(kmsContext)
+	if err != nil {
+		return err
+	}
+	return setBucketManagedTag(ctx, client, bucket, sseKMSContextTagKey, value)
+}
+
+// ensureKMSKey creates the key in the KMS unless it already exists
+func ensureKMSKey(ctx context.Context, adminClient MinioAdmin, keyID string) error {
+	keys, err := adminClient.listKeys(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Name == keyID {
+			return nil
+		}
+	}
+	return adminClient.createKey(ctx, keyID)
+}
+
+// configureBucketEncryption creates the KMS key if requested, sets the bucket default encryption and
+// stores the SSE-KMS context used by Console uploads
+func configureBucketEncryption(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucket string, req *models.BucketEncryptionRequest) error {
+	if err := validateBucketEncryptionRequest(req); err != nil {
+		return err
+	}
+	if req.CreateKey {
+		if err := ensureKMSKey(ctx, adminClient, req.KmsKeyID); err != nil {
+			return fmt.Errorf("error creating KMS key %s: %w", req.KmsKeyID, err)
+		}
+	}
+	if err := enableBucketEncryption(ctx, client, bucket, *req.EncType, req.KmsKeyID); err != nil {
+		return err
+	}
+	return setBucketKMSContext(ctx, client, bucket, req.KmsContext)
+}
+
+// getBucketUploadEncryption returns the SSE-KMS settings objects uploaded through Console are
+// encrypted with. MinIO applies the bucket default encryption on its own but it can't know the
+// context, so it is only needed when the bucket has one. Errors are tolerated, the upload falls
+// back to the bucket default encryption.
+func getBucketUploadEncryption(ctx context.Context, client MinioClient, bucket string) encrypt.ServerSide {
+	kmsContext, err := getBucketKMSContext(ctx, client, bucket)
+	if err != nil || len(kmsContext) == 0 {
+		return nil
+	}
+	config, err := client.getBucketEncryption(ctx, bucket)
+	if err != nil || config == nil || len(config.Rules) == 0 {
+		return nil
+	}
+	apply := config.Rules[0].Apply
+	if apply.SSEAlgorithm != "aws:kms" || apply.KmsMasterKeyID == "" {
+		return nil
+	}
+	sseKMS, err := encrypt.NewSSEKMS(strings.TrimPrefix(apply.KmsMasterKeyID, "arn:aws:kms:"), kmsContext)
+	if err != nil {
+		ErrorWithContext(ctx, fmt.Errorf("error preparing the encryption of %s uploads: %v", bucket, err))
+		return nil
+	}
+	return sseKMS
+}
@@ -0,0 +1,181 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/encrypt"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+// kmsKeysAdminMock keeps the keys in memory so key creation can be verified
+type kmsKeysAdminMock struct {
+	AdminClientMock
+	keys *[]string
+}
+
+func (ac kmsKeysAdminMock) listKeys(_ context.Context, pattern string) ([]madmin.KMSKeyInfo, error) {
+	var keys []madmin.KMSKeyInfo
+	for _, key := range *ac.keys {
+		if key == pattern {
+			keys = append(keys, madmin.KMSKeyInfo{Name: key})
+		}
+	}
+	return keys, nil
+}
+
+func (ac kmsKeysAdminMock) createKey(_ context.Context, key string) error {
+	*ac.keys = append(*ac.keys, key)
+	return nil
+}
+
+func Test_validateBucketEncryptionRequest(t *testing.T) {
+	s3 := models.BucketEncryptionTypeSseDashS3
+	kms := models.BucketEncryptionTypeSseDashKms
+	tests := []struct {
+		name    string
+		req     *models.BucketEncryptionRequest
+		wantErr bool
+	}{
+		{name: "sse-s3", req: &models.BucketEncryptionRequest{EncType: &s3}},
+		{name: "sse-kms with context", req: &models.BucketEncryptionRequest{EncType: &kms, KmsKeyID: "key", KmsContext: map[string]string{"app": "billing"}}},
+		{name: "sse-kms creating the key", req: &models.BucketEncryptionRequest{EncType: &kms, KmsKeyID: "key", CreateKey: true}},
+		{name: "sse-s3 with context", req: &models.BucketEncryptionRequest{EncType: &s3, KmsContext: map[string]string{"app": "billing"}}, wantErr: true},
+		{name: "key creation without a name", req: &models.BucketEncryptionRequest{EncType: &kms, CreateKey: true}, wantErr: true},
+		{name: "empty context key", req: &models.BucketEncryptionRequest{EncType: &kms, KmsKeyID: "key", KmsContext: map[string]string{" ": "billing"}}, wantErr: true},
+		{name: "context too large", req: &models.BucketEncryptionRequest{EncType: &kms, KmsKeyID: "key", KmsContext: map[string]string{"app": strings.Repeat("a", 200)}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBucketEncryptionRequest(tt.req)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidEncryptionContext)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_kmsContextTagValue(t *testing.T) {
+	assert := assert.New(t)
+	value, err := encodeKMSContext(map[string]string{"app": "billing", "env": "prod"})
+	assert.NoError(err)
+	// the value must be a valid tag value
+	_, err = tags.NewTags(map[string]string{sseKMSContextTagKey: value}, true)
+	assert.NoError(err)
+	kmsContext, err := decodeKMSContext(value)
+	assert.NoError(err)
+	assert.Equal(map[string]string{"app": "billing", "env": "prod"}, kmsContext)
+
+	value, err = encodeKMSContext(nil)
+	assert.NoError(err)
+	assert.Equal("", value)
+}
+
+func Test_configureBucketEncryption(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	getBucketTagging := minioGetBucketTaggingMock
+	defer func() { minioGetBucketTaggingMock = getBucketTagging }()
+
+	stored := map[string]string{"team": "storage"}
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return tags.NewTags(stored, true)
+	}
+	minioSetBucketTaggingMock = func(ctx context.Context, bucketName string, t *tags.Tags) error {
+		stored = t.ToMap()
+		return nil
+	}
+	var config *sse.Configuration
+	minioSetBucketEncryptionMock = func(ctx context.Context, bucketName string, c *sse.Configuration) error {
+		config = c
+		return nil
+	}
+
+	keys := []string{"existing"}
+	adminClient := kmsKeysAdminMock{keys: &keys}
+	kms := models.BucketEncryptionTypeSseDashKms
+
+	// the key is created and the context stored along with the user tags
+	err := configureBucketEncryption(ctx, client, adminClient, "bucket", &models.BucketEncryptionRequest{
+		EncType:    &kms,
+		KmsKeyID:   "billing-key",
+		CreateKey:  true,
+		KmsContext: map[string]string{"app": "billing"},
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"existing", "billing-key"}, keys)
+	assert.Equal("billing-key", config.Rules[0].Apply.KmsMasterKeyID)
+	assert.Equal("storage", stored["team"])
+	kmsContext, err := getBucketKMSContext(ctx, client, "bucket")
+	assert.NoError(err)
+	assert.Equal(map[string]string{"app": "billing"}, kmsContext)
+
+	// existing keys are not created again
+	err = configureBucketEncryption(ctx, client, adminClient, "bucket", &models.BucketEncryptionRequest{
+		EncType:   &kms,
+		KmsKeyID:  "existing",
+		CreateKey: true,
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"existing", "billing-key"}, keys)
+	_, hasContext := stored[sseKMSContextTagKey]
+	assert.False(hasContext)
+}
+
+func Test_getBucketUploadEncryption(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	getBucketTagging := minioGetBucketTaggingMock
+	defer func() { minioGetBucketTaggingMock = getBucketTagging }()
+
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		return sse.NewConfigurationSSEKMS("billing-key"), nil
+	}
+
+	// without a context the bucket default encryption is enough
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return tags.NewTags(map[string]string{}, true)
+	}
+	assert.Nil(getBucketUploadEncryption(ctx, client, "bucket"))
+
+	value, err := encodeKMSContext(map[string]string{"app": "billing"})
+	assert.NoError(err)
+	minioGetBucketTaggingMock = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		return tags.NewTags(map[string]string{sseKMSContextTagKey: value}, true)
+	}
+	sseKMS := getBucketUploadEncryption(ctx, client, "bucket")
+	if assert.NotNil(sseKMS) {
+		assert.Equal(encrypt.KMS, sseKMS.Type())
+	}
+
+	// the context is ignored once the bucket isn't encrypted with a KMS key
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		return sse.NewConfigurationSSES3(), nil
+	}
+	assert.Nil(getBucketUploadEncryption(ctx, client, "bucket"))
+}
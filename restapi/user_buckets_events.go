@@ -18,7 +18,11 @@ package restapi
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
@@ -35,7 +39,7 @@ func registerBucketEventsHandlers(api *operations.ConsoleAPI) {
 		if err != nil {
 			return bucketApi.NewListBucketEventsDefault(int(err.Code)).WithPayload(err)
 		}
-		return bucketApi.NewListBucketEventsOK().WithPayload(listBucketEventsResponse)
+		return withPageHeaders(bucketApi.NewListBucketEventsOK().WithPayload(listBucketEventsResponse), listBucketEventsResponse.Total, listBucketEventsResponse.NextCursor)
 	})
 	// create bucket event
 	api.BucketCreateBucketEventHandler = bucketApi.CreateBucketEventHandlerFunc(func(params bucketApi.CreateBucketEventParams, session *models.Principal) middleware.Responder {
@@ -51,6 +55,14 @@ func registerBucketEventsHandlers(api *operations.ConsoleAPI) {
 		}
 		return bucketApi.NewDeleteBucketEventNoContent()
 	})
+	// send a test event to the target of a bucket event
+	api.BucketTestBucketEventHandler = bucketApi.TestBucketEventHandlerFunc(func(params bucketApi.TestBucketEventParams, session *models.Principal) middleware.Responder {
+		result, err := getTestBucketEventResponse(session, params)
+		if err != nil {
+			return bucketApi.NewTestBucketEventDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewTestBucketEventOK().WithPayload(result)
+	})
 }
 
 // listBucketEvents fetches a list of all events set for a bucket and serializes them for a proper output
@@ -78,25 +90,8 @@ func listBucketEvents(client MinioClient, bucketName string) ([]*models.Notifica
 		}
 		return result
 	}
-	// part of implementation taken from minio/mc
-	// s3Client.ListNotificationConfigs()... to serialize configurations
-	getFilters := func(config notification.Config) (prefix, suffix string) {
-		if config.Filter == nil {
-			return
-		}
-		for _, filter := range config.Filter.S3Key.FilterRules {
-			if strings.ToLower(filter.Name) == "prefix" {
-				prefix = filter.Value
-			}
-			if strings.ToLower(filter.Name) == "suffix" {
-				suffix = filter.Value
-			}
-
-		}
-		return prefix, suffix
-	}
 	for _, embed := range bn.TopicConfigs {
-		prefix, suffix := getFilters(embed.Config)
+		prefix, suffix := notificationFilters(embed.Config)
 		configs = append(configs, &models.NotificationConfig{
 			ID:     embed.ID,
 			Arn:    swag.String(embed.Topic),
@@ -106,7 +101,7 @@ func listBucketEvents(client MinioClient, bucketName string) ([]*models.Notifica
 		})
 	}
 	for _, embed := range bn.QueueConfigs {
-		prefix, suffix := getFilters(embed.Config)
+		prefix, suffix := notificationFilters(embed.Config)
 		configs = append(configs, &models.NotificationConfig{
 			ID:     embed.ID,
 			Arn:    swag.String(embed.Queue),
@@ -116,7 +111,7 @@ func listBucketEvents(client MinioClient, bucketName string) ([]*models.Notifica
 		})
 	}
 	for _, embed := range bn.LambdaConfigs {
-		prefix, suffix := getFilters(embed.Config)
+		prefix, suffix := notificationFilters(embed.Config)
 		configs = append(configs, &models.NotificationConfig{
 			ID:     embed.ID,
 			Arn:    swag.String(embed.Lambda),
@@ -128,6 +123,24 @@ func listBucketEvents(client MinioClient, bucketName string) ([]*models.Notifica
 	return configs, nil
 }
 
+// notificationFilters returns the key filters of a bucket event
+// part of implementation taken from minio/mc
+// s3Client.ListNotificationConfigs()... to serialize configurations
+func notificationFilters(config notification.Config) (prefix, suffix string) {
+	if config.Filter == nil {
+		return
+	}
+	for _, filter := range config.Filter.S3Key.FilterRules {
+		if strings.ToLower(filter.Name) == "prefix" {
+			prefix = filter.Value
+		}
+		if strings.ToLower(filter.Name) == "suffix" {
+			suffix = filter.Value
+		}
+	}
+	return prefix, suffix
+}
+
 // getListBucketsResponse performs listBucketEvents() and serializes it to the handler's output
 func getListBucketEventsResponse(session *models.Principal, params bucketApi.ListBucketEventsParams) (*models.ListBucketEventsResponse, *models.Error) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
@@ -144,10 +157,18 @@ func getListBucketEventsResponse(session *models.Principal, params bucketApi.Lis
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	// the same event ID can be used on several targets
+	eventKey := func(i int) string { return bucketEvents[i].ID + "|" + *bucketEvents[i].Arn }
+	sort.Slice(bucketEvents, func(i, j int) bool { return eventKey(i) < eventKey(j) })
+	page, err := paginate(len(bucketEvents), eventKey, params.Cursor, params.PageSize)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	// serialize output
 	listBucketsResponse := &models.ListBucketEventsResponse{
-		Events: bucketEvents,
-		Total:  int64(len(bucketEvents)),
+		Events:     bucketEvents[page.start:page.end],
+		Total:      int64(page.total),
+		NextCursor: page.nextCursor,
 	}
 	return listBucketsResponse, nil
 }
@@ -241,3 +262,112 @@ func getDeleteBucketEventsResponse(session *models.Principal, params bucketApi.D
 	}
 	return nil
 }
+
+// bucketEventConfig returns the configuration of the bucket event delivering to the ARN
+func bucketEventConfig(ctx context.Context, client MinioClient, bucketName, arn string) (*notification.Config, error) {
+	bn, err := client.getBucketNotification(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	for i := range bn.QueueConfigs {
+		if bn.QueueConfigs[i].Queue == arn {
+			return &bn.QueueConfigs[i].Config, nil
+		}
+	}
+	for i := range bn.TopicConfigs {
+		if bn.TopicConfigs[i].Topic == arn {
+			return &bn.TopicConfigs[i].Config, nil
+		}
+	}
+	for i := range bn.LambdaConfigs {
+		if bn.LambdaConfigs[i].Lambda == arn {
+			return &bn.LambdaConfigs[i].Config, nil
+		}
+	}
+	return nil, ErrBucketEventNotFound
+}
+
+// testBucketEvent synthesizes the object created event the subscription would deliver, with a key
+// matching its filters, and reports whether the target accepted it. Console delivers the event itself,
+// that is only possible for webhooks, the other targets only get the status MinIO has for them.
+func testBucketEvent(ctx context.Context, client MinioClient, adminClient MinioAdmin, bucketName, arn string, httpClient func(endpoint string) *http.Client) (*models.BucketEventTestResult, error) {
+	config, err := bucketEventConfig(ctx, client, bucketName, arn)
+	if err != nil {
+		return nil, err
+	}
+	eventName := ""
+	for _, event := range config.Events {
+		if event == notification.ObjectCreatedAll {
+			eventName = string(notification.ObjectCreatedPut)
+			break
+		}
+		if eventName == "" && strings.HasPrefix(string(event), "s3:ObjectCreated:") {
+			eventName = string(event)
+		}
+	}
+	if eventName == "" {
+		return nil, fmt.Errorf("%w: the subscription doesn't include object created events", ErrInvalidBucketEventTest)
+	}
+	// ARNs look like arn:minio:sqs:<region>:<account_id>:<service>
+	parts := strings.Split(arn, ":")
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("%w: unexpected ARN %s", ErrInvalidBucketEventTest, arn)
+	}
+	service, accountID := models.NofiticationService(parts[5]), parts[4]
+
+	prefix, suffix := notificationFilters(*config)
+	result := &models.BucketEventTestResult{
+		Arn:       arn,
+		EventName: eventName,
+		Key:       prefix + "console-test-event" + suffix,
+	}
+	if result.TargetStatus, err = notificationEndpointStatus(ctx, adminClient, service, accountID); err != nil {
+		return nil, err
+	}
+	if service != models.NofiticationServiceWebhook {
+		result.Error = fmt.Sprintf("test events can't be delivered to %s targets, MinIO reports the target as %s", service, result.TargetStatus)
+		return result, nil
+	}
+	properties, err := notificationEndpointConfig(ctx, adminClient, service, accountID)
+	if err != nil {
+		return nil, err
+	}
+	endpoint := properties["endpoint"]
+	if endpoint == "" {
+		result.Error = "the webhook has no endpoint configured"
+		return result, nil
+	}
+	testCtx, cancel := context.WithTimeout(ctx, notificationTestTimeout)
+	defer cancel()
+	event := newNotificationTestEvent(time.Now(), eventName, bucketName, result.Key)
+	code, err := sendWebhookTestEvent(testCtx, httpClient(endpoint), endpoint, properties["auth_token"], event)
+	result.ResponseCode = int64(code)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+	result.Delivered = true
+	return result, nil
+}
+
+func getTestBucketEventResponse(session *models.Principal, params bucketApi.TestBucketEventParams) (*models.BucketEventTestResult, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := testBucketEvent(ctx, minioClient, adminClient, params.BucketName, params.Arn, GetConsoleHTTPClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
@@ -18,25 +18,37 @@ package restapi
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-openapi/swag"
 	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7/pkg/notification"
 	"github.com/stretchr/testify/assert"
 )
 
 // assigning mock at runtime instead of compile time
-var minioGetBucketNotificationMock func(ctx context.Context, bucketName string) (bucketNotification notification.Configuration, err error)
+var (
+	minioGetBucketNotificationMock func(ctx context.Context, bucketName string) (bucketNotification notification.Configuration, err error)
+	minioSetBucketNotificationMock func(ctx context.Context, bucketName string, config notification.Configuration) error
+)
 
 // mock function of getBucketNotification()
 func (mc minioClientMock) getBucketNotification(ctx context.Context, bucketName string) (bucketNotification notification.Configuration, err error) {
 	return minioGetBucketNotificationMock(ctx, bucketName)
 }
 
+// mock function of setBucketNotification()
+func (mc minioClientMock) setBucketNotification(ctx context.Context, bucketName string, config notification.Configuration) error {
+	return minioSetBucketNotificationMock(ctx, bucketName, config)
+}
+
 // // Mock mc S3Client functions ////
 var (
 	mcAddNotificationConfigMock    func(ctx context.Context, arn string, events []string, prefix, suffix string, ignoreExisting bool) *probe.Error
@@ -386,3 +398,92 @@ func TestListBucketEvents(t *testing.T) {
 		assert.Equal("error", err.Error())
 	}
 }
+
+func Test_testBucketEvent(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+	adminClient := AdminClientMock{}
+
+	var received notificationTestEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer server.Close()
+	httpClient := func(string) *http.Client { return server.Client() }
+
+	minioGetBucketNotificationMock = func(ctx context.Context, bucketName string) (notification.Configuration, error) {
+		return notification.Configuration{
+			QueueConfigs: []notification.QueueConfig{
+				{
+					Queue: "arn:minio:sqs::hook:webhook",
+					Config: notification.Config{
+						Events: []notification.EventType{notification.ObjectCreatedAll},
+						Filter: &notification.Filter{
+							S3Key: notification.S3Key{
+								FilterRules: []notification.FilterRule{
+									{Name: "prefix", Value: "images/"},
+									{Name: "suffix", Value: ".jpg"},
+								},
+							},
+						},
+					},
+				},
+				{
+					Queue: "arn:minio:sqs::deletes:webhook",
+					Config: notification.Config{
+						Events: []notification.EventType{notification.ObjectRemovedAll},
+					},
+				},
+				{
+					Queue: "arn:minio:sqs::stream:kafka",
+					Config: notification.Config{
+						Events: []notification.EventType{notification.ObjectCreatedPut},
+					},
+				},
+			},
+		}, nil
+	}
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`notify_webhook:hook endpoint="` + server.URL + `"`), nil
+	}
+	MinioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Services: madmin.Services{
+			Notifications: []map[string][]madmin.TargetIDStatus{{
+				"webhook": {{"hook": madmin.Status{Status: "online"}}},
+				"kafka":   {{"stream": madmin.Status{Status: "offline"}}},
+			}},
+		}}, nil
+	}
+
+	// the event key matches the subscription filters
+	result, err := testBucketEvent(ctx, client, adminClient, "bucket", "arn:minio:sqs::hook:webhook", httpClient)
+	assert.NoError(err)
+	assert.True(result.Delivered)
+	assert.Equal("online", result.TargetStatus)
+	assert.Equal("images/console-test-event.jpg", result.Key)
+	assert.Equal("bucket/images/console-test-event.jpg", received.Key)
+	assert.Equal("s3:ObjectCreated:Put", received.EventName)
+
+	// the target error is surfaced
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+	})
+	result, err = testBucketEvent(ctx, client, adminClient, "bucket", "arn:minio:sqs::hook:webhook", httpClient)
+	assert.NoError(err)
+	assert.False(result.Delivered)
+	assert.Equal(int64(http.StatusForbidden), result.ResponseCode)
+	assert.Contains(result.Error, "invalid signature")
+
+	// only webhooks receive the event
+	result, err = testBucketEvent(ctx, client, adminClient, "bucket", "arn:minio:sqs::stream:kafka", httpClient)
+	assert.NoError(err)
+	assert.False(result.Delivered)
+	assert.Equal("offline", result.TargetStatus)
+
+	_, err = testBucketEvent(ctx, client, adminClient, "bucket", "arn:minio:sqs::deletes:webhook", httpClient)
+	assert.ErrorIs(err, ErrInvalidBucketEventTest)
+
+	_, err = testBucketEvent(ctx, client, adminClient, "bucket", "arn:minio:sqs::missing:webhook", httpClient)
+	assert.ErrorIs(err, ErrBucketEventNotFound)
+}
@@ -103,30 +103,51 @@ func getBucketLifecycle(ctx context.Context, client MinioClient, bucketName stri
 			})
 		}
 
+		if rule.RuleFilter.Tag.Key != "" {
+			tags = append(tags, &models.LifecycleTag{
+				Key:   rule.RuleFilter.Tag.Key,
+				Value: rule.RuleFilter.Tag.Value,
+			})
+		}
+
 		rulePrefix := rule.RuleFilter.And.Prefix
 
 		if rulePrefix == "" {
 			rulePrefix = rule.RuleFilter.Prefix
 		}
 
+		sizeGreaterThan := rule.RuleFilter.And.ObjectSizeGreaterThan
+		if sizeGreaterThan == 0 {
+			sizeGreaterThan = rule.RuleFilter.ObjectSizeGreaterThan
+		}
+
+		sizeLessThan := rule.RuleFilter.And.ObjectSizeLessThan
+		if sizeLessThan == 0 {
+			sizeLessThan = rule.RuleFilter.ObjectSizeLessThan
+		}
+
 		rules = append(rules, &models.ObjectBucketLifecycle{
 			ID:     rule.ID,
 			Status: rule.Status,
 			Prefix: rulePrefix,
 			Expiration: &models.ExpirationResponse{
-				Date:                     rule.Expiration.Date.Format(time.RFC3339),
-				Days:                     int64(rule.Expiration.Days),
-				DeleteMarker:             rule.Expiration.DeleteMarker.IsEnabled(),
-				NoncurrentExpirationDays: int64(rule.NoncurrentVersionExpiration.NoncurrentDays),
+				Date:                              rule.Expiration.Date.Format(time.RFC3339),
+				Days:                              int64(rule.Expiration.Days),
+				DeleteMarker:                      rule.Expiration.DeleteMarker.IsEnabled(),
+				NoncurrentExpirationDays:          int64(rule.NoncurrentVersionExpiration.NoncurrentDays),
+				NewerNoncurrentExpirationVersions: int64(rule.NoncurrentVersionExpiration.NewerNoncurrentVersions),
 			},
 			Transition: &models.TransitionResponse{
-				Date:                     rule.Transition.Date.Format(time.RFC3339),
-				Days:                     int64(rule.Transition.Days),
-				StorageClass:             rule.Transition.StorageClass,
-				NoncurrentStorageClass:   rule.NoncurrentVersionTransition.StorageClass,
-				NoncurrentTransitionDays: int64(rule.NoncurrentVersionTransition.NoncurrentDays),
+				Date:                              rule.Transition.Date.Format(time.RFC3339),
+				Days:                              int64(rule.Transition.Days),
+				StorageClass:                      rule.Transition.StorageClass,
+				NoncurrentStorageClass:            rule.NoncurrentVersionTransition.StorageClass,
+				NoncurrentTransitionDays:          int64(rule.NoncurrentVersionTransition.NoncurrentDays),
+				NewerNoncurrentTransitionVersions: int64(rule.NoncurrentVersionTransition.NewerNoncurrentVersions),
 			},
-			Tags: tags,
+			Tags:                  tags,
+			ObjectSizeGreaterThan: sizeGreaterThan,
+			ObjectSizeLessThan:    sizeLessThan,
 		})
 	}
 
@@ -169,76 +190,147 @@ func addBucketLifecycle(ctx context.Context, client MinioClient, params bucketAp
 		}
 	}
 
-	id := xid.New().String()
+	opts, err := lifecycleOptionsFromRequest(xid.New().String(), params.Body)
+	if err != nil {
+		return err
+	}
+
+	newRule, merr := opts.ToILMRule()
+	if merr != nil {
+		return merr.ToGoError()
+	}
 
-	opts := ilm.LifecycleOptions{}
+	if err = applyLifecycleRuleExtensions(&newRule, params.Body); err != nil {
+		return err
+	}
 
-	// Verify if transition rule is requested
-	switch params.Body.Type {
+	lfcCfg.Rules = append(lfcCfg.Rules, newRule)
+
+	return client.setBucketLifecycle(ctx, params.BucketName, lfcCfg)
+}
+
+// lifecycleOptionsFromRequest translates a lifecycle rule request into mc ILM options. Transition rules may
+// also expire current and noncurrent versions, expiry rules cannot transition objects
+func lifecycleOptionsFromRequest(id string, body *models.AddBucketLifecycle) (ilm.LifecycleOptions, error) {
+	status := !body.Disable
+	opts := ilm.LifecycleOptions{
+		ID:                        id,
+		Prefix:                    &body.Prefix,
+		Status:                    &status,
+		Tags:                      &body.Tags,
+		ExpiredObjectDeleteMarker: &body.ExpiredObjectDeleteMarker,
+	}
+
+	switch body.Type {
 	case models.AddBucketLifecycleTypeTransition:
-		if params.Body.TransitionDays == 0 && params.Body.NoncurrentversionTransitionDays == 0 {
-			return errors.New("only one expiry configuration can be set (days or date)")
+		if body.TransitionDays == 0 && body.NoncurrentversionTransitionDays == 0 {
+			return opts, errors.New("you must select transition days or non-current transition days configuration")
 		}
 
-		status := !params.Body.Disable
-		opts = ilm.LifecycleOptions{
-			ID:                        id,
-			Prefix:                    &params.Body.Prefix,
-			Status:                    &status,
-			Tags:                      &params.Body.Tags,
-			ExpiredObjectDeleteMarker: &params.Body.ExpiredObjectDeleteMarker,
+		if body.TransitionDays > 0 {
+			tdays := strconv.Itoa(int(body.TransitionDays))
+			sclass := strings.ToUpper(body.StorageClass)
+			opts.TransitionDays = &tdays
+			opts.StorageClass = &sclass
 		}
 
-		if params.Body.NoncurrentversionTransitionDays > 0 {
-			noncurrentVersionTransitionDays := int(params.Body.NoncurrentversionTransitionDays)
-			noncurrentVersionTransitionStorageClass := strings.ToUpper(params.Body.NoncurrentversionTransitionStorageClass)
+		if body.NoncurrentversionTransitionDays > 0 {
+			noncurrentVersionTransitionDays := int(body.NoncurrentversionTransitionDays)
+			noncurrentVersionTransitionStorageClass := strings.ToUpper(body.NoncurrentversionTransitionStorageClass)
 			opts.NoncurrentVersionTransitionDays = &noncurrentVersionTransitionDays
 			opts.NoncurrentVersionTransitionStorageClass = &noncurrentVersionTransitionStorageClass
-		} else {
-			tdays := strconv.Itoa(int(params.Body.TransitionDays))
-			sclass := strings.ToUpper(params.Body.StorageClass)
-			opts.TransitionDays = &tdays
-			opts.StorageClass = &sclass
 		}
 	case models.AddBucketLifecycleTypeExpiry:
 		// Verify if expiry items are set
-		if params.Body.NoncurrentversionTransitionDays != 0 {
-			return errors.New("non current version Transition Days cannot be set when expiry is being configured")
+		if body.NoncurrentversionTransitionDays != 0 {
+			return opts, errors.New("non current version Transition Days cannot be set when expiry is being configured")
 		}
 
-		if params.Body.NoncurrentversionTransitionStorageClass != "" {
-			return errors.New("non current version Transition Storage Class cannot be set when expiry is being configured")
+		if body.NoncurrentversionTransitionStorageClass != "" {
+			return opts, errors.New("non current version Transition Storage Class cannot be set when expiry is being configured")
 		}
 
-		status := !params.Body.Disable
-		opts = ilm.LifecycleOptions{
-			ID:                        id,
-			Prefix:                    &params.Body.Prefix,
-			Status:                    &status,
-			Tags:                      &params.Body.Tags,
-			ExpiredObjectDeleteMarker: &params.Body.ExpiredObjectDeleteMarker,
-		}
-
-		if params.Body.NoncurrentversionExpirationDays > 0 {
-			days := int(params.Body.NoncurrentversionExpirationDays)
-			opts.NoncurrentVersionExpirationDays = &days
-		} else {
-			days := strconv.Itoa(int(params.Body.ExpiryDays))
-			opts.ExpiryDays = &days
+		if body.ExpiryDays == 0 && body.NoncurrentversionExpirationDays == 0 && !body.ExpiredObjectDeleteMarker {
+			return opts, errors.New("you must select expiry days, non-current expiration days or expired object delete marker configuration")
 		}
 	default:
 		// Non set, we return errors
-		return errors.New("no valid lifecycle configuration requested")
+		return opts, errors.New("no valid lifecycle configuration requested")
 	}
 
-	newRule, merr := opts.ToILMRule()
-	if merr != nil {
-		return merr.ToGoError()
+	if body.ExpiryDays > 0 {
+		days := strconv.Itoa(int(body.ExpiryDays))
+		opts.ExpiryDays = &days
 	}
 
-	lfcCfg.Rules = append(lfcCfg.Rules, newRule)
+	if body.NoncurrentversionExpirationDays > 0 {
+		days := int(body.NoncurrentversionExpirationDays)
+		opts.NoncurrentVersionExpirationDays = &days
+	}
 
-	return client.setBucketLifecycle(ctx, params.BucketName, lfcCfg)
+	return opts, nil
+}
+
+// applyLifecycleRuleExtensions sets the parts of the rule the mc ILM options don't cover: the newer noncurrent
+// versions to retain and the object size filters, which turn the rule filter into an And filter when combined
+// with other conditions
+func applyLifecycleRuleExtensions(rule *lifecycle.Rule, body *models.AddBucketLifecycle) error {
+	if body.NoncurrentversionExpirationNewerVersions < 0 || body.NoncurrentversionTransitionNewerVersions < 0 {
+		return errors.New("newer non-current versions cannot be negative")
+	}
+	if body.NoncurrentversionExpirationNewerVersions > 0 && rule.NoncurrentVersionExpiration.NoncurrentDays == 0 {
+		return errors.New("newer non-current versions require non-current expiration days")
+	}
+	if body.NoncurrentversionTransitionNewerVersions > 0 && rule.NoncurrentVersionTransition.NoncurrentDays == 0 {
+		return errors.New("newer non-current versions require non-current transition days")
+	}
+	rule.NoncurrentVersionExpiration.NewerNoncurrentVersions = int(body.NoncurrentversionExpirationNewerVersions)
+	rule.NoncurrentVersionTransition.NewerNoncurrentVersions = int(body.NoncurrentversionTransitionNewerVersions)
+
+	greaterThan := body.ObjectSizeGreaterThan
+	lessThan := body.ObjectSizeLessThan
+	if greaterThan < 0 || lessThan < 0 || (greaterThan > 0 && lessThan > 0 && greaterThan >= lessThan) {
+		return errors.New("invalid object size filter, the minimum size must be lower than the maximum size")
+	}
+
+	prefix := rule.RuleFilter.And.Prefix
+	if prefix == "" {
+		prefix = rule.RuleFilter.Prefix
+	}
+	ruleTags := append([]lifecycle.Tag{}, rule.RuleFilter.And.Tags...)
+	if rule.RuleFilter.Tag.Key != "" {
+		ruleTags = append(ruleTags, rule.RuleFilter.Tag)
+	}
+
+	conditions := len(ruleTags)
+	for _, set := range []bool{prefix != "", greaterThan > 0, lessThan > 0} {
+		if set {
+			conditions++
+		}
+	}
+
+	if conditions > 1 {
+		rule.RuleFilter = lifecycle.Filter{
+			And: lifecycle.And{
+				Prefix:                prefix,
+				Tags:                  ruleTags,
+				ObjectSizeGreaterThan: greaterThan,
+				ObjectSizeLessThan:    lessThan,
+			},
+		}
+		return nil
+	}
+
+	filter := lifecycle.Filter{
+		Prefix:                prefix,
+		ObjectSizeGreaterThan: greaterThan,
+		ObjectSizeLessThan:    lessThan,
+	}
+	if len(ruleTags) == 1 {
+		filter.Tag = ruleTags[0]
+	}
+	rule.RuleFilter = filter
+	return nil
 }
 
 // getAddBucketLifecycleResponse returns the response of adding a bucket lifecycle response
@@ -273,66 +365,26 @@ func editBucketLifecycle(ctx context.Context, client MinioClient, params bucketA
 		}
 	}
 
-	id := params.LifecycleID
-
-	opts := ilm.LifecycleOptions{}
-
-	// Verify if transition items are set
-	switch *params.Body.Type {
-	case models.UpdateBucketLifecycleTypeTransition:
-		if params.Body.TransitionDays == 0 && params.Body.NoncurrentversionTransitionDays == 0 {
-			return errors.New("you must select transition days or non-current transition days configuration")
-		}
-
-		status := !params.Body.Disable
-		opts = ilm.LifecycleOptions{
-			ID:                        id,
-			Prefix:                    &params.Body.Prefix,
-			Status:                    &status,
-			Tags:                      &params.Body.Tags,
-			ExpiredObjectDeleteMarker: &params.Body.ExpiredObjectDeleteMarker,
-		}
-
-		if params.Body.NoncurrentversionTransitionDays > 0 {
-			noncurrentVersionTransitionDays := int(params.Body.NoncurrentversionTransitionDays)
-			noncurrentVersionTransitionStorageClass := strings.ToUpper(params.Body.NoncurrentversionTransitionStorageClass)
-			opts.NoncurrentVersionTransitionDays = &noncurrentVersionTransitionDays
-			opts.NoncurrentVersionTransitionStorageClass = &noncurrentVersionTransitionStorageClass
-
-		} else {
-			tdays := strconv.Itoa(int(params.Body.TransitionDays))
-			sclass := strings.ToUpper(params.Body.StorageClass)
-			opts.TransitionDays = &tdays
-			opts.StorageClass = &sclass
-		}
-	case models.UpdateBucketLifecycleTypeExpiry: // Verify if expiry configuration is set
-		if params.Body.NoncurrentversionTransitionDays != 0 {
-			return errors.New("non current version Transition Days cannot be set when expiry is being configured")
-		}
-
-		if params.Body.NoncurrentversionTransitionStorageClass != "" {
-			return errors.New("non current version Transition Storage Class cannot be set when expiry is being configured")
-		}
-
-		status := !params.Body.Disable
-		opts = ilm.LifecycleOptions{
-			ID:                        id,
-			Prefix:                    &params.Body.Prefix,
-			Status:                    &status,
-			Tags:                      &params.Body.Tags,
-			ExpiredObjectDeleteMarker: &params.Body.ExpiredObjectDeleteMarker,
-		}
-
-		if params.Body.NoncurrentversionExpirationDays > 0 {
-			days := int(params.Body.NoncurrentversionExpirationDays)
-			opts.NoncurrentVersionExpirationDays = &days
-		} else {
-			days := strconv.Itoa(int(params.Body.ExpiryDays))
-			opts.ExpiryDays = &days
-		}
-	default:
-		// Non set, we return errors
-		return errors.New("no valid configuration requested")
+	body := &models.AddBucketLifecycle{
+		Type:                                     *params.Body.Type,
+		Prefix:                                   params.Body.Prefix,
+		Tags:                                     params.Body.Tags,
+		ExpiryDays:                               params.Body.ExpiryDays,
+		TransitionDays:                           params.Body.TransitionDays,
+		StorageClass:                             params.Body.StorageClass,
+		Disable:                                  params.Body.Disable,
+		ExpiredObjectDeleteMarker:                params.Body.ExpiredObjectDeleteMarker,
+		NoncurrentversionExpirationDays:          params.Body.NoncurrentversionExpirationDays,
+		NoncurrentversionExpirationNewerVersions: params.Body.NoncurrentversionExpirationNewerVersions,
+		NoncurrentversionTransitionDays:          params.Body.NoncurrentversionTransitionDays,
+		NoncurrentversionTransitionNewerVersions: params.Body.NoncurrentversionTransitionNewerVersions,
+		NoncurrentversionTransitionStorageClass:  params.Body.NoncurrentversionTransitionStorageClass,
+		ObjectSizeGreaterThan:                    params.Body.ObjectSizeGreaterThan,
+		ObjectSizeLessThan:                       params.Body.ObjectSizeLessThan,
+	}
+	opts, err := lifecycleOptionsFromRequest(params.LifecycleID, body)
+	if err != nil {
+		return err
 	}
 
 	var rule *lifecycle.Rule
@@ -351,6 +403,10 @@ func editBucketLifecycle(ctx context.Context, client MinioClient, params bucketA
 		return fmt.Errorf("Unable to generate new lifecycle rule: %v", err2.ToGoError())
 	}
 
+	if err = applyLifecycleRuleExtensions(rule, body); err != nil {
+		return err
+	}
+
 	return client.setBucketLifecycle(ctx, params.BucketName, lfcCfg)
 }
 
@@ -438,17 +494,21 @@ func addMultiBucketLifecycle(ctx context.Context, client MinioClient, params buc
 		remoteProc := make(chan MultiLifecycleResult)
 
 		lifecycleParams := models.AddBucketLifecycle{
-			Type:                                    *params.Body.Type,
-			StorageClass:                            params.Body.StorageClass,
-			TransitionDays:                          params.Body.TransitionDays,
-			Prefix:                                  params.Body.Prefix,
-			NoncurrentversionTransitionDays:         params.Body.NoncurrentversionTransitionDays,
-			NoncurrentversionTransitionStorageClass: params.Body.NoncurrentversionTransitionStorageClass,
-			NoncurrentversionExpirationDays:         params.Body.NoncurrentversionExpirationDays,
-			Tags:                                    params.Body.Tags,
-			ExpiryDays:                              params.Body.ExpiryDays,
-			Disable:                                 false,
-			ExpiredObjectDeleteMarker:               params.Body.ExpiredObjectDeleteMarker,
+			Type:                                     *params.Body.Type,
+			StorageClass:                             params.Body.StorageClass,
+			TransitionDays:                           params.Body.TransitionDays,
+			Prefix:                                   params.Body.Prefix,
+			NoncurrentversionTransitionDays:          params.Body.NoncurrentversionTransitionDays,
+			NoncurrentversionTransitionStorageClass:  params.Body.NoncurrentversionTransitionStorageClass,
+			NoncurrentversionExpirationDays:          params.Body.NoncurrentversionExpirationDays,
+			NoncurrentversionExpirationNewerVersions: params.Body.NoncurrentversionExpirationNewerVersions,
+			NoncurrentversionTransitionNewerVersions: params.Body.NoncurrentversionTransitionNewerVersions,
+			ObjectSizeGreaterThan:                    params.Body.ObjectSizeGreaterThan,
+			ObjectSizeLessThan:                       params.Body.ObjectSizeLessThan,
+			Tags:                                     params.Body.Tags,
+			ExpiryDays:                               params.Body.ExpiryDays,
+			Disable:                                  false,
+			ExpiredObjectDeleteMarker:                params.Body.ExpiredObjectDeleteMarker,
 		}
 
 		go func() {
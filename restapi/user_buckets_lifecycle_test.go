@@ -368,3 +368,116 @@ func TestDeleteLifecycleRule(t *testing.T) {
 
 	assert.Equal(errors.New("no rules available to delete"), err3, fmt.Sprintf("Failed on %s: Error returned", function))
 }
+
+func Test_lifecycleOptionsFromRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    *models.AddBucketLifecycle
+		wantErr bool
+	}{
+		{
+			name: "transition with expiration of noncurrent versions",
+			body: &models.AddBucketLifecycle{
+				Type:                            models.AddBucketLifecycleTypeTransition,
+				TransitionDays:                  30,
+				StorageClass:                    "warm",
+				NoncurrentversionExpirationDays: 90,
+			},
+		},
+		{
+			name: "transition without days",
+			body: &models.AddBucketLifecycle{
+				Type:         models.AddBucketLifecycleTypeTransition,
+				StorageClass: "warm",
+			},
+			wantErr: true,
+		},
+		{
+			name: "expiry of expired delete markers only",
+			body: &models.AddBucketLifecycle{
+				Type:                      models.AddBucketLifecycleTypeExpiry,
+				ExpiredObjectDeleteMarker: true,
+			},
+		},
+		{
+			name:    "expiry without anything to expire",
+			body:    &models.AddBucketLifecycle{Type: models.AddBucketLifecycleTypeExpiry},
+			wantErr: true,
+		},
+		{
+			name: "expiry with noncurrent transition",
+			body: &models.AddBucketLifecycle{
+				Type:                            models.AddBucketLifecycleTypeExpiry,
+				ExpiryDays:                      10,
+				NoncurrentversionTransitionDays: 5,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := lifecycleOptionsFromRequest("rule", tt.body)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "rule", opts.ID)
+			if tt.body.TransitionDays > 0 {
+				assert.Equal(t, "WARM", *opts.StorageClass)
+			}
+			if tt.body.NoncurrentversionExpirationDays > 0 {
+				assert.Equal(t, int(tt.body.NoncurrentversionExpirationDays), *opts.NoncurrentVersionExpirationDays)
+			}
+		})
+	}
+}
+
+func Test_applyLifecycleRuleExtensions(t *testing.T) {
+	assert := assert.New(t)
+
+	// a size filter alone stays a plain filter
+	rule := lifecycle.Rule{ID: "rule"}
+	err := applyLifecycleRuleExtensions(&rule, &models.AddBucketLifecycle{ObjectSizeGreaterThan: 1024})
+	assert.NoError(err)
+	assert.Equal(lifecycle.Filter{ObjectSizeGreaterThan: 1024}, rule.RuleFilter)
+
+	// combined with a prefix and a tag it becomes an And filter
+	rule = lifecycle.Rule{
+		ID:         "rule",
+		RuleFilter: lifecycle.Filter{And: lifecycle.And{Prefix: "logs/", Tags: []lifecycle.Tag{{Key: "tier", Value: "cold"}}}},
+	}
+	err = applyLifecycleRuleExtensions(&rule, &models.AddBucketLifecycle{ObjectSizeGreaterThan: 1024, ObjectSizeLessThan: 4096})
+	assert.NoError(err)
+	assert.Equal(lifecycle.Filter{And: lifecycle.And{
+		Prefix:                "logs/",
+		Tags:                  []lifecycle.Tag{{Key: "tier", Value: "cold"}},
+		ObjectSizeGreaterThan: 1024,
+		ObjectSizeLessThan:    4096,
+	}}, rule.RuleFilter)
+
+	// removing the size filter collapses the And filter back
+	rule = lifecycle.Rule{
+		ID:         "rule",
+		RuleFilter: lifecycle.Filter{And: lifecycle.And{Prefix: "logs/", ObjectSizeGreaterThan: 1024}},
+	}
+	err = applyLifecycleRuleExtensions(&rule, &models.AddBucketLifecycle{})
+	assert.NoError(err)
+	assert.Equal(lifecycle.Filter{Prefix: "logs/"}, rule.RuleFilter)
+
+	// invalid size range
+	err = applyLifecycleRuleExtensions(&lifecycle.Rule{}, &models.AddBucketLifecycle{ObjectSizeGreaterThan: 4096, ObjectSizeLessThan: 1024})
+	assert.Error(err)
+
+	// newer noncurrent versions
+	rule = lifecycle.Rule{
+		ID:                          "rule",
+		NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 30},
+	}
+	err = applyLifecycleRuleExtensions(&rule, &models.AddBucketLifecycle{NoncurrentversionExpirationNewerVersions: 3})
+	assert.NoError(err)
+	assert.Equal(3, rule.NoncurrentVersionExpiration.NewerNoncurrentVersions)
+
+	err = applyLifecycleRuleExtensions(&lifecycle.Rule{}, &models.AddBucketLifecycle{NoncurrentversionTransitionNewerVersions: 3})
+	assert.Error(err)
+}
@@ -0,0 +1,246 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/rs/xid"
+)
+
+// maxLifecycleRules is the maximum number of rules S3 accepts in a lifecycle configuration
+const maxLifecycleRules = 1000
+
+func registerBucketLifecycleTransferHandlers(api *operations.ConsoleAPI) {
+	api.BucketExportBucketLifecycleHandler = bucketApi.ExportBucketLifecycleHandlerFunc(func(params bucketApi.ExportBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportBucketLifecycleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewExportBucketLifecycleDefault(int(err.Code)).WithPayload(err)
+		}
+		return resp
+	})
+	api.BucketImportBucketLifecycleHandler = bucketApi.ImportBucketLifecycleHandlerFunc(func(params bucketApi.ImportBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportBucketLifecycleResponse(session, params)
+		if err != nil {
+			return bucketApi.NewImportBucketLifecycleDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewImportBucketLifecycleOK().WithPayload(resp)
+	})
+}
+
+func getExportBucketLifecycleResponse(session *models.Principal, params bucketApi.ExportBucketLifecycleParams) (middleware.Responder, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	format := models.ImportBucketLifecycleRequestFormatJSON
+	if params.Format != nil {
+		format = *params.Format
+	}
+	data, err := exportBucketLifecycle(ctx, minioClient, params.BucketName, format)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	contentType := "application/json"
+	if format == models.ImportBucketLifecycleRequestFormatXML {
+		contentType = "application/xml"
+	}
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s-lifecycle.%s\"", params.BucketName, format))
+		if _, err := w.Write(data); err != nil {
+			LogError("Unable to write the lifecycle configuration: %v", err)
+		}
+	}), nil
+}
+
+// exportBucketLifecycle serializes the whole lifecycle configuration of a bucket, a bucket without one
+// exports an empty configuration so it can be used to clear other buckets
+func exportBucketLifecycle(ctx context.Context, client MinioClient, bucketName, format string) ([]byte, error) {
+	lfcCfg, err := getCurrentLifecycle(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	switch format {
+	case models.ImportBucketLifecycleRequestFormatXML:
+		data, err := xml.MarshalIndent(lfcCfg, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte(xml.Header), data...), nil
+	case models.ImportBucketLifecycleRequestFormatJSON:
+		return json.MarshalIndent(lfcCfg, "", "  ")
+	default:
+		return nil, fmt.Errorf("unsupported lifecycle format %s", format)
+	}
+}
+
+func getImportBucketLifecycleResponse(session *models.Principal, params bucketApi.ImportBucketLifecycleParams) (*models.ImportBucketLifecycleResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	resp, err := importBucketLifecycle(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+// importBucketLifecycle validates the configuration, diffs it against the current one and, unless it is a
+// dry run, replaces the lifecycle configuration of the bucket with it
+func importBucketLifecycle(ctx context.Context, client MinioClient, bucketName string, req *models.ImportBucketLifecycleRequest) (*models.ImportBucketLifecycleResponse, error) {
+	incoming, err := parseLifecycleConfiguration(*req.Format, *req.Configuration)
+	if err != nil {
+		return nil, err
+	}
+	current, err := getCurrentLifecycle(ctx, client, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := diffLifecycleConfigurations(current, incoming)
+	if err != nil {
+		return nil, err
+	}
+	resp.Rules = int64(len(incoming.Rules))
+	if req.DryRun {
+		return resp, nil
+	}
+	if err := client.setBucketLifecycle(ctx, bucketName, incoming); err != nil {
+		return nil, err
+	}
+	resp.Applied = true
+	return resp, nil
+}
+
+// getCurrentLifecycle returns the lifecycle configuration of the bucket, empty if it has none
+func getCurrentLifecycle(ctx context.Context, client MinioClient, bucketName string) (*lifecycle.Configuration, error) {
+	lfcCfg, err := client.getLifecycleRules(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return lifecycle.NewConfiguration(), nil
+		}
+		return nil, err
+	}
+	if lfcCfg == nil {
+		return lifecycle.NewConfiguration(), nil
+	}
+	return lfcCfg, nil
+}
+
+// parseLifecycleConfiguration decodes and validates a lifecycle configuration, rules without an ID get one
+func parseLifecycleConfiguration(format, data string) (*lifecycle.Configuration, error) {
+	lfcCfg := lifecycle.NewConfiguration()
+	var err error
+	switch format {
+	case models.ImportBucketLifecycleRequestFormatXML:
+		err = xml.Unmarshal([]byte(data), lfcCfg)
+	case models.ImportBucketLifecycleRequestFormatJSON:
+		err = json.Unmarshal([]byte(data), lfcCfg)
+	default:
+		err = fmt.Errorf("unsupported format %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLifecycleConfiguration, err)
+	}
+
+	if len(lfcCfg.Rules) > maxLifecycleRules {
+		return nil, fmt.Errorf("%w: more than %d rules", ErrInvalidLifecycleConfiguration, maxLifecycleRules)
+	}
+	ids := map[string]bool{}
+	for i := range lfcCfg.Rules {
+		rule := &lfcCfg.Rules[i]
+		if rule.ID == "" {
+			rule.ID = xid.New().String()
+		}
+		if ids[rule.ID] {
+			return nil, fmt.Errorf("%w: duplicated rule ID %s", ErrInvalidLifecycleConfiguration, rule.ID)
+		}
+		ids[rule.ID] = true
+		if rule.Status != "Enabled" && rule.Status != "Disabled" {
+			return nil, fmt.Errorf("%w: rule %s has an invalid status %q", ErrInvalidLifecycleConfiguration, rule.ID, rule.Status)
+		}
+	}
+	return lfcCfg, nil
+}
+
+// diffLifecycleConfigurations classifies the rules by ID into added, removed, changed and unchanged
+func diffLifecycleConfigurations(current, incoming *lifecycle.Configuration) (*models.ImportBucketLifecycleResponse, error) {
+	resp := &models.ImportBucketLifecycleResponse{
+		Added:     []string{},
+		Removed:   []string{},
+		Changed:   []string{},
+		Unchanged: []string{},
+	}
+	currentRules := map[string][]byte{}
+	for _, rule := range current.Rules {
+		data, err := json.Marshal(rule)
+		if err != nil {
+			return nil, err
+		}
+		currentRules[rule.ID] = data
+	}
+	incomingIDs := map[string]bool{}
+	for _, rule := range incoming.Rules {
+		incomingIDs[rule.ID] = true
+		data, err := json.Marshal(rule)
+		if err != nil {
+			return nil, err
+		}
+		existing, ok := currentRules[rule.ID]
+		switch {
+		case !ok:
+			resp.Added = append(resp.Added, rule.ID)
+		case bytes.Equal(existing, data):
+			resp.Unchanged = append(resp.Unchanged, rule.ID)
+		default:
+			resp.Changed = append(resp.Changed, rule.ID)
+		}
+	}
+	for id := range currentRules {
+		if !incomingIDs[id] {
+			resp.Removed = append(resp.Removed, id)
+		}
+	}
+	sort.Strings(resp.Removed)
+	return resp, nil
+}
@@ -0,0 +1,140 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_exportImportBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+
+	current := &lifecycle.Configuration{
+		Rules: []lifecycle.Rule{
+			{
+				ID:         "expire-logs",
+				Status:     "Enabled",
+				Expiration: lifecycle.Expiration{Days: 30},
+				RuleFilter: lifecycle.Filter{Prefix: "logs/"},
+			},
+			{
+				ID:         "expire-tmp",
+				Status:     "Enabled",
+				Expiration: lifecycle.Expiration{Days: 1},
+				RuleFilter: lifecycle.Filter{Prefix: "tmp/"},
+			},
+		},
+	}
+	minioGetLifecycleRulesMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return current, nil
+	}
+	var applied *lifecycle.Configuration
+	minioSetBucketLifecycleMock = func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+		applied = config
+		return nil
+	}
+
+	for _, format := range []string{models.ImportBucketLifecycleRequestFormatJSON, models.ImportBucketLifecycleRequestFormatXML} {
+		// an exported configuration imports back without changes
+		data, err := exportBucketLifecycle(ctx, client, "bucket", format)
+		assert.NoError(err)
+		configuration := string(data)
+		applied = nil
+		resp, err := importBucketLifecycle(ctx, client, "bucket", &models.ImportBucketLifecycleRequest{
+			Format:        &format,
+			Configuration: &configuration,
+			DryRun:        true,
+		})
+		assert.NoError(err, format)
+		assert.False(resp.Applied)
+		assert.Nil(applied)
+		assert.Equal(int64(2), resp.Rules)
+		assert.Equal([]string{"expire-logs", "expire-tmp"}, resp.Unchanged, format)
+		assert.Empty(resp.Added)
+		assert.Empty(resp.Changed)
+		assert.Empty(resp.Removed)
+	}
+
+	// a configuration that adds, changes and removes rules
+	format := models.ImportBucketLifecycleRequestFormatXML
+	configuration := `<LifecycleConfiguration>
+  <Rule><ID>expire-logs</ID><Status>Enabled</Status><Filter><Prefix>logs/</Prefix></Filter><Expiration><Days>60</Days></Expiration></Rule>
+  <Rule><Status>Enabled</Status><Filter><Prefix>cache/</Prefix></Filter><Expiration><Days>7</Days></Expiration></Rule>
+</LifecycleConfiguration>`
+	resp, err := importBucketLifecycle(ctx, client, "bucket", &models.ImportBucketLifecycleRequest{
+		Format:        &format,
+		Configuration: &configuration,
+	})
+	assert.NoError(err)
+	assert.True(resp.Applied)
+	assert.Equal([]string{"expire-logs"}, resp.Changed)
+	assert.Equal([]string{"expire-tmp"}, resp.Removed)
+	assert.Len(resp.Added, 1)
+	assert.NotEmpty(resp.Added[0])
+	assert.Len(applied.Rules, 2)
+
+	// a bucket without lifecycle configuration
+	minioGetLifecycleRulesMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration", StatusCode: http.StatusNotFound}
+	}
+	resp, err = importBucketLifecycle(ctx, client, "bucket", &models.ImportBucketLifecycleRequest{
+		Format:        &format,
+		Configuration: &configuration,
+		DryRun:        true,
+	})
+	assert.NoError(err)
+	assert.Len(resp.Added, 2)
+}
+
+func Test_parseLifecycleConfiguration(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   string
+	}{
+		{
+			name:   "malformed json",
+			format: models.ImportBucketLifecycleRequestFormatJSON,
+			data:   `{"Rules": [`,
+		},
+		{
+			name:   "duplicated ids",
+			format: models.ImportBucketLifecycleRequestFormatJSON,
+			data:   `{"Rules": [{"ID": "a", "Status": "Enabled"}, {"ID": "a", "Status": "Enabled"}]}`,
+		},
+		{
+			name:   "invalid status",
+			format: models.ImportBucketLifecycleRequestFormatXML,
+			data:   `<LifecycleConfiguration><Rule><ID>a</ID><Status>On</Status></Rule></LifecycleConfiguration>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseLifecycleConfiguration(tt.format, tt.data)
+			assert.ErrorIs(t, err, ErrInvalidLifecycleConfiguration)
+		})
+	}
+}
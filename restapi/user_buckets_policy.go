@@ -0,0 +1,122 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	policies "github.com/minio/console/restapi/policy"
+	"github.com/minio/minio-go/v7/pkg/policy"
+	bucketPolicy "github.com/minio/pkg/bucket/policy"
+	minioIAMPolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerBucketPolicyHandlers(api *operations.ConsoleAPI) {
+	// validate a bucket policy
+	api.BucketValidateBucketPolicyHandler = bucketApi.ValidateBucketPolicyHandlerFunc(func(params bucketApi.ValidateBucketPolicyParams, session *models.Principal) middleware.Responder {
+		return bucketApi.NewValidateBucketPolicyOK().WithPayload(validateBucketPolicy(params.BucketName, *params.Body.Policy))
+	})
+	// generate a bucket policy from prefix rules
+	api.BucketGenerateBucketPolicyHandler = bucketApi.GenerateBucketPolicyHandlerFunc(func(params bucketApi.GenerateBucketPolicyParams, session *models.Principal) middleware.Responder {
+		response, err := getGenerateBucketPolicyResponse(params)
+		if err != nil {
+			return bucketApi.NewGenerateBucketPolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGenerateBucketPolicyOK().WithPayload(response)
+	})
+}
+
+func policyIssues(issues []policies.Issue) []*models.BucketPolicyIssue {
+	result := make([]*models.BucketPolicyIssue, 0, len(issues))
+	for _, issue := range issues {
+		result = append(result, &models.BucketPolicyIssue{Statement: int64(issue.Statement), Message: issue.Message})
+	}
+	return result
+}
+
+// validateBucketPolicy lints the policy and, when the linter finds nothing wrong, parses it the way
+// MinIO does so anything the linter doesn't know about is reported as well
+func validateBucketPolicy(bucketName, rawPolicy string) *models.BucketPolicyValidation {
+	report := policies.LintBucketPolicy([]byte(rawPolicy), bucketName)
+	if report.Valid() {
+		if _, err := bucketPolicy.ParseConfig(strings.NewReader(rawPolicy), bucketName); err != nil {
+			report.Errors = append(report.Errors, policies.Issue{Message: err.Error()})
+		}
+	}
+	return &models.BucketPolicyValidation{
+		Valid:    report.Valid(),
+		Errors:   policyIssues(report.Errors),
+		Warnings: policyIssues(report.Warnings),
+	}
+}
+
+// generateBucketPolicy builds the bucket policy granting anonymous access to the prefixes of the rules
+func generateBucketPolicy(bucketName string, rules []*models.BucketPolicyPrefixRule) (*models.BucketPolicyGenerateResponse, error) {
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%w: at least one rule is needed", ErrInvalidBucketPolicyRules)
+	}
+	bucketAccessPolicy := policy.BucketAccessPolicy{Version: minioIAMPolicy.DefaultVersion}
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		if rule == nil || rule.Access == nil {
+			return nil, fmt.Errorf("%w: every rule needs an access", ErrInvalidBucketPolicyRules)
+		}
+		prefix := strings.TrimPrefix(rule.Prefix, "/")
+		if seen[prefix] {
+			return nil, fmt.Errorf("%w: prefix %q is used by more than one rule", ErrInvalidBucketPolicyRules, prefix)
+		}
+		seen[prefix] = true
+		var access policy.BucketPolicy
+		switch *rule.Access {
+		case models.BucketPolicyPrefixRuleAccessReadonly:
+			access = policy.BucketPolicyReadOnly
+		case models.BucketPolicyPrefixRuleAccessWriteonly:
+			access = policy.BucketPolicyWriteOnly
+		case models.BucketPolicyPrefixRuleAccessReadwrite:
+			access = policy.BucketPolicyReadWrite
+		default:
+			return nil, fmt.Errorf("%w: unknown access %q", ErrInvalidBucketPolicyRules, *rule.Access)
+		}
+		bucketAccessPolicy.Statements = policy.SetPolicy(bucketAccessPolicy.Statements, access, bucketName, prefix)
+	}
+	policyJSON, err := json.MarshalIndent(bucketAccessPolicy, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	report := policies.LintBucketPolicy(policyJSON, bucketName)
+	return &models.BucketPolicyGenerateResponse{
+		Policy:   string(policyJSON),
+		Warnings: policyIssues(report.Warnings),
+	}, nil
+}
+
+func getGenerateBucketPolicyResponse(params bucketApi.GenerateBucketPolicyParams) (*models.BucketPolicyGenerateResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	response, err := generateBucketPolicy(params.BucketName, params.Body.Rules)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return response, nil
+}
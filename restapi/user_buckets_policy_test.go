@@ -0,0 +1,70 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_generateBucketPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	response, err := generateBucketPolicy("photos", []*models.BucketPolicyPrefixRule{
+		{Prefix: "/public", Access: swag.String(models.BucketPolicyPrefixRuleAccessReadonly)},
+		{Prefix: "uploads", Access: swag.String(models.BucketPolicyPrefixRuleAccessWriteonly)},
+	})
+	assert.NoError(err)
+	// the generated policy is accepted by the validator
+	validation := validateBucketPolicy("photos", response.Policy)
+	assert.True(validation.Valid, "%v", validation.Errors)
+	assert.Contains(response.Policy, "arn:aws:s3:::photos/public*")
+	// anonymous uploads are worth a warning
+	found := false
+	for _, warning := range response.Warnings {
+		if warning.Statement > 0 {
+			found = true
+		}
+	}
+	assert.True(found)
+
+	_, err = generateBucketPolicy("photos", nil)
+	assert.ErrorIs(err, ErrInvalidBucketPolicyRules)
+
+	_, err = generateBucketPolicy("photos", []*models.BucketPolicyPrefixRule{
+		{Prefix: "public", Access: swag.String(models.BucketPolicyPrefixRuleAccessReadonly)},
+		{Prefix: "/public", Access: swag.String(models.BucketPolicyPrefixRuleAccessReadwrite)},
+	})
+	assert.ErrorIs(err, ErrInvalidBucketPolicyRules)
+}
+
+func Test_validateBucketPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	validation := validateBucketPolicy("photos", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/public/*"}]}`)
+	assert.True(validation.Valid)
+	assert.Empty(validation.Errors)
+
+	validation = validateBucketPolicy("photos", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:Fly","Resource":"arn:aws:s3:::photos/*"}]}`)
+	assert.False(validation.Valid)
+	if assert.Len(validation.Errors, 1) {
+		assert.Equal(int64(1), validation.Errors[0].Statement)
+	}
+}
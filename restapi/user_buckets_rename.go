@@ -0,0 +1,675 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	// objects copied at the same time by a rename job
+	bucketRenameConcurrency = 8
+	// finished jobs are kept so their summary can be consulted
+	bucketRenameJobRetention = time.Hour
+	// maximum number of failed objects reported in detail
+	maxBucketRenameFailures = 1000
+	// largest object a single server side copy can handle, bigger ones are copied in parts
+	maxSingleCopySize = 5 * 1024 * 1024 * 1024
+
+	s3ResourcePrefix = "arn:aws:s3:::"
+)
+
+func registerBucketRenameHandlers(api *operations.ConsoleAPI) {
+	// start bucket rename job
+	api.BucketStartBucketRenameHandler = bucketApi.StartBucketRenameHandlerFunc(func(params bucketApi.StartBucketRenameParams, session *models.Principal) middleware.Responder {
+		resp, err := getStartBucketRenameResponse(session, params)
+		if err != nil {
+			return bucketApi.NewStartBucketRenameDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewStartBucketRenameCreated().WithPayload(resp)
+	})
+	// get bucket rename job
+	api.BucketGetBucketRenameJobHandler = bucketApi.GetBucketRenameJobHandlerFunc(func(params bucketApi.GetBucketRenameJobParams, session *models.Principal) middleware.Responder {
+		job, owner := globalBucketRenameJobs.get(params.JobID), sessionOwner(session)
+		if job == nil || owner == "" || job.owner != owner || job.source != params.BucketName {
+			err := ErrorWithContext(params.HTTPRequest.Context(), ErrNotFound)
+			return bucketApi.NewGetBucketRenameJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetBucketRenameJobOK().WithPayload(job.toModel())
+	})
+	// cancel bucket rename job
+	api.BucketCancelBucketRenameJobHandler = bucketApi.CancelBucketRenameJobHandlerFunc(func(params bucketApi.CancelBucketRenameJobParams, session *models.Principal) middleware.Responder {
+		job, owner := globalBucketRenameJobs.get(params.JobID), sessionOwner(session)
+		if job == nil || owner == "" || job.owner != owner || job.source != params.BucketName {
+			err := ErrorWithContext(params.HTTPRequest.Context(), ErrNotFound)
+			return bucketApi.NewCancelBucketRenameJobDefault(int(err.Code)).WithPayload(err)
+		}
+		job.cancel()
+		return bucketApi.NewCancelBucketRenameJobOK().WithPayload(job.toModel())
+	})
+}
+
+// bucketRenameJob copies a bucket into a new one: its objects, including older versions, delete
+// markers, tags, metadata, retention and legal holds, followed by its configuration. Optionally
+// the IAM policies granting access to the bucket are updated and the source bucket is removed.
+// Version IDs can't be preserved, every copied version gets a new one.
+type bucketRenameJob struct {
+	id             string
+	owner          string
+	source         string
+	target         string
+	deleteSource   bool
+	updatePolicies bool
+	// settings of the source bucket replicated on the target
+	objectLocking bool
+	versioning    string
+
+	client      MinioClient
+	adminClient MinioAdmin
+	// s3Client returns the mc client of a bucket, used to change its versioning
+	s3Client func(bucketName string) (MCClient, error)
+	cancel   context.CancelFunc
+
+	mu       sync.Mutex
+	state    string
+	phase    string
+	started  time.Time
+	finished time.Time
+	// counters of the job progress
+	objects         int64
+	copied          int64
+	copiedBytes     int64
+	failed          int64
+	failures        []*models.BucketRenameFailure
+	updatedPolicies []string
+	warnings        []string
+	sourceDeleted   bool
+	err             error
+	// the versions copied, the only ones removed from the source
+	copiedVersions []copiedVersion
+}
+
+// copiedVersion is a version of an object of the source bucket copied to the target
+type copiedVersion struct {
+	key, versionID, etag string
+}
+
+func (j *bucketRenameJob) toModel() *models.BucketRenameJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	m := &models.BucketRenameJob{
+		ID:                 j.id,
+		Source:             j.source,
+		Target:             j.target,
+		DeleteSource:       j.deleteSource,
+		UpdatePolicies:     j.updatePolicies,
+		State:              j.state,
+		Phase:              j.phase,
+		Started:            j.started.Format(time.RFC3339),
+		Objects:            j.objects,
+		Copied:             j.copied,
+		CopiedBytes:        j.copiedBytes,
+		FailedObjectsCount: j.failed,
+		FailedObjects:      append([]*models.BucketRenameFailure{}, j.failures...),
+		UpdatedPolicies:    append([]string{}, j.updatedPolicies...),
+		Warnings:           append([]string{}, j.warnings...),
+		SourceDeleted:      j.sourceDeleted,
+	}
+	if !j.finished.IsZero() {
+		m.Finished = j.finished.Format(time.RFC3339)
+	}
+	if j.err != nil {
+		m.Error = j.err.Error()
+	}
+	return m
+}
+
+func (j *bucketRenameJob) update(fn func(j *bucketRenameJob)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(j)
+}
+
+func (j *bucketRenameJob) addFailure(obj minio.ObjectInfo, err error) {
+	j.update(func(j *bucketRenameJob) {
+		j.failed++
+		if len(j.failures) < maxBucketRenameFailures {
+			j.failures = append(j.failures, &models.BucketRenameFailure{Object: obj.Key, VersionID: obj.VersionID, Error: err.Error()})
+		}
+	})
+}
+
+func (j *bucketRenameJob) addWarning(format string, args ...interface{}) {
+	j.update(func(j *bucketRenameJob) { j.warnings = append(j.warnings, fmt.Sprintf(format, args...)) })
+}
+
+func (j *bucketRenameJob) running() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.state == models.BucketRenameJobStateRunning
+}
+
+func (j *bucketRenameJob) setPhase(phase string) {
+	j.update(func(j *bucketRenameJob) { j.phase = phase })
+}
+
+type bucketRenameJobs struct {
+	mu   sync.Mutex
+	jobs map[string]*bucketRenameJob
+}
+
+var globalBucketRenameJobs = &bucketRenameJobs{jobs: make(map[string]*bucketRenameJob)}
+
+func (r *bucketRenameJobs) get(id string) *bucketRenameJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.jobs[id]
+}
+
+// add registers the job unless another running job reads from or writes to one of its buckets
+func (r *bucketRenameJobs) add(job *bucketRenameJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, other := range r.jobs {
+		if !other.running() {
+			continue
+		}
+		if IsElementInArray([]string{other.source, other.target}, job.source) || IsElementInArray([]string{other.source, other.target}, job.target) {
+			return fmt.Errorf("%w: bucket %s is already being renamed", ErrInvalidBucketRename, other.source)
+		}
+	}
+	r.jobs[job.id] = job
+	return nil
+}
+
+func (r *bucketRenameJobs) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.jobs, id)
+}
+
+// repointPolicyResources makes the S3 resources of a policy referencing the source bucket, or objects
+// in it, reference the target bucket instead. With keepSource the target resources are added next to
+// the source ones. It returns whether the policy changed.
+func repointPolicyResources(policy []byte, source, target string, keepSource bool) ([]byte, bool, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(policy, &doc); err != nil {
+		return nil, false, err
+	}
+	sourceARN := s3ResourcePrefix + source
+	repoint := func(resource string) (string, bool) {
+		if resource == sourceARN || strings.HasPrefix(resource, sourceARN+"/") {
+			return s3ResourcePrefix + target + strings.TrimPrefix(resource, sourceARN), true
+		}
+		return "", false
+	}
+
+	changed := false
+	var statements []interface{}
+	switch st := doc["Statement"].(type) {
+	case []interface{}:
+		statements = st
+	case map[string]interface{}:
+		statements = []interface{}{st}
+	}
+	for _, st := range statements {
+		statement, ok := st.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range []string{"Resource", "NotResource"} {
+			var resources []string
+			switch value := statement[key].(type) {
+			case string:
+				resources = []string{value}
+			case []interface{}:
+				for _, r := range value {
+					if resource, ok := r.(string); ok {
+						resources = append(resources, resource)
+					}
+				}
+			default:
+				continue
+			}
+			var updated []string
+			statementChanged := false
+			for _, resource := range resources {
+				repointed, ok := repoint(resource)
+				if !ok {
+					updated = append(updated, resource)
+					continue
+				}
+				statementChanged = true
+				if keepSource {
+					updated = append(updated, resource)
+				}
+				if !IsElementInArray(resources, repointed) && !IsElementInArray(updated, repointed) {
+					updated = append(updated, repointed)
+				}
+			}
+			if statementChanged {
+				statement[key] = updated
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return policy, false, nil
+	}
+	result, err := json.Marshal(doc)
+	return result, true, err
+}
+
+// isObjectLockNotFound returns whether the error means a bucket or object version has no object locking
+// configuration, retention or legal hold
+func isObjectLockNotFound(err error) bool {
+	code := minio.ToErrorResponse(err).Code
+	return code == "ObjectLockConfigurationNotFoundError" || code == "NoSuchObjectLockConfiguration"
+}
+
+// prepareTarget creates the target bucket with the object locking and versioning of the source
+func (j *bucketRenameJob) prepareTarget(ctx context.Context) error {
+	lock, _, _, _, err := j.client.getObjectLockConfig(ctx, j.source)
+	if err != nil && !isObjectLockNotFound(err) {
+		return err
+	}
+	j.objectLocking = lock == "Enabled"
+	versioning, err := j.client.getBucketVersioning(ctx, j.source)
+	if err != nil {
+		return err
+	}
+	j.versioning = versioning.Status
+	if err = j.client.makeBucketWithContext(ctx, j.target, "", j.objectLocking); err != nil {
+		return err
+	}
+	// versioning is enabled on the target before copying, even for suspended sources, to keep
+	// their older versions. Object locking already enables it.
+	if j.versioning != "" && !j.objectLocking {
+		s3Client, err := j.s3Client(j.target)
+		if err != nil {
+			return err
+		}
+		return doSetVersioning(s3Client, VersionEnable)
+	}
+	return nil
+}
+
+// copyObjectVersion copies a version of an object to the target bucket. Server side copies keep the
+// metadata and tags of the object, objects too large for a single copy are composed in parts and
+// get their metadata and tags set explicitly.
+func (j *bucketRenameJob) copyObjectVersion(ctx context.Context, obj minio.ObjectInfo) error {
+	if obj.IsDeleteMarker {
+		// deleting the latest version of an object in a versioned bucket adds a delete marker
+		return j.client.removeObject(ctx, j.target, obj.Key, minio.RemoveObjectOptions{})
+	}
+	src := minio.CopySrcOptions{Bucket: j.source, Object: obj.Key, VersionID: obj.VersionID}
+	dst := minio.CopyDestOptions{Bucket: j.target, Object: obj.Key}
+	if j.objectLocking {
+		mode, retainUntil, err := j.client.getObjectRetention(ctx, j.source, obj.Key, obj.VersionID)
+		if err != nil && !isObjectLockNotFound(err) {
+			return err
+		}
+		if mode != nil && retainUntil != nil {
+			dst.Mode = *mode
+			dst.RetainUntilDate = *retainUntil
+		}
+		legalHold, err := j.client.getObjectLegalHold(ctx, j.source, obj.Key, minio.GetObjectLegalHoldOptions{VersionID: obj.VersionID})
+		if err != nil && !isObjectLockNotFound(err) {
+			return err
+		}
+		if legalHold != nil {
+			dst.LegalHold = *legalHold
+		}
+	}
+	if obj.Size <= maxSingleCopySize {
+		_, err := j.client.copyObject(ctx, dst, src)
+		return err
+	}
+
+	info, err := j.client.statObject(ctx, j.source, obj.Key, minio.GetObjectOptions{VersionID: obj.VersionID})
+	if err != nil {
+		return err
+	}
+	dst.ReplaceMetadata = true
+	dst.UserMetadata = map[string]string{"Content-Type": info.ContentType}
+	for key, value := range info.UserMetadata {
+		dst.UserMetadata[key] = value
+	}
+	if _, err = j.client.composeObject(ctx, dst, src); err != nil {
+		return err
+	}
+	objTags, err := j.client.getObjectTagging(ctx, j.source, obj.Key, minio.GetObjectTaggingOptions{VersionID: obj.VersionID})
+	if err != nil || len(objTags.ToMap()) == 0 {
+		return err
+	}
+	return j.client.putObjectTagging(ctx, j.target, obj.Key, objTags, minio.PutObjectTaggingOptions{})
+}
+
+// copyObjects copies every object of the source bucket. The versions of an object are listed from
+// the newest to the oldest and are copied the other way around so the latest version stays the
+// latest one, different objects are copied concurrently.
+func (j *bucketRenameJob) copyObjects(ctx context.Context) error {
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sem := make(chan struct{}, bucketRenameConcurrency)
+	var wg sync.WaitGroup
+	copyVersions := func(versions []minio.ObjectInfo) {
+		defer func() {
+			<-sem
+			wg.Done()
+		}()
+		for i := len(versions) - 1; i >= 0; i-- {
+			obj := versions[i]
+			if err := j.copyObjectVersion(lctx, obj); err != nil {
+				j.addFailure(obj, err)
+				// newer versions would end up on top of a missing one
+				return
+			}
+			j.update(func(j *bucketRenameJob) {
+				j.copied++
+				j.copiedBytes += obj.Size
+				j.copiedVersions = append(j.copiedVersions, copiedVersion{key: obj.Key, versionID: obj.VersionID, etag: obj.ETag})
+			})
+		}
+	}
+
+	var versions []minio.ObjectInfo
+	flush := func() {
+		if len(versions) == 0 {
+			return
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go copyVersions(versions)
+		versions = nil
+	}
+	var err error
+	for obj := range j.client.listObjects(lctx, j.source, minio.ListObjectsOptions{
+		Recursive:    true,
+		WithVersions: j.versioning != "",
+	}) {
+		if obj.Err != nil {
+			err = obj.Err
+			break
+		}
+		j.update(func(j *bucketRenameJob) { j.objects++ })
+		if len(versions) > 0 && versions[0].Key != obj.Key {
+			flush()
+		}
+		versions = append(versions, obj)
+	}
+	if err == nil {
+		flush()
+	} else {
+		cancel()
+	}
+	wg.Wait()
+	if err == nil {
+		err = ctx.Err()
+	}
+	return err
+}
+
+// copyConfiguration applies the configuration of the source bucket to the target. It happens after
+// the objects are copied so quotas, lifecycle rules and notifications don't act on the copy itself.
+// Replication isn't copied, its remote targets belong to the source bucket.
+func (j *bucketRenameJob) copyConfiguration(ctx context.Context) error {
+	snapshot, err := exportBucketConfig(ctx, j.client, j.adminClient, j.source, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	if snapshot.Replication != nil {
+		snapshot.Replication = nil
+		j.addWarning("replication of bucket %s has to be configured again on bucket %s", j.source, j.target)
+	}
+	if snapshot.Policy != "" {
+		policy, _, err := repointPolicyResources([]byte(snapshot.Policy), j.source, j.target, false)
+		if err != nil {
+			return err
+		}
+		snapshot.Policy = string(policy)
+	}
+	resp, err := importBucketConfig(ctx, j.client, j.adminClient, j.target, &models.BucketConfigImportRequest{Snapshot: snapshot})
+	if err != nil {
+		return err
+	}
+	for _, section := range resp.Sections {
+		if section.Error != "" {
+			j.addWarning("unable to copy the %s configuration: %s", section.Section, section.Error)
+		}
+	}
+	if j.versioning == string(minio.Suspended) {
+		s3Client, err := j.s3Client(j.target)
+		if err != nil {
+			return err
+		}
+		return doSetVersioning(s3Client, VersionSuspend)
+	}
+	return nil
+}
+
+// repointPolicies updates the IAM policies granting access to the source bucket so they grant it on
+// the target. Access to the source is kept unless the source is going to be removed.
+func (j *bucketRenameJob) repointPolicies(ctx context.Context) error {
+	policies, err := j.adminClient.listPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for name, policy := range policies {
+		raw, err := json.Marshal(policy)
+		if err != nil {
+			return err
+		}
+		updated, changed, err := repointPolicyResources(raw, j.source, j.target, !j.deleteSource)
+		if err != nil || !changed {
+			if err != nil {
+				j.addWarning("unable to update policy %s: %v", name, err)
+			}
+			continue
+		}
+		newPolicy, err := iampolicy.ParseConfig(strings.NewReader(string(updated)))
+		if err == nil {
+			err = j.adminClient.addPolicy(ctx, name, newPolicy)
+		}
+		if err != nil {
+			j.addWarning("unable to update policy %s: %v", name, err)
+			continue
+		}
+		j.update(func(j *bucketRenameJob) { j.updatedPolicies = append(j.updatedPolicies, name) })
+	}
+	return nil
+}
+
+// removeSource removes the versions copied from the source bucket, and then the bucket when nothing else is left
+// in it. The objects written to the source while it was copied are kept, and so is the bucket.
+func (j *bucketRenameJob) removeSource(ctx context.Context) error {
+	j.mu.Lock()
+	copied := j.copiedVersions
+	j.mu.Unlock()
+	for _, version := range copied {
+		// the current object of an unversioned bucket, and the null version, are replaced by the writes, they are only
+		// removed while they are still the ones copied
+		if version.versionID == "" || version.versionID == "null" {
+			info, err := j.client.statObject(ctx, j.source, version.key, minio.GetObjectOptions{VersionID: version.versionID})
+			if err != nil || info.ETag != version.etag {
+				continue
+			}
+		}
+		if err := j.client.removeObject(ctx, j.source, version.key, minio.RemoveObjectOptions{VersionID: version.versionID}); err != nil {
+			return fmt.Errorf("unable to remove %s from bucket %s: %w", version.key, j.source, err)
+		}
+	}
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for obj := range j.client.listObjects(lctx, j.source, minio.ListObjectsOptions{Recursive: true, WithVersions: j.versioning != ""}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		j.addWarning("bucket %s wasn't removed, objects were written to it while it was copied", j.source)
+		return nil
+	}
+	if err := j.client.removeBucket(ctx, j.source); err != nil {
+		return err
+	}
+	j.update(func(j *bucketRenameJob) { j.sourceDeleted = true })
+	return nil
+}
+
+// run goes through the phases of the job once the target bucket exists. The source is only removed
+// when every object was copied.
+func (j *bucketRenameJob) run(ctx context.Context) error {
+	j.setPhase(models.BucketRenameJobPhaseCopying)
+	if err := j.copyObjects(ctx); err != nil {
+		return err
+	}
+	j.setPhase(models.BucketRenameJobPhaseConfiguring)
+	if err := j.copyConfiguration(ctx); err != nil {
+		return err
+	}
+	if j.updatePolicies {
+		j.setPhase(models.BucketRenameJobPhasePolicies)
+		if err := j.repointPolicies(ctx); err != nil {
+			return err
+		}
+	}
+	if j.deleteSource {
+		if failed := j.toModel().FailedObjectsCount; failed > 0 {
+			j.addWarning("bucket %s wasn't removed, %d objects failed to copy", j.source, failed)
+		} else {
+			j.setPhase(models.BucketRenameJobPhaseDeleting)
+			if err := j.removeSource(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	j.setPhase(models.BucketRenameJobPhaseDone)
+	return nil
+}
+
+func (j *bucketRenameJob) finish(err error) {
+	j.update(func(j *bucketRenameJob) {
+		j.finished = time.Now().UTC()
+		j.err = err
+		switch {
+		case errors.Is(err, context.Canceled):
+			j.state = models.BucketRenameJobStateCanceled
+		case err != nil:
+			j.state = models.BucketRenameJobStateFailed
+		default:
+			j.state = models.BucketRenameJobStateCompleted
+		}
+	})
+}
+
+// validateBucketRename checks the rename request, returning the target bucket
+func validateBucketRename(source string, req *models.BucketRenameRequest) (string, error) {
+	target := strings.TrimSpace(*req.Target)
+	if target == "" {
+		return "", fmt.Errorf("%w: the target bucket is required", ErrInvalidBucketRename)
+	}
+	if target == source {
+		return "", fmt.Errorf("%w: the target bucket must be different from the source", ErrInvalidBucketRename)
+	}
+	return target, nil
+}
+
+// startBucketRename creates the target bucket and starts copying into it in the background. Errors
+// creating the target, like an existing bucket, are returned right away.
+func startBucketRename(ctx context.Context, job *bucketRenameJob) error {
+	// the job outlives the request that started it
+	jctx, cancel := context.WithCancel(context.Background())
+	job.cancel = cancel
+	if err := globalBucketRenameJobs.add(job); err != nil {
+		cancel()
+		return err
+	}
+	if err := job.prepareTarget(ctx); err != nil {
+		cancel()
+		globalBucketRenameJobs.remove(job.id)
+		return err
+	}
+	go func() {
+		defer cancel()
+		job.finish(job.run(jctx))
+		time.AfterFunc(bucketRenameJobRetention, func() {
+			globalBucketRenameJobs.remove(job.id)
+		})
+	}()
+	return nil
+}
+
+func getStartBucketRenameResponse(session *models.Principal, params bucketApi.StartBucketRenameParams) (*models.BucketRenameJob, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	target, err := validateBucketRename(params.BucketName, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// the jobs of the sessions without an owner would be visible to all of them
+	owner := sessionOwner(session)
+	if owner == "" {
+		return nil, ErrorWithContext(ctx, ErrInvalidSession)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	id, err := utils.NewUUID()
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	job := &bucketRenameJob{
+		id:             id,
+		owner:          owner,
+		source:         params.BucketName,
+		target:         target,
+		deleteSource:   params.Body.DeleteSource,
+		updatePolicies: params.Body.UpdatePolicies,
+		client:         minioClient{client: mClient},
+		adminClient:    AdminClient{Client: mAdmin},
+		s3Client: func(bucketName string) (MCClient, error) {
+			s3Client, err := newS3BucketClient(session, bucketName, "")
+			if err != nil {
+				return nil, err
+			}
+			return mcClient{client: s3Client}, nil
+		},
+		state:   models.BucketRenameJobStateRunning,
+		started: time.Now().UTC(),
+	}
+	if err = startBucketRename(ctx, job); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return job.toModel(), nil
+}
@@ -0,0 +1,193 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_bucketRenameCopyObjects(t *testing.T) {
+	assert := assert.New(t)
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.Equal("source", bucket)
+		assert.True(opts.WithVersions)
+		// versions are listed from the newest to the oldest
+		objs := []minio.ObjectInfo{
+			{Key: "a.txt", VersionID: "a2", Size: 20},
+			{Key: "a.txt", VersionID: "a1", Size: 10},
+			{Key: "b.txt", VersionID: "b2", IsDeleteMarker: true},
+			{Key: "b.txt", VersionID: "b1", Size: 5},
+			{Key: "c.txt", VersionID: "c2", Size: 1},
+			{Key: "c.txt", VersionID: "c1", Size: 1},
+		}
+		ch := make(chan minio.ObjectInfo, len(objs))
+		for _, obj := range objs {
+			ch <- obj
+		}
+		close(ch)
+		return ch
+	}
+	var mu sync.Mutex
+	copied := map[string][]string{}
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		assert.Equal("target", dst.Bucket)
+		if src.VersionID == "c1" {
+			return minio.UploadInfo{}, errors.New("access denied")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		copied[src.Object] = append(copied[src.Object], src.VersionID)
+		return minio.UploadInfo{}, nil
+	}
+	minioRemoveObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+		assert.Equal("target", bucketName)
+		mu.Lock()
+		defer mu.Unlock()
+		copied[objectName] = append(copied[objectName], "delete-marker")
+		return nil
+	}
+
+	job := &bucketRenameJob{source: "source", target: "target", versioning: string(minio.Enabled), client: minioClientMock{}}
+	assert.NoError(job.copyObjects(context.Background()))
+	// older versions are copied first
+	assert.Equal([]string{"a1", "a2"}, copied["a.txt"])
+	assert.Equal([]string{"b1", "delete-marker"}, copied["b.txt"])
+	// newer versions aren't copied on top of a version that failed
+	assert.Empty(copied["c.txt"])
+
+	m := job.toModel()
+	assert.Equal(int64(6), m.Objects)
+	assert.Equal(int64(4), m.Copied)
+	assert.Equal(int64(35), m.CopiedBytes)
+	assert.Equal(int64(1), m.FailedObjectsCount)
+	assert.Equal(&models.BucketRenameFailure{Object: "c.txt", VersionID: "c1", Error: "access denied"}, m.FailedObjects[0])
+}
+
+func Test_bucketRenameRemoveSource(t *testing.T) {
+	assert := assert.New(t)
+	var remaining []minio.ObjectInfo
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, len(remaining))
+		for _, obj := range remaining {
+			ch <- obj
+		}
+		close(ch)
+		return ch
+	}
+	var removed []string
+	minioRemoveObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+		assert.Equal("source", bucketName)
+		removed = append(removed, objectName+"@"+opts.VersionID)
+		return nil
+	}
+	minioStatObjectMock = func(ctx context.Context, bucketName, prefix string, opts minio.GetObjectOptions) (minio.ObjectInfo, error) {
+		// b.txt was overwritten while it was copied
+		return minio.ObjectInfo{Key: prefix, ETag: map[string]string{"a.txt": "a", "b.txt": "b2"}[prefix]}, nil
+	}
+	bucketRemoved := false
+	minioRemoveBucketMock = func(bucketName string) error {
+		bucketRemoved = true
+		return nil
+	}
+
+	// Test-1 : only the versions copied are removed, the bucket is kept with the versions written during the copy
+	remaining = []minio.ObjectInfo{{Key: "a.txt", VersionID: "a3"}}
+	job := &bucketRenameJob{source: "source", versioning: string(minio.Enabled), client: minioClientMock{}}
+	job.copiedVersions = []copiedVersion{{key: "a.txt", versionID: "a1"}, {key: "a.txt", versionID: "a2"}}
+	assert.NoError(job.removeSource(context.Background()))
+	assert.Equal([]string{"a.txt@a1", "a.txt@a2"}, removed)
+	assert.False(bucketRemoved)
+	m := job.toModel()
+	assert.False(m.SourceDeleted)
+	assert.Len(m.Warnings, 1)
+
+	// Test-2 : the objects of an unversioned bucket are only removed while they are the ones copied
+	removed, remaining = nil, []minio.ObjectInfo{{Key: "b.txt", ETag: "b2"}}
+	job = &bucketRenameJob{source: "source", client: minioClientMock{}}
+	job.copiedVersions = []copiedVersion{{key: "a.txt", etag: "a"}, {key: "b.txt", etag: "b1"}}
+	assert.NoError(job.removeSource(context.Background()))
+	assert.Equal([]string{"a.txt@"}, removed)
+	assert.False(bucketRemoved)
+
+	// Test-3 : the bucket is removed once it's empty
+	removed, remaining = nil, nil
+	job = &bucketRenameJob{source: "source", client: minioClientMock{}}
+	job.copiedVersions = []copiedVersion{{key: "a.txt", etag: "a"}}
+	assert.NoError(job.removeSource(context.Background()))
+	assert.True(bucketRemoved)
+	assert.True(job.toModel().SourceDeleted)
+}
+
+func Test_repointPolicyResources(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     string
+		keepSource bool
+		want       string
+		changed    bool
+	}{
+		{
+			name:    "bucket and objects",
+			policy:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::photos","arn:aws:s3:::photos/*"]}]}`,
+			want:    `{"Statement":[{"Action":["s3:*"],"Effect":"Allow","Resource":["arn:aws:s3:::albums","arn:aws:s3:::albums/*"]}],"Version":"2012-10-17"}`,
+			changed: true,
+		},
+		{
+			name:       "source kept",
+			policy:     `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::photos/public/*"}}`,
+			keepSource: true,
+			want:       `{"Statement":{"Action":"s3:GetObject","Effect":"Allow","Resource":["arn:aws:s3:::photos/public/*","arn:aws:s3:::albums/public/*"]},"Version":"2012-10-17"}`,
+			changed:    true,
+		},
+		{
+			name:   "other buckets untouched",
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::photos-archive/*"]}]}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::photos-archive/*"]}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed, err := repointPolicyResources([]byte(tt.policy), "photos", "albums", tt.keepSource)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.changed, changed)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func Test_bucketRenameJobsConflict(t *testing.T) {
+	assert := assert.New(t)
+	jobs := &bucketRenameJobs{jobs: make(map[string]*bucketRenameJob)}
+	assert.NoError(jobs.add(&bucketRenameJob{id: "1", source: "photos", target: "albums", state: models.BucketRenameJobStateRunning}))
+	assert.ErrorIs(jobs.add(&bucketRenameJob{id: "2", source: "albums", target: "pictures", state: models.BucketRenameJobStateRunning}), ErrInvalidBucketRename)
+	assert.NoError(jobs.add(&bucketRenameJob{id: "3", source: "docs", target: "documents", state: models.BucketRenameJobStateRunning}))
+
+	jobs.get("1").finish(nil)
+	assert.Equal(models.BucketRenameJobStateCompleted, jobs.get("1").toModel().State)
+	assert.NoError(jobs.add(&bucketRenameJob{id: "4", source: "albums", target: "pictures", state: models.BucketRenameJobStateRunning}))
+
+	_, err := validateBucketRename("photos", &models.BucketRenameRequest{Target: swag.String("photos")})
+	assert.ErrorIs(err, ErrInvalidBucketRename)
+}
@@ -0,0 +1,178 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+)
+
+// number of latency samples kept per replication target
+const replicationLatencyWindow = 120
+
+// replicationLatencySampler keeps the latest latencies observed for every target, the probes only give the current,
+// average and max latency so percentiles are computed over the samples taken by Console
+type replicationLatencySampler struct {
+	sync.Mutex
+	samples map[string][]time.Duration
+	// probes of the targets already sampled, a probe reused by several calls is only sampled once
+	sampled map[string]time.Time
+}
+
+var globalReplicationLatency = &replicationLatencySampler{samples: map[string][]time.Duration{}}
+
+// observe records a latency sample for the target and returns the percentiles of the window
+func (s *replicationLatencySampler) observe(arn string, latency time.Duration) (p50, p90, p99 time.Duration, count int) {
+	s.Lock()
+	defer s.Unlock()
+	window := s.samples[arn]
+	if latency > 0 {
+		window = append(window, latency)
+		if len(window) > replicationLatencyWindow {
+			window = window[len(window)-replicationLatencyWindow:]
+		}
+		s.samples[arn] = window
+	}
+	if len(window) == 0 {
+		return 0, 0, 0, 0
+	}
+	sorted := append([]time.Duration(nil), window...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return percentile(sorted, 50), percentile(sorted, 90), percentile(sorted, 99), len(sorted)
+}
+
+// percentile returns the nearest-rank percentile of the sorted samples
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func registerBucketReplicationMetricsHandlers(api *operations.ConsoleAPI) {
+	api.BucketGetBucketReplicationMetricsHandler = bucketApi.GetBucketReplicationMetricsHandlerFunc(func(params bucketApi.GetBucketReplicationMetricsParams, session *models.Principal) middleware.Responder {
+		resp, err := getBucketReplicationMetricsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketReplicationMetricsDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetBucketReplicationMetricsOK().WithPayload(resp)
+	})
+}
+
+// isNewProbe tells whether the probe of the target wasn't sampled yet and marks it as sampled
+func (s *replicationLatencySampler) isNewProbe(arn string, probedAt time.Time) bool {
+	s.Lock()
+	defer s.Unlock()
+	if s.sampled == nil {
+		s.sampled = map[string]time.Time{}
+	}
+	if s.sampled[arn].Equal(probedAt) {
+		return false
+	}
+	s.sampled[arn] = probedAt
+	return true
+}
+
+// replicationTargetLatency returns the latency probed for the target along with the percentiles of the samples
+func replicationTargetLatency(sampler *replicationLatencySampler, arn string, health replicationTargetHealth) *models.ReplicationLatency {
+	var current time.Duration
+	if health.online && sampler.isNewProbe(arn, health.probedAt) {
+		current = health.latency
+	}
+	p50, p90, p99, count := sampler.observe(arn, current)
+	return &models.ReplicationLatency{
+		Current: health.latency.Milliseconds(),
+		Average: health.latencyAverage().Milliseconds(),
+		Max:     health.latencyMax.Milliseconds(),
+		P50:     p50.Milliseconds(),
+		P90:     p90.Milliseconds(),
+		P99:     p99.Milliseconds(),
+		Samples: int64(count),
+	}
+}
+
+func getBucketReplicationMetrics(ctx context.Context, ac MinioAdmin, client MinioClient, tracker *replicationHealthTracker, sampler *replicationLatencySampler, bucketName string) (*models.BucketReplicationMetrics, error) {
+	metrics, err := client.getBucketReplicationMetrics(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := ac.listRemoteBuckets(ctx, bucketName, "replication")
+	if err != nil {
+		return nil, err
+	}
+	result := &models.BucketReplicationMetrics{
+		Bucket:         bucketName,
+		PendingCount:   int64(metrics.PendingCount),
+		PendingSize:    int64(metrics.PendingSize),
+		FailedCount:    int64(metrics.FailedCount),
+		FailedSize:     int64(metrics.FailedSize),
+		ReplicatedSize: int64(metrics.ReplicatedSize),
+		ReplicaSize:    int64(metrics.ReplicaSize),
+		Targets:        []*models.ReplicationTargetMetrics{},
+	}
+	health := tracker.healthOf(ctx, targets)
+	for i, target := range targets {
+		item := &models.ReplicationTargetMetrics{
+			Arn:           target.Arn,
+			Endpoint:      target.Endpoint,
+			TargetBucket:  target.TargetBucket,
+			Online:        health[i].online,
+			TotalDowntime: int64(health[i].totalDowntime.Seconds()),
+			Latency:       replicationTargetLatency(sampler, target.Arn, health[i]),
+		}
+		if !health[i].lastOnline.IsZero() {
+			item.LastOnline = health[i].lastOnline.UTC().Format(time.RFC3339)
+		}
+		if stats, ok := metrics.Stats[target.Arn]; ok {
+			item.PendingCount = int64(stats.PendingCount)
+			item.PendingSize = int64(stats.PendingSize)
+			item.FailedCount = int64(stats.FailedCount)
+			item.FailedSize = int64(stats.FailedSize)
+			item.ReplicatedSize = int64(stats.ReplicatedSize)
+		}
+		result.Targets = append(result.Targets, item)
+	}
+	return result, nil
+}
+
+func getBucketReplicationMetricsResponse(session *models.Principal, params bucketApi.GetBucketReplicationMetricsParams) (*models.BucketReplicationMetrics, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	metrics, err := getBucketReplicationMetrics(ctx, adminClient, minioClient, globalReplicationHealth, globalReplicationLatency, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return metrics, nil
+}
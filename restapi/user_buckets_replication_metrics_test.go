@@ -0,0 +1,119 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplicationLatencySampler(t *testing.T) {
+	assert := assert.New(t)
+	sampler := &replicationLatencySampler{samples: map[string][]time.Duration{}}
+
+	p50, p90, p99, count := sampler.observe("arn", 0)
+	assert.Zero(count)
+	assert.Zero(p50 + p90 + p99)
+
+	for i := 1; i <= 100; i++ {
+		p50, p90, p99, count = sampler.observe("arn", time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(100, count)
+	assert.Equal(50*time.Millisecond, p50)
+	assert.Equal(90*time.Millisecond, p90)
+	assert.Equal(99*time.Millisecond, p99)
+
+	// the window only keeps the latest samples
+	for i := 0; i < replicationLatencyWindow; i++ {
+		_, _, p99, count = sampler.observe("arn", time.Millisecond)
+	}
+	assert.Equal(replicationLatencyWindow, count)
+	assert.Equal(time.Millisecond, p99)
+
+	// targets are sampled independently
+	_, _, _, count = sampler.observe("other", 0)
+	assert.Zero(count)
+}
+
+func TestGetBucketReplicationMetrics(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	sampler := &replicationLatencySampler{samples: map[string][]time.Duration{}}
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	tracker := replicationHealthTrackerMock(&now, map[string]time.Duration{"site-a:9000": 12 * time.Millisecond})
+	adminClient := replicationTargetsAdminMock{targets: []madmin.BucketTarget{
+		{Arn: "arn:minio:replication::a:dest", Endpoint: "site-a:9000", TargetBucket: "dest"},
+		{Arn: "arn:minio:replication::b:dest", Endpoint: "site-b:9000", TargetBucket: "dest"},
+	}}
+	minClient := minioClientMock{}
+	minioGetReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{
+			PendingCount:   7,
+			PendingSize:    7000,
+			FailedCount:    2,
+			FailedSize:     200,
+			ReplicatedSize: 1 << 20,
+			Stats: map[string]replication.TargetMetrics{
+				"arn:minio:replication::a:dest": {PendingCount: 1, PendingSize: 1000, ReplicatedSize: 1 << 20},
+				"arn:minio:replication::b:dest": {PendingCount: 6, PendingSize: 6000, FailedCount: 2, FailedSize: 200},
+			},
+		}, nil
+	}
+
+	metrics, err := getBucketReplicationMetrics(ctx, adminClient, minClient, tracker, sampler, "source")
+	assert.NoError(err)
+	assert.Equal(int64(7), metrics.PendingCount)
+	assert.Equal(int64(200), metrics.FailedSize)
+	assert.Len(metrics.Targets, 2)
+
+	online := metrics.Targets[0]
+	assert.True(online.Online)
+	assert.Equal(int64(1000), online.PendingSize)
+	assert.Equal(int64(12), online.Latency.Current)
+	assert.Equal(int64(12), online.Latency.P99)
+	assert.Equal(int64(1), online.Latency.Samples)
+
+	// offline targets aren't sampled
+	offline := metrics.Targets[1]
+	assert.False(offline.Online)
+	assert.Equal(int64(2), offline.FailedCount)
+	assert.Zero(offline.Latency.Current)
+	assert.Zero(offline.Latency.Samples)
+
+	// a probe reused by the next call isn't sampled twice, the downtime grows between the probes
+	now = now.Add(time.Second)
+	metrics, err = getBucketReplicationMetrics(ctx, adminClient, minClient, tracker, sampler, "source")
+	assert.NoError(err)
+	assert.Equal(int64(1), metrics.Targets[0].Latency.Samples)
+	now = now.Add(time.Hour)
+	metrics, err = getBucketReplicationMetrics(ctx, adminClient, minClient, tracker, sampler, "source")
+	assert.NoError(err)
+	assert.Equal(int64(2), metrics.Targets[0].Latency.Samples)
+	assert.Equal(int64(3601), metrics.Targets[1].TotalDowntime)
+
+	minioGetReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{}, errors.New("replication is not configured")
+	}
+	_, err = getBucketReplicationMetrics(ctx, adminClient, minClient, tracker, sampler, "source")
+	assert.EqualError(err, "replication is not configured")
+}
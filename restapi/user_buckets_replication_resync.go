@@ -0,0 +1,256 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/minio/websocket"
+)
+
+// default and minimum interval between the updates of a resync stream
+const (
+	defaultResyncStreamInterval = 2 * time.Second
+	minResyncStreamInterval     = 500 * time.Millisecond
+)
+
+type replicationResyncOptions struct {
+	BucketName string
+	Arn        string
+	Interval   time.Duration
+}
+
+func registerReplicationResyncHandlers(api *operations.ConsoleAPI) {
+	// start a resync towards a replication target
+	api.BucketStartReplicationResyncHandler = bucketApi.StartReplicationResyncHandlerFunc(func(params bucketApi.StartReplicationResyncParams, session *models.Principal) middleware.Responder {
+		resp, err := getStartReplicationResyncResponse(session, params)
+		if err != nil {
+			return bucketApi.NewStartReplicationResyncDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewStartReplicationResyncCreated().WithPayload(resp)
+	})
+	// progress of the resyncs of a bucket
+	api.BucketGetReplicationResyncStatusHandler = bucketApi.GetReplicationResyncStatusHandlerFunc(func(params bucketApi.GetReplicationResyncStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getReplicationResyncStatusResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetReplicationResyncStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetReplicationResyncStatusOK().WithPayload(resp)
+	})
+	// cancel a running resync
+	api.BucketCancelReplicationResyncHandler = bucketApi.CancelReplicationResyncHandlerFunc(func(params bucketApi.CancelReplicationResyncParams, session *models.Principal) middleware.Responder {
+		if err := getCancelReplicationResyncResponse(session, params); err != nil {
+			return bucketApi.NewCancelReplicationResyncDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewCancelReplicationResyncNoContent()
+	})
+}
+
+// replicationResyncStatusToModel merges the resync progress reported by MinIO with the
+// replication backlog of every target
+func replicationResyncStatusToModel(info replication.ResyncTargetsInfo, metrics replication.Metrics) *models.ReplicationResyncStatus {
+	status := &models.ReplicationResyncStatus{Targets: []*models.ReplicationResyncTarget{}}
+	for _, target := range info.Targets {
+		item := &models.ReplicationResyncTarget{
+			Arn:             target.Arn,
+			ResetID:         target.ResetID,
+			Status:          target.ResyncStatus,
+			ReplicatedCount: target.ReplicatedCount,
+			ReplicatedSize:  target.ReplicatedSize,
+			FailedCount:     target.FailedCount,
+			FailedSize:      target.FailedSize,
+		}
+		if !target.StartTime.IsZero() {
+			item.StartTime = target.StartTime.Format(time.RFC3339)
+		}
+		if !target.EndTime.IsZero() {
+			item.EndTime = target.EndTime.Format(time.RFC3339)
+		}
+		if target.Object != "" {
+			item.LastObject = target.Bucket + "/" + target.Object
+		}
+		if stats, ok := metrics.Stats[target.Arn]; ok {
+			item.PendingCount = int64(stats.PendingCount)
+			item.PendingSize = int64(stats.PendingSize)
+		}
+		status.Targets = append(status.Targets, item)
+	}
+	return status
+}
+
+// resyncInProgress returns whether any of the resyncs is still running
+func resyncInProgress(status *models.ReplicationResyncStatus) bool {
+	for _, target := range status.Targets {
+		if target.Status == "Pending" || target.Status == "Ongoing" {
+			return true
+		}
+	}
+	return false
+}
+
+func startReplicationResync(ctx context.Context, client MinioClient, bucketName string, body *models.ReplicationResyncRequest) (*models.ReplicationResyncStatus, error) {
+	if body.Arn == nil || strings.TrimSpace(*body.Arn) == "" {
+		return nil, fmt.Errorf("%w: a replication target is required", ErrInvalidReplicationResync)
+	}
+	if body.OlderThan < 0 {
+		return nil, fmt.Errorf("%w: olderThan can't be negative", ErrInvalidReplicationResync)
+	}
+	info, err := client.resetBucketReplicationOnTarget(ctx, bucketName, time.Duration(body.OlderThan)*time.Second, *body.Arn)
+	if err != nil {
+		return nil, err
+	}
+	return replicationResyncStatusToModel(info, replication.Metrics{}), nil
+}
+
+func getReplicationResyncStatus(ctx context.Context, client MinioClient, bucketName, arn string) (*models.ReplicationResyncStatus, error) {
+	info, err := client.getBucketReplicationResyncStatus(ctx, bucketName, arn)
+	if err != nil {
+		return nil, err
+	}
+	// the backlog is informative, we will tolerate this call failing
+	metrics, err := client.getBucketReplicationMetrics(ctx, bucketName)
+	if err != nil {
+		ErrorWithContext(ctx, fmt.Errorf("error fetching replication metrics for bucket %s: %v", bucketName, err))
+	}
+	return replicationResyncStatusToModel(info, metrics), nil
+}
+
+// cancelBucketReplicationResync cancels the resync running for a target, this release of minio-go
+// doesn't expose the call so the request is signed with the session credentials
+func cancelBucketReplicationResync(ctx context.Context, httpClient *http.Client, endpoint string, creds *credentials.Credentials, region, bucketName, arn string) error {
+	query := url.Values{"replication-reset-cancel": {""}, "arn": {arn}}
+	_, err := signedBucketRequest(ctx, httpClient, endpoint, creds, region, http.MethodPut, bucketName, query, nil)
+	return err
+}
+
+func getStartReplicationResyncResponse(session *models.Principal, params bucketApi.StartReplicationResyncParams) (*models.ReplicationResyncStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	status, err := startReplicationResync(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return status, nil
+}
+
+func getReplicationResyncStatusResponse(session *models.Principal, params bucketApi.GetReplicationResyncStatusParams) (*models.ReplicationResyncStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	arn := ""
+	if params.Arn != nil {
+		arn = *params.Arn
+	}
+	status, err := getReplicationResyncStatus(ctx, minioClient, params.BucketName, arn)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return status, nil
+}
+
+func getCancelReplicationResyncResponse(session *models.Principal, params bucketApi.CancelReplicationResyncParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if strings.TrimSpace(params.Arn) == "" {
+		return ErrorWithContext(ctx, fmt.Errorf("%w: a replication target is required", ErrInvalidReplicationResync))
+	}
+	minioURL, err := sessionMinIOServer(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	err = cancelBucketReplicationResync(ctx, GetConsoleHTTPClient(minioURL), minioURL,
+		getConsoleCredentialsFromSession(session), GetMinIORegion(), params.BucketName, params.Arn)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// getReplicationResyncOptionsFromReq parses requests like /replication-resync/<bucket>?arn=<arn>&interval=<seconds>
+func getReplicationResyncOptionsFromReq(req *http.Request) (*replicationResyncOptions, error) {
+	re := regexp.MustCompile(`(/replication-resync/)(.*?$)`)
+	matches := re.FindStringSubmatch(req.URL.Path)
+	if len(matches) < 3 || strings.TrimSpace(matches[2]) == "" {
+		return nil, fmt.Errorf("invalid url: %s", req.URL.Path)
+	}
+	opts := &replicationResyncOptions{
+		BucketName: strings.TrimSpace(matches[2]),
+		Arn:        req.FormValue("arn"),
+		Interval:   defaultResyncStreamInterval,
+	}
+	if interval := req.FormValue("interval"); interval != "" {
+		seconds, err := strconv.ParseFloat(interval, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval: %s", interval)
+		}
+		opts.Interval = time.Duration(seconds * float64(time.Second))
+		if opts.Interval < minResyncStreamInterval {
+			opts.Interval = minResyncStreamInterval
+		}
+	}
+	return opts, nil
+}
+
+// startReplicationResyncStream sends the progress of the resyncs of a bucket until all of them end
+func startReplicationResyncStream(ctx context.Context, conn WSConn, client MinioClient, opts *replicationResyncOptions) error {
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+	for {
+		status, err := getReplicationResyncStatus(ctx, client, opts.BucketName, opts.Arn)
+		if err != nil {
+			return err
+		}
+		message, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		if err = conn.writeMessage(websocket.TextMessage, message); err != nil {
+			return err
+		}
+		if !resyncInProgress(status) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartReplicationResync(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	var gotOlderThan time.Duration
+	minioResetBucketReplicationMock = func(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error) {
+		gotOlderThan = olderThan
+		return replication.ResyncTargetsInfo{Targets: []replication.ResyncTarget{
+			{Arn: arn, ResetID: "reset-1", ResyncStatus: "Pending"},
+		}}, nil
+	}
+
+	status, err := startReplicationResync(ctx, minClient, "source", &models.ReplicationResyncRequest{
+		Arn:       swag.String("arn:minio:replication::a:dest"),
+		OlderThan: 3600,
+	})
+	assert.NoError(err)
+	assert.Equal(time.Hour, gotOlderThan)
+	assert.Len(status.Targets, 1)
+	assert.Equal("reset-1", status.Targets[0].ResetID)
+	assert.True(resyncInProgress(status))
+
+	_, err = startReplicationResync(ctx, minClient, "source", &models.ReplicationResyncRequest{Arn: swag.String(" ")})
+	assert.ErrorIs(err, ErrInvalidReplicationResync)
+	_, err = startReplicationResync(ctx, minClient, "source", &models.ReplicationResyncRequest{
+		Arn:       swag.String("arn:minio:replication::a:dest"),
+		OlderThan: -1,
+	})
+	assert.ErrorIs(err, ErrInvalidReplicationResync)
+}
+
+func TestGetReplicationResyncStatus(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	minioGetReplicationResyncMock = func(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+		return replication.ResyncTargetsInfo{Targets: []replication.ResyncTarget{
+			{
+				Arn:             "arn:minio:replication::a:dest",
+				ResyncStatus:    "Ongoing",
+				StartTime:       start,
+				ReplicatedCount: 10,
+				ReplicatedSize:  1024,
+				FailedCount:     1,
+				FailedSize:      12,
+				Bucket:          "source",
+				Object:          "photos/a.jpg",
+			},
+		}}, nil
+	}
+	minioGetReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{Stats: map[string]replication.TargetMetrics{
+			"arn:minio:replication::a:dest": {PendingCount: 5, PendingSize: 500},
+		}}, nil
+	}
+
+	status, err := getReplicationResyncStatus(ctx, minClient, "source", "")
+	assert.NoError(err)
+	assert.Equal(&models.ReplicationResyncTarget{
+		Arn:             "arn:minio:replication::a:dest",
+		Status:          "Ongoing",
+		StartTime:       "2023-05-01T10:00:00Z",
+		ReplicatedCount: 10,
+		ReplicatedSize:  1024,
+		FailedCount:     1,
+		FailedSize:      12,
+		PendingCount:    5,
+		PendingSize:     500,
+		LastObject:      "source/photos/a.jpg",
+	}, status.Targets[0])
+
+	// the backlog is left empty when the metrics can't be fetched
+	minioGetReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{}, errors.New("metrics unavailable")
+	}
+	status, err = getReplicationResyncStatus(ctx, minClient, "source", "")
+	assert.NoError(err)
+	assert.Zero(status.Targets[0].PendingCount)
+
+	minioGetReplicationResyncMock = func(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+		return replication.ResyncTargetsInfo{}, errors.New("no resync in progress")
+	}
+	_, err = getReplicationResyncStatus(ctx, minClient, "source", "")
+	assert.EqualError(err, "no resync in progress")
+}
+
+func TestCancelBucketReplicationResync(t *testing.T) {
+	assert := assert.New(t)
+	var gotRequest *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRequest = r
+		if r.URL.Query().Get("arn") == "unknown" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>XMinioAdminRemoteArnInvalid</Code><Message>The bucket remote ARN does not have correct format</Message></Error>`))
+			return
+		}
+		w.Write([]byte("reset-1"))
+	}))
+	defer server.Close()
+	creds := credentials.NewStaticV4("access", "secret12345", "")
+
+	err := cancelBucketReplicationResync(context.Background(), server.Client(), server.URL, creds, "", "source", "arn:minio:replication::a:dest")
+	assert.NoError(err)
+	assert.Equal(http.MethodPut, gotRequest.Method)
+	assert.Equal("/source", gotRequest.URL.Path)
+	_, ok := gotRequest.URL.Query()["replication-reset-cancel"]
+	assert.True(ok)
+	assert.Equal("arn:minio:replication::a:dest", gotRequest.URL.Query().Get("arn"))
+	assert.True(strings.HasPrefix(gotRequest.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/"))
+
+	err = cancelBucketReplicationResync(context.Background(), server.Client(), server.URL, creds, "", "source", "unknown")
+	assert.Equal("XMinioAdminRemoteArnInvalid", minio.ToErrorResponse(err).Code)
+}
+
+func TestStartReplicationResyncStream(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	mockWSConn := mockConn{}
+	statuses := []string{"Ongoing", "Ongoing", "Completed"}
+	calls := 0
+	minioGetReplicationResyncMock = func(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+		status := statuses[calls]
+		calls++
+		return replication.ResyncTargetsInfo{Targets: []replication.ResyncTarget{{Arn: arn, ResyncStatus: status}}}, nil
+	}
+	minioGetReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{}, nil
+	}
+	var messages []models.ReplicationResyncStatus
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var status models.ReplicationResyncStatus
+		assert.NoError(json.Unmarshal(data, &status))
+		messages = append(messages, status)
+		return nil
+	}
+
+	err := startReplicationResyncStream(ctx, mockWSConn, minClient, &replicationResyncOptions{
+		BucketName: "source",
+		Arn:        "arn:minio:replication::a:dest",
+		Interval:   time.Millisecond,
+	})
+	assert.NoError(err)
+	assert.Len(messages, 3)
+	assert.Equal("Completed", messages[2].Targets[0].Status)
+
+	// write errors end the stream
+	calls = 0
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		return errors.New("connection closed")
+	}
+	err = startReplicationResyncStream(ctx, mockWSConn, minClient, &replicationResyncOptions{BucketName: "source", Interval: time.Millisecond})
+	assert.EqualError(err, "connection closed")
+}
+
+func TestGetReplicationResyncOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+	req := httptest.NewRequest(http.MethodGet, "/ws/replication-resync/source?arn=arn:minio:replication::a:dest&interval=0.1", nil)
+	opts, err := getReplicationResyncOptionsFromReq(req)
+	assert.NoError(err)
+	assert.Equal("source", opts.BucketName)
+	assert.Equal("arn:minio:replication::a:dest", opts.Arn)
+	assert.Equal(minResyncStreamInterval, opts.Interval)
+
+	req = httptest.NewRequest(http.MethodGet, "/ws/replication-resync/source", nil)
+	opts, err = getReplicationResyncOptionsFromReq(req)
+	assert.NoError(err)
+	assert.Equal(defaultResyncStreamInterval, opts.Interval)
+
+	req = httptest.NewRequest(http.MethodGet, "/ws/replication-resync/", nil)
+	_, err = getReplicationResyncOptionsFromReq(req)
+	assert.Error(err)
+
+	req = httptest.NewRequest(http.MethodGet, "/ws/replication-resync/source?interval=soon", nil)
+	_, err = getReplicationResyncOptionsFromReq(req)
+	assert.Error(err)
+}
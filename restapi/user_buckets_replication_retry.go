@@ -0,0 +1,329 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	defaultReplicationRetryBatchSize = 100
+	maxReplicationRetryBatchSize     = 1000
+	// time given to MinIO to replicate a batch before checking its status again
+	replicationRetrySettleTime = 10 * time.Second
+	// finished jobs are kept so their summary can be consulted
+	replicationRetryJobRetention = time.Hour
+	// maximum number of persistent failures reported in detail
+	maxReplicationRetryFailures = 1000
+
+	replicationStatusHeader = "X-Amz-Replication-Status"
+)
+
+func registerReplicationRetryHandlers(api *operations.ConsoleAPI) {
+	// start replication retry job
+	api.BucketStartReplicationRetryHandler = bucketApi.StartReplicationRetryHandlerFunc(func(params bucketApi.StartReplicationRetryParams, session *models.Principal) middleware.Responder {
+		resp, err := getStartReplicationRetryResponse(session, params)
+		if err != nil {
+			return bucketApi.NewStartReplicationRetryDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewStartReplicationRetryCreated().WithPayload(resp)
+	})
+	// get replication retry job
+	api.BucketGetReplicationRetryJobHandler = bucketApi.GetReplicationRetryJobHandlerFunc(func(params bucketApi.GetReplicationRetryJobParams, session *models.Principal) middleware.Responder {
+		job, owner := globalReplicationRetryJobs.get(params.JobID), sessionOwner(session)
+		if job == nil || owner == "" || job.owner != owner || job.bucket != params.BucketName {
+			err := ErrorWithContext(params.HTTPRequest.Context(), ErrNotFound)
+			return bucketApi.NewGetReplicationRetryJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetReplicationRetryJobOK().WithPayload(job.toModel())
+	})
+}
+
+// replicationRetryJob re-triggers the replication of the objects of a bucket whose
+// replication status is FAILED
+type replicationRetryJob struct {
+	id        string
+	owner     string
+	bucket    string
+	prefix    string
+	batchSize int
+	settle    time.Duration
+
+	mu       sync.Mutex
+	state    string
+	started  time.Time
+	finished time.Time
+	// counters of the job progress
+	scanned     int64
+	failed      int64
+	retriggered int64
+	replicated  int64
+	pending     int64
+	persistent  int64
+	failures    []*models.ReplicationRetryFailure
+	err         error
+}
+
+func (j *replicationRetryJob) toModel() *models.ReplicationRetryJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	m := &models.ReplicationRetryJob{
+		ID:                      j.id,
+		Bucket:                  j.bucket,
+		Prefix:                  j.prefix,
+		State:                   j.state,
+		Started:                 j.started.Format(time.RFC3339),
+		Scanned:                 j.scanned,
+		FailedObjects:           j.failed,
+		Retriggered:             j.retriggered,
+		Replicated:              j.replicated,
+		Pending:                 j.pending,
+		PersistentFailuresCount: j.persistent,
+		PersistentFailures:      append([]*models.ReplicationRetryFailure{}, j.failures...),
+	}
+	if !j.finished.IsZero() {
+		m.Finished = j.finished.Format(time.RFC3339)
+	}
+	if j.err != nil {
+		m.Error = j.err.Error()
+	}
+	return m
+}
+
+func (j *replicationRetryJob) addFailure(obj minio.ObjectInfo, reason string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.persistent++
+	if len(j.failures) < maxReplicationRetryFailures {
+		j.failures = append(j.failures, &models.ReplicationRetryFailure{Object: obj.Key, VersionID: obj.VersionID, Error: reason})
+	}
+}
+
+func (j *replicationRetryJob) update(fn func(j *replicationRetryJob)) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	fn(j)
+}
+
+type replicationRetryJobs struct {
+	mu   sync.Mutex
+	jobs map[string]*replicationRetryJob
+}
+
+var globalReplicationRetryJobs = &replicationRetryJobs{jobs: make(map[string]*replicationRetryJob)}
+
+func (r *replicationRetryJobs) get(id string) *replicationRetryJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.jobs[id]
+}
+
+func (r *replicationRetryJobs) add(job *replicationRetryJob) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[job.id] = job
+}
+
+func (r *replicationRetryJobs) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.jobs, id)
+}
+
+// objectReplicationStatus returns the replication status of a listed object, falling back
+// to a stat call when the listing doesn't include it
+func objectReplicationStatus(ctx context.Context, client MinioClient, bucket string, obj minio.ObjectInfo) (string, error) {
+	if status, ok := obj.UserMetadata[replicationStatusHeader]; ok {
+		return status, nil
+	}
+	if obj.ReplicationStatus != "" {
+		return obj.ReplicationStatus, nil
+	}
+	info, err := client.statObject(ctx, bucket, obj.Key, minio.GetObjectOptions{VersionID: obj.VersionID})
+	if err != nil {
+		return "", err
+	}
+	return info.ReplicationStatus, nil
+}
+
+// retriggerReplication queues the object for replication again. Setting the tags of the
+// object, even to their current value, makes MinIO replicate it, including its data when
+// the object is missing on the target.
+func retriggerReplication(ctx context.Context, client MinioClient, bucket string, obj minio.ObjectInfo) error {
+	objTags, err := client.getObjectTagging(ctx, bucket, obj.Key, minio.GetObjectTaggingOptions{VersionID: obj.VersionID})
+	if err != nil {
+		return err
+	}
+	return client.putObjectTagging(ctx, bucket, obj.Key, objTags, minio.PutObjectTaggingOptions{VersionID: obj.VersionID})
+}
+
+// processBatch re-triggers the replication of a batch of objects and, after giving
+// MinIO some time to replicate them, records the ones still failing
+func (j *replicationRetryJob) processBatch(ctx context.Context, client MinioClient, batch []minio.ObjectInfo) error {
+	var retriggered []minio.ObjectInfo
+	for _, obj := range batch {
+		if err := retriggerReplication(ctx, client, j.bucket, obj); err != nil {
+			j.addFailure(obj, err.Error())
+			continue
+		}
+		retriggered = append(retriggered, obj)
+	}
+	j.update(func(j *replicationRetryJob) { j.retriggered += int64(len(retriggered)) })
+	if len(retriggered) == 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(j.settle):
+	}
+
+	for _, obj := range retriggered {
+		info, err := client.statObject(ctx, j.bucket, obj.Key, minio.GetObjectOptions{VersionID: obj.VersionID})
+		if err != nil {
+			j.addFailure(obj, err.Error())
+			continue
+		}
+		switch strings.ToUpper(info.ReplicationStatus) {
+		case string(minio.ReplicationStatusFailed):
+			j.addFailure(obj, "replication failed again after being re-triggered")
+		case string(minio.ReplicationStatusComplete):
+			j.update(func(j *replicationRetryJob) { j.replicated++ })
+		default:
+			j.update(func(j *replicationRetryJob) { j.pending++ })
+		}
+	}
+	return nil
+}
+
+// run lists the objects under the job prefix, including older versions, and retries the
+// replication of the failed ones in batches
+func (j *replicationRetryJob) run(ctx context.Context, client MinioClient) error {
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var batch []minio.ObjectInfo
+	for obj := range client.listObjects(lctx, j.bucket, minio.ListObjectsOptions{
+		Prefix:       j.prefix,
+		Recursive:    true,
+		WithVersions: true,
+		WithMetadata: true,
+	}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		// delete markers can't be tagged
+		if obj.IsDeleteMarker {
+			continue
+		}
+		j.update(func(j *replicationRetryJob) { j.scanned++ })
+		status, err := objectReplicationStatus(lctx, client, j.bucket, obj)
+		if err != nil {
+			j.addFailure(obj, fmt.Sprintf("unable to get replication status: %v", err))
+			continue
+		}
+		if !strings.EqualFold(status, string(minio.ReplicationStatusFailed)) {
+			continue
+		}
+		j.update(func(j *replicationRetryJob) { j.failed++ })
+		batch = append(batch, obj)
+		if len(batch) >= j.batchSize {
+			if err := j.processBatch(lctx, client, batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		return j.processBatch(lctx, client, batch)
+	}
+	return nil
+}
+
+func (j *replicationRetryJob) finish(err error) {
+	j.update(func(j *replicationRetryJob) {
+		j.finished = time.Now().UTC()
+		j.err = err
+		j.state = models.ReplicationRetryJobStateCompleted
+		if err != nil {
+			j.state = models.ReplicationRetryJobStateFailed
+		}
+	})
+}
+
+func getStartReplicationRetryResponse(session *models.Principal, params bucketApi.StartReplicationRetryParams) (*models.ReplicationRetryJob, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	batchSize := defaultReplicationRetryBatchSize
+	if params.Body.BatchSize > 0 {
+		batchSize = int(params.Body.BatchSize)
+	}
+	if batchSize > maxReplicationRetryBatchSize {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("batch_size can't be larger than %d", maxReplicationRetryBatchSize))
+	}
+	// the jobs of the sessions without an owner would be visible to all of them
+	owner := sessionOwner(session)
+	if owner == "" {
+		return nil, ErrorWithContext(ctx, ErrInvalidSession)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	// make sure the bucket has replication configured before starting
+	if _, err := minioClient.getBucketReplication(ctx, params.BucketName); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+
+	id, err := utils.NewUUID()
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	job := &replicationRetryJob{
+		id:        id,
+		owner:     owner,
+		bucket:    params.BucketName,
+		prefix:    params.Body.Prefix,
+		batchSize: batchSize,
+		settle:    replicationRetrySettleTime,
+		state:     models.ReplicationRetryJobStateRunning,
+		started:   time.Now().UTC(),
+	}
+	globalReplicationRetryJobs.add(job)
+	// the job outlives the request that started it
+	go func() {
+		job.finish(job.run(context.Background(), minioClient))
+		time.AfterFunc(replicationRetryJobRetention, func() {
+			globalReplicationRetryJobs.remove(job.id)
+		})
+	}()
+	return job.toModel(), nil
+}
@@ -0,0 +1,112 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_replicationRetryJob(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+
+	failed := minio.StringMap{replicationStatusHeader: "FAILED"}
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.True(opts.Recursive)
+		assert.True(opts.WithVersions)
+		objs := []minio.ObjectInfo{
+			{Key: "a.txt", VersionID: "1", UserMetadata: failed},
+			{Key: "b.txt", VersionID: "1", UserMetadata: minio.StringMap{replicationStatusHeader: "COMPLETED"}},
+			{Key: "c.txt", VersionID: "2", UserMetadata: failed},
+			{Key: "d.txt", VersionID: "1", IsDeleteMarker: true},
+			// status not included in the listing
+			{Key: "e.txt", VersionID: "3"},
+			{Key: "f.txt", VersionID: "1", UserMetadata: failed},
+		}
+		ch := make(chan minio.ObjectInfo, len(objs))
+		for _, obj := range objs {
+			ch <- obj
+		}
+		close(ch)
+		return ch
+	}
+	var tagged []string
+	minioGetObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		if objectName == "c.txt" {
+			return nil, errors.New("access denied")
+		}
+		return tags.NewTags(map[string]string{"team": "storage"}, true)
+	}
+	minioPutObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error {
+		assert.Equal("storage", otags.ToMap()["team"])
+		tagged = append(tagged, objectName+"@"+opts.VersionID)
+		return nil
+	}
+	// status of every object once its replication was re-triggered
+	afterRetry := map[string]string{
+		"a.txt": "COMPLETED",
+		"e.txt": "PENDING",
+		"f.txt": "FAILED",
+	}
+	statCalls := map[string]int{}
+	minioStatObjectMock = func(ctx context.Context, bucketName, prefix string, opts minio.GetObjectOptions) (minio.ObjectInfo, error) {
+		statCalls[prefix]++
+		// e.txt is stat while listing as its status isn't part of the listing
+		if prefix == "e.txt" && statCalls[prefix] == 1 {
+			return minio.ObjectInfo{Key: prefix, ReplicationStatus: "FAILED"}, nil
+		}
+		return minio.ObjectInfo{Key: prefix, ReplicationStatus: afterRetry[prefix]}, nil
+	}
+
+	job := &replicationRetryJob{bucket: "bucket", batchSize: 2, state: models.ReplicationRetryJobStateRunning}
+	job.finish(job.run(context.Background(), client))
+
+	m := job.toModel()
+	assert.Equal(models.ReplicationRetryJobStateCompleted, m.State)
+	assert.Equal(int64(5), m.Scanned)
+	assert.Equal(int64(4), m.FailedObjects)
+	assert.Equal(int64(3), m.Retriggered)
+	assert.Equal(int64(1), m.Replicated)
+	assert.Equal(int64(1), m.Pending)
+	assert.Equal(int64(2), m.PersistentFailuresCount)
+	assert.Equal([]string{"a.txt@1", "e.txt@3", "f.txt@1"}, tagged)
+	if assert.Len(m.PersistentFailures, 2) {
+		assert.Equal("c.txt", m.PersistentFailures[0].Object)
+		assert.Equal("access denied", m.PersistentFailures[0].Error)
+		assert.Equal("f.txt", m.PersistentFailures[1].Object)
+	}
+
+	// a listing error fails the job
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Err: errors.New("bucket not found")}
+		close(ch)
+		return ch
+	}
+	job = &replicationRetryJob{bucket: "bucket", batchSize: 2, state: models.ReplicationRetryJobStateRunning}
+	job.finish(job.run(context.Background(), client))
+	m = job.toModel()
+	assert.Equal(models.ReplicationRetryJobStateFailed, m.State)
+	assert.Equal("bucket not found", m.Error)
+}
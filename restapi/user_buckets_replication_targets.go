@@ -0,0 +1,285 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+const (
+	// how long the probe of a replication target is reused before probing it again
+	replicationHealthProbeInterval = 10 * time.Second
+	// how long a replication target has to answer its probe to be online
+	replicationHealthProbeTimeout = 3 * time.Second
+)
+
+// replicationTargetHealth is the health of a replication target as observed by Console, the admin API doesn't report
+// it so the liveness endpoint of the targets is probed and the downtime is counted between the probes
+type replicationTargetHealth struct {
+	online        bool
+	probedAt      time.Time
+	lastOnline    time.Time
+	totalDowntime time.Duration
+	// latency of the last probe and the sum and max of the latencies of the probes answered
+	latency, latencySum, latencyMax time.Duration
+	answered                        int64
+}
+
+// latencyAverage returns the average latency of the probes answered
+func (h replicationTargetHealth) latencyAverage() time.Duration {
+	if h.answered == 0 {
+		return 0
+	}
+	return h.latencySum / time.Duration(h.answered)
+}
+
+// replicationHealthTracker keeps the health of the replication targets by ARN
+type replicationHealthTracker struct {
+	sync.Mutex
+	targets map[string]*replicationTargetHealth
+	probe   func(ctx context.Context, target madmin.BucketTarget) (time.Duration, error)
+	now     func() time.Time
+}
+
+var globalReplicationHealth = &replicationHealthTracker{
+	targets: map[string]*replicationTargetHealth{},
+	probe:   probeReplicationTarget,
+	now:     time.Now,
+}
+
+// health returns the health of the target, which is probed again when its last probe is older than
+// replicationHealthProbeInterval
+func (t *replicationHealthTracker) health(ctx context.Context, target madmin.BucketTarget) replicationTargetHealth {
+	t.Lock()
+	h, ok := t.targets[target.Arn]
+	if !ok {
+		h = &replicationTargetHealth{}
+		t.targets[target.Arn] = h
+	}
+	if ok && t.now().Sub(h.probedAt) < replicationHealthProbeInterval {
+		defer t.Unlock()
+		return *h
+	}
+	t.Unlock()
+
+	latency, err := t.probe(ctx, target)
+	now := t.now()
+	t.Lock()
+	defer t.Unlock()
+	if !h.probedAt.IsZero() && !h.online {
+		h.totalDowntime += now.Sub(h.probedAt)
+	}
+	h.probedAt = now
+	h.online = err == nil
+	h.latency = 0
+	if h.online {
+		h.lastOnline = now
+		h.latency = latency
+		h.latencySum += latency
+		h.answered++
+		if latency > h.latencyMax {
+			h.latencyMax = latency
+		}
+	}
+	return *h
+}
+
+// healthOf returns the health of the targets, the ones due are probed in parallel
+func (t *replicationHealthTracker) healthOf(ctx context.Context, targets []madmin.BucketTarget) []replicationTargetHealth {
+	health := make([]replicationTargetHealth, len(targets))
+	var wg sync.WaitGroup
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			health[i] = t.health(ctx, targets[i])
+		}(i)
+	}
+	wg.Wait()
+	return health
+}
+
+var (
+	replicationHealthClient     *http.Client
+	replicationHealthClientOnce sync.Once
+)
+
+// probeReplicationTarget calls the liveness endpoint of the MinIO target and returns how long it took to answer
+func probeReplicationTarget(ctx context.Context, target madmin.BucketTarget) (time.Duration, error) {
+	replicationHealthClientOnce.Do(func() {
+		replicationHealthClient = PrepareConsoleHTTPClient(false)
+	})
+	ctx, cancel := context.WithTimeout(ctx, replicationHealthProbeTimeout)
+	defer cancel()
+	scheme := "http"
+	if target.Secure {
+		scheme = "https"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s://%s/minio/health/live", scheme, target.Endpoint), nil)
+	if err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	resp, err := replicationHealthClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("the target answered %s", resp.Status)
+	}
+	return time.Since(start), nil
+}
+
+func registerBucketReplicationTargetsHandlers(api *operations.ConsoleAPI) {
+	// list the remote targets of a bucket with their health
+	api.BucketListBucketReplicationTargetsHandler = bucketApi.ListBucketReplicationTargetsHandlerFunc(func(params bucketApi.ListBucketReplicationTargetsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListBucketReplicationTargetsResponse(session, params)
+		if err != nil {
+			return bucketApi.NewListBucketReplicationTargetsDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewListBucketReplicationTargetsOK().WithPayload(resp)
+	})
+	// reorder the replication rules of a bucket
+	api.BucketSetBucketReplicationPrioritiesHandler = bucketApi.SetBucketReplicationPrioritiesHandlerFunc(func(params bucketApi.SetBucketReplicationPrioritiesParams, session *models.Principal) middleware.Responder {
+		if err := getSetBucketReplicationPrioritiesResponse(session, params); err != nil {
+			return bucketApi.NewSetBucketReplicationPrioritiesDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewSetBucketReplicationPrioritiesNoContent()
+	})
+}
+
+// listBucketReplicationTargets returns the remote targets of a bucket with their health along with the replication rules
+// using them
+func listBucketReplicationTargets(ctx context.Context, ac MinioAdmin, client MinioClient, tracker *replicationHealthTracker, bucketName string) ([]*models.BucketReplicationTarget, error) {
+	targets, err := ac.listRemoteBuckets(ctx, bucketName, "replication")
+	if err != nil {
+		return nil, err
+	}
+	// a bucket can have remote targets without any rule yet, we will tolerate this call failing
+	cfg, err := client.getBucketReplication(ctx, bucketName)
+	if err != nil {
+		ErrorWithContext(ctx, fmt.Errorf("error fetching replication configuration for bucket %s: %v", bucketName, err))
+	}
+	rulesByARN := map[string][]string{}
+	for _, rule := range cfg.Rules {
+		rulesByARN[rule.Destination.Bucket] = append(rulesByARN[rule.Destination.Bucket], rule.ID)
+	}
+
+	health := tracker.healthOf(ctx, targets)
+	result := []*models.BucketReplicationTarget{}
+	for i, target := range targets {
+		item := &models.BucketReplicationTarget{
+			Arn:               target.Arn,
+			Endpoint:          target.Endpoint,
+			TargetBucket:      target.TargetBucket,
+			SyncMode:          "async",
+			Bandwidth:         target.BandwidthLimit,
+			HealthCheckPeriod: int64(target.HealthCheckDuration.Seconds()),
+			Online:            health[i].online,
+			TotalDowntime:     int64(health[i].totalDowntime.Seconds()),
+			LatencyCurrent:    health[i].latency.Milliseconds(),
+			LatencyAverage:    health[i].latencyAverage().Milliseconds(),
+			LatencyMax:        health[i].latencyMax.Milliseconds(),
+			Rules:             rulesByARN[target.Arn],
+		}
+		if target.ReplicationSync {
+			item.SyncMode = "sync"
+		}
+		if !health[i].lastOnline.IsZero() {
+			item.LastOnline = health[i].lastOnline.UTC().Format(time.RFC3339)
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// setBucketReplicationPriorities updates the priority of the given rules, MinIO requires every rule of a bucket
+// to have a different priority so the resulting configuration is checked before storing it
+func setBucketReplicationPriorities(ctx context.Context, client MinioClient, bucketName string, priorities []*models.BucketReplicationRulePriority) error {
+	cfg, err := client.getBucketReplication(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	rules := map[string]*replication.Rule{}
+	for i := range cfg.Rules {
+		rules[cfg.Rules[i].ID] = &cfg.Rules[i]
+	}
+	for _, priority := range priorities {
+		rule, ok := rules[*priority.ID]
+		if !ok {
+			return fmt.Errorf("%w: rule %s not found", ErrInvalidReplicationPriority, *priority.ID)
+		}
+		if *priority.Priority < 1 {
+			return fmt.Errorf("%w: priority of rule %s must be greater than zero", ErrInvalidReplicationPriority, *priority.ID)
+		}
+		rule.Priority = int(*priority.Priority)
+	}
+	inUse := map[int]string{}
+	for _, rule := range cfg.Rules {
+		if other, ok := inUse[rule.Priority]; ok {
+			return fmt.Errorf("%w: rules %s and %s share priority %d", ErrInvalidReplicationPriority, other, rule.ID, rule.Priority)
+		}
+		inUse[rule.Priority] = rule.ID
+	}
+	return client.setBucketReplication(ctx, bucketName, cfg)
+}
+
+func getListBucketReplicationTargetsResponse(session *models.Principal, params bucketApi.ListBucketReplicationTargetsParams) (*models.BucketReplicationTargetsResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	adminClient := AdminClient{Client: mAdmin}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	targets, err := listBucketReplicationTargets(ctx, adminClient, minioClient, globalReplicationHealth, params.BucketName)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.BucketReplicationTargetsResponse{Targets: targets}, nil
+}
+
+func getSetBucketReplicationPrioritiesResponse(session *models.Principal, params bucketApi.SetBucketReplicationPrioritiesParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	if err := setBucketReplicationPriorities(ctx, minioClient, params.BucketName, params.Body.Rules); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+// replicationTargetsAdminMock returns a fixed set of remote targets
+type replicationTargetsAdminMock struct {
+	AdminClientMock
+	targets []madmin.BucketTarget
+}
+
+func (ac replicationTargetsAdminMock) listRemoteBuckets(_ context.Context, _, _ string) ([]madmin.BucketTarget, error) {
+	return ac.targets, nil
+}
+
+// replicationHealthTrackerMock returns a tracker probing the targets with the latencies given, the targets without
+// one are offline
+func replicationHealthTrackerMock(now *time.Time, latencies map[string]time.Duration) *replicationHealthTracker {
+	return &replicationHealthTracker{
+		targets: map[string]*replicationTargetHealth{},
+		probe: func(_ context.Context, target madmin.BucketTarget) (time.Duration, error) {
+			if latency, ok := latencies[target.Endpoint]; ok {
+				return latency, nil
+			}
+			return 0, errors.New("connection refused")
+		},
+		now: func() time.Time { return *now },
+	}
+}
+
+func TestListBucketReplicationTargets(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	latencies := map[string]time.Duration{"site-a:9000": 5 * time.Millisecond}
+	tracker := replicationHealthTrackerMock(&now, latencies)
+	adminClient := replicationTargetsAdminMock{targets: []madmin.BucketTarget{
+		{
+			Arn:                 "arn:minio:replication::a:dest",
+			Endpoint:            "site-a:9000",
+			TargetBucket:        "dest",
+			ReplicationSync:     true,
+			HealthCheckDuration: 30 * time.Second,
+		},
+		{
+			Arn:          "arn:minio:replication::b:dest",
+			Endpoint:     "site-b:9000",
+			TargetBucket: "dest",
+		},
+	}}
+	minClient := minioClientMock{}
+	minioGetBucketReplicationMock = func(ctx context.Context, bucketName string) (replication.Config, error) {
+		return replication.Config{Rules: []replication.Rule{
+			{ID: "rule-a", Priority: 1, Destination: replication.Destination{Bucket: "arn:minio:replication::a:dest"}},
+			{ID: "rule-a-logs", Priority: 2, Destination: replication.Destination{Bucket: "arn:minio:replication::a:dest"}},
+			{ID: "rule-b", Priority: 3, Destination: replication.Destination{Bucket: "arn:minio:replication::b:dest"}},
+		}}, nil
+	}
+
+	targets, err := listBucketReplicationTargets(ctx, adminClient, minClient, tracker, "source")
+	assert.NoError(err)
+	assert.Len(targets, 2)
+	assert.Equal(&models.BucketReplicationTarget{
+		Arn:               "arn:minio:replication::a:dest",
+		Endpoint:          "site-a:9000",
+		TargetBucket:      "dest",
+		SyncMode:          "sync",
+		HealthCheckPeriod: 30,
+		Online:            true,
+		LastOnline:        "2023-05-01T10:00:00Z",
+		LatencyCurrent:    5,
+		LatencyAverage:    5,
+		LatencyMax:        5,
+		Rules:             []string{"rule-a", "rule-a-logs"},
+	}, targets[0])
+	assert.False(targets[1].Online)
+	assert.Empty(targets[1].LastOnline)
+	assert.Equal("async", targets[1].SyncMode)
+	assert.Equal([]string{"rule-b"}, targets[1].Rules)
+
+	// the probes are reused until they're due, the downtime is counted between the probes
+	latencies["site-a:9000"] = 20 * time.Millisecond
+	now = now.Add(time.Second)
+	targets, err = listBucketReplicationTargets(ctx, adminClient, minClient, tracker, "source")
+	assert.NoError(err)
+	assert.Equal(int64(5), targets[0].LatencyCurrent)
+	now = now.Add(2 * time.Minute)
+	targets, err = listBucketReplicationTargets(ctx, adminClient, minClient, tracker, "source")
+	assert.NoError(err)
+	assert.Equal(int64(20), targets[0].LatencyCurrent)
+	assert.Equal(int64(12), targets[0].LatencyAverage)
+	assert.Equal(int64(20), targets[0].LatencyMax)
+	assert.Equal("2023-05-01T10:02:01Z", targets[0].LastOnline)
+	assert.Equal(int64(121), targets[1].TotalDowntime)
+
+	// targets are still listed when the bucket has no replication configuration
+	minioGetBucketReplicationMock = func(ctx context.Context, bucketName string) (replication.Config, error) {
+		return replication.Config{}, errors.New("The replication configuration was not found")
+	}
+	targets, err = listBucketReplicationTargets(ctx, adminClient, minClient, tracker, "source")
+	assert.NoError(err)
+	assert.Len(targets, 2)
+	assert.Empty(targets[0].Rules)
+}
+
+func TestSetBucketReplicationPriorities(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	minioGetBucketReplicationMock = func(ctx context.Context, bucketName string) (replication.Config, error) {
+		return replication.Config{Rules: []replication.Rule{
+			{ID: "rule-a", Priority: 1},
+			{ID: "rule-b", Priority: 2},
+			{ID: "rule-c", Priority: 3},
+		}}, nil
+	}
+	var stored replication.Config
+	minioSetBucketReplicationMock = func(ctx context.Context, bucketName string, cfg replication.Config) error {
+		stored = cfg
+		return nil
+	}
+	priority := func(id string, value int32) *models.BucketReplicationRulePriority {
+		return &models.BucketReplicationRulePriority{ID: swag.String(id), Priority: swag.Int32(value)}
+	}
+
+	// swap the first and last rules
+	err := setBucketReplicationPriorities(ctx, minClient, "source", []*models.BucketReplicationRulePriority{
+		priority("rule-a", 3),
+		priority("rule-c", 1),
+	})
+	assert.NoError(err)
+	assert.Equal(3, stored.Rules[0].Priority)
+	assert.Equal(2, stored.Rules[1].Priority)
+	assert.Equal(1, stored.Rules[2].Priority)
+
+	stored = replication.Config{}
+	err = setBucketReplicationPriorities(ctx, minClient, "source", []*models.BucketReplicationRulePriority{
+		priority("rule-a", 2),
+	})
+	assert.ErrorIs(err, ErrInvalidReplicationPriority)
+	assert.Empty(stored.Rules)
+
+	err = setBucketReplicationPriorities(ctx, minClient, "source", []*models.BucketReplicationRulePriority{
+		priority("rule-z", 4),
+	})
+	assert.ErrorIs(err, ErrInvalidReplicationPriority)
+
+	err = setBucketReplicationPriorities(ctx, minClient, "source", []*models.BucketReplicationRulePriority{
+		priority("rule-a", 0),
+	})
+	assert.ErrorIs(err, ErrInvalidReplicationPriority)
+	assert.Empty(stored.Rules)
+}
+
+func TestBucketReplicationRuleFromConfig(t *testing.T) {
+	assert := assert.New(t)
+	rule := replication.Rule{
+		ID:                        "rule-a",
+		Status:                    replication.Enabled,
+		Priority:                  4,
+		DeleteMarkerReplication:   replication.DeleteMarkerReplication{Status: replication.Enabled},
+		DeleteReplication:         replication.DeleteReplication{Status: replication.Disabled},
+		ExistingObjectReplication: replication.ExistingObjectReplication{Status: replication.Enabled},
+		SourceSelectionCriteria: replication.SourceSelectionCriteria{
+			ReplicaModifications: replication.ReplicaModifications{Status: replication.Enabled},
+		},
+		Destination: replication.Destination{Bucket: "arn:minio:replication::a:dest"},
+	}
+	model := bucketReplicationRuleFromConfig(rule)
+	assert.True(model.DeleteMarkerReplication)
+	assert.False(model.DeletesReplication)
+	assert.True(model.ExistingObjects)
+	assert.True(model.MetadataReplication)
+	assert.Equal(int32(4), model.Priority)
+	assert.Equal("Enabled", model.Status)
+	assert.Equal("arn:minio:replication::a:dest", model.Destination.Bucket)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/minio/madmin-go/v2"
 	"github.com/minio/mc/pkg/probe"
 	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
 	"github.com/minio/minio-go/v7/pkg/sse"
 	"github.com/minio/minio-go/v7/pkg/tags"
 	"github.com/stretchr/testify/assert"
@@ -50,8 +51,22 @@ var (
 	minioGetObjectLockConfigMock        func(ctx context.Context, bucketName string) (lock string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
 	minioSetVersioningMock              func(ctx context.Context, state string) *probe.Error
 	minioCopyObjectMock                 func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	minioComposeObjectMock              func(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error)
+	minioRemoveObjectMock               func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+	minioGetBucketVersioningMock        func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
 	minioSetBucketTaggingMock           func(ctx context.Context, bucketName string, tags *tags.Tags) error
 	minioRemoveBucketTaggingMock        func(ctx context.Context, bucketName string) error
+	minioGetBucketReplicationMock       func(ctx context.Context, bucketName string) (replication.Config, error)
+	minioSetBucketReplicationMock       func(ctx context.Context, bucketName string, cfg replication.Config) error
+	minioResetBucketReplicationMock     func(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error)
+	minioGetReplicationResyncMock       func(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error)
+	minioGetReplicationMetricsMock      func(ctx context.Context, bucketName string) (replication.Metrics, error)
+	minioGetBucketTaggingMock           = func(ctx context.Context, bucketName string) (*tags.Tags, error) {
+		fmt.Println(ctx)
+		fmt.Println(bucketName)
+		retval, _ := tags.NewTags(map[string]string{}, true)
+		return retval, nil
+	}
 )
 
 // Define a mock struct of minio Client interface implementation
@@ -110,10 +125,42 @@ func (mc minioClientMock) copyObject(ctx context.Context, dst minio.CopyDestOpti
 	return minioCopyObjectMock(ctx, dst, src)
 }
 
+func (mc minioClientMock) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs ...minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return minioComposeObjectMock(ctx, dst, srcs...)
+}
+
+func (mc minioClientMock) removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+	return minioRemoveObjectMock(ctx, bucketName, objectName, opts)
+}
+
+func (mc minioClientMock) getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+	return minioGetBucketVersioningMock(ctx, bucketName)
+}
+
 func (c s3ClientMock) setVersioning(ctx context.Context, state string) *probe.Error {
 	return minioSetVersioningMock(ctx, state)
 }
 
+func (mc minioClientMock) getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	return minioGetBucketReplicationMock(ctx, bucketName)
+}
+
+func (mc minioClientMock) setBucketReplication(ctx context.Context, bucketName string, cfg replication.Config) error {
+	return minioSetBucketReplicationMock(ctx, bucketName, cfg)
+}
+
+func (mc minioClientMock) resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (replication.ResyncTargetsInfo, error) {
+	return minioResetBucketReplicationMock(ctx, bucketName, olderThan, arn)
+}
+
+func (mc minioClientMock) getBucketReplicationResyncStatus(ctx context.Context, bucketName, arn string) (replication.ResyncTargetsInfo, error) {
+	return minioGetReplicationResyncMock(ctx, bucketName, arn)
+}
+
+func (mc minioClientMock) getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error) {
+	return minioGetReplicationMetricsMock(ctx, bucketName)
+}
+
 func (mc minioClientMock) GetBucketTagging(ctx context.Context, bucketName string) (*tags.Tags, error) {
 	return minioGetBucketTaggingMock(ctx, bucketName)
 }
@@ -126,13 +173,6 @@ func (mc minioClientMock) RemoveBucketTagging(ctx context.Context, bucketName st
 	return minioRemoveBucketTaggingMock(ctx, bucketName)
 }
 
-func minioGetBucketTaggingMock(ctx context.Context, bucketName string) (*tags.Tags, error) {
-	fmt.Println(ctx)
-	fmt.Println(bucketName)
-	retval, _ := tags.NewTags(map[string]string{}, true)
-	return retval, nil
-}
-
 func TestMakeBucket(t *testing.T) {
 	assert := assert.New(t)
 	// mock minIO client
@@ -157,6 +197,88 @@ func TestMakeBucket(t *testing.T) {
 	}
 }
 
+func Test_makeBucketWithConfig(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+	s3Client := s3ClientMock{}
+	adminClient := AdminClientMock{}
+
+	var steps []string
+	minioMakeBucketWithContextMock = func(ctx context.Context, bucketName, location string, objectLock bool) error {
+		steps = append(steps, fmt.Sprintf("make locking=%t", objectLock))
+		return nil
+	}
+	minioRemoveBucketMock = func(bucketName string) error {
+		steps = append(steps, "remove")
+		return nil
+	}
+	minioSetVersioningMock = func(ctx context.Context, state string) *probe.Error {
+		steps = append(steps, "versioning")
+		return nil
+	}
+	minioSetObjectLockConfigMock = func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+		steps = append(steps, "retention")
+		return nil
+	}
+	minioSetBucketQuotaMock = func(ctx context.Context, bucket string, quota *madmin.BucketQuota) error {
+		steps = append(steps, "quota")
+		return nil
+	}
+	minioSetBucketEncryptionMock = func(ctx context.Context, bucketName string, config *sse.Configuration) error {
+		steps = append(steps, "encryption")
+		return nil
+	}
+	minioSetBucketTaggingMock = func(ctx context.Context, bucketName string, tags *tags.Tags) error {
+		steps = append(steps, "tags")
+		return nil
+	}
+
+	mode := models.ObjectRetentionModeCompliance
+	unit := models.ObjectRetentionUnitDays
+	encType := models.BucketEncryptionTypeSseDashS3
+	fullRequest := func() *models.MakeBucketRequest {
+		return &models.MakeBucketRequest{
+			Name:       swag.String("bucket"),
+			Versioning: true,
+			Retention:  &models.PutBucketRetentionRequest{Mode: &mode, Unit: &unit, Validity: swag.Int32(2)},
+			Quota:      &models.SetBucketQuota{Enabled: swag.Bool(true), QuotaType: models.SetBucketQuotaQuotaTypeHard, Amount: 1024},
+			Encryption: &models.BucketEncryptionRequest{EncType: &encType},
+			Tags:       map[string]string{"team": "storage"},
+		}
+	}
+
+	// Test-1: every configuration is applied in order, retention enables locking
+	assert.NoError(makeBucketWithConfig(ctx, minClient, s3Client, adminClient, fullRequest()))
+	assert.Equal([]string{"make locking=true", "versioning", "retention", "quota", "encryption", "tags"}, steps)
+
+	// Test-2: a failure after the bucket was created removes the bucket
+	steps = nil
+	minioSetBucketEncryptionMock = func(ctx context.Context, bucketName string, config *sse.Configuration) error {
+		steps = append(steps, "encryption")
+		return errors.New("kms not configured")
+	}
+	err := makeBucketWithConfig(ctx, minClient, s3Client, adminClient, fullRequest())
+	if assert.Error(err) {
+		assert.Equal("kms not configured", err.Error())
+	}
+	assert.Equal([]string{"make locking=true", "versioning", "retention", "quota", "encryption", "remove"}, steps)
+
+	// Test-3: a failure setting versioning is not swallowed
+	steps = nil
+	minioSetVersioningMock = func(ctx context.Context, state string) *probe.Error {
+		steps = append(steps, "versioning")
+		return probe.NewError(errors.New("versioning failed"))
+	}
+	assert.Error(makeBucketWithConfig(ctx, minClient, s3Client, adminClient, &models.MakeBucketRequest{Name: swag.String("bucket"), Versioning: true}))
+	assert.Equal([]string{"make locking=false", "versioning", "remove"}, steps)
+
+	// Test-4: invalid tags are rejected before creating the bucket
+	steps = nil
+	assert.Error(makeBucketWithConfig(ctx, minClient, s3Client, adminClient, &models.MakeBucketRequest{Name: swag.String("bucket"), Tags: map[string]string{"": "value"}}))
+	assert.Empty(steps)
+}
+
 func TestDeleteBucket(t *testing.T) {
 	assert := assert.New(t)
 	// mock minIO client
@@ -652,6 +774,36 @@ func Test_SetBucketRetentionConfig(t *testing.T) {
 			},
 			expectedError: errors.New("error func"),
 		},
+		{
+			name: "Validity out of range",
+			args: args{
+				ctx:        ctx,
+				client:     minClient,
+				bucketName: "test",
+				mode:       models.ObjectRetentionModeGovernance,
+				unit:       models.ObjectRetentionUnitYears,
+				validity:   swag.Int32(101),
+				mockBucketRetentionFunc: func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+					return nil
+				},
+			},
+			expectedError: fmt.Errorf("%w: validity must be between 1 and 100 years", ErrInvalidBucketRetention),
+		},
+		{
+			name: "Bucket without object locking",
+			args: args{
+				ctx:        ctx,
+				client:     minClient,
+				bucketName: "test",
+				mode:       models.ObjectRetentionModeCompliance,
+				unit:       models.ObjectRetentionUnitDays,
+				validity:   swag.Int32(2),
+				mockBucketRetentionFunc: func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+					return minio.ErrorResponse{Code: "InvalidBucketState", Message: "Object Lock configuration cannot be enabled on existing buckets"}
+				},
+			},
+			expectedError: fmt.Errorf("%w: Object Lock configuration cannot be enabled on existing buckets", ErrBucketObjectLockingDisabled),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -667,6 +819,27 @@ func Test_SetBucketRetentionConfig(t *testing.T) {
 	}
 }
 
+func Test_clearBucketRetentionConfig(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minClient := minioClientMock{}
+
+	minioSetObjectLockConfigMock = func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+		// the default rule is dropped, locking stays enabled
+		assert.Nil(mode)
+		assert.Nil(validity)
+		assert.Nil(unit)
+		return nil
+	}
+	assert.NoError(clearBucketRetentionConfig(ctx, minClient, "test"))
+
+	minioSetObjectLockConfigMock = func(ctx context.Context, bucketName string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error {
+		return minio.ErrorResponse{Code: "InvalidBucketState"}
+	}
+	err := clearBucketRetentionConfig(ctx, minClient, "test")
+	assert.True(errors.Is(err, ErrBucketObjectLockingDisabled))
+}
+
 func Test_GetBucketRetentionConfig(t *testing.T) {
 	assert := assert.New(t)
 	ctx := context.Background()
@@ -696,9 +869,10 @@ func Test_GetBucketRetentionConfig(t *testing.T) {
 				},
 			},
 			expectedResponse: &models.GetBucketRetentionConfig{
-				Mode:     models.ObjectRetentionModeGovernance,
-				Unit:     models.ObjectRetentionUnitDays,
-				Validity: int32(2),
+				ObjectLockingEnabled: true,
+				Mode:                 models.ObjectRetentionModeGovernance,
+				Unit:                 models.ObjectRetentionUnitDays,
+				Validity:             int32(2),
 			},
 			expectedError: nil,
 		},
@@ -715,9 +889,10 @@ func Test_GetBucketRetentionConfig(t *testing.T) {
 				},
 			},
 			expectedResponse: &models.GetBucketRetentionConfig{
-				Mode:     models.ObjectRetentionModeCompliance,
-				Unit:     models.ObjectRetentionUnitDays,
-				Validity: int32(2),
+				ObjectLockingEnabled: true,
+				Mode:                 models.ObjectRetentionModeCompliance,
+				Unit:                 models.ObjectRetentionUnitDays,
+				Validity:             int32(2),
 			},
 			expectedError: nil,
 		},
@@ -752,6 +927,19 @@ func Test_GetBucketRetentionConfig(t *testing.T) {
 			expectedResponse: &models.GetBucketRetentionConfig{},
 			expectedError:    nil,
 		},
+		{
+			name: "Object locking without default retention",
+			args: args{
+				ctx:        ctx,
+				client:     minClient,
+				bucketName: "test",
+				getRetentionFunc: func(ctx context.Context, bucketName string) (mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error) {
+					return nil, nil, nil, nil
+				},
+			},
+			expectedResponse: &models.GetBucketRetentionConfig{ObjectLockingEnabled: true},
+			expectedError:    nil,
+		},
 		{
 			name: "Return errors on invalid mode",
 			args: args{
@@ -0,0 +1,295 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/usagehistory"
+	"github.com/minio/console/restapi/operations"
+	bucketApi "github.com/minio/console/restapi/operations/bucket"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	defaultPrefixUsageDepth = 1
+	maxPrefixUsageDepth     = 3
+	defaultPrefixUsageLimit = 50
+	maxPrefixUsageLimit     = 1000
+	// objects listed before the usage is reported as truncated
+	maxPrefixUsageObjects = 1000000
+)
+
+var (
+	globalUsageHistory     *usagehistory.Store
+	globalUsageHistoryOnce sync.Once
+)
+
+type prefixUsageOptions struct {
+	Prefix     string
+	Depth      int
+	Offset     int
+	Limit      int
+	MaxObjects int64
+}
+
+func registerBucketUsageHandlers(api *operations.ConsoleAPI) {
+	// usage of a bucket grouped by prefix
+	api.BucketGetBucketPrefixUsageHandler = bucketApi.GetBucketPrefixUsageHandlerFunc(func(params bucketApi.GetBucketPrefixUsageParams, session *models.Principal) middleware.Responder {
+		resp, err := getBucketPrefixUsageResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketPrefixUsageDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetBucketPrefixUsageOK().WithPayload(resp)
+	})
+	// size and object count history of a bucket
+	api.BucketGetBucketUsageHistoryHandler = bucketApi.GetBucketUsageHistoryHandlerFunc(func(params bucketApi.GetBucketUsageHistoryParams, session *models.Principal) middleware.Responder {
+		resp, err := getBucketUsageHistoryResponse(session, params)
+		if err != nil {
+			return bucketApi.NewGetBucketUsageHistoryDefault(int(err.Code)).WithPayload(err)
+		}
+		return bucketApi.NewGetBucketUsageHistoryOK().WithPayload(resp)
+	})
+}
+
+// usageHistory returns the store of the bucket usage samples, when the configured file
+// can't be loaded the history is only kept in memory
+func usageHistory() *usagehistory.Store {
+	globalUsageHistoryOnce.Do(func() {
+		interval, retention := getConsoleUsageHistoryInterval(), getConsoleUsageHistoryRetention()
+		store, err := usagehistory.New(getConsoleUsageHistoryFile(), interval, retention)
+		if err != nil {
+			LogError("unable to load the bucket usage history: %v", err)
+			if store, err = usagehistory.New("", interval, retention); err != nil {
+				store, _ = usagehistory.New("", time.Hour, 30*24*time.Hour)
+			}
+		}
+		globalUsageHistory = store
+	})
+	return globalUsageHistory
+}
+
+// recordBucketUsage samples the usage MinIO reported for the buckets of an account,
+// every user listing buckets feeds the history of the buckets they can see
+func recordBucketUsage(ctx context.Context, store *usagehistory.Store, info madmin.AccountInfo, now time.Time) {
+	usage := make(map[string]usagehistory.Usage, len(info.Buckets))
+	for _, bucket := range info.Buckets {
+		usage[bucket.Name] = usagehistory.Usage{Size: int64(bucket.Size), Objects: int64(bucket.Objects)}
+	}
+	if err := store.Record(now, usage); err != nil {
+		ErrorWithContext(ctx, fmt.Errorf("unable to save the bucket usage history: %v", err))
+	}
+}
+
+// parseUsageHistorySince accepts a date in RFC3339 or a duration counted back from now
+func parseUsageHistorySince(since string, now time.Time) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(since)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("%w: since must be a RFC3339 date or a duration like 168h", ErrInvalidUsageHistoryRange)
+	}
+	return now.Add(-d), nil
+}
+
+// getBucketUsageHistory takes a sample of the current usage when due and returns the history of the
+// bucket, only to accounts that can access it
+func getBucketUsageHistory(ctx context.Context, client MinioAdmin, store *usagehistory.Store, bucketName, since string, now time.Time) (*models.BucketUsageHistory, error) {
+	from, err := parseUsageHistorySince(since, now)
+	if err != nil {
+		return nil, err
+	}
+	info, err := client.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, bucket := range info.Buckets {
+		if bucket.Name == bucketName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrNotFound
+	}
+	recordBucketUsage(ctx, store, info, now)
+	history := &models.BucketUsageHistory{
+		Bucket:    bucketName,
+		Interval:  int64(store.Interval().Seconds()),
+		Retention: int64(store.Retention().Seconds()),
+		Samples:   []*models.BucketUsageSample{},
+	}
+	for _, sample := range store.Series(bucketName, from) {
+		history.Samples = append(history.Samples, &models.BucketUsageSample{
+			Time:    sample.Time.Format(time.RFC3339),
+			Size:    sample.Size,
+			Objects: sample.Objects,
+		})
+	}
+	return history, nil
+}
+
+// usagePrefixOf returns the prefix, at most depth levels below base, an object is accounted to.
+// Objects stored directly under base return an empty prefix.
+func usagePrefixOf(base, key string, depth int) string {
+	rest := strings.TrimPrefix(key, base)
+	end := 0
+	for level := 0; level < depth; level++ {
+		i := strings.Index(rest[end:], "/")
+		if i < 0 {
+			break
+		}
+		end += i + 1
+	}
+	if end == 0 {
+		return ""
+	}
+	return base + rest[:end]
+}
+
+// getBucketPrefixUsage lists the objects under a prefix and aggregates their size by sub-prefix,
+// listing stops after opts.MaxObjects objects so very large buckets report a partial usage
+func getBucketPrefixUsage(ctx context.Context, client MinioClient, bucketName string, opts prefixUsageOptions) (*models.BucketPrefixUsageResponse, error) {
+	if opts.Depth < 1 || opts.Depth > maxPrefixUsageDepth {
+		return nil, fmt.Errorf("%w: depth must be between 1 and %d", ErrInvalidPrefixUsageQuery, maxPrefixUsageDepth)
+	}
+	if opts.Offset < 0 || opts.Limit < 1 || opts.Limit > maxPrefixUsageLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d and offset can't be negative", ErrInvalidPrefixUsageQuery, maxPrefixUsageLimit)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	// stops the listing when returning early
+	defer cancel()
+
+	resp := &models.BucketPrefixUsageResponse{
+		Prefix:   opts.Prefix,
+		Depth:    int32(opts.Depth),
+		Prefixes: []*models.PrefixUsage{},
+	}
+	usage := make(map[string]*models.PrefixUsage)
+	for obj := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: opts.Prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		if opts.MaxObjects > 0 && resp.TotalObjects >= opts.MaxObjects {
+			resp.Truncated = true
+			break
+		}
+		resp.TotalObjects++
+		resp.TotalSize += obj.Size
+		prefix := usagePrefixOf(opts.Prefix, obj.Key, opts.Depth)
+		if prefix == "" {
+			resp.DirectObjects++
+			resp.DirectSize += obj.Size
+			continue
+		}
+		item, ok := usage[prefix]
+		if !ok {
+			item = &models.PrefixUsage{Prefix: prefix}
+			usage[prefix] = item
+		}
+		item.Objects++
+		item.Size += obj.Size
+	}
+
+	prefixes := make([]*models.PrefixUsage, 0, len(usage))
+	for _, item := range usage {
+		prefixes = append(prefixes, item)
+	}
+	// biggest consumers first
+	sort.Slice(prefixes, func(i, j int) bool {
+		if prefixes[i].Size != prefixes[j].Size {
+			return prefixes[i].Size > prefixes[j].Size
+		}
+		return prefixes[i].Prefix < prefixes[j].Prefix
+	})
+	resp.Total = int64(len(prefixes))
+	if opts.Offset < len(prefixes) {
+		end := opts.Offset + opts.Limit
+		if end > len(prefixes) {
+			end = len(prefixes)
+		}
+		resp.Prefixes = prefixes[opts.Offset:end]
+	}
+	return resp, nil
+}
+
+func getBucketPrefixUsageResponse(session *models.Principal, params bucketApi.GetBucketPrefixUsageParams) (*models.BucketPrefixUsageResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	opts := prefixUsageOptions{
+		Depth:      defaultPrefixUsageDepth,
+		Limit:      defaultPrefixUsageLimit,
+		MaxObjects: maxPrefixUsageObjects,
+	}
+	if params.Prefix != nil {
+		prefix, err := decodeObjectPrefix(*params.Prefix)
+		if err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		opts.Prefix = prefix
+	}
+	if params.Depth != nil {
+		opts.Depth = int(*params.Depth)
+	}
+	if params.Offset != nil {
+		opts.Offset = int(*params.Offset)
+	}
+	if params.Limit != nil {
+		opts.Limit = int(*params.Limit)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	minioClient := minioClient{client: mClient}
+	resp, err := getBucketPrefixUsage(ctx, minioClient, params.BucketName, opts)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+func getBucketUsageHistoryResponse(session *models.Principal, params bucketApi.GetBucketUsageHistoryParams) (*models.BucketUsageHistory, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	since := ""
+	if params.Since != nil {
+		since = *params.Since
+	}
+	history, err := getBucketUsageHistory(ctx, AdminClient{Client: mAdmin}, usageHistory(), params.BucketName, since, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return history, nil
+}
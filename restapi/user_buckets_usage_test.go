@@ -0,0 +1,150 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/usagehistory"
+	"github.com/minio/madmin-go/v2"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_usagePrefixOf(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("a/", usagePrefixOf("", "a/b/c.txt", 1))
+	assert.Equal("a/b/", usagePrefixOf("", "a/b/c.txt", 2))
+	assert.Equal("a/b/", usagePrefixOf("", "a/b/c.txt", 3))
+	assert.Equal("", usagePrefixOf("", "c.txt", 2))
+	assert.Equal("logs/2023/", usagePrefixOf("logs/", "logs/2023/01/app.log", 1))
+	assert.Equal("", usagePrefixOf("logs/", "logs/app.log", 1))
+}
+
+func Test_getBucketPrefixUsage(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	client := minioClientMock{}
+
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		assert.True(opts.Recursive)
+		objs := []minio.ObjectInfo{
+			{Key: "readme.md", Size: 5},
+			{Key: "images/a.png", Size: 100},
+			{Key: "images/b/c.png", Size: 200},
+			{Key: "videos/a.mp4", Size: 1000},
+			{Key: "docs/a.pdf", Size: 100},
+		}
+		ch := make(chan minio.ObjectInfo, len(objs))
+		for _, obj := range objs {
+			ch <- obj
+		}
+		close(ch)
+		return ch
+	}
+
+	resp, err := getBucketPrefixUsage(ctx, client, "bucket", prefixUsageOptions{Depth: 1, Limit: 2})
+	assert.NoError(err)
+	assert.Equal(int64(1405), resp.TotalSize)
+	assert.Equal(int64(5), resp.TotalObjects)
+	assert.Equal(int64(5), resp.DirectSize)
+	assert.Equal(int64(1), resp.DirectObjects)
+	assert.Equal(int64(3), resp.Total)
+	assert.False(resp.Truncated)
+	if assert.Len(resp.Prefixes, 2) {
+		assert.Equal("videos/", resp.Prefixes[0].Prefix)
+		assert.Equal("images/", resp.Prefixes[1].Prefix)
+		assert.Equal(int64(2), resp.Prefixes[1].Objects)
+	}
+
+	// second page
+	resp, err = getBucketPrefixUsage(ctx, client, "bucket", prefixUsageOptions{Depth: 1, Offset: 2, Limit: 2})
+	assert.NoError(err)
+	if assert.Len(resp.Prefixes, 1) {
+		assert.Equal("docs/", resp.Prefixes[0].Prefix)
+	}
+
+	// deeper breakdown, limited scan
+	resp, err = getBucketPrefixUsage(ctx, client, "bucket", prefixUsageOptions{Depth: 2, Limit: 10, MaxObjects: 3})
+	assert.NoError(err)
+	assert.True(resp.Truncated)
+	assert.Equal(int64(3), resp.TotalObjects)
+	// images/ and images/b/
+	assert.Equal(int64(2), resp.Total)
+
+	_, err = getBucketPrefixUsage(ctx, client, "bucket", prefixUsageOptions{Depth: 4, Limit: 10})
+	assert.True(errors.Is(err, ErrInvalidPrefixUsageQuery))
+	_, err = getBucketPrefixUsage(ctx, client, "bucket", prefixUsageOptions{Depth: 1, Limit: 0})
+	assert.True(errors.Is(err, ErrInvalidPrefixUsageQuery))
+
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Err: errors.New("access denied")}
+		close(ch)
+		return ch
+	}
+	_, err = getBucketPrefixUsage(ctx, client, "bucket", prefixUsageOptions{Depth: 1, Limit: 10})
+	assert.EqualError(err, "access denied")
+}
+
+func Test_getBucketUsageHistory(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	adminClient := AdminClientMock{}
+	store, err := usagehistory.New("", time.Hour, 24*time.Hour)
+	assert.NoError(err)
+
+	size := uint64(100)
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+			{Name: "photos", Size: size, Objects: size / 10},
+		}}, nil
+	}
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		_, err = getBucketUsageHistory(ctx, adminClient, store, "photos", "", start.Add(time.Duration(i)*time.Hour))
+		assert.NoError(err)
+		size += 100
+	}
+
+	history, err := getBucketUsageHistory(ctx, adminClient, store, "photos", "", start.Add(150*time.Minute))
+	assert.NoError(err)
+	assert.Equal(int64(3600), history.Interval)
+	if assert.Len(history.Samples, 3) {
+		assert.Equal("2023-05-01T10:00:00Z", history.Samples[0].Time)
+		assert.Equal(int64(300), history.Samples[2].Size)
+		assert.Equal(int64(30), history.Samples[2].Objects)
+	}
+
+	history, err = getBucketUsageHistory(ctx, adminClient, store, "photos", "90m", start.Add(150*time.Minute))
+	assert.NoError(err)
+	assert.Len(history.Samples, 2)
+
+	history, err = getBucketUsageHistory(ctx, adminClient, store, "photos", "2023-05-01T12:00:00Z", start.Add(150*time.Minute))
+	assert.NoError(err)
+	assert.Len(history.Samples, 1)
+
+	_, err = getBucketUsageHistory(ctx, adminClient, store, "photos", "yesterday", start)
+	assert.True(errors.Is(err, ErrInvalidUsageHistoryRange))
+
+	// buckets the account can't see have no history
+	_, err = getBucketUsageHistory(ctx, adminClient, store, "secrets", "", start)
+	assert.Equal(ErrNotFound, err)
+}
@@ -0,0 +1,234 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	logApi "github.com/minio/console/restapi/operations/logging"
+)
+
+const (
+	defaultLogEntriesPageSize = 50
+	maxLogEntriesPageSize     = 1000
+	// maxLogExportEntries bounds the entries of an export
+	maxLogExportEntries = 100000
+)
+
+func registerLogEntriesHandlers(api *operations.ConsoleAPI) {
+	// page through the historical log entries
+	api.LoggingSearchLogEntriesHandler = logApi.SearchLogEntriesHandlerFunc(func(params logApi.SearchLogEntriesParams, session *models.Principal) middleware.Responder {
+		resp, err := getSearchLogEntriesResponse(session, params)
+		if err != nil {
+			return logApi.NewSearchLogEntriesDefault(int(err.Code)).WithPayload(err)
+		}
+		return logApi.NewSearchLogEntriesOK().WithPayload(resp)
+	})
+	// export the historical log entries as NDJSON
+	api.LoggingExportLogEntriesHandler = logApi.ExportLogEntriesHandlerFunc(func(params logApi.ExportLogEntriesParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportLogEntriesResponse(session, params)
+		if err != nil {
+			return logApi.NewExportLogEntriesDefault(int(err.Code)).WithPayload(err)
+		}
+		return resp
+	})
+}
+
+// logEntriesQuery returns the Log Search query of a page of raw log entries within the time range of the request
+func logEntriesQuery(logRequest LogRequest, order string, pageSize, pageNo int) string {
+	values := url.Values{}
+	values.Set("token", getLogSearchAPIToken())
+	values.Set("q", "raw")
+	values.Set(order, "ok")
+	if !logRequest.since.IsZero() {
+		values.Set("timeStart", logRequest.since.UTC().Format(time.RFC3339))
+	}
+	if !logRequest.until.IsZero() {
+		values.Set("timeEnd", logRequest.until.UTC().Format(time.RFC3339))
+	}
+	values.Set("pageSize", strconv.Itoa(pageSize))
+	values.Set("pageNo", strconv.Itoa(pageNo))
+	return fmt.Sprintf("%s/api/query?%s", getLogSearchURL(), values.Encode())
+}
+
+// fetchLogEntries returns a page of raw log entries from Log Search
+func fetchLogEntries(logRequest LogRequest, order string, pageSize, pageNo int) ([]map[string]interface{}, error) {
+	if getLogSearchURL() == "" {
+		return nil, ErrLogSearchNotConfigured
+	}
+	resp, err := logSearch(logEntriesQuery(logRequest, order, pageSize, pageNo))
+	if err != nil {
+		return nil, err
+	}
+	entries, _ := resp.Results.([]map[string]interface{})
+	return entries, nil
+}
+
+// logEntryString returns the first of the fields of a log entry that is a string
+func logEntryString(fields map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		if value, ok := fields[key].(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// matchLogEntry filters a raw log entry by node, severity and text. The entry is either the log itself or a
+// row holding it under `log`.
+func matchLogEntry(logRequest LogRequest, entry map[string]interface{}) bool {
+	fields := entry
+	if log, ok := entry["log"].(map[string]interface{}); ok {
+		fields = log
+	}
+	if logRequest.level != "" && !strings.EqualFold(logEntryString(fields, "level"), logRequest.level) {
+		return false
+	}
+	if logRequest.node != "" {
+		node := logEntryString(fields, "node", "host")
+		if !strings.EqualFold(strings.Split(node, ":")[0], strings.Split(logRequest.node, ":")[0]) {
+			return false
+		}
+	}
+	if logRequest.query == "" {
+		return true
+	}
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return false
+	}
+	return anyContainsFold(logRequest.query, string(raw))
+}
+
+// searchLogEntries returns the entries of a Log Search page matching the filters. Log Search pages the entries
+// within the time range, so a page may hold fewer entries than its size once the other filters are applied.
+func searchLogEntries(logRequest LogRequest, order string, pageSize, pageNo int) (*models.LogEntriesResponse, error) {
+	entries, err := fetchLogEntries(logRequest, order, pageSize, pageNo)
+	if err != nil {
+		return nil, err
+	}
+	results := []map[string]interface{}{}
+	for _, entry := range entries {
+		if matchLogEntry(logRequest, entry) {
+			results = append(results, entry)
+		}
+	}
+	return &models.LogEntriesResponse{
+		Results:  results,
+		PageNo:   int32(pageNo),
+		PageSize: int32(pageSize),
+		HasMore:  len(entries) == pageSize,
+	}, nil
+}
+
+// exportLogEntries writes the entries matching the filters, oldest first, as a JSON document per line
+func exportLogEntries(ctx context.Context, w io.Writer, logRequest LogRequest) error {
+	encoder := json.NewEncoder(w)
+	var exported int
+	for pageNo := 0; ; pageNo++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		entries, err := fetchLogEntries(logRequest, "timeAsc", maxLogEntriesPageSize, pageNo)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if !matchLogEntry(logRequest, entry) {
+				continue
+			}
+			if err := encoder.Encode(entry); err != nil {
+				return err
+			}
+			if exported++; exported >= maxLogExportEntries {
+				return nil
+			}
+		}
+		if len(entries) < maxLogEntriesPageSize {
+			return nil
+		}
+	}
+}
+
+func getSearchLogEntriesResponse(session *models.Principal, params logApi.SearchLogEntriesParams) (*models.LogEntriesResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := checkLogSearchAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	logRequest, err := newLogRequest(swag.StringValue(params.Node), swag.StringValue(params.Level), swag.StringValue(params.Query),
+		swag.StringValue(params.TimeStart), swag.StringValue(params.TimeEnd))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	pageSize := defaultLogEntriesPageSize
+	if params.PageSize != nil && *params.PageSize > 0 {
+		pageSize = int(*params.PageSize)
+	}
+	if pageSize > maxLogEntriesPageSize {
+		pageSize = maxLogEntriesPageSize
+	}
+	pageNo := 0
+	if params.PageNo != nil && *params.PageNo > 0 {
+		pageNo = int(*params.PageNo)
+	}
+	order := "timeDesc"
+	if swag.StringValue(params.Order) == "timeAsc" {
+		order = "timeAsc"
+	}
+	resp, err := searchLogEntries(logRequest, order, pageSize, pageNo)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+func getExportLogEntriesResponse(session *models.Principal, params logApi.ExportLogEntriesParams) (middleware.Responder, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := checkLogSearchAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	logRequest, err := newLogRequest(swag.StringValue(params.Node), swag.StringValue(params.Level), swag.StringValue(params.Query),
+		swag.StringValue(params.TimeStart), swag.StringValue(params.TimeEnd))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if getLogSearchURL() == "" {
+		return nil, ErrorWithContext(ctx, ErrLogSearchNotConfigured)
+	}
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"logs-%s.ndjson\"", time.Now().UTC().Format("20060102150405")))
+		// the entries are streamed, an error half way can only be logged
+		if err := exportLogEntries(ctx, w, logRequest); err != nil {
+			LogError("Unable to export the log entries: %v", err)
+		}
+	}), nil
+}
@@ -0,0 +1,143 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_matchLogEntry(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := map[string]interface{}{
+		"event_time": "2023-03-01T10:30:00Z",
+		"log": map[string]interface{}{
+			"level":   "ERROR",
+			"host":    "node1:9000",
+			"message": "drive /data3 is offline",
+		},
+	}
+	assert.True(matchLogEntry(LogRequest{}, entry))
+	assert.True(matchLogEntry(LogRequest{node: "node1", level: "error", query: "Data3"}, entry))
+	assert.False(matchLogEntry(LogRequest{node: "node2:9000"}, entry))
+	assert.False(matchLogEntry(LogRequest{level: "INFO"}, entry))
+	assert.False(matchLogEntry(LogRequest{query: "bucket"}, entry))
+
+	// entries that are the log itself
+	assert.True(matchLogEntry(LogRequest{level: "INFO"}, map[string]interface{}{"level": "INFO"}))
+}
+
+// logSearchServer serves pages of entries generated by the page function and records the queries received
+func logSearchServer(t *testing.T, page func(query url.Values) []map[string]interface{}) *[]url.Values {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		json.NewEncoder(w).Encode(page(r.URL.Query()))
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(ConsoleLogQueryURL, server.URL)
+	t.Setenv(ConsoleLogQueryAuthToken, "secret")
+	return &queries
+}
+
+func TestSearchLogEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	queries := logSearchServer(t, func(query url.Values) []map[string]interface{} {
+		return []map[string]interface{}{
+			{"level": "ERROR", "message": "drive offline"},
+			{"level": "INFO", "message": "drive healed"},
+		}
+	})
+
+	since := time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+	resp, err := searchLogEntries(LogRequest{level: "error", since: since}, "timeDesc", 2, 3)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal(int32(3), resp.PageNo)
+	assert.True(resp.HasMore)
+	results, _ := resp.Results.([]map[string]interface{})
+	if assert.Len(results, 1) {
+		assert.Equal("drive offline", results[0]["message"])
+	}
+	if assert.Len(*queries, 1) {
+		query := (*queries)[0]
+		assert.Equal("raw", query.Get("q"))
+		assert.Equal("secret", query.Get("token"))
+		assert.Equal("ok", query.Get("timeDesc"))
+		assert.Equal("2023-03-01T10:00:00Z", query.Get("timeStart"))
+		assert.Equal("", query.Get("timeEnd"))
+		assert.Equal("2", query.Get("pageSize"))
+		assert.Equal("3", query.Get("pageNo"))
+	}
+
+	// the last page holds fewer entries than its size
+	resp, err = searchLogEntries(LogRequest{}, "timeAsc", 10, 0)
+	if assert.NoError(err) {
+		assert.False(resp.HasMore)
+	}
+
+	t.Setenv(ConsoleLogQueryURL, "")
+	_, err = searchLogEntries(LogRequest{}, "timeAsc", 10, 0)
+	assert.ErrorIs(err, ErrLogSearchNotConfigured)
+}
+
+func TestExportLogEntries(t *testing.T) {
+	assert := assert.New(t)
+
+	// two pages, the first one full
+	queries := logSearchServer(t, func(query url.Values) []map[string]interface{} {
+		size := maxLogEntriesPageSize
+		if query.Get("pageNo") != "0" {
+			size = 3
+		}
+		page := make([]map[string]interface{}, size)
+		for i := range page {
+			level := "INFO"
+			if i%2 == 0 {
+				level = "ERROR"
+			}
+			page[i] = map[string]interface{}{"level": level, "message": query.Get("pageNo") + "-" + strconv.Itoa(i)}
+		}
+		return page
+	})
+
+	var out bytes.Buffer
+	if !assert.NoError(exportLogEntries(context.Background(), &out, LogRequest{level: "ERROR"})) {
+		return
+	}
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Len(lines, maxLogEntriesPageSize/2+2)
+	assert.Equal(`{"level":"ERROR","message":"0-0"}`, lines[0])
+	assert.Equal(`{"level":"ERROR","message":"1-2"}`, lines[len(lines)-1])
+	if assert.Len(*queries, 2) {
+		assert.Equal("ok", (*queries)[0].Get("timeAsc"))
+		assert.Equal("1", (*queries)[1].Get("pageNo"))
+	}
+}
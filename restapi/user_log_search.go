@@ -41,31 +41,39 @@ func registerLogSearchHandlers(api *operations.ConsoleAPI) {
 	})
 }
 
-// getLogSearchResponse performs a query to Log Search if Enabled
-func getLogSearchResponse(session *models.Principal, params logApi.LogSearchParams) (*models.LogSearchResponse, *models.Error) {
-	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
-	defer cancel()
-	sessionResp, err := getSessionResponse(ctx, session)
-	if err != nil {
-		return nil, err
-	}
-	var allowedToQueryLogSearchAPI bool
-	if permissions, ok := sessionResp.Permissions[ConsoleResourceName]; ok {
-		for _, permission := range permissions {
-			if permission == iampolicy.HealthInfoAdminAction {
-				allowedToQueryLogSearchAPI = true
-				break
-			}
+// hasLogSearchAccess returns whether the session permissions allow querying Log Search
+func hasLogSearchAccess(permissions map[string][]string) bool {
+	for _, permission := range permissions[ConsoleResourceName] {
+		if permission == iampolicy.HealthInfoAdminAction {
+			return true
 		}
 	}
+	return false
+}
 
-	if !allowedToQueryLogSearchAPI {
-		return nil, &models.Error{
+// checkLogSearchAccess returns an error when the session isn't allowed to query Log Search
+func checkLogSearchAccess(ctx context.Context, session *models.Principal) *models.Error {
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return err
+	}
+	if !hasLogSearchAccess(sessionResp.Permissions) {
+		return &models.Error{
 			Code:            int32(403),
 			Message:         swag.String("Forbidden"),
 			DetailedMessage: swag.String("The Log Search API not available."),
 		}
 	}
+	return nil
+}
+
+// getLogSearchResponse performs a query to Log Search if Enabled
+func getLogSearchResponse(session *models.Principal, params logApi.LogSearchParams) (*models.LogSearchResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := checkLogSearchAccess(ctx, session); err != nil {
+		return nil, err
+	}
 
 	token := getLogSearchAPIToken()
 	endpoint := fmt.Sprintf("%s/api/query?token=%s&q=reqinfo", getLogSearchURL(), token)
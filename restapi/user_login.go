@@ -20,15 +20,18 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/models"
 	"github.com/minio/console/pkg/auth"
 	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/pkg/realip"
 	"github.com/minio/console/restapi/operations"
 	authApi "github.com/minio/console/restapi/operations/auth"
 	"github.com/minio/madmin-go/v2"
@@ -126,6 +129,10 @@ func getLoginResponse(params authApi.LoginParams) (*models.LoginResponse, *model
 	lr := params.Body
 	var err error
 	var consoleCreds *ConsoleCredentials
+	sourceIP := realip.ClientIP(params.HTTPRequest)
+	if err = checkLoginLockout(loginAttempts(), lr.AccessKey, sourceIP, time.Now()); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	// if we receive an STS we use that instead of the credentials
 	if lr.Sts != "" {
 		creds := credentials.NewStaticV4(lr.AccessKey, lr.SecretKey, lr.Sts)
@@ -145,8 +152,24 @@ func getLoginResponse(params authApi.LoginParams) (*models.LoginResponse, *model
 	if lr.Features != nil {
 		sf.HideMenu = lr.Features.HideMenu
 	}
+	// the code is only asked once the credentials are known to be valid, and the session is only created once the
+	// code is, the credentials keep the temporary keys they got for login() to use
+	if _, err = consoleCreds.Get(); err != nil {
+		recordLoginFailure(loginAttempts(), lr.AccessKey, sourceIP, err, time.Now())
+		failedLoginAttempt(ctx, err)
+		return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
+	}
+	if err = checkLoginTwoFactor(twoFactor(), lr.AccessKey, lr.Otp, time.Now()); err != nil {
+		if !errors.Is(err, ErrTwoFactorRequired) {
+			recordLoginFailure(loginAttempts(), lr.AccessKey, sourceIP, err, time.Now())
+			failedLoginAttempt(ctx, err)
+		}
+		return nil, ErrorWithContext(ctx, err)
+	}
 	sessionID, err := login(consoleCreds, sf)
 	if err != nil {
+		recordLoginFailure(loginAttempts(), lr.AccessKey, sourceIP, err, time.Now())
+		failedLoginAttempt(ctx, err)
 		return nil, ErrorWithContext(ctx, err, ErrInvalidLogin)
 	}
 	// serialize output
@@ -176,7 +199,9 @@ func getLoginDetailsResponse(params authApi.LoginDetailParams, openIDProviders o
 	var loginDetails *models.LoginDetails
 	if len(openIDProviders) >= 1 {
 		loginStrategy = models.LoginDetailsLoginStrategyRedirect
-		for name, provider := range openIDProviders {
+		// the providers are listed in a stable order for the login page selector
+		for _, name := range openIDProviders.Names() {
+			provider := openIDProviders[name]
 			// initialize new oauth2 client
 			oauth2Client, err := openIDProviders.NewOauth2ProviderClient(name, nil, r, GetConsoleHTTPClient(""), GetConsoleHTTPClient(getMinIOServer()))
 			if err != nil {
@@ -207,6 +232,7 @@ func getLoginDetailsResponse(params authApi.LoginDetailParams, openIDProviders o
 				Redirect:    identityProvider.GenerateLoginURL(),
 				DisplayName: displayName,
 				ServiceType: serviceType,
+				Name:        name,
 			}
 
 			redirectRules = append(redirectRules, &redirectRule)
@@ -225,7 +251,7 @@ func getLoginDetailsResponse(params authApi.LoginDetailParams, openIDProviders o
 func verifyUserAgainstIDP(ctx context.Context, provider auth.IdentityProviderI, code, state string) (*credentials.Credentials, error) {
 	userCredentials, err := provider.VerifyIdentity(ctx, code, state)
 	if err != nil {
-		LogError("error validating user identity against idp: %v", err)
+		LogErrorContext(ctx, "error validating user identity against idp: %v", err)
 		return nil, err
 	}
 	return userCredentials, nil
@@ -256,7 +282,10 @@ func getLoginOauth2AuthResponse(params authApi.LoginOauth2AuthParams, openIDProv
 
 		IDPName := requestItems.IDPName
 		state := requestItems.State
-		providerCfg := openIDProviders[IDPName]
+		providerCfg, ok := openIDProviders[IDPName]
+		if !ok {
+			return nil, ErrorWithContext(ctx, fmt.Errorf("%w: %s", ErrUnknownIdentityProvider, IDPName))
+		}
 		oauth2Client, err := openIDProviders.NewOauth2ProviderClient(IDPName, nil, r, GetConsoleHTTPClient(""), GetConsoleHTTPClient(getMinIOServer()))
 		if err != nil {
 			return nil, ErrorWithContext(ctx, err)
@@ -271,6 +300,11 @@ func getLoginOauth2AuthResponse(params authApi.LoginOauth2AuthParams, openIDProv
 		// Validate user against IDP
 		userCredentials, err := verifyUserAgainstIDP(ctx, identityProvider, *lr.Code, state)
 		if err != nil {
+			failedLoginAttempt(ctx, err)
+			return nil, ErrorWithContext(ctx, err)
+		}
+		// the refresh token and the expiration are only known once the code is exchanged
+		if _, err = userCredentials.Get(); err != nil {
 			return nil, ErrorWithContext(ctx, err)
 		}
 		// initialize admin client
@@ -278,7 +312,11 @@ func getLoginOauth2AuthResponse(params authApi.LoginOauth2AuthParams, openIDProv
 		token, err := login(&ConsoleCredentials{
 			ConsoleCredentials: userCredentials,
 			AccountAccessKey:   "",
-		}, nil)
+		}, &auth.SessionFeatures{
+			IDPName:         IDPName,
+			IDPRefreshToken: identityProvider.Client.RefreshToken,
+			Expiration:      identityProvider.Client.Expiry,
+		})
 		if err != nil {
 			return nil, ErrorWithContext(ctx, err)
 		}
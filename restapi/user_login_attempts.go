@@ -0,0 +1,194 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/loginattempts"
+	"github.com/minio/console/restapi/operations"
+	userApi "github.com/minio/console/restapi/operations/user"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// defaultLoginAttemptsLimit is the number of failures listed when the request doesn't say
+const defaultLoginAttemptsLimit = 100
+
+var (
+	globalLoginAttempts     *loginattempts.Store
+	globalLoginAttemptsOnce sync.Once
+)
+
+func registerLoginAttemptsHandlers(api *operations.ConsoleAPI) {
+	// list the recent failed logins and the active lockouts
+	api.UserListLoginAttemptsHandler = userApi.ListLoginAttemptsHandlerFunc(func(params userApi.ListLoginAttemptsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListLoginAttemptsResponse(session, params)
+		if err != nil {
+			return userApi.NewListLoginAttemptsDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewListLoginAttemptsOK().WithPayload(resp)
+	})
+	// lift the lockout of an access key or a source IP
+	api.UserUnlockLoginAttemptsHandler = userApi.UnlockLoginAttemptsHandlerFunc(func(params userApi.UnlockLoginAttemptsParams, session *models.Principal) middleware.Responder {
+		if err := getUnlockLoginAttemptsResponse(session, params); err != nil {
+			return userApi.NewUnlockLoginAttemptsDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewUnlockLoginAttemptsNoContent()
+	})
+}
+
+// loginAttempts returns the store of the failed logins and lockouts, when the configured file can't
+// be loaded they are only kept in memory
+func loginAttempts() *loginattempts.Store {
+	globalLoginAttemptsOnce.Do(func() {
+		policy := getConsoleLoginLockoutPolicy()
+		store, err := loginattempts.New(getConsoleLoginAttemptsFile(), policy)
+		if err != nil {
+			LogError("unable to load the login attempts: %v", err)
+			store, _ = loginattempts.New("", policy)
+		}
+		globalLoginAttempts = store
+	})
+	return globalLoginAttempts
+}
+
+// checkLoginLockout returns ErrLoginLockedOut when the access key or the source IP can't log in
+func checkLoginLockout(store *loginattempts.Store, accessKey, sourceIP string, now time.Time) error {
+	if lockout := store.Locked(accessKey, sourceIP, now); lockout != nil {
+		return fmt.Errorf("%w: %s %s is locked out until %s", ErrLoginLockedOut, lockout.Type, lockout.Value, lockout.Until.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// recordLoginFailure records a login that failed with err, MinIO being unreachable isn't the
+// fault of the user so it doesn't count
+func recordLoginFailure(store *loginattempts.Store, accessKey, sourceIP string, err error, now time.Time) {
+	if !countsAsLoginFailure(err) {
+		return
+	}
+	lockout, err := store.Failed(accessKey, sourceIP, now)
+	if err != nil {
+		LogError("unable to record the failed login of %s: %v", accessKey, err)
+	}
+	if lockout != nil {
+		LogInfo("%s %s is locked out until %s after %d failed logins", lockout.Type, lockout.Value, lockout.Until.Format(time.RFC3339), lockout.Failures)
+	}
+}
+
+// hasConsoleAdminAction returns whether the session permissions allow the admin action
+func hasConsoleAdminAction(permissions map[string][]string, action string) bool {
+	for _, permission := range permissions[ConsoleResourceName] {
+		if permission == action {
+			return true
+		}
+	}
+	return false
+}
+
+// listLoginAttempts returns the failures matching the filters, newest first, and the active lockouts
+func listLoginAttempts(store *loginattempts.Store, accessKey, sourceIP string, limit int, now time.Time) *models.LoginAttempts {
+	if limit <= 0 {
+		limit = defaultLoginAttemptsLimit
+	}
+	resp := &models.LoginAttempts{
+		LockoutEnabled: store.Policy().Enabled(),
+		Failures:       []*models.LoginFailure{},
+		Lockouts:       []*models.LoginLockout{},
+	}
+	for _, failure := range store.Failures(accessKey, sourceIP, limit) {
+		resp.Failures = append(resp.Failures, &models.LoginFailure{
+			AccessKey: failure.AccessKey,
+			SourceIP:  failure.SourceIP,
+			Time:      failure.Time.Format(time.RFC3339),
+		})
+	}
+	for _, lockout := range store.Lockouts(now) {
+		resp.Lockouts = append(resp.Lockouts, &models.LoginLockout{
+			Type:     lockout.Type,
+			Value:    lockout.Value,
+			Failures: int32(lockout.Failures),
+			Until:    lockout.Until.Format(time.RFC3339),
+		})
+	}
+	return resp
+}
+
+// unlockLoginAttempts lifts the lockouts of the access key and the source IP of the request
+func unlockLoginAttempts(store *loginattempts.Store, req *models.LoginUnlockRequest, now time.Time) error {
+	if req.AccessKey == "" && req.SourceIP == "" {
+		return fmt.Errorf("%w: an access key or a source IP is required", ErrInvalidLoginUnlock)
+	}
+	if req.AccessKey != "" {
+		if err := store.Unlock(loginattempts.AccessKey, req.AccessKey, now); err != nil {
+			return err
+		}
+	}
+	if req.SourceIP != "" {
+		if err := store.Unlock(loginattempts.SourceIP, req.SourceIP, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forbiddenLoginAttempts is returned to the sessions that can't manage users
+func forbiddenLoginAttempts() *models.Error {
+	return &models.Error{
+		Code:            int32(403),
+		Message:         swag.String("Forbidden"),
+		DetailedMessage: swag.String("The login attempts are only available to user administrators."),
+	}
+}
+
+func getListLoginAttemptsResponse(session *models.Principal, params userApi.ListLoginAttemptsParams) (*models.LoginAttempts, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ListUsersAdminAction) {
+		return nil, forbiddenLoginAttempts()
+	}
+	limit := 0
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	return listLoginAttempts(loginAttempts(), swag.StringValue(params.AccessKey), swag.StringValue(params.SourceIP), limit, time.Now()), nil
+}
+
+func getUnlockLoginAttemptsResponse(session *models.Principal, params userApi.UnlockLoginAttemptsParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.EnableUserAdminAction) {
+		return forbiddenLoginAttempts()
+	}
+	if err := unlockLoginAttempts(loginAttempts(), params.Body, time.Now()); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
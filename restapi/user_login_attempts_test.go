@@ -0,0 +1,69 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/loginattempts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginLockout(t *testing.T) {
+	assert := assert.New(t)
+	store, err := loginattempts.New("", loginattempts.Policy{AccessKeyThreshold: 2, Window: time.Minute, Duration: time.Hour})
+	assert.NoError(err)
+	now := time.Now()
+
+	// MinIO being unreachable doesn't count
+	recordLoginFailure(store, "alice", "10.0.0.1", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, now)
+	assert.Empty(store.Failures("", "", 0))
+
+	recordLoginFailure(store, "alice", "10.0.0.1", ErrInvalidLogin, now)
+	assert.NoError(checkLoginLockout(store, "alice", "10.0.0.1", now))
+	recordLoginFailure(store, "alice", "10.0.0.2", ErrInvalidLogin, now)
+	err = checkLoginLockout(store, "alice", "10.0.0.3", now)
+	assert.True(errors.Is(err, ErrLoginLockedOut))
+	assert.NoError(checkLoginLockout(store, "bob", "10.0.0.1", now))
+
+	resp := listLoginAttempts(store, "", "", 0, now)
+	assert.True(resp.LockoutEnabled)
+	assert.Len(resp.Failures, 2)
+	assert.Equal("10.0.0.2", resp.Failures[0].SourceIP)
+	assert.Len(resp.Lockouts, 1)
+	assert.Equal(models.LoginLockoutTypeAccessKey, resp.Lockouts[0].Type)
+	assert.Equal(int32(2), resp.Lockouts[0].Failures)
+	assert.Len(listLoginAttempts(store, "", "10.0.0.1", 0, now).Failures, 1)
+
+	err = unlockLoginAttempts(store, &models.LoginUnlockRequest{}, now)
+	assert.True(errors.Is(err, ErrInvalidLoginUnlock))
+	assert.NoError(unlockLoginAttempts(store, &models.LoginUnlockRequest{AccessKey: "alice"}, now))
+	assert.NoError(checkLoginLockout(store, "alice", "10.0.0.3", now))
+	assert.Empty(listLoginAttempts(store, "", "", 0, now).Lockouts)
+}
+
+func TestHasConsoleAdminAction(t *testing.T) {
+	assert := assert.New(t)
+	permissions := map[string][]string{ConsoleResourceName: {"admin:ListUsers"}}
+	assert.True(hasConsoleAdminAction(permissions, "admin:ListUsers"))
+	assert.False(hasConsoleAdminAction(permissions, "admin:EnableUser"))
+	assert.False(hasConsoleAdminAction(map[string][]string{"arn:aws:s3:::bucket": {"admin:ListUsers"}}, "admin:ListUsers"))
+}
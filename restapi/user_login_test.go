@@ -83,6 +83,7 @@ var (
 	idpVerifyIdentityMock            func(ctx context.Context, code, state string) (*credentials.Credentials, error)
 	idpVerifyIdentityForOperatorMock func(ctx context.Context, code, state string) (*xoauth2.Token, error)
 	idpGenerateLoginURLMock          func() string
+	idpRefreshIdentityMock           func(ctx context.Context, refreshToken string) (*auth.RefreshedIdentity, error)
 )
 
 func (ac IdentityProviderMock) VerifyIdentity(ctx context.Context, code, state string) (*credentials.Credentials, error) {
@@ -97,6 +98,10 @@ func (ac IdentityProviderMock) GenerateLoginURL() string {
 	return idpGenerateLoginURLMock()
 }
 
+func (ac IdentityProviderMock) RefreshIdentity(ctx context.Context, refreshToken string) (*auth.RefreshedIdentity, error) {
+	return idpRefreshIdentityMock(ctx, refreshToken)
+}
+
 func Test_validateUserAgainstIDP(t *testing.T) {
 	provider := IdentityProviderMock{}
 	mockCode := "EAEAEAE"
@@ -0,0 +1,266 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/console/pkg/logger"
+	"github.com/minio/console/pkg/logger/message/audit"
+	"github.com/minio/console/pkg/loginthrottle"
+	"github.com/minio/console/pkg/realip"
+	"github.com/minio/console/pkg/utils"
+)
+
+// the login endpoints that are throttled, only the credential login can ask for a CAPTCHA
+const (
+	loginThrottlePath       = "/api/v1/login"
+	loginOauth2ThrottlePath = "/api/v1/login/oauth2/auth"
+)
+
+// loginThrottleMaxBody bounds the login body read to find the access key and the CAPTCHA response
+const loginThrottleMaxBody = 1 << 20
+
+// captchaVerifyTimeout bounds how long a login waits for the CAPTCHA verification
+const captchaVerifyTimeout = 5 * time.Second
+
+var (
+	globalLoginThrottle     *loginthrottle.Limiter
+	globalLoginThrottleOnce sync.Once
+
+	globalCaptchaVerifier     CaptchaVerifier
+	globalCaptchaVerifierOnce sync.Once
+)
+
+// loginThrottle returns the limiter of the login attempts
+func loginThrottle() *loginthrottle.Limiter {
+	globalLoginThrottleOnce.Do(func() {
+		globalLoginThrottle = loginthrottle.New(getConsoleLoginThrottlePolicy())
+	})
+	return globalLoginThrottle
+}
+
+// CaptchaVerifier checks the CAPTCHA response sent with a login once the failures require one
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, response, sourceIP string) (bool, error)
+}
+
+// SetCaptchaVerifier replaces the CAPTCHA verifier configured from the environment, nil disables the CAPTCHA
+func SetCaptchaVerifier(verifier CaptchaVerifier) {
+	globalCaptchaVerifierOnce.Do(func() {})
+	globalCaptchaVerifier = verifier
+}
+
+// captchaVerifier returns the CAPTCHA verifier, nil when none is configured
+func captchaVerifier() CaptchaVerifier {
+	globalCaptchaVerifierOnce.Do(func() {
+		if endpoint := getConsoleLoginCaptchaVerifyURL(); endpoint != "" {
+			globalCaptchaVerifier = newSiteVerifyCaptcha(endpoint, getConsoleLoginCaptchaSecret())
+		}
+	})
+	return globalCaptchaVerifier
+}
+
+// siteVerifyCaptcha verifies the CAPTCHA responses with the siteverify API shared by reCAPTCHA, hCaptcha
+// and Turnstile
+type siteVerifyCaptcha struct {
+	endpoint string
+	secret   string
+	client   *http.Client
+}
+
+func newSiteVerifyCaptcha(endpoint, secret string) *siteVerifyCaptcha {
+	return &siteVerifyCaptcha{
+		endpoint: endpoint,
+		secret:   secret,
+		client:   GetConsoleHTTPClient(endpoint),
+	}
+}
+
+// Verify implements CaptchaVerifier
+func (c *siteVerifyCaptcha) Verify(ctx context.Context, response, sourceIP string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, captchaVerifyTimeout)
+	defer cancel()
+	form := url.Values{
+		"secret":   {c.secret},
+		"response": {response},
+		"remoteip": {sourceIP},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("CAPTCHA verification responded with %s", resp.Status)
+	}
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, err
+	}
+	return result.Success, nil
+}
+
+// checkLoginThrottle lets the login attempt proceed or returns ErrLoginThrottled when it must wait, and
+// ErrCaptchaRequired when the failures require a CAPTCHA the attempt didn't solve
+func checkLoginThrottle(ctx context.Context, limiter *loginthrottle.Limiter, verifier CaptchaVerifier, sourceIP, accessKey, captcha string, now time.Time) (loginthrottle.Decision, error) {
+	decision := limiter.Allow(sourceIP, accessKey, now)
+	if !decision.Allowed {
+		return decision, fmt.Errorf("%w: %s %s, retry after %s", ErrLoginThrottled, decision.Reason, decision.Key, decision.RetryAfter.Round(time.Second))
+	}
+	if !decision.CaptchaRequired || verifier == nil {
+		return decision, nil
+	}
+	if captcha == "" {
+		return decision, ErrCaptchaRequired
+	}
+	solved, err := verifier.Verify(ctx, captcha, sourceIP)
+	if err != nil {
+		LogErrorContext(ctx, "unable to verify the CAPTCHA of a login from %s: %v", sourceIP, err)
+	}
+	if !solved {
+		return decision, fmt.Errorf("%w: the CAPTCHA response is invalid", ErrCaptchaRequired)
+	}
+	return decision, nil
+}
+
+// loginThrottleKey is the context key of the loginThrottleAttempt of a login
+type loginThrottleKey struct{}
+
+// loginThrottleAttempt is how the login handlers report a failed attempt to LoginThrottleMiddleware
+type loginThrottleAttempt struct {
+	failed bool
+}
+
+// countsAsLoginFailure returns whether a login failing with err is the fault of the user, MinIO being
+// unreachable isn't
+func countsAsLoginFailure(err error) bool {
+	var netErr net.Error
+	return !errors.As(err, &netErr)
+}
+
+// failedLoginAttempt reports a login that failed with err to the throttling
+func failedLoginAttempt(ctx context.Context, err error) {
+	if attempt, ok := ctx.Value(loginThrottleKey{}).(*loginThrottleAttempt); ok && countsAsLoginFailure(err) {
+		attempt.failed = true
+	}
+}
+
+// auditLoginOffender sends an entry about a throttled login to the audit targets
+func auditLoginOffender(ctx context.Context, r *http.Request, event, sourceIP, accessKey string, detail string) {
+	LogInfoContext(ctx, "login %s for %s from %s: %s", event, accessKey, sourceIP, detail)
+	entry := audit.NewEntry(logger.GetGlobalDeploymentID())
+	entry.Trigger = "login-throttle"
+	entry.API.Path = r.URL.Path
+	entry.API.Method = r.Method
+	entry.RemoteHost = sourceIP
+	entry.UserAgent = r.UserAgent()
+	if requestID, ok := ctx.Value(utils.ContextRequestID).(string); ok {
+		entry.RequestID = requestID
+	}
+	entry.Tags = map[string]interface{}{
+		"event":     event,
+		"accessKey": accessKey,
+		"detail":    detail,
+	}
+	logger.AuditLog(logger.SetAuditEntry(ctx, &entry), nil, nil, nil)
+}
+
+// writeLoginThrottleError answers a rejected login attempt
+func writeLoginThrottleError(w http.ResponseWriter, r *http.Request, decision loginthrottle.Decision, err error) {
+	apiErr := ErrorWithContext(r.Context(), err)
+	w.Header().Set("Content-Type", "application/json")
+	if decision.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+	}
+	w.WriteHeader(int(apiErr.Code))
+	json.NewEncoder(w).Encode(apiErr)
+}
+
+// LoginThrottleMiddleware rate limits the logins per source IP and access key, makes them wait longer after
+// every consecutive failure and asks for a CAPTCHA after too many of them
+func LoginThrottleMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || (r.URL.Path != loginThrottlePath && r.URL.Path != loginOauth2ThrottlePath) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx := r.Context()
+		limiter := loginThrottle()
+		sourceIP := realip.ClientIP(r)
+
+		var verifier CaptchaVerifier
+		var body struct {
+			AccessKey string `json:"accessKey"`
+			Captcha   string `json:"captcha"`
+		}
+		if r.URL.Path == loginThrottlePath {
+			data, err := io.ReadAll(io.LimitReader(r.Body, loginThrottleMaxBody))
+			if err != nil {
+				writeLoginThrottleError(w, r, loginthrottle.Decision{}, ErrBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(data))
+			// a malformed body is rejected by the handler
+			json.Unmarshal(data, &body)
+			verifier = captchaVerifier()
+		}
+
+		decision, err := checkLoginThrottle(ctx, limiter, verifier, sourceIP, body.AccessKey, body.Captcha, time.Now())
+		if err != nil {
+			event := "throttled"
+			if errors.Is(err, ErrCaptchaRequired) {
+				event = "captcha-rejected"
+			}
+			auditLoginOffender(ctx, r, event, sourceIP, body.AccessKey, err.Error())
+			writeLoginThrottleError(w, r, decision, err)
+			return
+		}
+
+		attempt := &loginThrottleAttempt{}
+		rw := logger.NewResponseWriter(w)
+		next.ServeHTTP(rw, r.WithContext(context.WithValue(ctx, loginThrottleKey{}, attempt)))
+		if attempt.failed {
+			failures := limiter.Failed(sourceIP, body.AccessKey, time.Now())
+			if threshold := limiter.Policy().CaptchaThreshold; threshold > 0 && failures == threshold && verifier != nil {
+				auditLoginOffender(ctx, r, "captcha-required", sourceIP, body.AccessKey, fmt.Sprintf("%d consecutive failed logins", failures))
+			}
+		} else if rw.StatusCode < http.StatusBadRequest {
+			limiter.Succeeded(body.AccessKey)
+		}
+	})
+}
@@ -0,0 +1,140 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/loginthrottle"
+	"github.com/stretchr/testify/assert"
+)
+
+type captchaVerifierMock struct {
+	responses []string
+}
+
+func (c *captchaVerifierMock) Verify(ctx context.Context, response, sourceIP string) (bool, error) {
+	c.responses = append(c.responses, response)
+	return response == "solved", nil
+}
+
+func Test_checkLoginThrottle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	limiter := loginthrottle.New(loginthrottle.Policy{AccessKeyRate: 3, Window: time.Minute, CaptchaThreshold: 1})
+	verifier := &captchaVerifierMock{}
+	now := time.Now()
+
+	_, err := checkLoginThrottle(ctx, limiter, verifier, "10.0.0.1", "alice", "", now)
+	assert.NoError(err)
+	limiter.Failed("10.0.0.1", "alice", now)
+
+	// after the failure a CAPTCHA is required
+	_, err = checkLoginThrottle(ctx, limiter, verifier, "10.0.0.1", "alice", "", now)
+	assert.True(errors.Is(err, ErrCaptchaRequired))
+	_, err = checkLoginThrottle(ctx, limiter, verifier, "10.0.0.1", "alice", "wrong", now)
+	assert.True(errors.Is(err, ErrCaptchaRequired))
+	assert.Equal([]string{"wrong"}, verifier.responses)
+
+	// without a verifier the CAPTCHA is never asked, but the rate still applies
+	decision, err := checkLoginThrottle(ctx, limiter, nil, "10.0.0.1", "alice", "", now)
+	assert.True(errors.Is(err, ErrLoginThrottled))
+	assert.Equal(loginthrottle.ReasonRate, decision.Reason)
+	assert.Equal(time.Minute, decision.RetryAfter)
+
+	_, err = checkLoginThrottle(ctx, limiter, verifier, "10.0.0.1", "alice", "solved", now.Add(time.Minute+time.Second))
+	assert.NoError(err)
+}
+
+func Test_siteVerifyCaptcha(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(r.ParseForm())
+		assert.Equal("secret", r.PostForm.Get("secret"))
+		assert.Equal("10.0.0.1", r.PostForm.Get("remoteip"))
+		json.NewEncoder(w).Encode(map[string]bool{"success": r.PostForm.Get("response") == "solved"})
+	}))
+	defer server.Close()
+
+	verifier := newSiteVerifyCaptcha(server.URL, "secret")
+	solved, err := verifier.Verify(context.Background(), "solved", "10.0.0.1")
+	assert.NoError(err)
+	assert.True(solved)
+	solved, err = verifier.Verify(context.Background(), "wrong", "10.0.0.1")
+	assert.NoError(err)
+	assert.False(solved)
+}
+
+func TestLoginThrottleMiddleware(t *testing.T) {
+	assert := assert.New(t)
+	globalLoginThrottleOnce.Do(func() {})
+	globalLoginThrottle = loginthrottle.New(loginthrottle.Policy{Window: time.Minute, BackoffBase: time.Hour, BackoffMax: time.Hour})
+	defer func() { globalLoginThrottle = nil }()
+
+	var received string
+	handler := LoginThrottleMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		var lr models.LoginRequest
+		json.Unmarshal(body, &lr)
+		if lr.SecretKey != "right" {
+			failedLoginAttempt(r.Context(), ErrInvalidLogin)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	login := func(path, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		r.RemoteAddr = "10.0.0.1:40000"
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	w := login(loginThrottlePath, `{"accessKey": "alice", "secretKey": "right"}`)
+	assert.Equal(http.StatusNoContent, w.Code)
+	// the handler still reads the whole body
+	assert.Equal(`{"accessKey": "alice", "secretKey": "right"}`, received)
+
+	w = login(loginThrottlePath, `{"accessKey": "alice", "secretKey": "wrong"}`)
+	assert.Equal(http.StatusUnauthorized, w.Code)
+
+	// the failure makes the source IP and the access key back off
+	w = login(loginThrottlePath, `{"accessKey": "alice", "secretKey": "right"}`)
+	assert.Equal(http.StatusTooManyRequests, w.Code)
+	assert.Equal("3600", w.Header().Get("Retry-After"))
+	var apiErr models.Error
+	assert.NoError(json.NewDecoder(w.Body).Decode(&apiErr))
+	assert.Equal(ErrLoginThrottled.Error(), *apiErr.Message)
+
+	w = login(loginOauth2ThrottlePath, `{"code": "code", "state": "state"}`)
+	assert.Equal(http.StatusTooManyRequests, w.Code)
+
+	// other requests are not throttled
+	w = login("/api/v1/buckets", `{}`)
+	assert.Equal(http.StatusUnauthorized, w.Code)
+}
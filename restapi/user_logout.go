@@ -27,6 +27,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
 	"github.com/minio/console/pkg/auth/idp/oauth2"
 	"github.com/minio/console/restapi/operations"
 	authApi "github.com/minio/console/restapi/operations/auth"
@@ -75,6 +76,12 @@ func getLogoutResponse(session *models.Principal, params authApi.LogoutParams) *
 			return ErrorWithContext(ctx, err)
 		}
 	}
+	// end the session on every console replica sharing the session store
+	if token, err := auth.GetTokenFromRequest(params.HTTPRequest); err == nil {
+		if err := auth.EndSession(token); err != nil {
+			return ErrorWithContext(ctx, err)
+		}
+	}
 	creds := getConsoleCredentialsFromSession(session)
 	credentials := ConsoleCredentials{ConsoleCredentials: creds}
 	logout(credentials)
@@ -158,6 +158,21 @@ func registerObjectsHandlers(api *operations.ConsoleAPI) {
 		}
 		return objectApi.NewPutObjectRestoreOK()
 	})
+	// Restore object transitioned to a remote tier
+	api.ObjectRestoreTieredObjectHandler = objectApi.RestoreTieredObjectHandlerFunc(func(params objectApi.RestoreTieredObjectParams, session *models.Principal) middleware.Responder {
+		if err := getRestoreTieredObjectResponse(session, params); err != nil {
+			return objectApi.NewRestoreTieredObjectDefault(int(err.Code)).WithPayload(err)
+		}
+		return objectApi.NewRestoreTieredObjectOK()
+	})
+	// Restore status of an object transitioned to a remote tier
+	api.ObjectGetObjectTierRestoreStatusHandler = objectApi.GetObjectTierRestoreStatusHandlerFunc(func(params objectApi.GetObjectTierRestoreStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getObjectTierRestoreStatusResponse(session, params)
+		if err != nil {
+			return objectApi.NewGetObjectTierRestoreStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return objectApi.NewGetObjectTierRestoreStatusOK().WithPayload(resp)
+	})
 	// Metadata in object
 	api.ObjectGetObjectMetadataHandler = objectApi.GetObjectMetadataHandlerFunc(func(params objectApi.GetObjectMetadataParams, session *models.Principal) middleware.Responder {
 		resp, err := getObjectMetadataResponse(session, params)
@@ -395,6 +410,45 @@ func parseRange(s string, size int64) ([]httpRange, error) {
 	return ranges, nil
 }
 
+// quoteETag returns the ETag as an HTTP entity tag
+func quoteETag(etag string) string {
+	etag = strings.Trim(etag, "\"")
+	if etag == "" {
+		return ""
+	}
+	return "\"" + etag + "\""
+}
+
+// isNotModified evaluates the If-None-Match and If-Modified-Since headers of a GET or HEAD
+// request, If-Modified-Since is ignored when If-None-Match is present as per RFC 7232
+func isNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		if etag == "" {
+			return false
+		}
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimSpace(tag)
+			// weak comparison, W/"x" matches "x"
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		t, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		// Last-Modified is sent with a one second precision
+		return !lastModified.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
 func getDownloadObjectResponse(session *models.Principal, params objectApi.DownloadObjectParams) (middleware.Responder, *models.Error) {
 	ctx := params.HTTPRequest.Context()
 	var prefix string
@@ -458,6 +512,18 @@ func getDownloadObjectResponse(session *models.Principal, params objectApi.Downl
 			return
 		}
 
+		// let the browser revalidate its cached copy instead of downloading the object again
+		etag := quoteETag(stat.ETag)
+		if etag != "" {
+			rw.Header().Set("ETag", etag)
+		}
+		rw.Header().Set("Last-Modified", stat.LastModified.UTC().Format(http.TimeFormat))
+		rw.Header().Set("Cache-Control", "private, no-cache")
+		if isNotModified(params.HTTPRequest, etag, stat.LastModified) {
+			rw.WriteHeader(http.StatusNotModified)
+			return
+		}
+
 		// if we are getting a Range Request (video) handle that specially
 		ranges, err := parseRange(params.HTTPRequest.Header.Get("Range"), stat.Size)
 		if err != nil {
@@ -475,8 +541,6 @@ func getDownloadObjectResponse(session *models.Principal, params objectApi.Downl
 			rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", escapedName))
 		}
 
-		rw.Header().Set("Last-Modified", stat.LastModified.UTC().Format(http.TimeFormat))
-
 		if isPreview {
 			// In case content type was uploaded as octet-stream, we double verify content type
 			if stat.ContentType == "application/octet-stream" {
@@ -863,6 +927,9 @@ func uploadFiles(ctx context.Context, client MinioClient, params objectApi.PostB
 		return err
 	}
 
+	// objects of buckets with an encryption context need it on every upload
+	sseKMS := getBucketUploadEncryption(ctx, client, params.BucketName)
+
 	for {
 		p, err := mr.NextPart()
 		if err == io.EOF {
@@ -880,8 +947,9 @@ func uploadFiles(ctx context.Context, client MinioClient, params objectApi.PostB
 		}
 
 		_, err = client.putObject(ctx, params.BucketName, path.Join(prefix, path.Clean(p.FileName())), p, size, minio.PutObjectOptions{
-			ContentType:      contentType,
-			DisableMultipart: true, // Do not upload as multipart stream for console uploader.
+			ContentType:          contentType,
+			DisableMultipart:     true, // Do not upload as multipart stream for console uploader.
+			ServerSideEncryption: sseKMS,
 		})
 
 		if err != nil {
@@ -1191,6 +1259,103 @@ func getObjectInfo(ctx context.Context, client MinioClient, bucketName, prefix s
 	return objectData, nil
 }
 
+func getRestoreTieredObjectResponse(session *models.Principal, params objectApi.RestoreTieredObjectParams) *models.Error {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	var prefix string
+	if params.Prefix != "" {
+		encodedPrefix := SanitizeEncodedPrefix(params.Prefix)
+		decodedPrefix, err := base64.StdEncoding.DecodeString(encodedPrefix)
+		if err != nil {
+			return ErrorWithContext(ctx, err)
+		}
+		prefix = string(decodedPrefix)
+	}
+	var versionID string
+	if params.VersionID != nil {
+		versionID = *params.VersionID
+	}
+	err = restoreTieredObject(ctx, minioClient, params.BucketName, prefix, versionID, params.Body)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// restoreTieredObject requests a temporary copy of an object that was transitioned
+// to a remote tier, the copy stays available for the requested number of days
+func restoreTieredObject(ctx context.Context, client MinioClient, bucketName, prefix, versionID string, restoreOpts *models.RestoreTieredObjectRequest) error {
+	if restoreOpts == nil || restoreOpts.Days == nil {
+		return errors.New("restore days can't be nil")
+	}
+	if *restoreOpts.Days < 1 {
+		return errors.New("restore days must be greater than zero")
+	}
+	req := minio.RestoreRequest{}
+	req.SetDays(int(*restoreOpts.Days))
+	if restoreOpts.Tier != "" {
+		req.SetGlacierJobParameters(minio.GlacierJobParameters{Tier: minio.TierType(restoreOpts.Tier)})
+	}
+	return client.restoreObject(ctx, bucketName, prefix, versionID, req)
+}
+
+func getObjectTierRestoreStatusResponse(session *models.Principal, params objectApi.GetObjectTierRestoreStatusParams) (*models.ObjectTierRestoreStatus, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	var prefix string
+	if params.Prefix != "" {
+		encodedPrefix := SanitizeEncodedPrefix(params.Prefix)
+		decodedPrefix, err := base64.StdEncoding.DecodeString(encodedPrefix)
+		if err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		prefix = string(decodedPrefix)
+	}
+	var versionID string
+	if params.VersionID != nil {
+		versionID = *params.VersionID
+	}
+	status, err := getObjectTierRestoreStatus(ctx, minioClient, params.BucketName, prefix, versionID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return status, nil
+}
+
+// getObjectTierRestoreStatus reports whether an object lives in a remote tier and,
+// if a restore was requested, whether it's still in progress and when the restored
+// copy expires
+func getObjectTierRestoreStatus(ctx context.Context, client MinioClient, bucketName, prefix, versionID string) (*models.ObjectTierRestoreStatus, error) {
+	objectInfo, err := client.statObject(ctx, bucketName, prefix, minio.GetObjectOptions{VersionID: versionID})
+	if err != nil {
+		return nil, err
+	}
+	status := &models.ObjectTierRestoreStatus{
+		StorageClass: objectInfo.StorageClass,
+		// objects in a remote tier report the tier name as storage class
+		Transitioned: objectInfo.StorageClass != "" && objectInfo.StorageClass != "STANDARD" && objectInfo.StorageClass != "REDUCED_REDUNDANCY",
+	}
+	if objectInfo.Restore != nil {
+		status.OngoingRestore = objectInfo.Restore.OngoingRestore
+		if !objectInfo.Restore.ExpiryTime.IsZero() {
+			status.RestoreExpiryDate = objectInfo.Restore.ExpiryTime.Format(time.RFC3339)
+		}
+	}
+	return status, nil
+}
+
 // newClientURL returns an abstracted URL for filesystems and object storage.
 func newClientURL(urlStr string) *mc.ClientURL {
 	scheme, rest := getScheme(urlStr)
@@ -0,0 +1,385 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	objectApi "github.com/minio/console/restapi/operations/object"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	checksumManifestAlgorithm       = "SHA256"
+	defaultChecksumPartSize   int64 = 16 << 20
+	minChecksumPartSize       int64 = 1 << 20
+	maxChecksumPartSize       int64 = 5 << 30
+)
+
+var errUnsupportedChecksumAlgorithm = errors.New("unsupported checksum algorithm, only SHA256 manifests are supported")
+
+func registerObjectChecksumHandlers(api *operations.ConsoleAPI) {
+	// get object checksum manifest
+	api.ObjectGetObjectChecksumManifestHandler = objectApi.GetObjectChecksumManifestHandlerFunc(func(params objectApi.GetObjectChecksumManifestParams, session *models.Principal) middleware.Responder {
+		resp, err := getObjectChecksumManifestResponse(session, params)
+		if err != nil {
+			return objectApi.NewGetObjectChecksumManifestDefault(int(err.Code)).WithPayload(err)
+		}
+		return objectApi.NewGetObjectChecksumManifestOK().WithPayload(resp)
+	})
+	// verify object against a checksum manifest
+	api.ObjectVerifyObjectChecksumManifestHandler = objectApi.VerifyObjectChecksumManifestHandlerFunc(func(params objectApi.VerifyObjectChecksumManifestParams, session *models.Principal) middleware.Responder {
+		resp, err := getVerifyObjectChecksumManifestResponse(session, params)
+		if err != nil {
+			return objectApi.NewVerifyObjectChecksumManifestDefault(int(err.Code)).WithPayload(err)
+		}
+		return objectApi.NewVerifyObjectChecksumManifestOK().WithPayload(resp)
+	})
+	// verify object integrity against a digest
+	api.ObjectVerifyObjectIntegrityHandler = objectApi.VerifyObjectIntegrityHandlerFunc(func(params objectApi.VerifyObjectIntegrityParams, session *models.Principal) middleware.Responder {
+		resp, err := getVerifyObjectIntegrityResponse(session, params)
+		if err != nil {
+			return objectApi.NewVerifyObjectIntegrityDefault(int(err.Code)).WithPayload(err)
+		}
+		return objectApi.NewVerifyObjectIntegrityOK().WithPayload(resp)
+	})
+}
+
+func getObjectChecksumManifestResponse(session *models.Principal, params objectApi.GetObjectChecksumManifestParams) (*models.ObjectChecksumManifest, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	prefix, err := decodeObjectPrefix(params.Prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	var versionID string
+	if params.VersionID != nil {
+		versionID = *params.VersionID
+	}
+	partSize := defaultChecksumPartSize
+	if params.PartSize != nil {
+		partSize = *params.PartSize
+	}
+	manifest, err := computeObjectChecksumManifest(ctx, minioClient, params.BucketName, prefix, versionID, partSize)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return manifest, nil
+}
+
+func getVerifyObjectChecksumManifestResponse(session *models.Principal, params objectApi.VerifyObjectChecksumManifestParams) (*models.ObjectChecksumVerifyResponse, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	prefix, err := decodeObjectPrefix(params.Prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	var versionID string
+	if params.VersionID != nil {
+		versionID = *params.VersionID
+	}
+	resp, err := verifyObjectChecksumManifest(ctx, minioClient, params.BucketName, prefix, versionID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+// decodeObjectPrefix decodes the base64 encoded object name sent by the UI
+func decodeObjectPrefix(encodedPrefix string) (string, error) {
+	if encodedPrefix == "" {
+		return "", nil
+	}
+	decodedPrefix, err := base64.StdEncoding.DecodeString(SanitizeEncodedPrefix(encodedPrefix))
+	if err != nil {
+		return "", err
+	}
+	return string(decodedPrefix), nil
+}
+
+// computeObjectChecksumManifest reads the object and calculates the checksum of
+// every partSize bytes, so external tools can fetch and verify ranges in parallel
+func computeObjectChecksumManifest(ctx context.Context, client MinioClient, bucketName, objectName, versionID string, partSize int64) (*models.ObjectChecksumManifest, error) {
+	if partSize < minChecksumPartSize || partSize > maxChecksumPartSize {
+		return nil, fmt.Errorf("part size must be between %d and %d bytes", minChecksumPartSize, maxChecksumPartSize)
+	}
+	opts := minio.GetObjectOptions{VersionID: versionID}
+	info, err := client.statObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	// pin the version and content we got information for
+	opts.VersionID = info.VersionID
+	if err = opts.SetMatchETag(info.ETag); err != nil {
+		return nil, err
+	}
+	obj, err := client.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	manifest := &models.ObjectChecksumManifest{
+		ObjectName: objectName,
+		VersionID:  info.VersionID,
+		Etag:       info.ETag,
+		Size:       info.Size,
+		Algorithm:  checksumManifestAlgorithm,
+		PartSize:   partSize,
+		Parts:      []*models.ObjectChecksumManifestPart{},
+	}
+	var offset int64
+	for partNumber := int32(1); offset < info.Size; partNumber++ {
+		size := partSize
+		if remaining := info.Size - offset; remaining < size {
+			size = remaining
+		}
+		hash := sha256.New()
+		if _, err := io.CopyN(hash, obj, size); err != nil {
+			return nil, err
+		}
+		manifest.Parts = append(manifest.Parts, &models.ObjectChecksumManifestPart{
+			PartNumber: partNumber,
+			Offset:     offset,
+			Size:       size,
+			Checksum:   hex.EncodeToString(hash.Sum(nil)),
+		})
+		offset += size
+	}
+	return manifest, nil
+}
+
+// verifyObjectChecksumManifest checks the stored object against a manifest supplied by the client
+func verifyObjectChecksumManifest(ctx context.Context, client MinioClient, bucketName, objectName, versionID string, manifest *models.ObjectChecksumManifest) (*models.ObjectChecksumVerifyResponse, error) {
+	if manifest == nil {
+		return nil, errors.New("checksum manifest can't be nil")
+	}
+	if manifest.Algorithm != "" && !strings.EqualFold(manifest.Algorithm, checksumManifestAlgorithm) {
+		return nil, errUnsupportedChecksumAlgorithm
+	}
+	partSize := manifest.PartSize
+	if partSize == 0 {
+		partSize = defaultChecksumPartSize
+	}
+	current, err := computeObjectChecksumManifest(ctx, client, bucketName, objectName, versionID, partSize)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ObjectChecksumVerifyResponse{
+		Valid:           true,
+		MismatchedParts: []int32{},
+	}
+	if manifest.Size != current.Size {
+		resp.Valid = false
+		resp.Message = fmt.Sprintf("object size is %d bytes, manifest expects %d bytes", current.Size, manifest.Size)
+		return resp, nil
+	}
+	if len(manifest.Parts) != len(current.Parts) {
+		resp.Valid = false
+		resp.Message = fmt.Sprintf("object has %d parts, manifest lists %d parts", len(current.Parts), len(manifest.Parts))
+		return resp, nil
+	}
+	for i, part := range current.Parts {
+		expected := manifest.Parts[i]
+		if expected == nil || expected.Size != part.Size || !strings.EqualFold(expected.Checksum, part.Checksum) {
+			resp.Valid = false
+			resp.MismatchedParts = append(resp.MismatchedParts, part.PartNumber)
+		}
+	}
+	if !resp.Valid {
+		resp.Message = fmt.Sprintf("%d of %d parts don't match the manifest", len(resp.MismatchedParts), len(current.Parts))
+	}
+	return resp, nil
+}
+
+func getVerifyObjectIntegrityResponse(session *models.Principal, params objectApi.VerifyObjectIntegrityParams) (*models.ObjectIntegrityResponse, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	prefix, err := decodeObjectPrefix(params.Prefix)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	var versionID string
+	if params.VersionID != nil {
+		versionID = *params.VersionID
+	}
+	resp, err := verifyObjectIntegrity(ctx, minioClient, params.BucketName, prefix, versionID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return resp, nil
+}
+
+// newIntegrityHash returns the hash for the algorithm, CRC values are
+// calculated the same way S3 trailing checksums are
+func newIntegrityHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case models.ObjectIntegrityRequestAlgorithmCRC32:
+		return crc32.NewIEEE(), nil
+	case models.ObjectIntegrityRequestAlgorithmCRC32C:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli)), nil
+	case models.ObjectIntegrityRequestAlgorithmSHA1:
+		return sha1.New(), nil
+	case models.ObjectIntegrityRequestAlgorithmSHA256:
+		return sha256.New(), nil
+	case models.ObjectIntegrityRequestAlgorithmMD5:
+		return md5.New(), nil
+	}
+	return nil, fmt.Errorf("unsupported algorithm %s", algorithm)
+}
+
+// decodeDigest accepts digests either hex or base64 encoded
+func decodeDigest(digest string) ([]byte, error) {
+	digest = strings.TrimSpace(digest)
+	if b, err := hex.DecodeString(digest); err == nil {
+		return b, nil
+	}
+	b, err := base64.StdEncoding.DecodeString(digest)
+	if err != nil {
+		return nil, errors.New("digest must be hex or base64 encoded")
+	}
+	return b, nil
+}
+
+// multipartETag reconstructs the ETag S3 assigns to an object uploaded in parts of
+// partSize bytes, the MD5 of the concatenated part MD5s followed by the number of parts
+func multipartETag(reader io.Reader, size, partSize int64) (string, error) {
+	if partSize <= 0 || size <= partSize {
+		h := md5.New()
+		if _, err := io.CopyN(h, reader, size); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+	var sums []byte
+	parts := 0
+	for offset := int64(0); offset < size; offset += partSize {
+		n := partSize
+		if remaining := size - offset; remaining < n {
+			n = remaining
+		}
+		h := md5.New()
+		if _, err := io.CopyN(h, reader, n); err != nil {
+			return "", err
+		}
+		sums = append(sums, h.Sum(nil)...)
+		parts++
+	}
+	sum := md5.Sum(sums)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// verifyObjectIntegrity computes the digest of the stored object and compares it with the one supplied
+// by the client
+func verifyObjectIntegrity(ctx context.Context, client MinioClient, bucketName, objectName, versionID string, req *models.ObjectIntegrityRequest) (*models.ObjectIntegrityResponse, error) {
+	if req == nil || req.Algorithm == nil || req.Digest == nil {
+		return nil, errors.New("algorithm and digest are required")
+	}
+	algorithm := strings.ToUpper(*req.Algorithm)
+	opts := minio.GetObjectOptions{VersionID: versionID}
+	info, err := client.statObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ObjectIntegrityResponse{
+		Algorithm: algorithm,
+		Expected:  *req.Digest,
+		Etag:      info.ETag,
+	}
+
+	// multipart ETags can't be verified without knowing the part size used on upload
+	isMultipartETag := strings.Contains(info.ETag, "-")
+	if algorithm == models.ObjectIntegrityRequestAlgorithmETAG && isMultipartETag && req.PartSize <= 0 {
+		resp.Computed = info.ETag
+		resp.Valid = strings.EqualFold(strings.Trim(*req.Digest, `"`), info.ETag)
+		resp.Message = "compared against the stored ETag, provide the part size to verify the object content"
+		return resp, nil
+	}
+
+	opts.VersionID = info.VersionID
+	if err = opts.SetMatchETag(info.ETag); err != nil {
+		return nil, err
+	}
+	obj, err := client.getObject(ctx, bucketName, objectName, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+
+	if algorithm == models.ObjectIntegrityRequestAlgorithmETAG {
+		computed, err := multipartETag(obj, info.Size, req.PartSize)
+		if err != nil {
+			return nil, err
+		}
+		resp.Computed = computed
+		resp.Valid = strings.EqualFold(strings.Trim(*req.Digest, `"`), computed)
+		if !strings.EqualFold(computed, info.ETag) {
+			resp.Message = "object content doesn't match the stored ETag"
+		}
+		return resp, nil
+	}
+
+	h, err := newIntegrityHash(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := decodeDigest(*req.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = io.CopyN(h, obj, info.Size); err != nil {
+		return nil, err
+	}
+	sum := h.Sum(nil)
+	resp.Computed = hex.EncodeToString(sum)
+	resp.ComputedBase64 = base64.StdEncoding.EncodeToString(sum)
+	resp.Valid = bytes.Equal(expected, sum)
+	return resp, nil
+}
@@ -0,0 +1,167 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash/crc32"
+	"io"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockChecksumObject(content []byte) {
+	minioStatObjectMock = func(ctx context.Context, bucketName, prefix string, opts minio.GetObjectOptions) (minio.ObjectInfo, error) {
+		return minio.ObjectInfo{Key: prefix, Size: int64(len(content)), ETag: "etag", VersionID: "v1"}, nil
+	}
+	minioGetObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+}
+
+func Test_computeObjectChecksumManifest(t *testing.T) {
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := minioClientMock{}
+
+	content := bytes.Repeat([]byte("a"), int(minChecksumPartSize)*2+10)
+	mockChecksumObject(content)
+
+	manifest, err := computeObjectChecksumManifest(ctx, client, "bucket", "file.bin", "", minChecksumPartSize)
+	assert.Nil(err)
+	assert.Equal(int64(len(content)), manifest.Size)
+	assert.Equal("v1", manifest.VersionID)
+	assert.Len(manifest.Parts, 3)
+	assert.Equal(int64(10), manifest.Parts[2].Size)
+	assert.Equal(minChecksumPartSize*2, manifest.Parts[2].Offset)
+	lastPart := sha256.Sum256(content[minChecksumPartSize*2:])
+	assert.Equal(hex.EncodeToString(lastPart[:]), manifest.Parts[2].Checksum)
+
+	// part size out of range
+	_, err = computeObjectChecksumManifest(ctx, client, "bucket", "file.bin", "", 10)
+	assert.NotNil(err)
+
+	// empty objects have no parts
+	mockChecksumObject([]byte{})
+	manifest, err = computeObjectChecksumManifest(ctx, client, "bucket", "empty", "", minChecksumPartSize)
+	assert.Nil(err)
+	assert.Empty(manifest.Parts)
+}
+
+func Test_verifyObjectChecksumManifest(t *testing.T) {
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := minioClientMock{}
+
+	content := bytes.Repeat([]byte("b"), int(minChecksumPartSize)+1)
+	mockChecksumObject(content)
+	manifest, err := computeObjectChecksumManifest(ctx, client, "bucket", "file.bin", "", minChecksumPartSize)
+	assert.Nil(err)
+
+	resp, err := verifyObjectChecksumManifest(ctx, client, "bucket", "file.bin", "", manifest)
+	assert.Nil(err)
+	assert.True(resp.Valid)
+	assert.Empty(resp.MismatchedParts)
+
+	// second part changed
+	content[len(content)-1] = 'c'
+	mockChecksumObject(content)
+	resp, err = verifyObjectChecksumManifest(ctx, client, "bucket", "file.bin", "", manifest)
+	assert.Nil(err)
+	assert.False(resp.Valid)
+	assert.Equal([]int32{2}, resp.MismatchedParts)
+
+	// object size changed
+	mockChecksumObject(content[:10])
+	resp, err = verifyObjectChecksumManifest(ctx, client, "bucket", "file.bin", "", manifest)
+	assert.Nil(err)
+	assert.False(resp.Valid)
+	assert.NotEmpty(resp.Message)
+
+	_, err = verifyObjectChecksumManifest(ctx, client, "bucket", "file.bin", "", &models.ObjectChecksumManifest{Algorithm: "MD5"})
+	assert.Equal(errUnsupportedChecksumAlgorithm, err)
+}
+
+func Test_verifyObjectIntegrity(t *testing.T) {
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := minioClientMock{}
+
+	content := []byte("integrity matters")
+	mockChecksumObject(content)
+
+	sha := sha256.Sum256(content)
+	resp, err := verifyObjectIntegrity(ctx, client, "bucket", "file.txt", "", &models.ObjectIntegrityRequest{
+		Algorithm: swag.String(models.ObjectIntegrityRequestAlgorithmSHA256),
+		Digest:    swag.String(hex.EncodeToString(sha[:])),
+	})
+	assert.Nil(err)
+	assert.True(resp.Valid)
+
+	// CRC32C digests are usually base64 encoded
+	crc := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	crc.Write(content)
+	resp, err = verifyObjectIntegrity(ctx, client, "bucket", "file.txt", "", &models.ObjectIntegrityRequest{
+		Algorithm: swag.String(models.ObjectIntegrityRequestAlgorithmCRC32C),
+		Digest:    swag.String(base64.StdEncoding.EncodeToString(crc.Sum(nil))),
+	})
+	assert.Nil(err)
+	assert.True(resp.Valid)
+
+	resp, err = verifyObjectIntegrity(ctx, client, "bucket", "file.txt", "", &models.ObjectIntegrityRequest{
+		Algorithm: swag.String(models.ObjectIntegrityRequestAlgorithmSHA1),
+		Digest:    swag.String("0000"),
+	})
+	assert.Nil(err)
+	assert.False(resp.Valid)
+
+	_, err = verifyObjectIntegrity(ctx, client, "bucket", "file.txt", "", &models.ObjectIntegrityRequest{
+		Algorithm: swag.String(models.ObjectIntegrityRequestAlgorithmSHA1),
+		Digest:    swag.String("not a digest!"),
+	})
+	assert.NotNil(err)
+}
+
+func Test_multipartETag(t *testing.T) {
+	assert := assert.New(t)
+	content := []byte("0123456789")
+
+	single := md5.Sum(content)
+	etag, err := multipartETag(bytes.NewReader(content), int64(len(content)), 0)
+	assert.Nil(err)
+	assert.Equal(hex.EncodeToString(single[:]), etag)
+
+	first := md5.Sum(content[:4])
+	second := md5.Sum(content[4:8])
+	third := md5.Sum(content[8:])
+	all := md5.Sum(append(append(first[:], second[:]...), third[:]...))
+	etag, err = multipartETag(bytes.NewReader(content), int64(len(content)), 4)
+	assert.Nil(err)
+	assert.Equal(hex.EncodeToString(all[:])+"-3", etag)
+}
@@ -22,6 +22,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"reflect"
 	"testing"
@@ -46,6 +48,8 @@ var (
 	minioGetObjectTaggingMock   func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error)
 	minioPutObjectTaggingMock   func(ctx context.Context, bucketName, objectName string, otags *tags.Tags, opts minio.PutObjectTaggingOptions) error
 	minioStatObjectMock         func(ctx context.Context, bucketName, prefix string, opts minio.GetObjectOptions) (objectInfo minio.ObjectInfo, err error)
+	minioRestoreObjectMock      func(ctx context.Context, bucketName, objectName, versionID string, opts minio.RestoreRequest) error
+	minioGetObjectMock          func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error)
 )
 
 var (
@@ -92,6 +96,14 @@ func (ac minioClientMock) statObject(ctx context.Context, bucketName, prefix str
 	return minioStatObjectMock(ctx, bucketName, prefix, opts)
 }
 
+func (ac minioClientMock) restoreObject(ctx context.Context, bucketName, objectName, versionID string, opts minio.RestoreRequest) error {
+	return minioRestoreObjectMock(ctx, bucketName, objectName, versionID, opts)
+}
+
+func (ac minioClientMock) getObject(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	return minioGetObjectMock(ctx, bucketName, objectName, opts)
+}
+
 // mock functions for s3ClientMock
 func (c s3ClientMock) list(ctx context.Context, opts mc.ListOptions) <-chan *mc.ClientContent {
 	return mcListMock(ctx, opts)
@@ -1280,6 +1292,98 @@ func Test_getObjectInfo(t *testing.T) {
 	}
 }
 
+func Test_restoreTieredObject(t *testing.T) {
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := minioClientMock{}
+
+	var restoreCalled bool
+	minioRestoreObjectMock = func(ctx context.Context, bucketName, objectName, versionID string, opts minio.RestoreRequest) error {
+		restoreCalled = true
+		return nil
+	}
+
+	// days are required
+	err := restoreTieredObject(ctx, client, "bucket", "file.txt", "", &models.RestoreTieredObjectRequest{})
+	assert.NotNil(err)
+	assert.False(restoreCalled)
+
+	err = restoreTieredObject(ctx, client, "bucket", "file.txt", "", &models.RestoreTieredObjectRequest{Days: swag.Int32(0)})
+	assert.NotNil(err)
+	assert.False(restoreCalled)
+
+	err = restoreTieredObject(ctx, client, "bucket", "file.txt", "v1", &models.RestoreTieredObjectRequest{
+		Days: swag.Int32(3),
+		Tier: models.RestoreTieredObjectRequestTierExpedited,
+	})
+	assert.Nil(err)
+	assert.True(restoreCalled)
+
+	minioRestoreObjectMock = func(ctx context.Context, bucketName, objectName, versionID string, opts minio.RestoreRequest) error {
+		return errors.New("object is not in a remote tier")
+	}
+	err = restoreTieredObject(ctx, client, "bucket", "file.txt", "", &models.RestoreTieredObjectRequest{Days: swag.Int32(1)})
+	if assert.NotNil(err) {
+		assert.Equal("object is not in a remote tier", err.Error())
+	}
+}
+
+func Test_getObjectTierRestoreStatus(t *testing.T) {
+	assert := assert.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := minioClientMock{}
+	expiry := time.Date(2023, time.May, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		test       string
+		objectInfo minio.ObjectInfo
+		statErr    error
+		want       *models.ObjectTierRestoreStatus
+	}{
+		{
+			test:       "object stored locally",
+			objectInfo: minio.ObjectInfo{StorageClass: "STANDARD"},
+			want:       &models.ObjectTierRestoreStatus{StorageClass: "STANDARD"},
+		},
+		{
+			test: "restore in progress",
+			objectInfo: minio.ObjectInfo{
+				StorageClass: "WARM-TIER",
+				Restore:      &minio.RestoreInfo{OngoingRestore: true},
+			},
+			want: &models.ObjectTierRestoreStatus{StorageClass: "WARM-TIER", Transitioned: true, OngoingRestore: true},
+		},
+		{
+			test: "restored copy available",
+			objectInfo: minio.ObjectInfo{
+				StorageClass: "WARM-TIER",
+				Restore:      &minio.RestoreInfo{ExpiryTime: expiry},
+			},
+			want: &models.ObjectTierRestoreStatus{StorageClass: "WARM-TIER", Transitioned: true, RestoreExpiryDate: "2023-05-02T10:00:00Z"},
+		},
+		{
+			test:    "stat error",
+			statErr: errors.New("object not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.test, func(t *testing.T) {
+			minioStatObjectMock = func(ctx context.Context, bucketName, prefix string, opts minio.GetObjectOptions) (minio.ObjectInfo, error) {
+				return tt.objectInfo, tt.statErr
+			}
+			status, err := getObjectTierRestoreStatus(ctx, client, "bucket", "file.txt", "")
+			if tt.statErr != nil {
+				assert.Equal(tt.statErr, err)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.want, status)
+		})
+	}
+}
+
 func Test_getScheme(t *testing.T) {
 	type args struct {
 		rawurl string
@@ -1440,3 +1544,86 @@ func Test_newClientURL(t *testing.T) {
 		})
 	}
 }
+
+func Test_isNotModified(t *testing.T) {
+	lastModified := time.Date(2023, 4, 10, 12, 30, 15, 500, time.UTC)
+	etag := quoteETag("9b2cf535f27731c974343645a3985328")
+	tests := []struct {
+		name    string
+		method  string
+		headers map[string]string
+		want    bool
+	}{
+		{
+			name:   "no conditional headers",
+			method: "GET",
+			want:   false,
+		},
+		{
+			name:    "matching etag",
+			method:  "GET",
+			headers: map[string]string{"If-None-Match": `"9b2cf535f27731c974343645a3985328"`},
+			want:    true,
+		},
+		{
+			name:    "matching weak etag in a list",
+			method:  "GET",
+			headers: map[string]string{"If-None-Match": `"abc", W/"9b2cf535f27731c974343645a3985328"`},
+			want:    true,
+		},
+		{
+			name:    "wildcard etag",
+			method:  "HEAD",
+			headers: map[string]string{"If-None-Match": "*"},
+			want:    true,
+		},
+		{
+			name:   "different etag takes precedence over modified since",
+			method: "GET",
+			headers: map[string]string{
+				"If-None-Match":     `"abc"`,
+				"If-Modified-Since": lastModified.Add(time.Hour).Format(http.TimeFormat),
+			},
+			want: false,
+		},
+		{
+			name:    "not modified since",
+			method:  "GET",
+			headers: map[string]string{"If-Modified-Since": lastModified.Format(http.TimeFormat)},
+			want:    true,
+		},
+		{
+			name:    "modified since",
+			method:  "GET",
+			headers: map[string]string{"If-Modified-Since": lastModified.Add(-time.Minute).Format(http.TimeFormat)},
+			want:    false,
+		},
+		{
+			name:    "invalid date",
+			method:  "GET",
+			headers: map[string]string{"If-Modified-Since": "yesterday"},
+			want:    false,
+		},
+		{
+			name:    "only GET and HEAD are conditional",
+			method:  "POST",
+			headers: map[string]string{"If-None-Match": "*"},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/api/v1/buckets/bucket/objects/download", nil)
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+			assert.Equal(t, tt.want, isNotModified(r, etag, lastModified))
+		})
+	}
+}
+
+func Test_quoteETag(t *testing.T) {
+	assert.Equal(t, `"abc"`, quoteETag("abc"))
+	assert.Equal(t, `"abc"`, quoteETag(`"abc"`))
+	assert.Equal(t, "", quoteETag(""))
+}
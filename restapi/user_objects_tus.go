@@ -0,0 +1,430 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	errorsApi "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/pkg/mimedb"
+)
+
+// TUS resumable upload protocol (https://tus.io/protocols/resumable-upload) support
+// for the objects upload endpoint. Each upload streams into a single putObject call
+// which is kept open between PATCH requests, so no data is staged on the console.
+const (
+	tusResumableHeader = "Tus-Resumable"
+	tusVersion         = "1.0.0"
+	tusExtensions      = "creation,creation-with-upload,termination"
+	// maximum object size accepted by MinIO on a single put
+	tusMaxSize = 5 << 40
+	// uploads without activity for this long are aborted
+	tusUploadIdleTimeout = 30 * time.Minute
+	tusOffsetContentType = "application/offset+octet-stream"
+)
+
+var (
+	errTUSOffsetMismatch = errors.New("upload offset doesn't match the current offset")
+	errTUSUploadExceeded = errors.New("upload exceeds the declared length")
+	errTUSUploadNotFound = errors.New("upload not found")
+)
+
+// tusUploadPath matches /api/v1/buckets/{bucket_name}/objects/upload[/{upload_id}]
+var tusUploadPath = regexp.MustCompile(`^/api/v1/buckets/([^/]+)/objects/upload(?:/([^/]+))?$`)
+
+// isTUSRequest returns true for requests to the upload endpoint done with the TUS protocol,
+// the rest of the requests keep going to the multipart/form-data uploader
+func isTUSRequest(r *http.Request) bool {
+	matches := tusUploadPath.FindStringSubmatch(r.URL.Path)
+	if matches == nil {
+		return false
+	}
+	// uploads resources are only handled by TUS
+	if matches[2] != "" {
+		return true
+	}
+	return r.Header.Get(tusResumableHeader) != "" || r.Method == http.MethodOptions
+}
+
+// tusUpload keeps the state of an in progress upload, data received through
+// PATCH requests is written to pipe, which feeds the putObject call.
+type tusUpload struct {
+	sync.Mutex
+	id         string
+	owner      string
+	bucketName string
+	objectName string
+	length     int64
+	offset     int64
+	pipe       *io.PipeWriter
+	// result receives the outcome of putObject once the pipe is closed
+	result chan error
+	cancel context.CancelFunc
+	timer  *time.Timer
+}
+
+type tusUploads struct {
+	sync.Mutex
+	uploads map[string]*tusUpload
+}
+
+var globalTUSUploads = &tusUploads{uploads: make(map[string]*tusUpload)}
+
+func (u *tusUploads) get(id string) *tusUpload {
+	u.Lock()
+	defer u.Unlock()
+	return u.uploads[id]
+}
+
+func (u *tusUploads) add(upload *tusUpload) {
+	u.Lock()
+	defer u.Unlock()
+	u.uploads[upload.id] = upload
+}
+
+func (u *tusUploads) remove(id string) {
+	u.Lock()
+	defer u.Unlock()
+	delete(u.uploads, id)
+}
+
+// parseTUSMetadata decodes the Upload-Metadata header, a comma separated list of
+// key and base64 encoded value pairs
+func parseTUSMetadata(header string) (map[string]string, error) {
+	metadata := make(map[string]string)
+	for _, pair := range strings.Split(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := strings.SplitN(pair, " ", 2)
+		var value []byte
+		if len(kv) == 2 {
+			var err error
+			value, err = base64.StdEncoding.DecodeString(kv[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for metadata key %s: %v", kv[0], err)
+			}
+		}
+		metadata[kv[0]] = string(value)
+	}
+	return metadata, nil
+}
+
+// newTUSUpload starts the putObject call that will receive the upload data
+func newTUSUpload(client MinioClient, owner, bucketName, objectName, contentType string, length int64) (*tusUpload, error) {
+	id, err := utils.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	pr, pw := io.Pipe()
+	upload := &tusUpload{
+		id:         id,
+		owner:      owner,
+		bucketName: bucketName,
+		objectName: objectName,
+		length:     length,
+		pipe:       pw,
+		result:     make(chan error, 1),
+		cancel:     cancel,
+	}
+	opts := minio.PutObjectOptions{
+		ContentType:          contentType,
+		ServerSideEncryption: getBucketUploadEncryption(ctx, client, bucketName),
+	}
+	go func() {
+		_, err := client.putObject(ctx, bucketName, objectName, pr, length, opts)
+		// result is buffered, it's set before unblocking any pending write so
+		// writers can tell the upload failed
+		upload.result <- err
+		pr.CloseWithError(err)
+	}()
+	upload.timer = time.AfterFunc(tusUploadIdleTimeout, func() {
+		upload.abort()
+		globalTUSUploads.remove(upload.id)
+	})
+	return upload, nil
+}
+
+// write appends data at offset and reports if the upload is over, either because
+// the declared length was reached or because it can't continue. Once over, the
+// returned error is the outcome of the putObject call.
+func (u *tusUpload) write(offset int64, data io.Reader) (bool, error) {
+	u.Lock()
+	defer u.Unlock()
+	if offset != u.offset {
+		return false, errTUSOffsetMismatch
+	}
+	u.timer.Reset(tusUploadIdleTimeout)
+	remaining := u.length - u.offset
+	n, err := io.Copy(u.pipe, io.LimitReader(data, remaining))
+	u.offset += n
+	if err == nil && n == remaining {
+		// clients must not send more than the declared length
+		if extra, _ := data.Read(make([]byte, 1)); extra > 0 {
+			u.abort()
+			<-u.result
+			return true, errTUSUploadExceeded
+		}
+	}
+	if err != nil {
+		select {
+		case putErr := <-u.result:
+			// putObject gave up, the upload can't be resumed
+			u.timer.Stop()
+			if putErr == nil {
+				putErr = err
+			}
+			return true, putErr
+		default:
+			// a broken request body keeps what was received, the client can resume from the new offset
+			return false, err
+		}
+	}
+	if u.offset == u.length {
+		u.pipe.Close()
+		u.timer.Stop()
+		return true, <-u.result
+	}
+	return false, nil
+}
+
+// abort stops the upload, discarding the data received so far
+func (u *tusUpload) abort() {
+	u.pipe.CloseWithError(context.Canceled)
+	u.cancel()
+	u.timer.Stop()
+}
+
+// globalTUSHandler serves the TUS requests, it's set once the API is configured
+var globalTUSHandler http.Handler
+
+// newTUSHandler returns the handler of the TUS requests. go-swagger doesn't route them, so they are authenticated,
+// authorized and recorded by the console action audit as the upload operation of their bucket.
+func newTUSHandler(api *operations.ConsoleAPI) http.Handler {
+	apiContext := api.Context()
+	authorized := ConsoleActionAuditMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, authorizedRequest, err := apiContext.Authorize(r, middleware.MatchedRouteFrom(r))
+		if err != nil {
+			api.ServeError(w, r, err)
+			return
+		}
+		session, _ := principal.(*models.Principal)
+		if session == nil || session.STSAccessKeyID == "" {
+			api.ServeError(w, r, errorsApi.New(http.StatusUnauthorized, ErrInvalidSession.Error()))
+			return
+		}
+		serveTUS(w, authorizedRequest, session)
+	}))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(tusResumableHeader, tusVersion)
+		if r.Method == http.MethodOptions {
+			w.Header().Set("Tus-Version", tusVersion)
+			w.Header().Set("Tus-Extension", tusExtensions)
+			w.Header().Set("Tus-Max-Size", strconv.FormatInt(tusMaxSize, 10))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Header.Get(tusResumableHeader) != tusVersion {
+			w.Header().Set("Tus-Version", tusVersion)
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		matches := tusUploadPath.FindStringSubmatch(r.URL.Path)
+		upload := r.Clone(r.Context())
+		upload.Method = http.MethodPost
+		upload.URL.Path, upload.URL.RawPath = fmt.Sprintf("/api/v1/buckets/%s/objects/upload", matches[1]), ""
+		_, routed, ok := apiContext.RouteInfo(upload)
+		if !ok {
+			api.ServeError(w, r, errorsApi.NotFound("path %s was not found", r.URL.Path))
+			return
+		}
+		authorized.ServeHTTP(w, r.WithContext(routed.Context()))
+	})
+}
+
+func serveTUS(w http.ResponseWriter, r *http.Request, session *models.Principal) {
+	// the uploads of the sessions without an owner could be resumed by all of them
+	owner := sessionOwner(session)
+	if owner == "" {
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusUnauthorized, ErrInvalidSession.Error()))
+		return
+	}
+	matches := tusUploadPath.FindStringSubmatch(r.URL.Path)
+	bucketName, uploadID := matches[1], matches[2]
+
+	if uploadID == "" {
+		if r.Method != http.MethodPost {
+			errorsApi.ServeError(w, r, errorsApi.MethodNotAllowed(r.Method, []string{http.MethodPost, http.MethodOptions}))
+			return
+		}
+		createTUSUpload(w, r, session, owner, bucketName)
+		return
+	}
+
+	upload := globalTUSUploads.get(uploadID)
+	if upload == nil || upload.owner != owner || upload.bucketName != bucketName {
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusNotFound, errTUSUploadNotFound.Error()))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodHead:
+		upload.Lock()
+		offset := upload.offset
+		upload.Unlock()
+		w.Header().Set("Upload-Offset", strconv.FormatInt(offset, 10))
+		w.Header().Set("Upload-Length", strconv.FormatInt(upload.length, 10))
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(http.StatusOK)
+	case http.MethodPatch:
+		if r.Header.Get("Content-Type") != tusOffsetContentType {
+			errorsApi.ServeError(w, r, errorsApi.New(http.StatusUnsupportedMediaType, "content type must be %s", tusOffsetContentType))
+			return
+		}
+		offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+		if err != nil || offset < 0 {
+			errorsApi.ServeError(w, r, errorsApi.New(http.StatusBadRequest, "invalid Upload-Offset header"))
+			return
+		}
+		patchTUSUpload(w, r, upload, offset)
+	case http.MethodDelete:
+		upload.abort()
+		globalTUSUploads.remove(upload.id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		errorsApi.ServeError(w, r, errorsApi.MethodNotAllowed(r.Method, []string{http.MethodHead, http.MethodPatch, http.MethodDelete}))
+	}
+}
+
+func createTUSUpload(w http.ResponseWriter, r *http.Request, session *models.Principal, owner, bucketName string) {
+	ctx := r.Context()
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length < 0 {
+		// Upload-Defer-Length is not supported, the length must be known upfront
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusBadRequest, "invalid Upload-Length header"))
+		return
+	}
+	if length > tusMaxSize {
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusRequestEntityTooLarge, ErrFileTooLarge.Error()))
+		return
+	}
+	metadata, err := parseTUSMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusBadRequest, err.Error()))
+		return
+	}
+	fileName := metadata["filename"]
+	if fileName == "" {
+		fileName = metadata["name"]
+	}
+	if fileName == "" {
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusBadRequest, "filename is required in Upload-Metadata"))
+		return
+	}
+	var prefix string
+	if p := r.URL.Query().Get("prefix"); p != "" {
+		decodedPrefix, err := base64.StdEncoding.DecodeString(SanitizeEncodedPrefix(p))
+		if err != nil {
+			errorsApi.ServeError(w, r, errorsApi.New(http.StatusBadRequest, err.Error()))
+			return
+		}
+		// trim any leading '/', since that is not expected for any object.
+		prefix = strings.TrimPrefix(string(decodedPrefix), "/")
+	}
+	contentType := metadata["filetype"]
+	if contentType == "" {
+		contentType = metadata["type"]
+	}
+	if contentType == "" {
+		contentType = mimedb.TypeByExtension(filepath.Ext(fileName))
+	}
+
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		apiErr := ErrorWithContext(ctx, err)
+		errorsApi.ServeError(w, r, errorsApi.New(apiErr.Code, *apiErr.Message))
+		return
+	}
+	upload, err := newTUSUpload(minioClient{client: mClient}, owner, bucketName, path.Join(prefix, path.Clean(fileName)), contentType, length)
+	if err != nil {
+		apiErr := ErrorWithContext(ctx, err)
+		errorsApi.ServeError(w, r, errorsApi.New(apiErr.Code, *apiErr.Message))
+		return
+	}
+	globalTUSUploads.add(upload)
+
+	// relative to the upload endpoint, so it resolves properly behind a sub path
+	w.Header().Set("Location", fmt.Sprintf("upload/%s", upload.id))
+
+	// creation-with-upload, the body contains the first chunk
+	if r.Header.Get("Content-Type") == tusOffsetContentType || length == 0 {
+		patchTUSUpload(w, r, upload, 0)
+		return
+	}
+	w.Header().Set("Upload-Offset", "0")
+	w.WriteHeader(http.StatusCreated)
+}
+
+func patchTUSUpload(w http.ResponseWriter, r *http.Request, upload *tusUpload, offset int64) {
+	ctx := r.Context()
+	status := http.StatusNoContent
+	if r.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	finished, err := upload.write(offset, r.Body)
+	if finished {
+		globalTUSUploads.remove(upload.id)
+	}
+	switch {
+	case errors.Is(err, errTUSOffsetMismatch):
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusConflict, err.Error()))
+		return
+	case errors.Is(err, errTUSUploadExceeded):
+		errorsApi.ServeError(w, r, errorsApi.New(http.StatusRequestEntityTooLarge, err.Error()))
+		return
+	case err != nil && finished:
+		apiErr := ErrorWithContext(ctx, err)
+		errorsApi.ServeError(w, r, errorsApi.New(apiErr.Code, *apiErr.Message))
+		return
+	case err != nil:
+		LogInfoContext(r.Context(), "upload %s interrupted: %v", upload.id, err)
+	}
+	upload.Lock()
+	currentOffset := upload.offset
+	upload.Unlock()
+	w.Header().Set("Upload-Offset", strconv.FormatInt(currentOffset, 10))
+	w.WriteHeader(status)
+}
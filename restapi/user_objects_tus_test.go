@@ -0,0 +1,176 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	errorsApi "github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseTUSMetadata(t *testing.T) {
+	assert := assert.New(t)
+
+	metadata, err := parseTUSMetadata("filename d29ybGRfZG9taW5hdGlvbl9wbGFuLnBkZg==,filetype YXBwbGljYXRpb24vcGRm, is_confidential")
+	assert.Nil(err)
+	assert.Equal(map[string]string{
+		"filename":        "world_domination_plan.pdf",
+		"filetype":        "application/pdf",
+		"is_confidential": "",
+	}, metadata)
+
+	_, err = parseTUSMetadata("filename not-base64!")
+	assert.NotNil(err)
+}
+
+func Test_isTUSRequest(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		tus    bool
+		want   bool
+	}{
+		{name: "multipart form upload", method: http.MethodPost, path: "/api/v1/buckets/test/objects/upload", want: false},
+		{name: "tus creation", method: http.MethodPost, path: "/api/v1/buckets/test/objects/upload", tus: true, want: true},
+		{name: "tus discovery", method: http.MethodOptions, path: "/api/v1/buckets/test/objects/upload", want: true},
+		{name: "tus upload resource", method: http.MethodPatch, path: "/api/v1/buckets/test/objects/upload/abc", tus: true, want: true},
+		{name: "other endpoint", method: http.MethodGet, path: "/api/v1/buckets/test/objects", tus: true, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := http.NewRequest(tt.method, tt.path, nil)
+			if tt.tus {
+				r.Header.Set(tusResumableHeader, tusVersion)
+			}
+			assert.Equal(t, tt.want, isTUSRequest(r))
+		})
+	}
+}
+
+func Test_tusUploadWrite(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+
+	var uploaded bytes.Buffer
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		_, err := io.CopyN(&uploaded, reader, objectSize)
+		return minio.UploadInfo{Bucket: bucketName, Key: objectName, Size: objectSize}, err
+	}
+
+	upload, err := newTUSUpload(client, "user", "bucket", "dir/file.txt", "text/plain", 11)
+	assert.Nil(err)
+
+	// offsets must match
+	finished, err := upload.write(3, strings.NewReader("lo world"))
+	assert.False(finished)
+	assert.Equal(errTUSOffsetMismatch, err)
+
+	finished, err = upload.write(0, strings.NewReader("hel"))
+	assert.False(finished)
+	assert.Nil(err)
+	assert.Equal(int64(3), upload.offset)
+
+	finished, err = upload.write(3, strings.NewReader("lo world"))
+	assert.True(finished)
+	assert.Nil(err)
+	assert.Equal("hello world", uploaded.String())
+
+	// the client sends more data than declared
+	upload, err = newTUSUpload(client, "user", "bucket", "file.txt", "text/plain", 2)
+	assert.Nil(err)
+	finished, err = upload.write(0, strings.NewReader("too long"))
+	assert.True(finished)
+	assert.Equal(errTUSUploadExceeded, err)
+
+	// putObject fails, the upload can't be resumed
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+		return minio.UploadInfo{}, errors.New("access denied")
+	}
+	upload, err = newTUSUpload(client, "user", "bucket", "file.txt", "text/plain", 5)
+	assert.Nil(err)
+	finished, err = upload.write(0, strings.NewReader("hello"))
+	assert.True(finished)
+	if assert.NotNil(err) {
+		assert.Equal("access denied", err.Error())
+	}
+}
+
+func Test_newTUSHandler(t *testing.T) {
+	assert := assert.New(t)
+	swaggerSpec, err := loads.Embedded(SwaggerJSON, FlatSwaggerJSON)
+	assert.NoError(err)
+	api := operations.NewConsoleAPI(swaggerSpec)
+	api.KeyAuth = func(token string, scopes []string) (*models.Principal, error) {
+		if token == "Anonymous" {
+			return &models.Principal{}, nil
+		}
+		if token == "sso" {
+			return &models.Principal{STSAccessKeyID: token}, nil
+		}
+		return &models.Principal{STSAccessKeyID: token, AccountAccessKey: token}, nil
+	}
+	var authorized []string
+	api.APIAuthorizer = runtime.AuthorizerFunc(func(r *http.Request, principal interface{}) error {
+		route := middleware.MatchedRouteFrom(r)
+		authorized = append(authorized, r.Method+" "+route.PathPattern+" "+route.Params.Get("bucket_name"))
+		if principal.(*models.Principal).STSAccessKeyID == "denied" {
+			return errorsApi.New(http.StatusForbidden, "denied")
+		}
+		return nil
+	})
+	api.Serve(middleware.PassthroughBuilder)
+	handler := newTUSHandler(api)
+	serve := func(method, path, token string) int {
+		r := httptest.NewRequest(method, path, nil)
+		r.Header.Set(tusResumableHeader, tusVersion)
+		r.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// Test-1 : the capabilities are discovered without a session
+	assert.Equal(http.StatusNoContent, serve(http.MethodOptions, "/api/v1/buckets/photos/objects/upload", ""))
+	assert.Empty(authorized)
+
+	// Test-2 : the requests without a session are rejected
+	assert.Equal(http.StatusUnauthorized, serve(http.MethodPatch, "/api/v1/buckets/photos/objects/upload/abc", "Anonymous"))
+
+	// Test-3 : the authorizers of the API see the requests as the upload to their bucket
+	authorized = nil
+	assert.Equal(http.StatusForbidden, serve(http.MethodHead, "/api/v1/buckets/photos/objects/upload/abc", "denied"))
+	assert.Equal([]string{"HEAD /api/v1/buckets/{bucket_name}/objects/upload photos"}, authorized)
+	assert.Equal(http.StatusNotFound, serve(http.MethodHead, "/api/v1/buckets/photos/objects/upload/abc", "alice"))
+
+	// Test-4 : the sessions without an owner can't upload
+	assert.Equal(http.StatusUnauthorized, serve(http.MethodHead, "/api/v1/buckets/photos/objects/upload/abc", "sso"))
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/minio/console/pkg/utils"
@@ -75,7 +76,14 @@ func registerServiceAccountsHandlers(api *operations.ConsoleAPI) {
 		if err != nil {
 			return saApi.NewListUserServiceAccountsDefault(int(err.Code)).WithPayload(err)
 		}
-		return saApi.NewListUserServiceAccountsOK().WithPayload(serviceAccounts)
+		// the listing is an array, its pagination is only told by the headers
+		sort.Strings(serviceAccounts)
+		page, perr := paginate(len(serviceAccounts), func(i int) string { return serviceAccounts[i] }, params.Cursor, params.PageSize)
+		if perr != nil {
+			err = ErrorWithContext(ctx, perr)
+			return saApi.NewListUserServiceAccountsDefault(int(err.Code)).WithPayload(err)
+		}
+		return withPageHeaders(saApi.NewListUserServiceAccountsOK().WithPayload(serviceAccounts[page.start:page.end]), int64(page.total), page.nextCursor)
 	})
 
 	// Delete a User's service account
@@ -179,6 +187,10 @@ func getCreateServiceAccountResponse(session *models.Principal, params saApi.Cre
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	// the service account belongs to the cluster the session works with
+	if saCreds.URL, err = sessionMinIOServer(session); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	return saCreds, nil
 }
 
@@ -243,6 +255,10 @@ func getCreateAUserServiceAccountResponse(session *models.Principal, params user
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	// the service account belongs to the cluster the session works with
+	if saCreds.URL, err = sessionMinIOServer(session); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	return saCreds, nil
 }
 
@@ -279,6 +295,10 @@ func getCreateAUserServiceAccountCredsResponse(session *models.Principal, params
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	// the service account belongs to the cluster the session works with
+	if saCreds.URL, err = sessionMinIOServer(session); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	return saCreds, nil
 }
 
@@ -313,6 +333,10 @@ func getCreateServiceAccountCredsResponse(session *models.Principal, params saAp
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
+	// the service account belongs to the cluster the session works with
+	if saCreds.URL, err = sessionMinIOServer(session); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
 	return saCreds, nil
 }
 
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"strconv"
+	"strings"
 	"time"
 
 	policies "github.com/minio/console/restapi/policy"
@@ -68,6 +69,24 @@ func getClaimsFromToken(sessionToken string) (map[string]interface{}, error) {
 	return claims, nil
 }
 
+// sessionOwner returns the user owning what a session creates, so it's found again by the next sessions of the
+// same user: its access key, or the parent user MinIO issued the STS credentials of the IDP sessions for. It's
+// empty when the session's user can't be told apart, such sessions own nothing.
+func sessionOwner(session *models.Principal) string {
+	if session == nil {
+		return ""
+	}
+	if session.AccountAccessKey != "" {
+		return session.AccountAccessKey
+	}
+	claims, _ := getClaimsFromToken(session.STSSessionToken)
+	if parent, ok := claims["parent"].(string); ok && parent != "" {
+		// the IDP users are kept apart from the MinIO users of the same name
+		return "parent:" + parent
+	}
+	return ""
+}
+
 // getSessionResponse parse the token of the current session and returns a list of allowed actions to render in the UI
 func getSessionResponse(ctx context.Context, session *models.Principal) (*models.SessionResponse, *models.Error) {
 	ctx, cancel := context.WithCancel(ctx)
@@ -84,6 +103,9 @@ func getSessionResponse(ctx context.Context, session *models.Principal) (*models
 		STSAccessKeyID:     session.STSAccessKeyID,
 		STSSecretAccessKey: session.STSSecretAccessKey,
 		STSSessionToken:    session.STSSessionToken,
+		AccountAccessKey:   session.AccountAccessKey,
+		ClusterID:          session.ClusterID,
+		LoginCluster:       session.LoginCluster,
 	})
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err, ErrInvalidSession)
@@ -102,6 +124,10 @@ func getSessionResponse(ctx context.Context, session *models.Principal) (*models
 		return nil, ErrorWithContext(ctx, err, ErrInvalidSession)
 	}
 	currTime := time.Now().UTC()
+	serverEndpoint, err := sessionMinIOServer(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err, ErrInvalidSession)
+	}
 
 	customStyles := session.CustomStyleOb
 	// This actions will be global, meaning has to be attached to all resources
@@ -109,7 +135,7 @@ func getSessionResponse(ctx context.Context, session *models.Principal) (*models
 		condition.AWSUsername.Name(): {session.AccountAccessKey},
 		// All calls to MinIO from console use temporary credentials.
 		condition.AWSPrincipalType.Name():   {"AssumeRole"},
-		condition.AWSSecureTransport.Name(): {strconv.FormatBool(getMinIOEndpointIsSecure())},
+		condition.AWSSecureTransport.Name(): {strconv.FormatBool(strings.HasPrefix(serverEndpoint, "https://"))},
 		condition.AWSCurrentTime.Name():     {currTime.Format(time.RFC3339)},
 		condition.AWSEpochTime.Name():       {strconv.FormatInt(currTime.Unix(), 10)},
 
@@ -254,7 +280,13 @@ func getSessionResponse(ctx context.Context, session *models.Principal) (*models
 		AllowResources:  allowResources,
 		CustomStyles:    customStyles,
 		EnvConstants:    &envConstants,
-		ServerEndPoint:  getMinIOServer(),
+		ServerEndPoint:  serverEndpoint,
+		// the UI asks for a new secret key when an administrator reset it
+		PasswordChangeRequired: passwordState().MustChange(session.AccountAccessKey),
+		// OpenID sessions renew their credentials before they expire
+		SessionRenewIn: sessionRenewIn(session, time.Now()),
+		// feature areas of the console the session can use
+		ConsoleGrants: sessionConsoleGrants(session, resourcePermissions),
 	}
 	return sessionResp, nil
 }
@@ -263,7 +295,7 @@ func getSessionResponse(ctx context.Context, session *models.Principal) (*models
 func getListOfEnabledFeatures(ctx context.Context, minioClient MinioAdmin, session *models.Principal) []string {
 	features := []string{}
 	logSearchURL := getLogSearchURL()
-	oidcEnabled := oauth2.IsIDPEnabled()
+	oidcEnabled := oauth2.IsIDPEnabled() || len(GlobalMinIOConfig.OpenIDProviders) > 0
 	ldapEnabled := ldap.GetLDAPEnabled()
 
 	if logSearchURL != "" {
@@ -0,0 +1,107 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/console/restapi/operations"
+	authApi "github.com/minio/console/restapi/operations/auth"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerSessionKeysHandlers(api *operations.ConsoleAPI) {
+	// rotate the key encrypting the sessions
+	api.AuthRotateSessionKeyHandler = authApi.RotateSessionKeyHandlerFunc(func(params authApi.RotateSessionKeyParams, session *models.Principal) middleware.Responder {
+		rotation, err := getRotateSessionKeyResponse(session, params)
+		if err != nil {
+			return authApi.NewRotateSessionKeyDefault(int(err.Code)).WithPayload(err)
+		}
+		return authApi.NewRotateSessionKeyOK().WithPayload(rotation)
+	})
+}
+
+// configureSessionKeys sets the keys encrypting the sessions from the environment. The previous key is retired at
+// the startup, the session keys file keeps the keys and the time they were first retired across the restarts once it
+// is set. The passphrases and salts may be sealed by the console KMS.
+func configureSessionKeys(now time.Time) error {
+	current, err := unsealSessionKey(auth.SessionKey{
+		ID:         xjwt.GetPBKDFKeyID(),
+		Passphrase: xjwt.GetPBKDFPassphrase(),
+		Salt:       xjwt.GetPBKDFSalt(),
+	})
+	if err != nil {
+		return err
+	}
+	keys := []auth.SessionKey{current}
+	if id, passphrase, salt := xjwt.GetPreviousPBKDF(); passphrase != "" || salt != "" {
+		previous, err := unsealSessionKey(auth.SessionKey{ID: id, Passphrase: passphrase, Salt: salt, RetiredAt: now.UTC()})
+		if err != nil {
+			return err
+		}
+		keys = append(keys, previous)
+	}
+	overlap := xjwt.GetSessionKeyOverlap()
+	if path := xjwt.GetSessionKeysFile(); path != "" {
+		return auth.LoadSessionKeys(path, keys, overlap)
+	}
+	return auth.SetSessionKeys(keys, overlap)
+}
+
+// unsealSessionKey returns key with its passphrase and salt unsealed
+func unsealSessionKey(key auth.SessionKey) (auth.SessionKey, error) {
+	var err error
+	if key.Passphrase, err = unsealConsoleSecret(key.Passphrase, consoleKMSPBKDFContext); err != nil {
+		return key, fmt.Errorf("passphrase of the session key %q: %w", key.ID, err)
+	}
+	if key.Salt, err = unsealConsoleSecret(key.Salt, consoleKMSPBKDFContext); err != nil {
+		return key, fmt.Errorf("salt of the session key %q: %w", key.ID, err)
+	}
+	return key, nil
+}
+
+// getRotateSessionKeyResponse rotates the session key for the administrators allowed to update the configuration
+func getRotateSessionKeyResponse(session *models.Principal, params authApi.RotateSessionKeyParams) (*models.SessionKeyRotation, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.ConfigUpdateAdminAction) {
+		return nil, ErrorWithContext(ctx, ErrAccessDenied)
+	}
+	current, retired, errRotate := auth.RotateSessionKey()
+	if errRotate != nil {
+		return nil, ErrorWithContext(ctx, errRotate)
+	}
+	acceptedUntil := retired.RetiredAt.Add(auth.SessionKeysOverlap())
+	LogInfo("session key %q replaced by %q, its sessions are accepted until %s", retired.ID, current.ID, acceptedUntil.Format(time.RFC3339))
+	return &models.SessionKeyRotation{
+		KeyID:         current.ID,
+		RetiredKeyID:  retired.ID,
+		AcceptedUntil: acceptedUntil.Format(time.RFC3339),
+		// without the session keys file the new key is lost on restart and only this replica knows it
+		Persisted: xjwt.GetSessionKeysFile() != "",
+	}, nil
+}
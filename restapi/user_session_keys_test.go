@@ -0,0 +1,81 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/console/pkg/auth"
+	xjwt "github.com/minio/console/pkg/auth/token"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_configureSessionKeys(t *testing.T) {
+	assert := assert.New(t)
+	// restore the keys of the environment
+	defer configureSessionKeys(time.Now())
+	now := time.Now()
+
+	// the tokens of the previous key are accepted after the keys are rotated through the environment
+	os.Setenv(xjwt.ConsolePBKDFPassphrase, "previous-passphrase")
+	os.Setenv(xjwt.ConsolePBKDFSalt, "previous-salt")
+	os.Setenv(xjwt.ConsolePBKDFKeyID, "2023-05")
+	assert.NoError(configureSessionKeys(now))
+	token, err := auth.NewEncryptedTokenForClient(&credentials.Value{AccessKeyID: "fakeAccessKeyID"}, "alice", nil)
+	assert.NoError(err)
+
+	os.Setenv(xjwt.ConsolePBKDFPreviousPassphrase, "previous-passphrase")
+	os.Setenv(xjwt.ConsolePBKDFPreviousSalt, "previous-salt")
+	os.Setenv(xjwt.ConsolePBKDFPreviousKeyID, "2023-05")
+	os.Setenv(xjwt.ConsolePBKDFPassphrase, "passphrase")
+	os.Setenv(xjwt.ConsolePBKDFSalt, "salt")
+	os.Setenv(xjwt.ConsolePBKDFKeyID, "2023-06")
+	os.Setenv(xjwt.ConsoleSessionKeyOverlap, "2h")
+	defer func() {
+		for _, key := range []string{
+			xjwt.ConsolePBKDFPassphrase, xjwt.ConsolePBKDFSalt, xjwt.ConsolePBKDFKeyID, xjwt.ConsolePBKDFPreviousPassphrase,
+			xjwt.ConsolePBKDFPreviousSalt, xjwt.ConsolePBKDFPreviousKeyID, xjwt.ConsoleSessionKeyOverlap, xjwt.ConsoleSessionKeysFile,
+		} {
+			os.Unsetenv(key)
+		}
+	}()
+	assert.NoError(configureSessionKeys(now))
+	assert.Equal(2*time.Hour, auth.SessionKeysOverlap())
+	claims, err := auth.SessionTokenAuthenticate(token)
+	assert.NoError(err)
+	assert.Equal("alice", claims.AccountAccessKey)
+
+	// two keys without an ID can't be told apart
+	os.Unsetenv(xjwt.ConsolePBKDFKeyID)
+	os.Unsetenv(xjwt.ConsolePBKDFPreviousKeyID)
+	assert.Error(configureSessionKeys(now))
+
+	// the session keys file is created with the keys of the environment
+	os.Setenv(xjwt.ConsolePBKDFKeyID, "2023-06")
+	os.Setenv(xjwt.ConsolePBKDFPreviousKeyID, "2023-05")
+	path := filepath.Join(t.TempDir(), "session-keys.json")
+	os.Setenv(xjwt.ConsoleSessionKeysFile, path)
+	assert.NoError(configureSessionKeys(now))
+	_, err = os.Stat(path)
+	assert.NoError(err)
+	_, err = auth.SessionTokenAuthenticate(token)
+	assert.NoError(err)
+}
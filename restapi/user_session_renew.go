@@ -0,0 +1,155 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/auth/idp/oauth2"
+	"github.com/minio/console/restapi/operations"
+	authApi "github.com/minio/console/restapi/operations/auth"
+)
+
+// sessionRenewMargin is how long before its credentials expire an OpenID session is renewed, browsers
+// throttle the timers of background tabs to about once a minute
+const sessionRenewMargin = 2 * time.Minute
+
+// renewedSession is a renewed OpenID session along with the refresh token to keep in its cookie
+type renewedSession struct {
+	sessionID    string
+	refreshToken string
+	renew        *models.SessionRenew
+}
+
+func registerSessionRenewHandlers(api *operations.ConsoleAPI) {
+	// renew the credentials of an OpenID session
+	api.AuthSessionRenewHandler = authApi.SessionRenewHandlerFunc(func(params authApi.SessionRenewParams, session *models.Principal) middleware.Responder {
+		renewed, err := getSessionRenewResponse(session, params, GlobalMinIOConfig.OpenIDProviders)
+		if err != nil {
+			return authApi.NewSessionRenewDefault(int(err.Code)).WithPayload(err)
+		}
+		// Custom response writer to replace the session cookies
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			cookie := NewSessionCookieForConsole(renewed.sessionID)
+			http.SetCookie(w, &cookie)
+			http.SetCookie(w, &http.Cookie{
+				Path:     "/",
+				Name:     "idp-refresh-token",
+				Value:    renewed.refreshToken,
+				HttpOnly: true,
+				Secure:   len(GlobalPublicCerts) > 0,
+				SameSite: http.SameSiteLaxMode,
+			})
+			authApi.NewSessionRenewOK().WithPayload(renewed.renew).WriteResponse(w, p)
+		})
+	})
+}
+
+// sessionRenewIn returns the seconds left before an OpenID session should renew its credentials, 0 when
+// the session can't be renewed
+func sessionRenewIn(session *models.Principal, now time.Time) int64 {
+	if session == nil || session.IdpRefreshToken == "" || session.Expiration == 0 {
+		return 0
+	}
+	renewIn := time.Unix(session.Expiration, 0).Sub(now) - sessionRenewMargin
+	// credentials about to expire are renewed right away
+	if renewIn < time.Second {
+		return 1
+	}
+	return int64(renewIn.Seconds())
+}
+
+// renewSession exchanges the refresh token of an OpenID session for new credentials and returns the new
+// session, the features of the session are kept
+func renewSession(ctx context.Context, provider auth.IdentityProviderI, session *models.Principal, now time.Time) (*renewedSession, error) {
+	if session.IdpRefreshToken == "" {
+		return nil, ErrSessionNotRenewable
+	}
+	identity, err := provider.RefreshIdentity(ctx, session.IdpRefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSession, err)
+	}
+	// providers that don't rotate the refresh token keep accepting the current one
+	refreshToken := identity.RefreshToken
+	if refreshToken == "" {
+		refreshToken = session.IdpRefreshToken
+	}
+	sessionID, err := login(&ConsoleCredentials{
+		ConsoleCredentials: identity.Credentials,
+		AccountAccessKey:   session.AccountAccessKey,
+	}, &auth.SessionFeatures{
+		HideMenu:        session.Hm,
+		ObjectBrowser:   session.Ob,
+		CustomStyleOB:   session.CustomStyleOb,
+		IDPName:         session.IdpName,
+		IDPRefreshToken: refreshToken,
+		Expiration:      identity.Expiry,
+		IssuedAt:        sessionIssuedAt(session),
+		ClusterID:       session.ClusterID,
+		LoginCluster:    session.LoginCluster,
+	})
+	if err != nil {
+		return nil, err
+	}
+	renewed := &models.Principal{IdpRefreshToken: refreshToken, Expiration: identity.Expiry.Unix()}
+	return &renewedSession{
+		sessionID:    *sessionID,
+		refreshToken: refreshToken,
+		renew:        &models.SessionRenew{SessionRenewIn: sessionRenewIn(renewed, now)},
+	}, nil
+}
+
+func getSessionRenewResponse(session *models.Principal, params authApi.SessionRenewParams, openIDProviders oauth2.OpenIDPCfg) (*renewedSession, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if session.IdpRefreshToken == "" {
+		return nil, ErrorWithContext(ctx, ErrSessionNotRenewable)
+	}
+	providerCfg, ok := openIDProviders[session.IdpName]
+	if !ok {
+		return nil, ErrorWithContext(ctx, fmt.Errorf("%w: %s", ErrUnknownIdentityProvider, session.IdpName))
+	}
+	oauth2Client, err := openIDProviders.NewOauth2ProviderClient(session.IdpName, nil, params.HTTPRequest, GetConsoleHTTPClient(""), GetConsoleHTTPClient(getMinIOServer()))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err, ErrOauth2Provider)
+	}
+	identityProvider := auth.IdentityProvider{
+		KeyFunc: providerCfg.GetStateKeyFunc(),
+		Client:  oauth2Client,
+		RoleARN: providerCfg.RoleArn,
+	}
+	renewed, err := renewSession(ctx, identityProvider, session, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return renewed, nil
+}
+
+// sessionIssuedAt returns the login of the session, zero for the sessions issued before it was recorded
+func sessionIssuedAt(session *models.Principal) time.Time {
+	if session.IssuedAt == 0 {
+		return time.Time{}
+	}
+	return time.Unix(session.IssuedAt, 0)
+}
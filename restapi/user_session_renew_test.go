@@ -0,0 +1,83 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionRenewIn(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Unix(1700000000, 0)
+	assert.Zero(sessionRenewIn(&models.Principal{}, now))
+	assert.Zero(sessionRenewIn(&models.Principal{IdpRefreshToken: "refresh"}, now))
+	assert.Equal(int64(3600-120), sessionRenewIn(&models.Principal{IdpRefreshToken: "refresh", Expiration: now.Unix() + 3600}, now))
+	// credentials about to expire are renewed right away
+	assert.Equal(int64(1), sessionRenewIn(&models.Principal{IdpRefreshToken: "refresh", Expiration: now.Unix() + 30}, now))
+}
+
+func TestRenewSession(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	session := &models.Principal{IdpName: "employees", IdpRefreshToken: "refresh-1", Hm: true}
+
+	idpRefreshIdentityMock = func(ctx context.Context, refreshToken string) (*auth.RefreshedIdentity, error) {
+		assert.Equal("refresh-1", refreshToken)
+		return &auth.RefreshedIdentity{
+			Credentials:  credentials.NewStaticV4("access", "secret", "session"),
+			RefreshToken: "refresh-2",
+			Expiry:       now.Add(time.Hour),
+		}, nil
+	}
+	renewed, err := renewSession(ctx, IdentityProviderMock{}, session, now)
+	assert.NoError(err)
+	assert.Equal("refresh-2", renewed.refreshToken)
+	assert.Equal(int64(3600-120), renewed.renew.SessionRenewIn)
+	claims, err := auth.SessionTokenAuthenticate(renewed.sessionID)
+	assert.NoError(err)
+	assert.Equal("access", claims.STSAccessKeyID)
+	assert.Equal("employees", claims.IDPName)
+	assert.Equal("refresh-2", claims.IDPRefreshToken)
+	assert.Equal(now.Add(time.Hour).Unix(), claims.Expiration)
+	assert.True(claims.HideMenu)
+
+	// the current refresh token is kept when the provider doesn't rotate it
+	idpRefreshIdentityMock = func(ctx context.Context, refreshToken string) (*auth.RefreshedIdentity, error) {
+		return &auth.RefreshedIdentity{Credentials: credentials.NewStaticV4("access", "secret", "session"), Expiry: now.Add(time.Hour)}, nil
+	}
+	renewed, err = renewSession(ctx, IdentityProviderMock{}, session, now)
+	assert.NoError(err)
+	assert.Equal("refresh-1", renewed.refreshToken)
+
+	idpRefreshIdentityMock = func(ctx context.Context, refreshToken string) (*auth.RefreshedIdentity, error) {
+		return nil, errors.New("invalid_grant")
+	}
+	_, err = renewSession(ctx, IdentityProviderMock{}, session, now)
+	assert.True(errors.Is(err, ErrInvalidSession))
+
+	_, err = renewSession(ctx, IdentityProviderMock{}, &models.Principal{}, now)
+	assert.True(errors.Is(err, ErrSessionNotRenewable))
+}
@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"testing"
 
+	jwtgo "github.com/golang-jwt/jwt/v4"
 	"github.com/minio/console/models"
 	"github.com/minio/console/pkg/auth/idp/oauth2"
 	"github.com/minio/console/pkg/auth/ldap"
@@ -137,3 +138,26 @@ func Test_getListOfEnabledFeatures(t *testing.T) {
 		})
 	}
 }
+
+func Test_sessionOwner(t *testing.T) {
+	assert := assert.New(t)
+	stsToken := func(claims jwtgo.MapClaims) string {
+		token, err := jwtgo.NewWithClaims(jwtgo.SigningMethodHS512, claims).SignedString([]byte("secret"))
+		assert.NoError(err)
+		return token
+	}
+
+	// Test-1 : the users are identified by their access key
+	assert.Equal("alice", sessionOwner(&models.Principal{AccountAccessKey: "alice", STSAccessKeyID: "STS1"}))
+
+	// Test-2 : the IDP users by the parent user of their STS credentials, which is the same across their logins
+	first := &models.Principal{STSAccessKeyID: "STS2", STSSessionToken: stsToken(jwtgo.MapClaims{"parent": "openid:alice", "accessKey": "STS2"})}
+	second := &models.Principal{STSAccessKeyID: "STS3", STSSessionToken: stsToken(jwtgo.MapClaims{"parent": "openid:alice", "accessKey": "STS3"})}
+	assert.Equal("parent:openid:alice", sessionOwner(first))
+	assert.Equal(sessionOwner(first), sessionOwner(second))
+
+	// Test-3 : the sessions whose user is unknown have no owner
+	assert.Empty(sessionOwner(&models.Principal{STSAccessKeyID: "STS4", STSSessionToken: stsToken(jwtgo.MapClaims{"sub": "alice"})}))
+	assert.Empty(sessionOwner(&models.Principal{STSAccessKeyID: "STS5"}))
+	assert.Empty(sessionOwner(nil))
+}
@@ -0,0 +1,388 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	stagingApi "github.com/minio/console/restapi/operations/staging"
+)
+
+const (
+	// workspaces without activity are discarded after this period
+	stagingWorkspaceIdleTimeout = 24 * time.Hour
+	// limits to keep the memory used by every session bounded
+	maxStagingWorkspaces = 20
+	maxStagedOperations  = 10000
+)
+
+func registerStagingHandlers(api *operations.ConsoleAPI) {
+	// list staging workspaces
+	api.StagingListStagingWorkspacesHandler = stagingApi.ListStagingWorkspacesHandlerFunc(func(params stagingApi.ListStagingWorkspacesParams, session *models.Principal) middleware.Responder {
+		workspaces := globalStagingWorkspaces.list(sessionOwner(session))
+		return stagingApi.NewListStagingWorkspacesOK().WithPayload(&models.ListStagingWorkspacesResponse{Workspaces: workspaces})
+	})
+	// create staging workspace
+	api.StagingCreateStagingWorkspaceHandler = stagingApi.CreateStagingWorkspaceHandlerFunc(func(params stagingApi.CreateStagingWorkspaceParams, session *models.Principal) middleware.Responder {
+		ws, err := globalStagingWorkspaces.create(sessionOwner(session), params.Body.Name)
+		if err != nil {
+			apiErr := ErrorWithContext(params.HTTPRequest.Context(), err)
+			return stagingApi.NewCreateStagingWorkspaceDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return stagingApi.NewCreateStagingWorkspaceCreated().WithPayload(ws)
+	})
+	// get staging workspace
+	api.StagingGetStagingWorkspaceHandler = stagingApi.GetStagingWorkspaceHandlerFunc(func(params stagingApi.GetStagingWorkspaceParams, session *models.Principal) middleware.Responder {
+		ws, err := globalStagingWorkspaces.get(sessionOwner(session), params.WorkspaceID)
+		if err != nil {
+			apiErr := ErrorWithContext(params.HTTPRequest.Context(), err)
+			return stagingApi.NewGetStagingWorkspaceDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return stagingApi.NewGetStagingWorkspaceOK().WithPayload(ws)
+	})
+	// discard staging workspace
+	api.StagingDeleteStagingWorkspaceHandler = stagingApi.DeleteStagingWorkspaceHandlerFunc(func(params stagingApi.DeleteStagingWorkspaceParams, session *models.Principal) middleware.Responder {
+		if err := globalStagingWorkspaces.remove(sessionOwner(session), params.WorkspaceID); err != nil {
+			apiErr := ErrorWithContext(params.HTTPRequest.Context(), err)
+			return stagingApi.NewDeleteStagingWorkspaceDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return stagingApi.NewDeleteStagingWorkspaceNoContent()
+	})
+	// stage operations
+	api.StagingAddStagedOperationsHandler = stagingApi.AddStagedOperationsHandlerFunc(func(params stagingApi.AddStagedOperationsParams, session *models.Principal) middleware.Responder {
+		ws, err := globalStagingWorkspaces.addOperations(sessionOwner(session), params.WorkspaceID, params.Body.Operations)
+		if err != nil {
+			apiErr := ErrorWithContext(params.HTTPRequest.Context(), err)
+			return stagingApi.NewAddStagedOperationsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return stagingApi.NewAddStagedOperationsOK().WithPayload(ws)
+	})
+	// unstage operation
+	api.StagingRemoveStagedOperationHandler = stagingApi.RemoveStagedOperationHandlerFunc(func(params stagingApi.RemoveStagedOperationParams, session *models.Principal) middleware.Responder {
+		ws, err := globalStagingWorkspaces.removeOperation(sessionOwner(session), params.WorkspaceID, params.OperationID)
+		if err != nil {
+			apiErr := ErrorWithContext(params.HTTPRequest.Context(), err)
+			return stagingApi.NewRemoveStagedOperationDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return stagingApi.NewRemoveStagedOperationOK().WithPayload(ws)
+	})
+	// commit staging workspace
+	api.StagingCommitStagingWorkspaceHandler = stagingApi.CommitStagingWorkspaceHandlerFunc(func(params stagingApi.CommitStagingWorkspaceParams, session *models.Principal) middleware.Responder {
+		resp, err := getCommitStagingWorkspaceResponse(session, params)
+		if err != nil {
+			return stagingApi.NewCommitStagingWorkspaceDefault(int(err.Code)).WithPayload(err)
+		}
+		return stagingApi.NewCommitStagingWorkspaceOK().WithPayload(resp)
+	})
+}
+
+// stagingWorkspace is a set of operations assembled by a user across several requests
+type stagingWorkspace struct {
+	id         string
+	owner      string
+	name       string
+	created    time.Time
+	updated    time.Time
+	operations []*models.StagedOperation
+	committing bool
+	timer      *time.Timer
+}
+
+func (w *stagingWorkspace) toModel() *models.StagingWorkspace {
+	ops := make([]*models.StagedOperation, len(w.operations))
+	copy(ops, w.operations)
+	return &models.StagingWorkspace{
+		ID:         w.id,
+		Name:       w.name,
+		Created:    w.created.Format(time.RFC3339),
+		Updated:    w.updated.Format(time.RFC3339),
+		Operations: ops,
+	}
+}
+
+// stagingWorkspaces keeps the workspaces of every session in memory
+type stagingWorkspaces struct {
+	mu         sync.Mutex
+	workspaces map[string]*stagingWorkspace
+}
+
+var globalStagingWorkspaces = &stagingWorkspaces{workspaces: make(map[string]*stagingWorkspace)}
+
+// lookup returns the workspace if it belongs to owner and refreshes its idle timeout,
+// must be called with the lock held
+func (s *stagingWorkspaces) lookup(owner, id string) (*stagingWorkspace, error) {
+	ws, ok := s.workspaces[id]
+	if !ok || owner == "" || ws.owner != owner {
+		return nil, ErrNotFound
+	}
+	ws.timer.Reset(stagingWorkspaceIdleTimeout)
+	return ws, nil
+}
+
+func (s *stagingWorkspaces) create(owner, name string) (*models.StagingWorkspace, error) {
+	// the workspaces of the sessions without an owner would be shared by all of them
+	if owner == "" {
+		return nil, ErrInvalidSession
+	}
+	id, err := utils.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, ws := range s.workspaces {
+		if ws.owner == owner {
+			count++
+		}
+	}
+	if count >= maxStagingWorkspaces {
+		return nil, ErrTooManyStagingWorkspaces
+	}
+	now := time.Now().UTC()
+	ws := &stagingWorkspace{id: id, owner: owner, name: name, created: now, updated: now}
+	ws.timer = time.AfterFunc(stagingWorkspaceIdleTimeout, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.workspaces, id)
+	})
+	s.workspaces[id] = ws
+	return ws.toModel(), nil
+}
+
+func (s *stagingWorkspaces) list(owner string) []*models.StagingWorkspace {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var list []*stagingWorkspace
+	for _, ws := range s.workspaces {
+		if owner != "" && ws.owner == owner {
+			list = append(list, ws)
+		}
+	}
+	// oldest first, so the order is stable across reloads
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].created.Before(list[j].created)
+	})
+	result := make([]*models.StagingWorkspace, 0, len(list))
+	for _, ws := range list {
+		result = append(result, ws.toModel())
+	}
+	return result
+}
+
+func (s *stagingWorkspaces) get(owner, id string) (*models.StagingWorkspace, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ws, err := s.lookup(owner, id)
+	if err != nil {
+		return nil, err
+	}
+	return ws.toModel(), nil
+}
+
+func (s *stagingWorkspaces) remove(owner, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ws, err := s.lookup(owner, id)
+	if err != nil {
+		return err
+	}
+	if ws.committing {
+		return ErrStagingWorkspaceBusy
+	}
+	ws.timer.Stop()
+	delete(s.workspaces, id)
+	return nil
+}
+
+// validateStagedOperation checks an operation can be applied once the workspace is committed
+func validateStagedOperation(op *models.StagedOperation) error {
+	if op == nil || op.Action == nil || op.BucketName == nil || op.Path == nil {
+		return ErrBadRequest
+	}
+	if *op.BucketName == "" || *op.Path == "" {
+		return fmt.Errorf("%w: bucket_name and path are required", ErrInvalidStagedOperation)
+	}
+	switch *op.Action {
+	case models.StagedOperationActionDelete:
+	case models.StagedOperationActionTag:
+		if op.Recursive {
+			return fmt.Errorf("%w: tags can't be set recursively", ErrInvalidStagedOperation)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported action %s", ErrInvalidStagedOperation, *op.Action)
+	}
+	return nil
+}
+
+func (s *stagingWorkspaces) addOperations(owner, id string, ops []*models.StagedOperation) (*models.StagingWorkspace, error) {
+	for _, op := range ops {
+		if err := validateStagedOperation(op); err != nil {
+			return nil, err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ws, err := s.lookup(owner, id)
+	if err != nil {
+		return nil, err
+	}
+	if ws.committing {
+		return nil, ErrStagingWorkspaceBusy
+	}
+	if len(ws.operations)+len(ops) > maxStagedOperations {
+		return nil, ErrTooManyStagedOperations
+	}
+	for _, op := range ops {
+		opID, err := utils.NewUUID()
+		if err != nil {
+			return nil, err
+		}
+		staged := *op
+		staged.ID = opID
+		ws.operations = append(ws.operations, &staged)
+	}
+	ws.updated = time.Now().UTC()
+	return ws.toModel(), nil
+}
+
+func (s *stagingWorkspaces) removeOperation(owner, id, operationID string) (*models.StagingWorkspace, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ws, err := s.lookup(owner, id)
+	if err != nil {
+		return nil, err
+	}
+	if ws.committing {
+		return nil, ErrStagingWorkspaceBusy
+	}
+	for i, op := range ws.operations {
+		if op.ID == operationID {
+			ws.operations = append(ws.operations[:i], ws.operations[i+1:]...)
+			ws.updated = time.Now().UTC()
+			return ws.toModel(), nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// beginCommit locks the workspace for modifications and returns the operations to apply
+func (s *stagingWorkspaces) beginCommit(owner, id string) ([]*models.StagedOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ws, err := s.lookup(owner, id)
+	if err != nil {
+		return nil, err
+	}
+	if ws.committing {
+		return nil, ErrStagingWorkspaceBusy
+	}
+	ws.committing = true
+	ops := make([]*models.StagedOperation, len(ws.operations))
+	copy(ops, ws.operations)
+	return ops, nil
+}
+
+// endCommit keeps the failed operations in the workspace so they can be retried,
+// the workspace is discarded once every operation was applied
+func (s *stagingWorkspaces) endCommit(id string, failed map[string]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ws, ok := s.workspaces[id]
+	if !ok {
+		return
+	}
+	ws.committing = false
+	var pending []*models.StagedOperation
+	for _, op := range ws.operations {
+		if failed[op.ID] {
+			pending = append(pending, op)
+		}
+	}
+	if len(pending) == 0 {
+		ws.timer.Stop()
+		delete(s.workspaces, id)
+		return
+	}
+	ws.operations = pending
+	ws.updated = time.Now().UTC()
+}
+
+// commitStagedOperations applies every operation in the order they were staged, a failed
+// operation doesn't stop the job and is reported with its error
+func commitStagedOperations(ctx context.Context, ops []*models.StagedOperation, apply func(context.Context, *models.StagedOperation) error) *models.StagingCommitResponse {
+	resp := &models.StagingCommitResponse{}
+	for _, op := range ops {
+		if err := apply(ctx, op); err != nil {
+			resp.Failed++
+			resp.Failures = append(resp.Failures, &models.StagedOperationFailure{OperationID: op.ID, Error: err.Error()})
+			continue
+		}
+		resp.Succeeded++
+	}
+	return resp
+}
+
+// applyStagedOperation applies a single operation with the session credentials
+func applyStagedOperation(ctx context.Context, session *models.Principal, op *models.StagedOperation) error {
+	switch *op.Action {
+	case models.StagedOperationActionDelete:
+		s3Client, err := newS3BucketClient(session, *op.BucketName, *op.Path)
+		if err != nil {
+			return err
+		}
+		// create a mc S3Client interface implementation
+		// defining the client to be used
+		mcClient := mcClient{client: s3Client}
+		return deleteObjects(ctx, mcClient, *op.BucketName, *op.Path, op.VersionID, op.Recursive, false, false, false)
+	case models.StagedOperationActionTag:
+		mClient, err := newMinioClient(session)
+		if err != nil {
+			return err
+		}
+		// create a minioClient interface implementation
+		// defining the client to be used
+		minioClient := minioClient{client: mClient}
+		return putObjectTags(ctx, minioClient, *op.BucketName, *op.Path, op.VersionID, op.Tags)
+	}
+	return ErrInvalidStagedOperation
+}
+
+func getCommitStagingWorkspaceResponse(session *models.Principal, params stagingApi.CommitStagingWorkspaceParams) (*models.StagingCommitResponse, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	ops, err := globalStagingWorkspaces.beginCommit(sessionOwner(session), params.WorkspaceID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp := commitStagedOperations(ctx, ops, func(ctx context.Context, op *models.StagedOperation) error {
+		return applyStagedOperation(ctx, session, op)
+	})
+	failed := make(map[string]bool, len(resp.Failures))
+	for _, f := range resp.Failures {
+		failed[f.OperationID] = true
+	}
+	globalStagingWorkspaces.endCommit(params.WorkspaceID, failed)
+	return resp, nil
+}
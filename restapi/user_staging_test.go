@@ -0,0 +1,123 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newStagedOperation(action, bucket, path string) *models.StagedOperation {
+	return &models.StagedOperation{Action: swag.String(action), BucketName: swag.String(bucket), Path: swag.String(path)}
+}
+
+func Test_stagingWorkspaces(t *testing.T) {
+	assert := assert.New(t)
+	store := &stagingWorkspaces{workspaces: make(map[string]*stagingWorkspace)}
+
+	ws, err := store.create("alice", "cleanup")
+	assert.NoError(err)
+	assert.Equal("cleanup", ws.Name)
+
+	// workspaces are only visible to their owner
+	_, err = store.get("bob", ws.ID)
+	assert.ErrorIs(err, ErrNotFound)
+	assert.Empty(store.list("bob"))
+	assert.Len(store.list("alice"), 1)
+
+	// the sessions without an owner have no workspaces
+	_, err = store.create("", "shared")
+	assert.ErrorIs(err, ErrInvalidSession)
+	assert.Empty(store.list(""))
+
+	tagOp := newStagedOperation(models.StagedOperationActionTag, "bucket", "a/file.txt")
+	tagOp.Tags = map[string]string{"reviewed": "true"}
+	ws, err = store.addOperations("alice", ws.ID, []*models.StagedOperation{
+		newStagedOperation(models.StagedOperationActionDelete, "bucket", "b/file.txt"),
+		tagOp,
+		newStagedOperation(models.StagedOperationActionDelete, "other", "c/"),
+	})
+	assert.NoError(err)
+	if assert.Len(ws.Operations, 3) {
+		assert.NotEmpty(ws.Operations[0].ID)
+		assert.NotEqual(ws.Operations[0].ID, ws.Operations[1].ID)
+	}
+
+	// invalid operations are rejected
+	_, err = store.addOperations("alice", ws.ID, []*models.StagedOperation{newStagedOperation("rename", "bucket", "a")})
+	assert.ErrorIs(err, ErrInvalidStagedOperation)
+
+	ws, err = store.removeOperation("alice", ws.ID, ws.Operations[2].ID)
+	assert.NoError(err)
+	assert.Len(ws.Operations, 2)
+	_, err = store.removeOperation("alice", ws.ID, "missing")
+	assert.ErrorIs(err, ErrNotFound)
+
+	// the workspace can't change while it is committed
+	ops, err := store.beginCommit("alice", ws.ID)
+	assert.NoError(err)
+	_, err = store.beginCommit("alice", ws.ID)
+	assert.ErrorIs(err, ErrStagingWorkspaceBusy)
+	_, err = store.addOperations("alice", ws.ID, []*models.StagedOperation{newStagedOperation(models.StagedOperationActionDelete, "bucket", "d")})
+	assert.ErrorIs(err, ErrStagingWorkspaceBusy)
+
+	resp := commitStagedOperations(context.Background(), ops, func(ctx context.Context, op *models.StagedOperation) error {
+		if *op.Action == models.StagedOperationActionTag {
+			return errors.New("access denied")
+		}
+		return nil
+	})
+	assert.Equal(int64(1), resp.Succeeded)
+	assert.Equal(int64(1), resp.Failed)
+	if assert.Len(resp.Failures, 1) {
+		assert.Equal(ops[1].ID, resp.Failures[0].OperationID)
+		assert.Equal("access denied", resp.Failures[0].Error)
+	}
+	store.endCommit(ws.ID, map[string]bool{resp.Failures[0].OperationID: true})
+
+	// failed operations stay in the workspace to be retried
+	ws, err = store.get("alice", ws.ID)
+	assert.NoError(err)
+	if assert.Len(ws.Operations, 1) {
+		assert.Equal(models.StagedOperationActionTag, *ws.Operations[0].Action)
+	}
+
+	// the workspace is discarded once every operation was applied
+	_, err = store.beginCommit("alice", ws.ID)
+	assert.NoError(err)
+	store.endCommit(ws.ID, nil)
+	_, err = store.get("alice", ws.ID)
+	assert.ErrorIs(err, ErrNotFound)
+}
+
+func Test_stagingWorkspacesLimit(t *testing.T) {
+	store := &stagingWorkspaces{workspaces: make(map[string]*stagingWorkspace)}
+	for i := 0; i < maxStagingWorkspaces; i++ {
+		_, err := store.create("alice", "")
+		assert.NoError(t, err)
+	}
+	_, err := store.create("alice", "")
+	assert.ErrorIs(t, err, ErrTooManyStagingWorkspaces)
+	// other sessions are not affected
+	_, err = store.create("bob", "")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,245 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/pkg/twofactor"
+	"github.com/minio/console/pkg/utils"
+	"github.com/minio/console/restapi/operations"
+	accountApi "github.com/minio/console/restapi/operations/account"
+	userApi "github.com/minio/console/restapi/operations/user"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+var (
+	globalTwoFactor     *twofactor.Store
+	globalTwoFactorOnce sync.Once
+)
+
+func registerTwoFactorHandlers(api *operations.ConsoleAPI) {
+	// two-factor authentication status of the session user
+	api.AccountTwoFactorStatusHandler = accountApi.TwoFactorStatusHandlerFunc(func(params accountApi.TwoFactorStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getTwoFactorStatusResponse(session, params)
+		if err != nil {
+			return accountApi.NewTwoFactorStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewTwoFactorStatusOK().WithPayload(resp)
+	})
+	// start the enrollment of the session user
+	api.AccountEnrollTwoFactorHandler = accountApi.EnrollTwoFactorHandlerFunc(func(params accountApi.EnrollTwoFactorParams, session *models.Principal) middleware.Responder {
+		resp, err := getEnrollTwoFactorResponse(session, params)
+		if err != nil {
+			return accountApi.NewEnrollTwoFactorDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewEnrollTwoFactorCreated().WithPayload(resp)
+	})
+	// confirm the enrollment with a code and get the recovery codes
+	api.AccountVerifyTwoFactorHandler = accountApi.VerifyTwoFactorHandlerFunc(func(params accountApi.VerifyTwoFactorParams, session *models.Principal) middleware.Responder {
+		resp, err := getVerifyTwoFactorResponse(session, params)
+		if err != nil {
+			return accountApi.NewVerifyTwoFactorDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewVerifyTwoFactorOK().WithPayload(resp)
+	})
+	// turn two-factor authentication off for the session user
+	api.AccountDisableTwoFactorHandler = accountApi.DisableTwoFactorHandlerFunc(func(params accountApi.DisableTwoFactorParams, session *models.Principal) middleware.Responder {
+		if err := getDisableTwoFactorResponse(session, params); err != nil {
+			return accountApi.NewDisableTwoFactorDefault(int(err.Code)).WithPayload(err)
+		}
+		return accountApi.NewDisableTwoFactorNoContent()
+	})
+	// drop the two-factor authentication of a user that lost their authenticator
+	api.UserResetUserTwoFactorHandler = userApi.ResetUserTwoFactorHandlerFunc(func(params userApi.ResetUserTwoFactorParams, session *models.Principal) middleware.Responder {
+		if err := getResetUserTwoFactorResponse(session, params); err != nil {
+			return userApi.NewResetUserTwoFactorDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewResetUserTwoFactorNoContent()
+	})
+}
+
+// sessionKeyCipher encrypts the two-factor authentication secrets with the key of the sessions
+type sessionKeyCipher struct{}
+
+func (sessionKeyCipher) Encrypt(plaintext []byte, owner string) (string, error) {
+	return auth.EncryptSecret(plaintext, owner)
+}
+
+func (sessionKeyCipher) Decrypt(ciphertext, owner string) ([]byte, error) {
+	return auth.DecryptSecret(ciphertext, owner)
+}
+
+// twoFactor returns the store of the two-factor authentication enrollments, when the configured file
+// can't be loaded they are only kept in memory
+func twoFactor() *twofactor.Store {
+	globalTwoFactorOnce.Do(func() {
+		store, err := twofactor.New(getConsoleTwoFactorFile(), sessionKeyCipher{})
+		if err != nil {
+			LogError("unable to load the two-factor authentication enrollments: %v", err)
+			store, _ = twofactor.New("", sessionKeyCipher{})
+		}
+		globalTwoFactor = store
+	})
+	return globalTwoFactor
+}
+
+// checkLoginTwoFactor verifies the code of a login with the credentials of a user enrolled in two-factor
+// authentication, a wrong code is a failed login
+func checkLoginTwoFactor(store *twofactor.Store, accessKey, code string, now time.Time) error {
+	if !store.Enabled(accessKey) {
+		return nil
+	}
+	if strings.TrimSpace(code) == "" {
+		return ErrTwoFactorRequired
+	}
+	if err := store.Verify(accessKey, code, now); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLogin, err)
+	}
+	return nil
+}
+
+// twoFactorUser returns the user enrolling, the sessions of OpenID providers don't log in with the
+// credentials form so they can't
+func twoFactorUser(session *models.Principal) (string, error) {
+	if session == nil || session.AccountAccessKey == "" {
+		return "", fmt.Errorf("%w: only the users logging in with their credentials can enroll", ErrInvalidTwoFactor)
+	}
+	return session.AccountAccessKey, nil
+}
+
+// twoFactorError reports the errors of the store as invalid requests
+func twoFactorError(err error) error {
+	if err == twofactor.ErrInvalidCode || err == twofactor.ErrNotEnrolled || err == twofactor.ErrAlreadyEnabled {
+		return fmt.Errorf("%w: %v", ErrInvalidTwoFactor, err)
+	}
+	return err
+}
+
+func getTwoFactorStatus(store *twofactor.Store, user string) *models.TwoFactorStatus {
+	status := store.Status(user)
+	return &models.TwoFactorStatus{
+		Enabled:           status.Enabled,
+		Pending:           status.Pending,
+		RecoveryCodesLeft: int32(status.RecoveryCodesLeft),
+	}
+}
+
+// enrollTwoFactor starts the enrollment of the user, the secret is only returned this once
+func enrollTwoFactor(store *twofactor.Store, issuer, user string) (*models.TwoFactorEnrollment, error) {
+	secret, err := store.Enroll(user)
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+	return &models.TwoFactorEnrollment{
+		Secret:          twofactor.EncodeSecret(secret),
+		ProvisioningURI: twofactor.ProvisioningURI(issuer, user, secret),
+	}, nil
+}
+
+// verifyTwoFactor enables two-factor authentication for the user, the recovery codes are only returned
+// this once
+func verifyTwoFactor(store *twofactor.Store, user, code string, now time.Time) (*models.TwoFactorRecoveryCodes, error) {
+	codes, err := store.Activate(user, code, now)
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+	return &models.TwoFactorRecoveryCodes{RecoveryCodes: codes}, nil
+}
+
+func getTwoFactorStatusResponse(session *models.Principal, params accountApi.TwoFactorStatusParams) (*models.TwoFactorStatus, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := twoFactorUser(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return getTwoFactorStatus(twoFactor(), user), nil
+}
+
+func getEnrollTwoFactorResponse(session *models.Principal, params accountApi.EnrollTwoFactorParams) (*models.TwoFactorEnrollment, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := twoFactorUser(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	enrollment, err := enrollTwoFactor(twoFactor(), getConsoleTwoFactorIssuer(), user)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return enrollment, nil
+}
+
+func getVerifyTwoFactorResponse(session *models.Principal, params accountApi.VerifyTwoFactorParams) (*models.TwoFactorRecoveryCodes, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := twoFactorUser(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	codes, err := verifyTwoFactor(twoFactor(), user, swag.StringValue(params.Body.Code), time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return codes, nil
+}
+
+func getDisableTwoFactorResponse(session *models.Principal, params accountApi.DisableTwoFactorParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	user, err := twoFactorUser(session)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err := twoFactor().Disable(user, swag.StringValue(params.Body.Code), time.Now()); err != nil {
+		return ErrorWithContext(ctx, twoFactorError(err))
+	}
+	return nil
+}
+
+func getResetUserTwoFactorResponse(session *models.Principal, params userApi.ResetUserTwoFactorParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	sessionResp, errResp := getSessionResponse(ctx, session)
+	if errResp != nil {
+		return errResp
+	}
+	if !hasConsoleAdminAction(sessionResp.Permissions, iampolicy.CreateUserAdminAction) {
+		return &models.Error{
+			Code:            int32(403),
+			Message:         swag.String("Forbidden"),
+			DetailedMessage: swag.String("Resetting the two-factor authentication of a user requires the admin:CreateUser action."),
+		}
+	}
+	userName, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err := twoFactor().Reset(userName); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/base32"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/twofactor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoFactorEnrollment(t *testing.T) {
+	assert := assert.New(t)
+	store, err := twofactor.New("", nil)
+	assert.NoError(err)
+	now := time.Unix(1700000000, 0)
+
+	// OpenID sessions don't log in with the credentials form
+	_, err = twoFactorUser(&models.Principal{})
+	assert.True(errors.Is(err, ErrInvalidTwoFactor))
+	user, err := twoFactorUser(&models.Principal{AccountAccessKey: "alice"})
+	assert.NoError(err)
+
+	// users that didn't enroll log in without a code
+	assert.NoError(checkLoginTwoFactor(store, user, "", now))
+	_, err = verifyTwoFactor(store, user, "123456", now)
+	assert.True(errors.Is(err, ErrInvalidTwoFactor))
+
+	enrollment, err := enrollTwoFactor(store, "MinIO Console", user)
+	assert.NoError(err)
+	uri, err := url.Parse(enrollment.ProvisioningURI)
+	assert.NoError(err)
+	assert.Equal(enrollment.Secret, uri.Query().Get("secret"))
+	assert.True(getTwoFactorStatus(store, user).Pending)
+	// the enrollment isn't enforced until confirmed
+	assert.NoError(checkLoginTwoFactor(store, user, "", now))
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	assert.NoError(err)
+	codes, err := verifyTwoFactor(store, user, twofactor.Code(key, now), now)
+	assert.NoError(err)
+	assert.Len(codes.RecoveryCodes, twofactor.RecoveryCodes)
+	status := getTwoFactorStatus(store, user)
+	assert.True(status.Enabled)
+	assert.Equal(int32(twofactor.RecoveryCodes), status.RecoveryCodesLeft)
+	_, err = enrollTwoFactor(store, "MinIO Console", user)
+	assert.True(errors.Is(err, ErrInvalidTwoFactor))
+
+	// logins need a code, a wrong one is a failed login
+	err = checkLoginTwoFactor(store, user, "", now)
+	assert.True(errors.Is(err, ErrTwoFactorRequired))
+	err = checkLoginTwoFactor(store, user, twofactor.Code(key, now.Add(time.Hour)), now)
+	assert.True(errors.Is(err, ErrInvalidLogin))
+	assert.NoError(checkLoginTwoFactor(store, user, twofactor.Code(key, now.Add(twofactor.Period)), now))
+	assert.NoError(checkLoginTwoFactor(store, user, codes.RecoveryCodes[0], now))
+	assert.Equal(int32(twofactor.RecoveryCodes-1), getTwoFactorStatus(store, user).RecoveryCodesLeft)
+
+	// an administrator resets the users that lost their authenticator
+	assert.NoError(store.Reset(user))
+	assert.NoError(checkLoginTwoFactor(store, user, "", now))
+}
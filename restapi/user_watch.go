@@ -36,7 +36,7 @@ type watchOptions struct {
 func startWatch(ctx context.Context, conn WSConn, wsc MCClient, options *watchOptions) error {
 	wo, pErr := wsc.watch(ctx, options.WatchOptions)
 	if pErr != nil {
-		LogError("error initializing watch: %v", pErr.Cause)
+		LogErrorContext(ctx, "error initializing watch: %v", pErr.Cause)
 		return pErr.Cause
 	}
 	for {
@@ -53,13 +53,13 @@ func startWatch(ctx context.Context, conn WSConn, wsc MCClient, options *watchOp
 				// Serialize message to be sent
 				bytes, err := json.Marshal(event)
 				if err != nil {
-					LogError("error on json.Marshal: %v", err)
+					LogErrorContext(ctx, "error on json.Marshal: %v", err)
 					return err
 				}
 				// Send Message through websocket connection
 				err = conn.writeMessage(websocket.TextMessage, bytes)
 				if err != nil {
-					LogError("error writeMessage: %v", err)
+					LogErrorContext(ctx, "error writeMessage: %v", err)
 					return err
 				}
 			}
@@ -69,7 +69,7 @@ func startWatch(ctx context.Context, conn WSConn, wsc MCClient, options *watchOp
 				return nil
 			}
 			if pErr != nil {
-				LogError("error on watch: %v", pErr.Cause)
+				LogErrorContext(ctx, "error on watch: %v", pErr.Cause)
 				return pErr.Cause
 
 			}
@@ -0,0 +1,118 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Websocket subprotocols a client can negotiate through the Sec-WebSocket-Protocol header.
+// JSON text frames are used when no subprotocol is requested.
+const (
+	wsProtocolJSON    = "json"
+	wsProtocolMsgpack = "msgpack"
+)
+
+// websocket channels carrying high event volumes, messages on these channels are
+// compressed when the client negotiated permessage-deflate
+var wsCompressedPaths = []string{"/trace", "/console", "/watch", "/objectManager"}
+
+// wsCompressionEnabled returns whether the messages written on wsPath should be compressed
+func wsCompressionEnabled(wsPath string) bool {
+	for _, p := range wsCompressedPaths {
+		if strings.HasPrefix(wsPath, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonToMsgpack converts a JSON message into its msgpack representation, numbers are
+// kept as integers whenever possible
+func jsonToMsgpack(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return appendMsgpack(nil, v)
+}
+
+func appendMsgpack(b []byte, v interface{}) ([]byte, error) {
+	var err error
+	switch t := v.(type) {
+	case nil:
+		return msgp.AppendNil(b), nil
+	case bool:
+		return msgp.AppendBool(b, t), nil
+	case string:
+		return msgp.AppendString(b, t), nil
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return msgp.AppendInt64(b, i), nil
+		}
+		if u, err := strconv.ParseUint(t.String(), 10, 64); err == nil {
+			return msgp.AppendUint64(b, u), nil
+		}
+		f, err := t.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return msgp.AppendFloat64(b, f), nil
+	case []interface{}:
+		b = msgp.AppendArrayHeader(b, uint32(len(t)))
+		for _, e := range t {
+			if b, err = appendMsgpack(b, e); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]interface{}:
+		// sort the keys so the same message is always encoded the same way
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b = msgp.AppendMapHeader(b, uint32(len(t)))
+		for _, k := range keys {
+			b = msgp.AppendString(b, k)
+			if b, err = appendMsgpack(b, t[k]); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("unsupported JSON value of type %T", v)
+}
+
+// msgpackToJSON converts a msgpack message sent by the client into JSON
+func msgpackToJSON(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := msgp.UnmarshalAsJSON(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
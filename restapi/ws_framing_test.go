@@ -0,0 +1,56 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_jsonToMsgpack(t *testing.T) {
+	message, err := json.Marshal(WSResponse{
+		RequestID: 1,
+		Data: []ObjectResponse{
+			{Name: "file.txt", Size: 9007199254740993, LastModified: "2023-04-10T12:30:15Z"},
+		},
+	})
+	assert.NoError(t, err)
+
+	packed, err := jsonToMsgpack(message)
+	assert.NoError(t, err)
+	assert.Less(t, len(packed), len(message))
+
+	unpacked, err := msgpackToJSON(packed)
+	assert.NoError(t, err)
+	// integers larger than 2^53 survive the conversion
+	var got, want WSResponse
+	assert.NoError(t, json.Unmarshal(unpacked, &got))
+	assert.NoError(t, json.Unmarshal(message, &want))
+	assert.Equal(t, want, got)
+
+	_, err = jsonToMsgpack([]byte("{invalid"))
+	assert.Error(t, err)
+}
+
+func Test_wsCompressionEnabled(t *testing.T) {
+	assert.True(t, wsCompressionEnabled("/trace"))
+	assert.True(t, wsCompressionEnabled("/objectManager"))
+	assert.False(t, wsCompressionEnabled("/profile"))
+	assert.False(t, wsCompressionEnabled("/health-info"))
+}
@@ -17,6 +17,7 @@
 package restapi
 
 import (
+	"compress/flate"
 	"context"
 	"errors"
 	"fmt"
@@ -39,6 +40,9 @@ import (
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  0,
 	WriteBufferSize: 1024,
+	// negotiate permessage-deflate with clients supporting it
+	EnableCompression: true,
+	Subprotocols:      []string{wsProtocolMsgpack, wsProtocolJSON},
 }
 
 const (
@@ -102,25 +106,41 @@ type wsConn struct {
 
 // Types for trace request. this adds support for calls, threshold, status and extra filters
 type TraceRequest struct {
-	s3         bool
-	internal   bool
-	storage    bool
-	os         bool
-	threshold  int64
-	onlyErrors bool
-	statusCode int64
-	method     string
-	funcName   string
-	path       string
-}
-
-// Type for log requests. This allows for filtering by node and kind
+	s3          bool
+	internal    bool
+	storage     bool
+	os          bool
+	threshold   int64
+	onlyErrors  bool
+	statusCode  int64
+	method      string
+	funcName    string
+	path        string
+	bucket      string
+	node        string
+	minDuration time.Duration
+	sampleRate  float64
+}
+
+// Type for log requests. This allows for filtering by node, kind, level, time range and text
 type LogRequest struct {
 	node    string
 	logType string
+	level   string
+	query   string
+	since   time.Time
+	until   time.Time
 }
 
 func (c wsConn) writeMessage(messageType int, data []byte) error {
+	// JSON messages are sent as binary msgpack frames when the client negotiated it
+	if messageType == websocket.TextMessage && c.conn.Subprotocol() == wsProtocolMsgpack {
+		packed, err := jsonToMsgpack(data)
+		if err != nil {
+			return err
+		}
+		return c.conn.WriteMessage(websocket.BinaryMessage, packed)
+	}
 	return c.conn.WriteMessage(messageType, data)
 }
 
@@ -129,7 +149,13 @@ func (c wsConn) close() error {
 }
 
 func (c wsConn) readMessage() (messageType int, p []byte, err error) {
-	return c.conn.ReadMessage()
+	messageType, p, err = c.conn.ReadMessage()
+	if err != nil || messageType != websocket.BinaryMessage || c.conn.Subprotocol() != wsProtocolMsgpack {
+		return messageType, p, err
+	}
+	// handlers expect JSON requests
+	p, err = msgpackToJSON(p)
+	return websocket.TextMessage, p, err
 }
 
 // serveWS validates the incoming request and
@@ -148,6 +174,27 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 		errorsApi.ServeError(w, req, errorsApi.New(http.StatusUnauthorized, err.Error()))
 		return
 	}
+	if area := websocketConsoleArea(wsPath); area != "" && session != nil {
+		if err := checkConsoleArea(ctx, session, area); err != nil {
+			serveConsoleError(w, req, err)
+			return
+		}
+	}
+	// a speedtest loads the whole cluster, the slot is held until it ends
+	var releaseSpeedtest func()
+	if strings.HasPrefix(wsPath, `/speedtest`) && !strings.HasPrefix(wsPath, `/speedtest/`) {
+		release, ok := acquireConcurrencySlot(concurrencySpeedtest)
+		if !ok {
+			writeTooManyRequests(w, req, concurrencyRetryAfter)
+			return
+		}
+		releaseSpeedtest = release
+		defer func() {
+			if releaseSpeedtest != nil {
+				releaseSpeedtest()
+			}
+		}()
+	}
 	// Development mode validation
 	if getConsoleDevMode() {
 		upgrader.CheckOrigin = func(r *http.Request) bool {
@@ -162,6 +209,9 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 		errorsApi.ServeError(w, req, err)
 		return
 	}
+	// compression only pays off on channels with a high volume of messages
+	conn.EnableWriteCompression(wsCompressionEnabled(wsPath))
+	conn.SetCompressionLevel(flate.BestSpeed)
 
 	switch {
 	case strings.HasPrefix(wsPath, `/trace`):
@@ -179,6 +229,8 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 		method := req.URL.Query().Get("method")
 		funcName := req.URL.Query().Get("funcname")
 		path := req.URL.Query().Get("path")
+		minDuration, _ := time.ParseDuration(req.URL.Query().Get("minDuration"))
+		sampleRate, _ := strconv.ParseFloat(req.URL.Query().Get("sampleRate"), 64)
 
 		statusCode := int64(0)
 
@@ -186,21 +238,60 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			statusCode = stCode
 		}
 
+		var recorder *traceRecorder
+		if req.URL.Query().Get("record") == "on" {
+			bucket := getConsoleDiagnosticsBucket()
+			if bucket == "" {
+				ErrorWithContext(ctx, fmt.Errorf("recording a trace requires %s", ConsoleDiagnosticsBucket))
+				closeWsConn(conn)
+				return
+			}
+			owner := sessionOwner(session)
+			if owner == "" {
+				ErrorWithContext(ctx, ErrInvalidSession)
+				closeWsConn(conn)
+				return
+			}
+			mClient, err := newMinioClient(session)
+			if err != nil {
+				ErrorWithContext(ctx, err)
+				closeWsConn(conn)
+				return
+			}
+			recorder = &traceRecorder{
+				client: minioClient{client: mClient},
+				bucket: bucket,
+				object: traceRecordingName(owner, time.Now()),
+			}
+		}
+
 		traceRequestItem := TraceRequest{
-			s3:         strings.Contains(calls, "s3") || strings.Contains(calls, "all"),
-			internal:   strings.Contains(calls, "internal") || strings.Contains(calls, "all"),
-			storage:    strings.Contains(calls, "storage") || strings.Contains(calls, "all"),
-			os:         strings.Contains(calls, "os") || strings.Contains(calls, "all"),
-			onlyErrors: onlyErrors == "yes",
-			threshold:  threshold,
-			statusCode: statusCode,
-			method:     method,
-			funcName:   funcName,
-			path:       path,
-		}
-
-		go wsAdminClient.trace(ctx, traceRequestItem)
+			s3:          strings.Contains(calls, "s3") || strings.Contains(calls, "all"),
+			internal:    strings.Contains(calls, "internal") || strings.Contains(calls, "all"),
+			storage:     strings.Contains(calls, "storage") || strings.Contains(calls, "all"),
+			os:          strings.Contains(calls, "os") || strings.Contains(calls, "all"),
+			onlyErrors:  onlyErrors == "yes",
+			threshold:   threshold,
+			statusCode:  statusCode,
+			method:      method,
+			funcName:    funcName,
+			path:        path,
+			bucket:      req.URL.Query().Get("bucket"),
+			node:        req.URL.Query().Get("node"),
+			minDuration: minDuration,
+			sampleRate:  sampleRate,
+		}
+
+		go wsAdminClient.trace(ctx, traceRequestItem, recorder)
 	case strings.HasPrefix(wsPath, `/console`):
+		query := req.URL.Query()
+		logRequestItem, err := newLogRequest(query.Get("node"), query.Get("level"), query.Get("query"), query.Get("since"), query.Get("until"))
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting console log options: %v", err))
+			closeWsConn(conn)
+			return
+		}
+		logRequestItem.logType = query.Get("logType")
 
 		wsAdminClient, err := newWebSocketAdminClient(conn, session)
 		if err != nil {
@@ -208,13 +299,6 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			closeWsConn(conn)
 			return
 		}
-		node := req.URL.Query().Get("node")
-		logType := req.URL.Query().Get("logType")
-
-		logRequestItem := LogRequest{
-			node:    node,
-			logType: logType,
-		}
 		go wsAdminClient.console(ctx, logRequestItem)
 	case strings.HasPrefix(wsPath, `/health-info`):
 		deadline, err := getHealthInfoOptionsFromReq(req)
@@ -258,6 +342,20 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 		go wsS3Client.watch(ctx, wOptions)
+	case strings.HasPrefix(wsPath, `/speedtest/`):
+		stRequest, err := getSpeedtestRequestFromReq(strings.TrimPrefix(wsPath, `/speedtest/`), req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting speedtest options: %v", err))
+			closeWsConn(conn)
+			return
+		}
+		wsAdminClient, err := newWebSocketAdminClient(conn, session)
+		if err != nil {
+			ErrorWithContext(ctx, err)
+			closeWsConn(conn)
+			return
+		}
+		go wsAdminClient.speedtestReport(ctx, stRequest)
 	case strings.HasPrefix(wsPath, `/speedtest`):
 		speedtestOpts, err := getSpeedtestOptionsFromReq(req)
 		if err != nil {
@@ -271,7 +369,26 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			closeWsConn(conn)
 			return
 		}
-		go wsAdminClient.speedtest(ctx, speedtestOpts)
+		release := releaseSpeedtest
+		releaseSpeedtest = nil
+		go func() {
+			defer release()
+			wsAdminClient.speedtest(ctx, speedtestOpts)
+		}()
+	case strings.HasPrefix(wsPath, `/service`):
+		serviceOpts, err := getServiceActionOptionsFromReq(req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting service options: %v", err))
+			closeWsConn(conn)
+			return
+		}
+		wsAdminClient, err := newWebSocketAdminClient(conn, session)
+		if err != nil {
+			ErrorWithContext(ctx, err)
+			closeWsConn(conn)
+			return
+		}
+		go wsAdminClient.serviceAction(ctx, sessionOwner(session), serviceOpts)
 	case strings.HasPrefix(wsPath, `/profile`):
 		pOptions, err := getProfileOptionsFromReq(req)
 		if err != nil {
@@ -286,6 +403,34 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 		go wsAdminClient.profile(ctx, pOptions)
+	case strings.HasPrefix(wsPath, `/replication-resync`):
+		rOptions, err := getReplicationResyncOptionsFromReq(req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting replication resync options: %v", err))
+			closeWsConn(conn)
+			return
+		}
+		wsMinioClient, err := newWebSocketMinioClient(conn, session)
+		if err != nil {
+			ErrorWithContext(ctx, err)
+			closeWsConn(conn)
+			return
+		}
+		go wsMinioClient.replicationResync(ctx, rOptions)
+	case strings.HasPrefix(wsPath, `/batch-jobs`):
+		bOptions, err := getBatchJobProgressOptionsFromReq(req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting batch job progress options: %v", err))
+			closeWsConn(conn)
+			return
+		}
+		wsAdminClient, err := newWebSocketAdminClient(conn, session)
+		if err != nil {
+			ErrorWithContext(ctx, err)
+			closeWsConn(conn)
+			return
+		}
+		go wsAdminClient.batchJobProgress(ctx, bOptions)
 
 	case strings.HasPrefix(wsPath, `/objectManager`):
 		wsMinioClient, err := newWebSocketMinioClient(conn, session)
@@ -424,37 +569,67 @@ func wsReadClientCtx(parentContext context.Context, conn WSConn) context.Context
 }
 
 // closeWsConn sends Close Message and closes the websocket connection
+// startStream traces and counts a websocket stream until the returned function is called, it outlives the
+// request upgrading the connection
+func startStream(ctx context.Context, stream string) (context.Context, func()) {
+	ctx, span := startStreamSpan(ctx, stream)
+	untrack := trackWebsocket(stream)
+	return ctx, func() {
+		untrack()
+		span.End()
+	}
+}
+
 func closeWsConn(conn *websocket.Conn) {
 	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
 	conn.Close()
 }
 
 // trace serves madmin.ServiceTraceInfo
-// on a Websocket connection.
-func (wsc *wsAdminClient) trace(ctx context.Context, traceRequestItem TraceRequest) {
+// on a Websocket connection, recording the session when a recorder is passed.
+func (wsc *wsAdminClient) trace(ctx context.Context, traceRequestItem TraceRequest, recorder *traceRecorder) {
+	ctx, endStream := startStream(ctx, "trace")
+	defer endStream()
 	defer func() {
-		LogInfo("trace stopped")
+		LogInfoContext(ctx, "trace stopped")
 		// close connection after return
 		wsc.conn.close()
 	}()
-	LogInfo("trace started")
+	LogInfoContext(ctx, "trace started")
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
-	err := startTraceInfo(ctx, wsc.conn, wsc.client, traceRequestItem)
-
+	if recorder == nil {
+		err := startTraceInfo(ctx, wsc.conn, wsc.client, traceRequestItem)
+		sendWsCloseMessage(wsc.conn, err)
+		return
+	}
+	recording, err := startTraceRecording(wsc.conn, recorder)
+	if err != nil {
+		sendWsCloseMessage(wsc.conn, err)
+		return
+	}
+	err = startTraceInfo(ctx, recording, wsc.client, traceRequestItem)
+	if recErr := recording.finish(); recErr != nil {
+		LogErrorContext(ctx, "error recording trace to %s/%s: %v", recorder.bucket, recorder.object, recErr)
+		if err == nil {
+			err = recErr
+		}
+	}
 	sendWsCloseMessage(wsc.conn, err)
 }
 
 // console serves madmin.GetLogs
 // on a Websocket connection.
 func (wsc *wsAdminClient) console(ctx context.Context, logRequestItem LogRequest) {
+	ctx, endStream := startStream(ctx, "console")
+	defer endStream()
 	defer func() {
-		LogInfo("console logs stopped")
+		LogInfoContext(ctx, "console logs stopped")
 		// close connection after return
 		wsc.conn.close()
 	}()
-	LogInfo("console logs started")
+	LogInfoContext(ctx, "console logs started")
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
@@ -464,12 +639,14 @@ func (wsc *wsAdminClient) console(ctx context.Context, logRequestItem LogRequest
 }
 
 func (wsc *wsS3Client) watch(ctx context.Context, params *watchOptions) {
+	ctx, endStream := startStream(ctx, "watch")
+	defer endStream()
 	defer func() {
-		LogInfo("watch stopped")
+		LogInfoContext(ctx, "watch stopped")
 		// close connection after return
 		wsc.conn.close()
 	}()
-	LogInfo("watch started")
+	LogInfoContext(ctx, "watch started")
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
@@ -479,12 +656,14 @@ func (wsc *wsS3Client) watch(ctx context.Context, params *watchOptions) {
 }
 
 func (wsc *wsAdminClient) heal(ctx context.Context, opts *healOptions) {
+	ctx, endStream := startStream(ctx, "heal")
+	defer endStream()
 	defer func() {
-		LogInfo("heal stopped")
+		LogInfoContext(ctx, "heal stopped")
 		// close connection after return
 		wsc.conn.close()
 	}()
-	LogInfo("heal started")
+	LogInfoContext(ctx, "heal started")
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
@@ -494,12 +673,14 @@ func (wsc *wsAdminClient) heal(ctx context.Context, opts *healOptions) {
 }
 
 func (wsc *wsAdminClient) healthInfo(ctx context.Context, deadline *time.Duration) {
+	ctx, endStream := startStream(ctx, "healthInfo")
+	defer endStream()
 	defer func() {
-		LogInfo("health info stopped")
+		LogInfoContext(ctx, "health info stopped")
 		// close connection after return
 		wsc.conn.close()
 	}()
-	LogInfo("health info started")
+	LogInfoContext(ctx, "health info started")
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 	err := startHealthInfo(ctx, wsc.conn, wsc.client, deadline)
@@ -508,12 +689,14 @@ func (wsc *wsAdminClient) healthInfo(ctx context.Context, deadline *time.Duratio
 }
 
 func (wsc *wsAdminClient) speedtest(ctx context.Context, opts *madmin.SpeedtestOpts) {
+	ctx, endStream := startStream(ctx, "speedtest")
+	defer endStream()
 	defer func() {
-		LogInfo("speedtest stopped")
+		LogInfoContext(ctx, "speedtest stopped")
 		// close connection after return
 		wsc.conn.close()
 	}()
-	LogInfo("speedtest started")
+	LogInfoContext(ctx, "speedtest started")
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
@@ -522,13 +705,49 @@ func (wsc *wsAdminClient) speedtest(ctx context.Context, opts *madmin.SpeedtestO
 	sendWsCloseMessage(wsc.conn, err)
 }
 
+func (wsc *wsAdminClient) speedtestReport(ctx context.Context, request *speedtestRequest) {
+	ctx, endStream := startStream(ctx, "speedtestReport")
+	defer endStream()
+	defer func() {
+		LogInfoContext(ctx, "%s speedtest stopped", request.Test)
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfoContext(ctx, "%s speedtest started", request.Test)
+
+	ctx = wsReadClientCtx(ctx, wsc.conn)
+
+	err := runSpeedtest(ctx, wsc.conn, wsc.client, request)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
+func (wsc *wsAdminClient) serviceAction(ctx context.Context, owner string, opts *serviceActionOptions) {
+	ctx, endStream := startStream(ctx, "serviceAction")
+	defer endStream()
+	defer func() {
+		LogInfoContext(ctx, "service %s stopped", opts.Action)
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfoContext(ctx, "service %s started", opts.Action)
+
+	ctx = wsReadClientCtx(ctx, wsc.conn)
+
+	err := runServiceAction(ctx, wsc.conn, wsc.client, owner, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
 func (wsc *wsAdminClient) profile(ctx context.Context, opts *profileOptions) {
+	ctx, endStream := startStream(ctx, "profile")
+	defer endStream()
 	defer func() {
-		LogInfo("profile stopped")
+		LogInfoContext(ctx, "profile stopped")
 		// close connection after return
 		wsc.conn.close()
 	}()
-	LogInfo("profile started")
+	LogInfoContext(ctx, "profile started")
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
@@ -537,6 +756,23 @@ func (wsc *wsAdminClient) profile(ctx context.Context, opts *profileOptions) {
 	sendWsCloseMessage(wsc.conn, err)
 }
 
+func (wsc *wsAdminClient) batchJobProgress(ctx context.Context, opts *batchJobProgressOptions) {
+	ctx, endStream := startStream(ctx, "batchJobProgress")
+	defer endStream()
+	defer func() {
+		LogInfoContext(ctx, "batch job progress stream stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfoContext(ctx, "batch job progress stream started")
+
+	ctx = wsReadClientCtx(ctx, wsc.conn)
+
+	err := startBatchJobProgressStream(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
 // sendWsCloseMessage sends Websocket Connection Close Message indicating the Status Code
 // see https://tools.ietf.org/html/rfc6455#page-45
 func sendWsCloseMessage(conn WSConn, err error) {
@@ -558,3 +794,20 @@ func sendWsCloseMessage(conn WSConn, err error) {
 	// normal closure
 	conn.writeMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
 }
+
+func (wsc *wsMinioClient) replicationResync(ctx context.Context, opts *replicationResyncOptions) {
+	ctx, endStream := startStream(ctx, "replicationResync")
+	defer endStream()
+	defer func() {
+		LogInfoContext(ctx, "replication resync stream stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfoContext(ctx, "replication resync stream started")
+
+	ctx = wsReadClientCtx(ctx, wsc.conn)
+
+	err := startReplicationResyncStream(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
@@ -21,27 +21,152 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/minio/console/models"
 	"github.com/minio/websocket"
 )
 
+const (
+	// itemsPerBatch is the maximum number of objects sent on a single websocket message
+	itemsPerBatch = 1000
+	// maxPendingBatches is the number of batches that can be queued for writing before
+	// listing is paused, this keeps huge prefixes from being buffered in memory when the
+	// client is slower than the backend
+	maxPendingBatches = 10
+)
+
+// wsRequestsTracker keeps the cancel functions of the listings running on a connection.
+// Listings run on their own goroutines, so access to the map is serialized.
+type wsRequestsTracker struct {
+	sync.Mutex
+	cancelFuncs map[int64]context.CancelFunc
+}
+
+func newWSRequestsTracker() *wsRequestsTracker {
+	return &wsRequestsTracker{cancelFuncs: make(map[int64]context.CancelFunc)}
+}
+
+// add stores the cancel func associated with requestID
+func (t *wsRequestsTracker) add(requestID int64, cancel context.CancelFunc) {
+	t.Lock()
+	defer t.Unlock()
+	t.cancelFuncs[requestID] = cancel
+}
+
+// cancel stops the request with the given ID, if it is still running
+func (t *wsRequestsTracker) cancel(requestID int64) {
+	t.Lock()
+	defer t.Unlock()
+	if cancelFunc, ok := t.cancelFuncs[requestID]; ok {
+		cancelFunc()
+		delete(t.cancelFuncs, requestID)
+	}
+}
+
+// cancelPrevious stops every request older than requestID
+func (t *wsRequestsTracker) cancelPrevious(requestID int64) {
+	t.Lock()
+	defer t.Unlock()
+	for rid, cancelFunc := range t.cancelFuncs {
+		if rid < requestID {
+			cancelFunc()
+			delete(t.cancelFuncs, rid)
+		}
+	}
+}
+
+// done removes a finished request, cancelling its context to release resources
+func (t *wsRequestsTracker) done(requestID int64) {
+	t.cancel(requestID)
+}
+
+// cancelAll stops every running request
+func (t *wsRequestsTracker) cancelAll() {
+	t.Lock()
+	defer t.Unlock()
+	for rid, cancelFunc := range t.cancelFuncs {
+		cancelFunc()
+		delete(t.cancelFuncs, rid)
+	}
+}
+
+// wsObjectsBatcher groups listed objects into batches and hands them over
+// to send, which blocks until the batch is accepted by the writer. Since the
+// listing is consumed on the same goroutine, a slow client pauses the listing
+// instead of making the server accumulate the whole prefix.
+type wsObjectsBatcher struct {
+	requestID int64
+	size      int
+	buffer    []ObjectResponse
+	send      func(response WSResponse) bool
+}
+
+// add appends an object to the current batch and flushes it once it's full.
+// It returns false if the response could not be delivered.
+func (b *wsObjectsBatcher) add(obj ObjectResponse) bool {
+	b.buffer = append(b.buffer, obj)
+	if len(b.buffer) >= b.size {
+		return b.flush()
+	}
+	return true
+}
+
+// flush sends any pending objects
+func (b *wsObjectsBatcher) flush() bool {
+	if len(b.buffer) == 0 {
+		return true
+	}
+	ok := b.send(WSResponse{
+		RequestID: b.requestID,
+		Data:      b.buffer,
+	})
+	b.buffer = nil
+	return ok
+}
+
+// end flushes pending objects and notifies the client the listing finished
+func (b *wsObjectsBatcher) end() bool {
+	if !b.flush() {
+		return false
+	}
+	return b.send(WSResponse{
+		RequestID:  b.requestID,
+		RequestEnd: true,
+	})
+}
+
 func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 	// Storage of Cancel Contexts for this connection
-	cancelContexts := make(map[int64]context.CancelFunc)
+	requests := newWSRequestsTracker()
+
+	writeChannel := make(chan WSResponse, maxPendingBatches)
+	done := make(chan interface{})
+	var doneOnce sync.Once
+	closeDone := func() {
+		doneOnce.Do(func() { close(done) })
+	}
+
 	// Initial goroutine
 	defer func() {
 		// We close socket at the end of requests
 		wsc.conn.close()
-		for _, c := range cancelContexts {
-			// invoke cancel
-			c()
-		}
+		requests.cancelAll()
 	}()
 
-	writeChannel := make(chan WSResponse)
-	done := make(chan interface{})
+	// sendResponse queues a response for writing, blocking while the queue is full.
+	// It gives up if the request gets cancelled or the connection is closed.
+	sendResponse := func(ctx context.Context, response WSResponse) bool {
+		select {
+		case writeChannel <- response:
+			return true
+		case <-ctx.Done():
+			return false
+		case <-done:
+			return false
+		}
+	}
 
 	// Read goroutine
 	go func() {
@@ -49,7 +174,7 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 			mType, message, err := wsc.conn.readMessage()
 			if err != nil {
 				LogInfo("Error while reading objectManager message", err)
-				close(done)
+				closeDone()
 				return
 			}
 
@@ -60,53 +185,55 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 				err := json.Unmarshal(message, &messageRequest)
 				if err != nil {
 					LogInfo("Error on message request unmarshal")
-					close(done)
+					closeDone()
 					return
 				}
 
-				// new message, new context
-				ctx, cancel := context.WithCancel(context.Background())
-
-				// We store the cancel func associated with this request
-				cancelContexts[messageRequest.RequestID] = cancel
-
-				const itemsPerBatch = 1000
 				switch messageRequest.Mode {
 				case "close":
-					close(done)
+					closeDone()
 					return
 				case "cancel":
 					// if we have that request id, cancel it
-					if cancelFunc, ok := cancelContexts[messageRequest.RequestID]; ok {
-						cancelFunc()
-						delete(cancelContexts, messageRequest.RequestID)
-					}
+					requests.cancel(messageRequest.RequestID)
 				case "objects":
 					// cancel all previous open objects requests for listing
-					for rid, c := range cancelContexts {
-						if rid < messageRequest.RequestID {
-							// invoke cancel
-							c()
-						}
-					}
+					requests.cancelPrevious(messageRequest.RequestID)
+
+					// new message, new context
+					ctx, cancel := context.WithCancel(context.Background())
+					// We store the cancel func associated with this request
+					requests.add(messageRequest.RequestID, cancel)
 
 					// start listing and writing to web socket
 					go func() {
+						defer requests.done(messageRequest.RequestID)
+
 						objectRqConfigs, err := getObjectsOptionsFromReq(messageRequest)
 						if err != nil {
 							LogInfo(fmt.Sprintf("Error during Objects OptionsParse %s", err.Error()))
 							return
 						}
-						var buffer []ObjectResponse
+
+						batcher := wsObjectsBatcher{
+							requestID: messageRequest.RequestID,
+							size:      itemsPerBatch,
+							send: func(response WSResponse) bool {
+								return sendResponse(ctx, response)
+							},
+						}
+
 						for lsObj := range startObjectsListing(ctx, wsc.client, objectRqConfigs) {
-							if cancelContexts[messageRequest.RequestID] == nil {
+							if ctx.Err() != nil {
 								return
 							}
 							if lsObj.Err != nil {
-								writeChannel <- WSResponse{
+								if !sendResponse(ctx, WSResponse{
 									RequestID: messageRequest.RequestID,
 									Error:     lsObj.Err.Error(),
 									Prefix:    messageRequest.Prefix,
+								}) {
+									return
 								}
 
 								continue
@@ -119,67 +246,60 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 								IsLatest:     lsObj.IsLatest,
 								DeleteMarker: lsObj.IsDeleteMarker,
 							}
-							buffer = append(buffer, objItem)
-
-							if len(buffer) >= itemsPerBatch {
-								writeChannel <- WSResponse{
-									RequestID: messageRequest.RequestID,
-									Data:      buffer,
-								}
-								buffer = nil
-							}
-						}
-						if len(buffer) > 0 {
-							writeChannel <- WSResponse{
-								RequestID: messageRequest.RequestID,
-								Data:      buffer,
+							if !batcher.add(objItem) {
+								return
 							}
 						}
 
-						writeChannel <- WSResponse{
-							RequestID:  messageRequest.RequestID,
-							RequestEnd: true,
-						}
-
-						// remove the cancellation context
-						delete(cancelContexts, messageRequest.RequestID)
+						batcher.end()
 					}()
 				case "rewind":
 					// cancel all previous open objects requests for listing
-					for rid, c := range cancelContexts {
-						if rid < messageRequest.RequestID {
-							// invoke cancel
-							c()
-						}
-					}
+					requests.cancelPrevious(messageRequest.RequestID)
+
+					// new message, new context
+					ctx, cancel := context.WithCancel(context.Background())
+					// We store the cancel func associated with this request
+					requests.add(messageRequest.RequestID, cancel)
 
 					// start listing and writing to web socket
 					go func() {
+						defer requests.done(messageRequest.RequestID)
+
 						objectRqConfigs, err := getObjectsOptionsFromReq(messageRequest)
 						if err != nil {
 							LogInfo(fmt.Sprintf("Error during Objects OptionsParse %s", err.Error()))
-							cancel()
 							return
 						}
 
 						s3Client, err := newS3BucketClient(session, objectRqConfigs.BucketName, objectRqConfigs.Prefix)
 						if err != nil {
 							LogError("error creating S3Client:", err)
-							close(done)
-							cancel()
+							closeDone()
 							return
 						}
 
 						mcS3C := mcClient{client: s3Client}
 
-						var buffer []ObjectResponse
+						batcher := wsObjectsBatcher{
+							requestID: messageRequest.RequestID,
+							size:      itemsPerBatch,
+							send: func(response WSResponse) bool {
+								return sendResponse(ctx, response)
+							},
+						}
 
 						for lsObj := range startRewindListing(ctx, mcS3C, objectRqConfigs) {
+							if ctx.Err() != nil {
+								return
+							}
 							if lsObj.Err != nil {
-								writeChannel <- WSResponse{
+								if !sendResponse(ctx, WSResponse{
 									RequestID: messageRequest.RequestID,
 									Error:     lsObj.Err.String(),
 									Prefix:    messageRequest.Prefix,
+								}) {
+									return
 								}
 
 								continue
@@ -195,31 +315,12 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 								IsLatest:     lsObj.IsLatest,
 								DeleteMarker: lsObj.IsDeleteMarker,
 							}
-							buffer = append(buffer, objItem)
-
-							if len(buffer) >= itemsPerBatch {
-								writeChannel <- WSResponse{
-									RequestID: messageRequest.RequestID,
-									Data:      buffer,
-								}
-								buffer = nil
-							}
-
-						}
-						if len(buffer) > 0 {
-							writeChannel <- WSResponse{
-								RequestID: messageRequest.RequestID,
-								Data:      buffer,
+							if !batcher.add(objItem) {
+								return
 							}
 						}
 
-						writeChannel <- WSResponse{
-							RequestID:  messageRequest.RequestID,
-							RequestEnd: true,
-						}
-
-						// remove the cancellation context
-						delete(cancelContexts, messageRequest.RequestID)
+						batcher.end()
 					}()
 				}
 			}
@@ -228,18 +329,25 @@ func (wsc *wsMinioClient) objectManager(session *models.Principal) {
 
 	// Write goroutine
 	go func() {
-		for writeM := range writeChannel {
-			jsonData, err := json.Marshal(writeM)
-			if err != nil {
-				LogInfo("Error while parsing the response", err)
+		for {
+			select {
+			case <-done:
 				return
-			}
+			case writeM := <-writeChannel:
+				jsonData, err := json.Marshal(writeM)
+				if err != nil {
+					LogInfo("Error while parsing the response", err)
+					closeDone()
+					return
+				}
 
-			err = wsc.conn.writeMessage(websocket.TextMessage, jsonData)
+				err = wsc.conn.writeMessage(websocket.TextMessage, jsonData)
 
-			if err != nil {
-				LogInfo("Error while writing the message", err)
-				return
+				if err != nil {
+					LogInfo("Error while writing the message", err)
+					closeDone()
+					return
+				}
 			}
 		}
 	}()
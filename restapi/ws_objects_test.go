@@ -0,0 +1,98 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2023 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWSObjectsBatcher(t *testing.T) {
+	assert := assert.New(t)
+
+	var responses []WSResponse
+	batcher := wsObjectsBatcher{
+		requestID: 7,
+		size:      2,
+		send: func(response WSResponse) bool {
+			responses = append(responses, response)
+			return true
+		},
+	}
+
+	for i := 0; i < 5; i++ {
+		assert.True(batcher.add(ObjectResponse{Name: fmt.Sprintf("file%d.txt", i)}))
+	}
+	assert.True(batcher.end())
+
+	// two full batches, the remainder and the request end
+	assert.Len(responses, 4)
+	assert.Len(responses[0].Data, 2)
+	assert.Len(responses[1].Data, 2)
+	assert.Len(responses[2].Data, 1)
+	assert.Equal("file4.txt", responses[2].Data[0].Name)
+	assert.True(responses[3].RequestEnd)
+	for _, r := range responses {
+		assert.Equal(int64(7), r.RequestID)
+	}
+
+	// if the receiver is gone, the batcher reports it so listing can stop
+	rejecting := wsObjectsBatcher{
+		requestID: 8,
+		size:      1,
+		send: func(response WSResponse) bool {
+			return false
+		},
+	}
+	assert.False(rejecting.add(ObjectResponse{Name: "file.txt"}))
+	assert.False(rejecting.end())
+}
+
+func TestWSRequestsTracker(t *testing.T) {
+	assert := assert.New(t)
+	tracker := newWSRequestsTracker()
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	ctx3, cancel3 := context.WithCancel(context.Background())
+	tracker.add(1, cancel1)
+	tracker.add(2, cancel2)
+	tracker.add(3, cancel3)
+
+	// a new listing cancels the older ones only
+	tracker.cancelPrevious(3)
+	assert.Error(ctx1.Err())
+	assert.Error(ctx2.Err())
+	assert.NoError(ctx3.Err())
+
+	// cancelling an unknown request is a no-op
+	tracker.cancel(10)
+	assert.NoError(ctx3.Err())
+
+	tracker.cancel(3)
+	assert.Error(ctx3.Err())
+	assert.Empty(tracker.cancelFuncs)
+
+	ctx4, cancel4 := context.WithCancel(context.Background())
+	tracker.add(4, cancel4)
+	tracker.cancelAll()
+	assert.Error(ctx4.Err())
+	assert.Empty(tracker.cancelFuncs)
+}